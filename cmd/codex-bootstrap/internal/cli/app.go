@@ -31,6 +31,8 @@ func Run(args []string, stdout io.Writer, stderr io.Writer) int {
 		return runValidate(args[1:], stdout, stderr)
 	case "render":
 		return runRender(args[1:], stdout, stderr)
+	case "lint":
+		return runLint(args[1:], stdout, stderr)
 	case "render-manifest":
 		return runRenderManifest(args[1:], stdout, stderr)
 	case "preflight":
@@ -361,6 +363,7 @@ func printUsage(out io.Writer) {
 	writeln(out, "Usage:")
 	writeln(out, "  codex-bootstrap validate [flags]")
 	writeln(out, "  codex-bootstrap render [flags]")
+	writeln(out, "  codex-bootstrap lint [flags]")
 	writeln(out, "  codex-bootstrap render-manifest [flags]")
 	writeln(out, "  codex-bootstrap preflight [flags]")
 	writeln(out, "  codex-bootstrap github-sync [flags]")
@@ -372,6 +375,7 @@ func printUsage(out io.Writer) {
 	writeln(out, "Examples:")
 	writeln(out, "  go run ./cmd/codex-bootstrap validate --config services.yaml --env production")
 	writeln(out, "  go run ./cmd/codex-bootstrap render --config services.yaml --env production --output /tmp/rendered.yaml")
+	writeln(out, "  go run ./cmd/codex-bootstrap lint --config services.yaml --format sarif --output /tmp/services-lint.sarif")
 	writeln(out, "  go run ./cmd/codex-bootstrap render-manifest --template deploy/base/namespace/namespace.yaml.tpl")
 	writeln(out, "  go run ./cmd/codex-bootstrap preflight --env-file bootstrap/host/config.env")
 	writeln(out, "  go run ./cmd/codex-bootstrap github-sync --env-file bootstrap/host/config.env")
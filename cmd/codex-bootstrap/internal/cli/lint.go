@@ -0,0 +1,254 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+)
+
+const (
+	lintFormatText  = "text"
+	lintFormatJSON  = "json"
+	lintFormatSARIF = "sarif"
+
+	sarifSchemaURI = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion   = "2.1.0"
+)
+
+type stringList []string
+
+func (s *stringList) String() string {
+	return strings.Join(*s, ",")
+}
+
+func (s *stringList) Set(value string) error {
+	for _, item := range strings.Split(value, ",") {
+		if trimmed := strings.TrimSpace(item); trimmed != "" {
+			*s = append(*s, trimmed)
+		}
+	}
+	return nil
+}
+
+func runLint(args []string, stdout io.Writer, stderr io.Writer) int {
+	var (
+		vars         kvList
+		envs         stringList
+		slots        stringList
+		templateDirs stringList
+	)
+	fs := flag.NewFlagSet("lint", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	configPath := fs.String("config", "services.yaml", "Path to services.yaml")
+	repositoryRoot := fs.String("repository-root", "", "Repository root for manifest and bumpOn paths (default: config directory)")
+	format := fs.String("format", lintFormatText, "Output format: text|json|sarif")
+	outputPath := fs.String("output", "", "Optional output path for report")
+	fs.Var(&envs, "env", "Environment to lint (repeatable, default: all environments)")
+	fs.Var(&slots, "slot", "Slot sample for slot-based environments (repeatable, default: 1)")
+	fs.Var(&templateDirs, "templates-dir", "Extra directory scanned for image/version usage (repeatable, default: deploy)")
+	fs.Var(&vars, "var", "Template variable in KEY=VALUE format (repeatable)")
+
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+
+	outputFormat := strings.ToLower(strings.TrimSpace(*format))
+	switch outputFormat {
+	case lintFormatText, lintFormatJSON, lintFormatSARIF:
+	default:
+		writef(stderr, "lint failed: unsupported format %q\n", *format)
+		return 2
+	}
+
+	slotSamples := make([]int, 0, len(slots))
+	for _, raw := range slots {
+		slot, err := strconv.Atoi(raw)
+		if err != nil || slot < 0 {
+			writef(stderr, "lint failed: invalid --slot %q\n", raw)
+			return 2
+		}
+		slotSamples = append(slotSamples, slot)
+	}
+	if len(templateDirs) == 0 {
+		templateDirs = stringList{"deploy"}
+	}
+
+	report, err := servicescfg.Lint(*configPath, servicescfg.LintOptions{
+		Environments:   envs,
+		SlotSamples:    slotSamples,
+		Vars:           vars.Map(),
+		RepositoryRoot: *repositoryRoot,
+		TemplateDirs:   templateDirs,
+	})
+	if err != nil {
+		writef(stderr, "lint failed: %v\n", err)
+		return 2
+	}
+
+	out := stdout
+	if strings.TrimSpace(*outputPath) != "" {
+		file, err := os.Create(filepath.Clean(*outputPath))
+		if err != nil {
+			writef(stderr, "create output %q: %v\n", *outputPath, err)
+			return 1
+		}
+		defer func() { _ = file.Close() }()
+		out = file
+	}
+
+	switch outputFormat {
+	case lintFormatJSON:
+		err = writeJSON(out, report)
+	case lintFormatSARIF:
+		err = writeJSON(out, buildLintSARIF(report))
+	default:
+		writeLintText(out, report)
+	}
+	if err != nil {
+		writef(stderr, "write report: %v\n", err)
+		return 1
+	}
+
+	if report.HasErrors() {
+		return 1
+	}
+	return 0
+}
+
+func writeLintText(out io.Writer, report servicescfg.LintReport) {
+	for _, finding := range report.Findings {
+		location := finding.File
+		if finding.Location != "" {
+			location += " " + finding.Location
+		}
+		line := fmt.Sprintf("%s [%s] %s: %s", finding.Severity, finding.Rule, location, finding.Message)
+		if len(finding.Targets) > 0 {
+			line += " (" + strings.Join(finding.Targets, ", ") + ")"
+		}
+		writeln(out, line)
+	}
+
+	targets := make([]string, 0, len(report.Targets))
+	for _, target := range report.Targets {
+		targets = append(targets, target.Label())
+	}
+	errorsCount, warningsCount := report.Counts()
+	writef(out, "lint config=%s targets=%s errors=%d warnings=%d\n",
+		report.ConfigFile,
+		strings.Join(targets, ","),
+		errorsCount,
+		warningsCount,
+	)
+}
+
+func writeJSON(out io.Writer, value any) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(value)
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID                   string             `json:"id"`
+	ShortDescription     sarifMessage       `json:"shortDescription"`
+	DefaultConfiguration sarifConfiguration `json:"defaultConfiguration"`
+}
+
+type sarifConfiguration struct {
+	Level string `json:"level"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation  `json:"physicalLocation"`
+	LogicalLocations []sarifLogicalLocation `json:"logicalLocations,omitempty"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifLogicalLocation struct {
+	FullyQualifiedName string `json:"fullyQualifiedName"`
+}
+
+func buildLintSARIF(report servicescfg.LintReport) sarifLog {
+	rules := make([]sarifRule, 0, len(servicescfg.LintRules))
+	for _, rule := range servicescfg.LintRules {
+		rules = append(rules, sarifRule{
+			ID:                   rule.ID,
+			ShortDescription:     sarifMessage{Text: rule.Description},
+			DefaultConfiguration: sarifConfiguration{Level: string(rule.Severity)},
+		})
+	}
+
+	results := make([]sarifResult, 0, len(report.Findings))
+	for _, finding := range report.Findings {
+		message := finding.Message
+		if len(finding.Targets) > 0 {
+			message += " (" + strings.Join(finding.Targets, ", ") + ")"
+		}
+		location := sarifLocation{
+			PhysicalLocation: sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: finding.File}},
+		}
+		if finding.Location != "" {
+			location.LogicalLocations = []sarifLogicalLocation{{FullyQualifiedName: finding.Location}}
+		}
+		results = append(results, sarifResult{
+			RuleID:    finding.Rule,
+			Level:     string(finding.Severity),
+			Message:   sarifMessage{Text: message},
+			Locations: []sarifLocation{location},
+		})
+	}
+
+	return sarifLog{
+		Schema:  sarifSchemaURI,
+		Version: sarifVersion,
+		Runs: []sarifRun{{
+			Tool:    sarifTool{Driver: sarifDriver{Name: "codex-bootstrap lint", Rules: rules}},
+			Results: results,
+		}},
+	}
+}
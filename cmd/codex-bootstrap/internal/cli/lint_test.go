@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunLint_SARIFReportAndExitCode(t *testing.T) {
+	dir := t.TempDir()
+	configPath := filepath.Join(dir, "services.yaml")
+	config := `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-production"
+  services:
+    - name: api
+      manifests:
+        - path: deploy/api.yaml
+`
+	if err := os.WriteFile(configPath, []byte(config), 0o644); err != nil {
+		t.Fatalf("write config: %v", err)
+	}
+
+	var stdout, stderr bytes.Buffer
+	code := runLint([]string{"--config", configPath, "--format", "sarif"}, &stdout, &stderr)
+	if code != 1 {
+		t.Fatalf("unexpected exit code: got=%d want=1 stderr=%s", code, stderr.String())
+	}
+
+	var report sarifLog
+	if err := json.Unmarshal(stdout.Bytes(), &report); err != nil {
+		t.Fatalf("decode sarif: %v", err)
+	}
+	if len(report.Runs) != 1 || len(report.Runs[0].Results) != 1 {
+		t.Fatalf("unexpected sarif runs: %+v", report.Runs)
+	}
+	result := report.Runs[0].Results[0]
+	if result.RuleID != "missing-manifest" || result.Level != "error" {
+		t.Fatalf("unexpected sarif result: %+v", result)
+	}
+	if got := result.Locations[0].PhysicalLocation.ArtifactLocation.URI; got != "services.yaml" {
+		t.Fatalf("unexpected artifact uri: %q", got)
+	}
+}
+
+func TestRunLint_InvalidFormat(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if code := runLint([]string{"--format", "xml"}, &stdout, &stderr); code != 2 {
+		t.Fatalf("unexpected exit code: got=%d want=2", code)
+	}
+}
@@ -247,6 +247,7 @@ func hydrateValuesFromExistingSecrets(values map[string]string, existingPostgres
 		"KODEX_TELEGRAM_INTERACTION_ADAPTER_WEBHOOK_SECRET",
 		"KODEX_TELEGRAM_INTERACTION_ADAPTER_TIMEOUT",
 		"KODEX_TELEGRAM_INTERACTION_ADAPTER_RECIPIENT_BINDINGS_JSON",
+		"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_API_KEY",
 		"KODEX_CONTEXT7_API_KEY",
 		"KODEX_APP_SECRET_KEY",
 		"KODEX_TOKEN_ENCRYPTION_KEY",
@@ -254,8 +255,24 @@ func hydrateValuesFromExistingSecrets(values map[string]string, existingPostgres
 		"KODEX_MCP_TOKEN_TTL",
 		"KODEX_RUN_HEAVY_FIELDS_RETENTION_DAYS",
 		"KODEX_RUN_AGENT_LOGS_RETENTION_DAYS",
+		"KODEX_BLOB_STORE_BACKEND",
+		"KODEX_BLOB_STORE_FS_ROOT",
+		"KODEX_BLOB_STORE_S3_ENDPOINT",
+		"KODEX_BLOB_STORE_S3_REGION",
+		"KODEX_BLOB_STORE_S3_BUCKET",
+		"KODEX_BLOB_STORE_S3_ACCESS_KEY_ID",
+		"KODEX_BLOB_STORE_S3_SECRET_ACCESS_KEY",
+		"KODEX_BLOB_STORE_S3_PATH_STYLE",
+		"KODEX_RETENTION_PINNED_FLOW_EVENT_TYPES",
+		"KODEX_OIDC_ISSUER_URL",
+		"KODEX_OIDC_CLIENT_ID",
+		"KODEX_OIDC_CLIENT_SECRET",
+		"KODEX_OIDC_SCOPES",
+		"KODEX_OIDC_GROUPS_CLAIM",
+		"KODEX_OIDC_GROUP_ROLE_MAPPINGS",
 		"KODEX_LEARNING_MODE_DEFAULT",
 		"KODEX_GITHUB_WEBHOOK_SECRET",
+		"KODEX_ALERTMANAGER_WEBHOOK_TOKEN",
 		"KODEX_GITHUB_WEBHOOK_URL",
 		"KODEX_GITHUB_WEBHOOK_EVENTS",
 		"KODEX_PRODUCTION_DOMAIN",
@@ -296,6 +313,10 @@ func hydrateValuesFromExistingSecrets(values map[string]string, existingPostgres
 	if err != nil {
 		return err
 	}
+	values["KODEX_ALERTMANAGER_WEBHOOK_TOKEN"], err = valueOrRandomHex(values, "KODEX_ALERTMANAGER_WEBHOOK_TOKEN", 32)
+	if err != nil {
+		return err
+	}
 	values["KODEX_JWT_SIGNING_KEY"], err = valueOrRandomHex(values, "KODEX_JWT_SIGNING_KEY", 32)
 	if err != nil {
 		return err
@@ -372,6 +393,7 @@ func buildRuntimeSecretValues(values map[string]string) map[string]string {
 		"KODEX_TELEGRAM_INTERACTION_ADAPTER_WEBHOOK_SECRET":          strings.TrimSpace(values["KODEX_TELEGRAM_INTERACTION_ADAPTER_WEBHOOK_SECRET"]),
 		"KODEX_TELEGRAM_INTERACTION_ADAPTER_TIMEOUT":                 strings.TrimSpace(values["KODEX_TELEGRAM_INTERACTION_ADAPTER_TIMEOUT"]),
 		"KODEX_TELEGRAM_INTERACTION_ADAPTER_RECIPIENT_BINDINGS_JSON": strings.TrimSpace(values["KODEX_TELEGRAM_INTERACTION_ADAPTER_RECIPIENT_BINDINGS_JSON"]),
+		"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_API_KEY":        strings.TrimSpace(values["KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_API_KEY"]),
 		"KODEX_CONTEXT7_API_KEY":                                     strings.TrimSpace(values["KODEX_CONTEXT7_API_KEY"]),
 		"KODEX_APP_SECRET_KEY":                                       strings.TrimSpace(values["KODEX_APP_SECRET_KEY"]),
 		"KODEX_TOKEN_ENCRYPTION_KEY":                                 strings.TrimSpace(values["KODEX_TOKEN_ENCRYPTION_KEY"]),
@@ -379,8 +401,24 @@ func buildRuntimeSecretValues(values map[string]string) map[string]string {
 		"KODEX_MCP_TOKEN_TTL":                                        strings.TrimSpace(values["KODEX_MCP_TOKEN_TTL"]),
 		"KODEX_RUN_HEAVY_FIELDS_RETENTION_DAYS":                      strings.TrimSpace(values["KODEX_RUN_HEAVY_FIELDS_RETENTION_DAYS"]),
 		"KODEX_RUN_AGENT_LOGS_RETENTION_DAYS":                        strings.TrimSpace(values["KODEX_RUN_AGENT_LOGS_RETENTION_DAYS"]),
+		"KODEX_BLOB_STORE_BACKEND":                                   strings.TrimSpace(values["KODEX_BLOB_STORE_BACKEND"]),
+		"KODEX_BLOB_STORE_FS_ROOT":                                   strings.TrimSpace(values["KODEX_BLOB_STORE_FS_ROOT"]),
+		"KODEX_BLOB_STORE_S3_ENDPOINT":                               strings.TrimSpace(values["KODEX_BLOB_STORE_S3_ENDPOINT"]),
+		"KODEX_BLOB_STORE_S3_REGION":                                 strings.TrimSpace(values["KODEX_BLOB_STORE_S3_REGION"]),
+		"KODEX_BLOB_STORE_S3_BUCKET":                                 strings.TrimSpace(values["KODEX_BLOB_STORE_S3_BUCKET"]),
+		"KODEX_BLOB_STORE_S3_ACCESS_KEY_ID":                          strings.TrimSpace(values["KODEX_BLOB_STORE_S3_ACCESS_KEY_ID"]),
+		"KODEX_BLOB_STORE_S3_SECRET_ACCESS_KEY":                      strings.TrimSpace(values["KODEX_BLOB_STORE_S3_SECRET_ACCESS_KEY"]),
+		"KODEX_BLOB_STORE_S3_PATH_STYLE":                             strings.TrimSpace(values["KODEX_BLOB_STORE_S3_PATH_STYLE"]),
+		"KODEX_RETENTION_PINNED_FLOW_EVENT_TYPES":                    strings.TrimSpace(values["KODEX_RETENTION_PINNED_FLOW_EVENT_TYPES"]),
+		"KODEX_OIDC_ISSUER_URL":                                      strings.TrimSpace(values["KODEX_OIDC_ISSUER_URL"]),
+		"KODEX_OIDC_CLIENT_ID":                                       strings.TrimSpace(values["KODEX_OIDC_CLIENT_ID"]),
+		"KODEX_OIDC_CLIENT_SECRET":                                   strings.TrimSpace(values["KODEX_OIDC_CLIENT_SECRET"]),
+		"KODEX_OIDC_SCOPES":                                          strings.TrimSpace(values["KODEX_OIDC_SCOPES"]),
+		"KODEX_OIDC_GROUPS_CLAIM":                                    strings.TrimSpace(values["KODEX_OIDC_GROUPS_CLAIM"]),
+		"KODEX_OIDC_GROUP_ROLE_MAPPINGS":                             strings.TrimSpace(values["KODEX_OIDC_GROUP_ROLE_MAPPINGS"]),
 		"KODEX_LEARNING_MODE_DEFAULT":                                strings.TrimSpace(values["KODEX_LEARNING_MODE_DEFAULT"]),
 		"KODEX_GITHUB_WEBHOOK_SECRET":                                strings.TrimSpace(values["KODEX_GITHUB_WEBHOOK_SECRET"]),
+		"KODEX_ALERTMANAGER_WEBHOOK_TOKEN":                           strings.TrimSpace(values["KODEX_ALERTMANAGER_WEBHOOK_TOKEN"]),
 		"KODEX_GITHUB_WEBHOOK_URL":                                   strings.TrimSpace(values["KODEX_GITHUB_WEBHOOK_URL"]),
 		"KODEX_GITHUB_WEBHOOK_EVENTS":                                strings.TrimSpace(values["KODEX_GITHUB_WEBHOOK_EVENTS"]),
 		"KODEX_PRODUCTION_DOMAIN":                                    strings.TrimSpace(values["KODEX_PRODUCTION_DOMAIN"]),
@@ -72,6 +72,12 @@ spec:
                 secretKeyRef:
                   name: kodex-runtime
                   key: KODEX_GITHUB_WEBHOOK_SECRET
+            - name: KODEX_ALERTMANAGER_WEBHOOK_TOKEN
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_ALERTMANAGER_WEBHOOK_TOKEN
+                  optional: true
             - name: KODEX_PUBLIC_BASE_URL
               valueFrom:
                 secretKeyRef:
@@ -99,6 +105,36 @@ spec:
                 secretKeyRef:
                   name: kodex-runtime
                   key: KODEX_JWT_TTL
+            - name: KODEX_OIDC_ISSUER_URL
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_OIDC_ISSUER_URL
+                  optional: true
+            - name: KODEX_OIDC_CLIENT_ID
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_OIDC_CLIENT_ID
+                  optional: true
+            - name: KODEX_OIDC_CLIENT_SECRET
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_OIDC_CLIENT_SECRET
+                  optional: true
+            - name: KODEX_OIDC_SCOPES
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_OIDC_SCOPES
+                  optional: true
+            - name: KODEX_OIDC_GROUPS_CLAIM
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_OIDC_GROUPS_CLAIM
+                  optional: true
 {{ if eq (envOr "KODEX_HOT_RELOAD" "") "true" }}
           volumeMounts:
             - name: repo-cache
@@ -301,6 +337,8 @@ spec:
                   name: kodex-runtime
                   key: KODEX_PROJECT_DB_LIFECYCLE_ALLOWED_ENVS
                   optional: true
+            - name: KODEX_MCP_PROJECT_TOOL_ALLOWED_HOSTS
+              value: '{{ envOr "KODEX_MCP_PROJECT_TOOL_ALLOWED_HOSTS" "" }}'
             - name: KODEX_TOKEN_ENCRYPTION_KEY
               valueFrom:
                 secretKeyRef:
@@ -353,6 +391,66 @@ spec:
                   name: kodex-runtime
                   key: KODEX_RUN_HEAVY_FIELDS_RETENTION_DAYS
                   optional: true
+            - name: KODEX_BLOB_STORE_BACKEND
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_BLOB_STORE_BACKEND
+                  optional: true
+            - name: KODEX_BLOB_STORE_FS_ROOT
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_BLOB_STORE_FS_ROOT
+                  optional: true
+            - name: KODEX_BLOB_STORE_S3_ENDPOINT
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_BLOB_STORE_S3_ENDPOINT
+                  optional: true
+            - name: KODEX_BLOB_STORE_S3_REGION
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_BLOB_STORE_S3_REGION
+                  optional: true
+            - name: KODEX_BLOB_STORE_S3_BUCKET
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_BLOB_STORE_S3_BUCKET
+                  optional: true
+            - name: KODEX_BLOB_STORE_S3_ACCESS_KEY_ID
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_BLOB_STORE_S3_ACCESS_KEY_ID
+                  optional: true
+            - name: KODEX_BLOB_STORE_S3_SECRET_ACCESS_KEY
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_BLOB_STORE_S3_SECRET_ACCESS_KEY
+                  optional: true
+            - name: KODEX_BLOB_STORE_S3_PATH_STYLE
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_BLOB_STORE_S3_PATH_STYLE
+                  optional: true
+            - name: KODEX_RETENTION_PINNED_FLOW_EVENT_TYPES
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_RETENTION_PINNED_FLOW_EVENT_TYPES
+                  optional: true
+            - name: KODEX_OIDC_GROUP_ROLE_MAPPINGS
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_OIDC_GROUP_ROLE_MAPPINGS
+                  optional: true
             - name: KODEX_CONTROL_PLANE_MCP_BASE_URL
               value: '{{ envOr "KODEX_CONTROL_PLANE_MCP_BASE_URL" "http://kodex-control-plane:8081/mcp" }}'
             - name: KODEX_LEARNING_MODE_DEFAULT
@@ -677,6 +775,9 @@ rules:
   - apiGroups: [""]
     resources: ["configmaps", "endpoints", "services"]
     verbs: ["get", "list", "watch"]
+  - apiGroups: [""]
+    resources: ["secrets"]
+    verbs: ["get", "create", "update", "delete"]
   - apiGroups: ["apps"]
     resources: ["daemonsets", "deployments", "replicasets", "statefulsets"]
     verbs: ["get", "list", "watch"]
@@ -844,6 +945,22 @@ spec:
               value: '{{ envOr "KODEX_WORKER_MISSION_CONTROL_RETRY_MAX_ATTEMPTS" "" }}'
             - name: KODEX_WORKER_MISSION_CONTROL_RETRY_BASE_INTERVAL
               value: '{{ envOr "KODEX_WORKER_MISSION_CONTROL_RETRY_BASE_INTERVAL" "" }}'
+            - name: KODEX_WORKER_RETENTION_POLL_INTERVAL
+              value: '{{ envOr "KODEX_WORKER_RETENTION_POLL_INTERVAL" "" }}'
+            - name: KODEX_WORKER_RETENTION_SWEEP_INTERVAL
+              value: '{{ envOr "KODEX_WORKER_RETENTION_SWEEP_INTERVAL" "" }}'
+            - name: KODEX_WORKER_RETENTION_POLICY_LIMIT
+              value: '{{ envOr "KODEX_WORKER_RETENTION_POLICY_LIMIT" "" }}'
+            - name: KODEX_WORKER_RETENTION_BATCH_SIZE
+              value: '{{ envOr "KODEX_WORKER_RETENTION_BATCH_SIZE" "" }}'
+            - name: KODEX_WORKER_RETENTION_MAX_BATCHES
+              value: '{{ envOr "KODEX_WORKER_RETENTION_MAX_BATCHES" "" }}'
+            - name: KODEX_WORKER_WARM_POOL_RECONCILE_INTERVAL
+              value: '{{ envOr "KODEX_WORKER_WARM_POOL_RECONCILE_INTERVAL" "" }}'
+            - name: KODEX_WORKER_WARM_POOL_PREPARE_TIMEOUT
+              value: '{{ envOr "KODEX_WORKER_WARM_POOL_PREPARE_TIMEOUT" "" }}'
+            - name: KODEX_WORKER_WARM_POOL_MAX_AGE
+              value: '{{ envOr "KODEX_WORKER_WARM_POOL_MAX_AGE" "" }}'
             - name: KODEX_WORKER_K8S_NAMESPACE
               value: '{{ envOr "KODEX_WORKER_K8S_NAMESPACE" "" }}'
             - name: KODEX_WORKER_POD_NAME
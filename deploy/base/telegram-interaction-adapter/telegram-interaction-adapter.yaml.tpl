@@ -84,6 +84,8 @@ spec:
                   name: kodex-runtime
                   key: KODEX_TELEGRAM_INTERACTION_ADAPTER_RECIPIENT_BINDINGS_JSON
                   optional: true
+            - name: KODEX_TELEGRAM_INTERACTION_ADAPTER_PROJECT_CHAT_BINDINGS_JSON
+              value: '{{ envOr "KODEX_TELEGRAM_INTERACTION_ADAPTER_PROJECT_CHAT_BINDINGS_JSON" "" }}'
             - name: KODEX_TELEGRAM_INTERACTION_ADAPTER_BEARER_TOKEN
               valueFrom:
                 secretKeyRef:
@@ -100,6 +102,22 @@ spec:
               value: '{{ envOr "KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_MODEL" "gpt-4o-mini-transcribe" }}'
             - name: KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_TIMEOUT
               value: '{{ envOr "KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_TIMEOUT" "30s" }}'
+            - name: KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_BACKEND
+              value: '{{ envOr "KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_BACKEND" "openai" }}'
+            - name: KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_URL
+              value: '{{ envOr "KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_URL" "" }}'
+            - name: KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_MODEL
+              value: '{{ envOr "KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_MODEL" "" }}'
+            - name: KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_API_KEY
+              valueFrom:
+                secretKeyRef:
+                  name: kodex-runtime
+                  key: KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_API_KEY
+                  optional: true
+            - name: KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_MIN_CONFIDENCE
+              value: '{{ envOr "KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_MIN_CONFIDENCE" "0" }}'
+            - name: KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_PROJECT_LANGUAGES_JSON
+              value: '{{ envOr "KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_PROJECT_LANGUAGES_JSON" "" }}'
           readinessProbe:
             httpGet:
               path: /readyz
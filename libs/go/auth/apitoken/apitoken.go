@@ -0,0 +1,66 @@
+// Package apitoken defines staff API token format and scopes shared by api-gateway and control-plane.
+package apitoken
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// TokenPrefix distinguishes staff API tokens from staff session JWTs in `Authorization: Bearer`.
+const TokenPrefix = "kxt_"
+
+// displayPrefixLen is the length of the random part kept in plaintext for token identification.
+const displayPrefixLen = 8
+
+// Scope grants access to one group of staff API routes.
+type Scope string
+
+const (
+	ScopeProjectsRead           Scope = "projects:read"
+	ScopeRunsRead               Scope = "runs:read"
+	ScopeRunsCancel             Scope = "runs:cancel"
+	ScopeRepositoriesManage     Scope = "repositories:manage"
+	ScopeMissionControlCommands Scope = "mission_control:commands"
+	// ScopeAdmin allows every staff route; effective rights are still bounded by token owner RBAC.
+	ScopeAdmin Scope = "admin"
+)
+
+// Scopes lists every supported scope in stable order.
+var Scopes = []Scope{
+	ScopeProjectsRead,
+	ScopeRunsRead,
+	ScopeRunsCancel,
+	ScopeRepositoriesManage,
+	ScopeMissionControlCommands,
+	ScopeAdmin,
+}
+
+// IsKnownScope reports whether scope is supported.
+func IsKnownScope(scope string) bool {
+	return slices.Contains(Scopes, Scope(scope))
+}
+
+// Generate returns a new raw token and its plaintext display prefix.
+func Generate() (raw string, displayPrefix string, err error) {
+	var b [32]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", "", fmt.Errorf("random: %w", err)
+	}
+	raw = TokenPrefix + base64.RawURLEncoding.EncodeToString(b[:])
+	return raw, raw[:len(TokenPrefix)+displayPrefixLen], nil
+}
+
+// Hash returns the at-rest representation of a raw token.
+func Hash(raw string) []byte {
+	sum := sha256.Sum256([]byte(strings.TrimSpace(raw)))
+	return sum[:]
+}
+
+// IsToken reports whether bearer value looks like a staff API token.
+func IsToken(raw string) bool {
+	return strings.HasPrefix(strings.TrimSpace(raw), TokenPrefix)
+}
@@ -0,0 +1,37 @@
+package apitoken
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	raw, prefix, err := Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !IsToken(raw) || !strings.HasPrefix(raw, prefix) || len(prefix) != len(TokenPrefix)+displayPrefixLen {
+		t.Fatalf("unexpected token %q with prefix %q", raw, prefix)
+	}
+	other, _, err := Generate()
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if raw == other || bytes.Equal(Hash(raw), Hash(other)) {
+		t.Fatal("Generate() must return unique tokens")
+	}
+	if !bytes.Equal(Hash(raw), Hash(" "+raw+" ")) {
+		t.Fatal("Hash() must ignore surrounding whitespace")
+	}
+}
+
+func TestIsKnownScope(t *testing.T) {
+	t.Parallel()
+
+	if !IsKnownScope("runs:read") || IsKnownScope("runs:write") {
+		t.Fatal("unexpected scope validation result")
+	}
+}
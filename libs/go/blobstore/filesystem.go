@@ -0,0 +1,123 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FilesystemStore keeps objects as files under root directory.
+type FilesystemStore struct {
+	root string
+}
+
+// NewFilesystemStore creates filesystem-backed blob store and ensures root directory exists.
+func NewFilesystemStore(root string) (*FilesystemStore, error) {
+	trimmed := strings.TrimSpace(root)
+	if trimmed == "" {
+		return nil, fmt.Errorf("filesystem blob store root is required")
+	}
+	if err := os.MkdirAll(trimmed, 0o750); err != nil {
+		return nil, fmt.Errorf("create filesystem blob store root: %w", err)
+	}
+	return &FilesystemStore{root: trimmed}, nil
+}
+
+// Put writes object atomically via temp file + rename.
+func (s *FilesystemStore) Put(_ context.Context, key string, data []byte) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return fmt.Errorf("create blob directory: %w", err)
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".blob-*")
+	if err != nil {
+		return fmt.Errorf("create blob temp file: %w", err)
+	}
+	tmpName := tmp.Name()
+	defer func() {
+		_ = os.Remove(tmpName)
+	}()
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write blob %s: %w", key, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close blob %s: %w", key, err)
+	}
+	if err := os.Rename(tmpName, path); err != nil {
+		return fmt.Errorf("commit blob %s: %w", key, err)
+	}
+	return nil
+}
+
+// Get reads object content.
+func (s *FilesystemStore) Get(_ context.Context, key string) ([]byte, error) {
+	path, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("read blob %s: %w", key, err)
+	}
+	return data, nil
+}
+
+// Delete removes object file.
+func (s *FilesystemStore) Delete(_ context.Context, key string) error {
+	path, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("delete blob %s: %w", key, err)
+	}
+	return nil
+}
+
+// List walks root and returns sorted keys with prefix.
+func (s *FilesystemStore) List(_ context.Context, prefix string) ([]string, error) {
+	normalizedPrefix := strings.TrimLeft(strings.TrimSpace(prefix), "/")
+	keys := make([]string, 0)
+	err := filepath.WalkDir(s.root, func(path string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".blob-") {
+			return nil
+		}
+		rel, err := filepath.Rel(s.root, path)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		if strings.HasPrefix(key, normalizedPrefix) {
+			keys = append(keys, key)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list blobs with prefix %q: %w", prefix, err)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (s *FilesystemStore) path(key string) (string, error) {
+	normalized, err := normalizeKey(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(normalized)), nil
+}
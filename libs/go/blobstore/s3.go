@@ -0,0 +1,242 @@
+package blobstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	defaultS3Region      = "us-east-1"
+	defaultS3HTTPTimeout = 30 * time.Second
+	maxS3ErrorBodyBytes  = 1024
+)
+
+// S3Config configures S3-compatible object storage access.
+type S3Config struct {
+	// Endpoint is base URL, e.g. "https://s3.eu-central-1.amazonaws.com" or "http://minio:9000".
+	Endpoint string
+	// Region is signing region; MinIO accepts default "us-east-1".
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+	// SessionToken is optional temporary credentials token.
+	SessionToken string
+	// PathStyle addresses bucket as URL path segment instead of virtual-hosted subdomain.
+	PathStyle bool
+	Timeout   time.Duration
+}
+
+// S3Store stores objects in one bucket of S3-compatible object storage.
+type S3Store struct {
+	endpoint  *url.URL
+	bucket    string
+	pathStyle bool
+	signer    sigV4Signer
+	http      *http.Client
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// NewS3Store validates config and creates S3-compatible blob store.
+func NewS3Store(cfg S3Config) (*S3Store, error) {
+	endpoint := strings.TrimSpace(cfg.Endpoint)
+	if endpoint == "" {
+		return nil, fmt.Errorf("s3 endpoint is required")
+	}
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "https://" + endpoint
+	}
+	parsed, err := url.Parse(strings.TrimRight(endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("parse s3 endpoint: %w", err)
+	}
+	if strings.TrimSpace(parsed.Host) == "" {
+		return nil, fmt.Errorf("s3 endpoint host is required")
+	}
+	bucket := strings.TrimSpace(cfg.Bucket)
+	if bucket == "" {
+		return nil, fmt.Errorf("s3 bucket is required")
+	}
+	if strings.TrimSpace(cfg.AccessKeyID) == "" || strings.TrimSpace(cfg.SecretAccessKey) == "" {
+		return nil, fmt.Errorf("s3 access key id and secret access key are required")
+	}
+	region := strings.TrimSpace(cfg.Region)
+	if region == "" {
+		region = defaultS3Region
+	}
+	timeout := cfg.Timeout
+	if timeout <= 0 {
+		timeout = defaultS3HTTPTimeout
+	}
+
+	return &S3Store{
+		endpoint:  parsed,
+		bucket:    bucket,
+		pathStyle: cfg.PathStyle,
+		signer: sigV4Signer{
+			accessKeyID:     strings.TrimSpace(cfg.AccessKeyID),
+			secretAccessKey: strings.TrimSpace(cfg.SecretAccessKey),
+			sessionToken:    strings.TrimSpace(cfg.SessionToken),
+			region:          region,
+			service:         "s3",
+			now:             time.Now,
+		},
+		http: &http.Client{Timeout: timeout},
+	}, nil
+}
+
+// Put uploads object with PutObject.
+func (s *S3Store) Put(ctx context.Context, key string, data []byte) error {
+	normalized, err := normalizeKey(key)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(ctx, http.MethodPut, normalized, nil, data)
+	if err != nil {
+		return fmt.Errorf("put blob %s: %w", normalized, err)
+	}
+	defer closeBody(resp)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("put blob %s: %w", normalized, s3ResponseError(resp))
+	}
+	return nil
+}
+
+// Get downloads object with GetObject.
+func (s *S3Store) Get(ctx context.Context, key string) ([]byte, error) {
+	normalized, err := normalizeKey(key)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.do(ctx, http.MethodGet, normalized, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("get blob %s: %w", normalized, err)
+	}
+	defer closeBody(resp)
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, ErrNotFound
+	}
+	if resp.StatusCode/100 != 2 {
+		return nil, fmt.Errorf("get blob %s: %w", normalized, s3ResponseError(resp))
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read blob %s: %w", normalized, err)
+	}
+	return data, nil
+}
+
+// Delete removes object with DeleteObject.
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	normalized, err := normalizeKey(key)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(ctx, http.MethodDelete, normalized, nil, nil)
+	if err != nil {
+		return fmt.Errorf("delete blob %s: %w", normalized, err)
+	}
+	defer closeBody(resp)
+	if resp.StatusCode/100 != 2 && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("delete blob %s: %w", normalized, s3ResponseError(resp))
+	}
+	return nil
+}
+
+// List pages through ListObjectsV2 and returns keys with prefix.
+func (s *S3Store) List(ctx context.Context, prefix string) ([]string, error) {
+	keys := make([]string, 0)
+	continuationToken := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", strings.TrimLeft(strings.TrimSpace(prefix), "/"))
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		page, err := s.listPage(ctx, query)
+		if err != nil {
+			return nil, fmt.Errorf("list blobs with prefix %q: %w", prefix, err)
+		}
+		for _, item := range page.Contents {
+			keys = append(keys, item.Key)
+		}
+		if !page.IsTruncated || strings.TrimSpace(page.NextContinuationToken) == "" {
+			return keys, nil
+		}
+		continuationToken = page.NextContinuationToken
+	}
+}
+
+func (s *S3Store) listPage(ctx context.Context, query url.Values) (listBucketResult, error) {
+	resp, err := s.do(ctx, http.MethodGet, "", query, nil)
+	if err != nil {
+		return listBucketResult{}, err
+	}
+	defer closeBody(resp)
+	if resp.StatusCode/100 != 2 {
+		return listBucketResult{}, s3ResponseError(resp)
+	}
+	var page listBucketResult
+	if err := xml.NewDecoder(resp.Body).Decode(&page); err != nil {
+		return listBucketResult{}, fmt.Errorf("decode list response: %w", err)
+	}
+	return page, nil
+}
+
+func (s *S3Store) do(ctx context.Context, method string, key string, query url.Values, body []byte) (*http.Response, error) {
+	target := *s.endpoint
+	objectPath := ""
+	if key != "" {
+		objectPath = "/" + key
+	}
+	if s.pathStyle {
+		target.Path = strings.TrimRight(target.Path, "/") + "/" + s.bucket + objectPath
+	} else {
+		target.Host = s.bucket + "." + target.Host
+		target.Path = strings.TrimRight(target.Path, "/") + objectPath
+		if target.Path == "" {
+			target.Path = "/"
+		}
+	}
+	target.RawPath = ""
+	target.RawQuery = ""
+	if len(query) > 0 {
+		target.RawQuery = canonicalQueryString(query)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if method == http.MethodPut {
+		req.Header.Set("Content-Type", "application/octet-stream")
+	}
+	s.signer.sign(req, body)
+	return s.http.Do(req)
+}
+
+func s3ResponseError(resp *http.Response) error {
+	raw, _ := io.ReadAll(io.LimitReader(resp.Body, maxS3ErrorBodyBytes))
+	return fmt.Errorf("s3 responded %d: %s", resp.StatusCode, strings.TrimSpace(string(raw)))
+}
+
+func closeBody(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
@@ -0,0 +1,83 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrNotFound is returned by Store.Get when object key does not exist.
+var ErrNotFound = errors.New("blob not found")
+
+// Backend identifies blob storage implementation.
+type Backend string
+
+const (
+	// BackendNone disables blob storage; callers keep payloads inline.
+	BackendNone Backend = ""
+	// BackendFilesystem stores objects as files under one root directory.
+	BackendFilesystem Backend = "filesystem"
+	// BackendS3 stores objects in S3-compatible object storage (AWS S3, MinIO, Ceph RGW).
+	BackendS3 Backend = "s3"
+)
+
+// Store persists opaque objects by slash-separated keys.
+type Store interface {
+	// Put writes object, overwriting existing content for the same key.
+	Put(ctx context.Context, key string, data []byte) error
+	// Get reads object content or returns ErrNotFound.
+	Get(ctx context.Context, key string) ([]byte, error)
+	// Delete removes object; missing keys are not an error.
+	Delete(ctx context.Context, key string) error
+	// List returns keys that start with prefix.
+	List(ctx context.Context, prefix string) ([]string, error)
+}
+
+// Config selects and configures blob storage backend.
+type Config struct {
+	Backend        Backend
+	FilesystemRoot string
+	S3             S3Config
+}
+
+// Open builds blob store for configured backend. BackendNone returns nil store.
+func Open(cfg Config) (Store, error) {
+	switch Backend(strings.ToLower(strings.TrimSpace(string(cfg.Backend)))) {
+	case BackendNone, "postgres":
+		return nil, nil
+	case BackendFilesystem:
+		return NewFilesystemStore(cfg.FilesystemRoot)
+	case BackendS3:
+		return NewS3Store(cfg.S3)
+	default:
+		return nil, fmt.Errorf("unsupported blob store backend %q", cfg.Backend)
+	}
+}
+
+// DeletePrefix removes every object whose key starts with prefix and returns number of deleted keys.
+func DeletePrefix(ctx context.Context, store Store, prefix string) (int, error) {
+	keys, err := store.List(ctx, prefix)
+	if err != nil {
+		return 0, err
+	}
+	for i, key := range keys {
+		if err := store.Delete(ctx, key); err != nil {
+			return i, err
+		}
+	}
+	return len(keys), nil
+}
+
+func normalizeKey(key string) (string, error) {
+	trimmed := strings.Trim(strings.TrimSpace(key), "/")
+	if trimmed == "" {
+		return "", fmt.Errorf("blob key is required")
+	}
+	for _, segment := range strings.Split(trimmed, "/") {
+		if segment == "" || segment == "." || segment == ".." {
+			return "", fmt.Errorf("invalid blob key %q", key)
+		}
+	}
+	return trimmed, nil
+}
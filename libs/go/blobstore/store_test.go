@@ -0,0 +1,156 @@
+package blobstore
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeS3Server is a MinIO-like in-memory stand-in serving path-style object requests for one bucket.
+type fakeS3Server struct {
+	mu      sync.Mutex
+	bucket  string
+	objects map[string][]byte
+}
+
+func (f *fakeS3Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=minio/") || r.Header.Get("X-Amz-Content-Sha256") == "" {
+		http.Error(w, "<Error><Code>AccessDenied</Code></Error>", http.StatusForbidden)
+		return
+	}
+	path := strings.TrimPrefix(r.URL.Path, "/"+f.bucket)
+	key := strings.TrimPrefix(path, "/")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	switch {
+	case r.Method == http.MethodPut:
+		body, _ := io.ReadAll(r.Body)
+		f.objects[key] = body
+	case r.Method == http.MethodGet && key == "":
+		type content struct {
+			Key string `xml:"Key"`
+		}
+		result := struct {
+			XMLName  xml.Name  `xml:"ListBucketResult"`
+			Contents []content `xml:"Contents"`
+		}{}
+		keys := make([]string, 0, len(f.objects))
+		for objectKey := range f.objects {
+			if strings.HasPrefix(objectKey, r.URL.Query().Get("prefix")) {
+				keys = append(keys, objectKey)
+			}
+		}
+		sort.Strings(keys)
+		for _, objectKey := range keys {
+			result.Contents = append(result.Contents, content{Key: objectKey})
+		}
+		_ = xml.NewEncoder(w).Encode(result)
+	case r.Method == http.MethodGet:
+		body, ok := f.objects[key]
+		if !ok {
+			http.Error(w, "<Error><Code>NoSuchKey</Code></Error>", http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(body)
+	case r.Method == http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func TestStores_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	fake := &fakeS3Server{bucket: "kodex", objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	s3Store, err := Open(Config{Backend: BackendS3, S3: S3Config{
+		Endpoint:        server.URL,
+		Bucket:          "kodex",
+		AccessKeyID:     "minio",
+		SecretAccessKey: "minio-secret",
+		PathStyle:       true,
+	}})
+	if err != nil {
+		t.Fatalf("open s3 store: %v", err)
+	}
+	fsStore, err := Open(Config{Backend: BackendFilesystem, FilesystemRoot: t.TempDir()})
+	if err != nil {
+		t.Fatalf("open filesystem store: %v", err)
+	}
+
+	for name, store := range map[string]Store{"s3": s3Store, "filesystem": fsStore} {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			for _, key := range []string{"sessions/run-1/a.gz", "sessions/run-1/b.gz", "sessions/run-2/a.gz"} {
+				if err := store.Put(ctx, key, []byte("payload:"+key)); err != nil {
+					t.Fatalf("put %s: %v", key, err)
+				}
+			}
+
+			got, err := store.Get(ctx, "sessions/run-1/b.gz")
+			if err != nil || string(got) != "payload:sessions/run-1/b.gz" {
+				t.Fatalf("get = %q, %v", got, err)
+			}
+			if _, err := store.Get(ctx, "sessions/missing.gz"); !errors.Is(err, ErrNotFound) {
+				t.Fatalf("get missing: got %v, want ErrNotFound", err)
+			}
+
+			deleted, err := DeletePrefix(ctx, store, "sessions/run-1/")
+			if err != nil || deleted != 2 {
+				t.Fatalf("delete prefix = %d, %v; want 2", deleted, err)
+			}
+			keys, err := store.List(ctx, "sessions/")
+			if err != nil {
+				t.Fatalf("list: %v", err)
+			}
+			if want := []string{"sessions/run-2/a.gz"}; !slices.Equal(keys, want) {
+				t.Fatalf("remaining keys = %#v, want %#v", keys, want)
+			}
+			if err := store.Delete(ctx, "sessions/missing.gz"); err != nil {
+				t.Fatalf("delete missing key: %v", err)
+			}
+		})
+	}
+}
+
+func TestOpen_ValidatesConfig(t *testing.T) {
+	t.Parallel()
+
+	store, err := Open(Config{})
+	if err != nil || store != nil {
+		t.Fatalf("disabled backend = %v, %v; want nil store", store, err)
+	}
+	if _, err := Open(Config{Backend: "gcs"}); err == nil {
+		t.Fatal("expected unsupported backend error")
+	}
+	if _, err := Open(Config{Backend: BackendS3, S3: S3Config{Endpoint: "http://minio:9000"}}); err == nil {
+		t.Fatal("expected missing bucket error")
+	}
+	if _, err := Open(Config{Backend: BackendFilesystem}); err == nil {
+		t.Fatal("expected missing root error")
+	}
+}
+
+func TestNormalizeKey_RejectsTraversal(t *testing.T) {
+	t.Parallel()
+
+	for _, key := range []string{"", "../etc/passwd", "a//b", "a/./b"} {
+		if _, err := normalizeKey(key); err == nil {
+			t.Fatalf("normalizeKey(%q): expected error", key)
+		}
+	}
+}
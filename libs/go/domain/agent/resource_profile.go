@@ -0,0 +1,62 @@
+package agent
+
+import "strings"
+
+// DefaultResourceProfileName is used when role has no explicit profile binding.
+const DefaultResourceProfileName = "default"
+
+// ResourceProfile defines compute resources and scheduling constraints for run pods
+// and quota sizes for full-env runtime namespaces.
+type ResourceProfile struct {
+	Requests     ResourceAmounts   `yaml:"requests,omitempty" json:"requests,omitempty"`
+	Limits       ResourceAmounts   `yaml:"limits,omitempty" json:"limits,omitempty"`
+	Quota        NamespaceQuota    `yaml:"quota,omitempty" json:"quota,omitempty"`
+	NodeSelector map[string]string `yaml:"nodeSelector,omitempty" json:"node_selector,omitempty"`
+	Tolerations  []Toleration      `yaml:"tolerations,omitempty" json:"tolerations,omitempty"`
+}
+
+// ResourceAmounts keeps Kubernetes quantity strings (for example `500m`, `2Gi`).
+type ResourceAmounts struct {
+	CPU              string `yaml:"cpu,omitempty" json:"cpu,omitempty"`
+	Memory           string `yaml:"memory,omitempty" json:"memory,omitempty"`
+	EphemeralStorage string `yaml:"ephemeralStorage,omitempty" json:"ephemeral_storage,omitempty"`
+}
+
+// NamespaceQuota defines aggregate ResourceQuota of one runtime namespace.
+type NamespaceQuota struct {
+	Pods           int64  `yaml:"pods,omitempty" json:"pods,omitempty"`
+	RequestsCPU    string `yaml:"requestsCpu,omitempty" json:"requests_cpu,omitempty"`
+	RequestsMemory string `yaml:"requestsMemory,omitempty" json:"requests_memory,omitempty"`
+	LimitsCPU      string `yaml:"limitsCpu,omitempty" json:"limits_cpu,omitempty"`
+	LimitsMemory   string `yaml:"limitsMemory,omitempty" json:"limits_memory,omitempty"`
+	// EphemeralStorage limits aggregate requests.ephemeral-storage.
+	EphemeralStorage string `yaml:"ephemeralStorage,omitempty" json:"ephemeral_storage,omitempty"`
+}
+
+// Toleration mirrors Kubernetes pod toleration fields.
+type Toleration struct {
+	Key      string `yaml:"key,omitempty" json:"key,omitempty"`
+	Operator string `yaml:"operator,omitempty" json:"operator,omitempty"`
+	Value    string `yaml:"value,omitempty" json:"value,omitempty"`
+	Effect   string `yaml:"effect,omitempty" json:"effect,omitempty"`
+}
+
+// IsZero reports whether amounts are not configured.
+func (a ResourceAmounts) IsZero() bool {
+	return strings.TrimSpace(a.CPU) == "" && strings.TrimSpace(a.Memory) == "" && strings.TrimSpace(a.EphemeralStorage) == ""
+}
+
+// HasComputeQuota reports whether quota constrains aggregate cpu/memory/storage.
+// Such quotas require every pod in namespace to declare matching requests/limits.
+func (q NamespaceQuota) HasComputeQuota() bool {
+	return strings.TrimSpace(q.RequestsCPU) != "" ||
+		strings.TrimSpace(q.RequestsMemory) != "" ||
+		strings.TrimSpace(q.LimitsCPU) != "" ||
+		strings.TrimSpace(q.LimitsMemory) != "" ||
+		strings.TrimSpace(q.EphemeralStorage) != ""
+}
+
+// NormalizeResourceProfileName lower-cases and trims profile name.
+func NormalizeResourceProfileName(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
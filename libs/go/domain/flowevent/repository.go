@@ -20,6 +20,7 @@ type ActorID string
 
 const (
 	ActorIDGitHubWebhook   ActorID = "github-webhook"
+	ActorIDAlertmanager    ActorID = "alertmanager-webhook"
 	ActorIDWorker          ActorID = "worker"
 	ActorIDControlPlane    ActorID = "control-plane"
 	ActorIDControlPlaneMCP ActorID = "control-plane-mcp"
@@ -39,6 +40,8 @@ const (
 	EventTypeRunNamespacePrepared      EventType = "run.namespace.prepared"
 	EventTypeRunNamespaceReuseFastPath EventType = "run.namespace.reuse_fast_path"
 	EventTypeRunNamespaceReuseFallback EventType = "run.namespace.reuse_fallback_redeploy"
+	EventTypeRunNamespaceWarmPoolHit   EventType = "run.namespace.warm_pool_hit"
+	EventTypeRunNamespaceWarmPoolMiss  EventType = "run.namespace.warm_pool_miss"
 	EventTypeRunNamespaceTTLScheduled  EventType = "run.namespace.ttl_scheduled"
 	EventTypeRunNamespaceTTLExtended   EventType = "run.namespace.ttl_extended"
 )
@@ -72,6 +75,7 @@ const (
 	EventTypeRunProfileResolved            EventType = "run.profile.resolved"
 	EventTypeRunPRCreated                  EventType = "run.pr.created"
 	EventTypeRunPRUpdated                  EventType = "run.pr.updated"
+	EventTypeRunPRClosed                   EventType = "run.pr.closed"
 	EventTypeRunRevisePRNotFound           EventType = "run.revise.pr_not_found"
 	EventTypeRunJobImageResolved           EventType = "run.job.image.resolved"
 	EventTypeRunSelfImproveDiagnosisReady  EventType = "run.self_improve.diagnosis_ready"
@@ -101,6 +105,12 @@ const (
 	EventTypeApprovalExpired        EventType = "approval.expired"
 	EventTypeApprovalFailed         EventType = "approval.failed"
 	EventTypeApprovalApplied        EventType = "approval.applied"
+	EventTypeApprovalVoteRecorded   EventType = "approval.vote_recorded"
+)
+
+const (
+	EventTypeNextStepActionExecuted  EventType = "next_step.action.executed"
+	EventTypeStageAutoAdvanceSkipped EventType = "stage.auto_advance.skipped"
 )
 
 const (
@@ -123,6 +133,8 @@ const (
 	EventTypeQualityGovernancePackageUpserted     EventType = "quality_governance.package.upserted"
 	EventTypeQualityGovernanceWaveMapPublished    EventType = "quality_governance.wave_map.published"
 	EventTypeQualityGovernanceProjectionRefreshed EventType = "quality_governance.projection.refreshed"
+	EventTypeQualityGovernanceDecisionRecorded    EventType = "quality_governance.decision.recorded"
+	EventTypeQualityGovernanceFeedbackOpened      EventType = "quality_governance.feedback.opened"
 )
 
 // InsertParams defines a single flow event record.
@@ -0,0 +1,115 @@
+package run
+
+import (
+	"strconv"
+	"strings"
+)
+
+// PriorityClass is a named run queue priority band.
+type PriorityClass string
+
+const (
+	PriorityClassLow    PriorityClass = "low"
+	PriorityClassNormal PriorityClass = "normal"
+	PriorityClassHigh   PriorityClass = "high"
+	PriorityClassUrgent PriorityClass = "urgent"
+)
+
+// Priority values stored in agent_runs.priority; higher runs are claimed first.
+const (
+	PriorityLow    = 25
+	PriorityNormal = 50
+	PriorityHigh   = 75
+	PriorityUrgent = 100
+
+	// PriorityMin and PriorityMax bound numeric label priority values.
+	PriorityMin = 1
+	PriorityMax = 100
+)
+
+// PriorityLabelPrefix marks issue/PR labels that override run priority, e.g. `priority:high` or `priority:90`.
+const PriorityLabelPrefix = "priority:"
+
+// Priority sources reported with resolved priority.
+const (
+	PrioritySourceLabel       = "label"
+	PrioritySourceTriggerKind = "trigger_kind"
+	PrioritySourceDefault     = "default"
+)
+
+var priorityClassValues = map[PriorityClass]int{
+	PriorityClassLow:    PriorityLow,
+	PriorityClassNormal: PriorityNormal,
+	PriorityClassHigh:   PriorityHigh,
+	PriorityClassUrgent: PriorityUrgent,
+}
+
+// triggerKindPriorities keeps default priority by normalized trigger kind; unlisted kinds are normal.
+var triggerKindPriorities = map[string]int{
+	"ops":                 PriorityHigh,
+	"ops_revise":          PriorityHigh,
+	"ai_repair":           PriorityHigh,
+	"postdeploy":          PriorityHigh,
+	"postdeploy_revise":   PriorityHigh,
+	"release":             PriorityHigh,
+	"release_revise":      PriorityHigh,
+	"intake":              PriorityLow,
+	"intake_revise":       PriorityLow,
+	"self_improve":        PriorityLow,
+	"self_improve_revise": PriorityLow,
+}
+
+// PriorityInput carries run attributes used to derive queue priority.
+type PriorityInput struct {
+	// Labels are issue/PR labels of the trigger source.
+	Labels []string
+	// TriggerKind is normalized run trigger kind.
+	TriggerKind string
+}
+
+// ResolvePriority returns effective run priority and its source.
+// Resolution order: `priority:*` label (highest wins), trigger kind default, normal.
+func ResolvePriority(input PriorityInput) (int, string) {
+	best := 0
+	for _, label := range input.Labels {
+		if value, ok := ParsePriorityLabel(label); ok && value > best {
+			best = value
+		}
+	}
+	if best > 0 {
+		return best, PrioritySourceLabel
+	}
+
+	if value, ok := triggerKindPriorities[strings.ToLower(strings.TrimSpace(input.TriggerKind))]; ok {
+		return value, PrioritySourceTriggerKind
+	}
+	return PriorityNormal, PrioritySourceDefault
+}
+
+// ParsePriorityLabel parses `priority:<class>` or `priority:<1..100>` labels.
+func ParsePriorityLabel(label string) (int, bool) {
+	normalized := strings.ToLower(strings.TrimSpace(label))
+	if !strings.HasPrefix(normalized, PriorityLabelPrefix) {
+		return 0, false
+	}
+	raw := strings.TrimSpace(strings.TrimPrefix(normalized, PriorityLabelPrefix))
+	if value, ok := priorityClassValues[PriorityClass(raw)]; ok {
+		return value, true
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil || value <= 0 {
+		return 0, false
+	}
+	return ClampPriority(value), true
+}
+
+// ClampPriority bounds value to [PriorityMin, PriorityMax].
+func ClampPriority(value int) int {
+	if value < PriorityMin {
+		return PriorityMin
+	}
+	if value > PriorityMax {
+		return PriorityMax
+	}
+	return value
+}
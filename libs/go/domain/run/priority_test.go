@@ -0,0 +1,26 @@
+package run
+
+import "testing"
+
+func TestResolvePriority(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		input      PriorityInput
+		wantValue  int
+		wantSource string
+	}{
+		{name: "highest label wins", input: PriorityInput{Labels: []string{"run:dev", "priority:low", "Priority:Urgent"}, TriggerKind: "intake"}, wantValue: PriorityUrgent, wantSource: PrioritySourceLabel},
+		{name: "numeric label", input: PriorityInput{Labels: []string{"priority:90"}}, wantValue: 90, wantSource: PrioritySourceLabel},
+		{name: "invalid label ignored", input: PriorityInput{Labels: []string{"priority:asap"}, TriggerKind: "ops"}, wantValue: PriorityHigh, wantSource: PrioritySourceTriggerKind},
+		{name: "trigger kind default", input: PriorityInput{TriggerKind: "intake"}, wantValue: PriorityLow, wantSource: PrioritySourceTriggerKind},
+		{name: "fallback normal", input: PriorityInput{TriggerKind: "dev"}, wantValue: PriorityNormal, wantSource: PrioritySourceDefault},
+	}
+	for _, tc := range cases {
+		value, source := ResolvePriority(tc.input)
+		if value != tc.wantValue || source != tc.wantSource {
+			t.Fatalf("%s: got %d/%s want %d/%s", tc.name, value, source, tc.wantValue, tc.wantSource)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package run
+
+import (
+	"strings"
+	"time"
+)
+
+// FailureClass groups run failures by who is expected to act on them.
+type FailureClass string
+
+const (
+	// FailureClassInfrastructure marks transient platform failures (image pull, eviction, runtime deploy timeouts, control-plane outages).
+	FailureClassInfrastructure FailureClass = "infrastructure"
+	// FailureClassAgent marks failures produced by agent workload itself (non-zero exit, OOM, run timeout).
+	FailureClassAgent FailureClass = "agent"
+	// FailureClassPrecondition marks failures caused by invalid run input or configuration.
+	FailureClassPrecondition FailureClass = "precondition"
+)
+
+// RetryPolicy controls automatic re-queue of infrastructure-caused run failures.
+type RetryPolicy struct {
+	// MaxAttempts is the total attempts budget including the first run; values <= 1 disable retries.
+	MaxAttempts int
+	// InitialBackoff is a delay before the second attempt; it doubles for each next attempt.
+	InitialBackoff time.Duration
+	// MaxBackoff caps exponential backoff; zero means uncapped.
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy is applied when services.yaml does not configure run retries.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    2,
+	InitialBackoff: time.Minute,
+	MaxBackoff:     15 * time.Minute,
+}
+
+// AllowsRetry reports whether one more attempt is allowed after failedAttempt (1-based).
+func (p RetryPolicy) AllowsRetry(failedAttempt int) bool {
+	if failedAttempt < 1 {
+		failedAttempt = 1
+	}
+	return failedAttempt < p.MaxAttempts
+}
+
+// Backoff returns delay before the attempt that follows failedAttempt (1-based).
+func (p RetryPolicy) Backoff(failedAttempt int) time.Duration {
+	if p.InitialBackoff <= 0 {
+		return 0
+	}
+	if failedAttempt < 1 {
+		failedAttempt = 1
+	}
+	delay := p.InitialBackoff
+	for i := 1; i < failedAttempt; i++ {
+		delay *= 2
+		if p.MaxBackoff > 0 && delay >= p.MaxBackoff {
+			return p.MaxBackoff
+		}
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return delay
+}
+
+// ResolveRetryPolicy returns trigger-specific policy or fallback.
+func ResolveRetryPolicy(fallback RetryPolicy, byTriggerKind map[string]RetryPolicy, triggerKind string) RetryPolicy {
+	if policy, ok := byTriggerKind[strings.ToLower(strings.TrimSpace(triggerKind))]; ok {
+		return policy
+	}
+	return fallback
+}
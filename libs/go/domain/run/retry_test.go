@@ -0,0 +1,46 @@
+package run
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{MaxAttempts: 4, InitialBackoff: time.Minute, MaxBackoff: 3 * time.Minute}
+	want := []time.Duration{time.Minute, 2 * time.Minute, 3 * time.Minute, 3 * time.Minute}
+	for i, expected := range want {
+		if got := policy.Backoff(i + 1); got != expected {
+			t.Fatalf("backoff after attempt %d: got %s want %s", i+1, got, expected)
+		}
+	}
+}
+
+func TestRetryPolicyAllowsRetry(t *testing.T) {
+	t.Parallel()
+
+	policy := RetryPolicy{MaxAttempts: 3}
+	if !policy.AllowsRetry(1) || !policy.AllowsRetry(2) {
+		t.Fatal("expected retries for attempts 1 and 2")
+	}
+	if policy.AllowsRetry(3) {
+		t.Fatal("expected retries to be exhausted after attempt 3")
+	}
+	if (RetryPolicy{}).AllowsRetry(1) {
+		t.Fatal("zero policy must disable retries")
+	}
+}
+
+func TestResolveRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	fallback := RetryPolicy{MaxAttempts: 2}
+	byKind := map[string]RetryPolicy{"ops": {MaxAttempts: 4}}
+	if got := ResolveRetryPolicy(fallback, byKind, " OPS "); got.MaxAttempts != 4 {
+		t.Fatalf("expected ops policy, got %+v", got)
+	}
+	if got := ResolveRetryPolicy(fallback, byKind, "dev"); got.MaxAttempts != 2 {
+		t.Fatalf("expected fallback policy, got %+v", got)
+	}
+}
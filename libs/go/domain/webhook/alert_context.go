@@ -0,0 +1,21 @@
+package webhook
+
+// TriggerSourceAlertmanager marks runs started from Alertmanager incident ingestion.
+const TriggerSourceAlertmanager = "alertmanager"
+
+// AlertContextMaxBytes bounds alert context passed into run pod environment.
+const AlertContextMaxBytes = 16 * 1024
+
+// AlertContext is an incident snapshot attached to runs started by Alertmanager ingestion.
+type AlertContext struct {
+	IncidentID   string            `json:"incident_id"`
+	Fingerprint  string            `json:"fingerprint"`
+	AlertName    string            `json:"alert_name"`
+	Severity     string            `json:"severity,omitempty"`
+	Status       string            `json:"status"`
+	StartsAt     string            `json:"starts_at,omitempty"`
+	Occurrences  int               `json:"occurrences"`
+	GeneratorURL string            `json:"generator_url,omitempty"`
+	Labels       map[string]string `json:"labels,omitempty"`
+	Annotations  map[string]string `json:"annotations,omitempty"`
+}
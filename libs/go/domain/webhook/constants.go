@@ -24,12 +24,15 @@ const (
 type GitHubAction string
 
 const (
-	GitHubActionLabeled   GitHubAction = "labeled"
-	GitHubActionUnlabeled GitHubAction = "unlabeled"
-	GitHubActionCreated   GitHubAction = "created"
-	GitHubActionSubmitted GitHubAction = "submitted"
-	GitHubActionClosed    GitHubAction = "closed"
-	GitHubActionDeleted   GitHubAction = "deleted"
+	GitHubActionLabeled     GitHubAction = "labeled"
+	GitHubActionUnlabeled   GitHubAction = "unlabeled"
+	GitHubActionCreated     GitHubAction = "created"
+	GitHubActionSubmitted   GitHubAction = "submitted"
+	GitHubActionClosed      GitHubAction = "closed"
+	GitHubActionDeleted     GitHubAction = "deleted"
+	GitHubActionOpened      GitHubAction = "opened"
+	GitHubActionReopened    GitHubAction = "reopened"
+	GitHubActionSynchronize GitHubAction = "synchronize"
 )
 
 // TriggerKind is an issue-label trigger flavor that maps to run behavior.
@@ -0,0 +1,104 @@
+package joblauncher
+
+import (
+	"context"
+	"fmt"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JobFailureCause is a normalized reason why a run workload failed.
+type JobFailureCause string
+
+const (
+	// JobFailureCauseImagePull means run image could not be pulled.
+	JobFailureCauseImagePull JobFailureCause = "image_pull"
+	// JobFailureCauseContainerConfig means kubelet could not create run container (missing config/secret refs).
+	JobFailureCauseContainerConfig JobFailureCause = "container_config"
+	// JobFailureCauseEvicted means pod was evicted by kubelet (node pressure).
+	JobFailureCauseEvicted JobFailureCause = "evicted"
+	// JobFailureCauseDisrupted means pod was terminated by disruption (preemption, node drain/shutdown).
+	JobFailureCauseDisrupted JobFailureCause = "disrupted"
+	// JobFailureCauseDeadlineExceeded means Job activeDeadlineSeconds elapsed.
+	JobFailureCauseDeadlineExceeded JobFailureCause = "deadline_exceeded"
+	// JobFailureCauseOOMKilled means run container exceeded memory limit.
+	JobFailureCauseOOMKilled JobFailureCause = "oom_killed"
+	// JobFailureCauseExitCode means run container exited with non-zero code.
+	JobFailureCauseExitCode JobFailureCause = "exit_code"
+	// JobFailureCauseUnknown means failure cause could not be derived from workload state.
+	JobFailureCauseUnknown JobFailureCause = "unknown"
+)
+
+// FailureCause inspects failed run workload (Job pods or standalone Pod) and returns normalized failure cause.
+func (l *Launcher) FailureCause(ctx context.Context, ref JobRef) (JobFailureCause, error) {
+	job, err := l.client.BatchV1().Jobs(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return JobFailureCauseUnknown, fmt.Errorf("get kubernetes job %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		pod, podErr := l.client.CoreV1().Pods(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if podErr != nil {
+			if apierrors.IsNotFound(podErr) {
+				return JobFailureCauseUnknown, nil
+			}
+			return JobFailureCauseUnknown, fmt.Errorf("get kubernetes pod %s/%s: %w", ref.Namespace, ref.Name, podErr)
+		}
+		return failureCauseFromPods([]corev1.Pod{*pod}), nil
+	}
+
+	for _, condition := range job.Status.Conditions {
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue && condition.Reason == batchv1.JobReasonDeadlineExceeded {
+			return JobFailureCauseDeadlineExceeded, nil
+		}
+	}
+
+	pods, err := l.client.CoreV1().Pods(ref.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("job-name=%s", ref.Name),
+	})
+	if err != nil {
+		return JobFailureCauseUnknown, fmt.Errorf("list pods for kubernetes job %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	return failureCauseFromPods(pods.Items), nil
+}
+
+func failureCauseFromPods(pods []corev1.Pod) JobFailureCause {
+	cause := JobFailureCauseUnknown
+	for _, pod := range pods {
+		if pod.Status.Reason == "Evicted" {
+			return JobFailureCauseEvicted
+		}
+		for _, condition := range pod.Status.Conditions {
+			if condition.Type == corev1.DisruptionTarget && condition.Status == corev1.ConditionTrue {
+				return JobFailureCauseDisrupted
+			}
+		}
+
+		statuses := append(append([]corev1.ContainerStatus(nil), pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		for _, cs := range statuses {
+			if cs.State.Waiting != nil {
+				switch cs.State.Waiting.Reason {
+				case "ErrImagePull", "ImagePullBackOff", "InvalidImageName":
+					return JobFailureCauseImagePull
+				case "CreateContainerConfigError", "CreateContainerError", "RunContainerError":
+					return JobFailureCauseContainerConfig
+				}
+			}
+			terminated := cs.State.Terminated
+			if terminated == nil {
+				terminated = cs.LastTerminationState.Terminated
+			}
+			if terminated == nil || terminated.ExitCode == 0 {
+				continue
+			}
+			if terminated.Reason == "OOMKilled" {
+				cause = JobFailureCauseOOMKilled
+			} else if cause == JobFailureCauseUnknown {
+				cause = JobFailureCauseExitCode
+			}
+		}
+	}
+	return cause
+}
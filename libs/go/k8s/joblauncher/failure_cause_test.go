@@ -0,0 +1,88 @@
+package joblauncher
+
+import (
+	"context"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLauncher_FailureCause(t *testing.T) {
+	t.Parallel()
+
+	jobPod := func(status corev1.PodStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "pod-1", Namespace: "ns", Labels: map[string]string{"job-name": "job1"}},
+			Status:     status,
+		}
+	}
+	cases := []struct {
+		name string
+		job  batchv1.JobStatus
+		pod  *corev1.Pod
+		want JobFailureCause
+	}{
+		{
+			name: "image pull",
+			pod: jobPod(corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "run",
+				State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff"}},
+			}}}),
+			want: JobFailureCauseImagePull,
+		},
+		{
+			name: "evicted",
+			pod:  jobPod(corev1.PodStatus{Phase: corev1.PodFailed, Reason: "Evicted"}),
+			want: JobFailureCauseEvicted,
+		},
+		{
+			name: "disrupted",
+			pod: jobPod(corev1.PodStatus{Phase: corev1.PodFailed, Conditions: []corev1.PodCondition{{
+				Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue,
+			}}}),
+			want: JobFailureCauseDisrupted,
+		},
+		{
+			name: "deadline",
+			job: batchv1.JobStatus{Conditions: []batchv1.JobCondition{{
+				Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: batchv1.JobReasonDeadlineExceeded,
+			}}},
+			want: JobFailureCauseDeadlineExceeded,
+		},
+		{
+			name: "exit code",
+			pod: jobPod(corev1.PodStatus{Phase: corev1.PodFailed, ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "run",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 2}},
+			}}}),
+			want: JobFailureCauseExitCode,
+		},
+		{
+			name: "oom",
+			pod: jobPod(corev1.PodStatus{Phase: corev1.PodFailed, ContainerStatuses: []corev1.ContainerStatus{{
+				Name:  "run",
+				State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 137, Reason: "OOMKilled"}},
+			}}}),
+			want: JobFailureCauseOOMKilled,
+		},
+	}
+
+	for _, tc := range cases {
+		objects := []runtime.Object{&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "job1", Namespace: "ns"}, Status: tc.job}}
+		if tc.pod != nil {
+			objects = append(objects, tc.pod)
+		}
+		l := NewForClient(Config{Namespace: "ns"}, fake.NewClientset(objects...))
+		got, err := l.FailureCause(context.Background(), JobRef{Namespace: "ns", Name: "job1"})
+		if err != nil {
+			t.Fatalf("%s: FailureCause returned error: %v", tc.name, err)
+		}
+		if got != tc.want {
+			t.Fatalf("%s: expected %q, got %q", tc.name, tc.want, got)
+		}
+	}
+}
@@ -97,6 +97,8 @@ type JobSpec struct {
 	TriggerLabel string
 	// DiscussionMode enables comment-only discussion flow without PR/push.
 	DiscussionMode bool
+	// AlertContext is compact Alertmanager incident JSON for alert-triggered ops runs.
+	AlertContext string
 	// TargetBranch overrides deterministic branch naming when already known.
 	TargetBranch string
 	// ExistingPRNumber preloads PR reference for revise flows when already known.
@@ -131,6 +133,8 @@ type JobSpec struct {
 	GitBotMail string
 	// ServiceAccountName overrides pod service account for this run workload.
 	ServiceAccountName string
+	// ResourceProfile sets run container requests/limits and pod scheduling constraints.
+	ResourceProfile agentdomain.ResourceProfile
 }
 
 // NamespaceSpec defines runtime namespace metadata.
@@ -155,6 +159,10 @@ type NamespaceSpec struct {
 	LeaseTTL time.Duration
 	// LeaseExpiresAt pins effective lease expiration timestamp when already resolved by caller.
 	LeaseExpiresAt time.Time
+	// ResourceProfile sizes full-env namespace quota and container defaults.
+	ResourceProfile agentdomain.ResourceProfile
+	// WarmPool marks namespace as pre-provisioned warm pool member not owned by any agent run yet.
+	WarmPool bool
 }
 
 // NamespaceEnsureResult reports whether namespace was newly created or reused.
@@ -266,7 +274,7 @@ type Config struct {
 	RunResourceQuotaName string
 	// RunLimitRangeName defines limit range object name in runtime namespaces.
 	RunLimitRangeName string
-	// RunCredentialsSecretName defines secret object with run credentials in runtime namespaces.
+	// RunCredentialsSecretName defines name prefix of per-run credentials Secret created next to run workload.
 	RunCredentialsSecretName string
 	// RunResourceQuotaPods defines max pod count in runtime namespace.
 	RunResourceQuotaPods int64
@@ -397,14 +405,19 @@ func (l *Launcher) Launch(ctx context.Context, spec JobSpec) (JobRef, error) {
 		jobImage = l.cfg.Image
 	}
 
+	credentialsSecretName, err := l.ensureRunCredentialsSecret(ctx, ref, spec)
+	if err != nil {
+		return JobRef{}, err
+	}
+
 	if isAIRepairTriggerKind(spec.TriggerKind) {
-		return l.launchAIRepairPod(ctx, ref, spec, jobImage)
+		return l.launchAIRepairPod(ctx, ref, spec, jobImage, credentialsSecretName)
 	}
 	if spec.DiscussionMode {
-		return l.launchRunPod(ctx, ref, spec, jobImage, discussionComponentLabel, false)
+		return l.launchRunPod(ctx, ref, spec, jobImage, credentialsSecretName, discussionComponentLabel, false)
 	}
 
-	container := buildRunContainer(spec, jobImage, l.cfg.Command)
+	container := buildRunContainer(spec, jobImage, l.cfg.Command, credentialsSecretName)
 	if shouldMountRepoCache(spec) {
 		container = withRepoCacheVolumeMount(container)
 	}
@@ -424,6 +437,9 @@ func (l *Launcher) Launch(ctx context.Context, spec JobSpec) (JobRef, error) {
 	if serviceAccountName != "" {
 		podSpec.ServiceAccountName = serviceAccountName
 	}
+	if err := applyRunResourceProfile(&podSpec, spec.ResourceProfile); err != nil {
+		return JobRef{}, err
+	}
 
 	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
@@ -455,23 +471,30 @@ func (l *Launcher) Launch(ctx context.Context, spec JobSpec) (JobRef, error) {
 		},
 	}
 
-	_, err := l.client.BatchV1().Jobs(ref.Namespace).Create(ctx, job, metav1.CreateOptions{})
+	created, err := l.client.BatchV1().Jobs(ref.Namespace).Create(ctx, job, metav1.CreateOptions{})
 	if err != nil {
-		if apierrors.IsAlreadyExists(err) {
-			return ref, nil
+		if !apierrors.IsAlreadyExists(err) {
+			return JobRef{}, fmt.Errorf("create kubernetes job %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		created, err = l.client.BatchV1().Jobs(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return JobRef{}, fmt.Errorf("get kubernetes job %s/%s: %w", ref.Namespace, ref.Name, err)
 		}
-		return JobRef{}, fmt.Errorf("create kubernetes job %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	owner := *metav1.NewControllerRef(created, batchv1.SchemeGroupVersion.WithKind("Job"))
+	if err := l.bindRunCredentialsSecretOwner(ctx, ref.Namespace, credentialsSecretName, owner); err != nil {
+		return JobRef{}, err
 	}
 
 	return ref, nil
 }
 
-func (l *Launcher) launchAIRepairPod(ctx context.Context, ref JobRef, spec JobSpec, jobImage string) (JobRef, error) {
-	return l.launchRunPod(ctx, ref, spec, jobImage, aiRepairComponentLabelVal, true)
+func (l *Launcher) launchAIRepairPod(ctx context.Context, ref JobRef, spec JobSpec, jobImage string, credentialsSecretName string) (JobRef, error) {
+	return l.launchRunPod(ctx, ref, spec, jobImage, credentialsSecretName, aiRepairComponentLabelVal, true)
 }
 
-func (l *Launcher) launchRunPod(ctx context.Context, ref JobRef, spec JobSpec, jobImage string, componentLabel string, withKeepalive bool) (JobRef, error) {
-	runContainer := buildRunContainer(spec, jobImage, l.cfg.Command)
+func (l *Launcher) launchRunPod(ctx context.Context, ref JobRef, spec JobSpec, jobImage string, credentialsSecretName string, componentLabel string, withKeepalive bool) (JobRef, error) {
+	runContainer := buildRunContainer(spec, jobImage, l.cfg.Command, credentialsSecretName)
 	containers := []corev1.Container{runContainer}
 	if shouldMountRepoCache(spec) {
 		runContainer = withRepoCacheVolumeMount(runContainer)
@@ -500,6 +523,9 @@ func (l *Launcher) launchRunPod(ctx context.Context, ref JobRef, spec JobSpec, j
 	if serviceAccountName != "" {
 		podSpec.ServiceAccountName = serviceAccountName
 	}
+	if err := applyRunResourceProfile(&podSpec, spec.ResourceProfile); err != nil {
+		return JobRef{}, err
+	}
 
 	pod := &corev1.Pod{
 		ObjectMeta: metav1.ObjectMeta{
@@ -519,27 +545,36 @@ func (l *Launcher) launchRunPod(ctx context.Context, ref JobRef, spec JobSpec, j
 		Spec: podSpec,
 	}
 
-	if _, err := l.client.CoreV1().Pods(ref.Namespace).Create(ctx, pod, metav1.CreateOptions{}); err != nil {
-		if apierrors.IsAlreadyExists(err) {
-			return ref, nil
+	created, err := l.client.CoreV1().Pods(ref.Namespace).Create(ctx, pod, metav1.CreateOptions{})
+	if err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return JobRef{}, fmt.Errorf("create kubernetes pod %s/%s: %w", ref.Namespace, ref.Name, err)
+		}
+		created, err = l.client.CoreV1().Pods(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+		if err != nil {
+			return JobRef{}, fmt.Errorf("get kubernetes pod %s/%s: %w", ref.Namespace, ref.Name, err)
 		}
-		return JobRef{}, fmt.Errorf("create kubernetes pod %s/%s: %w", ref.Namespace, ref.Name, err)
+	}
+	owner := *metav1.NewControllerRef(created, corev1.SchemeGroupVersion.WithKind("Pod"))
+	if err := l.bindRunCredentialsSecretOwner(ctx, ref.Namespace, credentialsSecretName, owner); err != nil {
+		return JobRef{}, err
 	}
 
 	return ref, nil
 }
 
-func buildRunContainer(spec JobSpec, image string, command string) corev1.Container {
+func buildRunContainer(spec JobSpec, image string, command string, credentialsSecretName string) corev1.Container {
 	return corev1.Container{
 		Name:    runContainerName,
 		Image:   image,
 		Command: []string{"/bin/sh", "-c", command},
-		Env:     buildRunContainerEnv(spec),
+		Env:     buildRunContainerEnv(spec, credentialsSecretName),
 	}
 }
 
-func buildRunContainerEnv(spec JobSpec) []corev1.EnvVar {
-	return []corev1.EnvVar{
+// buildRunContainerEnv builds run container env; credentials are referenced from per-run Secret, never inlined.
+func buildRunContainerEnv(spec JobSpec, credentialsSecretName string) []corev1.EnvVar {
+	env := []corev1.EnvVar{
 		{Name: "KODEX_RUN_ID", Value: spec.RunID},
 		{Name: "KODEX_CORRELATION_ID", Value: spec.CorrelationID},
 		{Name: "KODEX_PROJECT_ID", Value: spec.ProjectID},
@@ -550,7 +585,6 @@ func buildRunContainerEnv(spec JobSpec) []corev1.EnvVar {
 		{Name: "KODEX_RUNTIME_ACCESS_PROFILE", Value: strings.TrimSpace(string(spec.RuntimeAccessProfile))},
 		{Name: "KODEX_CONTROL_PLANE_GRPC_TARGET", Value: strings.TrimSpace(spec.ControlPlaneGRPCTarget)},
 		{Name: "KODEX_MCP_BASE_URL", Value: strings.TrimSpace(spec.MCPBaseURL)},
-		{Name: "KODEX_MCP_BEARER_TOKEN", Value: strings.TrimSpace(spec.MCPBearerToken)},
 		{Name: "KODEX_QUALITY_GOVERNANCE_ENABLED", Value: fmt.Sprintf("%t", spec.QualityGovernanceEnabled)},
 		{Name: "KODEX_REPOSITORY_FULL_NAME", Value: strings.TrimSpace(spec.RepositoryFullName)},
 		{Name: "KODEX_ISSUE_NUMBER", Value: fmt.Sprintf("%d", spec.IssueNumber)},
@@ -567,13 +601,14 @@ func buildRunContainerEnv(spec JobSpec) []corev1.EnvVar {
 		{Name: "KODEX_PROMPT_TEMPLATE_LOCALE", Value: strings.TrimSpace(spec.PromptTemplateLocale)},
 		{Name: "KODEX_STATE_IN_REVIEW_LABEL", Value: strings.TrimSpace(spec.StateInReviewLabel)},
 		{Name: "KODEX_AGENT_BASE_BRANCH", Value: strings.TrimSpace(spec.BaseBranch)},
-		{Name: "KODEX_OPENAI_API_KEY", Value: strings.TrimSpace(spec.OpenAIAPIKey)},
-		{Name: "KODEX_CONTEXT7_API_KEY", Value: strings.TrimSpace(spec.Context7APIKey)},
 		{Name: "KODEX_AGENT_DISPLAY_NAME", Value: strings.TrimSpace(spec.AgentDisplayName)},
-		{Name: "KODEX_GIT_BOT_TOKEN", Value: strings.TrimSpace(spec.GitBotToken)},
 		{Name: "KODEX_GIT_BOT_USERNAME", Value: strings.TrimSpace(spec.GitBotUsername)},
 		{Name: "KODEX_GIT_BOT_MAIL", Value: strings.TrimSpace(spec.GitBotMail)},
 	}
+	if alertContext := strings.TrimSpace(spec.AlertContext); alertContext != "" {
+		env = append(env, corev1.EnvVar{Name: "KODEX_ALERT_CONTEXT", Value: alertContext})
+	}
+	return append(env, runCredentialEnv(credentialsSecretName)...)
 }
 
 func shouldMountRepoCache(spec JobSpec) bool {
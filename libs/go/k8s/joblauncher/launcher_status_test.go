@@ -278,6 +278,72 @@ func TestLauncher_Launch_DoesNotExposeInteractionResumePayloadEnv(t *testing.T)
 	}
 }
 
+func TestLauncher_Launch_ReferencesCredentialsFromRunSecret(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fake.NewClientset()
+	l := NewForClient(Config{Namespace: "ns", Image: "busybox:1.36"}, client)
+
+	ref, err := l.Launch(ctx, JobSpec{
+		RunID:          "run-credentials",
+		ProjectID:      "project-1",
+		Namespace:      "kodex-dev-credentials",
+		RuntimeMode:    "full-env",
+		OpenAIAPIKey:   "sk-openai",
+		GitBotToken:    "ghp-token",
+		MCPBearerToken: "mcp-token",
+	})
+	if err != nil {
+		t.Fatalf("Launch returned error: %v", err)
+	}
+
+	job, err := client.BatchV1().Jobs(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected job %s/%s, got error: %v", ref.Namespace, ref.Name, err)
+	}
+	secretName := BuildRunCredentialsSecretName("", "run-credentials")
+	referenced := make(map[string]bool)
+	for _, item := range job.Spec.Template.Spec.Containers[0].Env {
+		for _, key := range runCredentialKeys {
+			if item.Name != key {
+				continue
+			}
+			if item.Value != "" {
+				t.Fatalf("credential %s must not be inlined in pod spec", key)
+			}
+			if item.ValueFrom == nil || item.ValueFrom.SecretKeyRef == nil || item.ValueFrom.SecretKeyRef.Name != secretName {
+				t.Fatalf("credential %s must reference secret %s, got %+v", key, secretName, item.ValueFrom)
+			}
+			referenced[key] = true
+		}
+	}
+	if len(referenced) != len(runCredentialKeys) {
+		t.Fatalf("expected all credentials to be referenced, got %v", referenced)
+	}
+
+	secret, err := client.CoreV1().Secrets(ref.Namespace).Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected credentials secret, got error: %v", err)
+	}
+	if got := string(secret.Data[runCredentialOpenAIAPIKey]); got != "sk-openai" {
+		t.Fatalf("unexpected openai key in secret: %q", got)
+	}
+	if _, ok := secret.Data[runCredentialContext7APIKey]; ok {
+		t.Fatal("empty credentials must not be stored")
+	}
+	if len(secret.OwnerReferences) != 1 || secret.OwnerReferences[0].Kind != "Job" || secret.OwnerReferences[0].Name != ref.Name {
+		t.Fatalf("expected secret owned by job %s, got %+v", ref.Name, secret.OwnerReferences)
+	}
+
+	if err := l.DeleteRunCredentials(ctx, ref, "run-credentials"); err != nil {
+		t.Fatalf("DeleteRunCredentials returned error: %v", err)
+	}
+	if err := l.DeleteRunCredentials(ctx, ref, "run-credentials"); err != nil {
+		t.Fatalf("DeleteRunCredentials must ignore missing secret: %v", err)
+	}
+}
+
 func TestLauncher_Status_AIRepairPodRunContainerSucceeded(t *testing.T) {
 	t.Parallel()
 
@@ -12,4 +12,6 @@ const (
 	metadataAnnotationNamespaceTTL  = "kodex.works/namespace-lease-ttl"
 	metadataAnnotationNamespaceExp  = "kodex.works/namespace-lease-expires-at"
 	metadataAnnotationNamespaceUpd  = "kodex.works/namespace-lease-updated-at"
+	metadataLabelWarmPoolState      = "kodex.works/warm-pool-state"
+	metadataAnnotationWarmBuildRef  = "kodex.works/warm-pool-build-ref"
 )
@@ -0,0 +1,161 @@
+package joblauncher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// buildResourceRequirements converts profile requests/limits into container resources.
+func buildResourceRequirements(profile agentdomain.ResourceProfile) (corev1.ResourceRequirements, error) {
+	requests, err := buildResourceList(profile.Requests, "requests")
+	if err != nil {
+		return corev1.ResourceRequirements{}, err
+	}
+	limits, err := buildResourceList(profile.Limits, "limits")
+	if err != nil {
+		return corev1.ResourceRequirements{}, err
+	}
+	return corev1.ResourceRequirements{Requests: requests, Limits: limits}, nil
+}
+
+func buildResourceList(amounts agentdomain.ResourceAmounts, field string) (corev1.ResourceList, error) {
+	return parseResourceList([]resourceQuantityField{
+		{name: corev1.ResourceCPU, value: amounts.CPU},
+		{name: corev1.ResourceMemory, value: amounts.Memory},
+		{name: corev1.ResourceEphemeralStorage, value: amounts.EphemeralStorage},
+	}, field)
+}
+
+type resourceQuantityField struct {
+	name  corev1.ResourceName
+	value string
+}
+
+func parseResourceList(values []resourceQuantityField, field string) (corev1.ResourceList, error) {
+	var out corev1.ResourceList
+	for _, item := range values {
+		raw := strings.TrimSpace(item.value)
+		if raw == "" {
+			continue
+		}
+		quantity, err := resource.ParseQuantity(raw)
+		if err != nil {
+			return nil, fmt.Errorf("resource profile %s.%s: parse quantity %q: %w", field, item.name, raw, err)
+		}
+		if out == nil {
+			out = corev1.ResourceList{}
+		}
+		out[item.name] = quantity
+	}
+	return out, nil
+}
+
+// applyRunResourceProfile sets run container resources and pod scheduling constraints from profile.
+func applyRunResourceProfile(podSpec *corev1.PodSpec, profile agentdomain.ResourceProfile) error {
+	resources, err := buildResourceRequirements(profile)
+	if err != nil {
+		return err
+	}
+	for idx := range podSpec.Containers {
+		if podSpec.Containers[idx].Name == runContainerName {
+			podSpec.Containers[idx].Resources = resources
+		}
+	}
+	if len(profile.NodeSelector) > 0 {
+		podSpec.NodeSelector = make(map[string]string, len(profile.NodeSelector))
+		for key, value := range profile.NodeSelector {
+			podSpec.NodeSelector[key] = value
+		}
+	}
+	for _, toleration := range profile.Tolerations {
+		podSpec.Tolerations = append(podSpec.Tolerations, corev1.Toleration{
+			Key:      strings.TrimSpace(toleration.Key),
+			Operator: corev1.TolerationOperator(strings.TrimSpace(toleration.Operator)),
+			Value:    strings.TrimSpace(toleration.Value),
+			Effect:   corev1.TaintEffect(strings.TrimSpace(toleration.Effect)),
+		})
+	}
+	return nil
+}
+
+// buildResourceQuotaHard returns namespace quota hard limits; pods falls back to launcher default.
+func buildResourceQuotaHard(quota agentdomain.NamespaceQuota, defaultPods int64) (corev1.ResourceList, error) {
+	hard, err := parseResourceList([]resourceQuantityField{
+		{name: corev1.ResourceRequestsCPU, value: quota.RequestsCPU},
+		{name: corev1.ResourceRequestsMemory, value: quota.RequestsMemory},
+		{name: corev1.ResourceLimitsCPU, value: quota.LimitsCPU},
+		{name: corev1.ResourceLimitsMemory, value: quota.LimitsMemory},
+		{name: corev1.ResourceRequestsEphemeralStorage, value: quota.EphemeralStorage},
+	}, "quota")
+	if err != nil {
+		return nil, err
+	}
+	if hard == nil {
+		hard = corev1.ResourceList{}
+	}
+	pods := quota.Pods
+	if pods <= 0 {
+		pods = defaultPods
+	}
+	hard[corev1.ResourcePods] = *resource.NewQuantity(pods, resource.DecimalSI)
+	return hard, nil
+}
+
+// ensureProfileLimitRange keeps per-container defaults equal to profile requests/limits.
+// Compute quota rejects pods without matching requests/limits, so deployed stack workloads
+// receive profile values unless their manifests declare their own.
+func (l *Launcher) ensureProfileLimitRange(ctx context.Context, namespace string, profile agentdomain.ResourceProfile) error {
+	name := strings.TrimSpace(l.cfg.RunLimitRangeName)
+	if name == "" {
+		return nil
+	}
+	resources, err := buildResourceRequirements(profile)
+	if err != nil {
+		return err
+	}
+	spec := corev1.LimitRangeSpec{
+		Limits: []corev1.LimitRangeItem{{
+			Type:           corev1.LimitTypeContainer,
+			Default:        resources.Limits,
+			DefaultRequest: resources.Requests,
+		}},
+	}
+
+	limitRanges := l.client.CoreV1().LimitRanges(namespace)
+	existing, err := limitRanges.Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("get limitrange %s: %w", name, err)
+		}
+		_, createErr := limitRanges.Create(ctx, &corev1.LimitRange{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+				Labels: map[string]string{
+					runNamespaceManagedByLabel: runNamespaceManagedByValue,
+				},
+			},
+			Spec: spec,
+		}, metav1.CreateOptions{})
+		if createErr != nil && !apierrors.IsAlreadyExists(createErr) {
+			return fmt.Errorf("create limitrange %s: %w", name, createErr)
+		}
+		return nil
+	}
+
+	if existing.Labels == nil {
+		existing.Labels = map[string]string{}
+	}
+	existing.Labels[runNamespaceManagedByLabel] = runNamespaceManagedByValue
+	existing.Spec = spec
+	if _, err := limitRanges.Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("update limitrange %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package joblauncher
+
+import (
+	"context"
+	"testing"
+
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLauncher_Launch_AppliesResourceProfile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fake.NewClientset()
+	l := NewForClient(Config{Namespace: "ns", Image: "busybox:1.36"}, client)
+
+	ref, err := l.Launch(ctx, JobSpec{
+		RunID:       "run-profile",
+		ProjectID:   "project-1",
+		Namespace:   "kodex-dev-profile",
+		RuntimeMode: agentdomain.RuntimeModeFullEnv,
+		ResourceProfile: agentdomain.ResourceProfile{
+			Requests:     agentdomain.ResourceAmounts{CPU: "500m", Memory: "1Gi"},
+			Limits:       agentdomain.ResourceAmounts{CPU: "2", Memory: "4Gi", EphemeralStorage: "10Gi"},
+			NodeSelector: map[string]string{"kodex.works/pool": "heavy"},
+			Tolerations:  []agentdomain.Toleration{{Key: "kodex.works/pool", Operator: "Equal", Value: "heavy", Effect: "NoSchedule"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Launch returned error: %v", err)
+	}
+
+	job, err := client.BatchV1().Jobs(ref.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected job %s/%s, got error: %v", ref.Namespace, ref.Name, err)
+	}
+	podSpec := job.Spec.Template.Spec
+	resources := podSpec.Containers[0].Resources
+	if got, want := resources.Requests.Cpu().String(), "500m"; got != want {
+		t.Fatalf("unexpected cpu request: got %q want %q", got, want)
+	}
+	if got, want := resources.Limits.Memory().String(), "4Gi"; got != want {
+		t.Fatalf("unexpected memory limit: got %q want %q", got, want)
+	}
+	if got, want := resources.Limits.StorageEphemeral().String(), "10Gi"; got != want {
+		t.Fatalf("unexpected ephemeral storage limit: got %q want %q", got, want)
+	}
+	if got, want := podSpec.NodeSelector["kodex.works/pool"], "heavy"; got != want {
+		t.Fatalf("unexpected node selector: got %q want %q", got, want)
+	}
+	if len(podSpec.Tolerations) != 1 || podSpec.Tolerations[0].Effect != corev1.TaintEffectNoSchedule {
+		t.Fatalf("unexpected tolerations: %+v", podSpec.Tolerations)
+	}
+}
+
+func TestLauncher_Launch_RejectsInvalidResourceQuantity(t *testing.T) {
+	t.Parallel()
+
+	l := NewForClient(Config{Namespace: "ns", Image: "busybox:1.36"}, fake.NewClientset())
+	_, err := l.Launch(context.Background(), JobSpec{
+		RunID:           "run-invalid-profile",
+		Namespace:       "ns",
+		ResourceProfile: agentdomain.ResourceProfile{Limits: agentdomain.ResourceAmounts{Memory: "lots"}},
+	})
+	if err == nil {
+		t.Fatal("expected invalid resource quantity to fail launch")
+	}
+}
+
+func TestLauncher_EnsureNamespace_SizesQuotaAndLimitRangeFromProfile(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fake.NewClientset()
+	launcher := NewForClient(Config{Namespace: "kodex-prod"}, client)
+
+	spec := NamespaceSpec{
+		RunID:       "run-1",
+		ProjectID:   "project-1",
+		AgentKey:    "qa",
+		RuntimeMode: agentdomain.RuntimeModeFullEnv,
+		Namespace:   "codex-issue-p1-i74-r1",
+		ResourceProfile: agentdomain.ResourceProfile{
+			Requests: agentdomain.ResourceAmounts{CPU: "250m", Memory: "512Mi"},
+			Limits:   agentdomain.ResourceAmounts{CPU: "1", Memory: "2Gi"},
+			Quota:    agentdomain.NamespaceQuota{Pods: 40, LimitsCPU: "16", LimitsMemory: "32Gi"},
+		},
+	}
+	if _, err := launcher.EnsureNamespace(ctx, spec); err != nil {
+		t.Fatalf("EnsureNamespace() error = %v", err)
+	}
+
+	quota, err := client.CoreV1().ResourceQuotas(spec.Namespace).Get(ctx, launcher.cfg.RunResourceQuotaName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("resourcequota not created: %v", err)
+	}
+	if got, want := quota.Spec.Hard.Pods().Value(), int64(40); got != want {
+		t.Fatalf("unexpected pods quota: got %d want %d", got, want)
+	}
+	limitsCPU := quota.Spec.Hard[corev1.ResourceLimitsCPU]
+	if got, want := limitsCPU.String(), "16"; got != want {
+		t.Fatalf("unexpected limits.cpu quota: got %q want %q", got, want)
+	}
+
+	limitRange, err := client.CoreV1().LimitRanges(spec.Namespace).Get(ctx, launcher.cfg.RunLimitRangeName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("limitrange must exist when profile quota constrains compute: %v", err)
+	}
+	item := limitRange.Spec.Limits[0]
+	if got, want := item.Default.Memory().String(), "2Gi"; got != want {
+		t.Fatalf("unexpected default memory limit: got %q want %q", got, want)
+	}
+	if got, want := item.DefaultRequest.Cpu().String(), "250m"; got != want {
+		t.Fatalf("unexpected default cpu request: got %q want %q", got, want)
+	}
+}
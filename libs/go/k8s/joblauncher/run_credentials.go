@@ -0,0 +1,148 @@
+package joblauncher
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	runCredentialOpenAIAPIKey   = "KODEX_OPENAI_API_KEY"
+	runCredentialContext7APIKey = "KODEX_CONTEXT7_API_KEY"
+	runCredentialGitBotToken    = "KODEX_GIT_BOT_TOKEN"
+	runCredentialMCPBearerToken = "KODEX_MCP_BEARER_TOKEN"
+)
+
+// runCredentialKeys lists env vars which are delivered to run pod via per-run Secret instead of literal values.
+var runCredentialKeys = []string{
+	runCredentialOpenAIAPIKey,
+	runCredentialContext7APIKey,
+	runCredentialGitBotToken,
+	runCredentialMCPBearerToken,
+}
+
+// BuildRunCredentialsSecretName returns deterministic per-run Secret name derived from configured prefix.
+func BuildRunCredentialsSecretName(prefix string, runID string) string {
+	prefix = strings.Trim(strings.TrimSpace(prefix), "-")
+	if prefix == "" {
+		prefix = "codex-run-credentials"
+	}
+	suffix := strings.TrimPrefix(BuildRunJobName(runID), "kodex-run")
+	suffix = strings.Trim(suffix, "-")
+	if suffix == "" {
+		return prefix
+	}
+	name := prefix + "-" + suffix
+	if len(name) > 63 {
+		name = strings.TrimRight(name[:63], "-")
+	}
+	return name
+}
+
+func runCredentialsData(spec JobSpec) map[string][]byte {
+	values := map[string]string{
+		runCredentialOpenAIAPIKey:   spec.OpenAIAPIKey,
+		runCredentialContext7APIKey: spec.Context7APIKey,
+		runCredentialGitBotToken:    spec.GitBotToken,
+		runCredentialMCPBearerToken: spec.MCPBearerToken,
+	}
+	data := make(map[string][]byte, len(values))
+	for key, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			data[key] = []byte(trimmed)
+		}
+	}
+	return data
+}
+
+func runCredentialEnv(secretName string) []corev1.EnvVar {
+	optional := true
+	out := make([]corev1.EnvVar, 0, len(runCredentialKeys))
+	for _, key := range runCredentialKeys {
+		out = append(out, corev1.EnvVar{
+			Name: key,
+			ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+					Key:                  key,
+					Optional:             &optional,
+				},
+			},
+		})
+	}
+	return out
+}
+
+// ensureRunCredentialsSecret creates or refreshes per-run credentials Secret before workload creation.
+func (l *Launcher) ensureRunCredentialsSecret(ctx context.Context, ref JobRef, spec JobSpec) (string, error) {
+	name := BuildRunCredentialsSecretName(l.cfg.RunCredentialsSecretName, spec.RunID)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ref.Namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       runWorkloadAppName,
+				"app.kubernetes.io/managed-by": "kodex-worker",
+				metadataLabelRunID:             spec.RunID,
+				metadataLabelProjectID:         sanitizeLabel(spec.ProjectID),
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: runCredentialsData(spec),
+	}
+
+	secrets := l.client.CoreV1().Secrets(ref.Namespace)
+	if _, err := secrets.Create(ctx, secret, metav1.CreateOptions{}); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return "", fmt.Errorf("create run credentials secret %s/%s: %w", ref.Namespace, name, err)
+		}
+		existing, getErr := secrets.Get(ctx, name, metav1.GetOptions{})
+		if getErr != nil {
+			return "", fmt.Errorf("get run credentials secret %s/%s: %w", ref.Namespace, name, getErr)
+		}
+		existing.Labels = secret.Labels
+		existing.Type = secret.Type
+		existing.Data = secret.Data
+		if _, err := secrets.Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+			return "", fmt.Errorf("update run credentials secret %s/%s: %w", ref.Namespace, name, err)
+		}
+	}
+	return name, nil
+}
+
+// bindRunCredentialsSecretOwner makes run workload the owner of credentials Secret,
+// so Kubernetes garbage collection removes it together with Job/Pod.
+func (l *Launcher) bindRunCredentialsSecretOwner(ctx context.Context, namespace string, secretName string, owner metav1.OwnerReference) error {
+	secrets := l.client.CoreV1().Secrets(namespace)
+	secret, err := secrets.Get(ctx, secretName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get run credentials secret %s/%s: %w", namespace, secretName, err)
+	}
+	for _, existing := range secret.OwnerReferences {
+		if existing.UID == owner.UID {
+			return nil
+		}
+	}
+	secret.OwnerReferences = append(secret.OwnerReferences, owner)
+	if _, err := secrets.Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("bind run credentials secret %s/%s owner: %w", namespace, secretName, err)
+	}
+	return nil
+}
+
+// DeleteRunCredentials removes per-run credentials Secret once run reached terminal state.
+func (l *Launcher) DeleteRunCredentials(ctx context.Context, ref JobRef, runID string) error {
+	namespace := strings.TrimSpace(ref.Namespace)
+	if namespace == "" {
+		namespace = l.cfg.Namespace
+	}
+	name := BuildRunCredentialsSecretName(l.cfg.RunCredentialsSecretName, runID)
+	if err := l.client.CoreV1().Secrets(namespace).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("delete run credentials secret %s/%s: %w", namespace, name, err)
+	}
+	return nil
+}
@@ -15,7 +15,6 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -60,10 +59,10 @@ func (l *Launcher) EnsureNamespace(ctx context.Context, spec NamespaceSpec) (Nam
 	if _, err := l.EnsureAccessProfile(ctx, namespace, spec.AccessProfile); err != nil {
 		return NamespaceEnsureResult{}, fmt.Errorf("ensure access profile in namespace %s: %w", namespace, err)
 	}
-	if err := l.ensureResourceQuota(ctx, namespace); err != nil {
+	if err := l.ensureResourceQuota(ctx, namespace, spec.ResourceProfile.Quota); err != nil {
 		return NamespaceEnsureResult{}, fmt.Errorf("ensure resource quota in namespace %s: %w", namespace, err)
 	}
-	if err := l.ensureLimitRange(ctx, namespace); err != nil {
+	if err := l.ensureLimitRange(ctx, namespace, spec.ResourceProfile); err != nil {
 		return NamespaceEnsureResult{}, fmt.Errorf("ensure limit range in namespace %s: %w", namespace, err)
 	}
 	return ensureResult, nil
@@ -343,34 +342,7 @@ func (l *Launcher) resolveAccessProfileSpec(profile agentdomain.RuntimeAccessPro
 // ensureNamespaceObject upserts namespace metadata required for managed runtime namespaces.
 func (l *Launcher) ensureNamespaceObject(ctx context.Context, spec NamespaceSpec) (NamespaceEnsureResult, error) {
 	namespace := strings.TrimSpace(spec.Namespace)
-	leaseExpiresAt := resolveNamespaceLeaseExpiresAt(spec)
-	leaseTTL := resolveNamespaceLeaseTTL(spec)
-	leaseUpdatedAt := time.Now().UTC()
-
-	labels := map[string]string{
-		runNamespaceManagedByLabel:   runNamespaceManagedByValue,
-		runNamespacePurposeLabel:     runNamespacePurposeValue,
-		runNamespaceRuntimeModeLabel: string(spec.RuntimeMode),
-		runNamespaceRunIDLabel:       sanitizeLabel(spec.RunID),
-		runNamespaceProjectIDLabel:   sanitizeLabel(spec.ProjectID),
-	}
-	if spec.IssueNumber > 0 {
-		labels[runNamespaceIssueNumberLabel] = strconv.FormatInt(spec.IssueNumber, 10)
-	}
-	agentKey := sanitizeLabel(spec.AgentKey)
-	if agentKey != "" && agentKey != "unknown" {
-		labels[runNamespaceAgentKeyLabel] = agentKey
-	}
-	projectLabel := sanitizeLabel(spec.ProjectID)
-	if projectLabel != "unknown" {
-		labels[runNamespaceProjectIDLabel] = projectLabel
-	}
-	annotations := map[string]string{
-		runNamespaceCorrelationAnnotKey: spec.CorrelationID,
-		runNamespaceLeaseTTLAnnotKey:    leaseTTL.String(),
-		runNamespaceLeaseExpAnnotKey:    leaseExpiresAt.Format(time.RFC3339),
-		runNamespaceLeaseUpdAnnotKey:    leaseUpdatedAt.Format(time.RFC3339),
-	}
+	labels, annotations, leaseExpiresAt := buildRunNamespaceMetadata(spec)
 
 	existing, err := l.client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
 	if err != nil {
@@ -421,6 +393,46 @@ func (l *Launcher) ensureNamespaceObject(ctx context.Context, spec NamespaceSpec
 	}, nil
 }
 
+// buildRunNamespaceMetadata returns labels/annotations written to one managed namespace and its lease expiration.
+func buildRunNamespaceMetadata(spec NamespaceSpec) (map[string]string, map[string]string, time.Time) {
+	leaseExpiresAt := resolveNamespaceLeaseExpiresAt(spec)
+	leaseTTL := resolveNamespaceLeaseTTL(spec)
+	leaseUpdatedAt := time.Now().UTC()
+
+	purpose := runNamespacePurposeValue
+	if spec.WarmPool {
+		purpose = warmPoolNamespacePurposeValue
+	}
+	labels := map[string]string{
+		runNamespaceManagedByLabel:   runNamespaceManagedByValue,
+		runNamespacePurposeLabel:     purpose,
+		runNamespaceRuntimeModeLabel: string(spec.RuntimeMode),
+		runNamespaceRunIDLabel:       sanitizeLabel(spec.RunID),
+		runNamespaceProjectIDLabel:   sanitizeLabel(spec.ProjectID),
+	}
+	if spec.WarmPool {
+		labels[warmPoolNamespaceStateLabel] = string(WarmPoolNamespaceStatePreparing)
+	}
+	if spec.IssueNumber > 0 {
+		labels[runNamespaceIssueNumberLabel] = strconv.FormatInt(spec.IssueNumber, 10)
+	}
+	agentKey := sanitizeLabel(spec.AgentKey)
+	if agentKey != "" && agentKey != "unknown" {
+		labels[runNamespaceAgentKeyLabel] = agentKey
+	}
+	projectLabel := sanitizeLabel(spec.ProjectID)
+	if projectLabel != "unknown" {
+		labels[runNamespaceProjectIDLabel] = projectLabel
+	}
+	annotations := map[string]string{
+		runNamespaceCorrelationAnnotKey: spec.CorrelationID,
+		runNamespaceLeaseTTLAnnotKey:    leaseTTL.String(),
+		runNamespaceLeaseExpAnnotKey:    leaseExpiresAt.Format(time.RFC3339),
+		runNamespaceLeaseUpdAnnotKey:    leaseUpdatedAt.Format(time.RFC3339),
+	}
+	return labels, annotations, leaseExpiresAt
+}
+
 func resolveNamespaceLeaseTTL(spec NamespaceSpec) time.Duration {
 	if spec.LeaseTTL > 0 {
 		return spec.LeaseTTL
@@ -827,9 +839,10 @@ func productionReadOnlyRoleRules() []rbacv1.PolicyRule {
 }
 
 // ensureResourceQuota limits aggregate namespace resource consumption per run namespace.
-func (l *Launcher) ensureResourceQuota(ctx context.Context, namespace string) error {
-	hard := corev1.ResourceList{
-		corev1.ResourcePods: *resource.NewQuantity(l.cfg.RunResourceQuotaPods, resource.DecimalSI),
+func (l *Launcher) ensureResourceQuota(ctx context.Context, namespace string, quota agentdomain.NamespaceQuota) error {
+	hard, err := buildResourceQuotaHard(quota, l.cfg.RunResourceQuotaPods)
+	if err != nil {
+		return err
 	}
 
 	name := l.cfg.RunResourceQuotaName
@@ -866,9 +879,13 @@ func (l *Launcher) ensureResourceQuota(ctx context.Context, namespace string) er
 	return nil
 }
 
-// ensureLimitRange removes managed per-container defaults to avoid cpu/memory constraints.
-func (l *Launcher) ensureLimitRange(ctx context.Context, namespace string) error {
-	return l.deleteLimitRangeIfExists(ctx, namespace)
+// ensureLimitRange keeps managed per-container defaults only when profile quota constrains cpu/memory;
+// otherwise it removes them to avoid cpu/memory constraints.
+func (l *Launcher) ensureLimitRange(ctx context.Context, namespace string, profile agentdomain.ResourceProfile) error {
+	if !profile.Quota.HasComputeQuota() {
+		return l.deleteLimitRangeIfExists(ctx, namespace)
+	}
+	return l.ensureProfileLimitRange(ctx, namespace, profile)
 }
 
 func (l *Launcher) deleteLimitRangeIfExists(ctx context.Context, namespace string) error {
@@ -0,0 +1,190 @@
+package joblauncher
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	warmPoolNamespacePurposeValue     = "warm-pool"
+	warmPoolNamespaceStateLabel       = metadataLabelWarmPoolState
+	warmPoolNamespaceBuildRefAnnotKey = metadataAnnotationWarmBuildRef
+)
+
+// WarmPoolNamespaceState is lifecycle state of one warm pool namespace.
+type WarmPoolNamespaceState string
+
+const (
+	// WarmPoolNamespaceStatePreparing means namespace stack deploy is still in progress.
+	WarmPoolNamespaceStatePreparing WarmPoolNamespaceState = "preparing"
+	// WarmPoolNamespaceStateReady means namespace stack is deployed and can be handed to a run.
+	WarmPoolNamespaceStateReady WarmPoolNamespaceState = "ready"
+)
+
+// WarmPoolNamespace describes one pre-provisioned namespace not owned by any agent run yet.
+type WarmPoolNamespace struct {
+	Namespace string
+	ProjectID string
+	State     WarmPoolNamespaceState
+	// DeployRunID is the deploy-only run that prepares namespace stack.
+	DeployRunID string
+	// BuildRef is the build ref namespace stack was deployed at; empty until ready.
+	BuildRef  string
+	CreatedAt time.Time
+}
+
+// ListWarmPoolNamespaces returns warm pool namespaces of one project (all projects when empty), oldest first.
+func (l *Launcher) ListWarmPoolNamespaces(ctx context.Context, projectID string) ([]WarmPoolNamespace, error) {
+	selector := fmt.Sprintf(
+		"%s=%s,%s=%s",
+		runNamespaceManagedByLabel,
+		runNamespaceManagedByValue,
+		runNamespacePurposeLabel,
+		warmPoolNamespacePurposeValue,
+	)
+	if strings.TrimSpace(projectID) != "" {
+		selector += fmt.Sprintf(",%s=%s", runNamespaceProjectIDLabel, sanitizeLabel(strings.TrimSpace(projectID)))
+	}
+	items, err := l.client.CoreV1().Namespaces().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, fmt.Errorf("list warm pool namespaces: %w", err)
+	}
+	sort.Slice(items.Items, func(i, j int) bool {
+		left, right := items.Items[i].CreationTimestamp.Time, items.Items[j].CreationTimestamp.Time
+		if left.Equal(right) {
+			return items.Items[i].Name < items.Items[j].Name
+		}
+		return left.Before(right)
+	})
+
+	out := make([]WarmPoolNamespace, 0, len(items.Items))
+	for _, item := range items.Items {
+		if item.DeletionTimestamp != nil {
+			continue
+		}
+		out = append(out, WarmPoolNamespace{
+			Namespace:   strings.TrimSpace(item.Name),
+			ProjectID:   strings.TrimSpace(item.Labels[runNamespaceProjectIDLabel]),
+			State:       WarmPoolNamespaceState(strings.TrimSpace(item.Labels[warmPoolNamespaceStateLabel])),
+			DeployRunID: strings.TrimSpace(item.Labels[runNamespaceRunIDLabel]),
+			BuildRef:    strings.TrimSpace(item.Annotations[warmPoolNamespaceBuildRefAnnotKey]),
+			CreatedAt:   item.CreationTimestamp.Time.UTC(),
+		})
+	}
+	return out, nil
+}
+
+// MarkWarmPoolNamespaceReady flips warm pool namespace to ready after its stack deploy succeeded.
+func (l *Launcher) MarkWarmPoolNamespaceReady(ctx context.Context, namespace string, buildRef string) error {
+	targetNamespace := strings.TrimSpace(namespace)
+	if targetNamespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+	ns, err := l.client.CoreV1().Namespaces().Get(ctx, targetNamespace, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("get namespace %s: %w", targetNamespace, err)
+	}
+	if !isWarmPoolNamespace(ns.Labels) {
+		return fmt.Errorf("namespace %s is not a warm pool namespace", targetNamespace)
+	}
+	ns.Labels[warmPoolNamespaceStateLabel] = string(WarmPoolNamespaceStateReady)
+	if ns.Annotations == nil {
+		ns.Annotations = map[string]string{}
+	}
+	ns.Annotations[warmPoolNamespaceBuildRefAnnotKey] = strings.TrimSpace(buildRef)
+	if _, err := l.client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("update namespace %s: %w", targetNamespace, err)
+	}
+	return nil
+}
+
+// ClaimWarmPoolNamespace hands one ready warm pool namespace of spec.ProjectID over to the run described by spec.
+// Namespace is re-labelled as a regular run namespace with a fresh lease; updates use optimistic concurrency,
+// so concurrent workers never claim the same namespace. spec.Namespace is ignored.
+func (l *Launcher) ClaimWarmPoolNamespace(ctx context.Context, spec NamespaceSpec) (string, bool, error) {
+	if strings.TrimSpace(spec.ProjectID) == "" {
+		return "", false, fmt.Errorf("project id is required")
+	}
+	candidates, err := l.ListWarmPoolNamespaces(ctx, spec.ProjectID)
+	if err != nil {
+		return "", false, err
+	}
+
+	spec.WarmPool = false
+	for _, candidate := range candidates {
+		if candidate.State != WarmPoolNamespaceStateReady {
+			continue
+		}
+		ns, err := l.client.CoreV1().Namespaces().Get(ctx, candidate.Namespace, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return "", false, fmt.Errorf("get namespace %s: %w", candidate.Namespace, err)
+		}
+		if ns.DeletionTimestamp != nil || !isWarmPoolNamespace(ns.Labels) ||
+			ns.Labels[warmPoolNamespaceStateLabel] != string(WarmPoolNamespaceStateReady) {
+			continue
+		}
+
+		spec.Namespace = candidate.Namespace
+		labels, annotations, _ := buildRunNamespaceMetadata(spec)
+		delete(ns.Labels, warmPoolNamespaceStateLabel)
+		for key, value := range labels {
+			ns.Labels[key] = value
+		}
+		if ns.Annotations == nil {
+			ns.Annotations = map[string]string{}
+		}
+		delete(ns.Annotations, warmPoolNamespaceBuildRefAnnotKey)
+		for key, value := range annotations {
+			ns.Annotations[key] = value
+		}
+		if _, err := l.client.CoreV1().Namespaces().Update(ctx, ns, metav1.UpdateOptions{}); err != nil {
+			if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+				continue
+			}
+			return "", false, fmt.Errorf("claim warm pool namespace %s: %w", candidate.Namespace, err)
+		}
+		return candidate.Namespace, true, nil
+	}
+	return "", false, nil
+}
+
+// DeleteWarmPoolNamespace removes one unclaimed warm pool namespace.
+func (l *Launcher) DeleteWarmPoolNamespace(ctx context.Context, namespace string) (bool, error) {
+	targetNamespace := strings.TrimSpace(namespace)
+	if targetNamespace == "" || targetNamespace == strings.TrimSpace(l.cfg.Namespace) {
+		return false, nil
+	}
+	ns, err := l.client.CoreV1().Namespaces().Get(ctx, targetNamespace, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("get namespace %s: %w", targetNamespace, err)
+	}
+	if !isWarmPoolNamespace(ns.Labels) {
+		return false, nil
+	}
+	if err := l.client.CoreV1().Namespaces().Delete(ctx, targetNamespace, metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{ResourceVersion: &ns.ResourceVersion},
+	}); err != nil {
+		if apierrors.IsNotFound(err) || apierrors.IsConflict(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("delete namespace %s: %w", targetNamespace, err)
+	}
+	return true, nil
+}
+
+func isWarmPoolNamespace(labels map[string]string) bool {
+	return strings.TrimSpace(labels[runNamespaceManagedByLabel]) == runNamespaceManagedByValue &&
+		strings.TrimSpace(labels[runNamespacePurposeLabel]) == warmPoolNamespacePurposeValue
+}
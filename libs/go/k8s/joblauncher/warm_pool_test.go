@@ -0,0 +1,87 @@
+package joblauncher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestLauncher_WarmPoolNamespaceLifecycle(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fake.NewClientset()
+	launcher := NewForClient(Config{Namespace: "kodex-prod"}, client)
+
+	if _, err := launcher.EnsureNamespace(ctx, NamespaceSpec{
+		RunID:       "deploy-run-1",
+		ProjectID:   "project-1",
+		RuntimeMode: agentdomain.RuntimeModeFullEnv,
+		Namespace:   "codex-issue-p1-w1",
+		WarmPool:    true,
+	}); err != nil {
+		t.Fatalf("EnsureNamespace(warm) error = %v", err)
+	}
+
+	items, err := launcher.ListWarmPoolNamespaces(ctx, "project-1")
+	if err != nil {
+		t.Fatalf("ListWarmPoolNamespaces() error = %v", err)
+	}
+	if len(items) != 1 || items[0].State != WarmPoolNamespaceStatePreparing || items[0].DeployRunID != "deploy-run-1" {
+		t.Fatalf("unexpected warm pool namespaces: %+v", items)
+	}
+	if _, found, err := launcher.FindReusableNamespace(ctx, NamespaceReuseLookup{ProjectID: "project-1", IssueNumber: 7, AgentKey: "dev"}); err != nil || found {
+		t.Fatalf("warm namespace must not be reusable by revise lookup: found=%v err=%v", found, err)
+	}
+
+	runSpec := NamespaceSpec{
+		RunID:       "run-7",
+		ProjectID:   "project-1",
+		IssueNumber: 7,
+		AgentKey:    "dev",
+		RuntimeMode: agentdomain.RuntimeModeFullEnv,
+		LeaseTTL:    2 * time.Hour,
+	}
+	if _, found, err := launcher.ClaimWarmPoolNamespace(ctx, runSpec); err != nil || found {
+		t.Fatalf("preparing namespace must not be claimed: found=%v err=%v", found, err)
+	}
+
+	if err := launcher.MarkWarmPoolNamespaceReady(ctx, "codex-issue-p1-w1", "main"); err != nil {
+		t.Fatalf("MarkWarmPoolNamespaceReady() error = %v", err)
+	}
+	namespace, found, err := launcher.ClaimWarmPoolNamespace(ctx, runSpec)
+	if err != nil || !found {
+		t.Fatalf("ClaimWarmPoolNamespace() found=%v err=%v", found, err)
+	}
+	if namespace != "codex-issue-p1-w1" {
+		t.Fatalf("claimed namespace = %q", namespace)
+	}
+
+	ns, err := client.CoreV1().Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("get claimed namespace: %v", err)
+	}
+	if got := ns.Labels[runNamespacePurposeLabel]; got != runNamespacePurposeValue {
+		t.Fatalf("purpose label = %q, want %q", got, runNamespacePurposeValue)
+	}
+	if got := ns.Labels[runNamespaceRunIDLabel]; got != "run-7" {
+		t.Fatalf("run-id label = %q, want run-7", got)
+	}
+	if _, ok := ns.Labels[warmPoolNamespaceStateLabel]; ok {
+		t.Fatal("warm pool state label must be removed on claim")
+	}
+	if got := ns.Annotations[runNamespaceLeaseTTLAnnotKey]; got != (2 * time.Hour).String() {
+		t.Fatalf("lease ttl annotation = %q", got)
+	}
+
+	if _, found, err := launcher.ClaimWarmPoolNamespace(ctx, runSpec); err != nil || found {
+		t.Fatalf("claimed namespace must not be claimed twice: found=%v err=%v", found, err)
+	}
+	if deleted, err := launcher.DeleteWarmPoolNamespace(ctx, namespace); err != nil || deleted {
+		t.Fatalf("claimed namespace must not be deleted as warm pool member: deleted=%v err=%v", deleted, err)
+	}
+}
@@ -0,0 +1,434 @@
+package manifestpolicy
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+const defaultLoadBalancerEnvironment = "production"
+
+// Violation is one policy finding on a rendered manifest object.
+type Violation struct {
+	Rule      string
+	Mode      servicescfg.ManifestPolicyMode
+	Kind      string
+	Namespace string
+	Name      string
+	Message   string
+}
+
+// Object returns short object identity used in logs.
+func (v Violation) Object() string {
+	if v.Namespace == "" {
+		return v.Kind + "/" + v.Name
+	}
+	return v.Kind + "/" + v.Namespace + "/" + v.Name
+}
+
+// String renders violation as one log line.
+func (v Violation) String() string {
+	return fmt.Sprintf("[%s] %s %s: %s", v.Mode, v.Rule, v.Object(), v.Message)
+}
+
+// Blocking reports whether violations contain at least one blocking finding.
+func Blocking(violations []Violation) bool {
+	for _, violation := range violations {
+		if violation.Mode == servicescfg.ManifestPolicyModeBlock {
+			return true
+		}
+	}
+	return false
+}
+
+// Evaluate checks every object of rendered manifest against built-in and project-defined rules
+// resolved for env. Rules in off mode are skipped.
+func Evaluate(policy servicescfg.ManifestPolicy, env string, manifest []byte) ([]Violation, error) {
+	objects, err := decodeObjects(manifest)
+	if err != nil {
+		return nil, err
+	}
+	customPatterns := make(map[string]*regexp.Regexp, len(policy.CustomRules))
+	for _, rule := range policy.CustomRules {
+		if rule.Operator != servicescfg.ManifestPolicyOperatorMatches && rule.Operator != servicescfg.ManifestPolicyOperatorNotMatches {
+			continue
+		}
+		pattern, err := regexp.Compile(rule.Value)
+		if err != nil {
+			return nil, fmt.Errorf("custom rule %q: compile regexp: %w", rule.Name, err)
+		}
+		customPatterns[rule.Name] = pattern
+	}
+
+	var out []Violation
+	for _, object := range objects {
+		target := newTarget(object)
+		for _, ruleName := range servicescfg.BuiltinManifestPolicyRules {
+			mode := policy.ResolveMode(ruleName, env)
+			if mode == servicescfg.ManifestPolicyModeOff {
+				continue
+			}
+			for _, message := range evaluateBuiltin(ruleName, policy, env, target) {
+				out = append(out, target.violation(ruleName, mode, message))
+			}
+		}
+		for _, rule := range policy.CustomRules {
+			mode := policy.ResolveMode(rule.Name, env)
+			if mode == servicescfg.ManifestPolicyModeOff {
+				continue
+			}
+			if message := evaluateCustom(rule, customPatterns[rule.Name], target); message != "" {
+				out = append(out, target.violation(rule.Name, mode, message))
+			}
+		}
+	}
+	return out, nil
+}
+
+type target struct {
+	kind      string
+	namespace string
+	name      string
+	object    map[string]any
+}
+
+func newTarget(object map[string]any) target {
+	metadata, _ := object["metadata"].(map[string]any)
+	return target{
+		kind:      stringValue(object["kind"]),
+		namespace: stringValue(metadata["namespace"]),
+		name:      stringValue(metadata["name"]),
+		object:    object,
+	}
+}
+
+func (t target) violation(rule string, mode servicescfg.ManifestPolicyMode, message string) Violation {
+	return Violation{
+		Rule:      rule,
+		Mode:      mode,
+		Kind:      t.kind,
+		Namespace: t.namespace,
+		Name:      t.name,
+		Message:   message,
+	}
+}
+
+func evaluateBuiltin(ruleName string, policy servicescfg.ManifestPolicy, env string, t target) []string {
+	switch ruleName {
+	case servicescfg.ManifestPolicyRuleResourceLimits:
+		return checkResourceLimits(t)
+	case servicescfg.ManifestPolicyRuleNoPrivileged:
+		return checkPrivileged(t)
+	case servicescfg.ManifestPolicyRuleNoHostPath:
+		return checkHostPath(t)
+	case servicescfg.ManifestPolicyRuleAllowedRegistries:
+		return checkAllowedRegistries(t, policy.AllowedRegistries)
+	case servicescfg.ManifestPolicyRuleRequiredLabels:
+		return checkRequiredLabels(t, policy.RequiredLabels)
+	case servicescfg.ManifestPolicyRuleNoLoadBalancer:
+		return checkLoadBalancer(t, policy.LoadBalancerEnvironments, env)
+	default:
+		return nil
+	}
+}
+
+func checkResourceLimits(t target) []string {
+	var out []string
+	for _, container := range podContainers(t.object) {
+		limits, _ := lookupMap(container.spec, "resources", "limits")
+		var missing []string
+		for _, resource := range []string{"cpu", "memory"} {
+			if isEmptyValue(limits[resource]) {
+				missing = append(missing, resource)
+			}
+		}
+		if len(missing) > 0 {
+			out = append(out, fmt.Sprintf("container %q has no resources.limits for %s", container.name, strings.Join(missing, ", ")))
+		}
+	}
+	return out
+}
+
+func checkPrivileged(t target) []string {
+	var out []string
+	for _, container := range podContainers(t.object) {
+		securityContext, _ := container.spec["securityContext"].(map[string]any)
+		if privileged, _ := securityContext["privileged"].(bool); privileged {
+			out = append(out, fmt.Sprintf("container %q runs privileged", container.name))
+		}
+	}
+	return out
+}
+
+func checkHostPath(t target) []string {
+	podSpec, ok := podSpecOf(t.object)
+	if !ok {
+		return nil
+	}
+	volumes, _ := podSpec["volumes"].([]any)
+	var out []string
+	for _, rawVolume := range volumes {
+		volume, _ := rawVolume.(map[string]any)
+		if _, ok := volume["hostPath"]; ok {
+			out = append(out, fmt.Sprintf("volume %q mounts hostPath", stringValue(volume["name"])))
+		}
+	}
+	return out
+}
+
+func checkAllowedRegistries(t target, allowed []string) []string {
+	if len(allowed) == 0 {
+		return nil
+	}
+	var out []string
+	for _, container := range podContainers(t.object) {
+		image := stringValue(container.spec["image"])
+		if image == "" || imageFromAllowedRegistry(image, allowed) {
+			continue
+		}
+		out = append(out, fmt.Sprintf("container %q image %q is outside allowed registries", container.name, image))
+	}
+	return out
+}
+
+func imageFromAllowedRegistry(image string, allowed []string) bool {
+	for _, prefix := range allowed {
+		if strings.HasPrefix(image, prefix+"/") || strings.HasPrefix(image, prefix+":") || strings.HasPrefix(image, prefix+"@") || image == prefix {
+			return true
+		}
+	}
+	return false
+}
+
+func checkRequiredLabels(t target, required []string) []string {
+	if len(required) == 0 {
+		return nil
+	}
+	labels, _ := lookupMap(t.object, "metadata", "labels")
+	var missing []string
+	for _, key := range required {
+		if isEmptyValue(labels[key]) {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	return []string{"missing required labels: " + strings.Join(missing, ", ")}
+}
+
+func checkLoadBalancer(t target, allowedEnvironments []string, env string) []string {
+	if t.kind != "Service" {
+		return nil
+	}
+	spec, _ := t.object["spec"].(map[string]any)
+	if stringValue(spec["type"]) != "LoadBalancer" {
+		return nil
+	}
+	if len(allowedEnvironments) == 0 {
+		allowedEnvironments = []string{defaultLoadBalancerEnvironment}
+	}
+	envKey := strings.ToLower(strings.TrimSpace(env))
+	for _, allowed := range allowedEnvironments {
+		if allowed == envKey {
+			return nil
+		}
+	}
+	return []string{fmt.Sprintf("Service type LoadBalancer is not allowed in environment %q", env)}
+}
+
+func evaluateCustom(rule servicescfg.ManifestPolicyCustomRule, pattern *regexp.Regexp, t target) string {
+	if len(rule.Kinds) > 0 && !containsFold(rule.Kinds, t.kind) {
+		return ""
+	}
+	values := lookupPath(t.object, strings.Split(rule.Path, "."))
+	present := make([]any, 0, len(values))
+	for _, value := range values {
+		if !isEmptyValue(value) {
+			present = append(present, value)
+		}
+	}
+	description := rule.Description
+	if description == "" {
+		description = fmt.Sprintf("%s %s", rule.Path, rule.Operator)
+		if rule.Value != "" {
+			description += " " + rule.Value
+		}
+	}
+
+	switch rule.Operator {
+	case servicescfg.ManifestPolicyOperatorRequired:
+		if len(present) < len(values) {
+			return description
+		}
+	case servicescfg.ManifestPolicyOperatorForbidden:
+		if len(present) > 0 {
+			return description
+		}
+	case servicescfg.ManifestPolicyOperatorEquals:
+		for _, value := range present {
+			if fmt.Sprint(value) != rule.Value {
+				return description
+			}
+		}
+	case servicescfg.ManifestPolicyOperatorNotEquals:
+		for _, value := range present {
+			if fmt.Sprint(value) == rule.Value {
+				return description
+			}
+		}
+	case servicescfg.ManifestPolicyOperatorMatches:
+		for _, value := range present {
+			if !pattern.MatchString(fmt.Sprint(value)) {
+				return description
+			}
+		}
+	case servicescfg.ManifestPolicyOperatorNotMatches:
+		for _, value := range present {
+			if pattern.MatchString(fmt.Sprint(value)) {
+				return description
+			}
+		}
+	}
+	return ""
+}
+
+// lookupPath resolves dotted path segments; `name[]` iterates list items.
+// Missing leaf values are returned as nil so that `required` can detect them per list item.
+func lookupPath(value any, segments []string) []any {
+	if len(segments) == 0 {
+		return []any{value}
+	}
+	segment := segments[0]
+	iterate := strings.HasSuffix(segment, "[]")
+	key := strings.TrimSuffix(segment, "[]")
+
+	object, ok := value.(map[string]any)
+	if !ok {
+		return []any{nil}
+	}
+	child, ok := object[key]
+	if !ok {
+		return []any{nil}
+	}
+	if !iterate {
+		return lookupPath(child, segments[1:])
+	}
+	items, _ := child.([]any)
+	out := make([]any, 0, len(items))
+	for _, item := range items {
+		out = append(out, lookupPath(item, segments[1:])...)
+	}
+	return out
+}
+
+type container struct {
+	name string
+	spec map[string]any
+}
+
+func podContainers(object map[string]any) []container {
+	podSpec, ok := podSpecOf(object)
+	if !ok {
+		return nil
+	}
+	var out []container
+	for _, field := range []string{"initContainers", "containers"} {
+		items, _ := podSpec[field].([]any)
+		for _, item := range items {
+			spec, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			out = append(out, container{name: stringValue(spec["name"]), spec: spec})
+		}
+	}
+	return out
+}
+
+func podSpecOf(object map[string]any) (map[string]any, bool) {
+	switch stringValue(object["kind"]) {
+	case "Pod":
+		return lookupMap(object, "spec")
+	case "Deployment", "StatefulSet", "DaemonSet", "ReplicaSet", "Job":
+		return lookupMap(object, "spec", "template", "spec")
+	case "CronJob":
+		return lookupMap(object, "spec", "jobTemplate", "spec", "template", "spec")
+	default:
+		return nil, false
+	}
+}
+
+func lookupMap(object map[string]any, path ...string) (map[string]any, bool) {
+	current := object
+	for _, key := range path {
+		next, ok := current[key].(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		current = next
+	}
+	return current, true
+}
+
+func decodeObjects(manifest []byte) ([]map[string]any, error) {
+	decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	var out []map[string]any
+	for {
+		var object map[string]any
+		if err := decoder.Decode(&object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("decode manifest yaml: %w", err)
+		}
+		if len(object) == 0 {
+			continue
+		}
+		if stringValue(object["kind"]) == "List" {
+			items, _ := object["items"].([]any)
+			for _, item := range items {
+				if itemObject, ok := item.(map[string]any); ok {
+					out = append(out, itemObject)
+				}
+			}
+			continue
+		}
+		out = append(out, object)
+	}
+	return out, nil
+}
+
+func containsFold(values []string, needle string) bool {
+	for _, value := range values {
+		if strings.EqualFold(value, needle) {
+			return true
+		}
+	}
+	return false
+}
+
+func isEmptyValue(value any) bool {
+	switch typed := value.(type) {
+	case nil:
+		return true
+	case string:
+		return strings.TrimSpace(typed) == ""
+	case map[string]any:
+		return len(typed) == 0
+	case []any:
+		return len(typed) == 0
+	default:
+		return false
+	}
+}
+
+func stringValue(value any) string {
+	text, _ := value.(string)
+	return strings.TrimSpace(text)
+}
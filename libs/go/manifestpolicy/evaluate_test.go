@@ -0,0 +1,161 @@
+package manifestpolicy
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+)
+
+const testManifest = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: api
+  labels:
+    app.kubernetes.io/name: api
+spec:
+  template:
+    spec:
+      containers:
+        - name: api
+          image: ghcr.io/acme/api:1.0.0
+          resources:
+            limits:
+              cpu: 500m
+              memory: 256Mi
+        - name: debug
+          image: docker.io/library/busybox:latest
+          securityContext:
+            privileged: true
+      volumes:
+        - name: host
+          hostPath:
+            path: /var/run
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: api
+spec:
+  type: LoadBalancer
+`
+
+func TestEvaluate_BuiltinRules(t *testing.T) {
+	t.Parallel()
+
+	policy := servicescfg.ManifestPolicy{
+		AllowedRegistries: []string{"ghcr.io/acme"},
+		RequiredLabels:    []string{"app.kubernetes.io/name"},
+	}
+	violations, err := Evaluate(policy, "ai", []byte(testManifest))
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+
+	got := make([]string, 0, len(violations))
+	for _, violation := range violations {
+		if violation.Mode != servicescfg.ManifestPolicyModeWarn {
+			t.Fatalf("violation %s mode = %q, want warn", violation.Rule, violation.Mode)
+		}
+		got = append(got, violation.Rule+" "+violation.Object())
+	}
+	want := []string{
+		"resource-limits Deployment/api",
+		"no-privileged Deployment/api",
+		"no-host-path Deployment/api",
+		"allowed-registries Deployment/api",
+		"required-labels Service/api",
+		"no-load-balancer Service/api",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("violations = %#v, want %#v", got, want)
+	}
+	if Blocking(violations) {
+		t.Fatalf("warn-only violations must not block")
+	}
+}
+
+func TestEvaluate_ModeResolutionPerEnvironment(t *testing.T) {
+	t.Parallel()
+
+	policy := servicescfg.ManifestPolicy{
+		Mode:             servicescfg.ManifestPolicyModeOff,
+		EnvironmentModes: map[string]servicescfg.ManifestPolicyMode{"production": servicescfg.ManifestPolicyModeWarn},
+		Rules: map[string]servicescfg.ManifestPolicyRuleMode{
+			servicescfg.ManifestPolicyRuleNoPrivileged: {
+				Environments: map[string]servicescfg.ManifestPolicyMode{"production": servicescfg.ManifestPolicyModeBlock},
+			},
+		},
+		LoadBalancerEnvironments: []string{"production"},
+	}
+
+	violations, err := Evaluate(policy, "ai", []byte(testManifest))
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(violations) != 0 {
+		t.Fatalf("expected no violations in ai, got %v", violations)
+	}
+
+	violations, err = Evaluate(policy, "production", []byte(testManifest))
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if !Blocking(violations) {
+		t.Fatalf("expected blocking violation in production, got %v", violations)
+	}
+	for _, violation := range violations {
+		if violation.Rule == servicescfg.ManifestPolicyRuleNoLoadBalancer {
+			t.Fatalf("LoadBalancer must be allowed in production")
+		}
+		wantMode := servicescfg.ManifestPolicyModeWarn
+		if violation.Rule == servicescfg.ManifestPolicyRuleNoPrivileged {
+			wantMode = servicescfg.ManifestPolicyModeBlock
+		}
+		if violation.Mode != wantMode {
+			t.Fatalf("violation %s mode = %q, want %q", violation.Rule, violation.Mode, wantMode)
+		}
+	}
+}
+
+func TestEvaluate_CustomRules(t *testing.T) {
+	t.Parallel()
+
+	policy := servicescfg.ManifestPolicy{
+		Mode: servicescfg.ManifestPolicyModeOff,
+		CustomRules: []servicescfg.ManifestPolicyCustomRule{
+			{
+				Name:                   "no-latest-tag",
+				Kinds:                  []string{"Deployment"},
+				Path:                   "spec.template.spec.containers[].image",
+				Operator:               servicescfg.ManifestPolicyOperatorNotMatches,
+				Value:                  ":latest$",
+				ManifestPolicyRuleMode: servicescfg.ManifestPolicyRuleMode{Mode: servicescfg.ManifestPolicyModeBlock},
+			},
+			{
+				Name:                   "probes-required",
+				Kinds:                  []string{"Deployment"},
+				Path:                   "spec.template.spec.containers[].readinessProbe",
+				Operator:               servicescfg.ManifestPolicyOperatorRequired,
+				Description:            "every container needs readinessProbe",
+				ManifestPolicyRuleMode: servicescfg.ManifestPolicyRuleMode{Mode: servicescfg.ManifestPolicyModeWarn},
+			},
+		},
+	}
+
+	violations, err := Evaluate(policy, "ai", []byte(testManifest))
+	if err != nil {
+		t.Fatalf("Evaluate() error = %v", err)
+	}
+	if len(violations) != 2 {
+		t.Fatalf("expected 2 violations, got %v", violations)
+	}
+	if violations[0].Rule != "no-latest-tag" || violations[0].Mode != servicescfg.ManifestPolicyModeBlock {
+		t.Fatalf("unexpected first violation: %+v", violations[0])
+	}
+	if violations[1].Rule != "probes-required" || !strings.Contains(violations[1].Message, "readinessProbe") {
+		t.Fatalf("unexpected second violation: %+v", violations[1])
+	}
+}
@@ -0,0 +1,12 @@
+package approvalwait
+
+const (
+	// ResumePayloadRunPayloadFieldName stores deterministic approval resume data inside run payload JSON.
+	ResumePayloadRunPayloadFieldName = "approval_resume_payload"
+	// ResumeCorrelationPrefix marks pending runs scheduled specifically for deterministic approval resume.
+	ResumeCorrelationPrefix = "approval-resume:"
+	// ResumePayloadMaxBytes bounds the serialized approval resume payload fetched by agent-runner.
+	ResumePayloadMaxBytes = 8 * 1024
+	// RunnerActionPersistSessionAndExitWait tells the agent to end its turn so agent-runner can persist the session and exit.
+	RunnerActionPersistSessionAndExitWait = "persist_session_and_exit_wait"
+)
@@ -0,0 +1,257 @@
+package servicescfg
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// AgentToolCategory marks read/write class of one project-declared agent tool.
+type AgentToolCategory string
+
+const (
+	AgentToolCategoryRead  AgentToolCategory = "read"
+	AgentToolCategoryWrite AgentToolCategory = "write"
+)
+
+// AgentToolApproval defines approval requirement of one project-declared HTTP tool.
+type AgentToolApproval string
+
+const (
+	AgentToolApprovalNone      AgentToolApproval = "none"
+	AgentToolApprovalOwner     AgentToolApproval = "owner"
+	AgentToolApprovalDelegated AgentToolApproval = "delegated"
+)
+
+const (
+	// AgentHTTPToolDefaultTimeoutSeconds is used when httpTools[].timeoutSeconds is omitted.
+	AgentHTTPToolDefaultTimeoutSeconds = 30
+	// AgentHTTPToolMaxTimeoutSeconds caps one HTTP tool callout.
+	AgentHTTPToolMaxTimeoutSeconds = 120
+	// AgentMCPServerMaxToolTimeoutSeconds caps tool_timeout_sec of one external MCP server.
+	AgentMCPServerMaxToolTimeoutSeconds = 600
+)
+
+// reservedAgentMCPServerNames are MCP servers configured by the platform itself.
+var reservedAgentMCPServerNames = []string{"kodex", "context7"}
+
+var (
+	agentToolNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{0,62}$`)
+	agentToolEnvPattern  = regexp.MustCompile(`^[A-Z_][A-Z0-9_]*$`)
+)
+
+// AgentTools declares project-specific MCP servers and HTTP-backed tools for agent runs.
+type AgentTools struct {
+	// MCPServers are mounted into codex config of the run pod; tool calls go directly to the server.
+	MCPServers []AgentMCPServer `yaml:"mcpServers,omitempty"`
+	// HTTPTools are exposed by control-plane MCP as `project.<name>` and proxied as HTTP POST callouts.
+	HTTPTools []AgentHTTPTool `yaml:"httpTools,omitempty"`
+}
+
+// AgentMCPServer declares one external MCP server: stdio command or streamable HTTP URL.
+type AgentMCPServer struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description,omitempty"`
+	// Command starts a stdio server inside the run pod; mutually exclusive with URL.
+	Command string   `yaml:"command,omitempty"`
+	Args    []string `yaml:"args,omitempty"`
+	// Env holds static, non-secret environment for stdio servers.
+	Env                map[string]string `yaml:"env,omitempty"`
+	URL                string            `yaml:"url,omitempty"`
+	ToolTimeoutSeconds int               `yaml:"toolTimeoutSeconds,omitempty"`
+	// Category is write when the server mutates external state; write servers are never mounted
+	// because their calls bypass approvals, so mutating callouts belong to HTTPTools.
+	Category AgentToolCategory `yaml:"category,omitempty"`
+	// Roles limits agent role keys that get the server; empty means every role.
+	Roles []string `yaml:"roles,omitempty"`
+}
+
+// AgentHTTPTool declares one custom tool implemented as HTTP callout proxied through control-plane.
+type AgentHTTPTool struct {
+	Name        string            `yaml:"name"`
+	Description string            `yaml:"description"`
+	URL         string            `yaml:"url"`
+	Category    AgentToolCategory `yaml:"category,omitempty"`
+	Approval    AgentToolApproval `yaml:"approval,omitempty"`
+	// TimeoutSeconds bounds one callout; 30 when omitted.
+	TimeoutSeconds int `yaml:"timeoutSeconds,omitempty"`
+	// InputSchema is JSON schema of tool arguments; free-form object when omitted.
+	InputSchema map[string]any `yaml:"inputSchema,omitempty"`
+	// Roles limits agent role keys that see the tool; empty means every role.
+	Roles []string `yaml:"roles,omitempty"`
+}
+
+// AllowsRole reports whether server is available for the agent role key.
+func (s AgentMCPServer) AllowsRole(role string) bool {
+	return agentToolAllowsRole(s.Roles, role)
+}
+
+// AllowsRole reports whether tool is available for the agent role key.
+func (t AgentHTTPTool) AllowsRole(role string) bool {
+	return agentToolAllowsRole(t.Roles, role)
+}
+
+func agentToolAllowsRole(roles []string, role string) bool {
+	if len(roles) == 0 {
+		return true
+	}
+	return slices.Contains(roles, normalizeRoleKey(role))
+}
+
+func normalizeAndValidateAgentTools(tools *AgentTools) error {
+	seenServers := make(map[string]struct{}, len(tools.MCPServers))
+	for idx := range tools.MCPServers {
+		server := &tools.MCPServers[idx]
+		field := fmt.Sprintf("spec.agentTools.mcpServers[%d]", idx)
+		name, err := normalizeAgentToolName(server.Name, field)
+		if err != nil {
+			return err
+		}
+		if slices.Contains(reservedAgentMCPServerNames, name) {
+			return fmt.Errorf("%s.name %q is reserved by platform", field, name)
+		}
+		if _, ok := seenServers[name]; ok {
+			return fmt.Errorf("duplicate spec.agentTools.mcpServers name %q", name)
+		}
+		seenServers[name] = struct{}{}
+		server.Name = name
+		server.Description = strings.TrimSpace(server.Description)
+		server.Command = strings.TrimSpace(server.Command)
+		server.URL = strings.TrimSpace(server.URL)
+
+		switch {
+		case server.Command != "" && server.URL != "":
+			return fmt.Errorf("%s: command and url are mutually exclusive", field)
+		case server.Command == "" && server.URL == "":
+			return fmt.Errorf("%s: command or url is required", field)
+		case server.URL != "":
+			if err := validateAgentToolURL(server.URL, field+".url"); err != nil {
+				return err
+			}
+			if len(server.Args) > 0 || len(server.Env) > 0 {
+				return fmt.Errorf("%s: args and env are supported only for command servers", field)
+			}
+		}
+		for key := range server.Env {
+			if !agentToolEnvPattern.MatchString(key) {
+				return fmt.Errorf("%s.env: invalid variable name %q", field, key)
+			}
+		}
+		if server.ToolTimeoutSeconds < 0 || server.ToolTimeoutSeconds > AgentMCPServerMaxToolTimeoutSeconds {
+			return fmt.Errorf("%s.toolTimeoutSeconds must be in range 0..%d", field, AgentMCPServerMaxToolTimeoutSeconds)
+		}
+		if server.Category, err = normalizeAgentToolCategory(server.Category, field); err != nil {
+			return err
+		}
+		if server.Roles, err = normalizeAgentToolRoles(server.Roles, field); err != nil {
+			return err
+		}
+	}
+
+	seenTools := make(map[string]struct{}, len(tools.HTTPTools))
+	for idx := range tools.HTTPTools {
+		tool := &tools.HTTPTools[idx]
+		field := fmt.Sprintf("spec.agentTools.httpTools[%d]", idx)
+		name, err := normalizeAgentToolName(tool.Name, field)
+		if err != nil {
+			return err
+		}
+		if _, ok := seenTools[name]; ok {
+			return fmt.Errorf("duplicate spec.agentTools.httpTools name %q", name)
+		}
+		seenTools[name] = struct{}{}
+		tool.Name = name
+		tool.Description = strings.TrimSpace(tool.Description)
+		if tool.Description == "" {
+			return fmt.Errorf("%s.description is required", field)
+		}
+		tool.URL = strings.TrimSpace(tool.URL)
+		if tool.URL == "" {
+			return fmt.Errorf("%s.url is required", field)
+		}
+		if err := validateAgentToolURL(tool.URL, field+".url"); err != nil {
+			return err
+		}
+		if tool.Category, err = normalizeAgentToolCategory(tool.Category, field); err != nil {
+			return err
+		}
+		switch approval := AgentToolApproval(strings.ToLower(strings.TrimSpace(string(tool.Approval)))); approval {
+		case "":
+			tool.Approval = AgentToolApprovalNone
+		case AgentToolApprovalNone, AgentToolApprovalOwner, AgentToolApprovalDelegated:
+			tool.Approval = approval
+		default:
+			return fmt.Errorf("%s.approval: unsupported approval %q", field, tool.Approval)
+		}
+		switch {
+		case tool.TimeoutSeconds == 0:
+			tool.TimeoutSeconds = AgentHTTPToolDefaultTimeoutSeconds
+		case tool.TimeoutSeconds < 0 || tool.TimeoutSeconds > AgentHTTPToolMaxTimeoutSeconds:
+			return fmt.Errorf("%s.timeoutSeconds must be in range 1..%d", field, AgentHTTPToolMaxTimeoutSeconds)
+		}
+		if tool.InputSchema != nil {
+			if schemaType, ok := tool.InputSchema["type"]; ok && schemaType != "object" {
+				return fmt.Errorf("%s.inputSchema.type must be object", field)
+			}
+		}
+		if tool.Roles, err = normalizeAgentToolRoles(tool.Roles, field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func normalizeAgentToolName(value string, field string) (string, error) {
+	name := strings.ToLower(strings.TrimSpace(value))
+	if name == "" {
+		return "", fmt.Errorf("%s.name is required", field)
+	}
+	if !agentToolNamePattern.MatchString(name) {
+		return "", fmt.Errorf("%s.name %q must match %s", field, value, agentToolNamePattern.String())
+	}
+	return name, nil
+}
+
+func normalizeAgentToolCategory(value AgentToolCategory, field string) (AgentToolCategory, error) {
+	switch category := AgentToolCategory(strings.ToLower(strings.TrimSpace(string(value)))); category {
+	case "":
+		return AgentToolCategoryRead, nil
+	case AgentToolCategoryRead, AgentToolCategoryWrite:
+		return category, nil
+	default:
+		return "", fmt.Errorf("%s.category: unsupported category %q", field, value)
+	}
+}
+
+func normalizeAgentToolRoles(values []string, field string) ([]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	out := make([]string, 0, len(values))
+	for _, raw := range values {
+		role := normalizeRoleKey(raw)
+		if !isValidRoleKey(role) {
+			return nil, fmt.Errorf("%s.roles: invalid role key %q", field, raw)
+		}
+		if !slices.Contains(out, role) {
+			out = append(out, role)
+		}
+	}
+	return out, nil
+}
+
+func validateAgentToolURL(value string, field string) error {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("%s: parse url: %w", field, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("%s: scheme must be http or https", field)
+	}
+	if parsed.Host == "" {
+		return fmt.Errorf("%s: host is required", field)
+	}
+	return nil
+}
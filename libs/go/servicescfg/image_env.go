@@ -0,0 +1,34 @@
+package servicescfg
+
+import (
+	"strings"
+	"unicode"
+)
+
+// AgentRunnerImageEnvVar is image env variable consumed by control-plane for run jobs instead of manifests.
+const AgentRunnerImageEnvVar = "KODEX_AGENT_RUNNER_IMAGE"
+
+// ImageEnvVar returns env variable name used to pass resolved image reference into manifest templates.
+func ImageEnvVar(name string) string {
+	trimmed := strings.TrimSpace(name)
+	if trimmed == "" {
+		return ""
+	}
+	normalized := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return unicode.ToUpper(r)
+		case r >= 'A' && r <= 'Z':
+			return r
+		case r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, trimmed)
+	normalized = strings.Trim(normalized, "_")
+	if normalized == "" {
+		return ""
+	}
+	return "KODEX_" + normalized + "_IMAGE"
+}
@@ -0,0 +1,696 @@
+package servicescfg
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/codex-k8s/kodex/libs/go/manifesttpl"
+)
+
+// LintSeverity classifies one lint finding.
+type LintSeverity string
+
+const (
+	// LintSeverityError marks findings that break render or deploy.
+	LintSeverityError LintSeverity = "error"
+	// LintSeverityWarning marks findings that do not block deploy.
+	LintSeverityWarning LintSeverity = "warning"
+)
+
+// Lint rule identifiers.
+const (
+	LintRuleLoad              = "load"
+	LintRuleTemplate          = "template"
+	LintRuleSchema            = "schema"
+	LintRuleValidation        = "validation"
+	LintRuleMissingManifest   = "missing-manifest"
+	LintRuleUnknownComponent  = "unknown-component"
+	LintRuleUnknownDependency = "unknown-dependency"
+	LintRuleDependencyCycle   = "dependency-cycle"
+	LintRuleDeployOrder       = "deploy-order"
+	LintRuleUnusedImage       = "unused-image"
+	LintRuleUnusedVersion     = "unused-version"
+	LintRuleMissingBumpPath   = "missing-bump-path"
+)
+
+// LintRuleInfo describes one lint rule for reporting formats.
+type LintRuleInfo struct {
+	ID          string
+	Severity    LintSeverity
+	Description string
+}
+
+// LintRules lists all lint rules in reporting order.
+var LintRules = []LintRuleInfo{
+	{ID: LintRuleLoad, Severity: LintSeverityError, Description: "services.yaml or one of its imports cannot be read or decoded"},
+	{ID: LintRuleTemplate, Severity: LintSeverityError, Description: "services.yaml, `when` expression or manifest template fails to render"},
+	{ID: LintRuleSchema, Severity: LintSeverityError, Description: "rendered services.yaml violates JSON schema"},
+	{ID: LintRuleValidation, Severity: LintSeverityError, Description: "rendered services.yaml fails contract validation"},
+	{ID: LintRuleMissingManifest, Severity: LintSeverityError, Description: "manifest file referenced by infrastructure or service does not exist"},
+	{ID: LintRuleUnknownComponent, Severity: LintSeverityError, Description: "service `use` references undefined component"},
+	{ID: LintRuleUnknownDependency, Severity: LintSeverityError, Description: "`dependsOn` references undefined infrastructure item or service"},
+	{ID: LintRuleDependencyCycle, Severity: LintSeverityError, Description: "`dependsOn` graph contains cycle"},
+	{ID: LintRuleDeployOrder, Severity: LintSeverityWarning, Description: "service deployGroup is missing from orchestration.deployOrder"},
+	{ID: LintRuleUnusedImage, Severity: LintSeverityWarning, Description: "image is not referenced by any manifest template"},
+	{ID: LintRuleUnusedVersion, Severity: LintSeverityWarning, Description: "version is not referenced by services.yaml or manifest templates"},
+	{ID: LintRuleMissingBumpPath, Severity: LintSeverityError, Description: "version bumpOn path does not exist in repository"},
+}
+
+// LintOptions controls multi-environment services.yaml lint.
+type LintOptions struct {
+	// Environments limits lint to listed environments; all spec.environments when empty.
+	Environments []string
+	// SlotSamples are slot numbers rendered for slot-based environments; [1] when empty.
+	SlotSamples []int
+	Vars        map[string]string
+	// RepositoryRoot resolves manifest and bumpOn paths; config file directory when empty.
+	RepositoryRoot string
+	// TemplateDirs are repository-relative directories additionally scanned for image/version usage.
+	TemplateDirs []string
+}
+
+// LintTarget is one rendered environment/slot combination.
+type LintTarget struct {
+	Env  string `json:"env"`
+	Slot int    `json:"slot"`
+}
+
+// Label returns human-readable target name.
+func (t LintTarget) Label() string {
+	if t.Slot > 0 {
+		return fmt.Sprintf("%s[slot=%d]", t.Env, t.Slot)
+	}
+	return t.Env
+}
+
+// LintFinding is one problem found by Lint.
+type LintFinding struct {
+	Rule     string       `json:"rule"`
+	Severity LintSeverity `json:"severity"`
+	// Location is a logical services.yaml path, e.g. spec.services[api].dependsOn.
+	Location string `json:"location,omitempty"`
+	// File is a repository-relative file the finding points to.
+	File    string `json:"file"`
+	Message string `json:"message"`
+	// Targets lists environment/slot labels where finding was observed; empty for env-independent checks.
+	Targets []string `json:"targets,omitempty"`
+}
+
+// LintReport aggregates findings over all lint targets.
+type LintReport struct {
+	ConfigFile string        `json:"config_file"`
+	Targets    []LintTarget  `json:"targets"`
+	Findings   []LintFinding `json:"findings"`
+}
+
+// Counts returns number of error and warning findings.
+func (r LintReport) Counts() (errorsCount int, warningsCount int) {
+	for _, finding := range r.Findings {
+		if finding.Severity == LintSeverityError {
+			errorsCount++
+		} else {
+			warningsCount++
+		}
+	}
+	return errorsCount, warningsCount
+}
+
+// HasErrors reports whether report contains error findings.
+func (r LintReport) HasErrors() bool {
+	errorsCount, _ := r.Counts()
+	return errorsCount > 0
+}
+
+var versionRefPattern = regexp.MustCompile(`\.Versions(?:\s+"([^"]+)"|\.([A-Za-z0-9_]+))`)
+
+// Lint renders services.yaml for every environment and slot sample and collects all problems
+// instead of stopping at the first one like Load does.
+func Lint(path string, opts LintOptions) (LintReport, error) {
+	if strings.TrimSpace(path) == "" {
+		return LintReport{}, fmt.Errorf("config path is required")
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return LintReport{}, fmt.Errorf("resolve config path: %w", err)
+	}
+	root := strings.TrimSpace(opts.RepositoryRoot)
+	if root == "" {
+		root = filepath.Dir(absPath)
+	}
+	root, err = filepath.Abs(root)
+	if err != nil {
+		return LintReport{}, fmt.Errorf("resolve repository root: %w", err)
+	}
+
+	l := &linter{
+		root:       root,
+		configFile: relativeToRoot(root, absPath),
+		opts:       opts,
+		index:      make(map[string]int),
+		templates:  make(map[string]struct{}),
+	}
+	report := l.run(absPath)
+	return report, nil
+}
+
+type linter struct {
+	root       string
+	configFile string
+	opts       LintOptions
+	findings   []LintFinding
+	index      map[string]int
+	// templates holds repository-relative manifest paths referenced by any rendered target.
+	templates map[string]struct{}
+}
+
+func (l *linter) run(absPath string) LintReport {
+	report := LintReport{ConfigFile: l.configFile}
+
+	rootMap, err := loadMergedMap(absPath, nil)
+	if err != nil {
+		l.add(LintRuleLoad, LintSeverityError, "", l.configFile, err.Error(), nil)
+		report.Findings = l.sorted()
+		return report
+	}
+	rawMerged, err := yaml.Marshal(rootMap)
+	if err != nil {
+		l.add(LintRuleLoad, LintSeverityError, "", l.configFile, fmt.Sprintf("marshal merged config: %v", err), nil)
+		report.Findings = l.sorted()
+		return report
+	}
+	var header rawHeader
+	if err := yaml.Unmarshal(rawMerged, &header); err != nil {
+		l.add(LintRuleLoad, LintSeverityError, "", l.configFile, fmt.Sprintf("parse header: %v", err), nil)
+		report.Findings = l.sorted()
+		return report
+	}
+
+	report.Targets = l.targets(header.Spec.Environments)
+	stacks := make([]*Stack, 0, len(report.Targets))
+	for _, target := range report.Targets {
+		if stack := l.lintTarget(absPath, rawMerged, target); stack != nil {
+			stacks = append(stacks, stack)
+		}
+	}
+
+	l.checkBumpPaths(header.Spec.Versions)
+	if len(stacks) > 0 {
+		usage := l.collectUsageText(rawMerged)
+		l.checkUnusedVersions(header.Spec.Versions, usage)
+		l.checkUnusedImages(stacks, usage)
+	}
+
+	report.Findings = l.sorted()
+	return report
+}
+
+// targets expands requested environments into env/slot combinations.
+func (l *linter) targets(environments map[string]Environment) []LintTarget {
+	names := make([]string, 0, len(environments))
+	if len(l.opts.Environments) > 0 {
+		for _, name := range l.opts.Environments {
+			if trimmed := strings.TrimSpace(name); trimmed != "" {
+				names = append(names, trimmed)
+			}
+		}
+	} else {
+		for name := range environments {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+	if len(names) == 0 {
+		l.add(LintRuleValidation, LintSeverityError, "spec.environments", l.configFile, "spec.environments is empty", nil)
+		return nil
+	}
+
+	slots := l.opts.SlotSamples
+	if len(slots) == 0 {
+		slots = []int{1}
+	}
+	out := make([]LintTarget, 0, len(names))
+	for _, name := range names {
+		if !environmentUsesSlot(environments, name) {
+			out = append(out, LintTarget{Env: name})
+			continue
+		}
+		for _, slot := range slots {
+			out = append(out, LintTarget{Env: name, Slot: slot})
+		}
+	}
+	return out
+}
+
+// environmentUsesSlot reports whether namespace of environment depends on slot number.
+func environmentUsesSlot(environments map[string]Environment, name string) bool {
+	envCfg, err := resolveEnvironmentFromMap(environments, name)
+	if err != nil {
+		return false
+	}
+	if strings.Contains(envCfg.NamespaceTemplate, ".Slot") {
+		return true
+	}
+	return strings.TrimSpace(envCfg.NamespaceTemplate) == "" && name == "ai"
+}
+
+func (l *linter) lintTarget(absPath string, rawMerged []byte, target LintTarget) *Stack {
+	label := []string{target.Label()}
+	envLocation := fmt.Sprintf("spec.environments[%s]", target.Env)
+
+	ctx, err := buildContext(rawMerged, LoadOptions{Env: target.Env, Slot: target.Slot, Vars: l.opts.Vars})
+	if err != nil {
+		l.add(LintRuleTemplate, LintSeverityError, envLocation, l.configFile, err.Error(), label)
+		return nil
+	}
+	rendered, err := renderTemplate(absPath, rawMerged, ctx)
+	if err != nil {
+		l.add(LintRuleTemplate, LintSeverityError, "", l.configFile, err.Error(), label)
+		return nil
+	}
+	if err := validateRenderedSchema(rendered); err != nil {
+		l.add(LintRuleSchema, LintSeverityError, "", l.configFile, err.Error(), label)
+		return nil
+	}
+
+	var stack Stack
+	if err := yaml.Unmarshal(rendered, &stack); err != nil {
+		l.add(LintRuleSchema, LintSeverityError, "", l.configFile, fmt.Sprintf("parse rendered services.yaml: %v", err), label)
+		return nil
+	}
+	normalizeRootDefaults(&stack, ctx)
+
+	if l.checkComponents(&stack, label) {
+		if err := applyServiceComponents(&stack); err != nil {
+			l.add(LintRuleValidation, LintSeverityError, "spec.components", l.configFile, err.Error(), label)
+		}
+	}
+	if err := normalizeAndValidate(&stack, target.Env); err != nil {
+		l.add(LintRuleValidation, LintSeverityError, "", l.configFile, err.Error(), label)
+	}
+
+	l.checkWhen(&stack, label)
+	l.checkDependencies(&stack, label)
+	l.checkDeployOrder(&stack, label)
+	l.checkManifests(&stack, label)
+	return &stack
+}
+
+// checkComponents reports unknown `use` references and returns true when all references resolve.
+func (l *linter) checkComponents(stack *Stack, label []string) bool {
+	components := make(map[string]struct{}, len(stack.Spec.Components))
+	for _, component := range stack.Spec.Components {
+		components[strings.TrimSpace(component.Name)] = struct{}{}
+	}
+	ok := true
+	for _, svc := range stack.Spec.Services {
+		for _, ref := range svc.Use {
+			name := strings.TrimSpace(ref)
+			if _, exists := components[name]; exists {
+				continue
+			}
+			ok = false
+			l.add(
+				LintRuleUnknownComponent, LintSeverityError,
+				fmt.Sprintf("spec.services[%s].use", svc.Name), l.configFile,
+				fmt.Sprintf("service %q references unknown component %q", svc.Name, name),
+				label,
+			)
+		}
+	}
+	return ok
+}
+
+func (l *linter) checkWhen(stack *Stack, label []string) {
+	check := func(location string, value string) {
+		trimmed := strings.TrimSpace(value)
+		if trimmed == "" {
+			return
+		}
+		if _, err := strconv.ParseBool(strings.ToLower(trimmed)); err != nil {
+			l.add(LintRuleTemplate, LintSeverityError, location, l.configFile,
+				fmt.Sprintf("when expression must render to boolean, got %q", trimmed), label)
+		}
+	}
+	for _, item := range stack.Spec.Infrastructure {
+		check(fmt.Sprintf("spec.infrastructure[%s].when", item.Name), item.When)
+	}
+	for _, svc := range stack.Spec.Services {
+		check(fmt.Sprintf("spec.services[%s].when", svc.Name), svc.When)
+	}
+}
+
+type lintNode struct {
+	location  string
+	dependsOn []string
+}
+
+func (l *linter) checkDependencies(stack *Stack, label []string) {
+	nodes := make(map[string]lintNode, len(stack.Spec.Infrastructure)+len(stack.Spec.Services))
+	for _, item := range stack.Spec.Infrastructure {
+		name := strings.TrimSpace(item.Name)
+		nodes[name] = lintNode{location: fmt.Sprintf("spec.infrastructure[%s]", name), dependsOn: item.DependsOn}
+	}
+	for _, svc := range stack.Spec.Services {
+		name := strings.TrimSpace(svc.Name)
+		nodes[name] = lintNode{location: fmt.Sprintf("spec.services[%s]", name), dependsOn: svc.DependsOn}
+	}
+
+	names := make([]string, 0, len(nodes))
+	for name := range nodes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		node := nodes[name]
+		for _, dep := range node.dependsOn {
+			dep = strings.TrimSpace(dep)
+			if _, ok := nodes[dep]; ok {
+				continue
+			}
+			l.add(LintRuleUnknownDependency, LintSeverityError, node.location+".dependsOn", l.configFile,
+				fmt.Sprintf("%q depends on unknown infrastructure item or service %q", name, dep), label)
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+	state := make(map[string]int, len(nodes))
+	stackPath := make([]string, 0, len(nodes))
+	reported := make(map[string]struct{})
+	var visit func(name string)
+	visit = func(name string) {
+		state[name] = visiting
+		stackPath = append(stackPath, name)
+		deps := append([]string(nil), nodes[name].dependsOn...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			dep = strings.TrimSpace(dep)
+			if _, ok := nodes[dep]; !ok {
+				continue
+			}
+			switch state[dep] {
+			case unvisited:
+				visit(dep)
+			case visiting:
+				cycle := cycleFromPath(stackPath, dep)
+				key := strings.Join(cycle, "->")
+				if _, seen := reported[key]; seen {
+					continue
+				}
+				reported[key] = struct{}{}
+				l.add(LintRuleDependencyCycle, LintSeverityError, nodes[cycle[0]].location+".dependsOn", l.configFile,
+					"dependency cycle: "+strings.Join(append(cycle, cycle[0]), " -> "), label)
+			}
+		}
+		stackPath = stackPath[:len(stackPath)-1]
+		state[name] = done
+	}
+	for _, name := range names {
+		if state[name] == unvisited {
+			visit(name)
+		}
+	}
+}
+
+// cycleFromPath extracts cycle starting at dep and rotates it to start from the smallest name.
+func cycleFromPath(path []string, dep string) []string {
+	start := 0
+	for i, name := range path {
+		if name == dep {
+			start = i
+			break
+		}
+	}
+	cycle := append([]string(nil), path[start:]...)
+	minIdx := 0
+	for i, name := range cycle {
+		if name < cycle[minIdx] {
+			minIdx = i
+		}
+	}
+	return append(cycle[minIdx:], cycle[:minIdx]...)
+}
+
+func (l *linter) checkDeployOrder(stack *Stack, label []string) {
+	ordered := make(map[string]struct{}, len(stack.Spec.Orchestration.DeployOrder))
+	for _, group := range stack.Spec.Orchestration.DeployOrder {
+		ordered[strings.TrimSpace(group)] = struct{}{}
+	}
+	for _, svc := range stack.Spec.Services {
+		group := strings.TrimSpace(svc.DeployGroup)
+		if group == "" {
+			continue
+		}
+		if _, ok := ordered[group]; ok {
+			continue
+		}
+		l.add(LintRuleDeployOrder, LintSeverityWarning, fmt.Sprintf("spec.services[%s].deployGroup", svc.Name), l.configFile,
+			fmt.Sprintf("deployGroup %q is missing from spec.orchestration.deployOrder and will be deployed after ordered groups", group), label)
+	}
+}
+
+func (l *linter) checkManifests(stack *Stack, label []string) {
+	check := func(location string, manifests []ManifestRef) {
+		for idx, manifest := range manifests {
+			itemLocation := fmt.Sprintf("%s.manifests[%d]", location, idx)
+			path := strings.TrimSpace(manifest.Path)
+			if path == "" {
+				l.add(LintRuleValidation, LintSeverityError, itemLocation, l.configFile, "manifest path is empty", label)
+				continue
+			}
+			fullPath := path
+			if !filepath.IsAbs(fullPath) {
+				fullPath = filepath.Join(l.root, path)
+			}
+			relPath := relativeToRoot(l.root, fullPath)
+			raw, err := os.ReadFile(fullPath)
+			if err != nil {
+				l.add(LintRuleMissingManifest, LintSeverityError, itemLocation, l.configFile,
+					fmt.Sprintf("manifest %q not found", relPath), label)
+				continue
+			}
+			if _, seen := l.templates[relPath]; seen {
+				continue
+			}
+			l.templates[relPath] = struct{}{}
+			if _, err := manifesttpl.Render(relPath, raw, l.opts.Vars); err != nil {
+				l.add(LintRuleTemplate, LintSeverityError, itemLocation, relPath, err.Error(), nil)
+			}
+		}
+	}
+	for _, item := range stack.Spec.Infrastructure {
+		check(fmt.Sprintf("spec.infrastructure[%s]", item.Name), item.Manifests)
+	}
+	for _, svc := range stack.Spec.Services {
+		check(fmt.Sprintf("spec.services[%s]", svc.Name), svc.Manifests)
+	}
+}
+
+func (l *linter) checkBumpPaths(versions map[string]VersionSpec) {
+	keys := make([]string, 0, len(versions))
+	for key := range versions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		for _, path := range normalizeVersionBumpPaths(versions[key].BumpOn) {
+			if _, err := os.Stat(filepath.Join(l.root, path)); err == nil {
+				continue
+			}
+			l.add(LintRuleMissingBumpPath, LintSeverityError, fmt.Sprintf("spec.versions[%s].bumpOn", key), l.configFile,
+				fmt.Sprintf("bumpOn path %q does not exist", path), nil)
+		}
+	}
+}
+
+// collectUsageText concatenates merged services.yaml with referenced manifests and extra template dirs.
+func (l *linter) collectUsageText(rawMerged []byte) string {
+	var builder strings.Builder
+	builder.Write(rawMerged)
+
+	files := make(map[string]struct{}, len(l.templates))
+	for path := range l.templates {
+		files[filepath.Join(l.root, path)] = struct{}{}
+	}
+	for _, dir := range l.opts.TemplateDirs {
+		dir = strings.TrimSpace(dir)
+		if dir == "" {
+			continue
+		}
+		_ = filepath.WalkDir(filepath.Join(l.root, dir), func(path string, entry fs.DirEntry, err error) error {
+			if err != nil || entry.IsDir() {
+				return nil
+			}
+			files[path] = struct{}{}
+			return nil
+		})
+	}
+
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		builder.WriteByte('\n')
+		builder.Write(raw)
+	}
+	return builder.String()
+}
+
+func (l *linter) checkUnusedVersions(versions map[string]VersionSpec, usage string) {
+	referenced := make(map[string]struct{})
+	for _, match := range versionRefPattern.FindAllStringSubmatch(usage, -1) {
+		if match[1] != "" {
+			referenced[match[1]] = struct{}{}
+		}
+		if match[2] != "" {
+			referenced[match[2]] = struct{}{}
+		}
+	}
+	keys := make([]string, 0, len(versions))
+	for key := range versions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if _, ok := referenced[strings.TrimSpace(key)]; ok {
+			continue
+		}
+		l.add(LintRuleUnusedVersion, LintSeverityWarning, fmt.Sprintf("spec.versions[%s]", key), l.configFile,
+			fmt.Sprintf("version %q is not referenced by services.yaml or manifest templates", key), nil)
+	}
+}
+
+func (l *linter) checkUnusedImages(stacks []*Stack, usage string) {
+	images := make(map[string]Image)
+	buildUsage := make(map[string]string)
+	for _, stack := range stacks {
+		for name, image := range stack.Spec.Images {
+			images[name] = image
+			if strings.TrimSpace(image.Type) != "build" || strings.TrimSpace(image.Dockerfile) == "" {
+				continue
+			}
+			key := name + "\x00" + image.Dockerfile
+			if _, seen := buildUsage[key]; seen {
+				continue
+			}
+			raw, _ := os.ReadFile(filepath.Join(l.root, image.Dockerfile))
+			var builder strings.Builder
+			builder.Write(raw)
+			for _, value := range image.BuildArgs {
+				builder.WriteByte('\n')
+				builder.WriteString(value)
+			}
+			buildUsage[key] = builder.String()
+		}
+	}
+
+	names := make([]string, 0, len(images))
+	for name := range images {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		envKey := ImageEnvVar(name)
+		if envKey == "" || envKey == AgentRunnerImageEnvVar || strings.Contains(usage, envKey) {
+			continue
+		}
+		if mirrorConsumedByBuild(images[name], buildUsage) {
+			continue
+		}
+		l.add(LintRuleUnusedImage, LintSeverityWarning, fmt.Sprintf("spec.images[%s]", name), l.configFile,
+			fmt.Sprintf("image %q is not referenced by manifest templates (%s) or build Dockerfiles", name, envKey), nil)
+	}
+}
+
+// mirrorConsumedByBuild reports whether local mirror of external image is used as base by any build image.
+// Dockerfiles pin mirror refs either as full `repo:tag` or as separate repository and version args.
+func mirrorConsumedByBuild(image Image, buildUsage map[string]string) bool {
+	local := strings.TrimSpace(image.Local)
+	if strings.TrimSpace(image.Type) != "external" || local == "" {
+		return false
+	}
+	if _, path, ok := strings.Cut(local, "/"); ok {
+		local = path
+	}
+	repository, tag := local, ""
+	if idx := strings.LastIndex(local, ":"); idx > strings.LastIndex(local, "/") {
+		repository, tag = local[:idx], local[idx+1:]
+	}
+	for _, text := range buildUsage {
+		if strings.Contains(text, repository) && (tag == "" || strings.Contains(text, tag)) {
+			return true
+		}
+	}
+	return false
+}
+
+// add records finding, merging identical findings from different targets.
+func (l *linter) add(rule string, severity LintSeverity, location string, file string, message string, targets []string) {
+	key := strings.Join([]string{rule, location, file, message}, "\x00")
+	if idx, ok := l.index[key]; ok {
+		for _, target := range targets {
+			if !containsString(l.findings[idx].Targets, target) {
+				l.findings[idx].Targets = append(l.findings[idx].Targets, target)
+			}
+		}
+		return
+	}
+	l.index[key] = len(l.findings)
+	l.findings = append(l.findings, LintFinding{
+		Rule:     rule,
+		Severity: severity,
+		Location: location,
+		File:     file,
+		Message:  message,
+		Targets:  append([]string(nil), targets...),
+	})
+}
+
+func (l *linter) sorted() []LintFinding {
+	out := append([]LintFinding(nil), l.findings...)
+	sort.SliceStable(out, func(i, j int) bool {
+		if out[i].Severity != out[j].Severity {
+			return out[i].Severity == LintSeverityError
+		}
+		if out[i].Rule != out[j].Rule {
+			return out[i].Rule < out[j].Rule
+		}
+		return out[i].Location < out[j].Location
+	})
+	return out
+}
+
+func relativeToRoot(root string, path string) string {
+	rel, err := filepath.Rel(root, path)
+	if err != nil || strings.HasPrefix(rel, "..") {
+		return filepath.ToSlash(path)
+	}
+	return filepath.ToSlash(rel)
+}
+
+func containsString(values []string, value string) bool {
+	for _, item := range values {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
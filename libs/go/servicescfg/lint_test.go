@@ -0,0 +1,198 @@
+package servicescfg
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLint_CollectsAllProblemsAcrossEnvironments(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+	rootFile := filepath.Join(tmpDir, "services.yaml")
+	writeFile(t, filepath.Join(tmpDir, "api.yaml.tpl"), "image: ${KODEX_API_IMAGE}\n")
+	writeFile(t, rootFile, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  versions:
+    api:
+      value: "1.0.0"
+      bumpOn: [services/api]
+    orphan:
+      value: "2.0.0"
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-production"
+    ai:
+      from: production
+      namespaceTemplate: "{{ .Project }}-dev-{{ .Slot }}"
+  images:
+    api:
+      type: external
+      from: 'api:{{ index .Versions "api" }}'
+    unused:
+      type: external
+      from: "busybox:1"
+  orchestration:
+    deployOrder: [internal]
+  infrastructure:
+    - name: database
+      dependsOn: [cache]
+      manifests:
+        - path: deploy/database.yaml
+    - name: cache
+      dependsOn: [database]
+  services:
+    - name: api
+      deployGroup: edge
+      dependsOn: [database, missing]
+      manifests:
+        - path: api.yaml.tpl
+    - name: worker
+      use: [unknown-component]
+      when: '{{ if eq .Env "ai" }}maybe{{ end }}'
+`)
+
+	report, err := Lint(rootFile, LintOptions{SlotSamples: []int{1, 2}})
+	if err != nil {
+		t.Fatalf("Lint() error = %v", err)
+	}
+
+	gotTargets := make([]string, 0, len(report.Targets))
+	for _, target := range report.Targets {
+		gotTargets = append(gotTargets, target.Label())
+	}
+	if got, want := strings.Join(gotTargets, ","), "ai[slot=1],ai[slot=2],production"; got != want {
+		t.Fatalf("targets = %q, want %q", got, want)
+	}
+
+	wantRules := map[string]string{
+		LintRuleMissingManifest:   "spec.infrastructure[database].manifests[0]",
+		LintRuleUnknownDependency: "spec.services[api].dependsOn",
+		LintRuleUnknownComponent:  "spec.services[worker].use",
+		LintRuleDependencyCycle:   "spec.infrastructure[cache].dependsOn",
+		LintRuleDeployOrder:       "spec.services[api].deployGroup",
+		LintRuleMissingBumpPath:   "spec.versions[api].bumpOn",
+		LintRuleUnusedVersion:     "spec.versions[orphan]",
+		LintRuleUnusedImage:       "spec.images[unused]",
+		LintRuleTemplate:          "spec.services[worker].when",
+	}
+	for rule, location := range wantRules {
+		finding, ok := findLintFinding(report, rule, location)
+		if !ok {
+			t.Fatalf("missing %s finding at %s; findings = %+v", rule, location, report.Findings)
+		}
+		if rule == LintRuleTemplate {
+			if got, want := strings.Join(finding.Targets, ","), "ai[slot=1],ai[slot=2]"; got != want {
+				t.Fatalf("when finding targets = %q, want %q", got, want)
+			}
+		}
+	}
+	if _, ok := findLintFinding(report, LintRuleUnusedImage, "spec.images[api]"); ok {
+		t.Fatal("image referenced by manifest template must not be reported as unused")
+	}
+
+	missing, _ := findLintFinding(report, LintRuleMissingManifest, "spec.infrastructure[database].manifests[0]")
+	if got, want := len(missing.Targets), 3; got != want {
+		t.Fatalf("missing manifest targets = %v, want deduplicated across %d targets", missing.Targets, want)
+	}
+	if !report.HasErrors() {
+		t.Fatal("expected report to contain errors")
+	}
+	if report.Findings[0].Severity != LintSeverityError || report.Findings[len(report.Findings)-1].Severity != LintSeverityWarning {
+		t.Fatalf("findings must be sorted errors first: %+v", report.Findings)
+	}
+}
+
+func TestLint_ReportsTemplateErrorsPerTarget(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+	rootFile := filepath.Join(tmpDir, "services.yaml")
+	writeFile(t, filepath.Join(tmpDir, "broken.yaml.tpl"), "value: {{ .Vars.MISSING \n")
+	writeFile(t, rootFile, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-production"
+    dev:
+      namespaceTemplate: "{{ .Project }}-dev"
+  services:
+    - name: api
+      manifests:
+        - path: broken.yaml.tpl
+`)
+
+	report, err := Lint(rootFile, LintOptions{Environments: []string{"dev", "production", "staging"}})
+	if err != nil {
+		t.Fatalf("Lint() error = %v", err)
+	}
+
+	template, ok := findLintFinding(report, LintRuleTemplate, "spec.services[api].manifests[0]")
+	if !ok {
+		t.Fatalf("missing manifest template finding; findings = %+v", report.Findings)
+	}
+	if got, want := template.File, "broken.yaml.tpl"; got != want {
+		t.Fatalf("template finding file = %q, want %q", got, want)
+	}
+	if _, ok := findLintFinding(report, LintRuleTemplate, "spec.environments[staging]"); !ok {
+		t.Fatalf("missing unknown environment finding; findings = %+v", report.Findings)
+	}
+}
+
+func TestLint_CleanConfig(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+	rootFile := filepath.Join(tmpDir, "services.yaml")
+	writeFile(t, filepath.Join(tmpDir, "api.yaml.tpl"), "image: ${KODEX_API_IMAGE}\n")
+	writeFile(t, rootFile, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  versions:
+    api:
+      value: "1.0.0"
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-production"
+  images:
+    api:
+      type: external
+      from: 'api:{{ index .Versions "api" }}'
+  orchestration:
+    deployOrder: [internal]
+  services:
+    - name: api
+      deployGroup: internal
+      manifests:
+        - path: api.yaml.tpl
+`)
+
+	report, err := Lint(rootFile, LintOptions{})
+	if err != nil {
+		t.Fatalf("Lint() error = %v", err)
+	}
+	if len(report.Findings) != 0 {
+		t.Fatalf("findings = %+v, want none", report.Findings)
+	}
+}
+
+func findLintFinding(report LintReport, rule string, location string) (LintFinding, bool) {
+	for _, finding := range report.Findings {
+		if finding.Rule == rule && finding.Location == location {
+			return finding, true
+		}
+	}
+	return LintFinding{}, false
+}
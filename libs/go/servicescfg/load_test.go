@@ -3,8 +3,12 @@ package servicescfg
 import (
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
+
+	rundomain "github.com/codex-k8s/kodex/libs/go/domain/run"
 )
 
 func TestLoad_WithImportsComponentsAndTemplates(t *testing.T) {
@@ -294,6 +298,105 @@ spec:
 `, "namespaceTTLByRole")
 }
 
+func TestLoad_WebhookRuntimeResourceProfiles(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "services.yaml")
+	writeFile(t, path, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-production"
+  webhookRuntime:
+    resourceProfiles:
+      default:
+        requests:
+          cpu: 250m
+          memory: 512Mi
+      Heavy:
+        limits:
+          cpu: "4"
+          memory: 8Gi
+          ephemeralStorage: 20Gi
+        quota:
+          pods: 40
+          limitsCpu: "16"
+        nodeSelector:
+          kodex.works/pool: heavy
+        tolerations:
+          - key: kodex.works/pool
+            operator: Equal
+            value: heavy
+            effect: NoSchedule
+    resourceProfileByRole:
+      QA: heavy
+`)
+
+	result, err := Load(path, LoadOptions{Env: "production"})
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	runtime := result.Stack.Spec.WebhookRuntime
+
+	name, profile := ResolveResourceProfile(runtime, "qa")
+	if name != "heavy" {
+		t.Fatalf("unexpected qa profile name: %q", name)
+	}
+	if got, want := profile.Quota.Pods, int64(40); got != want {
+		t.Fatalf("unexpected heavy quota pods: got %d want %d", got, want)
+	}
+	if got, want := profile.NodeSelector["kodex.works/pool"], "heavy"; got != want {
+		t.Fatalf("unexpected heavy node selector: got %q want %q", got, want)
+	}
+	if len(profile.Tolerations) != 1 || profile.Tolerations[0].Effect != "NoSchedule" {
+		t.Fatalf("unexpected heavy tolerations: %+v", profile.Tolerations)
+	}
+
+	name, profile = ResolveResourceProfile(runtime, "dev")
+	if name != "default" || profile.Requests.CPU != "250m" {
+		t.Fatalf("expected dev role to fall back to default profile, got %q %+v", name, profile)
+	}
+}
+
+func TestLoad_WebhookRuntimeResourceProfilesValidation(t *testing.T) {
+	t.Parallel()
+
+	assertLoadErrorContains(t, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-production"
+  webhookRuntime:
+    resourceProfiles:
+      default:
+        limits:
+          memory: lots
+`, "limits.memory")
+
+	assertLoadErrorContains(t, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-production"
+  webhookRuntime:
+    resourceProfileByRole:
+      dev: missing
+`, "unknown profile")
+}
+
 func TestLoad_SecretResolutionContract(t *testing.T) {
 	t.Parallel()
 
@@ -821,3 +924,252 @@ func assertLoadErrorContains(t *testing.T, content string, wantSubstring string)
 		t.Fatalf("unexpected error: %v", err)
 	}
 }
+
+func TestLoad_ManifestPolicyContract(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "services.yaml")
+	writeFile(t, path, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-prod"
+  manifestPolicy:
+    mode: warn
+    environmentModes:
+      Production: block
+    rules:
+      no-privileged:
+        mode: block
+        environments:
+          ai: warn
+    allowedRegistries: [ghcr.io/acme/]
+    customRules:
+      - name: no-latest-tag
+        path: spec.template.spec.containers[].image
+        operator: notMatches
+        value: ":latest$"
+`)
+
+	result, err := Load(path, LoadOptions{Env: "production"})
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	policy := result.Stack.Spec.ManifestPolicy
+	if got, want := policy.AllowedRegistries, []string{"ghcr.io/acme"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("unexpected allowed registries: got %#v want %#v", got, want)
+	}
+	cases := []struct {
+		rule string
+		env  string
+		want ManifestPolicyMode
+	}{
+		{rule: ManifestPolicyRuleNoPrivileged, env: "ai", want: ManifestPolicyModeWarn},
+		{rule: ManifestPolicyRuleNoPrivileged, env: "staging", want: ManifestPolicyModeBlock},
+		{rule: ManifestPolicyRuleResourceLimits, env: "production", want: ManifestPolicyModeBlock},
+		{rule: ManifestPolicyRuleResourceLimits, env: "ai", want: ManifestPolicyModeWarn},
+		{rule: "no-latest-tag", env: "production", want: ManifestPolicyModeBlock},
+	}
+	for _, tc := range cases {
+		if got := policy.ResolveMode(tc.rule, tc.env); got != tc.want {
+			t.Fatalf("ResolveMode(%q, %q) = %q, want %q", tc.rule, tc.env, got, tc.want)
+		}
+	}
+}
+
+func TestLoad_ManifestPolicyRejectsUnknownBuiltinRule(t *testing.T) {
+	t.Parallel()
+
+	assertLoadErrorContains(t, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-prod"
+  manifestPolicy:
+    customRules:
+      - name: resource-limits
+        path: spec.replicas
+        operator: required
+`, "conflicts with built-in rule")
+}
+
+func TestLoad_WebhookRuntimeRetryPolicy(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "services.yaml")
+	writeFile(t, path, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-production"
+  webhookRuntime:
+    retryPolicy:
+      default:
+        maxAttempts: 3
+        initialBackoff: 30s
+      byTriggerKind:
+        Intake:
+          maxAttempts: 1
+`)
+
+	result, err := Load(path, LoadOptions{Env: "production"})
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+
+	fallback, byKind := ResolveRunRetryPolicies(result.Stack.Spec.WebhookRuntime)
+	if fallback.MaxAttempts != 3 || fallback.InitialBackoff != 30*time.Second || fallback.MaxBackoff != rundomain.DefaultRetryPolicy.MaxBackoff {
+		t.Fatalf("unexpected default retry policy: %+v", fallback)
+	}
+	intake, ok := byKind["intake"]
+	if !ok || intake.MaxAttempts != 1 || intake.InitialBackoff != 30*time.Second {
+		t.Fatalf("unexpected intake retry policy: %+v (found=%t)", intake, ok)
+	}
+
+	assertLoadErrorContains(t, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-production"
+  webhookRuntime:
+    retryPolicy:
+      default:
+        initialBackoff: soon
+`, "initialBackoff")
+}
+
+func TestLoad_AgentToolsContract(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "services.yaml")
+	writeFile(t, path, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-prod"
+  agentTools:
+    mcpServers:
+      - name: Feature-Flags
+        command: npx
+        args: ["-y", "@acme/flags-mcp"]
+        env:
+          FLAGS_ENV: staging
+        roles: [Dev, sre]
+    httpTools:
+      - name: testdata_generate
+        description: Generate test fixtures
+        url: http://testdata.acme.svc:8080/generate
+        category: write
+        approval: owner
+`)
+
+	result, err := Load(path, LoadOptions{Env: "production"})
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	tools := result.Stack.Spec.AgentTools
+	server := tools.MCPServers[0]
+	if server.Name != "feature-flags" || server.Category != AgentToolCategoryRead {
+		t.Fatalf("unexpected normalized server: %#v", server)
+	}
+	if !server.AllowsRole("DEV") || server.AllowsRole("qa") {
+		t.Fatalf("unexpected server role allowlist: %#v", server.Roles)
+	}
+	tool := tools.HTTPTools[0]
+	if tool.TimeoutSeconds != AgentHTTPToolDefaultTimeoutSeconds || tool.Approval != AgentToolApprovalOwner {
+		t.Fatalf("unexpected normalized http tool: %#v", tool)
+	}
+	if !tool.AllowsRole("qa") {
+		t.Fatal("expected tool without roles to be available for every role")
+	}
+}
+
+func TestLoad_AgentToolsValidation(t *testing.T) {
+	t.Parallel()
+
+	header := `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-prod"
+  agentTools:
+`
+	cases := []struct {
+		name string
+		body string
+		want string
+	}{
+		{
+			name: "reserved server name",
+			body: `
+    mcpServers:
+      - name: kodex
+        url: http://example.com/mcp
+`,
+			want: "reserved by platform",
+		},
+		{
+			name: "command and url",
+			body: `
+    mcpServers:
+      - name: flags
+        command: npx
+        url: http://example.com/mcp
+`,
+			want: "mutually exclusive",
+		},
+		{
+			name: "http tool scheme",
+			body: `
+    httpTools:
+      - name: flags
+        description: Toggle flag
+        url: ftp://flags.acme
+`,
+			want: "scheme must be http or https",
+		},
+		{
+			name: "http tool timeout",
+			body: `
+    httpTools:
+      - name: flags
+        description: Toggle flag
+        url: http://flags.acme
+        timeoutSeconds: 600
+`,
+			want: "timeoutSeconds must be in range",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assertLoadErrorContains(t, header+strings.TrimPrefix(tc.body, "\n"), tc.want)
+		})
+	}
+}
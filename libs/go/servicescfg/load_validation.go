@@ -95,6 +95,12 @@ func normalizeAndValidate(stack *Stack, env string) error {
 		}
 		stack.Spec.WebhookRuntime.NamespaceTTLByRole = normalizedNamespaceTTLByRole
 	}
+	if err := normalizeAndValidateResourceProfiles(&stack.Spec.WebhookRuntime); err != nil {
+		return err
+	}
+	if err := normalizeAndValidateRetryPolicy(&stack.Spec.WebhookRuntime); err != nil {
+		return err
+	}
 
 	normalizedVersions, err := normalizeVersions(stack.Spec.Versions)
 	if err != nil {
@@ -105,6 +111,12 @@ func normalizeAndValidate(stack *Stack, env string) error {
 	if err := validateSecretResolution(stack.Spec.SecretResolution); err != nil {
 		return err
 	}
+	if err := normalizeAndValidateManifestPolicy(&stack.Spec.ManifestPolicy); err != nil {
+		return err
+	}
+	if err := normalizeAndValidateAgentTools(&stack.Spec.AgentTools); err != nil {
+		return err
+	}
 
 	seenServices := make(map[string]struct{})
 	for i := range stack.Spec.Services {
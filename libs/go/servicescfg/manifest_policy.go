@@ -0,0 +1,276 @@
+package servicescfg
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// ManifestPolicyMode controls how violations of one manifest policy rule are handled.
+type ManifestPolicyMode string
+
+const (
+	// ManifestPolicyModeOff disables rule evaluation.
+	ManifestPolicyModeOff ManifestPolicyMode = "off"
+	// ManifestPolicyModeWarn reports violations without stopping apply.
+	ManifestPolicyModeWarn ManifestPolicyMode = "warn"
+	// ManifestPolicyModeBlock reports violations and stops apply.
+	ManifestPolicyModeBlock ManifestPolicyMode = "block"
+)
+
+// Built-in manifest policy rule names.
+const (
+	ManifestPolicyRuleResourceLimits    = "resource-limits"
+	ManifestPolicyRuleNoPrivileged      = "no-privileged"
+	ManifestPolicyRuleNoHostPath        = "no-host-path"
+	ManifestPolicyRuleAllowedRegistries = "allowed-registries"
+	ManifestPolicyRuleRequiredLabels    = "required-labels"
+	ManifestPolicyRuleNoLoadBalancer    = "no-load-balancer"
+)
+
+// BuiltinManifestPolicyRules lists built-in rule names in evaluation order.
+var BuiltinManifestPolicyRules = []string{
+	ManifestPolicyRuleResourceLimits,
+	ManifestPolicyRuleNoPrivileged,
+	ManifestPolicyRuleNoHostPath,
+	ManifestPolicyRuleAllowedRegistries,
+	ManifestPolicyRuleRequiredLabels,
+	ManifestPolicyRuleNoLoadBalancer,
+}
+
+// ManifestPolicyOperator defines how a project-defined rule checks field values.
+type ManifestPolicyOperator string
+
+const (
+	ManifestPolicyOperatorRequired   ManifestPolicyOperator = "required"
+	ManifestPolicyOperatorForbidden  ManifestPolicyOperator = "forbidden"
+	ManifestPolicyOperatorEquals     ManifestPolicyOperator = "equals"
+	ManifestPolicyOperatorNotEquals  ManifestPolicyOperator = "notEquals"
+	ManifestPolicyOperatorMatches    ManifestPolicyOperator = "matches"
+	ManifestPolicyOperatorNotMatches ManifestPolicyOperator = "notMatches"
+)
+
+// ManifestPolicy configures checks evaluated on rendered manifests before apply.
+type ManifestPolicy struct {
+	// Mode is the default mode for every rule; warn when omitted.
+	Mode ManifestPolicyMode `yaml:"mode,omitempty"`
+	// EnvironmentModes overrides default mode per environment.
+	EnvironmentModes map[string]ManifestPolicyMode `yaml:"environmentModes,omitempty"`
+	// Rules overrides mode of built-in rules by rule name.
+	Rules map[string]ManifestPolicyRuleMode `yaml:"rules,omitempty"`
+	// AllowedRegistries enables allowed-registries rule when not empty.
+	AllowedRegistries []string `yaml:"allowedRegistries,omitempty"`
+	// RequiredLabels enables required-labels rule when not empty.
+	RequiredLabels []string `yaml:"requiredLabels,omitempty"`
+	// LoadBalancerEnvironments lists environments where Service type LoadBalancer is allowed; production when omitted.
+	LoadBalancerEnvironments []string `yaml:"loadBalancerEnvironments,omitempty"`
+	// CustomRules declares project-defined field checks.
+	CustomRules []ManifestPolicyCustomRule `yaml:"customRules,omitempty"`
+}
+
+// ManifestPolicyRuleMode configures mode of one rule with optional per-environment overrides.
+type ManifestPolicyRuleMode struct {
+	Mode         ManifestPolicyMode            `yaml:"mode,omitempty"`
+	Environments map[string]ManifestPolicyMode `yaml:"environments,omitempty"`
+}
+
+// ManifestPolicyCustomRule is a project-defined check of one field path.
+//
+// Path is dotted; `[]` iterates list items, for example `spec.template.spec.containers[].image`.
+type ManifestPolicyCustomRule struct {
+	Name                   string                 `yaml:"name"`
+	Description            string                 `yaml:"description,omitempty"`
+	Kinds                  []string               `yaml:"kinds,omitempty"`
+	Path                   string                 `yaml:"path"`
+	Operator               ManifestPolicyOperator `yaml:"operator"`
+	Value                  string                 `yaml:"value,omitempty"`
+	ManifestPolicyRuleMode `yaml:",inline"`
+}
+
+// NormalizeManifestPolicyMode validates and normalizes manifest policy mode values.
+func NormalizeManifestPolicyMode(value ManifestPolicyMode) (ManifestPolicyMode, error) {
+	v := ManifestPolicyMode(strings.TrimSpace(strings.ToLower(string(value))))
+	switch v {
+	case "":
+		return "", nil
+	case ManifestPolicyModeOff, ManifestPolicyModeWarn, ManifestPolicyModeBlock:
+		return v, nil
+	default:
+		return "", fmt.Errorf("unsupported manifest policy mode %q", value)
+	}
+}
+
+// ResolveMode returns effective mode of the named rule in the given environment.
+// Resolution order: rule environment override, rule mode, policy environment override, policy mode, warn.
+func (p ManifestPolicy) ResolveMode(ruleName string, env string) ManifestPolicyMode {
+	envKey := normalizeEnvName(env)
+	if rule, ok := p.ruleMode(ruleName); ok {
+		if mode := rule.Environments[envKey]; mode != "" {
+			return mode
+		}
+		if rule.Mode != "" {
+			return rule.Mode
+		}
+	}
+	if mode := p.EnvironmentModes[envKey]; mode != "" {
+		return mode
+	}
+	if p.Mode != "" {
+		return p.Mode
+	}
+	return ManifestPolicyModeWarn
+}
+
+func (p ManifestPolicy) ruleMode(ruleName string) (ManifestPolicyRuleMode, bool) {
+	name := strings.TrimSpace(ruleName)
+	if rule, ok := p.Rules[name]; ok {
+		return rule, true
+	}
+	for _, custom := range p.CustomRules {
+		if custom.Name == name {
+			return custom.ManifestPolicyRuleMode, true
+		}
+	}
+	return ManifestPolicyRuleMode{}, false
+}
+
+func normalizeAndValidateManifestPolicy(policy *ManifestPolicy) error {
+	mode, err := NormalizeManifestPolicyMode(policy.Mode)
+	if err != nil {
+		return fmt.Errorf("spec.manifestPolicy.mode: %w", err)
+	}
+	policy.Mode = mode
+
+	environmentModes, err := normalizeManifestPolicyEnvironmentModes(policy.EnvironmentModes, "spec.manifestPolicy.environmentModes")
+	if err != nil {
+		return err
+	}
+	policy.EnvironmentModes = environmentModes
+
+	builtin := make(map[string]struct{}, len(BuiltinManifestPolicyRules))
+	for _, name := range BuiltinManifestPolicyRules {
+		builtin[name] = struct{}{}
+	}
+	if len(policy.Rules) > 0 {
+		rules := make(map[string]ManifestPolicyRuleMode, len(policy.Rules))
+		for rawName, rule := range policy.Rules {
+			name := strings.TrimSpace(rawName)
+			if _, ok := builtin[name]; !ok {
+				return fmt.Errorf("spec.manifestPolicy.rules: unknown built-in rule %q", rawName)
+			}
+			normalized, err := normalizeManifestPolicyRuleMode(rule, fmt.Sprintf("spec.manifestPolicy.rules[%q]", name))
+			if err != nil {
+				return err
+			}
+			rules[name] = normalized
+		}
+		policy.Rules = rules
+	}
+
+	policy.AllowedRegistries = normalizeManifestPolicyList(policy.AllowedRegistries, func(value string) string {
+		return strings.TrimSuffix(value, "/")
+	})
+	policy.RequiredLabels = normalizeManifestPolicyList(policy.RequiredLabels, nil)
+	policy.LoadBalancerEnvironments = normalizeManifestPolicyList(policy.LoadBalancerEnvironments, normalizeEnvName)
+
+	seen := make(map[string]struct{}, len(policy.CustomRules))
+	for idx := range policy.CustomRules {
+		rule := &policy.CustomRules[idx]
+		field := fmt.Sprintf("spec.manifestPolicy.customRules[%d]", idx)
+		rule.Name = strings.TrimSpace(rule.Name)
+		if rule.Name == "" {
+			return fmt.Errorf("%s.name is required", field)
+		}
+		if _, ok := builtin[rule.Name]; ok {
+			return fmt.Errorf("%s.name %q conflicts with built-in rule", field, rule.Name)
+		}
+		if _, ok := seen[rule.Name]; ok {
+			return fmt.Errorf("duplicate spec.manifestPolicy.customRules name %q", rule.Name)
+		}
+		seen[rule.Name] = struct{}{}
+
+		rule.Description = strings.TrimSpace(rule.Description)
+		rule.Kinds = normalizeManifestPolicyList(rule.Kinds, nil)
+		rule.Path = strings.TrimSpace(rule.Path)
+		if rule.Path == "" {
+			return fmt.Errorf("%s.path is required", field)
+		}
+		for _, segment := range strings.Split(rule.Path, ".") {
+			if strings.TrimSuffix(segment, "[]") == "" {
+				return fmt.Errorf("%s.path %q contains empty segment", field, rule.Path)
+			}
+		}
+		switch rule.Operator {
+		case ManifestPolicyOperatorRequired, ManifestPolicyOperatorForbidden:
+		case ManifestPolicyOperatorEquals, ManifestPolicyOperatorNotEquals:
+		case ManifestPolicyOperatorMatches, ManifestPolicyOperatorNotMatches:
+			if _, err := regexp.Compile(rule.Value); err != nil {
+				return fmt.Errorf("%s.value: compile regexp: %w", field, err)
+			}
+		default:
+			return fmt.Errorf("%s.operator: unsupported operator %q", field, rule.Operator)
+		}
+		normalized, err := normalizeManifestPolicyRuleMode(rule.ManifestPolicyRuleMode, field)
+		if err != nil {
+			return err
+		}
+		rule.ManifestPolicyRuleMode = normalized
+	}
+	return nil
+}
+
+func normalizeManifestPolicyRuleMode(rule ManifestPolicyRuleMode, field string) (ManifestPolicyRuleMode, error) {
+	mode, err := NormalizeManifestPolicyMode(rule.Mode)
+	if err != nil {
+		return ManifestPolicyRuleMode{}, fmt.Errorf("%s.mode: %w", field, err)
+	}
+	environments, err := normalizeManifestPolicyEnvironmentModes(rule.Environments, field+".environments")
+	if err != nil {
+		return ManifestPolicyRuleMode{}, err
+	}
+	return ManifestPolicyRuleMode{Mode: mode, Environments: environments}, nil
+}
+
+func normalizeManifestPolicyEnvironmentModes(values map[string]ManifestPolicyMode, field string) (map[string]ManifestPolicyMode, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	out := make(map[string]ManifestPolicyMode, len(values))
+	for rawEnv, rawMode := range values {
+		env := normalizeEnvName(rawEnv)
+		if env == "" {
+			return nil, fmt.Errorf("%s contains empty environment key", field)
+		}
+		mode, err := NormalizeManifestPolicyMode(rawMode)
+		if err != nil {
+			return nil, fmt.Errorf("%s[%q]: %w", field, rawEnv, err)
+		}
+		if mode != "" {
+			out[env] = mode
+		}
+	}
+	return out, nil
+}
+
+func normalizeManifestPolicyList(values []string, normalize func(string) string) []string {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]string, 0, len(values))
+	seen := make(map[string]struct{}, len(values))
+	for _, raw := range values {
+		value := strings.TrimSpace(raw)
+		if normalize != nil {
+			value = normalize(value)
+		}
+		if value == "" {
+			continue
+		}
+		if _, ok := seen[value]; ok {
+			continue
+		}
+		seen[value] = struct{}{}
+		out = append(out, value)
+	}
+	return out
+}
@@ -3,6 +3,8 @@ package servicescfg
 import (
 	"fmt"
 	"strings"
+
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
 )
 
 const (
@@ -109,6 +111,8 @@ type Spec struct {
 	Infrastructure   []InfrastructureItem            `yaml:"infrastructure,omitempty"`
 	Services         []Service                       `yaml:"services,omitempty"`
 	Orchestration    Orchestration                   `yaml:"orchestration,omitempty"`
+	ManifestPolicy   ManifestPolicy                  `yaml:"manifestPolicy,omitempty"`
+	AgentTools       AgentTools                      `yaml:"agentTools,omitempty"`
 }
 
 // ImportRef points to reusable services.yaml fragment.
@@ -143,6 +147,25 @@ type WebhookRuntime struct {
 	TriggerModes        map[string]RuntimeMode `yaml:"triggerModes,omitempty"`
 	DefaultNamespaceTTL string                 `yaml:"defaultNamespaceTTL,omitempty"`
 	NamespaceTTLByRole  map[string]string      `yaml:"namespaceTTLByRole,omitempty"`
+	// ResourceProfiles declares named run pod/namespace resource profiles; `default` applies to unbound roles.
+	ResourceProfiles map[string]agentdomain.ResourceProfile `yaml:"resourceProfiles,omitempty"`
+	// ResourceProfileByRole binds agent role keys to profile names.
+	ResourceProfileByRole map[string]string `yaml:"resourceProfileByRole,omitempty"`
+	// RetryPolicy configures automatic retries of infrastructure-caused run failures.
+	RetryPolicy *RunRetryPolicy `yaml:"retryPolicy,omitempty"`
+}
+
+// RunRetryPolicy declares default and per-trigger retry rules for infrastructure failures.
+type RunRetryPolicy struct {
+	Default       RunRetryRule            `yaml:"default,omitempty"`
+	ByTriggerKind map[string]RunRetryRule `yaml:"byTriggerKind,omitempty"`
+}
+
+// RunRetryRule declares attempts budget and exponential backoff for one trigger kind.
+type RunRetryRule struct {
+	MaxAttempts    int    `yaml:"maxAttempts,omitempty"`
+	InitialBackoff string `yaml:"initialBackoff,omitempty"`
+	MaxBackoff     string `yaml:"maxBackoff,omitempty"`
 }
 
 // SecretResolution configures environment-scoped secret override strategy.
@@ -0,0 +1,124 @@
+package servicescfg
+
+import (
+	"fmt"
+	"strings"
+
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+var supportedTolerationOperators = map[string]struct{}{
+	"":       {},
+	"Exists": {},
+	"Equal":  {},
+}
+
+var supportedTolerationEffects = map[string]struct{}{
+	"":                 {},
+	"NoSchedule":       {},
+	"PreferNoSchedule": {},
+	"NoExecute":        {},
+}
+
+// ValidateResourceProfile checks quantities and scheduling fields of one resource profile.
+func ValidateResourceProfile(profile agentdomain.ResourceProfile, field string) error {
+	quantities := [][2]string{
+		{"requests.cpu", profile.Requests.CPU},
+		{"requests.memory", profile.Requests.Memory},
+		{"requests.ephemeralStorage", profile.Requests.EphemeralStorage},
+		{"limits.cpu", profile.Limits.CPU},
+		{"limits.memory", profile.Limits.Memory},
+		{"limits.ephemeralStorage", profile.Limits.EphemeralStorage},
+		{"quota.requestsCpu", profile.Quota.RequestsCPU},
+		{"quota.requestsMemory", profile.Quota.RequestsMemory},
+		{"quota.limitsCpu", profile.Quota.LimitsCPU},
+		{"quota.limitsMemory", profile.Quota.LimitsMemory},
+		{"quota.ephemeralStorage", profile.Quota.EphemeralStorage},
+	}
+	for _, item := range quantities {
+		key := item[0]
+		value := strings.TrimSpace(item[1])
+		if value == "" {
+			continue
+		}
+		if _, err := resource.ParseQuantity(value); err != nil {
+			return fmt.Errorf("%s.%s: parse quantity %q: %w", field, key, value, err)
+		}
+	}
+	if profile.Quota.Pods < 0 {
+		return fmt.Errorf("%s.quota.pods must be >= 0", field)
+	}
+	for key := range profile.NodeSelector {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("%s.nodeSelector contains empty key", field)
+		}
+	}
+	for idx, toleration := range profile.Tolerations {
+		operator := strings.TrimSpace(toleration.Operator)
+		if _, ok := supportedTolerationOperators[operator]; !ok {
+			return fmt.Errorf("%s.tolerations[%d].operator: unsupported operator %q", field, idx, toleration.Operator)
+		}
+		if _, ok := supportedTolerationEffects[strings.TrimSpace(toleration.Effect)]; !ok {
+			return fmt.Errorf("%s.tolerations[%d].effect: unsupported effect %q", field, idx, toleration.Effect)
+		}
+		if operator == "Exists" && strings.TrimSpace(toleration.Value) != "" {
+			return fmt.Errorf("%s.tolerations[%d].value must be empty for operator Exists", field, idx)
+		}
+	}
+	return nil
+}
+
+// ResolveResourceProfile returns profile bound to role, falling back to the default profile.
+// Empty name means no profile is configured for the role.
+func ResolveResourceProfile(runtime WebhookRuntime, role string) (string, agentdomain.ResourceProfile) {
+	name := runtime.ResourceProfileByRole[strings.ToLower(strings.TrimSpace(role))]
+	if name == "" {
+		name = agentdomain.DefaultResourceProfileName
+	}
+	profile, ok := runtime.ResourceProfiles[name]
+	if !ok {
+		return "", agentdomain.ResourceProfile{}
+	}
+	return name, profile
+}
+
+func normalizeAndValidateResourceProfiles(runtime *WebhookRuntime) error {
+	if len(runtime.ResourceProfiles) > 0 {
+		profiles := make(map[string]agentdomain.ResourceProfile, len(runtime.ResourceProfiles))
+		for rawName, profile := range runtime.ResourceProfiles {
+			name := agentdomain.NormalizeResourceProfileName(rawName)
+			if name == "" {
+				return fmt.Errorf("spec.webhookRuntime.resourceProfiles contains empty profile name")
+			}
+			if _, exists := profiles[name]; exists {
+				return fmt.Errorf("spec.webhookRuntime.resourceProfiles contains duplicate profile name %q", name)
+			}
+			if err := ValidateResourceProfile(profile, fmt.Sprintf("spec.webhookRuntime.resourceProfiles[%q]", name)); err != nil {
+				return err
+			}
+			profiles[name] = profile
+		}
+		runtime.ResourceProfiles = profiles
+	}
+
+	if len(runtime.ResourceProfileByRole) > 0 {
+		byRole := make(map[string]string, len(runtime.ResourceProfileByRole))
+		for rawRole, rawName := range runtime.ResourceProfileByRole {
+			role := strings.ToLower(strings.TrimSpace(rawRole))
+			if role == "" {
+				return fmt.Errorf("spec.webhookRuntime.resourceProfileByRole contains empty role key")
+			}
+			if _, exists := byRole[role]; exists {
+				return fmt.Errorf("spec.webhookRuntime.resourceProfileByRole contains duplicate role key %q", role)
+			}
+			name := agentdomain.NormalizeResourceProfileName(rawName)
+			if _, ok := runtime.ResourceProfiles[name]; !ok {
+				return fmt.Errorf("spec.webhookRuntime.resourceProfileByRole[%q] references unknown profile %q", rawRole, rawName)
+			}
+			byRole[role] = name
+		}
+		runtime.ResourceProfileByRole = byRole
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package servicescfg
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	rundomain "github.com/codex-k8s/kodex/libs/go/domain/run"
+)
+
+const maxRunRetryAttempts = 10
+
+// ResolveRunRetryPolicies converts validated retry policy config into domain policies.
+// Without `retryPolicy` the platform default is returned; unset rule fields inherit the default rule.
+func ResolveRunRetryPolicies(runtime WebhookRuntime) (rundomain.RetryPolicy, map[string]rundomain.RetryPolicy) {
+	byTriggerKind := make(map[string]rundomain.RetryPolicy)
+	if runtime.RetryPolicy == nil {
+		return rundomain.DefaultRetryPolicy, byTriggerKind
+	}
+
+	fallback := applyRunRetryRule(rundomain.DefaultRetryPolicy, runtime.RetryPolicy.Default)
+	for kind, rule := range runtime.RetryPolicy.ByTriggerKind {
+		byTriggerKind[kind] = applyRunRetryRule(fallback, rule)
+	}
+	return fallback, byTriggerKind
+}
+
+func applyRunRetryRule(base rundomain.RetryPolicy, rule RunRetryRule) rundomain.RetryPolicy {
+	out := base
+	if rule.MaxAttempts > 0 {
+		out.MaxAttempts = rule.MaxAttempts
+	}
+	if d, err := time.ParseDuration(strings.TrimSpace(rule.InitialBackoff)); err == nil && d > 0 {
+		out.InitialBackoff = d
+	}
+	if d, err := time.ParseDuration(strings.TrimSpace(rule.MaxBackoff)); err == nil && d > 0 {
+		out.MaxBackoff = d
+	}
+	return out
+}
+
+func normalizeAndValidateRetryPolicy(runtime *WebhookRuntime) error {
+	if runtime.RetryPolicy == nil {
+		return nil
+	}
+	if err := validateRunRetryRule(runtime.RetryPolicy.Default, "spec.webhookRuntime.retryPolicy.default"); err != nil {
+		return err
+	}
+	if len(runtime.RetryPolicy.ByTriggerKind) == 0 {
+		return nil
+	}
+
+	normalized := make(map[string]RunRetryRule, len(runtime.RetryPolicy.ByTriggerKind))
+	for rawKind, rule := range runtime.RetryPolicy.ByTriggerKind {
+		kind := strings.ToLower(strings.TrimSpace(rawKind))
+		if kind == "" {
+			return fmt.Errorf("spec.webhookRuntime.retryPolicy.byTriggerKind contains empty trigger kind")
+		}
+		if _, exists := normalized[kind]; exists {
+			return fmt.Errorf("spec.webhookRuntime.retryPolicy.byTriggerKind contains duplicate trigger kind %q", kind)
+		}
+		if err := validateRunRetryRule(rule, fmt.Sprintf("spec.webhookRuntime.retryPolicy.byTriggerKind[%q]", kind)); err != nil {
+			return err
+		}
+		normalized[kind] = rule
+	}
+	runtime.RetryPolicy.ByTriggerKind = normalized
+	return nil
+}
+
+func validateRunRetryRule(rule RunRetryRule, field string) error {
+	if rule.MaxAttempts < 0 || rule.MaxAttempts > maxRunRetryAttempts {
+		return fmt.Errorf("%s.maxAttempts must be between 1 and %d", field, maxRunRetryAttempts)
+	}
+	durations := [][2]string{
+		{"initialBackoff", rule.InitialBackoff},
+		{"maxBackoff", rule.MaxBackoff},
+	}
+	for _, item := range durations {
+		raw := strings.TrimSpace(item[1])
+		if raw == "" {
+			continue
+		}
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return fmt.Errorf("%s.%s: parse duration %q: %w", field, item[0], raw, err)
+		}
+		if d <= 0 {
+			return fmt.Errorf("%s.%s must be > 0", field, item[0])
+		}
+	}
+	return nil
+}
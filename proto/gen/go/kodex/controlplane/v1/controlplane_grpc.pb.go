@@ -20,90 +20,125 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ControlPlaneService_IngestGitHubWebhook_FullMethodName                  = "/kodex.controlplane.v1.ControlPlaneService/IngestGitHubWebhook"
-	ControlPlaneService_ResolveStaffByEmail_FullMethodName                  = "/kodex.controlplane.v1.ControlPlaneService/ResolveStaffByEmail"
-	ControlPlaneService_AuthorizeOAuthUser_FullMethodName                   = "/kodex.controlplane.v1.ControlPlaneService/AuthorizeOAuthUser"
-	ControlPlaneService_ListProjects_FullMethodName                         = "/kodex.controlplane.v1.ControlPlaneService/ListProjects"
-	ControlPlaneService_UpsertProject_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/UpsertProject"
-	ControlPlaneService_GetProject_FullMethodName                           = "/kodex.controlplane.v1.ControlPlaneService/GetProject"
-	ControlPlaneService_DeleteProject_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/DeleteProject"
-	ControlPlaneService_ListRuns_FullMethodName                             = "/kodex.controlplane.v1.ControlPlaneService/ListRuns"
-	ControlPlaneService_ListRunWaits_FullMethodName                         = "/kodex.controlplane.v1.ControlPlaneService/ListRunWaits"
-	ControlPlaneService_GetRun_FullMethodName                               = "/kodex.controlplane.v1.ControlPlaneService/GetRun"
-	ControlPlaneService_CancelRun_FullMethodName                            = "/kodex.controlplane.v1.ControlPlaneService/CancelRun"
-	ControlPlaneService_GetRunLogs_FullMethodName                           = "/kodex.controlplane.v1.ControlPlaneService/GetRunLogs"
-	ControlPlaneService_ListPendingApprovals_FullMethodName                 = "/kodex.controlplane.v1.ControlPlaneService/ListPendingApprovals"
-	ControlPlaneService_ResolveApprovalDecision_FullMethodName              = "/kodex.controlplane.v1.ControlPlaneService/ResolveApprovalDecision"
-	ControlPlaneService_ListRunEvents_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/ListRunEvents"
-	ControlPlaneService_ListRunLearningFeedback_FullMethodName              = "/kodex.controlplane.v1.ControlPlaneService/ListRunLearningFeedback"
-	ControlPlaneService_ListSystemSettings_FullMethodName                   = "/kodex.controlplane.v1.ControlPlaneService/ListSystemSettings"
-	ControlPlaneService_GetSystemSetting_FullMethodName                     = "/kodex.controlplane.v1.ControlPlaneService/GetSystemSetting"
-	ControlPlaneService_UpdateSystemSettingBoolean_FullMethodName           = "/kodex.controlplane.v1.ControlPlaneService/UpdateSystemSettingBoolean"
-	ControlPlaneService_ResetSystemSetting_FullMethodName                   = "/kodex.controlplane.v1.ControlPlaneService/ResetSystemSetting"
-	ControlPlaneService_ListUsers_FullMethodName                            = "/kodex.controlplane.v1.ControlPlaneService/ListUsers"
-	ControlPlaneService_CreateUser_FullMethodName                           = "/kodex.controlplane.v1.ControlPlaneService/CreateUser"
-	ControlPlaneService_DeleteUser_FullMethodName                           = "/kodex.controlplane.v1.ControlPlaneService/DeleteUser"
-	ControlPlaneService_ListProjectMembers_FullMethodName                   = "/kodex.controlplane.v1.ControlPlaneService/ListProjectMembers"
-	ControlPlaneService_UpsertProjectMember_FullMethodName                  = "/kodex.controlplane.v1.ControlPlaneService/UpsertProjectMember"
-	ControlPlaneService_DeleteProjectMember_FullMethodName                  = "/kodex.controlplane.v1.ControlPlaneService/DeleteProjectMember"
-	ControlPlaneService_SetProjectMemberLearningModeOverride_FullMethodName = "/kodex.controlplane.v1.ControlPlaneService/SetProjectMemberLearningModeOverride"
-	ControlPlaneService_ListProjectRepositories_FullMethodName              = "/kodex.controlplane.v1.ControlPlaneService/ListProjectRepositories"
-	ControlPlaneService_UpsertProjectRepository_FullMethodName              = "/kodex.controlplane.v1.ControlPlaneService/UpsertProjectRepository"
-	ControlPlaneService_DeleteProjectRepository_FullMethodName              = "/kodex.controlplane.v1.ControlPlaneService/DeleteProjectRepository"
-	ControlPlaneService_UpsertRepositoryBotParams_FullMethodName            = "/kodex.controlplane.v1.ControlPlaneService/UpsertRepositoryBotParams"
-	ControlPlaneService_RunRepositoryPreflight_FullMethodName               = "/kodex.controlplane.v1.ControlPlaneService/RunRepositoryPreflight"
-	ControlPlaneService_GetProjectGitHubTokens_FullMethodName               = "/kodex.controlplane.v1.ControlPlaneService/GetProjectGitHubTokens"
-	ControlPlaneService_UpsertProjectGitHubTokens_FullMethodName            = "/kodex.controlplane.v1.ControlPlaneService/UpsertProjectGitHubTokens"
-	ControlPlaneService_PreviewNextStepAction_FullMethodName                = "/kodex.controlplane.v1.ControlPlaneService/PreviewNextStepAction"
-	ControlPlaneService_ExecuteNextStepAction_FullMethodName                = "/kodex.controlplane.v1.ControlPlaneService/ExecuteNextStepAction"
-	ControlPlaneService_ListDocsetGroups_FullMethodName                     = "/kodex.controlplane.v1.ControlPlaneService/ListDocsetGroups"
-	ControlPlaneService_ImportDocset_FullMethodName                         = "/kodex.controlplane.v1.ControlPlaneService/ImportDocset"
-	ControlPlaneService_SyncDocset_FullMethodName                           = "/kodex.controlplane.v1.ControlPlaneService/SyncDocset"
-	ControlPlaneService_IssueRunMCPToken_FullMethodName                     = "/kodex.controlplane.v1.ControlPlaneService/IssueRunMCPToken"
-	ControlPlaneService_PrepareRunEnvironment_FullMethodName                = "/kodex.controlplane.v1.ControlPlaneService/PrepareRunEnvironment"
-	ControlPlaneService_EvaluateRuntimeReuse_FullMethodName                 = "/kodex.controlplane.v1.ControlPlaneService/EvaluateRuntimeReuse"
-	ControlPlaneService_ClaimNextInteractionDispatch_FullMethodName         = "/kodex.controlplane.v1.ControlPlaneService/ClaimNextInteractionDispatch"
-	ControlPlaneService_CompleteInteractionDispatch_FullMethodName          = "/kodex.controlplane.v1.ControlPlaneService/CompleteInteractionDispatch"
-	ControlPlaneService_ExpireNextInteraction_FullMethodName                = "/kodex.controlplane.v1.ControlPlaneService/ExpireNextInteraction"
-	ControlPlaneService_ProcessNextGitHubRateLimitWait_FullMethodName       = "/kodex.controlplane.v1.ControlPlaneService/ProcessNextGitHubRateLimitWait"
-	ControlPlaneService_ReportGitHubRateLimitSignal_FullMethodName          = "/kodex.controlplane.v1.ControlPlaneService/ReportGitHubRateLimitSignal"
-	ControlPlaneService_ReportChangeGovernanceDraftSignal_FullMethodName    = "/kodex.controlplane.v1.ControlPlaneService/ReportChangeGovernanceDraftSignal"
-	ControlPlaneService_PublishChangeGovernanceWaveMap_FullMethodName       = "/kodex.controlplane.v1.ControlPlaneService/PublishChangeGovernanceWaveMap"
-	ControlPlaneService_UpsertChangeGovernanceEvidenceSignal_FullMethodName = "/kodex.controlplane.v1.ControlPlaneService/UpsertChangeGovernanceEvidenceSignal"
-	ControlPlaneService_GetMissionControlWorkspace_FullMethodName           = "/kodex.controlplane.v1.ControlPlaneService/GetMissionControlWorkspace"
-	ControlPlaneService_GetMissionControlNode_FullMethodName                = "/kodex.controlplane.v1.ControlPlaneService/GetMissionControlNode"
-	ControlPlaneService_ListMissionControlNodeActivity_FullMethodName       = "/kodex.controlplane.v1.ControlPlaneService/ListMissionControlNodeActivity"
-	ControlPlaneService_PreviewMissionControlLaunch_FullMethodName          = "/kodex.controlplane.v1.ControlPlaneService/PreviewMissionControlLaunch"
-	ControlPlaneService_GetMissionControlSnapshot_FullMethodName            = "/kodex.controlplane.v1.ControlPlaneService/GetMissionControlSnapshot"
-	ControlPlaneService_GetMissionControlEntity_FullMethodName              = "/kodex.controlplane.v1.ControlPlaneService/GetMissionControlEntity"
-	ControlPlaneService_ListMissionControlTimeline_FullMethodName           = "/kodex.controlplane.v1.ControlPlaneService/ListMissionControlTimeline"
-	ControlPlaneService_ListMissionControlWarmupProjects_FullMethodName     = "/kodex.controlplane.v1.ControlPlaneService/ListMissionControlWarmupProjects"
-	ControlPlaneService_RunMissionControlWarmup_FullMethodName              = "/kodex.controlplane.v1.ControlPlaneService/RunMissionControlWarmup"
-	ControlPlaneService_SubmitMissionControlCommand_FullMethodName          = "/kodex.controlplane.v1.ControlPlaneService/SubmitMissionControlCommand"
-	ControlPlaneService_GetMissionControlCommand_FullMethodName             = "/kodex.controlplane.v1.ControlPlaneService/GetMissionControlCommand"
-	ControlPlaneService_ClaimMissionControlPendingCommands_FullMethodName   = "/kodex.controlplane.v1.ControlPlaneService/ClaimMissionControlPendingCommands"
-	ControlPlaneService_QueueMissionControlCommand_FullMethodName           = "/kodex.controlplane.v1.ControlPlaneService/QueueMissionControlCommand"
-	ControlPlaneService_MarkMissionControlCommandPendingSync_FullMethodName = "/kodex.controlplane.v1.ControlPlaneService/MarkMissionControlCommandPendingSync"
-	ControlPlaneService_MarkMissionControlCommandReconciled_FullMethodName  = "/kodex.controlplane.v1.ControlPlaneService/MarkMissionControlCommandReconciled"
-	ControlPlaneService_MarkMissionControlCommandFailed_FullMethodName      = "/kodex.controlplane.v1.ControlPlaneService/MarkMissionControlCommandFailed"
-	ControlPlaneService_SubmitInteractionCallback_FullMethodName            = "/kodex.controlplane.v1.ControlPlaneService/SubmitInteractionCallback"
-	ControlPlaneService_SubmitAdapterInteractionCallback_FullMethodName     = "/kodex.controlplane.v1.ControlPlaneService/SubmitAdapterInteractionCallback"
-	ControlPlaneService_ListRuntimeDeployTasks_FullMethodName               = "/kodex.controlplane.v1.ControlPlaneService/ListRuntimeDeployTasks"
-	ControlPlaneService_GetRuntimeDeployTask_FullMethodName                 = "/kodex.controlplane.v1.ControlPlaneService/GetRuntimeDeployTask"
-	ControlPlaneService_CancelRuntimeDeployTask_FullMethodName              = "/kodex.controlplane.v1.ControlPlaneService/CancelRuntimeDeployTask"
-	ControlPlaneService_StopRuntimeDeployTask_FullMethodName                = "/kodex.controlplane.v1.ControlPlaneService/StopRuntimeDeployTask"
-	ControlPlaneService_ListRuntimeErrors_FullMethodName                    = "/kodex.controlplane.v1.ControlPlaneService/ListRuntimeErrors"
-	ControlPlaneService_MarkRuntimeErrorViewed_FullMethodName               = "/kodex.controlplane.v1.ControlPlaneService/MarkRuntimeErrorViewed"
-	ControlPlaneService_UpsertAgentSession_FullMethodName                   = "/kodex.controlplane.v1.ControlPlaneService/UpsertAgentSession"
-	ControlPlaneService_GetLatestAgentSession_FullMethodName                = "/kodex.controlplane.v1.ControlPlaneService/GetLatestAgentSession"
-	ControlPlaneService_GetRunInteractionResumePayload_FullMethodName       = "/kodex.controlplane.v1.ControlPlaneService/GetRunInteractionResumePayload"
-	ControlPlaneService_GetRunGitHubRateLimitResumePayload_FullMethodName   = "/kodex.controlplane.v1.ControlPlaneService/GetRunGitHubRateLimitResumePayload"
-	ControlPlaneService_LookupRunPullRequest_FullMethodName                 = "/kodex.controlplane.v1.ControlPlaneService/LookupRunPullRequest"
-	ControlPlaneService_InsertRunFlowEvent_FullMethodName                   = "/kodex.controlplane.v1.ControlPlaneService/InsertRunFlowEvent"
-	ControlPlaneService_UpsertRunStatusComment_FullMethodName               = "/kodex.controlplane.v1.ControlPlaneService/UpsertRunStatusComment"
-	ControlPlaneService_GetCodexAuth_FullMethodName                         = "/kodex.controlplane.v1.ControlPlaneService/GetCodexAuth"
-	ControlPlaneService_UpsertCodexAuth_FullMethodName                      = "/kodex.controlplane.v1.ControlPlaneService/UpsertCodexAuth"
-	ControlPlaneService_DeleteRunNamespace_FullMethodName                   = "/kodex.controlplane.v1.ControlPlaneService/DeleteRunNamespace"
+	ControlPlaneService_IngestGitHubWebhook_FullMethodName                            = "/kodex.controlplane.v1.ControlPlaneService/IngestGitHubWebhook"
+	ControlPlaneService_IngestAlertmanagerWebhook_FullMethodName                      = "/kodex.controlplane.v1.ControlPlaneService/IngestAlertmanagerWebhook"
+	ControlPlaneService_ResolveStaffByEmail_FullMethodName                            = "/kodex.controlplane.v1.ControlPlaneService/ResolveStaffByEmail"
+	ControlPlaneService_ResolveStaffByGitHubLogin_FullMethodName                      = "/kodex.controlplane.v1.ControlPlaneService/ResolveStaffByGitHubLogin"
+	ControlPlaneService_AuthorizeOAuthUser_FullMethodName                             = "/kodex.controlplane.v1.ControlPlaneService/AuthorizeOAuthUser"
+	ControlPlaneService_AuthorizeOIDCUser_FullMethodName                              = "/kodex.controlplane.v1.ControlPlaneService/AuthorizeOIDCUser"
+	ControlPlaneService_AuthenticateStaffAPIToken_FullMethodName                      = "/kodex.controlplane.v1.ControlPlaneService/AuthenticateStaffAPIToken"
+	ControlPlaneService_ListProjects_FullMethodName                                   = "/kodex.controlplane.v1.ControlPlaneService/ListProjects"
+	ControlPlaneService_UpsertProject_FullMethodName                                  = "/kodex.controlplane.v1.ControlPlaneService/UpsertProject"
+	ControlPlaneService_GetProject_FullMethodName                                     = "/kodex.controlplane.v1.ControlPlaneService/GetProject"
+	ControlPlaneService_DeleteProject_FullMethodName                                  = "/kodex.controlplane.v1.ControlPlaneService/DeleteProject"
+	ControlPlaneService_ListRuns_FullMethodName                                       = "/kodex.controlplane.v1.ControlPlaneService/ListRuns"
+	ControlPlaneService_ListRunWaits_FullMethodName                                   = "/kodex.controlplane.v1.ControlPlaneService/ListRunWaits"
+	ControlPlaneService_GetRun_FullMethodName                                         = "/kodex.controlplane.v1.ControlPlaneService/GetRun"
+	ControlPlaneService_CancelRun_FullMethodName                                      = "/kodex.controlplane.v1.ControlPlaneService/CancelRun"
+	ControlPlaneService_GetRunLogs_FullMethodName                                     = "/kodex.controlplane.v1.ControlPlaneService/GetRunLogs"
+	ControlPlaneService_ListPendingApprovals_FullMethodName                           = "/kodex.controlplane.v1.ControlPlaneService/ListPendingApprovals"
+	ControlPlaneService_ResolveApprovalDecision_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/ResolveApprovalDecision"
+	ControlPlaneService_ListRunEvents_FullMethodName                                  = "/kodex.controlplane.v1.ControlPlaneService/ListRunEvents"
+	ControlPlaneService_ListRunLearningFeedback_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/ListRunLearningFeedback"
+	ControlPlaneService_ListSystemSettings_FullMethodName                             = "/kodex.controlplane.v1.ControlPlaneService/ListSystemSettings"
+	ControlPlaneService_GetSystemSetting_FullMethodName                               = "/kodex.controlplane.v1.ControlPlaneService/GetSystemSetting"
+	ControlPlaneService_UpdateSystemSettingBoolean_FullMethodName                     = "/kodex.controlplane.v1.ControlPlaneService/UpdateSystemSettingBoolean"
+	ControlPlaneService_ResetSystemSetting_FullMethodName                             = "/kodex.controlplane.v1.ControlPlaneService/ResetSystemSetting"
+	ControlPlaneService_ListUsers_FullMethodName                                      = "/kodex.controlplane.v1.ControlPlaneService/ListUsers"
+	ControlPlaneService_CreateUser_FullMethodName                                     = "/kodex.controlplane.v1.ControlPlaneService/CreateUser"
+	ControlPlaneService_DeleteUser_FullMethodName                                     = "/kodex.controlplane.v1.ControlPlaneService/DeleteUser"
+	ControlPlaneService_CreateServiceAccount_FullMethodName                           = "/kodex.controlplane.v1.ControlPlaneService/CreateServiceAccount"
+	ControlPlaneService_CreateStaffAPIToken_FullMethodName                            = "/kodex.controlplane.v1.ControlPlaneService/CreateStaffAPIToken"
+	ControlPlaneService_ListStaffAPITokens_FullMethodName                             = "/kodex.controlplane.v1.ControlPlaneService/ListStaffAPITokens"
+	ControlPlaneService_RevokeStaffAPIToken_FullMethodName                            = "/kodex.controlplane.v1.ControlPlaneService/RevokeStaffAPIToken"
+	ControlPlaneService_ListProjectMembers_FullMethodName                             = "/kodex.controlplane.v1.ControlPlaneService/ListProjectMembers"
+	ControlPlaneService_UpsertProjectMember_FullMethodName                            = "/kodex.controlplane.v1.ControlPlaneService/UpsertProjectMember"
+	ControlPlaneService_DeleteProjectMember_FullMethodName                            = "/kodex.controlplane.v1.ControlPlaneService/DeleteProjectMember"
+	ControlPlaneService_SetProjectMemberLearningModeOverride_FullMethodName           = "/kodex.controlplane.v1.ControlPlaneService/SetProjectMemberLearningModeOverride"
+	ControlPlaneService_ListProjectRoles_FullMethodName                               = "/kodex.controlplane.v1.ControlPlaneService/ListProjectRoles"
+	ControlPlaneService_UpsertProjectRole_FullMethodName                              = "/kodex.controlplane.v1.ControlPlaneService/UpsertProjectRole"
+	ControlPlaneService_DeleteProjectRole_FullMethodName                              = "/kodex.controlplane.v1.ControlPlaneService/DeleteProjectRole"
+	ControlPlaneService_ListMCPApprovalPolicies_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/ListMCPApprovalPolicies"
+	ControlPlaneService_UpsertMCPApprovalPolicy_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/UpsertMCPApprovalPolicy"
+	ControlPlaneService_DeleteMCPApprovalPolicy_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/DeleteMCPApprovalPolicy"
+	ControlPlaneService_ListProjectRepositories_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/ListProjectRepositories"
+	ControlPlaneService_UpsertProjectRepository_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/UpsertProjectRepository"
+	ControlPlaneService_DeleteProjectRepository_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/DeleteProjectRepository"
+	ControlPlaneService_UpsertRepositoryBotParams_FullMethodName                      = "/kodex.controlplane.v1.ControlPlaneService/UpsertRepositoryBotParams"
+	ControlPlaneService_RunRepositoryPreflight_FullMethodName                         = "/kodex.controlplane.v1.ControlPlaneService/RunRepositoryPreflight"
+	ControlPlaneService_GetProjectGitHubTokens_FullMethodName                         = "/kodex.controlplane.v1.ControlPlaneService/GetProjectGitHubTokens"
+	ControlPlaneService_UpsertProjectGitHubTokens_FullMethodName                      = "/kodex.controlplane.v1.ControlPlaneService/UpsertProjectGitHubTokens"
+	ControlPlaneService_PreviewNextStepAction_FullMethodName                          = "/kodex.controlplane.v1.ControlPlaneService/PreviewNextStepAction"
+	ControlPlaneService_ExecuteNextStepAction_FullMethodName                          = "/kodex.controlplane.v1.ControlPlaneService/ExecuteNextStepAction"
+	ControlPlaneService_ListDocsetGroups_FullMethodName                               = "/kodex.controlplane.v1.ControlPlaneService/ListDocsetGroups"
+	ControlPlaneService_ImportDocset_FullMethodName                                   = "/kodex.controlplane.v1.ControlPlaneService/ImportDocset"
+	ControlPlaneService_SyncDocset_FullMethodName                                     = "/kodex.controlplane.v1.ControlPlaneService/SyncDocset"
+	ControlPlaneService_IssueRunMCPToken_FullMethodName                               = "/kodex.controlplane.v1.ControlPlaneService/IssueRunMCPToken"
+	ControlPlaneService_PrepareRunEnvironment_FullMethodName                          = "/kodex.controlplane.v1.ControlPlaneService/PrepareRunEnvironment"
+	ControlPlaneService_EvaluateRuntimeReuse_FullMethodName                           = "/kodex.controlplane.v1.ControlPlaneService/EvaluateRuntimeReuse"
+	ControlPlaneService_ClaimNextInteractionDispatch_FullMethodName                   = "/kodex.controlplane.v1.ControlPlaneService/ClaimNextInteractionDispatch"
+	ControlPlaneService_CompleteInteractionDispatch_FullMethodName                    = "/kodex.controlplane.v1.ControlPlaneService/CompleteInteractionDispatch"
+	ControlPlaneService_ExpireNextInteraction_FullMethodName                          = "/kodex.controlplane.v1.ControlPlaneService/ExpireNextInteraction"
+	ControlPlaneService_ProcessNextGitHubRateLimitWait_FullMethodName                 = "/kodex.controlplane.v1.ControlPlaneService/ProcessNextGitHubRateLimitWait"
+	ControlPlaneService_ReportGitHubRateLimitSignal_FullMethodName                    = "/kodex.controlplane.v1.ControlPlaneService/ReportGitHubRateLimitSignal"
+	ControlPlaneService_ReportChangeGovernanceDraftSignal_FullMethodName              = "/kodex.controlplane.v1.ControlPlaneService/ReportChangeGovernanceDraftSignal"
+	ControlPlaneService_PublishChangeGovernanceWaveMap_FullMethodName                 = "/kodex.controlplane.v1.ControlPlaneService/PublishChangeGovernanceWaveMap"
+	ControlPlaneService_UpsertChangeGovernanceEvidenceSignal_FullMethodName           = "/kodex.controlplane.v1.ControlPlaneService/UpsertChangeGovernanceEvidenceSignal"
+	ControlPlaneService_GetChangeGovernancePackage_FullMethodName                     = "/kodex.controlplane.v1.ControlPlaneService/GetChangeGovernancePackage"
+	ControlPlaneService_SubmitChangeGovernanceWaiverDecision_FullMethodName           = "/kodex.controlplane.v1.ControlPlaneService/SubmitChangeGovernanceWaiverDecision"
+	ControlPlaneService_SubmitChangeGovernanceReleaseReadinessDecision_FullMethodName = "/kodex.controlplane.v1.ControlPlaneService/SubmitChangeGovernanceReleaseReadinessDecision"
+	ControlPlaneService_ReportChangeGovernanceFeedback_FullMethodName                 = "/kodex.controlplane.v1.ControlPlaneService/ReportChangeGovernanceFeedback"
+	ControlPlaneService_GetMissionControlWorkspace_FullMethodName                     = "/kodex.controlplane.v1.ControlPlaneService/GetMissionControlWorkspace"
+	ControlPlaneService_GetMissionControlNode_FullMethodName                          = "/kodex.controlplane.v1.ControlPlaneService/GetMissionControlNode"
+	ControlPlaneService_ListMissionControlNodeActivity_FullMethodName                 = "/kodex.controlplane.v1.ControlPlaneService/ListMissionControlNodeActivity"
+	ControlPlaneService_PreviewMissionControlLaunch_FullMethodName                    = "/kodex.controlplane.v1.ControlPlaneService/PreviewMissionControlLaunch"
+	ControlPlaneService_GetMissionControlSnapshot_FullMethodName                      = "/kodex.controlplane.v1.ControlPlaneService/GetMissionControlSnapshot"
+	ControlPlaneService_GetMissionControlEntity_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/GetMissionControlEntity"
+	ControlPlaneService_ListMissionControlTimeline_FullMethodName                     = "/kodex.controlplane.v1.ControlPlaneService/ListMissionControlTimeline"
+	ControlPlaneService_ListMissionControlWarmupProjects_FullMethodName               = "/kodex.controlplane.v1.ControlPlaneService/ListMissionControlWarmupProjects"
+	ControlPlaneService_RunMissionControlWarmup_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/RunMissionControlWarmup"
+	ControlPlaneService_SubmitMissionControlCommand_FullMethodName                    = "/kodex.controlplane.v1.ControlPlaneService/SubmitMissionControlCommand"
+	ControlPlaneService_GetMissionControlCommand_FullMethodName                       = "/kodex.controlplane.v1.ControlPlaneService/GetMissionControlCommand"
+	ControlPlaneService_ClaimMissionControlPendingCommands_FullMethodName             = "/kodex.controlplane.v1.ControlPlaneService/ClaimMissionControlPendingCommands"
+	ControlPlaneService_QueueMissionControlCommand_FullMethodName                     = "/kodex.controlplane.v1.ControlPlaneService/QueueMissionControlCommand"
+	ControlPlaneService_MarkMissionControlCommandPendingSync_FullMethodName           = "/kodex.controlplane.v1.ControlPlaneService/MarkMissionControlCommandPendingSync"
+	ControlPlaneService_MarkMissionControlCommandReconciled_FullMethodName            = "/kodex.controlplane.v1.ControlPlaneService/MarkMissionControlCommandReconciled"
+	ControlPlaneService_MarkMissionControlCommandFailed_FullMethodName                = "/kodex.controlplane.v1.ControlPlaneService/MarkMissionControlCommandFailed"
+	ControlPlaneService_CreateMissionControlVoiceCandidate_FullMethodName             = "/kodex.controlplane.v1.ControlPlaneService/CreateMissionControlVoiceCandidate"
+	ControlPlaneService_ListMissionControlVoiceCandidates_FullMethodName              = "/kodex.controlplane.v1.ControlPlaneService/ListMissionControlVoiceCandidates"
+	ControlPlaneService_PromoteMissionControlVoiceCandidate_FullMethodName            = "/kodex.controlplane.v1.ControlPlaneService/PromoteMissionControlVoiceCandidate"
+	ControlPlaneService_RejectMissionControlVoiceCandidate_FullMethodName             = "/kodex.controlplane.v1.ControlPlaneService/RejectMissionControlVoiceCandidate"
+	ControlPlaneService_SubmitInteractionCallback_FullMethodName                      = "/kodex.controlplane.v1.ControlPlaneService/SubmitInteractionCallback"
+	ControlPlaneService_SubmitAdapterInteractionCallback_FullMethodName               = "/kodex.controlplane.v1.ControlPlaneService/SubmitAdapterInteractionCallback"
+	ControlPlaneService_ListRuntimeDeployTasks_FullMethodName                         = "/kodex.controlplane.v1.ControlPlaneService/ListRuntimeDeployTasks"
+	ControlPlaneService_GetRuntimeDeployTask_FullMethodName                           = "/kodex.controlplane.v1.ControlPlaneService/GetRuntimeDeployTask"
+	ControlPlaneService_CancelRuntimeDeployTask_FullMethodName                        = "/kodex.controlplane.v1.ControlPlaneService/CancelRuntimeDeployTask"
+	ControlPlaneService_StopRuntimeDeployTask_FullMethodName                          = "/kodex.controlplane.v1.ControlPlaneService/StopRuntimeDeployTask"
+	ControlPlaneService_PreviewRuntimeDeploy_FullMethodName                           = "/kodex.controlplane.v1.ControlPlaneService/PreviewRuntimeDeploy"
+	ControlPlaneService_ListRuntimeErrors_FullMethodName                              = "/kodex.controlplane.v1.ControlPlaneService/ListRuntimeErrors"
+	ControlPlaneService_MarkRuntimeErrorViewed_FullMethodName                         = "/kodex.controlplane.v1.ControlPlaneService/MarkRuntimeErrorViewed"
+	ControlPlaneService_ListRuntimeErrorGroups_FullMethodName                         = "/kodex.controlplane.v1.ControlPlaneService/ListRuntimeErrorGroups"
+	ControlPlaneService_UpdateRuntimeErrorGroupStatus_FullMethodName                  = "/kodex.controlplane.v1.ControlPlaneService/UpdateRuntimeErrorGroupStatus"
+	ControlPlaneService_EscalateRuntimeErrorGroup_FullMethodName                      = "/kodex.controlplane.v1.ControlPlaneService/EscalateRuntimeErrorGroup"
+	ControlPlaneService_ListRetentionPolicies_FullMethodName                          = "/kodex.controlplane.v1.ControlPlaneService/ListRetentionPolicies"
+	ControlPlaneService_UpsertRetentionPolicy_FullMethodName                          = "/kodex.controlplane.v1.ControlPlaneService/UpsertRetentionPolicy"
+	ControlPlaneService_DeleteRetentionPolicy_FullMethodName                          = "/kodex.controlplane.v1.ControlPlaneService/DeleteRetentionPolicy"
+	ControlPlaneService_SetFlowEventRetentionPin_FullMethodName                       = "/kodex.controlplane.v1.ControlPlaneService/SetFlowEventRetentionPin"
+	ControlPlaneService_RunRetentionSweep_FullMethodName                              = "/kodex.controlplane.v1.ControlPlaneService/RunRetentionSweep"
+	ControlPlaneService_GetRunOutcomeAnalytics_FullMethodName                         = "/kodex.controlplane.v1.ControlPlaneService/GetRunOutcomeAnalytics"
+	ControlPlaneService_UpsertAgentSession_FullMethodName                             = "/kodex.controlplane.v1.ControlPlaneService/UpsertAgentSession"
+	ControlPlaneService_GetLatestAgentSession_FullMethodName                          = "/kodex.controlplane.v1.ControlPlaneService/GetLatestAgentSession"
+	ControlPlaneService_GetRunInteractionResumePayload_FullMethodName                 = "/kodex.controlplane.v1.ControlPlaneService/GetRunInteractionResumePayload"
+	ControlPlaneService_GetRunGitHubRateLimitResumePayload_FullMethodName             = "/kodex.controlplane.v1.ControlPlaneService/GetRunGitHubRateLimitResumePayload"
+	ControlPlaneService_GetRunApprovalResumePayload_FullMethodName                    = "/kodex.controlplane.v1.ControlPlaneService/GetRunApprovalResumePayload"
+	ControlPlaneService_SuspendRunForApproval_FullMethodName                          = "/kodex.controlplane.v1.ControlPlaneService/SuspendRunForApproval"
+	ControlPlaneService_ListRunProjectMCPServers_FullMethodName                       = "/kodex.controlplane.v1.ControlPlaneService/ListRunProjectMCPServers"
+	ControlPlaneService_LookupRunPullRequest_FullMethodName                           = "/kodex.controlplane.v1.ControlPlaneService/LookupRunPullRequest"
+	ControlPlaneService_InsertRunFlowEvent_FullMethodName                             = "/kodex.controlplane.v1.ControlPlaneService/InsertRunFlowEvent"
+	ControlPlaneService_UpsertRunStatusComment_FullMethodName                         = "/kodex.controlplane.v1.ControlPlaneService/UpsertRunStatusComment"
+	ControlPlaneService_GetCodexAuth_FullMethodName                                   = "/kodex.controlplane.v1.ControlPlaneService/GetCodexAuth"
+	ControlPlaneService_UpsertCodexAuth_FullMethodName                                = "/kodex.controlplane.v1.ControlPlaneService/UpsertCodexAuth"
+	ControlPlaneService_DeleteRunNamespace_FullMethodName                             = "/kodex.controlplane.v1.ControlPlaneService/DeleteRunNamespace"
 )
 
 // ControlPlaneServiceClient is the client API for ControlPlaneService service.
@@ -111,10 +146,15 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ControlPlaneServiceClient interface {
 	IngestGitHubWebhook(ctx context.Context, in *IngestGitHubWebhookRequest, opts ...grpc.CallOption) (*IngestGitHubWebhookResponse, error)
+	IngestAlertmanagerWebhook(ctx context.Context, in *IngestAlertmanagerWebhookRequest, opts ...grpc.CallOption) (*IngestAlertmanagerWebhookResponse, error)
 	// Used by api-gateway staff auth when oauth2-proxy injects identity headers.
 	ResolveStaffByEmail(ctx context.Context, in *ResolveStaffByEmailRequest, opts ...grpc.CallOption) (*ResolveStaffByEmailResponse, error)
+	// Used by chat adapters to act on behalf of the staff user bound to a chat.
+	ResolveStaffByGitHubLogin(ctx context.Context, in *ResolveStaffByGitHubLoginRequest, opts ...grpc.CallOption) (*ResolveStaffByGitHubLoginResponse, error)
 	// Used by api-gateway OAuth callback to enforce allowlist and persist GitHub identity.
 	AuthorizeOAuthUser(ctx context.Context, in *AuthorizeOAuthUserRequest, opts ...grpc.CallOption) (*AuthorizeOAuthUserResponse, error)
+	AuthorizeOIDCUser(ctx context.Context, in *AuthorizeOIDCUserRequest, opts ...grpc.CallOption) (*AuthorizeOIDCUserResponse, error)
+	AuthenticateStaffAPIToken(ctx context.Context, in *AuthenticateStaffAPITokenRequest, opts ...grpc.CallOption) (*AuthenticateStaffAPITokenResponse, error)
 	ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error)
 	UpsertProject(ctx context.Context, in *UpsertProjectRequest, opts ...grpc.CallOption) (*Project, error)
 	GetProject(ctx context.Context, in *GetProjectRequest, opts ...grpc.CallOption) (*Project, error)
@@ -135,10 +175,20 @@ type ControlPlaneServiceClient interface {
 	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*User, error)
 	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*User, error)
+	CreateStaffAPIToken(ctx context.Context, in *CreateStaffAPITokenRequest, opts ...grpc.CallOption) (*CreateStaffAPITokenResponse, error)
+	ListStaffAPITokens(ctx context.Context, in *ListStaffAPITokensRequest, opts ...grpc.CallOption) (*ListStaffAPITokensResponse, error)
+	RevokeStaffAPIToken(ctx context.Context, in *RevokeStaffAPITokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error)
 	UpsertProjectMember(ctx context.Context, in *UpsertProjectMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteProjectMember(ctx context.Context, in *DeleteProjectMemberRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	SetProjectMemberLearningModeOverride(ctx context.Context, in *SetProjectMemberLearningModeOverrideRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListProjectRoles(ctx context.Context, in *ListProjectRolesRequest, opts ...grpc.CallOption) (*ListProjectRolesResponse, error)
+	UpsertProjectRole(ctx context.Context, in *UpsertProjectRoleRequest, opts ...grpc.CallOption) (*ProjectRole, error)
+	DeleteProjectRole(ctx context.Context, in *DeleteProjectRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListMCPApprovalPolicies(ctx context.Context, in *ListMCPApprovalPoliciesRequest, opts ...grpc.CallOption) (*ListMCPApprovalPoliciesResponse, error)
+	UpsertMCPApprovalPolicy(ctx context.Context, in *UpsertMCPApprovalPolicyRequest, opts ...grpc.CallOption) (*MCPApprovalPolicy, error)
+	DeleteMCPApprovalPolicy(ctx context.Context, in *DeleteMCPApprovalPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListProjectRepositories(ctx context.Context, in *ListProjectRepositoriesRequest, opts ...grpc.CallOption) (*ListProjectRepositoriesResponse, error)
 	UpsertProjectRepository(ctx context.Context, in *UpsertProjectRepositoryRequest, opts ...grpc.CallOption) (*RepositoryBinding, error)
 	DeleteProjectRepository(ctx context.Context, in *DeleteProjectRepositoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
@@ -162,6 +212,10 @@ type ControlPlaneServiceClient interface {
 	ReportChangeGovernanceDraftSignal(ctx context.Context, in *ReportChangeGovernanceDraftSignalRequest, opts ...grpc.CallOption) (*ReportChangeGovernanceDraftSignalResponse, error)
 	PublishChangeGovernanceWaveMap(ctx context.Context, in *PublishChangeGovernanceWaveMapRequest, opts ...grpc.CallOption) (*PublishChangeGovernanceWaveMapResponse, error)
 	UpsertChangeGovernanceEvidenceSignal(ctx context.Context, in *UpsertChangeGovernanceEvidenceSignalRequest, opts ...grpc.CallOption) (*UpsertChangeGovernanceEvidenceSignalResponse, error)
+	GetChangeGovernancePackage(ctx context.Context, in *GetChangeGovernancePackageRequest, opts ...grpc.CallOption) (*ChangeGovernancePackage, error)
+	SubmitChangeGovernanceWaiverDecision(ctx context.Context, in *SubmitChangeGovernanceWaiverDecisionRequest, opts ...grpc.CallOption) (*ChangeGovernancePackage, error)
+	SubmitChangeGovernanceReleaseReadinessDecision(ctx context.Context, in *SubmitChangeGovernanceReleaseReadinessDecisionRequest, opts ...grpc.CallOption) (*ChangeGovernancePackage, error)
+	ReportChangeGovernanceFeedback(ctx context.Context, in *ReportChangeGovernanceFeedbackRequest, opts ...grpc.CallOption) (*ChangeGovernancePackage, error)
 	GetMissionControlWorkspace(ctx context.Context, in *GetMissionControlWorkspaceRequest, opts ...grpc.CallOption) (*GetMissionControlWorkspaceResponse, error)
 	GetMissionControlNode(ctx context.Context, in *GetMissionControlNodeRequest, opts ...grpc.CallOption) (*MissionControlNodeDetails, error)
 	ListMissionControlNodeActivity(ctx context.Context, in *ListMissionControlNodeActivityRequest, opts ...grpc.CallOption) (*ListMissionControlNodeActivityResponse, error)
@@ -178,19 +232,36 @@ type ControlPlaneServiceClient interface {
 	MarkMissionControlCommandPendingSync(ctx context.Context, in *MarkMissionControlCommandPendingSyncRequest, opts ...grpc.CallOption) (*MissionControlCommandState, error)
 	MarkMissionControlCommandReconciled(ctx context.Context, in *MarkMissionControlCommandReconciledRequest, opts ...grpc.CallOption) (*MissionControlCommandState, error)
 	MarkMissionControlCommandFailed(ctx context.Context, in *MarkMissionControlCommandFailedRequest, opts ...grpc.CallOption) (*MissionControlCommandState, error)
+	CreateMissionControlVoiceCandidate(ctx context.Context, in *CreateMissionControlVoiceCandidateRequest, opts ...grpc.CallOption) (*MissionControlVoiceCandidate, error)
+	ListMissionControlVoiceCandidates(ctx context.Context, in *ListMissionControlVoiceCandidatesRequest, opts ...grpc.CallOption) (*ListMissionControlVoiceCandidatesResponse, error)
+	PromoteMissionControlVoiceCandidate(ctx context.Context, in *PromoteMissionControlVoiceCandidateRequest, opts ...grpc.CallOption) (*PromoteMissionControlVoiceCandidateResponse, error)
+	RejectMissionControlVoiceCandidate(ctx context.Context, in *RejectMissionControlVoiceCandidateRequest, opts ...grpc.CallOption) (*MissionControlVoiceCandidate, error)
 	SubmitInteractionCallback(ctx context.Context, in *SubmitInteractionCallbackRequest, opts ...grpc.CallOption) (*SubmitInteractionCallbackResponse, error)
 	SubmitAdapterInteractionCallback(ctx context.Context, in *SubmitInteractionCallbackRequest, opts ...grpc.CallOption) (*SubmitInteractionCallbackResponse, error)
 	ListRuntimeDeployTasks(ctx context.Context, in *ListRuntimeDeployTasksRequest, opts ...grpc.CallOption) (*ListRuntimeDeployTasksResponse, error)
 	GetRuntimeDeployTask(ctx context.Context, in *GetRuntimeDeployTaskRequest, opts ...grpc.CallOption) (*RuntimeDeployTask, error)
 	CancelRuntimeDeployTask(ctx context.Context, in *CancelRuntimeDeployTaskRequest, opts ...grpc.CallOption) (*RuntimeDeployTaskActionResponse, error)
 	StopRuntimeDeployTask(ctx context.Context, in *StopRuntimeDeployTaskRequest, opts ...grpc.CallOption) (*RuntimeDeployTaskActionResponse, error)
+	PreviewRuntimeDeploy(ctx context.Context, in *PreviewRuntimeDeployRequest, opts ...grpc.CallOption) (*PreviewRuntimeDeployResponse, error)
 	ListRuntimeErrors(ctx context.Context, in *ListRuntimeErrorsRequest, opts ...grpc.CallOption) (*ListRuntimeErrorsResponse, error)
 	MarkRuntimeErrorViewed(ctx context.Context, in *MarkRuntimeErrorViewedRequest, opts ...grpc.CallOption) (*RuntimeError, error)
+	ListRuntimeErrorGroups(ctx context.Context, in *ListRuntimeErrorGroupsRequest, opts ...grpc.CallOption) (*ListRuntimeErrorGroupsResponse, error)
+	UpdateRuntimeErrorGroupStatus(ctx context.Context, in *UpdateRuntimeErrorGroupStatusRequest, opts ...grpc.CallOption) (*RuntimeErrorGroup, error)
+	EscalateRuntimeErrorGroup(ctx context.Context, in *EscalateRuntimeErrorGroupRequest, opts ...grpc.CallOption) (*RuntimeErrorGroup, error)
+	ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error)
+	UpsertRetentionPolicy(ctx context.Context, in *UpsertRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error)
+	DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetFlowEventRetentionPin(ctx context.Context, in *SetFlowEventRetentionPinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RunRetentionSweep(ctx context.Context, in *RunRetentionSweepRequest, opts ...grpc.CallOption) (*RunRetentionSweepResponse, error)
+	GetRunOutcomeAnalytics(ctx context.Context, in *GetRunOutcomeAnalyticsRequest, opts ...grpc.CallOption) (*RunOutcomeAnalytics, error)
 	// Used by agent-runner for run-bound session persistence and event callbacks.
 	UpsertAgentSession(ctx context.Context, in *UpsertAgentSessionRequest, opts ...grpc.CallOption) (*UpsertAgentSessionResponse, error)
 	GetLatestAgentSession(ctx context.Context, in *GetLatestAgentSessionRequest, opts ...grpc.CallOption) (*GetLatestAgentSessionResponse, error)
 	GetRunInteractionResumePayload(ctx context.Context, in *GetRunInteractionResumePayloadRequest, opts ...grpc.CallOption) (*GetRunInteractionResumePayloadResponse, error)
 	GetRunGitHubRateLimitResumePayload(ctx context.Context, in *GetRunGitHubRateLimitResumePayloadRequest, opts ...grpc.CallOption) (*GetRunGitHubRateLimitResumePayloadResponse, error)
+	GetRunApprovalResumePayload(ctx context.Context, in *GetRunApprovalResumePayloadRequest, opts ...grpc.CallOption) (*GetRunApprovalResumePayloadResponse, error)
+	SuspendRunForApproval(ctx context.Context, in *SuspendRunForApprovalRequest, opts ...grpc.CallOption) (*SuspendRunForApprovalResponse, error)
+	ListRunProjectMCPServers(ctx context.Context, in *ListRunProjectMCPServersRequest, opts ...grpc.CallOption) (*ListRunProjectMCPServersResponse, error)
 	LookupRunPullRequest(ctx context.Context, in *LookupRunPullRequestRequest, opts ...grpc.CallOption) (*LookupRunPullRequestResponse, error)
 	InsertRunFlowEvent(ctx context.Context, in *InsertRunFlowEventRequest, opts ...grpc.CallOption) (*InsertRunFlowEventResponse, error)
 	UpsertRunStatusComment(ctx context.Context, in *UpsertRunStatusCommentRequest, opts ...grpc.CallOption) (*UpsertRunStatusCommentResponse, error)
@@ -217,6 +288,16 @@ func (c *controlPlaneServiceClient) IngestGitHubWebhook(ctx context.Context, in
 	return out, nil
 }
 
+func (c *controlPlaneServiceClient) IngestAlertmanagerWebhook(ctx context.Context, in *IngestAlertmanagerWebhookRequest, opts ...grpc.CallOption) (*IngestAlertmanagerWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(IngestAlertmanagerWebhookResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_IngestAlertmanagerWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlPlaneServiceClient) ResolveStaffByEmail(ctx context.Context, in *ResolveStaffByEmailRequest, opts ...grpc.CallOption) (*ResolveStaffByEmailResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ResolveStaffByEmailResponse)
@@ -227,6 +308,16 @@ func (c *controlPlaneServiceClient) ResolveStaffByEmail(ctx context.Context, in
 	return out, nil
 }
 
+func (c *controlPlaneServiceClient) ResolveStaffByGitHubLogin(ctx context.Context, in *ResolveStaffByGitHubLoginRequest, opts ...grpc.CallOption) (*ResolveStaffByGitHubLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolveStaffByGitHubLoginResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_ResolveStaffByGitHubLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlPlaneServiceClient) AuthorizeOAuthUser(ctx context.Context, in *AuthorizeOAuthUserRequest, opts ...grpc.CallOption) (*AuthorizeOAuthUserResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(AuthorizeOAuthUserResponse)
@@ -237,6 +328,26 @@ func (c *controlPlaneServiceClient) AuthorizeOAuthUser(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *controlPlaneServiceClient) AuthorizeOIDCUser(ctx context.Context, in *AuthorizeOIDCUserRequest, opts ...grpc.CallOption) (*AuthorizeOIDCUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthorizeOIDCUserResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_AuthorizeOIDCUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) AuthenticateStaffAPIToken(ctx context.Context, in *AuthenticateStaffAPITokenRequest, opts ...grpc.CallOption) (*AuthenticateStaffAPITokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AuthenticateStaffAPITokenResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_AuthenticateStaffAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlPlaneServiceClient) ListProjects(ctx context.Context, in *ListProjectsRequest, opts ...grpc.CallOption) (*ListProjectsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProjectsResponse)
@@ -437,6 +548,46 @@ func (c *controlPlaneServiceClient) DeleteUser(ctx context.Context, in *DeleteUs
 	return out, nil
 }
 
+func (c *controlPlaneServiceClient) CreateServiceAccount(ctx context.Context, in *CreateServiceAccountRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, ControlPlaneService_CreateServiceAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) CreateStaffAPIToken(ctx context.Context, in *CreateStaffAPITokenRequest, opts ...grpc.CallOption) (*CreateStaffAPITokenResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateStaffAPITokenResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_CreateStaffAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) ListStaffAPITokens(ctx context.Context, in *ListStaffAPITokensRequest, opts ...grpc.CallOption) (*ListStaffAPITokensResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListStaffAPITokensResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_ListStaffAPITokens_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) RevokeStaffAPIToken(ctx context.Context, in *RevokeStaffAPITokenRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ControlPlaneService_RevokeStaffAPIToken_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlPlaneServiceClient) ListProjectMembers(ctx context.Context, in *ListProjectMembersRequest, opts ...grpc.CallOption) (*ListProjectMembersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProjectMembersResponse)
@@ -477,6 +628,66 @@ func (c *controlPlaneServiceClient) SetProjectMemberLearningModeOverride(ctx con
 	return out, nil
 }
 
+func (c *controlPlaneServiceClient) ListProjectRoles(ctx context.Context, in *ListProjectRolesRequest, opts ...grpc.CallOption) (*ListProjectRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListProjectRolesResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_ListProjectRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) UpsertProjectRole(ctx context.Context, in *UpsertProjectRoleRequest, opts ...grpc.CallOption) (*ProjectRole, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectRole)
+	err := c.cc.Invoke(ctx, ControlPlaneService_UpsertProjectRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) DeleteProjectRole(ctx context.Context, in *DeleteProjectRoleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ControlPlaneService_DeleteProjectRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) ListMCPApprovalPolicies(ctx context.Context, in *ListMCPApprovalPoliciesRequest, opts ...grpc.CallOption) (*ListMCPApprovalPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMCPApprovalPoliciesResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_ListMCPApprovalPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) UpsertMCPApprovalPolicy(ctx context.Context, in *UpsertMCPApprovalPolicyRequest, opts ...grpc.CallOption) (*MCPApprovalPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MCPApprovalPolicy)
+	err := c.cc.Invoke(ctx, ControlPlaneService_UpsertMCPApprovalPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) DeleteMCPApprovalPolicy(ctx context.Context, in *DeleteMCPApprovalPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ControlPlaneService_DeleteMCPApprovalPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlPlaneServiceClient) ListProjectRepositories(ctx context.Context, in *ListProjectRepositoriesRequest, opts ...grpc.CallOption) (*ListProjectRepositoriesResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListProjectRepositoriesResponse)
@@ -707,6 +918,46 @@ func (c *controlPlaneServiceClient) UpsertChangeGovernanceEvidenceSignal(ctx con
 	return out, nil
 }
 
+func (c *controlPlaneServiceClient) GetChangeGovernancePackage(ctx context.Context, in *GetChangeGovernancePackageRequest, opts ...grpc.CallOption) (*ChangeGovernancePackage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeGovernancePackage)
+	err := c.cc.Invoke(ctx, ControlPlaneService_GetChangeGovernancePackage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) SubmitChangeGovernanceWaiverDecision(ctx context.Context, in *SubmitChangeGovernanceWaiverDecisionRequest, opts ...grpc.CallOption) (*ChangeGovernancePackage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeGovernancePackage)
+	err := c.cc.Invoke(ctx, ControlPlaneService_SubmitChangeGovernanceWaiverDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) SubmitChangeGovernanceReleaseReadinessDecision(ctx context.Context, in *SubmitChangeGovernanceReleaseReadinessDecisionRequest, opts ...grpc.CallOption) (*ChangeGovernancePackage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeGovernancePackage)
+	err := c.cc.Invoke(ctx, ControlPlaneService_SubmitChangeGovernanceReleaseReadinessDecision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) ReportChangeGovernanceFeedback(ctx context.Context, in *ReportChangeGovernanceFeedbackRequest, opts ...grpc.CallOption) (*ChangeGovernancePackage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChangeGovernancePackage)
+	err := c.cc.Invoke(ctx, ControlPlaneService_ReportChangeGovernanceFeedback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlPlaneServiceClient) GetMissionControlWorkspace(ctx context.Context, in *GetMissionControlWorkspaceRequest, opts ...grpc.CallOption) (*GetMissionControlWorkspaceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMissionControlWorkspaceResponse)
@@ -867,6 +1118,46 @@ func (c *controlPlaneServiceClient) MarkMissionControlCommandFailed(ctx context.
 	return out, nil
 }
 
+func (c *controlPlaneServiceClient) CreateMissionControlVoiceCandidate(ctx context.Context, in *CreateMissionControlVoiceCandidateRequest, opts ...grpc.CallOption) (*MissionControlVoiceCandidate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MissionControlVoiceCandidate)
+	err := c.cc.Invoke(ctx, ControlPlaneService_CreateMissionControlVoiceCandidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) ListMissionControlVoiceCandidates(ctx context.Context, in *ListMissionControlVoiceCandidatesRequest, opts ...grpc.CallOption) (*ListMissionControlVoiceCandidatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMissionControlVoiceCandidatesResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_ListMissionControlVoiceCandidates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) PromoteMissionControlVoiceCandidate(ctx context.Context, in *PromoteMissionControlVoiceCandidateRequest, opts ...grpc.CallOption) (*PromoteMissionControlVoiceCandidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PromoteMissionControlVoiceCandidateResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_PromoteMissionControlVoiceCandidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) RejectMissionControlVoiceCandidate(ctx context.Context, in *RejectMissionControlVoiceCandidateRequest, opts ...grpc.CallOption) (*MissionControlVoiceCandidate, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MissionControlVoiceCandidate)
+	err := c.cc.Invoke(ctx, ControlPlaneService_RejectMissionControlVoiceCandidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlPlaneServiceClient) SubmitInteractionCallback(ctx context.Context, in *SubmitInteractionCallbackRequest, opts ...grpc.CallOption) (*SubmitInteractionCallbackResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(SubmitInteractionCallbackResponse)
@@ -927,6 +1218,16 @@ func (c *controlPlaneServiceClient) StopRuntimeDeployTask(ctx context.Context, i
 	return out, nil
 }
 
+func (c *controlPlaneServiceClient) PreviewRuntimeDeploy(ctx context.Context, in *PreviewRuntimeDeployRequest, opts ...grpc.CallOption) (*PreviewRuntimeDeployResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PreviewRuntimeDeployResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_PreviewRuntimeDeploy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlPlaneServiceClient) ListRuntimeErrors(ctx context.Context, in *ListRuntimeErrorsRequest, opts ...grpc.CallOption) (*ListRuntimeErrorsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListRuntimeErrorsResponse)
@@ -947,6 +1248,96 @@ func (c *controlPlaneServiceClient) MarkRuntimeErrorViewed(ctx context.Context,
 	return out, nil
 }
 
+func (c *controlPlaneServiceClient) ListRuntimeErrorGroups(ctx context.Context, in *ListRuntimeErrorGroupsRequest, opts ...grpc.CallOption) (*ListRuntimeErrorGroupsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRuntimeErrorGroupsResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_ListRuntimeErrorGroups_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) UpdateRuntimeErrorGroupStatus(ctx context.Context, in *UpdateRuntimeErrorGroupStatusRequest, opts ...grpc.CallOption) (*RuntimeErrorGroup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuntimeErrorGroup)
+	err := c.cc.Invoke(ctx, ControlPlaneService_UpdateRuntimeErrorGroupStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) EscalateRuntimeErrorGroup(ctx context.Context, in *EscalateRuntimeErrorGroupRequest, opts ...grpc.CallOption) (*RuntimeErrorGroup, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RuntimeErrorGroup)
+	err := c.cc.Invoke(ctx, ControlPlaneService_EscalateRuntimeErrorGroup_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) ListRetentionPolicies(ctx context.Context, in *ListRetentionPoliciesRequest, opts ...grpc.CallOption) (*ListRetentionPoliciesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRetentionPoliciesResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_ListRetentionPolicies_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) UpsertRetentionPolicy(ctx context.Context, in *UpsertRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RetentionPolicy)
+	err := c.cc.Invoke(ctx, ControlPlaneService_UpsertRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) DeleteRetentionPolicy(ctx context.Context, in *DeleteRetentionPolicyRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ControlPlaneService_DeleteRetentionPolicy_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) SetFlowEventRetentionPin(ctx context.Context, in *SetFlowEventRetentionPinRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ControlPlaneService_SetFlowEventRetentionPin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) RunRetentionSweep(ctx context.Context, in *RunRetentionSweepRequest, opts ...grpc.CallOption) (*RunRetentionSweepResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunRetentionSweepResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_RunRetentionSweep_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) GetRunOutcomeAnalytics(ctx context.Context, in *GetRunOutcomeAnalyticsRequest, opts ...grpc.CallOption) (*RunOutcomeAnalytics, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RunOutcomeAnalytics)
+	err := c.cc.Invoke(ctx, ControlPlaneService_GetRunOutcomeAnalytics_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlPlaneServiceClient) UpsertAgentSession(ctx context.Context, in *UpsertAgentSessionRequest, opts ...grpc.CallOption) (*UpsertAgentSessionResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpsertAgentSessionResponse)
@@ -987,6 +1378,36 @@ func (c *controlPlaneServiceClient) GetRunGitHubRateLimitResumePayload(ctx conte
 	return out, nil
 }
 
+func (c *controlPlaneServiceClient) GetRunApprovalResumePayload(ctx context.Context, in *GetRunApprovalResumePayloadRequest, opts ...grpc.CallOption) (*GetRunApprovalResumePayloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRunApprovalResumePayloadResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_GetRunApprovalResumePayload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) SuspendRunForApproval(ctx context.Context, in *SuspendRunForApprovalRequest, opts ...grpc.CallOption) (*SuspendRunForApprovalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SuspendRunForApprovalResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_SuspendRunForApproval_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlPlaneServiceClient) ListRunProjectMCPServers(ctx context.Context, in *ListRunProjectMCPServersRequest, opts ...grpc.CallOption) (*ListRunProjectMCPServersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRunProjectMCPServersResponse)
+	err := c.cc.Invoke(ctx, ControlPlaneService_ListRunProjectMCPServers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *controlPlaneServiceClient) LookupRunPullRequest(ctx context.Context, in *LookupRunPullRequestRequest, opts ...grpc.CallOption) (*LookupRunPullRequestResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(LookupRunPullRequestResponse)
@@ -1052,10 +1473,15 @@ func (c *controlPlaneServiceClient) DeleteRunNamespace(ctx context.Context, in *
 // for forward compatibility.
 type ControlPlaneServiceServer interface {
 	IngestGitHubWebhook(context.Context, *IngestGitHubWebhookRequest) (*IngestGitHubWebhookResponse, error)
+	IngestAlertmanagerWebhook(context.Context, *IngestAlertmanagerWebhookRequest) (*IngestAlertmanagerWebhookResponse, error)
 	// Used by api-gateway staff auth when oauth2-proxy injects identity headers.
 	ResolveStaffByEmail(context.Context, *ResolveStaffByEmailRequest) (*ResolveStaffByEmailResponse, error)
+	// Used by chat adapters to act on behalf of the staff user bound to a chat.
+	ResolveStaffByGitHubLogin(context.Context, *ResolveStaffByGitHubLoginRequest) (*ResolveStaffByGitHubLoginResponse, error)
 	// Used by api-gateway OAuth callback to enforce allowlist and persist GitHub identity.
 	AuthorizeOAuthUser(context.Context, *AuthorizeOAuthUserRequest) (*AuthorizeOAuthUserResponse, error)
+	AuthorizeOIDCUser(context.Context, *AuthorizeOIDCUserRequest) (*AuthorizeOIDCUserResponse, error)
+	AuthenticateStaffAPIToken(context.Context, *AuthenticateStaffAPITokenRequest) (*AuthenticateStaffAPITokenResponse, error)
 	ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error)
 	UpsertProject(context.Context, *UpsertProjectRequest) (*Project, error)
 	GetProject(context.Context, *GetProjectRequest) (*Project, error)
@@ -1076,10 +1502,20 @@ type ControlPlaneServiceServer interface {
 	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
 	CreateUser(context.Context, *CreateUserRequest) (*User, error)
 	DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error)
+	CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*User, error)
+	CreateStaffAPIToken(context.Context, *CreateStaffAPITokenRequest) (*CreateStaffAPITokenResponse, error)
+	ListStaffAPITokens(context.Context, *ListStaffAPITokensRequest) (*ListStaffAPITokensResponse, error)
+	RevokeStaffAPIToken(context.Context, *RevokeStaffAPITokenRequest) (*emptypb.Empty, error)
 	ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error)
 	UpsertProjectMember(context.Context, *UpsertProjectMemberRequest) (*emptypb.Empty, error)
 	DeleteProjectMember(context.Context, *DeleteProjectMemberRequest) (*emptypb.Empty, error)
 	SetProjectMemberLearningModeOverride(context.Context, *SetProjectMemberLearningModeOverrideRequest) (*emptypb.Empty, error)
+	ListProjectRoles(context.Context, *ListProjectRolesRequest) (*ListProjectRolesResponse, error)
+	UpsertProjectRole(context.Context, *UpsertProjectRoleRequest) (*ProjectRole, error)
+	DeleteProjectRole(context.Context, *DeleteProjectRoleRequest) (*emptypb.Empty, error)
+	ListMCPApprovalPolicies(context.Context, *ListMCPApprovalPoliciesRequest) (*ListMCPApprovalPoliciesResponse, error)
+	UpsertMCPApprovalPolicy(context.Context, *UpsertMCPApprovalPolicyRequest) (*MCPApprovalPolicy, error)
+	DeleteMCPApprovalPolicy(context.Context, *DeleteMCPApprovalPolicyRequest) (*emptypb.Empty, error)
 	ListProjectRepositories(context.Context, *ListProjectRepositoriesRequest) (*ListProjectRepositoriesResponse, error)
 	UpsertProjectRepository(context.Context, *UpsertProjectRepositoryRequest) (*RepositoryBinding, error)
 	DeleteProjectRepository(context.Context, *DeleteProjectRepositoryRequest) (*emptypb.Empty, error)
@@ -1103,6 +1539,10 @@ type ControlPlaneServiceServer interface {
 	ReportChangeGovernanceDraftSignal(context.Context, *ReportChangeGovernanceDraftSignalRequest) (*ReportChangeGovernanceDraftSignalResponse, error)
 	PublishChangeGovernanceWaveMap(context.Context, *PublishChangeGovernanceWaveMapRequest) (*PublishChangeGovernanceWaveMapResponse, error)
 	UpsertChangeGovernanceEvidenceSignal(context.Context, *UpsertChangeGovernanceEvidenceSignalRequest) (*UpsertChangeGovernanceEvidenceSignalResponse, error)
+	GetChangeGovernancePackage(context.Context, *GetChangeGovernancePackageRequest) (*ChangeGovernancePackage, error)
+	SubmitChangeGovernanceWaiverDecision(context.Context, *SubmitChangeGovernanceWaiverDecisionRequest) (*ChangeGovernancePackage, error)
+	SubmitChangeGovernanceReleaseReadinessDecision(context.Context, *SubmitChangeGovernanceReleaseReadinessDecisionRequest) (*ChangeGovernancePackage, error)
+	ReportChangeGovernanceFeedback(context.Context, *ReportChangeGovernanceFeedbackRequest) (*ChangeGovernancePackage, error)
 	GetMissionControlWorkspace(context.Context, *GetMissionControlWorkspaceRequest) (*GetMissionControlWorkspaceResponse, error)
 	GetMissionControlNode(context.Context, *GetMissionControlNodeRequest) (*MissionControlNodeDetails, error)
 	ListMissionControlNodeActivity(context.Context, *ListMissionControlNodeActivityRequest) (*ListMissionControlNodeActivityResponse, error)
@@ -1119,19 +1559,36 @@ type ControlPlaneServiceServer interface {
 	MarkMissionControlCommandPendingSync(context.Context, *MarkMissionControlCommandPendingSyncRequest) (*MissionControlCommandState, error)
 	MarkMissionControlCommandReconciled(context.Context, *MarkMissionControlCommandReconciledRequest) (*MissionControlCommandState, error)
 	MarkMissionControlCommandFailed(context.Context, *MarkMissionControlCommandFailedRequest) (*MissionControlCommandState, error)
+	CreateMissionControlVoiceCandidate(context.Context, *CreateMissionControlVoiceCandidateRequest) (*MissionControlVoiceCandidate, error)
+	ListMissionControlVoiceCandidates(context.Context, *ListMissionControlVoiceCandidatesRequest) (*ListMissionControlVoiceCandidatesResponse, error)
+	PromoteMissionControlVoiceCandidate(context.Context, *PromoteMissionControlVoiceCandidateRequest) (*PromoteMissionControlVoiceCandidateResponse, error)
+	RejectMissionControlVoiceCandidate(context.Context, *RejectMissionControlVoiceCandidateRequest) (*MissionControlVoiceCandidate, error)
 	SubmitInteractionCallback(context.Context, *SubmitInteractionCallbackRequest) (*SubmitInteractionCallbackResponse, error)
 	SubmitAdapterInteractionCallback(context.Context, *SubmitInteractionCallbackRequest) (*SubmitInteractionCallbackResponse, error)
 	ListRuntimeDeployTasks(context.Context, *ListRuntimeDeployTasksRequest) (*ListRuntimeDeployTasksResponse, error)
 	GetRuntimeDeployTask(context.Context, *GetRuntimeDeployTaskRequest) (*RuntimeDeployTask, error)
 	CancelRuntimeDeployTask(context.Context, *CancelRuntimeDeployTaskRequest) (*RuntimeDeployTaskActionResponse, error)
 	StopRuntimeDeployTask(context.Context, *StopRuntimeDeployTaskRequest) (*RuntimeDeployTaskActionResponse, error)
+	PreviewRuntimeDeploy(context.Context, *PreviewRuntimeDeployRequest) (*PreviewRuntimeDeployResponse, error)
 	ListRuntimeErrors(context.Context, *ListRuntimeErrorsRequest) (*ListRuntimeErrorsResponse, error)
 	MarkRuntimeErrorViewed(context.Context, *MarkRuntimeErrorViewedRequest) (*RuntimeError, error)
+	ListRuntimeErrorGroups(context.Context, *ListRuntimeErrorGroupsRequest) (*ListRuntimeErrorGroupsResponse, error)
+	UpdateRuntimeErrorGroupStatus(context.Context, *UpdateRuntimeErrorGroupStatusRequest) (*RuntimeErrorGroup, error)
+	EscalateRuntimeErrorGroup(context.Context, *EscalateRuntimeErrorGroupRequest) (*RuntimeErrorGroup, error)
+	ListRetentionPolicies(context.Context, *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error)
+	UpsertRetentionPolicy(context.Context, *UpsertRetentionPolicyRequest) (*RetentionPolicy, error)
+	DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyRequest) (*emptypb.Empty, error)
+	SetFlowEventRetentionPin(context.Context, *SetFlowEventRetentionPinRequest) (*emptypb.Empty, error)
+	RunRetentionSweep(context.Context, *RunRetentionSweepRequest) (*RunRetentionSweepResponse, error)
+	GetRunOutcomeAnalytics(context.Context, *GetRunOutcomeAnalyticsRequest) (*RunOutcomeAnalytics, error)
 	// Used by agent-runner for run-bound session persistence and event callbacks.
 	UpsertAgentSession(context.Context, *UpsertAgentSessionRequest) (*UpsertAgentSessionResponse, error)
 	GetLatestAgentSession(context.Context, *GetLatestAgentSessionRequest) (*GetLatestAgentSessionResponse, error)
 	GetRunInteractionResumePayload(context.Context, *GetRunInteractionResumePayloadRequest) (*GetRunInteractionResumePayloadResponse, error)
 	GetRunGitHubRateLimitResumePayload(context.Context, *GetRunGitHubRateLimitResumePayloadRequest) (*GetRunGitHubRateLimitResumePayloadResponse, error)
+	GetRunApprovalResumePayload(context.Context, *GetRunApprovalResumePayloadRequest) (*GetRunApprovalResumePayloadResponse, error)
+	SuspendRunForApproval(context.Context, *SuspendRunForApprovalRequest) (*SuspendRunForApprovalResponse, error)
+	ListRunProjectMCPServers(context.Context, *ListRunProjectMCPServersRequest) (*ListRunProjectMCPServersResponse, error)
 	LookupRunPullRequest(context.Context, *LookupRunPullRequestRequest) (*LookupRunPullRequestResponse, error)
 	InsertRunFlowEvent(context.Context, *InsertRunFlowEventRequest) (*InsertRunFlowEventResponse, error)
 	UpsertRunStatusComment(context.Context, *UpsertRunStatusCommentRequest) (*UpsertRunStatusCommentResponse, error)
@@ -1151,12 +1608,24 @@ type UnimplementedControlPlaneServiceServer struct{}
 func (UnimplementedControlPlaneServiceServer) IngestGitHubWebhook(context.Context, *IngestGitHubWebhookRequest) (*IngestGitHubWebhookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IngestGitHubWebhook not implemented")
 }
+func (UnimplementedControlPlaneServiceServer) IngestAlertmanagerWebhook(context.Context, *IngestAlertmanagerWebhookRequest) (*IngestAlertmanagerWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IngestAlertmanagerWebhook not implemented")
+}
 func (UnimplementedControlPlaneServiceServer) ResolveStaffByEmail(context.Context, *ResolveStaffByEmailRequest) (*ResolveStaffByEmailResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResolveStaffByEmail not implemented")
 }
+func (UnimplementedControlPlaneServiceServer) ResolveStaffByGitHubLogin(context.Context, *ResolveStaffByGitHubLoginRequest) (*ResolveStaffByGitHubLoginResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveStaffByGitHubLogin not implemented")
+}
 func (UnimplementedControlPlaneServiceServer) AuthorizeOAuthUser(context.Context, *AuthorizeOAuthUserRequest) (*AuthorizeOAuthUserResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeOAuthUser not implemented")
 }
+func (UnimplementedControlPlaneServiceServer) AuthorizeOIDCUser(context.Context, *AuthorizeOIDCUserRequest) (*AuthorizeOIDCUserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizeOIDCUser not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) AuthenticateStaffAPIToken(context.Context, *AuthenticateStaffAPITokenRequest) (*AuthenticateStaffAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthenticateStaffAPIToken not implemented")
+}
 func (UnimplementedControlPlaneServiceServer) ListProjects(context.Context, *ListProjectsRequest) (*ListProjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjects not implemented")
 }
@@ -1217,7 +1686,19 @@ func (UnimplementedControlPlaneServiceServer) CreateUser(context.Context, *Creat
 func (UnimplementedControlPlaneServiceServer) DeleteUser(context.Context, *DeleteUserRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteUser not implemented")
 }
-func (UnimplementedControlPlaneServiceServer) ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error) {
+func (UnimplementedControlPlaneServiceServer) CreateServiceAccount(context.Context, *CreateServiceAccountRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateServiceAccount not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) CreateStaffAPIToken(context.Context, *CreateStaffAPITokenRequest) (*CreateStaffAPITokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStaffAPIToken not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) ListStaffAPITokens(context.Context, *ListStaffAPITokensRequest) (*ListStaffAPITokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListStaffAPITokens not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) RevokeStaffAPIToken(context.Context, *RevokeStaffAPITokenRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeStaffAPIToken not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) ListProjectMembers(context.Context, *ListProjectMembersRequest) (*ListProjectMembersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjectMembers not implemented")
 }
 func (UnimplementedControlPlaneServiceServer) UpsertProjectMember(context.Context, *UpsertProjectMemberRequest) (*emptypb.Empty, error) {
@@ -1229,6 +1710,24 @@ func (UnimplementedControlPlaneServiceServer) DeleteProjectMember(context.Contex
 func (UnimplementedControlPlaneServiceServer) SetProjectMemberLearningModeOverride(context.Context, *SetProjectMemberLearningModeOverrideRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetProjectMemberLearningModeOverride not implemented")
 }
+func (UnimplementedControlPlaneServiceServer) ListProjectRoles(context.Context, *ListProjectRolesRequest) (*ListProjectRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProjectRoles not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) UpsertProjectRole(context.Context, *UpsertProjectRoleRequest) (*ProjectRole, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertProjectRole not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) DeleteProjectRole(context.Context, *DeleteProjectRoleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteProjectRole not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) ListMCPApprovalPolicies(context.Context, *ListMCPApprovalPoliciesRequest) (*ListMCPApprovalPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMCPApprovalPolicies not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) UpsertMCPApprovalPolicy(context.Context, *UpsertMCPApprovalPolicyRequest) (*MCPApprovalPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertMCPApprovalPolicy not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) DeleteMCPApprovalPolicy(context.Context, *DeleteMCPApprovalPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMCPApprovalPolicy not implemented")
+}
 func (UnimplementedControlPlaneServiceServer) ListProjectRepositories(context.Context, *ListProjectRepositoriesRequest) (*ListProjectRepositoriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListProjectRepositories not implemented")
 }
@@ -1298,6 +1797,18 @@ func (UnimplementedControlPlaneServiceServer) PublishChangeGovernanceWaveMap(con
 func (UnimplementedControlPlaneServiceServer) UpsertChangeGovernanceEvidenceSignal(context.Context, *UpsertChangeGovernanceEvidenceSignalRequest) (*UpsertChangeGovernanceEvidenceSignalResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertChangeGovernanceEvidenceSignal not implemented")
 }
+func (UnimplementedControlPlaneServiceServer) GetChangeGovernancePackage(context.Context, *GetChangeGovernancePackageRequest) (*ChangeGovernancePackage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetChangeGovernancePackage not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) SubmitChangeGovernanceWaiverDecision(context.Context, *SubmitChangeGovernanceWaiverDecisionRequest) (*ChangeGovernancePackage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitChangeGovernanceWaiverDecision not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) SubmitChangeGovernanceReleaseReadinessDecision(context.Context, *SubmitChangeGovernanceReleaseReadinessDecisionRequest) (*ChangeGovernancePackage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitChangeGovernanceReleaseReadinessDecision not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) ReportChangeGovernanceFeedback(context.Context, *ReportChangeGovernanceFeedbackRequest) (*ChangeGovernancePackage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportChangeGovernanceFeedback not implemented")
+}
 func (UnimplementedControlPlaneServiceServer) GetMissionControlWorkspace(context.Context, *GetMissionControlWorkspaceRequest) (*GetMissionControlWorkspaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMissionControlWorkspace not implemented")
 }
@@ -1346,6 +1857,18 @@ func (UnimplementedControlPlaneServiceServer) MarkMissionControlCommandReconcile
 func (UnimplementedControlPlaneServiceServer) MarkMissionControlCommandFailed(context.Context, *MarkMissionControlCommandFailedRequest) (*MissionControlCommandState, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkMissionControlCommandFailed not implemented")
 }
+func (UnimplementedControlPlaneServiceServer) CreateMissionControlVoiceCandidate(context.Context, *CreateMissionControlVoiceCandidateRequest) (*MissionControlVoiceCandidate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMissionControlVoiceCandidate not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) ListMissionControlVoiceCandidates(context.Context, *ListMissionControlVoiceCandidatesRequest) (*ListMissionControlVoiceCandidatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMissionControlVoiceCandidates not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) PromoteMissionControlVoiceCandidate(context.Context, *PromoteMissionControlVoiceCandidateRequest) (*PromoteMissionControlVoiceCandidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteMissionControlVoiceCandidate not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) RejectMissionControlVoiceCandidate(context.Context, *RejectMissionControlVoiceCandidateRequest) (*MissionControlVoiceCandidate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectMissionControlVoiceCandidate not implemented")
+}
 func (UnimplementedControlPlaneServiceServer) SubmitInteractionCallback(context.Context, *SubmitInteractionCallbackRequest) (*SubmitInteractionCallbackResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SubmitInteractionCallback not implemented")
 }
@@ -1364,12 +1887,42 @@ func (UnimplementedControlPlaneServiceServer) CancelRuntimeDeployTask(context.Co
 func (UnimplementedControlPlaneServiceServer) StopRuntimeDeployTask(context.Context, *StopRuntimeDeployTaskRequest) (*RuntimeDeployTaskActionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopRuntimeDeployTask not implemented")
 }
+func (UnimplementedControlPlaneServiceServer) PreviewRuntimeDeploy(context.Context, *PreviewRuntimeDeployRequest) (*PreviewRuntimeDeployResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewRuntimeDeploy not implemented")
+}
 func (UnimplementedControlPlaneServiceServer) ListRuntimeErrors(context.Context, *ListRuntimeErrorsRequest) (*ListRuntimeErrorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListRuntimeErrors not implemented")
 }
 func (UnimplementedControlPlaneServiceServer) MarkRuntimeErrorViewed(context.Context, *MarkRuntimeErrorViewedRequest) (*RuntimeError, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MarkRuntimeErrorViewed not implemented")
 }
+func (UnimplementedControlPlaneServiceServer) ListRuntimeErrorGroups(context.Context, *ListRuntimeErrorGroupsRequest) (*ListRuntimeErrorGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRuntimeErrorGroups not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) UpdateRuntimeErrorGroupStatus(context.Context, *UpdateRuntimeErrorGroupStatusRequest) (*RuntimeErrorGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRuntimeErrorGroupStatus not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) EscalateRuntimeErrorGroup(context.Context, *EscalateRuntimeErrorGroupRequest) (*RuntimeErrorGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EscalateRuntimeErrorGroup not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) ListRetentionPolicies(context.Context, *ListRetentionPoliciesRequest) (*ListRetentionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRetentionPolicies not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) UpsertRetentionPolicy(context.Context, *UpsertRetentionPolicyRequest) (*RetentionPolicy, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertRetentionPolicy not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) DeleteRetentionPolicy(context.Context, *DeleteRetentionPolicyRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRetentionPolicy not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) SetFlowEventRetentionPin(context.Context, *SetFlowEventRetentionPinRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFlowEventRetentionPin not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) RunRetentionSweep(context.Context, *RunRetentionSweepRequest) (*RunRetentionSweepResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunRetentionSweep not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) GetRunOutcomeAnalytics(context.Context, *GetRunOutcomeAnalyticsRequest) (*RunOutcomeAnalytics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunOutcomeAnalytics not implemented")
+}
 func (UnimplementedControlPlaneServiceServer) UpsertAgentSession(context.Context, *UpsertAgentSessionRequest) (*UpsertAgentSessionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpsertAgentSession not implemented")
 }
@@ -1382,6 +1935,15 @@ func (UnimplementedControlPlaneServiceServer) GetRunInteractionResumePayload(con
 func (UnimplementedControlPlaneServiceServer) GetRunGitHubRateLimitResumePayload(context.Context, *GetRunGitHubRateLimitResumePayloadRequest) (*GetRunGitHubRateLimitResumePayloadResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRunGitHubRateLimitResumePayload not implemented")
 }
+func (UnimplementedControlPlaneServiceServer) GetRunApprovalResumePayload(context.Context, *GetRunApprovalResumePayloadRequest) (*GetRunApprovalResumePayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRunApprovalResumePayload not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) SuspendRunForApproval(context.Context, *SuspendRunForApprovalRequest) (*SuspendRunForApprovalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SuspendRunForApproval not implemented")
+}
+func (UnimplementedControlPlaneServiceServer) ListRunProjectMCPServers(context.Context, *ListRunProjectMCPServersRequest) (*ListRunProjectMCPServersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRunProjectMCPServers not implemented")
+}
 func (UnimplementedControlPlaneServiceServer) LookupRunPullRequest(context.Context, *LookupRunPullRequestRequest) (*LookupRunPullRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LookupRunPullRequest not implemented")
 }
@@ -1439,6 +2001,24 @@ func _ControlPlaneService_IngestGitHubWebhook_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlPlaneService_IngestAlertmanagerWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IngestAlertmanagerWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).IngestAlertmanagerWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_IngestAlertmanagerWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).IngestAlertmanagerWebhook(ctx, req.(*IngestAlertmanagerWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControlPlaneService_ResolveStaffByEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResolveStaffByEmailRequest)
 	if err := dec(in); err != nil {
@@ -1457,6 +2037,24 @@ func _ControlPlaneService_ResolveStaffByEmail_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlPlaneService_ResolveStaffByGitHubLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveStaffByGitHubLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).ResolveStaffByGitHubLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_ResolveStaffByGitHubLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).ResolveStaffByGitHubLogin(ctx, req.(*ResolveStaffByGitHubLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControlPlaneService_AuthorizeOAuthUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(AuthorizeOAuthUserRequest)
 	if err := dec(in); err != nil {
@@ -1475,6 +2073,42 @@ func _ControlPlaneService_AuthorizeOAuthUser_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlPlaneService_AuthorizeOIDCUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthorizeOIDCUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).AuthorizeOIDCUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_AuthorizeOIDCUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).AuthorizeOIDCUser(ctx, req.(*AuthorizeOIDCUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_AuthenticateStaffAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AuthenticateStaffAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).AuthenticateStaffAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_AuthenticateStaffAPIToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).AuthenticateStaffAPIToken(ctx, req.(*AuthenticateStaffAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControlPlaneService_ListProjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectsRequest)
 	if err := dec(in); err != nil {
@@ -1835,6 +2469,78 @@ func _ControlPlaneService_DeleteUser_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlPlaneService_CreateServiceAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateServiceAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).CreateServiceAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_CreateServiceAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).CreateServiceAccount(ctx, req.(*CreateServiceAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_CreateStaffAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateStaffAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).CreateStaffAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_CreateStaffAPIToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).CreateStaffAPIToken(ctx, req.(*CreateStaffAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_ListStaffAPITokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStaffAPITokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).ListStaffAPITokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_ListStaffAPITokens_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).ListStaffAPITokens(ctx, req.(*ListStaffAPITokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_RevokeStaffAPIToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeStaffAPITokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).RevokeStaffAPIToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_RevokeStaffAPIToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).RevokeStaffAPIToken(ctx, req.(*RevokeStaffAPITokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControlPlaneService_ListProjectMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectMembersRequest)
 	if err := dec(in); err != nil {
@@ -1907,6 +2613,114 @@ func _ControlPlaneService_SetProjectMemberLearningModeOverride_Handler(srv inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlPlaneService_ListProjectRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProjectRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).ListProjectRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_ListProjectRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).ListProjectRoles(ctx, req.(*ListProjectRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_UpsertProjectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).UpsertProjectRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_UpsertProjectRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).UpsertProjectRole(ctx, req.(*UpsertProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_DeleteProjectRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteProjectRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).DeleteProjectRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_DeleteProjectRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).DeleteProjectRole(ctx, req.(*DeleteProjectRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_ListMCPApprovalPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMCPApprovalPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).ListMCPApprovalPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_ListMCPApprovalPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).ListMCPApprovalPolicies(ctx, req.(*ListMCPApprovalPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_UpsertMCPApprovalPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertMCPApprovalPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).UpsertMCPApprovalPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_UpsertMCPApprovalPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).UpsertMCPApprovalPolicy(ctx, req.(*UpsertMCPApprovalPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_DeleteMCPApprovalPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMCPApprovalPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).DeleteMCPApprovalPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_DeleteMCPApprovalPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).DeleteMCPApprovalPolicy(ctx, req.(*DeleteMCPApprovalPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControlPlaneService_ListProjectRepositories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListProjectRepositoriesRequest)
 	if err := dec(in); err != nil {
@@ -2321,116 +3135,188 @@ func _ControlPlaneService_UpsertChangeGovernanceEvidenceSignal_Handler(srv inter
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControlPlaneService_GetMissionControlWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMissionControlWorkspaceRequest)
+func _ControlPlaneService_GetChangeGovernancePackage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetChangeGovernancePackageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlPlaneServiceServer).GetMissionControlWorkspace(ctx, in)
+		return srv.(ControlPlaneServiceServer).GetChangeGovernancePackage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ControlPlaneService_GetMissionControlWorkspace_FullMethodName,
+		FullMethod: ControlPlaneService_GetChangeGovernancePackage_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlPlaneServiceServer).GetMissionControlWorkspace(ctx, req.(*GetMissionControlWorkspaceRequest))
+		return srv.(ControlPlaneServiceServer).GetChangeGovernancePackage(ctx, req.(*GetChangeGovernancePackageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControlPlaneService_GetMissionControlNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMissionControlNodeRequest)
+func _ControlPlaneService_SubmitChangeGovernanceWaiverDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitChangeGovernanceWaiverDecisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlPlaneServiceServer).GetMissionControlNode(ctx, in)
+		return srv.(ControlPlaneServiceServer).SubmitChangeGovernanceWaiverDecision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ControlPlaneService_GetMissionControlNode_FullMethodName,
+		FullMethod: ControlPlaneService_SubmitChangeGovernanceWaiverDecision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlPlaneServiceServer).GetMissionControlNode(ctx, req.(*GetMissionControlNodeRequest))
+		return srv.(ControlPlaneServiceServer).SubmitChangeGovernanceWaiverDecision(ctx, req.(*SubmitChangeGovernanceWaiverDecisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControlPlaneService_ListMissionControlNodeActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMissionControlNodeActivityRequest)
+func _ControlPlaneService_SubmitChangeGovernanceReleaseReadinessDecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitChangeGovernanceReleaseReadinessDecisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlPlaneServiceServer).ListMissionControlNodeActivity(ctx, in)
+		return srv.(ControlPlaneServiceServer).SubmitChangeGovernanceReleaseReadinessDecision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ControlPlaneService_ListMissionControlNodeActivity_FullMethodName,
+		FullMethod: ControlPlaneService_SubmitChangeGovernanceReleaseReadinessDecision_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlPlaneServiceServer).ListMissionControlNodeActivity(ctx, req.(*ListMissionControlNodeActivityRequest))
+		return srv.(ControlPlaneServiceServer).SubmitChangeGovernanceReleaseReadinessDecision(ctx, req.(*SubmitChangeGovernanceReleaseReadinessDecisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControlPlaneService_PreviewMissionControlLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PreviewMissionControlLaunchRequest)
+func _ControlPlaneService_ReportChangeGovernanceFeedback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportChangeGovernanceFeedbackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlPlaneServiceServer).PreviewMissionControlLaunch(ctx, in)
+		return srv.(ControlPlaneServiceServer).ReportChangeGovernanceFeedback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ControlPlaneService_PreviewMissionControlLaunch_FullMethodName,
+		FullMethod: ControlPlaneService_ReportChangeGovernanceFeedback_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlPlaneServiceServer).PreviewMissionControlLaunch(ctx, req.(*PreviewMissionControlLaunchRequest))
+		return srv.(ControlPlaneServiceServer).ReportChangeGovernanceFeedback(ctx, req.(*ReportChangeGovernanceFeedbackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControlPlaneService_GetMissionControlSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMissionControlSnapshotRequest)
+func _ControlPlaneService_GetMissionControlWorkspace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMissionControlWorkspaceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlPlaneServiceServer).GetMissionControlSnapshot(ctx, in)
+		return srv.(ControlPlaneServiceServer).GetMissionControlWorkspace(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ControlPlaneService_GetMissionControlSnapshot_FullMethodName,
+		FullMethod: ControlPlaneService_GetMissionControlWorkspace_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlPlaneServiceServer).GetMissionControlSnapshot(ctx, req.(*GetMissionControlSnapshotRequest))
+		return srv.(ControlPlaneServiceServer).GetMissionControlWorkspace(ctx, req.(*GetMissionControlWorkspaceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControlPlaneService_GetMissionControlEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMissionControlEntityRequest)
+func _ControlPlaneService_GetMissionControlNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMissionControlNodeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(ControlPlaneServiceServer).GetMissionControlEntity(ctx, in)
+		return srv.(ControlPlaneServiceServer).GetMissionControlNode(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: ControlPlaneService_GetMissionControlEntity_FullMethodName,
+		FullMethod: ControlPlaneService_GetMissionControlNode_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ControlPlaneServiceServer).GetMissionControlEntity(ctx, req.(*GetMissionControlEntityRequest))
+		return srv.(ControlPlaneServiceServer).GetMissionControlNode(ctx, req.(*GetMissionControlNodeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _ControlPlaneService_ListMissionControlTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListMissionControlTimelineRequest)
+func _ControlPlaneService_ListMissionControlNodeActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMissionControlNodeActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).ListMissionControlNodeActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_ListMissionControlNodeActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).ListMissionControlNodeActivity(ctx, req.(*ListMissionControlNodeActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_PreviewMissionControlLaunch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewMissionControlLaunchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).PreviewMissionControlLaunch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_PreviewMissionControlLaunch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).PreviewMissionControlLaunch(ctx, req.(*PreviewMissionControlLaunchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_GetMissionControlSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMissionControlSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).GetMissionControlSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_GetMissionControlSnapshot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).GetMissionControlSnapshot(ctx, req.(*GetMissionControlSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_GetMissionControlEntity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMissionControlEntityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).GetMissionControlEntity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_GetMissionControlEntity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).GetMissionControlEntity(ctx, req.(*GetMissionControlEntityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_ListMissionControlTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMissionControlTimelineRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -2609,6 +3495,78 @@ func _ControlPlaneService_MarkMissionControlCommandFailed_Handler(srv interface{
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlPlaneService_CreateMissionControlVoiceCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMissionControlVoiceCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).CreateMissionControlVoiceCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_CreateMissionControlVoiceCandidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).CreateMissionControlVoiceCandidate(ctx, req.(*CreateMissionControlVoiceCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_ListMissionControlVoiceCandidates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMissionControlVoiceCandidatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).ListMissionControlVoiceCandidates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_ListMissionControlVoiceCandidates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).ListMissionControlVoiceCandidates(ctx, req.(*ListMissionControlVoiceCandidatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_PromoteMissionControlVoiceCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteMissionControlVoiceCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).PromoteMissionControlVoiceCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_PromoteMissionControlVoiceCandidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).PromoteMissionControlVoiceCandidate(ctx, req.(*PromoteMissionControlVoiceCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_RejectMissionControlVoiceCandidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectMissionControlVoiceCandidateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).RejectMissionControlVoiceCandidate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_RejectMissionControlVoiceCandidate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).RejectMissionControlVoiceCandidate(ctx, req.(*RejectMissionControlVoiceCandidateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControlPlaneService_SubmitInteractionCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SubmitInteractionCallbackRequest)
 	if err := dec(in); err != nil {
@@ -2717,6 +3675,24 @@ func _ControlPlaneService_StopRuntimeDeployTask_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlPlaneService_PreviewRuntimeDeploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewRuntimeDeployRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).PreviewRuntimeDeploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_PreviewRuntimeDeploy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).PreviewRuntimeDeploy(ctx, req.(*PreviewRuntimeDeployRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControlPlaneService_ListRuntimeErrors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListRuntimeErrorsRequest)
 	if err := dec(in); err != nil {
@@ -2753,6 +3729,168 @@ func _ControlPlaneService_MarkRuntimeErrorViewed_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlPlaneService_ListRuntimeErrorGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRuntimeErrorGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).ListRuntimeErrorGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_ListRuntimeErrorGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).ListRuntimeErrorGroups(ctx, req.(*ListRuntimeErrorGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_UpdateRuntimeErrorGroupStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRuntimeErrorGroupStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).UpdateRuntimeErrorGroupStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_UpdateRuntimeErrorGroupStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).UpdateRuntimeErrorGroupStatus(ctx, req.(*UpdateRuntimeErrorGroupStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_EscalateRuntimeErrorGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EscalateRuntimeErrorGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).EscalateRuntimeErrorGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_EscalateRuntimeErrorGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).EscalateRuntimeErrorGroup(ctx, req.(*EscalateRuntimeErrorGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_ListRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRetentionPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).ListRetentionPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_ListRetentionPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).ListRetentionPolicies(ctx, req.(*ListRetentionPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_UpsertRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).UpsertRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_UpsertRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).UpsertRetentionPolicy(ctx, req.(*UpsertRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_DeleteRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRetentionPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).DeleteRetentionPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_DeleteRetentionPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).DeleteRetentionPolicy(ctx, req.(*DeleteRetentionPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_SetFlowEventRetentionPin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFlowEventRetentionPinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).SetFlowEventRetentionPin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_SetFlowEventRetentionPin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).SetFlowEventRetentionPin(ctx, req.(*SetFlowEventRetentionPinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_RunRetentionSweep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunRetentionSweepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).RunRetentionSweep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_RunRetentionSweep_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).RunRetentionSweep(ctx, req.(*RunRetentionSweepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_GetRunOutcomeAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunOutcomeAnalyticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).GetRunOutcomeAnalytics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_GetRunOutcomeAnalytics_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).GetRunOutcomeAnalytics(ctx, req.(*GetRunOutcomeAnalyticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControlPlaneService_UpsertAgentSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpsertAgentSessionRequest)
 	if err := dec(in); err != nil {
@@ -2825,6 +3963,60 @@ func _ControlPlaneService_GetRunGitHubRateLimitResumePayload_Handler(srv interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ControlPlaneService_GetRunApprovalResumePayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRunApprovalResumePayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).GetRunApprovalResumePayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_GetRunApprovalResumePayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).GetRunApprovalResumePayload(ctx, req.(*GetRunApprovalResumePayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_SuspendRunForApproval_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SuspendRunForApprovalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).SuspendRunForApproval(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_SuspendRunForApproval_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).SuspendRunForApproval(ctx, req.(*SuspendRunForApprovalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlPlaneService_ListRunProjectMCPServers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRunProjectMCPServersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlPlaneServiceServer).ListRunProjectMCPServers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ControlPlaneService_ListRunProjectMCPServers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlPlaneServiceServer).ListRunProjectMCPServers(ctx, req.(*ListRunProjectMCPServersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ControlPlaneService_LookupRunPullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(LookupRunPullRequestRequest)
 	if err := dec(in); err != nil {
@@ -2944,14 +4136,30 @@ var ControlPlaneService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IngestGitHubWebhook",
 			Handler:    _ControlPlaneService_IngestGitHubWebhook_Handler,
 		},
+		{
+			MethodName: "IngestAlertmanagerWebhook",
+			Handler:    _ControlPlaneService_IngestAlertmanagerWebhook_Handler,
+		},
 		{
 			MethodName: "ResolveStaffByEmail",
 			Handler:    _ControlPlaneService_ResolveStaffByEmail_Handler,
 		},
+		{
+			MethodName: "ResolveStaffByGitHubLogin",
+			Handler:    _ControlPlaneService_ResolveStaffByGitHubLogin_Handler,
+		},
 		{
 			MethodName: "AuthorizeOAuthUser",
 			Handler:    _ControlPlaneService_AuthorizeOAuthUser_Handler,
 		},
+		{
+			MethodName: "AuthorizeOIDCUser",
+			Handler:    _ControlPlaneService_AuthorizeOIDCUser_Handler,
+		},
+		{
+			MethodName: "AuthenticateStaffAPIToken",
+			Handler:    _ControlPlaneService_AuthenticateStaffAPIToken_Handler,
+		},
 		{
 			MethodName: "ListProjects",
 			Handler:    _ControlPlaneService_ListProjects_Handler,
@@ -3032,6 +4240,22 @@ var ControlPlaneService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteUser",
 			Handler:    _ControlPlaneService_DeleteUser_Handler,
 		},
+		{
+			MethodName: "CreateServiceAccount",
+			Handler:    _ControlPlaneService_CreateServiceAccount_Handler,
+		},
+		{
+			MethodName: "CreateStaffAPIToken",
+			Handler:    _ControlPlaneService_CreateStaffAPIToken_Handler,
+		},
+		{
+			MethodName: "ListStaffAPITokens",
+			Handler:    _ControlPlaneService_ListStaffAPITokens_Handler,
+		},
+		{
+			MethodName: "RevokeStaffAPIToken",
+			Handler:    _ControlPlaneService_RevokeStaffAPIToken_Handler,
+		},
 		{
 			MethodName: "ListProjectMembers",
 			Handler:    _ControlPlaneService_ListProjectMembers_Handler,
@@ -3048,6 +4272,30 @@ var ControlPlaneService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SetProjectMemberLearningModeOverride",
 			Handler:    _ControlPlaneService_SetProjectMemberLearningModeOverride_Handler,
 		},
+		{
+			MethodName: "ListProjectRoles",
+			Handler:    _ControlPlaneService_ListProjectRoles_Handler,
+		},
+		{
+			MethodName: "UpsertProjectRole",
+			Handler:    _ControlPlaneService_UpsertProjectRole_Handler,
+		},
+		{
+			MethodName: "DeleteProjectRole",
+			Handler:    _ControlPlaneService_DeleteProjectRole_Handler,
+		},
+		{
+			MethodName: "ListMCPApprovalPolicies",
+			Handler:    _ControlPlaneService_ListMCPApprovalPolicies_Handler,
+		},
+		{
+			MethodName: "UpsertMCPApprovalPolicy",
+			Handler:    _ControlPlaneService_UpsertMCPApprovalPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteMCPApprovalPolicy",
+			Handler:    _ControlPlaneService_DeleteMCPApprovalPolicy_Handler,
+		},
 		{
 			MethodName: "ListProjectRepositories",
 			Handler:    _ControlPlaneService_ListProjectRepositories_Handler,
@@ -3140,6 +4388,22 @@ var ControlPlaneService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpsertChangeGovernanceEvidenceSignal",
 			Handler:    _ControlPlaneService_UpsertChangeGovernanceEvidenceSignal_Handler,
 		},
+		{
+			MethodName: "GetChangeGovernancePackage",
+			Handler:    _ControlPlaneService_GetChangeGovernancePackage_Handler,
+		},
+		{
+			MethodName: "SubmitChangeGovernanceWaiverDecision",
+			Handler:    _ControlPlaneService_SubmitChangeGovernanceWaiverDecision_Handler,
+		},
+		{
+			MethodName: "SubmitChangeGovernanceReleaseReadinessDecision",
+			Handler:    _ControlPlaneService_SubmitChangeGovernanceReleaseReadinessDecision_Handler,
+		},
+		{
+			MethodName: "ReportChangeGovernanceFeedback",
+			Handler:    _ControlPlaneService_ReportChangeGovernanceFeedback_Handler,
+		},
 		{
 			MethodName: "GetMissionControlWorkspace",
 			Handler:    _ControlPlaneService_GetMissionControlWorkspace_Handler,
@@ -3204,6 +4468,22 @@ var ControlPlaneService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkMissionControlCommandFailed",
 			Handler:    _ControlPlaneService_MarkMissionControlCommandFailed_Handler,
 		},
+		{
+			MethodName: "CreateMissionControlVoiceCandidate",
+			Handler:    _ControlPlaneService_CreateMissionControlVoiceCandidate_Handler,
+		},
+		{
+			MethodName: "ListMissionControlVoiceCandidates",
+			Handler:    _ControlPlaneService_ListMissionControlVoiceCandidates_Handler,
+		},
+		{
+			MethodName: "PromoteMissionControlVoiceCandidate",
+			Handler:    _ControlPlaneService_PromoteMissionControlVoiceCandidate_Handler,
+		},
+		{
+			MethodName: "RejectMissionControlVoiceCandidate",
+			Handler:    _ControlPlaneService_RejectMissionControlVoiceCandidate_Handler,
+		},
 		{
 			MethodName: "SubmitInteractionCallback",
 			Handler:    _ControlPlaneService_SubmitInteractionCallback_Handler,
@@ -3228,6 +4508,10 @@ var ControlPlaneService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopRuntimeDeployTask",
 			Handler:    _ControlPlaneService_StopRuntimeDeployTask_Handler,
 		},
+		{
+			MethodName: "PreviewRuntimeDeploy",
+			Handler:    _ControlPlaneService_PreviewRuntimeDeploy_Handler,
+		},
 		{
 			MethodName: "ListRuntimeErrors",
 			Handler:    _ControlPlaneService_ListRuntimeErrors_Handler,
@@ -3236,6 +4520,42 @@ var ControlPlaneService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MarkRuntimeErrorViewed",
 			Handler:    _ControlPlaneService_MarkRuntimeErrorViewed_Handler,
 		},
+		{
+			MethodName: "ListRuntimeErrorGroups",
+			Handler:    _ControlPlaneService_ListRuntimeErrorGroups_Handler,
+		},
+		{
+			MethodName: "UpdateRuntimeErrorGroupStatus",
+			Handler:    _ControlPlaneService_UpdateRuntimeErrorGroupStatus_Handler,
+		},
+		{
+			MethodName: "EscalateRuntimeErrorGroup",
+			Handler:    _ControlPlaneService_EscalateRuntimeErrorGroup_Handler,
+		},
+		{
+			MethodName: "ListRetentionPolicies",
+			Handler:    _ControlPlaneService_ListRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "UpsertRetentionPolicy",
+			Handler:    _ControlPlaneService_UpsertRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "DeleteRetentionPolicy",
+			Handler:    _ControlPlaneService_DeleteRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "SetFlowEventRetentionPin",
+			Handler:    _ControlPlaneService_SetFlowEventRetentionPin_Handler,
+		},
+		{
+			MethodName: "RunRetentionSweep",
+			Handler:    _ControlPlaneService_RunRetentionSweep_Handler,
+		},
+		{
+			MethodName: "GetRunOutcomeAnalytics",
+			Handler:    _ControlPlaneService_GetRunOutcomeAnalytics_Handler,
+		},
 		{
 			MethodName: "UpsertAgentSession",
 			Handler:    _ControlPlaneService_UpsertAgentSession_Handler,
@@ -3252,6 +4572,18 @@ var ControlPlaneService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRunGitHubRateLimitResumePayload",
 			Handler:    _ControlPlaneService_GetRunGitHubRateLimitResumePayload_Handler,
 		},
+		{
+			MethodName: "GetRunApprovalResumePayload",
+			Handler:    _ControlPlaneService_GetRunApprovalResumePayload_Handler,
+		},
+		{
+			MethodName: "SuspendRunForApproval",
+			Handler:    _ControlPlaneService_SuspendRunForApproval_Handler,
+		},
+		{
+			MethodName: "ListRunProjectMCPServers",
+			Handler:    _ControlPlaneService_ListRunProjectMCPServers_Handler,
+		},
 		{
 			MethodName: "LookupRunPullRequest",
 			Handler:    _ControlPlaneService_LookupRunPullRequest_Handler,
@@ -45,6 +45,11 @@ func Run() error {
 		JWTSigningKey:           []byte(cfg.JWTSigningKey),
 		JWTTTL:                  jwtTTL,
 		CookieSecure:            cfg.CookieSecure,
+		OIDCIssuerURL:           cfg.OIDCIssuerURL,
+		OIDCClientID:            cfg.OIDCClientID,
+		OIDCClientSecret:        cfg.OIDCClientSecret,
+		OIDCScopes:              cfg.OIDCScopes,
+		OIDCGroupsClaim:         cfg.OIDCGroupsClaim,
 	}, cp)
 	if err != nil {
 		return fmt.Errorf("init auth service: %w", err)
@@ -53,6 +58,7 @@ func Run() error {
 		HTTPAddr:                 cfg.HTTPAddr,
 		GitHubWebhookSecret:      cfg.GitHubWebhookSecret,
 		MCPCallbackToken:         strings.TrimSpace(cfg.MCPCallbackToken),
+		AlertmanagerWebhookToken: strings.TrimSpace(cfg.AlertmanagerWebhookToken),
 		MaxBodyBytes:             cfg.WebhookMaxBodyBytes,
 		CookieSecure:             cfg.CookieSecure,
 		StaticDir:                "/app/web",
@@ -33,6 +33,18 @@ type Config struct {
 	// GitHubOAuthClientSecret is GitHub OAuth App client secret.
 	GitHubOAuthClientSecret string `env:"KODEX_GITHUB_OAUTH_CLIENT_SECRET,required,notEmpty"`
 
+	// OIDCIssuerURL enables generic OIDC single sign-on next to GitHub OAuth, e.g. https://sso.example.com/realms/kodex.
+	// Discovery, PKCE and ID token verification use this issuer; empty value disables OIDC login.
+	OIDCIssuerURL string `env:"KODEX_OIDC_ISSUER_URL"`
+	// OIDCClientID is the OIDC client id registered for `<PublicBaseURL>/api/v1/auth/oidc/callback`.
+	OIDCClientID string `env:"KODEX_OIDC_CLIENT_ID"`
+	// OIDCClientSecret is the OIDC client secret (optional for public clients with PKCE).
+	OIDCClientSecret string `env:"KODEX_OIDC_CLIENT_SECRET"`
+	// OIDCScopes are requested OIDC scopes.
+	OIDCScopes []string `env:"KODEX_OIDC_SCOPES" envDefault:"openid,profile,email"`
+	// OIDCGroupsClaim is the ID token claim carrying user groups for role mapping.
+	OIDCGroupsClaim string `env:"KODEX_OIDC_GROUPS_CLAIM" envDefault:"groups"`
+
 	// JWTSigningKey is the HMAC key for staff JWT tokens.
 	JWTSigningKey string `env:"KODEX_JWT_SIGNING_KEY,required,notEmpty"`
 	// JWTTTL is the short-lived JWT TTL duration, e.g. 15m.
@@ -45,6 +57,9 @@ type Config struct {
 	// MCPCallbackToken is shared token for external approver/executor callback contracts.
 	// If empty, callback endpoints work without token auth (network perimeter restrictions are expected).
 	MCPCallbackToken string `env:"KODEX_MCP_CALLBACK_TOKEN"`
+	// AlertmanagerWebhookToken is bearer token for Alertmanager webhook receivers.
+	// If empty, Alertmanager ingestion endpoint rejects all requests.
+	AlertmanagerWebhookToken string `env:"KODEX_ALERTMANAGER_WEBHOOK_TOKEN"`
 	// WebhookMaxBodyBytes limits accepted webhook payload size.
 	WebhookMaxBodyBytes int64 `env:"KODEX_WEBHOOK_MAX_BODY_BYTES" envDefault:"1048576"`
 }
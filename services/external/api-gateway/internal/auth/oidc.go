@@ -0,0 +1,319 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+	"golang.org/x/oauth2"
+)
+
+const (
+	oidcDiscoveryPath     = "/.well-known/openid-configuration"
+	oidcJWKSRefreshPeriod = time.Minute
+	oidcClockLeeway       = 30 * time.Second
+)
+
+// oidcDiscovery is the subset of OpenID Provider metadata used by staff login.
+type oidcDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+type oidcJWKS struct {
+	Keys []oidcJWK `json:"keys"`
+}
+
+type oidcJWK struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// oidcIdentity is a verified subset of ID token claims.
+type oidcIdentity struct {
+	Issuer            string
+	Subject           string
+	Email             string
+	PreferredUsername string
+	Groups            []string
+}
+
+// oidcProvider implements authorization code flow with PKCE against a generic OpenID Provider.
+// Provider metadata and signing keys are discovered lazily and cached.
+type oidcProvider struct {
+	issuerURL    string
+	clientID     string
+	clientSecret string
+	redirectURL  string
+	scopes       []string
+	groupsClaim  string
+	httpClient   *http.Client
+	now          func() time.Time
+
+	mu            sync.Mutex
+	discovery     *oidcDiscovery
+	keys          map[string]any
+	keysFetchedAt time.Time
+}
+
+func (p *oidcProvider) authCodeURL(ctx context.Context, state string, nonce string, verifier string) (string, error) {
+	oauthCfg, err := p.oauthConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+	return oauthCfg.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier), oauth2.SetAuthURLParam("nonce", nonce)), nil
+}
+
+func (p *oidcProvider) exchange(ctx context.Context, code string, verifier string, nonce string) (oidcIdentity, error) {
+	oauthCfg, err := p.oauthConfig(ctx)
+	if err != nil {
+		return oidcIdentity{}, err
+	}
+	tok, err := oauthCfg.Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.httpClient), code, oauth2.VerifierOption(verifier))
+	if err != nil {
+		return oidcIdentity{}, fmt.Errorf("exchange oidc code: %w", err)
+	}
+	rawIDToken, _ := tok.Extra("id_token").(string)
+	if strings.TrimSpace(rawIDToken) == "" {
+		return oidcIdentity{}, errors.New("oidc token response has no id_token")
+	}
+	return p.verifyIDToken(ctx, rawIDToken, nonce)
+}
+
+func (p *oidcProvider) oauthConfig(ctx context.Context) (*oauth2.Config, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &oauth2.Config{
+		ClientID:     p.clientID,
+		ClientSecret: p.clientSecret,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  discovery.AuthorizationEndpoint,
+			TokenURL: discovery.TokenEndpoint,
+		},
+		RedirectURL: p.redirectURL,
+		Scopes:      p.scopes,
+	}, nil
+}
+
+func (p *oidcProvider) discover(ctx context.Context) (oidcDiscovery, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.discovery != nil {
+		return *p.discovery, nil
+	}
+
+	var discovery oidcDiscovery
+	if err := p.getJSON(ctx, strings.TrimRight(p.issuerURL, "/")+oidcDiscoveryPath, &discovery); err != nil {
+		return oidcDiscovery{}, fmt.Errorf("oidc discovery: %w", err)
+	}
+	if strings.TrimRight(discovery.Issuer, "/") != strings.TrimRight(p.issuerURL, "/") {
+		return oidcDiscovery{}, fmt.Errorf("oidc discovery issuer %q does not match configured issuer %q", discovery.Issuer, p.issuerURL)
+	}
+	if discovery.AuthorizationEndpoint == "" || discovery.TokenEndpoint == "" || discovery.JWKSURI == "" {
+		return oidcDiscovery{}, errors.New("oidc discovery document is missing required endpoints")
+	}
+	p.discovery = &discovery
+	return discovery, nil
+}
+
+func (p *oidcProvider) verifyIDToken(ctx context.Context, rawIDToken string, nonce string) (oidcIdentity, error) {
+	discovery, err := p.discover(ctx)
+	if err != nil {
+		return oidcIdentity{}, err
+	}
+
+	claims := jwtv5.MapClaims{}
+	_, err = jwtv5.ParseWithClaims(rawIDToken, claims, func(token *jwtv5.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.signingKey(ctx, discovery.JWKSURI, kid)
+	},
+		jwtv5.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512"}),
+		jwtv5.WithIssuer(discovery.Issuer),
+		jwtv5.WithAudience(p.clientID),
+		jwtv5.WithExpirationRequired(),
+		jwtv5.WithLeeway(oidcClockLeeway),
+		jwtv5.WithTimeFunc(p.now),
+	)
+	if err != nil {
+		return oidcIdentity{}, fmt.Errorf("verify id token: %w", err)
+	}
+
+	if claimNonce, _ := claims["nonce"].(string); nonce == "" || claimNonce != nonce {
+		return oidcIdentity{}, errors.New("verify id token: nonce mismatch")
+	}
+	subject, _ := claims["sub"].(string)
+	if strings.TrimSpace(subject) == "" {
+		return oidcIdentity{}, errors.New("verify id token: sub claim is required")
+	}
+	// Email links the identity to an existing user, so only provider-verified emails are accepted.
+	if verified, _ := claims["email_verified"].(bool); !verified {
+		return oidcIdentity{}, errors.New("verify id token: email is not verified")
+	}
+	email, _ := claims["email"].(string)
+	preferredUsername, _ := claims["preferred_username"].(string)
+
+	return oidcIdentity{
+		Issuer:            discovery.Issuer,
+		Subject:           subject,
+		Email:             strings.TrimSpace(email),
+		PreferredUsername: strings.TrimSpace(preferredUsername),
+		Groups:            parseOIDCGroupsClaim(claims[p.groupsClaim]),
+	}, nil
+}
+
+// signingKey returns JWKS key by kid and refetches JWKS (at most once per refresh period) on key rotation.
+func (p *oidcProvider) signingKey(ctx context.Context, jwksURI string, kid string) (any, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := lookupOIDCKey(p.keys, kid); ok {
+		return key, nil
+	}
+	if p.keys != nil && p.now().Sub(p.keysFetchedAt) < oidcJWKSRefreshPeriod {
+		return nil, fmt.Errorf("unknown id token signing key %q", kid)
+	}
+
+	var jwks oidcJWKS
+	if err := p.getJSON(ctx, jwksURI, &jwks); err != nil {
+		return nil, fmt.Errorf("fetch oidc jwks: %w", err)
+	}
+	keys := make(map[string]any, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+	p.keys = keys
+	p.keysFetchedAt = p.now()
+
+	if key, ok := lookupOIDCKey(p.keys, kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown id token signing key %q", kid)
+}
+
+func lookupOIDCKey(keys map[string]any, kid string) (any, bool) {
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+	key, ok := keys[kid]
+	return key, ok
+}
+
+func (p *oidcProvider) getJSON(ctx context.Context, url string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("new request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("call %s: %w", url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("%s returned %s", url, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("decode %s: %w", url, err)
+	}
+	return nil
+}
+
+func (k oidcJWK) publicKey() (any, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, fmt.Errorf("decode rsa modulus: %w", err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, fmt.Errorf("decode rsa exponent: %w", err)
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() <= 1 || exponent.Int64() > 1<<31-1 {
+			return nil, errors.New("invalid rsa exponent")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported ec curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, fmt.Errorf("decode ec x: %w", err)
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, fmt.Errorf("decode ec y: %w", err)
+		}
+		size := (curve.Params().BitSize + 7) / 8
+		if len(x) > size || len(y) > size {
+			return nil, errors.New("invalid ec point size")
+		}
+		point := make([]byte, 1+2*size)
+		point[0] = 4
+		copy(point[1+size-len(x):1+size], x)
+		copy(point[1+2*size-len(y):], y)
+		return ecdsa.ParseUncompressedPublicKey(curve, point)
+	default:
+		return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+	}
+}
+
+// parseOIDCGroupsClaim accepts array or space/comma separated string group claims.
+func parseOIDCGroupsClaim(raw any) []string {
+	var groups []string
+	switch value := raw.(type) {
+	case []any:
+		for _, item := range value {
+			if group, ok := item.(string); ok && strings.TrimSpace(group) != "" {
+				groups = append(groups, strings.TrimSpace(group))
+			}
+		}
+	case string:
+		for _, group := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == ' ' }) {
+			groups = append(groups, group)
+		}
+	}
+	return groups
+}
@@ -0,0 +1,249 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+)
+
+// oidcStandIn is a minimal local OpenID Provider: discovery, JWKS and PKCE-checked token endpoint.
+type oidcStandIn struct {
+	server *httptest.Server
+	key    *rsa.PrivateKey
+
+	mu            sync.Mutex
+	codeChallenge string
+	claims        jwtv5.MapClaims
+}
+
+func newOIDCStandIn(t *testing.T) *oidcStandIn {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey() error = %v", err)
+	}
+	idp := &oidcStandIn{key: key}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(oidcDiscovery{
+			Issuer:                idp.server.URL,
+			AuthorizationEndpoint: idp.server.URL + "/authorize",
+			TokenEndpoint:         idp.server.URL + "/token",
+			JWKSURI:               idp.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(oidcJWKS{Keys: []oidcJWK{{
+			Kid: "k1",
+			Kty: "RSA",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("code") != "good-code" {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		idp.mu.Lock()
+		challenge, claims := idp.codeChallenge, idp.claims
+		idp.mu.Unlock()
+		if base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+			http.Error(w, `{"error":"invalid_grant","error_description":"pkce"}`, http.StatusBadRequest)
+			return
+		}
+		token := jwtv5.NewWithClaims(jwtv5.SigningMethodRS256, claims)
+		token.Header["kid"] = "k1"
+		signed, err := token.SignedString(key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "at",
+			"token_type":   "Bearer",
+			"expires_in":   300,
+			"id_token":     signed,
+		})
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+// authorize emulates the user approving login and records PKCE challenge plus ID token claims.
+func (idp *oidcStandIn) authorize(t *testing.T, authorizeURL string, claims func(nonce string) jwtv5.MapClaims) {
+	t.Helper()
+
+	parsed, err := url.Parse(authorizeURL)
+	if err != nil {
+		t.Fatalf("parse authorize url: %v", err)
+	}
+	query := parsed.Query()
+	if !strings.HasPrefix(authorizeURL, idp.server.URL+"/authorize") || query.Get("code_challenge_method") != "S256" {
+		t.Fatalf("unexpected authorize url %q", authorizeURL)
+	}
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.codeChallenge = query.Get("code_challenge")
+	idp.claims = claims(query.Get("nonce"))
+}
+
+type fakeOIDCAuthorizer struct {
+	oauthAuthorizer
+	issuer, subject, email string
+	groups                 []string
+}
+
+func (f *fakeOIDCAuthorizer) AuthorizeOIDCUser(_ context.Context, issuer string, subject string, email string, _ string, groups []string) (*controlplanev1.Principal, error) {
+	f.issuer, f.subject, f.email, f.groups = issuer, subject, email, groups
+	return &controlplanev1.Principal{UserId: "u1", Email: email, IsPlatformAdmin: slices.Contains(groups, "kodex-admins")}, nil
+}
+
+func newOIDCTestService(t *testing.T, idp *oidcStandIn, authz oauthAuthorizer) *Service {
+	t.Helper()
+
+	service, err := NewService(Config{
+		PublicBaseURL:           "https://kodex.example.com",
+		GitHubOAuthClientID:     "gh",
+		GitHubOAuthClientSecret: "gh-secret",
+		JWTSigningKey:           []byte("test-signing-key"),
+		JWTTTL:                  time.Minute,
+		OIDCIssuerURL:           idp.server.URL,
+		OIDCClientID:            "kodex",
+		OIDCClientSecret:        "kodex-secret",
+		OIDCHTTPClient:          idp.server.Client(),
+	}, authz)
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+	return service
+}
+
+func TestOIDCLogin_VerifiesIDTokenAndIssuesJWT(t *testing.T) {
+	t.Parallel()
+
+	idp := newOIDCStandIn(t)
+	authz := &fakeOIDCAuthorizer{}
+	service := newOIDCTestService(t, idp, authz)
+
+	authorizeURL, state, session, err := service.BuildOIDCLoginURL(context.Background())
+	if err != nil {
+		t.Fatalf("BuildOIDCLoginURL() error = %v", err)
+	}
+	if state == "" || !strings.Contains(authorizeURL, "redirect_uri="+url.QueryEscape("https://kodex.example.com/api/v1/auth/oidc/callback")) {
+		t.Fatalf("unexpected login url %q (state %q)", authorizeURL, state)
+	}
+	idp.authorize(t, authorizeURL, func(nonce string) jwtv5.MapClaims {
+		return jwtv5.MapClaims{
+			"iss":            idp.server.URL,
+			"aud":            "kodex",
+			"sub":            "sub-1",
+			"exp":            time.Now().Add(time.Minute).Unix(),
+			"iat":            time.Now().Unix(),
+			"nonce":          nonce,
+			"email":          "dev@example.com",
+			"email_verified": true,
+			"groups":         []string{"/kodex-admins", "team-a"},
+		}
+	})
+
+	jwtToken, _, err := service.ExchangeOIDCAndIssueJWT(context.Background(), "good-code", session)
+	if err != nil {
+		t.Fatalf("ExchangeOIDCAndIssueJWT() error = %v", err)
+	}
+	claims, err := service.VerifyJWT(jwtToken)
+	if err != nil {
+		t.Fatalf("VerifyJWT() error = %v", err)
+	}
+	if claims.Subject != "u1" || claims.Email != "dev@example.com" {
+		t.Fatalf("unexpected staff jwt claims: %#v", claims)
+	}
+	if authz.issuer != idp.server.URL || authz.subject != "sub-1" || !slices.Equal(authz.groups, []string{"/kodex-admins", "team-a"}) {
+		t.Fatalf("unexpected authorizer input: %#v", authz)
+	}
+}
+
+func TestOIDCLogin_RejectsInvalidIDTokens(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name   string
+		mutate func(claims jwtv5.MapClaims)
+	}{
+		{name: "nonce mismatch", mutate: func(claims jwtv5.MapClaims) { claims["nonce"] = "other" }},
+		{name: "wrong audience", mutate: func(claims jwtv5.MapClaims) { claims["aud"] = "someone-else" }},
+		{name: "wrong issuer", mutate: func(claims jwtv5.MapClaims) { claims["iss"] = "https://evil.example.com" }},
+		{name: "expired", mutate: func(claims jwtv5.MapClaims) { claims["exp"] = time.Now().Add(-time.Hour).Unix() }},
+		{name: "unverified email", mutate: func(claims jwtv5.MapClaims) { claims["email_verified"] = false }},
+		{name: "missing email_verified", mutate: func(claims jwtv5.MapClaims) { delete(claims, "email_verified") }},
+	}
+	for _, testCase := range testCases {
+		idp := newOIDCStandIn(t)
+		authz := &fakeOIDCAuthorizer{}
+		service := newOIDCTestService(t, idp, authz)
+
+		authorizeURL, _, session, err := service.BuildOIDCLoginURL(context.Background())
+		if err != nil {
+			t.Fatalf("%s: BuildOIDCLoginURL() error = %v", testCase.name, err)
+		}
+		idp.authorize(t, authorizeURL, func(nonce string) jwtv5.MapClaims {
+			claims := jwtv5.MapClaims{
+				"iss":            idp.server.URL,
+				"aud":            "kodex",
+				"sub":            "sub-1",
+				"exp":            time.Now().Add(time.Minute).Unix(),
+				"nonce":          nonce,
+				"email":          "dev@example.com",
+				"email_verified": true,
+			}
+			testCase.mutate(claims)
+			return claims
+		})
+
+		if _, _, err := service.ExchangeOIDCAndIssueJWT(context.Background(), "good-code", session); err == nil {
+			t.Fatalf("%s: ExchangeOIDCAndIssueJWT() error = nil, want verification failure", testCase.name)
+		}
+		if authz.subject != "" {
+			t.Fatalf("%s: authorizer must not be called", testCase.name)
+		}
+	}
+}
+
+func TestOIDCLogin_RejectsTamperedPKCEVerifier(t *testing.T) {
+	t.Parallel()
+
+	idp := newOIDCStandIn(t)
+	service := newOIDCTestService(t, idp, &fakeOIDCAuthorizer{})
+
+	authorizeURL, _, session, err := service.BuildOIDCLoginURL(context.Background())
+	if err != nil {
+		t.Fatalf("BuildOIDCLoginURL() error = %v", err)
+	}
+	idp.authorize(t, authorizeURL, func(nonce string) jwtv5.MapClaims {
+		return jwtv5.MapClaims{"iss": idp.server.URL, "aud": "kodex", "sub": "sub-1", "exp": time.Now().Add(time.Minute).Unix(), "nonce": nonce}
+	})
+	nonce, _, _ := strings.Cut(session, ".")
+	if _, _, err := service.ExchangeOIDCAndIssueJWT(context.Background(), "good-code", nonce+".forged-verifier"); err == nil {
+		t.Fatal("ExchangeOIDCAndIssueJWT() error = nil, want PKCE failure")
+	}
+}
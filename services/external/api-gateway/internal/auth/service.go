@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 	"time"
@@ -29,17 +30,30 @@ type Config struct {
 	JWTSigningKey           []byte
 	JWTTTL                  time.Duration
 	CookieSecure            bool
+
+	// OIDCIssuerURL enables generic OIDC login when set; provider metadata is discovered from it.
+	OIDCIssuerURL    string
+	OIDCClientID     string
+	OIDCClientSecret string
+	// OIDCScopes defaults to openid, profile, email.
+	OIDCScopes []string
+	// OIDCGroupsClaim is the ID token claim with user groups (default: groups).
+	OIDCGroupsClaim string
+	// OIDCHTTPClient overrides HTTP client used for discovery, JWKS and token exchange.
+	OIDCHTTPClient *http.Client
 }
 
 type oauthAuthorizer interface {
 	AuthorizeOAuthUser(ctx context.Context, email string, githubUserID int64, githubLogin string) (*controlplanev1.Principal, error)
+	AuthorizeOIDCUser(ctx context.Context, issuer string, subject string, email string, preferredUsername string, groups []string) (*controlplanev1.Principal, error)
 }
 
-// Service implements GitHub OAuth login and JWT issuance.
+// Service implements GitHub OAuth and generic OIDC login and JWT issuance.
 type Service struct {
 	cfg      Config
 	authz    oauthAuthorizer
 	oauth    *oauth2.Config
+	oidc     *oidcProvider
 	signer   *jwtlib.Signer
 	verifier *jwtlib.Verifier
 	now      func() time.Time
@@ -83,16 +97,60 @@ func NewService(cfg Config, authz oauthAuthorizer) (*Service, error) {
 		return nil, err
 	}
 
+	oidc, err := newOIDCProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Service{
 		cfg:      cfg,
 		authz:    authz,
 		oauth:    oauthCfg,
+		oidc:     oidc,
 		signer:   signer,
 		verifier: verifier,
 		now:      time.Now,
 	}, nil
 }
 
+func newOIDCProvider(cfg Config) (*oidcProvider, error) {
+	issuerURL := strings.TrimSpace(cfg.OIDCIssuerURL)
+	if issuerURL == "" {
+		return nil, nil
+	}
+	issuer, err := url.Parse(issuerURL)
+	if err != nil || issuer.Scheme == "" || issuer.Host == "" {
+		return nil, fmt.Errorf("invalid oidc issuer url %q", cfg.OIDCIssuerURL)
+	}
+	if strings.TrimSpace(cfg.OIDCClientID) == "" {
+		return nil, errors.New("oidc client id is required when oidc issuer is set")
+	}
+
+	scopes := cfg.OIDCScopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "profile", "email"}
+	}
+	groupsClaim := strings.TrimSpace(cfg.OIDCGroupsClaim)
+	if groupsClaim == "" {
+		groupsClaim = "groups"
+	}
+	httpClient := cfg.OIDCHTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &oidcProvider{
+		issuerURL:    issuerURL,
+		clientID:     strings.TrimSpace(cfg.OIDCClientID),
+		clientSecret: cfg.OIDCClientSecret,
+		redirectURL:  strings.TrimRight(cfg.PublicBaseURL, "/") + "/api/v1/auth/oidc/callback",
+		scopes:       scopes,
+		groupsClaim:  groupsClaim,
+		httpClient:   httpClient,
+		now:          time.Now,
+	}, nil
+}
+
 // BuildLoginURL returns GitHub OAuth authorize URL and the generated state value.
 func (s *Service) BuildLoginURL() (authorizeURL string, state string, err error) {
 	state, err = randomState()
@@ -128,12 +186,71 @@ func (s *Service) ExchangeAndIssueJWT(ctx context.Context, code string) (jwtToke
 	if err != nil {
 		return "", time.Time{}, err
 	}
+	return s.issueJWT(p)
+}
+
+// BuildOIDCLoginURL returns OIDC authorize URL, state and opaque session (nonce + PKCE verifier)
+// that must be kept by the browser until callback.
+func (s *Service) BuildOIDCLoginURL(ctx context.Context) (authorizeURL string, state string, session string, err error) {
+	if s.oidc == nil {
+		return "", "", "", errs.NotFound{Msg: "oidc login is not configured"}
+	}
+	state, err = randomState()
+	if err != nil {
+		return "", "", "", err
+	}
+	nonce, err := randomState()
+	if err != nil {
+		return "", "", "", err
+	}
+	verifier := oauth2.GenerateVerifier()
+
+	authorizeURL, err = s.oidc.authCodeURL(ctx, state, nonce, verifier)
+	if err != nil {
+		return "", "", "", err
+	}
+	return authorizeURL, state, nonce + "." + verifier, nil
+}
+
+// ExchangeOIDCAndIssueJWT exchanges OIDC code, verifies ID token and returns a signed JWT token
+// for a user authorized by control-plane group mapping.
+func (s *Service) ExchangeOIDCAndIssueJWT(ctx context.Context, code string, session string) (jwtToken string, expiresAt time.Time, err error) {
+	if s.oidc == nil {
+		return "", time.Time{}, errs.NotFound{Msg: "oidc login is not configured"}
+	}
+	if code == "" {
+		return "", time.Time{}, errs.Validation{Field: "code", Msg: "is required"}
+	}
+	nonce, verifier, ok := strings.Cut(session, ".")
+	if !ok || nonce == "" || verifier == "" {
+		return "", time.Time{}, errs.Unauthorized{Msg: "missing oidc session"}
+	}
+
+	identity, err := s.oidc.exchange(ctx, code, verifier, nonce)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	if identity.Email == "" {
+		return "", time.Time{}, errs.Forbidden{Msg: "oidc account must have a verified email"}
+	}
+
+	if s.authz == nil {
+		return "", time.Time{}, errs.Unauthorized{Msg: "staff auth misconfigured"}
+	}
+	p, err := s.authz.AuthorizeOIDCUser(ctx, identity.Issuer, identity.Subject, identity.Email, identity.PreferredUsername, identity.Groups)
+	if err != nil {
+		return "", time.Time{}, err
+	}
+	return s.issueJWT(p)
+}
+
+func (s *Service) issueJWT(p *controlplanev1.Principal) (string, time.Time, error) {
 	if p == nil || strings.TrimSpace(p.UserId) == "" {
 		return "", time.Time{}, errs.Unauthorized{Msg: "staff auth misconfigured"}
 	}
 
 	now := s.now().UTC()
-	jwtToken, expiresAt, err = s.signer.Issue(p.UserId, p.Email, p.GithubLogin, p.IsPlatformAdmin, p.IsPlatformOwner, now)
+	jwtToken, expiresAt, err := s.signer.Issue(p.UserId, p.Email, p.GithubLogin, p.IsPlatformAdmin, p.IsPlatformOwner, now)
 	if err != nil {
 		return "", time.Time{}, err
 	}
@@ -72,6 +72,24 @@ func (c *Client) AuthorizeOAuthUser(ctx context.Context, email string, githubUse
 	return resp.GetPrincipal(), nil
 }
 
+func (c *Client) AuthorizeOIDCUser(ctx context.Context, issuer string, subject string, email string, preferredUsername string, groups []string) (*controlplanev1.Principal, error) {
+	resp, err := c.svc.AuthorizeOIDCUser(ctx, &controlplanev1.AuthorizeOIDCUserRequest{
+		Issuer:            issuer,
+		Subject:           subject,
+		Email:             email,
+		PreferredUsername: preferredUsername,
+		Groups:            groups,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPrincipal(), nil
+}
+
+func (c *Client) AuthenticateStaffAPIToken(ctx context.Context, token string) (*controlplanev1.AuthenticateStaffAPITokenResponse, error) {
+	return c.svc.AuthenticateStaffAPIToken(ctx, &controlplanev1.AuthenticateStaffAPITokenRequest{Token: token})
+}
+
 func (c *Client) IngestGitHubWebhook(ctx context.Context, correlationID string, eventType string, deliveryID string, receivedAt time.Time, payloadJSON []byte) (*controlplanev1.IngestGitHubWebhookResponse, error) {
 	resp, err := c.svc.IngestGitHubWebhook(ctx, &controlplanev1.IngestGitHubWebhookRequest{
 		CorrelationId: correlationID,
@@ -86,6 +104,19 @@ func (c *Client) IngestGitHubWebhook(ctx context.Context, correlationID string,
 	return resp, nil
 }
 
+func (c *Client) IngestAlertmanagerWebhook(ctx context.Context, correlationID string, projectID string, receivedAt time.Time, payloadJSON []byte) (*controlplanev1.IngestAlertmanagerWebhookResponse, error) {
+	resp, err := c.svc.IngestAlertmanagerWebhook(ctx, &controlplanev1.IngestAlertmanagerWebhookRequest{
+		CorrelationId: correlationID,
+		ProjectId:     projectID,
+		ReceivedAt:    timestamppb.New(receivedAt.UTC()),
+		PayloadJson:   payloadJSON,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *Client) SubmitInteractionCallback(
 	ctx context.Context,
 	callbackToken string,
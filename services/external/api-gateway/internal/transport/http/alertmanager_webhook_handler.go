@@ -0,0 +1,81 @@
+package http
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/casters"
+)
+
+const (
+	alertmanagerWebhookEvent   = "alertmanager"
+	queryAlertmanagerProjectID = "project_id"
+)
+
+type alertmanagerWebhookHandler struct {
+	cp           alertmanagerIngress
+	token        string
+	maxBodyBytes int64
+}
+
+type alertmanagerIngress interface {
+	IngestAlertmanagerWebhook(ctx context.Context, correlationID string, projectID string, receivedAt time.Time, payloadJSON []byte) (*controlplanev1.IngestAlertmanagerWebhookResponse, error)
+}
+
+func newAlertmanagerWebhookHandler(cfg ServerConfig, cp alertmanagerIngress) *alertmanagerWebhookHandler {
+	return &alertmanagerWebhookHandler{
+		cp:           cp,
+		token:        strings.TrimSpace(cfg.AlertmanagerWebhookToken),
+		maxBodyBytes: cfg.MaxBodyBytes,
+	}
+}
+
+func (h *alertmanagerWebhookHandler) IngestAlertmanagerWebhook(c *echo.Context) error {
+	startedAt := time.Now().UTC()
+	req := c.Request()
+
+	// Empty token disables the endpoint: alert ingestion creates issues and runs on behalf of the platform.
+	if h.token == "" {
+		return errs.Unauthorized{Msg: "alertmanager webhook is not configured"}
+	}
+	token := resolveMCPCallbackToken("", req.Header.Get(echo.HeaderAuthorization))
+	if subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) != 1 {
+		return errs.Unauthorized{Msg: "invalid alertmanager webhook token"}
+	}
+
+	rawPayload, err := readRequestBody(req.Body, h.maxBodyBytes)
+	if err != nil {
+		return err
+	}
+	if !json.Valid(rawPayload) {
+		return errs.Validation{Field: "body", Msg: "payload must be valid JSON"}
+	}
+
+	if h.cp == nil {
+		return errs.Unauthorized{Msg: "webhook ingress misconfigured"}
+	}
+
+	projectID := strings.TrimSpace(c.QueryParam(queryAlertmanagerProjectID))
+	result, err := h.cp.IngestAlertmanagerWebhook(req.Context(), uuid.NewString(), projectID, startedAt, rawPayload)
+	if err != nil {
+		recordWebhookMetrics(webhookResultError, alertmanagerWebhookEvent, startedAt)
+		return err
+	}
+
+	if result.GetStatus() == string(webhookdomain.IngestStatusIgnored) {
+		recordWebhookMetrics(string(webhookdomain.IngestStatusIgnored), alertmanagerWebhookEvent, startedAt)
+		return c.JSON(http.StatusOK, casters.IngestAlertmanagerWebhook(result))
+	}
+	recordWebhookMetrics(string(webhookdomain.IngestStatusAccepted), alertmanagerWebhookEvent, startedAt)
+	return c.JSON(http.StatusAccepted, casters.IngestAlertmanagerWebhook(result))
+}
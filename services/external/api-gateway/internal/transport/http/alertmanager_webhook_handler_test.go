@@ -0,0 +1,111 @@
+package http
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v5"
+
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+)
+
+func TestIngestAlertmanagerWebhook_AcceptedAndIgnored(t *testing.T) {
+	t.Parallel()
+
+	payload := `{"version":"4","status":"firing","alerts":[]}`
+	fake := &fakeAlertmanagerService{
+		sequence: []*controlplanev1.IngestAlertmanagerWebhookResponse{
+			{Status: string(webhookdomain.IngestStatusAccepted), Incidents: []*controlplanev1.AlertIncidentOutcome{{Fingerprint: "fp-1", AlertName: "HighLatency", Action: "opened"}}},
+			{Status: string(webhookdomain.IngestStatusIgnored)},
+		},
+	}
+	h := newAlertmanagerWebhookHandler(ServerConfig{AlertmanagerWebhookToken: "am-token", MaxBodyBytes: 1024 * 1024}, fake)
+	e := echo.New()
+
+	for _, expected := range []int{http.StatusAccepted, http.StatusOK} {
+		req := httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/alertmanager?project_id=project-1", strings.NewReader(payload))
+		req.Header.Set(echo.HeaderAuthorization, "Bearer am-token")
+		rec := httptest.NewRecorder()
+		if err := h.IngestAlertmanagerWebhook(e.NewContext(req, rec)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if rec.Code != expected {
+			t.Fatalf("expected %d, got %d", expected, rec.Code)
+		}
+	}
+	if fake.lastProjectID != "project-1" {
+		t.Fatalf("expected project id from query, got %q", fake.lastProjectID)
+	}
+	if fake.lastCorrelationID == "" {
+		t.Fatal("expected generated correlation id")
+	}
+}
+
+func TestIngestAlertmanagerWebhook_Unauthorized(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		token         string
+		authorization string
+	}{
+		{name: "endpoint disabled", token: "", authorization: "Bearer anything"},
+		{name: "missing token", token: "am-token", authorization: ""},
+		{name: "wrong token", token: "am-token", authorization: "Bearer other"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake := &fakeAlertmanagerService{}
+			h := newAlertmanagerWebhookHandler(ServerConfig{AlertmanagerWebhookToken: tc.token, MaxBodyBytes: 1024}, fake)
+			e := echo.New()
+			e.HTTPErrorHandler = newHTTPErrorHandler(slog.New(slog.NewTextHandler(ioDiscard{}, nil)))
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/webhooks/alertmanager", strings.NewReader(`{}`))
+			if tc.authorization != "" {
+				req.Header.Set(echo.HeaderAuthorization, tc.authorization)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			err := h.IngestAlertmanagerWebhook(c)
+			if err == nil {
+				t.Fatal("expected unauthorized error")
+			}
+			e.HTTPErrorHandler(c, err)
+			if rec.Code != http.StatusUnauthorized {
+				t.Fatalf("expected 401, got %d", rec.Code)
+			}
+			if fake.calls != 0 {
+				t.Fatalf("expected control-plane not to be called, got %d calls", fake.calls)
+			}
+		})
+	}
+}
+
+type fakeAlertmanagerService struct {
+	sequence          []*controlplanev1.IngestAlertmanagerWebhookResponse
+	calls             int
+	lastCorrelationID string
+	lastProjectID     string
+}
+
+func (f *fakeAlertmanagerService) IngestAlertmanagerWebhook(_ context.Context, correlationID string, projectID string, _ time.Time, _ []byte) (*controlplanev1.IngestAlertmanagerWebhookResponse, error) {
+	f.lastCorrelationID = correlationID
+	f.lastProjectID = projectID
+	index := f.calls
+	f.calls++
+	if len(f.sequence) == 0 {
+		return &controlplanev1.IngestAlertmanagerWebhookResponse{CorrelationId: correlationID, Status: string(webhookdomain.IngestStatusAccepted)}, nil
+	}
+	if index >= len(f.sequence) {
+		index = len(f.sequence) - 1
+	}
+	return f.sequence[index], nil
+}
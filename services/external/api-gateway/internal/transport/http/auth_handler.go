@@ -12,16 +12,23 @@ import (
 )
 
 const (
-	cookieOAuthState = "kodex_oauth_state"
+	cookieOAuthState   = "kodex_oauth_state"
+	cookieOIDCState    = "kodex_oidc_state"
+	cookieOIDCSession  = "kodex_oidc_session"
+	oidcCallbackPath   = "/api/v1/auth/oidc/callback"
+	githubCallbackPath = "/api/v1/auth/github/callback"
+	loginFlowCookieTTL = 300
 )
 
 type authService interface {
 	BuildLoginURL() (authorizeURL string, state string, err error)
 	ExchangeAndIssueJWT(ctx context.Context, code string) (jwtToken string, expiresAt time.Time, err error)
+	BuildOIDCLoginURL(ctx context.Context) (authorizeURL string, state string, session string, err error)
+	ExchangeOIDCAndIssueJWT(ctx context.Context, code string, session string) (jwtToken string, expiresAt time.Time, err error)
 	VerifyJWT(token string) (jwtlib.Claims, error)
 }
 
-// authHandler implements OAuth login/callback and basic session endpoints.
+// authHandler implements OAuth/OIDC login/callback and basic session endpoints.
 type authHandler struct {
 	svc          authService
 	cookieSecure bool
@@ -40,15 +47,7 @@ func (h *authHandler) LoginGitHub(c *echo.Context) error {
 		return err
 	}
 
-	c.SetCookie(&http.Cookie{
-		Name:     cookieOAuthState,
-		Value:    state,
-		Path:     "/api/v1/auth/github/callback",
-		HttpOnly: true,
-		Secure:   h.cookieSecure,
-		SameSite: http.SameSiteLaxMode,
-		MaxAge:   300,
-	})
+	h.setLoginFlowCookie(c, cookieOAuthState, state, githubCallbackPath, loginFlowCookieTTL)
 
 	return c.Redirect(http.StatusFound, authorizeURL)
 }
@@ -72,21 +71,80 @@ func (h *authHandler) CallbackGitHub(c *echo.Context) error {
 	}
 
 	// Clear state cookie on callback.
-	c.SetCookie(&http.Cookie{
-		Name:     cookieOAuthState,
-		Value:    "",
-		Path:     "/api/v1/auth/github/callback",
-		HttpOnly: true,
-		Secure:   h.cookieSecure,
-		SameSite: http.SameSiteLaxMode,
-		MaxAge:   -1,
-	})
+	h.setLoginFlowCookie(c, cookieOAuthState, "", githubCallbackPath, -1)
 
 	jwtToken, expiresAt, err := h.svc.ExchangeAndIssueJWT(c.Request().Context(), qCode)
 	if err != nil {
 		return err
 	}
 
+	h.setAuthTokenCookie(c, jwtToken, expiresAt)
+	return c.Redirect(http.StatusFound, "/")
+}
+
+func (h *authHandler) LoginOIDC(c *echo.Context) error {
+	authorizeURL, state, session, err := h.svc.BuildOIDCLoginURL(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	h.setLoginFlowCookie(c, cookieOIDCState, state, oidcCallbackPath, loginFlowCookieTTL)
+	h.setLoginFlowCookie(c, cookieOIDCSession, session, oidcCallbackPath, loginFlowCookieTTL)
+
+	return c.Redirect(http.StatusFound, authorizeURL)
+}
+
+func (h *authHandler) CallbackOIDC(c *echo.Context) error {
+	if errCode := c.QueryParam("error"); errCode != "" {
+		return errs.Unauthorized{Msg: "oidc provider error: " + errCode}
+	}
+	qState := c.QueryParam("state")
+	qCode := c.QueryParam("code")
+	if qState == "" {
+		return errs.Validation{Field: "state", Msg: "is required"}
+	}
+	if qCode == "" {
+		return errs.Validation{Field: "code", Msg: "is required"}
+	}
+
+	stateCookie, err := c.Cookie(cookieOIDCState)
+	if err != nil || stateCookie == nil || stateCookie.Value == "" {
+		return errs.Unauthorized{Msg: "missing oidc state"}
+	}
+	if stateCookie.Value != qState {
+		return errs.Unauthorized{Msg: "oidc state mismatch"}
+	}
+	sessionCookie, err := c.Cookie(cookieOIDCSession)
+	if err != nil || sessionCookie == nil || sessionCookie.Value == "" {
+		return errs.Unauthorized{Msg: "missing oidc session"}
+	}
+
+	// Clear login flow cookies on callback: state, nonce and PKCE verifier are single-use.
+	h.setLoginFlowCookie(c, cookieOIDCState, "", oidcCallbackPath, -1)
+	h.setLoginFlowCookie(c, cookieOIDCSession, "", oidcCallbackPath, -1)
+
+	jwtToken, expiresAt, err := h.svc.ExchangeOIDCAndIssueJWT(c.Request().Context(), qCode, sessionCookie.Value)
+	if err != nil {
+		return err
+	}
+
+	h.setAuthTokenCookie(c, jwtToken, expiresAt)
+	return c.Redirect(http.StatusFound, "/")
+}
+
+func (h *authHandler) setLoginFlowCookie(c *echo.Context, name string, value string, path string, maxAge int) {
+	c.SetCookie(&http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     path,
+		HttpOnly: true,
+		Secure:   h.cookieSecure,
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   maxAge,
+	})
+}
+
+func (h *authHandler) setAuthTokenCookie(c *echo.Context, jwtToken string, expiresAt time.Time) {
 	ttl := int(time.Until(expiresAt).Seconds())
 	if ttl < 1 {
 		ttl = 1
@@ -100,8 +158,6 @@ func (h *authHandler) CallbackGitHub(c *echo.Context) error {
 		SameSite: http.SameSiteLaxMode,
 		MaxAge:   ttl,
 	})
-
-	return c.Redirect(http.StatusFound, "/")
 }
 
 func (h *authHandler) Logout(c *echo.Context) error {
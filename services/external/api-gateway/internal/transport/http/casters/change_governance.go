@@ -0,0 +1,60 @@
+package casters
+
+import (
+	"github.com/codex-k8s/kodex/libs/go/cast"
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/models"
+)
+
+func ChangeGovernancePackage(item *controlplanev1.ChangeGovernancePackage) models.ChangeGovernancePackage {
+	if item == nil {
+		return models.ChangeGovernancePackage{}
+	}
+	out := models.ChangeGovernancePackage{
+		ID:                        item.GetId(),
+		ProjectID:                 item.GetProjectId(),
+		RepositoryFullName:        item.GetRepositoryFullName(),
+		IssueNumber:               item.GetIssueNumber(),
+		PRNumber:                  int32PtrFromWrapper(item.GetPrNumber()),
+		RiskTier:                  cast.OptionalTrimmedString(item.RiskTier),
+		BundleAdmissibility:       item.GetBundleAdmissibility(),
+		PublicationState:          item.GetPublicationState(),
+		EvidenceCompletenessState: item.GetEvidenceCompletenessState(),
+		VerificationMinimumState:  item.GetVerificationMinimumState(),
+		WaiverState:               item.GetWaiverState(),
+		ReleaseReadinessState:     item.GetReleaseReadinessState(),
+		GovernanceFeedbackState:   item.GetGovernanceFeedbackState(),
+		ProjectionVersion:         item.GetProjectionVersion(),
+		Decisions:                 make([]models.ChangeGovernanceDecision, 0, len(item.GetDecisions())),
+		Feedback:                  make([]models.ChangeGovernanceFeedback, 0, len(item.GetFeedback())),
+		UpdatedAt:                 cast.OptionalTimestampRFC3339Nano(item.GetUpdatedAt()),
+	}
+	for _, decision := range item.GetDecisions() {
+		out.Decisions = append(out.Decisions, models.ChangeGovernanceDecision{
+			ID:               decision.GetId(),
+			DecisionID:       decision.GetDecisionId(),
+			DecisionKind:     decision.GetDecisionKind(),
+			State:            decision.GetState(),
+			ActorKind:        decision.GetActorKind(),
+			ResidualRiskTier: cast.OptionalTrimmedString(decision.ResidualRiskTier),
+			SummaryMarkdown:  decision.GetSummaryMarkdown(),
+			RecordedAt:       cast.OptionalTimestampRFC3339Nano(decision.GetRecordedAt()),
+		})
+	}
+	for _, feedback := range item.GetFeedback() {
+		out.Feedback = append(out.Feedback, models.ChangeGovernanceFeedback{
+			ID:                 feedback.GetId(),
+			FeedbackID:         feedback.GetFeedbackId(),
+			GapKind:            feedback.GetGapKind(),
+			SourceKind:         feedback.GetSourceKind(),
+			Severity:           feedback.GetSeverity(),
+			State:              feedback.GetState(),
+			SuggestedAction:    feedback.GetSuggestedAction(),
+			SummaryMarkdown:    feedback.GetSummaryMarkdown(),
+			RelatedArtifactRef: cast.OptionalTrimmedString(feedback.RelatedArtifactRef),
+			OpenedAt:           cast.OptionalTimestampRFC3339Nano(feedback.GetOpenedAt()),
+			ClosedAt:           cast.OptionalTimestampRFC3339Nano(feedback.GetClosedAt()),
+		})
+	}
+	return out
+}
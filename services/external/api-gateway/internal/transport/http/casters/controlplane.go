@@ -32,32 +32,35 @@ func Run(item *controlplanev1.Run) models.Run {
 		return models.Run{}
 	}
 	return models.Run{
-		ID:              item.GetId(),
-		CorrelationID:   item.GetCorrelationId(),
-		ProjectID:       cast.OptionalTrimmedString(item.ProjectId),
-		ProjectSlug:     cast.TrimmedStringValue(item.ProjectSlug),
-		ProjectName:     cast.TrimmedStringValue(item.ProjectName),
-		IssueNumber:     cast.PositiveInt32Ptr(item.IssueNumber),
-		IssueURL:        cast.OptionalTrimmedString(item.IssueUrl),
-		PRNumber:        cast.PositiveInt32Ptr(item.PrNumber),
-		PRURL:           cast.OptionalTrimmedString(item.PrUrl),
-		TriggerKind:     cast.OptionalTrimmedString(item.TriggerKind),
-		TriggerLabel:    cast.OptionalTrimmedString(item.TriggerLabel),
-		AgentKey:        cast.OptionalTrimmedString(item.AgentKey),
-		JobName:         cast.OptionalTrimmedString(item.JobName),
-		JobNamespace:    cast.OptionalTrimmedString(item.JobNamespace),
-		Namespace:       cast.OptionalTrimmedString(item.Namespace),
-		JobExists:       item.GetJobExists(),
-		NamespaceExists: item.GetNamespaceExists(),
-		WaitState:       cast.OptionalTrimmedString(item.WaitState),
-		WaitReason:      cast.OptionalTrimmedString(item.WaitReason),
-		WaitSince:       cast.OptionalTimestampRFC3339Nano(item.GetWaitSince()),
-		LastHeartbeatAt: cast.OptionalTimestampRFC3339Nano(item.GetLastHeartbeatAt()),
-		Status:          item.GetStatus(),
-		CreatedAt:       cast.TimestampRFC3339Nano(item.GetCreatedAt()),
-		StartedAt:       cast.OptionalTimestampRFC3339Nano(item.GetStartedAt()),
-		FinishedAt:      cast.OptionalTimestampRFC3339Nano(item.GetFinishedAt()),
-		WaitProjection:  runWaitProjection(item.GetWaitProjection()),
+		ID:                   item.GetId(),
+		CorrelationID:        item.GetCorrelationId(),
+		ProjectID:            cast.OptionalTrimmedString(item.ProjectId),
+		ProjectSlug:          cast.TrimmedStringValue(item.ProjectSlug),
+		ProjectName:          cast.TrimmedStringValue(item.ProjectName),
+		IssueNumber:          cast.PositiveInt32Ptr(item.IssueNumber),
+		IssueURL:             cast.OptionalTrimmedString(item.IssueUrl),
+		PRNumber:             cast.PositiveInt32Ptr(item.PrNumber),
+		PRURL:                cast.OptionalTrimmedString(item.PrUrl),
+		TriggerKind:          cast.OptionalTrimmedString(item.TriggerKind),
+		TriggerLabel:         cast.OptionalTrimmedString(item.TriggerLabel),
+		AgentKey:             cast.OptionalTrimmedString(item.AgentKey),
+		JobName:              cast.OptionalTrimmedString(item.JobName),
+		JobNamespace:         cast.OptionalTrimmedString(item.JobNamespace),
+		Namespace:            cast.OptionalTrimmedString(item.Namespace),
+		JobExists:            item.GetJobExists(),
+		NamespaceExists:      item.GetNamespaceExists(),
+		WaitState:            cast.OptionalTrimmedString(item.WaitState),
+		WaitReason:           cast.OptionalTrimmedString(item.WaitReason),
+		WaitSince:            cast.OptionalTimestampRFC3339Nano(item.GetWaitSince()),
+		LastHeartbeatAt:      cast.OptionalTimestampRFC3339Nano(item.GetLastHeartbeatAt()),
+		Status:               item.GetStatus(),
+		CreatedAt:            cast.TimestampRFC3339Nano(item.GetCreatedAt()),
+		StartedAt:            cast.OptionalTimestampRFC3339Nano(item.GetStartedAt()),
+		FinishedAt:           cast.OptionalTimestampRFC3339Nano(item.GetFinishedAt()),
+		WaitProjection:       runWaitProjection(item.GetWaitProjection()),
+		Priority:             cast.PositiveInt32Ptr(item.Priority),
+		QueuePosition:        cast.PositiveInt32Ptr(item.QueuePosition),
+		EstimatedWaitSeconds: item.EstimatedWaitSeconds,
 	}
 }
 
@@ -149,9 +152,27 @@ func ApprovalRequest(item *controlplanev1.ApprovalRequest) models.ApprovalReques
 		ApprovalMode:  item.GetApprovalMode(),
 		RequestedBy:   item.GetRequestedBy(),
 		CreatedAt:     cast.TimestampRFC3339Nano(item.GetCreatedAt()),
+
+		RequiredApprovals: item.GetRequiredApprovals(),
+		ApprovalsCount:    item.GetApprovalsCount(),
+		Votes:             ApprovalVotes(item.GetVotes()),
+		ExpiresAt:         cast.OptionalTimestampRFC3339Nano(item.GetExpiresAt()),
 	}
 }
 
+func ApprovalVotes(items []*controlplanev1.ApprovalVote) []models.ApprovalVote {
+	out := make([]models.ApprovalVote, 0, len(items))
+	for _, item := range items {
+		out = append(out, models.ApprovalVote{
+			ActorID:  item.GetActorId(),
+			Decision: item.GetDecision(),
+			Reason:   cast.OptionalTrimmedString(item.Reason),
+			VotedAt:  cast.OptionalTimestampRFC3339Nano(item.GetVotedAt()),
+		})
+	}
+	return out
+}
+
 func ApprovalRequests(items []*controlplanev1.ApprovalRequest) []models.ApprovalRequest {
 	out := make([]models.ApprovalRequest, 0, len(items))
 	for _, item := range items {
@@ -171,6 +192,9 @@ func ResolveApprovalDecision(item *controlplanev1.ResolveApprovalDecisionRespons
 		ToolName:      item.GetToolName(),
 		Action:        item.GetAction(),
 		ApprovalState: item.GetApprovalState(),
+
+		RequiredApprovals: item.GetRequiredApprovals(),
+		ApprovalsCount:    item.GetApprovalsCount(),
 	}
 }
 
@@ -187,10 +211,12 @@ func FlowEvent(item *controlplanev1.FlowEvent) models.FlowEvent {
 		return models.FlowEvent{}
 	}
 	return models.FlowEvent{
-		CorrelationID: item.GetCorrelationId(),
-		EventType:     item.GetEventType(),
-		CreatedAt:     cast.TimestampRFC3339Nano(item.GetCreatedAt()),
-		PayloadJSON:   item.GetPayloadJson(),
+		ID:              item.GetId(),
+		CorrelationID:   item.GetCorrelationId(),
+		EventType:       item.GetEventType(),
+		CreatedAt:       cast.TimestampRFC3339Nano(item.GetCreatedAt()),
+		PayloadJSON:     item.GetPayloadJson(),
+		RetentionPinned: item.GetRetentionPinned(),
 	}
 }
 
@@ -210,10 +236,12 @@ func FlowEventsSummary(items []*controlplanev1.FlowEvent) []models.FlowEvent {
 			continue
 		}
 		out = append(out, models.FlowEvent{
-			CorrelationID: item.GetCorrelationId(),
-			EventType:     item.GetEventType(),
-			CreatedAt:     cast.TimestampRFC3339Nano(item.GetCreatedAt()),
-			PayloadJSON:   "",
+			ID:              item.GetId(),
+			CorrelationID:   item.GetCorrelationId(),
+			EventType:       item.GetEventType(),
+			CreatedAt:       cast.TimestampRFC3339Nano(item.GetCreatedAt()),
+			PayloadJSON:     "",
+			RetentionPinned: item.GetRetentionPinned(),
 		})
 	}
 	return out
@@ -249,12 +277,13 @@ func User(item *controlplanev1.User) models.User {
 		return models.User{}
 	}
 	return models.User{
-		ID:              item.GetId(),
-		Email:           item.GetEmail(),
-		GitHubUserID:    cast.PositiveInt64Ptr(item.GithubUserId),
-		GitHubLogin:     cast.OptionalTrimmedString(item.GithubLogin),
-		IsPlatformAdmin: item.GetIsPlatformAdmin(),
-		IsPlatformOwner: item.GetIsPlatformOwner(),
+		ID:               item.GetId(),
+		Email:            item.GetEmail(),
+		GitHubUserID:     cast.PositiveInt64Ptr(item.GithubUserId),
+		GitHubLogin:      cast.OptionalTrimmedString(item.GithubLogin),
+		IsPlatformAdmin:  item.GetIsPlatformAdmin(),
+		IsPlatformOwner:  item.GetIsPlatformOwner(),
+		IsServiceAccount: item.GetIsServiceAccount(),
 	}
 }
 
@@ -287,6 +316,65 @@ func ProjectMembers(items []*controlplanev1.ProjectMember) []models.ProjectMembe
 	return out
 }
 
+func ProjectRole(item *controlplanev1.ProjectRole) models.ProjectRole {
+	if item == nil {
+		return models.ProjectRole{}
+	}
+	return models.ProjectRole{
+		ProjectID:   item.GetProjectId(),
+		Name:        item.GetName(),
+		Description: item.GetDescription(),
+		Permissions: append([]string{}, item.GetPermissions()...),
+		Builtin:     item.GetBuiltin(),
+		CreatedAt:   cast.OptionalTimestampRFC3339Nano(item.GetCreatedAt()),
+		UpdatedAt:   cast.OptionalTimestampRFC3339Nano(item.GetUpdatedAt()),
+	}
+}
+
+func ProjectRoles(resp *controlplanev1.ListProjectRolesResponse) models.ProjectRolesResponse {
+	out := models.ProjectRolesResponse{
+		Items:   make([]models.ProjectRole, 0, len(resp.GetItems())),
+		Catalog: make([]models.ProjectPermissionDescriptor, 0, len(resp.GetCatalog())),
+	}
+	for _, item := range resp.GetItems() {
+		out.Items = append(out.Items, ProjectRole(item))
+	}
+	for _, item := range resp.GetCatalog() {
+		out.Catalog = append(out.Catalog, models.ProjectPermissionDescriptor{
+			Permission: item.GetPermission(),
+			Qualified:  item.GetQualified(),
+		})
+	}
+	return out
+}
+
+func MCPApprovalPolicy(item *controlplanev1.MCPApprovalPolicy) models.MCPApprovalPolicy {
+	if item == nil {
+		return models.MCPApprovalPolicy{}
+	}
+	return models.MCPApprovalPolicy{
+		ID:                  item.GetId(),
+		ProjectID:           item.GetProjectId(),
+		ToolName:            item.GetToolName(),
+		Environment:         item.GetEnvironment(),
+		RequiredApprovals:   item.GetRequiredApprovals(),
+		EligibleRoles:       append([]string{}, item.GetEligibleRoles()...),
+		EligibleUserIDs:     append([]string{}, item.GetEligibleUserIds()...),
+		SeparationOfDuties:  item.GetSeparationOfDuties(),
+		ExpiresAfterSeconds: item.GetExpiresAfterSeconds(),
+		CreatedAt:           cast.OptionalTimestampRFC3339Nano(item.GetCreatedAt()),
+		UpdatedAt:           cast.OptionalTimestampRFC3339Nano(item.GetUpdatedAt()),
+	}
+}
+
+func MCPApprovalPolicies(resp *controlplanev1.ListMCPApprovalPoliciesResponse) models.ItemsResponse[models.MCPApprovalPolicy] {
+	out := make([]models.MCPApprovalPolicy, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		out = append(out, MCPApprovalPolicy(item))
+	}
+	return models.ItemsResponse[models.MCPApprovalPolicy]{Items: out}
+}
+
 func RepositoryBinding(item *controlplanev1.RepositoryBinding) models.RepositoryBinding {
 	if item == nil {
 		return models.RepositoryBinding{}
@@ -475,6 +563,29 @@ func IngestGitHubWebhook(item *controlplanev1.IngestGitHubWebhookResponse) model
 	}
 }
 
+func IngestAlertmanagerWebhook(item *controlplanev1.IngestAlertmanagerWebhookResponse) models.IngestAlertmanagerWebhookResponse {
+	if item == nil {
+		return models.IngestAlertmanagerWebhookResponse{Incidents: []models.AlertIncidentOutcome{}}
+	}
+	incidents := make([]models.AlertIncidentOutcome, 0, len(item.GetIncidents()))
+	for _, incident := range item.GetIncidents() {
+		incidents = append(incidents, models.AlertIncidentOutcome{
+			IncidentID:    incident.GetIncidentId(),
+			Fingerprint:   incident.GetFingerprint(),
+			AlertName:     incident.GetAlertName(),
+			Action:        incident.GetAction(),
+			IssueURL:      incident.GetIssueUrl(),
+			RunID:         incident.GetRunId(),
+			RunSkipReason: incident.GetRunSkipReason(),
+		})
+	}
+	return models.IngestAlertmanagerWebhookResponse{
+		CorrelationID: item.GetCorrelationId(),
+		Status:        item.GetStatus(),
+		Incidents:     incidents,
+	}
+}
+
 func RunLogs(item *controlplanev1.RunLogs) models.RunLogs {
 	out := models.RunLogs{}
 	if item == nil {
@@ -588,6 +699,43 @@ func RuntimeDeployTaskAction(item *controlplanev1.RuntimeDeployTaskActionRespons
 	}
 }
 
+func RuntimeDeployPreview(item *controlplanev1.PreviewRuntimeDeployResponse) models.RuntimeDeployPreview {
+	if item == nil {
+		return models.RuntimeDeployPreview{Objects: []models.RuntimeDeployPreviewObject{}, Images: []models.RuntimeDeployPreviewImage{}}
+	}
+	objects := make([]models.RuntimeDeployPreviewObject, 0, len(item.GetObjects()))
+	for _, object := range item.GetObjects() {
+		changedFields := object.GetChangedFields()
+		if changedFields == nil {
+			changedFields = []string{}
+		}
+		objects = append(objects, models.RuntimeDeployPreviewObject{
+			Unit:          object.GetUnit(),
+			APIVersion:    object.GetApiVersion(),
+			Kind:          object.GetKind(),
+			Namespace:     object.GetNamespace(),
+			Name:          object.GetName(),
+			Action:        object.GetAction(),
+			ChangedFields: changedFields,
+		})
+	}
+	images := make([]models.RuntimeDeployPreviewImage, 0, len(item.GetImages()))
+	for _, image := range item.GetImages() {
+		images = append(images, models.RuntimeDeployPreviewImage{
+			Name:     image.GetName(),
+			ImageRef: image.GetImageRef(),
+			Action:   image.GetAction(),
+		})
+	}
+	return models.RuntimeDeployPreview{
+		Namespace: item.GetNamespace(),
+		TargetEnv: item.GetTargetEnv(),
+		BuildRef:  item.GetBuildRef(),
+		Objects:   objects,
+		Images:    images,
+	}
+}
+
 func RunAction(item *controlplanev1.RunActionResponse) models.RunActionResponse {
 	if item == nil {
 		return models.RunActionResponse{}
@@ -624,6 +772,7 @@ func RuntimeError(item *controlplanev1.RuntimeError) models.RuntimeError {
 	out.ViewedAt = cast.OptionalTimestampRFC3339Nano(item.GetViewedAt())
 	out.ViewedBy = cast.OptionalTrimmedString(item.ViewedBy)
 	out.CreatedAt = cast.TimestampRFC3339Nano(item.GetCreatedAt())
+	out.GroupID = cast.OptionalTrimmedString(item.GroupId)
 	return out
 }
 
@@ -635,6 +784,155 @@ func RuntimeErrors(items []*controlplanev1.RuntimeError) []models.RuntimeError {
 	return out
 }
 
+func RuntimeErrorGroup(item *controlplanev1.RuntimeErrorGroup) models.RuntimeErrorGroup {
+	out := models.RuntimeErrorGroup{}
+	if item == nil {
+		return out
+	}
+	out.ID = item.GetId()
+	out.ProjectID = cast.OptionalTrimmedString(item.ProjectId)
+	out.Fingerprint = item.GetFingerprint()
+	out.Source = item.GetSource()
+	out.ErrorClass = item.GetErrorClass()
+	out.NormalizedMessage = item.GetNormalizedMessage()
+	out.SampleMessage = item.GetSampleMessage()
+	out.Level = item.GetLevel()
+	out.Status = item.GetStatus()
+	out.MutedUntil = cast.OptionalTimestampRFC3339Nano(item.GetMutedUntil())
+	out.StatusChangedAt = cast.OptionalTimestampRFC3339Nano(item.GetStatusChangedAt())
+	out.StatusChangedBy = cast.OptionalTrimmedString(item.StatusChangedBy)
+	out.ResolvedAt = cast.OptionalTimestampRFC3339Nano(item.GetResolvedAt())
+	out.Occurrences = item.GetOccurrences()
+	out.RegressionCount = item.GetRegressionCount()
+	out.AffectedRunCount = item.GetAffectedRunCount()
+	out.FirstSeenAt = cast.TimestampRFC3339Nano(item.GetFirstSeenAt())
+	out.LastSeenAt = cast.TimestampRFC3339Nano(item.GetLastSeenAt())
+	out.LastRunID = cast.OptionalTrimmedString(item.LastRunId)
+	out.EscalationKind = cast.TrimmedStringPtr(item.GetEscalationKind())
+	out.EscalatedAt = cast.OptionalTimestampRFC3339Nano(item.GetEscalatedAt())
+	out.EscalatedBy = cast.OptionalTrimmedString(item.EscalatedBy)
+	out.RepositoryFullName = cast.OptionalTrimmedString(item.RepositoryFullName)
+	out.IssueNumber = item.IssueNumber
+	out.IssueURL = cast.OptionalTrimmedString(item.IssueUrl)
+	out.CreatedAt = cast.TimestampRFC3339Nano(item.GetCreatedAt())
+	out.UpdatedAt = cast.TimestampRFC3339Nano(item.GetUpdatedAt())
+	return out
+}
+
+func RuntimeErrorGroups(items []*controlplanev1.RuntimeErrorGroup) []models.RuntimeErrorGroup {
+	out := make([]models.RuntimeErrorGroup, 0, len(items))
+	for _, item := range items {
+		out = append(out, RuntimeErrorGroup(item))
+	}
+	return out
+}
+
+func RetentionPolicy(item *controlplanev1.RetentionPolicy) models.RetentionPolicy {
+	out := models.RetentionPolicy{}
+	if item == nil {
+		return out
+	}
+	out.ID = item.GetId()
+	out.ProjectID = cast.OptionalTrimmedString(item.ProjectId)
+	out.Target = item.GetTarget()
+	out.RetentionDays = item.GetRetentionDays()
+	out.Action = item.GetAction()
+	out.Enabled = item.GetEnabled()
+	out.UpdatedBy = cast.OptionalTrimmedString(item.UpdatedBy)
+	out.LastSweptAt = cast.OptionalTimestampRFC3339Nano(item.GetLastSweptAt())
+	out.LastRemovedCount = item.GetLastRemovedCount()
+	out.LastArchiveKey = cast.OptionalTrimmedString(item.LastArchiveKey)
+	out.LastError = cast.OptionalTrimmedString(item.LastError)
+	out.CreatedAt = cast.TimestampRFC3339Nano(item.GetCreatedAt())
+	out.UpdatedAt = cast.TimestampRFC3339Nano(item.GetUpdatedAt())
+	return out
+}
+
+func RetentionPolicies(items []*controlplanev1.RetentionPolicy) []models.RetentionPolicy {
+	out := make([]models.RetentionPolicy, 0, len(items))
+	for _, item := range items {
+		out = append(out, RetentionPolicy(item))
+	}
+	return out
+}
+
+func StaffAPIToken(item *controlplanev1.StaffAPIToken) models.StaffAPIToken {
+	if item == nil {
+		return models.StaffAPIToken{}
+	}
+	return models.StaffAPIToken{
+		ID:          item.GetId(),
+		UserID:      item.GetUserId(),
+		UserEmail:   item.GetUserEmail(),
+		Name:        item.GetName(),
+		TokenPrefix: item.GetTokenPrefix(),
+		Scopes:      append([]string{}, item.GetScopes()...),
+		ProjectIDs:  append([]string{}, item.GetProjectIds()...),
+		ExpiresAt:   cast.OptionalTimestampRFC3339Nano(item.GetExpiresAt()),
+		LastUsedAt:  cast.OptionalTimestampRFC3339Nano(item.GetLastUsedAt()),
+		RevokedAt:   cast.OptionalTimestampRFC3339Nano(item.GetRevokedAt()),
+		CreatedBy:   cast.OptionalTrimmedString(item.CreatedBy),
+		CreatedAt:   cast.TimestampRFC3339Nano(item.GetCreatedAt()),
+	}
+}
+
+func StaffAPITokens(items []*controlplanev1.StaffAPIToken) []models.StaffAPIToken {
+	out := make([]models.StaffAPIToken, 0, len(items))
+	for _, item := range items {
+		out = append(out, StaffAPIToken(item))
+	}
+	return out
+}
+
+func CreatedStaffAPIToken(item *controlplanev1.CreateStaffAPITokenResponse) models.CreateStaffAPITokenResponse {
+	return models.CreateStaffAPITokenResponse{
+		Token:  StaffAPIToken(item.GetToken()),
+		Secret: item.GetSecret(),
+	}
+}
+
+func RunOutcomeGroup(item *controlplanev1.RunOutcomeGroup) models.RunOutcomeGroup {
+	if item == nil {
+		return models.RunOutcomeGroup{}
+	}
+	return models.RunOutcomeGroup{
+		ProjectID:                item.GetProjectId(),
+		Role:                     item.GetRole(),
+		TriggerKind:              item.GetTriggerKind(),
+		Model:                    item.GetModel(),
+		ReasoningEffort:          item.GetReasoningEffort(),
+		PromptSource:             item.GetPromptSource(),
+		Lineages:                 item.GetLineages(),
+		Runs:                     item.GetRuns(),
+		ReviseRuns:               item.GetReviseRuns(),
+		FailedRuns:               item.GetFailedRuns(),
+		ChangesRequested:         item.GetChangesRequested(),
+		PRsOpened:                item.GetPrsOpened(),
+		PRsMerged:                item.GetPrsMerged(),
+		PRsClosedUnmerged:        item.GetPrsClosedUnmerged(),
+		MergeRate:                item.GetMergeRate(),
+		AvgReviseRounds:          item.GetAvgReviseRounds(),
+		MedianTimeToMergeSeconds: item.GetMedianTimeToMergeSeconds(),
+		AvgWallClockSeconds:      item.GetAvgWallClockSeconds(),
+		AgentSeconds:             item.GetAgentSeconds(),
+	}
+}
+
+func RunOutcomeAnalytics(item *controlplanev1.RunOutcomeAnalytics) models.RunOutcomeAnalytics {
+	out := models.RunOutcomeAnalytics{GroupBy: []string{}, Groups: []models.RunOutcomeGroup{}}
+	if item == nil {
+		return out
+	}
+	out.From = cast.TimestampRFC3339Nano(item.GetFrom())
+	out.To = cast.TimestampRFC3339Nano(item.GetTo())
+	out.GroupBy = append(out.GroupBy, item.GetGroupBy()...)
+	out.Totals = RunOutcomeGroup(item.GetTotals())
+	for _, group := range item.GetGroups() {
+		out.Groups = append(out.Groups, RunOutcomeGroup(group))
+	}
+	return out
+}
+
 func RegistryImageTag(item *controlplanev1.RegistryImageTag) models.RegistryImageTag {
 	out := models.RegistryImageTag{}
 	if item == nil {
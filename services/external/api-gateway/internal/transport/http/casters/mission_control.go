@@ -963,3 +963,55 @@ func derefStringEnum[T ~string](value *T) T {
 	}
 	return *value
 }
+
+func MissionControlVoiceCandidate(item *controlplanev1.MissionControlVoiceCandidate) generated.MissionControlVoiceCandidate {
+	if item == nil {
+		return generated.MissionControlVoiceCandidate{}
+	}
+	out := generated.MissionControlVoiceCandidate{
+		CandidateId:        item.GetCandidateId(),
+		ProjectId:          item.GetProjectId(),
+		Status:             generated.MissionControlVoiceCandidateStatus(item.GetStatus()),
+		SourceKind:         generated.MissionControlVoiceCandidateSourceKind(item.GetSourceKind()),
+		CommandKind:        generated.MissionControlVoiceCandidateCommandKind(item.GetCommandKind()),
+		TranscriptExcerpt:  item.GetTranscriptExcerpt(),
+		StructuredSummary:  item.GetStructuredSummary(),
+		Transcript:         item.GetTranscript(),
+		Confidence:         item.GetConfidence(),
+		DraftTitle:         item.GetDraftTitle(),
+		DraftBodyMarkdown:  cast.OptionalTrimmedString(item.DraftBodyMarkdown),
+		DraftInitialLabels: requiredStringSlice(item.GetDraftInitialLabels()),
+		PromotedCommandId:  cast.OptionalTrimmedString(item.PromotedCommandId),
+		CreatedBy:          item.GetCreatedBy(),
+		SourceAdapter:      cast.OptionalTrimmedString(item.SourceAdapter),
+		DecidedBy:          cast.OptionalTrimmedString(item.DecidedBy),
+		RejectReason:       cast.OptionalTrimmedString(item.RejectReason),
+		CreatedAt:          item.GetCreatedAt().AsTime().UTC(),
+	}
+	if item.GetDecidedAt() != nil {
+		value := item.GetDecidedAt().AsTime().UTC()
+		out.DecidedAt = &value
+	}
+	return out
+}
+
+func MissionControlVoiceCandidateItems(items []*controlplanev1.MissionControlVoiceCandidate) generated.MissionControlVoiceCandidateItemsResponse {
+	out := generated.MissionControlVoiceCandidateItemsResponse{
+		Items: make([]generated.MissionControlVoiceCandidate, 0, len(items)),
+	}
+	for _, item := range items {
+		out.Items = append(out.Items, MissionControlVoiceCandidate(item))
+	}
+	return out
+}
+
+func MissionControlVoiceCandidatePromotion(item *controlplanev1.PromoteMissionControlVoiceCandidateResponse) generated.MissionControlVoiceCandidatePromotion {
+	out := generated.MissionControlVoiceCandidatePromotion{
+		Candidate: MissionControlVoiceCandidate(item.GetCandidate()),
+	}
+	if item.GetCommand() != nil {
+		command := MissionControlCommandState(item.GetCommand())
+		out.Command = &command
+	}
+	return out
+}
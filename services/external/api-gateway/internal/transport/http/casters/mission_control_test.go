@@ -21,7 +21,7 @@ func TestMissionControlCommandRequest_DiscussionCreatePayload(t *testing.T) {
 	body := generated.MissionControlCommandRequest{}
 	if err := body.FromMissionControlDiscussionCreateCommandRequest(generated.MissionControlDiscussionCreateCommandRequest{
 		BusinessIntentKey: "intent-1",
-		CommandKind:       generated.DiscussionCreate,
+		CommandKind:       generated.MissionControlDiscussionCreateCommandRequestCommandKindDiscussionCreate,
 		ProjectId:         "project-1",
 		TargetEntityKind:  &targetKind,
 		Payload: generated.DiscussionCreatePayload{
@@ -324,3 +324,40 @@ func TestMissionControlCommandState_RequiredCollectionsMarshalAsArrays(t *testin
 func stringPtr(value string) *string {
 	return &value
 }
+
+func TestMissionControlVoiceCandidatePromotion(t *testing.T) {
+	t.Parallel()
+
+	promotedCommandID := "command-1"
+	decidedAt := time.Unix(456, 0).UTC()
+	out := MissionControlVoiceCandidatePromotion(&controlplanev1.PromoteMissionControlVoiceCandidateResponse{
+		Candidate: &controlplanev1.MissionControlVoiceCandidate{
+			ProjectId:         "project-1",
+			CandidateId:       "voice-1",
+			Status:            "promoted",
+			SourceKind:        "voice",
+			CommandKind:       "work_item.create",
+			DraftTitle:        "Export reports",
+			PromotedCommandId: &promotedCommandID,
+			CreatedBy:         "telegram:42",
+			CreatedAt:         timestamppb.New(time.Unix(123, 0).UTC()),
+			DecidedAt:         timestamppb.New(decidedAt),
+		},
+	})
+
+	if out.Candidate.CandidateId != "voice-1" || out.Candidate.Status != generated.MissionControlVoiceCandidateStatus("promoted") {
+		t.Fatalf("unexpected candidate: %+v", out.Candidate)
+	}
+	if out.Candidate.PromotedCommandId == nil || *out.Candidate.PromotedCommandId != promotedCommandID {
+		t.Fatalf("expected promoted command id, got %v", out.Candidate.PromotedCommandId)
+	}
+	if out.Candidate.DecidedAt == nil || !out.Candidate.DecidedAt.Equal(decidedAt) {
+		t.Fatalf("expected decided_at to be preserved, got %v", out.Candidate.DecidedAt)
+	}
+	if out.Candidate.DraftInitialLabels == nil {
+		t.Fatal("draft_initial_labels must be an empty list, not null")
+	}
+	if out.Command != nil {
+		t.Fatalf("expected no command state, got %+v", out.Command)
+	}
+}
@@ -17,6 +17,15 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for AlertIncidentOutcomeAction.
+const (
+	AlertIncidentOutcomeActionIgnored  AlertIncidentOutcomeAction = "ignored"
+	AlertIncidentOutcomeActionOpened   AlertIncidentOutcomeAction = "opened"
+	AlertIncidentOutcomeActionReopened AlertIncidentOutcomeAction = "reopened"
+	AlertIncidentOutcomeActionResolved AlertIncidentOutcomeAction = "resolved"
+	AlertIncidentOutcomeActionUpdated  AlertIncidentOutcomeAction = "updated"
+)
+
 // Defines values for ApprovalRequestApprovalMode.
 const (
 	ApprovalRequestApprovalModeDelegated ApprovalRequestApprovalMode = "delegated"
@@ -24,6 +33,30 @@ const (
 	ApprovalRequestApprovalModeOwner     ApprovalRequestApprovalMode = "owner"
 )
 
+// Defines values for ApprovalVoteDecision.
+const (
+	ApprovalVoteDecisionApproved ApprovalVoteDecision = "approved"
+	ApprovalVoteDecisionDenied   ApprovalVoteDecision = "denied"
+)
+
+// Defines values for ChangeGovernancePackageReleaseReadinessState.
+const (
+	ChangeGovernancePackageReleaseReadinessStateBlocked            ChangeGovernancePackageReleaseReadinessState = "blocked"
+	ChangeGovernancePackageReleaseReadinessStateConditionallyReady ChangeGovernancePackageReleaseReadinessState = "conditionally_ready"
+	ChangeGovernancePackageReleaseReadinessStateNotReady           ChangeGovernancePackageReleaseReadinessState = "not_ready"
+	ChangeGovernancePackageReleaseReadinessStateReady              ChangeGovernancePackageReleaseReadinessState = "ready"
+	ChangeGovernancePackageReleaseReadinessStateReleased           ChangeGovernancePackageReleaseReadinessState = "released"
+)
+
+// Defines values for ChangeGovernancePackageWaiverState.
+const (
+	ChangeGovernancePackageWaiverStateApproved  ChangeGovernancePackageWaiverState = "approved"
+	ChangeGovernancePackageWaiverStateExpired   ChangeGovernancePackageWaiverState = "expired"
+	ChangeGovernancePackageWaiverStateNone      ChangeGovernancePackageWaiverState = "none"
+	ChangeGovernancePackageWaiverStateRejected  ChangeGovernancePackageWaiverState = "rejected"
+	ChangeGovernancePackageWaiverStateRequested ChangeGovernancePackageWaiverState = "requested"
+)
+
 // Defines values for DiscussionCreatePayloadParentEntityKind.
 const (
 	DiscussionCreatePayloadParentEntityKindAgent       DiscussionCreatePayloadParentEntityKind = "agent"
@@ -55,6 +88,12 @@ const (
 	ErrorResponseCodeUnavailable        ErrorResponseCode = "unavailable"
 )
 
+// Defines values for EscalateRuntimeErrorGroupRequestKind.
+const (
+	EscalateRuntimeErrorGroupRequestKindAiRepair EscalateRuntimeErrorGroupRequestKind = "ai_repair"
+	EscalateRuntimeErrorGroupRequestKindIssue    EscalateRuntimeErrorGroupRequestKind = "issue"
+)
+
 // Defines values for GitHubRateLimitManualActionKind.
 const (
 	RequeuePlatformOperation GitHubRateLimitManualActionKind = "requeue_platform_operation"
@@ -120,6 +159,12 @@ const (
 	ImportDocsetRequestLocaleRu ImportDocsetRequestLocale = "ru"
 )
 
+// Defines values for IngestAlertmanagerWebhookResponseStatus.
+const (
+	IngestAlertmanagerWebhookResponseStatusAccepted IngestAlertmanagerWebhookResponseStatus = "accepted"
+	IngestAlertmanagerWebhookResponseStatusIgnored  IngestAlertmanagerWebhookResponseStatus = "ignored"
+)
+
 // Defines values for IngestGitHubWebhookResponseStatus.
 const (
 	IngestGitHubWebhookResponseStatusAccepted  IngestGitHubWebhookResponseStatus = "accepted"
@@ -173,6 +218,13 @@ const (
 	MCPApprovalCallbackRequestDecisionFailed   MCPApprovalCallbackRequestDecision = "failed"
 )
 
+// Defines values for MCPApprovalPolicyToolName.
+const (
+	MCPApprovalPolicyToolNameDatabaseLifecycle    MCPApprovalPolicyToolName = "database.lifecycle"
+	MCPApprovalPolicyToolNameOwnerFeedbackRequest MCPApprovalPolicyToolName = "owner.feedback.request"
+	MCPApprovalPolicyToolNameSecretSyncK8s        MCPApprovalPolicyToolName = "secret.sync.k8s"
+)
+
 // Defines values for MissionControlActivityEntryNodeKind.
 const (
 	MissionControlActivityEntryNodeKindDiscussion  MissionControlActivityEntryNodeKind = "discussion"
@@ -287,9 +339,9 @@ const (
 
 // Defines values for MissionControlContinuityGapStatus.
 const (
-	Deferred MissionControlContinuityGapStatus = "deferred"
-	Open     MissionControlContinuityGapStatus = "open"
-	Resolved MissionControlContinuityGapStatus = "resolved"
+	MissionControlContinuityGapStatusDeferred MissionControlContinuityGapStatus = "deferred"
+	MissionControlContinuityGapStatusOpen     MissionControlContinuityGapStatus = "open"
+	MissionControlContinuityGapStatusResolved MissionControlContinuityGapStatus = "resolved"
 )
 
 // Defines values for MissionControlContinuityGapSubjectNodeKind.
@@ -315,7 +367,7 @@ const (
 
 // Defines values for MissionControlDiscussionCreateCommandRequestCommandKind.
 const (
-	DiscussionCreate MissionControlDiscussionCreateCommandRequestCommandKind = "discussion.create"
+	MissionControlDiscussionCreateCommandRequestCommandKindDiscussionCreate MissionControlDiscussionCreateCommandRequestCommandKind = "discussion.create"
 )
 
 // Defines values for MissionControlDiscussionCreateCommandRequestTargetEntityKind.
@@ -666,9 +718,36 @@ const (
 	VoiceCandidate MissionControlTimelineEntrySourceKind = "voice_candidate"
 )
 
+// Defines values for MissionControlVoiceCandidateCommandKind.
+const (
+	MissionControlVoiceCandidateCommandKindDiscussionCreate MissionControlVoiceCandidateCommandKind = "discussion.create"
+	MissionControlVoiceCandidateCommandKindWorkItemCreate   MissionControlVoiceCandidateCommandKind = "work_item.create"
+)
+
+// Defines values for MissionControlVoiceCandidateSourceKind.
+const (
+	AudioUpload MissionControlVoiceCandidateSourceKind = "audio_upload"
+	Transcript  MissionControlVoiceCandidateSourceKind = "transcript"
+	Voice       MissionControlVoiceCandidateSourceKind = "voice"
+)
+
+// Defines values for MissionControlVoiceCandidateStatus.
+const (
+	MissionControlVoiceCandidateStatusDraft    MissionControlVoiceCandidateStatus = "draft"
+	MissionControlVoiceCandidateStatusExpired  MissionControlVoiceCandidateStatus = "expired"
+	MissionControlVoiceCandidateStatusPromoted MissionControlVoiceCandidateStatus = "promoted"
+	MissionControlVoiceCandidateStatusRejected MissionControlVoiceCandidateStatus = "rejected"
+)
+
+// Defines values for MissionControlVoiceCandidatePromoteRequestCommandKind.
+const (
+	MissionControlVoiceCandidatePromoteRequestCommandKindDiscussionCreate MissionControlVoiceCandidatePromoteRequestCommandKind = "discussion.create"
+	MissionControlVoiceCandidatePromoteRequestCommandKindWorkItemCreate   MissionControlVoiceCandidatePromoteRequestCommandKind = "work_item.create"
+)
+
 // Defines values for MissionControlWorkItemCreateCommandRequestCommandKind.
 const (
-	WorkItemCreate MissionControlWorkItemCreateCommandRequestCommandKind = "work_item.create"
+	MissionControlWorkItemCreateCommandRequestCommandKindWorkItemCreate MissionControlWorkItemCreateCommandRequestCommandKind = "work_item.create"
 )
 
 // Defines values for MissionControlWorkItemCreateCommandRequestTargetEntityKind.
@@ -707,10 +786,10 @@ const (
 
 // Defines values for MissionControlWorkspaceWatermarkStatus.
 const (
-	Degraded   MissionControlWorkspaceWatermarkStatus = "degraded"
-	Fresh      MissionControlWorkspaceWatermarkStatus = "fresh"
-	OutOfScope MissionControlWorkspaceWatermarkStatus = "out_of_scope"
-	Stale      MissionControlWorkspaceWatermarkStatus = "stale"
+	MissionControlWorkspaceWatermarkStatusDegraded   MissionControlWorkspaceWatermarkStatus = "degraded"
+	MissionControlWorkspaceWatermarkStatusFresh      MissionControlWorkspaceWatermarkStatus = "fresh"
+	MissionControlWorkspaceWatermarkStatusOutOfScope MissionControlWorkspaceWatermarkStatus = "out_of_scope"
+	MissionControlWorkspaceWatermarkStatusStale      MissionControlWorkspaceWatermarkStatus = "stale"
 )
 
 // Defines values for MissionControlWorkspaceWatermarkWatermarkKind.
@@ -721,11 +800,38 @@ const (
 	ProviderFreshness MissionControlWorkspaceWatermarkWatermarkKind = "provider_freshness"
 )
 
-// Defines values for ProjectMemberRole.
+// Defines values for ReportChangeGovernanceFeedbackRequestGapKind.
+const (
+	LateReclassification ReportChangeGovernanceFeedbackRequestGapKind = "late_reclassification"
+	MissingEvidence      ReportChangeGovernanceFeedbackRequestGapKind = "missing_evidence"
+	SemanticMix          ReportChangeGovernanceFeedbackRequestGapKind = "semantic_mix"
+	SilentWaiverAttempt  ReportChangeGovernanceFeedbackRequestGapKind = "silent_waiver_attempt"
+	UnderClassified      ReportChangeGovernanceFeedbackRequestGapKind = "under_classified"
+	VerificationBypass   ReportChangeGovernanceFeedbackRequestGapKind = "verification_bypass"
+)
+
+// Defines values for ReportChangeGovernanceFeedbackRequestSeverity.
+const (
+	ReportChangeGovernanceFeedbackRequestSeverityCritical ReportChangeGovernanceFeedbackRequestSeverity = "critical"
+	ReportChangeGovernanceFeedbackRequestSeverityHigh     ReportChangeGovernanceFeedbackRequestSeverity = "high"
+	ReportChangeGovernanceFeedbackRequestSeverityMedium   ReportChangeGovernanceFeedbackRequestSeverity = "medium"
+)
+
+// Defines values for ReportChangeGovernanceFeedbackRequestSourceKind.
+const (
+	ReportChangeGovernanceFeedbackRequestSourceKindPostdeploy  ReportChangeGovernanceFeedbackRequestSourceKind = "postdeploy"
+	ReportChangeGovernanceFeedbackRequestSourceKindRelease     ReportChangeGovernanceFeedbackRequestSourceKind = "release"
+	ReportChangeGovernanceFeedbackRequestSourceKindRemediation ReportChangeGovernanceFeedbackRequestSourceKind = "remediation"
+	ReportChangeGovernanceFeedbackRequestSourceKindReview      ReportChangeGovernanceFeedbackRequestSourceKind = "review"
+)
+
+// Defines values for ReportChangeGovernanceFeedbackRequestSuggestedAction.
 const (
-	ProjectMemberRoleAdmin     ProjectMemberRole = "admin"
-	ProjectMemberRoleRead      ProjectMemberRole = "read"
-	ProjectMemberRoleReadWrite ProjectMemberRole = "read_write"
+	BlockRelease    ReportChangeGovernanceFeedbackRequestSuggestedAction = "block_release"
+	CloseGap        ReportChangeGovernanceFeedbackRequestSuggestedAction = "close_gap"
+	Reclassify      ReportChangeGovernanceFeedbackRequestSuggestedAction = "reclassify"
+	RecordWaiver    ReportChangeGovernanceFeedbackRequestSuggestedAction = "record_waiver"
+	RequestEvidence ReportChangeGovernanceFeedbackRequestSuggestedAction = "request_evidence"
 )
 
 // Defines values for RepositoryBindingRole.
@@ -754,6 +860,23 @@ const (
 	ResolveApprovalDecisionResponseApprovalStateRequested ResolveApprovalDecisionResponseApprovalState = "requested"
 )
 
+// Defines values for RetentionPolicyAction.
+const (
+	RetentionPolicyActionArchive RetentionPolicyAction = "archive"
+	RetentionPolicyActionDelete  RetentionPolicyAction = "delete"
+)
+
+// Defines values for RetentionPolicyTarget.
+const (
+	RetentionPolicyTargetAgentRunLogs                  RetentionPolicyTarget = "agent_run_logs"
+	RetentionPolicyTargetAgentSessions                 RetentionPolicyTarget = "agent_sessions"
+	RetentionPolicyTargetFlowEvents                    RetentionPolicyTarget = "flow_events"
+	RetentionPolicyTargetGithubRateLimitWaitEvidence   RetentionPolicyTarget = "github_rate_limit_wait_evidence"
+	RetentionPolicyTargetInteractionCallbackEvents     RetentionPolicyTarget = "interaction_callback_events"
+	RetentionPolicyTargetInteractionDeliveryAttempts   RetentionPolicyTarget = "interaction_delivery_attempts"
+	RetentionPolicyTargetMissionControlTimelineEntries RetentionPolicyTarget = "mission_control_timeline_entries"
+)
+
 // Defines values for RetrySyncPayloadExpectedStatus.
 const (
 	RetrySyncPayloadExpectedStatusAccepted        RetrySyncPayloadExpectedStatus = "accepted"
@@ -824,6 +947,21 @@ const (
 	ManuallyResolved RunWaitResolutionResolutionKind = "manually_resolved"
 )
 
+// Defines values for RuntimeDeployPreviewImageAction.
+const (
+	Build    RuntimeDeployPreviewImageAction = "build"
+	External RuntimeDeployPreviewImageAction = "external"
+	Reuse    RuntimeDeployPreviewImageAction = "reuse"
+)
+
+// Defines values for RuntimeDeployPreviewObjectAction.
+const (
+	Create    RuntimeDeployPreviewObjectAction = "create"
+	Prune     RuntimeDeployPreviewObjectAction = "prune"
+	Unchanged RuntimeDeployPreviewObjectAction = "unchanged"
+	Update    RuntimeDeployPreviewObjectAction = "update"
+)
+
 // Defines values for RuntimeDeployTaskStatus.
 const (
 	RuntimeDeployTaskStatusCanceled  RuntimeDeployTaskStatus = "canceled"
@@ -835,9 +973,9 @@ const (
 
 // Defines values for RuntimeDeployTaskTerminalStatusSource.
 const (
-	Operator RuntimeDeployTaskTerminalStatusSource = "operator"
-	System   RuntimeDeployTaskTerminalStatusSource = "system"
-	Worker   RuntimeDeployTaskTerminalStatusSource = "worker"
+	RuntimeDeployTaskTerminalStatusSourceOperator RuntimeDeployTaskTerminalStatusSource = "operator"
+	RuntimeDeployTaskTerminalStatusSourceSystem   RuntimeDeployTaskTerminalStatusSource = "system"
+	RuntimeDeployTaskTerminalStatusSourceWorker   RuntimeDeployTaskTerminalStatusSource = "worker"
 )
 
 // Defines values for RuntimeDeployTaskActionResponseAction.
@@ -887,11 +1025,84 @@ const (
 	RuntimeErrorLevelWarning  RuntimeErrorLevel = "warning"
 )
 
-// Defines values for UpsertProjectMemberRequestRole.
+// Defines values for RuntimeErrorGroupEscalationKind.
+const (
+	AiRepair RuntimeErrorGroupEscalationKind = "ai_repair"
+	Issue    RuntimeErrorGroupEscalationKind = "issue"
+)
+
+// Defines values for RuntimeErrorGroupLevel.
+const (
+	RuntimeErrorGroupLevelCritical RuntimeErrorGroupLevel = "critical"
+	RuntimeErrorGroupLevelError    RuntimeErrorGroupLevel = "error"
+	RuntimeErrorGroupLevelWarning  RuntimeErrorGroupLevel = "warning"
+)
+
+// Defines values for RuntimeErrorGroupStatus.
+const (
+	RuntimeErrorGroupStatusMuted    RuntimeErrorGroupStatus = "muted"
+	RuntimeErrorGroupStatusOpen     RuntimeErrorGroupStatus = "open"
+	RuntimeErrorGroupStatusResolved RuntimeErrorGroupStatus = "resolved"
+)
+
+// Defines values for StaffAPITokenScope.
+const (
+	Admin                  StaffAPITokenScope = "admin"
+	MissionControlCommands StaffAPITokenScope = "mission_control:commands"
+	ProjectsRead           StaffAPITokenScope = "projects:read"
+	RepositoriesManage     StaffAPITokenScope = "repositories:manage"
+	RunsCancel             StaffAPITokenScope = "runs:cancel"
+	RunsRead               StaffAPITokenScope = "runs:read"
+)
+
+// Defines values for SubmitChangeGovernanceReleaseReadinessDecisionRequestActorKind.
+const (
+	SubmitChangeGovernanceReleaseReadinessDecisionRequestActorKindOperator SubmitChangeGovernanceReleaseReadinessDecisionRequestActorKind = "operator"
+	SubmitChangeGovernanceReleaseReadinessDecisionRequestActorKindOwner    SubmitChangeGovernanceReleaseReadinessDecisionRequestActorKind = "owner"
+	SubmitChangeGovernanceReleaseReadinessDecisionRequestActorKindReviewer SubmitChangeGovernanceReleaseReadinessDecisionRequestActorKind = "reviewer"
+)
+
+// Defines values for SubmitChangeGovernanceReleaseReadinessDecisionRequestDecisionState.
+const (
+	SubmitChangeGovernanceReleaseReadinessDecisionRequestDecisionStateBlocked            SubmitChangeGovernanceReleaseReadinessDecisionRequestDecisionState = "blocked"
+	SubmitChangeGovernanceReleaseReadinessDecisionRequestDecisionStateConditionallyReady SubmitChangeGovernanceReleaseReadinessDecisionRequestDecisionState = "conditionally_ready"
+	SubmitChangeGovernanceReleaseReadinessDecisionRequestDecisionStateReady              SubmitChangeGovernanceReleaseReadinessDecisionRequestDecisionState = "ready"
+	SubmitChangeGovernanceReleaseReadinessDecisionRequestDecisionStateReleased           SubmitChangeGovernanceReleaseReadinessDecisionRequestDecisionState = "released"
+)
+
+// Defines values for SubmitChangeGovernanceWaiverDecisionRequestActorKind.
+const (
+	Operator SubmitChangeGovernanceWaiverDecisionRequestActorKind = "operator"
+	Owner    SubmitChangeGovernanceWaiverDecisionRequestActorKind = "owner"
+	Reviewer SubmitChangeGovernanceWaiverDecisionRequestActorKind = "reviewer"
+)
+
+// Defines values for SubmitChangeGovernanceWaiverDecisionRequestDecisionState.
+const (
+	SubmitChangeGovernanceWaiverDecisionRequestDecisionStateApproved SubmitChangeGovernanceWaiverDecisionRequestDecisionState = "approved"
+	SubmitChangeGovernanceWaiverDecisionRequestDecisionStateRejected SubmitChangeGovernanceWaiverDecisionRequestDecisionState = "rejected"
+)
+
+// Defines values for SubmitChangeGovernanceWaiverDecisionRequestResidualRiskTier.
+const (
+	SubmitChangeGovernanceWaiverDecisionRequestResidualRiskTierCritical SubmitChangeGovernanceWaiverDecisionRequestResidualRiskTier = "critical"
+	SubmitChangeGovernanceWaiverDecisionRequestResidualRiskTierHigh     SubmitChangeGovernanceWaiverDecisionRequestResidualRiskTier = "high"
+	SubmitChangeGovernanceWaiverDecisionRequestResidualRiskTierLow      SubmitChangeGovernanceWaiverDecisionRequestResidualRiskTier = "low"
+	SubmitChangeGovernanceWaiverDecisionRequestResidualRiskTierMedium   SubmitChangeGovernanceWaiverDecisionRequestResidualRiskTier = "medium"
+)
+
+// Defines values for UpdateRuntimeErrorGroupStatusRequestStatus.
+const (
+	UpdateRuntimeErrorGroupStatusRequestStatusMuted    UpdateRuntimeErrorGroupStatusRequestStatus = "muted"
+	UpdateRuntimeErrorGroupStatusRequestStatusOpen     UpdateRuntimeErrorGroupStatusRequestStatus = "open"
+	UpdateRuntimeErrorGroupStatusRequestStatusResolved UpdateRuntimeErrorGroupStatusRequestStatus = "resolved"
+)
+
+// Defines values for UpsertMCPApprovalPolicyRequestToolName.
 const (
-	UpsertProjectMemberRequestRoleAdmin     UpsertProjectMemberRequestRole = "admin"
-	UpsertProjectMemberRequestRoleRead      UpsertProjectMemberRequestRole = "read"
-	UpsertProjectMemberRequestRoleReadWrite UpsertProjectMemberRequestRole = "read_write"
+	UpsertMCPApprovalPolicyRequestToolNameDatabaseLifecycle    UpsertMCPApprovalPolicyRequestToolName = "database.lifecycle"
+	UpsertMCPApprovalPolicyRequestToolNameOwnerFeedbackRequest UpsertMCPApprovalPolicyRequestToolName = "owner.feedback.request"
+	UpsertMCPApprovalPolicyRequestToolNameSecretSyncK8s        UpsertMCPApprovalPolicyRequestToolName = "secret.sync.k8s"
 )
 
 // Defines values for UpsertProjectRepositoryRequestRole.
@@ -902,6 +1113,23 @@ const (
 	UpsertProjectRepositoryRequestRoleService      UpsertProjectRepositoryRequestRole = "service"
 )
 
+// Defines values for UpsertRetentionPolicyRequestAction.
+const (
+	UpsertRetentionPolicyRequestActionArchive UpsertRetentionPolicyRequestAction = "archive"
+	UpsertRetentionPolicyRequestActionDelete  UpsertRetentionPolicyRequestAction = "delete"
+)
+
+// Defines values for UpsertRetentionPolicyRequestTarget.
+const (
+	UpsertRetentionPolicyRequestTargetAgentRunLogs                  UpsertRetentionPolicyRequestTarget = "agent_run_logs"
+	UpsertRetentionPolicyRequestTargetAgentSessions                 UpsertRetentionPolicyRequestTarget = "agent_sessions"
+	UpsertRetentionPolicyRequestTargetFlowEvents                    UpsertRetentionPolicyRequestTarget = "flow_events"
+	UpsertRetentionPolicyRequestTargetGithubRateLimitWaitEvidence   UpsertRetentionPolicyRequestTarget = "github_rate_limit_wait_evidence"
+	UpsertRetentionPolicyRequestTargetInteractionCallbackEvents     UpsertRetentionPolicyRequestTarget = "interaction_callback_events"
+	UpsertRetentionPolicyRequestTargetInteractionDeliveryAttempts   UpsertRetentionPolicyRequestTarget = "interaction_delivery_attempts"
+	UpsertRetentionPolicyRequestTargetMissionControlTimelineEntries UpsertRetentionPolicyRequestTarget = "mission_control_timeline_entries"
+)
+
 // Defines values for MissionControlActiveFilter.
 const (
 	MissionControlActiveFilterAllActive             MissionControlActiveFilter = "all_active"
@@ -950,6 +1178,16 @@ const (
 	MissionControlWorkspaceViewModeList  MissionControlWorkspaceViewMode = "list"
 )
 
+// Defines values for GetRunOutcomeAnalyticsParamsGroupBy.
+const (
+	GetRunOutcomeAnalyticsParamsGroupByModel        GetRunOutcomeAnalyticsParamsGroupBy = "model"
+	GetRunOutcomeAnalyticsParamsGroupByProject      GetRunOutcomeAnalyticsParamsGroupBy = "project"
+	GetRunOutcomeAnalyticsParamsGroupByPromptSource GetRunOutcomeAnalyticsParamsGroupBy = "prompt_source"
+	GetRunOutcomeAnalyticsParamsGroupByReasoning    GetRunOutcomeAnalyticsParamsGroupBy = "reasoning"
+	GetRunOutcomeAnalyticsParamsGroupByRole         GetRunOutcomeAnalyticsParamsGroupBy = "role"
+	GetRunOutcomeAnalyticsParamsGroupByTrigger      GetRunOutcomeAnalyticsParamsGroupBy = "trigger"
+)
+
 // Defines values for ListDocsetGroupsParamsLocale.
 const (
 	ListDocsetGroupsParamsLocaleEn ListDocsetGroupsParamsLocale = "en"
@@ -972,6 +1210,14 @@ const (
 	ListMissionControlNodeActivityParamsNodeKindWorkItem    ListMissionControlNodeActivityParamsNodeKind = "work_item"
 )
 
+// Defines values for ListMissionControlVoiceCandidatesParamsStatus.
+const (
+	ListMissionControlVoiceCandidatesParamsStatusDraft    ListMissionControlVoiceCandidatesParamsStatus = "draft"
+	ListMissionControlVoiceCandidatesParamsStatusExpired  ListMissionControlVoiceCandidatesParamsStatus = "expired"
+	ListMissionControlVoiceCandidatesParamsStatusPromoted ListMissionControlVoiceCandidatesParamsStatus = "promoted"
+	ListMissionControlVoiceCandidatesParamsStatusRejected ListMissionControlVoiceCandidatesParamsStatus = "rejected"
+)
+
 // Defines values for GetMissionControlWorkspaceParamsViewMode.
 const (
 	Graph GetMissionControlWorkspaceParamsViewMode = "graph"
@@ -990,11 +1236,11 @@ const (
 
 // Defines values for RuntimeDeployTasksRealtimeParamsStatus.
 const (
-	Canceled  RuntimeDeployTasksRealtimeParamsStatus = "canceled"
-	Failed    RuntimeDeployTasksRealtimeParamsStatus = "failed"
-	Pending   RuntimeDeployTasksRealtimeParamsStatus = "pending"
-	Running   RuntimeDeployTasksRealtimeParamsStatus = "running"
-	Succeeded RuntimeDeployTasksRealtimeParamsStatus = "succeeded"
+	RuntimeDeployTasksRealtimeParamsStatusCanceled  RuntimeDeployTasksRealtimeParamsStatus = "canceled"
+	RuntimeDeployTasksRealtimeParamsStatusFailed    RuntimeDeployTasksRealtimeParamsStatus = "failed"
+	RuntimeDeployTasksRealtimeParamsStatusPending   RuntimeDeployTasksRealtimeParamsStatus = "pending"
+	RuntimeDeployTasksRealtimeParamsStatusRunning   RuntimeDeployTasksRealtimeParamsStatus = "running"
+	RuntimeDeployTasksRealtimeParamsStatusSucceeded RuntimeDeployTasksRealtimeParamsStatus = "succeeded"
 )
 
 // Defines values for ListRuntimeErrorsParamsState.
@@ -1006,9 +1252,24 @@ const (
 
 // Defines values for ListRuntimeErrorsParamsLevel.
 const (
-	Critical ListRuntimeErrorsParamsLevel = "critical"
-	Error    ListRuntimeErrorsParamsLevel = "error"
-	Warning  ListRuntimeErrorsParamsLevel = "warning"
+	ListRuntimeErrorsParamsLevelCritical ListRuntimeErrorsParamsLevel = "critical"
+	ListRuntimeErrorsParamsLevelError    ListRuntimeErrorsParamsLevel = "error"
+	ListRuntimeErrorsParamsLevelWarning  ListRuntimeErrorsParamsLevel = "warning"
+)
+
+// Defines values for ListRuntimeErrorGroupsParamsStatus.
+const (
+	ListRuntimeErrorGroupsParamsStatusAll      ListRuntimeErrorGroupsParamsStatus = "all"
+	ListRuntimeErrorGroupsParamsStatusMuted    ListRuntimeErrorGroupsParamsStatus = "muted"
+	ListRuntimeErrorGroupsParamsStatusOpen     ListRuntimeErrorGroupsParamsStatus = "open"
+	ListRuntimeErrorGroupsParamsStatusResolved ListRuntimeErrorGroupsParamsStatus = "resolved"
+)
+
+// Defines values for ListRuntimeErrorGroupsParamsLevel.
+const (
+	Critical ListRuntimeErrorGroupsParamsLevel = "critical"
+	Error    ListRuntimeErrorGroupsParamsLevel = "error"
+	Warning  ListRuntimeErrorGroupsParamsLevel = "warning"
 )
 
 // AgentDetailsPayload defines model for AgentDetailsPayload.
@@ -1022,22 +1283,42 @@ type AgentDetailsPayload struct {
 	WaitingReason     *string    `json:"waiting_reason"`
 }
 
+// AlertIncidentOutcome defines model for AlertIncidentOutcome.
+type AlertIncidentOutcome struct {
+	Action        AlertIncidentOutcomeAction `json:"action"`
+	AlertName     string                     `json:"alert_name"`
+	Fingerprint   string                     `json:"fingerprint"`
+	IncidentId    *string                    `json:"incident_id,omitempty"`
+	IssueUrl      *string                    `json:"issue_url,omitempty"`
+	RunId         *string                    `json:"run_id,omitempty"`
+	RunSkipReason *string                    `json:"run_skip_reason,omitempty"`
+}
+
+// AlertIncidentOutcomeAction defines model for AlertIncidentOutcome.Action.
+type AlertIncidentOutcomeAction string
+
 // ApprovalRequest defines model for ApprovalRequest.
 type ApprovalRequest struct {
-	Action        string                      `json:"action"`
-	ApprovalMode  ApprovalRequestApprovalMode `json:"approval_mode"`
-	CorrelationId string                      `json:"correlation_id"`
-	CreatedAt     time.Time                   `json:"created_at"`
-	Id            int64                       `json:"id"`
-	IssueNumber   *int32                      `json:"issue_number"`
-	PrNumber      *int32                      `json:"pr_number"`
-	ProjectId     *string                     `json:"project_id"`
-	ProjectName   *string                     `json:"project_name"`
-	ProjectSlug   *string                     `json:"project_slug"`
-	RequestedBy   string                      `json:"requested_by"`
-	RunId         *string                     `json:"run_id"`
-	ToolName      string                      `json:"tool_name"`
-	TriggerLabel  *string                     `json:"trigger_label"`
+	Action         string                      `json:"action"`
+	ApprovalMode   ApprovalRequestApprovalMode `json:"approval_mode"`
+	ApprovalsCount int32                       `json:"approvals_count"`
+	CorrelationId  string                      `json:"correlation_id"`
+	CreatedAt      time.Time                   `json:"created_at"`
+	ExpiresAt      *time.Time                  `json:"expires_at"`
+	Id             int64                       `json:"id"`
+	IssueNumber    *int32                      `json:"issue_number"`
+	PrNumber       *int32                      `json:"pr_number"`
+	ProjectId      *string                     `json:"project_id"`
+	ProjectName    *string                     `json:"project_name"`
+	ProjectSlug    *string                     `json:"project_slug"`
+	RequestedBy    string                      `json:"requested_by"`
+
+	// RequiredApprovals Approvals required by project policy; 0 means the request is resolved by a single decision.
+	RequiredApprovals int32          `json:"required_approvals"`
+	RunId             *string        `json:"run_id"`
+	ToolName          string         `json:"tool_name"`
+	TriggerLabel      *string        `json:"trigger_label"`
+	Votes             []ApprovalVote `json:"votes"`
 }
 
 // ApprovalRequestApprovalMode defines model for ApprovalRequest.ApprovalMode.
@@ -1048,6 +1329,97 @@ type ApprovalRequestItemsResponse struct {
 	Items []ApprovalRequest `json:"items"`
 }
 
+// ApprovalVote defines model for ApprovalVote.
+type ApprovalVote struct {
+	ActorId  string               `json:"actor_id"`
+	Decision ApprovalVoteDecision `json:"decision"`
+	Reason   *string              `json:"reason"`
+	VotedAt  *time.Time           `json:"voted_at"`
+}
+
+// ApprovalVoteDecision defines model for ApprovalVote.Decision.
+type ApprovalVoteDecision string
+
+// ChangeGovernanceDecision defines model for ChangeGovernanceDecision.
+type ChangeGovernanceDecision struct {
+	ActorKind        string     `json:"actor_kind"`
+	DecisionId       string     `json:"decision_id"`
+	DecisionKind     string     `json:"decision_kind"`
+	Id               string     `json:"id"`
+	RecordedAt       *time.Time `json:"recorded_at"`
+	ResidualRiskTier *string    `json:"residual_risk_tier"`
+	State            string     `json:"state"`
+	SummaryMarkdown  string     `json:"summary_markdown"`
+}
+
+// ChangeGovernanceFeedback defines model for ChangeGovernanceFeedback.
+type ChangeGovernanceFeedback struct {
+	ClosedAt           *time.Time `json:"closed_at"`
+	FeedbackId         string     `json:"feedback_id"`
+	GapKind            string     `json:"gap_kind"`
+	Id                 string     `json:"id"`
+	OpenedAt           *time.Time `json:"opened_at"`
+	RelatedArtifactRef *string    `json:"related_artifact_ref"`
+	Severity           string     `json:"severity"`
+	SourceKind         string     `json:"source_kind"`
+	State              string     `json:"state"`
+	SuggestedAction    string     `json:"suggested_action"`
+	SummaryMarkdown    string     `json:"summary_markdown"`
+}
+
+// ChangeGovernancePackage defines model for ChangeGovernancePackage.
+type ChangeGovernancePackage struct {
+	BundleAdmissibility       string                                       `json:"bundle_admissibility"`
+	Decisions                 []ChangeGovernanceDecision                   `json:"decisions"`
+	EvidenceCompletenessState string                                       `json:"evidence_completeness_state"`
+	Feedback                  []ChangeGovernanceFeedback                   `json:"feedback"`
+	GovernanceFeedbackState   string                                       `json:"governance_feedback_state"`
+	Id                        string                                       `json:"id"`
+	IssueNumber               int32                                        `json:"issue_number"`
+	PrNumber                  *int32                                       `json:"pr_number"`
+	ProjectId                 string                                       `json:"project_id"`
+	ProjectionVersion         int64                                        `json:"projection_version"`
+	PublicationState          string                                       `json:"publication_state"`
+	ReleaseReadinessState     ChangeGovernancePackageReleaseReadinessState `json:"release_readiness_state"`
+	RepositoryFullName        string                                       `json:"repository_full_name"`
+	RiskTier                  *string                                      `json:"risk_tier"`
+	UpdatedAt                 *time.Time                                   `json:"updated_at"`
+	VerificationMinimumState  string                                       `json:"verification_minimum_state"`
+	WaiverState               ChangeGovernancePackageWaiverState           `json:"waiver_state"`
+}
+
+// ChangeGovernancePackageReleaseReadinessState defines model for ChangeGovernancePackage.ReleaseReadinessState.
+type ChangeGovernancePackageReleaseReadinessState string
+
+// ChangeGovernancePackageWaiverState defines model for ChangeGovernancePackage.WaiverState.
+type ChangeGovernancePackageWaiverState string
+
+// CreateServiceAccountRequest defines model for CreateServiceAccountRequest.
+type CreateServiceAccountRequest struct {
+	IsPlatformAdmin *bool `json:"is_platform_admin,omitempty"`
+
+	// Name DNS label; service account email becomes `<name>@service-accounts.kodex.invalid`.
+	Name string `json:"name"`
+}
+
+// CreateStaffAPITokenRequest defines model for CreateStaffAPITokenRequest.
+type CreateStaffAPITokenRequest struct {
+	ExpiresInDays *int32               `json:"expires_in_days,omitempty"`
+	Name          string               `json:"name"`
+	ProjectIds    *[]string            `json:"project_ids,omitempty"`
+	Scopes        []StaffAPITokenScope `json:"scopes"`
+
+	// UserId Token owner: the requesting admin (default) or a service account.
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// CreateStaffAPITokenResponse defines model for CreateStaffAPITokenResponse.
+type CreateStaffAPITokenResponse struct {
+	// Secret Raw bearer token (`kxt_...`); returned only once.
+	Secret string        `json:"secret"`
+	Token  StaffAPIToken `json:"token"`
+}
+
 // CreateUserRequest defines model for CreateUserRequest.
 type CreateUserRequest struct {
 	Email           openapi_types.Email `json:"email"`
@@ -1110,12 +1482,22 @@ type ErrorResponse struct {
 // ErrorResponseCode defines model for ErrorResponse.Code.
 type ErrorResponseCode string
 
+// EscalateRuntimeErrorGroupRequest defines model for EscalateRuntimeErrorGroupRequest.
+type EscalateRuntimeErrorGroupRequest struct {
+	Kind EscalateRuntimeErrorGroupRequestKind `json:"kind"`
+}
+
+// EscalateRuntimeErrorGroupRequestKind defines model for EscalateRuntimeErrorGroupRequest.Kind.
+type EscalateRuntimeErrorGroupRequestKind string
+
 // FlowEvent defines model for FlowEvent.
 type FlowEvent struct {
-	CorrelationId string    `json:"correlation_id"`
-	CreatedAt     time.Time `json:"created_at"`
-	EventType     string    `json:"event_type"`
-	PayloadJson   string    `json:"payload_json"`
+	CorrelationId   string    `json:"correlation_id"`
+	CreatedAt       time.Time `json:"created_at"`
+	EventType       string    `json:"event_type"`
+	Id              int64     `json:"id"`
+	PayloadJson     string    `json:"payload_json"`
+	RetentionPinned bool      `json:"retention_pinned"`
 }
 
 // FlowEventItemsResponse defines model for FlowEventItemsResponse.
@@ -1199,6 +1581,16 @@ type ImportDocsetResponse struct {
 	RepositoryFullName string `json:"repository_full_name"`
 }
 
+// IngestAlertmanagerWebhookResponse defines model for IngestAlertmanagerWebhookResponse.
+type IngestAlertmanagerWebhookResponse struct {
+	CorrelationId string                                  `json:"correlation_id"`
+	Incidents     []AlertIncidentOutcome                  `json:"incidents"`
+	Status        IngestAlertmanagerWebhookResponseStatus `json:"status"`
+}
+
+// IngestAlertmanagerWebhookResponseStatus defines model for IngestAlertmanagerWebhookResponse.Status.
+type IngestAlertmanagerWebhookResponseStatus string
+
 // IngestGitHubWebhookResponse defines model for IngestGitHubWebhookResponse.
 type IngestGitHubWebhookResponse struct {
 	CorrelationId string                            `json:"correlation_id"`
@@ -1299,6 +1691,35 @@ type MCPApprovalCallbackRequest struct {
 // MCPApprovalCallbackRequestDecision defines model for MCPApprovalCallbackRequest.Decision.
 type MCPApprovalCallbackRequestDecision string
 
+// MCPApprovalPolicy defines model for MCPApprovalPolicy.
+type MCPApprovalPolicy struct {
+	CreatedAt       *string  `json:"created_at"`
+	EligibleRoles   []string `json:"eligible_roles"`
+	EligibleUserIds []string `json:"eligible_user_ids"`
+
+	// Environment Empty value matches every environment without a more specific policy.
+	Environment string `json:"environment"`
+
+	// ExpiresAfterSeconds Pending requests are auto-rejected after this period; 0 disables expiry.
+	ExpiresAfterSeconds int32  `json:"expires_after_seconds"`
+	Id                  string `json:"id"`
+	ProjectId           string `json:"project_id"`
+	RequiredApprovals   int32  `json:"required_approvals"`
+
+	// SeparationOfDuties Run initiator cannot approve the request.
+	SeparationOfDuties bool                      `json:"separation_of_duties"`
+	ToolName           MCPApprovalPolicyToolName `json:"tool_name"`
+	UpdatedAt          *string                   `json:"updated_at"`
+}
+
+// MCPApprovalPolicyToolName defines model for MCPApprovalPolicy.ToolName.
+type MCPApprovalPolicyToolName string
+
+// MCPApprovalPolicyItemsResponse defines model for MCPApprovalPolicyItemsResponse.
+type MCPApprovalPolicyItemsResponse struct {
+	Items []MCPApprovalPolicy `json:"items"`
+}
+
 // MeResponse defines model for MeResponse.
 type MeResponse struct {
 	User MeUser `json:"user"`
@@ -1970,6 +2391,67 @@ type MissionControlTimelineItemsResponse struct {
 	NextCursor *string                       `json:"next_cursor"`
 }
 
+// MissionControlVoiceCandidate defines model for MissionControlVoiceCandidate.
+type MissionControlVoiceCandidate struct {
+	CandidateId        string                                  `json:"candidate_id"`
+	CommandKind        MissionControlVoiceCandidateCommandKind `json:"command_kind"`
+	Confidence         float64                                 `json:"confidence"`
+	CreatedAt          time.Time                               `json:"created_at"`
+	CreatedBy          string                                  `json:"created_by"`
+	DecidedAt          *time.Time                              `json:"decided_at"`
+	DecidedBy          *string                                 `json:"decided_by"`
+	DraftBodyMarkdown  *string                                 `json:"draft_body_markdown"`
+	DraftInitialLabels []string                                `json:"draft_initial_labels"`
+	DraftTitle         string                                  `json:"draft_title"`
+	ProjectId          string                                  `json:"project_id"`
+	PromotedCommandId  *string                                 `json:"promoted_command_id"`
+	RejectReason       *string                                 `json:"reject_reason"`
+	SourceAdapter      *string                                 `json:"source_adapter"`
+	SourceKind         MissionControlVoiceCandidateSourceKind  `json:"source_kind"`
+	Status             MissionControlVoiceCandidateStatus      `json:"status"`
+	StructuredSummary  string                                  `json:"structured_summary"`
+	Transcript         string                                  `json:"transcript"`
+	TranscriptExcerpt  string                                  `json:"transcript_excerpt"`
+}
+
+// MissionControlVoiceCandidateCommandKind defines model for MissionControlVoiceCandidate.CommandKind.
+type MissionControlVoiceCandidateCommandKind string
+
+// MissionControlVoiceCandidateSourceKind defines model for MissionControlVoiceCandidate.SourceKind.
+type MissionControlVoiceCandidateSourceKind string
+
+// MissionControlVoiceCandidateStatus defines model for MissionControlVoiceCandidate.Status.
+type MissionControlVoiceCandidateStatus string
+
+// MissionControlVoiceCandidateItemsResponse defines model for MissionControlVoiceCandidateItemsResponse.
+type MissionControlVoiceCandidateItemsResponse struct {
+	Items []MissionControlVoiceCandidate `json:"items"`
+}
+
+// MissionControlVoiceCandidatePromoteRequest defines model for MissionControlVoiceCandidatePromoteRequest.
+type MissionControlVoiceCandidatePromoteRequest struct {
+	BodyMarkdown  *string                                                `json:"body_markdown,omitempty"`
+	CommandKind   *MissionControlVoiceCandidatePromoteRequestCommandKind `json:"command_kind,omitempty"`
+	InitialLabels *[]string                                              `json:"initial_labels,omitempty"`
+	ProjectId     string                                                 `json:"project_id"`
+	Title         *string                                                `json:"title,omitempty"`
+}
+
+// MissionControlVoiceCandidatePromoteRequestCommandKind defines model for MissionControlVoiceCandidatePromoteRequest.CommandKind.
+type MissionControlVoiceCandidatePromoteRequestCommandKind string
+
+// MissionControlVoiceCandidatePromotion defines model for MissionControlVoiceCandidatePromotion.
+type MissionControlVoiceCandidatePromotion struct {
+	Candidate MissionControlVoiceCandidate `json:"candidate"`
+	Command   *MissionControlCommandState  `json:"command,omitempty"`
+}
+
+// MissionControlVoiceCandidateRejectRequest defines model for MissionControlVoiceCandidateRejectRequest.
+type MissionControlVoiceCandidateRejectRequest struct {
+	ProjectId string  `json:"project_id"`
+	Reason    *string `json:"reason,omitempty"`
+}
+
 // MissionControlWorkItemCreateCommandRequest defines model for MissionControlWorkItemCreateCommandRequest.
 type MissionControlWorkItemCreateCommandRequest struct {
 	BusinessIntentKey         string                                                      `json:"business_intent_key"`
@@ -2091,6 +2573,16 @@ type PreflightCheckResult struct {
 	Status  string  `json:"status"`
 }
 
+// PreviewRuntimeDeployRequest defines model for PreviewRuntimeDeployRequest.
+type PreviewRuntimeDeployRequest struct {
+	BuildRef           string  `json:"build_ref"`
+	Namespace          *string `json:"namespace,omitempty"`
+	RepositoryFullName *string `json:"repository_full_name,omitempty"`
+	ServicesYamlPath   *string `json:"services_yaml_path,omitempty"`
+	SlotNo             *int32  `json:"slot_no,omitempty"`
+	TargetEnv          string  `json:"target_env"`
+}
+
 // Project defines model for Project.
 type Project struct {
 	Id   string `json:"id"`
@@ -2115,21 +2607,45 @@ type ProjectItemsResponse struct {
 
 // ProjectMember defines model for ProjectMember.
 type ProjectMember struct {
-	Email                string            `json:"email"`
-	LearningModeOverride *bool             `json:"learning_mode_override"`
-	ProjectId            string            `json:"project_id"`
-	Role                 ProjectMemberRole `json:"role"`
-	UserId               string            `json:"user_id"`
-}
+	Email                string `json:"email"`
+	LearningModeOverride *bool  `json:"learning_mode_override"`
+	ProjectId            string `json:"project_id"`
 
-// ProjectMemberRole defines model for ProjectMember.Role.
-type ProjectMemberRole string
+	// Role Built-in role (read, read_write, admin) or custom project role name.
+	Role   string `json:"role"`
+	UserId string `json:"user_id"`
+}
 
 // ProjectMemberItemsResponse defines model for ProjectMemberItemsResponse.
 type ProjectMemberItemsResponse struct {
 	Items []ProjectMember `json:"items"`
 }
 
+// ProjectPermissionDescriptor defines model for ProjectPermissionDescriptor.
+type ProjectPermissionDescriptor struct {
+	Permission string `json:"permission"`
+	Qualified  bool   `json:"qualified"`
+}
+
+// ProjectRole defines model for ProjectRole.
+type ProjectRole struct {
+	Builtin     bool    `json:"builtin"`
+	CreatedAt   *string `json:"created_at"`
+	Description string  `json:"description"`
+	Name        string  `json:"name"`
+
+	// Permissions Permission grants in "<permission>" or "<permission>:<qualifier>" form.
+	Permissions []string `json:"permissions"`
+	ProjectId   string   `json:"project_id"`
+	UpdatedAt   *string  `json:"updated_at"`
+}
+
+// ProjectRolesResponse defines model for ProjectRolesResponse.
+type ProjectRolesResponse struct {
+	Catalog []ProjectPermissionDescriptor `json:"catalog"`
+	Items   []ProjectRole                 `json:"items"`
+}
+
 // PullRequestDetailsPayload defines model for PullRequestDetailsPayload.
 type PullRequestDetailsPayload struct {
 	BranchBase         *string   `json:"branch_base"`
@@ -2145,6 +2661,29 @@ type PullRequestDetailsPayload struct {
 	ReviewDecision     *string   `json:"review_decision"`
 }
 
+// ReportChangeGovernanceFeedbackRequest defines model for ReportChangeGovernanceFeedbackRequest.
+type ReportChangeGovernanceFeedbackRequest struct {
+	FeedbackId         string                                               `json:"feedback_id"`
+	GapKind            ReportChangeGovernanceFeedbackRequestGapKind         `json:"gap_kind"`
+	RelatedArtifactRef *string                                              `json:"related_artifact_ref,omitempty"`
+	Severity           ReportChangeGovernanceFeedbackRequestSeverity        `json:"severity"`
+	SourceKind         ReportChangeGovernanceFeedbackRequestSourceKind      `json:"source_kind"`
+	SuggestedAction    ReportChangeGovernanceFeedbackRequestSuggestedAction `json:"suggested_action"`
+	SummaryMarkdown    string                                               `json:"summary_markdown"`
+}
+
+// ReportChangeGovernanceFeedbackRequestGapKind defines model for ReportChangeGovernanceFeedbackRequest.GapKind.
+type ReportChangeGovernanceFeedbackRequestGapKind string
+
+// ReportChangeGovernanceFeedbackRequestSeverity defines model for ReportChangeGovernanceFeedbackRequest.Severity.
+type ReportChangeGovernanceFeedbackRequestSeverity string
+
+// ReportChangeGovernanceFeedbackRequestSourceKind defines model for ReportChangeGovernanceFeedbackRequest.SourceKind.
+type ReportChangeGovernanceFeedbackRequestSourceKind string
+
+// ReportChangeGovernanceFeedbackRequestSuggestedAction defines model for ReportChangeGovernanceFeedbackRequest.SuggestedAction.
+type ReportChangeGovernanceFeedbackRequestSuggestedAction string
+
 // RepositoryBinding defines model for RepositoryBinding.
 type RepositoryBinding struct {
 	Alias              string                `json:"alias"`
@@ -2182,17 +2721,47 @@ type ResolveApprovalDecisionRequestDecision string
 
 // ResolveApprovalDecisionResponse defines model for ResolveApprovalDecisionResponse.
 type ResolveApprovalDecisionResponse struct {
-	Action        string                                       `json:"action"`
-	ApprovalState ResolveApprovalDecisionResponseApprovalState `json:"approval_state"`
-	CorrelationId string                                       `json:"correlation_id"`
-	Id            int64                                        `json:"id"`
-	RunId         *string                                      `json:"run_id"`
-	ToolName      string                                       `json:"tool_name"`
+	Action            string                                       `json:"action"`
+	ApprovalState     ResolveApprovalDecisionResponseApprovalState `json:"approval_state"`
+	ApprovalsCount    int32                                        `json:"approvals_count"`
+	CorrelationId     string                                       `json:"correlation_id"`
+	Id                int64                                        `json:"id"`
+	RequiredApprovals int32                                        `json:"required_approvals"`
+	RunId             *string                                      `json:"run_id"`
+	ToolName          string                                       `json:"tool_name"`
 }
 
 // ResolveApprovalDecisionResponseApprovalState defines model for ResolveApprovalDecisionResponse.ApprovalState.
 type ResolveApprovalDecisionResponseApprovalState string
 
+// RetentionPolicy defines model for RetentionPolicy.
+type RetentionPolicy struct {
+	Action           RetentionPolicyAction `json:"action"`
+	CreatedAt        time.Time             `json:"created_at"`
+	Enabled          bool                  `json:"enabled"`
+	Id               string                `json:"id"`
+	LastArchiveKey   *string               `json:"last_archive_key"`
+	LastError        *string               `json:"last_error"`
+	LastRemovedCount int64                 `json:"last_removed_count"`
+	LastSweptAt      *time.Time            `json:"last_swept_at"`
+	ProjectId        *string               `json:"project_id"`
+	RetentionDays    int32                 `json:"retention_days"`
+	Target           RetentionPolicyTarget `json:"target"`
+	UpdatedAt        time.Time             `json:"updated_at"`
+	UpdatedBy        *string               `json:"updated_by"`
+}
+
+// RetentionPolicyAction defines model for RetentionPolicy.Action.
+type RetentionPolicyAction string
+
+// RetentionPolicyTarget defines model for RetentionPolicy.Target.
+type RetentionPolicyTarget string
+
+// RetentionPolicyItemsResponse defines model for RetentionPolicyItemsResponse.
+type RetentionPolicyItemsResponse struct {
+	Items []RetentionPolicy `json:"items"`
+}
+
 // RetrySyncPayload defines model for RetrySyncPayload.
 type RetrySyncPayload struct {
 	CommandId      string                          `json:"command_id"`
@@ -2205,32 +2774,41 @@ type RetrySyncPayloadExpectedStatus string
 
 // Run defines model for Run.
 type Run struct {
-	AgentKey        *string            `json:"agent_key"`
-	CorrelationId   string             `json:"correlation_id"`
-	CreatedAt       time.Time          `json:"created_at"`
-	FinishedAt      *time.Time         `json:"finished_at"`
-	Id              string             `json:"id"`
-	IssueNumber     *int32             `json:"issue_number"`
-	IssueUrl        *string            `json:"issue_url"`
-	JobExists       *bool              `json:"job_exists,omitempty"`
-	JobName         *string            `json:"job_name"`
-	JobNamespace    *string            `json:"job_namespace"`
-	LastHeartbeatAt *time.Time         `json:"last_heartbeat_at"`
-	Namespace       *string            `json:"namespace"`
-	NamespaceExists *bool              `json:"namespace_exists,omitempty"`
-	PrNumber        *int32             `json:"pr_number"`
-	PrUrl           *string            `json:"pr_url"`
-	ProjectId       *string            `json:"project_id"`
-	ProjectName     string             `json:"project_name"`
-	ProjectSlug     string             `json:"project_slug"`
-	StartedAt       *time.Time         `json:"started_at"`
-	Status          string             `json:"status"`
-	TriggerKind     *string            `json:"trigger_kind"`
-	TriggerLabel    *string            `json:"trigger_label"`
-	WaitProjection  *RunWaitProjection `json:"wait_projection,omitempty"`
-	WaitReason      *string            `json:"wait_reason"`
-	WaitSince       *time.Time         `json:"wait_since"`
-	WaitState       *string            `json:"wait_state"`
+	AgentKey      *string   `json:"agent_key"`
+	CorrelationId string    `json:"correlation_id"`
+	CreatedAt     time.Time `json:"created_at"`
+
+	// EstimatedWaitSeconds Rough queue wait estimate from recent project run durations; null when unknown.
+	EstimatedWaitSeconds *int64     `json:"estimated_wait_seconds"`
+	FinishedAt           *time.Time `json:"finished_at"`
+	Id                   string     `json:"id"`
+	IssueNumber          *int32     `json:"issue_number"`
+	IssueUrl             *string    `json:"issue_url"`
+	JobExists            *bool      `json:"job_exists,omitempty"`
+	JobName              *string    `json:"job_name"`
+	JobNamespace         *string    `json:"job_namespace"`
+	LastHeartbeatAt      *time.Time `json:"last_heartbeat_at"`
+	Namespace            *string    `json:"namespace"`
+	NamespaceExists      *bool      `json:"namespace_exists,omitempty"`
+	PrNumber             *int32     `json:"pr_number"`
+	PrUrl                *string    `json:"pr_url"`
+
+	// Priority Run queue priority (1..100); higher runs are claimed first.
+	Priority    *int32  `json:"priority"`
+	ProjectId   *string `json:"project_id"`
+	ProjectName string  `json:"project_name"`
+	ProjectSlug string  `json:"project_slug"`
+
+	// QueuePosition Approximate 1-based claim position among pending runs in fair-share order; null when run is not pending.
+	QueuePosition  *int32             `json:"queue_position"`
+	StartedAt      *time.Time         `json:"started_at"`
+	Status         string             `json:"status"`
+	TriggerKind    *string            `json:"trigger_kind"`
+	TriggerLabel   *string            `json:"trigger_label"`
+	WaitProjection *RunWaitProjection `json:"wait_projection,omitempty"`
+	WaitReason     *string            `json:"wait_reason"`
+	WaitSince      *time.Time         `json:"wait_since"`
+	WaitState      *string            `json:"wait_state"`
 }
 
 // RunActionRequest defines model for RunActionRequest.
@@ -2283,6 +2861,38 @@ type RunNamespaceCleanupResponse struct {
 	RunId          string  `json:"run_id"`
 }
 
+// RunOutcomeAnalytics defines model for RunOutcomeAnalytics.
+type RunOutcomeAnalytics struct {
+	From    time.Time         `json:"from"`
+	GroupBy []string          `json:"group_by"`
+	Groups  []RunOutcomeGroup `json:"groups"`
+	To      time.Time         `json:"to"`
+	Totals  RunOutcomeGroup   `json:"totals"`
+}
+
+// RunOutcomeGroup defines model for RunOutcomeGroup.
+type RunOutcomeGroup struct {
+	AgentSeconds             float64 `json:"agent_seconds"`
+	AvgReviseRounds          float64 `json:"avg_revise_rounds"`
+	AvgWallClockSeconds      float64 `json:"avg_wall_clock_seconds"`
+	ChangesRequested         int64   `json:"changes_requested"`
+	FailedRuns               int64   `json:"failed_runs"`
+	Lineages                 int64   `json:"lineages"`
+	MedianTimeToMergeSeconds float64 `json:"median_time_to_merge_seconds"`
+	MergeRate                float64 `json:"merge_rate"`
+	Model                    string  `json:"model"`
+	ProjectId                string  `json:"project_id"`
+	PromptSource             string  `json:"prompt_source"`
+	PrsClosedUnmerged        int64   `json:"prs_closed_unmerged"`
+	PrsMerged                int64   `json:"prs_merged"`
+	PrsOpened                int64   `json:"prs_opened"`
+	ReasoningEffort          string  `json:"reasoning_effort"`
+	ReviseRuns               int64   `json:"revise_runs"`
+	Role                     string  `json:"role"`
+	Runs                     int64   `json:"runs"`
+	TriggerKind              string  `json:"trigger_kind"`
+}
+
 // RunRealtimeMessage defines model for RunRealtimeMessage.
 type RunRealtimeMessage struct {
 	Events           *[]FlowEvent              `json:"events,omitempty"`
@@ -2341,6 +2951,39 @@ type RunWaitResolutionContourKind string
 // RunWaitResolutionResolutionKind defines model for RunWaitResolution.ResolutionKind.
 type RunWaitResolutionResolutionKind string
 
+// RuntimeDeployPreview defines model for RuntimeDeployPreview.
+type RuntimeDeployPreview struct {
+	BuildRef  string                       `json:"build_ref"`
+	Images    []RuntimeDeployPreviewImage  `json:"images"`
+	Namespace string                       `json:"namespace"`
+	Objects   []RuntimeDeployPreviewObject `json:"objects"`
+	TargetEnv string                       `json:"target_env"`
+}
+
+// RuntimeDeployPreviewImage defines model for RuntimeDeployPreviewImage.
+type RuntimeDeployPreviewImage struct {
+	Action   RuntimeDeployPreviewImageAction `json:"action"`
+	ImageRef string                          `json:"image_ref"`
+	Name     string                          `json:"name"`
+}
+
+// RuntimeDeployPreviewImageAction defines model for RuntimeDeployPreviewImage.Action.
+type RuntimeDeployPreviewImageAction string
+
+// RuntimeDeployPreviewObject defines model for RuntimeDeployPreviewObject.
+type RuntimeDeployPreviewObject struct {
+	Action        RuntimeDeployPreviewObjectAction `json:"action"`
+	ApiVersion    string                           `json:"api_version"`
+	ChangedFields []string                         `json:"changed_fields"`
+	Kind          string                           `json:"kind"`
+	Name          string                           `json:"name"`
+	Namespace     string                           `json:"namespace"`
+	Unit          string                           `json:"unit"`
+}
+
+// RuntimeDeployPreviewObjectAction defines model for RuntimeDeployPreviewObject.Action.
+type RuntimeDeployPreviewObjectAction string
+
 // RuntimeDeployTask defines model for RuntimeDeployTask.
 type RuntimeDeployTask struct {
 	Attempts             int32                                  `json:"attempts"`
@@ -2444,6 +3087,7 @@ type RuntimeError struct {
 	CorrelationId *string           `json:"correlation_id"`
 	CreatedAt     time.Time         `json:"created_at"`
 	DetailsJson   string            `json:"details_json"`
+	GroupId       *string           `json:"group_id"`
 	Id            string            `json:"id"`
 	JobName       *string           `json:"job_name"`
 	Level         RuntimeErrorLevel `json:"level"`
@@ -2460,16 +3104,129 @@ type RuntimeError struct {
 // RuntimeErrorLevel defines model for RuntimeError.Level.
 type RuntimeErrorLevel string
 
+// RuntimeErrorGroup defines model for RuntimeErrorGroup.
+type RuntimeErrorGroup struct {
+	AffectedRunCount   int32                            `json:"affected_run_count"`
+	CreatedAt          time.Time                        `json:"created_at"`
+	ErrorClass         string                           `json:"error_class"`
+	EscalatedAt        *time.Time                       `json:"escalated_at"`
+	EscalatedBy        *string                          `json:"escalated_by"`
+	EscalationKind     *RuntimeErrorGroupEscalationKind `json:"escalation_kind"`
+	Fingerprint        string                           `json:"fingerprint"`
+	FirstSeenAt        time.Time                        `json:"first_seen_at"`
+	Id                 string                           `json:"id"`
+	IssueNumber        *int64                           `json:"issue_number"`
+	IssueUrl           *string                          `json:"issue_url"`
+	LastRunId          *string                          `json:"last_run_id"`
+	LastSeenAt         time.Time                        `json:"last_seen_at"`
+	Level              RuntimeErrorGroupLevel           `json:"level"`
+	MutedUntil         *time.Time                       `json:"muted_until"`
+	NormalizedMessage  string                           `json:"normalized_message"`
+	Occurrences        int64                            `json:"occurrences"`
+	ProjectId          *string                          `json:"project_id"`
+	RegressionCount    int32                            `json:"regression_count"`
+	RepositoryFullName *string                          `json:"repository_full_name"`
+	ResolvedAt         *time.Time                       `json:"resolved_at"`
+	SampleMessage      string                           `json:"sample_message"`
+	Source             string                           `json:"source"`
+	Status             RuntimeErrorGroupStatus          `json:"status"`
+	StatusChangedAt    *time.Time                       `json:"status_changed_at"`
+	StatusChangedBy    *string                          `json:"status_changed_by"`
+	UpdatedAt          time.Time                        `json:"updated_at"`
+}
+
+// RuntimeErrorGroupEscalationKind defines model for RuntimeErrorGroup.EscalationKind.
+type RuntimeErrorGroupEscalationKind string
+
+// RuntimeErrorGroupLevel defines model for RuntimeErrorGroup.Level.
+type RuntimeErrorGroupLevel string
+
+// RuntimeErrorGroupStatus defines model for RuntimeErrorGroup.Status.
+type RuntimeErrorGroupStatus string
+
+// RuntimeErrorGroupItemsResponse defines model for RuntimeErrorGroupItemsResponse.
+type RuntimeErrorGroupItemsResponse struct {
+	Items []RuntimeErrorGroup `json:"items"`
+}
+
 // RuntimeErrorItemsResponse defines model for RuntimeErrorItemsResponse.
 type RuntimeErrorItemsResponse struct {
 	Items []RuntimeError `json:"items"`
 }
 
+// SetFlowEventRetentionPinRequest defines model for SetFlowEventRetentionPinRequest.
+type SetFlowEventRetentionPinRequest struct {
+	Pinned bool `json:"pinned"`
+}
+
 // SetProjectMemberLearningModeRequest defines model for SetProjectMemberLearningModeRequest.
 type SetProjectMemberLearningModeRequest struct {
 	Enabled *bool `json:"enabled"`
 }
 
+// StaffAPIToken defines model for StaffAPIToken.
+type StaffAPIToken struct {
+	CreatedAt  time.Time  `json:"created_at"`
+	CreatedBy  *string    `json:"created_by"`
+	ExpiresAt  *time.Time `json:"expires_at"`
+	Id         string     `json:"id"`
+	LastUsedAt *time.Time `json:"last_used_at"`
+	Name       string     `json:"name"`
+
+	// ProjectIds Projects the token is restricted to; empty means no project restriction.
+	ProjectIds []string             `json:"project_ids"`
+	RevokedAt  *time.Time           `json:"revoked_at"`
+	Scopes     []StaffAPITokenScope `json:"scopes"`
+
+	// TokenPrefix Leading characters of the token to recognize it; the secret itself is never returned again.
+	TokenPrefix string `json:"token_prefix"`
+	UserEmail   string `json:"user_email"`
+	UserId      string `json:"user_id"`
+}
+
+// StaffAPITokenItemsResponse defines model for StaffAPITokenItemsResponse.
+type StaffAPITokenItemsResponse struct {
+	Items []StaffAPIToken `json:"items"`
+}
+
+// StaffAPITokenScope defines model for StaffAPITokenScope.
+type StaffAPITokenScope string
+
+// SubmitChangeGovernanceReleaseReadinessDecisionRequest defines model for SubmitChangeGovernanceReleaseReadinessDecisionRequest.
+type SubmitChangeGovernanceReleaseReadinessDecisionRequest struct {
+	ActorKind                 *SubmitChangeGovernanceReleaseReadinessDecisionRequestActorKind    `json:"actor_kind,omitempty"`
+	DecisionId                string                                                             `json:"decision_id"`
+	DecisionState             SubmitChangeGovernanceReleaseReadinessDecisionRequestDecisionState `json:"decision_state"`
+	ExpectedProjectionVersion int64                                                              `json:"expected_projection_version"`
+	SummaryMarkdown           string                                                             `json:"summary_markdown"`
+}
+
+// SubmitChangeGovernanceReleaseReadinessDecisionRequestActorKind defines model for SubmitChangeGovernanceReleaseReadinessDecisionRequest.ActorKind.
+type SubmitChangeGovernanceReleaseReadinessDecisionRequestActorKind string
+
+// SubmitChangeGovernanceReleaseReadinessDecisionRequestDecisionState defines model for SubmitChangeGovernanceReleaseReadinessDecisionRequest.DecisionState.
+type SubmitChangeGovernanceReleaseReadinessDecisionRequestDecisionState string
+
+// SubmitChangeGovernanceWaiverDecisionRequest defines model for SubmitChangeGovernanceWaiverDecisionRequest.
+type SubmitChangeGovernanceWaiverDecisionRequest struct {
+	ActorKind                 *SubmitChangeGovernanceWaiverDecisionRequestActorKind        `json:"actor_kind,omitempty"`
+	DecisionId                string                                                       `json:"decision_id"`
+	DecisionState             SubmitChangeGovernanceWaiverDecisionRequestDecisionState     `json:"decision_state"`
+	ExpectedProjectionVersion int64                                                        `json:"expected_projection_version"`
+	ExpiresAt                 *time.Time                                                   `json:"expires_at,omitempty"`
+	ReasonMarkdown            string                                                       `json:"reason_markdown"`
+	ResidualRiskTier          *SubmitChangeGovernanceWaiverDecisionRequestResidualRiskTier `json:"residual_risk_tier,omitempty"`
+}
+
+// SubmitChangeGovernanceWaiverDecisionRequestActorKind defines model for SubmitChangeGovernanceWaiverDecisionRequest.ActorKind.
+type SubmitChangeGovernanceWaiverDecisionRequestActorKind string
+
+// SubmitChangeGovernanceWaiverDecisionRequestDecisionState defines model for SubmitChangeGovernanceWaiverDecisionRequest.DecisionState.
+type SubmitChangeGovernanceWaiverDecisionRequestDecisionState string
+
+// SubmitChangeGovernanceWaiverDecisionRequestResidualRiskTier defines model for SubmitChangeGovernanceWaiverDecisionRequest.ResidualRiskTier.
+type SubmitChangeGovernanceWaiverDecisionRequestResidualRiskTier string
+
 // SyncDocsetRequest defines model for SyncDocsetRequest.
 type SyncDocsetRequest struct {
 	DocsetRef    string `json:"docset_ref"`
@@ -2507,11 +3264,34 @@ type SystemSettingItemsResponse struct {
 	Items []SystemSetting `json:"items"`
 }
 
+// UpdateRuntimeErrorGroupStatusRequest defines model for UpdateRuntimeErrorGroupStatusRequest.
+type UpdateRuntimeErrorGroupStatusRequest struct {
+	MutedUntil *time.Time                                 `json:"muted_until,omitempty"`
+	Status     UpdateRuntimeErrorGroupStatusRequestStatus `json:"status"`
+}
+
+// UpdateRuntimeErrorGroupStatusRequestStatus defines model for UpdateRuntimeErrorGroupStatusRequest.Status.
+type UpdateRuntimeErrorGroupStatusRequestStatus string
+
 // UpdateSystemSettingBooleanRequest defines model for UpdateSystemSettingBooleanRequest.
 type UpdateSystemSettingBooleanRequest struct {
 	BooleanValue bool `json:"boolean_value"`
 }
 
+// UpsertMCPApprovalPolicyRequest defines model for UpsertMCPApprovalPolicyRequest.
+type UpsertMCPApprovalPolicyRequest struct {
+	EligibleRoles       *[]string                              `json:"eligible_roles,omitempty"`
+	EligibleUserIds     *[]string                              `json:"eligible_user_ids,omitempty"`
+	Environment         *string                                `json:"environment,omitempty"`
+	ExpiresAfterSeconds *int32                                 `json:"expires_after_seconds,omitempty"`
+	RequiredApprovals   int32                                  `json:"required_approvals"`
+	SeparationOfDuties  *bool                                  `json:"separation_of_duties,omitempty"`
+	ToolName            UpsertMCPApprovalPolicyRequestToolName `json:"tool_name"`
+}
+
+// UpsertMCPApprovalPolicyRequestToolName defines model for UpsertMCPApprovalPolicyRequest.ToolName.
+type UpsertMCPApprovalPolicyRequestToolName string
+
 // UpsertProjectGitHubTokensRequest defines model for UpsertProjectGitHubTokensRequest.
 type UpsertProjectGitHubTokensRequest struct {
 	BotEmail      *string `json:"bot_email"`
@@ -2522,15 +3302,14 @@ type UpsertProjectGitHubTokensRequest struct {
 
 // UpsertProjectMemberRequest defines model for UpsertProjectMemberRequest.
 type UpsertProjectMemberRequest struct {
-	Email  *openapi_types.Email            `json:"email,omitempty"`
-	Role   *UpsertProjectMemberRequestRole `json:"role,omitempty"`
-	UserId *string                         `json:"user_id,omitempty"`
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// Role Built-in role (read, read_write, admin) or custom project role name.
+	Role   *string `json:"role,omitempty"`
+	UserId *string `json:"user_id,omitempty"`
 	union  json.RawMessage
 }
 
-// UpsertProjectMemberRequestRole defines model for UpsertProjectMemberRequest.Role.
-type UpsertProjectMemberRequestRole string
-
 // UpsertProjectMemberRequest0 defines model for .
 type UpsertProjectMemberRequest0 = interface{}
 
@@ -2559,6 +3338,12 @@ type UpsertProjectRequest struct {
 	Slug string `json:"slug"`
 }
 
+// UpsertProjectRoleRequest defines model for UpsertProjectRoleRequest.
+type UpsertProjectRoleRequest struct {
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+}
+
 // UpsertRepositoryBotParamsRequest defines model for UpsertRepositoryBotParamsRequest.
 type UpsertRepositoryBotParamsRequest struct {
 	BotEmail    *string `json:"bot_email"`
@@ -2566,14 +3351,30 @@ type UpsertRepositoryBotParamsRequest struct {
 	BotUsername *string `json:"bot_username"`
 }
 
+// UpsertRetentionPolicyRequest defines model for UpsertRetentionPolicyRequest.
+type UpsertRetentionPolicyRequest struct {
+	Action        UpsertRetentionPolicyRequestAction `json:"action"`
+	Enabled       bool                               `json:"enabled"`
+	ProjectId     *string                            `json:"project_id"`
+	RetentionDays int32                              `json:"retention_days"`
+	Target        UpsertRetentionPolicyRequestTarget `json:"target"`
+}
+
+// UpsertRetentionPolicyRequestAction defines model for UpsertRetentionPolicyRequest.Action.
+type UpsertRetentionPolicyRequestAction string
+
+// UpsertRetentionPolicyRequestTarget defines model for UpsertRetentionPolicyRequest.Target.
+type UpsertRetentionPolicyRequestTarget string
+
 // User defines model for User.
 type User struct {
-	Email           string  `json:"email"`
-	GithubLogin     *string `json:"github_login"`
-	GithubUserId    *int64  `json:"github_user_id"`
-	Id              string  `json:"id"`
-	IsPlatformAdmin bool    `json:"is_platform_admin"`
-	IsPlatformOwner bool    `json:"is_platform_owner"`
+	Email            string  `json:"email"`
+	GithubLogin      *string `json:"github_login"`
+	GithubUserId     *int64  `json:"github_user_id"`
+	Id               string  `json:"id"`
+	IsPlatformAdmin  bool    `json:"is_platform_admin"`
+	IsPlatformOwner  bool    `json:"is_platform_owner"`
+	IsServiceAccount *bool   `json:"is_service_account,omitempty"`
 }
 
 // UserItemsResponse defines model for UserItemsResponse.
@@ -2611,6 +3412,12 @@ type AgentKeyFilter = string
 // ApprovalRequestID defines model for ApprovalRequestID.
 type ApprovalRequestID = int64
 
+// ChangeGovernancePackageID defines model for ChangeGovernancePackageID.
+type ChangeGovernancePackageID = string
+
+// FlowEventID defines model for FlowEventID.
+type FlowEventID = int64
+
 // IncludeLogs defines model for IncludeLogs.
 type IncludeLogs = bool
 
@@ -2623,6 +3430,9 @@ type IncludeSnapshot = bool
 // Limit defines model for Limit.
 type Limit = int
 
+// MCPApprovalPolicyID defines model for MCPApprovalPolicyID.
+type MCPApprovalPolicyID = string
+
 // MCPCallbackToken defines model for MCPCallbackToken.
 type MCPCallbackToken = string
 
@@ -2665,6 +3475,9 @@ type MissionControlSearch = string
 // MissionControlViewMode defines model for MissionControlViewMode.
 type MissionControlViewMode string
 
+// MissionControlVoiceCandidateID defines model for MissionControlVoiceCandidateID.
+type MissionControlVoiceCandidateID = string
+
 // MissionControlWorkspaceStatePreset defines model for MissionControlWorkspaceStatePreset.
 type MissionControlWorkspaceStatePreset string
 
@@ -2680,18 +3493,30 @@ type PageSize = int
 // ProjectID defines model for ProjectID.
 type ProjectID = string
 
+// ProjectRoleName defines model for ProjectRoleName.
+type ProjectRoleName = string
+
+// RetentionPolicyID defines model for RetentionPolicyID.
+type RetentionPolicyID = string
+
 // RunID defines model for RunID.
 type RunID = string
 
 // RunStatusFilter defines model for RunStatusFilter.
 type RunStatusFilter = string
 
+// RuntimeErrorGroupID defines model for RuntimeErrorGroupID.
+type RuntimeErrorGroupID = string
+
 // RuntimeErrorID defines model for RuntimeErrorID.
 type RuntimeErrorID = string
 
 // SettingKey defines model for SettingKey.
 type SettingKey = string
 
+// StaffAPITokenID defines model for StaffAPITokenID.
+type StaffAPITokenID = string
+
 // TailLines defines model for TailLines.
 type TailLines = int
 
@@ -2731,6 +3556,13 @@ type CallbackGithubParams struct {
 	Code  string `form:"code" json:"code"`
 }
 
+// CallbackOidcParams defines parameters for CallbackOidc.
+type CallbackOidcParams struct {
+	State string  `form:"state" json:"state"`
+	Code  *string `form:"code,omitempty" json:"code,omitempty"`
+	Error *string `form:"error,omitempty" json:"error,omitempty"`
+}
+
 // McpApproverCallbackParams defines parameters for McpApproverCallback.
 type McpApproverCallbackParams struct {
 	// XCodexMCPToken Callback bearer token. Authorization: Bearer ... is also accepted.
@@ -2749,6 +3581,29 @@ type McpInteractionCallbackParams struct {
 	XCodexMCPToken *MCPCallbackToken `json:"X-Codex-MCP-Token,omitempty"`
 }
 
+// GetRunOutcomeAnalyticsParams defines parameters for GetRunOutcomeAnalytics.
+type GetRunOutcomeAnalyticsParams struct {
+	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// From Lineages whose first run was created at or after this RFC3339 timestamp. Defaults to `to` minus 30 days.
+	From *time.Time `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Exclusive RFC3339 upper bound; defaults to now. Range must not exceed 366 days.
+	To *time.Time `form:"to,omitempty" json:"to,omitempty"`
+
+	// GroupBy Grouping keys, repeated or comma-separated. Defaults to all keys.
+	GroupBy *[]GetRunOutcomeAnalyticsParamsGroupBy `form:"group_by,omitempty" json:"group_by,omitempty"`
+}
+
+// GetRunOutcomeAnalyticsParamsGroupBy defines parameters for GetRunOutcomeAnalytics.
+type GetRunOutcomeAnalyticsParamsGroupBy string
+
+// ListStaffAPITokensParams defines parameters for ListStaffAPITokens.
+type ListStaffAPITokensParams struct {
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Limit  *Limit  `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
 // ListPendingApprovalsParams defines parameters for ListPendingApprovals.
 type ListPendingApprovalsParams struct {
 	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
@@ -2786,6 +3641,16 @@ type MissionControlRealtimeParams struct {
 	ResumeToken MissionControlResumeToken `form:"resume_token" json:"resume_token"`
 }
 
+// ListMissionControlVoiceCandidatesParams defines parameters for ListMissionControlVoiceCandidates.
+type ListMissionControlVoiceCandidatesParams struct {
+	ProjectId string                                         `form:"project_id" json:"project_id"`
+	Status    *ListMissionControlVoiceCandidatesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit     *Limit                                         `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// ListMissionControlVoiceCandidatesParamsStatus defines parameters for ListMissionControlVoiceCandidates.
+type ListMissionControlVoiceCandidatesParamsStatus string
+
 // GetMissionControlWorkspaceParams defines parameters for GetMissionControlWorkspace.
 type GetMissionControlWorkspaceParams struct {
 	ViewMode    *GetMissionControlWorkspaceParamsViewMode    `form:"view_mode,omitempty" json:"view_mode,omitempty"`
@@ -2816,6 +3681,12 @@ type ListProjectRepositoriesParams struct {
 	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListRetentionPoliciesParams defines parameters for ListRetentionPolicies.
+type ListRetentionPoliciesParams struct {
+	// ProjectId Return only overrides for this project; global defaults are always included.
+	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+}
+
 // RunsRealtimeParams defines parameters for RunsRealtime.
 type RunsRealtimeParams struct {
 	Page     *Page     `form:"page,omitempty" json:"page,omitempty"`
@@ -2882,11 +3753,38 @@ type ListRuntimeErrorsParamsState string
 // ListRuntimeErrorsParamsLevel defines parameters for ListRuntimeErrors.
 type ListRuntimeErrorsParamsLevel string
 
+// ListRuntimeErrorGroupsParams defines parameters for ListRuntimeErrorGroups.
+type ListRuntimeErrorGroupsParams struct {
+	Limit     *Limit                              `form:"limit,omitempty" json:"limit,omitempty"`
+	Status    *ListRuntimeErrorGroupsParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Level     *ListRuntimeErrorGroupsParamsLevel  `form:"level,omitempty" json:"level,omitempty"`
+	Source    *string                             `form:"source,omitempty" json:"source,omitempty"`
+	ProjectId *string                             `form:"project_id,omitempty" json:"project_id,omitempty"`
+}
+
+// ListRuntimeErrorGroupsParamsStatus defines parameters for ListRuntimeErrorGroups.
+type ListRuntimeErrorGroupsParamsStatus string
+
+// ListRuntimeErrorGroupsParamsLevel defines parameters for ListRuntimeErrorGroups.
+type ListRuntimeErrorGroupsParamsLevel string
+
 // ListUsersParams defines parameters for ListUsers.
 type ListUsersParams struct {
 	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// IngestAlertmanagerWebhookJSONBody defines parameters for IngestAlertmanagerWebhook.
+type IngestAlertmanagerWebhookJSONBody map[string]interface{}
+
+// IngestAlertmanagerWebhookParams defines parameters for IngestAlertmanagerWebhook.
+type IngestAlertmanagerWebhookParams struct {
+	// ProjectId Target project. Falls back to `kodex_project_id` common alert label.
+	ProjectId *string `form:"project_id,omitempty" json:"project_id,omitempty"`
+
+	// Authorization Bearer token configured as KODEX_ALERTMANAGER_WEBHOOK_TOKEN.
+	Authorization string `json:"Authorization"`
+}
+
 // IngestGithubWebhookJSONBody defines parameters for IngestGithubWebhook.
 type IngestGithubWebhookJSONBody map[string]interface{}
 
@@ -2906,21 +3804,42 @@ type McpExecutorCallbackJSONRequestBody = MCPApprovalCallbackRequest
 // McpInteractionCallbackJSONRequestBody defines body for McpInteractionCallback for application/json ContentType.
 type McpInteractionCallbackJSONRequestBody = InteractionCallbackEnvelope
 
+// CreateStaffAPITokenJSONRequestBody defines body for CreateStaffAPIToken for application/json ContentType.
+type CreateStaffAPITokenJSONRequestBody = CreateStaffAPITokenRequest
+
 // ResolveApprovalDecisionJSONRequestBody defines body for ResolveApprovalDecision for application/json ContentType.
 type ResolveApprovalDecisionJSONRequestBody = ResolveApprovalDecisionRequest
 
+// SetFlowEventRetentionPinJSONRequestBody defines body for SetFlowEventRetentionPin for application/json ContentType.
+type SetFlowEventRetentionPinJSONRequestBody = SetFlowEventRetentionPinRequest
+
 // ExecuteNextStepActionJSONRequestBody defines body for ExecuteNextStepAction for application/json ContentType.
 type ExecuteNextStepActionJSONRequestBody = NextStepActionRequest
 
 // PreviewNextStepActionJSONRequestBody defines body for PreviewNextStepAction for application/json ContentType.
 type PreviewNextStepActionJSONRequestBody = NextStepActionRequest
 
+// ReportChangeGovernanceFeedbackJSONRequestBody defines body for ReportChangeGovernanceFeedback for application/json ContentType.
+type ReportChangeGovernanceFeedbackJSONRequestBody = ReportChangeGovernanceFeedbackRequest
+
+// SubmitChangeGovernanceReleaseReadinessDecisionJSONRequestBody defines body for SubmitChangeGovernanceReleaseReadinessDecision for application/json ContentType.
+type SubmitChangeGovernanceReleaseReadinessDecisionJSONRequestBody = SubmitChangeGovernanceReleaseReadinessDecisionRequest
+
+// SubmitChangeGovernanceWaiverDecisionJSONRequestBody defines body for SubmitChangeGovernanceWaiverDecision for application/json ContentType.
+type SubmitChangeGovernanceWaiverDecisionJSONRequestBody = SubmitChangeGovernanceWaiverDecisionRequest
+
 // SubmitMissionControlCommandJSONRequestBody defines body for SubmitMissionControlCommand for application/json ContentType.
 type SubmitMissionControlCommandJSONRequestBody = MissionControlCommandRequest
 
 // PreviewMissionControlLaunchJSONRequestBody defines body for PreviewMissionControlLaunch for application/json ContentType.
 type PreviewMissionControlLaunchJSONRequestBody = MissionControlLaunchPreviewRequest
 
+// PromoteMissionControlVoiceCandidateJSONRequestBody defines body for PromoteMissionControlVoiceCandidate for application/json ContentType.
+type PromoteMissionControlVoiceCandidateJSONRequestBody = MissionControlVoiceCandidatePromoteRequest
+
+// RejectMissionControlVoiceCandidateJSONRequestBody defines body for RejectMissionControlVoiceCandidate for application/json ContentType.
+type RejectMissionControlVoiceCandidateJSONRequestBody = MissionControlVoiceCandidateRejectRequest
+
 // UpsertProjectJSONRequestBody defines body for UpsertProject for application/json ContentType.
 type UpsertProjectJSONRequestBody = UpsertProjectRequest
 
@@ -2933,6 +3852,9 @@ type SyncDocsetJSONRequestBody = SyncDocsetRequest
 // UpsertProjectGitHubTokensJSONRequestBody defines body for UpsertProjectGitHubTokens for application/json ContentType.
 type UpsertProjectGitHubTokensJSONRequestBody = UpsertProjectGitHubTokensRequest
 
+// UpsertMCPApprovalPolicyJSONRequestBody defines body for UpsertMCPApprovalPolicy for application/json ContentType.
+type UpsertMCPApprovalPolicyJSONRequestBody = UpsertMCPApprovalPolicyRequest
+
 // UpsertProjectMemberJSONRequestBody defines body for UpsertProjectMember for application/json ContentType.
 type UpsertProjectMemberJSONRequestBody = UpsertProjectMemberRequest
 
@@ -2945,21 +3867,42 @@ type UpsertProjectRepositoryJSONRequestBody = UpsertProjectRepositoryRequest
 // UpsertRepositoryBotParamsJSONRequestBody defines body for UpsertRepositoryBotParams for application/json ContentType.
 type UpsertRepositoryBotParamsJSONRequestBody = UpsertRepositoryBotParamsRequest
 
+// UpsertProjectRoleJSONRequestBody defines body for UpsertProjectRole for application/json ContentType.
+type UpsertProjectRoleJSONRequestBody = UpsertProjectRoleRequest
+
+// UpsertRetentionPolicyJSONRequestBody defines body for UpsertRetentionPolicy for application/json ContentType.
+type UpsertRetentionPolicyJSONRequestBody = UpsertRetentionPolicyRequest
+
 // CancelRunJSONRequestBody defines body for CancelRun for application/json ContentType.
 type CancelRunJSONRequestBody = RunActionRequest
 
+// PreviewRuntimeDeployJSONRequestBody defines body for PreviewRuntimeDeploy for application/json ContentType.
+type PreviewRuntimeDeployJSONRequestBody = PreviewRuntimeDeployRequest
+
 // CancelRuntimeDeployTaskJSONRequestBody defines body for CancelRuntimeDeployTask for application/json ContentType.
 type CancelRuntimeDeployTaskJSONRequestBody = RuntimeDeployTaskActionRequest
 
 // StopRuntimeDeployTaskJSONRequestBody defines body for StopRuntimeDeployTask for application/json ContentType.
 type StopRuntimeDeployTaskJSONRequestBody = RuntimeDeployTaskActionRequest
 
-// UpdateSystemSettingBooleanJSONRequestBody defines body for UpdateSystemSettingBoolean for application/json ContentType.
+// EscalateRuntimeErrorGroupJSONRequestBody defines body for EscalateRuntimeErrorGroup for application/json ContentType.
+type EscalateRuntimeErrorGroupJSONRequestBody = EscalateRuntimeErrorGroupRequest
+
+// UpdateRuntimeErrorGroupStatusJSONRequestBody defines body for UpdateRuntimeErrorGroupStatus for application/json ContentType.
+type UpdateRuntimeErrorGroupStatusJSONRequestBody = UpdateRuntimeErrorGroupStatusRequest
+
+// CreateServiceAccountJSONRequestBody defines body for CreateServiceAccount for application/json ContentType.
+type CreateServiceAccountJSONRequestBody = CreateServiceAccountRequest
+
+// UpdateSystemSettingBooleanJSONRequestBody defines body for UpdateSystemSettingBoolean for application/json ContentType.
 type UpdateSystemSettingBooleanJSONRequestBody = UpdateSystemSettingBooleanRequest
 
 // CreateUserJSONRequestBody defines body for CreateUser for application/json ContentType.
 type CreateUserJSONRequestBody = CreateUserRequest
 
+// IngestAlertmanagerWebhookJSONRequestBody defines body for IngestAlertmanagerWebhook for application/json ContentType.
+type IngestAlertmanagerWebhookJSONRequestBody IngestAlertmanagerWebhookJSONBody
+
 // IngestGithubWebhookJSONRequestBody defines body for IngestGithubWebhook for application/json ContentType.
 type IngestGithubWebhookJSONRequestBody IngestGithubWebhookJSONBody
 
@@ -3687,6 +4630,12 @@ type ServerInterface interface {
 	// Get current authenticated staff principal
 	// (GET /api/v1/auth/me)
 	GetMe(w http.ResponseWriter, r *http.Request)
+	// Complete OIDC callback, verify ID token and set auth cookie
+	// (GET /api/v1/auth/oidc/callback)
+	CallbackOidc(w http.ResponseWriter, r *http.Request, params CallbackOidcParams)
+	// Start generic OIDC single sign-on login (authorization code + PKCE)
+	// (GET /api/v1/auth/oidc/login)
+	LoginOidc(w http.ResponseWriter, r *http.Request)
 	// Resolve one MCP approval request from external approver callback
 	// (POST /api/v1/mcp/approver/callback)
 	McpApproverCallback(w http.ResponseWriter, r *http.Request, params McpApproverCallbackParams)
@@ -3696,6 +4645,18 @@ type ServerInterface interface {
 	// Apply one built-in MCP user interaction callback
 	// (POST /api/v1/mcp/interactions/callback)
 	McpInteractionCallback(w http.ResponseWriter, r *http.Request, params McpInteractionCallbackParams)
+	// Aggregate run outcomes (merge rate, revise rounds, time-to-merge) per issue/PR lineage
+	// (GET /api/v1/staff/analytics/run-outcomes)
+	GetRunOutcomeAnalytics(w http.ResponseWriter, r *http.Request, params GetRunOutcomeAnalyticsParams)
+	// List staff API tokens
+	// (GET /api/v1/staff/api-tokens)
+	ListStaffAPITokens(w http.ResponseWriter, r *http.Request, params ListStaffAPITokensParams)
+	// Issue staff API token
+	// (POST /api/v1/staff/api-tokens)
+	CreateStaffAPIToken(w http.ResponseWriter, r *http.Request)
+	// Revoke staff API token
+	// (DELETE /api/v1/staff/api-tokens/{token_id})
+	RevokeStaffAPIToken(w http.ResponseWriter, r *http.Request, tokenId StaffAPITokenID)
 	// List pending approval requests
 	// (GET /api/v1/staff/approvals)
 	ListPendingApprovals(w http.ResponseWriter, r *http.Request, params ListPendingApprovalsParams)
@@ -3705,12 +4666,27 @@ type ServerInterface interface {
 	// List docset groups (manifest v1)
 	// (GET /api/v1/staff/docset/groups)
 	ListDocsetGroups(w http.ResponseWriter, r *http.Request, params ListDocsetGroupsParams)
+	// Pin or unpin flow event from retention sweeps
+	// (PUT /api/v1/staff/flow-events/{event_id}/retention-pin)
+	SetFlowEventRetentionPin(w http.ResponseWriter, r *http.Request, eventId FlowEventID)
 	// Execute one GitHub next-step action
 	// (POST /api/v1/staff/github/next-step-actions/execute)
 	ExecuteNextStepAction(w http.ResponseWriter, r *http.Request)
 	// Preview one GitHub next-step action
 	// (POST /api/v1/staff/github/next-step-actions/preview)
 	PreviewNextStepAction(w http.ResponseWriter, r *http.Request)
+	// Get change-governance package with decisions and feedback
+	// (GET /api/v1/staff/governance/change-packages/{package_id})
+	GetChangeGovernancePackage(w http.ResponseWriter, r *http.Request, packageId ChangeGovernancePackageID)
+	// Open a change-governance feedback gap
+	// (POST /api/v1/staff/governance/change-packages/{package_id}/feedback)
+	ReportChangeGovernanceFeedback(w http.ResponseWriter, r *http.Request, packageId ChangeGovernancePackageID)
+	// Record a change-governance release readiness decision
+	// (POST /api/v1/staff/governance/change-packages/{package_id}/release-readiness)
+	SubmitChangeGovernanceReleaseReadinessDecision(w http.ResponseWriter, r *http.Request, packageId ChangeGovernancePackageID)
+	// Approve or reject a change-governance waiver
+	// (POST /api/v1/staff/governance/change-packages/{package_id}/waivers)
+	SubmitChangeGovernanceWaiverDecision(w http.ResponseWriter, r *http.Request, packageId ChangeGovernancePackageID)
 	// Submit Mission Control command
 	// (POST /api/v1/staff/mission-control/commands)
 	SubmitMissionControlCommand(w http.ResponseWriter, r *http.Request, params SubmitMissionControlCommandParams)
@@ -3729,6 +4705,15 @@ type ServerInterface interface {
 	// Open Mission Control realtime stream (WebSocket upgrade)
 	// (GET /api/v1/staff/mission-control/realtime)
 	MissionControlRealtime(w http.ResponseWriter, r *http.Request, params MissionControlRealtimeParams)
+	// List Mission Control voice candidates
+	// (GET /api/v1/staff/mission-control/voice-candidates)
+	ListMissionControlVoiceCandidates(w http.ResponseWriter, r *http.Request, params ListMissionControlVoiceCandidatesParams)
+	// Confirm Mission Control voice candidate and submit its command
+	// (POST /api/v1/staff/mission-control/voice-candidates/{candidate_id}/promote)
+	PromoteMissionControlVoiceCandidate(w http.ResponseWriter, r *http.Request, candidateId MissionControlVoiceCandidateID)
+	// Reject Mission Control voice candidate
+	// (POST /api/v1/staff/mission-control/voice-candidates/{candidate_id}/reject)
+	RejectMissionControlVoiceCandidate(w http.ResponseWriter, r *http.Request, candidateId MissionControlVoiceCandidateID)
 	// Get Mission Control workspace snapshot
 	// (GET /api/v1/staff/mission-control/workspace)
 	GetMissionControlWorkspace(w http.ResponseWriter, r *http.Request, params GetMissionControlWorkspaceParams)
@@ -3756,6 +4741,15 @@ type ServerInterface interface {
 	// Upsert project-scoped GitHub tokens
 	// (PUT /api/v1/staff/projects/{project_id}/github-tokens)
 	UpsertProjectGitHubTokens(w http.ResponseWriter, r *http.Request, projectId ProjectID)
+	// List MCP approval policies of project
+	// (GET /api/v1/staff/projects/{project_id}/mcp-approval-policies)
+	ListMCPApprovalPolicies(w http.ResponseWriter, r *http.Request, projectId ProjectID)
+	// Create or update MCP approval policy for tool and environment
+	// (PUT /api/v1/staff/projects/{project_id}/mcp-approval-policies)
+	UpsertMCPApprovalPolicy(w http.ResponseWriter, r *http.Request, projectId ProjectID)
+	// Delete MCP approval policy
+	// (DELETE /api/v1/staff/projects/{project_id}/mcp-approval-policies/{policy_id})
+	DeleteMCPApprovalPolicy(w http.ResponseWriter, r *http.Request, projectId ProjectID, policyId MCPApprovalPolicyID)
 	// List project members
 	// (GET /api/v1/staff/projects/{project_id}/members)
 	ListProjectMembers(w http.ResponseWriter, r *http.Request, projectId ProjectID, params ListProjectMembersParams)
@@ -3783,6 +4777,24 @@ type ServerInterface interface {
 	// Run repository onboarding preflight
 	// (POST /api/v1/staff/projects/{project_id}/repositories/{repository_id}/preflight)
 	RunRepositoryPreflight(w http.ResponseWriter, r *http.Request, projectId ProjectID, repositoryId string)
+	// List project roles and permission catalog
+	// (GET /api/v1/staff/projects/{project_id}/roles)
+	ListProjectRoles(w http.ResponseWriter, r *http.Request, projectId ProjectID)
+	// Delete custom project role
+	// (DELETE /api/v1/staff/projects/{project_id}/roles/{role_name})
+	DeleteProjectRole(w http.ResponseWriter, r *http.Request, projectId ProjectID, roleName ProjectRoleName)
+	// Create or update custom project role
+	// (PUT /api/v1/staff/projects/{project_id}/roles/{role_name})
+	UpsertProjectRole(w http.ResponseWriter, r *http.Request, projectId ProjectID, roleName ProjectRoleName)
+	// List data retention policies
+	// (GET /api/v1/staff/retention-policies)
+	ListRetentionPolicies(w http.ResponseWriter, r *http.Request, params ListRetentionPoliciesParams)
+	// Create or update data retention policy for target and scope
+	// (PUT /api/v1/staff/retention-policies)
+	UpsertRetentionPolicy(w http.ResponseWriter, r *http.Request)
+	// Delete project-scoped retention policy override
+	// (DELETE /api/v1/staff/retention-policies/{policy_id})
+	DeleteRetentionPolicy(w http.ResponseWriter, r *http.Request, policyId RetentionPolicyID)
 	// Open realtime runs list stream (WebSocket upgrade)
 	// (GET /api/v1/staff/runs/realtime)
 	RunsRealtime(w http.ResponseWriter, r *http.Request, params RunsRealtimeParams)
@@ -3810,6 +4822,9 @@ type ServerInterface interface {
 	// Open realtime run stream (WebSocket upgrade)
 	// (GET /api/v1/staff/runs/{run_id}/realtime)
 	RunRealtime(w http.ResponseWriter, r *http.Request, runId RunID, params RunRealtimeParams)
+	// Preview runtime deploy changes via server-side dry-run
+	// (POST /api/v1/staff/runtime-deploy/previews)
+	PreviewRuntimeDeploy(w http.ResponseWriter, r *http.Request)
 	// Open realtime runtime deploy tasks list stream (WebSocket upgrade)
 	// (GET /api/v1/staff/runtime-deploy/tasks/realtime)
 	RuntimeDeployTasksRealtime(w http.ResponseWriter, r *http.Request, params RuntimeDeployTasksRealtimeParams)
@@ -3825,9 +4840,21 @@ type ServerInterface interface {
 	// List runtime error journal entries
 	// (GET /api/v1/staff/runtime-errors)
 	ListRuntimeErrors(w http.ResponseWriter, r *http.Request, params ListRuntimeErrorsParams)
+	// List runtime error groups aggregated by normalized fingerprint
+	// (GET /api/v1/staff/runtime-errors/groups)
+	ListRuntimeErrorGroups(w http.ResponseWriter, r *http.Request, params ListRuntimeErrorGroupsParams)
+	// Escalate runtime error group to GitHub issue or ai-repair run
+	// (POST /api/v1/staff/runtime-errors/groups/{group_id}/escalate)
+	EscalateRuntimeErrorGroup(w http.ResponseWriter, r *http.Request, groupId RuntimeErrorGroupID)
+	// Mute, resolve or reopen runtime error group
+	// (POST /api/v1/staff/runtime-errors/groups/{group_id}/status)
+	UpdateRuntimeErrorGroupStatus(w http.ResponseWriter, r *http.Request, groupId RuntimeErrorGroupID)
 	// Mark runtime error as viewed
 	// (POST /api/v1/staff/runtime-errors/{runtime_error_id}/viewed)
 	MarkRuntimeErrorViewed(w http.ResponseWriter, r *http.Request, runtimeErrorId RuntimeErrorID)
+	// Create service account
+	// (POST /api/v1/staff/service-accounts)
+	CreateServiceAccount(w http.ResponseWriter, r *http.Request)
 	// List platform system settings
 	// (GET /api/v1/staff/system-settings)
 	ListSystemSettings(w http.ResponseWriter, r *http.Request)
@@ -3852,6 +4879,9 @@ type ServerInterface interface {
 	// Delete user
 	// (DELETE /api/v1/staff/users/{user_id})
 	DeleteUser(w http.ResponseWriter, r *http.Request, userId UserID)
+	// Ingest Alertmanager webhook notification
+	// (POST /api/v1/webhooks/alertmanager)
+	IngestAlertmanagerWebhook(w http.ResponseWriter, r *http.Request, params IngestAlertmanagerWebhookParams)
 	// Ingest GitHub webhook delivery
 	// (POST /api/v1/webhooks/github)
 	IngestGithubWebhook(w http.ResponseWriter, r *http.Request, params IngestGithubWebhookParams)
@@ -3957,6 +4987,70 @@ func (siw *ServerInterfaceWrapper) GetMe(w http.ResponseWriter, r *http.Request)
 	handler.ServeHTTP(w, r)
 }
 
+// CallbackOidc operation middleware
+func (siw *ServerInterfaceWrapper) CallbackOidc(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CallbackOidcParams
+
+	// ------------- Required query parameter "state" -------------
+
+	if paramValue := r.URL.Query().Get("state"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "state"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "code" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code", r.URL.Query(), &params.Code)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "code", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "error" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "error", r.URL.Query(), &params.Error)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "error", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CallbackOidc(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// LoginOidc operation middleware
+func (siw *ServerInterfaceWrapper) LoginOidc(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.LoginOidc(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // McpApproverCallback operation middleware
 func (siw *ServerInterfaceWrapper) McpApproverCallback(w http.ResponseWriter, r *http.Request) {
 
@@ -4077,6 +5171,131 @@ func (siw *ServerInterfaceWrapper) McpInteractionCallback(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
+// GetRunOutcomeAnalytics operation middleware
+func (siw *ServerInterfaceWrapper) GetRunOutcomeAnalytics(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRunOutcomeAnalyticsParams
+
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", r.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "from", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", r.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "to", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "group_by" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "group_by", r.URL.Query(), &params.GroupBy)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_by", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRunOutcomeAnalytics(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListStaffAPITokens operation middleware
+func (siw *ServerInterfaceWrapper) ListStaffAPITokens(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListStaffAPITokensParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", r.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "user_id", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListStaffAPITokens(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateStaffAPIToken operation middleware
+func (siw *ServerInterfaceWrapper) CreateStaffAPIToken(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateStaffAPIToken(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevokeStaffAPIToken operation middleware
+func (siw *ServerInterfaceWrapper) RevokeStaffAPIToken(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "token_id" -------------
+	var tokenId StaffAPITokenID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "token_id", r.PathValue("token_id"), &tokenId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "token_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevokeStaffAPIToken(w, r, tokenId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListPendingApprovals operation middleware
 func (siw *ServerInterfaceWrapper) ListPendingApprovals(w http.ResponseWriter, r *http.Request) {
 
@@ -4164,6 +5383,31 @@ func (siw *ServerInterfaceWrapper) ListDocsetGroups(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
+// SetFlowEventRetentionPin operation middleware
+func (siw *ServerInterfaceWrapper) SetFlowEventRetentionPin(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "event_id" -------------
+	var eventId FlowEventID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "event_id", r.PathValue("event_id"), &eventId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "event_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetFlowEventRetentionPin(w, r, eventId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ExecuteNextStepAction operation middleware
 func (siw *ServerInterfaceWrapper) ExecuteNextStepAction(w http.ResponseWriter, r *http.Request) {
 
@@ -4192,6 +5436,106 @@ func (siw *ServerInterfaceWrapper) PreviewNextStepAction(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
+// GetChangeGovernancePackage operation middleware
+func (siw *ServerInterfaceWrapper) GetChangeGovernancePackage(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "package_id" -------------
+	var packageId ChangeGovernancePackageID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "package_id", r.PathValue("package_id"), &packageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetChangeGovernancePackage(w, r, packageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReportChangeGovernanceFeedback operation middleware
+func (siw *ServerInterfaceWrapper) ReportChangeGovernanceFeedback(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "package_id" -------------
+	var packageId ChangeGovernancePackageID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "package_id", r.PathValue("package_id"), &packageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReportChangeGovernanceFeedback(w, r, packageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitChangeGovernanceReleaseReadinessDecision operation middleware
+func (siw *ServerInterfaceWrapper) SubmitChangeGovernanceReleaseReadinessDecision(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "package_id" -------------
+	var packageId ChangeGovernancePackageID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "package_id", r.PathValue("package_id"), &packageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitChangeGovernanceReleaseReadinessDecision(w, r, packageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SubmitChangeGovernanceWaiverDecision operation middleware
+func (siw *ServerInterfaceWrapper) SubmitChangeGovernanceWaiverDecision(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "package_id" -------------
+	var packageId ChangeGovernancePackageID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "package_id", r.PathValue("package_id"), &packageId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "package_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SubmitChangeGovernanceWaiverDecision(w, r, packageId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // SubmitMissionControlCommand operation middleware
 func (siw *ServerInterfaceWrapper) SubmitMissionControlCommand(w http.ResponseWriter, r *http.Request) {
 
@@ -4415,31 +5759,131 @@ func (siw *ServerInterfaceWrapper) MissionControlRealtime(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// GetMissionControlWorkspace operation middleware
-func (siw *ServerInterfaceWrapper) GetMissionControlWorkspace(w http.ResponseWriter, r *http.Request) {
+// ListMissionControlVoiceCandidates operation middleware
+func (siw *ServerInterfaceWrapper) ListMissionControlVoiceCandidates(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetMissionControlWorkspaceParams
+	var params ListMissionControlVoiceCandidatesParams
 
-	// ------------- Optional query parameter "view_mode" -------------
+	// ------------- Required query parameter "project_id" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "view_mode", r.URL.Query(), &params.ViewMode)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "view_mode", Err: err})
+	if paramValue := r.URL.Query().Get("project_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "project_id"})
 		return
 	}
 
-	// ------------- Optional query parameter "state_preset" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "state_preset", r.URL.Query(), &params.StatePreset)
+	err = runtime.BindQueryParameter("form", true, true, "project_id", r.URL.Query(), &params.ProjectId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state_preset", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "search" -------------
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMissionControlVoiceCandidates(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PromoteMissionControlVoiceCandidate operation middleware
+func (siw *ServerInterfaceWrapper) PromoteMissionControlVoiceCandidate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "candidate_id" -------------
+	var candidateId MissionControlVoiceCandidateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "candidate_id", r.PathValue("candidate_id"), &candidateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "candidate_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PromoteMissionControlVoiceCandidate(w, r, candidateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RejectMissionControlVoiceCandidate operation middleware
+func (siw *ServerInterfaceWrapper) RejectMissionControlVoiceCandidate(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "candidate_id" -------------
+	var candidateId MissionControlVoiceCandidateID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "candidate_id", r.PathValue("candidate_id"), &candidateId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "candidate_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RejectMissionControlVoiceCandidate(w, r, candidateId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMissionControlWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) GetMissionControlWorkspace(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMissionControlWorkspaceParams
+
+	// ------------- Optional query parameter "view_mode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "view_mode", r.URL.Query(), &params.ViewMode)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "view_mode", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "state_preset" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state_preset", r.URL.Query(), &params.StatePreset)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "state_preset", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
 
 	err = runtime.BindQueryParameter("form", true, false, "search", r.URL.Query(), &params.Search)
 	if err != nil {
@@ -4665,6 +6109,90 @@ func (siw *ServerInterfaceWrapper) UpsertProjectGitHubTokens(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
+// ListMCPApprovalPolicies operation middleware
+func (siw *ServerInterfaceWrapper) ListMCPApprovalPolicies(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "project_id" -------------
+	var projectId ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", r.PathValue("project_id"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMCPApprovalPolicies(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpsertMCPApprovalPolicy operation middleware
+func (siw *ServerInterfaceWrapper) UpsertMCPApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "project_id" -------------
+	var projectId ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", r.PathValue("project_id"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpsertMCPApprovalPolicy(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMCPApprovalPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMCPApprovalPolicy(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "project_id" -------------
+	var projectId ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", r.PathValue("project_id"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "policy_id" -------------
+	var policyId MCPApprovalPolicyID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policy_id", r.PathValue("policy_id"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policy_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMCPApprovalPolicy(w, r, projectId, policyId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListProjectMembers operation middleware
 func (siw *ServerInterfaceWrapper) ListProjectMembers(w http.ResponseWriter, r *http.Request) {
 
@@ -4957,32 +6485,22 @@ func (siw *ServerInterfaceWrapper) RunRepositoryPreflight(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
-// RunsRealtime operation middleware
-func (siw *ServerInterfaceWrapper) RunsRealtime(w http.ResponseWriter, r *http.Request) {
+// ListProjectRoles operation middleware
+func (siw *ServerInterfaceWrapper) ListProjectRoles(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params RunsRealtimeParams
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
-		return
-	}
-
-	// ------------- Optional query parameter "page_size" -------------
+	// ------------- Path parameter "project_id" -------------
+	var projectId ProjectID
 
-	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", r.PathValue("project_id"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RunsRealtime(w, r, params)
+		siw.Handler.ListProjectRoles(w, r, projectId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -4992,56 +6510,65 @@ func (siw *ServerInterfaceWrapper) RunsRealtime(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// ListRunWaits operation middleware
-func (siw *ServerInterfaceWrapper) ListRunWaits(w http.ResponseWriter, r *http.Request) {
+// DeleteProjectRole operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProjectRole(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListRunWaitsParams
-
-	// ------------- Optional query parameter "limit" -------------
+	// ------------- Path parameter "project_id" -------------
+	var projectId ProjectID
 
-	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", r.PathValue("project_id"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "trigger_kind" -------------
+	// ------------- Path parameter "role_name" -------------
+	var roleName ProjectRoleName
 
-	err = runtime.BindQueryParameter("form", true, false, "trigger_kind", r.URL.Query(), &params.TriggerKind)
+	err = runtime.BindStyledParameterWithOptions("simple", "role_name", r.PathValue("role_name"), &roleName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trigger_kind", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_name", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProjectRole(w, r, projectId, roleName)
+	}))
 
-	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
 	}
 
-	// ------------- Optional query parameter "agent_key" -------------
+	handler.ServeHTTP(w, r)
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "agent_key", r.URL.Query(), &params.AgentKey)
+// UpsertProjectRole operation middleware
+func (siw *ServerInterfaceWrapper) UpsertProjectRole(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "project_id" -------------
+	var projectId ProjectID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "project_id", r.PathValue("project_id"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "agent_key", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
 		return
 	}
 
-	// ------------- Optional query parameter "wait_state" -------------
+	// ------------- Path parameter "role_name" -------------
+	var roleName ProjectRoleName
 
-	err = runtime.BindQueryParameter("form", true, false, "wait_state", r.URL.Query(), &params.WaitState)
+	err = runtime.BindStyledParameterWithOptions("simple", "role_name", r.PathValue("role_name"), &roleName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait_state", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "role_name", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListRunWaits(w, r, params)
+		siw.Handler.UpsertProjectRole(w, r, projectId, roleName)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5051,22 +6578,24 @@ func (siw *ServerInterfaceWrapper) ListRunWaits(w http.ResponseWriter, r *http.R
 	handler.ServeHTTP(w, r)
 }
 
-// GetRun operation middleware
-func (siw *ServerInterfaceWrapper) GetRun(w http.ResponseWriter, r *http.Request) {
+// ListRetentionPolicies operation middleware
+func (siw *ServerInterfaceWrapper) ListRetentionPolicies(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "run_id" -------------
-	var runId RunID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRetentionPoliciesParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "run_id", r.PathValue("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetRun(w, r, runId)
+		siw.Handler.ListRetentionPolicies(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5076,22 +6605,36 @@ func (siw *ServerInterfaceWrapper) GetRun(w http.ResponseWriter, r *http.Request
 	handler.ServeHTTP(w, r)
 }
 
-// CancelRun operation middleware
-func (siw *ServerInterfaceWrapper) CancelRun(w http.ResponseWriter, r *http.Request) {
+// UpsertRetentionPolicy operation middleware
+func (siw *ServerInterfaceWrapper) UpsertRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpsertRetentionPolicy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteRetentionPolicy operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRetentionPolicy(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "run_id" -------------
-	var runId RunID
+	// ------------- Path parameter "policy_id" -------------
+	var policyId RetentionPolicyID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "run_id", r.PathValue("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "policy_id", r.PathValue("policy_id"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "policy_id", Err: err})
 		return
 	}
 
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CancelRun(w, r, runId)
+		siw.Handler.DeleteRetentionPolicy(w, r, policyId)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -5101,13 +6644,157 @@ func (siw *ServerInterfaceWrapper) CancelRun(w http.ResponseWriter, r *http.Requ
 	handler.ServeHTTP(w, r)
 }
 
-// ListRunEvents operation middleware
-func (siw *ServerInterfaceWrapper) ListRunEvents(w http.ResponseWriter, r *http.Request) {
+// RunsRealtime operation middleware
+func (siw *ServerInterfaceWrapper) RunsRealtime(w http.ResponseWriter, r *http.Request) {
 
 	var err error
 
-	// ------------- Path parameter "run_id" -------------
-	var runId RunID
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RunsRealtimeParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page", r.URL.Query(), &params.Page)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "page_size" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "page_size", r.URL.Query(), &params.PageSize)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "page_size", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RunsRealtime(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRunWaits operation middleware
+func (siw *ServerInterfaceWrapper) ListRunWaits(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRunWaitsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "trigger_kind" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "trigger_kind", r.URL.Query(), &params.TriggerKind)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "trigger_kind", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "agent_key" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "agent_key", r.URL.Query(), &params.AgentKey)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "agent_key", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "wait_state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "wait_state", r.URL.Query(), &params.WaitState)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "wait_state", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRunWaits(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetRun operation middleware
+func (siw *ServerInterfaceWrapper) GetRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "run_id" -------------
+	var runId RunID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "run_id", r.PathValue("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRun(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CancelRun operation middleware
+func (siw *ServerInterfaceWrapper) CancelRun(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "run_id" -------------
+	var runId RunID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "run_id", r.PathValue("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "run_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CancelRun(w, r, runId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListRunEvents operation middleware
+func (siw *ServerInterfaceWrapper) ListRunEvents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "run_id" -------------
+	var runId RunID
 
 	err = runtime.BindStyledParameterWithOptions("simple", "run_id", r.PathValue("run_id"), &runId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
 	if err != nil {
@@ -5302,6 +6989,20 @@ func (siw *ServerInterfaceWrapper) RunRealtime(w http.ResponseWriter, r *http.Re
 	handler.ServeHTTP(w, r)
 }
 
+// PreviewRuntimeDeploy operation middleware
+func (siw *ServerInterfaceWrapper) PreviewRuntimeDeploy(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewRuntimeDeploy(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // RuntimeDeployTasksRealtime operation middleware
 func (siw *ServerInterfaceWrapper) RuntimeDeployTasksRealtime(w http.ResponseWriter, r *http.Request) {
 
@@ -5495,6 +7196,115 @@ func (siw *ServerInterfaceWrapper) ListRuntimeErrors(w http.ResponseWriter, r *h
 	handler.ServeHTTP(w, r)
 }
 
+// ListRuntimeErrorGroups operation middleware
+func (siw *ServerInterfaceWrapper) ListRuntimeErrorGroups(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListRuntimeErrorGroupsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "limit", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", r.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "status", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "level" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "level", r.URL.Query(), &params.Level)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "level", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "source" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "source", r.URL.Query(), &params.Source)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "source", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRuntimeErrorGroups(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EscalateRuntimeErrorGroup operation middleware
+func (siw *ServerInterfaceWrapper) EscalateRuntimeErrorGroup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId RuntimeErrorGroupID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", r.PathValue("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EscalateRuntimeErrorGroup(w, r, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateRuntimeErrorGroupStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateRuntimeErrorGroupStatus(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId RuntimeErrorGroupID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", r.PathValue("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "group_id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateRuntimeErrorGroupStatus(w, r, groupId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // MarkRuntimeErrorViewed operation middleware
 func (siw *ServerInterfaceWrapper) MarkRuntimeErrorViewed(w http.ResponseWriter, r *http.Request) {
 
@@ -5520,6 +7330,20 @@ func (siw *ServerInterfaceWrapper) MarkRuntimeErrorViewed(w http.ResponseWriter,
 	handler.ServeHTTP(w, r)
 }
 
+// CreateServiceAccount operation middleware
+func (siw *ServerInterfaceWrapper) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateServiceAccount(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // ListSystemSettings operation middleware
 func (siw *ServerInterfaceWrapper) ListSystemSettings(w http.ResponseWriter, r *http.Request) {
 
@@ -5689,7 +7513,59 @@ func (siw *ServerInterfaceWrapper) DeleteUser(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// IngestGithubWebhook operation middleware
+// IngestAlertmanagerWebhook operation middleware
+func (siw *ServerInterfaceWrapper) IngestAlertmanagerWebhook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params IngestAlertmanagerWebhookParams
+
+	// ------------- Optional query parameter "project_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "project_id", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "project_id", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Required header parameter "Authorization" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Authorization")]; found {
+		var Authorization string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "Authorization", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Authorization", valueList[0], &Authorization, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "Authorization", Err: err})
+			return
+		}
+
+		params.Authorization = Authorization
+
+	} else {
+		err := fmt.Errorf("Header parameter Authorization is required, but not found")
+		siw.ErrorHandlerFunc(w, r, &RequiredHeaderError{ParamName: "Authorization", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.IngestAlertmanagerWebhook(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// IngestGithubWebhook operation middleware
 func (siw *ServerInterfaceWrapper) IngestGithubWebhook(w http.ResponseWriter, r *http.Request) {
 
 	var err error
@@ -5903,20 +7779,34 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/auth/github/login", wrapper.LoginGithub)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/auth/logout", wrapper.Logout)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/auth/me", wrapper.GetMe)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/auth/oidc/callback", wrapper.CallbackOidc)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/auth/oidc/login", wrapper.LoginOidc)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/mcp/approver/callback", wrapper.McpApproverCallback)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/mcp/executor/callback", wrapper.McpExecutorCallback)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/mcp/interactions/callback", wrapper.McpInteractionCallback)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/analytics/run-outcomes", wrapper.GetRunOutcomeAnalytics)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/api-tokens", wrapper.ListStaffAPITokens)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/api-tokens", wrapper.CreateStaffAPIToken)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/staff/api-tokens/{token_id}", wrapper.RevokeStaffAPIToken)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/approvals", wrapper.ListPendingApprovals)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/approvals/{approval_request_id}/decision", wrapper.ResolveApprovalDecision)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/docset/groups", wrapper.ListDocsetGroups)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1/staff/flow-events/{event_id}/retention-pin", wrapper.SetFlowEventRetentionPin)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/github/next-step-actions/execute", wrapper.ExecuteNextStepAction)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/github/next-step-actions/preview", wrapper.PreviewNextStepAction)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/governance/change-packages/{package_id}", wrapper.GetChangeGovernancePackage)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/governance/change-packages/{package_id}/feedback", wrapper.ReportChangeGovernanceFeedback)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/governance/change-packages/{package_id}/release-readiness", wrapper.SubmitChangeGovernanceReleaseReadinessDecision)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/governance/change-packages/{package_id}/waivers", wrapper.SubmitChangeGovernanceWaiverDecision)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/mission-control/commands", wrapper.SubmitMissionControlCommand)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/mission-control/commands/{command_id}", wrapper.GetMissionControlCommand)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/mission-control/launch-preview", wrapper.PreviewMissionControlLaunch)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/mission-control/nodes/{node_kind}/{node_public_id}", wrapper.GetMissionControlNode)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/mission-control/nodes/{node_kind}/{node_public_id}/activity", wrapper.ListMissionControlNodeActivity)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/mission-control/realtime", wrapper.MissionControlRealtime)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/mission-control/voice-candidates", wrapper.ListMissionControlVoiceCandidates)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/mission-control/voice-candidates/{candidate_id}/promote", wrapper.PromoteMissionControlVoiceCandidate)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/mission-control/voice-candidates/{candidate_id}/reject", wrapper.RejectMissionControlVoiceCandidate)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/mission-control/workspace", wrapper.GetMissionControlWorkspace)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/projects", wrapper.ListProjects)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/projects", wrapper.UpsertProject)
@@ -5926,6 +7816,9 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/projects/{project_id}/docset/sync", wrapper.SyncDocset)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/projects/{project_id}/github-tokens", wrapper.GetProjectGitHubTokens)
 	m.HandleFunc("PUT "+options.BaseURL+"/api/v1/staff/projects/{project_id}/github-tokens", wrapper.UpsertProjectGitHubTokens)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/projects/{project_id}/mcp-approval-policies", wrapper.ListMCPApprovalPolicies)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1/staff/projects/{project_id}/mcp-approval-policies", wrapper.UpsertMCPApprovalPolicy)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/staff/projects/{project_id}/mcp-approval-policies/{policy_id}", wrapper.DeleteMCPApprovalPolicy)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/projects/{project_id}/members", wrapper.ListProjectMembers)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/projects/{project_id}/members", wrapper.UpsertProjectMember)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/staff/projects/{project_id}/members/{user_id}", wrapper.DeleteProjectMember)
@@ -5935,6 +7828,12 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/staff/projects/{project_id}/repositories/{repository_id}", wrapper.DeleteProjectRepository)
 	m.HandleFunc("PUT "+options.BaseURL+"/api/v1/staff/projects/{project_id}/repositories/{repository_id}/bot-params", wrapper.UpsertRepositoryBotParams)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/projects/{project_id}/repositories/{repository_id}/preflight", wrapper.RunRepositoryPreflight)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/projects/{project_id}/roles", wrapper.ListProjectRoles)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/staff/projects/{project_id}/roles/{role_name}", wrapper.DeleteProjectRole)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1/staff/projects/{project_id}/roles/{role_name}", wrapper.UpsertProjectRole)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/retention-policies", wrapper.ListRetentionPolicies)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/v1/staff/retention-policies", wrapper.UpsertRetentionPolicy)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/staff/retention-policies/{policy_id}", wrapper.DeleteRetentionPolicy)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/runs/realtime", wrapper.RunsRealtime)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/runs/waits", wrapper.ListRunWaits)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/runs/{run_id}", wrapper.GetRun)
@@ -5944,12 +7843,17 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/runs/{run_id}/logs", wrapper.GetRunLogs)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/staff/runs/{run_id}/namespace", wrapper.DeleteRunNamespace)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/runs/{run_id}/realtime", wrapper.RunRealtime)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/runtime-deploy/previews", wrapper.PreviewRuntimeDeploy)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/runtime-deploy/tasks/realtime", wrapper.RuntimeDeployTasksRealtime)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/runtime-deploy/tasks/{run_id}", wrapper.GetRuntimeDeployTask)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/runtime-deploy/tasks/{run_id}/cancel", wrapper.CancelRuntimeDeployTask)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/runtime-deploy/tasks/{run_id}/stop", wrapper.StopRuntimeDeployTask)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/runtime-errors", wrapper.ListRuntimeErrors)
+	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/runtime-errors/groups", wrapper.ListRuntimeErrorGroups)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/runtime-errors/groups/{group_id}/escalate", wrapper.EscalateRuntimeErrorGroup)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/runtime-errors/groups/{group_id}/status", wrapper.UpdateRuntimeErrorGroupStatus)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/runtime-errors/{runtime_error_id}/viewed", wrapper.MarkRuntimeErrorViewed)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/service-accounts", wrapper.CreateServiceAccount)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/system-settings", wrapper.ListSystemSettings)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/system-settings/realtime", wrapper.SystemSettingsRealtime)
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/system-settings/{setting_key}", wrapper.GetSystemSetting)
@@ -5958,6 +7862,7 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("GET "+options.BaseURL+"/api/v1/staff/users", wrapper.ListUsers)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/staff/users", wrapper.CreateUser)
 	m.HandleFunc("DELETE "+options.BaseURL+"/api/v1/staff/users/{user_id}", wrapper.DeleteUser)
+	m.HandleFunc("POST "+options.BaseURL+"/api/v1/webhooks/alertmanager", wrapper.IngestAlertmanagerWebhook)
 	m.HandleFunc("POST "+options.BaseURL+"/api/v1/webhooks/github", wrapper.IngestGithubWebhook)
 
 	return m
@@ -6093,6 +7998,100 @@ func (response GetMe401JSONResponse) VisitGetMeResponse(w http.ResponseWriter) e
 	return json.NewEncoder(w).Encode(response)
 }
 
+type CallbackOidcRequestObject struct {
+	Params CallbackOidcParams
+}
+
+type CallbackOidcResponseObject interface {
+	VisitCallbackOidcResponse(w http.ResponseWriter) error
+}
+
+type CallbackOidc302Response struct {
+}
+
+func (response CallbackOidc302Response) VisitCallbackOidcResponse(w http.ResponseWriter) error {
+	w.WriteHeader(302)
+	return nil
+}
+
+type CallbackOidc400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CallbackOidc400JSONResponse) VisitCallbackOidcResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CallbackOidc401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CallbackOidc401JSONResponse) VisitCallbackOidcResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CallbackOidc403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CallbackOidc403JSONResponse) VisitCallbackOidcResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CallbackOidc404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CallbackOidc404JSONResponse) VisitCallbackOidcResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CallbackOidc500JSONResponse struct{ InternalJSONResponse }
+
+func (response CallbackOidc500JSONResponse) VisitCallbackOidcResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginOidcRequestObject struct {
+}
+
+type LoginOidcResponseObject interface {
+	VisitLoginOidcResponse(w http.ResponseWriter) error
+}
+
+type LoginOidc302Response struct {
+}
+
+func (response LoginOidc302Response) VisitLoginOidcResponse(w http.ResponseWriter) error {
+	w.WriteHeader(302)
+	return nil
+}
+
+type LoginOidc404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response LoginOidc404JSONResponse) VisitLoginOidcResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginOidc500JSONResponse struct{ InternalJSONResponse }
+
+func (response LoginOidc500JSONResponse) VisitLoginOidcResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type McpApproverCallbackRequestObject struct {
 	Params McpApproverCallbackParams
 	Body   *McpApproverCallbackJSONRequestBody
@@ -6246,2510 +8245,3950 @@ func (response McpInteractionCallback500JSONResponse) VisitMcpInteractionCallbac
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPendingApprovalsRequestObject struct {
-	Params ListPendingApprovalsParams
+type GetRunOutcomeAnalyticsRequestObject struct {
+	Params GetRunOutcomeAnalyticsParams
 }
 
-type ListPendingApprovalsResponseObject interface {
-	VisitListPendingApprovalsResponse(w http.ResponseWriter) error
+type GetRunOutcomeAnalyticsResponseObject interface {
+	VisitGetRunOutcomeAnalyticsResponse(w http.ResponseWriter) error
 }
 
-type ListPendingApprovals200JSONResponse ApprovalRequestItemsResponse
+type GetRunOutcomeAnalytics200JSONResponse RunOutcomeAnalytics
 
-func (response ListPendingApprovals200JSONResponse) VisitListPendingApprovalsResponse(w http.ResponseWriter) error {
+func (response GetRunOutcomeAnalytics200JSONResponse) VisitGetRunOutcomeAnalyticsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPendingApprovals400JSONResponse struct{ BadRequestJSONResponse }
+type GetRunOutcomeAnalytics400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListPendingApprovals400JSONResponse) VisitListPendingApprovalsResponse(w http.ResponseWriter) error {
+func (response GetRunOutcomeAnalytics400JSONResponse) VisitGetRunOutcomeAnalyticsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPendingApprovals401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetRunOutcomeAnalytics401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListPendingApprovals401JSONResponse) VisitListPendingApprovalsResponse(w http.ResponseWriter) error {
+func (response GetRunOutcomeAnalytics401JSONResponse) VisitGetRunOutcomeAnalyticsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListPendingApprovals403JSONResponse struct{ ForbiddenJSONResponse }
+type GetRunOutcomeAnalytics403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ListPendingApprovals403JSONResponse) VisitListPendingApprovalsResponse(w http.ResponseWriter) error {
+func (response GetRunOutcomeAnalytics403JSONResponse) VisitGetRunOutcomeAnalyticsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ResolveApprovalDecisionRequestObject struct {
-	ApprovalRequestId ApprovalRequestID `json:"approval_request_id"`
-	Body              *ResolveApprovalDecisionJSONRequestBody
+type ListStaffAPITokensRequestObject struct {
+	Params ListStaffAPITokensParams
 }
 
-type ResolveApprovalDecisionResponseObject interface {
-	VisitResolveApprovalDecisionResponse(w http.ResponseWriter) error
+type ListStaffAPITokensResponseObject interface {
+	VisitListStaffAPITokensResponse(w http.ResponseWriter) error
 }
 
-type ResolveApprovalDecision200JSONResponse ResolveApprovalDecisionResponse
+type ListStaffAPITokens200JSONResponse StaffAPITokenItemsResponse
 
-func (response ResolveApprovalDecision200JSONResponse) VisitResolveApprovalDecisionResponse(w http.ResponseWriter) error {
+func (response ListStaffAPITokens200JSONResponse) VisitListStaffAPITokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ResolveApprovalDecision400JSONResponse struct{ BadRequestJSONResponse }
+type ListStaffAPITokens400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ResolveApprovalDecision400JSONResponse) VisitResolveApprovalDecisionResponse(w http.ResponseWriter) error {
+func (response ListStaffAPITokens400JSONResponse) VisitListStaffAPITokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ResolveApprovalDecision401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListStaffAPITokens401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ResolveApprovalDecision401JSONResponse) VisitResolveApprovalDecisionResponse(w http.ResponseWriter) error {
+func (response ListStaffAPITokens401JSONResponse) VisitListStaffAPITokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ResolveApprovalDecision403JSONResponse struct{ ForbiddenJSONResponse }
+type ListStaffAPITokens403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ResolveApprovalDecision403JSONResponse) VisitResolveApprovalDecisionResponse(w http.ResponseWriter) error {
+func (response ListStaffAPITokens403JSONResponse) VisitListStaffAPITokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ResolveApprovalDecision404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response ResolveApprovalDecision404JSONResponse) VisitResolveApprovalDecisionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ListDocsetGroupsRequestObject struct {
-	Params ListDocsetGroupsParams
+type CreateStaffAPITokenRequestObject struct {
+	Body *CreateStaffAPITokenJSONRequestBody
 }
 
-type ListDocsetGroupsResponseObject interface {
-	VisitListDocsetGroupsResponse(w http.ResponseWriter) error
+type CreateStaffAPITokenResponseObject interface {
+	VisitCreateStaffAPITokenResponse(w http.ResponseWriter) error
 }
 
-type ListDocsetGroups200JSONResponse DocsetGroupItemsResponse
+type CreateStaffAPIToken201JSONResponse CreateStaffAPITokenResponse
 
-func (response ListDocsetGroups200JSONResponse) VisitListDocsetGroupsResponse(w http.ResponseWriter) error {
+func (response CreateStaffAPIToken201JSONResponse) VisitCreateStaffAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListDocsetGroups400JSONResponse struct{ BadRequestJSONResponse }
+type CreateStaffAPIToken400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListDocsetGroups400JSONResponse) VisitListDocsetGroupsResponse(w http.ResponseWriter) error {
+func (response CreateStaffAPIToken400JSONResponse) VisitCreateStaffAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListDocsetGroups401JSONResponse struct{ UnauthorizedJSONResponse }
+type CreateStaffAPIToken401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListDocsetGroups401JSONResponse) VisitListDocsetGroupsResponse(w http.ResponseWriter) error {
+func (response CreateStaffAPIToken401JSONResponse) VisitCreateStaffAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListDocsetGroups403JSONResponse struct{ ForbiddenJSONResponse }
+type CreateStaffAPIToken403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ListDocsetGroups403JSONResponse) VisitListDocsetGroupsResponse(w http.ResponseWriter) error {
+func (response CreateStaffAPIToken403JSONResponse) VisitCreateStaffAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ExecuteNextStepActionRequestObject struct {
-	Body *ExecuteNextStepActionJSONRequestBody
+type RevokeStaffAPITokenRequestObject struct {
+	TokenId StaffAPITokenID `json:"token_id"`
 }
 
-type ExecuteNextStepActionResponseObject interface {
-	VisitExecuteNextStepActionResponse(w http.ResponseWriter) error
+type RevokeStaffAPITokenResponseObject interface {
+	VisitRevokeStaffAPITokenResponse(w http.ResponseWriter) error
 }
 
-type ExecuteNextStepAction200JSONResponse NextStepActionResponse
-
-func (response ExecuteNextStepAction200JSONResponse) VisitExecuteNextStepActionResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type RevokeStaffAPIToken204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response RevokeStaffAPIToken204Response) VisitRevokeStaffAPITokenResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type ExecuteNextStepAction400JSONResponse struct{ BadRequestJSONResponse }
+type RevokeStaffAPIToken400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ExecuteNextStepAction400JSONResponse) VisitExecuteNextStepActionResponse(w http.ResponseWriter) error {
+func (response RevokeStaffAPIToken400JSONResponse) VisitRevokeStaffAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ExecuteNextStepAction401JSONResponse struct{ UnauthorizedJSONResponse }
+type RevokeStaffAPIToken401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ExecuteNextStepAction401JSONResponse) VisitExecuteNextStepActionResponse(w http.ResponseWriter) error {
+func (response RevokeStaffAPIToken401JSONResponse) VisitRevokeStaffAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ExecuteNextStepAction403JSONResponse struct{ ForbiddenJSONResponse }
+type RevokeStaffAPIToken403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ExecuteNextStepAction403JSONResponse) VisitExecuteNextStepActionResponse(w http.ResponseWriter) error {
+func (response RevokeStaffAPIToken403JSONResponse) VisitRevokeStaffAPITokenResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewNextStepActionRequestObject struct {
-	Body *PreviewNextStepActionJSONRequestBody
+type RevokeStaffAPIToken404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RevokeStaffAPIToken404JSONResponse) VisitRevokeStaffAPITokenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewNextStepActionResponseObject interface {
-	VisitPreviewNextStepActionResponse(w http.ResponseWriter) error
+type ListPendingApprovalsRequestObject struct {
+	Params ListPendingApprovalsParams
 }
 
-type PreviewNextStepAction200JSONResponse NextStepActionResponse
+type ListPendingApprovalsResponseObject interface {
+	VisitListPendingApprovalsResponse(w http.ResponseWriter) error
+}
 
-func (response PreviewNextStepAction200JSONResponse) VisitPreviewNextStepActionResponse(w http.ResponseWriter) error {
+type ListPendingApprovals200JSONResponse ApprovalRequestItemsResponse
+
+func (response ListPendingApprovals200JSONResponse) VisitListPendingApprovalsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewNextStepAction400JSONResponse struct{ BadRequestJSONResponse }
+type ListPendingApprovals400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response PreviewNextStepAction400JSONResponse) VisitPreviewNextStepActionResponse(w http.ResponseWriter) error {
+func (response ListPendingApprovals400JSONResponse) VisitListPendingApprovalsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewNextStepAction401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListPendingApprovals401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response PreviewNextStepAction401JSONResponse) VisitPreviewNextStepActionResponse(w http.ResponseWriter) error {
+func (response ListPendingApprovals401JSONResponse) VisitListPendingApprovalsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewNextStepAction403JSONResponse struct{ ForbiddenJSONResponse }
+type ListPendingApprovals403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response PreviewNextStepAction403JSONResponse) VisitPreviewNextStepActionResponse(w http.ResponseWriter) error {
+func (response ListPendingApprovals403JSONResponse) VisitListPendingApprovalsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SubmitMissionControlCommandRequestObject struct {
-	Params SubmitMissionControlCommandParams
-	Body   *SubmitMissionControlCommandJSONRequestBody
+type ResolveApprovalDecisionRequestObject struct {
+	ApprovalRequestId ApprovalRequestID `json:"approval_request_id"`
+	Body              *ResolveApprovalDecisionJSONRequestBody
 }
 
-type SubmitMissionControlCommandResponseObject interface {
-	VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error
+type ResolveApprovalDecisionResponseObject interface {
+	VisitResolveApprovalDecisionResponse(w http.ResponseWriter) error
 }
 
-type SubmitMissionControlCommand200JSONResponse MissionControlCommandState
+type ResolveApprovalDecision200JSONResponse ResolveApprovalDecisionResponse
 
-func (response SubmitMissionControlCommand200JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
+func (response ResolveApprovalDecision200JSONResponse) VisitResolveApprovalDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SubmitMissionControlCommand400JSONResponse struct{ BadRequestJSONResponse }
+type ResolveApprovalDecision400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response SubmitMissionControlCommand400JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
+func (response ResolveApprovalDecision400JSONResponse) VisitResolveApprovalDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SubmitMissionControlCommand401JSONResponse struct{ UnauthorizedJSONResponse }
+type ResolveApprovalDecision401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response SubmitMissionControlCommand401JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
+func (response ResolveApprovalDecision401JSONResponse) VisitResolveApprovalDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SubmitMissionControlCommand403JSONResponse struct{ ForbiddenJSONResponse }
+type ResolveApprovalDecision403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response SubmitMissionControlCommand403JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
+func (response ResolveApprovalDecision403JSONResponse) VisitResolveApprovalDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SubmitMissionControlCommand404JSONResponse struct{ NotFoundJSONResponse }
+type ResolveApprovalDecision404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response SubmitMissionControlCommand404JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
+func (response ResolveApprovalDecision404JSONResponse) VisitResolveApprovalDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SubmitMissionControlCommand409JSONResponse struct{ ConflictJSONResponse }
+type ListDocsetGroupsRequestObject struct {
+	Params ListDocsetGroupsParams
+}
 
-func (response SubmitMissionControlCommand409JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
+type ListDocsetGroupsResponseObject interface {
+	VisitListDocsetGroupsResponse(w http.ResponseWriter) error
+}
+
+type ListDocsetGroups200JSONResponse DocsetGroupItemsResponse
+
+func (response ListDocsetGroups200JSONResponse) VisitListDocsetGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SubmitMissionControlCommand429JSONResponse struct{ TooManyRequestsJSONResponse }
+type ListDocsetGroups400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response SubmitMissionControlCommand429JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
+func (response ListDocsetGroups400JSONResponse) VisitListDocsetGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(429)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlCommandRequestObject struct {
-	CommandId MissionControlCommandID `json:"command_id"`
-}
+type ListDocsetGroups401JSONResponse struct{ UnauthorizedJSONResponse }
 
-type GetMissionControlCommandResponseObject interface {
-	VisitGetMissionControlCommandResponse(w http.ResponseWriter) error
+func (response ListDocsetGroups401JSONResponse) VisitListDocsetGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlCommand200JSONResponse MissionControlCommandState
+type ListDocsetGroups403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetMissionControlCommand200JSONResponse) VisitGetMissionControlCommandResponse(w http.ResponseWriter) error {
+func (response ListDocsetGroups403JSONResponse) VisitListDocsetGroupsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlCommand400JSONResponse struct{ BadRequestJSONResponse }
+type SetFlowEventRetentionPinRequestObject struct {
+	EventId FlowEventID `json:"event_id"`
+	Body    *SetFlowEventRetentionPinJSONRequestBody
+}
 
-func (response GetMissionControlCommand400JSONResponse) VisitGetMissionControlCommandResponse(w http.ResponseWriter) error {
+type SetFlowEventRetentionPinResponseObject interface {
+	VisitSetFlowEventRetentionPinResponse(w http.ResponseWriter) error
+}
+
+type SetFlowEventRetentionPin204Response struct {
+}
+
+func (response SetFlowEventRetentionPin204Response) VisitSetFlowEventRetentionPinResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type SetFlowEventRetentionPin400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response SetFlowEventRetentionPin400JSONResponse) VisitSetFlowEventRetentionPinResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlCommand401JSONResponse struct{ UnauthorizedJSONResponse }
+type SetFlowEventRetentionPin401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetMissionControlCommand401JSONResponse) VisitGetMissionControlCommandResponse(w http.ResponseWriter) error {
+func (response SetFlowEventRetentionPin401JSONResponse) VisitSetFlowEventRetentionPinResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlCommand403JSONResponse struct{ ForbiddenJSONResponse }
+type SetFlowEventRetentionPin403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetMissionControlCommand403JSONResponse) VisitGetMissionControlCommandResponse(w http.ResponseWriter) error {
+func (response SetFlowEventRetentionPin403JSONResponse) VisitSetFlowEventRetentionPinResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlCommand404JSONResponse struct{ NotFoundJSONResponse }
+type SetFlowEventRetentionPin404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetMissionControlCommand404JSONResponse) VisitGetMissionControlCommandResponse(w http.ResponseWriter) error {
+func (response SetFlowEventRetentionPin404JSONResponse) VisitSetFlowEventRetentionPinResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewMissionControlLaunchRequestObject struct {
-	Body *PreviewMissionControlLaunchJSONRequestBody
+type ExecuteNextStepActionRequestObject struct {
+	Body *ExecuteNextStepActionJSONRequestBody
 }
 
-type PreviewMissionControlLaunchResponseObject interface {
-	VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error
+type ExecuteNextStepActionResponseObject interface {
+	VisitExecuteNextStepActionResponse(w http.ResponseWriter) error
 }
 
-type PreviewMissionControlLaunch200JSONResponse MissionControlLaunchPreview
+type ExecuteNextStepAction200JSONResponse NextStepActionResponse
 
-func (response PreviewMissionControlLaunch200JSONResponse) VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error {
+func (response ExecuteNextStepAction200JSONResponse) VisitExecuteNextStepActionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewMissionControlLaunch400JSONResponse struct{ BadRequestJSONResponse }
+type ExecuteNextStepAction400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response PreviewMissionControlLaunch400JSONResponse) VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error {
+func (response ExecuteNextStepAction400JSONResponse) VisitExecuteNextStepActionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewMissionControlLaunch401JSONResponse struct{ UnauthorizedJSONResponse }
+type ExecuteNextStepAction401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response PreviewMissionControlLaunch401JSONResponse) VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error {
+func (response ExecuteNextStepAction401JSONResponse) VisitExecuteNextStepActionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewMissionControlLaunch403JSONResponse struct{ ForbiddenJSONResponse }
+type ExecuteNextStepAction403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response PreviewMissionControlLaunch403JSONResponse) VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error {
+func (response ExecuteNextStepAction403JSONResponse) VisitExecuteNextStepActionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewMissionControlLaunch404JSONResponse struct{ NotFoundJSONResponse }
+type PreviewNextStepActionRequestObject struct {
+	Body *PreviewNextStepActionJSONRequestBody
+}
 
-func (response PreviewMissionControlLaunch404JSONResponse) VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error {
+type PreviewNextStepActionResponseObject interface {
+	VisitPreviewNextStepActionResponse(w http.ResponseWriter) error
+}
+
+type PreviewNextStepAction200JSONResponse NextStepActionResponse
+
+func (response PreviewNextStepAction200JSONResponse) VisitPreviewNextStepActionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PreviewMissionControlLaunch409JSONResponse struct{ ConflictJSONResponse }
+type PreviewNextStepAction400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response PreviewMissionControlLaunch409JSONResponse) VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error {
+func (response PreviewNextStepAction400JSONResponse) VisitPreviewNextStepActionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlNodeRequestObject struct {
-	NodeKind     GetMissionControlNodeParamsNodeKind `json:"node_kind"`
-	NodePublicId MissionControlNodePublicID          `json:"node_public_id"`
-}
+type PreviewNextStepAction401JSONResponse struct{ UnauthorizedJSONResponse }
 
-type GetMissionControlNodeResponseObject interface {
-	VisitGetMissionControlNodeResponse(w http.ResponseWriter) error
+func (response PreviewNextStepAction401JSONResponse) VisitPreviewNextStepActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlNode200JSONResponse MissionControlNodeDetails
+type PreviewNextStepAction403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetMissionControlNode200JSONResponse) VisitGetMissionControlNodeResponse(w http.ResponseWriter) error {
+func (response PreviewNextStepAction403JSONResponse) VisitPreviewNextStepActionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlNode400JSONResponse struct{ BadRequestJSONResponse }
+type GetChangeGovernancePackageRequestObject struct {
+	PackageId ChangeGovernancePackageID `json:"package_id"`
+}
 
-func (response GetMissionControlNode400JSONResponse) VisitGetMissionControlNodeResponse(w http.ResponseWriter) error {
+type GetChangeGovernancePackageResponseObject interface {
+	VisitGetChangeGovernancePackageResponse(w http.ResponseWriter) error
+}
+
+type GetChangeGovernancePackage200JSONResponse ChangeGovernancePackage
+
+func (response GetChangeGovernancePackage200JSONResponse) VisitGetChangeGovernancePackageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlNode401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetChangeGovernancePackage400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetMissionControlNode401JSONResponse) VisitGetMissionControlNodeResponse(w http.ResponseWriter) error {
+func (response GetChangeGovernancePackage400JSONResponse) VisitGetChangeGovernancePackageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlNode403JSONResponse struct{ ForbiddenJSONResponse }
+type GetChangeGovernancePackage401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetMissionControlNode403JSONResponse) VisitGetMissionControlNodeResponse(w http.ResponseWriter) error {
+func (response GetChangeGovernancePackage401JSONResponse) VisitGetChangeGovernancePackageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlNode404JSONResponse struct{ NotFoundJSONResponse }
+type GetChangeGovernancePackage403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetMissionControlNode404JSONResponse) VisitGetMissionControlNodeResponse(w http.ResponseWriter) error {
+func (response GetChangeGovernancePackage403JSONResponse) VisitGetChangeGovernancePackageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlNode409JSONResponse struct{ ConflictJSONResponse }
+type GetChangeGovernancePackage404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetMissionControlNode409JSONResponse) VisitGetMissionControlNodeResponse(w http.ResponseWriter) error {
+func (response GetChangeGovernancePackage404JSONResponse) VisitGetChangeGovernancePackageResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListMissionControlNodeActivityRequestObject struct {
-	NodeKind     ListMissionControlNodeActivityParamsNodeKind `json:"node_kind"`
-	NodePublicId MissionControlNodePublicID                   `json:"node_public_id"`
-	Params       ListMissionControlNodeActivityParams
+type ReportChangeGovernanceFeedbackRequestObject struct {
+	PackageId ChangeGovernancePackageID `json:"package_id"`
+	Body      *ReportChangeGovernanceFeedbackJSONRequestBody
 }
 
-type ListMissionControlNodeActivityResponseObject interface {
-	VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error
+type ReportChangeGovernanceFeedbackResponseObject interface {
+	VisitReportChangeGovernanceFeedbackResponse(w http.ResponseWriter) error
 }
 
-type ListMissionControlNodeActivity200JSONResponse MissionControlNodeActivityItemsResponse
+type ReportChangeGovernanceFeedback200JSONResponse ChangeGovernancePackage
 
-func (response ListMissionControlNodeActivity200JSONResponse) VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error {
+func (response ReportChangeGovernanceFeedback200JSONResponse) VisitReportChangeGovernanceFeedbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListMissionControlNodeActivity400JSONResponse struct{ BadRequestJSONResponse }
+type ReportChangeGovernanceFeedback400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListMissionControlNodeActivity400JSONResponse) VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error {
+func (response ReportChangeGovernanceFeedback400JSONResponse) VisitReportChangeGovernanceFeedbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListMissionControlNodeActivity401JSONResponse struct{ UnauthorizedJSONResponse }
+type ReportChangeGovernanceFeedback401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListMissionControlNodeActivity401JSONResponse) VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error {
+func (response ReportChangeGovernanceFeedback401JSONResponse) VisitReportChangeGovernanceFeedbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListMissionControlNodeActivity403JSONResponse struct{ ForbiddenJSONResponse }
+type ReportChangeGovernanceFeedback403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ListMissionControlNodeActivity403JSONResponse) VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error {
+func (response ReportChangeGovernanceFeedback403JSONResponse) VisitReportChangeGovernanceFeedbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListMissionControlNodeActivity404JSONResponse struct{ NotFoundJSONResponse }
+type ReportChangeGovernanceFeedback404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response ListMissionControlNodeActivity404JSONResponse) VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error {
+func (response ReportChangeGovernanceFeedback404JSONResponse) VisitReportChangeGovernanceFeedbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListMissionControlNodeActivity409JSONResponse struct{ ConflictJSONResponse }
+type ReportChangeGovernanceFeedback409JSONResponse struct{ ConflictJSONResponse }
 
-func (response ListMissionControlNodeActivity409JSONResponse) VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error {
+func (response ReportChangeGovernanceFeedback409JSONResponse) VisitReportChangeGovernanceFeedbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MissionControlRealtimeRequestObject struct {
-	Params MissionControlRealtimeParams
+type SubmitChangeGovernanceReleaseReadinessDecisionRequestObject struct {
+	PackageId ChangeGovernancePackageID `json:"package_id"`
+	Body      *SubmitChangeGovernanceReleaseReadinessDecisionJSONRequestBody
 }
 
-type MissionControlRealtimeResponseObject interface {
-	VisitMissionControlRealtimeResponse(w http.ResponseWriter) error
+type SubmitChangeGovernanceReleaseReadinessDecisionResponseObject interface {
+	VisitSubmitChangeGovernanceReleaseReadinessDecisionResponse(w http.ResponseWriter) error
 }
 
-type MissionControlRealtime200Response struct {
-}
+type SubmitChangeGovernanceReleaseReadinessDecision200JSONResponse ChangeGovernancePackage
 
-func (response MissionControlRealtime200Response) VisitMissionControlRealtimeResponse(w http.ResponseWriter) error {
+func (response SubmitChangeGovernanceReleaseReadinessDecision200JSONResponse) VisitSubmitChangeGovernanceReleaseReadinessDecisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type MissionControlRealtime400JSONResponse struct{ BadRequestJSONResponse }
+type SubmitChangeGovernanceReleaseReadinessDecision400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response MissionControlRealtime400JSONResponse) VisitMissionControlRealtimeResponse(w http.ResponseWriter) error {
+func (response SubmitChangeGovernanceReleaseReadinessDecision400JSONResponse) VisitSubmitChangeGovernanceReleaseReadinessDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MissionControlRealtime401JSONResponse struct{ UnauthorizedJSONResponse }
+type SubmitChangeGovernanceReleaseReadinessDecision401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response MissionControlRealtime401JSONResponse) VisitMissionControlRealtimeResponse(w http.ResponseWriter) error {
+func (response SubmitChangeGovernanceReleaseReadinessDecision401JSONResponse) VisitSubmitChangeGovernanceReleaseReadinessDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MissionControlRealtime403JSONResponse struct{ ForbiddenJSONResponse }
+type SubmitChangeGovernanceReleaseReadinessDecision403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response MissionControlRealtime403JSONResponse) VisitMissionControlRealtimeResponse(w http.ResponseWriter) error {
+func (response SubmitChangeGovernanceReleaseReadinessDecision403JSONResponse) VisitSubmitChangeGovernanceReleaseReadinessDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MissionControlRealtime409JSONResponse struct{ ConflictJSONResponse }
+type SubmitChangeGovernanceReleaseReadinessDecision404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response MissionControlRealtime409JSONResponse) VisitMissionControlRealtimeResponse(w http.ResponseWriter) error {
+func (response SubmitChangeGovernanceReleaseReadinessDecision404JSONResponse) VisitSubmitChangeGovernanceReleaseReadinessDecisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubmitChangeGovernanceReleaseReadinessDecision409JSONResponse struct{ ConflictJSONResponse }
+
+func (response SubmitChangeGovernanceReleaseReadinessDecision409JSONResponse) VisitSubmitChangeGovernanceReleaseReadinessDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlWorkspaceRequestObject struct {
-	Params GetMissionControlWorkspaceParams
+type SubmitChangeGovernanceWaiverDecisionRequestObject struct {
+	PackageId ChangeGovernancePackageID `json:"package_id"`
+	Body      *SubmitChangeGovernanceWaiverDecisionJSONRequestBody
 }
 
-type GetMissionControlWorkspaceResponseObject interface {
-	VisitGetMissionControlWorkspaceResponse(w http.ResponseWriter) error
+type SubmitChangeGovernanceWaiverDecisionResponseObject interface {
+	VisitSubmitChangeGovernanceWaiverDecisionResponse(w http.ResponseWriter) error
 }
 
-type GetMissionControlWorkspace200JSONResponse MissionControlWorkspaceSnapshot
+type SubmitChangeGovernanceWaiverDecision200JSONResponse ChangeGovernancePackage
 
-func (response GetMissionControlWorkspace200JSONResponse) VisitGetMissionControlWorkspaceResponse(w http.ResponseWriter) error {
+func (response SubmitChangeGovernanceWaiverDecision200JSONResponse) VisitSubmitChangeGovernanceWaiverDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlWorkspace400JSONResponse struct{ BadRequestJSONResponse }
+type SubmitChangeGovernanceWaiverDecision400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetMissionControlWorkspace400JSONResponse) VisitGetMissionControlWorkspaceResponse(w http.ResponseWriter) error {
+func (response SubmitChangeGovernanceWaiverDecision400JSONResponse) VisitSubmitChangeGovernanceWaiverDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlWorkspace401JSONResponse struct{ UnauthorizedJSONResponse }
+type SubmitChangeGovernanceWaiverDecision401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetMissionControlWorkspace401JSONResponse) VisitGetMissionControlWorkspaceResponse(w http.ResponseWriter) error {
+func (response SubmitChangeGovernanceWaiverDecision401JSONResponse) VisitSubmitChangeGovernanceWaiverDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlWorkspace403JSONResponse struct{ ForbiddenJSONResponse }
+type SubmitChangeGovernanceWaiverDecision403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetMissionControlWorkspace403JSONResponse) VisitGetMissionControlWorkspaceResponse(w http.ResponseWriter) error {
+func (response SubmitChangeGovernanceWaiverDecision403JSONResponse) VisitSubmitChangeGovernanceWaiverDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMissionControlWorkspace409JSONResponse struct{ ConflictJSONResponse }
+type SubmitChangeGovernanceWaiverDecision404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetMissionControlWorkspace409JSONResponse) VisitGetMissionControlWorkspaceResponse(w http.ResponseWriter) error {
+func (response SubmitChangeGovernanceWaiverDecision404JSONResponse) VisitSubmitChangeGovernanceWaiverDecisionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubmitChangeGovernanceWaiverDecision409JSONResponse struct{ ConflictJSONResponse }
+
+func (response SubmitChangeGovernanceWaiverDecision409JSONResponse) VisitSubmitChangeGovernanceWaiverDecisionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListProjectsRequestObject struct {
-	Params ListProjectsParams
+type SubmitMissionControlCommandRequestObject struct {
+	Params SubmitMissionControlCommandParams
+	Body   *SubmitMissionControlCommandJSONRequestBody
 }
 
-type ListProjectsResponseObject interface {
-	VisitListProjectsResponse(w http.ResponseWriter) error
+type SubmitMissionControlCommandResponseObject interface {
+	VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error
 }
 
-type ListProjects200JSONResponse ProjectItemsResponse
+type SubmitMissionControlCommand200JSONResponse MissionControlCommandState
 
-func (response ListProjects200JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+func (response SubmitMissionControlCommand200JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListProjects400JSONResponse struct{ BadRequestJSONResponse }
+type SubmitMissionControlCommand400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListProjects400JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+func (response SubmitMissionControlCommand400JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListProjects401JSONResponse struct{ UnauthorizedJSONResponse }
+type SubmitMissionControlCommand401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListProjects401JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
+func (response SubmitMissionControlCommand401JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectRequestObject struct {
-	Body *UpsertProjectJSONRequestBody
-}
+type SubmitMissionControlCommand403JSONResponse struct{ ForbiddenJSONResponse }
 
-type UpsertProjectResponseObject interface {
-	VisitUpsertProjectResponse(w http.ResponseWriter) error
+func (response SubmitMissionControlCommand403JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProject201JSONResponse Project
+type SubmitMissionControlCommand404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response UpsertProject201JSONResponse) VisitUpsertProjectResponse(w http.ResponseWriter) error {
+func (response SubmitMissionControlCommand404JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProject400JSONResponse struct{ BadRequestJSONResponse }
+type SubmitMissionControlCommand409JSONResponse struct{ ConflictJSONResponse }
 
-func (response UpsertProject400JSONResponse) VisitUpsertProjectResponse(w http.ResponseWriter) error {
+func (response SubmitMissionControlCommand409JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProject401JSONResponse struct{ UnauthorizedJSONResponse }
+type SubmitMissionControlCommand429JSONResponse struct{ TooManyRequestsJSONResponse }
 
-func (response UpsertProject401JSONResponse) VisitUpsertProjectResponse(w http.ResponseWriter) error {
+func (response SubmitMissionControlCommand429JSONResponse) VisitSubmitMissionControlCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(429)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProject403JSONResponse struct{ ForbiddenJSONResponse }
+type GetMissionControlCommandRequestObject struct {
+	CommandId MissionControlCommandID `json:"command_id"`
+}
 
-func (response UpsertProject403JSONResponse) VisitUpsertProjectResponse(w http.ResponseWriter) error {
+type GetMissionControlCommandResponseObject interface {
+	VisitGetMissionControlCommandResponse(w http.ResponseWriter) error
+}
+
+type GetMissionControlCommand200JSONResponse MissionControlCommandState
+
+func (response GetMissionControlCommand200JSONResponse) VisitGetMissionControlCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProject500JSONResponse struct{ InternalJSONResponse }
+type GetMissionControlCommand400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpsertProject500JSONResponse) VisitUpsertProjectResponse(w http.ResponseWriter) error {
+func (response GetMissionControlCommand400JSONResponse) VisitGetMissionControlCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectRequestObject struct {
-	ProjectId ProjectID `json:"project_id"`
-}
-
-type DeleteProjectResponseObject interface {
-	VisitDeleteProjectResponse(w http.ResponseWriter) error
-}
-
-type DeleteProject204Response struct {
-}
-
-func (response DeleteProject204Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
-
-type DeleteProject400JSONResponse struct{ BadRequestJSONResponse }
+type GetMissionControlCommand401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteProject400JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+func (response GetMissionControlCommand401JSONResponse) VisitGetMissionControlCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProject401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetMissionControlCommand403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response DeleteProject401JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+func (response GetMissionControlCommand403JSONResponse) VisitGetMissionControlCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProject403JSONResponse struct{ ForbiddenJSONResponse }
+type GetMissionControlCommand404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response DeleteProject403JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+func (response GetMissionControlCommand404JSONResponse) VisitGetMissionControlCommandResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectRequestObject struct {
-	ProjectId ProjectID `json:"project_id"`
+type PreviewMissionControlLaunchRequestObject struct {
+	Body *PreviewMissionControlLaunchJSONRequestBody
 }
 
-type GetProjectResponseObject interface {
-	VisitGetProjectResponse(w http.ResponseWriter) error
+type PreviewMissionControlLaunchResponseObject interface {
+	VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error
 }
 
-type GetProject200JSONResponse Project
+type PreviewMissionControlLaunch200JSONResponse MissionControlLaunchPreview
 
-func (response GetProject200JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+func (response PreviewMissionControlLaunch200JSONResponse) VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProject400JSONResponse struct{ BadRequestJSONResponse }
+type PreviewMissionControlLaunch400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetProject400JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+func (response PreviewMissionControlLaunch400JSONResponse) VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProject401JSONResponse struct{ UnauthorizedJSONResponse }
+type PreviewMissionControlLaunch401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetProject401JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+func (response PreviewMissionControlLaunch401JSONResponse) VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProject404JSONResponse struct{ NotFoundJSONResponse }
+type PreviewMissionControlLaunch403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetProject404JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+func (response PreviewMissionControlLaunch403JSONResponse) VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewMissionControlLaunch404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PreviewMissionControlLaunch404JSONResponse) VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ImportDocsetRequestObject struct {
-	ProjectId ProjectID `json:"project_id"`
-	Body      *ImportDocsetJSONRequestBody
+type PreviewMissionControlLaunch409JSONResponse struct{ ConflictJSONResponse }
+
+func (response PreviewMissionControlLaunch409JSONResponse) VisitPreviewMissionControlLaunchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ImportDocsetResponseObject interface {
-	VisitImportDocsetResponse(w http.ResponseWriter) error
+type GetMissionControlNodeRequestObject struct {
+	NodeKind     GetMissionControlNodeParamsNodeKind `json:"node_kind"`
+	NodePublicId MissionControlNodePublicID          `json:"node_public_id"`
 }
 
-type ImportDocset200JSONResponse ImportDocsetResponse
+type GetMissionControlNodeResponseObject interface {
+	VisitGetMissionControlNodeResponse(w http.ResponseWriter) error
+}
 
-func (response ImportDocset200JSONResponse) VisitImportDocsetResponse(w http.ResponseWriter) error {
+type GetMissionControlNode200JSONResponse MissionControlNodeDetails
+
+func (response GetMissionControlNode200JSONResponse) VisitGetMissionControlNodeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ImportDocset400JSONResponse struct{ BadRequestJSONResponse }
+type GetMissionControlNode400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ImportDocset400JSONResponse) VisitImportDocsetResponse(w http.ResponseWriter) error {
+func (response GetMissionControlNode400JSONResponse) VisitGetMissionControlNodeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ImportDocset401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetMissionControlNode401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ImportDocset401JSONResponse) VisitImportDocsetResponse(w http.ResponseWriter) error {
+func (response GetMissionControlNode401JSONResponse) VisitGetMissionControlNodeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ImportDocset403JSONResponse struct{ ForbiddenJSONResponse }
+type GetMissionControlNode403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ImportDocset403JSONResponse) VisitImportDocsetResponse(w http.ResponseWriter) error {
+func (response GetMissionControlNode403JSONResponse) VisitGetMissionControlNodeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SyncDocsetRequestObject struct {
-	ProjectId ProjectID `json:"project_id"`
-	Body      *SyncDocsetJSONRequestBody
-}
-
-type SyncDocsetResponseObject interface {
-	VisitSyncDocsetResponse(w http.ResponseWriter) error
-}
-
-type SyncDocset200JSONResponse SyncDocsetResponse
+type GetMissionControlNode404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response SyncDocset200JSONResponse) VisitSyncDocsetResponse(w http.ResponseWriter) error {
+func (response GetMissionControlNode404JSONResponse) VisitGetMissionControlNodeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SyncDocset400JSONResponse struct{ BadRequestJSONResponse }
+type GetMissionControlNode409JSONResponse struct{ ConflictJSONResponse }
 
-func (response SyncDocset400JSONResponse) VisitSyncDocsetResponse(w http.ResponseWriter) error {
+func (response GetMissionControlNode409JSONResponse) VisitGetMissionControlNodeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SyncDocset401JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response SyncDocset401JSONResponse) VisitSyncDocsetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+type ListMissionControlNodeActivityRequestObject struct {
+	NodeKind     ListMissionControlNodeActivityParamsNodeKind `json:"node_kind"`
+	NodePublicId MissionControlNodePublicID                   `json:"node_public_id"`
+	Params       ListMissionControlNodeActivityParams
+}
 
-	return json.NewEncoder(w).Encode(response)
+type ListMissionControlNodeActivityResponseObject interface {
+	VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error
 }
 
-type SyncDocset403JSONResponse struct{ ForbiddenJSONResponse }
+type ListMissionControlNodeActivity200JSONResponse MissionControlNodeActivityItemsResponse
 
-func (response SyncDocset403JSONResponse) VisitSyncDocsetResponse(w http.ResponseWriter) error {
+func (response ListMissionControlNodeActivity200JSONResponse) VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectGitHubTokensRequestObject struct {
-	ProjectId ProjectID `json:"project_id"`
-}
+type ListMissionControlNodeActivity400JSONResponse struct{ BadRequestJSONResponse }
 
-type GetProjectGitHubTokensResponseObject interface {
-	VisitGetProjectGitHubTokensResponse(w http.ResponseWriter) error
+func (response ListMissionControlNodeActivity400JSONResponse) VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectGitHubTokens200JSONResponse ProjectGitHubTokens
+type ListMissionControlNodeActivity401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetProjectGitHubTokens200JSONResponse) VisitGetProjectGitHubTokensResponse(w http.ResponseWriter) error {
+func (response ListMissionControlNodeActivity401JSONResponse) VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectGitHubTokens400JSONResponse struct{ BadRequestJSONResponse }
+type ListMissionControlNodeActivity403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetProjectGitHubTokens400JSONResponse) VisitGetProjectGitHubTokensResponse(w http.ResponseWriter) error {
+func (response ListMissionControlNodeActivity403JSONResponse) VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectGitHubTokens401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListMissionControlNodeActivity404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetProjectGitHubTokens401JSONResponse) VisitGetProjectGitHubTokensResponse(w http.ResponseWriter) error {
+func (response ListMissionControlNodeActivity404JSONResponse) VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProjectGitHubTokens403JSONResponse struct{ ForbiddenJSONResponse }
+type ListMissionControlNodeActivity409JSONResponse struct{ ConflictJSONResponse }
 
-func (response GetProjectGitHubTokens403JSONResponse) VisitGetProjectGitHubTokensResponse(w http.ResponseWriter) error {
+func (response ListMissionControlNodeActivity409JSONResponse) VisitListMissionControlNodeActivityResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectGitHubTokensRequestObject struct {
-	ProjectId ProjectID `json:"project_id"`
-	Body      *UpsertProjectGitHubTokensJSONRequestBody
+type MissionControlRealtimeRequestObject struct {
+	Params MissionControlRealtimeParams
 }
 
-type UpsertProjectGitHubTokensResponseObject interface {
-	VisitUpsertProjectGitHubTokensResponse(w http.ResponseWriter) error
+type MissionControlRealtimeResponseObject interface {
+	VisitMissionControlRealtimeResponse(w http.ResponseWriter) error
 }
 
-type UpsertProjectGitHubTokens204Response struct {
+type MissionControlRealtime200Response struct {
 }
 
-func (response UpsertProjectGitHubTokens204Response) VisitUpsertProjectGitHubTokensResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
+func (response MissionControlRealtime200Response) VisitMissionControlRealtimeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
 	return nil
 }
 
-type UpsertProjectGitHubTokens400JSONResponse struct{ BadRequestJSONResponse }
+type MissionControlRealtime400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpsertProjectGitHubTokens400JSONResponse) VisitUpsertProjectGitHubTokensResponse(w http.ResponseWriter) error {
+func (response MissionControlRealtime400JSONResponse) VisitMissionControlRealtimeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectGitHubTokens401JSONResponse struct{ UnauthorizedJSONResponse }
+type MissionControlRealtime401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpsertProjectGitHubTokens401JSONResponse) VisitUpsertProjectGitHubTokensResponse(w http.ResponseWriter) error {
+func (response MissionControlRealtime401JSONResponse) VisitMissionControlRealtimeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectGitHubTokens403JSONResponse struct{ ForbiddenJSONResponse }
+type MissionControlRealtime403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response UpsertProjectGitHubTokens403JSONResponse) VisitUpsertProjectGitHubTokensResponse(w http.ResponseWriter) error {
+func (response MissionControlRealtime403JSONResponse) VisitMissionControlRealtimeResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListProjectMembersRequestObject struct {
-	ProjectId ProjectID `json:"project_id"`
-	Params    ListProjectMembersParams
+type MissionControlRealtime409JSONResponse struct{ ConflictJSONResponse }
+
+func (response MissionControlRealtime409JSONResponse) VisitMissionControlRealtimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListProjectMembersResponseObject interface {
-	VisitListProjectMembersResponse(w http.ResponseWriter) error
+type ListMissionControlVoiceCandidatesRequestObject struct {
+	Params ListMissionControlVoiceCandidatesParams
 }
 
-type ListProjectMembers200JSONResponse ProjectMemberItemsResponse
+type ListMissionControlVoiceCandidatesResponseObject interface {
+	VisitListMissionControlVoiceCandidatesResponse(w http.ResponseWriter) error
+}
 
-func (response ListProjectMembers200JSONResponse) VisitListProjectMembersResponse(w http.ResponseWriter) error {
+type ListMissionControlVoiceCandidates200JSONResponse MissionControlVoiceCandidateItemsResponse
+
+func (response ListMissionControlVoiceCandidates200JSONResponse) VisitListMissionControlVoiceCandidatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListProjectMembers400JSONResponse struct{ BadRequestJSONResponse }
+type ListMissionControlVoiceCandidates400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListProjectMembers400JSONResponse) VisitListProjectMembersResponse(w http.ResponseWriter) error {
+func (response ListMissionControlVoiceCandidates400JSONResponse) VisitListMissionControlVoiceCandidatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListProjectMembers401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListMissionControlVoiceCandidates401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListProjectMembers401JSONResponse) VisitListProjectMembersResponse(w http.ResponseWriter) error {
+func (response ListMissionControlVoiceCandidates401JSONResponse) VisitListMissionControlVoiceCandidatesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectMemberRequestObject struct {
-	ProjectId ProjectID `json:"project_id"`
-	Body      *UpsertProjectMemberJSONRequestBody
+type ListMissionControlVoiceCandidates403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListMissionControlVoiceCandidates403JSONResponse) VisitListMissionControlVoiceCandidatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectMemberResponseObject interface {
-	VisitUpsertProjectMemberResponse(w http.ResponseWriter) error
+type ListMissionControlVoiceCandidates404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ListMissionControlVoiceCandidates404JSONResponse) VisitListMissionControlVoiceCandidatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectMember204Response struct {
+type PromoteMissionControlVoiceCandidateRequestObject struct {
+	CandidateId MissionControlVoiceCandidateID `json:"candidate_id"`
+	Body        *PromoteMissionControlVoiceCandidateJSONRequestBody
 }
 
-func (response UpsertProjectMember204Response) VisitUpsertProjectMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type PromoteMissionControlVoiceCandidateResponseObject interface {
+	VisitPromoteMissionControlVoiceCandidateResponse(w http.ResponseWriter) error
 }
 
-type UpsertProjectMember400JSONResponse struct{ BadRequestJSONResponse }
+type PromoteMissionControlVoiceCandidate200JSONResponse MissionControlVoiceCandidatePromotion
 
-func (response UpsertProjectMember400JSONResponse) VisitUpsertProjectMemberResponse(w http.ResponseWriter) error {
+func (response PromoteMissionControlVoiceCandidate200JSONResponse) VisitPromoteMissionControlVoiceCandidateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PromoteMissionControlVoiceCandidate400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PromoteMissionControlVoiceCandidate400JSONResponse) VisitPromoteMissionControlVoiceCandidateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectMember401JSONResponse struct{ UnauthorizedJSONResponse }
+type PromoteMissionControlVoiceCandidate401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpsertProjectMember401JSONResponse) VisitUpsertProjectMemberResponse(w http.ResponseWriter) error {
+func (response PromoteMissionControlVoiceCandidate401JSONResponse) VisitPromoteMissionControlVoiceCandidateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectMember403JSONResponse struct{ ForbiddenJSONResponse }
+type PromoteMissionControlVoiceCandidate403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response UpsertProjectMember403JSONResponse) VisitUpsertProjectMemberResponse(w http.ResponseWriter) error {
+func (response PromoteMissionControlVoiceCandidate403JSONResponse) VisitPromoteMissionControlVoiceCandidateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectMemberRequestObject struct {
-	ProjectId ProjectID `json:"project_id"`
-	UserId    UserID    `json:"user_id"`
+type PromoteMissionControlVoiceCandidate404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response PromoteMissionControlVoiceCandidate404JSONResponse) VisitPromoteMissionControlVoiceCandidateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectMemberResponseObject interface {
-	VisitDeleteProjectMemberResponse(w http.ResponseWriter) error
+type PromoteMissionControlVoiceCandidate409JSONResponse struct{ ConflictJSONResponse }
+
+func (response PromoteMissionControlVoiceCandidate409JSONResponse) VisitPromoteMissionControlVoiceCandidateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectMember204Response struct {
+type RejectMissionControlVoiceCandidateRequestObject struct {
+	CandidateId MissionControlVoiceCandidateID `json:"candidate_id"`
+	Body        *RejectMissionControlVoiceCandidateJSONRequestBody
 }
 
-func (response DeleteProjectMember204Response) VisitDeleteProjectMemberResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type RejectMissionControlVoiceCandidateResponseObject interface {
+	VisitRejectMissionControlVoiceCandidateResponse(w http.ResponseWriter) error
 }
 
-type DeleteProjectMember400JSONResponse struct{ BadRequestJSONResponse }
+type RejectMissionControlVoiceCandidate200JSONResponse MissionControlVoiceCandidate
 
-func (response DeleteProjectMember400JSONResponse) VisitDeleteProjectMemberResponse(w http.ResponseWriter) error {
+func (response RejectMissionControlVoiceCandidate200JSONResponse) VisitRejectMissionControlVoiceCandidateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RejectMissionControlVoiceCandidate400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RejectMissionControlVoiceCandidate400JSONResponse) VisitRejectMissionControlVoiceCandidateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectMember401JSONResponse struct{ UnauthorizedJSONResponse }
+type RejectMissionControlVoiceCandidate401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteProjectMember401JSONResponse) VisitDeleteProjectMemberResponse(w http.ResponseWriter) error {
+func (response RejectMissionControlVoiceCandidate401JSONResponse) VisitRejectMissionControlVoiceCandidateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectMember403JSONResponse struct{ ForbiddenJSONResponse }
+type RejectMissionControlVoiceCandidate403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response DeleteProjectMember403JSONResponse) VisitDeleteProjectMemberResponse(w http.ResponseWriter) error {
+func (response RejectMissionControlVoiceCandidate403JSONResponse) VisitRejectMissionControlVoiceCandidateResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SetProjectMemberLearningModeOverrideRequestObject struct {
-	ProjectId ProjectID `json:"project_id"`
-	UserId    UserID    `json:"user_id"`
-	Body      *SetProjectMemberLearningModeOverrideJSONRequestBody
+type RejectMissionControlVoiceCandidate404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response RejectMissionControlVoiceCandidate404JSONResponse) VisitRejectMissionControlVoiceCandidateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SetProjectMemberLearningModeOverrideResponseObject interface {
-	VisitSetProjectMemberLearningModeOverrideResponse(w http.ResponseWriter) error
+type RejectMissionControlVoiceCandidate409JSONResponse struct{ ConflictJSONResponse }
+
+func (response RejectMissionControlVoiceCandidate409JSONResponse) VisitRejectMissionControlVoiceCandidateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type SetProjectMemberLearningModeOverride204Response struct {
+type GetMissionControlWorkspaceRequestObject struct {
+	Params GetMissionControlWorkspaceParams
 }
 
-func (response SetProjectMemberLearningModeOverride204Response) VisitSetProjectMemberLearningModeOverrideResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+type GetMissionControlWorkspaceResponseObject interface {
+	VisitGetMissionControlWorkspaceResponse(w http.ResponseWriter) error
 }
 
-type SetProjectMemberLearningModeOverride400JSONResponse struct{ BadRequestJSONResponse }
+type GetMissionControlWorkspace200JSONResponse MissionControlWorkspaceSnapshot
 
-func (response SetProjectMemberLearningModeOverride400JSONResponse) VisitSetProjectMemberLearningModeOverrideResponse(w http.ResponseWriter) error {
+func (response GetMissionControlWorkspace200JSONResponse) VisitGetMissionControlWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMissionControlWorkspace400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetMissionControlWorkspace400JSONResponse) VisitGetMissionControlWorkspaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SetProjectMemberLearningModeOverride401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetMissionControlWorkspace401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response SetProjectMemberLearningModeOverride401JSONResponse) VisitSetProjectMemberLearningModeOverrideResponse(w http.ResponseWriter) error {
+func (response GetMissionControlWorkspace401JSONResponse) VisitGetMissionControlWorkspaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SetProjectMemberLearningModeOverride403JSONResponse struct{ ForbiddenJSONResponse }
+type GetMissionControlWorkspace403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response SetProjectMemberLearningModeOverride403JSONResponse) VisitSetProjectMemberLearningModeOverrideResponse(w http.ResponseWriter) error {
+func (response GetMissionControlWorkspace403JSONResponse) VisitGetMissionControlWorkspaceResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListProjectRepositoriesRequestObject struct {
-	ProjectId ProjectID `json:"project_id"`
-	Params    ListProjectRepositoriesParams
+type GetMissionControlWorkspace409JSONResponse struct{ ConflictJSONResponse }
+
+func (response GetMissionControlWorkspace409JSONResponse) VisitGetMissionControlWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListProjectRepositoriesResponseObject interface {
-	VisitListProjectRepositoriesResponse(w http.ResponseWriter) error
+type ListProjectsRequestObject struct {
+	Params ListProjectsParams
 }
 
-type ListProjectRepositories200JSONResponse RepositoryBindingItemsResponse
+type ListProjectsResponseObject interface {
+	VisitListProjectsResponse(w http.ResponseWriter) error
+}
 
-func (response ListProjectRepositories200JSONResponse) VisitListProjectRepositoriesResponse(w http.ResponseWriter) error {
+type ListProjects200JSONResponse ProjectItemsResponse
+
+func (response ListProjects200JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListProjectRepositories400JSONResponse struct{ BadRequestJSONResponse }
+type ListProjects400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListProjectRepositories400JSONResponse) VisitListProjectRepositoriesResponse(w http.ResponseWriter) error {
+func (response ListProjects400JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListProjectRepositories401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListProjects401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListProjectRepositories401JSONResponse) VisitListProjectRepositoriesResponse(w http.ResponseWriter) error {
+func (response ListProjects401JSONResponse) VisitListProjectsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectRepositoryRequestObject struct {
-	ProjectId ProjectID `json:"project_id"`
-	Body      *UpsertProjectRepositoryJSONRequestBody
+type UpsertProjectRequestObject struct {
+	Body *UpsertProjectJSONRequestBody
 }
 
-type UpsertProjectRepositoryResponseObject interface {
-	VisitUpsertProjectRepositoryResponse(w http.ResponseWriter) error
+type UpsertProjectResponseObject interface {
+	VisitUpsertProjectResponse(w http.ResponseWriter) error
 }
 
-type UpsertProjectRepository201JSONResponse RepositoryBinding
+type UpsertProject201JSONResponse Project
 
-func (response UpsertProjectRepository201JSONResponse) VisitUpsertProjectRepositoryResponse(w http.ResponseWriter) error {
+func (response UpsertProject201JSONResponse) VisitUpsertProjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectRepository400JSONResponse struct{ BadRequestJSONResponse }
+type UpsertProject400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpsertProjectRepository400JSONResponse) VisitUpsertProjectRepositoryResponse(w http.ResponseWriter) error {
+func (response UpsertProject400JSONResponse) VisitUpsertProjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectRepository401JSONResponse struct{ UnauthorizedJSONResponse }
+type UpsertProject401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpsertProjectRepository401JSONResponse) VisitUpsertProjectRepositoryResponse(w http.ResponseWriter) error {
+func (response UpsertProject401JSONResponse) VisitUpsertProjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertProjectRepository403JSONResponse struct{ ForbiddenJSONResponse }
+type UpsertProject403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response UpsertProjectRepository403JSONResponse) VisitUpsertProjectRepositoryResponse(w http.ResponseWriter) error {
+func (response UpsertProject403JSONResponse) VisitUpsertProjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectRepositoryRequestObject struct {
-	ProjectId    ProjectID `json:"project_id"`
-	RepositoryId string    `json:"repository_id"`
+type UpsertProject500JSONResponse struct{ InternalJSONResponse }
+
+func (response UpsertProject500JSONResponse) VisitUpsertProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectRepositoryResponseObject interface {
-	VisitDeleteProjectRepositoryResponse(w http.ResponseWriter) error
+type DeleteProjectRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
 }
 
-type DeleteProjectRepository204Response struct {
+type DeleteProjectResponseObject interface {
+	VisitDeleteProjectResponse(w http.ResponseWriter) error
 }
 
-func (response DeleteProjectRepository204Response) VisitDeleteProjectRepositoryResponse(w http.ResponseWriter) error {
+type DeleteProject204Response struct {
+}
+
+func (response DeleteProject204Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
 	w.WriteHeader(204)
 	return nil
 }
 
-type DeleteProjectRepository400JSONResponse struct{ BadRequestJSONResponse }
+type DeleteProject400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response DeleteProjectRepository400JSONResponse) VisitDeleteProjectRepositoryResponse(w http.ResponseWriter) error {
+func (response DeleteProject400JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectRepository401JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteProject401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteProjectRepository401JSONResponse) VisitDeleteProjectRepositoryResponse(w http.ResponseWriter) error {
+func (response DeleteProject401JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProjectRepository403JSONResponse struct{ ForbiddenJSONResponse }
+type DeleteProject403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response DeleteProjectRepository403JSONResponse) VisitDeleteProjectRepositoryResponse(w http.ResponseWriter) error {
+func (response DeleteProject403JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertRepositoryBotParamsRequestObject struct {
-	ProjectId    ProjectID `json:"project_id"`
-	RepositoryId string    `json:"repository_id"`
-	Body         *UpsertRepositoryBotParamsJSONRequestBody
+type GetProjectRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
 }
 
-type UpsertRepositoryBotParamsResponseObject interface {
-	VisitUpsertRepositoryBotParamsResponse(w http.ResponseWriter) error
+type GetProjectResponseObject interface {
+	VisitGetProjectResponse(w http.ResponseWriter) error
 }
 
-type UpsertRepositoryBotParams204Response struct {
-}
+type GetProject200JSONResponse Project
 
-func (response UpsertRepositoryBotParams204Response) VisitUpsertRepositoryBotParamsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response GetProject200JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertRepositoryBotParams400JSONResponse struct{ BadRequestJSONResponse }
+type GetProject400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpsertRepositoryBotParams400JSONResponse) VisitUpsertRepositoryBotParamsResponse(w http.ResponseWriter) error {
+func (response GetProject400JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertRepositoryBotParams401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetProject401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpsertRepositoryBotParams401JSONResponse) VisitUpsertRepositoryBotParamsResponse(w http.ResponseWriter) error {
+func (response GetProject401JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpsertRepositoryBotParams403JSONResponse struct{ ForbiddenJSONResponse }
+type GetProject404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response UpsertRepositoryBotParams403JSONResponse) VisitUpsertRepositoryBotParamsResponse(w http.ResponseWriter) error {
+func (response GetProject404JSONResponse) VisitGetProjectResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RunRepositoryPreflightRequestObject struct {
-	ProjectId    ProjectID `json:"project_id"`
-	RepositoryId string    `json:"repository_id"`
+type ImportDocsetRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
+	Body      *ImportDocsetJSONRequestBody
 }
 
-type RunRepositoryPreflightResponseObject interface {
-	VisitRunRepositoryPreflightResponse(w http.ResponseWriter) error
+type ImportDocsetResponseObject interface {
+	VisitImportDocsetResponse(w http.ResponseWriter) error
 }
 
-type RunRepositoryPreflight200JSONResponse RunRepositoryPreflightResponse
+type ImportDocset200JSONResponse ImportDocsetResponse
 
-func (response RunRepositoryPreflight200JSONResponse) VisitRunRepositoryPreflightResponse(w http.ResponseWriter) error {
+func (response ImportDocset200JSONResponse) VisitImportDocsetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RunRepositoryPreflight400JSONResponse struct{ BadRequestJSONResponse }
+type ImportDocset400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response RunRepositoryPreflight400JSONResponse) VisitRunRepositoryPreflightResponse(w http.ResponseWriter) error {
+func (response ImportDocset400JSONResponse) VisitImportDocsetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RunRepositoryPreflight401JSONResponse struct{ UnauthorizedJSONResponse }
+type ImportDocset401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response RunRepositoryPreflight401JSONResponse) VisitRunRepositoryPreflightResponse(w http.ResponseWriter) error {
+func (response ImportDocset401JSONResponse) VisitImportDocsetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RunRepositoryPreflight403JSONResponse struct{ ForbiddenJSONResponse }
+type ImportDocset403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response RunRepositoryPreflight403JSONResponse) VisitRunRepositoryPreflightResponse(w http.ResponseWriter) error {
+func (response ImportDocset403JSONResponse) VisitImportDocsetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RunsRealtimeRequestObject struct {
-	Params RunsRealtimeParams
+type SyncDocsetRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
+	Body      *SyncDocsetJSONRequestBody
 }
 
-type RunsRealtimeResponseObject interface {
-	VisitRunsRealtimeResponse(w http.ResponseWriter) error
+type SyncDocsetResponseObject interface {
+	VisitSyncDocsetResponse(w http.ResponseWriter) error
 }
 
-type RunsRealtime200Response struct {
-}
+type SyncDocset200JSONResponse SyncDocsetResponse
 
-func (response RunsRealtime200Response) VisitRunsRealtimeResponse(w http.ResponseWriter) error {
+func (response SyncDocset200JSONResponse) VisitSyncDocsetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type RunsRealtime400JSONResponse struct{ BadRequestJSONResponse }
+type SyncDocset400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response RunsRealtime400JSONResponse) VisitRunsRealtimeResponse(w http.ResponseWriter) error {
+func (response SyncDocset400JSONResponse) VisitSyncDocsetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RunsRealtime401JSONResponse struct{ UnauthorizedJSONResponse }
+type SyncDocset401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response RunsRealtime401JSONResponse) VisitRunsRealtimeResponse(w http.ResponseWriter) error {
+func (response SyncDocset401JSONResponse) VisitSyncDocsetResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRunWaitsRequestObject struct {
-	Params ListRunWaitsParams
-}
+type SyncDocset403JSONResponse struct{ ForbiddenJSONResponse }
 
-type ListRunWaitsResponseObject interface {
-	VisitListRunWaitsResponse(w http.ResponseWriter) error
+func (response SyncDocset403JSONResponse) VisitSyncDocsetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRunWaits200JSONResponse RunItemsResponse
+type GetProjectGitHubTokensRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
+}
 
-func (response ListRunWaits200JSONResponse) VisitListRunWaitsResponse(w http.ResponseWriter) error {
+type GetProjectGitHubTokensResponseObject interface {
+	VisitGetProjectGitHubTokensResponse(w http.ResponseWriter) error
+}
+
+type GetProjectGitHubTokens200JSONResponse ProjectGitHubTokens
+
+func (response GetProjectGitHubTokens200JSONResponse) VisitGetProjectGitHubTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRunWaits400JSONResponse struct{ BadRequestJSONResponse }
+type GetProjectGitHubTokens400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListRunWaits400JSONResponse) VisitListRunWaitsResponse(w http.ResponseWriter) error {
+func (response GetProjectGitHubTokens400JSONResponse) VisitGetProjectGitHubTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRunWaits401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetProjectGitHubTokens401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListRunWaits401JSONResponse) VisitListRunWaitsResponse(w http.ResponseWriter) error {
+func (response GetProjectGitHubTokens401JSONResponse) VisitGetProjectGitHubTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRunRequestObject struct {
-	RunId RunID `json:"run_id"`
+type GetProjectGitHubTokens403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetProjectGitHubTokens403JSONResponse) VisitGetProjectGitHubTokensResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRunResponseObject interface {
-	VisitGetRunResponse(w http.ResponseWriter) error
+type UpsertProjectGitHubTokensRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
+	Body      *UpsertProjectGitHubTokensJSONRequestBody
 }
 
-type GetRun200JSONResponse Run
+type UpsertProjectGitHubTokensResponseObject interface {
+	VisitUpsertProjectGitHubTokensResponse(w http.ResponseWriter) error
+}
 
-func (response GetRun200JSONResponse) VisitGetRunResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type UpsertProjectGitHubTokens204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response UpsertProjectGitHubTokens204Response) VisitUpsertProjectGitHubTokensResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type GetRun400JSONResponse struct{ BadRequestJSONResponse }
+type UpsertProjectGitHubTokens400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetRun400JSONResponse) VisitGetRunResponse(w http.ResponseWriter) error {
+func (response UpsertProjectGitHubTokens400JSONResponse) VisitUpsertProjectGitHubTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRun401JSONResponse struct{ UnauthorizedJSONResponse }
+type UpsertProjectGitHubTokens401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetRun401JSONResponse) VisitGetRunResponse(w http.ResponseWriter) error {
+func (response UpsertProjectGitHubTokens401JSONResponse) VisitUpsertProjectGitHubTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRun404JSONResponse struct{ NotFoundJSONResponse }
+type UpsertProjectGitHubTokens403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetRun404JSONResponse) VisitGetRunResponse(w http.ResponseWriter) error {
+func (response UpsertProjectGitHubTokens403JSONResponse) VisitUpsertProjectGitHubTokensResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelRunRequestObject struct {
-	RunId RunID `json:"run_id"`
-	Body  *CancelRunJSONRequestBody
+type ListMCPApprovalPoliciesRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
 }
 
-type CancelRunResponseObject interface {
-	VisitCancelRunResponse(w http.ResponseWriter) error
+type ListMCPApprovalPoliciesResponseObject interface {
+	VisitListMCPApprovalPoliciesResponse(w http.ResponseWriter) error
 }
 
-type CancelRun200JSONResponse RunActionResponse
+type ListMCPApprovalPolicies200JSONResponse MCPApprovalPolicyItemsResponse
 
-func (response CancelRun200JSONResponse) VisitCancelRunResponse(w http.ResponseWriter) error {
+func (response ListMCPApprovalPolicies200JSONResponse) VisitListMCPApprovalPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelRun400JSONResponse struct{ BadRequestJSONResponse }
+type ListMCPApprovalPolicies400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response CancelRun400JSONResponse) VisitCancelRunResponse(w http.ResponseWriter) error {
+func (response ListMCPApprovalPolicies400JSONResponse) VisitListMCPApprovalPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelRun401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListMCPApprovalPolicies401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response CancelRun401JSONResponse) VisitCancelRunResponse(w http.ResponseWriter) error {
+func (response ListMCPApprovalPolicies401JSONResponse) VisitListMCPApprovalPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelRun403JSONResponse struct{ ForbiddenJSONResponse }
+type ListMCPApprovalPolicies403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response CancelRun403JSONResponse) VisitCancelRunResponse(w http.ResponseWriter) error {
+func (response ListMCPApprovalPolicies403JSONResponse) VisitListMCPApprovalPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelRun404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response CancelRun404JSONResponse) VisitCancelRunResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CancelRun409JSONResponse struct{ ConflictJSONResponse }
-
-func (response CancelRun409JSONResponse) VisitCancelRunResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type ListRunEventsRequestObject struct {
-	RunId  RunID `json:"run_id"`
-	Params ListRunEventsParams
+type UpsertMCPApprovalPolicyRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
+	Body      *UpsertMCPApprovalPolicyJSONRequestBody
 }
 
-type ListRunEventsResponseObject interface {
-	VisitListRunEventsResponse(w http.ResponseWriter) error
+type UpsertMCPApprovalPolicyResponseObject interface {
+	VisitUpsertMCPApprovalPolicyResponse(w http.ResponseWriter) error
 }
 
-type ListRunEvents200JSONResponse FlowEventItemsResponse
+type UpsertMCPApprovalPolicy200JSONResponse MCPApprovalPolicy
 
-func (response ListRunEvents200JSONResponse) VisitListRunEventsResponse(w http.ResponseWriter) error {
+func (response UpsertMCPApprovalPolicy200JSONResponse) VisitUpsertMCPApprovalPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRunEvents400JSONResponse struct{ BadRequestJSONResponse }
+type UpsertMCPApprovalPolicy400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListRunEvents400JSONResponse) VisitListRunEventsResponse(w http.ResponseWriter) error {
+func (response UpsertMCPApprovalPolicy400JSONResponse) VisitUpsertMCPApprovalPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRunEvents401JSONResponse struct{ UnauthorizedJSONResponse }
+type UpsertMCPApprovalPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListRunEvents401JSONResponse) VisitListRunEventsResponse(w http.ResponseWriter) error {
+func (response UpsertMCPApprovalPolicy401JSONResponse) VisitUpsertMCPApprovalPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRunLearningFeedbackRequestObject struct {
-	RunId  RunID `json:"run_id"`
-	Params ListRunLearningFeedbackParams
-}
-
-type ListRunLearningFeedbackResponseObject interface {
-	VisitListRunLearningFeedbackResponse(w http.ResponseWriter) error
-}
+type UpsertMCPApprovalPolicy403JSONResponse struct{ ForbiddenJSONResponse }
 
-type ListRunLearningFeedback200JSONResponse LearningFeedbackItemsResponse
-
-func (response ListRunLearningFeedback200JSONResponse) VisitListRunLearningFeedbackResponse(w http.ResponseWriter) error {
+func (response UpsertMCPApprovalPolicy403JSONResponse) VisitUpsertMCPApprovalPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRunLearningFeedback400JSONResponse struct{ BadRequestJSONResponse }
-
-func (response ListRunLearningFeedback400JSONResponse) VisitListRunLearningFeedbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+type DeleteMCPApprovalPolicyRequestObject struct {
+	ProjectId ProjectID           `json:"project_id"`
+	PolicyId  MCPApprovalPolicyID `json:"policy_id"`
 }
 
-type ListRunLearningFeedback401JSONResponse struct{ UnauthorizedJSONResponse }
-
-func (response ListRunLearningFeedback401JSONResponse) VisitListRunLearningFeedbackResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
-
-	return json.NewEncoder(w).Encode(response)
+type DeleteMCPApprovalPolicyResponseObject interface {
+	VisitDeleteMCPApprovalPolicyResponse(w http.ResponseWriter) error
 }
 
-type GetRunLogsRequestObject struct {
-	RunId  RunID `json:"run_id"`
-	Params GetRunLogsParams
+type DeleteMCPApprovalPolicy204Response struct {
 }
 
-type GetRunLogsResponseObject interface {
-	VisitGetRunLogsResponse(w http.ResponseWriter) error
+func (response DeleteMCPApprovalPolicy204Response) VisitDeleteMCPApprovalPolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type GetRunLogs200JSONResponse RunLogs
+type DeleteMCPApprovalPolicy400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetRunLogs200JSONResponse) VisitGetRunLogsResponse(w http.ResponseWriter) error {
+func (response DeleteMCPApprovalPolicy400JSONResponse) VisitDeleteMCPApprovalPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRunLogs400JSONResponse struct{ BadRequestJSONResponse }
+type DeleteMCPApprovalPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetRunLogs400JSONResponse) VisitGetRunLogsResponse(w http.ResponseWriter) error {
+func (response DeleteMCPApprovalPolicy401JSONResponse) VisitDeleteMCPApprovalPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRunLogs401JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteMCPApprovalPolicy403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetRunLogs401JSONResponse) VisitGetRunLogsResponse(w http.ResponseWriter) error {
+func (response DeleteMCPApprovalPolicy403JSONResponse) VisitDeleteMCPApprovalPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRunLogs404JSONResponse struct{ NotFoundJSONResponse }
+type DeleteMCPApprovalPolicy404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetRunLogs404JSONResponse) VisitGetRunLogsResponse(w http.ResponseWriter) error {
+func (response DeleteMCPApprovalPolicy404JSONResponse) VisitDeleteMCPApprovalPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRunNamespaceRequestObject struct {
-	RunId RunID `json:"run_id"`
+type ListProjectMembersRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
+	Params    ListProjectMembersParams
 }
 
-type DeleteRunNamespaceResponseObject interface {
-	VisitDeleteRunNamespaceResponse(w http.ResponseWriter) error
+type ListProjectMembersResponseObject interface {
+	VisitListProjectMembersResponse(w http.ResponseWriter) error
 }
 
-type DeleteRunNamespace200JSONResponse RunNamespaceCleanupResponse
+type ListProjectMembers200JSONResponse ProjectMemberItemsResponse
 
-func (response DeleteRunNamespace200JSONResponse) VisitDeleteRunNamespaceResponse(w http.ResponseWriter) error {
+func (response ListProjectMembers200JSONResponse) VisitListProjectMembersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRunNamespace400JSONResponse struct{ BadRequestJSONResponse }
+type ListProjectMembers400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response DeleteRunNamespace400JSONResponse) VisitDeleteRunNamespaceResponse(w http.ResponseWriter) error {
+func (response ListProjectMembers400JSONResponse) VisitListProjectMembersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRunNamespace401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListProjectMembers401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteRunNamespace401JSONResponse) VisitDeleteRunNamespaceResponse(w http.ResponseWriter) error {
+func (response ListProjectMembers401JSONResponse) VisitListProjectMembersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteRunNamespace403JSONResponse struct{ ForbiddenJSONResponse }
-
-func (response DeleteRunNamespace403JSONResponse) VisitDeleteRunNamespaceResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+type UpsertProjectMemberRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
+	Body      *UpsertProjectMemberJSONRequestBody
+}
 
-	return json.NewEncoder(w).Encode(response)
+type UpsertProjectMemberResponseObject interface {
+	VisitUpsertProjectMemberResponse(w http.ResponseWriter) error
 }
 
-type RunRealtimeRequestObject struct {
-	RunId  RunID `json:"run_id"`
-	Params RunRealtimeParams
+type UpsertProjectMember204Response struct {
 }
 
-type RunRealtimeResponseObject interface {
-	VisitRunRealtimeResponse(w http.ResponseWriter) error
+func (response UpsertProjectMember204Response) VisitUpsertProjectMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type RunRealtime200JSONResponse RunRealtimeMessage
+type UpsertProjectMember400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response RunRealtime200JSONResponse) VisitRunRealtimeResponse(w http.ResponseWriter) error {
+func (response UpsertProjectMember400JSONResponse) VisitUpsertProjectMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RunRealtime400JSONResponse struct{ BadRequestJSONResponse }
+type UpsertProjectMember401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response RunRealtime400JSONResponse) VisitRunRealtimeResponse(w http.ResponseWriter) error {
+func (response UpsertProjectMember401JSONResponse) VisitUpsertProjectMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RunRealtime401JSONResponse struct{ UnauthorizedJSONResponse }
+type UpsertProjectMember403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response RunRealtime401JSONResponse) VisitRunRealtimeResponse(w http.ResponseWriter) error {
+func (response UpsertProjectMember403JSONResponse) VisitUpsertProjectMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RuntimeDeployTasksRealtimeRequestObject struct {
-	Params RuntimeDeployTasksRealtimeParams
+type DeleteProjectMemberRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
+	UserId    UserID    `json:"user_id"`
 }
 
-type RuntimeDeployTasksRealtimeResponseObject interface {
-	VisitRuntimeDeployTasksRealtimeResponse(w http.ResponseWriter) error
+type DeleteProjectMemberResponseObject interface {
+	VisitDeleteProjectMemberResponse(w http.ResponseWriter) error
 }
 
-type RuntimeDeployTasksRealtime200Response struct {
+type DeleteProjectMember204Response struct {
 }
 
-func (response RuntimeDeployTasksRealtime200Response) VisitRuntimeDeployTasksRealtimeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
+func (response DeleteProjectMember204Response) VisitDeleteProjectMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
 	return nil
 }
 
-type RuntimeDeployTasksRealtime400JSONResponse struct{ BadRequestJSONResponse }
+type DeleteProjectMember400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response RuntimeDeployTasksRealtime400JSONResponse) VisitRuntimeDeployTasksRealtimeResponse(w http.ResponseWriter) error {
+func (response DeleteProjectMember400JSONResponse) VisitDeleteProjectMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RuntimeDeployTasksRealtime401JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteProjectMember401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response RuntimeDeployTasksRealtime401JSONResponse) VisitRuntimeDeployTasksRealtimeResponse(w http.ResponseWriter) error {
+func (response DeleteProjectMember401JSONResponse) VisitDeleteProjectMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RuntimeDeployTasksRealtime403JSONResponse struct{ ForbiddenJSONResponse }
+type DeleteProjectMember403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response RuntimeDeployTasksRealtime403JSONResponse) VisitRuntimeDeployTasksRealtimeResponse(w http.ResponseWriter) error {
+func (response DeleteProjectMember403JSONResponse) VisitDeleteProjectMemberResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRuntimeDeployTaskRequestObject struct {
-	RunId RunID `json:"run_id"`
+type SetProjectMemberLearningModeOverrideRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
+	UserId    UserID    `json:"user_id"`
+	Body      *SetProjectMemberLearningModeOverrideJSONRequestBody
 }
 
-type GetRuntimeDeployTaskResponseObject interface {
-	VisitGetRuntimeDeployTaskResponse(w http.ResponseWriter) error
-}
-
-type GetRuntimeDeployTask200JSONResponse RuntimeDeployTask
+type SetProjectMemberLearningModeOverrideResponseObject interface {
+	VisitSetProjectMemberLearningModeOverrideResponse(w http.ResponseWriter) error
+}
 
-func (response GetRuntimeDeployTask200JSONResponse) VisitGetRuntimeDeployTaskResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type SetProjectMemberLearningModeOverride204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response SetProjectMemberLearningModeOverride204Response) VisitSetProjectMemberLearningModeOverrideResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type GetRuntimeDeployTask400JSONResponse struct{ BadRequestJSONResponse }
+type SetProjectMemberLearningModeOverride400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetRuntimeDeployTask400JSONResponse) VisitGetRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+func (response SetProjectMemberLearningModeOverride400JSONResponse) VisitSetProjectMemberLearningModeOverrideResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRuntimeDeployTask401JSONResponse struct{ UnauthorizedJSONResponse }
+type SetProjectMemberLearningModeOverride401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetRuntimeDeployTask401JSONResponse) VisitGetRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+func (response SetProjectMemberLearningModeOverride401JSONResponse) VisitSetProjectMemberLearningModeOverrideResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRuntimeDeployTask403JSONResponse struct{ ForbiddenJSONResponse }
+type SetProjectMemberLearningModeOverride403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetRuntimeDeployTask403JSONResponse) VisitGetRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+func (response SetProjectMemberLearningModeOverride403JSONResponse) VisitSetProjectMemberLearningModeOverrideResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetRuntimeDeployTask404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response GetRuntimeDeployTask404JSONResponse) VisitGetRuntimeDeployTaskResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CancelRuntimeDeployTaskRequestObject struct {
-	RunId RunID `json:"run_id"`
-	Body  *CancelRuntimeDeployTaskJSONRequestBody
+type ListProjectRepositoriesRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
+	Params    ListProjectRepositoriesParams
 }
 
-type CancelRuntimeDeployTaskResponseObject interface {
-	VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error
+type ListProjectRepositoriesResponseObject interface {
+	VisitListProjectRepositoriesResponse(w http.ResponseWriter) error
 }
 
-type CancelRuntimeDeployTask200JSONResponse RuntimeDeployTaskActionResponse
+type ListProjectRepositories200JSONResponse RepositoryBindingItemsResponse
 
-func (response CancelRuntimeDeployTask200JSONResponse) VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+func (response ListProjectRepositories200JSONResponse) VisitListProjectRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelRuntimeDeployTask400JSONResponse struct{ BadRequestJSONResponse }
+type ListProjectRepositories400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response CancelRuntimeDeployTask400JSONResponse) VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+func (response ListProjectRepositories400JSONResponse) VisitListProjectRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelRuntimeDeployTask401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListProjectRepositories401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response CancelRuntimeDeployTask401JSONResponse) VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+func (response ListProjectRepositories401JSONResponse) VisitListProjectRepositoriesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelRuntimeDeployTask403JSONResponse struct{ ForbiddenJSONResponse }
+type UpsertProjectRepositoryRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
+	Body      *UpsertProjectRepositoryJSONRequestBody
+}
 
-func (response CancelRuntimeDeployTask403JSONResponse) VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+type UpsertProjectRepositoryResponseObject interface {
+	VisitUpsertProjectRepositoryResponse(w http.ResponseWriter) error
+}
+
+type UpsertProjectRepository201JSONResponse RepositoryBinding
+
+func (response UpsertProjectRepository201JSONResponse) VisitUpsertProjectRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelRuntimeDeployTask404JSONResponse struct{ NotFoundJSONResponse }
+type UpsertProjectRepository400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response CancelRuntimeDeployTask404JSONResponse) VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+func (response UpsertProjectRepository400JSONResponse) VisitUpsertProjectRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CancelRuntimeDeployTask409JSONResponse struct{ ConflictJSONResponse }
+type UpsertProjectRepository401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response CancelRuntimeDeployTask409JSONResponse) VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+func (response UpsertProjectRepository401JSONResponse) VisitUpsertProjectRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StopRuntimeDeployTaskRequestObject struct {
-	RunId RunID `json:"run_id"`
-	Body  *StopRuntimeDeployTaskJSONRequestBody
+type UpsertProjectRepository403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpsertProjectRepository403JSONResponse) VisitUpsertProjectRepositoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type StopRuntimeDeployTaskResponseObject interface {
-	VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error
+type DeleteProjectRepositoryRequestObject struct {
+	ProjectId    ProjectID `json:"project_id"`
+	RepositoryId string    `json:"repository_id"`
 }
 
-type StopRuntimeDeployTask200JSONResponse RuntimeDeployTaskActionResponse
+type DeleteProjectRepositoryResponseObject interface {
+	VisitDeleteProjectRepositoryResponse(w http.ResponseWriter) error
+}
 
-func (response StopRuntimeDeployTask200JSONResponse) VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type DeleteProjectRepository204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response DeleteProjectRepository204Response) VisitDeleteProjectRepositoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type StopRuntimeDeployTask400JSONResponse struct{ BadRequestJSONResponse }
+type DeleteProjectRepository400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response StopRuntimeDeployTask400JSONResponse) VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+func (response DeleteProjectRepository400JSONResponse) VisitDeleteProjectRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StopRuntimeDeployTask401JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteProjectRepository401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response StopRuntimeDeployTask401JSONResponse) VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+func (response DeleteProjectRepository401JSONResponse) VisitDeleteProjectRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StopRuntimeDeployTask403JSONResponse struct{ ForbiddenJSONResponse }
+type DeleteProjectRepository403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response StopRuntimeDeployTask403JSONResponse) VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+func (response DeleteProjectRepository403JSONResponse) VisitDeleteProjectRepositoryResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StopRuntimeDeployTask404JSONResponse struct{ NotFoundJSONResponse }
+type UpsertRepositoryBotParamsRequestObject struct {
+	ProjectId    ProjectID `json:"project_id"`
+	RepositoryId string    `json:"repository_id"`
+	Body         *UpsertRepositoryBotParamsJSONRequestBody
+}
 
-func (response StopRuntimeDeployTask404JSONResponse) VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+type UpsertRepositoryBotParamsResponseObject interface {
+	VisitUpsertRepositoryBotParamsResponse(w http.ResponseWriter) error
+}
+
+type UpsertRepositoryBotParams204Response struct {
+}
+
+func (response UpsertRepositoryBotParams204Response) VisitUpsertRepositoryBotParamsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UpsertRepositoryBotParams400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpsertRepositoryBotParams400JSONResponse) VisitUpsertRepositoryBotParamsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type StopRuntimeDeployTask409JSONResponse struct{ ConflictJSONResponse }
+type UpsertRepositoryBotParams401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response StopRuntimeDeployTask409JSONResponse) VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+func (response UpsertRepositoryBotParams401JSONResponse) VisitUpsertRepositoryBotParamsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRuntimeErrorsRequestObject struct {
-	Params ListRuntimeErrorsParams
+type UpsertRepositoryBotParams403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpsertRepositoryBotParams403JSONResponse) VisitUpsertRepositoryBotParamsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRuntimeErrorsResponseObject interface {
-	VisitListRuntimeErrorsResponse(w http.ResponseWriter) error
+type RunRepositoryPreflightRequestObject struct {
+	ProjectId    ProjectID `json:"project_id"`
+	RepositoryId string    `json:"repository_id"`
 }
 
-type ListRuntimeErrors200JSONResponse RuntimeErrorItemsResponse
+type RunRepositoryPreflightResponseObject interface {
+	VisitRunRepositoryPreflightResponse(w http.ResponseWriter) error
+}
 
-func (response ListRuntimeErrors200JSONResponse) VisitListRuntimeErrorsResponse(w http.ResponseWriter) error {
+type RunRepositoryPreflight200JSONResponse RunRepositoryPreflightResponse
+
+func (response RunRepositoryPreflight200JSONResponse) VisitRunRepositoryPreflightResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRuntimeErrors400JSONResponse struct{ BadRequestJSONResponse }
+type RunRepositoryPreflight400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListRuntimeErrors400JSONResponse) VisitListRuntimeErrorsResponse(w http.ResponseWriter) error {
+func (response RunRepositoryPreflight400JSONResponse) VisitRunRepositoryPreflightResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRuntimeErrors401JSONResponse struct{ UnauthorizedJSONResponse }
+type RunRepositoryPreflight401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListRuntimeErrors401JSONResponse) VisitListRuntimeErrorsResponse(w http.ResponseWriter) error {
+func (response RunRepositoryPreflight401JSONResponse) VisitRunRepositoryPreflightResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListRuntimeErrors403JSONResponse struct{ ForbiddenJSONResponse }
+type RunRepositoryPreflight403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ListRuntimeErrors403JSONResponse) VisitListRuntimeErrorsResponse(w http.ResponseWriter) error {
+func (response RunRepositoryPreflight403JSONResponse) VisitRunRepositoryPreflightResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MarkRuntimeErrorViewedRequestObject struct {
-	RuntimeErrorId RuntimeErrorID `json:"runtime_error_id"`
+type ListProjectRolesRequestObject struct {
+	ProjectId ProjectID `json:"project_id"`
 }
 
-type MarkRuntimeErrorViewedResponseObject interface {
-	VisitMarkRuntimeErrorViewedResponse(w http.ResponseWriter) error
+type ListProjectRolesResponseObject interface {
+	VisitListProjectRolesResponse(w http.ResponseWriter) error
 }
 
-type MarkRuntimeErrorViewed200JSONResponse RuntimeError
+type ListProjectRoles200JSONResponse ProjectRolesResponse
 
-func (response MarkRuntimeErrorViewed200JSONResponse) VisitMarkRuntimeErrorViewedResponse(w http.ResponseWriter) error {
+func (response ListProjectRoles200JSONResponse) VisitListProjectRolesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MarkRuntimeErrorViewed400JSONResponse struct{ BadRequestJSONResponse }
+type ListProjectRoles400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response MarkRuntimeErrorViewed400JSONResponse) VisitMarkRuntimeErrorViewedResponse(w http.ResponseWriter) error {
+func (response ListProjectRoles400JSONResponse) VisitListProjectRolesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MarkRuntimeErrorViewed401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListProjectRoles401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response MarkRuntimeErrorViewed401JSONResponse) VisitMarkRuntimeErrorViewedResponse(w http.ResponseWriter) error {
+func (response ListProjectRoles401JSONResponse) VisitListProjectRolesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MarkRuntimeErrorViewed403JSONResponse struct{ ForbiddenJSONResponse }
+type ListProjectRoles403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response MarkRuntimeErrorViewed403JSONResponse) VisitMarkRuntimeErrorViewedResponse(w http.ResponseWriter) error {
+func (response ListProjectRoles403JSONResponse) VisitListProjectRolesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type MarkRuntimeErrorViewed404JSONResponse struct{ NotFoundJSONResponse }
-
-func (response MarkRuntimeErrorViewed404JSONResponse) VisitMarkRuntimeErrorViewedResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+type DeleteProjectRoleRequestObject struct {
+	ProjectId ProjectID       `json:"project_id"`
+	RoleName  ProjectRoleName `json:"role_name"`
+}
 
-	return json.NewEncoder(w).Encode(response)
+type DeleteProjectRoleResponseObject interface {
+	VisitDeleteProjectRoleResponse(w http.ResponseWriter) error
 }
 
-type ListSystemSettingsRequestObject struct {
+type DeleteProjectRole204Response struct {
 }
 
-type ListSystemSettingsResponseObject interface {
-	VisitListSystemSettingsResponse(w http.ResponseWriter) error
+func (response DeleteProjectRole204Response) VisitDeleteProjectRoleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type ListSystemSettings200JSONResponse SystemSettingItemsResponse
+type DeleteProjectRole400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListSystemSettings200JSONResponse) VisitListSystemSettingsResponse(w http.ResponseWriter) error {
+func (response DeleteProjectRole400JSONResponse) VisitDeleteProjectRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListSystemSettings401JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteProjectRole401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListSystemSettings401JSONResponse) VisitListSystemSettingsResponse(w http.ResponseWriter) error {
+func (response DeleteProjectRole401JSONResponse) VisitDeleteProjectRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListSystemSettings403JSONResponse struct{ ForbiddenJSONResponse }
+type DeleteProjectRole403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ListSystemSettings403JSONResponse) VisitListSystemSettingsResponse(w http.ResponseWriter) error {
+func (response DeleteProjectRole403JSONResponse) VisitDeleteProjectRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SystemSettingsRealtimeRequestObject struct {
-}
-
-type SystemSettingsRealtimeResponseObject interface {
-	VisitSystemSettingsRealtimeResponse(w http.ResponseWriter) error
-}
-
-type SystemSettingsRealtime200Response struct {
-}
-
-func (response SystemSettingsRealtime200Response) VisitSystemSettingsRealtimeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(200)
-	return nil
-}
-
-type SystemSettingsRealtime401JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteProjectRole404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response SystemSettingsRealtime401JSONResponse) VisitSystemSettingsRealtimeResponse(w http.ResponseWriter) error {
+func (response DeleteProjectRole404JSONResponse) VisitDeleteProjectRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(401)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SystemSettingsRealtime403JSONResponse struct{ ForbiddenJSONResponse }
+type DeleteProjectRole409JSONResponse struct{ ConflictJSONResponse }
 
-func (response SystemSettingsRealtime403JSONResponse) VisitSystemSettingsRealtimeResponse(w http.ResponseWriter) error {
+func (response DeleteProjectRole409JSONResponse) VisitDeleteProjectRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemSettingRequestObject struct {
-	SettingKey SettingKey `json:"setting_key"`
+type UpsertProjectRoleRequestObject struct {
+	ProjectId ProjectID       `json:"project_id"`
+	RoleName  ProjectRoleName `json:"role_name"`
+	Body      *UpsertProjectRoleJSONRequestBody
 }
 
-type GetSystemSettingResponseObject interface {
-	VisitGetSystemSettingResponse(w http.ResponseWriter) error
+type UpsertProjectRoleResponseObject interface {
+	VisitUpsertProjectRoleResponse(w http.ResponseWriter) error
 }
 
-type GetSystemSetting200JSONResponse SystemSetting
+type UpsertProjectRole200JSONResponse ProjectRole
 
-func (response GetSystemSetting200JSONResponse) VisitGetSystemSettingResponse(w http.ResponseWriter) error {
+func (response UpsertProjectRole200JSONResponse) VisitUpsertProjectRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemSetting400JSONResponse struct{ BadRequestJSONResponse }
+type UpsertProjectRole400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response GetSystemSetting400JSONResponse) VisitGetSystemSettingResponse(w http.ResponseWriter) error {
+func (response UpsertProjectRole400JSONResponse) VisitUpsertProjectRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemSetting401JSONResponse struct{ UnauthorizedJSONResponse }
+type UpsertProjectRole401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response GetSystemSetting401JSONResponse) VisitGetSystemSettingResponse(w http.ResponseWriter) error {
+func (response UpsertProjectRole401JSONResponse) VisitUpsertProjectRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemSetting403JSONResponse struct{ ForbiddenJSONResponse }
+type UpsertProjectRole403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response GetSystemSetting403JSONResponse) VisitGetSystemSettingResponse(w http.ResponseWriter) error {
+func (response UpsertProjectRole403JSONResponse) VisitUpsertProjectRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetSystemSetting404JSONResponse struct{ NotFoundJSONResponse }
+type UpsertProjectRole404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response GetSystemSetting404JSONResponse) VisitGetSystemSettingResponse(w http.ResponseWriter) error {
+func (response UpsertProjectRole404JSONResponse) VisitUpsertProjectRoleResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateSystemSettingBooleanRequestObject struct {
-	SettingKey SettingKey `json:"setting_key"`
-	Body       *UpdateSystemSettingBooleanJSONRequestBody
+type ListRetentionPoliciesRequestObject struct {
+	Params ListRetentionPoliciesParams
 }
 
-type UpdateSystemSettingBooleanResponseObject interface {
-	VisitUpdateSystemSettingBooleanResponse(w http.ResponseWriter) error
+type ListRetentionPoliciesResponseObject interface {
+	VisitListRetentionPoliciesResponse(w http.ResponseWriter) error
 }
 
-type UpdateSystemSettingBoolean200JSONResponse SystemSetting
+type ListRetentionPolicies200JSONResponse RetentionPolicyItemsResponse
 
-func (response UpdateSystemSettingBoolean200JSONResponse) VisitUpdateSystemSettingBooleanResponse(w http.ResponseWriter) error {
+func (response ListRetentionPolicies200JSONResponse) VisitListRetentionPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateSystemSettingBoolean400JSONResponse struct{ BadRequestJSONResponse }
+type ListRetentionPolicies400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response UpdateSystemSettingBoolean400JSONResponse) VisitUpdateSystemSettingBooleanResponse(w http.ResponseWriter) error {
+func (response ListRetentionPolicies400JSONResponse) VisitListRetentionPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateSystemSettingBoolean401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListRetentionPolicies401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response UpdateSystemSettingBoolean401JSONResponse) VisitUpdateSystemSettingBooleanResponse(w http.ResponseWriter) error {
+func (response ListRetentionPolicies401JSONResponse) VisitListRetentionPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateSystemSettingBoolean403JSONResponse struct{ ForbiddenJSONResponse }
+type ListRetentionPolicies403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response UpdateSystemSettingBoolean403JSONResponse) VisitUpdateSystemSettingBooleanResponse(w http.ResponseWriter) error {
+func (response ListRetentionPolicies403JSONResponse) VisitListRetentionPoliciesResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateSystemSettingBoolean404JSONResponse struct{ NotFoundJSONResponse }
+type UpsertRetentionPolicyRequestObject struct {
+	Body *UpsertRetentionPolicyJSONRequestBody
+}
 
-func (response UpdateSystemSettingBoolean404JSONResponse) VisitUpdateSystemSettingBooleanResponse(w http.ResponseWriter) error {
+type UpsertRetentionPolicyResponseObject interface {
+	VisitUpsertRetentionPolicyResponse(w http.ResponseWriter) error
+}
+
+type UpsertRetentionPolicy200JSONResponse RetentionPolicy
+
+func (response UpsertRetentionPolicy200JSONResponse) VisitUpsertRetentionPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ResetSystemSettingRequestObject struct {
-	SettingKey SettingKey `json:"setting_key"`
+type UpsertRetentionPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpsertRetentionPolicy400JSONResponse) VisitUpsertRetentionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ResetSystemSettingResponseObject interface {
-	VisitResetSystemSettingResponse(w http.ResponseWriter) error
+type UpsertRetentionPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpsertRetentionPolicy401JSONResponse) VisitUpsertRetentionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ResetSystemSetting200JSONResponse SystemSetting
+type UpsertRetentionPolicy403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ResetSystemSetting200JSONResponse) VisitResetSystemSettingResponse(w http.ResponseWriter) error {
+func (response UpsertRetentionPolicy403JSONResponse) VisitUpsertRetentionPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ResetSystemSetting400JSONResponse struct{ BadRequestJSONResponse }
+type UpsertRetentionPolicy404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response ResetSystemSetting400JSONResponse) VisitResetSystemSettingResponse(w http.ResponseWriter) error {
+func (response UpsertRetentionPolicy404JSONResponse) VisitUpsertRetentionPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRetentionPolicyRequestObject struct {
+	PolicyId RetentionPolicyID `json:"policy_id"`
+}
+
+type DeleteRetentionPolicyResponseObject interface {
+	VisitDeleteRetentionPolicyResponse(w http.ResponseWriter) error
+}
+
+type DeleteRetentionPolicy204Response struct {
+}
+
+func (response DeleteRetentionPolicy204Response) VisitDeleteRetentionPolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteRetentionPolicy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteRetentionPolicy400JSONResponse) VisitDeleteRetentionPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ResetSystemSetting401JSONResponse struct{ UnauthorizedJSONResponse }
+type DeleteRetentionPolicy401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ResetSystemSetting401JSONResponse) VisitResetSystemSettingResponse(w http.ResponseWriter) error {
+func (response DeleteRetentionPolicy401JSONResponse) VisitDeleteRetentionPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ResetSystemSetting403JSONResponse struct{ ForbiddenJSONResponse }
+type DeleteRetentionPolicy403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response ResetSystemSetting403JSONResponse) VisitResetSystemSettingResponse(w http.ResponseWriter) error {
+func (response DeleteRetentionPolicy403JSONResponse) VisitDeleteRetentionPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ResetSystemSetting404JSONResponse struct{ NotFoundJSONResponse }
+type DeleteRetentionPolicy404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response ResetSystemSetting404JSONResponse) VisitResetSystemSettingResponse(w http.ResponseWriter) error {
+func (response DeleteRetentionPolicy404JSONResponse) VisitDeleteRetentionPolicyResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUsersRequestObject struct {
-	Params ListUsersParams
+type RunsRealtimeRequestObject struct {
+	Params RunsRealtimeParams
 }
 
-type ListUsersResponseObject interface {
-	VisitListUsersResponse(w http.ResponseWriter) error
+type RunsRealtimeResponseObject interface {
+	VisitRunsRealtimeResponse(w http.ResponseWriter) error
 }
 
-type ListUsers200JSONResponse UserItemsResponse
+type RunsRealtime200Response struct {
+}
 
-func (response ListUsers200JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+func (response RunsRealtime200Response) VisitRunsRealtimeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type RunsRealtime400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RunsRealtime400JSONResponse) VisitRunsRealtimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunsRealtime401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RunsRealtime401JSONResponse) VisitRunsRealtimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRunWaitsRequestObject struct {
+	Params ListRunWaitsParams
+}
+
+type ListRunWaitsResponseObject interface {
+	VisitListRunWaitsResponse(w http.ResponseWriter) error
+}
+
+type ListRunWaits200JSONResponse RunItemsResponse
+
+func (response ListRunWaits200JSONResponse) VisitListRunWaitsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUsers400JSONResponse struct{ BadRequestJSONResponse }
+type ListRunWaits400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response ListUsers400JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+func (response ListRunWaits400JSONResponse) VisitListRunWaitsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListUsers401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListRunWaits401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response ListUsers401JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+func (response ListRunWaits401JSONResponse) VisitListRunWaitsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateUserRequestObject struct {
-	Body *CreateUserJSONRequestBody
+type GetRunRequestObject struct {
+	RunId RunID `json:"run_id"`
 }
 
-type CreateUserResponseObject interface {
-	VisitCreateUserResponse(w http.ResponseWriter) error
+type GetRunResponseObject interface {
+	VisitGetRunResponse(w http.ResponseWriter) error
 }
 
-type CreateUser201JSONResponse User
+type GetRun200JSONResponse Run
 
-func (response CreateUser201JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+func (response GetRun200JSONResponse) VisitGetRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateUser400JSONResponse struct{ BadRequestJSONResponse }
+type GetRun400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response CreateUser400JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+func (response GetRun400JSONResponse) VisitGetRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateUser401JSONResponse struct{ UnauthorizedJSONResponse }
+type GetRun401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response CreateUser401JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+func (response GetRun401JSONResponse) VisitGetRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateUser403JSONResponse struct{ ForbiddenJSONResponse }
+type GetRun404JSONResponse struct{ NotFoundJSONResponse }
 
-func (response CreateUser403JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+func (response GetRun404JSONResponse) VisitGetRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUserRequestObject struct {
-	UserId UserID `json:"user_id"`
+type CancelRunRequestObject struct {
+	RunId RunID `json:"run_id"`
+	Body  *CancelRunJSONRequestBody
 }
 
-type DeleteUserResponseObject interface {
-	VisitDeleteUserResponse(w http.ResponseWriter) error
+type CancelRunResponseObject interface {
+	VisitCancelRunResponse(w http.ResponseWriter) error
 }
 
-type DeleteUser204Response struct {
-}
+type CancelRun200JSONResponse RunActionResponse
 
-func (response DeleteUser204Response) VisitDeleteUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response CancelRun200JSONResponse) VisitCancelRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUser400JSONResponse struct{ BadRequestJSONResponse }
+type CancelRun400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response DeleteUser400JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+func (response CancelRun400JSONResponse) VisitCancelRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUser401JSONResponse struct{ UnauthorizedJSONResponse }
+type CancelRun401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response DeleteUser401JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+func (response CancelRun401JSONResponse) VisitCancelRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteUser403JSONResponse struct{ ForbiddenJSONResponse }
+type CancelRun403JSONResponse struct{ ForbiddenJSONResponse }
 
-func (response DeleteUser403JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+func (response CancelRun403JSONResponse) VisitCancelRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type IngestGithubWebhookRequestObject struct {
-	Params IngestGithubWebhookParams
-	Body   *IngestGithubWebhookJSONRequestBody
-}
+type CancelRun404JSONResponse struct{ NotFoundJSONResponse }
 
-type IngestGithubWebhookResponseObject interface {
-	VisitIngestGithubWebhookResponse(w http.ResponseWriter) error
+func (response CancelRun404JSONResponse) VisitCancelRunResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type IngestGithubWebhook200JSONResponse IngestGitHubWebhookResponse
+type CancelRun409JSONResponse struct{ ConflictJSONResponse }
 
-func (response IngestGithubWebhook200JSONResponse) VisitIngestGithubWebhookResponse(w http.ResponseWriter) error {
+func (response CancelRun409JSONResponse) VisitCancelRunResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(409)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type IngestGithubWebhook202JSONResponse IngestGitHubWebhookResponse
+type ListRunEventsRequestObject struct {
+	RunId  RunID `json:"run_id"`
+	Params ListRunEventsParams
+}
 
-func (response IngestGithubWebhook202JSONResponse) VisitIngestGithubWebhookResponse(w http.ResponseWriter) error {
+type ListRunEventsResponseObject interface {
+	VisitListRunEventsResponse(w http.ResponseWriter) error
+}
+
+type ListRunEvents200JSONResponse FlowEventItemsResponse
+
+func (response ListRunEvents200JSONResponse) VisitListRunEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type IngestGithubWebhook400JSONResponse struct{ BadRequestJSONResponse }
+type ListRunEvents400JSONResponse struct{ BadRequestJSONResponse }
 
-func (response IngestGithubWebhook400JSONResponse) VisitIngestGithubWebhookResponse(w http.ResponseWriter) error {
+func (response ListRunEvents400JSONResponse) VisitListRunEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type IngestGithubWebhook401JSONResponse struct{ UnauthorizedJSONResponse }
+type ListRunEvents401JSONResponse struct{ UnauthorizedJSONResponse }
 
-func (response IngestGithubWebhook401JSONResponse) VisitIngestGithubWebhookResponse(w http.ResponseWriter) error {
+func (response ListRunEvents401JSONResponse) VisitListRunEventsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type IngestGithubWebhook500JSONResponse struct{ InternalJSONResponse }
+type ListRunLearningFeedbackRequestObject struct {
+	RunId  RunID `json:"run_id"`
+	Params ListRunLearningFeedbackParams
+}
 
-func (response IngestGithubWebhook500JSONResponse) VisitIngestGithubWebhookResponse(w http.ResponseWriter) error {
+type ListRunLearningFeedbackResponseObject interface {
+	VisitListRunLearningFeedbackResponse(w http.ResponseWriter) error
+}
+
+type ListRunLearningFeedback200JSONResponse LearningFeedbackItemsResponse
+
+func (response ListRunLearningFeedback200JSONResponse) VisitListRunLearningFeedbackResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(500)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Complete GitHub OAuth callback and set auth cookie
-	// (GET /api/v1/auth/github/callback)
-	CallbackGithub(ctx context.Context, request CallbackGithubRequestObject) (CallbackGithubResponseObject, error)
-	// Start GitHub OAuth login
-	// (GET /api/v1/auth/github/login)
-	LoginGithub(ctx context.Context, request LoginGithubRequestObject) (LoginGithubResponseObject, error)
-	// Logout current staff user
-	// (POST /api/v1/auth/logout)
-	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
-	// Get current authenticated staff principal
-	// (GET /api/v1/auth/me)
-	GetMe(ctx context.Context, request GetMeRequestObject) (GetMeResponseObject, error)
-	// Resolve one MCP approval request from external approver callback
-	// (POST /api/v1/mcp/approver/callback)
-	McpApproverCallback(ctx context.Context, request McpApproverCallbackRequestObject) (McpApproverCallbackResponseObject, error)
-	// Resolve one MCP approval request from external executor callback
-	// (POST /api/v1/mcp/executor/callback)
-	McpExecutorCallback(ctx context.Context, request McpExecutorCallbackRequestObject) (McpExecutorCallbackResponseObject, error)
+type ListRunLearningFeedback400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListRunLearningFeedback400JSONResponse) VisitListRunLearningFeedbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRunLearningFeedback401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListRunLearningFeedback401JSONResponse) VisitListRunLearningFeedbackResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRunLogsRequestObject struct {
+	RunId  RunID `json:"run_id"`
+	Params GetRunLogsParams
+}
+
+type GetRunLogsResponseObject interface {
+	VisitGetRunLogsResponse(w http.ResponseWriter) error
+}
+
+type GetRunLogs200JSONResponse RunLogs
+
+func (response GetRunLogs200JSONResponse) VisitGetRunLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRunLogs400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRunLogs400JSONResponse) VisitGetRunLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRunLogs401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetRunLogs401JSONResponse) VisitGetRunLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRunLogs404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetRunLogs404JSONResponse) VisitGetRunLogsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRunNamespaceRequestObject struct {
+	RunId RunID `json:"run_id"`
+}
+
+type DeleteRunNamespaceResponseObject interface {
+	VisitDeleteRunNamespaceResponse(w http.ResponseWriter) error
+}
+
+type DeleteRunNamespace200JSONResponse RunNamespaceCleanupResponse
+
+func (response DeleteRunNamespace200JSONResponse) VisitDeleteRunNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRunNamespace400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteRunNamespace400JSONResponse) VisitDeleteRunNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRunNamespace401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteRunNamespace401JSONResponse) VisitDeleteRunNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteRunNamespace403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteRunNamespace403JSONResponse) VisitDeleteRunNamespaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunRealtimeRequestObject struct {
+	RunId  RunID `json:"run_id"`
+	Params RunRealtimeParams
+}
+
+type RunRealtimeResponseObject interface {
+	VisitRunRealtimeResponse(w http.ResponseWriter) error
+}
+
+type RunRealtime200JSONResponse RunRealtimeMessage
+
+func (response RunRealtime200JSONResponse) VisitRunRealtimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunRealtime400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RunRealtime400JSONResponse) VisitRunRealtimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RunRealtime401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RunRealtime401JSONResponse) VisitRunRealtimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRuntimeDeployRequestObject struct {
+	Body *PreviewRuntimeDeployJSONRequestBody
+}
+
+type PreviewRuntimeDeployResponseObject interface {
+	VisitPreviewRuntimeDeployResponse(w http.ResponseWriter) error
+}
+
+type PreviewRuntimeDeploy200JSONResponse RuntimeDeployPreview
+
+func (response PreviewRuntimeDeploy200JSONResponse) VisitPreviewRuntimeDeployResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRuntimeDeploy400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response PreviewRuntimeDeploy400JSONResponse) VisitPreviewRuntimeDeployResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRuntimeDeploy401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response PreviewRuntimeDeploy401JSONResponse) VisitPreviewRuntimeDeployResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewRuntimeDeploy403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response PreviewRuntimeDeploy403JSONResponse) VisitPreviewRuntimeDeployResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RuntimeDeployTasksRealtimeRequestObject struct {
+	Params RuntimeDeployTasksRealtimeParams
+}
+
+type RuntimeDeployTasksRealtimeResponseObject interface {
+	VisitRuntimeDeployTasksRealtimeResponse(w http.ResponseWriter) error
+}
+
+type RuntimeDeployTasksRealtime200Response struct {
+}
+
+func (response RuntimeDeployTasksRealtime200Response) VisitRuntimeDeployTasksRealtimeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type RuntimeDeployTasksRealtime400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response RuntimeDeployTasksRealtime400JSONResponse) VisitRuntimeDeployTasksRealtimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RuntimeDeployTasksRealtime401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response RuntimeDeployTasksRealtime401JSONResponse) VisitRuntimeDeployTasksRealtimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RuntimeDeployTasksRealtime403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response RuntimeDeployTasksRealtime403JSONResponse) VisitRuntimeDeployTasksRealtimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRuntimeDeployTaskRequestObject struct {
+	RunId RunID `json:"run_id"`
+}
+
+type GetRuntimeDeployTaskResponseObject interface {
+	VisitGetRuntimeDeployTaskResponse(w http.ResponseWriter) error
+}
+
+type GetRuntimeDeployTask200JSONResponse RuntimeDeployTask
+
+func (response GetRuntimeDeployTask200JSONResponse) VisitGetRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRuntimeDeployTask400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetRuntimeDeployTask400JSONResponse) VisitGetRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRuntimeDeployTask401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetRuntimeDeployTask401JSONResponse) VisitGetRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRuntimeDeployTask403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetRuntimeDeployTask403JSONResponse) VisitGetRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRuntimeDeployTask404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetRuntimeDeployTask404JSONResponse) VisitGetRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRuntimeDeployTaskRequestObject struct {
+	RunId RunID `json:"run_id"`
+	Body  *CancelRuntimeDeployTaskJSONRequestBody
+}
+
+type CancelRuntimeDeployTaskResponseObject interface {
+	VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error
+}
+
+type CancelRuntimeDeployTask200JSONResponse RuntimeDeployTaskActionResponse
+
+func (response CancelRuntimeDeployTask200JSONResponse) VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRuntimeDeployTask400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CancelRuntimeDeployTask400JSONResponse) VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRuntimeDeployTask401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CancelRuntimeDeployTask401JSONResponse) VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRuntimeDeployTask403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CancelRuntimeDeployTask403JSONResponse) VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRuntimeDeployTask404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response CancelRuntimeDeployTask404JSONResponse) VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CancelRuntimeDeployTask409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CancelRuntimeDeployTask409JSONResponse) VisitCancelRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopRuntimeDeployTaskRequestObject struct {
+	RunId RunID `json:"run_id"`
+	Body  *StopRuntimeDeployTaskJSONRequestBody
+}
+
+type StopRuntimeDeployTaskResponseObject interface {
+	VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error
+}
+
+type StopRuntimeDeployTask200JSONResponse RuntimeDeployTaskActionResponse
+
+func (response StopRuntimeDeployTask200JSONResponse) VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopRuntimeDeployTask400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response StopRuntimeDeployTask400JSONResponse) VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopRuntimeDeployTask401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response StopRuntimeDeployTask401JSONResponse) VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopRuntimeDeployTask403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response StopRuntimeDeployTask403JSONResponse) VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopRuntimeDeployTask404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response StopRuntimeDeployTask404JSONResponse) VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type StopRuntimeDeployTask409JSONResponse struct{ ConflictJSONResponse }
+
+func (response StopRuntimeDeployTask409JSONResponse) VisitStopRuntimeDeployTaskResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRuntimeErrorsRequestObject struct {
+	Params ListRuntimeErrorsParams
+}
+
+type ListRuntimeErrorsResponseObject interface {
+	VisitListRuntimeErrorsResponse(w http.ResponseWriter) error
+}
+
+type ListRuntimeErrors200JSONResponse RuntimeErrorItemsResponse
+
+func (response ListRuntimeErrors200JSONResponse) VisitListRuntimeErrorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRuntimeErrors400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListRuntimeErrors400JSONResponse) VisitListRuntimeErrorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRuntimeErrors401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListRuntimeErrors401JSONResponse) VisitListRuntimeErrorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRuntimeErrors403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListRuntimeErrors403JSONResponse) VisitListRuntimeErrorsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRuntimeErrorGroupsRequestObject struct {
+	Params ListRuntimeErrorGroupsParams
+}
+
+type ListRuntimeErrorGroupsResponseObject interface {
+	VisitListRuntimeErrorGroupsResponse(w http.ResponseWriter) error
+}
+
+type ListRuntimeErrorGroups200JSONResponse RuntimeErrorGroupItemsResponse
+
+func (response ListRuntimeErrorGroups200JSONResponse) VisitListRuntimeErrorGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRuntimeErrorGroups400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListRuntimeErrorGroups400JSONResponse) VisitListRuntimeErrorGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRuntimeErrorGroups401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListRuntimeErrorGroups401JSONResponse) VisitListRuntimeErrorGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListRuntimeErrorGroups403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListRuntimeErrorGroups403JSONResponse) VisitListRuntimeErrorGroupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EscalateRuntimeErrorGroupRequestObject struct {
+	GroupId RuntimeErrorGroupID `json:"group_id"`
+	Body    *EscalateRuntimeErrorGroupJSONRequestBody
+}
+
+type EscalateRuntimeErrorGroupResponseObject interface {
+	VisitEscalateRuntimeErrorGroupResponse(w http.ResponseWriter) error
+}
+
+type EscalateRuntimeErrorGroup200JSONResponse RuntimeErrorGroup
+
+func (response EscalateRuntimeErrorGroup200JSONResponse) VisitEscalateRuntimeErrorGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EscalateRuntimeErrorGroup400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response EscalateRuntimeErrorGroup400JSONResponse) VisitEscalateRuntimeErrorGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EscalateRuntimeErrorGroup401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response EscalateRuntimeErrorGroup401JSONResponse) VisitEscalateRuntimeErrorGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EscalateRuntimeErrorGroup403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response EscalateRuntimeErrorGroup403JSONResponse) VisitEscalateRuntimeErrorGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EscalateRuntimeErrorGroup404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response EscalateRuntimeErrorGroup404JSONResponse) VisitEscalateRuntimeErrorGroupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRuntimeErrorGroupStatusRequestObject struct {
+	GroupId RuntimeErrorGroupID `json:"group_id"`
+	Body    *UpdateRuntimeErrorGroupStatusJSONRequestBody
+}
+
+type UpdateRuntimeErrorGroupStatusResponseObject interface {
+	VisitUpdateRuntimeErrorGroupStatusResponse(w http.ResponseWriter) error
+}
+
+type UpdateRuntimeErrorGroupStatus200JSONResponse RuntimeErrorGroup
+
+func (response UpdateRuntimeErrorGroupStatus200JSONResponse) VisitUpdateRuntimeErrorGroupStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRuntimeErrorGroupStatus400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateRuntimeErrorGroupStatus400JSONResponse) VisitUpdateRuntimeErrorGroupStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRuntimeErrorGroupStatus401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateRuntimeErrorGroupStatus401JSONResponse) VisitUpdateRuntimeErrorGroupStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRuntimeErrorGroupStatus403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateRuntimeErrorGroupStatus403JSONResponse) VisitUpdateRuntimeErrorGroupStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateRuntimeErrorGroupStatus404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateRuntimeErrorGroupStatus404JSONResponse) VisitUpdateRuntimeErrorGroupStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkRuntimeErrorViewedRequestObject struct {
+	RuntimeErrorId RuntimeErrorID `json:"runtime_error_id"`
+}
+
+type MarkRuntimeErrorViewedResponseObject interface {
+	VisitMarkRuntimeErrorViewedResponse(w http.ResponseWriter) error
+}
+
+type MarkRuntimeErrorViewed200JSONResponse RuntimeError
+
+func (response MarkRuntimeErrorViewed200JSONResponse) VisitMarkRuntimeErrorViewedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkRuntimeErrorViewed400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response MarkRuntimeErrorViewed400JSONResponse) VisitMarkRuntimeErrorViewedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkRuntimeErrorViewed401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response MarkRuntimeErrorViewed401JSONResponse) VisitMarkRuntimeErrorViewedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkRuntimeErrorViewed403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response MarkRuntimeErrorViewed403JSONResponse) VisitMarkRuntimeErrorViewedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MarkRuntimeErrorViewed404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response MarkRuntimeErrorViewed404JSONResponse) VisitMarkRuntimeErrorViewedResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccountRequestObject struct {
+	Body *CreateServiceAccountJSONRequestBody
+}
+
+type CreateServiceAccountResponseObject interface {
+	VisitCreateServiceAccountResponse(w http.ResponseWriter) error
+}
+
+type CreateServiceAccount201JSONResponse User
+
+func (response CreateServiceAccount201JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateServiceAccount400JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateServiceAccount401JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateServiceAccount403JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateServiceAccount409JSONResponse struct{ ConflictJSONResponse }
+
+func (response CreateServiceAccount409JSONResponse) VisitCreateServiceAccountResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSystemSettingsRequestObject struct {
+}
+
+type ListSystemSettingsResponseObject interface {
+	VisitListSystemSettingsResponse(w http.ResponseWriter) error
+}
+
+type ListSystemSettings200JSONResponse SystemSettingItemsResponse
+
+func (response ListSystemSettings200JSONResponse) VisitListSystemSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSystemSettings401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListSystemSettings401JSONResponse) VisitListSystemSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListSystemSettings403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ListSystemSettings403JSONResponse) VisitListSystemSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SystemSettingsRealtimeRequestObject struct {
+}
+
+type SystemSettingsRealtimeResponseObject interface {
+	VisitSystemSettingsRealtimeResponse(w http.ResponseWriter) error
+}
+
+type SystemSettingsRealtime200Response struct {
+}
+
+func (response SystemSettingsRealtime200Response) VisitSystemSettingsRealtimeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type SystemSettingsRealtime401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response SystemSettingsRealtime401JSONResponse) VisitSystemSettingsRealtimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SystemSettingsRealtime403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response SystemSettingsRealtime403JSONResponse) VisitSystemSettingsRealtimeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemSettingRequestObject struct {
+	SettingKey SettingKey `json:"setting_key"`
+}
+
+type GetSystemSettingResponseObject interface {
+	VisitGetSystemSettingResponse(w http.ResponseWriter) error
+}
+
+type GetSystemSetting200JSONResponse SystemSetting
+
+func (response GetSystemSetting200JSONResponse) VisitGetSystemSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemSetting400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response GetSystemSetting400JSONResponse) VisitGetSystemSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemSetting401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response GetSystemSetting401JSONResponse) VisitGetSystemSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemSetting403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response GetSystemSetting403JSONResponse) VisitGetSystemSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSystemSetting404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response GetSystemSetting404JSONResponse) VisitGetSystemSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSystemSettingBooleanRequestObject struct {
+	SettingKey SettingKey `json:"setting_key"`
+	Body       *UpdateSystemSettingBooleanJSONRequestBody
+}
+
+type UpdateSystemSettingBooleanResponseObject interface {
+	VisitUpdateSystemSettingBooleanResponse(w http.ResponseWriter) error
+}
+
+type UpdateSystemSettingBoolean200JSONResponse SystemSetting
+
+func (response UpdateSystemSettingBoolean200JSONResponse) VisitUpdateSystemSettingBooleanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSystemSettingBoolean400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response UpdateSystemSettingBoolean400JSONResponse) VisitUpdateSystemSettingBooleanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSystemSettingBoolean401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response UpdateSystemSettingBoolean401JSONResponse) VisitUpdateSystemSettingBooleanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSystemSettingBoolean403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response UpdateSystemSettingBoolean403JSONResponse) VisitUpdateSystemSettingBooleanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSystemSettingBoolean404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response UpdateSystemSettingBoolean404JSONResponse) VisitUpdateSystemSettingBooleanResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSystemSettingRequestObject struct {
+	SettingKey SettingKey `json:"setting_key"`
+}
+
+type ResetSystemSettingResponseObject interface {
+	VisitResetSystemSettingResponse(w http.ResponseWriter) error
+}
+
+type ResetSystemSetting200JSONResponse SystemSetting
+
+func (response ResetSystemSetting200JSONResponse) VisitResetSystemSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSystemSetting400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ResetSystemSetting400JSONResponse) VisitResetSystemSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSystemSetting401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ResetSystemSetting401JSONResponse) VisitResetSystemSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSystemSetting403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response ResetSystemSetting403JSONResponse) VisitResetSystemSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ResetSystemSetting404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response ResetSystemSetting404JSONResponse) VisitResetSystemSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsersRequestObject struct {
+	Params ListUsersParams
+}
+
+type ListUsersResponseObject interface {
+	VisitListUsersResponse(w http.ResponseWriter) error
+}
+
+type ListUsers200JSONResponse UserItemsResponse
+
+func (response ListUsers200JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsers400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response ListUsers400JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListUsers401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response ListUsers401JSONResponse) VisitListUsersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUserRequestObject struct {
+	Body *CreateUserJSONRequestBody
+}
+
+type CreateUserResponseObject interface {
+	VisitCreateUserResponse(w http.ResponseWriter) error
+}
+
+type CreateUser201JSONResponse User
+
+func (response CreateUser201JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response CreateUser400JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response CreateUser401JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateUser403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response CreateUser403JSONResponse) VisitCreateUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUserRequestObject struct {
+	UserId UserID `json:"user_id"`
+}
+
+type DeleteUserResponseObject interface {
+	VisitDeleteUserResponse(w http.ResponseWriter) error
+}
+
+type DeleteUser204Response struct {
+}
+
+func (response DeleteUser204Response) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteUser400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response DeleteUser400JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response DeleteUser401JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteUser403JSONResponse struct{ ForbiddenJSONResponse }
+
+func (response DeleteUser403JSONResponse) VisitDeleteUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAlertmanagerWebhookRequestObject struct {
+	Params IngestAlertmanagerWebhookParams
+	Body   *IngestAlertmanagerWebhookJSONRequestBody
+}
+
+type IngestAlertmanagerWebhookResponseObject interface {
+	VisitIngestAlertmanagerWebhookResponse(w http.ResponseWriter) error
+}
+
+type IngestAlertmanagerWebhook200JSONResponse IngestAlertmanagerWebhookResponse
+
+func (response IngestAlertmanagerWebhook200JSONResponse) VisitIngestAlertmanagerWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAlertmanagerWebhook202JSONResponse IngestAlertmanagerWebhookResponse
+
+func (response IngestAlertmanagerWebhook202JSONResponse) VisitIngestAlertmanagerWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAlertmanagerWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response IngestAlertmanagerWebhook400JSONResponse) VisitIngestAlertmanagerWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAlertmanagerWebhook401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response IngestAlertmanagerWebhook401JSONResponse) VisitIngestAlertmanagerWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAlertmanagerWebhook404JSONResponse struct{ NotFoundJSONResponse }
+
+func (response IngestAlertmanagerWebhook404JSONResponse) VisitIngestAlertmanagerWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestAlertmanagerWebhook500JSONResponse struct{ InternalJSONResponse }
+
+func (response IngestAlertmanagerWebhook500JSONResponse) VisitIngestAlertmanagerWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestGithubWebhookRequestObject struct {
+	Params IngestGithubWebhookParams
+	Body   *IngestGithubWebhookJSONRequestBody
+}
+
+type IngestGithubWebhookResponseObject interface {
+	VisitIngestGithubWebhookResponse(w http.ResponseWriter) error
+}
+
+type IngestGithubWebhook200JSONResponse IngestGitHubWebhookResponse
+
+func (response IngestGithubWebhook200JSONResponse) VisitIngestGithubWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestGithubWebhook202JSONResponse IngestGitHubWebhookResponse
+
+func (response IngestGithubWebhook202JSONResponse) VisitIngestGithubWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestGithubWebhook400JSONResponse struct{ BadRequestJSONResponse }
+
+func (response IngestGithubWebhook400JSONResponse) VisitIngestGithubWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestGithubWebhook401JSONResponse struct{ UnauthorizedJSONResponse }
+
+func (response IngestGithubWebhook401JSONResponse) VisitIngestGithubWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type IngestGithubWebhook500JSONResponse struct{ InternalJSONResponse }
+
+func (response IngestGithubWebhook500JSONResponse) VisitIngestGithubWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Complete GitHub OAuth callback and set auth cookie
+	// (GET /api/v1/auth/github/callback)
+	CallbackGithub(ctx context.Context, request CallbackGithubRequestObject) (CallbackGithubResponseObject, error)
+	// Start GitHub OAuth login
+	// (GET /api/v1/auth/github/login)
+	LoginGithub(ctx context.Context, request LoginGithubRequestObject) (LoginGithubResponseObject, error)
+	// Logout current staff user
+	// (POST /api/v1/auth/logout)
+	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
+	// Get current authenticated staff principal
+	// (GET /api/v1/auth/me)
+	GetMe(ctx context.Context, request GetMeRequestObject) (GetMeResponseObject, error)
+	// Complete OIDC callback, verify ID token and set auth cookie
+	// (GET /api/v1/auth/oidc/callback)
+	CallbackOidc(ctx context.Context, request CallbackOidcRequestObject) (CallbackOidcResponseObject, error)
+	// Start generic OIDC single sign-on login (authorization code + PKCE)
+	// (GET /api/v1/auth/oidc/login)
+	LoginOidc(ctx context.Context, request LoginOidcRequestObject) (LoginOidcResponseObject, error)
+	// Resolve one MCP approval request from external approver callback
+	// (POST /api/v1/mcp/approver/callback)
+	McpApproverCallback(ctx context.Context, request McpApproverCallbackRequestObject) (McpApproverCallbackResponseObject, error)
+	// Resolve one MCP approval request from external executor callback
+	// (POST /api/v1/mcp/executor/callback)
+	McpExecutorCallback(ctx context.Context, request McpExecutorCallbackRequestObject) (McpExecutorCallbackResponseObject, error)
 	// Apply one built-in MCP user interaction callback
 	// (POST /api/v1/mcp/interactions/callback)
 	McpInteractionCallback(ctx context.Context, request McpInteractionCallbackRequestObject) (McpInteractionCallbackResponseObject, error)
+	// Aggregate run outcomes (merge rate, revise rounds, time-to-merge) per issue/PR lineage
+	// (GET /api/v1/staff/analytics/run-outcomes)
+	GetRunOutcomeAnalytics(ctx context.Context, request GetRunOutcomeAnalyticsRequestObject) (GetRunOutcomeAnalyticsResponseObject, error)
+	// List staff API tokens
+	// (GET /api/v1/staff/api-tokens)
+	ListStaffAPITokens(ctx context.Context, request ListStaffAPITokensRequestObject) (ListStaffAPITokensResponseObject, error)
+	// Issue staff API token
+	// (POST /api/v1/staff/api-tokens)
+	CreateStaffAPIToken(ctx context.Context, request CreateStaffAPITokenRequestObject) (CreateStaffAPITokenResponseObject, error)
+	// Revoke staff API token
+	// (DELETE /api/v1/staff/api-tokens/{token_id})
+	RevokeStaffAPIToken(ctx context.Context, request RevokeStaffAPITokenRequestObject) (RevokeStaffAPITokenResponseObject, error)
 	// List pending approval requests
 	// (GET /api/v1/staff/approvals)
 	ListPendingApprovals(ctx context.Context, request ListPendingApprovalsRequestObject) (ListPendingApprovalsResponseObject, error)
@@ -8759,12 +12198,27 @@ type StrictServerInterface interface {
 	// List docset groups (manifest v1)
 	// (GET /api/v1/staff/docset/groups)
 	ListDocsetGroups(ctx context.Context, request ListDocsetGroupsRequestObject) (ListDocsetGroupsResponseObject, error)
+	// Pin or unpin flow event from retention sweeps
+	// (PUT /api/v1/staff/flow-events/{event_id}/retention-pin)
+	SetFlowEventRetentionPin(ctx context.Context, request SetFlowEventRetentionPinRequestObject) (SetFlowEventRetentionPinResponseObject, error)
 	// Execute one GitHub next-step action
 	// (POST /api/v1/staff/github/next-step-actions/execute)
 	ExecuteNextStepAction(ctx context.Context, request ExecuteNextStepActionRequestObject) (ExecuteNextStepActionResponseObject, error)
 	// Preview one GitHub next-step action
 	// (POST /api/v1/staff/github/next-step-actions/preview)
 	PreviewNextStepAction(ctx context.Context, request PreviewNextStepActionRequestObject) (PreviewNextStepActionResponseObject, error)
+	// Get change-governance package with decisions and feedback
+	// (GET /api/v1/staff/governance/change-packages/{package_id})
+	GetChangeGovernancePackage(ctx context.Context, request GetChangeGovernancePackageRequestObject) (GetChangeGovernancePackageResponseObject, error)
+	// Open a change-governance feedback gap
+	// (POST /api/v1/staff/governance/change-packages/{package_id}/feedback)
+	ReportChangeGovernanceFeedback(ctx context.Context, request ReportChangeGovernanceFeedbackRequestObject) (ReportChangeGovernanceFeedbackResponseObject, error)
+	// Record a change-governance release readiness decision
+	// (POST /api/v1/staff/governance/change-packages/{package_id}/release-readiness)
+	SubmitChangeGovernanceReleaseReadinessDecision(ctx context.Context, request SubmitChangeGovernanceReleaseReadinessDecisionRequestObject) (SubmitChangeGovernanceReleaseReadinessDecisionResponseObject, error)
+	// Approve or reject a change-governance waiver
+	// (POST /api/v1/staff/governance/change-packages/{package_id}/waivers)
+	SubmitChangeGovernanceWaiverDecision(ctx context.Context, request SubmitChangeGovernanceWaiverDecisionRequestObject) (SubmitChangeGovernanceWaiverDecisionResponseObject, error)
 	// Submit Mission Control command
 	// (POST /api/v1/staff/mission-control/commands)
 	SubmitMissionControlCommand(ctx context.Context, request SubmitMissionControlCommandRequestObject) (SubmitMissionControlCommandResponseObject, error)
@@ -8783,6 +12237,15 @@ type StrictServerInterface interface {
 	// Open Mission Control realtime stream (WebSocket upgrade)
 	// (GET /api/v1/staff/mission-control/realtime)
 	MissionControlRealtime(ctx context.Context, request MissionControlRealtimeRequestObject) (MissionControlRealtimeResponseObject, error)
+	// List Mission Control voice candidates
+	// (GET /api/v1/staff/mission-control/voice-candidates)
+	ListMissionControlVoiceCandidates(ctx context.Context, request ListMissionControlVoiceCandidatesRequestObject) (ListMissionControlVoiceCandidatesResponseObject, error)
+	// Confirm Mission Control voice candidate and submit its command
+	// (POST /api/v1/staff/mission-control/voice-candidates/{candidate_id}/promote)
+	PromoteMissionControlVoiceCandidate(ctx context.Context, request PromoteMissionControlVoiceCandidateRequestObject) (PromoteMissionControlVoiceCandidateResponseObject, error)
+	// Reject Mission Control voice candidate
+	// (POST /api/v1/staff/mission-control/voice-candidates/{candidate_id}/reject)
+	RejectMissionControlVoiceCandidate(ctx context.Context, request RejectMissionControlVoiceCandidateRequestObject) (RejectMissionControlVoiceCandidateResponseObject, error)
 	// Get Mission Control workspace snapshot
 	// (GET /api/v1/staff/mission-control/workspace)
 	GetMissionControlWorkspace(ctx context.Context, request GetMissionControlWorkspaceRequestObject) (GetMissionControlWorkspaceResponseObject, error)
@@ -8810,6 +12273,15 @@ type StrictServerInterface interface {
 	// Upsert project-scoped GitHub tokens
 	// (PUT /api/v1/staff/projects/{project_id}/github-tokens)
 	UpsertProjectGitHubTokens(ctx context.Context, request UpsertProjectGitHubTokensRequestObject) (UpsertProjectGitHubTokensResponseObject, error)
+	// List MCP approval policies of project
+	// (GET /api/v1/staff/projects/{project_id}/mcp-approval-policies)
+	ListMCPApprovalPolicies(ctx context.Context, request ListMCPApprovalPoliciesRequestObject) (ListMCPApprovalPoliciesResponseObject, error)
+	// Create or update MCP approval policy for tool and environment
+	// (PUT /api/v1/staff/projects/{project_id}/mcp-approval-policies)
+	UpsertMCPApprovalPolicy(ctx context.Context, request UpsertMCPApprovalPolicyRequestObject) (UpsertMCPApprovalPolicyResponseObject, error)
+	// Delete MCP approval policy
+	// (DELETE /api/v1/staff/projects/{project_id}/mcp-approval-policies/{policy_id})
+	DeleteMCPApprovalPolicy(ctx context.Context, request DeleteMCPApprovalPolicyRequestObject) (DeleteMCPApprovalPolicyResponseObject, error)
 	// List project members
 	// (GET /api/v1/staff/projects/{project_id}/members)
 	ListProjectMembers(ctx context.Context, request ListProjectMembersRequestObject) (ListProjectMembersResponseObject, error)
@@ -8837,6 +12309,24 @@ type StrictServerInterface interface {
 	// Run repository onboarding preflight
 	// (POST /api/v1/staff/projects/{project_id}/repositories/{repository_id}/preflight)
 	RunRepositoryPreflight(ctx context.Context, request RunRepositoryPreflightRequestObject) (RunRepositoryPreflightResponseObject, error)
+	// List project roles and permission catalog
+	// (GET /api/v1/staff/projects/{project_id}/roles)
+	ListProjectRoles(ctx context.Context, request ListProjectRolesRequestObject) (ListProjectRolesResponseObject, error)
+	// Delete custom project role
+	// (DELETE /api/v1/staff/projects/{project_id}/roles/{role_name})
+	DeleteProjectRole(ctx context.Context, request DeleteProjectRoleRequestObject) (DeleteProjectRoleResponseObject, error)
+	// Create or update custom project role
+	// (PUT /api/v1/staff/projects/{project_id}/roles/{role_name})
+	UpsertProjectRole(ctx context.Context, request UpsertProjectRoleRequestObject) (UpsertProjectRoleResponseObject, error)
+	// List data retention policies
+	// (GET /api/v1/staff/retention-policies)
+	ListRetentionPolicies(ctx context.Context, request ListRetentionPoliciesRequestObject) (ListRetentionPoliciesResponseObject, error)
+	// Create or update data retention policy for target and scope
+	// (PUT /api/v1/staff/retention-policies)
+	UpsertRetentionPolicy(ctx context.Context, request UpsertRetentionPolicyRequestObject) (UpsertRetentionPolicyResponseObject, error)
+	// Delete project-scoped retention policy override
+	// (DELETE /api/v1/staff/retention-policies/{policy_id})
+	DeleteRetentionPolicy(ctx context.Context, request DeleteRetentionPolicyRequestObject) (DeleteRetentionPolicyResponseObject, error)
 	// Open realtime runs list stream (WebSocket upgrade)
 	// (GET /api/v1/staff/runs/realtime)
 	RunsRealtime(ctx context.Context, request RunsRealtimeRequestObject) (RunsRealtimeResponseObject, error)
@@ -8864,6 +12354,9 @@ type StrictServerInterface interface {
 	// Open realtime run stream (WebSocket upgrade)
 	// (GET /api/v1/staff/runs/{run_id}/realtime)
 	RunRealtime(ctx context.Context, request RunRealtimeRequestObject) (RunRealtimeResponseObject, error)
+	// Preview runtime deploy changes via server-side dry-run
+	// (POST /api/v1/staff/runtime-deploy/previews)
+	PreviewRuntimeDeploy(ctx context.Context, request PreviewRuntimeDeployRequestObject) (PreviewRuntimeDeployResponseObject, error)
 	// Open realtime runtime deploy tasks list stream (WebSocket upgrade)
 	// (GET /api/v1/staff/runtime-deploy/tasks/realtime)
 	RuntimeDeployTasksRealtime(ctx context.Context, request RuntimeDeployTasksRealtimeRequestObject) (RuntimeDeployTasksRealtimeResponseObject, error)
@@ -8879,9 +12372,21 @@ type StrictServerInterface interface {
 	// List runtime error journal entries
 	// (GET /api/v1/staff/runtime-errors)
 	ListRuntimeErrors(ctx context.Context, request ListRuntimeErrorsRequestObject) (ListRuntimeErrorsResponseObject, error)
+	// List runtime error groups aggregated by normalized fingerprint
+	// (GET /api/v1/staff/runtime-errors/groups)
+	ListRuntimeErrorGroups(ctx context.Context, request ListRuntimeErrorGroupsRequestObject) (ListRuntimeErrorGroupsResponseObject, error)
+	// Escalate runtime error group to GitHub issue or ai-repair run
+	// (POST /api/v1/staff/runtime-errors/groups/{group_id}/escalate)
+	EscalateRuntimeErrorGroup(ctx context.Context, request EscalateRuntimeErrorGroupRequestObject) (EscalateRuntimeErrorGroupResponseObject, error)
+	// Mute, resolve or reopen runtime error group
+	// (POST /api/v1/staff/runtime-errors/groups/{group_id}/status)
+	UpdateRuntimeErrorGroupStatus(ctx context.Context, request UpdateRuntimeErrorGroupStatusRequestObject) (UpdateRuntimeErrorGroupStatusResponseObject, error)
 	// Mark runtime error as viewed
 	// (POST /api/v1/staff/runtime-errors/{runtime_error_id}/viewed)
 	MarkRuntimeErrorViewed(ctx context.Context, request MarkRuntimeErrorViewedRequestObject) (MarkRuntimeErrorViewedResponseObject, error)
+	// Create service account
+	// (POST /api/v1/staff/service-accounts)
+	CreateServiceAccount(ctx context.Context, request CreateServiceAccountRequestObject) (CreateServiceAccountResponseObject, error)
 	// List platform system settings
 	// (GET /api/v1/staff/system-settings)
 	ListSystemSettings(ctx context.Context, request ListSystemSettingsRequestObject) (ListSystemSettingsResponseObject, error)
@@ -8906,59 +12411,572 @@ type StrictServerInterface interface {
 	// Delete user
 	// (DELETE /api/v1/staff/users/{user_id})
 	DeleteUser(ctx context.Context, request DeleteUserRequestObject) (DeleteUserResponseObject, error)
+	// Ingest Alertmanager webhook notification
+	// (POST /api/v1/webhooks/alertmanager)
+	IngestAlertmanagerWebhook(ctx context.Context, request IngestAlertmanagerWebhookRequestObject) (IngestAlertmanagerWebhookResponseObject, error)
 	// Ingest GitHub webhook delivery
 	// (POST /api/v1/webhooks/github)
 	IngestGithubWebhook(ctx context.Context, request IngestGithubWebhookRequestObject) (IngestGithubWebhookResponseObject, error)
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// CallbackGithub operation middleware
+func (sh *strictHandler) CallbackGithub(w http.ResponseWriter, r *http.Request, params CallbackGithubParams) {
+	var request CallbackGithubRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CallbackGithub(ctx, request.(CallbackGithubRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CallbackGithub")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CallbackGithubResponseObject); ok {
+		if err := validResponse.VisitCallbackGithubResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LoginGithub operation middleware
+func (sh *strictHandler) LoginGithub(w http.ResponseWriter, r *http.Request) {
+	var request LoginGithubRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LoginGithub(ctx, request.(LoginGithubRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LoginGithub")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoginGithubResponseObject); ok {
+		if err := validResponse.VisitLoginGithubResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Logout operation middleware
+func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var request LogoutRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Logout")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LogoutResponseObject); ok {
+		if err := validResponse.VisitLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMe operation middleware
+func (sh *strictHandler) GetMe(w http.ResponseWriter, r *http.Request) {
+	var request GetMeRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMe(ctx, request.(GetMeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMe")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMeResponseObject); ok {
+		if err := validResponse.VisitGetMeResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CallbackOidc operation middleware
+func (sh *strictHandler) CallbackOidc(w http.ResponseWriter, r *http.Request, params CallbackOidcParams) {
+	var request CallbackOidcRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CallbackOidc(ctx, request.(CallbackOidcRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CallbackOidc")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CallbackOidcResponseObject); ok {
+		if err := validResponse.VisitCallbackOidcResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// LoginOidc operation middleware
+func (sh *strictHandler) LoginOidc(w http.ResponseWriter, r *http.Request) {
+	var request LoginOidcRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.LoginOidc(ctx, request.(LoginOidcRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "LoginOidc")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoginOidcResponseObject); ok {
+		if err := validResponse.VisitLoginOidcResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// McpApproverCallback operation middleware
+func (sh *strictHandler) McpApproverCallback(w http.ResponseWriter, r *http.Request, params McpApproverCallbackParams) {
+	var request McpApproverCallbackRequestObject
+
+	request.Params = params
+
+	var body McpApproverCallbackJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.McpApproverCallback(ctx, request.(McpApproverCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "McpApproverCallback")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(McpApproverCallbackResponseObject); ok {
+		if err := validResponse.VisitMcpApproverCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// McpExecutorCallback operation middleware
+func (sh *strictHandler) McpExecutorCallback(w http.ResponseWriter, r *http.Request, params McpExecutorCallbackParams) {
+	var request McpExecutorCallbackRequestObject
+
+	request.Params = params
+
+	var body McpExecutorCallbackJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.McpExecutorCallback(ctx, request.(McpExecutorCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "McpExecutorCallback")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(McpExecutorCallbackResponseObject); ok {
+		if err := validResponse.VisitMcpExecutorCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// McpInteractionCallback operation middleware
+func (sh *strictHandler) McpInteractionCallback(w http.ResponseWriter, r *http.Request, params McpInteractionCallbackParams) {
+	var request McpInteractionCallbackRequestObject
+
+	request.Params = params
+
+	var body McpInteractionCallbackJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.McpInteractionCallback(ctx, request.(McpInteractionCallbackRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "McpInteractionCallback")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(McpInteractionCallbackResponseObject); ok {
+		if err := validResponse.VisitMcpInteractionCallbackResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRunOutcomeAnalytics operation middleware
+func (sh *strictHandler) GetRunOutcomeAnalytics(w http.ResponseWriter, r *http.Request, params GetRunOutcomeAnalyticsParams) {
+	var request GetRunOutcomeAnalyticsRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRunOutcomeAnalytics(ctx, request.(GetRunOutcomeAnalyticsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRunOutcomeAnalytics")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetRunOutcomeAnalyticsResponseObject); ok {
+		if err := validResponse.VisitGetRunOutcomeAnalyticsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+// ListStaffAPITokens operation middleware
+func (sh *strictHandler) ListStaffAPITokens(w http.ResponseWriter, r *http.Request, params ListStaffAPITokensParams) {
+	var request ListStaffAPITokensRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListStaffAPITokens(ctx, request.(ListStaffAPITokensRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListStaffAPITokens")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListStaffAPITokensResponseObject); ok {
+		if err := validResponse.VisitListStaffAPITokensResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// CreateStaffAPIToken operation middleware
+func (sh *strictHandler) CreateStaffAPIToken(w http.ResponseWriter, r *http.Request) {
+	var request CreateStaffAPITokenRequestObject
+
+	var body CreateStaffAPITokenJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateStaffAPIToken(ctx, request.(CreateStaffAPITokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateStaffAPIToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateStaffAPITokenResponseObject); ok {
+		if err := validResponse.VisitCreateStaffAPITokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// RevokeStaffAPIToken operation middleware
+func (sh *strictHandler) RevokeStaffAPIToken(w http.ResponseWriter, r *http.Request, tokenId StaffAPITokenID) {
+	var request RevokeStaffAPITokenRequestObject
+
+	request.TokenId = tokenId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevokeStaffAPIToken(ctx, request.(RevokeStaffAPITokenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevokeStaffAPIToken")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevokeStaffAPITokenResponseObject); ok {
+		if err := validResponse.VisitRevokeStaffAPITokenResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// CallbackGithub operation middleware
-func (sh *strictHandler) CallbackGithub(w http.ResponseWriter, r *http.Request, params CallbackGithubParams) {
-	var request CallbackGithubRequestObject
+// ListPendingApprovals operation middleware
+func (sh *strictHandler) ListPendingApprovals(w http.ResponseWriter, r *http.Request, params ListPendingApprovalsParams) {
+	var request ListPendingApprovalsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CallbackGithub(ctx, request.(CallbackGithubRequestObject))
+		return sh.ssi.ListPendingApprovals(ctx, request.(ListPendingApprovalsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CallbackGithub")
+		handler = middleware(handler, "ListPendingApprovals")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListPendingApprovalsResponseObject); ok {
+		if err := validResponse.VisitListPendingApprovalsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ResolveApprovalDecision operation middleware
+func (sh *strictHandler) ResolveApprovalDecision(w http.ResponseWriter, r *http.Request, approvalRequestId ApprovalRequestID) {
+	var request ResolveApprovalDecisionRequestObject
+
+	request.ApprovalRequestId = approvalRequestId
+
+	var body ResolveApprovalDecisionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ResolveApprovalDecision(ctx, request.(ResolveApprovalDecisionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ResolveApprovalDecision")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ResolveApprovalDecisionResponseObject); ok {
+		if err := validResponse.VisitResolveApprovalDecisionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListDocsetGroups operation middleware
+func (sh *strictHandler) ListDocsetGroups(w http.ResponseWriter, r *http.Request, params ListDocsetGroupsParams) {
+	var request ListDocsetGroupsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListDocsetGroups(ctx, request.(ListDocsetGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListDocsetGroups")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListDocsetGroupsResponseObject); ok {
+		if err := validResponse.VisitListDocsetGroupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetFlowEventRetentionPin operation middleware
+func (sh *strictHandler) SetFlowEventRetentionPin(w http.ResponseWriter, r *http.Request, eventId FlowEventID) {
+	var request SetFlowEventRetentionPinRequestObject
+
+	request.EventId = eventId
+
+	var body SetFlowEventRetentionPinJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetFlowEventRetentionPin(ctx, request.(SetFlowEventRetentionPinRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetFlowEventRetentionPin")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetFlowEventRetentionPinResponseObject); ok {
+		if err := validResponse.VisitSetFlowEventRetentionPinResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExecuteNextStepAction operation middleware
+func (sh *strictHandler) ExecuteNextStepAction(w http.ResponseWriter, r *http.Request) {
+	var request ExecuteNextStepActionRequestObject
+
+	var body ExecuteNextStepActionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ExecuteNextStepAction(ctx, request.(ExecuteNextStepActionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExecuteNextStepAction")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ExecuteNextStepActionResponseObject); ok {
+		if err := validResponse.VisitExecuteNextStepActionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PreviewNextStepAction operation middleware
+func (sh *strictHandler) PreviewNextStepAction(w http.ResponseWriter, r *http.Request) {
+	var request PreviewNextStepActionRequestObject
+
+	var body PreviewNextStepActionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PreviewNextStepAction(ctx, request.(PreviewNextStepActionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PreviewNextStepAction")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CallbackGithubResponseObject); ok {
-		if err := validResponse.VisitCallbackGithubResponse(w); err != nil {
+	} else if validResponse, ok := response.(PreviewNextStepActionResponseObject); ok {
+		if err := validResponse.VisitPreviewNextStepActionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8966,23 +12984,25 @@ func (sh *strictHandler) CallbackGithub(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// LoginGithub operation middleware
-func (sh *strictHandler) LoginGithub(w http.ResponseWriter, r *http.Request) {
-	var request LoginGithubRequestObject
+// GetChangeGovernancePackage operation middleware
+func (sh *strictHandler) GetChangeGovernancePackage(w http.ResponseWriter, r *http.Request, packageId ChangeGovernancePackageID) {
+	var request GetChangeGovernancePackageRequestObject
+
+	request.PackageId = packageId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.LoginGithub(ctx, request.(LoginGithubRequestObject))
+		return sh.ssi.GetChangeGovernancePackage(ctx, request.(GetChangeGovernancePackageRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "LoginGithub")
+		handler = middleware(handler, "GetChangeGovernancePackage")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LoginGithubResponseObject); ok {
-		if err := validResponse.VisitLoginGithubResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetChangeGovernancePackageResponseObject); ok {
+		if err := validResponse.VisitGetChangeGovernancePackageResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8990,23 +13010,32 @@ func (sh *strictHandler) LoginGithub(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Logout operation middleware
-func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
-	var request LogoutRequestObject
+// ReportChangeGovernanceFeedback operation middleware
+func (sh *strictHandler) ReportChangeGovernanceFeedback(w http.ResponseWriter, r *http.Request, packageId ChangeGovernancePackageID) {
+	var request ReportChangeGovernanceFeedbackRequestObject
+
+	request.PackageId = packageId
+
+	var body ReportChangeGovernanceFeedbackJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+		return sh.ssi.ReportChangeGovernanceFeedback(ctx, request.(ReportChangeGovernanceFeedbackRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Logout")
+		handler = middleware(handler, "ReportChangeGovernanceFeedback")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LogoutResponseObject); ok {
-		if err := validResponse.VisitLogoutResponse(w); err != nil {
+	} else if validResponse, ok := response.(ReportChangeGovernanceFeedbackResponseObject); ok {
+		if err := validResponse.VisitReportChangeGovernanceFeedbackResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9014,23 +13043,32 @@ func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetMe operation middleware
-func (sh *strictHandler) GetMe(w http.ResponseWriter, r *http.Request) {
-	var request GetMeRequestObject
+// SubmitChangeGovernanceReleaseReadinessDecision operation middleware
+func (sh *strictHandler) SubmitChangeGovernanceReleaseReadinessDecision(w http.ResponseWriter, r *http.Request, packageId ChangeGovernancePackageID) {
+	var request SubmitChangeGovernanceReleaseReadinessDecisionRequestObject
+
+	request.PackageId = packageId
+
+	var body SubmitChangeGovernanceReleaseReadinessDecisionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMe(ctx, request.(GetMeRequestObject))
+		return sh.ssi.SubmitChangeGovernanceReleaseReadinessDecision(ctx, request.(SubmitChangeGovernanceReleaseReadinessDecisionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMe")
+		handler = middleware(handler, "SubmitChangeGovernanceReleaseReadinessDecision")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetMeResponseObject); ok {
-		if err := validResponse.VisitGetMeResponse(w); err != nil {
+	} else if validResponse, ok := response.(SubmitChangeGovernanceReleaseReadinessDecisionResponseObject); ok {
+		if err := validResponse.VisitSubmitChangeGovernanceReleaseReadinessDecisionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9038,13 +13076,13 @@ func (sh *strictHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// McpApproverCallback operation middleware
-func (sh *strictHandler) McpApproverCallback(w http.ResponseWriter, r *http.Request, params McpApproverCallbackParams) {
-	var request McpApproverCallbackRequestObject
+// SubmitChangeGovernanceWaiverDecision operation middleware
+func (sh *strictHandler) SubmitChangeGovernanceWaiverDecision(w http.ResponseWriter, r *http.Request, packageId ChangeGovernancePackageID) {
+	var request SubmitChangeGovernanceWaiverDecisionRequestObject
 
-	request.Params = params
+	request.PackageId = packageId
 
-	var body McpApproverCallbackJSONRequestBody
+	var body SubmitChangeGovernanceWaiverDecisionJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9052,18 +13090,18 @@ func (sh *strictHandler) McpApproverCallback(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.McpApproverCallback(ctx, request.(McpApproverCallbackRequestObject))
+		return sh.ssi.SubmitChangeGovernanceWaiverDecision(ctx, request.(SubmitChangeGovernanceWaiverDecisionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "McpApproverCallback")
+		handler = middleware(handler, "SubmitChangeGovernanceWaiverDecision")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(McpApproverCallbackResponseObject); ok {
-		if err := validResponse.VisitMcpApproverCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(SubmitChangeGovernanceWaiverDecisionResponseObject); ok {
+		if err := validResponse.VisitSubmitChangeGovernanceWaiverDecisionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9071,13 +13109,13 @@ func (sh *strictHandler) McpApproverCallback(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// McpExecutorCallback operation middleware
-func (sh *strictHandler) McpExecutorCallback(w http.ResponseWriter, r *http.Request, params McpExecutorCallbackParams) {
-	var request McpExecutorCallbackRequestObject
+// SubmitMissionControlCommand operation middleware
+func (sh *strictHandler) SubmitMissionControlCommand(w http.ResponseWriter, r *http.Request, params SubmitMissionControlCommandParams) {
+	var request SubmitMissionControlCommandRequestObject
 
 	request.Params = params
 
-	var body McpExecutorCallbackJSONRequestBody
+	var body SubmitMissionControlCommandJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9085,18 +13123,18 @@ func (sh *strictHandler) McpExecutorCallback(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.McpExecutorCallback(ctx, request.(McpExecutorCallbackRequestObject))
+		return sh.ssi.SubmitMissionControlCommand(ctx, request.(SubmitMissionControlCommandRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "McpExecutorCallback")
+		handler = middleware(handler, "SubmitMissionControlCommand")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(McpExecutorCallbackResponseObject); ok {
-		if err := validResponse.VisitMcpExecutorCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(SubmitMissionControlCommandResponseObject); ok {
+		if err := validResponse.VisitSubmitMissionControlCommandResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9104,13 +13142,37 @@ func (sh *strictHandler) McpExecutorCallback(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// McpInteractionCallback operation middleware
-func (sh *strictHandler) McpInteractionCallback(w http.ResponseWriter, r *http.Request, params McpInteractionCallbackParams) {
-	var request McpInteractionCallbackRequestObject
+// GetMissionControlCommand operation middleware
+func (sh *strictHandler) GetMissionControlCommand(w http.ResponseWriter, r *http.Request, commandId MissionControlCommandID) {
+	var request GetMissionControlCommandRequestObject
 
-	request.Params = params
+	request.CommandId = commandId
 
-	var body McpInteractionCallbackJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMissionControlCommand(ctx, request.(GetMissionControlCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMissionControlCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMissionControlCommandResponseObject); ok {
+		if err := validResponse.VisitGetMissionControlCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PreviewMissionControlLaunch operation middleware
+func (sh *strictHandler) PreviewMissionControlLaunch(w http.ResponseWriter, r *http.Request) {
+	var request PreviewMissionControlLaunchRequestObject
+
+	var body PreviewMissionControlLaunchJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9118,18 +13180,18 @@ func (sh *strictHandler) McpInteractionCallback(w http.ResponseWriter, r *http.R
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.McpInteractionCallback(ctx, request.(McpInteractionCallbackRequestObject))
+		return sh.ssi.PreviewMissionControlLaunch(ctx, request.(PreviewMissionControlLaunchRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "McpInteractionCallback")
+		handler = middleware(handler, "PreviewMissionControlLaunch")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(McpInteractionCallbackResponseObject); ok {
-		if err := validResponse.VisitMcpInteractionCallbackResponse(w); err != nil {
+	} else if validResponse, ok := response.(PreviewMissionControlLaunchResponseObject); ok {
+		if err := validResponse.VisitPreviewMissionControlLaunchResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9137,25 +13199,26 @@ func (sh *strictHandler) McpInteractionCallback(w http.ResponseWriter, r *http.R
 	}
 }
 
-// ListPendingApprovals operation middleware
-func (sh *strictHandler) ListPendingApprovals(w http.ResponseWriter, r *http.Request, params ListPendingApprovalsParams) {
-	var request ListPendingApprovalsRequestObject
+// GetMissionControlNode operation middleware
+func (sh *strictHandler) GetMissionControlNode(w http.ResponseWriter, r *http.Request, nodeKind GetMissionControlNodeParamsNodeKind, nodePublicId MissionControlNodePublicID) {
+	var request GetMissionControlNodeRequestObject
 
-	request.Params = params
+	request.NodeKind = nodeKind
+	request.NodePublicId = nodePublicId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListPendingApprovals(ctx, request.(ListPendingApprovalsRequestObject))
+		return sh.ssi.GetMissionControlNode(ctx, request.(GetMissionControlNodeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListPendingApprovals")
+		handler = middleware(handler, "GetMissionControlNode")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListPendingApprovalsResponseObject); ok {
-		if err := validResponse.VisitListPendingApprovalsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetMissionControlNodeResponseObject); ok {
+		if err := validResponse.VisitGetMissionControlNodeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9163,32 +13226,53 @@ func (sh *strictHandler) ListPendingApprovals(w http.ResponseWriter, r *http.Req
 	}
 }
 
-// ResolveApprovalDecision operation middleware
-func (sh *strictHandler) ResolveApprovalDecision(w http.ResponseWriter, r *http.Request, approvalRequestId ApprovalRequestID) {
-	var request ResolveApprovalDecisionRequestObject
+// ListMissionControlNodeActivity operation middleware
+func (sh *strictHandler) ListMissionControlNodeActivity(w http.ResponseWriter, r *http.Request, nodeKind ListMissionControlNodeActivityParamsNodeKind, nodePublicId MissionControlNodePublicID, params ListMissionControlNodeActivityParams) {
+	var request ListMissionControlNodeActivityRequestObject
 
-	request.ApprovalRequestId = approvalRequestId
+	request.NodeKind = nodeKind
+	request.NodePublicId = nodePublicId
+	request.Params = params
 
-	var body ResolveApprovalDecisionJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListMissionControlNodeActivity(ctx, request.(ListMissionControlNodeActivityRequestObject))
 	}
-	request.Body = &body
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListMissionControlNodeActivity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListMissionControlNodeActivityResponseObject); ok {
+		if err := validResponse.VisitListMissionControlNodeActivityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MissionControlRealtime operation middleware
+func (sh *strictHandler) MissionControlRealtime(w http.ResponseWriter, r *http.Request, params MissionControlRealtimeParams) {
+	var request MissionControlRealtimeRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ResolveApprovalDecision(ctx, request.(ResolveApprovalDecisionRequestObject))
+		return sh.ssi.MissionControlRealtime(ctx, request.(MissionControlRealtimeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ResolveApprovalDecision")
+		handler = middleware(handler, "MissionControlRealtime")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ResolveApprovalDecisionResponseObject); ok {
-		if err := validResponse.VisitResolveApprovalDecisionResponse(w); err != nil {
+	} else if validResponse, ok := response.(MissionControlRealtimeResponseObject); ok {
+		if err := validResponse.VisitMissionControlRealtimeResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9196,25 +13280,25 @@ func (sh *strictHandler) ResolveApprovalDecision(w http.ResponseWriter, r *http.
 	}
 }
 
-// ListDocsetGroups operation middleware
-func (sh *strictHandler) ListDocsetGroups(w http.ResponseWriter, r *http.Request, params ListDocsetGroupsParams) {
-	var request ListDocsetGroupsRequestObject
+// ListMissionControlVoiceCandidates operation middleware
+func (sh *strictHandler) ListMissionControlVoiceCandidates(w http.ResponseWriter, r *http.Request, params ListMissionControlVoiceCandidatesParams) {
+	var request ListMissionControlVoiceCandidatesRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListDocsetGroups(ctx, request.(ListDocsetGroupsRequestObject))
+		return sh.ssi.ListMissionControlVoiceCandidates(ctx, request.(ListMissionControlVoiceCandidatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListDocsetGroups")
+		handler = middleware(handler, "ListMissionControlVoiceCandidates")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListDocsetGroupsResponseObject); ok {
-		if err := validResponse.VisitListDocsetGroupsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListMissionControlVoiceCandidatesResponseObject); ok {
+		if err := validResponse.VisitListMissionControlVoiceCandidatesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9222,11 +13306,13 @@ func (sh *strictHandler) ListDocsetGroups(w http.ResponseWriter, r *http.Request
 	}
 }
 
-// ExecuteNextStepAction operation middleware
-func (sh *strictHandler) ExecuteNextStepAction(w http.ResponseWriter, r *http.Request) {
-	var request ExecuteNextStepActionRequestObject
+// PromoteMissionControlVoiceCandidate operation middleware
+func (sh *strictHandler) PromoteMissionControlVoiceCandidate(w http.ResponseWriter, r *http.Request, candidateId MissionControlVoiceCandidateID) {
+	var request PromoteMissionControlVoiceCandidateRequestObject
 
-	var body ExecuteNextStepActionJSONRequestBody
+	request.CandidateId = candidateId
+
+	var body PromoteMissionControlVoiceCandidateJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9234,18 +13320,18 @@ func (sh *strictHandler) ExecuteNextStepAction(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ExecuteNextStepAction(ctx, request.(ExecuteNextStepActionRequestObject))
+		return sh.ssi.PromoteMissionControlVoiceCandidate(ctx, request.(PromoteMissionControlVoiceCandidateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ExecuteNextStepAction")
+		handler = middleware(handler, "PromoteMissionControlVoiceCandidate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ExecuteNextStepActionResponseObject); ok {
-		if err := validResponse.VisitExecuteNextStepActionResponse(w); err != nil {
+	} else if validResponse, ok := response.(PromoteMissionControlVoiceCandidateResponseObject); ok {
+		if err := validResponse.VisitPromoteMissionControlVoiceCandidateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9253,11 +13339,13 @@ func (sh *strictHandler) ExecuteNextStepAction(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// PreviewNextStepAction operation middleware
-func (sh *strictHandler) PreviewNextStepAction(w http.ResponseWriter, r *http.Request) {
-	var request PreviewNextStepActionRequestObject
+// RejectMissionControlVoiceCandidate operation middleware
+func (sh *strictHandler) RejectMissionControlVoiceCandidate(w http.ResponseWriter, r *http.Request, candidateId MissionControlVoiceCandidateID) {
+	var request RejectMissionControlVoiceCandidateRequestObject
 
-	var body PreviewNextStepActionJSONRequestBody
+	request.CandidateId = candidateId
+
+	var body RejectMissionControlVoiceCandidateJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9265,18 +13353,18 @@ func (sh *strictHandler) PreviewNextStepAction(w http.ResponseWriter, r *http.Re
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PreviewNextStepAction(ctx, request.(PreviewNextStepActionRequestObject))
+		return sh.ssi.RejectMissionControlVoiceCandidate(ctx, request.(RejectMissionControlVoiceCandidateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PreviewNextStepAction")
+		handler = middleware(handler, "RejectMissionControlVoiceCandidate")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PreviewNextStepActionResponseObject); ok {
-		if err := validResponse.VisitPreviewNextStepActionResponse(w); err != nil {
+	} else if validResponse, ok := response.(RejectMissionControlVoiceCandidateResponseObject); ok {
+		if err := validResponse.VisitRejectMissionControlVoiceCandidateResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9284,13 +13372,63 @@ func (sh *strictHandler) PreviewNextStepAction(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// SubmitMissionControlCommand operation middleware
-func (sh *strictHandler) SubmitMissionControlCommand(w http.ResponseWriter, r *http.Request, params SubmitMissionControlCommandParams) {
-	var request SubmitMissionControlCommandRequestObject
+// GetMissionControlWorkspace operation middleware
+func (sh *strictHandler) GetMissionControlWorkspace(w http.ResponseWriter, r *http.Request, params GetMissionControlWorkspaceParams) {
+	var request GetMissionControlWorkspaceRequestObject
 
 	request.Params = params
 
-	var body SubmitMissionControlCommandJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMissionControlWorkspace(ctx, request.(GetMissionControlWorkspaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMissionControlWorkspace")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMissionControlWorkspaceResponseObject); ok {
+		if err := validResponse.VisitGetMissionControlWorkspaceResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListProjects operation middleware
+func (sh *strictHandler) ListProjects(w http.ResponseWriter, r *http.Request, params ListProjectsParams) {
+	var request ListProjectsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListProjects(ctx, request.(ListProjectsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListProjects")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListProjectsResponseObject); ok {
+		if err := validResponse.VisitListProjectsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpsertProject operation middleware
+func (sh *strictHandler) UpsertProject(w http.ResponseWriter, r *http.Request) {
+	var request UpsertProjectRequestObject
+
+	var body UpsertProjectJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9298,18 +13436,18 @@ func (sh *strictHandler) SubmitMissionControlCommand(w http.ResponseWriter, r *h
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SubmitMissionControlCommand(ctx, request.(SubmitMissionControlCommandRequestObject))
+		return sh.ssi.UpsertProject(ctx, request.(UpsertProjectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SubmitMissionControlCommand")
+		handler = middleware(handler, "UpsertProject")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SubmitMissionControlCommandResponseObject); ok {
-		if err := validResponse.VisitSubmitMissionControlCommandResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpsertProjectResponseObject); ok {
+		if err := validResponse.VisitUpsertProjectResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9317,25 +13455,25 @@ func (sh *strictHandler) SubmitMissionControlCommand(w http.ResponseWriter, r *h
 	}
 }
 
-// GetMissionControlCommand operation middleware
-func (sh *strictHandler) GetMissionControlCommand(w http.ResponseWriter, r *http.Request, commandId MissionControlCommandID) {
-	var request GetMissionControlCommandRequestObject
+// DeleteProject operation middleware
+func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
+	var request DeleteProjectRequestObject
 
-	request.CommandId = commandId
+	request.ProjectId = projectId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMissionControlCommand(ctx, request.(GetMissionControlCommandRequestObject))
+		return sh.ssi.DeleteProject(ctx, request.(DeleteProjectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMissionControlCommand")
+		handler = middleware(handler, "DeleteProject")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetMissionControlCommandResponseObject); ok {
-		if err := validResponse.VisitGetMissionControlCommandResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteProjectResponseObject); ok {
+		if err := validResponse.VisitDeleteProjectResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9343,30 +13481,25 @@ func (sh *strictHandler) GetMissionControlCommand(w http.ResponseWriter, r *http
 	}
 }
 
-// PreviewMissionControlLaunch operation middleware
-func (sh *strictHandler) PreviewMissionControlLaunch(w http.ResponseWriter, r *http.Request) {
-	var request PreviewMissionControlLaunchRequestObject
+// GetProject operation middleware
+func (sh *strictHandler) GetProject(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
+	var request GetProjectRequestObject
 
-	var body PreviewMissionControlLaunchJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.ProjectId = projectId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.PreviewMissionControlLaunch(ctx, request.(PreviewMissionControlLaunchRequestObject))
+		return sh.ssi.GetProject(ctx, request.(GetProjectRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PreviewMissionControlLaunch")
+		handler = middleware(handler, "GetProject")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(PreviewMissionControlLaunchResponseObject); ok {
-		if err := validResponse.VisitPreviewMissionControlLaunchResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetProjectResponseObject); ok {
+		if err := validResponse.VisitGetProjectResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9374,26 +13507,32 @@ func (sh *strictHandler) PreviewMissionControlLaunch(w http.ResponseWriter, r *h
 	}
 }
 
-// GetMissionControlNode operation middleware
-func (sh *strictHandler) GetMissionControlNode(w http.ResponseWriter, r *http.Request, nodeKind GetMissionControlNodeParamsNodeKind, nodePublicId MissionControlNodePublicID) {
-	var request GetMissionControlNodeRequestObject
+// ImportDocset operation middleware
+func (sh *strictHandler) ImportDocset(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
+	var request ImportDocsetRequestObject
 
-	request.NodeKind = nodeKind
-	request.NodePublicId = nodePublicId
+	request.ProjectId = projectId
+
+	var body ImportDocsetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMissionControlNode(ctx, request.(GetMissionControlNodeRequestObject))
+		return sh.ssi.ImportDocset(ctx, request.(ImportDocsetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMissionControlNode")
+		handler = middleware(handler, "ImportDocset")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetMissionControlNodeResponseObject); ok {
-		if err := validResponse.VisitGetMissionControlNodeResponse(w); err != nil {
+	} else if validResponse, ok := response.(ImportDocsetResponseObject); ok {
+		if err := validResponse.VisitImportDocsetResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9401,27 +13540,32 @@ func (sh *strictHandler) GetMissionControlNode(w http.ResponseWriter, r *http.Re
 	}
 }
 
-// ListMissionControlNodeActivity operation middleware
-func (sh *strictHandler) ListMissionControlNodeActivity(w http.ResponseWriter, r *http.Request, nodeKind ListMissionControlNodeActivityParamsNodeKind, nodePublicId MissionControlNodePublicID, params ListMissionControlNodeActivityParams) {
-	var request ListMissionControlNodeActivityRequestObject
+// SyncDocset operation middleware
+func (sh *strictHandler) SyncDocset(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
+	var request SyncDocsetRequestObject
 
-	request.NodeKind = nodeKind
-	request.NodePublicId = nodePublicId
-	request.Params = params
+	request.ProjectId = projectId
+
+	var body SyncDocsetJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListMissionControlNodeActivity(ctx, request.(ListMissionControlNodeActivityRequestObject))
+		return sh.ssi.SyncDocset(ctx, request.(SyncDocsetRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListMissionControlNodeActivity")
+		handler = middleware(handler, "SyncDocset")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListMissionControlNodeActivityResponseObject); ok {
-		if err := validResponse.VisitListMissionControlNodeActivityResponse(w); err != nil {
+	} else if validResponse, ok := response.(SyncDocsetResponseObject); ok {
+		if err := validResponse.VisitSyncDocsetResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9429,25 +13573,25 @@ func (sh *strictHandler) ListMissionControlNodeActivity(w http.ResponseWriter, r
 	}
 }
 
-// MissionControlRealtime operation middleware
-func (sh *strictHandler) MissionControlRealtime(w http.ResponseWriter, r *http.Request, params MissionControlRealtimeParams) {
-	var request MissionControlRealtimeRequestObject
+// GetProjectGitHubTokens operation middleware
+func (sh *strictHandler) GetProjectGitHubTokens(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
+	var request GetProjectGitHubTokensRequestObject
 
-	request.Params = params
+	request.ProjectId = projectId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.MissionControlRealtime(ctx, request.(MissionControlRealtimeRequestObject))
+		return sh.ssi.GetProjectGitHubTokens(ctx, request.(GetProjectGitHubTokensRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MissionControlRealtime")
+		handler = middleware(handler, "GetProjectGitHubTokens")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(MissionControlRealtimeResponseObject); ok {
-		if err := validResponse.VisitMissionControlRealtimeResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetProjectGitHubTokensResponseObject); ok {
+		if err := validResponse.VisitGetProjectGitHubTokensResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9455,25 +13599,32 @@ func (sh *strictHandler) MissionControlRealtime(w http.ResponseWriter, r *http.R
 	}
 }
 
-// GetMissionControlWorkspace operation middleware
-func (sh *strictHandler) GetMissionControlWorkspace(w http.ResponseWriter, r *http.Request, params GetMissionControlWorkspaceParams) {
-	var request GetMissionControlWorkspaceRequestObject
+// UpsertProjectGitHubTokens operation middleware
+func (sh *strictHandler) UpsertProjectGitHubTokens(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
+	var request UpsertProjectGitHubTokensRequestObject
 
-	request.Params = params
+	request.ProjectId = projectId
+
+	var body UpsertProjectGitHubTokensJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMissionControlWorkspace(ctx, request.(GetMissionControlWorkspaceRequestObject))
+		return sh.ssi.UpsertProjectGitHubTokens(ctx, request.(UpsertProjectGitHubTokensRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMissionControlWorkspace")
+		handler = middleware(handler, "UpsertProjectGitHubTokens")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetMissionControlWorkspaceResponseObject); ok {
-		if err := validResponse.VisitGetMissionControlWorkspaceResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpsertProjectGitHubTokensResponseObject); ok {
+		if err := validResponse.VisitUpsertProjectGitHubTokensResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9481,25 +13632,25 @@ func (sh *strictHandler) GetMissionControlWorkspace(w http.ResponseWriter, r *ht
 	}
 }
 
-// ListProjects operation middleware
-func (sh *strictHandler) ListProjects(w http.ResponseWriter, r *http.Request, params ListProjectsParams) {
-	var request ListProjectsRequestObject
+// ListMCPApprovalPolicies operation middleware
+func (sh *strictHandler) ListMCPApprovalPolicies(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
+	var request ListMCPApprovalPoliciesRequestObject
 
-	request.Params = params
+	request.ProjectId = projectId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListProjects(ctx, request.(ListProjectsRequestObject))
+		return sh.ssi.ListMCPApprovalPolicies(ctx, request.(ListMCPApprovalPoliciesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListProjects")
+		handler = middleware(handler, "ListMCPApprovalPolicies")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListProjectsResponseObject); ok {
-		if err := validResponse.VisitListProjectsResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListMCPApprovalPoliciesResponseObject); ok {
+		if err := validResponse.VisitListMCPApprovalPoliciesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9507,11 +13658,13 @@ func (sh *strictHandler) ListProjects(w http.ResponseWriter, r *http.Request, pa
 	}
 }
 
-// UpsertProject operation middleware
-func (sh *strictHandler) UpsertProject(w http.ResponseWriter, r *http.Request) {
-	var request UpsertProjectRequestObject
+// UpsertMCPApprovalPolicy operation middleware
+func (sh *strictHandler) UpsertMCPApprovalPolicy(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
+	var request UpsertMCPApprovalPolicyRequestObject
 
-	var body UpsertProjectJSONRequestBody
+	request.ProjectId = projectId
+
+	var body UpsertMCPApprovalPolicyJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9519,18 +13672,18 @@ func (sh *strictHandler) UpsertProject(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpsertProject(ctx, request.(UpsertProjectRequestObject))
+		return sh.ssi.UpsertMCPApprovalPolicy(ctx, request.(UpsertMCPApprovalPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpsertProject")
+		handler = middleware(handler, "UpsertMCPApprovalPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpsertProjectResponseObject); ok {
-		if err := validResponse.VisitUpsertProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpsertMCPApprovalPolicyResponseObject); ok {
+		if err := validResponse.VisitUpsertMCPApprovalPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9538,25 +13691,26 @@ func (sh *strictHandler) UpsertProject(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteProject operation middleware
-func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
-	var request DeleteProjectRequestObject
+// DeleteMCPApprovalPolicy operation middleware
+func (sh *strictHandler) DeleteMCPApprovalPolicy(w http.ResponseWriter, r *http.Request, projectId ProjectID, policyId MCPApprovalPolicyID) {
+	var request DeleteMCPApprovalPolicyRequestObject
 
 	request.ProjectId = projectId
+	request.PolicyId = policyId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProject(ctx, request.(DeleteProjectRequestObject))
+		return sh.ssi.DeleteMCPApprovalPolicy(ctx, request.(DeleteMCPApprovalPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProject")
+		handler = middleware(handler, "DeleteMCPApprovalPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteProjectResponseObject); ok {
-		if err := validResponse.VisitDeleteProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteMCPApprovalPolicyResponseObject); ok {
+		if err := validResponse.VisitDeleteMCPApprovalPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9564,25 +13718,26 @@ func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
-// GetProject operation middleware
-func (sh *strictHandler) GetProject(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
-	var request GetProjectRequestObject
+// ListProjectMembers operation middleware
+func (sh *strictHandler) ListProjectMembers(w http.ResponseWriter, r *http.Request, projectId ProjectID, params ListProjectMembersParams) {
+	var request ListProjectMembersRequestObject
 
 	request.ProjectId = projectId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProject(ctx, request.(GetProjectRequestObject))
+		return sh.ssi.ListProjectMembers(ctx, request.(ListProjectMembersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProject")
+		handler = middleware(handler, "ListProjectMembers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetProjectResponseObject); ok {
-		if err := validResponse.VisitGetProjectResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListProjectMembersResponseObject); ok {
+		if err := validResponse.VisitListProjectMembersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9590,13 +13745,13 @@ func (sh *strictHandler) GetProject(w http.ResponseWriter, r *http.Request, proj
 	}
 }
 
-// ImportDocset operation middleware
-func (sh *strictHandler) ImportDocset(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
-	var request ImportDocsetRequestObject
+// UpsertProjectMember operation middleware
+func (sh *strictHandler) UpsertProjectMember(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
+	var request UpsertProjectMemberRequestObject
 
 	request.ProjectId = projectId
 
-	var body ImportDocsetJSONRequestBody
+	var body UpsertProjectMemberJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9604,18 +13759,18 @@ func (sh *strictHandler) ImportDocset(w http.ResponseWriter, r *http.Request, pr
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ImportDocset(ctx, request.(ImportDocsetRequestObject))
+		return sh.ssi.UpsertProjectMember(ctx, request.(UpsertProjectMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ImportDocset")
+		handler = middleware(handler, "UpsertProjectMember")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ImportDocsetResponseObject); ok {
-		if err := validResponse.VisitImportDocsetResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpsertProjectMemberResponseObject); ok {
+		if err := validResponse.VisitUpsertProjectMemberResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9623,13 +13778,41 @@ func (sh *strictHandler) ImportDocset(w http.ResponseWriter, r *http.Request, pr
 	}
 }
 
-// SyncDocset operation middleware
-func (sh *strictHandler) SyncDocset(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
-	var request SyncDocsetRequestObject
+// DeleteProjectMember operation middleware
+func (sh *strictHandler) DeleteProjectMember(w http.ResponseWriter, r *http.Request, projectId ProjectID, userId UserID) {
+	var request DeleteProjectMemberRequestObject
 
 	request.ProjectId = projectId
+	request.UserId = userId
 
-	var body SyncDocsetJSONRequestBody
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteProjectMember(ctx, request.(DeleteProjectMemberRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteProjectMember")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteProjectMemberResponseObject); ok {
+		if err := validResponse.VisitDeleteProjectMemberResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetProjectMemberLearningModeOverride operation middleware
+func (sh *strictHandler) SetProjectMemberLearningModeOverride(w http.ResponseWriter, r *http.Request, projectId ProjectID, userId UserID) {
+	var request SetProjectMemberLearningModeOverrideRequestObject
+
+	request.ProjectId = projectId
+	request.UserId = userId
+
+	var body SetProjectMemberLearningModeOverrideJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9637,18 +13820,18 @@ func (sh *strictHandler) SyncDocset(w http.ResponseWriter, r *http.Request, proj
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SyncDocset(ctx, request.(SyncDocsetRequestObject))
+		return sh.ssi.SetProjectMemberLearningModeOverride(ctx, request.(SetProjectMemberLearningModeOverrideRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SyncDocset")
+		handler = middleware(handler, "SetProjectMemberLearningModeOverride")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SyncDocsetResponseObject); ok {
-		if err := validResponse.VisitSyncDocsetResponse(w); err != nil {
+	} else if validResponse, ok := response.(SetProjectMemberLearningModeOverrideResponseObject); ok {
+		if err := validResponse.VisitSetProjectMemberLearningModeOverrideResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9656,25 +13839,26 @@ func (sh *strictHandler) SyncDocset(w http.ResponseWriter, r *http.Request, proj
 	}
 }
 
-// GetProjectGitHubTokens operation middleware
-func (sh *strictHandler) GetProjectGitHubTokens(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
-	var request GetProjectGitHubTokensRequestObject
+// ListProjectRepositories operation middleware
+func (sh *strictHandler) ListProjectRepositories(w http.ResponseWriter, r *http.Request, projectId ProjectID, params ListProjectRepositoriesParams) {
+	var request ListProjectRepositoriesRequestObject
 
 	request.ProjectId = projectId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetProjectGitHubTokens(ctx, request.(GetProjectGitHubTokensRequestObject))
+		return sh.ssi.ListProjectRepositories(ctx, request.(ListProjectRepositoriesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetProjectGitHubTokens")
+		handler = middleware(handler, "ListProjectRepositories")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetProjectGitHubTokensResponseObject); ok {
-		if err := validResponse.VisitGetProjectGitHubTokensResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListProjectRepositoriesResponseObject); ok {
+		if err := validResponse.VisitListProjectRepositoriesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9682,13 +13866,13 @@ func (sh *strictHandler) GetProjectGitHubTokens(w http.ResponseWriter, r *http.R
 	}
 }
 
-// UpsertProjectGitHubTokens operation middleware
-func (sh *strictHandler) UpsertProjectGitHubTokens(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
-	var request UpsertProjectGitHubTokensRequestObject
+// UpsertProjectRepository operation middleware
+func (sh *strictHandler) UpsertProjectRepository(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
+	var request UpsertProjectRepositoryRequestObject
 
 	request.ProjectId = projectId
 
-	var body UpsertProjectGitHubTokensJSONRequestBody
+	var body UpsertProjectRepositoryJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9696,18 +13880,18 @@ func (sh *strictHandler) UpsertProjectGitHubTokens(w http.ResponseWriter, r *htt
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpsertProjectGitHubTokens(ctx, request.(UpsertProjectGitHubTokensRequestObject))
+		return sh.ssi.UpsertProjectRepository(ctx, request.(UpsertProjectRepositoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpsertProjectGitHubTokens")
+		handler = middleware(handler, "UpsertProjectRepository")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpsertProjectGitHubTokensResponseObject); ok {
-		if err := validResponse.VisitUpsertProjectGitHubTokensResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpsertProjectRepositoryResponseObject); ok {
+		if err := validResponse.VisitUpsertProjectRepositoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9715,26 +13899,26 @@ func (sh *strictHandler) UpsertProjectGitHubTokens(w http.ResponseWriter, r *htt
 	}
 }
 
-// ListProjectMembers operation middleware
-func (sh *strictHandler) ListProjectMembers(w http.ResponseWriter, r *http.Request, projectId ProjectID, params ListProjectMembersParams) {
-	var request ListProjectMembersRequestObject
+// DeleteProjectRepository operation middleware
+func (sh *strictHandler) DeleteProjectRepository(w http.ResponseWriter, r *http.Request, projectId ProjectID, repositoryId string) {
+	var request DeleteProjectRepositoryRequestObject
 
 	request.ProjectId = projectId
-	request.Params = params
+	request.RepositoryId = repositoryId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListProjectMembers(ctx, request.(ListProjectMembersRequestObject))
+		return sh.ssi.DeleteProjectRepository(ctx, request.(DeleteProjectRepositoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListProjectMembers")
+		handler = middleware(handler, "DeleteProjectRepository")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListProjectMembersResponseObject); ok {
-		if err := validResponse.VisitListProjectMembersResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteProjectRepositoryResponseObject); ok {
+		if err := validResponse.VisitDeleteProjectRepositoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9742,13 +13926,14 @@ func (sh *strictHandler) ListProjectMembers(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// UpsertProjectMember operation middleware
-func (sh *strictHandler) UpsertProjectMember(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
-	var request UpsertProjectMemberRequestObject
+// UpsertRepositoryBotParams operation middleware
+func (sh *strictHandler) UpsertRepositoryBotParams(w http.ResponseWriter, r *http.Request, projectId ProjectID, repositoryId string) {
+	var request UpsertRepositoryBotParamsRequestObject
 
 	request.ProjectId = projectId
+	request.RepositoryId = repositoryId
 
-	var body UpsertProjectMemberJSONRequestBody
+	var body UpsertRepositoryBotParamsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9756,18 +13941,18 @@ func (sh *strictHandler) UpsertProjectMember(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpsertProjectMember(ctx, request.(UpsertProjectMemberRequestObject))
+		return sh.ssi.UpsertRepositoryBotParams(ctx, request.(UpsertRepositoryBotParamsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpsertProjectMember")
+		handler = middleware(handler, "UpsertRepositoryBotParams")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpsertProjectMemberResponseObject); ok {
-		if err := validResponse.VisitUpsertProjectMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpsertRepositoryBotParamsResponseObject); ok {
+		if err := validResponse.VisitUpsertRepositoryBotParamsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9775,26 +13960,26 @@ func (sh *strictHandler) UpsertProjectMember(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// DeleteProjectMember operation middleware
-func (sh *strictHandler) DeleteProjectMember(w http.ResponseWriter, r *http.Request, projectId ProjectID, userId UserID) {
-	var request DeleteProjectMemberRequestObject
+// RunRepositoryPreflight operation middleware
+func (sh *strictHandler) RunRepositoryPreflight(w http.ResponseWriter, r *http.Request, projectId ProjectID, repositoryId string) {
+	var request RunRepositoryPreflightRequestObject
 
 	request.ProjectId = projectId
-	request.UserId = userId
+	request.RepositoryId = repositoryId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProjectMember(ctx, request.(DeleteProjectMemberRequestObject))
+		return sh.ssi.RunRepositoryPreflight(ctx, request.(RunRepositoryPreflightRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProjectMember")
+		handler = middleware(handler, "RunRepositoryPreflight")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteProjectMemberResponseObject); ok {
-		if err := validResponse.VisitDeleteProjectMemberResponse(w); err != nil {
+	} else if validResponse, ok := response.(RunRepositoryPreflightResponseObject); ok {
+		if err := validResponse.VisitRunRepositoryPreflightResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9802,33 +13987,25 @@ func (sh *strictHandler) DeleteProjectMember(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// SetProjectMemberLearningModeOverride operation middleware
-func (sh *strictHandler) SetProjectMemberLearningModeOverride(w http.ResponseWriter, r *http.Request, projectId ProjectID, userId UserID) {
-	var request SetProjectMemberLearningModeOverrideRequestObject
+// ListProjectRoles operation middleware
+func (sh *strictHandler) ListProjectRoles(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
+	var request ListProjectRolesRequestObject
 
 	request.ProjectId = projectId
-	request.UserId = userId
-
-	var body SetProjectMemberLearningModeOverrideJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SetProjectMemberLearningModeOverride(ctx, request.(SetProjectMemberLearningModeOverrideRequestObject))
+		return sh.ssi.ListProjectRoles(ctx, request.(ListProjectRolesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SetProjectMemberLearningModeOverride")
+		handler = middleware(handler, "ListProjectRoles")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SetProjectMemberLearningModeOverrideResponseObject); ok {
-		if err := validResponse.VisitSetProjectMemberLearningModeOverrideResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListProjectRolesResponseObject); ok {
+		if err := validResponse.VisitListProjectRolesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9836,26 +14013,26 @@ func (sh *strictHandler) SetProjectMemberLearningModeOverride(w http.ResponseWri
 	}
 }
 
-// ListProjectRepositories operation middleware
-func (sh *strictHandler) ListProjectRepositories(w http.ResponseWriter, r *http.Request, projectId ProjectID, params ListProjectRepositoriesParams) {
-	var request ListProjectRepositoriesRequestObject
+// DeleteProjectRole operation middleware
+func (sh *strictHandler) DeleteProjectRole(w http.ResponseWriter, r *http.Request, projectId ProjectID, roleName ProjectRoleName) {
+	var request DeleteProjectRoleRequestObject
 
 	request.ProjectId = projectId
-	request.Params = params
+	request.RoleName = roleName
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListProjectRepositories(ctx, request.(ListProjectRepositoriesRequestObject))
+		return sh.ssi.DeleteProjectRole(ctx, request.(DeleteProjectRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListProjectRepositories")
+		handler = middleware(handler, "DeleteProjectRole")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListProjectRepositoriesResponseObject); ok {
-		if err := validResponse.VisitListProjectRepositoriesResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteProjectRoleResponseObject); ok {
+		if err := validResponse.VisitDeleteProjectRoleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9863,13 +14040,14 @@ func (sh *strictHandler) ListProjectRepositories(w http.ResponseWriter, r *http.
 	}
 }
 
-// UpsertProjectRepository operation middleware
-func (sh *strictHandler) UpsertProjectRepository(w http.ResponseWriter, r *http.Request, projectId ProjectID) {
-	var request UpsertProjectRepositoryRequestObject
+// UpsertProjectRole operation middleware
+func (sh *strictHandler) UpsertProjectRole(w http.ResponseWriter, r *http.Request, projectId ProjectID, roleName ProjectRoleName) {
+	var request UpsertProjectRoleRequestObject
 
 	request.ProjectId = projectId
+	request.RoleName = roleName
 
-	var body UpsertProjectRepositoryJSONRequestBody
+	var body UpsertProjectRoleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9877,18 +14055,18 @@ func (sh *strictHandler) UpsertProjectRepository(w http.ResponseWriter, r *http.
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpsertProjectRepository(ctx, request.(UpsertProjectRepositoryRequestObject))
+		return sh.ssi.UpsertProjectRole(ctx, request.(UpsertProjectRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpsertProjectRepository")
+		handler = middleware(handler, "UpsertProjectRole")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpsertProjectRepositoryResponseObject); ok {
-		if err := validResponse.VisitUpsertProjectRepositoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpsertProjectRoleResponseObject); ok {
+		if err := validResponse.VisitUpsertProjectRoleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9896,26 +14074,25 @@ func (sh *strictHandler) UpsertProjectRepository(w http.ResponseWriter, r *http.
 	}
 }
 
-// DeleteProjectRepository operation middleware
-func (sh *strictHandler) DeleteProjectRepository(w http.ResponseWriter, r *http.Request, projectId ProjectID, repositoryId string) {
-	var request DeleteProjectRepositoryRequestObject
+// ListRetentionPolicies operation middleware
+func (sh *strictHandler) ListRetentionPolicies(w http.ResponseWriter, r *http.Request, params ListRetentionPoliciesParams) {
+	var request ListRetentionPoliciesRequestObject
 
-	request.ProjectId = projectId
-	request.RepositoryId = repositoryId
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteProjectRepository(ctx, request.(DeleteProjectRepositoryRequestObject))
+		return sh.ssi.ListRetentionPolicies(ctx, request.(ListRetentionPoliciesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteProjectRepository")
+		handler = middleware(handler, "ListRetentionPolicies")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteProjectRepositoryResponseObject); ok {
-		if err := validResponse.VisitDeleteProjectRepositoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListRetentionPoliciesResponseObject); ok {
+		if err := validResponse.VisitListRetentionPoliciesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9923,14 +14100,11 @@ func (sh *strictHandler) DeleteProjectRepository(w http.ResponseWriter, r *http.
 	}
 }
 
-// UpsertRepositoryBotParams operation middleware
-func (sh *strictHandler) UpsertRepositoryBotParams(w http.ResponseWriter, r *http.Request, projectId ProjectID, repositoryId string) {
-	var request UpsertRepositoryBotParamsRequestObject
-
-	request.ProjectId = projectId
-	request.RepositoryId = repositoryId
+// UpsertRetentionPolicy operation middleware
+func (sh *strictHandler) UpsertRetentionPolicy(w http.ResponseWriter, r *http.Request) {
+	var request UpsertRetentionPolicyRequestObject
 
-	var body UpsertRepositoryBotParamsJSONRequestBody
+	var body UpsertRetentionPolicyJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9938,18 +14112,18 @@ func (sh *strictHandler) UpsertRepositoryBotParams(w http.ResponseWriter, r *htt
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpsertRepositoryBotParams(ctx, request.(UpsertRepositoryBotParamsRequestObject))
+		return sh.ssi.UpsertRetentionPolicy(ctx, request.(UpsertRetentionPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpsertRepositoryBotParams")
+		handler = middleware(handler, "UpsertRetentionPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpsertRepositoryBotParamsResponseObject); ok {
-		if err := validResponse.VisitUpsertRepositoryBotParamsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpsertRetentionPolicyResponseObject); ok {
+		if err := validResponse.VisitUpsertRetentionPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9957,26 +14131,25 @@ func (sh *strictHandler) UpsertRepositoryBotParams(w http.ResponseWriter, r *htt
 	}
 }
 
-// RunRepositoryPreflight operation middleware
-func (sh *strictHandler) RunRepositoryPreflight(w http.ResponseWriter, r *http.Request, projectId ProjectID, repositoryId string) {
-	var request RunRepositoryPreflightRequestObject
+// DeleteRetentionPolicy operation middleware
+func (sh *strictHandler) DeleteRetentionPolicy(w http.ResponseWriter, r *http.Request, policyId RetentionPolicyID) {
+	var request DeleteRetentionPolicyRequestObject
 
-	request.ProjectId = projectId
-	request.RepositoryId = repositoryId
+	request.PolicyId = policyId
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RunRepositoryPreflight(ctx, request.(RunRepositoryPreflightRequestObject))
+		return sh.ssi.DeleteRetentionPolicy(ctx, request.(DeleteRetentionPolicyRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RunRepositoryPreflight")
+		handler = middleware(handler, "DeleteRetentionPolicy")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RunRepositoryPreflightResponseObject); ok {
-		if err := validResponse.VisitRunRepositoryPreflightResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteRetentionPolicyResponseObject); ok {
+		if err := validResponse.VisitDeleteRetentionPolicyResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -10229,6 +14402,37 @@ func (sh *strictHandler) RunRealtime(w http.ResponseWriter, r *http.Request, run
 	}
 }
 
+// PreviewRuntimeDeploy operation middleware
+func (sh *strictHandler) PreviewRuntimeDeploy(w http.ResponseWriter, r *http.Request) {
+	var request PreviewRuntimeDeployRequestObject
+
+	var body PreviewRuntimeDeployJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PreviewRuntimeDeploy(ctx, request.(PreviewRuntimeDeployRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PreviewRuntimeDeploy")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PreviewRuntimeDeployResponseObject); ok {
+		if err := validResponse.VisitPreviewRuntimeDeployResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // RuntimeDeployTasksRealtime operation middleware
 func (sh *strictHandler) RuntimeDeployTasksRealtime(w http.ResponseWriter, r *http.Request, params RuntimeDeployTasksRealtimeParams) {
 	var request RuntimeDeployTasksRealtimeRequestObject
@@ -10373,6 +14577,98 @@ func (sh *strictHandler) ListRuntimeErrors(w http.ResponseWriter, r *http.Reques
 	}
 }
 
+// ListRuntimeErrorGroups operation middleware
+func (sh *strictHandler) ListRuntimeErrorGroups(w http.ResponseWriter, r *http.Request, params ListRuntimeErrorGroupsParams) {
+	var request ListRuntimeErrorGroupsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ListRuntimeErrorGroups(ctx, request.(ListRuntimeErrorGroupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListRuntimeErrorGroups")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ListRuntimeErrorGroupsResponseObject); ok {
+		if err := validResponse.VisitListRuntimeErrorGroupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// EscalateRuntimeErrorGroup operation middleware
+func (sh *strictHandler) EscalateRuntimeErrorGroup(w http.ResponseWriter, r *http.Request, groupId RuntimeErrorGroupID) {
+	var request EscalateRuntimeErrorGroupRequestObject
+
+	request.GroupId = groupId
+
+	var body EscalateRuntimeErrorGroupJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.EscalateRuntimeErrorGroup(ctx, request.(EscalateRuntimeErrorGroupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "EscalateRuntimeErrorGroup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(EscalateRuntimeErrorGroupResponseObject); ok {
+		if err := validResponse.VisitEscalateRuntimeErrorGroupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateRuntimeErrorGroupStatus operation middleware
+func (sh *strictHandler) UpdateRuntimeErrorGroupStatus(w http.ResponseWriter, r *http.Request, groupId RuntimeErrorGroupID) {
+	var request UpdateRuntimeErrorGroupStatusRequestObject
+
+	request.GroupId = groupId
+
+	var body UpdateRuntimeErrorGroupStatusJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateRuntimeErrorGroupStatus(ctx, request.(UpdateRuntimeErrorGroupStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateRuntimeErrorGroupStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateRuntimeErrorGroupStatusResponseObject); ok {
+		if err := validResponse.VisitUpdateRuntimeErrorGroupStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // MarkRuntimeErrorViewed operation middleware
 func (sh *strictHandler) MarkRuntimeErrorViewed(w http.ResponseWriter, r *http.Request, runtimeErrorId RuntimeErrorID) {
 	var request MarkRuntimeErrorViewedRequestObject
@@ -10399,6 +14695,37 @@ func (sh *strictHandler) MarkRuntimeErrorViewed(w http.ResponseWriter, r *http.R
 	}
 }
 
+// CreateServiceAccount operation middleware
+func (sh *strictHandler) CreateServiceAccount(w http.ResponseWriter, r *http.Request) {
+	var request CreateServiceAccountRequestObject
+
+	var body CreateServiceAccountJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateServiceAccount(ctx, request.(CreateServiceAccountRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateServiceAccount")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateServiceAccountResponseObject); ok {
+		if err := validResponse.VisitCreateServiceAccountResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // ListSystemSettings operation middleware
 func (sh *strictHandler) ListSystemSettings(w http.ResponseWriter, r *http.Request) {
 	var request ListSystemSettingsRequestObject
@@ -10615,6 +14942,39 @@ func (sh *strictHandler) DeleteUser(w http.ResponseWriter, r *http.Request, user
 	}
 }
 
+// IngestAlertmanagerWebhook operation middleware
+func (sh *strictHandler) IngestAlertmanagerWebhook(w http.ResponseWriter, r *http.Request, params IngestAlertmanagerWebhookParams) {
+	var request IngestAlertmanagerWebhookRequestObject
+
+	request.Params = params
+
+	var body IngestAlertmanagerWebhookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.IngestAlertmanagerWebhook(ctx, request.(IngestAlertmanagerWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "IngestAlertmanagerWebhook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(IngestAlertmanagerWebhookResponseObject); ok {
+		if err := validResponse.VisitIngestAlertmanagerWebhookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // IngestGithubWebhook operation middleware
 func (sh *strictHandler) IngestGithubWebhook(w http.ResponseWriter, r *http.Request, params IngestGithubWebhookParams) {
 	var request IngestGithubWebhookRequestObject
@@ -0,0 +1,77 @@
+package models
+
+// SubmitChangeGovernanceWaiverDecisionRequest is a typed payload for staff waiver decisions.
+type SubmitChangeGovernanceWaiverDecisionRequest struct {
+	DecisionID                string `json:"decision_id"`
+	DecisionState             string `json:"decision_state"`
+	ActorKind                 string `json:"actor_kind"`
+	ResidualRiskTier          string `json:"residual_risk_tier"`
+	ReasonMarkdown            string `json:"reason_markdown"`
+	ExpiresAt                 string `json:"expires_at"`
+	ExpectedProjectionVersion int64  `json:"expected_projection_version"`
+}
+
+// SubmitChangeGovernanceReleaseReadinessDecisionRequest is a typed payload for staff release decisions.
+type SubmitChangeGovernanceReleaseReadinessDecisionRequest struct {
+	DecisionID                string `json:"decision_id"`
+	DecisionState             string `json:"decision_state"`
+	ActorKind                 string `json:"actor_kind"`
+	SummaryMarkdown           string `json:"summary_markdown"`
+	ExpectedProjectionVersion int64  `json:"expected_projection_version"`
+}
+
+// ReportChangeGovernanceFeedbackRequest is a typed payload for staff-opened governance gaps.
+type ReportChangeGovernanceFeedbackRequest struct {
+	FeedbackID         string `json:"feedback_id"`
+	GapKind            string `json:"gap_kind"`
+	SourceKind         string `json:"source_kind"`
+	Severity           string `json:"severity"`
+	SuggestedAction    string `json:"suggested_action"`
+	SummaryMarkdown    string `json:"summary_markdown"`
+	RelatedArtifactRef string `json:"related_artifact_ref"`
+}
+
+type ChangeGovernanceDecision struct {
+	ID               string  `json:"id"`
+	DecisionID       string  `json:"decision_id"`
+	DecisionKind     string  `json:"decision_kind"`
+	State            string  `json:"state"`
+	ActorKind        string  `json:"actor_kind"`
+	ResidualRiskTier *string `json:"residual_risk_tier"`
+	SummaryMarkdown  string  `json:"summary_markdown"`
+	RecordedAt       *string `json:"recorded_at"`
+}
+
+type ChangeGovernanceFeedback struct {
+	ID                 string  `json:"id"`
+	FeedbackID         string  `json:"feedback_id"`
+	GapKind            string  `json:"gap_kind"`
+	SourceKind         string  `json:"source_kind"`
+	Severity           string  `json:"severity"`
+	State              string  `json:"state"`
+	SuggestedAction    string  `json:"suggested_action"`
+	SummaryMarkdown    string  `json:"summary_markdown"`
+	RelatedArtifactRef *string `json:"related_artifact_ref"`
+	OpenedAt           *string `json:"opened_at"`
+	ClosedAt           *string `json:"closed_at"`
+}
+
+type ChangeGovernancePackage struct {
+	ID                        string                     `json:"id"`
+	ProjectID                 string                     `json:"project_id"`
+	RepositoryFullName        string                     `json:"repository_full_name"`
+	IssueNumber               int32                      `json:"issue_number"`
+	PRNumber                  *int32                     `json:"pr_number"`
+	RiskTier                  *string                    `json:"risk_tier"`
+	BundleAdmissibility       string                     `json:"bundle_admissibility"`
+	PublicationState          string                     `json:"publication_state"`
+	EvidenceCompletenessState string                     `json:"evidence_completeness_state"`
+	VerificationMinimumState  string                     `json:"verification_minimum_state"`
+	WaiverState               string                     `json:"waiver_state"`
+	ReleaseReadinessState     string                     `json:"release_readiness_state"`
+	GovernanceFeedbackState   string                     `json:"governance_feedback_state"`
+	ProjectionVersion         int64                      `json:"projection_version"`
+	Decisions                 []ChangeGovernanceDecision `json:"decisions"`
+	Feedback                  []ChangeGovernanceFeedback `json:"feedback"`
+	UpdatedAt                 *string                    `json:"updated_at"`
+}
@@ -49,6 +49,10 @@ type Run struct {
 	StartedAt       *string            `json:"started_at"`
 	FinishedAt      *string            `json:"finished_at"`
 	WaitProjection  *RunWaitProjection `json:"wait_projection,omitempty"`
+	Priority        *int32             `json:"priority,omitempty"`
+	QueuePosition   *int32             `json:"queue_position,omitempty"`
+	// EstimatedWaitSeconds is reported for pending runs when project run history is available.
+	EstimatedWaitSeconds *int64 `json:"estimated_wait_seconds,omitempty"`
 }
 
 type RunWaitProjection struct {
@@ -138,6 +142,30 @@ type RuntimeDeployTaskActionResponse struct {
 	AlreadyTerminal bool   `json:"already_terminal"`
 }
 
+type RuntimeDeployPreviewObject struct {
+	Unit          string   `json:"unit"`
+	APIVersion    string   `json:"api_version"`
+	Kind          string   `json:"kind"`
+	Namespace     string   `json:"namespace"`
+	Name          string   `json:"name"`
+	Action        string   `json:"action"`
+	ChangedFields []string `json:"changed_fields"`
+}
+
+type RuntimeDeployPreviewImage struct {
+	Name     string `json:"name"`
+	ImageRef string `json:"image_ref"`
+	Action   string `json:"action"`
+}
+
+type RuntimeDeployPreview struct {
+	Namespace string                       `json:"namespace"`
+	TargetEnv string                       `json:"target_env"`
+	BuildRef  string                       `json:"build_ref"`
+	Objects   []RuntimeDeployPreviewObject `json:"objects"`
+	Images    []RuntimeDeployPreviewImage  `json:"images"`
+}
+
 type RunActionResponse struct {
 	RunID                        string  `json:"run_id"`
 	Action                       string  `json:"action"`
@@ -197,6 +225,104 @@ type RuntimeError struct {
 	ViewedAt      *string `json:"viewed_at"`
 	ViewedBy      *string `json:"viewed_by"`
 	CreatedAt     string  `json:"created_at"`
+	GroupID       *string `json:"group_id"`
+}
+
+type RuntimeErrorGroup struct {
+	ID                 string  `json:"id"`
+	ProjectID          *string `json:"project_id"`
+	Fingerprint        string  `json:"fingerprint"`
+	Source             string  `json:"source"`
+	ErrorClass         string  `json:"error_class"`
+	NormalizedMessage  string  `json:"normalized_message"`
+	SampleMessage      string  `json:"sample_message"`
+	Level              string  `json:"level"`
+	Status             string  `json:"status"`
+	MutedUntil         *string `json:"muted_until"`
+	StatusChangedAt    *string `json:"status_changed_at"`
+	StatusChangedBy    *string `json:"status_changed_by"`
+	ResolvedAt         *string `json:"resolved_at"`
+	Occurrences        int64   `json:"occurrences"`
+	RegressionCount    int32   `json:"regression_count"`
+	AffectedRunCount   int32   `json:"affected_run_count"`
+	FirstSeenAt        string  `json:"first_seen_at"`
+	LastSeenAt         string  `json:"last_seen_at"`
+	LastRunID          *string `json:"last_run_id"`
+	EscalationKind     *string `json:"escalation_kind"`
+	EscalatedAt        *string `json:"escalated_at"`
+	EscalatedBy        *string `json:"escalated_by"`
+	RepositoryFullName *string `json:"repository_full_name"`
+	IssueNumber        *int64  `json:"issue_number"`
+	IssueURL           *string `json:"issue_url"`
+	CreatedAt          string  `json:"created_at"`
+	UpdatedAt          string  `json:"updated_at"`
+}
+
+type UpdateRuntimeErrorGroupStatusRequest struct {
+	Status     string `json:"status"`
+	MutedUntil string `json:"muted_until"`
+}
+
+type EscalateRuntimeErrorGroupRequest struct {
+	Kind string `json:"kind"`
+}
+
+type RetentionPolicy struct {
+	ID               string  `json:"id"`
+	ProjectID        *string `json:"project_id"`
+	Target           string  `json:"target"`
+	RetentionDays    int32   `json:"retention_days"`
+	Action           string  `json:"action"`
+	Enabled          bool    `json:"enabled"`
+	UpdatedBy        *string `json:"updated_by"`
+	LastSweptAt      *string `json:"last_swept_at"`
+	LastRemovedCount int64   `json:"last_removed_count"`
+	LastArchiveKey   *string `json:"last_archive_key"`
+	LastError        *string `json:"last_error"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+type UpsertRetentionPolicyRequest struct {
+	Target        string  `json:"target"`
+	ProjectID     *string `json:"project_id"`
+	RetentionDays int32   `json:"retention_days"`
+	Action        string  `json:"action"`
+	Enabled       bool    `json:"enabled"`
+}
+
+type SetFlowEventRetentionPinRequest struct {
+	Pinned bool `json:"pinned"`
+}
+
+type RunOutcomeGroup struct {
+	ProjectID                string  `json:"project_id"`
+	Role                     string  `json:"role"`
+	TriggerKind              string  `json:"trigger_kind"`
+	Model                    string  `json:"model"`
+	ReasoningEffort          string  `json:"reasoning_effort"`
+	PromptSource             string  `json:"prompt_source"`
+	Lineages                 int64   `json:"lineages"`
+	Runs                     int64   `json:"runs"`
+	ReviseRuns               int64   `json:"revise_runs"`
+	FailedRuns               int64   `json:"failed_runs"`
+	ChangesRequested         int64   `json:"changes_requested"`
+	PRsOpened                int64   `json:"prs_opened"`
+	PRsMerged                int64   `json:"prs_merged"`
+	PRsClosedUnmerged        int64   `json:"prs_closed_unmerged"`
+	MergeRate                float64 `json:"merge_rate"`
+	AvgReviseRounds          float64 `json:"avg_revise_rounds"`
+	MedianTimeToMergeSeconds float64 `json:"median_time_to_merge_seconds"`
+	AvgWallClockSeconds      float64 `json:"avg_wall_clock_seconds"`
+	AgentSeconds             float64 `json:"agent_seconds"`
+}
+
+type RunOutcomeAnalytics struct {
+	From    string            `json:"from"`
+	To      string            `json:"to"`
+	GroupBy []string          `json:"group_by"`
+	Totals  RunOutcomeGroup   `json:"totals"`
+	Groups  []RunOutcomeGroup `json:"groups"`
 }
 
 type RegistryImageTag struct {
@@ -242,22 +368,38 @@ type ApprovalRequest struct {
 	ApprovalMode  string  `json:"approval_mode"`
 	RequestedBy   string  `json:"requested_by"`
 	CreatedAt     string  `json:"created_at"`
+	// RequiredApprovals is 0 when the request is not governed by an approval policy.
+	RequiredApprovals int32          `json:"required_approvals"`
+	ApprovalsCount    int32          `json:"approvals_count"`
+	Votes             []ApprovalVote `json:"votes"`
+	ExpiresAt         *string        `json:"expires_at"`
+}
+
+type ApprovalVote struct {
+	ActorID  string  `json:"actor_id"`
+	Decision string  `json:"decision"`
+	Reason   *string `json:"reason"`
+	VotedAt  *string `json:"voted_at"`
 }
 
 type ResolveApprovalDecisionResponse struct {
-	ID            int64   `json:"id"`
-	CorrelationID string  `json:"correlation_id"`
-	RunID         *string `json:"run_id"`
-	ToolName      string  `json:"tool_name"`
-	Action        string  `json:"action"`
-	ApprovalState string  `json:"approval_state"`
+	ID                int64   `json:"id"`
+	CorrelationID     string  `json:"correlation_id"`
+	RunID             *string `json:"run_id"`
+	ToolName          string  `json:"tool_name"`
+	Action            string  `json:"action"`
+	ApprovalState     string  `json:"approval_state"`
+	RequiredApprovals int32   `json:"required_approvals"`
+	ApprovalsCount    int32   `json:"approvals_count"`
 }
 
 type FlowEvent struct {
-	CorrelationID string `json:"correlation_id"`
-	EventType     string `json:"event_type"`
-	CreatedAt     string `json:"created_at"`
-	PayloadJSON   string `json:"payload_json"`
+	ID              int64  `json:"id"`
+	CorrelationID   string `json:"correlation_id"`
+	EventType       string `json:"event_type"`
+	CreatedAt       string `json:"created_at"`
+	PayloadJSON     string `json:"payload_json"`
+	RetentionPinned bool   `json:"retention_pinned"`
 }
 
 type LearningFeedback struct {
@@ -273,12 +415,47 @@ type LearningFeedback struct {
 }
 
 type User struct {
-	ID              string  `json:"id"`
-	Email           string  `json:"email"`
-	GitHubUserID    *int64  `json:"github_user_id"`
-	GitHubLogin     *string `json:"github_login"`
-	IsPlatformAdmin bool    `json:"is_platform_admin"`
-	IsPlatformOwner bool    `json:"is_platform_owner"`
+	ID               string  `json:"id"`
+	Email            string  `json:"email"`
+	GitHubUserID     *int64  `json:"github_user_id"`
+	GitHubLogin      *string `json:"github_login"`
+	IsPlatformAdmin  bool    `json:"is_platform_admin"`
+	IsPlatformOwner  bool    `json:"is_platform_owner"`
+	IsServiceAccount bool    `json:"is_service_account"`
+}
+
+type CreateServiceAccountRequest struct {
+	Name            string `json:"name"`
+	IsPlatformAdmin bool   `json:"is_platform_admin"`
+}
+
+type StaffAPIToken struct {
+	ID          string   `json:"id"`
+	UserID      string   `json:"user_id"`
+	UserEmail   string   `json:"user_email"`
+	Name        string   `json:"name"`
+	TokenPrefix string   `json:"token_prefix"`
+	Scopes      []string `json:"scopes"`
+	ProjectIDs  []string `json:"project_ids"`
+	ExpiresAt   *string  `json:"expires_at"`
+	LastUsedAt  *string  `json:"last_used_at"`
+	RevokedAt   *string  `json:"revoked_at"`
+	CreatedBy   *string  `json:"created_by"`
+	CreatedAt   string   `json:"created_at"`
+}
+
+type CreateStaffAPITokenRequest struct {
+	UserID        *string  `json:"user_id"`
+	Name          string   `json:"name"`
+	Scopes        []string `json:"scopes"`
+	ProjectIDs    []string `json:"project_ids"`
+	ExpiresInDays *int32   `json:"expires_in_days"`
+}
+
+type CreateStaffAPITokenResponse struct {
+	Token StaffAPIToken `json:"token"`
+	// Secret is the raw token value; it is returned only once.
+	Secret string `json:"secret"`
 }
 
 type ProjectMember struct {
@@ -289,6 +466,55 @@ type ProjectMember struct {
 	LearningModeOverride *bool  `json:"learning_mode_override"`
 }
 
+type ProjectRole struct {
+	ProjectID   string   `json:"project_id"`
+	Name        string   `json:"name"`
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+	Builtin     bool     `json:"builtin"`
+	CreatedAt   *string  `json:"created_at"`
+	UpdatedAt   *string  `json:"updated_at"`
+}
+
+type ProjectPermissionDescriptor struct {
+	Permission string `json:"permission"`
+	Qualified  bool   `json:"qualified"`
+}
+
+type ProjectRolesResponse struct {
+	Items   []ProjectRole                 `json:"items"`
+	Catalog []ProjectPermissionDescriptor `json:"catalog"`
+}
+
+type UpsertProjectRoleRequest struct {
+	Description string   `json:"description"`
+	Permissions []string `json:"permissions"`
+}
+
+type MCPApprovalPolicy struct {
+	ID                  string   `json:"id"`
+	ProjectID           string   `json:"project_id"`
+	ToolName            string   `json:"tool_name"`
+	Environment         string   `json:"environment"`
+	RequiredApprovals   int32    `json:"required_approvals"`
+	EligibleRoles       []string `json:"eligible_roles"`
+	EligibleUserIDs     []string `json:"eligible_user_ids"`
+	SeparationOfDuties  bool     `json:"separation_of_duties"`
+	ExpiresAfterSeconds int32    `json:"expires_after_seconds"`
+	CreatedAt           *string  `json:"created_at"`
+	UpdatedAt           *string  `json:"updated_at"`
+}
+
+type UpsertMCPApprovalPolicyRequest struct {
+	ToolName            string   `json:"tool_name"`
+	Environment         string   `json:"environment"`
+	RequiredApprovals   int32    `json:"required_approvals"`
+	EligibleRoles       []string `json:"eligible_roles"`
+	EligibleUserIDs     []string `json:"eligible_user_ids"`
+	SeparationOfDuties  bool     `json:"separation_of_duties"`
+	ExpiresAfterSeconds int32    `json:"expires_after_seconds"`
+}
+
 type RepositoryBinding struct {
 	ID                 string  `json:"id"`
 	ProjectID          string  `json:"project_id"`
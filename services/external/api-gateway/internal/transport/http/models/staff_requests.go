@@ -59,6 +59,16 @@ type RuntimeDeployTaskActionRequest struct {
 	Force  bool   `json:"force"`
 }
 
+// PreviewRuntimeDeployRequest is a typed payload for runtime deploy dry-run preview.
+type PreviewRuntimeDeployRequest struct {
+	BuildRef           string `json:"build_ref"`
+	TargetEnv          string `json:"target_env"`
+	Namespace          string `json:"namespace"`
+	SlotNo             int32  `json:"slot_no"`
+	RepositoryFullName string `json:"repository_full_name"`
+	ServicesYAMLPath   string `json:"services_yaml_path"`
+}
+
 // DeleteRegistryImageTagRequest identifies one registry tag for deletion.
 type DeleteRegistryImageTagRequest struct {
 	Repository string `json:"repository"`
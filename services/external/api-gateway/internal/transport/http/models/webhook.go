@@ -6,3 +6,19 @@ type IngestGitHubWebhookResponse struct {
 	Status        string `json:"status"`
 	Duplicate     bool   `json:"duplicate"`
 }
+
+type IngestAlertmanagerWebhookResponse struct {
+	CorrelationID string                 `json:"correlation_id"`
+	Status        string                 `json:"status"`
+	Incidents     []AlertIncidentOutcome `json:"incidents"`
+}
+
+type AlertIncidentOutcome struct {
+	IncidentID    string `json:"incident_id,omitempty"`
+	Fingerprint   string `json:"fingerprint"`
+	AlertName     string `json:"alert_name"`
+	Action        string `json:"action"`
+	IssueURL      string `json:"issue_url,omitempty"`
+	RunID         string `json:"run_id,omitempty"`
+	RunSkipReason string `json:"run_skip_reason,omitempty"`
+}
@@ -29,6 +29,9 @@ type ServerConfig struct {
 	// MCPCallbackToken is shared token for external approver/executor callback contracts.
 	// Empty value means callback auth is disabled on HTTP level.
 	MCPCallbackToken string
+	// AlertmanagerWebhookToken is bearer token expected from Alertmanager webhook receivers.
+	// Empty value disables Alertmanager ingestion endpoint.
+	AlertmanagerWebhookToken string
 	// MaxBodyBytes sets webhook body size limit.
 	MaxBodyBytes int64
 	// CookieSecure controls Secure attribute for auth cookies.
@@ -72,13 +75,15 @@ func NewServer(initCtx context.Context, cfg ServerConfig, cp *controlplane.Clien
 	}
 
 	h := newWebhookHandler(cfg, cp)
+	alertH := newAlertmanagerWebhookHandler(cfg, cp)
 	mcpH := newMCPCallbackHandler(cfg, cp)
 	interactionCallbackH := newInteractionCallbackHandler(cp)
 	interactionCallbackH.logger = logger
 	authH := newAuthHandler(auth, cfg.CookieSecure)
 	staffH := newStaffHandler(cp)
 
-	staffAuthMw := requireStaffAuth(auth, cp.ResolveStaffByEmail)
+	staffAuthMw := requireStaffAuth(auth, cp.ResolveStaffByEmail, cp.AuthenticateStaffAPIToken)
+	staffAPITokenScopeMw := requireStaffAPITokenScope(staffH.resolveRunProject)
 	interactionCallbackRateLimitMw := newInteractionCallbackRateLimitMiddleware(cfg.MaxBodyBytes)
 
 	e.GET("/readyz", readyHandler)
@@ -88,16 +93,19 @@ func NewServer(initCtx context.Context, cfg ServerConfig, cp *controlplane.Clien
 	e.GET("/metrics", echo.WrapHandler(promhttp.Handler()))
 
 	e.POST("/api/v1/webhooks/github", h.IngestGitHubWebhook)
+	e.POST("/api/v1/webhooks/alertmanager", alertH.IngestAlertmanagerWebhook)
 	e.POST("/api/v1/mcp/approver/callback", mcpH.CallbackApprover)
 	e.POST("/api/v1/mcp/executor/callback", mcpH.CallbackExecutor)
 	e.POST("/api/v1/mcp/interactions/callback", interactionCallbackH.Callback, interactionCallbackRateLimitMw)
 
 	e.GET("/api/v1/auth/github/login", authH.LoginGitHub)
 	e.GET("/api/v1/auth/github/callback", authH.CallbackGitHub)
+	e.GET("/api/v1/auth/oidc/login", authH.LoginOIDC)
+	e.GET("/api/v1/auth/oidc/callback", authH.CallbackOIDC)
 	e.POST("/api/v1/auth/logout", authH.Logout, staffAuthMw)
 	e.GET("/api/v1/auth/me", authH.Me, staffAuthMw)
 
-	staffGroup := e.Group("/api/v1/staff", staffAuthMw)
+	staffGroup := e.Group("/api/v1/staff", staffAuthMw, staffAPITokenScopeMw)
 	staffGroup.GET("/projects", staffH.ListProjects)
 	staffGroup.POST("/projects", staffH.UpsertProject)
 	staffGroup.GET("/projects/:project_id", staffH.GetProject)
@@ -122,24 +130,50 @@ func NewServer(initCtx context.Context, cfg ServerConfig, cp *controlplane.Clien
 	staffGroup.GET("/runtime-deploy/tasks/:run_id", staffH.GetRuntimeDeployTask)
 	staffGroup.POST("/runtime-deploy/tasks/:run_id/cancel", staffH.CancelRuntimeDeployTask)
 	staffGroup.POST("/runtime-deploy/tasks/:run_id/stop", staffH.StopRuntimeDeployTask)
+	staffGroup.POST("/runtime-deploy/previews", staffH.PreviewRuntimeDeploy)
 	// TODO(kodex#81): Staff UI no longer renders "platform error" alerts.
 	// Revisit runtime-errors endpoints usage and decide whether to keep, repurpose, or remove them.
 	staffGroup.GET("/runtime-errors", staffH.ListRuntimeErrors)
 	staffGroup.POST("/runtime-errors/:runtime_error_id/viewed", staffH.MarkRuntimeErrorViewed)
+	staffGroup.GET("/runtime-errors/groups", staffH.ListRuntimeErrorGroups)
+	staffGroup.POST("/runtime-errors/groups/:group_id/status", staffH.UpdateRuntimeErrorGroupStatus)
+	staffGroup.POST("/runtime-errors/groups/:group_id/escalate", staffH.EscalateRuntimeErrorGroup)
+	staffGroup.GET("/retention-policies", staffH.ListRetentionPolicies)
+	staffGroup.PUT("/retention-policies", staffH.UpsertRetentionPolicy)
+	staffGroup.DELETE("/retention-policies/:policy_id", staffH.DeleteRetentionPolicy)
+	staffGroup.PUT("/flow-events/:event_id/retention-pin", staffH.SetFlowEventRetentionPin)
+	staffGroup.GET("/analytics/run-outcomes", staffH.GetRunOutcomeAnalytics)
+	staffGroup.GET("/governance/change-packages/:package_id", staffH.GetChangeGovernancePackage)
+	staffGroup.POST("/governance/change-packages/:package_id/waivers", staffH.SubmitChangeGovernanceWaiverDecision)
+	staffGroup.POST("/governance/change-packages/:package_id/release-readiness", staffH.SubmitChangeGovernanceReleaseReadinessDecision)
+	staffGroup.POST("/governance/change-packages/:package_id/feedback", staffH.ReportChangeGovernanceFeedback)
 	staffGroup.GET("/mission-control/workspace", staffH.GetMissionControlWorkspace)
 	staffGroup.GET("/mission-control/nodes/:node_kind/:node_public_id", staffH.GetMissionControlNode)
 	staffGroup.GET("/mission-control/nodes/:node_kind/:node_public_id/activity", staffH.ListMissionControlNodeActivity)
 	staffGroup.POST("/mission-control/launch-preview", staffH.PreviewMissionControlLaunch)
 	staffGroup.POST("/mission-control/commands", staffH.SubmitMissionControlCommand)
 	staffGroup.GET("/mission-control/commands/:command_id", staffH.GetMissionControlCommand)
+	staffGroup.GET("/mission-control/voice-candidates", staffH.ListMissionControlVoiceCandidates)
+	staffGroup.POST("/mission-control/voice-candidates/:candidate_id/promote", staffH.PromoteMissionControlVoiceCandidate)
+	staffGroup.POST("/mission-control/voice-candidates/:candidate_id/reject", staffH.RejectMissionControlVoiceCandidate)
 	staffGroup.GET("/mission-control/realtime", staffH.MissionControlRealtime)
 	staffGroup.GET("/users", staffH.ListUsers)
 	staffGroup.POST("/users", staffH.CreateUser)
 	staffGroup.DELETE("/users/:user_id", staffH.DeleteUser)
+	staffGroup.POST("/service-accounts", staffH.CreateServiceAccount)
+	staffGroup.GET("/api-tokens", staffH.ListStaffAPITokens)
+	staffGroup.POST("/api-tokens", staffH.CreateStaffAPIToken)
+	staffGroup.DELETE("/api-tokens/:token_id", staffH.RevokeStaffAPIToken)
 	staffGroup.GET("/projects/:project_id/members", staffH.ListProjectMembers)
 	staffGroup.POST("/projects/:project_id/members", staffH.UpsertProjectMember)
 	staffGroup.DELETE("/projects/:project_id/members/:user_id", staffH.DeleteProjectMember)
 	staffGroup.PUT("/projects/:project_id/members/:user_id/learning-mode", staffH.SetProjectMemberLearningModeOverride)
+	staffGroup.GET("/projects/:project_id/roles", staffH.ListProjectRoles)
+	staffGroup.PUT("/projects/:project_id/roles/:role_name", staffH.UpsertProjectRole)
+	staffGroup.DELETE("/projects/:project_id/roles/:role_name", staffH.DeleteProjectRole)
+	staffGroup.GET("/projects/:project_id/mcp-approval-policies", staffH.ListMCPApprovalPolicies)
+	staffGroup.PUT("/projects/:project_id/mcp-approval-policies", staffH.UpsertMCPApprovalPolicy)
+	staffGroup.DELETE("/projects/:project_id/mcp-approval-policies/:policy_id", staffH.DeleteMCPApprovalPolicy)
 	staffGroup.GET("/projects/:project_id/repositories", staffH.ListProjectRepositories)
 	staffGroup.POST("/projects/:project_id/repositories", staffH.UpsertProjectRepository)
 	staffGroup.DELETE("/projects/:project_id/repositories/:repository_id", staffH.DeleteProjectRepository)
@@ -0,0 +1,145 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/codex-k8s/kodex/libs/go/auth/apitoken"
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	"github.com/labstack/echo/v5"
+)
+
+// staffAPITokenGrant is attached to request context when staff API token was used for authentication.
+type staffAPITokenGrant struct {
+	TokenID string
+	Scopes  []string
+	// ProjectIDs restricts token to listed projects; empty means no restriction.
+	ProjectIDs []string
+}
+
+func (g *staffAPITokenGrant) hasScope(scope apitoken.Scope) bool {
+	return slices.Contains(g.Scopes, string(scope)) || slices.Contains(g.Scopes, string(apitoken.ScopeAdmin))
+}
+
+// staffRouteProjectSource tells where project context of a staff route comes from.
+type staffRouteProjectSource int
+
+const (
+	// staffRouteProjectNone marks routes without project context; project-restricted tokens are denied.
+	staffRouteProjectNone staffRouteProjectSource = iota
+	staffRouteProjectPath
+	staffRouteProjectQuery
+	staffRouteProjectRun
+)
+
+type staffRoutePolicy struct {
+	scope   apitoken.Scope
+	project staffRouteProjectSource
+}
+
+// staffRoutePolicies maps "METHOD registered-path" to scope required from staff API tokens.
+// Routes missing here are reachable only with `admin` scope.
+var staffRoutePolicies = map[string]staffRoutePolicy{
+	"GET /api/v1/staff/projects":                                   {scope: apitoken.ScopeProjectsRead},
+	"GET /api/v1/staff/projects/:project_id":                       {scope: apitoken.ScopeProjectsRead, project: staffRouteProjectPath},
+	"GET /api/v1/staff/projects/:project_id/members":               {scope: apitoken.ScopeProjectsRead, project: staffRouteProjectPath},
+	"GET /api/v1/staff/projects/:project_id/roles":                 {scope: apitoken.ScopeProjectsRead, project: staffRouteProjectPath},
+	"GET /api/v1/staff/projects/:project_id/mcp-approval-policies": {scope: apitoken.ScopeProjectsRead, project: staffRouteProjectPath},
+	"GET /api/v1/staff/projects/:project_id/repositories":          {scope: apitoken.ScopeProjectsRead, project: staffRouteProjectPath},
+	"GET /api/v1/staff/docset/groups":                              {scope: apitoken.ScopeProjectsRead},
+	"GET /api/v1/staff/runs/waits":                                 {scope: apitoken.ScopeRunsRead},
+	"GET /api/v1/staff/runs/realtime":                              {scope: apitoken.ScopeRunsRead},
+	"GET /api/v1/staff/runs/:run_id":                               {scope: apitoken.ScopeRunsRead, project: staffRouteProjectRun},
+	"GET /api/v1/staff/runs/:run_id/events":                        {scope: apitoken.ScopeRunsRead, project: staffRouteProjectRun},
+	"GET /api/v1/staff/runs/:run_id/realtime":                      {scope: apitoken.ScopeRunsRead, project: staffRouteProjectRun},
+	"GET /api/v1/staff/runs/:run_id/logs":                          {scope: apitoken.ScopeRunsRead, project: staffRouteProjectRun},
+	"GET /api/v1/staff/runs/:run_id/learning-feedback":             {scope: apitoken.ScopeRunsRead, project: staffRouteProjectRun},
+	"GET /api/v1/staff/runtime-deploy/tasks/:run_id":               {scope: apitoken.ScopeRunsRead, project: staffRouteProjectRun},
+	"GET /api/v1/staff/analytics/run-outcomes":                     {scope: apitoken.ScopeRunsRead, project: staffRouteProjectQuery},
+	"POST /api/v1/staff/runs/:run_id/cancel":                       {scope: apitoken.ScopeRunsCancel, project: staffRouteProjectRun},
+	"DELETE /api/v1/staff/runs/:run_id/namespace":                  {scope: apitoken.ScopeRunsCancel, project: staffRouteProjectRun},
+	"POST /api/v1/staff/runtime-deploy/tasks/:run_id/cancel":       {scope: apitoken.ScopeRunsCancel, project: staffRouteProjectRun},
+	"POST /api/v1/staff/runtime-deploy/tasks/:run_id/stop":         {scope: apitoken.ScopeRunsCancel, project: staffRouteProjectRun},
+
+	"POST /api/v1/staff/projects/:project_id/repositories":                          {scope: apitoken.ScopeRepositoriesManage, project: staffRouteProjectPath},
+	"DELETE /api/v1/staff/projects/:project_id/repositories/:repository_id":         {scope: apitoken.ScopeRepositoriesManage, project: staffRouteProjectPath},
+	"PUT /api/v1/staff/projects/:project_id/repositories/:repository_id/bot-params": {scope: apitoken.ScopeRepositoriesManage, project: staffRouteProjectPath},
+	"POST /api/v1/staff/projects/:project_id/repositories/:repository_id/preflight": {scope: apitoken.ScopeRepositoriesManage, project: staffRouteProjectPath},
+	"GET /api/v1/staff/projects/:project_id/github-tokens":                          {scope: apitoken.ScopeRepositoriesManage, project: staffRouteProjectPath},
+	"PUT /api/v1/staff/projects/:project_id/github-tokens":                          {scope: apitoken.ScopeRepositoriesManage, project: staffRouteProjectPath},
+	"POST /api/v1/staff/projects/:project_id/docset/import":                         {scope: apitoken.ScopeRepositoriesManage, project: staffRouteProjectPath},
+	"POST /api/v1/staff/projects/:project_id/docset/sync":                           {scope: apitoken.ScopeRepositoriesManage, project: staffRouteProjectPath},
+	"GET /api/v1/staff/mission-control/workspace":                                   {scope: apitoken.ScopeMissionControlCommands},
+	"GET /api/v1/staff/mission-control/nodes/:node_kind/:node_public_id":            {scope: apitoken.ScopeMissionControlCommands},
+	"GET /api/v1/staff/mission-control/nodes/:node_kind/:node_public_id/activity":   {scope: apitoken.ScopeMissionControlCommands},
+	"POST /api/v1/staff/mission-control/launch-preview":                             {scope: apitoken.ScopeMissionControlCommands},
+	"POST /api/v1/staff/mission-control/commands":                                   {scope: apitoken.ScopeMissionControlCommands},
+	"GET /api/v1/staff/mission-control/commands/:command_id":                        {scope: apitoken.ScopeMissionControlCommands},
+	"GET /api/v1/staff/mission-control/realtime":                                    {scope: apitoken.ScopeMissionControlCommands},
+	"POST /api/v1/staff/github/next-step-actions/preview":                           {scope: apitoken.ScopeMissionControlCommands},
+	"POST /api/v1/staff/github/next-step-actions/execute":                           {scope: apitoken.ScopeMissionControlCommands},
+}
+
+// runProjectResolver returns project id of a run visible to principal.
+type runProjectResolver func(ctx context.Context, principal *controlplanev1.Principal, runID string) (string, error)
+
+// requireStaffAPITokenScope enforces token scopes and project restriction for requests
+// authenticated by staff API token; session-authenticated requests pass through unchanged.
+// Effective access is still bounded by token owner RBAC in control-plane.
+func requireStaffAPITokenScope(resolveRunProject runProjectResolver) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			grant, ok := c.Get(ctxAPITokenGrantKey).(*staffAPITokenGrant)
+			if !ok || grant == nil {
+				return next(c)
+			}
+			if err := authorizeStaffAPITokenRoute(c, grant, resolveRunProject); err != nil {
+				return err
+			}
+			return next(c)
+		}
+	}
+}
+
+func authorizeStaffAPITokenRoute(c *echo.Context, grant *staffAPITokenGrant, resolveRunProject runProjectResolver) error {
+	method := c.Request().Method
+	if method == http.MethodHead {
+		method = http.MethodGet
+	}
+	policy, mapped := staffRoutePolicies[method+" "+c.Path()]
+	if !mapped {
+		policy = staffRoutePolicy{scope: apitoken.ScopeAdmin}
+	}
+	if !grant.hasScope(policy.scope) {
+		return errs.Forbidden{Msg: "api token scope " + string(policy.scope) + " required"}
+	}
+	if len(grant.ProjectIDs) == 0 {
+		return nil
+	}
+
+	projectID := ""
+	switch policy.project {
+	case staffRouteProjectPath:
+		projectID = c.Param("project_id")
+	case staffRouteProjectQuery:
+		projectID = c.QueryParam("project_id")
+	case staffRouteProjectRun:
+		principal, ok := getPrincipal(c)
+		if !ok || resolveRunProject == nil {
+			return errs.Forbidden{Msg: "api token is restricted to projects"}
+		}
+		resolved, err := resolveRunProject(c.Request().Context(), principal, c.Param("run_id"))
+		if err != nil {
+			return err
+		}
+		projectID = resolved
+	}
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" || !slices.Contains(grant.ProjectIDs, projectID) {
+		return errs.Forbidden{Msg: "api token is restricted to projects"}
+	}
+	return nil
+}
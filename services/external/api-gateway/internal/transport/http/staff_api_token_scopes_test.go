@@ -0,0 +1,73 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	"github.com/labstack/echo/v5"
+)
+
+func newStaffAPITokenScopeTestServer(grant *staffAPITokenGrant, runProjectID string) *echo.Echo {
+	e := echo.New()
+	auth := func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			c.Set(ctxPrincipalKey, &controlplanev1.Principal{UserId: "u1"})
+			if grant != nil {
+				c.Set(ctxAPITokenGrantKey, grant)
+			}
+			return next(c)
+		}
+	}
+	resolveRunProject := func(context.Context, *controlplanev1.Principal, string) (string, error) {
+		return runProjectID, nil
+	}
+	ok := func(c *echo.Context) error { return c.NoContent(http.StatusNoContent) }
+
+	g := e.Group("/api/v1/staff", auth, requireStaffAPITokenScope(resolveRunProject))
+	g.GET("/runs/:run_id", ok)
+	g.POST("/runs/:run_id/cancel", ok)
+	g.GET("/projects", ok)
+	g.GET("/projects/:project_id/repositories", ok)
+	g.GET("/users", ok)
+	return e
+}
+
+func TestRequireStaffAPITokenScope(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		grant      *staffAPITokenGrant
+		method     string
+		target     string
+		wantForbid bool
+	}{
+		{name: "session auth passes", method: http.MethodGet, target: "/api/v1/staff/users"},
+		{name: "scope granted", grant: &staffAPITokenGrant{Scopes: []string{"runs:read"}}, method: http.MethodGet, target: "/api/v1/staff/runs/r1"},
+		{name: "scope missing", grant: &staffAPITokenGrant{Scopes: []string{"runs:read"}}, method: http.MethodPost, target: "/api/v1/staff/runs/r1/cancel", wantForbid: true},
+		{name: "unmapped route requires admin", grant: &staffAPITokenGrant{Scopes: []string{"runs:read", "projects:read"}}, method: http.MethodGet, target: "/api/v1/staff/users", wantForbid: true},
+		{name: "admin scope allows unmapped route", grant: &staffAPITokenGrant{Scopes: []string{"admin"}}, method: http.MethodGet, target: "/api/v1/staff/users"},
+		{name: "restricted token in own project by path", grant: &staffAPITokenGrant{Scopes: []string{"projects:read"}, ProjectIDs: []string{"p1"}}, method: http.MethodGet, target: "/api/v1/staff/projects/p1/repositories"},
+		{name: "restricted token in foreign project by path", grant: &staffAPITokenGrant{Scopes: []string{"projects:read"}, ProjectIDs: []string{"p2"}}, method: http.MethodGet, target: "/api/v1/staff/projects/p1/repositories", wantForbid: true},
+		{name: "restricted token in own project by run", grant: &staffAPITokenGrant{Scopes: []string{"runs:cancel"}, ProjectIDs: []string{"p1"}}, method: http.MethodPost, target: "/api/v1/staff/runs/r1/cancel"},
+		{name: "restricted token on route without project", grant: &staffAPITokenGrant{Scopes: []string{"projects:read"}, ProjectIDs: []string{"p1"}}, method: http.MethodGet, target: "/api/v1/staff/projects", wantForbid: true},
+	}
+
+	for _, testCase := range testCases {
+		e := newStaffAPITokenScopeTestServer(testCase.grant, "p1")
+		var gotErr error
+		e.HTTPErrorHandler = func(_ *echo.Context, err error) { gotErr = err }
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(testCase.method, testCase.target, nil))
+
+		var forbidden errs.Forbidden
+		if gotForbid := errors.As(gotErr, &forbidden); gotForbid != testCase.wantForbid {
+			t.Fatalf("%s: forbidden = %v, want %v (err = %v, status = %d)", testCase.name, gotForbid, testCase.wantForbid, gotErr, rec.Code)
+		}
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/codex-k8s/kodex/libs/go/auth/apitoken"
 	jwtlib "github.com/codex-k8s/kodex/libs/go/auth/jwt"
 	"github.com/codex-k8s/kodex/libs/go/errs"
 	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
@@ -12,25 +13,33 @@ import (
 )
 
 const (
-	cookieAuthToken = "kodex_staff_jwt"
-	ctxPrincipalKey = "kodex_principal"
+	cookieAuthToken     = "kodex_staff_jwt"
+	ctxPrincipalKey     = "kodex_principal"
+	ctxAPITokenGrantKey = "kodex_api_token_grant"
 )
 
 type jwtVerifier interface {
 	VerifyJWT(token string) (jwtlib.Claims, error)
 }
 
+// staffAPITokenAuthenticator resolves staff API token owner and grant in control-plane.
+type staffAPITokenAuthenticator func(ctx context.Context, token string) (*controlplanev1.AuthenticateStaffAPITokenResponse, error)
+
 // requireStaffAuth authenticates staff requests either via:
 // - oauth2-proxy injected headers (production/dev): resolve allowlist and identity in control-plane; or
+// - staff API token (`kxt_` bearer): resolve owner, scopes and project restriction in control-plane; or
 // - JWT: verify locally and attach claims as principal.
-func requireStaffAuth(verifier jwtVerifier, resolver func(ctx context.Context, email string, githubLogin string) (*controlplanev1.Principal, error)) echo.MiddlewareFunc {
+func requireStaffAuth(verifier jwtVerifier, resolver func(ctx context.Context, email string, githubLogin string) (*controlplanev1.Principal, error), tokenAuth staffAPITokenAuthenticator) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c *echo.Context) error {
-			p, err := authenticatePrincipal(c, verifier, resolver)
+			p, grant, err := authenticatePrincipal(c, verifier, resolver, tokenAuth)
 			if err != nil {
 				return err
 			}
 			c.Set(ctxPrincipalKey, p)
+			if grant != nil {
+				c.Set(ctxAPITokenGrantKey, grant)
+			}
 			return next(c)
 		}
 	}
@@ -45,7 +54,8 @@ func getPrincipal(c *echo.Context) (*controlplanev1.Principal, bool) {
 	return p, ok
 }
 
-func authenticatePrincipal(c *echo.Context, verifier jwtVerifier, resolver func(ctx context.Context, email string, githubLogin string) (*controlplanev1.Principal, error)) (*controlplanev1.Principal, error) {
+// authenticatePrincipal returns non-nil grant only for staff API token requests.
+func authenticatePrincipal(c *echo.Context, verifier jwtVerifier, resolver func(ctx context.Context, email string, githubLogin string) (*controlplanev1.Principal, error), tokenAuth staffAPITokenAuthenticator) (*controlplanev1.Principal, *staffAPITokenGrant, error) {
 	req := c.Request()
 
 	// When running behind oauth2-proxy (dev/production), accept identity from headers
@@ -61,22 +71,39 @@ func authenticatePrincipal(c *echo.Context, verifier jwtVerifier, resolver func(
 	)
 	if email != "" {
 		if resolver == nil {
-			return nil, errs.Unauthorized{Msg: "staff auth misconfigured"}
+			return nil, nil, errs.Unauthorized{Msg: "staff auth misconfigured"}
 		}
 		p, err := resolver(req.Context(), email, login)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
 		if p == nil || strings.TrimSpace(p.UserId) == "" {
-			return nil, errs.Unauthorized{Msg: "staff auth misconfigured"}
+			return nil, nil, errs.Unauthorized{Msg: "staff auth misconfigured"}
 		}
-		return p, nil
+		return p, nil, nil
 	}
 
 	token := ""
 	if authz := req.Header.Get("Authorization"); strings.HasPrefix(authz, "Bearer ") {
 		token = strings.TrimSpace(strings.TrimPrefix(authz, "Bearer "))
 	}
+	if apitoken.IsToken(token) {
+		if tokenAuth == nil {
+			return nil, nil, errs.Unauthorized{Msg: "staff api tokens are not supported"}
+		}
+		resp, err := tokenAuth(req.Context(), token)
+		if err != nil {
+			return nil, nil, err
+		}
+		if resp.GetPrincipal() == nil || strings.TrimSpace(resp.GetPrincipal().GetUserId()) == "" {
+			return nil, nil, errs.Unauthorized{Msg: "invalid api token"}
+		}
+		return resp.GetPrincipal(), &staffAPITokenGrant{
+			TokenID:    resp.GetTokenId(),
+			Scopes:     resp.GetScopes(),
+			ProjectIDs: resp.GetProjectIds(),
+		}, nil
+	}
 	if token == "" {
 		if ck, err := c.Cookie(cookieAuthToken); err == nil && ck != nil {
 			token = ck.Value
@@ -85,14 +112,14 @@ func authenticatePrincipal(c *echo.Context, verifier jwtVerifier, resolver func(
 	if token == "" {
 		// For GET endpoints, surface unauthorized rather than method-level errors.
 		if req.Method == http.MethodGet || req.Method == http.MethodHead {
-			return nil, errs.Unauthorized{Msg: "missing auth token"}
+			return nil, nil, errs.Unauthorized{Msg: "missing auth token"}
 		}
-		return nil, errs.Unauthorized{Msg: "missing auth token"}
+		return nil, nil, errs.Unauthorized{Msg: "missing auth token"}
 	}
 
 	claims, err := verifier.VerifyJWT(token)
 	if err != nil {
-		return nil, errs.Unauthorized{Msg: "invalid auth token"}
+		return nil, nil, errs.Unauthorized{Msg: "invalid auth token"}
 	}
 
 	return &controlplanev1.Principal{
@@ -101,7 +128,7 @@ func authenticatePrincipal(c *echo.Context, verifier jwtVerifier, resolver func(
 		GithubLogin:     claims.GitHubLogin,
 		IsPlatformAdmin: claims.IsAdmin,
 		IsPlatformOwner: claims.IsOwner,
-	}, nil
+	}, nil, nil
 }
 
 func firstNonEmpty(vs ...string) string {
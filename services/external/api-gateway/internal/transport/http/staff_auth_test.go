@@ -34,7 +34,7 @@ func TestAuthenticatePrincipal_OAuth2ProxyHeaders_DelegatesToControlPlane(t *tes
 		}, nil
 	}
 
-	p, err := authenticatePrincipal(c, nil, resolver)
+	p, _, err := authenticatePrincipal(c, nil, resolver, nil)
 	if err != nil {
 		t.Fatalf("authenticatePrincipal failed: %v", err)
 	}
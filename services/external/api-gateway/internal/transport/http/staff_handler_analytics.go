@@ -0,0 +1,44 @@
+package http
+
+import (
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/casters"
+)
+
+func (h *staffHandler) GetRunOutcomeAnalytics(c *echo.Context) error {
+	return withPrincipalAndResolvedJSON(c, resolveRunOutcomeAnalyticsArg, h.getRunOutcomeAnalyticsCall, casters.RunOutcomeAnalytics)
+}
+
+func resolveRunOutcomeAnalyticsArg(c *echo.Context) (runOutcomeAnalyticsArg, error) {
+	arg := runOutcomeAnalyticsArg{projectID: strings.TrimSpace(c.QueryParam("project_id"))}
+	for _, field := range []string{"from", "to"} {
+		raw := strings.TrimSpace(c.QueryParam(field))
+		if raw == "" {
+			continue
+		}
+		value, err := time.Parse(time.RFC3339, raw)
+		if err != nil {
+			return runOutcomeAnalyticsArg{}, errs.Validation{Field: field, Msg: "must be RFC3339 timestamp"}
+		}
+		if field == "from" {
+			arg.from = timestamppb.New(value.UTC())
+		} else {
+			arg.to = timestamppb.New(value.UTC())
+		}
+	}
+	// group_by accepts both repeated params and comma-separated list.
+	for _, raw := range c.QueryParams()["group_by"] {
+		for _, dimension := range strings.Split(raw, ",") {
+			if dimension = strings.TrimSpace(dimension); dimension != "" {
+				arg.groupBy = append(arg.groupBy, dimension)
+			}
+		}
+	}
+	return arg, nil
+}
@@ -0,0 +1,34 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v5"
+
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/casters"
+)
+
+func (h *staffHandler) CreateServiceAccount(c *echo.Context) error {
+	return createByBodyResp(c, http.StatusCreated, h.createServiceAccountCall, casters.User)
+}
+
+func (h *staffHandler) ListStaffAPITokens(c *echo.Context) error {
+	return listByResolvedResp(c, resolveStaffAPITokenListArg, h.listStaffAPITokensCall, casters.StaffAPITokens)
+}
+
+func (h *staffHandler) CreateStaffAPIToken(c *echo.Context) error {
+	return createByBodyResp(c, http.StatusCreated, h.createStaffAPITokenCall, casters.CreatedStaffAPIToken)
+}
+
+func (h *staffHandler) RevokeStaffAPIToken(c *echo.Context) error {
+	return h.deleteWith1Param(c, "token_id", h.revokeStaffAPIToken)
+}
+
+func resolveStaffAPITokenListArg(c *echo.Context) (staffAPITokenListArg, error) {
+	limit, err := parseLimit(c, 200)
+	if err != nil {
+		return staffAPITokenListArg{}, err
+	}
+	return staffAPITokenListArg{userID: strings.TrimSpace(c.QueryParam("user_id")), limit: int32(limit)}, nil
+}
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/casters"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/models"
+)
+
+func (h *staffHandler) GetChangeGovernancePackage(c *echo.Context) error {
+	return getByPathResp(c, "package_id", h.getChangeGovernancePackageCall, casters.ChangeGovernancePackage)
+}
+
+func (h *staffHandler) SubmitChangeGovernanceWaiverDecision(c *echo.Context) error {
+	return withPrincipalAndResolved(c, resolvePath("package_id"), func(principal *controlplanev1.Principal, packageID string) error {
+		var req models.SubmitChangeGovernanceWaiverDecisionRequest
+		if err := bindBody(c, &req); err != nil {
+			return err
+		}
+		arg := changeGovernanceWaiverArg{packageID: packageID, body: req}
+		if raw := strings.TrimSpace(req.ExpiresAt); raw != "" {
+			expiresAt, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				return errs.Validation{Field: "expires_at", Msg: "must be RFC3339 timestamp"}
+			}
+			arg.expiresAt = timestamppb.New(expiresAt.UTC())
+		}
+
+		item, err := h.submitChangeGovernanceWaiverDecisionCall(c.Request().Context(), principal, arg)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, casters.ChangeGovernancePackage(item))
+	})
+}
+
+func (h *staffHandler) SubmitChangeGovernanceReleaseReadinessDecision(c *echo.Context) error {
+	return withPrincipalAndResolved(c, resolvePath("package_id"), func(principal *controlplanev1.Principal, packageID string) error {
+		var req models.SubmitChangeGovernanceReleaseReadinessDecisionRequest
+		if err := bindBody(c, &req); err != nil {
+			return err
+		}
+
+		item, err := h.submitChangeGovernanceReleaseReadinessDecisionCall(c.Request().Context(), principal, changeGovernanceReleaseArg{
+			packageID: packageID,
+			body:      req,
+		})
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, casters.ChangeGovernancePackage(item))
+	})
+}
+
+func (h *staffHandler) ReportChangeGovernanceFeedback(c *echo.Context) error {
+	return withPrincipalAndResolved(c, resolvePath("package_id"), func(principal *controlplanev1.Principal, packageID string) error {
+		var req models.ReportChangeGovernanceFeedbackRequest
+		if err := bindBody(c, &req); err != nil {
+			return err
+		}
+
+		item, err := h.reportChangeGovernanceFeedbackCall(c.Request().Context(), principal, changeGovernanceFeedbackArg{
+			packageID: packageID,
+			body:      req,
+		})
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, casters.ChangeGovernancePackage(item))
+	})
+}
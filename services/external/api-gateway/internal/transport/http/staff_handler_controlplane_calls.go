@@ -116,6 +116,62 @@ func buildResolveApprovalDecisionRequest(principal *controlplanev1.Principal, ar
 	}
 }
 
+func buildGetChangeGovernancePackageRequest(principal *controlplanev1.Principal, id string) *controlplanev1.GetChangeGovernancePackageRequest {
+	return &controlplanev1.GetChangeGovernancePackageRequest{Principal: principal, PackageId: id}
+}
+
+func buildSubmitChangeGovernanceWaiverDecisionRequest(principal *controlplanev1.Principal, arg changeGovernanceWaiverArg) *controlplanev1.SubmitChangeGovernanceWaiverDecisionRequest {
+	return &controlplanev1.SubmitChangeGovernanceWaiverDecisionRequest{
+		Principal:                 principal,
+		PackageId:                 arg.packageID,
+		DecisionId:                strings.TrimSpace(arg.body.DecisionID),
+		DecisionState:             strings.TrimSpace(arg.body.DecisionState),
+		ActorKind:                 optionalStringPtr(arg.body.ActorKind),
+		ResidualRiskTier:          optionalStringPtr(arg.body.ResidualRiskTier),
+		ReasonMarkdown:            strings.TrimSpace(arg.body.ReasonMarkdown),
+		ExpiresAt:                 arg.expiresAt,
+		ExpectedProjectionVersion: arg.body.ExpectedProjectionVersion,
+	}
+}
+
+func buildSubmitChangeGovernanceReleaseReadinessDecisionRequest(principal *controlplanev1.Principal, arg changeGovernanceReleaseArg) *controlplanev1.SubmitChangeGovernanceReleaseReadinessDecisionRequest {
+	return &controlplanev1.SubmitChangeGovernanceReleaseReadinessDecisionRequest{
+		Principal:                 principal,
+		PackageId:                 arg.packageID,
+		DecisionId:                strings.TrimSpace(arg.body.DecisionID),
+		DecisionState:             strings.TrimSpace(arg.body.DecisionState),
+		ActorKind:                 optionalStringPtr(arg.body.ActorKind),
+		SummaryMarkdown:           strings.TrimSpace(arg.body.SummaryMarkdown),
+		ExpectedProjectionVersion: arg.body.ExpectedProjectionVersion,
+	}
+}
+
+func buildReportChangeGovernanceFeedbackRequest(principal *controlplanev1.Principal, arg changeGovernanceFeedbackArg) *controlplanev1.ReportChangeGovernanceFeedbackRequest {
+	return &controlplanev1.ReportChangeGovernanceFeedbackRequest{
+		Principal:          principal,
+		PackageId:          arg.packageID,
+		FeedbackId:         strings.TrimSpace(arg.body.FeedbackID),
+		GapKind:            strings.TrimSpace(arg.body.GapKind),
+		SourceKind:         strings.TrimSpace(arg.body.SourceKind),
+		Severity:           strings.TrimSpace(arg.body.Severity),
+		SuggestedAction:    strings.TrimSpace(arg.body.SuggestedAction),
+		SummaryMarkdown:    strings.TrimSpace(arg.body.SummaryMarkdown),
+		RelatedArtifactRef: optionalStringPtr(arg.body.RelatedArtifactRef),
+	}
+}
+
+func buildPreviewRuntimeDeployRequest(principal *controlplanev1.Principal, body models.PreviewRuntimeDeployRequest) *controlplanev1.PreviewRuntimeDeployRequest {
+	return &controlplanev1.PreviewRuntimeDeployRequest{
+		Principal:          principal,
+		BuildRef:           strings.TrimSpace(body.BuildRef),
+		TargetEnv:          strings.TrimSpace(body.TargetEnv),
+		Namespace:          optionalStringPtr(body.Namespace),
+		SlotNo:             body.SlotNo,
+		RepositoryFullName: optionalStringPtr(body.RepositoryFullName),
+		ServicesYamlPath:   optionalStringPtr(body.ServicesYAMLPath),
+	}
+}
+
 func buildDeleteRunNamespaceRequest(principal *controlplanev1.Principal, id string) *controlplanev1.DeleteRunNamespaceRequest {
 	return &controlplanev1.DeleteRunNamespaceRequest{Principal: principal, RunId: id}
 }
@@ -174,6 +230,34 @@ func buildListRuntimeErrorsRequest(principal *controlplanev1.Principal, arg runt
 	}
 }
 
+func buildListRuntimeErrorGroupsRequest(principal *controlplanev1.Principal, arg runtimeErrorGroupsListArg) *controlplanev1.ListRuntimeErrorGroupsRequest {
+	return &controlplanev1.ListRuntimeErrorGroupsRequest{
+		Principal: principal,
+		Limit:     arg.limit,
+		Status:    optionalStringPtr(arg.status),
+		Level:     optionalStringPtr(arg.level),
+		Source:    optionalStringPtr(arg.source),
+		ProjectId: optionalStringPtr(arg.projectID),
+	}
+}
+
+func buildUpdateRuntimeErrorGroupStatusRequest(principal *controlplanev1.Principal, arg runtimeErrorGroupStatusArg) *controlplanev1.UpdateRuntimeErrorGroupStatusRequest {
+	return &controlplanev1.UpdateRuntimeErrorGroupStatusRequest{
+		Principal:  principal,
+		GroupId:    arg.groupID,
+		Status:     strings.TrimSpace(arg.body.Status),
+		MutedUntil: arg.mutedUntil,
+	}
+}
+
+func buildEscalateRuntimeErrorGroupRequest(principal *controlplanev1.Principal, arg runtimeErrorGroupEscalateArg) *controlplanev1.EscalateRuntimeErrorGroupRequest {
+	return &controlplanev1.EscalateRuntimeErrorGroupRequest{
+		Principal: principal,
+		GroupId:   arg.groupID,
+		Kind:      strings.TrimSpace(arg.body.Kind),
+	}
+}
+
 func buildMarkRuntimeErrorViewedRequest(principal *controlplanev1.Principal, runtimeErrorID string) *controlplanev1.MarkRuntimeErrorViewedRequest {
 	return &controlplanev1.MarkRuntimeErrorViewedRequest{
 		Principal:      principal,
@@ -181,6 +265,89 @@ func buildMarkRuntimeErrorViewedRequest(principal *controlplanev1.Principal, run
 	}
 }
 
+func buildListRetentionPoliciesRequest(principal *controlplanev1.Principal, projectID string) *controlplanev1.ListRetentionPoliciesRequest {
+	return &controlplanev1.ListRetentionPoliciesRequest{
+		Principal: principal,
+		ProjectId: optionalStringPtr(projectID),
+	}
+}
+
+func buildUpsertRetentionPolicyRequest(principal *controlplanev1.Principal, req models.UpsertRetentionPolicyRequest) *controlplanev1.UpsertRetentionPolicyRequest {
+	out := &controlplanev1.UpsertRetentionPolicyRequest{
+		Principal:     principal,
+		Target:        strings.TrimSpace(req.Target),
+		RetentionDays: req.RetentionDays,
+		Action:        strings.TrimSpace(req.Action),
+		Enabled:       req.Enabled,
+	}
+	if req.ProjectID != nil {
+		out.ProjectId = optionalStringPtr(*req.ProjectID)
+	}
+	return out
+}
+
+func buildDeleteRetentionPolicyRequest(principal *controlplanev1.Principal, policyID string) *controlplanev1.DeleteRetentionPolicyRequest {
+	return &controlplanev1.DeleteRetentionPolicyRequest{
+		Principal: principal,
+		PolicyId:  strings.TrimSpace(policyID),
+	}
+}
+
+func buildGetRunOutcomeAnalyticsRequest(principal *controlplanev1.Principal, arg runOutcomeAnalyticsArg) *controlplanev1.GetRunOutcomeAnalyticsRequest {
+	return &controlplanev1.GetRunOutcomeAnalyticsRequest{
+		Principal: principal,
+		ProjectId: optionalStringPtr(arg.projectID),
+		From:      arg.from,
+		To:        arg.to,
+		GroupBy:   arg.groupBy,
+	}
+}
+
+func buildSetFlowEventRetentionPinRequest(principal *controlplanev1.Principal, arg retentionPinArg) *controlplanev1.SetFlowEventRetentionPinRequest {
+	return &controlplanev1.SetFlowEventRetentionPinRequest{
+		Principal: principal,
+		EventId:   arg.eventID,
+		Pinned:    arg.pinned,
+	}
+}
+
+func buildCreateServiceAccountRequest(principal *controlplanev1.Principal, req models.CreateServiceAccountRequest) *controlplanev1.CreateServiceAccountRequest {
+	return &controlplanev1.CreateServiceAccountRequest{
+		Principal:       principal,
+		Name:            strings.TrimSpace(req.Name),
+		IsPlatformAdmin: req.IsPlatformAdmin,
+	}
+}
+
+func buildCreateStaffAPITokenRequest(principal *controlplanev1.Principal, req models.CreateStaffAPITokenRequest) *controlplanev1.CreateStaffAPITokenRequest {
+	out := &controlplanev1.CreateStaffAPITokenRequest{
+		Principal:     principal,
+		Name:          strings.TrimSpace(req.Name),
+		Scopes:        req.Scopes,
+		ProjectIds:    req.ProjectIDs,
+		ExpiresInDays: req.ExpiresInDays,
+	}
+	if req.UserID != nil {
+		out.UserId = optionalStringPtr(*req.UserID)
+	}
+	return out
+}
+
+func buildListStaffAPITokensRequest(principal *controlplanev1.Principal, arg staffAPITokenListArg) *controlplanev1.ListStaffAPITokensRequest {
+	return &controlplanev1.ListStaffAPITokensRequest{
+		Principal: principal,
+		UserId:    optionalStringPtr(arg.userID),
+		Limit:     arg.limit,
+	}
+}
+
+func buildRevokeStaffAPITokenRequest(principal *controlplanev1.Principal, tokenID string) *controlplanev1.RevokeStaffAPITokenRequest {
+	return &controlplanev1.RevokeStaffAPITokenRequest{
+		Principal: principal,
+		TokenId:   strings.TrimSpace(tokenID),
+	}
+}
+
 func buildListUsersRequest(principal *controlplanev1.Principal, limit int32) *controlplanev1.ListUsersRequest {
 	return &controlplanev1.ListUsersRequest{Principal: principal, Limit: limit}
 }
@@ -193,6 +360,38 @@ func buildListProjectMembersRequest(principal *controlplanev1.Principal, arg idL
 	return &controlplanev1.ListProjectMembersRequest{Principal: principal, ProjectId: arg.id, Limit: arg.limit}
 }
 
+func buildListProjectRolesRequest(principal *controlplanev1.Principal, projectID string) *controlplanev1.ListProjectRolesRequest {
+	return &controlplanev1.ListProjectRolesRequest{Principal: principal, ProjectId: projectID}
+}
+
+func buildUpsertProjectRoleRequest(principal *controlplanev1.Principal, arg projectRoleUpsertArg) *controlplanev1.UpsertProjectRoleRequest {
+	return &controlplanev1.UpsertProjectRoleRequest{
+		Principal:   principal,
+		ProjectId:   arg.projectID,
+		Name:        arg.name,
+		Description: arg.body.Description,
+		Permissions: arg.body.Permissions,
+	}
+}
+
+func buildListMCPApprovalPoliciesRequest(principal *controlplanev1.Principal, projectID string) *controlplanev1.ListMCPApprovalPoliciesRequest {
+	return &controlplanev1.ListMCPApprovalPoliciesRequest{Principal: principal, ProjectId: projectID}
+}
+
+func buildUpsertMCPApprovalPolicyRequest(principal *controlplanev1.Principal, arg mcpApprovalPolicyUpsertArg) *controlplanev1.UpsertMCPApprovalPolicyRequest {
+	return &controlplanev1.UpsertMCPApprovalPolicyRequest{
+		Principal:           principal,
+		ProjectId:           arg.projectID,
+		ToolName:            arg.body.ToolName,
+		Environment:         arg.body.Environment,
+		RequiredApprovals:   arg.body.RequiredApprovals,
+		EligibleRoles:       arg.body.EligibleRoles,
+		EligibleUserIds:     arg.body.EligibleUserIDs,
+		SeparationOfDuties:  arg.body.SeparationOfDuties,
+		ExpiresAfterSeconds: arg.body.ExpiresAfterSeconds,
+	}
+}
+
 func buildListProjectRepositoriesRequest(principal *controlplanev1.Principal, arg idLimitArg) *controlplanev1.ListProjectRepositoriesRequest {
 	return &controlplanev1.ListProjectRepositoriesRequest{Principal: principal, ProjectId: arg.id, Limit: arg.limit}
 }
@@ -226,6 +425,42 @@ func buildListMissionControlNodeActivityRequest(principal *controlplanev1.Princi
 	}
 }
 
+func buildListMissionControlVoiceCandidatesRequest(principal *controlplanev1.Principal, arg missionControlVoiceCandidatesArg) *controlplanev1.ListMissionControlVoiceCandidatesRequest {
+	req := &controlplanev1.ListMissionControlVoiceCandidatesRequest{
+		Principal: principal,
+		ProjectId: strings.TrimSpace(arg.projectID),
+		Limit:     arg.limit,
+	}
+	if status := strings.TrimSpace(arg.status); status != "" {
+		req.Statuses = []string{status}
+	}
+	return req
+}
+
+func buildPromoteMissionControlVoiceCandidateRequest(principal *controlplanev1.Principal, arg missionControlVoiceCandidatePromoteArg) *controlplanev1.PromoteMissionControlVoiceCandidateRequest {
+	req := &controlplanev1.PromoteMissionControlVoiceCandidateRequest{
+		Principal:     principal,
+		ProjectId:     strings.TrimSpace(arg.body.ProjectId),
+		CandidateId:   strings.TrimSpace(arg.candidateID),
+		Title:         optionalStringPtr(derefString(arg.body.Title)),
+		BodyMarkdown:  optionalStringPtr(derefString(arg.body.BodyMarkdown)),
+		InitialLabels: derefStringSlice(arg.body.InitialLabels),
+	}
+	if arg.body.CommandKind != nil {
+		req.CommandKind = optionalStringPtr(string(*arg.body.CommandKind))
+	}
+	return req
+}
+
+func buildRejectMissionControlVoiceCandidateRequest(principal *controlplanev1.Principal, arg missionControlVoiceCandidateRejectArg) *controlplanev1.RejectMissionControlVoiceCandidateRequest {
+	return &controlplanev1.RejectMissionControlVoiceCandidateRequest{
+		Principal:   principal,
+		ProjectId:   strings.TrimSpace(arg.body.ProjectId),
+		CandidateId: strings.TrimSpace(arg.candidateID),
+		Reason:      optionalStringPtr(derefString(arg.body.Reason)),
+	}
+}
+
 func buildPreviewMissionControlLaunchRequest(principal *controlplanev1.Principal, req generated.PreviewMissionControlLaunchJSONRequestBody) *controlplanev1.PreviewMissionControlLaunchRequest {
 	request := &controlplanev1.PreviewMissionControlLaunchRequest{
 		Principal:                 principal,
@@ -295,6 +530,15 @@ func (h *staffHandler) getRunCall(ctx context.Context, principal *controlplanev1
 	return callUnaryWithArg(ctx, principal, id, buildGetRunRequest, h.cp.Service().GetRun)
 }
 
+// resolveRunProject returns project of a run; used to enforce project-restricted staff API tokens.
+func (h *staffHandler) resolveRunProject(ctx context.Context, principal *controlplanev1.Principal, runID string) (string, error) {
+	run, err := h.getRunCall(ctx, principal, runID)
+	if err != nil {
+		return "", err
+	}
+	return run.GetProjectId(), nil
+}
+
 func (h *staffHandler) cancelRunCall(ctx context.Context, principal *controlplanev1.Principal, arg runActionArg) (*controlplanev1.RunActionResponse, error) {
 	return callUnaryWithArg(ctx, principal, arg, buildCancelRunRequest, h.cp.Service().CancelRun)
 }
@@ -318,6 +562,22 @@ func (h *staffHandler) resolveApprovalDecisionCall(
 	return callUnaryWithArg(ctx, principal, arg, buildResolveApprovalDecisionRequest, h.cp.Service().ResolveApprovalDecision)
 }
 
+func (h *staffHandler) getChangeGovernancePackageCall(ctx context.Context, principal *controlplanev1.Principal, id string) (*controlplanev1.ChangeGovernancePackage, error) {
+	return callUnaryWithArg(ctx, principal, id, buildGetChangeGovernancePackageRequest, h.cp.Service().GetChangeGovernancePackage)
+}
+
+func (h *staffHandler) submitChangeGovernanceWaiverDecisionCall(ctx context.Context, principal *controlplanev1.Principal, arg changeGovernanceWaiverArg) (*controlplanev1.ChangeGovernancePackage, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildSubmitChangeGovernanceWaiverDecisionRequest, h.cp.Service().SubmitChangeGovernanceWaiverDecision)
+}
+
+func (h *staffHandler) submitChangeGovernanceReleaseReadinessDecisionCall(ctx context.Context, principal *controlplanev1.Principal, arg changeGovernanceReleaseArg) (*controlplanev1.ChangeGovernancePackage, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildSubmitChangeGovernanceReleaseReadinessDecisionRequest, h.cp.Service().SubmitChangeGovernanceReleaseReadinessDecision)
+}
+
+func (h *staffHandler) reportChangeGovernanceFeedbackCall(ctx context.Context, principal *controlplanev1.Principal, arg changeGovernanceFeedbackArg) (*controlplanev1.ChangeGovernancePackage, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildReportChangeGovernanceFeedbackRequest, h.cp.Service().ReportChangeGovernanceFeedback)
+}
+
 func (h *staffHandler) deleteRunNamespaceCall(ctx context.Context, principal *controlplanev1.Principal, id string) (*controlplanev1.DeleteRunNamespaceResponse, error) {
 	return callUnaryWithArg(ctx, principal, id, buildDeleteRunNamespaceRequest, h.cp.Service().DeleteRunNamespace)
 }
@@ -343,6 +603,10 @@ func (h *staffHandler) cancelRuntimeDeployTaskCall(ctx context.Context, principa
 	return callUnaryWithArg(ctx, principal, arg, buildCancelRuntimeDeployTaskRequest, h.cp.Service().CancelRuntimeDeployTask)
 }
 
+func (h *staffHandler) previewRuntimeDeployCall(ctx context.Context, principal *controlplanev1.Principal, body models.PreviewRuntimeDeployRequest) (*controlplanev1.PreviewRuntimeDeployResponse, error) {
+	return callUnaryWithArg(ctx, principal, body, buildPreviewRuntimeDeployRequest, h.cp.Service().PreviewRuntimeDeploy)
+}
+
 func (h *staffHandler) stopRuntimeDeployTaskCall(ctx context.Context, principal *controlplanev1.Principal, arg runtimeDeployActionArg) (*controlplanev1.RuntimeDeployTaskActionResponse, error) {
 	return callUnaryWithArg(ctx, principal, arg, buildStopRuntimeDeployTaskRequest, h.cp.Service().StopRuntimeDeployTask)
 }
@@ -351,6 +615,57 @@ func (h *staffHandler) listRuntimeErrorsCall(ctx context.Context, principal *con
 	return callUnaryWithArg(ctx, principal, arg, buildListRuntimeErrorsRequest, h.cp.Service().ListRuntimeErrors)
 }
 
+func (h *staffHandler) listRuntimeErrorGroupsCall(ctx context.Context, principal *controlplanev1.Principal, arg runtimeErrorGroupsListArg) (*controlplanev1.ListRuntimeErrorGroupsResponse, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildListRuntimeErrorGroupsRequest, h.cp.Service().ListRuntimeErrorGroups)
+}
+
+func (h *staffHandler) updateRuntimeErrorGroupStatusCall(ctx context.Context, principal *controlplanev1.Principal, arg runtimeErrorGroupStatusArg) (*controlplanev1.RuntimeErrorGroup, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildUpdateRuntimeErrorGroupStatusRequest, h.cp.Service().UpdateRuntimeErrorGroupStatus)
+}
+
+func (h *staffHandler) escalateRuntimeErrorGroupCall(ctx context.Context, principal *controlplanev1.Principal, arg runtimeErrorGroupEscalateArg) (*controlplanev1.RuntimeErrorGroup, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildEscalateRuntimeErrorGroupRequest, h.cp.Service().EscalateRuntimeErrorGroup)
+}
+
+func (h *staffHandler) createServiceAccountCall(ctx context.Context, principal *controlplanev1.Principal, req models.CreateServiceAccountRequest) (*controlplanev1.User, error) {
+	return callUnaryWithArg(ctx, principal, req, buildCreateServiceAccountRequest, h.cp.Service().CreateServiceAccount)
+}
+
+func (h *staffHandler) createStaffAPITokenCall(ctx context.Context, principal *controlplanev1.Principal, req models.CreateStaffAPITokenRequest) (*controlplanev1.CreateStaffAPITokenResponse, error) {
+	return callUnaryWithArg(ctx, principal, req, buildCreateStaffAPITokenRequest, h.cp.Service().CreateStaffAPIToken)
+}
+
+func (h *staffHandler) listStaffAPITokensCall(ctx context.Context, principal *controlplanev1.Principal, arg staffAPITokenListArg) (*controlplanev1.ListStaffAPITokensResponse, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildListStaffAPITokensRequest, h.cp.Service().ListStaffAPITokens)
+}
+
+func (h *staffHandler) revokeStaffAPIToken(ctx context.Context, principal *controlplanev1.Principal, tokenID string) error {
+	_, err := callUnaryWithArg(ctx, principal, tokenID, buildRevokeStaffAPITokenRequest, h.cp.Service().RevokeStaffAPIToken)
+	return err
+}
+
+func (h *staffHandler) listRetentionPoliciesCall(ctx context.Context, principal *controlplanev1.Principal, projectID string) (*controlplanev1.ListRetentionPoliciesResponse, error) {
+	return callUnaryWithArg(ctx, principal, projectID, buildListRetentionPoliciesRequest, h.cp.Service().ListRetentionPolicies)
+}
+
+func (h *staffHandler) upsertRetentionPolicyCall(ctx context.Context, principal *controlplanev1.Principal, req models.UpsertRetentionPolicyRequest) (*controlplanev1.RetentionPolicy, error) {
+	return callUnaryWithArg(ctx, principal, req, buildUpsertRetentionPolicyRequest, h.cp.Service().UpsertRetentionPolicy)
+}
+
+func (h *staffHandler) deleteRetentionPolicy(ctx context.Context, principal *controlplanev1.Principal, policyID string) error {
+	_, err := callUnaryWithArg(ctx, principal, policyID, buildDeleteRetentionPolicyRequest, h.cp.Service().DeleteRetentionPolicy)
+	return err
+}
+
+func (h *staffHandler) getRunOutcomeAnalyticsCall(ctx context.Context, principal *controlplanev1.Principal, arg runOutcomeAnalyticsArg) (*controlplanev1.RunOutcomeAnalytics, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildGetRunOutcomeAnalyticsRequest, h.cp.Service().GetRunOutcomeAnalytics)
+}
+
+func (h *staffHandler) setFlowEventRetentionPinCall(ctx context.Context, principal *controlplanev1.Principal, arg retentionPinArg) error {
+	_, err := callUnaryWithArg(ctx, principal, arg, buildSetFlowEventRetentionPinRequest, h.cp.Service().SetFlowEventRetentionPin)
+	return err
+}
+
 func (h *staffHandler) markRuntimeErrorViewedCall(ctx context.Context, principal *controlplanev1.Principal, runtimeErrorID string) (*controlplanev1.RuntimeError, error) {
 	return callUnaryWithArg(ctx, principal, runtimeErrorID, buildMarkRuntimeErrorViewedRequest, h.cp.Service().MarkRuntimeErrorViewed)
 }
@@ -390,6 +705,18 @@ func (h *staffHandler) previewMissionControlLaunchCall(ctx context.Context, prin
 	return callUnaryWithArg(ctx, principal, req, buildPreviewMissionControlLaunchRequest, h.cp.Service().PreviewMissionControlLaunch)
 }
 
+func (h *staffHandler) listMissionControlVoiceCandidatesCall(ctx context.Context, principal *controlplanev1.Principal, arg missionControlVoiceCandidatesArg) (*controlplanev1.ListMissionControlVoiceCandidatesResponse, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildListMissionControlVoiceCandidatesRequest, h.cp.Service().ListMissionControlVoiceCandidates)
+}
+
+func (h *staffHandler) promoteMissionControlVoiceCandidateCall(ctx context.Context, principal *controlplanev1.Principal, arg missionControlVoiceCandidatePromoteArg) (*controlplanev1.PromoteMissionControlVoiceCandidateResponse, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildPromoteMissionControlVoiceCandidateRequest, h.cp.Service().PromoteMissionControlVoiceCandidate)
+}
+
+func (h *staffHandler) rejectMissionControlVoiceCandidateCall(ctx context.Context, principal *controlplanev1.Principal, arg missionControlVoiceCandidateRejectArg) (*controlplanev1.MissionControlVoiceCandidate, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildRejectMissionControlVoiceCandidateRequest, h.cp.Service().RejectMissionControlVoiceCandidate)
+}
+
 func (h *staffHandler) getMissionControlCommandCall(ctx context.Context, principal *controlplanev1.Principal, commandID string) (*controlplanev1.MissionControlCommandState, error) {
 	return callUnaryWithArg(ctx, principal, commandID, buildGetMissionControlCommandRequest, h.cp.Service().GetMissionControlCommand)
 }
@@ -410,6 +737,32 @@ func (h *staffHandler) deleteProjectMember(ctx context.Context, principal *contr
 	return err
 }
 
+func (h *staffHandler) listProjectRolesCall(ctx context.Context, principal *controlplanev1.Principal, projectID string) (*controlplanev1.ListProjectRolesResponse, error) {
+	return callUnaryWithArg(ctx, principal, projectID, buildListProjectRolesRequest, h.cp.Service().ListProjectRoles)
+}
+
+func (h *staffHandler) upsertProjectRoleCall(ctx context.Context, principal *controlplanev1.Principal, arg projectRoleUpsertArg) (*controlplanev1.ProjectRole, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildUpsertProjectRoleRequest, h.cp.Service().UpsertProjectRole)
+}
+
+func (h *staffHandler) deleteProjectRole(ctx context.Context, principal *controlplanev1.Principal, projectID string, roleName string) error {
+	_, err := h.cp.Service().DeleteProjectRole(ctx, &controlplanev1.DeleteProjectRoleRequest{Principal: principal, ProjectId: projectID, Name: roleName})
+	return err
+}
+
+func (h *staffHandler) listMCPApprovalPoliciesCall(ctx context.Context, principal *controlplanev1.Principal, projectID string) (*controlplanev1.ListMCPApprovalPoliciesResponse, error) {
+	return callUnaryWithArg(ctx, principal, projectID, buildListMCPApprovalPoliciesRequest, h.cp.Service().ListMCPApprovalPolicies)
+}
+
+func (h *staffHandler) upsertMCPApprovalPolicyCall(ctx context.Context, principal *controlplanev1.Principal, arg mcpApprovalPolicyUpsertArg) (*controlplanev1.MCPApprovalPolicy, error) {
+	return callUnaryWithArg(ctx, principal, arg, buildUpsertMCPApprovalPolicyRequest, h.cp.Service().UpsertMCPApprovalPolicy)
+}
+
+func (h *staffHandler) deleteMCPApprovalPolicy(ctx context.Context, principal *controlplanev1.Principal, projectID string, policyID string) error {
+	_, err := h.cp.Service().DeleteMCPApprovalPolicy(ctx, &controlplanev1.DeleteMCPApprovalPolicyRequest{Principal: principal, ProjectId: projectID, PolicyId: policyID})
+	return err
+}
+
 func (h *staffHandler) deleteProjectRepository(ctx context.Context, principal *controlplanev1.Principal, projectID string, repositoryID string) error {
 	req := controlplanev1.DeleteProjectRepositoryRequest{Principal: principal, ProjectId: projectID, RepositoryId: repositoryID}
 	_, err := h.cp.Service().DeleteProjectRepository(ctx, &req)
@@ -431,6 +784,13 @@ func derefStringSlice(value *[]string) []string {
 	return append([]string(nil), (*value)...)
 }
 
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
 func derefInt64(value *int64) int64 {
 	if value == nil {
 		return 0
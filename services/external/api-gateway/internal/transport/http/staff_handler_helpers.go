@@ -191,6 +191,22 @@ func resolveRuntimeErrorsListFilters(defLimit int) func(c *echo.Context) (runtim
 	}
 }
 
+func resolveRuntimeErrorGroupsListFilters(defLimit int) func(c *echo.Context) (runtimeErrorGroupsListArg, error) {
+	return func(c *echo.Context) (runtimeErrorGroupsListArg, error) {
+		limit, err := parseLimit(c, defLimit)
+		if err != nil {
+			return runtimeErrorGroupsListArg{}, err
+		}
+		return runtimeErrorGroupsListArg{
+			limit:     int32(limit),
+			status:    strings.TrimSpace(c.QueryParam("status")),
+			level:     strings.TrimSpace(c.QueryParam("level")),
+			source:    strings.TrimSpace(c.QueryParam("source")),
+			projectID: strings.TrimSpace(c.QueryParam("project_id")),
+		}, nil
+	}
+}
+
 func parseOptionalBool(raw string, field string) (bool, error) {
 	switch strings.ToLower(strings.TrimSpace(raw)) {
 	case "":
@@ -0,0 +1,33 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v5"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/casters"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/models"
+)
+
+func (h *staffHandler) ListMCPApprovalPolicies(c *echo.Context) error {
+	return getByPathResp(c, "project_id", h.listMCPApprovalPoliciesCall, casters.MCPApprovalPolicies)
+}
+
+func (h *staffHandler) UpsertMCPApprovalPolicy(c *echo.Context) error {
+	return withPrincipalAndResolved(c, resolvePath("project_id"), func(principal *controlplanev1.Principal, projectID string) error {
+		var req models.UpsertMCPApprovalPolicyRequest
+		if err := bindBody(c, &req); err != nil {
+			return err
+		}
+		item, err := h.upsertMCPApprovalPolicyCall(c.Request().Context(), principal, mcpApprovalPolicyUpsertArg{projectID: projectID, body: req})
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, casters.MCPApprovalPolicy(item))
+	})
+}
+
+func (h *staffHandler) DeleteMCPApprovalPolicy(c *echo.Context) error {
+	return h.deleteWith2Params(c, "project_id", "policy_id", h.deleteMCPApprovalPolicy)
+}
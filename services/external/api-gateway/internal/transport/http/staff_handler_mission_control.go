@@ -106,6 +106,44 @@ func (h *staffHandler) GetMissionControlCommand(c *echo.Context) error {
 	return getByPathResp(c, "command_id", h.getMissionControlCommandCall, casters.MissionControlCommandState)
 }
 
+func (h *staffHandler) ListMissionControlVoiceCandidates(c *echo.Context) error {
+	return withPrincipalAndResolved(c, resolveMissionControlVoiceCandidatesArg, func(principal *controlplanev1.Principal, arg missionControlVoiceCandidatesArg) error {
+		resp, err := h.listMissionControlVoiceCandidatesCall(c.Request().Context(), principal, arg)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, casters.MissionControlVoiceCandidateItems(resp.GetItems()))
+	})
+}
+
+func (h *staffHandler) PromoteMissionControlVoiceCandidate(c *echo.Context) error {
+	return withPrincipalAndResolved(c, resolvePath("candidate_id"), func(principal *controlplanev1.Principal, candidateID string) error {
+		var body generated.MissionControlVoiceCandidatePromoteRequest
+		if err := bindBody(c, &body); err != nil {
+			return err
+		}
+		resp, err := h.promoteMissionControlVoiceCandidateCall(c.Request().Context(), principal, missionControlVoiceCandidatePromoteArg{candidateID: candidateID, body: body})
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, casters.MissionControlVoiceCandidatePromotion(resp))
+	})
+}
+
+func (h *staffHandler) RejectMissionControlVoiceCandidate(c *echo.Context) error {
+	return withPrincipalAndResolved(c, resolvePath("candidate_id"), func(principal *controlplanev1.Principal, candidateID string) error {
+		var body generated.MissionControlVoiceCandidateRejectRequest
+		if err := bindBody(c, &body); err != nil {
+			return err
+		}
+		item, err := h.rejectMissionControlVoiceCandidateCall(c.Request().Context(), principal, missionControlVoiceCandidateRejectArg{candidateID: candidateID, body: body})
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, casters.MissionControlVoiceCandidate(item))
+	})
+}
+
 func (h *staffHandler) fetchMissionControlWorkspaceSnapshot(
 	ctx context.Context,
 	principal *controlplanev1.Principal,
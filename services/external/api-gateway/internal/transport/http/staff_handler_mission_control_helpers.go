@@ -10,6 +10,7 @@ import (
 	"github.com/labstack/echo/v5"
 
 	"github.com/codex-k8s/kodex/libs/go/errs"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/generated"
 )
 
 const (
@@ -36,6 +37,22 @@ type missionControlActivityArg struct {
 	limit        int32
 }
 
+type missionControlVoiceCandidatesArg struct {
+	projectID string
+	status    string
+	limit     int32
+}
+
+type missionControlVoiceCandidatePromoteArg struct {
+	candidateID string
+	body        generated.MissionControlVoiceCandidatePromoteRequest
+}
+
+type missionControlVoiceCandidateRejectArg struct {
+	candidateID string
+	body        generated.MissionControlVoiceCandidateRejectRequest
+}
+
 type missionControlResumeTokenPayload struct {
 	SnapshotID  string `json:"snapshot_id"`
 	ViewMode    string `json:"view_mode"`
@@ -60,6 +77,22 @@ func resolveMissionControlWorkspaceArg(c *echo.Context) (missionControlWorkspace
 	}, nil
 }
 
+func resolveMissionControlVoiceCandidatesArg(c *echo.Context) (missionControlVoiceCandidatesArg, error) {
+	projectID := strings.TrimSpace(c.QueryParam("project_id"))
+	if projectID == "" {
+		return missionControlVoiceCandidatesArg{}, errs.Validation{Field: "project_id", Msg: "is required"}
+	}
+	limit, err := parseLimit(c, missionControlDefaultLimit)
+	if err != nil {
+		return missionControlVoiceCandidatesArg{}, err
+	}
+	return missionControlVoiceCandidatesArg{
+		projectID: projectID,
+		status:    strings.TrimSpace(c.QueryParam("status")),
+		limit:     int32(limit),
+	}, nil
+}
+
 func resolveMissionControlNodeArg(c *echo.Context) (missionControlNodeArg, error) {
 	nodeKind, err := requirePathParam(c, "node_kind")
 	if err != nil {
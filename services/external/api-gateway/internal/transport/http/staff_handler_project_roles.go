@@ -0,0 +1,33 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v5"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/casters"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/models"
+)
+
+func (h *staffHandler) ListProjectRoles(c *echo.Context) error {
+	return getByPathResp(c, "project_id", h.listProjectRolesCall, casters.ProjectRoles)
+}
+
+func (h *staffHandler) UpsertProjectRole(c *echo.Context) error {
+	return withPrincipalAndTwoPaths(c, "project_id", "role_name", func(principal *controlplanev1.Principal, projectID string, roleName string) error {
+		var req models.UpsertProjectRoleRequest
+		if err := bindBody(c, &req); err != nil {
+			return err
+		}
+		item, err := h.upsertProjectRoleCall(c.Request().Context(), principal, projectRoleUpsertArg{projectID: projectID, name: roleName, body: req})
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, casters.ProjectRole(item))
+	})
+}
+
+func (h *staffHandler) DeleteProjectRole(c *echo.Context) error {
+	return h.deleteWith2Params(c, "project_id", "role_name", h.deleteProjectRole)
+}
@@ -0,0 +1,49 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v5"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/casters"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/models"
+)
+
+func (h *staffHandler) ListRetentionPolicies(c *echo.Context) error {
+	return listByResolvedResp(c, resolveQueryProjectID, h.listRetentionPoliciesCall, casters.RetentionPolicies)
+}
+
+func (h *staffHandler) UpsertRetentionPolicy(c *echo.Context) error {
+	return createByBodyResp(c, http.StatusOK, h.upsertRetentionPolicyCall, casters.RetentionPolicy)
+}
+
+func (h *staffHandler) DeleteRetentionPolicy(c *echo.Context) error {
+	return h.deleteWith1Param(c, "policy_id", h.deleteRetentionPolicy)
+}
+
+func (h *staffHandler) SetFlowEventRetentionPin(c *echo.Context) error {
+	return withPrincipalAndResolved(c, resolvePath("event_id"), func(principal *controlplanev1.Principal, rawID string) error {
+		eventID, err := strconv.ParseInt(strings.TrimSpace(rawID), 10, 64)
+		if err != nil || eventID <= 0 {
+			return errs.Validation{Field: "event_id", Msg: "must be a positive int64"}
+		}
+
+		var req models.SetFlowEventRetentionPinRequest
+		if err := bindBody(c, &req); err != nil {
+			return err
+		}
+
+		if err := h.setFlowEventRetentionPinCall(c.Request().Context(), principal, retentionPinArg{eventID: eventID, pinned: req.Pinned}); err != nil {
+			return err
+		}
+		return c.NoContent(http.StatusNoContent)
+	})
+}
+
+func resolveQueryProjectID(c *echo.Context) (string, error) {
+	return strings.TrimSpace(c.QueryParam("project_id")), nil
+}
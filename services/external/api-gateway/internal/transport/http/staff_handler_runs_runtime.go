@@ -104,6 +104,20 @@ func (h *staffHandler) StopRuntimeDeployTask(c *echo.Context) error {
 	return h.runtimeDeployTaskAction(c, h.stopRuntimeDeployTaskCall)
 }
 
+func (h *staffHandler) PreviewRuntimeDeploy(c *echo.Context) error {
+	return withPrincipal(c, func(principal *controlplanev1.Principal) error {
+		var req models.PreviewRuntimeDeployRequest
+		if err := bindBody(c, &req); err != nil {
+			return err
+		}
+		resp, err := h.previewRuntimeDeployCall(c.Request().Context(), principal, req)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, casters.RuntimeDeployPreview(resp))
+	})
+}
+
 func (h *staffHandler) runtimeDeployTaskAction(
 	c *echo.Context,
 	call func(ctx context.Context, principal *controlplanev1.Principal, arg runtimeDeployActionArg) (*controlplanev1.RuntimeDeployTaskActionResponse, error),
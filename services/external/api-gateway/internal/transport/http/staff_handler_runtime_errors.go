@@ -1,9 +1,17 @@
 package http
 
 import (
+	"net/http"
+	"strings"
+	"time"
+
 	"github.com/labstack/echo/v5"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
 	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/casters"
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/models"
 )
 
 // NOTE(kodex#226): Runtime errors endpoints are intentionally isolated because
@@ -20,3 +28,45 @@ func (h *staffHandler) ListRuntimeErrors(c *echo.Context) error {
 func (h *staffHandler) MarkRuntimeErrorViewed(c *echo.Context) error {
 	return getByPathResp(c, "runtime_error_id", h.markRuntimeErrorViewedCall, casters.RuntimeError)
 }
+
+func (h *staffHandler) ListRuntimeErrorGroups(c *echo.Context) error {
+	return listByResolvedResp(c, resolveRuntimeErrorGroupsListFilters(100), h.listRuntimeErrorGroupsCall, casters.RuntimeErrorGroups)
+}
+
+func (h *staffHandler) UpdateRuntimeErrorGroupStatus(c *echo.Context) error {
+	return withPrincipalAndResolved(c, resolvePath("group_id"), func(principal *controlplanev1.Principal, groupID string) error {
+		var req models.UpdateRuntimeErrorGroupStatusRequest
+		if err := bindBody(c, &req); err != nil {
+			return err
+		}
+		arg := runtimeErrorGroupStatusArg{groupID: groupID, body: req}
+		if raw := strings.TrimSpace(req.MutedUntil); raw != "" {
+			mutedUntil, err := time.Parse(time.RFC3339, raw)
+			if err != nil {
+				return errs.Validation{Field: "muted_until", Msg: "must be RFC3339 timestamp"}
+			}
+			arg.mutedUntil = timestamppb.New(mutedUntil.UTC())
+		}
+
+		item, err := h.updateRuntimeErrorGroupStatusCall(c.Request().Context(), principal, arg)
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, casters.RuntimeErrorGroup(item))
+	})
+}
+
+func (h *staffHandler) EscalateRuntimeErrorGroup(c *echo.Context) error {
+	return withPrincipalAndResolved(c, resolvePath("group_id"), func(principal *controlplanev1.Principal, groupID string) error {
+		var req models.EscalateRuntimeErrorGroupRequest
+		if err := bindBody(c, &req); err != nil {
+			return err
+		}
+
+		item, err := h.escalateRuntimeErrorGroupCall(c.Request().Context(), principal, runtimeErrorGroupEscalateArg{groupID: groupID, body: req})
+		if err != nil {
+			return err
+		}
+		return c.JSON(http.StatusOK, casters.RuntimeErrorGroup(item))
+	})
+}
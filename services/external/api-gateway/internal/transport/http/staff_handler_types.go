@@ -1,6 +1,9 @@
 package http
 
-import "github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/models"
+import (
+	"github.com/codex-k8s/kodex/services/external/api-gateway/internal/transport/http/models"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
 
 // pathLimit keeps parsed path id and list limit together for staff list handlers.
 type pathLimit struct {
@@ -85,3 +88,76 @@ type runtimeErrorsListArg struct {
 	runID         string
 	correlationID string
 }
+
+type runtimeErrorGroupsListArg struct {
+	limit     int32
+	status    string
+	level     string
+	source    string
+	projectID string
+}
+
+// runtimeErrorGroupStatusArg keeps path+body input for runtime error group status changes.
+type runtimeErrorGroupStatusArg struct {
+	groupID    string
+	body       models.UpdateRuntimeErrorGroupStatusRequest
+	mutedUntil *timestamppb.Timestamp
+}
+
+// runtimeErrorGroupEscalateArg keeps path+body input for runtime error group escalation.
+type runtimeErrorGroupEscalateArg struct {
+	groupID string
+	body    models.EscalateRuntimeErrorGroupRequest
+}
+
+// runOutcomeAnalyticsArg keeps query filters of run outcome analytics.
+type runOutcomeAnalyticsArg struct {
+	projectID string
+	from      *timestamppb.Timestamp
+	to        *timestamppb.Timestamp
+	groupBy   []string
+}
+
+// staffAPITokenListArg keeps query filters of staff API token listing.
+type staffAPITokenListArg struct {
+	userID string
+	limit  int32
+}
+
+// projectRoleUpsertArg keeps path+body input for custom project role upsert.
+type projectRoleUpsertArg struct {
+	projectID string
+	name      string
+	body      models.UpsertProjectRoleRequest
+}
+
+// mcpApprovalPolicyUpsertArg keeps path+body input for MCP approval policy upsert.
+type mcpApprovalPolicyUpsertArg struct {
+	projectID string
+	body      models.UpsertMCPApprovalPolicyRequest
+}
+
+// retentionPinArg keeps path+body input for flow event retention pin changes.
+type retentionPinArg struct {
+	eventID int64
+	pinned  bool
+}
+
+// changeGovernanceWaiverArg keeps path+body input for waiver decisions.
+type changeGovernanceWaiverArg struct {
+	packageID string
+	body      models.SubmitChangeGovernanceWaiverDecisionRequest
+	expiresAt *timestamppb.Timestamp
+}
+
+// changeGovernanceReleaseArg keeps path+body input for release decisions.
+type changeGovernanceReleaseArg struct {
+	packageID string
+	body      models.SubmitChangeGovernanceReleaseReadinessDecisionRequest
+}
+
+// changeGovernanceFeedbackArg keeps path+body input for governance feedback.
+type changeGovernanceFeedbackArg struct {
+	packageID string
+	body      models.ReportChangeGovernanceFeedbackRequest
+}
@@ -28,7 +28,7 @@ var (
 	webhookRequestsTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "kodex_webhook_requests_total",
-			Help: "Total number of GitHub webhook requests handled by api-gateway.",
+			Help: "Total number of webhook requests handled by api-gateway.",
 		},
 		[]string{"result", "event"},
 	)
@@ -36,7 +36,7 @@ var (
 	webhookDuration = promauto.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Name:    "kodex_webhook_duration_seconds",
-			Help:    "Duration of GitHub webhook ingestion in seconds.",
+			Help:    "Duration of webhook ingestion in seconds.",
 			Buckets: prometheus.DefBuckets,
 		},
 		[]string{"result", "event"},
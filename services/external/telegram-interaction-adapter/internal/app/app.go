@@ -52,6 +52,11 @@ func Run() error {
 		return fmt.Errorf("init telegram recipient resolver: %w", err)
 	}
 
+	projectChats, err := service.NewProjectChatResolver(cfg.TelegramProjectChatBindingsJSON)
+	if err != nil {
+		return fmt.Errorf("init telegram project chat bindings: %w", err)
+	}
+
 	botClient, err := service.NewTelegramBotClient(service.TelegramBotClientConfig{
 		Token:   cfg.TelegramBotToken,
 		Timeout: telegramHTTPTimeout,
@@ -61,23 +66,48 @@ func Run() error {
 		return fmt.Errorf("init telegram bot client: %w", err)
 	}
 
-	var speechToText service.SpeechToText
+	if cfg.TelegramSTTMinConfidence < 0 || cfg.TelegramSTTMinConfidence > 1 {
+		return fmt.Errorf("KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_MIN_CONFIDENCE must be within [0, 1]")
+	}
+	speechLanguageHints, err := service.ParseSpeechLanguageHints(cfg.TelegramSTTProjectLanguagesJSON)
+	if err != nil {
+		return err
+	}
+
+	speechToText, err := service.NewSpeechToText(service.SpeechToTextConfig{
+		Backend:      cfg.TelegramSTTBackend,
+		OpenAIAPIKey: cfg.OpenAIAPIKey,
+		OpenAIModel:  cfg.TelegramSTTModel,
+		HTTPURL:      cfg.TelegramSTTHTTPURL,
+		HTTPAPIKey:   cfg.TelegramSTTHTTPAPIKey,
+		HTTPModel:    cfg.TelegramSTTHTTPModel,
+		EchoText:     cfg.TelegramSTTEchoText,
+		Timeout:      sttTimeout,
+		Logger:       logger,
+	})
+	if err != nil {
+		return fmt.Errorf("init telegram speech-to-text: %w", err)
+	}
 	var audioConverter service.AudioConverter
-	if strings.TrimSpace(cfg.OpenAIAPIKey) != "" {
-		speechToText = service.NewOpenAISpeechToText(cfg.OpenAIAPIKey, cfg.TelegramSTTModel, sttTimeout, logger)
+	if speechToText != nil {
 		audioConverter = service.FFmpegAudioConverter{}
 	}
 
 	adapterService, err := service.New(service.Config{
-		PublicBaseURL:  cfg.PublicBaseURL,
-		WebhookSecret:  cfg.TelegramWebhookSecret,
-		DeliveryToken:  cfg.TelegramDeliveryBearerToken,
-		Recipients:     recipientResolver,
-		Bot:            botClient,
-		CallbackSink:   service.NewControlPlaneCallbackSink(controlPlaneClient),
-		AudioConverter: audioConverter,
-		SpeechToText:   speechToText,
-		Logger:         logger,
+		PublicBaseURL:       cfg.PublicBaseURL,
+		WebhookSecret:       cfg.TelegramWebhookSecret,
+		DeliveryToken:       cfg.TelegramDeliveryBearerToken,
+		Recipients:          recipientResolver,
+		Bot:                 botClient,
+		CallbackSink:        service.NewControlPlaneCallbackSink(controlPlaneClient),
+		AudioConverter:      audioConverter,
+		SpeechToText:        speechToText,
+		TranscriptPolicy:    service.TranscriptPolicy{MinConfidence: cfg.TelegramSTTMinConfidence},
+		SpeechLanguageHints: speechLanguageHints,
+		ProjectChats:        projectChats,
+		VoiceCandidates:     service.NewControlPlaneVoiceCandidateSink(controlPlaneClient),
+		ChatOps:             service.NewControlPlaneChatOpsBackend(controlPlaneClient),
+		Logger:              logger,
 	})
 	if err != nil {
 		return fmt.Errorf("init telegram adapter service: %w", err)
@@ -21,12 +21,22 @@ type Config struct {
 	TelegramBotToken string `env:"KODEX_TELEGRAM_BOT_TOKEN"`
 	TelegramChatID   string `env:"KODEX_TELEGRAM_CHAT_ID"`
 
-	TelegramRecipientBindingsJSON string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_RECIPIENT_BINDINGS_JSON"`
-	TelegramDeliveryBearerToken   string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_BEARER_TOKEN"`
-	TelegramWebhookSecret         string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_WEBHOOK_SECRET"`
-	TelegramHTTPTimeout           string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_HTTP_TIMEOUT" envDefault:"10s"`
-	TelegramSTTModel              string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_MODEL" envDefault:"gpt-4o-mini-transcribe"`
-	TelegramSTTTimeout            string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_TIMEOUT" envDefault:"30s"`
+	TelegramRecipientBindingsJSON   string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_RECIPIENT_BINDINGS_JSON"`
+	TelegramProjectChatBindingsJSON string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_PROJECT_CHAT_BINDINGS_JSON"`
+	TelegramDeliveryBearerToken     string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_BEARER_TOKEN"`
+	TelegramWebhookSecret           string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_WEBHOOK_SECRET"`
+	TelegramHTTPTimeout             string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_HTTP_TIMEOUT" envDefault:"10s"`
+	TelegramSTTModel                string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_MODEL" envDefault:"gpt-4o-mini-transcribe"`
+	TelegramSTTTimeout              string `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_TIMEOUT" envDefault:"30s"`
+
+	// TelegramSTTBackend selects the speech-to-text backend: openai, http (self-hosted Whisper-compatible server) or echo.
+	TelegramSTTBackend              string  `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_BACKEND" envDefault:"openai"`
+	TelegramSTTHTTPURL              string  `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_URL"`
+	TelegramSTTHTTPAPIKey           string  `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_API_KEY"`
+	TelegramSTTHTTPModel            string  `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_MODEL"`
+	TelegramSTTEchoText             string  `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_ECHO_TEXT"`
+	TelegramSTTMinConfidence        float64 `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_MIN_CONFIDENCE" envDefault:"0"`
+	TelegramSTTProjectLanguagesJSON string  `env:"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_PROJECT_LANGUAGES_JSON"`
 }
 
 // LoadConfig parses and validates environment configuration.
@@ -9,6 +9,8 @@ func TestLoadConfigDefaults(t *testing.T) {
 	t.Setenv("KODEX_TELEGRAM_INTERACTION_ADAPTER_HTTP_TIMEOUT", "")
 	t.Setenv("KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_MODEL", "")
 	t.Setenv("KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_TIMEOUT", "")
+	t.Setenv("KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_BACKEND", "")
+	t.Setenv("KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_MIN_CONFIDENCE", "")
 
 	cfg, err := LoadConfig()
 	if err != nil {
@@ -32,6 +34,12 @@ func TestLoadConfigDefaults(t *testing.T) {
 	if cfg.TelegramSTTTimeout != "30s" {
 		t.Fatalf("TelegramSTTTimeout = %q, want 30s", cfg.TelegramSTTTimeout)
 	}
+	if cfg.TelegramSTTBackend != "openai" {
+		t.Fatalf("TelegramSTTBackend = %q, want openai", cfg.TelegramSTTBackend)
+	}
+	if cfg.TelegramSTTMinConfidence != 0 {
+		t.Fatalf("TelegramSTTMinConfidence = %v, want 0", cfg.TelegramSTTMinConfidence)
+	}
 }
 
 func TestTelegramWebhookSyncEnabled(t *testing.T) {
@@ -52,3 +52,101 @@ func (c *Client) SubmitAdapterInteractionCallback(
 	}
 	return c.svc.SubmitAdapterInteractionCallback(ctx, req)
 }
+
+// CreateMissionControlVoiceCandidate stores one project chat transcript as a Mission Control draft.
+func (c *Client) CreateMissionControlVoiceCandidate(
+	ctx context.Context,
+	req *controlplanev1.CreateMissionControlVoiceCandidateRequest,
+) (*controlplanev1.MissionControlVoiceCandidate, error) {
+	if req == nil {
+		return nil, fmt.Errorf("create mission control voice candidate request is required")
+	}
+	return c.svc.CreateMissionControlVoiceCandidate(ctx, req)
+}
+
+// ResolveStaffByGitHubLogin resolves the staff principal bound to one chat recipient login.
+func (c *Client) ResolveStaffByGitHubLogin(
+	ctx context.Context,
+	req *controlplanev1.ResolveStaffByGitHubLoginRequest,
+) (*controlplanev1.ResolveStaffByGitHubLoginResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("resolve staff by github login request is required")
+	}
+	return c.svc.ResolveStaffByGitHubLogin(ctx, req)
+}
+
+// ListRuns returns runs visible to the principal.
+func (c *Client) ListRuns(ctx context.Context, req *controlplanev1.ListRunsRequest) (*controlplanev1.ListRunsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("list runs request is required")
+	}
+	return c.svc.ListRuns(ctx, req)
+}
+
+// GetRun returns one run visible to the principal.
+func (c *Client) GetRun(ctx context.Context, req *controlplanev1.GetRunRequest) (*controlplanev1.Run, error) {
+	if req == nil {
+		return nil, fmt.Errorf("get run request is required")
+	}
+	return c.svc.GetRun(ctx, req)
+}
+
+// ListRunEvents returns the latest flow events of one run.
+func (c *Client) ListRunEvents(ctx context.Context, req *controlplanev1.ListRunEventsRequest) (*controlplanev1.ListRunEventsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("list run events request is required")
+	}
+	return c.svc.ListRunEvents(ctx, req)
+}
+
+// CancelRun cancels one run on behalf of the principal.
+func (c *Client) CancelRun(ctx context.Context, req *controlplanev1.CancelRunRequest) (*controlplanev1.RunActionResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("cancel run request is required")
+	}
+	return c.svc.CancelRun(ctx, req)
+}
+
+// ListPendingApprovals returns approval requests waiting for a decision.
+func (c *Client) ListPendingApprovals(
+	ctx context.Context,
+	req *controlplanev1.ListPendingApprovalsRequest,
+) (*controlplanev1.ListPendingApprovalsResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("list pending approvals request is required")
+	}
+	return c.svc.ListPendingApprovals(ctx, req)
+}
+
+// ResolveApprovalDecision records one approve/deny decision.
+func (c *Client) ResolveApprovalDecision(
+	ctx context.Context,
+	req *controlplanev1.ResolveApprovalDecisionRequest,
+) (*controlplanev1.ResolveApprovalDecisionResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("resolve approval decision request is required")
+	}
+	return c.svc.ResolveApprovalDecision(ctx, req)
+}
+
+// PreviewNextStepAction returns the label diff a next-step action would apply.
+func (c *Client) PreviewNextStepAction(
+	ctx context.Context,
+	req *controlplanev1.NextStepActionRequest,
+) (*controlplanev1.NextStepActionResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("preview next step action request is required")
+	}
+	return c.svc.PreviewNextStepAction(ctx, req)
+}
+
+// ExecuteNextStepAction applies one next-step action.
+func (c *Client) ExecuteNextStepAction(
+	ctx context.Context,
+	req *controlplanev1.NextStepActionRequest,
+) (*controlplanev1.NextStepActionResponse, error) {
+	if req == nil {
+		return nil, fmt.Errorf("execute next step action request is required")
+	}
+	return c.svc.ExecuteNextStepAction(ctx, req)
+}
@@ -0,0 +1,412 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mymmrac/telego"
+)
+
+const (
+	chatOpsCommandHelp      = "help"
+	chatOpsCommandRuns      = "runs"
+	chatOpsCommandRun       = "run"
+	chatOpsCommandCancel    = "cancel"
+	chatOpsCommandApprovals = "approvals"
+	chatOpsCommandNext      = "next"
+
+	// Telegram limits callback data to 64 bytes, so buttons carry short ids instead of full requests.
+	chatOpsCallbackPrefix         = "ops:"
+	chatOpsCallbackApprovalPrefix = "ops:ap:"
+	chatOpsCallbackNextStepPrefix = "ops:nx:"
+
+	chatOpsDecisionApproved = "approved"
+	chatOpsDecisionDenied   = "denied"
+
+	chatOpsRunsMessageLimit = 10
+	chatOpsNextStepTTL      = 10 * time.Minute
+)
+
+type chatOpsCommand struct {
+	Name string
+	Args []string
+}
+
+// parseChatOpsCommand recognizes ChatOps slash commands, including the "/cmd@bot" group form.
+func parseChatOpsCommand(text string) (chatOpsCommand, bool) {
+	fields := strings.Fields(strings.TrimSpace(text))
+	if len(fields) == 0 || !strings.HasPrefix(fields[0], "/") {
+		return chatOpsCommand{}, false
+	}
+	name, _, _ := strings.Cut(strings.TrimPrefix(fields[0], "/"), "@")
+	name = strings.ToLower(name)
+	switch name {
+	case chatOpsCommandHelp, chatOpsCommandRuns, chatOpsCommandRun, chatOpsCommandCancel, chatOpsCommandApprovals, chatOpsCommandNext:
+		return chatOpsCommand{Name: name, Args: fields[1:]}, true
+	default:
+		return chatOpsCommand{}, false
+	}
+}
+
+// chatOpsLogin returns the platform login bound to a private chat; group chats are never attributable to one user.
+func (s *Service) chatOpsLogin(chat telego.Chat) (string, bool) {
+	if chat.Type != telego.ChatTypePrivate {
+		return "", false
+	}
+	return s.recipients.LoginForChat(chat.ID)
+}
+
+func (s *Service) handleChatOpsCommand(ctx context.Context, message *telego.Message, command chatOpsCommand) error {
+	locale := telegramLocale(message.From)
+	chatID := message.Chat.ID
+	login, ok := s.chatOpsLogin(message.Chat)
+	if !ok {
+		recordChatOpsCommand(command.Name, metricStatusRejected)
+		s.sendBestEffortChatMessage(ctx, chatID, s.messages.Render(locale, "chatops_unbound", nil))
+		return nil
+	}
+
+	var err error
+	switch command.Name {
+	case chatOpsCommandRuns:
+		err = s.chatOpsListRuns(ctx, chatID, locale, login)
+	case chatOpsCommandRun, chatOpsCommandCancel:
+		if len(command.Args) != 1 {
+			s.replyChatOpsHelp(ctx, chatID, locale, command.Name, metricStatusRejected)
+			return nil
+		}
+		if command.Name == chatOpsCommandRun {
+			err = s.chatOpsShowRun(ctx, chatID, locale, login, command.Args[0])
+		} else {
+			err = s.chatOpsCancelRun(ctx, chatID, locale, login, command.Args[0])
+		}
+	case chatOpsCommandApprovals:
+		err = s.chatOpsListApprovals(ctx, chatID, locale, login)
+	case chatOpsCommandNext:
+		step, ok := parseChatOpsNextStep(command.Args)
+		if !ok {
+			s.replyChatOpsHelp(ctx, chatID, locale, command.Name, metricStatusRejected)
+			return nil
+		}
+		err = s.chatOpsPreviewNextStep(ctx, chatID, locale, login, step)
+	default:
+		s.replyChatOpsHelp(ctx, chatID, locale, command.Name, metricStatusAccepted)
+		return nil
+	}
+	if err != nil {
+		s.replyChatOpsError(ctx, chatID, locale, command.Name, err)
+		return nil
+	}
+	recordChatOpsCommand(command.Name, metricStatusAccepted)
+	return nil
+}
+
+func (s *Service) replyChatOpsHelp(ctx context.Context, chatID int64, locale string, command string, metricStatus string) {
+	recordChatOpsCommand(command, metricStatus)
+	s.sendBestEffortChatMessage(ctx, chatID, s.messages.Render(locale, "chatops_help", nil))
+}
+
+func (s *Service) chatOpsListRuns(ctx context.Context, chatID int64, locale string, login string) error {
+	runs, err := s.chatOps.ListActiveRuns(ctx, login)
+	if err != nil {
+		return err
+	}
+	if len(runs) == 0 {
+		s.sendBestEffortChatMessage(ctx, chatID, s.messages.Render(locale, "chatops_runs_empty", nil))
+		return nil
+	}
+	data := chatOpsRunsMessageData{Runs: runs}
+	if len(runs) > chatOpsRunsMessageLimit {
+		data.Runs = runs[:chatOpsRunsMessageLimit]
+		data.Omitted = len(runs) - chatOpsRunsMessageLimit
+	}
+	s.sendBestEffortChatMessage(ctx, chatID, s.messages.Render(locale, "chatops_runs", data))
+	return nil
+}
+
+func (s *Service) chatOpsShowRun(ctx context.Context, chatID int64, locale string, login string, runID string) error {
+	details, err := s.chatOps.GetRun(ctx, login, runID)
+	if err != nil {
+		return err
+	}
+	s.sendBestEffortChatMessage(ctx, chatID, s.messages.Render(locale, "chatops_run", details))
+	return nil
+}
+
+func (s *Service) chatOpsCancelRun(ctx context.Context, chatID int64, locale string, login string, runID string) error {
+	outcome, err := s.chatOps.CancelRun(ctx, login, runID)
+	if err != nil {
+		return err
+	}
+	s.sendBestEffortChatMessage(ctx, chatID, s.messages.Render(locale, "chatops_cancel_done", outcome))
+	return nil
+}
+
+func (s *Service) chatOpsListApprovals(ctx context.Context, chatID int64, locale string, login string) error {
+	approvals, err := s.chatOps.ListPendingApprovals(ctx, login)
+	if err != nil {
+		return err
+	}
+	if len(approvals) == 0 {
+		s.sendBestEffortChatMessage(ctx, chatID, s.messages.Render(locale, "chatops_approvals_empty", nil))
+		return nil
+	}
+	// One message per approval keeps each keyboard removable once its decision is recorded.
+	for _, approval := range approvals {
+		callbackID := strconv.FormatInt(approval.ID, 10)
+		if _, err := s.bot.SendMessage(ctx, SendMessageRequest{
+			ChatID: chatID,
+			Text:   s.messages.Render(locale, "chatops_approval", approval),
+			InlineOptions: []InlineOption{
+				{
+					Label:        s.messages.Render(locale, "chatops_button_approve", nil),
+					CallbackData: chatOpsCallbackApprovalPrefix + callbackID + ":" + chatOpsDecisionApproved,
+				},
+				{
+					Label:        s.messages.Render(locale, "chatops_button_deny", nil),
+					CallbackData: chatOpsCallbackApprovalPrefix + callbackID + ":" + chatOpsDecisionDenied,
+				},
+			},
+		}); err != nil {
+			s.logger.Warn("send telegram chatops approval failed", "chat_id", chatID, "approval_request_id", approval.ID, "err", err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) chatOpsPreviewNextStep(ctx context.Context, chatID int64, locale string, login string, step ChatOpsNextStep) error {
+	outcome, err := s.chatOps.PreviewNextStep(ctx, login, step)
+	if err != nil {
+		return err
+	}
+	token, err := s.chatOpsNextSteps.Put(chatOpsPendingNextStep{GitHubLogin: login, ChatID: chatID, Step: step})
+	if err != nil {
+		return fmt.Errorf("store pending next step: %w", err)
+	}
+	if _, err := s.bot.SendMessage(ctx, SendMessageRequest{
+		ChatID: chatID,
+		Text:   s.messages.Render(locale, "chatops_next_preview", chatOpsNextStepMessageData{Step: step, Outcome: outcome}),
+		InlineOptions: []InlineOption{{
+			Label:        s.messages.Render(locale, "chatops_button_execute", nil),
+			CallbackData: chatOpsCallbackNextStepPrefix + token,
+		}},
+	}); err != nil {
+		s.logger.Warn("send telegram chatops next step preview failed", "chat_id", chatID, "err", err)
+	}
+	return nil
+}
+
+func (s *Service) handleChatOpsCallback(ctx context.Context, query *telego.CallbackQuery, data string) error {
+	locale := telegramLocale(&query.From)
+	if query.Message == nil {
+		recordChatOpsCommand(chatOpsCommandFromCallback(data), metricStatusIgnored)
+		return s.bot.AnswerCallbackQuery(ctx, AnswerCallbackQueryRequest{
+			QueryID: query.ID,
+			Text:    s.messages.Render(locale, "callback_ack_unavailable", nil),
+		})
+	}
+	chat := query.Message.GetChat()
+	login, ok := s.chatOpsLogin(chat)
+	if !ok || chat.ID != query.From.ID {
+		recordChatOpsCommand(chatOpsCommandFromCallback(data), metricStatusRejected)
+		return s.bot.AnswerCallbackQuery(ctx, AnswerCallbackQueryRequest{
+			QueryID: query.ID,
+			Text:    s.messages.Render(locale, "chatops_unbound", nil),
+		})
+	}
+
+	if err := s.bot.AnswerCallbackQuery(ctx, AnswerCallbackQueryRequest{
+		QueryID: query.ID,
+		Text:    s.messages.Render(locale, "callback_ack_received", nil),
+	}); err != nil {
+		s.logger.Warn("answer telegram callback query failed", "provider_callback_query_id", query.ID, "err", err)
+	}
+
+	command := chatOpsCommandFromCallback(data)
+	var (
+		text string
+		err  error
+	)
+	switch command {
+	case chatOpsCommandApprovals:
+		text, err = s.chatOpsResolveApproval(ctx, locale, login, strings.TrimPrefix(data, chatOpsCallbackApprovalPrefix))
+	case chatOpsCommandNext:
+		text, err = s.chatOpsExecuteNextStep(ctx, locale, login, chat.ID, strings.TrimPrefix(data, chatOpsCallbackNextStepPrefix))
+	default:
+		text = s.messages.Render(locale, "callback_ack_unavailable", nil)
+	}
+	if err != nil {
+		s.replyChatOpsError(ctx, chat.ID, locale, command, err)
+		return nil
+	}
+
+	if err := s.bot.EditMessageKeyboard(ctx, EditMessageKeyboardRequest{
+		ChatID:    chat.ID,
+		MessageID: query.Message.GetMessageID(),
+	}); err != nil {
+		s.logger.Warn("remove telegram chatops keyboard failed", "chat_id", chat.ID, "err", err)
+	}
+	s.sendBestEffortChatMessage(ctx, chat.ID, text)
+	recordChatOpsCommand(command, metricStatusAccepted)
+	return nil
+}
+
+func (s *Service) chatOpsResolveApproval(ctx context.Context, locale string, login string, payload string) (string, error) {
+	rawID, decision, _ := strings.Cut(payload, ":")
+	approvalID, err := strconv.ParseInt(rawID, 10, 64)
+	if err != nil || approvalID <= 0 || (decision != chatOpsDecisionApproved && decision != chatOpsDecisionDenied) {
+		return s.messages.Render(locale, "callback_ack_unavailable", nil), nil
+	}
+	outcome, err := s.chatOps.ResolveApproval(ctx, login, approvalID, decision)
+	if err != nil {
+		return "", err
+	}
+	return s.messages.Render(locale, "chatops_approval_resolved", outcome), nil
+}
+
+func (s *Service) chatOpsExecuteNextStep(ctx context.Context, locale string, login string, chatID int64, token string) (string, error) {
+	pending, ok := s.chatOpsNextSteps.Take(token)
+	if !ok || pending.GitHubLogin != login || pending.ChatID != chatID {
+		return s.messages.Render(locale, "chatops_next_expired", nil), nil
+	}
+	outcome, err := s.chatOps.ExecuteNextStep(ctx, login, pending.Step)
+	if err != nil {
+		return "", err
+	}
+	return s.messages.Render(locale, "chatops_next_done", chatOpsNextStepMessageData{Step: pending.Step, Outcome: outcome}), nil
+}
+
+func (s *Service) replyChatOpsError(ctx context.Context, chatID int64, locale string, command string, err error) {
+	key := "chatops_failed"
+	var reason string
+	var classified *chatOpsError
+	if errors.As(err, &classified) {
+		reason = classified.reason
+		switch {
+		case errors.Is(err, errChatOpsForbidden):
+			key = "chatops_forbidden"
+		case errors.Is(err, errChatOpsNotFound):
+			key = "chatops_not_found"
+		case errors.Is(err, errChatOpsRejected):
+			key = "chatops_rejected"
+		}
+		recordChatOpsCommand(command, metricStatusRejected)
+	} else {
+		recordChatOpsCommand(command, metricStatusFailed)
+		s.logger.Warn("telegram chatops command failed", "command", command, "chat_id", chatID, "err", err)
+	}
+	s.sendBestEffortChatMessage(ctx, chatID, s.messages.Render(locale, key, chatOpsErrorMessageData{Reason: reason}))
+}
+
+func chatOpsCommandFromCallback(data string) string {
+	switch {
+	case strings.HasPrefix(data, chatOpsCallbackApprovalPrefix):
+		return chatOpsCommandApprovals
+	case strings.HasPrefix(data, chatOpsCallbackNextStepPrefix):
+		return chatOpsCommandNext
+	default:
+		return metricLabelUnknown
+	}
+}
+
+// parseChatOpsNextStep accepts "<owner/repo>#<issue> <label>" or "<issue url> <label>".
+func parseChatOpsNextStep(args []string) (ChatOpsNextStep, bool) {
+	if len(args) != 2 {
+		return ChatOpsNextStep{}, false
+	}
+	repository, rawNumber, ok := strings.Cut(args[0], "#")
+	if !ok {
+		repository, rawNumber, ok = parseGitHubIssueURL(args[0])
+		if !ok {
+			return ChatOpsNextStep{}, false
+		}
+	}
+	number, err := strconv.ParseInt(rawNumber, 10, 32)
+	owner, name, hasSlash := strings.Cut(repository, "/")
+	if err != nil || number <= 0 || !hasSlash || owner == "" || name == "" || strings.Contains(name, "/") {
+		return ChatOpsNextStep{}, false
+	}
+	return ChatOpsNextStep{
+		RepositoryFullName: repository,
+		IssueNumber:        int32(number),
+		TargetLabel:        strings.TrimSpace(args[1]),
+	}, true
+}
+
+func parseGitHubIssueURL(raw string) (string, string, bool) {
+	parsed, err := url.Parse(raw)
+	if err != nil || parsed.Host != "github.com" {
+		return "", "", false
+	}
+	parts := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+	if len(parts) != 4 || parts[2] != "issues" {
+		return "", "", false
+	}
+	return parts[0] + "/" + parts[1], parts[3], true
+}
+
+type chatOpsPendingNextStep struct {
+	GitHubLogin string
+	ChatID      int64
+	Step        ChatOpsNextStep
+	ExpiresAt   time.Time
+}
+
+// chatOpsPendingNextSteps keeps previewed next-step actions until the user confirms them.
+// State is per-replica and short-lived: an expired or lost preview only asks the user to run /next again.
+type chatOpsPendingNextSteps struct {
+	mu    sync.Mutex
+	items map[string]chatOpsPendingNextStep
+	now   func() time.Time
+}
+
+func newChatOpsPendingNextSteps() *chatOpsPendingNextSteps {
+	return &chatOpsPendingNextSteps{
+		items: map[string]chatOpsPendingNextStep{},
+		now:   time.Now,
+	}
+}
+
+// Put stores one preview and returns its callback token.
+func (p *chatOpsPendingNextSteps) Put(item chatOpsPendingNextStep) (string, error) {
+	raw := make([]byte, 8)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	token := hex.EncodeToString(raw)
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	for key, existing := range p.items {
+		if !now.Before(existing.ExpiresAt) {
+			delete(p.items, key)
+		}
+	}
+	item.ExpiresAt = now.Add(chatOpsNextStepTTL)
+	p.items[token] = item
+	return token, nil
+}
+
+// Take removes and returns one unexpired preview so a confirmation button fires at most once.
+func (p *chatOpsPendingNextSteps) Take(token string) (chatOpsPendingNextStep, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	item, ok := p.items[token]
+	if !ok {
+		return chatOpsPendingNextStep{}, false
+	}
+	delete(p.items, token)
+	if !p.now().Before(item.ExpiresAt) {
+		return chatOpsPendingNextStep{}, false
+	}
+	return item, true
+}
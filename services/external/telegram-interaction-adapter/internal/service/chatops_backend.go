@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	rundomain "github.com/codex-k8s/kodex/libs/go/domain/run"
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	controlplaneclient "github.com/codex-k8s/kodex/services/external/telegram-interaction-adapter/internal/controlplane"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	chatOpsRunsPageSize       = 100
+	chatOpsRunEventsLimit     = 5
+	chatOpsApprovalsLimit     = 10
+	chatOpsNextStepActionKind = "issue_stage_transition"
+)
+
+var (
+	errChatOpsForbidden = errors.New("chatops command is not permitted")
+	errChatOpsNotFound  = errors.New("chatops target not found")
+	errChatOpsRejected  = errors.New("chatops command rejected")
+)
+
+// chatOpsError carries the control-plane reason of a classified ChatOps rejection.
+type chatOpsError struct {
+	kind   error
+	reason string
+}
+
+func (e *chatOpsError) Error() string {
+	if e.reason == "" {
+		return e.kind.Error()
+	}
+	return e.kind.Error() + ": " + e.reason
+}
+
+func (e *chatOpsError) Unwrap() error {
+	return e.kind
+}
+
+// ChatOpsBackend runs ChatOps commands on behalf of the platform user bound to a chat.
+// Every call carries the bound GitHub login; permissions are enforced by control-plane RBAC for that user.
+type ChatOpsBackend interface {
+	ListActiveRuns(ctx context.Context, githubLogin string) ([]ChatOpsRun, error)
+	GetRun(ctx context.Context, githubLogin string, runID string) (ChatOpsRunDetails, error)
+	CancelRun(ctx context.Context, githubLogin string, runID string) (ChatOpsCancelOutcome, error)
+	ListPendingApprovals(ctx context.Context, githubLogin string) ([]ChatOpsApproval, error)
+	ResolveApproval(ctx context.Context, githubLogin string, approvalID int64, decision string) (ChatOpsApprovalOutcome, error)
+	PreviewNextStep(ctx context.Context, githubLogin string, step ChatOpsNextStep) (ChatOpsNextStepOutcome, error)
+	ExecuteNextStep(ctx context.Context, githubLogin string, step ChatOpsNextStep) (ChatOpsNextStepOutcome, error)
+}
+
+// ControlPlaneChatOpsBackend maps ChatOps commands to staff control-plane RPCs.
+type ControlPlaneChatOpsBackend struct {
+	client *controlplaneclient.Client
+}
+
+// NewControlPlaneChatOpsBackend builds a gRPC-backed ChatOps backend.
+func NewControlPlaneChatOpsBackend(client *controlplaneclient.Client) *ControlPlaneChatOpsBackend {
+	return &ControlPlaneChatOpsBackend{client: client}
+}
+
+// ListActiveRuns returns non-terminal runs of the projects visible to the user.
+func (b *ControlPlaneChatOpsBackend) ListActiveRuns(ctx context.Context, githubLogin string) ([]ChatOpsRun, error) {
+	principal, err := b.principal(ctx, githubLogin)
+	if err != nil {
+		return nil, err
+	}
+	items, err := collectActiveRuns(func(page int32) (*controlplanev1.ListRunsResponse, error) {
+		return b.client.ListRuns(ctx, &controlplanev1.ListRunsRequest{
+			Principal: principal,
+			Page:      page,
+			PageSize:  chatOpsRunsPageSize,
+		})
+	})
+	if err != nil {
+		return nil, classifyChatOpsError(err)
+	}
+	return items, nil
+}
+
+// collectActiveRuns walks every ListRuns page: the RPC has no status filter and a long-running run can be older than the first page.
+// Runs created while paging shift offsets, so items are deduplicated by id.
+func collectActiveRuns(listPage func(page int32) (*controlplanev1.ListRunsResponse, error)) ([]ChatOpsRun, error) {
+	items := make([]ChatOpsRun, 0)
+	seen := make(map[string]struct{})
+	fetched := 0
+	for page := int32(1); ; page++ {
+		resp, err := listPage(page)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.GetItems() {
+			switch rundomain.Status(strings.TrimSpace(item.GetStatus())) {
+			case rundomain.StatusPending, rundomain.StatusRunning, rundomain.StatusWaitingBackpressure:
+			default:
+				continue
+			}
+			if _, ok := seen[item.GetId()]; ok {
+				continue
+			}
+			seen[item.GetId()] = struct{}{}
+			items = append(items, chatOpsRunFromProto(item))
+		}
+		fetched += len(resp.GetItems())
+		if len(resp.GetItems()) == 0 || fetched >= int(resp.GetTotalCount()) {
+			return items, nil
+		}
+	}
+}
+
+// GetRun returns run status with its latest flow events.
+func (b *ControlPlaneChatOpsBackend) GetRun(ctx context.Context, githubLogin string, runID string) (ChatOpsRunDetails, error) {
+	principal, err := b.principal(ctx, githubLogin)
+	if err != nil {
+		return ChatOpsRunDetails{}, err
+	}
+	run, err := b.client.GetRun(ctx, &controlplanev1.GetRunRequest{Principal: principal, RunId: runID})
+	if err != nil {
+		return ChatOpsRunDetails{}, classifyChatOpsError(err)
+	}
+	events, err := b.client.ListRunEvents(ctx, &controlplanev1.ListRunEventsRequest{
+		Principal: principal,
+		RunId:     runID,
+		Limit:     chatOpsRunEventsLimit,
+	})
+	if err != nil {
+		return ChatOpsRunDetails{}, classifyChatOpsError(err)
+	}
+
+	details := ChatOpsRunDetails{
+		Run:    chatOpsRunFromProto(run),
+		Events: make([]ChatOpsRunEvent, 0, len(events.GetItems())),
+	}
+	for _, event := range events.GetItems() {
+		details.Events = append(details.Events, ChatOpsRunEvent{
+			EventType: strings.TrimSpace(event.GetEventType()),
+			CreatedAt: event.GetCreatedAt().AsTime().UTC(),
+		})
+	}
+	return details, nil
+}
+
+// CancelRun cancels one run.
+func (b *ControlPlaneChatOpsBackend) CancelRun(ctx context.Context, githubLogin string, runID string) (ChatOpsCancelOutcome, error) {
+	principal, err := b.principal(ctx, githubLogin)
+	if err != nil {
+		return ChatOpsCancelOutcome{}, err
+	}
+	resp, err := b.client.CancelRun(ctx, &controlplanev1.CancelRunRequest{
+		Principal: principal,
+		RunId:     runID,
+		Reason:    optionalStringValue("canceled from Telegram ChatOps"),
+	})
+	if err != nil {
+		return ChatOpsCancelOutcome{}, classifyChatOpsError(err)
+	}
+	return ChatOpsCancelOutcome{
+		RunID:           strings.TrimSpace(resp.GetRunId()),
+		PreviousStatus:  strings.TrimSpace(resp.GetPreviousStatus()),
+		CurrentStatus:   strings.TrimSpace(resp.GetCurrentStatus()),
+		AlreadyTerminal: resp.GetAlreadyTerminal(),
+	}, nil
+}
+
+// ListPendingApprovals returns approval requests waiting for a decision.
+func (b *ControlPlaneChatOpsBackend) ListPendingApprovals(ctx context.Context, githubLogin string) ([]ChatOpsApproval, error) {
+	principal, err := b.principal(ctx, githubLogin)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.client.ListPendingApprovals(ctx, &controlplanev1.ListPendingApprovalsRequest{
+		Principal: principal,
+		Limit:     chatOpsApprovalsLimit,
+	})
+	if err != nil {
+		return nil, classifyChatOpsError(err)
+	}
+
+	items := make([]ChatOpsApproval, 0, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		items = append(items, ChatOpsApproval{
+			ID:                item.GetId(),
+			RunID:             strings.TrimSpace(item.GetRunId()),
+			ProjectSlug:       strings.TrimSpace(item.GetProjectSlug()),
+			ToolName:          strings.TrimSpace(item.GetToolName()),
+			Action:            strings.TrimSpace(item.GetAction()),
+			RequestedBy:       strings.TrimSpace(item.GetRequestedBy()),
+			RequiredApprovals: item.GetRequiredApprovals(),
+			ApprovalsCount:    item.GetApprovalsCount(),
+		})
+	}
+	return items, nil
+}
+
+// ResolveApproval records one approve/deny decision.
+func (b *ControlPlaneChatOpsBackend) ResolveApproval(ctx context.Context, githubLogin string, approvalID int64, decision string) (ChatOpsApprovalOutcome, error) {
+	principal, err := b.principal(ctx, githubLogin)
+	if err != nil {
+		return ChatOpsApprovalOutcome{}, err
+	}
+	resp, err := b.client.ResolveApprovalDecision(ctx, &controlplanev1.ResolveApprovalDecisionRequest{
+		Principal:         principal,
+		ApprovalRequestId: approvalID,
+		Decision:          decision,
+		Reason:            optionalStringValue("resolved from Telegram ChatOps"),
+	})
+	if err != nil {
+		return ChatOpsApprovalOutcome{}, classifyChatOpsError(err)
+	}
+	return ChatOpsApprovalOutcome{
+		ID:                resp.GetId(),
+		ToolName:          strings.TrimSpace(resp.GetToolName()),
+		ApprovalState:     strings.TrimSpace(resp.GetApprovalState()),
+		RequiredApprovals: resp.GetRequiredApprovals(),
+		ApprovalsCount:    resp.GetApprovalsCount(),
+	}, nil
+}
+
+// PreviewNextStep returns the label diff of one issue stage transition without applying it.
+func (b *ControlPlaneChatOpsBackend) PreviewNextStep(ctx context.Context, githubLogin string, step ChatOpsNextStep) (ChatOpsNextStepOutcome, error) {
+	return b.nextStep(ctx, githubLogin, step, b.client.PreviewNextStepAction)
+}
+
+// ExecuteNextStep applies one issue stage transition.
+func (b *ControlPlaneChatOpsBackend) ExecuteNextStep(ctx context.Context, githubLogin string, step ChatOpsNextStep) (ChatOpsNextStepOutcome, error) {
+	return b.nextStep(ctx, githubLogin, step, b.client.ExecuteNextStepAction)
+}
+
+func (b *ControlPlaneChatOpsBackend) nextStep(
+	ctx context.Context,
+	githubLogin string,
+	step ChatOpsNextStep,
+	call func(context.Context, *controlplanev1.NextStepActionRequest) (*controlplanev1.NextStepActionResponse, error),
+) (ChatOpsNextStepOutcome, error) {
+	principal, err := b.principal(ctx, githubLogin)
+	if err != nil {
+		return ChatOpsNextStepOutcome{}, err
+	}
+	issueNumber := step.IssueNumber
+	resp, err := call(ctx, &controlplanev1.NextStepActionRequest{
+		Principal:          principal,
+		RepositoryFullName: step.RepositoryFullName,
+		IssueNumber:        &issueNumber,
+		ActionKind:         chatOpsNextStepActionKind,
+		TargetLabel:        step.TargetLabel,
+	})
+	if err != nil {
+		return ChatOpsNextStepOutcome{}, classifyChatOpsError(err)
+	}
+	return ChatOpsNextStepOutcome{
+		ThreadURL:     strings.TrimSpace(resp.GetThreadUrl()),
+		RemovedLabels: resp.GetRemovedLabels(),
+		AddedLabels:   resp.GetAddedLabels(),
+		FinalLabels:   resp.GetFinalLabels(),
+	}, nil
+}
+
+func (b *ControlPlaneChatOpsBackend) principal(ctx context.Context, githubLogin string) (*controlplanev1.Principal, error) {
+	if b == nil || b.client == nil {
+		return nil, fmt.Errorf("control-plane chatops client is not configured")
+	}
+	resp, err := b.client.ResolveStaffByGitHubLogin(ctx, &controlplanev1.ResolveStaffByGitHubLoginRequest{
+		GithubLogin: githubLogin,
+	})
+	if err != nil {
+		return nil, classifyChatOpsError(err)
+	}
+	return resp.GetPrincipal(), nil
+}
+
+func chatOpsRunFromProto(run *controlplanev1.Run) ChatOpsRun {
+	return ChatOpsRun{
+		ID:          strings.TrimSpace(run.GetId()),
+		Status:      strings.TrimSpace(run.GetStatus()),
+		ProjectSlug: strings.TrimSpace(run.GetProjectSlug()),
+		WaitState:   strings.TrimSpace(run.GetWaitState()),
+		WaitReason:  strings.TrimSpace(run.GetWaitReason()),
+		IssueNumber: run.GetIssueNumber(),
+		IssueURL:    strings.TrimSpace(run.GetIssueUrl()),
+	}
+}
+
+// classifyChatOpsError keeps control-plane rejections distinguishable from transport failures.
+func classifyChatOpsError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch st.Code() {
+	case codes.PermissionDenied, codes.Unauthenticated:
+		return &chatOpsError{kind: errChatOpsForbidden, reason: st.Message()}
+	case codes.NotFound:
+		return &chatOpsError{kind: errChatOpsNotFound, reason: st.Message()}
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.AlreadyExists:
+		return &chatOpsError{kind: errChatOpsRejected, reason: st.Message()}
+	default:
+		return err
+	}
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"fmt"
+	"testing"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+)
+
+func TestCollectActiveRuns_WalksAllPages(t *testing.T) {
+	t.Parallel()
+
+	pages := map[int32][]*controlplanev1.Run{
+		1: {{Id: "run-1", Status: "succeeded"}, {Id: "run-2", Status: "running"}},
+		2: {{Id: "run-2", Status: "running"}, {Id: "run-3", Status: "failed"}},
+		3: {{Id: "run-4", Status: "pending"}},
+	}
+	var requested []int32
+	items, err := collectActiveRuns(func(page int32) (*controlplanev1.ListRunsResponse, error) {
+		requested = append(requested, page)
+		return &controlplanev1.ListRunsResponse{Items: pages[page], TotalCount: 5, Page: page, PageSize: 2}, nil
+	})
+	if err != nil {
+		t.Fatalf("collectActiveRuns() error = %v", err)
+	}
+	if len(requested) != 3 {
+		t.Fatalf("requested pages = %v, want 3 pages", requested)
+	}
+	if len(items) != 2 || items[0].ID != "run-2" || items[1].ID != "run-4" {
+		t.Fatalf("items = %+v, want deduplicated active runs from every page", items)
+	}
+}
+
+func TestCollectActiveRuns_StopsOnEmptyPageAndPropagatesErrors(t *testing.T) {
+	t.Parallel()
+
+	calls := 0
+	items, err := collectActiveRuns(func(int32) (*controlplanev1.ListRunsResponse, error) {
+		calls++
+		return &controlplanev1.ListRunsResponse{TotalCount: 10}, nil
+	})
+	if err != nil || len(items) != 0 || calls != 1 {
+		t.Fatalf("items = %+v err = %v calls = %d, want stop after empty page", items, err, calls)
+	}
+
+	if _, err := collectActiveRuns(func(int32) (*controlplanev1.ListRunsResponse, error) {
+		return nil, fmt.Errorf("unavailable")
+	}); err == nil {
+		t.Fatal("expected page error to be returned")
+	}
+}
@@ -14,6 +14,8 @@ const (
 	metricStatusFailed    = "failed"
 	metricStatusForwarded = "forwarded"
 	metricStatusIgnored   = "ignored"
+
+	metricCallbackKindVoiceCandidate = "voice_candidate"
 )
 
 var (
@@ -31,6 +33,13 @@ var (
 		},
 		[]string{"callback_kind", "classification"},
 	)
+	telegramInteractionChatOpsCommandTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kodex_telegram_interaction_chatops_command_total",
+			Help: "Total number of Telegram ChatOps commands and buttons handled by the adapter.",
+		},
+		[]string{"command", "status"},
+	)
 	telegramInteractionContinuationTotal = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "kodex_telegram_interaction_continuation_total",
@@ -54,6 +63,13 @@ func recordCallbackEvent(callbackKind string, classification string) {
 	).Inc()
 }
 
+func recordChatOpsCommand(command string, status string) {
+	telegramInteractionChatOpsCommandTotal.WithLabelValues(
+		normalizeMetricLabel(command),
+		normalizeMetricLabel(status),
+	).Inc()
+}
+
 func recordContinuationAttempt(actionKind string, status string) {
 	telegramInteractionContinuationTotal.WithLabelValues(
 		normalizeMetricLabel(actionKind),
@@ -26,6 +26,11 @@ const (
 	EditCapabilityFollowUpOnly = "follow_up_only"
 )
 
+const (
+	voiceCandidateSourceKindVoice       = "voice"
+	voiceCandidateSourceAdapterTelegram = "telegram"
+)
+
 const (
 	recipientRefPrefixGitHubLogin = "github_login:"
 	recipientRefPrefixChatID      = "telegram_chat_id:"
@@ -159,6 +164,111 @@ type CallbackEnvelope struct {
 	ProviderCallbackQueryID string              `json:"provider_callback_query_id,omitempty"`
 }
 
+// VoiceCandidateSubmission carries one transcribed project chat voice note.
+type VoiceCandidateSubmission struct {
+	ProjectID  string
+	Transcript string
+	DedupeKey  string
+	CreatedBy  string
+	ChatRef    string
+	MessageRef string
+	Locale     string
+	OccurredAt time.Time
+}
+
+// VoiceCandidateOutcome is the draft Mission Control made from the transcript.
+type VoiceCandidateOutcome struct {
+	CandidateID string
+	CommandKind string
+	DraftTitle  string
+}
+
+// ChatOpsRun is one run as shown by /runs and /run.
+type ChatOpsRun struct {
+	ID          string
+	Status      string
+	ProjectSlug string
+	WaitState   string
+	WaitReason  string
+	IssueNumber int32
+	IssueURL    string
+}
+
+// ChatOpsRunEvent is one recent flow event of a run.
+type ChatOpsRunEvent struct {
+	EventType string
+	CreatedAt time.Time
+}
+
+// ChatOpsRunDetails is a run with its latest events.
+type ChatOpsRunDetails struct {
+	Run    ChatOpsRun
+	Events []ChatOpsRunEvent
+}
+
+// ChatOpsCancelOutcome mirrors control-plane run cancel result.
+type ChatOpsCancelOutcome struct {
+	RunID           string
+	PreviousStatus  string
+	CurrentStatus   string
+	AlreadyTerminal bool
+}
+
+// ChatOpsApproval is one pending approval offered with approve/deny buttons.
+type ChatOpsApproval struct {
+	ID                int64
+	RunID             string
+	ProjectSlug       string
+	ToolName          string
+	Action            string
+	RequestedBy       string
+	RequiredApprovals int32
+	ApprovalsCount    int32
+}
+
+// ChatOpsApprovalOutcome mirrors control-plane approval decision result.
+type ChatOpsApprovalOutcome struct {
+	ID                int64
+	ToolName          string
+	ApprovalState     string
+	RequiredApprovals int32
+	ApprovalsCount    int32
+}
+
+// ChatOpsNextStep identifies one issue stage transition requested by /next.
+type ChatOpsNextStep struct {
+	RepositoryFullName string
+	IssueNumber        int32
+	TargetLabel        string
+}
+
+// ChatOpsNextStepOutcome is the label diff of a previewed or executed next-step action.
+type ChatOpsNextStepOutcome struct {
+	ThreadURL     string
+	RemovedLabels []string
+	AddedLabels   []string
+	FinalLabels   []string
+}
+
+type voiceCandidateMessageData struct {
+	CommandKind string
+	DraftTitle  string
+}
+
+type chatOpsRunsMessageData struct {
+	Runs    []ChatOpsRun
+	Omitted int
+}
+
+type chatOpsNextStepMessageData struct {
+	Step    ChatOpsNextStep
+	Outcome ChatOpsNextStepOutcome
+}
+
+type chatOpsErrorMessageData struct {
+	Reason string
+}
+
 type followUpMessageData struct {
 	RunURL         string
 	IssueURL       string
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// ProjectChatResolver maps project-bound Telegram chats to platform project ids.
+type ProjectChatResolver struct {
+	projects map[int64]string
+}
+
+// NewProjectChatResolver parses chat_id -> project_id bindings for voice intake.
+func NewProjectChatResolver(bindingsJSON string) (*ProjectChatResolver, error) {
+	resolver := &ProjectChatResolver{projects: map[int64]string{}}
+	if strings.TrimSpace(bindingsJSON) == "" {
+		return resolver, nil
+	}
+
+	items := map[string]string{}
+	if err := json.Unmarshal([]byte(bindingsJSON), &items); err != nil {
+		return nil, fmt.Errorf("parse KODEX_TELEGRAM_INTERACTION_ADAPTER_PROJECT_CHAT_BINDINGS_JSON: %w", err)
+	}
+	for rawChatID, rawProjectID := range items {
+		chatID, err := parseTelegramChatID(rawChatID)
+		if err != nil {
+			return nil, fmt.Errorf("parse project chat binding: %w", err)
+		}
+		projectID := strings.TrimSpace(rawProjectID)
+		if projectID == "" {
+			return nil, fmt.Errorf("project chat binding for chat %d has empty project id", chatID)
+		}
+		resolver.projects[chatID] = projectID
+	}
+	return resolver, nil
+}
+
+// ProjectForChat returns the project bound to one Telegram chat.
+func (r *ProjectChatResolver) ProjectForChat(chatID int64) (string, bool) {
+	if r == nil {
+		return "", false
+	}
+	projectID, ok := r.projects[chatID]
+	return projectID, ok
+}
@@ -90,6 +90,22 @@ func (r *RecipientResolver) IsAllowedChat(chatID int64) bool {
 	return ok
 }
 
+// LoginForChat returns the GitHub login explicitly bound to one chat.
+// Chats shared by several logins or reachable only through the default chat are not attributable to one user.
+func (r *RecipientResolver) LoginForChat(chatID int64) (string, bool) {
+	login := ""
+	for candidate, boundChatID := range r.mappings {
+		if boundChatID != chatID {
+			continue
+		}
+		if login != "" {
+			return "", false
+		}
+		login = candidate
+	}
+	return login, login != ""
+}
+
 func parseTelegramChatID(value string) (int64, error) {
 	parsed, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64)
 	if err != nil {
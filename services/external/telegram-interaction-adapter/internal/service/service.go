@@ -29,7 +29,16 @@ type Config struct {
 	CallbackSink   CallbackSink
 	AudioConverter AudioConverter
 	SpeechToText   SpeechToText
-	Logger         *slog.Logger
+	// TranscriptPolicy asks the user to retype instead of forwarding empty or low-confidence transcripts.
+	TranscriptPolicy TranscriptPolicy
+	// SpeechLanguageHints maps project ids to STT languages for voice notes in project-bound chats.
+	SpeechLanguageHints map[string]string
+	// ProjectChats and VoiceCandidates enable Mission Control voice intake; both are optional.
+	ProjectChats    *ProjectChatResolver
+	VoiceCandidates VoiceCandidateSink
+	// ChatOps enables staff slash commands in private chats bound to a platform user; optional.
+	ChatOps ChatOpsBackend
+	Logger  *slog.Logger
 }
 
 // Service owns Telegram transport logic and callback forwarding.
@@ -42,8 +51,15 @@ type Service struct {
 	callbacks      CallbackSink
 	audioConverter AudioConverter
 	speechToText   SpeechToText
-	messages       *messageRenderer
-	logger         *slog.Logger
+	transcripts    TranscriptPolicy
+	speechLanguage map[string]string
+	projectChats   *ProjectChatResolver
+	voiceIntake    VoiceCandidateSink
+	chatOps        ChatOpsBackend
+	// chatOpsNextSteps holds /next previews awaiting the execute button.
+	chatOpsNextSteps *chatOpsPendingNextSteps
+	messages         *messageRenderer
+	logger           *slog.Logger
 }
 
 // New builds the adapter service.
@@ -66,16 +82,22 @@ func New(cfg Config) (*Service, error) {
 	}
 
 	return &Service{
-		publicBaseURL:  strings.TrimRight(strings.TrimSpace(cfg.PublicBaseURL), "/"),
-		webhookSecret:  strings.TrimSpace(cfg.WebhookSecret),
-		deliveryToken:  strings.TrimSpace(cfg.DeliveryToken),
-		recipients:     cfg.Recipients,
-		bot:            cfg.Bot,
-		callbacks:      cfg.CallbackSink,
-		audioConverter: cfg.AudioConverter,
-		speechToText:   cfg.SpeechToText,
-		messages:       renderer,
-		logger:         cfg.Logger,
+		publicBaseURL:    strings.TrimRight(strings.TrimSpace(cfg.PublicBaseURL), "/"),
+		webhookSecret:    strings.TrimSpace(cfg.WebhookSecret),
+		deliveryToken:    strings.TrimSpace(cfg.DeliveryToken),
+		recipients:       cfg.Recipients,
+		bot:              cfg.Bot,
+		callbacks:        cfg.CallbackSink,
+		audioConverter:   cfg.AudioConverter,
+		speechToText:     cfg.SpeechToText,
+		transcripts:      cfg.TranscriptPolicy,
+		speechLanguage:   cfg.SpeechLanguageHints,
+		projectChats:     cfg.ProjectChats,
+		voiceIntake:      cfg.VoiceCandidates,
+		chatOps:          cfg.ChatOps,
+		chatOpsNextSteps: newChatOpsPendingNextSteps(),
+		messages:         renderer,
+		logger:           cfg.Logger,
 	}, nil
 }
 
@@ -309,6 +331,9 @@ func (s *Service) handleCallbackQuery(ctx context.Context, update telego.Update)
 	if query.Message != nil {
 		chatID = query.Message.GetChat().ID
 	}
+	if s.chatOps != nil && strings.HasPrefix(query.Data, chatOpsCallbackPrefix) {
+		return s.handleChatOpsCallback(ctx, query, query.Data)
+	}
 	if chatID != 0 && !s.recipients.IsAllowedChat(chatID) {
 		recordCallbackEvent(CallbackKindOptionSelected, metricStatusIgnored)
 		return s.bot.AnswerCallbackQuery(ctx, AnswerCallbackQueryRequest{
@@ -363,10 +388,16 @@ func (s *Service) handleMessage(ctx context.Context, update telego.Update) error
 	if message == nil {
 		return nil
 	}
+	if projectID, ok := s.voiceIntakeProject(message); ok {
+		return s.handleProjectVoiceNote(ctx, message, projectID)
+	}
 	if !s.recipients.IsAllowedChat(message.Chat.ID) {
 		recordCallbackEvent(CallbackKindFreeTextReceived, metricStatusIgnored)
 		return nil
 	}
+	if command, ok := parseChatOpsCommand(message.Text); ok && s.chatOps != nil && message.ReplyToMessage == nil {
+		return s.handleChatOpsCommand(ctx, message, command)
+	}
 
 	locale := telegramLocale(message.From)
 	freeText, err := s.resolveMessageText(ctx, message, locale)
@@ -424,6 +455,61 @@ func (s *Service) resolveMessageText(ctx context.Context, message *telego.Messag
 	if message.Voice == nil {
 		return "", nil
 	}
+	return s.transcribeVoice(ctx, message, locale)
+}
+
+// voiceIntakeProject reports the bound project when a top-level voice note should become a voice candidate.
+// Replies keep the interaction free-text path so voice answers to prompts are not diverted.
+func (s *Service) voiceIntakeProject(message *telego.Message) (string, bool) {
+	if s.voiceIntake == nil || message.Voice == nil || message.ReplyToMessage != nil {
+		return "", false
+	}
+	return s.projectChats.ProjectForChat(message.Chat.ID)
+}
+
+func (s *Service) handleProjectVoiceNote(ctx context.Context, message *telego.Message, projectID string) error {
+	locale := telegramLocale(message.From)
+	transcript, err := s.transcribeVoice(ctx, message, locale)
+	if err != nil {
+		recordCallbackEvent(metricCallbackKindVoiceCandidate, metricStatusFailed)
+		if errors.Is(err, errTelegramVoiceHandledNoRetry) {
+			return nil
+		}
+		return err
+	}
+	if transcript == "" {
+		recordCallbackEvent(metricCallbackKindVoiceCandidate, metricStatusIgnored)
+		return nil
+	}
+
+	chatRef := strconv.FormatInt(message.Chat.ID, 10)
+	messageRef := strconv.Itoa(message.MessageID)
+	outcome, err := s.voiceIntake.SubmitVoiceCandidate(ctx, VoiceCandidateSubmission{
+		ProjectID:  projectID,
+		Transcript: transcript,
+		DedupeKey:  "telegram:" + chatRef + ":" + messageRef,
+		CreatedBy:  buildResponderRef(message.From),
+		ChatRef:    chatRef,
+		MessageRef: messageRef,
+		Locale:     locale,
+		OccurredAt: voiceCandidateOccurredAt(message.Date),
+	})
+	if err != nil {
+		recordCallbackEvent(metricCallbackKindVoiceCandidate, metricStatusFailed)
+		s.logger.Warn("submit telegram voice candidate failed", "project_id", projectID, "chat_id", message.Chat.ID, "err", err)
+		s.sendBestEffortChatMessage(ctx, message.Chat.ID, s.messages.Render(locale, "voice_candidate_failed", nil))
+		return fmt.Errorf("submit voice candidate: %w", err)
+	}
+
+	s.sendBestEffortChatMessage(ctx, message.Chat.ID, s.messages.Render(locale, "voice_candidate_created", voiceCandidateMessageData{
+		CommandKind: outcome.CommandKind,
+		DraftTitle:  outcome.DraftTitle,
+	}))
+	recordCallbackEvent(metricCallbackKindVoiceCandidate, metricStatusForwarded)
+	return nil
+}
+
+func (s *Service) transcribeVoice(ctx context.Context, message *telego.Message, locale string) (string, error) {
 	if s.speechToText == nil || s.audioConverter == nil {
 		_, _ = s.bot.SendMessage(ctx, SendMessageRequest{
 			ChatID: message.Chat.ID,
@@ -444,12 +530,27 @@ func (s *Service) resolveMessageText(ctx context.Context, message *telego.Messag
 		return "", errTelegramVoiceHandledNoRetry
 	}
 
-	transcript, err := s.speechToText.Transcribe(ctx, normalized, telegramSTTLanguage(locale))
+	transcription, err := s.speechToText.Transcribe(ctx, normalized, s.speechLanguageFor(message.Chat.ID, locale))
 	if err != nil {
 		s.sendBestEffortChatMessage(ctx, message.Chat.ID, s.messages.Render(locale, "transcription_failed", nil))
 		return "", errTelegramVoiceHandledNoRetry
 	}
-	return strings.TrimSpace(transcript), nil
+	if !s.transcripts.Accepts(transcription) {
+		s.logger.Info("telegram voice transcript rejected by policy", "chat_id", message.Chat.ID, "empty", strings.TrimSpace(transcription.Text) == "")
+		s.sendBestEffortChatMessage(ctx, message.Chat.ID, s.messages.Render(locale, "transcription_unclear", nil))
+		return "", nil
+	}
+	return strings.TrimSpace(transcription.Text), nil
+}
+
+// speechLanguageFor prefers the language hint of the chat's project over the sender's Telegram locale.
+func (s *Service) speechLanguageFor(chatID int64, locale string) string {
+	if projectID, ok := s.projectChats.ProjectForChat(chatID); ok {
+		if language := s.speechLanguage[projectID]; language != "" {
+			return language
+		}
+	}
+	return telegramSTTLanguage(locale)
 }
 
 func (s *Service) sendBestEffortChatMessage(ctx context.Context, chatID int64, text string) {
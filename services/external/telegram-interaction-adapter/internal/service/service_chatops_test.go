@@ -0,0 +1,241 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mymmrac/telego"
+)
+
+type recordingChatOpsBackend struct {
+	logins    []string
+	decisions []string
+	executed  []ChatOpsNextStep
+	runsErr   error
+}
+
+func (b *recordingChatOpsBackend) ListActiveRuns(_ context.Context, githubLogin string) ([]ChatOpsRun, error) {
+	b.logins = append(b.logins, githubLogin)
+	if b.runsErr != nil {
+		return nil, b.runsErr
+	}
+	return []ChatOpsRun{{ID: "run-1", Status: "running", ProjectSlug: "kodex", IssueNumber: 42}}, nil
+}
+
+func (b *recordingChatOpsBackend) GetRun(_ context.Context, githubLogin string, runID string) (ChatOpsRunDetails, error) {
+	b.logins = append(b.logins, githubLogin)
+	return ChatOpsRunDetails{Run: ChatOpsRun{ID: runID, Status: "running"}}, nil
+}
+
+func (b *recordingChatOpsBackend) CancelRun(_ context.Context, githubLogin string, runID string) (ChatOpsCancelOutcome, error) {
+	b.logins = append(b.logins, githubLogin)
+	return ChatOpsCancelOutcome{RunID: runID, PreviousStatus: "running", CurrentStatus: "canceled"}, nil
+}
+
+func (b *recordingChatOpsBackend) ListPendingApprovals(_ context.Context, githubLogin string) ([]ChatOpsApproval, error) {
+	b.logins = append(b.logins, githubLogin)
+	return []ChatOpsApproval{{ID: 7, ToolName: "github_merge_pr", ProjectSlug: "kodex"}}, nil
+}
+
+func (b *recordingChatOpsBackend) ResolveApproval(_ context.Context, githubLogin string, approvalID int64, decision string) (ChatOpsApprovalOutcome, error) {
+	b.logins = append(b.logins, githubLogin)
+	b.decisions = append(b.decisions, decision)
+	return ChatOpsApprovalOutcome{ID: approvalID, ToolName: "github_merge_pr", ApprovalState: decision}, nil
+}
+
+func (b *recordingChatOpsBackend) PreviewNextStep(_ context.Context, githubLogin string, _ ChatOpsNextStep) (ChatOpsNextStepOutcome, error) {
+	b.logins = append(b.logins, githubLogin)
+	return ChatOpsNextStepOutcome{RemovedLabels: []string{"run:plan"}, AddedLabels: []string{"run:dev"}}, nil
+}
+
+func (b *recordingChatOpsBackend) ExecuteNextStep(_ context.Context, githubLogin string, step ChatOpsNextStep) (ChatOpsNextStepOutcome, error) {
+	b.logins = append(b.logins, githubLogin)
+	b.executed = append(b.executed, step)
+	return ChatOpsNextStepOutcome{AddedLabels: []string{step.TargetLabel}}, nil
+}
+
+func newChatOpsTestService(t *testing.T, backend ChatOpsBackend) (*Service, *testBotClient) {
+	t.Helper()
+
+	bot := &testBotClient{}
+	recipients, err := NewRecipientResolver("-100500", `{"alice": 1001, "bob": 2002, "carol": 2002}`)
+	if err != nil {
+		t.Fatalf("NewRecipientResolver() error = %v", err)
+	}
+	svc, err := New(Config{
+		Recipients:   recipients,
+		Bot:          bot,
+		CallbackSink: testCallbackSink{},
+		ChatOps:      backend,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	return svc, bot
+}
+
+func sendChatOpsUpdate(t *testing.T, svc *Service, update telego.Update) {
+	t.Helper()
+
+	raw, err := json.Marshal(update)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if err := svc.HandleWebhook(context.Background(), raw); err != nil {
+		t.Fatalf("HandleWebhook() error = %v", err)
+	}
+}
+
+func chatOpsMessage(chatID int64, chatType string, text string) telego.Update {
+	return telego.Update{Message: &telego.Message{
+		MessageID: 1,
+		Chat:      telego.Chat{ID: chatID, Type: chatType},
+		From:      &telego.User{ID: chatID, LanguageCode: "en"},
+		Text:      text,
+	}}
+}
+
+func chatOpsCallback(chatID int64, messageID int, data string) telego.Update {
+	return telego.Update{CallbackQuery: &telego.CallbackQuery{
+		ID:   "query-1",
+		From: telego.User{ID: chatID, LanguageCode: "en"},
+		Message: &telego.Message{
+			MessageID: messageID,
+			Chat:      telego.Chat{ID: chatID, Type: telego.ChatTypePrivate},
+		},
+		Data: data,
+	}}
+}
+
+func TestHandleWebhook_ChatOpsRunsActsAsBoundUser(t *testing.T) {
+	t.Parallel()
+
+	backend := &recordingChatOpsBackend{}
+	svc, bot := newChatOpsTestService(t, backend)
+
+	sendChatOpsUpdate(t, svc, chatOpsMessage(1001, telego.ChatTypePrivate, "/runs@kodex_bot"))
+
+	if len(backend.logins) != 1 || backend.logins[0] != "alice" {
+		t.Fatalf("backend logins = %v, want [alice]", backend.logins)
+	}
+	if len(bot.sendRequests) != 1 || !strings.Contains(bot.sendRequests[0].Text, "run-1 — running · kodex · #42") {
+		t.Fatalf("unexpected runs reply: %+v", bot.sendRequests)
+	}
+}
+
+func TestHandleWebhook_ChatOpsRejectsUnattributableChats(t *testing.T) {
+	t.Parallel()
+
+	for name, update := range map[string]telego.Update{
+		"default chat": chatOpsMessage(-100500, telego.ChatTypeGroup, "/runs"),
+		"group chat":   chatOpsMessage(1001, telego.ChatTypeGroup, "/runs"),
+		"shared chat":  chatOpsMessage(2002, telego.ChatTypePrivate, "/cancel run-1"),
+	} {
+		backend := &recordingChatOpsBackend{}
+		svc, bot := newChatOpsTestService(t, backend)
+
+		sendChatOpsUpdate(t, svc, update)
+
+		if len(backend.logins) != 0 {
+			t.Fatalf("%s: backend called for %v", name, backend.logins)
+		}
+		if len(bot.sendRequests) != 1 || !strings.Contains(bot.sendRequests[0].Text, "private chat bound to your platform account") {
+			t.Fatalf("%s: unexpected reply: %+v", name, bot.sendRequests)
+		}
+	}
+}
+
+func TestHandleWebhook_ChatOpsReportsPermissionDenied(t *testing.T) {
+	t.Parallel()
+
+	backend := &recordingChatOpsBackend{runsErr: &chatOpsError{kind: errChatOpsForbidden, reason: "project access denied"}}
+	svc, bot := newChatOpsTestService(t, backend)
+
+	sendChatOpsUpdate(t, svc, chatOpsMessage(1001, telego.ChatTypePrivate, "/runs"))
+
+	if len(bot.sendRequests) != 1 || bot.sendRequests[0].Text != "🔒 You do not have permission for this action. project access denied" {
+		t.Fatalf("unexpected reply: %+v", bot.sendRequests)
+	}
+}
+
+func TestHandleWebhook_ChatOpsApprovalButtonsResolveDecision(t *testing.T) {
+	t.Parallel()
+
+	backend := &recordingChatOpsBackend{}
+	svc, bot := newChatOpsTestService(t, backend)
+
+	sendChatOpsUpdate(t, svc, chatOpsMessage(1001, telego.ChatTypePrivate, "/approvals"))
+	if len(bot.sendRequests) != 1 || len(bot.sendRequests[0].InlineOptions) != 2 {
+		t.Fatalf("unexpected approvals reply: %+v", bot.sendRequests)
+	}
+	deny := bot.sendRequests[0].InlineOptions[1].CallbackData
+	if deny != "ops:ap:7:denied" {
+		t.Fatalf("deny callback data = %q", deny)
+	}
+
+	sendChatOpsUpdate(t, svc, chatOpsCallback(1001, 1, deny))
+
+	if len(backend.decisions) != 1 || backend.decisions[0] != "denied" {
+		t.Fatalf("decisions = %v, want [denied]", backend.decisions)
+	}
+	if got := bot.sendRequests[len(bot.sendRequests)-1].Text; got != "🔐 Approval #7 (github_merge_pr): denied" {
+		t.Fatalf("resolution reply = %q", got)
+	}
+}
+
+func TestHandleWebhook_ChatOpsNextStepExecutesPreviewOnce(t *testing.T) {
+	t.Parallel()
+
+	backend := &recordingChatOpsBackend{}
+	svc, bot := newChatOpsTestService(t, backend)
+
+	sendChatOpsUpdate(t, svc, chatOpsMessage(1001, telego.ChatTypePrivate, "/next codex-k8s/kodex#42 run:dev"))
+	if len(bot.sendRequests) != 1 || len(bot.sendRequests[0].InlineOptions) != 1 {
+		t.Fatalf("unexpected preview reply: %+v", bot.sendRequests)
+	}
+	if !strings.Contains(bot.sendRequests[0].Text, "➖ run:plan") || !strings.Contains(bot.sendRequests[0].Text, "➕ run:dev") {
+		t.Fatalf("preview text = %q", bot.sendRequests[0].Text)
+	}
+	execute := bot.sendRequests[0].InlineOptions[0].CallbackData
+
+	sendChatOpsUpdate(t, svc, chatOpsCallback(2002, 1, execute))
+	if len(backend.executed) != 0 {
+		t.Fatalf("execute from another chat must be rejected, executed = %v", backend.executed)
+	}
+
+	sendChatOpsUpdate(t, svc, chatOpsCallback(1001, 1, execute))
+	sendChatOpsUpdate(t, svc, chatOpsCallback(1001, 1, execute))
+
+	want := ChatOpsNextStep{RepositoryFullName: "codex-k8s/kodex", IssueNumber: 42, TargetLabel: "run:dev"}
+	if len(backend.executed) != 1 || backend.executed[0].RepositoryFullName != want.RepositoryFullName ||
+		backend.executed[0].IssueNumber != want.IssueNumber || backend.executed[0].TargetLabel != want.TargetLabel {
+		t.Fatalf("executed = %+v, want one %+v", backend.executed, want)
+	}
+	if got := bot.sendRequests[len(bot.sendRequests)-1].Text; got != "⌛ This preview has expired. Run /next again." {
+		t.Fatalf("repeated execute reply = %q", got)
+	}
+}
+
+func TestParseChatOpsNextStep(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		args []string
+		want ChatOpsNextStep
+		ok   bool
+	}{
+		{args: []string{"codex-k8s/kodex#42", "run:dev"}, want: ChatOpsNextStep{RepositoryFullName: "codex-k8s/kodex", IssueNumber: 42, TargetLabel: "run:dev"}, ok: true},
+		{args: []string{"https://github.com/codex-k8s/kodex/issues/7", "run:qa"}, want: ChatOpsNextStep{RepositoryFullName: "codex-k8s/kodex", IssueNumber: 7, TargetLabel: "run:qa"}, ok: true},
+		{args: []string{"kodex#42", "run:dev"}},
+		{args: []string{"codex-k8s/kodex#0", "run:dev"}},
+		{args: []string{"codex-k8s/kodex#42"}},
+	}
+	for _, tc := range cases {
+		got, ok := parseChatOpsNextStep(tc.args)
+		if ok != tc.ok || got != tc.want {
+			t.Fatalf("parseChatOpsNextStep(%v) = %+v, %v; want %+v, %v", tc.args, got, ok, tc.want, tc.ok)
+		}
+	}
+}
@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/mymmrac/telego"
+)
+
+type recordingVoiceCandidateSink struct {
+	submissions []VoiceCandidateSubmission
+}
+
+func (s *recordingVoiceCandidateSink) SubmitVoiceCandidate(_ context.Context, submission VoiceCandidateSubmission) (VoiceCandidateOutcome, error) {
+	s.submissions = append(s.submissions, submission)
+	return VoiceCandidateOutcome{CandidateID: "voice-1", CommandKind: "work_item.create", DraftTitle: "Добавить экспорт в CSV"}, nil
+}
+
+type fixedSpeechToText struct {
+	transcript string
+}
+
+func (s fixedSpeechToText) Transcribe(context.Context, AudioPayload, string) (Transcription, error) {
+	return Transcription{Text: s.transcript}, nil
+}
+
+func TestHandleWebhook_ProjectChatVoiceNoteCreatesVoiceCandidate(t *testing.T) {
+	t.Parallel()
+
+	bot := &testBotClient{}
+	sink := &recordingVoiceCandidateSink{}
+	recipients, err := NewRecipientResolver("989530970", "")
+	if err != nil {
+		t.Fatalf("NewRecipientResolver() error = %v", err)
+	}
+	projectChats, err := NewProjectChatResolver(`{"-100200": "project-1"}`)
+	if err != nil {
+		t.Fatalf("NewProjectChatResolver() error = %v", err)
+	}
+	svc, err := New(Config{
+		Recipients:      recipients,
+		Bot:             bot,
+		CallbackSink:    testCallbackSink{},
+		AudioConverter:  testAudioConverter{},
+		SpeechToText:    fixedSpeechToText{transcript: " Добавить экспорт в CSV. "},
+		ProjectChats:    projectChats,
+		VoiceCandidates: sink,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	update := telego.Update{
+		UpdateID: 78,
+		Message: &telego.Message{
+			MessageID: 56,
+			Date:      1775000000,
+			Chat:      telego.Chat{ID: -100200},
+			From:      &telego.User{ID: 42, LanguageCode: "ru"},
+			Voice:     &telego.Voice{FileID: "voice-file"},
+		},
+	}
+	raw, err := json.Marshal(update)
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if err := svc.HandleWebhook(context.Background(), raw); err != nil {
+		t.Fatalf("HandleWebhook() error = %v", err)
+	}
+
+	if len(sink.submissions) != 1 {
+		t.Fatalf("submissions len = %d, want 1", len(sink.submissions))
+	}
+	got := sink.submissions[0]
+	if got.ProjectID != "project-1" || got.Transcript != "Добавить экспорт в CSV." ||
+		got.DedupeKey != "telegram:-100200:56" || got.CreatedBy != "telegram_user:42" {
+		t.Fatalf("unexpected submission: %+v", got)
+	}
+	if len(bot.sendRequests) != 1 || !strings.Contains(bot.sendRequests[0].Text, "Черновик задачи создан: Добавить экспорт в CSV") {
+		t.Fatalf("unexpected confirmation: %+v", bot.sendRequests)
+	}
+}
+
+func TestHandleWebhook_ProjectChatVoiceReplyKeepsInteractionPath(t *testing.T) {
+	t.Parallel()
+
+	sink := &recordingVoiceCandidateSink{}
+	projectChats, err := NewProjectChatResolver(`{"-100200": "project-1"}`)
+	if err != nil {
+		t.Fatalf("NewProjectChatResolver() error = %v", err)
+	}
+	svc := &Service{projectChats: projectChats, voiceIntake: sink}
+
+	reply := &telego.Message{
+		Chat:           telego.Chat{ID: -100200},
+		Voice:          &telego.Voice{FileID: "voice-file"},
+		ReplyToMessage: &telego.Message{MessageID: 10, Chat: telego.Chat{ID: -100200}},
+	}
+	if _, ok := svc.voiceIntakeProject(reply); ok {
+		t.Fatal("voice replies must stay on the interaction free-text path")
+	}
+	if _, ok := svc.voiceIntakeProject(&telego.Message{Chat: telego.Chat{ID: 1}, Voice: &telego.Voice{FileID: "x"}}); ok {
+		t.Fatal("unbound chats must not create voice candidates")
+	}
+}
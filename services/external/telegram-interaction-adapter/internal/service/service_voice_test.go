@@ -45,8 +45,8 @@ func (testAudioConverter) Convert(_ context.Context, payload AudioPayload) (Audi
 
 type testSpeechToText struct{}
 
-func (testSpeechToText) Transcribe(context.Context, AudioPayload, string) (string, error) {
-	return "", errors.New("stt unavailable")
+func (testSpeechToText) Transcribe(context.Context, AudioPayload, string) (Transcription, error) {
+	return Transcription{}, errors.New("stt unavailable")
 }
 
 func TestHandleWebhook_AcksVoiceTranscriptionFailuresWithoutRetry(t *testing.T) {
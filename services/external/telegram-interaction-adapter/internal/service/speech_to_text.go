@@ -3,6 +3,7 @@ package service
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"strings"
@@ -13,9 +14,55 @@ import (
 	"github.com/openai/openai-go/v3/packages/param"
 )
 
+const (
+	SpeechToTextBackendOpenAI = "openai"
+	SpeechToTextBackendHTTP   = "http"
+	SpeechToTextBackendEcho   = "echo"
+)
+
 // SpeechToText transcribes audio into plain text.
 type SpeechToText interface {
-	Transcribe(context.Context, AudioPayload, string) (string, error)
+	Transcribe(context.Context, AudioPayload, string) (Transcription, error)
+}
+
+// Transcription is one STT result. Confidence is nil when the backend does not report it.
+type Transcription struct {
+	Text       string
+	Confidence *float64
+}
+
+// SpeechToTextConfig selects and configures one STT backend.
+type SpeechToTextConfig struct {
+	Backend      string
+	OpenAIAPIKey string
+	OpenAIModel  string
+	HTTPURL      string
+	HTTPAPIKey   string
+	HTTPModel    string
+	EchoText     string
+	Timeout      time.Duration
+	Logger       *slog.Logger
+}
+
+// NewSpeechToText builds the configured STT backend.
+// The OpenAI backend without an API key returns nil so voice transcription stays disabled.
+func NewSpeechToText(cfg SpeechToTextConfig) (SpeechToText, error) {
+	switch strings.ToLower(strings.TrimSpace(cfg.Backend)) {
+	case "", SpeechToTextBackendOpenAI:
+		if strings.TrimSpace(cfg.OpenAIAPIKey) == "" {
+			return nil, nil
+		}
+		return NewOpenAISpeechToText(cfg.OpenAIAPIKey, cfg.OpenAIModel, cfg.Timeout, cfg.Logger), nil
+	case SpeechToTextBackendHTTP:
+		if strings.TrimSpace(cfg.HTTPURL) == "" {
+			return nil, fmt.Errorf("speech-to-text http backend requires url")
+		}
+		return NewHTTPSpeechToText(cfg.HTTPURL, cfg.HTTPAPIKey, cfg.HTTPModel, cfg.Timeout, cfg.Logger), nil
+	case SpeechToTextBackendEcho:
+		return EchoSpeechToText{Text: cfg.EchoText}, nil
+	default:
+		return nil, fmt.Errorf("unsupported speech-to-text backend %q", cfg.Backend)
+	}
 }
 
 // OpenAISpeechToText uses OpenAI Audio Transcriptions API.
@@ -40,16 +87,12 @@ func NewOpenAISpeechToText(apiKey string, model string, timeout time.Duration, l
 }
 
 // Transcribe converts audio into text using the configured OpenAI model.
-func (s *OpenAISpeechToText) Transcribe(ctx context.Context, payload AudioPayload, language string) (string, error) {
+func (s *OpenAISpeechToText) Transcribe(ctx context.Context, payload AudioPayload, language string) (Transcription, error) {
 	if len(payload.Content) == 0 {
-		return "", fmt.Errorf("empty audio content")
+		return Transcription{}, fmt.Errorf("empty audio content")
 	}
 
-	timeout := s.timeout
-	if timeout <= 0 {
-		timeout = 30 * time.Second
-	}
-	requestCtx, cancel := context.WithTimeout(ctx, timeout)
+	requestCtx, cancel := context.WithTimeout(ctx, speechToTextTimeout(s.timeout))
 	defer cancel()
 
 	params := openai.AudioTranscriptionNewParams{
@@ -63,10 +106,66 @@ func (s *OpenAISpeechToText) Transcribe(ctx context.Context, payload AudioPayloa
 	result, err := s.client.Audio.Transcriptions.New(requestCtx, params)
 	if err != nil {
 		s.logger.Error("openai speech-to-text failed", "err", err)
-		return "", err
+		return Transcription{}, err
+	}
+	if result == nil {
+		return Transcription{}, nil
 	}
-	if result == nil || strings.TrimSpace(result.Text) == "" {
-		return "", fmt.Errorf("empty speech-to-text response")
+	return Transcription{Text: strings.TrimSpace(result.Text)}, nil
+}
+
+// EchoSpeechToText returns a fixed transcript without sending audio anywhere; an empty text acts as a no-op backend.
+type EchoSpeechToText struct {
+	Text string
+}
+
+// Transcribe returns the configured text.
+func (s EchoSpeechToText) Transcribe(context.Context, AudioPayload, string) (Transcription, error) {
+	return Transcription{Text: strings.TrimSpace(s.Text)}, nil
+}
+
+// TranscriptPolicy decides whether a transcript is usable or the user should retype the message.
+type TranscriptPolicy struct {
+	// MinConfidence rejects transcripts whose reported confidence is below it; 0 accepts any reported confidence.
+	MinConfidence float64
+}
+
+// Accepts reports whether the transcript is worth forwarding.
+func (p TranscriptPolicy) Accepts(transcription Transcription) bool {
+	if strings.TrimSpace(transcription.Text) == "" {
+		return false
+	}
+	if transcription.Confidence == nil || p.MinConfidence <= 0 {
+		return true
+	}
+	return *transcription.Confidence >= p.MinConfidence
+}
+
+// ParseSpeechLanguageHints parses project_id -> STT language bindings.
+func ParseSpeechLanguageHints(bindingsJSON string) (map[string]string, error) {
+	hints := map[string]string{}
+	if strings.TrimSpace(bindingsJSON) == "" {
+		return hints, nil
+	}
+
+	items := map[string]string{}
+	if err := json.Unmarshal([]byte(bindingsJSON), &items); err != nil {
+		return nil, fmt.Errorf("parse KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_PROJECT_LANGUAGES_JSON: %w", err)
+	}
+	for rawProjectID, rawLanguage := range items {
+		projectID := strings.TrimSpace(rawProjectID)
+		language := strings.ToLower(strings.TrimSpace(rawLanguage))
+		if projectID == "" || language == "" {
+			return nil, fmt.Errorf("speech language hint requires project id and language")
+		}
+		hints[projectID] = language
+	}
+	return hints, nil
+}
+
+func speechToTextTimeout(timeout time.Duration) time.Duration {
+	if timeout <= 0 {
+		return 30 * time.Second
 	}
-	return strings.TrimSpace(result.Text), nil
+	return timeout
 }
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const httpSpeechToTextMaxResponseBytes = 1 << 20
+
+// HTTPSpeechToText posts audio to a self-hosted Whisper-compatible endpoint,
+// e.g. faster-whisper-server "/v1/audio/transcriptions" or whisper.cpp server "/inference".
+type HTTPSpeechToText struct {
+	httpClient *http.Client
+	url        string
+	apiKey     string
+	model      string
+	timeout    time.Duration
+	logger     *slog.Logger
+}
+
+// NewHTTPSpeechToText builds a Whisper-compatible HTTP STT implementation.
+func NewHTTPSpeechToText(url string, apiKey string, model string, timeout time.Duration, logger *slog.Logger) *HTTPSpeechToText {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	return &HTTPSpeechToText{
+		httpClient: &http.Client{},
+		url:        strings.TrimSpace(url),
+		apiKey:     strings.TrimSpace(apiKey),
+		model:      strings.TrimSpace(model),
+		timeout:    timeout,
+		logger:     logger,
+	}
+}
+
+type whisperSegment struct {
+	AvgLogprob   float64 `json:"avg_logprob"`
+	NoSpeechProb float64 `json:"no_speech_prob"`
+}
+
+type whisperVerboseResponse struct {
+	Text     string           `json:"text"`
+	Segments []whisperSegment `json:"segments"`
+}
+
+// Transcribe requests verbose_json so segment statistics can be turned into a confidence score.
+func (s *HTTPSpeechToText) Transcribe(ctx context.Context, payload AudioPayload, language string) (Transcription, error) {
+	if len(payload.Content) == 0 {
+		return Transcription{}, fmt.Errorf("empty audio content")
+	}
+
+	body, contentType, err := buildWhisperMultipart(payload, language, s.model)
+	if err != nil {
+		return Transcription{}, err
+	}
+
+	requestCtx, cancel := context.WithTimeout(ctx, speechToTextTimeout(s.timeout))
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(requestCtx, http.MethodPost, s.url, body)
+	if err != nil {
+		return Transcription{}, fmt.Errorf("build speech-to-text request: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+	if s.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+s.apiKey)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		s.logger.Error("http speech-to-text failed", "err", err)
+		return Transcription{}, err
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, httpSpeechToTextMaxResponseBytes))
+	if err != nil {
+		return Transcription{}, fmt.Errorf("read speech-to-text response: %w", err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		s.logger.Error("http speech-to-text failed", "status", resp.StatusCode)
+		return Transcription{}, fmt.Errorf("speech-to-text endpoint returned status %d", resp.StatusCode)
+	}
+
+	var result whisperVerboseResponse
+	if err := json.Unmarshal(raw, &result); err != nil {
+		return Transcription{}, fmt.Errorf("decode speech-to-text response: %w", err)
+	}
+	return Transcription{
+		Text:       strings.TrimSpace(result.Text),
+		Confidence: whisperConfidence(result.Segments),
+	}, nil
+}
+
+func buildWhisperMultipart(payload AudioPayload, language string, model string) (*bytes.Buffer, string, error) {
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	fileName := strings.TrimSpace(payload.FileName)
+	if fileName == "" {
+		fileName = "voice.mp3"
+	}
+	part, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return nil, "", fmt.Errorf("build speech-to-text form: %w", err)
+	}
+	if _, err := part.Write(payload.Content); err != nil {
+		return nil, "", fmt.Errorf("build speech-to-text form: %w", err)
+	}
+
+	fields := map[string]string{"response_format": "verbose_json"}
+	if model != "" {
+		fields["model"] = model
+	}
+	if language = strings.TrimSpace(language); language != "" {
+		fields["language"] = language
+	}
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			return nil, "", fmt.Errorf("build speech-to-text form: %w", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, "", fmt.Errorf("build speech-to-text form: %w", err)
+	}
+	return &body, writer.FormDataContentType(), nil
+}
+
+// whisperConfidence averages per-segment token probability discounted by the no-speech probability.
+// Servers that return plain text without segments report no confidence.
+func whisperConfidence(segments []whisperSegment) *float64 {
+	if len(segments) == 0 {
+		return nil
+	}
+	var total float64
+	for _, segment := range segments {
+		total += math.Exp(segment.AvgLogprob) * (1 - segment.NoSpeechProb)
+	}
+	confidence := total / float64(len(segments))
+	return &confidence
+}
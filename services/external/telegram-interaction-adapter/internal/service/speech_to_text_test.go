@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/mymmrac/telego"
+)
+
+func TestHTTPSpeechToText_SendsWhisperFormAndScoresSegments(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer local-key" {
+			t.Errorf("Authorization = %q", got)
+		}
+		if err := r.ParseMultipartForm(1 << 20); err != nil {
+			t.Errorf("ParseMultipartForm() error = %v", err)
+			return
+		}
+		for field, want := range map[string]string{"language": "ru", "model": "Systran/faster-whisper-small", "response_format": "verbose_json"} {
+			if got := r.FormValue(field); got != want {
+				t.Errorf("form %s = %q, want %q", field, got, want)
+			}
+		}
+		file, _, err := r.FormFile("file")
+		if err != nil {
+			t.Errorf("FormFile() error = %v", err)
+			return
+		}
+		if content, _ := io.ReadAll(file); string(content) != "mp3-bytes" {
+			t.Errorf("file content = %q", content)
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"text": " Привет ",
+			"segments": []map[string]float64{
+				{"avg_logprob": 0, "no_speech_prob": 0},
+				{"avg_logprob": math.Log(0.5), "no_speech_prob": 0.5},
+			},
+		})
+	}))
+	defer server.Close()
+
+	stt := NewHTTPSpeechToText(server.URL, "local-key", "Systran/faster-whisper-small", 0, nil)
+	got, err := stt.Transcribe(context.Background(), AudioPayload{Content: []byte("mp3-bytes"), FileName: "voice.mp3"}, "ru")
+	if err != nil {
+		t.Fatalf("Transcribe() error = %v", err)
+	}
+	if got.Text != "Привет" || got.Confidence == nil || math.Abs(*got.Confidence-0.625) > 1e-9 {
+		t.Fatalf("Transcribe() = %+v (confidence %v), want Привет/0.625", got, got.Confidence)
+	}
+}
+
+func TestHTTPSpeechToText_PlainTextResponseHasNoConfidence(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"text":"hello"}`))
+	}))
+	defer server.Close()
+
+	got, err := NewHTTPSpeechToText(server.URL, "", "", 0, nil).Transcribe(context.Background(), AudioPayload{Content: []byte("x")}, "")
+	if err != nil {
+		t.Fatalf("Transcribe() error = %v", err)
+	}
+	if got.Text != "hello" || got.Confidence != nil {
+		t.Fatalf("Transcribe() = %+v, want hello without confidence", got)
+	}
+}
+
+func TestNewSpeechToText_SelectsBackend(t *testing.T) {
+	t.Parallel()
+
+	if stt, err := NewSpeechToText(SpeechToTextConfig{Backend: "openai"}); err != nil || stt != nil {
+		t.Fatalf("openai without key = %v, %v; want disabled", stt, err)
+	}
+	if _, err := NewSpeechToText(SpeechToTextConfig{Backend: "http"}); err == nil {
+		t.Fatal("http backend without url must fail")
+	}
+	if _, err := NewSpeechToText(SpeechToTextConfig{Backend: "vosk"}); err == nil {
+		t.Fatal("unknown backend must fail")
+	}
+	stt, err := NewSpeechToText(SpeechToTextConfig{Backend: "echo", EchoText: "test transcript"})
+	if err != nil {
+		t.Fatalf("NewSpeechToText(echo) error = %v", err)
+	}
+	got, err := stt.Transcribe(context.Background(), AudioPayload{}, "en")
+	if err != nil || got.Text != "test transcript" {
+		t.Fatalf("echo Transcribe() = %+v, %v", got, err)
+	}
+}
+
+func TestTranscriptPolicy_Accepts(t *testing.T) {
+	t.Parallel()
+
+	low, high := 0.3, 0.8
+	policy := TranscriptPolicy{MinConfidence: 0.5}
+	cases := []struct {
+		name          string
+		policy        TranscriptPolicy
+		transcription Transcription
+		want          bool
+	}{
+		{name: "empty", policy: policy, transcription: Transcription{Text: "  "}, want: false},
+		{name: "low confidence", policy: policy, transcription: Transcription{Text: "ok", Confidence: &low}, want: false},
+		{name: "high confidence", policy: policy, transcription: Transcription{Text: "ok", Confidence: &high}, want: true},
+		{name: "unknown confidence", policy: policy, transcription: Transcription{Text: "ok"}, want: true},
+		{name: "threshold disabled", transcription: Transcription{Text: "ok", Confidence: &low}, want: true},
+	}
+	for _, tc := range cases {
+		if got := tc.policy.Accepts(tc.transcription); got != tc.want {
+			t.Fatalf("%s: Accepts() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+type languageRecordingSpeechToText struct {
+	languages     []string
+	transcription Transcription
+}
+
+func (s *languageRecordingSpeechToText) Transcribe(_ context.Context, _ AudioPayload, language string) (Transcription, error) {
+	s.languages = append(s.languages, language)
+	return s.transcription, nil
+}
+
+func TestHandleWebhook_UnclearVoiceAsksToRetype(t *testing.T) {
+	t.Parallel()
+
+	confidence := 0.2
+	stt := &languageRecordingSpeechToText{transcription: Transcription{Text: "мм", Confidence: &confidence}}
+	sink := &recordingVoiceCandidateSink{}
+	bot := &testBotClient{}
+	recipients, err := NewRecipientResolver("989530970", "")
+	if err != nil {
+		t.Fatalf("NewRecipientResolver() error = %v", err)
+	}
+	projectChats, err := NewProjectChatResolver(`{"-100200": "project-1"}`)
+	if err != nil {
+		t.Fatalf("NewProjectChatResolver() error = %v", err)
+	}
+	hints, err := ParseSpeechLanguageHints(`{"project-1": "EN"}`)
+	if err != nil {
+		t.Fatalf("ParseSpeechLanguageHints() error = %v", err)
+	}
+	svc, err := New(Config{
+		Recipients:          recipients,
+		Bot:                 bot,
+		CallbackSink:        testCallbackSink{},
+		AudioConverter:      testAudioConverter{},
+		SpeechToText:        stt,
+		TranscriptPolicy:    TranscriptPolicy{MinConfidence: 0.5},
+		SpeechLanguageHints: hints,
+		ProjectChats:        projectChats,
+		VoiceCandidates:     sink,
+	})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	raw, err := json.Marshal(telego.Update{
+		UpdateID: 79,
+		Message: &telego.Message{
+			MessageID: 57,
+			Chat:      telego.Chat{ID: -100200},
+			From:      &telego.User{ID: 42, LanguageCode: "ru"},
+			Voice:     &telego.Voice{FileID: "voice-file"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if err := svc.HandleWebhook(context.Background(), raw); err != nil {
+		t.Fatalf("HandleWebhook() error = %v", err)
+	}
+
+	if len(stt.languages) != 1 || stt.languages[0] != "en" {
+		t.Fatalf("stt languages = %v, want project hint [en]", stt.languages)
+	}
+	if len(sink.submissions) != 0 {
+		t.Fatalf("unclear transcript must not be submitted: %+v", sink.submissions)
+	}
+	if len(bot.sendRequests) != 1 || bot.sendRequests[0].Text != "🎙️ Не удалось разобрать голосовое сообщение. Повторите четче или напишите текстом." {
+		t.Fatalf("unexpected reply: %+v", bot.sendRequests)
+	}
+}
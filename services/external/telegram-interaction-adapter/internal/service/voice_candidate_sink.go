@@ -0,0 +1,63 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	controlplaneclient "github.com/codex-k8s/kodex/services/external/telegram-interaction-adapter/internal/controlplane"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// VoiceCandidateSink hands project chat transcripts to Mission Control as draft voice candidates.
+type VoiceCandidateSink interface {
+	SubmitVoiceCandidate(context.Context, VoiceCandidateSubmission) (VoiceCandidateOutcome, error)
+}
+
+// ControlPlaneVoiceCandidateSink submits voice candidates over internal gRPC.
+type ControlPlaneVoiceCandidateSink struct {
+	client *controlplaneclient.Client
+}
+
+// NewControlPlaneVoiceCandidateSink builds a gRPC-backed voice candidate sink.
+func NewControlPlaneVoiceCandidateSink(client *controlplaneclient.Client) *ControlPlaneVoiceCandidateSink {
+	return &ControlPlaneVoiceCandidateSink{client: client}
+}
+
+// SubmitVoiceCandidate creates or re-reads one draft candidate keyed by the Telegram message identity.
+func (s *ControlPlaneVoiceCandidateSink) SubmitVoiceCandidate(ctx context.Context, submission VoiceCandidateSubmission) (VoiceCandidateOutcome, error) {
+	if s == nil || s.client == nil {
+		return VoiceCandidateOutcome{}, fmt.Errorf("control-plane voice candidate client is not configured")
+	}
+
+	resp, err := s.client.CreateMissionControlVoiceCandidate(ctx, &controlplanev1.CreateMissionControlVoiceCandidateRequest{
+		ProjectId:        strings.TrimSpace(submission.ProjectID),
+		SourceKind:       voiceCandidateSourceKindVoice,
+		Transcript:       submission.Transcript,
+		DedupeKey:        strings.TrimSpace(submission.DedupeKey),
+		CreatedBy:        strings.TrimSpace(submission.CreatedBy),
+		SourceAdapter:    optionalStringValue(voiceCandidateSourceAdapterTelegram),
+		SourceChatRef:    optionalStringValue(submission.ChatRef),
+		SourceMessageRef: optionalStringValue(submission.MessageRef),
+		SourceLocale:     optionalStringValue(submission.Locale),
+		CreatedAt:        timestamppb.New(submission.OccurredAt.UTC()),
+	})
+	if err != nil {
+		return VoiceCandidateOutcome{}, err
+	}
+
+	return VoiceCandidateOutcome{
+		CandidateID: strings.TrimSpace(resp.GetCandidateId()),
+		CommandKind: strings.TrimSpace(resp.GetCommandKind()),
+		DraftTitle:  strings.TrimSpace(resp.GetDraftTitle()),
+	}, nil
+}
+
+func voiceCandidateOccurredAt(unixDate int64) time.Time {
+	if unixDate <= 0 {
+		return time.Now().UTC()
+	}
+	return time.Unix(unixDate, 0).UTC()
+}
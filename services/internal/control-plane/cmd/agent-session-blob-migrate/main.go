@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/caarlos0/env/v11"
+
+	"github.com/codex-k8s/kodex/libs/go/postgres"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/app"
+	agentsessionrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/agentsession"
+)
+
+const (
+	defaultBatchSize = 100
+	defaultTimeout   = 2 * time.Hour
+)
+
+// config holds the subset of control-plane environment needed to move snapshots.
+type config struct {
+	DBHost     string `env:"KODEX_DB_HOST,required,notEmpty"`
+	DBPort     int    `env:"KODEX_DB_PORT" envDefault:"5432"`
+	DBName     string `env:"KODEX_DB_NAME,required,notEmpty"`
+	DBUser     string `env:"KODEX_DB_USER,required,notEmpty"`
+	DBPassword string `env:"KODEX_DB_PASSWORD,required,notEmpty"`
+	DBSSLMode  string `env:"KODEX_DB_SSLMODE" envDefault:"disable"`
+
+	BlobStore app.BlobStoreConfig
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run moves inline agent_sessions payloads into configured blob store batch by batch.
+// It is idempotent: rerunning continues with rows that are still stored inline.
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	fs := flag.NewFlagSet("agent-session-blob-migrate", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	batchSize := fs.Int("batch-size", defaultBatchSize, "Rows processed per batch")
+	timeout := fs.Duration("timeout", defaultTimeout, "Overall migration timeout")
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	if *batchSize <= 0 || *timeout <= 0 {
+		_, _ = fmt.Fprintln(stderr, "agent-session-blob-migrate failed: --batch-size and --timeout must be positive")
+		return 2
+	}
+
+	cfg, err := env.ParseAs[config]()
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "agent-session-blob-migrate failed: parse config from environment: %v\n", err)
+		return 2
+	}
+	store, err := cfg.BlobStore.Open()
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "agent-session-blob-migrate failed: %v\n", err)
+		return 2
+	}
+	if store == nil {
+		_, _ = fmt.Fprintln(stderr, "agent-session-blob-migrate failed: KODEX_BLOB_STORE_BACKEND must be set to filesystem or s3")
+		return 2
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	pool, err := postgres.OpenPGXPool(ctx, postgres.OpenParams{
+		Host:     cfg.DBHost,
+		Port:     cfg.DBPort,
+		DBName:   cfg.DBName,
+		User:     cfg.DBUser,
+		Password: cfg.DBPassword,
+		SSLMode:  cfg.DBSSLMode,
+	})
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "agent-session-blob-migrate failed: open postgres pgx pool: %v\n", err)
+		return 1
+	}
+	defer pool.Close()
+
+	repo := agentsessionrepo.NewRepository(pool, store)
+	var (
+		afterID int64
+		moved   int
+		skipped int
+	)
+	for {
+		batch, err := repo.MoveInlineSnapshotsToBlob(ctx, afterID, *batchSize)
+		moved += batch.Moved
+		skipped += batch.Skipped
+		if err != nil {
+			_, _ = fmt.Fprintf(stderr, "agent-session-blob-migrate failed after id=%d moved=%d: %v\n", afterID, moved, err)
+			return 1
+		}
+		if batch.Scanned == 0 {
+			break
+		}
+		afterID = batch.LastID
+		_, _ = fmt.Fprintf(stdout, "agent-session-blob-migrate batch last_id=%d moved=%d skipped=%d\n", batch.LastID, batch.Moved, batch.Skipped)
+	}
+
+	_, _ = fmt.Fprintf(stdout, "agent-session-blob-migrate completed moved=%d skipped=%d\n", moved, skipped)
+	return 0
+}
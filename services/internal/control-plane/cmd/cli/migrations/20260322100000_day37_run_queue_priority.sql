@@ -0,0 +1,25 @@
+-- +goose Up
+
+ALTER TABLE agent_runs
+    ADD COLUMN IF NOT EXISTS priority SMALLINT NOT NULL DEFAULT 50;
+
+ALTER TABLE agent_runs
+    DROP CONSTRAINT IF EXISTS chk_agent_runs_priority;
+
+ALTER TABLE agent_runs
+    ADD CONSTRAINT chk_agent_runs_priority
+        CHECK (priority BETWEEN 1 AND 100);
+
+CREATE INDEX IF NOT EXISTS idx_agent_runs_pending_queue
+    ON agent_runs (priority DESC, created_at ASC)
+    WHERE status = 'pending';
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_agent_runs_pending_queue;
+
+ALTER TABLE agent_runs
+    DROP CONSTRAINT IF EXISTS chk_agent_runs_priority;
+
+ALTER TABLE agent_runs
+    DROP COLUMN IF EXISTS priority;
@@ -0,0 +1,41 @@
+-- +goose Up
+
+ALTER TABLE agent_runs
+    ADD COLUMN IF NOT EXISTS attempt INT NOT NULL DEFAULT 1,
+    ADD COLUMN IF NOT EXISTS retry_of_run_id UUID NULL REFERENCES agent_runs(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS failure_class TEXT NULL,
+    ADD COLUMN IF NOT EXISTS not_before TIMESTAMPTZ NULL;
+
+ALTER TABLE agent_runs
+    DROP CONSTRAINT IF EXISTS chk_agent_runs_attempt;
+
+ALTER TABLE agent_runs
+    ADD CONSTRAINT chk_agent_runs_attempt
+        CHECK (attempt >= 1);
+
+ALTER TABLE agent_runs
+    DROP CONSTRAINT IF EXISTS chk_agent_runs_failure_class;
+
+ALTER TABLE agent_runs
+    ADD CONSTRAINT chk_agent_runs_failure_class
+        CHECK (failure_class IS NULL OR failure_class IN ('infrastructure', 'agent', 'precondition'));
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_agent_runs_retry_of_run_id
+    ON agent_runs (retry_of_run_id)
+    WHERE retry_of_run_id IS NOT NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS uq_agent_runs_retry_of_run_id;
+
+ALTER TABLE agent_runs
+    DROP CONSTRAINT IF EXISTS chk_agent_runs_failure_class;
+
+ALTER TABLE agent_runs
+    DROP CONSTRAINT IF EXISTS chk_agent_runs_attempt;
+
+ALTER TABLE agent_runs
+    DROP COLUMN IF EXISTS not_before,
+    DROP COLUMN IF EXISTS failure_class,
+    DROP COLUMN IF EXISTS retry_of_run_id,
+    DROP COLUMN IF EXISTS attempt;
@@ -0,0 +1,37 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS alert_incidents (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    fingerprint TEXT NOT NULL,
+    alert_name TEXT NOT NULL,
+    severity TEXT NOT NULL DEFAULT '',
+    status TEXT NOT NULL DEFAULT 'firing',
+    labels JSONB NOT NULL DEFAULT '{}'::jsonb,
+    annotations JSONB NOT NULL DEFAULT '{}'::jsonb,
+    generator_url TEXT NOT NULL DEFAULT '',
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NULL,
+    occurrences INT NOT NULL DEFAULT 1,
+    repository_full_name TEXT NOT NULL DEFAULT '',
+    issue_number BIGINT NULL,
+    issue_url TEXT NOT NULL DEFAULT '',
+    last_run_id UUID NULL REFERENCES agent_runs(id) ON DELETE SET NULL,
+    last_received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_alert_incidents_project_fingerprint
+        UNIQUE (project_id, fingerprint),
+    CONSTRAINT chk_alert_incidents_status
+        CHECK (status IN ('firing', 'resolved')),
+    CONSTRAINT chk_alert_incidents_occurrences
+        CHECK (occurrences >= 1)
+);
+
+CREATE INDEX IF NOT EXISTS idx_alert_incidents_project_status_updated
+    ON alert_incidents (project_id, status, updated_at DESC);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_alert_incidents_project_status_updated;
+DROP TABLE IF EXISTS alert_incidents;
@@ -0,0 +1,60 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS runtime_error_groups (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NULL REFERENCES projects(id) ON DELETE CASCADE,
+    fingerprint TEXT NOT NULL,
+    source TEXT NOT NULL,
+    error_class TEXT NOT NULL DEFAULT '',
+    normalized_message TEXT NOT NULL,
+    sample_message TEXT NOT NULL,
+    level TEXT NOT NULL DEFAULT 'error',
+    status TEXT NOT NULL DEFAULT 'open',
+    muted_until TIMESTAMPTZ NULL,
+    status_changed_at TIMESTAMPTZ NULL,
+    status_changed_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMPTZ NULL,
+    occurrences BIGINT NOT NULL DEFAULT 1,
+    regression_count INT NOT NULL DEFAULT 0,
+    first_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_seen_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_run_id UUID NULL REFERENCES agent_runs(id) ON DELETE SET NULL,
+    escalation_kind TEXT NOT NULL DEFAULT '',
+    escalated_at TIMESTAMPTZ NULL,
+    escalated_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    repository_full_name TEXT NOT NULL DEFAULT '',
+    issue_number BIGINT NULL,
+    issue_url TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_runtime_error_groups_fingerprint
+        UNIQUE (fingerprint),
+    CONSTRAINT chk_runtime_error_groups_level
+        CHECK (level IN ('error', 'warning', 'critical')),
+    CONSTRAINT chk_runtime_error_groups_status
+        CHECK (status IN ('open', 'muted', 'resolved')),
+    CONSTRAINT chk_runtime_error_groups_escalation_kind
+        CHECK (escalation_kind IN ('', 'issue', 'ai_repair')),
+    CONSTRAINT chk_runtime_error_groups_occurrences
+        CHECK (occurrences >= 1)
+);
+
+CREATE INDEX IF NOT EXISTS idx_runtime_error_groups_status_last_seen
+    ON runtime_error_groups (status, last_seen_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_runtime_error_groups_project_last_seen
+    ON runtime_error_groups (project_id, last_seen_at DESC);
+
+ALTER TABLE runtime_errors
+    ADD COLUMN IF NOT EXISTS group_id UUID NULL REFERENCES runtime_error_groups(id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_runtime_errors_group_created_at
+    ON runtime_errors (group_id, created_at DESC);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_runtime_errors_group_created_at;
+ALTER TABLE runtime_errors DROP COLUMN IF EXISTS group_id;
+DROP INDEX IF EXISTS idx_runtime_error_groups_project_last_seen;
+DROP INDEX IF EXISTS idx_runtime_error_groups_status_last_seen;
+DROP TABLE IF EXISTS runtime_error_groups;
@@ -0,0 +1,37 @@
+-- +goose Up
+
+ALTER TABLE agent_sessions
+    ADD COLUMN IF NOT EXISTS snapshot_storage TEXT NOT NULL DEFAULT 'postgres';
+
+ALTER TABLE agent_sessions
+    ADD COLUMN IF NOT EXISTS snapshot_blob_key TEXT NULL;
+
+ALTER TABLE agent_sessions
+    ADD COLUMN IF NOT EXISTS snapshot_blob_size_bytes BIGINT NULL;
+
+ALTER TABLE agent_sessions
+    ADD CONSTRAINT chk_agent_sessions_snapshot_storage
+        CHECK (
+            (snapshot_storage = 'postgres' AND snapshot_blob_key IS NULL)
+            OR (snapshot_storage = 'blob' AND COALESCE(snapshot_blob_key, '') <> '')
+        );
+
+CREATE INDEX IF NOT EXISTS idx_agent_sessions_inline_snapshots
+    ON agent_sessions (id)
+    WHERE snapshot_storage = 'postgres';
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_agent_sessions_inline_snapshots;
+
+ALTER TABLE agent_sessions
+    DROP CONSTRAINT IF EXISTS chk_agent_sessions_snapshot_storage;
+
+ALTER TABLE agent_sessions
+    DROP COLUMN IF EXISTS snapshot_blob_size_bytes;
+
+ALTER TABLE agent_sessions
+    DROP COLUMN IF EXISTS snapshot_blob_key;
+
+ALTER TABLE agent_sessions
+    DROP COLUMN IF EXISTS snapshot_storage;
@@ -0,0 +1,75 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS retention_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    target TEXT NOT NULL,
+    project_id UUID NULL REFERENCES projects(id) ON DELETE CASCADE,
+    retention_days INT NOT NULL,
+    action TEXT NOT NULL DEFAULT 'archive',
+    enabled BOOLEAN NOT NULL DEFAULT true,
+    updated_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    sweep_lease_owner TEXT NULL,
+    sweep_lease_until TIMESTAMPTZ NULL,
+    last_swept_at TIMESTAMPTZ NULL,
+    last_removed_count BIGINT NOT NULL DEFAULT 0,
+    last_archive_key TEXT NOT NULL DEFAULT '',
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_retention_policies_target
+        CHECK (target IN (
+            'flow_events',
+            'agent_run_logs',
+            'agent_sessions',
+            'interaction_delivery_attempts',
+            'interaction_callback_events',
+            'mission_control_timeline_entries',
+            'github_rate_limit_wait_evidence'
+        )),
+    CONSTRAINT chk_retention_policies_action
+        CHECK (action IN ('delete', 'archive')),
+    CONSTRAINT chk_retention_policies_retention_days
+        CHECK (retention_days BETWEEN 1 AND 3650)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_retention_policies_target_scope
+    ON retention_policies (target, COALESCE(project_id, '00000000-0000-0000-0000-000000000000'::uuid));
+
+-- Global defaults archive history only; without configured blob store archive policies stay idle.
+INSERT INTO retention_policies (target, retention_days, action)
+VALUES
+    ('flow_events', 180, 'archive'),
+    ('agent_run_logs', 30, 'archive'),
+    ('agent_sessions', 90, 'archive'),
+    ('interaction_delivery_attempts', 90, 'archive'),
+    ('interaction_callback_events', 90, 'archive'),
+    ('mission_control_timeline_entries', 365, 'archive'),
+    ('github_rate_limit_wait_evidence', 30, 'archive')
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE flow_events
+    ADD COLUMN IF NOT EXISTS retention_pinned BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX IF NOT EXISTS idx_flow_events_retention_created_at
+    ON flow_events (created_at, id)
+    WHERE retention_pinned = false;
+
+CREATE INDEX IF NOT EXISTS idx_agent_sessions_finished_at
+    ON agent_sessions (finished_at, id)
+    WHERE finished_at IS NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_mission_control_timeline_entries_occurred_at
+    ON mission_control_timeline_entries (occurred_at, id);
+
+CREATE INDEX IF NOT EXISTS idx_github_rate_limit_wait_evidence_created_at
+    ON github_rate_limit_wait_evidence (created_at, id);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_github_rate_limit_wait_evidence_created_at;
+DROP INDEX IF EXISTS idx_mission_control_timeline_entries_occurred_at;
+DROP INDEX IF EXISTS idx_agent_sessions_finished_at;
+DROP INDEX IF EXISTS idx_flow_events_retention_created_at;
+ALTER TABLE flow_events DROP COLUMN IF EXISTS retention_pinned;
+DROP INDEX IF EXISTS uq_retention_policies_target_scope;
+DROP TABLE IF EXISTS retention_policies;
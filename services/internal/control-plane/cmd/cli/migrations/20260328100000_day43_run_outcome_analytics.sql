@@ -0,0 +1,18 @@
+-- +goose Up
+
+CREATE INDEX IF NOT EXISTS idx_agent_runs_project_created_at
+    ON agent_runs (project_id, created_at);
+
+CREATE INDEX IF NOT EXISTS idx_flow_events_pull_request_outcome
+    ON flow_events (
+        (lower(payload->>'repository_full_name')),
+        (payload->>'pull_request_number'),
+        event_type,
+        created_at
+    )
+    WHERE event_type IN ('run.pr.closed', 'run.review.changes_requested.received');
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_flow_events_pull_request_outcome;
+DROP INDEX IF EXISTS idx_agent_runs_project_created_at;
@@ -0,0 +1,34 @@
+-- +goose Up
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS oidc_issuer TEXT NULL,
+    ADD COLUMN IF NOT EXISTS oidc_subject TEXT NULL,
+    ADD COLUMN IF NOT EXISTS is_platform_admin_oidc BOOLEAN NOT NULL DEFAULT false;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_users_oidc_identity
+    ON users (oidc_issuer, oidc_subject)
+    WHERE oidc_issuer IS NOT NULL AND oidc_subject IS NOT NULL;
+
+ALTER TABLE project_members
+    ADD COLUMN IF NOT EXISTS source TEXT NOT NULL DEFAULT 'manual';
+
+ALTER TABLE project_members
+    DROP CONSTRAINT IF EXISTS chk_project_members_source;
+
+ALTER TABLE project_members
+    ADD CONSTRAINT chk_project_members_source CHECK (source IN ('manual', 'oidc'));
+
+-- +goose Down
+
+ALTER TABLE project_members
+    DROP CONSTRAINT IF EXISTS chk_project_members_source;
+
+ALTER TABLE project_members
+    DROP COLUMN IF EXISTS source;
+
+DROP INDEX IF EXISTS uq_users_oidc_identity;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS is_platform_admin_oidc,
+    DROP COLUMN IF EXISTS oidc_subject,
+    DROP COLUMN IF EXISTS oidc_issuer;
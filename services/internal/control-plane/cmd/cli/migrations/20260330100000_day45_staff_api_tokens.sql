@@ -0,0 +1,32 @@
+-- +goose Up
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS is_service_account BOOLEAN NOT NULL DEFAULT false;
+
+CREATE TABLE IF NOT EXISTS staff_api_tokens (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    token_prefix TEXT NOT NULL,
+    token_hash BYTEA NOT NULL,
+    scopes TEXT[] NOT NULL,
+    project_ids UUID[] NOT NULL DEFAULT '{}',
+    expires_at TIMESTAMPTZ NULL,
+    last_used_at TIMESTAMPTZ NULL,
+    revoked_at TIMESTAMPTZ NULL,
+    created_by UUID NULL REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_staff_api_tokens_token_hash UNIQUE (token_hash),
+    CONSTRAINT chk_staff_api_tokens_scopes_not_empty CHECK (cardinality(scopes) > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_staff_api_tokens_user_created_at
+    ON staff_api_tokens (user_id, created_at DESC);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_staff_api_tokens_user_created_at;
+DROP TABLE IF EXISTS staff_api_tokens;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS is_service_account;
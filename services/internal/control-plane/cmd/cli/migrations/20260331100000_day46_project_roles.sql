@@ -0,0 +1,27 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS project_roles (
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    name TEXT NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    permissions TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT pk_project_roles PRIMARY KEY (project_id, name),
+    CONSTRAINT chk_project_roles_not_builtin CHECK (name NOT IN ('read', 'read_write', 'admin'))
+);
+
+-- Legacy read/read_write/admin values stay as built-in roles; custom role names are validated by control-plane.
+ALTER TABLE project_members
+    DROP CONSTRAINT IF EXISTS chk_project_members_role;
+
+-- +goose Down
+
+UPDATE project_members
+SET role = 'read'
+WHERE role NOT IN ('read', 'read_write', 'admin');
+
+ALTER TABLE project_members
+    ADD CONSTRAINT chk_project_members_role CHECK (role IN ('read', 'read_write', 'admin'));
+
+DROP TABLE IF EXISTS project_roles;
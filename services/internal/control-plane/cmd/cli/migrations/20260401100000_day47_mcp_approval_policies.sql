@@ -0,0 +1,50 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS mcp_approval_policies (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    tool_name TEXT NOT NULL,
+    environment TEXT NOT NULL DEFAULT '',
+    required_approvals INT NOT NULL DEFAULT 1,
+    eligible_roles TEXT[] NOT NULL DEFAULT '{}',
+    eligible_user_ids UUID[] NOT NULL DEFAULT '{}',
+    separation_of_duties BOOLEAN NOT NULL DEFAULT true,
+    expires_after_seconds INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT uq_mcp_approval_policies_scope UNIQUE (project_id, tool_name, environment),
+    CONSTRAINT chk_mcp_approval_policies_tool_name
+        CHECK (tool_name IN ('secret.sync.k8s', 'database.lifecycle', 'owner.feedback.request')),
+    CONSTRAINT chk_mcp_approval_policies_required_approvals
+        CHECK (required_approvals BETWEEN 1 AND 10),
+    CONSTRAINT chk_mcp_approval_policies_expires_after
+        CHECK (expires_after_seconds >= 0)
+);
+
+ALTER TABLE mcp_action_requests
+    ADD COLUMN IF NOT EXISTS approval_policy JSONB NULL;
+
+ALTER TABLE mcp_action_requests
+    ADD COLUMN IF NOT EXISTS approval_votes JSONB NOT NULL DEFAULT '[]'::jsonb;
+
+ALTER TABLE mcp_action_requests
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ NULL;
+
+CREATE INDEX IF NOT EXISTS idx_mcp_action_requests_requested_expires_at
+    ON mcp_action_requests (expires_at)
+    WHERE approval_state = 'requested' AND expires_at IS NOT NULL;
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_mcp_action_requests_requested_expires_at;
+
+ALTER TABLE mcp_action_requests
+    DROP COLUMN IF EXISTS expires_at;
+
+ALTER TABLE mcp_action_requests
+    DROP COLUMN IF EXISTS approval_votes;
+
+ALTER TABLE mcp_action_requests
+    DROP COLUMN IF EXISTS approval_policy;
+
+DROP TABLE IF EXISTS mcp_approval_policies;
@@ -0,0 +1,9 @@
+-- +goose Up
+
+ALTER TABLE mcp_action_requests
+    ADD COLUMN IF NOT EXISTS run_suspended_at TIMESTAMPTZ NULL;
+
+-- +goose Down
+
+ALTER TABLE mcp_action_requests
+    DROP COLUMN IF EXISTS run_suspended_at;
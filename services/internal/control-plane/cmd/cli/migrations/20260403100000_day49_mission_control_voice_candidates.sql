@@ -0,0 +1,39 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS mission_control_voice_candidates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    project_id UUID NOT NULL REFERENCES projects(id) ON DELETE CASCADE,
+    source_kind TEXT NOT NULL,
+    status TEXT NOT NULL DEFAULT 'draft',
+    dedupe_key TEXT NOT NULL,
+    command_kind TEXT NOT NULL,
+    transcript_excerpt TEXT NOT NULL DEFAULT '',
+    structured_summary TEXT NOT NULL DEFAULT '',
+    payload JSONB NOT NULL DEFAULT '{}'::jsonb,
+    promoted_command_id UUID NULL,
+    created_by TEXT NOT NULL,
+    decided_by TEXT NULL,
+    decided_at TIMESTAMPTZ NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT chk_mission_control_voice_candidates_source_kind
+        CHECK (source_kind IN ('voice', 'audio_upload', 'transcript')),
+    CONSTRAINT chk_mission_control_voice_candidates_status
+        CHECK (status IN ('draft', 'promoted', 'rejected', 'expired')),
+    CONSTRAINT chk_mission_control_voice_candidates_command_kind
+        CHECK (command_kind IN ('discussion.create', 'work_item.create')),
+    CONSTRAINT uq_mission_control_voice_candidates_dedupe_key
+        UNIQUE (project_id, dedupe_key),
+    CONSTRAINT fk_mission_control_voice_candidates_promoted_command
+        FOREIGN KEY (project_id, promoted_command_id)
+        REFERENCES mission_control_commands(project_id, id)
+        ON DELETE SET NULL (promoted_command_id)
+);
+
+CREATE INDEX IF NOT EXISTS idx_mission_control_voice_candidates_project_status_created
+    ON mission_control_voice_candidates (project_id, status, created_at DESC);
+
+-- +goose Down
+
+DROP INDEX IF EXISTS idx_mission_control_voice_candidates_project_status_created;
+DROP TABLE IF EXISTS mission_control_voice_candidates;
@@ -80,3 +80,11 @@ func (a runtimeDeployKubernetesAdapter) ApplyManifest(ctx context.Context, manif
 	}
 	return out, nil
 }
+
+func (a runtimeDeployKubernetesAdapter) DryRunApplyManifest(ctx context.Context, manifest []byte, namespaceOverride string, fieldManager string) ([]runtimedeploydomain.DryRunObject, error) {
+	return a.client.DryRunApplyManifest(ctx, manifest, namespaceOverride, fieldManager)
+}
+
+func (a runtimeDeployKubernetesAdapter) ListAppliedResources(ctx context.Context, namespace string, apiVersion string, kind string, fieldManager string) ([]runtimedeploydomain.AppliedResourceRef, error) {
+	return a.client.ListAppliedResources(ctx, namespace, apiVersion, kind, fieldManager)
+}
@@ -22,9 +22,11 @@ import (
 	"github.com/codex-k8s/kodex/libs/go/registry"
 	repoprovider "github.com/codex-k8s/kodex/libs/go/repo/provider"
 	githubprovider "github.com/codex-k8s/kodex/libs/go/repo/provider/github"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 	sharedsystemsettings "github.com/codex-k8s/kodex/libs/go/systemsettings"
 	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
 	githubclient "github.com/codex-k8s/kodex/services/internal/control-plane/internal/clients/github"
+	githubcache "github.com/codex-k8s/kodex/services/internal/control-plane/internal/clients/githubcache"
 	githubmgmtclient "github.com/codex-k8s/kodex/services/internal/control-plane/internal/clients/githubmgmt"
 	kubernetesclient "github.com/codex-k8s/kodex/services/internal/control-plane/internal/clients/kubernetes"
 	postgresadminclient "github.com/codex-k8s/kodex/services/internal/control-plane/internal/clients/postgresadmin"
@@ -35,6 +37,7 @@ import (
 	mcpdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/mcp"
 	missioncontroldomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/missioncontrol"
 	missioncontrolworkerdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/missioncontrolworker"
+	retentiondomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/retention"
 	runstatusdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/runstatus"
 	runtimedeploydomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/runtimedeploy"
 	runtimeerrordomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/runtimeerror"
@@ -46,21 +49,27 @@ import (
 	agentrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/agent"
 	agentrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/agentrun"
 	agentsessionrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/agentsession"
+	alertincidentrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/alertincident"
 	changegovernancerepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/changegovernance"
 	floweventrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/flowevent"
 	githubratelimitwaitrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/githubratelimitwait"
 	interactionrequestrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/interactionrequest"
 	learningfeedbackrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/learningfeedback"
 	mcpactionrequestrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/mcpactionrequest"
+	mcpapprovalpolicyrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/mcpapprovalpolicy"
 	missioncontrolrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/missioncontrol"
 	platformtokenrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/platformtoken"
 	projectrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/project"
 	projectdatabaserepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/projectdatabase"
 	projectmemberrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/projectmember"
+	projectrolerepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/projectrole"
 	projecttokenrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/projecttoken"
 	repocfgrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/repocfg"
+	retentionrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/retention"
+	runoutcomerepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/runoutcome"
 	runtimedeploytaskrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/runtimedeploytask"
 	runtimeerrorrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/runtimeerror"
+	staffapitokenrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/staffapitoken"
 	staffrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/staffrun"
 	systemsettingrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/systemsetting"
 	userrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/user"
@@ -103,18 +112,28 @@ func Run() error {
 	users := userrepo.NewRepository(pgxPool)
 	projects := projectrepo.NewRepository(pgxPool)
 	members := projectmemberrepo.NewRepository(pgxPool)
+	projectRoles := projectrolerepo.NewRepository(pgxPool)
 	runs := staffrunrepo.NewRepository(pgxPool)
 	repos := repocfgrepo.NewRepository(pgxPool)
 	feedback := learningfeedbackrepo.NewRepository(pgxPool)
-	agentSessions := agentsessionrepo.NewRepository(pgxPool)
+	snapshotBlobs, err := cfg.BlobStore.Open()
+	if err != nil {
+		return err
+	}
+	agentSessions := agentsessionrepo.NewRepository(pgxPool, snapshotBlobs)
 	platformTokens := platformtokenrepo.NewRepository(pgxPool)
 	projectTokens := projecttokenrepo.NewRepository(pgxPool)
 	mcpActionRequests := mcpactionrequestrepo.NewRepository(pgxPool)
+	mcpApprovalPolicies := mcpapprovalpolicyrepo.NewRepository(pgxPool)
 	missionControlProjection := missioncontrolrepo.NewRepository(pgxPool)
 	interactionRequests := interactionrequestrepo.NewRepository(pgxPool)
 	projectDatabases := projectdatabaserepo.NewRepository(pgxPool)
 	runtimeDeployTasks := runtimedeploytaskrepo.NewRepository(pgxPool)
 	runtimeErrors := runtimeerrorrepo.NewRepository(pgxPool)
+	retentionPolicies := retentionrepo.NewRepository(pgxPool)
+	runOutcomes := runoutcomerepo.NewRepository(pgxPool)
+	staffAPITokens := staffapitokenrepo.NewRepository(pgxPool)
+	alertIncidents := alertincidentrepo.NewRepository(pgxPool)
 	systemSettingsRepo := systemsettingrepo.NewRepository(pgxPool)
 	changeGovernanceProjection := changegovernancerepo.NewRepository(pgxPool)
 
@@ -149,8 +168,14 @@ func Run() error {
 		return fmt.Errorf("init postgres admin client: %w", err)
 	}
 	defer postgresAdminClient.Close()
-	githubMCPClient := githubclient.NewClient(nil)
-	githubMgmtClient := githubmgmtclient.NewClient(nil)
+	var githubHTTPClient *http.Client
+	var githubCache *githubcache.Transport
+	if cfg.GitHubCacheMaxEntries > 0 {
+		githubCache = githubcache.NewTransport(githubcache.Config{MaxEntries: cfg.GitHubCacheMaxEntries}, nil)
+		githubHTTPClient = githubCache.HTTPClient()
+	}
+	githubMCPClient := githubclient.NewClient(githubHTTPClient)
+	githubMgmtClient := githubmgmtclient.NewClient(githubHTTPClient)
 	githubRepoProvider := githubprovider.NewProvider(nil)
 
 	codexAuthService, err := codexauthdomain.NewService(codexauthdomain.Config{
@@ -177,12 +202,16 @@ func Run() error {
 		ServicesConfigEnv:            cfg.ServicesConfigEnv,
 		DefaultTokenTTL:              mcpTokenTTL,
 		DatabaseLifecycleAllowedEnvs: cfg.ProjectDBLifecycleAllowedEnvs,
+		ProjectToolAllowedHosts:      cfg.MCPProjectToolAllowedHosts,
 	}, mcpdomain.Dependencies{
 		Runs:             agentRuns,
 		FlowEvents:       flowEvents,
 		Repos:            repos,
 		Platform:         platformTokens,
 		Actions:          mcpActionRequests,
+		ApprovalPolicies: mcpApprovalPolicies,
+		ProjectMembers:   members,
+		Users:            users,
 		Interactions:     interactionRequests,
 		Sessions:         agentSessions,
 		ProjectDatabases: projectDatabases,
@@ -190,6 +219,7 @@ func Run() error {
 		GitHub:           githubMCPClient,
 		Kubernetes:       k8sClient,
 		Database:         postgresAdminClient,
+		ProjectToolHTTP:  &http.Client{Timeout: servicescfg.AgentHTTPToolMaxTimeoutSeconds * time.Second},
 	})
 	if err != nil {
 		return fmt.Errorf("init mcp domain service: %w", err)
@@ -213,6 +243,10 @@ func Run() error {
 	changeGovernanceService, err := changegovernancedomain.NewService(changegovernancedomain.Config{}, changegovernancedomain.Dependencies{
 		Repository:   changeGovernanceProjection,
 		RolloutState: systemSettingsService,
+		Platform:     platformTokens,
+		TokenCrypt:   tokenCrypto,
+		GitHub:       githubMgmtClient,
+		Logger:       logger,
 	})
 	if err != nil {
 		return fmt.Errorf("init change governance domain service: %w", err)
@@ -248,6 +282,16 @@ func Run() error {
 	if err != nil {
 		return fmt.Errorf("init mission control worker domain service: %w", err)
 	}
+	retentionService, err := retentiondomain.NewService(retentiondomain.Config{
+		PinnedFlowEventTypes: cfg.RetentionPinnedFlowEventTypes,
+	}, retentiondomain.Dependencies{
+		Repository: retentionPolicies,
+		Archive:    snapshotBlobs,
+		Logger:     logger,
+	})
+	if err != nil {
+		return fmt.Errorf("init retention domain service: %w", err)
+	}
 	runtimeDeployRolloutTimeout, err := time.ParseDuration(cfg.RuntimeDeployRolloutTimeout)
 	if err != nil {
 		return fmt.Errorf("parse KODEX_RUNTIME_DEPLOY_ROLLOUT_TIMEOUT=%q: %w", cfg.RuntimeDeployRolloutTimeout, err)
@@ -348,26 +392,6 @@ func Run() error {
 	}
 	webhookRuntimeModePolicy := loadWebhookRuntimeModePolicy(cfg, logger)
 
-	webhookService := webhook.NewService(webhook.Config{
-		AgentRuns:           agentRuns,
-		Agents:              agents,
-		FlowEvents:          flowEvents,
-		Repos:               repos,
-		Projects:            projects,
-		Users:               users,
-		Members:             members,
-		RunStatus:           runStatusService,
-		RuntimeErrors:       runtimeErrorService,
-		LearningModeDefault: learningDefault,
-		TriggerLabels:       buildWebhookTriggerLabels(cfg),
-		RuntimeModePolicy:   webhookRuntimeModePolicy,
-		PlatformNamespace:   strings.TrimSpace(cfg.PlatformNamespace),
-		GitHubToken:         strings.TrimSpace(cfg.GitHubPAT),
-		GitBotUsername:      strings.TrimSpace(cfg.GitBotUsername),
-		GitHubMgmt:          githubMgmtClient,
-		PushMainAutoBump:    true,
-	})
-
 	webhookURL := strings.TrimSpace(cfg.GitHubWebhookURL)
 	if webhookURL == "" {
 		webhookURL = strings.TrimRight(cfg.PublicBaseURL, "/") + "/api/v1/webhooks/github"
@@ -396,6 +420,10 @@ func Run() error {
 	if err != nil {
 		return fmt.Errorf("seed bootstrap projects and repositories: %w", err)
 	}
+	oidcGroupRoleMappings, err := parseOIDCGroupRoleMappings(cfg.OIDCGroupRoleMappings)
+	if err != nil {
+		return err
+	}
 	staffService := staff.NewService(staff.Config{
 		LearningModeDefault: learningDefault,
 		PromptSeedsDir:      filepath.Join(cfg.RepositoryRoot, "services/jobs/agent-runner/internal/runner/promptseeds"),
@@ -407,24 +435,59 @@ func Run() error {
 		ProtectedProjectIDs:    bootstrapSeed.ProtectedProjectIDs,
 		ProtectedRepositoryIDs: bootstrapSeed.ProtectedRepositoryIDs,
 		NextStepLabels:         buildNextStepLabels(cfg),
+		RunAIRepairLabel:       cfg.RunAIRepairLabel,
+		StateApprovedLabel:     cfg.StateApprovedLabel,
+		GitBotUsername:         strings.TrimSpace(cfg.GitBotUsername),
+		OIDCGroupRoleMappings:  oidcGroupRoleMappings,
 	}, staff.Dependencies{
-		Users:          users,
-		Projects:       projects,
-		Members:        members,
-		Repos:          repos,
-		ProjectTokens:  projectTokens,
-		Feedback:       feedback,
-		Runs:           runs,
-		Tasks:          runtimeDeployTasks,
-		RuntimeErrors:  runtimeErrors,
-		K8s:            k8sClient,
-		Tokencrypt:     tokenCrypto,
-		PlatformTokens: platformTokens,
-		GitHub:         githubRepoProvider,
-		GitHubMgmt:     githubMgmtClient,
-		RunStatus:      runStatusService,
-		RuntimeDeploy:  runtimeDeployService,
-		SystemSettings: systemSettingsService,
+		Users:               users,
+		Projects:            projects,
+		Members:             members,
+		ProjectRoles:        projectRoles,
+		MCPApprovalPolicies: mcpApprovalPolicies,
+		Repos:               repos,
+		ProjectTokens:       projectTokens,
+		Feedback:            feedback,
+		Runs:                runs,
+		Tasks:               runtimeDeployTasks,
+		RuntimeErrors:       runtimeErrors,
+		Retention:           retentionPolicies,
+		RunOutcomes:         runOutcomes,
+		FlowEvents:          flowEvents,
+		APITokens:           staffAPITokens,
+		K8s:                 k8sClient,
+		Tokencrypt:          tokenCrypto,
+		PlatformTokens:      platformTokens,
+		GitHub:              githubRepoProvider,
+		GitHubMgmt:          githubMgmtClient,
+		RunStatus:           runStatusService,
+		RuntimeDeploy:       runtimeDeployService,
+		SystemSettings:      systemSettingsService,
+	})
+	webhookService := webhook.NewService(webhook.Config{
+		AgentRuns:           agentRuns,
+		Agents:              agents,
+		FlowEvents:          flowEvents,
+		Repos:               repos,
+		Projects:            projects,
+		Users:               users,
+		Members:             members,
+		ProjectRoles:        projectRoles,
+		RunStatus:           runStatusService,
+		RuntimeErrors:       runtimeErrorService,
+		AlertIncidents:      alertIncidents,
+		AlertIssues:         githubMgmtClient,
+		LearningModeDefault: learningDefault,
+		TriggerLabels:       buildWebhookTriggerLabels(cfg),
+		RuntimeModePolicy:   webhookRuntimeModePolicy,
+		PlatformNamespace:   strings.TrimSpace(cfg.PlatformNamespace),
+		GitHubToken:         strings.TrimSpace(cfg.GitHubPAT),
+		GitBotUsername:      strings.TrimSpace(cfg.GitBotUsername),
+		GitHubMgmt:          githubMgmtClient,
+		PushMainAutoBump:    true,
+		GitHubCache:         githubCache,
+		StageAutoAdvance:    staffService,
+		ReleaseGates:        changeGovernanceService,
 	})
 	githubRateLimitService, err = githubratelimitdomain.NewService(githubratelimitdomain.Config{
 		RolloutState: valuetypes.GitHubRateLimitRolloutState{},
@@ -476,6 +539,9 @@ func Run() error {
 	if err := startRunHeavyFieldsCleanupLoop(runCtx, agentCallbackService, runtimeDeployService, logger, retentionDays); err != nil {
 		return fmt.Errorf("start run heavy fields cleanup loop: %w", err)
 	}
+	if err := startMCPApprovalExpiryLoop(runCtx, mcpService, logger); err != nil {
+		return fmt.Errorf("start mcp approval expiry loop: %w", err)
+	}
 	if err := startRuntimeDeployReconcilerLoop(
 		runCtx,
 		runtimeDeployService,
@@ -503,6 +569,7 @@ func Run() error {
 		GitHubRateLimit:      githubRateLimitService,
 		MissionControl:       missionControlWorkerService,
 		MissionControlDomain: missionControlService,
+		Retention:            retentionService,
 		RunStatus:            runStatusService,
 		Runs:                 agentRuns,
 		RuntimeDeploy:        runtimeDeployService,
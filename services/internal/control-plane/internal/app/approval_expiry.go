@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	mcpdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/mcp"
+)
+
+const (
+	mcpApprovalExpiryInterval  = time.Minute
+	mcpApprovalExpiryTimeout   = 30 * time.Second
+	mcpApprovalExpiryBatchSize = 100
+)
+
+// startMCPApprovalExpiryLoop periodically auto-rejects policy-governed approvals past their expiry.
+func startMCPApprovalExpiryLoop(ctx context.Context, mcp *mcpdomain.Service, logger *slog.Logger) error {
+	if mcp == nil {
+		return fmt.Errorf("mcp service is required")
+	}
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	runExpiry := func() {
+		expiryCtx, cancel := context.WithTimeout(ctx, mcpApprovalExpiryTimeout)
+		defer cancel()
+
+		expired, err := mcp.ExpireOverdueApprovals(expiryCtx, mcpApprovalExpiryBatchSize)
+		if err != nil {
+			logger.Error("mcp approval expiry failed", "err", err)
+		}
+		if expired > 0 {
+			logger.Info("mcp approvals expired by policy", "expired", expired)
+		}
+	}
+
+	go func() {
+		ticker := time.NewTicker(mcpApprovalExpiryInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				runExpiry()
+			}
+		}
+	}()
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package app
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/blobstore"
+)
+
+// BlobStoreConfig defines environment-backed settings of pluggable blob storage.
+type BlobStoreConfig struct {
+	// Backend is one of "" (inline Postgres payloads), "filesystem" or "s3".
+	Backend string `env:"KODEX_BLOB_STORE_BACKEND"`
+	// FilesystemRoot is root directory for filesystem backend.
+	FilesystemRoot string `env:"KODEX_BLOB_STORE_FS_ROOT" envDefault:"/var/lib/kodex/blobs"`
+	// S3Endpoint is S3-compatible endpoint URL, e.g. http://minio:9000.
+	S3Endpoint string `env:"KODEX_BLOB_STORE_S3_ENDPOINT"`
+	// S3Region is SigV4 signing region.
+	S3Region string `env:"KODEX_BLOB_STORE_S3_REGION" envDefault:"us-east-1"`
+	// S3Bucket is bucket name for stored objects.
+	S3Bucket string `env:"KODEX_BLOB_STORE_S3_BUCKET"`
+	// S3AccessKeyID is access key id for S3-compatible storage.
+	S3AccessKeyID string `env:"KODEX_BLOB_STORE_S3_ACCESS_KEY_ID"`
+	// S3SecretAccessKey is secret access key for S3-compatible storage.
+	S3SecretAccessKey string `env:"KODEX_BLOB_STORE_S3_SECRET_ACCESS_KEY"`
+	// S3PathStyle enables path-style bucket addressing required by MinIO-like storages.
+	S3PathStyle bool `env:"KODEX_BLOB_STORE_S3_PATH_STYLE" envDefault:"true"`
+	// S3Timeout is HTTP timeout for one object storage request.
+	S3Timeout string `env:"KODEX_BLOB_STORE_S3_TIMEOUT" envDefault:"30s"`
+}
+
+// Open builds configured blob store; nil store means payloads stay inline in Postgres.
+func (c BlobStoreConfig) Open() (blobstore.Store, error) {
+	timeout, err := time.ParseDuration(strings.TrimSpace(c.S3Timeout))
+	if err != nil {
+		return nil, fmt.Errorf("parse KODEX_BLOB_STORE_S3_TIMEOUT=%q: %w", c.S3Timeout, err)
+	}
+	store, err := blobstore.Open(blobstore.Config{
+		Backend:        blobstore.Backend(c.Backend),
+		FilesystemRoot: c.FilesystemRoot,
+		S3: blobstore.S3Config{
+			Endpoint:        c.S3Endpoint,
+			Region:          c.S3Region,
+			Bucket:          c.S3Bucket,
+			AccessKeyID:     c.S3AccessKeyID,
+			SecretAccessKey: c.S3SecretAccessKey,
+			PathStyle:       c.S3PathStyle,
+			Timeout:         timeout,
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("open blob store: %w", err)
+	}
+	return store, nil
+}
@@ -72,6 +72,7 @@ type Config struct {
 	RunRethinkLabel           string   `env:"KODEX_RUN_RETHINK_LABEL" envDefault:"run:rethink"`
 	ModeDiscussionLabel       string   `env:"KODEX_MODE_DISCUSSION_LABEL" envDefault:"mode:discussion"`
 	NeedReviewerLabel         string   `env:"KODEX_NEED_REVIEWER_LABEL" envDefault:"need:reviewer"`
+	StateApprovedLabel        string   `env:"KODEX_STATE_APPROVED_LABEL" envDefault:"state:approved"`
 	// ServicesConfigPath points to services.yaml used for webhook runtime policy.
 	ServicesConfigPath string `env:"KODEX_SERVICES_CONFIG_PATH" envDefault:"services.yaml"`
 	// ServicesConfigEnv selects environment context when rendering services.yaml.
@@ -110,8 +111,11 @@ type Config struct {
 	FirstProjectGitHubRepo string `env:"KODEX_FIRST_PROJECT_GITHUB_REPO"`
 	// GitBotToken is runtime GitHub bot token used for comments/labels and run messaging paths.
 	GitBotToken string `env:"KODEX_GIT_BOT_TOKEN"`
-	// GitBotUsername is GitHub login used to filter bot-authored issue comments from webhook triggers.
+	// GitBotUsername is GitHub login used to filter bot-authored issue comments from webhook triggers
+	// and to ignore bot reviews in stage auto-advance approval checks.
 	GitBotUsername string `env:"KODEX_GIT_BOT_USERNAME" envDefault:"codex-bot"`
+	// GitHubCacheMaxEntries bounds in-memory ETag cache of GitHub REST responses; 0 disables cache.
+	GitHubCacheMaxEntries int `env:"KODEX_GITHUB_CACHE_MAX_ENTRIES" envDefault:"4096"`
 
 	// TokenEncryptionKey is used to encrypt/decrypt repository tokens stored in DB.
 	TokenEncryptionKey string `env:"KODEX_TOKEN_ENCRYPTION_KEY,required,notEmpty"`
@@ -126,6 +130,14 @@ type Config struct {
 	RunHeavyFieldsRetentionDays int `env:"KODEX_RUN_HEAVY_FIELDS_RETENTION_DAYS" envDefault:"7"`
 	// RunAgentLogsRetentionDays is kept for legacy env compatibility as fallback retention source.
 	RunAgentLogsRetentionDays int `env:"KODEX_RUN_AGENT_LOGS_RETENTION_DAYS" envDefault:"7"`
+	// BlobStore configures object storage for agent session snapshots and retention archives; empty backend keeps snapshots in Postgres.
+	BlobStore BlobStoreConfig
+	// RetentionPinnedFlowEventTypes overrides audit-critical flow event types never removed by retention sweeps.
+	RetentionPinnedFlowEventTypes []string `env:"KODEX_RETENTION_PINNED_FLOW_EVENT_TYPES"`
+
+	// OIDCGroupRoleMappings is a JSON array mapping OIDC groups to platform admin flag and project roles,
+	// e.g. [{"group":"kodex-admins","platform_admin":true},{"group":"team-a","project":"team-a","role":"read_write"}].
+	OIDCGroupRoleMappings string `env:"KODEX_OIDC_GROUP_ROLE_MAPPINGS"`
 
 	// DBHost is the PostgreSQL host.
 	DBHost string `env:"KODEX_DB_HOST,required,notEmpty"`
@@ -154,6 +166,8 @@ type Config struct {
 	ProjectDBAdminDatabase string `env:"KODEX_PROJECT_DB_ADMIN_DATABASE" envDefault:"postgres"`
 	// ProjectDBLifecycleAllowedEnvs contains allowed environment names for MCP database lifecycle tool.
 	ProjectDBLifecycleAllowedEnvs []string `env:"KODEX_PROJECT_DB_LIFECYCLE_ALLOWED_ENVS" envDefault:"dev,production,prod"`
+	// MCPProjectToolAllowedHosts lists hosts (with subdomains) reachable by project HTTP tools; empty disables them.
+	MCPProjectToolAllowedHosts []string `env:"KODEX_MCP_PROJECT_TOOL_ALLOWED_HOSTS"`
 }
 
 func (c Config) LearningModeDefaultBool() (bool, error) {
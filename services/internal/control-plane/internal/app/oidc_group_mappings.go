@@ -0,0 +1,44 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+// parseOIDCGroupRoleMappings decodes KODEX_OIDC_GROUP_ROLE_MAPPINGS JSON array.
+func parseOIDCGroupRoleMappings(raw string) ([]querytypes.OIDCGroupRoleMapping, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return nil, nil
+	}
+
+	var mappings []querytypes.OIDCGroupRoleMapping
+	if err := json.Unmarshal([]byte(raw), &mappings); err != nil {
+		return nil, fmt.Errorf("decode oidc group role mappings: %w", err)
+	}
+	for i, mapping := range mappings {
+		if strings.TrimSpace(mapping.Group) == "" {
+			return nil, fmt.Errorf("oidc group role mapping #%d: group is required", i)
+		}
+		project := strings.TrimSpace(mapping.ProjectSlug)
+		role := strings.TrimSpace(mapping.Role)
+		switch {
+		case project == "" && role == "":
+			if !mapping.PlatformAdmin {
+				return nil, fmt.Errorf("oidc group role mapping #%d: project role or platform_admin is required", i)
+			}
+		case project == "" || role == "":
+			return nil, fmt.Errorf("oidc group role mapping #%d: project and role must be set together", i)
+		default:
+			switch role {
+			case "read", "read_write", "admin":
+			default:
+				return nil, fmt.Errorf("oidc group role mapping #%d: unsupported role %q", i, role)
+			}
+		}
+	}
+	return mappings, nil
+}
@@ -34,5 +34,6 @@ func buildWebhookTriggerLabels(cfg Config) webhook.TriggerLabels {
 	labels.RunRethink = cfg.RunRethinkLabel
 	labels.ModeDiscussion = cfg.ModeDiscussionLabel
 	labels.NeedReviewer = cfg.NeedReviewerLabel
+	labels.StateApproved = cfg.StateApprovedLabel
 	return labels
 }
@@ -0,0 +1,75 @@
+package githubcache
+
+import (
+	"strings"
+)
+
+// refSegments are followed by free-form refs/paths which are collapsed to keep metric cardinality bounded.
+var refSegments = map[string]struct{}{
+	"branches": {},
+	"commits":  {},
+	"compare":  {},
+	"contents": {},
+	"git":      {},
+	"statuses": {},
+}
+
+// endpointLabel normalizes GitHub REST path into low-cardinality template, e.g.
+// /repos/acme/app/issues/42/comments -> /repos/{owner}/{repo}/issues/{number}/comments.
+func endpointLabel(path string) string {
+	segments := splitPath(path)
+	if len(segments) == 0 {
+		return "/"
+	}
+	out := make([]string, 0, len(segments))
+	for idx, segment := range segments {
+		switch {
+		case segments[0] == "repos" && idx == 1:
+			out = append(out, "{owner}")
+		case segments[0] == "repos" && idx == 2:
+			out = append(out, "{repo}")
+		case segments[0] == "users" && idx == 1:
+			out = append(out, "{login}")
+		case isNumeric(segment):
+			out = append(out, "{number}")
+		default:
+			out = append(out, segment)
+		}
+		if _, ok := refSegments[segment]; ok && idx < len(segments)-1 {
+			out = append(out, "{ref}")
+			break
+		}
+	}
+	return "/" + strings.Join(out, "/")
+}
+
+// repositoryFromPath returns lower-cased owner/name for /repos/{owner}/{repo}/... paths.
+func repositoryFromPath(path string) string {
+	segments := splitPath(path)
+	if len(segments) < 3 || segments[0] != "repos" {
+		return ""
+	}
+	return strings.ToLower(segments[1] + "/" + segments[2])
+}
+
+func splitPath(path string) []string {
+	trimmed := strings.Trim(path, "/")
+	// go-github may be configured with enterprise base URL prefix.
+	trimmed = strings.TrimPrefix(trimmed, "api/v3/")
+	if trimmed == "" {
+		return nil
+	}
+	return strings.Split(trimmed, "/")
+}
+
+func isNumeric(value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
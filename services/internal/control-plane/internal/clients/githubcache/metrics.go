@@ -0,0 +1,50 @@
+package githubcache
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// resultHit means cached body was served after 304 revalidation (not counted against primary rate limit).
+	resultHit = "hit"
+	// resultMiss means GitHub returned full response.
+	resultMiss = "miss"
+	// resultBypass means request is not cacheable (writes or caller-managed conditional requests).
+	resultBypass = "bypass"
+
+	invalidationSourceWebhook = "webhook"
+	invalidationSourceWrite   = "write"
+)
+
+var (
+	githubCacheRequestsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kodex_github_api_cache_requests_total",
+			Help: "Total number of GitHub REST requests passed through control-plane cache grouped by endpoint template and cache result.",
+		},
+		[]string{"endpoint", "result"},
+	)
+
+	githubCacheInvalidationsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kodex_github_api_cache_invalidations_total",
+			Help: "Total number of cached GitHub responses dropped grouped by invalidation source.",
+		},
+		[]string{"source"},
+	)
+)
+
+func init() {
+	for _, source := range []string{invalidationSourceWebhook, invalidationSourceWrite} {
+		githubCacheInvalidationsTotal.WithLabelValues(source)
+	}
+}
+
+func observeRequest(endpoint string, result string) {
+	githubCacheRequestsTotal.WithLabelValues(endpoint, result).Inc()
+}
+
+func observeInvalidation(source string, removed int) {
+	githubCacheInvalidationsTotal.WithLabelValues(source).Add(float64(removed))
+}
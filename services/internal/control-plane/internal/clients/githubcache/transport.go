@@ -0,0 +1,274 @@
+package githubcache
+
+import (
+	"bytes"
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+const (
+	defaultMaxEntries   = 4096
+	defaultMaxBodyBytes = 1 << 20
+)
+
+// rateLimitHeaders are refreshed from revalidation responses so go-github sees current quota.
+var rateLimitHeaders = []string{
+	"X-RateLimit-Limit",
+	"X-RateLimit-Remaining",
+	"X-RateLimit-Used",
+	"X-RateLimit-Reset",
+	"X-RateLimit-Resource",
+	"Date",
+}
+
+// Config controls in-memory GitHub response cache bounds.
+type Config struct {
+	// MaxEntries bounds cached responses; least recently used entries are evicted first.
+	MaxEntries int
+	// MaxBodyBytes skips caching for larger response bodies.
+	MaxBodyBytes int64
+}
+
+// Transport is an http.RoundTripper that stores ETag/Last-Modified validators of GitHub REST GET responses
+// and revalidates them with conditional requests. GitHub does not charge 304 responses against primary rate limit.
+type Transport struct {
+	base         http.RoundTripper
+	maxEntries   int
+	maxBodyBytes int64
+
+	mu      sync.Mutex
+	entries map[string]*list.Element
+	lru     *list.List
+}
+
+type cacheEntry struct {
+	key          string
+	repository   string
+	etag         string
+	lastModified string
+	statusCode   int
+	header       http.Header
+	body         []byte
+}
+
+// NewTransport constructs caching transport over base (http.DefaultTransport when nil).
+func NewTransport(cfg Config, base http.RoundTripper) *Transport {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if cfg.MaxEntries <= 0 {
+		cfg.MaxEntries = defaultMaxEntries
+	}
+	if cfg.MaxBodyBytes <= 0 {
+		cfg.MaxBodyBytes = defaultMaxBodyBytes
+	}
+	return &Transport{
+		base:         base,
+		maxEntries:   cfg.MaxEntries,
+		maxBodyBytes: cfg.MaxBodyBytes,
+		entries:      make(map[string]*list.Element),
+		lru:          list.New(),
+	}
+}
+
+// HTTPClient returns http.Client using this transport.
+func (t *Transport) HTTPClient() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := endpointLabel(req.URL.Path)
+	repository := repositoryFromPath(req.URL.Path)
+
+	if req.Method != http.MethodGet {
+		resp, err := t.base.RoundTrip(req)
+		if err == nil && repository != "" && resp.StatusCode < http.StatusBadRequest {
+			t.invalidate(repository, invalidationSourceWrite)
+		}
+		observeRequest(endpoint, resultBypass)
+		return resp, err
+	}
+	// Caller-managed conditional requests are passed through untouched.
+	if req.Header.Get("If-None-Match") != "" || req.Header.Get("If-Modified-Since") != "" {
+		observeRequest(endpoint, resultBypass)
+		return t.base.RoundTrip(req)
+	}
+
+	key := cacheKey(req)
+	cached, ok := t.lookup(key)
+	outReq := req
+	if ok {
+		outReq = req.Clone(req.Context())
+		if cached.etag != "" {
+			outReq.Header.Set("If-None-Match", cached.etag)
+		}
+		if cached.lastModified != "" {
+			outReq.Header.Set("If-Modified-Since", cached.lastModified)
+		}
+	}
+
+	resp, err := t.base.RoundTrip(outReq)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		_, _ = io.Copy(io.Discard, resp.Body)
+		_ = resp.Body.Close()
+		observeRequest(endpoint, resultHit)
+		return cached.response(req, resp.Header), nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		if ok {
+			t.remove(key)
+		}
+		observeRequest(endpoint, resultMiss)
+		return resp, nil
+	}
+
+	etag := resp.Header.Get("ETag")
+	lastModified := resp.Header.Get("Last-Modified")
+	if etag == "" && lastModified == "" {
+		observeRequest(endpoint, resultMiss)
+		return resp, nil
+	}
+
+	body, readErr := io.ReadAll(io.LimitReader(resp.Body, t.maxBodyBytes+1))
+	if readErr != nil {
+		_ = resp.Body.Close()
+		return nil, readErr
+	}
+	if int64(len(body)) > t.maxBodyBytes {
+		// Too large to cache: stitch already read prefix back in front of remaining body.
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		observeRequest(endpoint, resultMiss)
+		return resp, nil
+	}
+	_ = resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	t.store(&cacheEntry{
+		key:          key,
+		repository:   repository,
+		etag:         etag,
+		lastModified: lastModified,
+		statusCode:   resp.StatusCode,
+		header:       resp.Header.Clone(),
+		body:         body,
+	})
+	observeRequest(endpoint, resultMiss)
+	return resp, nil
+}
+
+// InvalidateRepository drops cached responses of one repository (owner/name), e.g. on incoming webhook.
+// It is safe to call on nil Transport when cache is disabled.
+func (t *Transport) InvalidateRepository(fullName string) int {
+	if t == nil {
+		return 0
+	}
+	return t.invalidate(strings.ToLower(strings.TrimSpace(fullName)), invalidationSourceWebhook)
+}
+
+// Len returns number of cached responses.
+func (t *Transport) Len() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.lru.Len()
+}
+
+func (t *Transport) invalidate(repository string, source string) int {
+	if repository == "" {
+		return 0
+	}
+	t.mu.Lock()
+	removed := 0
+	for key, element := range t.entries {
+		if element.Value.(*cacheEntry).repository != repository {
+			continue
+		}
+		t.lru.Remove(element)
+		delete(t.entries, key)
+		removed++
+	}
+	t.mu.Unlock()
+	if removed > 0 {
+		observeInvalidation(source, removed)
+	}
+	return removed
+}
+
+func (t *Transport) lookup(key string) (*cacheEntry, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	element, ok := t.entries[key]
+	if !ok {
+		return nil, false
+	}
+	t.lru.MoveToFront(element)
+	return element.Value.(*cacheEntry), true
+}
+
+func (t *Transport) store(entry *cacheEntry) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if element, ok := t.entries[entry.key]; ok {
+		element.Value = entry
+		t.lru.MoveToFront(element)
+		return
+	}
+	t.entries[entry.key] = t.lru.PushFront(entry)
+	for t.lru.Len() > t.maxEntries {
+		oldest := t.lru.Back()
+		t.lru.Remove(oldest)
+		delete(t.entries, oldest.Value.(*cacheEntry).key)
+	}
+}
+
+func (t *Transport) remove(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if element, ok := t.entries[key]; ok {
+		t.lru.Remove(element)
+		delete(t.entries, key)
+	}
+}
+
+func (e *cacheEntry) response(req *http.Request, fresh http.Header) *http.Response {
+	header := e.header.Clone()
+	for _, name := range rateLimitHeaders {
+		if value := fresh.Get(name); value != "" {
+			header.Set(name, value)
+		}
+	}
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.statusCode, http.StatusText(e.statusCode)),
+		StatusCode:    e.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(e.body)),
+		ContentLength: int64(len(e.body)),
+		Request:       req,
+	}
+}
+
+// cacheKey scopes entries by credentials so responses of private repositories never leak across tokens.
+func cacheKey(req *http.Request) string {
+	auth := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	return strings.Join([]string{
+		req.URL.String(),
+		req.Header.Get("Accept"),
+		hex.EncodeToString(auth[:8]),
+	}, "\x00")
+}
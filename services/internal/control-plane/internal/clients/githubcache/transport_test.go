@@ -0,0 +1,116 @@
+package githubcache
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+)
+
+func TestTransport_RevalidatesWithETag(t *testing.T) {
+	t.Parallel()
+
+	var fullResponses, notModified atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(int(4999-fullResponses.Load()-notModified.Load())))
+		if r.Method != http.MethodGet {
+			w.WriteHeader(http.StatusCreated)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fullResponses.Add(1)
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(`{"number":42}`))
+	}))
+	defer server.Close()
+
+	transport := NewTransport(Config{}, nil)
+	client := transport.HTTPClient()
+	get := func(token string) (string, string) {
+		t.Helper()
+		req, _ := http.NewRequest(http.MethodGet, server.URL+"/repos/Acme/App/issues/42", nil)
+		req.Header.Set("Authorization", "Bearer "+token)
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("Do() error = %v", err)
+		}
+		defer func() { _ = resp.Body.Close() }()
+		if resp.StatusCode != http.StatusOK {
+			t.Fatalf("status = %d, want 200", resp.StatusCode)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		return string(body), resp.Header.Get("X-RateLimit-Remaining")
+	}
+
+	if body, _ := get("token-a"); body != `{"number":42}` {
+		t.Fatalf("first body = %q", body)
+	}
+	body, remaining := get("token-a")
+	if body != `{"number":42}` {
+		t.Fatalf("cached body = %q", body)
+	}
+	if notModified.Load() != 1 || fullResponses.Load() != 1 {
+		t.Fatalf("full=%d not_modified=%d, want 1/1", fullResponses.Load(), notModified.Load())
+	}
+	if remaining != "4998" {
+		t.Fatalf("rate limit header = %q, want refreshed value from 304", remaining)
+	}
+
+	get("token-b")
+	if fullResponses.Load() != 2 {
+		t.Fatalf("full responses = %d, want cache entries scoped by token", fullResponses.Load())
+	}
+
+	if removed := transport.InvalidateRepository("acme/app"); removed != 2 {
+		t.Fatalf("InvalidateRepository() removed = %d, want 2", removed)
+	}
+	get("token-a")
+	if fullResponses.Load() != 3 {
+		t.Fatalf("full responses = %d, want refetch after invalidation", fullResponses.Load())
+	}
+
+	req, _ := http.NewRequest(http.MethodPost, server.URL+"/repos/acme/app/issues/42/comments", nil)
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("Do(POST) error = %v", err)
+	}
+	_ = resp.Body.Close()
+	if transport.Len() != 0 {
+		t.Fatalf("cache entries = %d, want repository entries dropped after write", transport.Len())
+	}
+}
+
+func TestTransport_NilInvalidateIsNoop(t *testing.T) {
+	t.Parallel()
+
+	var transport *Transport
+	if removed := transport.InvalidateRepository("acme/app"); removed != 0 {
+		t.Fatalf("InvalidateRepository() = %d, want 0", removed)
+	}
+}
+
+func TestEndpointLabel(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]string{
+		"/repos/acme/app/issues/42/comments":       "/repos/{owner}/{repo}/issues/{number}/comments",
+		"/repos/acme/app/labels":                   "/repos/{owner}/{repo}/labels",
+		"/repos/acme/app/contents/docs/readme.md":  "/repos/{owner}/{repo}/contents/{ref}",
+		"/repos/acme/app/git/ref/heads/main":       "/repos/{owner}/{repo}/git/{ref}",
+		"/api/v3/repos/acme/app/pulls/7":           "/repos/{owner}/{repo}/pulls/{number}",
+		"/users/octocat":                           "/users/{login}",
+		"/user":                                    "/user",
+		"/repos/acme/app/compare/main...feature/x": "/repos/{owner}/{repo}/compare/{ref}",
+	}
+	for path, want := range cases {
+		if got := endpointLabel(path); got != want {
+			t.Fatalf("endpointLabel(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,70 @@
+package githubmgmt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
+	gh "github.com/google/go-github/v82/github"
+)
+
+// GetPullRequestHeadSHA returns current head commit SHA of one pull request.
+func (c *Client) GetPullRequestHeadSHA(ctx context.Context, token string, owner string, repo string, prNumber int) (string, error) {
+	head, err := c.GetPullRequestHead(ctx, token, owner, repo, prNumber)
+	if err != nil {
+		return "", err
+	}
+	if head.HeadSHA == "" {
+		return "", fmt.Errorf("github pull request %s/%s#%d has empty head sha", strings.TrimSpace(owner), strings.TrimSpace(repo), prNumber)
+	}
+	return head.HeadSHA, nil
+}
+
+// CreateCommitStatus publishes one commit status for the provided commit SHA.
+func (c *Client) CreateCommitStatus(ctx context.Context, token string, owner string, repo string, params valuetypes.GitHubCommitStatusParams) error {
+	owner = strings.TrimSpace(owner)
+	repo = strings.TrimSpace(repo)
+	if owner == "" || repo == "" {
+		return fmt.Errorf("owner and repository are required")
+	}
+	sha := strings.TrimSpace(params.SHA)
+	if sha == "" {
+		return fmt.Errorf("sha is required")
+	}
+	state := strings.TrimSpace(params.State)
+	switch state {
+	case "error", "failure", "pending", "success":
+	default:
+		return fmt.Errorf("unsupported commit status state %q", params.State)
+	}
+	statusContext := strings.TrimSpace(params.Context)
+	if statusContext == "" {
+		return fmt.Errorf("status context is required")
+	}
+
+	status := gh.RepoStatus{
+		State:       gh.Ptr(state),
+		Context:     gh.Ptr(statusContext),
+		Description: gh.Ptr(truncateCommitStatusDescription(params.Description)),
+	}
+	if targetURL := strings.TrimSpace(params.TargetURL); targetURL != "" {
+		status.TargetURL = gh.Ptr(targetURL)
+	}
+
+	client := c.clientWithToken(token)
+	if _, _, err := client.Repositories.CreateStatus(ctx, owner, repo, sha, status); err != nil {
+		return fmt.Errorf("github create commit status %s/%s@%s: %w", owner, repo, sha, err)
+	}
+	return nil
+}
+
+// truncateCommitStatusDescription keeps descriptions within GitHub's 140-character limit.
+func truncateCommitStatusDescription(value string) string {
+	const maxRunes = 140
+	runes := []rune(strings.TrimSpace(value))
+	if len(runes) <= maxRunes {
+		return string(runes)
+	}
+	return string(runes[:maxRunes-3]) + "..."
+}
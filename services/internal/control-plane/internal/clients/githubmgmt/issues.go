@@ -0,0 +1,59 @@
+package githubmgmt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gh "github.com/google/go-github/v82/github"
+)
+
+// CreateIssue opens one issue and returns its number and html url.
+func (c *Client) CreateIssue(ctx context.Context, token string, owner string, repo string, title string, body string, labels []string) (int, string, error) {
+	owner = strings.TrimSpace(owner)
+	repo = strings.TrimSpace(repo)
+	if owner == "" || repo == "" {
+		return 0, "", fmt.Errorf("owner and repository are required")
+	}
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return 0, "", fmt.Errorf("issue title is required")
+	}
+
+	request := &gh.IssueRequest{
+		Title: gh.Ptr(title),
+		Body:  gh.Ptr(body),
+	}
+	if normalizedLabels := normalizeIssueLabels(labels); len(normalizedLabels) > 0 {
+		request.Labels = &normalizedLabels
+	}
+
+	client := c.clientWithToken(token)
+	issue, _, err := client.Issues.Create(ctx, owner, repo, request)
+	if err != nil {
+		return 0, "", fmt.Errorf("github create issue %s/%s: %w", owner, repo, err)
+	}
+	return issue.GetNumber(), issue.GetHTMLURL(), nil
+}
+
+// CreateIssueComment posts one comment into issue thread and returns comment html url.
+func (c *Client) CreateIssueComment(ctx context.Context, token string, owner string, repo string, issueNumber int, body string) (string, error) {
+	owner = strings.TrimSpace(owner)
+	repo = strings.TrimSpace(repo)
+	if owner == "" || repo == "" {
+		return "", fmt.Errorf("owner and repository are required")
+	}
+	if issueNumber <= 0 {
+		return "", fmt.Errorf("issue_number must be positive")
+	}
+	if strings.TrimSpace(body) == "" {
+		return "", fmt.Errorf("comment body is required")
+	}
+
+	client := c.clientWithToken(token)
+	comment, _, err := client.Issues.CreateComment(ctx, owner, repo, issueNumber, &gh.IssueComment{Body: gh.Ptr(body)})
+	if err != nil {
+		return "", fmt.Errorf("github create issue comment %s/%s#%d: %w", owner, repo, issueNumber, err)
+	}
+	return comment.GetHTMLURL(), nil
+}
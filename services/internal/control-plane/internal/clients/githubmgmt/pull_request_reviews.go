@@ -0,0 +1,81 @@
+package githubmgmt
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	gh "github.com/google/go-github/v82/github"
+)
+
+// trustedReviewerAssociations are author associations whose reviews count toward approval;
+// anyone can leave a review on a public repository.
+var trustedReviewerAssociations = map[string]struct{}{
+	"OWNER":        {},
+	"MEMBER":       {},
+	"COLLABORATOR": {},
+}
+
+// IsPullRequestApproved reports whether the latest review of every trusted reviewer leaves the PR approved:
+// at least one reviewer approved and nobody has outstanding changes requested. Only repository owners,
+// organization members and collaborators are trusted; reviews by botLogin are ignored.
+func (c *Client) IsPullRequestApproved(ctx context.Context, token string, owner string, repo string, number int, botLogin string) (bool, error) {
+	owner = strings.TrimSpace(owner)
+	repo = strings.TrimSpace(repo)
+	if owner == "" || repo == "" {
+		return false, fmt.Errorf("owner and repository are required")
+	}
+	if number <= 0 {
+		return false, fmt.Errorf("pull_request_number must be positive")
+	}
+
+	client := c.clientWithToken(token)
+	opts := &gh.ListOptions{PerPage: 100}
+	var reviews []*gh.PullRequestReview
+	for {
+		page, resp, err := client.PullRequests.ListReviews(ctx, owner, repo, number, opts)
+		if err != nil {
+			return false, fmt.Errorf("github list pull request reviews %s/%s#%d: %w", owner, repo, number, err)
+		}
+		reviews = append(reviews, page...)
+		if resp == nil || resp.NextPage == 0 {
+			break
+		}
+		opts.Page = resp.NextPage
+	}
+	return isApprovedByTrustedReviewers(reviews, botLogin), nil
+}
+
+// isApprovedByTrustedReviewers folds chronologically ordered reviews into the latest verdict of each trusted reviewer.
+func isApprovedByTrustedReviewers(reviews []*gh.PullRequestReview, botLogin string) bool {
+	botLogin = strings.ToLower(strings.TrimSpace(botLogin))
+	latestByReviewer := make(map[string]string)
+	for _, review := range reviews {
+		if review == nil {
+			continue
+		}
+		reviewer := strings.ToLower(strings.TrimSpace(review.GetUser().GetLogin()))
+		if reviewer == "" || reviewer == botLogin {
+			continue
+		}
+		if _, ok := trustedReviewerAssociations[strings.ToUpper(strings.TrimSpace(review.GetAuthorAssociation()))]; !ok {
+			continue
+		}
+		// Comments do not change reviewer verdict.
+		switch state := strings.ToUpper(strings.TrimSpace(review.GetState())); state {
+		case "APPROVED", "CHANGES_REQUESTED", "DISMISSED":
+			latestByReviewer[reviewer] = state
+		}
+	}
+
+	approved := false
+	for _, state := range latestByReviewer {
+		switch state {
+		case "CHANGES_REQUESTED":
+			return false
+		case "APPROVED":
+			approved = true
+		}
+	}
+	return approved
+}
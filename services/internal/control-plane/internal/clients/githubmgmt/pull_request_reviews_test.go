@@ -0,0 +1,61 @@
+package githubmgmt
+
+import (
+	"testing"
+
+	gh "github.com/google/go-github/v82/github"
+)
+
+func TestIsApprovedByTrustedReviewers(t *testing.T) {
+	t.Parallel()
+
+	review := func(login string, association string, state string) *gh.PullRequestReview {
+		return &gh.PullRequestReview{
+			User:              &gh.User{Login: gh.Ptr(login)},
+			AuthorAssociation: gh.Ptr(association),
+			State:             gh.Ptr(state),
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		reviews []*gh.PullRequestReview
+		want    bool
+	}{
+		{
+			name:    "member approval",
+			reviews: []*gh.PullRequestReview{review("alice", "MEMBER", "APPROVED")},
+			want:    true,
+		},
+		{
+			name:    "outsider approval is ignored",
+			reviews: []*gh.PullRequestReview{review("mallory", "NONE", "APPROVED"), review("eve", "CONTRIBUTOR", "APPROVED")},
+			want:    false,
+		},
+		{
+			name:    "bot approval is ignored",
+			reviews: []*gh.PullRequestReview{review("Codex-Bot", "COLLABORATOR", "APPROVED")},
+			want:    false,
+		},
+		{
+			name:    "outstanding changes requested",
+			reviews: []*gh.PullRequestReview{review("alice", "OWNER", "APPROVED"), review("bob", "COLLABORATOR", "CHANGES_REQUESTED")},
+			want:    false,
+		},
+		{
+			name:    "outsider cannot block",
+			reviews: []*gh.PullRequestReview{review("alice", "OWNER", "APPROVED"), review("mallory", "NONE", "CHANGES_REQUESTED")},
+			want:    true,
+		},
+		{
+			name:    "latest verdict wins",
+			reviews: []*gh.PullRequestReview{review("bob", "MEMBER", "CHANGES_REQUESTED"), review("bob", "MEMBER", "COMMENTED"), review("bob", "MEMBER", "APPROVED")},
+			want:    true,
+		},
+	}
+	for _, testCase := range testCases {
+		if got := isApprovedByTrustedReviewers(testCase.reviews, "codex-bot"); got != testCase.want {
+			t.Fatalf("%s: isApprovedByTrustedReviewers() = %v, want %v", testCase.name, got, testCase.want)
+		}
+	}
+}
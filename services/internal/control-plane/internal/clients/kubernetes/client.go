@@ -1034,25 +1034,9 @@ func (c *Client) ApplyManifest(ctx context.Context, manifest []byte, namespaceOv
 			return nil, fmt.Errorf("manifest object %s/%s has empty metadata.name", gvk.GroupVersion().String(), gvk.Kind)
 		}
 
-		mapping, err := c.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+		resourceInterface, targetNamespace, err := c.resolveManifestResource(obj, overrideNamespace)
 		if err != nil {
-			return nil, fmt.Errorf("resolve rest mapping for %s: %w", gvk.String(), err)
-		}
-
-		resource := c.dynamic.Resource(mapping.Resource)
-		var resourceInterface dynamic.ResourceInterface
-		targetNamespace := strings.TrimSpace(obj.GetNamespace())
-		if mapping.Scope.Name() == metav1api.RESTScopeNameNamespace {
-			if overrideNamespace != "" {
-				targetNamespace = overrideNamespace
-			}
-			if targetNamespace == "" {
-				return nil, fmt.Errorf("manifest object %s/%s is namespaced but namespace is empty", gvk.String(), targetName)
-			}
-			obj.SetNamespace(targetNamespace)
-			resourceInterface = resource.Namespace(targetNamespace)
-		} else {
-			resourceInterface = resource
+			return nil, err
 		}
 
 		payload, err := json.Marshal(obj.Object)
@@ -1078,6 +1062,30 @@ func (c *Client) ApplyManifest(ctx context.Context, manifest []byte, namespaceOv
 	return applied, nil
 }
 
+// resolveManifestResource maps one manifest object to its dynamic resource interface and effective namespace.
+func (c *Client) resolveManifestResource(obj *unstructured.Unstructured, overrideNamespace string) (dynamic.ResourceInterface, string, error) {
+	gvk := obj.GroupVersionKind()
+	targetName := strings.TrimSpace(obj.GetName())
+	mapping, err := c.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, "", fmt.Errorf("resolve rest mapping for %s: %w", gvk.String(), err)
+	}
+
+	resource := c.dynamic.Resource(mapping.Resource)
+	targetNamespace := strings.TrimSpace(obj.GetNamespace())
+	if mapping.Scope.Name() != metav1api.RESTScopeNameNamespace {
+		return resource, targetNamespace, nil
+	}
+	if overrideNamespace != "" {
+		targetNamespace = overrideNamespace
+	}
+	if targetNamespace == "" {
+		return nil, "", fmt.Errorf("manifest object %s/%s is namespaced but namespace is empty", gvk.String(), targetName)
+	}
+	obj.SetNamespace(targetNamespace)
+	return resource.Namespace(targetNamespace), targetNamespace, nil
+}
+
 func isDeploymentReady(deployment *appsv1.Deployment) bool {
 	if deployment == nil {
 		return false
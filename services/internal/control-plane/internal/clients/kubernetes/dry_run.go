@@ -0,0 +1,175 @@
+package kubernetes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	runtimedeploydomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/runtimedeploy"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// DryRunApplyManifest runs server-side apply with dryRun=All for every object of the manifest
+// and returns live state next to the object the API server would persist.
+func (c *Client) DryRunApplyManifest(ctx context.Context, manifest []byte, namespaceOverride string, fieldManager string) ([]runtimedeploydomain.DryRunObject, error) {
+	if len(bytes.TrimSpace(manifest)) == 0 {
+		return nil, nil
+	}
+	if c.dynamic == nil {
+		return nil, fmt.Errorf("dynamic kubernetes client is not configured")
+	}
+	if c.restMapper == nil {
+		return nil, fmt.Errorf("kubernetes rest mapper is not configured")
+	}
+	manager := strings.TrimSpace(fieldManager)
+	if manager == "" {
+		manager = "kodex-control-plane"
+	}
+	overrideNamespace := strings.TrimSpace(namespaceOverride)
+
+	decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	out := make([]runtimedeploydomain.DryRunObject, 0, 8)
+	for {
+		var objectMap map[string]any
+		if err := decoder.Decode(&objectMap); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, fmt.Errorf("decode manifest yaml: %w", err)
+		}
+		if len(objectMap) == 0 {
+			continue
+		}
+
+		obj := &unstructured.Unstructured{Object: objectMap}
+		gvk := obj.GroupVersionKind()
+		if gvk.Empty() {
+			return nil, fmt.Errorf("manifest object has empty apiVersion/kind")
+		}
+		targetName := strings.TrimSpace(obj.GetName())
+		if targetName == "" {
+			return nil, fmt.Errorf("manifest object %s/%s has empty metadata.name", gvk.GroupVersion().String(), gvk.Kind)
+		}
+
+		resourceInterface, targetNamespace, err := c.resolveManifestResource(obj, overrideNamespace)
+		if err != nil {
+			return nil, err
+		}
+		item := runtimedeploydomain.DryRunObject{
+			Ref: runtimedeploydomain.AppliedResourceRef{
+				APIVersion: gvk.GroupVersion().String(),
+				Kind:       gvk.Kind,
+				Namespace:  targetNamespace,
+				Name:       targetName,
+			},
+		}
+
+		live, err := resourceInterface.Get(ctx, targetName, metav1.GetOptions{})
+		switch {
+		case err == nil:
+			item.Live = live.Object
+		case k8serrors.IsNotFound(err):
+		default:
+			return nil, fmt.Errorf("get live object %s/%s: %w", gvk.String(), targetName, err)
+		}
+
+		// Jobs are deleted and recreated by runtime deploy because their pod template is immutable,
+		// so server-side dry-run of an update would be rejected for a reason that never happens on apply.
+		if item.Live != nil && strings.EqualFold(gvk.Kind, k8sKindJob) {
+			item.Desired = obj.Object
+			out = append(out, item)
+			continue
+		}
+
+		payload, err := json.Marshal(obj.Object)
+		if err != nil {
+			return nil, fmt.Errorf("marshal manifest object %s/%s: %w", gvk.String(), targetName, err)
+		}
+		force := true
+		dryRun, err := resourceInterface.Patch(ctx, targetName, types.ApplyPatchType, payload, metav1.PatchOptions{
+			FieldManager: manager,
+			Force:        &force,
+			DryRun:       []string{metav1.DryRunAll},
+		})
+		switch {
+		case err == nil:
+			item.Desired = dryRun.Object
+			item.ServerSide = true
+		case k8serrors.IsNotFound(err) && item.Live == nil:
+			// Target namespace does not exist yet: report rendered object as-is.
+			item.Desired = obj.Object
+		default:
+			return nil, fmt.Errorf("dry-run apply manifest object %s/%s: %w", gvk.String(), targetName, err)
+		}
+		out = append(out, item)
+	}
+	return out, nil
+}
+
+// ListAppliedResources lists namespaced objects of one kind which were applied by the given field manager.
+func (c *Client) ListAppliedResources(ctx context.Context, namespace string, apiVersion string, kind string, fieldManager string) ([]runtimedeploydomain.AppliedResourceRef, error) {
+	targetNamespace := strings.TrimSpace(namespace)
+	if targetNamespace == "" {
+		return nil, fmt.Errorf("kubernetes namespace is required")
+	}
+	if c.dynamic == nil {
+		return nil, fmt.Errorf("dynamic kubernetes client is not configured")
+	}
+	if c.restMapper == nil {
+		return nil, fmt.Errorf("kubernetes rest mapper is not configured")
+	}
+	manager := strings.TrimSpace(fieldManager)
+	if manager == "" {
+		manager = "kodex-control-plane"
+	}
+	gv, err := schema.ParseGroupVersion(strings.TrimSpace(apiVersion))
+	if err != nil {
+		return nil, fmt.Errorf("parse apiVersion %q: %w", apiVersion, err)
+	}
+	gvk := gv.WithKind(strings.TrimSpace(kind))
+	mapping, err := c.restMapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, fmt.Errorf("resolve rest mapping for %s: %w", gvk.String(), err)
+	}
+
+	list, err := c.dynamic.Resource(mapping.Resource).Namespace(targetNamespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("list %s in namespace %s: %w", gvk.String(), targetNamespace, err)
+	}
+
+	out := make([]runtimedeploydomain.AppliedResourceRef, 0, len(list.Items))
+	for _, item := range list.Items {
+		if len(item.GetOwnerReferences()) > 0 || !isAppliedByFieldManager(item.GetManagedFields(), manager) {
+			continue
+		}
+		out = append(out, runtimedeploydomain.AppliedResourceRef{
+			APIVersion: gvk.GroupVersion().String(),
+			Kind:       gvk.Kind,
+			Namespace:  targetNamespace,
+			Name:       item.GetName(),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out, nil
+}
+
+func isAppliedByFieldManager(entries []metav1.ManagedFieldsEntry, manager string) bool {
+	for _, entry := range entries {
+		if entry.Manager == manager && entry.Operation == metav1.ManagedFieldsOperationApply {
+			return true
+		}
+	}
+	return false
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	sharedgithubratelimit "github.com/codex-k8s/kodex/libs/go/domain/githubratelimit"
+	"github.com/codex-k8s/kodex/libs/go/mcp/approvalwait"
 	"github.com/codex-k8s/kodex/libs/go/mcp/userinteraction"
 )
 
@@ -27,6 +28,15 @@ func extractGitHubRateLimitResumePayload(runPayload json.RawMessage) (json.RawMe
 	)
 }
 
+func extractApprovalResumePayload(runPayload json.RawMessage) (json.RawMessage, bool, error) {
+	return extractRunPayloadJSONField(
+		runPayload,
+		approvalwait.ResumePayloadRunPayloadFieldName,
+		approvalwait.ResumePayloadMaxBytes,
+		"approval resume payload",
+	)
+}
+
 func extractRunPayloadJSONField(runPayload json.RawMessage, fieldName string, maxBytes int, payloadLabel string) (json.RawMessage, bool, error) {
 	if len(runPayload) == 0 {
 		return nil, false, nil
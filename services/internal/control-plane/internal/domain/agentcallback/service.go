@@ -143,6 +143,11 @@ func (s *Service) GetRunGitHubRateLimitResumePayload(ctx context.Context, runID
 	return s.getRunPayloadField(ctx, runID, "github rate-limit resume payload", extractGitHubRateLimitResumePayload)
 }
 
+// GetRunApprovalResumePayload returns deterministic approval resume payload for one authenticated run.
+func (s *Service) GetRunApprovalResumePayload(ctx context.Context, runID string) (json.RawMessage, bool, error) {
+	return s.getRunPayloadField(ctx, runID, "approval resume payload", extractApprovalResumePayload)
+}
+
 func (s *Service) getRunPayloadField(
 	ctx context.Context,
 	runID string,
@@ -3,6 +3,7 @@ package changegovernance
 import (
 	"context"
 
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
 	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
 	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
 )
@@ -13,9 +14,17 @@ type WaveMapResult = valuetypes.ChangeGovernanceWaveMapResult
 
 type EvidenceSignalResult = valuetypes.ChangeGovernanceEvidenceSignalResult
 
+type Aggregate = valuetypes.ChangeGovernanceAggregate
+
+type Package = entitytypes.ChangeGovernancePackage
+
 // DomainService exposes canonical quality-governance foundation operations owned by control-plane.
 type DomainService interface {
 	ReportDraftSignal(ctx context.Context, params querytypes.ChangeGovernanceDraftSignalParams) (DraftSignalResult, error)
 	PublishWaveMap(ctx context.Context, params querytypes.ChangeGovernanceWaveMapParams) (WaveMapResult, error)
 	UpsertEvidenceSignal(ctx context.Context, params querytypes.ChangeGovernanceEvidenceSignalParams) (EvidenceSignalResult, error)
+	GetPackage(ctx context.Context, packageID string) (Aggregate, error)
+	SubmitWaiverDecision(ctx context.Context, params querytypes.ChangeGovernanceWaiverDecisionParams) (Aggregate, error)
+	SubmitReleaseReadinessDecision(ctx context.Context, params querytypes.ChangeGovernanceReleaseReadinessDecisionParams) (Aggregate, error)
+	ReportFeedback(ctx context.Context, params querytypes.ChangeGovernanceFeedbackParams) (Aggregate, error)
 }
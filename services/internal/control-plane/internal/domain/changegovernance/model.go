@@ -1,32 +1,55 @@
 package changegovernance
 
 import (
+	"context"
 	"fmt"
+	"log/slog"
+	"strings"
 
+	"github.com/codex-k8s/kodex/libs/go/crypto/tokencrypt"
 	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/changegovernance"
+	platformtokenrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/platformtoken"
 	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
 )
 
+const defaultReleaseGateStatusContext = "kodex/change-governance"
+
 // Config controls rollout gates for change-governance foundation.
 type Config struct {
 	RolloutState valuetypes.ChangeGovernanceRolloutState
+	// ReleaseGateStatusContext is the GitHub commit status context used for the release gate.
+	ReleaseGateStatusContext string
 }
 
 // Dependencies contains persistence collaborators required by the service.
 type Dependencies struct {
 	Repository   domainrepo.Repository
 	RolloutState rolloutStateProvider
+	// Platform, TokenCrypt and GitHub are optional; without them release gate publication is skipped.
+	Platform   platformtokenrepo.Repository
+	TokenCrypt *tokencrypt.Service
+	GitHub     releaseGateGitHubClient
+	Logger     *slog.Logger
 }
 
 type rolloutStateProvider interface {
 	CurrentChangeGovernanceRolloutState() valuetypes.ChangeGovernanceRolloutState
 }
 
+type releaseGateGitHubClient interface {
+	GetPullRequestHeadSHA(ctx context.Context, token string, owner string, repo string, prNumber int) (string, error)
+	CreateCommitStatus(ctx context.Context, token string, owner string, repo string, params valuetypes.GitHubCommitStatusParams) error
+}
+
 // Service owns canonical change-governance semantics in control-plane.
 type Service struct {
-	cfg     Config
-	repo    domainrepo.Repository
-	rollout rolloutStateProvider
+	cfg        Config
+	repo       domainrepo.Repository
+	rollout    rolloutStateProvider
+	platform   platformtokenrepo.Repository
+	tokenCrypt *tokencrypt.Service
+	github     releaseGateGitHubClient
+	logger     *slog.Logger
 }
 
 // NewService constructs change-governance foundation service.
@@ -34,9 +57,21 @@ func NewService(cfg Config, deps Dependencies) (*Service, error) {
 	if deps.Repository == nil {
 		return nil, fmt.Errorf("change governance repository is required")
 	}
+	cfg.ReleaseGateStatusContext = strings.TrimSpace(cfg.ReleaseGateStatusContext)
+	if cfg.ReleaseGateStatusContext == "" {
+		cfg.ReleaseGateStatusContext = defaultReleaseGateStatusContext
+	}
+	logger := deps.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
 	return &Service{
-		cfg:     cfg,
-		repo:    deps.Repository,
-		rollout: deps.RolloutState,
+		cfg:        cfg,
+		repo:       deps.Repository,
+		rollout:    deps.RolloutState,
+		platform:   deps.Platform,
+		tokenCrypt: deps.TokenCrypt,
+		github:     deps.GitHub,
+		logger:     logger,
 	}, nil
 }
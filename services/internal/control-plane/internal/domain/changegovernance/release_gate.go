@@ -0,0 +1,127 @@
+package changegovernance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
+)
+
+const (
+	commitStatusSuccess = "success"
+	commitStatusPending = "pending"
+	commitStatusFailure = "failure"
+)
+
+// PublishReleaseGate mirrors package release readiness into a GitHub commit status on the PR head.
+// Branch protection can require this status context so that merges stay blocked until governance is ready.
+// Statuses are bound to a commit, so new pushes are covered by RepublishPullRequestReleaseGate.
+func (s *Service) PublishReleaseGate(ctx context.Context, pkg Package) error {
+	if !s.releaseGateConfigured() {
+		return nil
+	}
+	if pkg.PRNumber == nil || *pkg.PRNumber <= 0 {
+		return nil
+	}
+	owner, repo, ok := strings.Cut(strings.TrimSpace(pkg.RepositoryFullName), "/")
+	if !ok || owner == "" || repo == "" {
+		return fmt.Errorf("change governance package %q has invalid repository %q", pkg.ID, pkg.RepositoryFullName)
+	}
+
+	token, err := s.loadBotToken(ctx)
+	if err != nil {
+		return err
+	}
+	headSHA, err := s.github.GetPullRequestHeadSHA(ctx, token, owner, repo, *pkg.PRNumber)
+	if err != nil {
+		return err
+	}
+	return s.createReleaseGateStatus(ctx, token, owner, repo, headSHA, pkg)
+}
+
+// RepublishPullRequestReleaseGate publishes the current gate of the PR package on a new head commit.
+// It is called on pull_request opened/synchronize/reopened, otherwise a push would leave the new head without the gate status.
+func (s *Service) RepublishPullRequestReleaseGate(ctx context.Context, repositoryFullName string, prNumber int, headSHA string) error {
+	if !s.releaseGateConfigured() || s.repo == nil {
+		return nil
+	}
+	repositoryFullName = strings.TrimSpace(repositoryFullName)
+	headSHA = strings.TrimSpace(headSHA)
+	if prNumber <= 0 || headSHA == "" {
+		return nil
+	}
+	caps, err := ResolveRolloutCapabilities(s.effectiveRolloutState())
+	if err != nil || !caps.CanPersistFoundation {
+		return err
+	}
+	owner, repo, ok := strings.Cut(repositoryFullName, "/")
+	if !ok || owner == "" || repo == "" {
+		return fmt.Errorf("invalid repository %q", repositoryFullName)
+	}
+
+	pkg, found, err := s.repo.GetLatestPackageByPullRequest(ctx, repositoryFullName, prNumber)
+	if err != nil || !found {
+		return err
+	}
+	token, err := s.loadBotToken(ctx)
+	if err != nil {
+		return err
+	}
+	return s.createReleaseGateStatus(ctx, token, owner, repo, headSHA, pkg)
+}
+
+func (s *Service) releaseGateConfigured() bool {
+	return s != nil && s.github != nil && s.platform != nil && s.tokenCrypt != nil
+}
+
+func (s *Service) createReleaseGateStatus(ctx context.Context, token string, owner string, repo string, headSHA string, pkg Package) error {
+	state, description := releaseGateCommitStatus(pkg)
+	return s.github.CreateCommitStatus(ctx, token, owner, repo, valuetypes.GitHubCommitStatusParams{
+		SHA:         headSHA,
+		State:       state,
+		Context:     s.cfg.ReleaseGateStatusContext,
+		Description: description,
+	})
+}
+
+func (s *Service) publishReleaseGateBestEffort(ctx context.Context, pkg Package) {
+	if err := s.PublishReleaseGate(ctx, pkg); err != nil {
+		s.logger.Warn("publish change-governance release gate failed", "package_id", pkg.ID, "err", err)
+	}
+}
+
+func (s *Service) loadBotToken(ctx context.Context) (string, error) {
+	item, found, err := s.platform.Get(ctx)
+	if err != nil {
+		return "", fmt.Errorf("load platform github tokens: %w", err)
+	}
+	if !found || len(item.BotTokenEncrypted) == 0 {
+		return "", fmt.Errorf("platform github bot token is not configured")
+	}
+	token, err := s.tokenCrypt.DecryptString(item.BotTokenEncrypted)
+	if err != nil {
+		return "", fmt.Errorf("decrypt platform github bot token: %w", err)
+	}
+	if strings.TrimSpace(token) == "" {
+		return "", fmt.Errorf("platform github bot token is not configured")
+	}
+	return token, nil
+}
+
+// releaseGateCommitStatus maps release readiness to a commit status; everything except ready/released keeps merges blocked.
+func releaseGateCommitStatus(pkg Package) (string, string) {
+	switch pkg.ReleaseReadinessState {
+	case enumtypes.ChangeGovernanceReleaseReadinessStateReady:
+		return commitStatusSuccess, "Change governance: ready for release"
+	case enumtypes.ChangeGovernanceReleaseReadinessStateReleased:
+		return commitStatusSuccess, "Change governance: released"
+	case enumtypes.ChangeGovernanceReleaseReadinessStateBlocked:
+		return commitStatusFailure, "Change governance: release blocked"
+	case enumtypes.ChangeGovernanceReleaseReadinessStateConditionallyReady:
+		return commitStatusPending, "Change governance: conditionally ready, awaiting final decision"
+	default:
+		return commitStatusPending, fmt.Sprintf("Change governance: not ready (evidence %s, verification %s)", pkg.EvidenceCompletenessState, pkg.VerificationMinimumState)
+	}
+}
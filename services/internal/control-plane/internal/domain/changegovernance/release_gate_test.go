@@ -0,0 +1,116 @@
+package changegovernance
+
+import (
+	"context"
+	"testing"
+
+	"github.com/codex-k8s/kodex/libs/go/crypto/tokencrypt"
+	platformtokenrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/platformtoken"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
+)
+
+type stubPlatformTokens struct {
+	platformtokenrepo.Repository
+	item platformtokenrepo.PlatformGitHubTokens
+}
+
+func (r *stubPlatformTokens) Get(context.Context) (platformtokenrepo.PlatformGitHubTokens, bool, error) {
+	return r.item, len(r.item.BotTokenEncrypted) > 0, nil
+}
+
+type stubReleaseGateGitHub struct {
+	headLookups int
+	statuses    []valuetypes.GitHubCommitStatusParams
+	tokens      []string
+}
+
+func (c *stubReleaseGateGitHub) GetPullRequestHeadSHA(context.Context, string, string, string, int) (string, error) {
+	c.headLookups++
+	return "stale-sha", nil
+}
+
+func (c *stubReleaseGateGitHub) CreateCommitStatus(_ context.Context, token string, _ string, _ string, params valuetypes.GitHubCommitStatusParams) error {
+	c.tokens = append(c.tokens, token)
+	c.statuses = append(c.statuses, params)
+	return nil
+}
+
+func newReleaseGateTestService(t *testing.T, repo *stubRepository, github *stubReleaseGateGitHub, rollout valuetypes.ChangeGovernanceRolloutState) *Service {
+	t.Helper()
+	tokenCrypt, err := tokencrypt.NewService("00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff")
+	if err != nil {
+		t.Fatalf("tokencrypt.NewService: %v", err)
+	}
+	botToken, err := tokenCrypt.EncryptString("bot-token")
+	if err != nil {
+		t.Fatalf("EncryptString: %v", err)
+	}
+	svc, err := NewService(Config{RolloutState: rollout}, Dependencies{
+		Repository: repo,
+		Platform:   &stubPlatformTokens{item: platformtokenrepo.PlatformGitHubTokens{BotTokenEncrypted: botToken}},
+		TokenCrypt: tokenCrypt,
+		GitHub:     github,
+	})
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+	return svc
+}
+
+func TestRepublishPullRequestReleaseGate_PublishesOnNewHead(t *testing.T) {
+	t.Parallel()
+
+	prNumber := 42
+	repo := &stubRepository{aggregate: Aggregate{Package: Package{
+		ID:                    "pkg-1",
+		RepositoryFullName:    "codex-k8s/kodex",
+		PRNumber:              &prNumber,
+		ReleaseReadinessState: enumtypes.ChangeGovernanceReleaseReadinessStateReady,
+	}}}
+	github := &stubReleaseGateGitHub{}
+	svc := newReleaseGateTestService(t, repo, github, valuetypes.ChangeGovernanceRolloutState{
+		CoreFeatureEnabled: true,
+		SchemaReady:        true,
+		DomainReady:        true,
+	})
+
+	if err := svc.RepublishPullRequestReleaseGate(context.Background(), "codex-k8s/kodex", prNumber, "new-head-sha"); err != nil {
+		t.Fatalf("RepublishPullRequestReleaseGate() error = %v", err)
+	}
+	if len(github.statuses) != 1 {
+		t.Fatalf("statuses = %+v, want one", github.statuses)
+	}
+	got := github.statuses[0]
+	if got.SHA != "new-head-sha" || got.State != commitStatusSuccess || got.Context != defaultReleaseGateStatusContext {
+		t.Fatalf("status = %+v, want success on new head", got)
+	}
+	if github.headLookups != 0 {
+		t.Fatalf("head lookups = %d, want webhook head sha to be used", github.headLookups)
+	}
+	if github.tokens[0] != "bot-token" {
+		t.Fatalf("token = %q, want bot token", github.tokens[0])
+	}
+
+	if err := svc.RepublishPullRequestReleaseGate(context.Background(), "codex-k8s/kodex", 7, "other-sha"); err != nil {
+		t.Fatalf("RepublishPullRequestReleaseGate() for unknown PR error = %v", err)
+	}
+	if len(github.statuses) != 1 {
+		t.Fatalf("statuses = %+v, want no status for PR without package", github.statuses)
+	}
+}
+
+func TestRepublishPullRequestReleaseGate_SkipsWithoutFoundationRollout(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubRepository{}
+	github := &stubReleaseGateGitHub{}
+	svc := newReleaseGateTestService(t, repo, github, valuetypes.ChangeGovernanceRolloutState{})
+
+	if err := svc.RepublishPullRequestReleaseGate(context.Background(), "codex-k8s/kodex", 42, "new-head-sha"); err != nil {
+		t.Fatalf("RepublishPullRequestReleaseGate() error = %v", err)
+	}
+	if len(repo.prLookups) != 0 || len(github.statuses) != 0 {
+		t.Fatalf("lookups = %v statuses = %+v, want none before rollout", repo.prLookups, github.statuses)
+	}
+}
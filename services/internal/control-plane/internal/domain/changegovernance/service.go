@@ -28,6 +28,8 @@ func (s *Service) ReportDraftSignal(ctx context.Context, params querytypes.Chang
 		return DraftSignalResult{}, err
 	}
 
+	s.publishReleaseGateBestEffort(ctx, aggregate.Package)
+
 	nextStepKind := enumtypes.ChangeGovernanceNextStepKindWaveMapRequired
 	if duplicate && len(aggregate.Waves) > 0 {
 		nextStepKind = enumtypes.ChangeGovernanceNextStepKindNoOp
@@ -79,6 +81,7 @@ func (s *Service) UpsertEvidenceSignal(ctx context.Context, params querytypes.Ch
 	if err != nil {
 		return EvidenceSignalResult{}, err
 	}
+	s.publishReleaseGateBestEffort(ctx, aggregate.Package)
 	return EvidenceSignalResult{
 		PackageID:                 aggregate.Package.ID,
 		EvidenceCompletenessState: aggregate.Package.EvidenceCompletenessState,
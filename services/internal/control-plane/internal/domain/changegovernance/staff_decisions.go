@@ -0,0 +1,332 @@
+package changegovernance
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+// GetPackage returns one hydrated package aggregate.
+func (s *Service) GetPackage(ctx context.Context, packageID string) (Aggregate, error) {
+	if s == nil {
+		return Aggregate{}, fmt.Errorf("change governance service is not configured")
+	}
+	packageID = strings.TrimSpace(packageID)
+	if packageID == "" {
+		return Aggregate{}, errs.Validation{Field: "package_id", Msg: "is required"}
+	}
+	aggregate, found, err := s.repo.GetAggregateByPackageID(ctx, packageID)
+	if err != nil {
+		return Aggregate{}, err
+	}
+	if !found {
+		return Aggregate{}, errs.NotFound{Msg: fmt.Sprintf("change governance package %q not found", packageID)}
+	}
+	return aggregate, nil
+}
+
+// SubmitWaiverDecision records one staff approval or rejection of a package waiver.
+func (s *Service) SubmitWaiverDecision(ctx context.Context, params querytypes.ChangeGovernanceWaiverDecisionParams) (Aggregate, error) {
+	if s == nil {
+		return Aggregate{}, fmt.Errorf("change governance service is not configured")
+	}
+	if err := s.assertStaffDecisionsAllowed(); err != nil {
+		return Aggregate{}, err
+	}
+	params.ActorKind = normalizeDecisionActorKind(params.ActorKind)
+	if err := validateWaiverDecisionParams(params, time.Now().UTC()); err != nil {
+		return Aggregate{}, err
+	}
+
+	aggregate, err := s.repo.RecordDecision(ctx, querytypes.ChangeGovernanceDecisionParams{
+		PackageID:                 params.PackageID,
+		ExpectedProjectID:         params.ExpectedProjectID,
+		DecisionID:                strings.TrimSpace(params.DecisionID),
+		CorrelationID:             params.CorrelationID,
+		ExpectedProjectionVersion: params.ExpectedProjectionVersion,
+		ScopeKind:                 enumtypes.ChangeGovernanceDecisionScopeKindPackage,
+		ScopeRef:                  strings.TrimSpace(params.PackageID),
+		DecisionKind:              enumtypes.ChangeGovernanceDecisionKindWaiver,
+		State:                     params.State,
+		ActorKind:                 params.ActorKind,
+		ResidualRiskTier:          params.ResidualRiskTier,
+		SummaryMarkdown:           params.SummaryMarkdown,
+		Payload: querytypes.ChangeGovernanceDecisionPayload{
+			ActorUserID: strings.TrimSpace(params.ActorUserID),
+			ExpiresAt:   params.ExpiresAt,
+		},
+		OccurredAt: params.OccurredAt,
+	})
+	if err != nil {
+		return Aggregate{}, err
+	}
+	s.publishReleaseGateBestEffort(ctx, aggregate.Package)
+	return aggregate, nil
+}
+
+// SubmitReleaseReadinessDecision records one staff release-readiness decision.
+func (s *Service) SubmitReleaseReadinessDecision(ctx context.Context, params querytypes.ChangeGovernanceReleaseReadinessDecisionParams) (Aggregate, error) {
+	if s == nil {
+		return Aggregate{}, fmt.Errorf("change governance service is not configured")
+	}
+	if err := s.assertStaffDecisionsAllowed(); err != nil {
+		return Aggregate{}, err
+	}
+	params.ActorKind = normalizeDecisionActorKind(params.ActorKind)
+	if err := validateReleaseDecisionParams(params); err != nil {
+		return Aggregate{}, err
+	}
+
+	current, err := s.GetPackage(ctx, params.PackageID)
+	if err != nil {
+		return Aggregate{}, err
+	}
+	if err := checkReleaseTransition(current.Package, params.ReleaseReadinessState); err != nil {
+		return Aggregate{}, err
+	}
+
+	aggregate, err := s.repo.RecordDecision(ctx, querytypes.ChangeGovernanceDecisionParams{
+		PackageID:                 params.PackageID,
+		ExpectedProjectID:         params.ExpectedProjectID,
+		DecisionID:                strings.TrimSpace(params.DecisionID),
+		CorrelationID:             params.CorrelationID,
+		ExpectedProjectionVersion: params.ExpectedProjectionVersion,
+		ScopeKind:                 enumtypes.ChangeGovernanceDecisionScopeKindPackage,
+		ScopeRef:                  strings.TrimSpace(params.PackageID),
+		DecisionKind:              enumtypes.ChangeGovernanceDecisionKindReleaseReadiness,
+		State:                     enumtypes.ChangeGovernanceDecisionStateApproved,
+		ActorKind:                 params.ActorKind,
+		SummaryMarkdown:           params.SummaryMarkdown,
+		Payload: querytypes.ChangeGovernanceDecisionPayload{
+			ActorUserID:           strings.TrimSpace(params.ActorUserID),
+			ReleaseReadinessState: params.ReleaseReadinessState,
+		},
+		OccurredAt: params.OccurredAt,
+	})
+	if err != nil {
+		return Aggregate{}, err
+	}
+	s.publishReleaseGateBestEffort(ctx, aggregate.Package)
+	return aggregate, nil
+}
+
+// ReportFeedback records one staff-reported governance gap for a package.
+func (s *Service) ReportFeedback(ctx context.Context, params querytypes.ChangeGovernanceFeedbackParams) (Aggregate, error) {
+	if s == nil {
+		return Aggregate{}, fmt.Errorf("change governance service is not configured")
+	}
+	if err := s.assertStaffDecisionsAllowed(); err != nil {
+		return Aggregate{}, err
+	}
+	if err := validateFeedbackParams(params); err != nil {
+		return Aggregate{}, err
+	}
+
+	aggregate, err := s.repo.RecordFeedback(ctx, params)
+	if err != nil {
+		return Aggregate{}, err
+	}
+	s.publishReleaseGateBestEffort(ctx, aggregate.Package)
+	return aggregate, nil
+}
+
+func (s *Service) assertStaffDecisionsAllowed() error {
+	caps, err := ResolveRolloutCapabilities(s.effectiveRolloutState())
+	if err != nil {
+		return err
+	}
+	if !caps.CanPersistFoundation {
+		return errs.FailedPrecondition{Msg: "change governance decisions require enabled schema and domain path"}
+	}
+	return nil
+}
+
+// checkReleaseTransition keeps release decisions consistent with evidence and waiver state.
+func checkReleaseTransition(pkg Package, target enumtypes.ChangeGovernanceReleaseReadinessState) error {
+	switch target {
+	case enumtypes.ChangeGovernanceReleaseReadinessStateReady:
+		if !releaseEvidenceSatisfied(pkg) {
+			return errs.FailedPrecondition{Msg: "release readiness requires complete evidence and verification or an approved waiver"}
+		}
+	case enumtypes.ChangeGovernanceReleaseReadinessStateReleased:
+		if pkg.ReleaseReadinessState != enumtypes.ChangeGovernanceReleaseReadinessStateReady &&
+			pkg.ReleaseReadinessState != enumtypes.ChangeGovernanceReleaseReadinessStateConditionallyReady {
+			return errs.FailedPrecondition{Msg: "package must be ready or conditionally_ready before release"}
+		}
+	}
+	return nil
+}
+
+func releaseEvidenceSatisfied(pkg Package) bool {
+	if pkg.WaiverState == enumtypes.ChangeGovernanceWaiverStateApproved {
+		return true
+	}
+	evidenceOK := pkg.EvidenceCompletenessState == enumtypes.ChangeGovernanceEvidenceCompletenessStateComplete ||
+		pkg.EvidenceCompletenessState == enumtypes.ChangeGovernanceEvidenceCompletenessStateWaived
+	verificationOK := pkg.VerificationMinimumState == enumtypes.ChangeGovernanceVerificationMinimumStateMet ||
+		pkg.VerificationMinimumState == enumtypes.ChangeGovernanceVerificationMinimumStateWaived
+	return evidenceOK && verificationOK
+}
+
+func normalizeDecisionActorKind(value enumtypes.ChangeGovernanceDecisionActorKind) enumtypes.ChangeGovernanceDecisionActorKind {
+	if strings.TrimSpace(string(value)) == "" {
+		return enumtypes.ChangeGovernanceDecisionActorKindReviewer
+	}
+	return value
+}
+
+func validateStaffActorKind(value enumtypes.ChangeGovernanceDecisionActorKind) error {
+	switch value {
+	case enumtypes.ChangeGovernanceDecisionActorKindOwner,
+		enumtypes.ChangeGovernanceDecisionActorKindReviewer,
+		enumtypes.ChangeGovernanceDecisionActorKindOperator:
+		return nil
+	default:
+		return errs.Validation{Field: "actor_kind", Msg: "must be owner, reviewer or operator"}
+	}
+}
+
+func validateWaiverDecisionParams(params querytypes.ChangeGovernanceWaiverDecisionParams, now time.Time) error {
+	if strings.TrimSpace(params.PackageID) == "" {
+		return errs.Validation{Field: "package_id", Msg: "is required"}
+	}
+	if strings.TrimSpace(params.ExpectedProjectID) == "" {
+		return errs.Validation{Field: "expected_project_id", Msg: "is required"}
+	}
+	if strings.TrimSpace(params.DecisionID) == "" {
+		return errs.Validation{Field: "decision_id", Msg: "is required"}
+	}
+	if strings.TrimSpace(params.CorrelationID) == "" {
+		return errs.Validation{Field: "correlation_id", Msg: "is required"}
+	}
+	if params.ExpectedProjectionVersion < 0 {
+		return errs.Validation{Field: "expected_projection_version", Msg: "must be >= 0"}
+	}
+	if err := validateStaffActorKind(params.ActorKind); err != nil {
+		return err
+	}
+	switch params.State {
+	case enumtypes.ChangeGovernanceDecisionStateApproved:
+		switch params.ResidualRiskTier {
+		case enumtypes.ChangeGovernanceRiskTierLow,
+			enumtypes.ChangeGovernanceRiskTierMedium,
+			enumtypes.ChangeGovernanceRiskTierHigh,
+			enumtypes.ChangeGovernanceRiskTierCritical:
+		default:
+			return errs.Validation{Field: "residual_risk_tier", Msg: "is required for approved waiver"}
+		}
+		if params.ExpiresAt != nil && !params.ExpiresAt.After(now) {
+			return errs.Validation{Field: "expires_at", Msg: "must be in the future"}
+		}
+	case enumtypes.ChangeGovernanceDecisionStateRejected:
+		if params.ResidualRiskTier != "" {
+			return errs.Validation{Field: "residual_risk_tier", Msg: "is only allowed for approved waiver"}
+		}
+	default:
+		return errs.Validation{Field: "decision_state", Msg: "must be approved or rejected"}
+	}
+	if strings.TrimSpace(params.SummaryMarkdown) == "" {
+		return errs.Validation{Field: "summary_markdown", Msg: "is required"}
+	}
+	if params.OccurredAt.IsZero() {
+		return errs.Validation{Field: "occurred_at", Msg: "is required"}
+	}
+	return nil
+}
+
+func validateReleaseDecisionParams(params querytypes.ChangeGovernanceReleaseReadinessDecisionParams) error {
+	if strings.TrimSpace(params.PackageID) == "" {
+		return errs.Validation{Field: "package_id", Msg: "is required"}
+	}
+	if strings.TrimSpace(params.ExpectedProjectID) == "" {
+		return errs.Validation{Field: "expected_project_id", Msg: "is required"}
+	}
+	if strings.TrimSpace(params.DecisionID) == "" {
+		return errs.Validation{Field: "decision_id", Msg: "is required"}
+	}
+	if strings.TrimSpace(params.CorrelationID) == "" {
+		return errs.Validation{Field: "correlation_id", Msg: "is required"}
+	}
+	if params.ExpectedProjectionVersion < 0 {
+		return errs.Validation{Field: "expected_projection_version", Msg: "must be >= 0"}
+	}
+	if err := validateStaffActorKind(params.ActorKind); err != nil {
+		return err
+	}
+	switch params.ReleaseReadinessState {
+	case enumtypes.ChangeGovernanceReleaseReadinessStateConditionallyReady,
+		enumtypes.ChangeGovernanceReleaseReadinessStateReady,
+		enumtypes.ChangeGovernanceReleaseReadinessStateBlocked,
+		enumtypes.ChangeGovernanceReleaseReadinessStateReleased:
+	default:
+		return errs.Validation{Field: "decision_state", Msg: "must be ready, blocked, conditionally_ready or released"}
+	}
+	if strings.TrimSpace(params.SummaryMarkdown) == "" {
+		return errs.Validation{Field: "summary_markdown", Msg: "is required"}
+	}
+	if params.OccurredAt.IsZero() {
+		return errs.Validation{Field: "occurred_at", Msg: "is required"}
+	}
+	return nil
+}
+
+func validateFeedbackParams(params querytypes.ChangeGovernanceFeedbackParams) error {
+	if strings.TrimSpace(params.PackageID) == "" {
+		return errs.Validation{Field: "package_id", Msg: "is required"}
+	}
+	if strings.TrimSpace(params.ExpectedProjectID) == "" {
+		return errs.Validation{Field: "expected_project_id", Msg: "is required"}
+	}
+	if strings.TrimSpace(params.FeedbackID) == "" {
+		return errs.Validation{Field: "feedback_id", Msg: "is required"}
+	}
+	if strings.TrimSpace(params.CorrelationID) == "" {
+		return errs.Validation{Field: "correlation_id", Msg: "is required"}
+	}
+	switch params.GapKind {
+	case enumtypes.ChangeGovernanceFeedbackGapKindUnderClassified,
+		enumtypes.ChangeGovernanceFeedbackGapKindMissingEvidence,
+		enumtypes.ChangeGovernanceFeedbackGapKindVerificationBypass,
+		enumtypes.ChangeGovernanceFeedbackGapKindSilentWaiverAttempt,
+		enumtypes.ChangeGovernanceFeedbackGapKindSemanticMix,
+		enumtypes.ChangeGovernanceFeedbackGapKindLateReclassification:
+	default:
+		return errs.Validation{Field: "gap_kind", Msg: "is invalid"}
+	}
+	switch params.SourceKind {
+	case enumtypes.ChangeGovernanceFeedbackSourceKindReview,
+		enumtypes.ChangeGovernanceFeedbackSourceKindRelease,
+		enumtypes.ChangeGovernanceFeedbackSourceKindPostdeploy,
+		enumtypes.ChangeGovernanceFeedbackSourceKindRemediation:
+	default:
+		return errs.Validation{Field: "source_kind", Msg: "must be review, release, postdeploy or remediation"}
+	}
+	switch params.Severity {
+	case enumtypes.ChangeGovernanceFeedbackSeverityMedium,
+		enumtypes.ChangeGovernanceFeedbackSeverityHigh,
+		enumtypes.ChangeGovernanceFeedbackSeverityCritical:
+	default:
+		return errs.Validation{Field: "severity", Msg: "is invalid"}
+	}
+	switch params.SuggestedAction {
+	case enumtypes.ChangeGovernanceFeedbackSuggestedActionReclassify,
+		enumtypes.ChangeGovernanceFeedbackSuggestedActionRequestEvidence,
+		enumtypes.ChangeGovernanceFeedbackSuggestedActionRecordWaiver,
+		enumtypes.ChangeGovernanceFeedbackSuggestedActionBlockRelease,
+		enumtypes.ChangeGovernanceFeedbackSuggestedActionCloseGap:
+	default:
+		return errs.Validation{Field: "suggested_action", Msg: "is invalid"}
+	}
+	if strings.TrimSpace(params.SummaryMarkdown) == "" {
+		return errs.Validation{Field: "summary_markdown", Msg: "is required"}
+	}
+	if params.OccurredAt.IsZero() {
+		return errs.Validation{Field: "occurred_at", Msg: "is required"}
+	}
+	return nil
+}
@@ -0,0 +1,193 @@
+package changegovernance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
+)
+
+type stubRepository struct {
+	aggregate Aggregate
+	decisions []querytypes.ChangeGovernanceDecisionParams
+	prLookups []string
+}
+
+func (r *stubRepository) RecordDraftSignal(context.Context, querytypes.ChangeGovernanceDraftSignalParams) (Aggregate, bool, error) {
+	return r.aggregate, false, nil
+}
+
+func (r *stubRepository) PublishWaveMap(context.Context, querytypes.ChangeGovernanceWaveMapParams) (Aggregate, error) {
+	return r.aggregate, nil
+}
+
+func (r *stubRepository) UpsertEvidenceSignal(context.Context, querytypes.ChangeGovernanceEvidenceSignalParams) (Aggregate, error) {
+	return r.aggregate, nil
+}
+
+func (r *stubRepository) RecordDecision(_ context.Context, params querytypes.ChangeGovernanceDecisionParams) (Aggregate, error) {
+	r.decisions = append(r.decisions, params)
+	return r.aggregate, nil
+}
+
+func (r *stubRepository) RecordFeedback(context.Context, querytypes.ChangeGovernanceFeedbackParams) (Aggregate, error) {
+	return r.aggregate, nil
+}
+
+func (r *stubRepository) GetAggregateByPackageID(context.Context, string) (Aggregate, bool, error) {
+	return r.aggregate, r.aggregate.Package.ID != "", nil
+}
+
+func (r *stubRepository) GetLatestPackageByPullRequest(_ context.Context, repositoryFullName string, prNumber int) (Package, bool, error) {
+	r.prLookups = append(r.prLookups, fmt.Sprintf("%s#%d", repositoryFullName, prNumber))
+	pkg := r.aggregate.Package
+	return pkg, pkg.ID != "" && pkg.PRNumber != nil && *pkg.PRNumber == prNumber, nil
+}
+
+func newEnabledTestService(t *testing.T, repo *stubRepository) *Service {
+	t.Helper()
+	svc, err := NewService(Config{RolloutState: valuetypes.ChangeGovernanceRolloutState{
+		CoreFeatureEnabled: true,
+		SchemaReady:        true,
+		DomainReady:        true,
+	}}, Dependencies{Repository: repo})
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+	return svc
+}
+
+func TestSubmitWaiverDecision_RequiresResidualRiskTierForApproval(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubRepository{aggregate: Aggregate{Package: Package{ID: "pkg-1"}}}
+	svc := newEnabledTestService(t, repo)
+
+	_, err := svc.SubmitWaiverDecision(context.Background(), querytypes.ChangeGovernanceWaiverDecisionParams{
+		PackageID:         "pkg-1",
+		ExpectedProjectID: "project-1",
+		DecisionID:        "decision-1",
+		CorrelationID:     "corr-1",
+		State:             enumtypes.ChangeGovernanceDecisionStateApproved,
+		SummaryMarkdown:   "accepted",
+		OccurredAt:        time.Now().UTC(),
+	})
+	var validationErr errs.Validation
+	if !errors.As(err, &validationErr) || validationErr.Field != "residual_risk_tier" {
+		t.Fatalf("SubmitWaiverDecision() error = %v, want residual_risk_tier validation", err)
+	}
+	if len(repo.decisions) != 0 {
+		t.Fatalf("decisions recorded = %d, want 0", len(repo.decisions))
+	}
+}
+
+func TestSubmitWaiverDecision_RecordsPackageScopedDecision(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubRepository{aggregate: Aggregate{Package: Package{ID: "pkg-1"}}}
+	svc := newEnabledTestService(t, repo)
+
+	_, err := svc.SubmitWaiverDecision(context.Background(), querytypes.ChangeGovernanceWaiverDecisionParams{
+		PackageID:         "pkg-1",
+		ExpectedProjectID: "project-1",
+		DecisionID:        "decision-1",
+		CorrelationID:     "corr-1",
+		ActorUserID:       "user-1",
+		State:             enumtypes.ChangeGovernanceDecisionStateApproved,
+		ResidualRiskTier:  enumtypes.ChangeGovernanceRiskTierMedium,
+		SummaryMarkdown:   "accepted",
+		OccurredAt:        time.Now().UTC(),
+	})
+	if err != nil {
+		t.Fatalf("SubmitWaiverDecision() error = %v", err)
+	}
+	if len(repo.decisions) != 1 {
+		t.Fatalf("decisions recorded = %d, want 1", len(repo.decisions))
+	}
+	got := repo.decisions[0]
+	if got.DecisionKind != enumtypes.ChangeGovernanceDecisionKindWaiver || got.ScopeRef != "pkg-1" {
+		t.Fatalf("decision = %+v, want package-scoped waiver", got)
+	}
+	if got.ActorKind != enumtypes.ChangeGovernanceDecisionActorKindReviewer {
+		t.Fatalf("actor kind = %q, want reviewer default", got.ActorKind)
+	}
+	if got.DecisionID != "decision-1" || got.Payload.ActorUserID != "user-1" {
+		t.Fatalf("decision id/payload = %q/%+v, want request id and actor", got.DecisionID, got.Payload)
+	}
+}
+
+func TestSubmitReleaseReadinessDecision_ReadyRequiresEvidenceOrWaiver(t *testing.T) {
+	t.Parallel()
+
+	repo := &stubRepository{aggregate: Aggregate{Package: Package{
+		ID:                        "pkg-1",
+		EvidenceCompletenessState: enumtypes.ChangeGovernanceEvidenceCompletenessStatePartial,
+		VerificationMinimumState:  enumtypes.ChangeGovernanceVerificationMinimumStateMet,
+		WaiverState:               enumtypes.ChangeGovernanceWaiverStateNone,
+	}}}
+	svc := newEnabledTestService(t, repo)
+	params := querytypes.ChangeGovernanceReleaseReadinessDecisionParams{
+		PackageID:             "pkg-1",
+		ExpectedProjectID:     "project-1",
+		DecisionID:            "decision-2",
+		CorrelationID:         "corr-1",
+		ReleaseReadinessState: enumtypes.ChangeGovernanceReleaseReadinessStateReady,
+		SummaryMarkdown:       "ship it",
+		OccurredAt:            time.Now().UTC(),
+	}
+
+	_, err := svc.SubmitReleaseReadinessDecision(context.Background(), params)
+	var preconditionErr errs.FailedPrecondition
+	if !errors.As(err, &preconditionErr) {
+		t.Fatalf("SubmitReleaseReadinessDecision() error = %v, want failed precondition", err)
+	}
+
+	repo.aggregate.Package.WaiverState = enumtypes.ChangeGovernanceWaiverStateApproved
+	if _, err := svc.SubmitReleaseReadinessDecision(context.Background(), params); err != nil {
+		t.Fatalf("SubmitReleaseReadinessDecision() with approved waiver error = %v", err)
+	}
+	if len(repo.decisions) != 1 || repo.decisions[0].Payload.ReleaseReadinessState != enumtypes.ChangeGovernanceReleaseReadinessStateReady {
+		t.Fatalf("decisions = %+v, want one ready decision", repo.decisions)
+	}
+}
+
+func TestStaffDecisions_RequireFoundationRollout(t *testing.T) {
+	t.Parallel()
+
+	svc, err := NewService(Config{}, Dependencies{Repository: &stubRepository{}})
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+	_, err = svc.ReportFeedback(context.Background(), querytypes.ChangeGovernanceFeedbackParams{})
+	var preconditionErr errs.FailedPrecondition
+	if !errors.As(err, &preconditionErr) {
+		t.Fatalf("ReportFeedback() error = %v, want failed precondition", err)
+	}
+}
+
+func TestReleaseGateCommitStatus(t *testing.T) {
+	t.Parallel()
+
+	cases := map[enumtypes.ChangeGovernanceReleaseReadinessState]string{
+		enumtypes.ChangeGovernanceReleaseReadinessStateNotReady:           commitStatusPending,
+		enumtypes.ChangeGovernanceReleaseReadinessStateConditionallyReady: commitStatusPending,
+		enumtypes.ChangeGovernanceReleaseReadinessStateReady:              commitStatusSuccess,
+		enumtypes.ChangeGovernanceReleaseReadinessStateReleased:           commitStatusSuccess,
+		enumtypes.ChangeGovernanceReleaseReadinessStateBlocked:            commitStatusFailure,
+	}
+	for readiness, want := range cases {
+		got, description := releaseGateCommitStatus(Package{ReleaseReadinessState: readiness})
+		if got != want {
+			t.Fatalf("releaseGateCommitStatus(%q) = %q, want %q", readiness, got, want)
+		}
+		if description == "" {
+			t.Fatalf("releaseGateCommitStatus(%q) returned empty description", readiness)
+		}
+	}
+}
@@ -258,6 +258,7 @@ func (s *Service) scheduleAgentSessionResume(ctx context.Context, wait Wait, now
 		AgentID:       agentID,
 		RunPayload:    pendingRunPayload,
 		LearningMode:  runMeta.LearningMode,
+		Priority:      run.Priority,
 	})
 	if err != nil {
 		return nil, "", fmt.Errorf("create pending github rate-limit resume run: %w", err)
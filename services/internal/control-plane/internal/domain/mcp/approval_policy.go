@@ -0,0 +1,265 @@
+package mcp
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	mcpactionrequestrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/mcpactionrequest"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+)
+
+const approvalPolicyExpiredReason = "approval policy expired"
+
+// resolveControlApproval combines runtime approval mode with project approval policy.
+// A matched policy always requires approval, even when runtime mode would apply the action directly.
+func (s *Service) resolveControlApproval(
+	ctx context.Context,
+	runCtx resolvedRunContext,
+	tool ToolName,
+	environment string,
+) (entitytypes.MCPApprovalMode, *entitytypes.MCPApprovalPolicySnapshot, error) {
+	mode := normalizeApprovalMode(resolveControlApprovalMode(tool, runCtx))
+
+	projectID := strings.TrimSpace(runCtx.Session.ProjectID)
+	if projectID == "" {
+		projectID = strings.TrimSpace(runCtx.Repository.ProjectID)
+	}
+	if projectID == "" {
+		return mode, nil, nil
+	}
+	policy, ok, err := s.approvalPolicies.Match(ctx, projectID, string(tool), strings.ToLower(strings.TrimSpace(environment)))
+	if err != nil {
+		return "", nil, fmt.Errorf("match approval policy: %w", err)
+	}
+	if !ok {
+		return mode, nil, nil
+	}
+	if mode == entitytypes.MCPApprovalModeNone {
+		mode = entitytypes.MCPApprovalModeDelegated
+	}
+	initiator := strings.TrimSpace(runCtx.Payload.Sender.Login)
+	initiatorUserID := ""
+	if policy.SeparationOfDuties {
+		initiatorUserID, err = s.resolveApprovalInitiatorUserID(ctx, initiator)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+	return mode, &entitytypes.MCPApprovalPolicySnapshot{
+		PolicyID:            policy.ID,
+		Environment:         policy.Environment,
+		RequiredApprovals:   policy.RequiredApprovals,
+		EligibleRoles:       policy.EligibleRoles,
+		EligibleUserIDs:     policy.EligibleUserIDs,
+		SeparationOfDuties:  policy.SeparationOfDuties,
+		ExpiresAfterSeconds: policy.ExpiresAfterSeconds,
+		Initiator:           initiator,
+		InitiatorUserID:     initiatorUserID,
+	}, nil
+}
+
+// resolveApprovalInitiatorUserID maps the run sender GitHub login to a platform user id.
+// An empty result means the initiator is unknown to the platform.
+func (s *Service) resolveApprovalInitiatorUserID(ctx context.Context, githubLogin string) (string, error) {
+	githubLogin = strings.TrimSpace(githubLogin)
+	if githubLogin == "" || s.users == nil {
+		return "", nil
+	}
+	user, ok, err := s.users.GetByGitHubLogin(ctx, githubLogin)
+	if err != nil {
+		return "", fmt.Errorf("resolve approval initiator: %w", err)
+	}
+	if !ok {
+		return "", nil
+	}
+	return strings.TrimSpace(user.ID), nil
+}
+
+func approvalPolicyExpiresAt(policy *entitytypes.MCPApprovalPolicySnapshot, now time.Time) *time.Time {
+	if policy == nil || policy.ExpiresAfterSeconds <= 0 {
+		return nil
+	}
+	expiresAt := now.UTC().Add(time.Duration(policy.ExpiresAfterSeconds) * time.Second)
+	return &expiresAt
+}
+
+// recordApprovalVote stores one approver vote on a policy-governed request.
+// One denial rejects the request; it is approved once approving votes reach the required count.
+func (s *Service) recordApprovalVote(
+	ctx context.Context,
+	item entitytypes.MCPActionRequest,
+	decision ApprovalDecision,
+	params ResolveApprovalParams,
+	actorID string,
+	reason string,
+) (ResolveApprovalResult, error) {
+	if decision != ApprovalDecisionApproved && decision != ApprovalDecisionDenied {
+		return ResolveApprovalResult{}, errs.Validation{Field: "decision", Msg: "must be approved or denied for policy-governed approvals"}
+	}
+	userID := strings.TrimSpace(params.ActorUserID)
+	if userID == "" {
+		return ResolveApprovalResult{}, errs.Validation{Field: "actor_user_id", Msg: "is required for policy-governed approvals"}
+	}
+	if err := s.checkApprovalEligibility(ctx, item, decision, userID, params.ActorGitHubLogin); err != nil {
+		return ResolveApprovalResult{}, err
+	}
+
+	voted, ok, err := s.actions.AppendVote(ctx, mcpactionrequestrepo.AppendVoteParams{
+		ID: item.ID,
+		Vote: entitytypes.MCPApprovalVote{
+			ActorID:  actorID,
+			UserID:   userID,
+			Decision: string(decisionToApprovalState(decision)),
+			Reason:   reason,
+			VotedAt:  s.now().UTC(),
+		},
+	})
+	if err != nil {
+		return ResolveApprovalResult{}, fmt.Errorf("record approval vote: %w", err)
+	}
+	if !ok {
+		current, found, err := s.actions.GetByID(ctx, item.ID)
+		if err != nil {
+			return ResolveApprovalResult{}, fmt.Errorf("get approval request: %w", err)
+		}
+		if !found {
+			return ResolveApprovalResult{}, fmt.Errorf("approval request not found")
+		}
+		if current.ApprovalState != entitytypes.MCPApprovalStateRequested {
+			return resolveApprovalResultFromRequest(current), nil
+		}
+		return ResolveApprovalResult{}, errs.Conflict{Msg: "approver has already voted on this request"}
+	}
+	s.auditApprovalVoteRecorded(ctx, SessionContext{RunID: voted.RunID, CorrelationID: voted.CorrelationID}, voted, actorID, reason)
+
+	if decision == ApprovalDecisionApproved && voted.ApprovedVotes() < voted.Policy.RequiredApprovals {
+		return resolveApprovalResultFromRequest(voted), nil
+	}
+	return s.transitionPolicyApproval(ctx, voted, decisionToApprovalState(decision), string(decision), actorID, reason)
+}
+
+// checkApprovalEligibility enforces separation of duties and eligible approvers of the request policy.
+func (s *Service) checkApprovalEligibility(
+	ctx context.Context,
+	item entitytypes.MCPActionRequest,
+	decision ApprovalDecision,
+	userID string,
+	githubLogin string,
+) error {
+	policy := item.Policy
+	if decision == ApprovalDecisionApproved && policy.SeparationOfDuties {
+		if err := s.checkSeparationOfDuties(ctx, policy, userID, githubLogin); err != nil {
+			return err
+		}
+	}
+	if len(policy.EligibleRoles) == 0 && len(policy.EligibleUserIDs) == 0 {
+		return nil
+	}
+	if slices.Contains(policy.EligibleUserIDs, userID) {
+		return nil
+	}
+	if len(policy.EligibleRoles) > 0 && item.ProjectID != "" {
+		role, ok, err := s.members.GetRole(ctx, item.ProjectID, userID)
+		if err != nil {
+			return fmt.Errorf("get approver project role: %w", err)
+		}
+		if ok && slices.Contains(policy.EligibleRoles, role) {
+			return nil
+		}
+	}
+	return errs.Forbidden{Msg: "approver is not eligible by approval policy"}
+}
+
+// checkSeparationOfDuties compares the approver with the run initiator by platform user id.
+// It fails closed: when the initiator cannot be resolved to a platform user, nobody may approve.
+func (s *Service) checkSeparationOfDuties(
+	ctx context.Context,
+	policy *entitytypes.MCPApprovalPolicySnapshot,
+	userID string,
+	githubLogin string,
+) error {
+	initiator := strings.TrimSpace(policy.Initiator)
+	if initiator != "" && strings.EqualFold(initiator, strings.TrimSpace(githubLogin)) {
+		return errs.Forbidden{Msg: "run initiator cannot approve this action"}
+	}
+	initiatorUserID := strings.TrimSpace(policy.InitiatorUserID)
+	if initiatorUserID == "" {
+		// Snapshots created before initiator ids were stored carry only the login.
+		resolved, err := s.resolveApprovalInitiatorUserID(ctx, initiator)
+		if err != nil {
+			return err
+		}
+		initiatorUserID = resolved
+	}
+	if initiatorUserID == "" {
+		return errs.Forbidden{Msg: "run initiator is unknown; separation of duties cannot be verified"}
+	}
+	if initiatorUserID == userID {
+		return errs.Forbidden{Msg: "run initiator cannot approve this action"}
+	}
+	return nil
+}
+
+// transitionPolicyApproval moves a requested action to its final state exactly once.
+func (s *Service) transitionPolicyApproval(
+	ctx context.Context,
+	item entitytypes.MCPActionRequest,
+	state entitytypes.MCPApprovalState,
+	decision string,
+	actorID string,
+	reason string,
+) (ResolveApprovalResult, error) {
+	updated, ok, err := s.actions.UpdateState(ctx, mcpactionrequestrepo.UpdateStateParams{
+		ID:            item.ID,
+		ApprovalState: state,
+		AppliedBy:     actorID,
+		Payload: marshalRawJSON(approvalDecisionPayload{
+			Decision:  decision,
+			ActorID:   actorID,
+			Reason:    reason,
+			DecidedAt: nowRFC3339Nano(s.now()),
+		}),
+		FromState: entitytypes.MCPApprovalStateRequested,
+	})
+	if err != nil {
+		return ResolveApprovalResult{}, fmt.Errorf("update approval state: %w", err)
+	}
+	if !ok {
+		// Concurrent vote or expiry has already finalized the request.
+		current, found, err := s.actions.GetByID(ctx, item.ID)
+		if err != nil {
+			return ResolveApprovalResult{}, fmt.Errorf("get approval request: %w", err)
+		}
+		if !found {
+			return ResolveApprovalResult{}, fmt.Errorf("approval request not found")
+		}
+		return resolveApprovalResultFromRequest(current), nil
+	}
+	return s.finishApprovalTransition(ctx, updated, state, actorID, reason)
+}
+
+// ExpireOverdueApprovals auto-rejects policy-governed approvals whose expiry has passed.
+func (s *Service) ExpireOverdueApprovals(ctx context.Context, limit int) (int, error) {
+	items, err := s.actions.ListExpired(ctx, s.now().UTC(), clampLimit(limit, 100, 500))
+	if err != nil {
+		return 0, fmt.Errorf("list expired approvals: %w", err)
+	}
+
+	actorID := string(floweventdomain.ActorIDControlPlaneMCP)
+	expired := 0
+	for _, item := range items {
+		result, err := s.transitionPolicyApproval(ctx, item, entitytypes.MCPApprovalStateExpired, string(ApprovalDecisionExpired), actorID, approvalPolicyExpiredReason)
+		if err != nil {
+			return expired, err
+		}
+		if result.ApprovalState == string(entitytypes.MCPApprovalStateExpired) {
+			expired++
+		}
+	}
+	return expired, nil
+}
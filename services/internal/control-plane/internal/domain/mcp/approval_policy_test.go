@@ -0,0 +1,325 @@
+package mcp
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	mcpactionrequestrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/mcpactionrequest"
+	projectmemberrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectmember"
+	userrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/user"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+)
+
+type approvalPolicyTestActions struct {
+	mcpactionrequestrepo.Repository
+	items map[int64]entitytypes.MCPActionRequest
+}
+
+func (r *approvalPolicyTestActions) GetByID(_ context.Context, id int64) (entitytypes.MCPActionRequest, bool, error) {
+	item, ok := r.items[id]
+	return item, ok, nil
+}
+
+func (r *approvalPolicyTestActions) AppendVote(_ context.Context, params mcpactionrequestrepo.AppendVoteParams) (entitytypes.MCPActionRequest, bool, error) {
+	item, ok := r.items[params.ID]
+	if !ok || item.ApprovalState != entitytypes.MCPApprovalStateRequested {
+		return entitytypes.MCPActionRequest{}, false, nil
+	}
+	for _, vote := range item.Votes {
+		if vote.UserID == params.Vote.UserID {
+			return entitytypes.MCPActionRequest{}, false, nil
+		}
+	}
+	item.Votes = append(item.Votes, params.Vote)
+	r.items[item.ID] = item
+	return item, true, nil
+}
+
+func (r *approvalPolicyTestActions) UpdateState(_ context.Context, params mcpactionrequestrepo.UpdateStateParams) (entitytypes.MCPActionRequest, bool, error) {
+	item, ok := r.items[params.ID]
+	if !ok || (params.FromState != "" && item.ApprovalState != params.FromState) {
+		return entitytypes.MCPActionRequest{}, false, nil
+	}
+	item.ApprovalState = params.ApprovalState
+	item.AppliedBy = params.AppliedBy
+	r.items[item.ID] = item
+	return item, true, nil
+}
+
+func (r *approvalPolicyTestActions) ListExpired(_ context.Context, now time.Time, _ int) ([]entitytypes.MCPActionRequest, error) {
+	var out []entitytypes.MCPActionRequest
+	for _, item := range r.items {
+		if item.ApprovalState == entitytypes.MCPApprovalStateRequested && item.ExpiresAt != nil && !item.ExpiresAt.After(now) {
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+type approvalPolicyTestMembers struct {
+	projectmemberrepo.Repository
+	roles map[string]string
+}
+
+func (r *approvalPolicyTestMembers) GetRole(_ context.Context, _ string, userID string) (string, bool, error) {
+	role, ok := r.roles[userID]
+	return role, ok, nil
+}
+
+type approvalPolicyTestUsers struct {
+	userrepo.Repository
+	byLogin map[string]string
+}
+
+func (r *approvalPolicyTestUsers) GetByGitHubLogin(_ context.Context, githubLogin string) (entitytypes.User, bool, error) {
+	id, ok := r.byLogin[strings.ToLower(githubLogin)]
+	return entitytypes.User{ID: id, GitHubLogin: githubLogin}, ok, nil
+}
+
+func newApprovalPolicyTestService(item entitytypes.MCPActionRequest) (*Service, *approvalPolicyTestActions) {
+	actions := &approvalPolicyTestActions{items: map[int64]entitytypes.MCPActionRequest{item.ID: item}}
+	return &Service{
+		actions: actions,
+		members: &approvalPolicyTestMembers{roles: map[string]string{
+			"u-admin":    "admin",
+			"u-reader":   "read",
+			"u-releaser": "release-manager",
+		}},
+		users: &approvalPolicyTestUsers{byLogin: map[string]string{"alice": "u-admin", "bob": "u-releaser"}},
+		now:   func() time.Time { return time.Date(2026, time.April, 1, 12, 0, 0, 0, time.UTC) },
+	}, actions
+}
+
+func policyGovernedRequest(policy entitytypes.MCPApprovalPolicySnapshot) entitytypes.MCPActionRequest {
+	return entitytypes.MCPActionRequest{
+		ID:            7,
+		ProjectID:     "p1",
+		ToolName:      string(ToolMCPSecretSyncEnv),
+		ApprovalMode:  entitytypes.MCPApprovalModeOwner,
+		ApprovalState: entitytypes.MCPApprovalStateRequested,
+		Policy:        &policy,
+	}
+}
+
+func TestResolveApprovalPolicyVotes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("approval waits for required votes", func(t *testing.T) {
+		t.Parallel()
+
+		svc, actions := newApprovalPolicyTestService(policyGovernedRequest(entitytypes.MCPApprovalPolicySnapshot{RequiredApprovals: 2}))
+		got, err := svc.ResolveApproval(context.Background(), ResolveApprovalParams{
+			RequestID:   7,
+			Decision:    ApprovalDecisionApproved,
+			ActorID:     "staff:alice",
+			ActorUserID: "u-admin",
+		})
+		if err != nil {
+			t.Fatalf("ResolveApproval returned error: %v", err)
+		}
+		if got.ApprovalState != string(entitytypes.MCPApprovalStateRequested) || got.ApprovalsCount != 1 || got.RequiredApprovals != 2 {
+			t.Fatalf("result = %+v, want requested with 1/2 approvals", got)
+		}
+		if votes := actions.items[7].Votes; len(votes) != 1 || votes[0].ActorID != "staff:alice" {
+			t.Fatalf("votes = %+v, want one vote by staff:alice", votes)
+		}
+	})
+
+	t.Run("same approver cannot vote twice", func(t *testing.T) {
+		t.Parallel()
+
+		svc, _ := newApprovalPolicyTestService(policyGovernedRequest(entitytypes.MCPApprovalPolicySnapshot{RequiredApprovals: 2}))
+		params := ResolveApprovalParams{RequestID: 7, Decision: ApprovalDecisionApproved, ActorID: "staff:alice", ActorUserID: "u-admin"}
+		if _, err := svc.ResolveApproval(context.Background(), params); err != nil {
+			t.Fatalf("first vote returned error: %v", err)
+		}
+		_, err := svc.ResolveApproval(context.Background(), params)
+		var conflict errs.Conflict
+		if !errors.As(err, &conflict) {
+			t.Fatalf("second vote error = %v, want conflict", err)
+		}
+	})
+
+	t.Run("single denial rejects request", func(t *testing.T) {
+		t.Parallel()
+
+		svc, _ := newApprovalPolicyTestService(policyGovernedRequest(entitytypes.MCPApprovalPolicySnapshot{RequiredApprovals: 3}))
+		got, err := svc.ResolveApproval(context.Background(), ResolveApprovalParams{
+			RequestID:   7,
+			Decision:    ApprovalDecisionDenied,
+			ActorID:     "staff:alice",
+			ActorUserID: "u-admin",
+		})
+		if err != nil {
+			t.Fatalf("ResolveApproval returned error: %v", err)
+		}
+		if got.ApprovalState != string(entitytypes.MCPApprovalStateDenied) {
+			t.Fatalf("approval state = %q, want denied", got.ApprovalState)
+		}
+	})
+
+	t.Run("run initiator cannot approve", func(t *testing.T) {
+		t.Parallel()
+
+		svc, _ := newApprovalPolicyTestService(policyGovernedRequest(entitytypes.MCPApprovalPolicySnapshot{
+			RequiredApprovals:  1,
+			SeparationOfDuties: true,
+			Initiator:          "Alice",
+		}))
+		_, err := svc.ResolveApproval(context.Background(), ResolveApprovalParams{
+			RequestID:        7,
+			Decision:         ApprovalDecisionApproved,
+			ActorID:          "staff:alice",
+			ActorUserID:      "u-admin",
+			ActorGitHubLogin: "alice",
+		})
+		var forbidden errs.Forbidden
+		if !errors.As(err, &forbidden) {
+			t.Fatalf("error = %v, want forbidden", err)
+		}
+	})
+
+	t.Run("separation of duties compares user ids and fails closed", func(t *testing.T) {
+		t.Parallel()
+
+		cases := []struct {
+			name      string
+			policy    entitytypes.MCPApprovalPolicySnapshot
+			userID    string
+			forbidden bool
+		}{
+			{
+				name:      "initiator without github login on approver account",
+				policy:    entitytypes.MCPApprovalPolicySnapshot{Initiator: "alice", InitiatorUserID: "u-admin"},
+				userID:    "u-admin",
+				forbidden: true,
+			},
+			{
+				name:      "legacy snapshot resolves initiator login",
+				policy:    entitytypes.MCPApprovalPolicySnapshot{Initiator: "alice"},
+				userID:    "u-admin",
+				forbidden: true,
+			},
+			{
+				name:      "run without sender login",
+				policy:    entitytypes.MCPApprovalPolicySnapshot{},
+				userID:    "u-releaser",
+				forbidden: true,
+			},
+			{
+				name:      "sender is not a platform user",
+				policy:    entitytypes.MCPApprovalPolicySnapshot{Initiator: "external-contributor"},
+				userID:    "u-releaser",
+				forbidden: true,
+			},
+			{
+				name:   "different approver",
+				policy: entitytypes.MCPApprovalPolicySnapshot{Initiator: "alice", InitiatorUserID: "u-admin"},
+				userID: "u-releaser",
+			},
+		}
+		for _, tc := range cases {
+			policy := tc.policy
+			policy.RequiredApprovals = 1
+			policy.SeparationOfDuties = true
+			svc, _ := newApprovalPolicyTestService(policyGovernedRequest(policy))
+			_, err := svc.ResolveApproval(context.Background(), ResolveApprovalParams{
+				RequestID:   7,
+				Decision:    ApprovalDecisionApproved,
+				ActorID:     "staff:" + tc.userID,
+				ActorUserID: tc.userID,
+			})
+			var forbidden errs.Forbidden
+			if got := errors.As(err, &forbidden); got != tc.forbidden {
+				t.Fatalf("%s: error = %v, want forbidden=%v", tc.name, err, tc.forbidden)
+			}
+		}
+	})
+
+	t.Run("eligible roles and users restrict approvers", func(t *testing.T) {
+		t.Parallel()
+
+		policy := entitytypes.MCPApprovalPolicySnapshot{
+			RequiredApprovals: 3,
+			EligibleRoles:     []string{"release-manager"},
+			EligibleUserIDs:   []string{"u-admin"},
+		}
+		svc, _ := newApprovalPolicyTestService(policyGovernedRequest(policy))
+		for _, userID := range []string{"u-releaser", "u-admin"} {
+			if _, err := svc.ResolveApproval(context.Background(), ResolveApprovalParams{
+				RequestID:   7,
+				Decision:    ApprovalDecisionApproved,
+				ActorID:     "staff:" + userID,
+				ActorUserID: userID,
+			}); err != nil {
+				t.Fatalf("eligible approver %s got error: %v", userID, err)
+			}
+		}
+		_, err := svc.ResolveApproval(context.Background(), ResolveApprovalParams{
+			RequestID:   7,
+			Decision:    ApprovalDecisionApproved,
+			ActorID:     "staff:reader",
+			ActorUserID: "u-reader",
+		})
+		var forbidden errs.Forbidden
+		if !errors.As(err, &forbidden) {
+			t.Fatalf("ineligible approver error = %v, want forbidden", err)
+		}
+	})
+
+	t.Run("only approve and deny decisions accepted", func(t *testing.T) {
+		t.Parallel()
+
+		svc, _ := newApprovalPolicyTestService(policyGovernedRequest(entitytypes.MCPApprovalPolicySnapshot{RequiredApprovals: 1}))
+		_, err := svc.ResolveApproval(context.Background(), ResolveApprovalParams{
+			RequestID:   7,
+			Decision:    ApprovalDecisionApplied,
+			ActorID:     "staff:alice",
+			ActorUserID: "u-admin",
+		})
+		var validation errs.Validation
+		if !errors.As(err, &validation) {
+			t.Fatalf("error = %v, want validation", err)
+		}
+	})
+}
+
+func TestExpireOverdueApprovals(t *testing.T) {
+	t.Parallel()
+
+	item := policyGovernedRequest(entitytypes.MCPApprovalPolicySnapshot{RequiredApprovals: 2, ExpiresAfterSeconds: 60})
+	expiresAt := time.Date(2026, time.April, 1, 11, 59, 0, 0, time.UTC)
+	item.ExpiresAt = &expiresAt
+	svc, actions := newApprovalPolicyTestService(item)
+
+	expired, err := svc.ExpireOverdueApprovals(context.Background(), 10)
+	if err != nil {
+		t.Fatalf("ExpireOverdueApprovals returned error: %v", err)
+	}
+	if expired != 1 {
+		t.Fatalf("expired = %d, want 1", expired)
+	}
+	if state := actions.items[7].ApprovalState; state != entitytypes.MCPApprovalStateExpired {
+		t.Fatalf("approval state = %q, want expired", state)
+	}
+}
+
+func TestApprovalPolicyExpiresAt(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.April, 1, 12, 0, 0, 0, time.UTC)
+	if got := approvalPolicyExpiresAt(nil, now); got != nil {
+		t.Fatalf("expiresAt without policy = %v, want nil", got)
+	}
+	if got := approvalPolicyExpiresAt(&entitytypes.MCPApprovalPolicySnapshot{}, now); got != nil {
+		t.Fatalf("expiresAt without ttl = %v, want nil", got)
+	}
+	got := approvalPolicyExpiresAt(&entitytypes.MCPApprovalPolicySnapshot{ExpiresAfterSeconds: 3600}, now)
+	if got == nil || !got.Equal(now.Add(time.Hour)) {
+		t.Fatalf("expiresAt = %v, want %v", got, now.Add(time.Hour))
+	}
+}
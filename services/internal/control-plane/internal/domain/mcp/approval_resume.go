@@ -0,0 +1,210 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/mcp/approvalwait"
+	agentrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentrun"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+)
+
+const (
+	approvalResumeReasonMaxBytes       = 1024
+	approvalResumeToolResponseMaxBytes = 2 * 1024
+)
+
+// SuspendRunForApprovalResult describes whether agent-runner may persist its session and exit.
+type SuspendRunForApprovalResult struct {
+	Suspended         bool
+	ApprovalRequestID int64
+	ToolName          string
+	ApprovalState     string
+	RunnerAction      string
+}
+
+// approvalResumePayload is the deterministic approval outcome handed to the resume run.
+type approvalResumePayload struct {
+	ApprovalRequestID int64  `json:"approval_request_id"`
+	ToolName          string `json:"tool_name"`
+	Action            string `json:"action"`
+	ApprovalState     string `json:"approval_state"`
+	ResolvedAt        string `json:"resolved_at"`
+	ResolutionReason  string `json:"resolution_reason,omitempty"`
+	// ToolHTTPStatus and ToolResponse carry the outcome of an applied project HTTP tool.
+	ToolHTTPStatus        int             `json:"tool_http_status,omitempty"`
+	ToolResponse          json.RawMessage `json:"tool_response,omitempty"`
+	ToolResponseTruncated bool            `json:"tool_response_truncated,omitempty"`
+}
+
+// SuspendRunForApproval marks the latest open approval request of a run as awaiting a resume run.
+// A request resolved before the call is marked as well and its resume run is queued right away.
+// The run is not suspended when it has no such action, so agent-runner finishes normally.
+func (s *Service) SuspendRunForApproval(ctx context.Context, runID string) (SuspendRunForApprovalResult, error) {
+	runID = strings.TrimSpace(runID)
+	if runID == "" {
+		return SuspendRunForApprovalResult{}, fmt.Errorf("run_id is required")
+	}
+	item, ok, err := s.actions.MarkRunSuspended(ctx, runID, s.now().UTC())
+	if err != nil {
+		return SuspendRunForApprovalResult{}, fmt.Errorf("suspend run for approval: %w", err)
+	}
+	if !ok {
+		return SuspendRunForApprovalResult{}, nil
+	}
+	if isFinalApprovalState(item.ApprovalState) {
+		// The decision landed before the runner suspended, so no later transition will queue the resume run.
+		if err := s.resumeSuspendedRun(ctx, item, approvalResolutionReason(item.Payload)); err != nil {
+			return SuspendRunForApprovalResult{}, err
+		}
+	}
+
+	s.auditRunWaitPaused(ctx, SessionContext{RunID: item.RunID, CorrelationID: item.CorrelationID}, runWaitPayload{
+		RunID:                item.RunID,
+		WaitState:            string(waitStateMCP),
+		TimeoutGuardDisabled: true,
+	})
+	return SuspendRunForApprovalResult{
+		Suspended:         true,
+		ApprovalRequestID: item.ID,
+		ToolName:          item.ToolName,
+		ApprovalState:     string(item.ApprovalState),
+		RunnerAction:      approvalwait.RunnerActionPersistSessionAndExitWait,
+	}, nil
+}
+
+// resumeSuspendedRun queues a resume run once a request whose source run has suspended reaches a final state.
+func (s *Service) resumeSuspendedRun(ctx context.Context, item entitytypes.MCPActionRequest, reason string) error {
+	if item.RunSuspendedAt == nil || strings.TrimSpace(item.RunID) == "" || s.runs == nil {
+		return nil
+	}
+
+	run, found, err := s.runs.GetByID(ctx, item.RunID)
+	if err != nil {
+		return fmt.Errorf("load run for approval resume: %w", err)
+	}
+	if !found || strings.TrimSpace(run.Status) == "canceled" {
+		return nil
+	}
+
+	runMeta, err := parseInteractionResumeRunPayload(run.RunPayload)
+	if err != nil {
+		return err
+	}
+	agentID := strings.TrimSpace(runMeta.Agent.ID)
+	if agentID == "" {
+		return fmt.Errorf("run payload missing agent.id for approval resume")
+	}
+	projectID := strings.TrimSpace(run.ProjectID)
+	if projectID == "" {
+		projectID = strings.TrimSpace(runMeta.Project.ID)
+	}
+
+	pendingRunPayload, err := buildApprovalResumePendingRunPayload(run.RunPayload, buildApprovalResumePayload(item, reason))
+	if err != nil {
+		return err
+	}
+	if _, err := s.runs.CreatePendingIfAbsent(ctx, agentrunrepo.CreateParams{
+		CorrelationID: buildApprovalResumeCorrelationID(item.ID),
+		ProjectID:     projectID,
+		AgentID:       agentID,
+		RunPayload:    pendingRunPayload,
+		LearningMode:  runMeta.LearningMode,
+		Priority:      run.Priority,
+	}); err != nil {
+		return fmt.Errorf("create pending approval resume run: %w", err)
+	}
+	return nil
+}
+
+func isFinalApprovalState(state entitytypes.MCPApprovalState) bool {
+	switch state {
+	case entitytypes.MCPApprovalStateApplied,
+		entitytypes.MCPApprovalStateDenied,
+		entitytypes.MCPApprovalStateExpired,
+		entitytypes.MCPApprovalStateFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// approvalResolutionReason restores the decision reason or apply error stored in a final request payload.
+func approvalResolutionReason(payload json.RawMessage) string {
+	var decision approvalDecisionPayload
+	if err := json.Unmarshal(payload, &decision); err != nil {
+		return ""
+	}
+	if reason := strings.TrimSpace(decision.Reason); reason != "" {
+		return reason
+	}
+	return strings.TrimSpace(decision.Error)
+}
+
+func buildApprovalResumePayload(item entitytypes.MCPActionRequest, reason string) approvalResumePayload {
+	reason = strings.TrimSpace(reason)
+	if len(reason) > approvalResumeReasonMaxBytes {
+		reason = strings.ToValidUTF8(reason[:approvalResumeReasonMaxBytes], "")
+	}
+	payload := approvalResumePayload{
+		ApprovalRequestID: item.ID,
+		ToolName:          item.ToolName,
+		Action:            item.Action,
+		ApprovalState:     string(item.ApprovalState),
+		ResolvedAt:        item.UpdatedAt.UTC().Format(time.RFC3339Nano),
+		ResolutionReason:  reason,
+	}
+	if item.ApprovalState != entitytypes.MCPApprovalStateApplied {
+		return payload
+	}
+	var applied approvalAppliedPayload
+	if err := json.Unmarshal(item.Payload, &applied); err != nil || applied.Result == nil {
+		return payload
+	}
+	payload.ToolHTTPStatus = applied.Result.HTTPStatus
+	payload.ToolResponse = applied.Result.Response
+	if len(payload.ToolResponse) > approvalResumeToolResponseMaxBytes {
+		// Resume payload size is bounded; a cut JSON document is passed as plain text.
+		payload.ToolResponse = marshalRawJSON(strings.ToValidUTF8(string(payload.ToolResponse[:approvalResumeToolResponseMaxBytes]), ""))
+		payload.ToolResponseTruncated = true
+	}
+	return payload
+}
+
+func buildApprovalResumePendingRunPayload(raw json.RawMessage, resumePayload approvalResumePayload) (json.RawMessage, error) {
+	var envelope map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &envelope); err != nil {
+		return nil, fmt.Errorf("decode run payload for approval resume persistence: %w", err)
+	}
+
+	encodedResumePayload, err := json.Marshal(resumePayload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal approval resume payload: %w", err)
+	}
+	if len(encodedResumePayload) > approvalwait.ResumePayloadMaxBytes && len(resumePayload.ToolResponse) > 0 {
+		// Escaping may still inflate a truncated response; keep the outcome without the body.
+		resumePayload.ToolResponse = nil
+		resumePayload.ToolResponseTruncated = true
+		if encodedResumePayload, err = json.Marshal(resumePayload); err != nil {
+			return nil, fmt.Errorf("marshal approval resume payload: %w", err)
+		}
+	}
+	if len(encodedResumePayload) > approvalwait.ResumePayloadMaxBytes {
+		return nil, fmt.Errorf("approval resume payload exceeds %d bytes", approvalwait.ResumePayloadMaxBytes)
+	}
+	envelope[approvalwait.ResumePayloadRunPayloadFieldName] = encodedResumePayload
+
+	encodedRunPayload, err := json.Marshal(envelope)
+	if err != nil {
+		return nil, fmt.Errorf("marshal run payload with approval resume payload: %w", err)
+	}
+	return json.RawMessage(encodedRunPayload), nil
+}
+
+func buildApprovalResumeCorrelationID(requestID int64) string {
+	return approvalwait.ResumeCorrelationPrefix + strconv.FormatInt(requestID, 10)
+}
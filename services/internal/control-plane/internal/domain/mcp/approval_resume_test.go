@@ -0,0 +1,179 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/mcp/approvalwait"
+	agentrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentrun"
+	mcpactionrequestrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/mcpactionrequest"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+)
+
+func TestResumeSuspendedRunSchedulesPendingRun(t *testing.T) {
+	t.Parallel()
+
+	runs := &interactionTestRunsRepository{
+		byID: map[string]agentrunrepo.Run{
+			"run-1": {
+				ID:        "run-1",
+				ProjectID: "project-1",
+				Status:    "succeeded",
+				RunPayload: json.RawMessage(`{
+					"project":{"id":"project-1"},
+					"agent":{"id":"agent-dev"}
+				}`),
+			},
+		},
+	}
+	service := &Service{runs: runs}
+	suspendedAt := time.Date(2026, 4, 2, 10, 0, 0, 0, time.UTC)
+
+	err := service.resumeSuspendedRun(context.Background(), entitytypes.MCPActionRequest{
+		ID:             42,
+		RunID:          "run-1",
+		ToolName:       "database_lifecycle",
+		Action:         "delete",
+		ApprovalState:  entitytypes.MCPApprovalStateDenied,
+		RunSuspendedAt: &suspendedAt,
+		UpdatedAt:      suspendedAt.Add(2 * time.Hour),
+	}, "owner rejected")
+	if err != nil {
+		t.Fatalf("resumeSuspendedRun returned error: %v", err)
+	}
+	if runs.createPendingCalls != 1 {
+		t.Fatalf("create pending calls = %d, want 1", runs.createPendingCalls)
+	}
+	if got, want := runs.lastCreatePending.CorrelationID, "approval-resume:42"; got != want {
+		t.Fatalf("correlation id = %q, want %q", got, want)
+	}
+	var pendingRunPayload map[string]json.RawMessage
+	if err := json.Unmarshal(runs.lastCreatePending.RunPayload, &pendingRunPayload); err != nil {
+		t.Fatalf("unmarshal pending run payload: %v", err)
+	}
+	var resumePayload approvalResumePayload
+	if err := json.Unmarshal(pendingRunPayload[approvalwait.ResumePayloadRunPayloadFieldName], &resumePayload); err != nil {
+		t.Fatalf("unmarshal approval resume payload: %v", err)
+	}
+	if resumePayload.ApprovalState != "denied" || resumePayload.ResolutionReason != "owner rejected" {
+		t.Fatalf("unexpected resume payload: %+v", resumePayload)
+	}
+}
+
+func TestResumeSuspendedRunSkipsRunsThatDidNotSuspend(t *testing.T) {
+	t.Parallel()
+
+	runs := &interactionTestRunsRepository{}
+	service := &Service{runs: runs}
+
+	err := service.resumeSuspendedRun(context.Background(), entitytypes.MCPActionRequest{
+		ID:            42,
+		RunID:         "run-1",
+		ApprovalState: entitytypes.MCPApprovalStateApplied,
+	}, "")
+	if err != nil {
+		t.Fatalf("resumeSuspendedRun returned error: %v", err)
+	}
+	if runs.createPendingCalls != 0 {
+		t.Fatalf("create pending calls = %d, want 0", runs.createPendingCalls)
+	}
+}
+
+func TestBuildApprovalResumePayloadCarriesProjectToolResult(t *testing.T) {
+	t.Parallel()
+
+	appliedAt := time.Date(2026, 4, 2, 10, 0, 0, 0, time.UTC)
+	item := entitytypes.MCPActionRequest{
+		ID:            42,
+		ToolName:      "project.deploy_preview",
+		Action:        string(controlActionProjectToolCall),
+		ApprovalState: entitytypes.MCPApprovalStateApplied,
+		Payload: marshalRawJSON(approvalAppliedPayload{
+			AppliedAt: appliedAt.Format(time.RFC3339Nano),
+			Result:    &approvalAppliedResult{HTTPStatus: 201, Response: json.RawMessage(`{"preview_url":"https://preview.example.com"}`)},
+		}),
+		UpdatedAt: appliedAt,
+	}
+
+	payload := buildApprovalResumePayload(item, "")
+	if payload.ToolHTTPStatus != 201 || string(payload.ToolResponse) != `{"preview_url":"https://preview.example.com"}` || payload.ToolResponseTruncated {
+		t.Fatalf("unexpected resume payload: %+v", payload)
+	}
+
+	item.Payload = marshalRawJSON(approvalAppliedPayload{
+		AppliedAt: appliedAt.Format(time.RFC3339Nano),
+		Result:    &approvalAppliedResult{HTTPStatus: 200, Response: marshalRawJSON(strings.Repeat("x", 3*approvalResumeToolResponseMaxBytes))},
+	})
+	payload = buildApprovalResumePayload(item, "")
+	if !payload.ToolResponseTruncated || len(payload.ToolResponse) > 2*approvalResumeToolResponseMaxBytes {
+		t.Fatalf("large tool response must be truncated, got %d bytes", len(payload.ToolResponse))
+	}
+	if _, err := buildApprovalResumePendingRunPayload(json.RawMessage(`{}`), payload); err != nil {
+		t.Fatalf("buildApprovalResumePendingRunPayload() error = %v", err)
+	}
+}
+
+type approvalResumeTestActions struct {
+	mcpactionrequestrepo.Repository
+	item entitytypes.MCPActionRequest
+}
+
+func (r *approvalResumeTestActions) MarkRunSuspended(_ context.Context, runID string, suspendedAt time.Time) (entitytypes.MCPActionRequest, bool, error) {
+	if r.item.RunID != runID {
+		return entitytypes.MCPActionRequest{}, false, nil
+	}
+	r.item.RunSuspendedAt = &suspendedAt
+	return r.item, true, nil
+}
+
+func TestSuspendRunForApprovalQueuesResumeWhenDecisionCameFirst(t *testing.T) {
+	t.Parallel()
+
+	runs := &interactionTestRunsRepository{
+		byID: map[string]agentrunrepo.Run{
+			"run-1": {
+				ID:         "run-1",
+				ProjectID:  "project-1",
+				Status:     "running",
+				RunPayload: json.RawMessage(`{"project":{"id":"project-1"},"agent":{"id":"agent-dev"}}`),
+			},
+		},
+	}
+	now := time.Date(2026, 4, 2, 10, 0, 0, 0, time.UTC)
+	actions := &approvalResumeTestActions{item: entitytypes.MCPActionRequest{
+		ID:            42,
+		RunID:         "run-1",
+		ToolName:      "database_lifecycle",
+		Action:        "delete",
+		ApprovalMode:  entitytypes.MCPApprovalModeOwner,
+		ApprovalState: entitytypes.MCPApprovalStateDenied,
+		Payload:       marshalRawJSON(approvalDecisionPayload{Decision: "denied", Reason: "not in this release"}),
+		UpdatedAt:     now,
+	}}
+	service := &Service{runs: runs, actions: actions, now: func() time.Time { return now }}
+
+	result, err := service.SuspendRunForApproval(context.Background(), "run-1")
+	if err != nil {
+		t.Fatalf("SuspendRunForApproval returned error: %v", err)
+	}
+	if !result.Suspended || result.ApprovalState != "denied" {
+		t.Fatalf("unexpected suspension result: %+v", result)
+	}
+	if runs.createPendingCalls != 1 {
+		t.Fatalf("create pending calls = %d, want 1", runs.createPendingCalls)
+	}
+	var pendingRunPayload map[string]json.RawMessage
+	if err := json.Unmarshal(runs.lastCreatePending.RunPayload, &pendingRunPayload); err != nil {
+		t.Fatalf("unmarshal pending run payload: %v", err)
+	}
+	var resumePayload approvalResumePayload
+	if err := json.Unmarshal(pendingRunPayload[approvalwait.ResumePayloadRunPayloadFieldName], &resumePayload); err != nil {
+		t.Fatalf("unmarshal approval resume payload: %v", err)
+	}
+	if resumePayload.ApprovalState != "denied" || resumePayload.ResolutionReason != "not in this release" {
+		t.Fatalf("unexpected resume payload: %+v", resumePayload)
+	}
+}
@@ -167,6 +167,18 @@ func (s *Service) auditApprovalExpired(ctx context.Context, session SessionConte
 	)
 }
 
+func (s *Service) auditApprovalVoteRecorded(ctx context.Context, session SessionContext, request entitytypes.MCPActionRequest, actorID string, reason string) {
+	s.auditApprovalStateChange(
+		ctx,
+		session,
+		request,
+		floweventdomain.ActorTypeHuman,
+		floweventdomain.EventTypeApprovalVoteRecorded,
+		actorID,
+		reason,
+	)
+}
+
 func (s *Service) auditApprovalFailed(ctx context.Context, session SessionContext, request entitytypes.MCPActionRequest, actorID string, reason string) {
 	s.auditApprovalStateChange(
 		ctx,
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	"github.com/codex-k8s/kodex/libs/go/mcp/approvalwait"
 	agentrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentrun"
 	agentsessionrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentsession"
 	mcpactionrequestrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/mcpactionrequest"
@@ -190,6 +191,7 @@ func (s *Service) MCPSecretSyncEnv(ctx context.Context, session SessionContext,
 				Policy:         string(policy),
 				IdempotencyKey: idempotencyKey,
 				Message:        controlToolMessageApprovalRequired,
+				RunnerAction:   approvalwait.RunnerActionPersistSessionAndExitWait,
 			}, nil
 		case entitytypes.MCPApprovalStateApplied:
 			s.auditToolSucceeded(ctx, runCtx.Session, tool)
@@ -207,7 +209,11 @@ func (s *Service) MCPSecretSyncEnv(ctx context.Context, session SessionContext,
 		}
 	}
 
-	approvalMode := normalizeApprovalMode(resolveControlApprovalMode(tool.Name, runCtx))
+	approvalMode, approvalPolicy, err := s.resolveControlApproval(ctx, runCtx, tool.Name, environment)
+	if err != nil {
+		s.auditToolFailed(ctx, runCtx.Session, tool, err)
+		return SecretSyncEnvResult{}, err
+	}
 	if approvalMode == entitytypes.MCPApprovalModeNone {
 		if err := s.applySecretSync(ctx, runCtx, payload); err != nil {
 			s.auditToolFailed(ctx, runCtx.Session, tool, err)
@@ -240,7 +246,7 @@ func (s *Service) MCPSecretSyncEnv(ctx context.Context, session SessionContext,
 		}, nil
 	}
 
-	request, created, err := s.ensurePendingApprovalRequest(ctx, runCtx, tool, string(controlActionSecretSyncEnv), targetRef, approvalMode, payload)
+	request, created, err := s.ensurePendingApprovalRequest(ctx, runCtx, tool, string(controlActionSecretSyncEnv), targetRef, approvalMode, approvalPolicy, payload)
 	if err != nil {
 		s.auditToolFailed(ctx, runCtx.Session, tool, err)
 		return SecretSyncEnvResult{}, err
@@ -258,6 +264,7 @@ func (s *Service) MCPSecretSyncEnv(ctx context.Context, session SessionContext,
 		Policy:         string(policy),
 		IdempotencyKey: idempotencyKey,
 		Message:        controlToolMessageApprovalRequired,
+		RunnerAction:   approvalwait.RunnerActionPersistSessionAndExitWait,
 	}, nil
 }
 
@@ -404,7 +411,11 @@ func (s *Service) MCPDatabaseLifecycle(ctx context.Context, session SessionConte
 		}, nil
 	}
 
-	approvalMode := normalizeApprovalMode(resolveControlApprovalMode(tool.Name, runCtx))
+	approvalMode, approvalPolicy, err := s.resolveControlApproval(ctx, runCtx, tool.Name, environment)
+	if err != nil {
+		s.auditToolFailed(ctx, runCtx.Session, tool, err)
+		return DatabaseLifecycleResult{}, err
+	}
 	if approvalMode == entitytypes.MCPApprovalModeNone {
 		applied, err := s.applyDatabaseLifecycle(ctx, payload)
 		if err != nil {
@@ -440,7 +451,7 @@ func (s *Service) MCPDatabaseLifecycle(ctx context.Context, session SessionConte
 		}, nil
 	}
 
-	request, created, err := s.ensurePendingApprovalRequest(ctx, runCtx, tool, databaseActionName(action), targetRef, approvalMode, payload)
+	request, created, err := s.ensurePendingApprovalRequest(ctx, runCtx, tool, databaseActionName(action), targetRef, approvalMode, approvalPolicy, payload)
 	if err != nil {
 		s.auditToolFailed(ctx, runCtx.Session, tool, err)
 		return DatabaseLifecycleResult{}, err
@@ -459,6 +470,7 @@ func (s *Service) MCPDatabaseLifecycle(ctx context.Context, session SessionConte
 		OwnedByProject: true,
 		OwnerProjectID: projectID,
 		Message:        controlToolMessageApprovalRequired,
+		RunnerAction:   approvalwait.RunnerActionPersistSessionAndExitWait,
 	}, nil
 }
 
@@ -507,7 +519,11 @@ func (s *Service) MCPOwnerFeedbackRequest(ctx context.Context, session SessionCo
 		}, nil
 	}
 
-	approvalMode := normalizeApprovalMode(resolveControlApprovalMode(tool.Name, runCtx))
+	approvalMode, approvalPolicy, err := s.resolveControlApproval(ctx, runCtx, tool.Name, "")
+	if err != nil {
+		s.auditToolFailed(ctx, runCtx.Session, tool, err)
+		return OwnerFeedbackRequestResult{}, err
+	}
 	if approvalMode == entitytypes.MCPApprovalModeNone {
 		item, err := s.createAppliedActionRequest(
 			ctx,
@@ -534,7 +550,7 @@ func (s *Service) MCPOwnerFeedbackRequest(ctx context.Context, session SessionCo
 		}, nil
 	}
 
-	request, created, err := s.ensurePendingApprovalRequest(ctx, runCtx, tool, string(controlActionOwnerFeedback), targetRef, approvalMode, payload)
+	request, created, err := s.ensurePendingApprovalRequest(ctx, runCtx, tool, string(controlActionOwnerFeedback), targetRef, approvalMode, approvalPolicy, payload)
 	if err != nil {
 		s.auditToolFailed(ctx, runCtx.Session, tool, err)
 		return OwnerFeedbackRequestResult{}, err
@@ -550,6 +566,7 @@ func (s *Service) MCPOwnerFeedbackRequest(ctx context.Context, session SessionCo
 		Question:      question,
 		Options:       options,
 		Message:       controlToolMessageApprovalRequired,
+		RunnerAction:  approvalwait.RunnerActionPersistSessionAndExitWait,
 	}, nil
 }
 
@@ -561,26 +578,72 @@ func (s *Service) ListPendingApprovals(ctx context.Context, limit int) ([]Approv
 
 	out := make([]ApprovalListItem, 0, len(items))
 	for _, item := range items {
-		out = append(out, ApprovalListItem{
-			ID:            item.ID,
-			CorrelationID: item.CorrelationID,
-			RunID:         item.RunID,
-			ProjectID:     item.ProjectID,
-			ProjectSlug:   item.ProjectSlug,
-			ProjectName:   item.ProjectName,
-			IssueNumber:   item.IssueNumber,
-			PRNumber:      item.PRNumber,
-			TriggerLabel:  item.TriggerLabel,
-			ToolName:      item.ToolName,
-			Action:        item.Action,
-			ApprovalMode:  string(item.ApprovalMode),
-			RequestedBy:   item.RequestedBy,
-			CreatedAt:     item.CreatedAt.UTC(),
-		})
+		out = append(out, approvalListItemFromRequest(item))
 	}
 	return out, nil
 }
 
+func approvalListItemFromRequest(item entitytypes.MCPActionRequest) ApprovalListItem {
+	out := ApprovalListItem{
+		ID:            item.ID,
+		CorrelationID: item.CorrelationID,
+		RunID:         item.RunID,
+		ProjectID:     item.ProjectID,
+		ProjectSlug:   item.ProjectSlug,
+		ProjectName:   item.ProjectName,
+		IssueNumber:   item.IssueNumber,
+		PRNumber:      item.PRNumber,
+		TriggerLabel:  item.TriggerLabel,
+		ToolName:      item.ToolName,
+		Action:        item.Action,
+		ApprovalMode:  string(item.ApprovalMode),
+		RequestedBy:   item.RequestedBy,
+		CreatedAt:     item.CreatedAt.UTC(),
+		ExpiresAt:     item.ExpiresAt,
+	}
+	if item.Policy != nil {
+		out.RequiredApprovals = item.Policy.RequiredApprovals
+		out.ApprovalsCount = item.ApprovedVotes()
+	}
+	for _, vote := range item.Votes {
+		out.Votes = append(out.Votes, ApprovalVote{
+			ActorID:  vote.ActorID,
+			Decision: vote.Decision,
+			Reason:   vote.Reason,
+			VotedAt:  vote.VotedAt.UTC(),
+		})
+	}
+	return out
+}
+
+func resolveApprovalResultFromRequest(item entitytypes.MCPActionRequest) ResolveApprovalResult {
+	out := ResolveApprovalResult{
+		ID:            item.ID,
+		CorrelationID: item.CorrelationID,
+		RunID:         item.RunID,
+		ToolName:      item.ToolName,
+		Action:        item.Action,
+		ApprovalState: string(item.ApprovalState),
+	}
+	if item.Policy != nil {
+		out.RequiredApprovals = item.Policy.RequiredApprovals
+		out.ApprovalsCount = item.ApprovedVotes()
+	}
+	return out
+}
+
+// GetApproval returns one approval request summary regardless of its state.
+func (s *Service) GetApproval(ctx context.Context, requestID int64) (ApprovalListItem, bool, error) {
+	item, ok, err := s.actions.GetByID(ctx, requestID)
+	if err != nil {
+		return ApprovalListItem{}, false, fmt.Errorf("get approval request: %w", err)
+	}
+	if !ok {
+		return ApprovalListItem{}, false, nil
+	}
+	return approvalListItemFromRequest(item), true, nil
+}
+
 func (s *Service) ResolveApproval(ctx context.Context, params ResolveApprovalParams) (ResolveApprovalResult, error) {
 	if params.RequestID <= 0 {
 		return ResolveApprovalResult{}, fmt.Errorf("request_id is required")
@@ -602,16 +665,12 @@ func (s *Service) ResolveApproval(ctx context.Context, params ResolveApprovalPar
 	if !ok {
 		return ResolveApprovalResult{}, fmt.Errorf("approval request not found")
 	}
+	if item.Policy != nil && item.ApprovalState == entitytypes.MCPApprovalStateRequested {
+		return s.recordApprovalVote(ctx, item, decision, params, actorID, reason)
+	}
 	state := decisionToApprovalState(decision)
 	if !canTransitionApprovalState(item.ApprovalState, state) {
-		return ResolveApprovalResult{
-			ID:            item.ID,
-			CorrelationID: item.CorrelationID,
-			RunID:         item.RunID,
-			ToolName:      item.ToolName,
-			Action:        item.Action,
-			ApprovalState: string(item.ApprovalState),
-		}, nil
+		return resolveApprovalResultFromRequest(item), nil
 	}
 
 	decisionPayload := marshalRawJSON(approvalDecisionPayload{
@@ -633,6 +692,17 @@ func (s *Service) ResolveApproval(ctx context.Context, params ResolveApprovalPar
 		return ResolveApprovalResult{}, fmt.Errorf("approval request not found")
 	}
 
+	return s.finishApprovalTransition(ctx, updated, state, actorID, reason)
+}
+
+// finishApprovalTransition audits the new approval state, applies approved actions and releases run wait state.
+func (s *Service) finishApprovalTransition(
+	ctx context.Context,
+	updated entitytypes.MCPActionRequest,
+	state entitytypes.MCPApprovalState,
+	actorID string,
+	reason string,
+) (ResolveApprovalResult, error) {
 	approvalSession := SessionContext{RunID: updated.RunID, CorrelationID: updated.CorrelationID}
 	switch state {
 	case entitytypes.MCPApprovalStateApproved:
@@ -661,14 +731,10 @@ func (s *Service) ResolveApproval(ctx context.Context, params ResolveApprovalPar
 			if clearErr := s.setRunWaitState(ctx, approvalSession, waitStateNone, false); clearErr != nil {
 				return ResolveApprovalResult{}, clearErr
 			}
-			return ResolveApprovalResult{
-				ID:            failed.ID,
-				CorrelationID: failed.CorrelationID,
-				RunID:         failed.RunID,
-				ToolName:      failed.ToolName,
-				Action:        failed.Action,
-				ApprovalState: string(failed.ApprovalState),
-			}, nil
+			if err := s.resumeSuspendedRun(ctx, failed, applyErr.Error()); err != nil {
+				return ResolveApprovalResult{}, err
+			}
+			return resolveApprovalResultFromRequest(failed), nil
 		}
 		updated = applied
 		if clearErr := s.setRunWaitState(ctx, approvalSession, waitStateNone, false); clearErr != nil {
@@ -695,15 +761,11 @@ func (s *Service) ResolveApproval(ctx context.Context, params ResolveApprovalPar
 			return ResolveApprovalResult{}, clearErr
 		}
 	}
+	if err := s.resumeSuspendedRun(ctx, updated, reason); err != nil {
+		return ResolveApprovalResult{}, err
+	}
 
-	return ResolveApprovalResult{
-		ID:            updated.ID,
-		CorrelationID: updated.CorrelationID,
-		RunID:         updated.RunID,
-		ToolName:      updated.ToolName,
-		Action:        updated.Action,
-		ApprovalState: string(updated.ApprovalState),
-	}, nil
+	return resolveApprovalResultFromRequest(updated), nil
 }
 
 func (s *Service) ensurePendingApprovalRequest(
@@ -713,6 +775,7 @@ func (s *Service) ensurePendingApprovalRequest(
 	action string,
 	targetRef json.RawMessage,
 	approvalMode entitytypes.MCPApprovalMode,
+	policy *entitytypes.MCPApprovalPolicySnapshot,
 	payload json.RawMessage,
 ) (entitytypes.MCPActionRequest, bool, error) {
 	existing, found, err := s.actions.FindPendingBySignature(
@@ -739,6 +802,8 @@ func (s *Service) ensurePendingApprovalRequest(
 		ApprovalState: entitytypes.MCPApprovalStateRequested,
 		RequestedBy:   requestActorID(runCtx),
 		Payload:       payload,
+		Policy:        policy,
+		ExpiresAt:     approvalPolicyExpiresAt(policy, s.now()),
 	})
 	if err != nil {
 		return entitytypes.MCPActionRequest{}, false, fmt.Errorf("create approval request: %w", err)
@@ -801,6 +866,7 @@ func (s *Service) applyApprovedControlAction(
 		return entitytypes.MCPActionRequest{}, err
 	}
 
+	var result *approvalAppliedResult
 	switch ToolName(item.ToolName) {
 	case ToolMCPSecretSyncEnv:
 		if err := s.applySecretSync(ctx, runCtx, item.Payload); err != nil {
@@ -820,12 +886,19 @@ func (s *Service) applyApprovedControlAction(
 	case ToolMCPOwnerFeedbackRequest:
 		// This tool stores operator decision in payload and does not have external side effects.
 	default:
-		return entitytypes.MCPActionRequest{}, fmt.Errorf("unsupported tool %q", item.ToolName)
+		if !IsProjectToolName(ToolName(item.ToolName)) {
+			return entitytypes.MCPActionRequest{}, fmt.Errorf("unsupported tool %q", item.ToolName)
+		}
+		result, err = s.applyProjectToolCall(ctx, runCtx, ToolName(item.ToolName), item.Payload)
+		if err != nil {
+			return entitytypes.MCPActionRequest{}, err
+		}
 	}
 
 	appliedPayload := marshalRawJSON(approvalAppliedPayload{
 		AppliedAt: nowRFC3339Nano(s.now()),
 		AppliedBy: actorID,
+		Result:    result,
 	})
 	updated, ok, err := s.actions.UpdateState(ctx, mcpactionrequestrepo.UpdateStateParams{
 		ID:            item.ID,
@@ -61,8 +61,15 @@ type approvalDecisionPayload struct {
 }
 
 type approvalAppliedPayload struct {
-	AppliedAt string `json:"applied_at"`
-	AppliedBy string `json:"applied_by,omitempty"`
+	AppliedAt string                 `json:"applied_at"`
+	AppliedBy string                 `json:"applied_by,omitempty"`
+	Result    *approvalAppliedResult `json:"result,omitempty"`
+}
+
+// approvalAppliedResult keeps the outcome of an approved action that returns data, e.g. a project HTTP tool.
+type approvalAppliedResult struct {
+	HTTPStatus int             `json:"http_status,omitempty"`
+	Response   json.RawMessage `json:"response,omitempty"`
 }
 
 type runWaitPayload struct {
@@ -117,6 +117,7 @@ func (s *Service) scheduleInteractionResume(
 		AgentID:       agentID,
 		RunPayload:    pendingRunPayload,
 		LearningMode:  runMeta.LearningMode,
+		Priority:      run.Priority,
 	})
 	if err != nil {
 		return false, fmt.Errorf("create pending interaction resume run: %w", err)
@@ -97,6 +97,8 @@ type ToolCapability struct {
 	Description string             `json:"description"`
 	Category    ToolCategory       `json:"category"`
 	Approval    ToolApprovalPolicy `json:"approval"`
+	// InputSchema is set for project-declared tools registered outside of the static MCP server catalog.
+	InputSchema map[string]any `json:"input_schema,omitempty"`
 }
 
 // SessionContext is an authenticated MCP session bound to one run.
@@ -609,6 +611,7 @@ type SecretSyncEnvResult struct {
 	Reused         bool                `json:"reused,omitempty"`
 	DryRun         bool                `json:"dry_run,omitempty"`
 	Message        string              `json:"message,omitempty"`
+	RunnerAction   string              `json:"runner_action,omitempty"`
 }
 
 // DatabaseLifecycleAction defines supported database lifecycle actions.
@@ -643,6 +646,7 @@ type DatabaseLifecycleResult struct {
 	OwnerProjectID string              `json:"owner_project_id,omitempty"`
 	DryRun         bool                `json:"dry_run,omitempty"`
 	Message        string              `json:"message,omitempty"`
+	RunnerAction   string              `json:"runner_action,omitempty"`
 }
 
 // OwnerFeedbackRequestInput describes owner feedback request with fixed options and optional custom answer.
@@ -662,6 +666,7 @@ type OwnerFeedbackRequestResult struct {
 	Options       []string            `json:"options,omitempty"`
 	DryRun        bool                `json:"dry_run,omitempty"`
 	Message       string              `json:"message,omitempty"`
+	RunnerAction  string              `json:"runner_action,omitempty"`
 }
 
 // SelfImproveRunsListInput describes paginated run history request.
@@ -754,6 +759,9 @@ type ResolveApprovalParams struct {
 	Decision  ApprovalDecision
 	ActorID   string
 	Reason    string
+	// ActorUserID and ActorGitHubLogin identify the staff approver for approval policy checks.
+	ActorUserID      string
+	ActorGitHubLogin string
 }
 
 // ApprovalListItem is staff-facing pending approval queue entry.
@@ -772,6 +780,19 @@ type ApprovalListItem struct {
 	ApprovalMode  string    `json:"approval_mode"`
 	RequestedBy   string    `json:"requested_by"`
 	CreatedAt     time.Time `json:"created_at"`
+	// RequiredApprovals is zero when no approval policy governs the request.
+	RequiredApprovals int            `json:"required_approvals,omitempty"`
+	ApprovalsCount    int            `json:"approvals_count,omitempty"`
+	Votes             []ApprovalVote `json:"votes,omitempty"`
+	ExpiresAt         *time.Time     `json:"expires_at,omitempty"`
+}
+
+// ApprovalVote is one recorded approver decision on a policy-governed request.
+type ApprovalVote struct {
+	ActorID  string    `json:"actor_id"`
+	Decision string    `json:"decision"`
+	Reason   string    `json:"reason,omitempty"`
+	VotedAt  time.Time `json:"voted_at"`
 }
 
 // ResolveApprovalResult returns updated approval request summary.
@@ -782,4 +803,7 @@ type ResolveApprovalResult struct {
 	ToolName      string `json:"tool_name"`
 	Action        string `json:"action"`
 	ApprovalState string `json:"approval_state"`
+	// RequiredApprovals and ApprovalsCount report multi-approver progress when a policy applies.
+	RequiredApprovals int `json:"required_approvals,omitempty"`
+	ApprovalsCount    int `json:"approvals_count,omitempty"`
 }
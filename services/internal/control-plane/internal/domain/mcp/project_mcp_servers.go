@@ -0,0 +1,43 @@
+package mcp
+
+import (
+	"context"
+	"sort"
+
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+)
+
+// ProjectMCPServers lists external MCP servers the run pod may mount into codex config.
+//
+// Servers come from services.yaml of the repository default ref, so the agent cannot add them by editing
+// the run branch. URL servers must pass the control-plane outbound host allowlist. Write servers are never
+// mounted: their calls go straight from the pod and would bypass approval policy and audit, so write
+// callouts must be declared as httpTools instead.
+func (s *Service) ProjectMCPServers(ctx context.Context, session SessionContext) ([]servicescfg.AgentMCPServer, error) {
+	runCtx, err := s.resolveRunContext(ctx, session, false)
+	if err != nil {
+		return nil, err
+	}
+	return s.projectMCPServersForRunContext(runCtx), nil
+}
+
+func (s *Service) projectMCPServersForRunContext(runCtx resolvedRunContext) []servicescfg.AgentMCPServer {
+	tools, ok := s.loadTrustedProjectAgentTools(runCtx)
+	if !ok {
+		return nil
+	}
+
+	agentKey := runAgentKey(runCtx)
+	out := make([]servicescfg.AgentMCPServer, 0, len(tools.MCPServers))
+	for _, server := range tools.MCPServers {
+		if !server.AllowsRole(agentKey) || server.Category == servicescfg.AgentToolCategoryWrite {
+			continue
+		}
+		if server.URL != "" && !s.isProjectToolURLAllowed(server.URL) {
+			continue
+		}
+		out = append(out, server)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
@@ -0,0 +1,95 @@
+package mcp
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+const projectMCPServersTrustedServicesYAML = `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-prod"
+  agentTools:
+    mcpServers:
+      - name: docs-search
+        url: https://mcp.tools.example.com/docs
+      - name: external-search
+        url: https://mcp.elsewhere.example.org/search
+      - name: sentry
+        command: npx
+        args: ["-y", "@sentry/mcp-server"]
+        category: write
+      - name: local-lint
+        command: lint-mcp
+      - name: ops-only
+        command: ops-mcp
+        roles: [sre]
+`
+
+const projectMCPServersBranchServicesYAML = `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-prod"
+  agentTools:
+    mcpServers:
+      - name: agent-added
+        command: exfiltrate
+`
+
+func TestProjectMCPServersForRunContext_UsesDefaultRefAndDropsUntrustedServers(t *testing.T) {
+	t.Parallel()
+
+	repoRoot := t.TempDir()
+	for ref, content := range map[string]string{
+		"main":         projectMCPServersTrustedServicesYAML,
+		"feature-test": projectMCPServersBranchServicesYAML,
+	} {
+		dir := filepath.Join(repoRoot, "github", "acme", "demo", ref)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatalf("mkdir %s: %v", dir, err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "services.yaml"), []byte(content), 0o644); err != nil {
+			t.Fatalf("write services.yaml: %v", err)
+		}
+	}
+
+	svc := &Service{
+		cfg:                     Config{RepositoryRoot: repoRoot},
+		projectToolAllowedHosts: normalizeProjectToolAllowedHosts([]string{"tools.example.com"}),
+	}
+	runCtx := resolvedRunContext{
+		Repository: entitytypes.RepositoryBinding{Owner: "acme", Name: "demo", DefaultRef: "main"},
+		Payload: querytypes.RunPayload{
+			Agent:       &querytypes.RunPayloadAgent{Key: "dev"},
+			PullRequest: &querytypes.RunPayloadPullRequest{HeadRef: "feature/test"},
+		},
+	}
+
+	servers := svc.projectMCPServersForRunContext(runCtx)
+	names := make([]string, 0, len(servers))
+	for _, server := range servers {
+		names = append(names, server.Name)
+		if server.Category == servicescfg.AgentToolCategoryWrite {
+			t.Fatalf("write server %q must not be mounted", server.Name)
+		}
+	}
+	if want := []string{"docs-search", "local-lint"}; !slices.Equal(names, want) {
+		t.Fatalf("servers=%v, want %v", names, want)
+	}
+}
@@ -0,0 +1,367 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/mcp/approvalwait"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+)
+
+// ProjectToolNamePrefix prefixes HTTP tools declared in project services.yaml.
+const ProjectToolNamePrefix = "project."
+
+const (
+	controlActionProjectToolCall controlAction = "project_tool_call"
+
+	projectToolResponseMaxBytes = 64 * 1024
+	projectToolArgumentsMaxSize = 64 * 1024
+	projectToolMaxRedirects     = 5
+)
+
+// IsProjectToolName reports whether tool name belongs to project-declared HTTP tools.
+func IsProjectToolName(name ToolName) bool {
+	return strings.HasPrefix(string(name), ProjectToolNamePrefix)
+}
+
+// ProjectToolCallResult is the outcome of one project HTTP tool call.
+type ProjectToolCallResult struct {
+	Status        ToolExecutionStatus `json:"status"`
+	Tool          ToolName            `json:"tool"`
+	HTTPStatus    int                 `json:"http_status,omitempty"`
+	Response      json.RawMessage     `json:"response,omitempty"`
+	RequestID     int64               `json:"request_id,omitempty"`
+	ApprovalState string              `json:"approval_state,omitempty"`
+	Message       string              `json:"message,omitempty"`
+	RunnerAction  string              `json:"runner_action,omitempty"`
+}
+
+type projectToolPayload struct {
+	URL            string          `json:"url"`
+	TimeoutSeconds int             `json:"timeout_seconds"`
+	Arguments      json.RawMessage `json:"arguments"`
+}
+
+type projectToolCallRequest struct {
+	Tool          string          `json:"tool"`
+	RunID         string          `json:"run_id"`
+	ProjectID     string          `json:"project_id,omitempty"`
+	CorrelationID string          `json:"correlation_id,omitempty"`
+	Arguments     json.RawMessage `json:"arguments"`
+}
+
+// CallProjectTool executes one project-declared HTTP tool, or opens an approval request when the tool requires it.
+func (s *Service) CallProjectTool(ctx context.Context, session SessionContext, name ToolName, arguments json.RawMessage) (ProjectToolCallResult, error) {
+	runCtx, err := s.resolveRunContext(ctx, session, false)
+	if err != nil {
+		return ProjectToolCallResult{}, err
+	}
+	tool, declared, ok := s.projectToolForRunContext(runCtx, name)
+	if !ok {
+		return ProjectToolCallResult{}, fmt.Errorf("tool %q is not available for current run profile", name)
+	}
+	s.auditToolCalled(ctx, runCtx.Session, tool)
+
+	arguments, err = normalizeProjectToolArguments(arguments)
+	if err != nil {
+		s.auditToolFailed(ctx, runCtx.Session, tool, err)
+		return ProjectToolCallResult{}, err
+	}
+	payload := projectToolPayload{
+		URL:            declared.URL,
+		TimeoutSeconds: declared.TimeoutSeconds,
+		Arguments:      arguments,
+	}
+
+	if tool.Approval == ToolApprovalNone {
+		httpStatus, response, err := s.callProjectHTTPTool(ctx, runCtx.Session, tool.Name, payload)
+		if err != nil {
+			s.auditToolFailed(ctx, runCtx.Session, tool, err)
+			return ProjectToolCallResult{}, err
+		}
+		s.auditToolSucceeded(ctx, runCtx.Session, tool)
+		return ProjectToolCallResult{
+			Status:     ToolExecutionStatusOK,
+			Tool:       tool.Name,
+			HTTPStatus: httpStatus,
+			Response:   response,
+		}, nil
+	}
+
+	approvalMode := entitytypes.MCPApprovalModeOwner
+	if tool.Approval == ToolApprovalDelegated {
+		approvalMode = entitytypes.MCPApprovalModeDelegated
+	}
+	targetRef := marshalRawJSON(approvalTargetRef{
+		ProjectID:      strings.TrimSpace(runCtx.Session.ProjectID),
+		IdempotencyKey: projectToolArgumentsDigest(arguments),
+	})
+	request, created, err := s.ensurePendingApprovalRequest(
+		ctx,
+		runCtx,
+		tool,
+		string(controlActionProjectToolCall),
+		targetRef,
+		approvalMode,
+		nil,
+		marshalRawJSON(payload),
+	)
+	if err != nil {
+		s.auditToolFailed(ctx, runCtx.Session, tool, err)
+		return ProjectToolCallResult{}, err
+	}
+	s.auditToolApprovalPending(ctx, runCtx.Session, tool, controlToolMessageApprovalRequired)
+	if created {
+		s.auditApprovalRequested(ctx, runCtx.Session, request, tool)
+	}
+	return ProjectToolCallResult{
+		Status:        ToolExecutionStatusApprovalRequired,
+		Tool:          tool.Name,
+		RequestID:     request.ID,
+		ApprovalState: string(request.ApprovalState),
+		Message:       controlToolMessageApprovalRequired,
+		RunnerAction:  approvalwait.RunnerActionPersistSessionAndExitWait,
+	}, nil
+}
+
+// applyProjectToolCall performs the HTTP callout of an approved project tool request
+// and returns the tool outcome so it can be kept for the resumed agent.
+func (s *Service) applyProjectToolCall(ctx context.Context, runCtx resolvedRunContext, name ToolName, payloadRaw json.RawMessage) (*approvalAppliedResult, error) {
+	var payload projectToolPayload
+	if err := json.Unmarshal(payloadRaw, &payload); err != nil {
+		return nil, fmt.Errorf("decode project tool payload: %w", err)
+	}
+	status, response, err := s.callProjectHTTPTool(ctx, runCtx.Session, name, payload)
+	if err != nil {
+		return nil, err
+	}
+	return &approvalAppliedResult{HTTPStatus: status, Response: response}, nil
+}
+
+// projectToolCapabilities lists project HTTP tools visible for the run agent role.
+func (s *Service) projectToolCapabilities(runCtx resolvedRunContext) []ToolCapability {
+	declared := s.projectHTTPToolsForRunContext(runCtx)
+	out := make([]ToolCapability, 0, len(declared))
+	for _, tool := range declared {
+		out = append(out, projectToolCapability(tool))
+	}
+	return out
+}
+
+func (s *Service) projectToolForRunContext(runCtx resolvedRunContext, name ToolName) (ToolCapability, servicescfg.AgentHTTPTool, bool) {
+	if !IsProjectToolName(name) {
+		return ToolCapability{}, servicescfg.AgentHTTPTool{}, false
+	}
+	for _, tool := range s.projectHTTPToolsForRunContext(runCtx) {
+		capability := projectToolCapability(tool)
+		if capability.Name == name {
+			return capability, tool, true
+		}
+	}
+	return ToolCapability{}, servicescfg.AgentHTTPTool{}, false
+}
+
+// projectHTTPToolsForRunContext keeps trusted project tools allowed for the agent role
+// and for control-plane outbound host allowlist.
+func (s *Service) projectHTTPToolsForRunContext(runCtx resolvedRunContext) []servicescfg.AgentHTTPTool {
+	if len(s.projectToolAllowedHosts) == 0 {
+		return nil
+	}
+	tools, ok := s.loadTrustedProjectAgentTools(runCtx)
+	if !ok {
+		return nil
+	}
+
+	agentKey := runAgentKey(runCtx)
+	out := make([]servicescfg.AgentHTTPTool, 0, len(tools.HTTPTools))
+	for _, tool := range tools.HTTPTools {
+		if !tool.AllowsRole(agentKey) || !s.isProjectToolURLAllowed(tool.URL) {
+			continue
+		}
+		out = append(out, tool)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// loadTrustedProjectAgentTools loads agent tools from services.yaml of the repository default ref.
+// Load errors hide project tools instead of failing the run.
+func (s *Service) loadTrustedProjectAgentTools(runCtx resolvedRunContext) (servicescfg.AgentTools, bool) {
+	configPath, err := s.resolveTrustedServicesConfigPath(runCtx, runCtx.Repository.ServicesYAMLPath)
+	if err != nil {
+		return servicescfg.AgentTools{}, false
+	}
+	loadResult, err := servicescfg.Load(configPath, servicescfg.LoadOptions{
+		Env:       resolvePromptTargetEnv(runCtx, s.cfg.ServicesConfigEnv),
+		Namespace: strings.TrimSpace(runCtx.Session.Namespace),
+	})
+	if err != nil || loadResult.Stack == nil {
+		return servicescfg.AgentTools{}, false
+	}
+	return loadResult.Stack.Spec.AgentTools, true
+}
+
+func runAgentKey(runCtx resolvedRunContext) string {
+	if runCtx.Payload.Agent == nil {
+		return ""
+	}
+	return runCtx.Payload.Agent.Key
+}
+
+func projectToolCapability(tool servicescfg.AgentHTTPTool) ToolCapability {
+	category := ToolCategoryRead
+	if tool.Category == servicescfg.AgentToolCategoryWrite {
+		category = ToolCategoryWrite
+	}
+	approval := ToolApprovalNone
+	switch tool.Approval {
+	case servicescfg.AgentToolApprovalOwner:
+		approval = ToolApprovalOwner
+	case servicescfg.AgentToolApprovalDelegated:
+		approval = ToolApprovalDelegated
+	}
+	inputSchema := tool.InputSchema
+	if inputSchema == nil {
+		inputSchema = map[string]any{"type": "object"}
+	}
+	return ToolCapability{
+		Name:        ToolName(ProjectToolNamePrefix + tool.Name),
+		Description: tool.Description,
+		Category:    category,
+		Approval:    approval,
+		InputSchema: inputSchema,
+	}
+}
+
+func (s *Service) callProjectHTTPTool(ctx context.Context, session SessionContext, name ToolName, payload projectToolPayload) (int, json.RawMessage, error) {
+	if !s.isProjectToolURLAllowed(payload.URL) {
+		return 0, nil, fmt.Errorf("project tool url host is not allowed by control-plane")
+	}
+	body, err := json.Marshal(projectToolCallRequest{
+		Tool:          strings.TrimPrefix(string(name), ProjectToolNamePrefix),
+		RunID:         session.RunID,
+		ProjectID:     session.ProjectID,
+		CorrelationID: session.CorrelationID,
+		Arguments:     payload.Arguments,
+	})
+	if err != nil {
+		return 0, nil, fmt.Errorf("marshal project tool request: %w", err)
+	}
+
+	timeout := time.Duration(payload.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = servicescfg.AgentHTTPToolDefaultTimeoutSeconds * time.Second
+	}
+	callCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(callCtx, http.MethodPost, payload.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, nil, fmt.Errorf("build project tool request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Kodex-Run-Id", session.RunID)
+	req.Header.Set("X-Kodex-Correlation-Id", session.CorrelationID)
+
+	client := *s.projectToolHTTP
+	client.CheckRedirect = s.checkProjectToolRedirect
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, nil, fmt.Errorf("call project tool: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, projectToolResponseMaxBytes+1))
+	if err != nil {
+		return resp.StatusCode, nil, fmt.Errorf("read project tool response: %w", err)
+	}
+	if len(raw) > projectToolResponseMaxBytes {
+		return resp.StatusCode, nil, fmt.Errorf("project tool response exceeds %d bytes", projectToolResponseMaxBytes)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return resp.StatusCode, nil, fmt.Errorf("project tool returned http status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, normalizeProjectToolResponse(raw), nil
+}
+
+// checkProjectToolRedirect re-applies the outbound host allowlist to every redirect hop,
+// so an allowed tool endpoint cannot bounce the callout to cluster-internal addresses.
+func (s *Service) checkProjectToolRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= projectToolMaxRedirects {
+		return fmt.Errorf("project tool exceeded %d redirects", projectToolMaxRedirects)
+	}
+	if !s.isProjectToolURLAllowed(req.URL.String()) {
+		return fmt.Errorf("project tool redirect host is not allowed by control-plane")
+	}
+	return nil
+}
+
+func (s *Service) isProjectToolURLAllowed(rawURL string) bool {
+	parsed, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return false
+	}
+	host := strings.ToLower(parsed.Hostname())
+	if host == "" {
+		return false
+	}
+	for _, allowed := range s.projectToolAllowedHosts {
+		if host == allowed || strings.HasSuffix(host, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+func normalizeProjectToolAllowedHosts(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		host := strings.Trim(strings.ToLower(strings.TrimSpace(value)), ".")
+		if host != "" {
+			out = append(out, host)
+		}
+	}
+	return normalizeDistinctStrings(out)
+}
+
+func normalizeProjectToolArguments(raw json.RawMessage) (json.RawMessage, error) {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return json.RawMessage(`{}`), nil
+	}
+	if len(trimmed) > projectToolArgumentsMaxSize {
+		return nil, fmt.Errorf("project tool arguments exceed %d bytes", projectToolArgumentsMaxSize)
+	}
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal(trimmed, &object); err != nil {
+		return nil, fmt.Errorf("project tool arguments must be a JSON object")
+	}
+	return json.RawMessage(trimmed), nil
+}
+
+func normalizeProjectToolResponse(raw []byte) json.RawMessage {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 {
+		return nil
+	}
+	if json.Valid(trimmed) {
+		return json.RawMessage(trimmed)
+	}
+	return marshalRawJSON(string(trimmed))
+}
+
+func projectToolArgumentsDigest(arguments json.RawMessage) string {
+	sum := sha256.Sum256(arguments)
+	return hex.EncodeToString(sum[:])
+}
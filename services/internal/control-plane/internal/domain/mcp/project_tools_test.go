@@ -0,0 +1,150 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestIsProjectToolURLAllowed(t *testing.T) {
+	t.Parallel()
+
+	svc := &Service{projectToolAllowedHosts: normalizeProjectToolAllowedHosts([]string{" Tools.Example.com. ", "", "internal.svc"})}
+	cases := []struct {
+		url  string
+		want bool
+	}{
+		{url: "https://tools.example.com/run", want: true},
+		{url: "https://api.tools.example.com/run", want: true},
+		{url: "http://hooks.internal.svc:8080/x", want: true},
+		{url: "https://eviltools.example.com/run", want: false},
+		{url: "https://example.com/run", want: false},
+		{url: "not a url", want: false},
+	}
+	for _, tc := range cases {
+		if got := svc.isProjectToolURLAllowed(tc.url); got != tc.want {
+			t.Fatalf("isProjectToolURLAllowed(%q)=%v, want %v", tc.url, got, tc.want)
+		}
+	}
+
+	if (&Service{}).isProjectToolURLAllowed("https://tools.example.com/run") {
+		t.Fatal("empty allowlist must deny every host")
+	}
+}
+
+func TestNormalizeProjectToolArguments(t *testing.T) {
+	t.Parallel()
+
+	for _, raw := range []string{"", "null", "  "} {
+		got, err := normalizeProjectToolArguments(json.RawMessage(raw))
+		if err != nil {
+			t.Fatalf("normalizeProjectToolArguments(%q) error: %v", raw, err)
+		}
+		if string(got) != "{}" {
+			t.Fatalf("normalizeProjectToolArguments(%q)=%s, want {}", raw, got)
+		}
+	}
+	if _, err := normalizeProjectToolArguments(json.RawMessage(`["a"]`)); err == nil {
+		t.Fatal("expected error for non-object arguments")
+	}
+	got, err := normalizeProjectToolArguments(json.RawMessage(` {"query":"x"} `))
+	if err != nil {
+		t.Fatalf("normalizeProjectToolArguments(object) error: %v", err)
+	}
+	if string(got) != `{"query":"x"}` {
+		t.Fatalf("normalizeProjectToolArguments(object)=%s", got)
+	}
+}
+
+func TestCallProjectHTTPTool(t *testing.T) {
+	t.Parallel()
+
+	var received projectToolCallRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Kodex-Run-Id"); got != "run-1" {
+			t.Errorf("X-Kodex-Run-Id=%q, want run-1", got)
+		}
+		body, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &received); err != nil {
+			t.Errorf("decode request: %v", err)
+		}
+		_, _ = w.Write([]byte("plain text result"))
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatalf("parse server url: %v", err)
+	}
+	svc := &Service{
+		projectToolHTTP:         server.Client(),
+		projectToolAllowedHosts: []string{serverURL.Hostname()},
+	}
+	session := SessionContext{RunID: "run-1", ProjectID: "project-1", CorrelationID: "corr-1"}
+
+	status, response, err := svc.callProjectHTTPTool(context.Background(), session, ToolName("project.search_docs"), projectToolPayload{
+		URL:       server.URL + "/search",
+		Arguments: json.RawMessage(`{"query":"deploy"}`),
+	})
+	if err != nil {
+		t.Fatalf("callProjectHTTPTool error: %v", err)
+	}
+	if status != http.StatusOK {
+		t.Fatalf("status=%d, want 200", status)
+	}
+	if string(response) != `"plain text result"` {
+		t.Fatalf("response=%s, want wrapped string", response)
+	}
+	if received.Tool != "search_docs" || received.RunID != "run-1" || string(received.Arguments) != `{"query":"deploy"}` {
+		t.Fatalf("unexpected request payload: %+v", received)
+	}
+
+	svc.projectToolAllowedHosts = []string{"tools.example.com"}
+	if _, _, err := svc.callProjectHTTPTool(context.Background(), session, ToolName("project.search_docs"), projectToolPayload{URL: server.URL}); err == nil {
+		t.Fatal("expected error for host outside allowlist")
+	}
+}
+
+func TestCallProjectHTTPToolRejectsRedirectOutsideAllowlist(t *testing.T) {
+	t.Parallel()
+
+	var internalHits int
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		internalHits++
+		_, _ = w.Write([]byte(`{"secret":"metadata"}`))
+	}))
+	defer internal.Close()
+	internalURL, err := url.Parse(internal.URL)
+	if err != nil {
+		t.Fatalf("parse internal url: %v", err)
+	}
+	// Route the allowed hostname to the redirecting server while the redirect target stays on 127.0.0.1.
+	redirector := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "http://127.0.0.1:"+internalURL.Port()+"/latest/meta-data", http.StatusTemporaryRedirect)
+	}))
+	defer redirector.Close()
+	redirectorURL, err := url.Parse(redirector.URL)
+	if err != nil {
+		t.Fatalf("parse redirector url: %v", err)
+	}
+
+	svc := &Service{
+		projectToolHTTP:         redirector.Client(),
+		projectToolAllowedHosts: []string{"localhost"},
+	}
+	session := SessionContext{RunID: "run-1", ProjectID: "project-1", CorrelationID: "corr-1"}
+	_, _, err = svc.callProjectHTTPTool(context.Background(), session, ToolName("project.search_docs"), projectToolPayload{
+		URL: "http://localhost:" + redirectorURL.Port() + "/search",
+	})
+	if err == nil || !strings.Contains(err.Error(), "redirect host is not allowed") {
+		t.Fatalf("callProjectHTTPTool error = %v, want redirect rejection", err)
+	}
+	if internalHits != 0 {
+		t.Fatalf("redirect target was called %d times", internalHits)
+	}
+}
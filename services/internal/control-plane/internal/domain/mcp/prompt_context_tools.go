@@ -469,6 +469,36 @@ func (s *Service) resolvePromptServicesConfigPath(runCtx resolvedRunContext, ser
 		}
 	}
 
+	return firstExistingServicesConfigPath(candidates)
+}
+
+// resolveTrustedServicesConfigPath resolves services.yaml of the repository default ref only.
+// Run branches are writable by the agent, so configuration that grants tools must not come from them.
+func (s *Service) resolveTrustedServicesConfigPath(runCtx resolvedRunContext, servicesPath string) (string, error) {
+	servicesPath = strings.TrimSpace(servicesPath)
+	if servicesPath == "" {
+		servicesPath = "services.yaml"
+	}
+
+	candidates := make([]string, 0, 3)
+	if filepath.IsAbs(servicesPath) {
+		candidates = append(candidates, servicesPath)
+	}
+	repositoryRoot := strings.TrimSpace(s.cfg.RepositoryRoot)
+	if repositoryRoot != "" {
+		candidates = append(candidates, filepath.Join(repositoryRoot, servicesPath))
+		if owner, name := strings.TrimSpace(runCtx.Repository.Owner), strings.TrimSpace(runCtx.Repository.Name); owner != "" && name != "" {
+			defaultRef := strings.TrimSpace(runCtx.Repository.DefaultRef)
+			if defaultRef == "" {
+				defaultRef = "main"
+			}
+			candidates = append(candidates, filepath.Join(repositoryRoot, "github", owner, name, sanitizePromptRepoRef(defaultRef), servicesPath))
+		}
+	}
+	return firstExistingServicesConfigPath(candidates)
+}
+
+func firstExistingServicesConfigPath(candidates []string) (string, error) {
 	checked := make([]string, 0, len(candidates))
 	seen := make(map[string]struct{}, len(candidates))
 	for _, candidate := range candidates {
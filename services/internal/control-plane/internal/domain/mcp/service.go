@@ -3,6 +3,7 @@ package mcp
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"sort"
 	"strings"
 	"time"
@@ -15,9 +16,12 @@ import (
 	floweventrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/flowevent"
 	interactionrequestrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/interactionrequest"
 	mcpactionrequestrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/mcpactionrequest"
+	mcpapprovalpolicyrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/mcpapprovalpolicy"
 	platformtokenrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/platformtoken"
 	projectdatabaserepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectdatabase"
+	projectmemberrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectmember"
 	repocfgrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/repocfg"
+	userrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/user"
 	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
 	"github.com/golang-jwt/jwt/v5"
 )
@@ -43,6 +47,8 @@ type Config struct {
 	ServicesConfigEnv            string
 	DefaultTokenTTL              time.Duration
 	DatabaseLifecycleAllowedEnvs []string
+	// ProjectToolAllowedHosts limits hosts reachable by project HTTP tools; empty disables them.
+	ProjectToolAllowedHosts []string
 }
 
 // GitHubClient defines GitHub operations used by MCP tools.
@@ -87,6 +93,9 @@ type Service struct {
 	repos                        repocfgrepo.Repository
 	platform                     platformtokenrepo.Repository
 	actions                      mcpactionrequestrepo.Repository
+	approvalPolicies             mcpapprovalpolicyrepo.Repository
+	members                      projectmemberrepo.Repository
+	users                        userrepo.Repository
 	interactions                 interactionrequestrepo.Repository
 	sessions                     agentsessionrepo.Repository
 	projectDatabases             projectdatabaserepo.Repository
@@ -95,6 +104,8 @@ type Service struct {
 	kubernetes                   KubernetesClient
 	database                     DatabaseClient
 	databaseLifecycleAllowedEnvs map[string]struct{}
+	projectToolHTTP              *http.Client
+	projectToolAllowedHosts      []string
 
 	toolCatalog []ToolCapability
 	now         func() time.Time
@@ -107,6 +118,9 @@ type Dependencies struct {
 	Repos            repocfgrepo.Repository
 	Platform         platformtokenrepo.Repository
 	Actions          mcpactionrequestrepo.Repository
+	ApprovalPolicies mcpapprovalpolicyrepo.Repository
+	ProjectMembers   projectmemberrepo.Repository
+	Users            userrepo.Repository
 	Interactions     interactionrequestrepo.Repository
 	Sessions         agentsessionrepo.Repository
 	ProjectDatabases projectdatabaserepo.Repository
@@ -114,6 +128,9 @@ type Dependencies struct {
 	GitHub           GitHubClient
 	Kubernetes       KubernetesClient
 	Database         DatabaseClient
+	// ProjectToolHTTP performs project HTTP tool callouts; http.DefaultClient when nil.
+	// Redirect hops are always re-checked against ProjectToolAllowedHosts.
+	ProjectToolHTTP *http.Client
 }
 
 // NewService creates MCP domain service.
@@ -172,6 +189,15 @@ func NewService(cfg Config, deps Dependencies) (*Service, error) {
 	if deps.Actions == nil {
 		return nil, fmt.Errorf("mcp action requests repository is required")
 	}
+	if deps.ApprovalPolicies == nil {
+		return nil, fmt.Errorf("mcp approval policy repository is required")
+	}
+	if deps.ProjectMembers == nil {
+		return nil, fmt.Errorf("project members repository is required")
+	}
+	if deps.Users == nil {
+		return nil, fmt.Errorf("users repository is required")
+	}
 	if deps.Interactions == nil {
 		return nil, fmt.Errorf("interaction request repository is required")
 	}
@@ -187,6 +213,11 @@ func NewService(cfg Config, deps Dependencies) (*Service, error) {
 
 	databaseAllowedEnvs := normalizeDatabaseLifecycleAllowedEnvs(cfg.DatabaseLifecycleAllowedEnvs)
 
+	projectToolHTTP := deps.ProjectToolHTTP
+	if projectToolHTTP == nil {
+		projectToolHTTP = http.DefaultClient
+	}
+
 	catalog := DefaultToolCatalog()
 	sort.Slice(catalog, func(i, j int) bool { return catalog[i].Name < catalog[j].Name })
 
@@ -197,6 +228,9 @@ func NewService(cfg Config, deps Dependencies) (*Service, error) {
 		repos:                        deps.Repos,
 		platform:                     deps.Platform,
 		actions:                      deps.Actions,
+		approvalPolicies:             deps.ApprovalPolicies,
+		members:                      deps.ProjectMembers,
+		users:                        deps.Users,
 		interactions:                 deps.Interactions,
 		sessions:                     deps.Sessions,
 		projectDatabases:             deps.ProjectDatabases,
@@ -205,6 +239,8 @@ func NewService(cfg Config, deps Dependencies) (*Service, error) {
 		kubernetes:                   deps.Kubernetes,
 		database:                     deps.Database,
 		databaseLifecycleAllowedEnvs: databaseAllowedEnvs,
+		projectToolHTTP:              projectToolHTTP,
+		projectToolAllowedHosts:      normalizeProjectToolAllowedHosts(cfg.ProjectToolAllowedHosts),
 		toolCatalog:                  catalog,
 		now:                          time.Now,
 	}, nil
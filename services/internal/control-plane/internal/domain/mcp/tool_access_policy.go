@@ -86,7 +86,7 @@ func (s *Service) allowedToolsForRunContext(runCtx resolvedRunContext) []ToolCap
 			out = append(out, tool)
 		}
 	}
-	return out
+	return append(out, s.projectToolCapabilities(runCtx)...)
 }
 
 func addAllowedToolNames(allowed map[ToolName]struct{}, names ...ToolName) {
@@ -9,35 +9,42 @@ import (
 )
 
 type (
-	WarmupRequest              = querytypes.MissionControlWarmupRequest
-	WarmupSummary              = valuetypes.MissionControlWarmupSummary
-	ActiveSetQuery             = querytypes.MissionControlActiveSetQuery
-	EntityDetailsQuery         = querytypes.MissionControlEntityDetailsQuery
-	WorkspaceRefreshParams     = querytypes.MissionControlWorkspaceRefreshParams
-	WorkspaceQuery             = querytypes.MissionControlWorkspaceQuery
-	LaunchPreviewParams        = querytypes.MissionControlLaunchPreviewParams
-	SubmitCommandParams        = querytypes.MissionControlSubmitCommandParams
-	ApprovalDecisionParams     = querytypes.MissionControlApprovalDecisionParams
-	CommandQueueParams         = querytypes.MissionControlCommandQueueParams
-	CommandSyncProgressParams  = querytypes.MissionControlCommandSyncProgressParams
-	CommandReconcileParams     = querytypes.MissionControlCommandReconcileParams
-	CommandFailureParams       = querytypes.MissionControlCommandFailureParams
-	CommandCancelParams        = querytypes.MissionControlCommandCancelParams
-	UpsertEntityParams         = missioncontrolrepo.UpsertEntityParams
-	UpdateEntityParams         = missioncontrolrepo.UpdateEntityParams
-	ReplaceRelationsParams     = missioncontrolrepo.ReplaceRelationsParams
-	UpsertTimelineEntryParams  = missioncontrolrepo.UpsertTimelineEntryParams
-	Entity                     = missioncontrolrepo.Entity
-	Relation                   = missioncontrolrepo.Relation
-	TimelineEntry              = missioncontrolrepo.TimelineEntry
-	Command                    = missioncontrolrepo.Command
-	ActiveSet                  = valuetypes.MissionControlActiveSet
-	EntityDetails              = valuetypes.MissionControlEntityDetails
-	WorkspaceProjectionSummary = valuetypes.MissionControlWorkspaceProjectionSummary
-	WorkspaceSnapshot          = valuetypes.MissionControlWorkspaceSnapshot
-	LaunchPreview              = valuetypes.MissionControlLaunchPreview
-	CommandAdmission           = valuetypes.MissionControlCommandAdmission
-	CommandStatusView          = valuetypes.MissionControlCommandStatusView
+	WarmupRequest               = querytypes.MissionControlWarmupRequest
+	WarmupSummary               = valuetypes.MissionControlWarmupSummary
+	ActiveSetQuery              = querytypes.MissionControlActiveSetQuery
+	EntityDetailsQuery          = querytypes.MissionControlEntityDetailsQuery
+	WorkspaceRefreshParams      = querytypes.MissionControlWorkspaceRefreshParams
+	WorkspaceQuery              = querytypes.MissionControlWorkspaceQuery
+	LaunchPreviewParams         = querytypes.MissionControlLaunchPreviewParams
+	SubmitCommandParams         = querytypes.MissionControlSubmitCommandParams
+	ApprovalDecisionParams      = querytypes.MissionControlApprovalDecisionParams
+	CommandQueueParams          = querytypes.MissionControlCommandQueueParams
+	CommandSyncProgressParams   = querytypes.MissionControlCommandSyncProgressParams
+	CommandReconcileParams      = querytypes.MissionControlCommandReconcileParams
+	CommandFailureParams        = querytypes.MissionControlCommandFailureParams
+	CommandCancelParams         = querytypes.MissionControlCommandCancelParams
+	UpsertEntityParams          = missioncontrolrepo.UpsertEntityParams
+	UpdateEntityParams          = missioncontrolrepo.UpdateEntityParams
+	ReplaceRelationsParams      = missioncontrolrepo.ReplaceRelationsParams
+	UpsertTimelineEntryParams   = missioncontrolrepo.UpsertTimelineEntryParams
+	Entity                      = missioncontrolrepo.Entity
+	Relation                    = missioncontrolrepo.Relation
+	TimelineEntry               = missioncontrolrepo.TimelineEntry
+	Command                     = missioncontrolrepo.Command
+	ActiveSet                   = valuetypes.MissionControlActiveSet
+	EntityDetails               = valuetypes.MissionControlEntityDetails
+	WorkspaceProjectionSummary  = valuetypes.MissionControlWorkspaceProjectionSummary
+	WorkspaceSnapshot           = valuetypes.MissionControlWorkspaceSnapshot
+	LaunchPreview               = valuetypes.MissionControlLaunchPreview
+	CommandAdmission            = valuetypes.MissionControlCommandAdmission
+	CommandStatusView           = valuetypes.MissionControlCommandStatusView
+	CreateVoiceCandidateParams  = querytypes.MissionControlCreateVoiceCandidateParams
+	VoiceCandidateListQuery     = querytypes.MissionControlVoiceCandidateListQuery
+	PromoteVoiceCandidateParams = querytypes.MissionControlPromoteVoiceCandidateParams
+	RejectVoiceCandidateParams  = querytypes.MissionControlRejectVoiceCandidateParams
+	VoiceCandidate              = missioncontrolrepo.VoiceCandidate
+	VoiceCandidateView          = valuetypes.MissionControlVoiceCandidateView
+	VoiceCandidatePromotion     = valuetypes.MissionControlVoiceCandidatePromotion
 )
 
 // WarmupExecutor defines the owner-controlled warmup/backfill entry-point that worker wave #371 must execute.
@@ -65,4 +72,9 @@ type DomainService interface {
 	MarkCommandFailed(ctx context.Context, params CommandFailureParams) (Command, error)
 	CancelCommand(ctx context.Context, params CommandCancelParams) (Command, error)
 	ApplyApprovalDecision(ctx context.Context, params ApprovalDecisionParams) (Command, error)
+	CreateVoiceCandidate(ctx context.Context, params CreateVoiceCandidateParams) (VoiceCandidateView, error)
+	ListVoiceCandidates(ctx context.Context, params VoiceCandidateListQuery) ([]VoiceCandidateView, error)
+	GetVoiceCandidate(ctx context.Context, projectID string, candidateID string) (VoiceCandidateView, error)
+	PromoteVoiceCandidate(ctx context.Context, params PromoteVoiceCandidateParams) (VoiceCandidatePromotion, error)
+	RejectVoiceCandidate(ctx context.Context, params RejectVoiceCandidateParams) (VoiceCandidateView, error)
 }
@@ -1381,6 +1381,8 @@ type inMemoryRepository struct {
 	workspaceWatermarks    []missioncontrolrepo.WorkspaceWatermark
 	commandsByID           map[string]Command
 	commandIDByIntent      map[string]string
+	voiceCandidatesByID    map[string]VoiceCandidate
+	nextVoiceCandidateID   int64
 }
 
 func newInMemoryRepository() *inMemoryRepository {
@@ -1391,6 +1393,7 @@ func newInMemoryRepository() *inMemoryRepository {
 		continuityGapsByKey:    make(map[string]missioncontrolrepo.ContinuityGap),
 		commandsByID:           make(map[string]Command),
 		commandIDByIntent:      make(map[string]string),
+		voiceCandidatesByID:    make(map[string]VoiceCandidate),
 	}
 }
 
@@ -1951,6 +1954,75 @@ func (r *inMemoryRepository) GetWarmupSummary(_ context.Context, projectID strin
 	return summary, nil
 }
 
+func (r *inMemoryRepository) CreateVoiceCandidate(_ context.Context, params missioncontrolrepo.CreateVoiceCandidateParams) (VoiceCandidate, bool, error) {
+	for _, existing := range r.voiceCandidatesByID {
+		if existing.ProjectID == params.ProjectID && existing.DedupeKey == params.DedupeKey {
+			return existing, false, nil
+		}
+	}
+	r.nextVoiceCandidateID++
+	candidate := VoiceCandidate{
+		ID:                fmt.Sprintf("voice-%d", r.nextVoiceCandidateID),
+		ProjectID:         params.ProjectID,
+		SourceKind:        params.SourceKind,
+		Status:            enumtypes.MissionControlVoiceCandidateStatusDraft,
+		DedupeKey:         params.DedupeKey,
+		CommandKind:       params.CommandKind,
+		TranscriptExcerpt: params.TranscriptExcerpt,
+		StructuredSummary: params.StructuredSummary,
+		PayloadJSON:       params.PayloadJSON,
+		CreatedBy:         params.CreatedBy,
+		CreatedAt:         params.CreatedAt,
+		UpdatedAt:         params.CreatedAt,
+	}
+	r.voiceCandidatesByID[candidate.ID] = candidate
+	return candidate, true, nil
+}
+
+func (r *inMemoryRepository) GetVoiceCandidate(_ context.Context, projectID string, candidateID string) (VoiceCandidate, bool, error) {
+	candidate, found := r.voiceCandidatesByID[candidateID]
+	if !found || candidate.ProjectID != projectID {
+		return VoiceCandidate{}, false, nil
+	}
+	return candidate, true, nil
+}
+
+func (r *inMemoryRepository) ListVoiceCandidates(_ context.Context, filter missioncontrolrepo.VoiceCandidateListFilter) ([]VoiceCandidate, error) {
+	out := make([]VoiceCandidate, 0, len(r.voiceCandidatesByID))
+	for _, candidate := range r.voiceCandidatesByID {
+		if candidate.ProjectID != filter.ProjectID {
+			continue
+		}
+		if len(filter.Statuses) > 0 && !slices.Contains(filter.Statuses, candidate.Status) {
+			continue
+		}
+		out = append(out, candidate)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID > out[j].ID })
+	if filter.Limit > 0 && len(out) > filter.Limit {
+		out = out[:filter.Limit]
+	}
+	return out, nil
+}
+
+func (r *inMemoryRepository) UpdateVoiceCandidateDecision(_ context.Context, params missioncontrolrepo.VoiceCandidateDecisionParams) (VoiceCandidate, bool, error) {
+	candidate, found := r.voiceCandidatesByID[params.CandidateID]
+	if !found || candidate.ProjectID != params.ProjectID || candidate.Status != enumtypes.MissionControlVoiceCandidateStatusDraft {
+		return VoiceCandidate{}, false, nil
+	}
+	decidedAt := params.DecidedAt
+	candidate.Status = params.Status
+	candidate.CommandKind = params.CommandKind
+	candidate.StructuredSummary = params.StructuredSummary
+	candidate.PayloadJSON = params.PayloadJSON
+	candidate.PromotedCommandID = params.PromotedCommandID
+	candidate.DecidedBy = params.DecidedBy
+	candidate.DecidedAt = &decidedAt
+	candidate.UpdatedAt = decidedAt
+	r.voiceCandidatesByID[candidate.ID] = candidate
+	return candidate, true, nil
+}
+
 type flowEventRecorder struct {
 	items []floweventrepo.InsertParams
 }
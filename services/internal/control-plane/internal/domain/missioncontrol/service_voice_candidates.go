@@ -0,0 +1,519 @@
+package missioncontrol
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	"github.com/google/uuid"
+
+	missioncontrolrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/missioncontrol"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
+)
+
+const (
+	eventTypeMissionControlVoiceCandidateCreated  floweventdomain.EventType = "mission_control.voice_candidate.created"
+	eventTypeMissionControlVoiceCandidatePromoted floweventdomain.EventType = "mission_control.voice_candidate.promoted"
+	eventTypeMissionControlVoiceCandidateRejected floweventdomain.EventType = "mission_control.voice_candidate.rejected"
+
+	voiceCandidateCorrelationPrefix    = "voice-candidate:"
+	voiceCandidateTitleMaxRunes        = 120
+	voiceCandidateExcerptMaxRunes      = 280
+	voiceCandidateTranscriptMaxRunes   = 8000
+	voiceCandidateDefaultListLimit     = 50
+	voiceCandidateShortTranscriptWords = 4
+)
+
+// Transcript markers are matched against lower-cased word prefixes, so stems cover inflected forms.
+var (
+	voiceCandidateDiscussionMarkers = []string{"обсуд", "вопрос", "идея", "идеи", "предлага", "подумать", "discuss", "question", "idea", "propos", "brainstorm"}
+	voiceCandidateWorkItemMarkers   = []string{"сдела", "исправ", "добав", "реализ", "почин", "баг", "ошибк", "задач", "fix", "add", "implement", "bug", "task", "build"}
+)
+
+type voiceCandidateEventPayload struct {
+	ProjectID         string                                           `json:"project_id"`
+	CandidateID       string                                           `json:"candidate_id"`
+	Status            enumtypes.MissionControlVoiceCandidateStatus     `json:"status"`
+	SourceKind        enumtypes.MissionControlVoiceCandidateSourceKind `json:"source_kind"`
+	CommandKind       enumtypes.MissionControlCommandKind              `json:"command_kind"`
+	PromotedCommandID string                                           `json:"promoted_command_id,omitempty"`
+	ActorID           string                                           `json:"actor_id,omitempty"`
+	Confidence        float64                                          `json:"confidence"`
+}
+
+// CreateVoiceCandidate structures one transcript into a draft discussion/work-item command awaiting staff confirmation.
+func (s *Service) CreateVoiceCandidate(ctx context.Context, params CreateVoiceCandidateParams) (VoiceCandidateView, error) {
+	if err := s.ensureVoicePathAllowed(); err != nil {
+		return VoiceCandidateView{}, err
+	}
+	params.ProjectID = strings.TrimSpace(params.ProjectID)
+	params.CreatedBy = strings.TrimSpace(params.CreatedBy)
+	params.DedupeKey = strings.TrimSpace(params.DedupeKey)
+	params.Transcript = truncateRunes(strings.TrimSpace(params.Transcript), voiceCandidateTranscriptMaxRunes, "")
+	if params.ProjectID == "" {
+		return VoiceCandidateView{}, errs.Validation{Field: "project_id", Msg: "is required"}
+	}
+	if params.CreatedBy == "" {
+		return VoiceCandidateView{}, errs.Validation{Field: "created_by", Msg: "is required"}
+	}
+	if params.Transcript == "" {
+		return VoiceCandidateView{}, errs.Validation{Field: "transcript", Msg: "is required"}
+	}
+	if params.SourceKind == "" {
+		params.SourceKind = enumtypes.MissionControlVoiceCandidateSourceKindVoice
+	}
+	if !isVoiceCandidateSourceKind(params.SourceKind) {
+		return VoiceCandidateView{}, errs.Validation{Field: "source_kind", Msg: fmt.Sprintf("unsupported value %q", params.SourceKind)}
+	}
+	if params.DedupeKey == "" {
+		params.DedupeKey = uuid.NewString()
+	}
+	createdAt := params.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = s.now()
+	} else {
+		createdAt = createdAt.UTC()
+	}
+
+	commandKind, draft, confidence := structureVoiceTranscript(params.Transcript)
+	payload := valuetypes.MissionControlVoiceCandidatePayload{
+		Transcript: params.Transcript,
+		Confidence: confidence,
+		Draft:      draft,
+		Source:     params.Source,
+	}
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return VoiceCandidateView{}, fmt.Errorf("marshal mission control voice candidate payload: %w", err)
+	}
+
+	candidate, created, err := s.repository.CreateVoiceCandidate(ctx, missioncontrolrepo.CreateVoiceCandidateParams{
+		ProjectID:         params.ProjectID,
+		SourceKind:        params.SourceKind,
+		DedupeKey:         params.DedupeKey,
+		CommandKind:       commandKind,
+		TranscriptExcerpt: truncateRunes(params.Transcript, voiceCandidateExcerptMaxRunes, "…"),
+		StructuredSummary: voiceCandidateSummary(commandKind, draft.Title),
+		PayloadJSON:       payloadJSON,
+		CreatedBy:         params.CreatedBy,
+		CreatedAt:         createdAt,
+	})
+	if err != nil {
+		return VoiceCandidateView{}, err
+	}
+	view, err := voiceCandidateView(candidate)
+	if err != nil {
+		return VoiceCandidateView{}, err
+	}
+	if created {
+		observeVoiceCandidate(candidate.Status)
+		s.insertVoiceCandidateEvent(ctx, eventTypeMissionControlVoiceCandidateCreated, view, params.CreatedBy)
+	}
+	return view, nil
+}
+
+// ListVoiceCandidates returns voice candidates for one project ordered newest first.
+func (s *Service) ListVoiceCandidates(ctx context.Context, params VoiceCandidateListQuery) ([]VoiceCandidateView, error) {
+	if err := s.ensureReadAllowed(); err != nil {
+		return nil, err
+	}
+	params.ProjectID = strings.TrimSpace(params.ProjectID)
+	if params.ProjectID == "" {
+		return nil, errs.Validation{Field: "project_id", Msg: "is required"}
+	}
+	for _, status := range params.Statuses {
+		if !isVoiceCandidateStatus(status) {
+			return nil, errs.Validation{Field: "statuses", Msg: fmt.Sprintf("unsupported value %q", status)}
+		}
+	}
+	if params.Limit <= 0 {
+		params.Limit = voiceCandidateDefaultListLimit
+	}
+	items, err := s.repository.ListVoiceCandidates(ctx, missioncontrolrepo.VoiceCandidateListFilter{
+		ProjectID: params.ProjectID,
+		Statuses:  params.Statuses,
+		Limit:     params.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	out := make([]VoiceCandidateView, 0, len(items))
+	for _, item := range items {
+		view, viewErr := voiceCandidateView(item)
+		if viewErr != nil {
+			return nil, viewErr
+		}
+		out = append(out, view)
+	}
+	return out, nil
+}
+
+// GetVoiceCandidate loads one voice candidate scoped to one project.
+func (s *Service) GetVoiceCandidate(ctx context.Context, projectID string, candidateID string) (VoiceCandidateView, error) {
+	if err := s.ensureReadAllowed(); err != nil {
+		return VoiceCandidateView{}, err
+	}
+	return s.loadVoiceCandidate(ctx, projectID, candidateID)
+}
+
+// PromoteVoiceCandidate submits the confirmed (optionally edited) draft as a Mission Control command.
+func (s *Service) PromoteVoiceCandidate(ctx context.Context, params PromoteVoiceCandidateParams) (VoiceCandidatePromotion, error) {
+	if err := s.ensureVoicePathAllowed(); err != nil {
+		return VoiceCandidatePromotion{}, err
+	}
+	params.ActorID = strings.TrimSpace(params.ActorID)
+	if params.ActorID == "" {
+		return VoiceCandidatePromotion{}, errs.Validation{Field: "actor_id", Msg: "is required"}
+	}
+	view, err := s.loadVoiceCandidate(ctx, params.ProjectID, params.CandidateID)
+	if err != nil {
+		return VoiceCandidatePromotion{}, err
+	}
+	candidate := view.Candidate
+	switch candidate.Status {
+	case enumtypes.MissionControlVoiceCandidateStatusDraft:
+	case enumtypes.MissionControlVoiceCandidateStatusPromoted:
+		return s.existingVoiceCandidatePromotion(ctx, view)
+	default:
+		return VoiceCandidatePromotion{}, errs.Conflict{Msg: fmt.Sprintf("mission control voice candidate is %s", candidate.Status)}
+	}
+
+	commandKind := candidate.CommandKind
+	if params.CommandKind != "" {
+		commandKind = params.CommandKind
+	}
+	if !isVoiceCandidateCommandKind(commandKind) {
+		return VoiceCandidatePromotion{}, errs.Validation{Field: "command_kind", Msg: fmt.Sprintf("unsupported value %q", commandKind)}
+	}
+	draft := view.Payload.Draft
+	if title := strings.TrimSpace(params.Title); title != "" {
+		draft.Title = title
+	}
+	if body := strings.TrimSpace(params.BodyMarkdown); body != "" {
+		draft.BodyMarkdown = body
+	}
+	if params.InitialLabels != nil {
+		draft.InitialLabels = normalizeVoiceCandidateLabels(params.InitialLabels)
+	}
+	if commandKind != enumtypes.MissionControlCommandKindWorkItemCreate {
+		draft.InitialLabels = nil
+	}
+
+	requestedAt := params.RequestedAt
+	if requestedAt.IsZero() {
+		requestedAt = s.now()
+	} else {
+		requestedAt = requestedAt.UTC()
+	}
+	intentKey := voiceCandidateCorrelationPrefix + candidate.ID
+	admission, err := s.SubmitCommand(ctx, SubmitCommandParams{
+		ProjectID:         candidate.ProjectID,
+		ActorID:           params.ActorID,
+		CorrelationID:     intentKey,
+		CommandKind:       commandKind,
+		BusinessIntentKey: intentKey,
+		Payload:           voiceCandidateCommandPayload(commandKind, draft),
+		RequestedAt:       requestedAt,
+	})
+	if err != nil {
+		// A previous promotion attempt may have created the command without closing the candidate.
+		var duplicate DuplicateIntentError
+		if !errors.As(err, &duplicate) {
+			return VoiceCandidatePromotion{}, err
+		}
+		admission = CommandAdmission{Command: duplicate.ExistingCommand}
+	}
+
+	payload := view.Payload
+	payload.Draft = draft
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return VoiceCandidatePromotion{}, fmt.Errorf("marshal mission control voice candidate payload: %w", err)
+	}
+	updated, ok, err := s.repository.UpdateVoiceCandidateDecision(ctx, missioncontrolrepo.VoiceCandidateDecisionParams{
+		ProjectID:         candidate.ProjectID,
+		CandidateID:       candidate.ID,
+		Status:            enumtypes.MissionControlVoiceCandidateStatusPromoted,
+		CommandKind:       commandKind,
+		StructuredSummary: voiceCandidateSummary(commandKind, draft.Title),
+		PayloadJSON:       payloadJSON,
+		PromotedCommandID: admission.Command.ID,
+		DecidedBy:         params.ActorID,
+		DecidedAt:         requestedAt,
+	})
+	if err != nil {
+		return VoiceCandidatePromotion{}, err
+	}
+	if !ok {
+		return VoiceCandidatePromotion{}, errs.Conflict{Msg: "mission control voice candidate was decided concurrently"}
+	}
+	updatedView, err := voiceCandidateView(updated)
+	if err != nil {
+		return VoiceCandidatePromotion{}, err
+	}
+	observeVoiceCandidate(updated.Status)
+	s.insertVoiceCandidateEvent(ctx, eventTypeMissionControlVoiceCandidatePromoted, updatedView, params.ActorID)
+	return VoiceCandidatePromotion{Candidate: updatedView, Admission: admission}, nil
+}
+
+// RejectVoiceCandidate closes one draft voice candidate without submitting a command.
+func (s *Service) RejectVoiceCandidate(ctx context.Context, params RejectVoiceCandidateParams) (VoiceCandidateView, error) {
+	if err := s.ensureVoicePathAllowed(); err != nil {
+		return VoiceCandidateView{}, err
+	}
+	params.ActorID = strings.TrimSpace(params.ActorID)
+	if params.ActorID == "" {
+		return VoiceCandidateView{}, errs.Validation{Field: "actor_id", Msg: "is required"}
+	}
+	view, err := s.loadVoiceCandidate(ctx, params.ProjectID, params.CandidateID)
+	if err != nil {
+		return VoiceCandidateView{}, err
+	}
+	candidate := view.Candidate
+	switch candidate.Status {
+	case enumtypes.MissionControlVoiceCandidateStatusDraft:
+	case enumtypes.MissionControlVoiceCandidateStatusRejected:
+		return view, nil
+	default:
+		return VoiceCandidateView{}, errs.Conflict{Msg: fmt.Sprintf("mission control voice candidate is %s", candidate.Status)}
+	}
+
+	rejectedAt := params.RejectedAt
+	if rejectedAt.IsZero() {
+		rejectedAt = s.now()
+	} else {
+		rejectedAt = rejectedAt.UTC()
+	}
+	payload := view.Payload
+	payload.RejectReason = strings.TrimSpace(params.Reason)
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return VoiceCandidateView{}, fmt.Errorf("marshal mission control voice candidate payload: %w", err)
+	}
+	updated, ok, err := s.repository.UpdateVoiceCandidateDecision(ctx, missioncontrolrepo.VoiceCandidateDecisionParams{
+		ProjectID:         candidate.ProjectID,
+		CandidateID:       candidate.ID,
+		Status:            enumtypes.MissionControlVoiceCandidateStatusRejected,
+		CommandKind:       candidate.CommandKind,
+		StructuredSummary: candidate.StructuredSummary,
+		PayloadJSON:       payloadJSON,
+		DecidedBy:         params.ActorID,
+		DecidedAt:         rejectedAt,
+	})
+	if err != nil {
+		return VoiceCandidateView{}, err
+	}
+	if !ok {
+		return VoiceCandidateView{}, errs.Conflict{Msg: "mission control voice candidate was decided concurrently"}
+	}
+	updatedView, err := voiceCandidateView(updated)
+	if err != nil {
+		return VoiceCandidateView{}, err
+	}
+	observeVoiceCandidate(updated.Status)
+	s.insertVoiceCandidateEvent(ctx, eventTypeMissionControlVoiceCandidateRejected, updatedView, params.ActorID)
+	return updatedView, nil
+}
+
+func (s *Service) ensureVoicePathAllowed() error {
+	caps, err := s.capabilities()
+	if err != nil {
+		return err
+	}
+	if !caps.CanUseVoicePath {
+		return errs.FailedPrecondition{Msg: "mission control voice path disabled"}
+	}
+	return nil
+}
+
+func (s *Service) loadVoiceCandidate(ctx context.Context, projectID string, candidateID string) (VoiceCandidateView, error) {
+	projectID = strings.TrimSpace(projectID)
+	candidateID = strings.TrimSpace(candidateID)
+	if projectID == "" {
+		return VoiceCandidateView{}, errs.Validation{Field: "project_id", Msg: "is required"}
+	}
+	if candidateID == "" {
+		return VoiceCandidateView{}, errs.Validation{Field: "candidate_id", Msg: "is required"}
+	}
+	candidate, found, err := s.repository.GetVoiceCandidate(ctx, projectID, candidateID)
+	if err != nil {
+		return VoiceCandidateView{}, err
+	}
+	if !found {
+		return VoiceCandidateView{}, errs.NotFound{Msg: "mission control voice candidate not found"}
+	}
+	return voiceCandidateView(candidate)
+}
+
+func (s *Service) existingVoiceCandidatePromotion(ctx context.Context, view VoiceCandidateView) (VoiceCandidatePromotion, error) {
+	out := VoiceCandidatePromotion{Candidate: view}
+	if view.Candidate.PromotedCommandID == "" {
+		return out, nil
+	}
+	command, found, err := s.repository.GetCommandByID(ctx, view.Candidate.ProjectID, view.Candidate.PromotedCommandID)
+	if err != nil {
+		return VoiceCandidatePromotion{}, err
+	}
+	if found {
+		out.Admission = CommandAdmission{Command: command}
+	}
+	return out, nil
+}
+
+func (s *Service) insertVoiceCandidateEvent(ctx context.Context, eventType floweventdomain.EventType, view VoiceCandidateView, actorID string) {
+	s.insertFlowEvent(ctx, voiceCandidateCorrelationPrefix+view.Candidate.ID, eventType, voiceCandidateEventPayload{
+		ProjectID:         view.Candidate.ProjectID,
+		CandidateID:       view.Candidate.ID,
+		Status:            view.Candidate.Status,
+		SourceKind:        view.Candidate.SourceKind,
+		CommandKind:       view.Candidate.CommandKind,
+		PromotedCommandID: view.Candidate.PromotedCommandID,
+		ActorID:           actorID,
+		Confidence:        view.Payload.Confidence,
+	})
+}
+
+func voiceCandidateView(candidate VoiceCandidate) (VoiceCandidateView, error) {
+	view := VoiceCandidateView{Candidate: candidate}
+	if len(candidate.PayloadJSON) == 0 {
+		return view, nil
+	}
+	if err := json.Unmarshal(candidate.PayloadJSON, &view.Payload); err != nil {
+		return VoiceCandidateView{}, fmt.Errorf("decode mission control voice candidate payload: %w", err)
+	}
+	return view, nil
+}
+
+// structureVoiceTranscript maps one free-form transcript onto a draft command.
+// Discussion markers win only when they outnumber work-item markers: voice notes are usually requests for work.
+func structureVoiceTranscript(transcript string) (enumtypes.MissionControlCommandKind, valuetypes.MissionControlVoiceCandidateDraft, float64) {
+	words := strings.FieldsFunc(strings.ToLower(transcript), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	discussionHits := countVoiceMarkerHits(words, voiceCandidateDiscussionMarkers)
+	workItemHits := countVoiceMarkerHits(words, voiceCandidateWorkItemMarkers)
+
+	commandKind := enumtypes.MissionControlCommandKindWorkItemCreate
+	if discussionHits > workItemHits || strings.HasSuffix(strings.TrimSpace(transcript), "?") && workItemHits == 0 {
+		commandKind = enumtypes.MissionControlCommandKindDiscussionCreate
+	}
+
+	confidence := 0.5
+	switch {
+	case len(words) < voiceCandidateShortTranscriptWords:
+		confidence = 0.3
+	case discussionHits > 0 && workItemHits > 0:
+		confidence = 0.6
+	case discussionHits > 0 || workItemHits > 0:
+		confidence = 0.8
+	}
+
+	return commandKind, valuetypes.MissionControlVoiceCandidateDraft{
+		Title:        voiceCandidateTitle(transcript),
+		BodyMarkdown: transcript,
+	}, confidence
+}
+
+func countVoiceMarkerHits(words []string, markers []string) int {
+	hits := 0
+	for _, word := range words {
+		for _, marker := range markers {
+			if strings.HasPrefix(word, marker) {
+				hits++
+				break
+			}
+		}
+	}
+	return hits
+}
+
+func voiceCandidateTitle(transcript string) string {
+	title := strings.TrimSpace(transcript)
+	if idx := strings.IndexAny(title, ".!?\n"); idx > 0 {
+		title = title[:idx]
+	}
+	title = strings.Join(strings.Fields(title), " ")
+	if title == "" {
+		return ""
+	}
+	first, size := utf8.DecodeRuneInString(title)
+	title = string(unicode.ToUpper(first)) + title[size:]
+	return truncateRunes(title, voiceCandidateTitleMaxRunes, "…")
+}
+
+func voiceCandidateSummary(commandKind enumtypes.MissionControlCommandKind, title string) string {
+	return string(commandKind) + ": " + title
+}
+
+func voiceCandidateCommandPayload(commandKind enumtypes.MissionControlCommandKind, draft valuetypes.MissionControlVoiceCandidateDraft) valuetypes.MissionControlCommandPayload {
+	if commandKind == enumtypes.MissionControlCommandKindDiscussionCreate {
+		return valuetypes.MissionControlCommandPayload{DiscussionCreate: &valuetypes.MissionControlDiscussionCreatePayload{
+			Title:        draft.Title,
+			BodyMarkdown: draft.BodyMarkdown,
+		}}
+	}
+	return valuetypes.MissionControlCommandPayload{WorkItemCreate: &valuetypes.MissionControlWorkItemCreatePayload{
+		Title:         draft.Title,
+		BodyMarkdown:  draft.BodyMarkdown,
+		InitialLabels: draft.InitialLabels,
+	}}
+}
+
+func normalizeVoiceCandidateLabels(labels []string) []string {
+	out := make([]string, 0, len(labels))
+	seen := make(map[string]struct{}, len(labels))
+	for _, label := range labels {
+		trimmed := strings.TrimSpace(label)
+		if trimmed == "" {
+			continue
+		}
+		if _, ok := seen[trimmed]; ok {
+			continue
+		}
+		seen[trimmed] = struct{}{}
+		out = append(out, trimmed)
+	}
+	return out
+}
+
+func truncateRunes(value string, limit int, suffix string) string {
+	if utf8.RuneCountInString(value) <= limit {
+		return value
+	}
+	runes := []rune(value)
+	return strings.TrimSpace(string(runes[:limit-utf8.RuneCountInString(suffix)])) + suffix
+}
+
+func isVoiceCandidateCommandKind(kind enumtypes.MissionControlCommandKind) bool {
+	return kind == enumtypes.MissionControlCommandKindDiscussionCreate || kind == enumtypes.MissionControlCommandKindWorkItemCreate
+}
+
+func isVoiceCandidateSourceKind(kind enumtypes.MissionControlVoiceCandidateSourceKind) bool {
+	switch kind {
+	case enumtypes.MissionControlVoiceCandidateSourceKindVoice,
+		enumtypes.MissionControlVoiceCandidateSourceKindAudioUpload,
+		enumtypes.MissionControlVoiceCandidateSourceKindTranscript:
+		return true
+	default:
+		return false
+	}
+}
+
+func isVoiceCandidateStatus(status enumtypes.MissionControlVoiceCandidateStatus) bool {
+	switch status {
+	case enumtypes.MissionControlVoiceCandidateStatusDraft,
+		enumtypes.MissionControlVoiceCandidateStatusPromoted,
+		enumtypes.MissionControlVoiceCandidateStatusRejected,
+		enumtypes.MissionControlVoiceCandidateStatusExpired:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,20 @@
+package missioncontrol
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
+
+var voiceCandidatesTotal = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "kodex_mission_control_voice_candidates_total",
+		Help: "Total Mission Control voice candidates grouped by lifecycle status transition.",
+	},
+	[]string{"status"},
+)
+
+func observeVoiceCandidate(status enumtypes.MissionControlVoiceCandidateStatus) {
+	voiceCandidatesTotal.WithLabelValues(string(status)).Inc()
+}
@@ -0,0 +1,187 @@
+package missioncontrol
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
+)
+
+func TestStructureVoiceTranscript(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		transcript string
+		wantKind   enumtypes.MissionControlCommandKind
+		wantTitle  string
+	}{
+		{
+			name:       "work item by default",
+			transcript: "Нужно исправить падение логина на мобильных. Воспроизводится после обновления.",
+			wantKind:   enumtypes.MissionControlCommandKindWorkItemCreate,
+			wantTitle:  "Нужно исправить падение логина на мобильных",
+		},
+		{
+			name:       "discussion markers",
+			transcript: "давай обсудим идею с тёмной темой для консоли",
+			wantKind:   enumtypes.MissionControlCommandKindDiscussionCreate,
+			wantTitle:  "Давай обсудим идею с тёмной темой для консоли",
+		},
+		{
+			name:       "plain question",
+			transcript: "Should the billing page stay public?",
+			wantKind:   enumtypes.MissionControlCommandKindDiscussionCreate,
+			wantTitle:  "Should the billing page stay public",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			kind, draft, confidence := structureVoiceTranscript(tc.transcript)
+			if kind != tc.wantKind {
+				t.Fatalf("kind = %q, want %q", kind, tc.wantKind)
+			}
+			if draft.Title != tc.wantTitle {
+				t.Fatalf("title = %q, want %q", draft.Title, tc.wantTitle)
+			}
+			if draft.BodyMarkdown != tc.transcript {
+				t.Fatalf("body must keep the full transcript, got %q", draft.BodyMarkdown)
+			}
+			if confidence <= 0 || confidence > 1 {
+				t.Fatalf("confidence out of range: %v", confidence)
+			}
+		})
+	}
+}
+
+func TestVoiceCandidatePromoteSubmitsEditedCommand(t *testing.T) {
+	t.Parallel()
+
+	svc, repo, events, _ := newTestService(t, valuetypes.MissionControlRolloutState{SchemaReady: true, DomainReady: true})
+	ctx := context.Background()
+
+	created, err := svc.CreateVoiceCandidate(ctx, CreateVoiceCandidateParams{
+		ProjectID:  "project-1",
+		Transcript: "Добавить экспорт отчётов в CSV. Нужен для финансов.",
+		DedupeKey:  "telegram:100:5",
+		CreatedBy:  "telegram:42",
+	})
+	if err != nil {
+		t.Fatalf("CreateVoiceCandidate() error = %v", err)
+	}
+	if created.Candidate.Status != enumtypes.MissionControlVoiceCandidateStatusDraft ||
+		created.Candidate.CommandKind != enumtypes.MissionControlCommandKindWorkItemCreate ||
+		created.Payload.Draft.Title != "Добавить экспорт отчётов в CSV" {
+		t.Fatalf("unexpected draft candidate: %+v", created)
+	}
+
+	again, err := svc.CreateVoiceCandidate(ctx, CreateVoiceCandidateParams{
+		ProjectID:  "project-1",
+		Transcript: "Добавить экспорт отчётов в CSV. Нужен для финансов.",
+		DedupeKey:  "telegram:100:5",
+		CreatedBy:  "telegram:42",
+	})
+	if err != nil || again.Candidate.ID != created.Candidate.ID {
+		t.Fatalf("redelivered voice note must return the same candidate, got %+v err=%v", again.Candidate, err)
+	}
+
+	promotion, err := svc.PromoteVoiceCandidate(ctx, PromoteVoiceCandidateParams{
+		ProjectID:     "project-1",
+		CandidateID:   created.Candidate.ID,
+		ActorID:       "staff-1",
+		CommandKind:   enumtypes.MissionControlCommandKindDiscussionCreate,
+		Title:         "Экспорт отчётов в CSV",
+		InitialLabels: []string{"kind:feature"},
+	})
+	if err != nil {
+		t.Fatalf("PromoteVoiceCandidate() error = %v", err)
+	}
+	command := promotion.Admission.Command
+	if command.CommandKind != enumtypes.MissionControlCommandKindDiscussionCreate || command.BusinessIntentKey != "voice-candidate:"+created.Candidate.ID {
+		t.Fatalf("unexpected promoted command: %+v", command)
+	}
+	if promotion.Candidate.Candidate.Status != enumtypes.MissionControlVoiceCandidateStatusPromoted ||
+		promotion.Candidate.Candidate.PromotedCommandID != command.ID ||
+		promotion.Candidate.Payload.Draft.Title != "Экспорт отчётов в CSV" ||
+		len(promotion.Candidate.Payload.Draft.InitialLabels) != 0 {
+		t.Fatalf("unexpected promoted candidate: %+v", promotion.Candidate)
+	}
+	var payload valuetypes.MissionControlCommandPayload
+	if err := json.Unmarshal(command.PayloadJSON, &payload); err != nil {
+		t.Fatalf("decode command payload: %v", err)
+	}
+	if payload.DiscussionCreate == nil || payload.DiscussionCreate.Title != "Экспорт отчётов в CSV" {
+		t.Fatalf("unexpected command payload: %+v", payload)
+	}
+
+	repeated, err := svc.PromoteVoiceCandidate(ctx, PromoteVoiceCandidateParams{ProjectID: "project-1", CandidateID: created.Candidate.ID, ActorID: "staff-1"})
+	if err != nil || repeated.Admission.Command.ID != command.ID {
+		t.Fatalf("repeated promote must be idempotent, got %+v err=%v", repeated.Admission.Command, err)
+	}
+	if len(repo.commandsByID) != 1 {
+		t.Fatalf("expected exactly one command, got %d", len(repo.commandsByID))
+	}
+
+	_, err = svc.RejectVoiceCandidate(ctx, RejectVoiceCandidateParams{ProjectID: "project-1", CandidateID: created.Candidate.ID, ActorID: "staff-1"})
+	var conflict errs.Conflict
+	if !errors.As(err, &conflict) {
+		t.Fatalf("rejecting a promoted candidate must conflict, got %v", err)
+	}
+
+	eventTypes := make([]floweventdomain.EventType, 0, len(events.items))
+	for _, item := range events.items {
+		eventTypes = append(eventTypes, item.EventType)
+	}
+	if !slices.Contains(eventTypes, eventTypeMissionControlVoiceCandidateCreated) || !slices.Contains(eventTypes, eventTypeMissionControlVoiceCandidatePromoted) {
+		t.Fatalf("expected voice candidate flow events, got %v", eventTypes)
+	}
+}
+
+func TestVoiceCandidateRejectAndRolloutGuard(t *testing.T) {
+	t.Parallel()
+
+	svc, _, _, _ := newTestService(t, valuetypes.MissionControlRolloutState{SchemaReady: true, DomainReady: true})
+	ctx := context.Background()
+	created, err := svc.CreateVoiceCandidate(ctx, CreateVoiceCandidateParams{
+		ProjectID:  "project-1",
+		Transcript: "Обсудить план релиза",
+		CreatedBy:  "telegram:42",
+	})
+	if err != nil {
+		t.Fatalf("CreateVoiceCandidate() error = %v", err)
+	}
+	rejected, err := svc.RejectVoiceCandidate(ctx, RejectVoiceCandidateParams{
+		ProjectID:   "project-1",
+		CandidateID: created.Candidate.ID,
+		ActorID:     "staff-1",
+		Reason:      "duplicate",
+	})
+	if err != nil {
+		t.Fatalf("RejectVoiceCandidate() error = %v", err)
+	}
+	if rejected.Candidate.Status != enumtypes.MissionControlVoiceCandidateStatusRejected || rejected.Payload.RejectReason != "duplicate" {
+		t.Fatalf("unexpected rejected candidate: %+v", rejected)
+	}
+	drafts, err := svc.ListVoiceCandidates(ctx, VoiceCandidateListQuery{
+		ProjectID: "project-1",
+		Statuses:  []enumtypes.MissionControlVoiceCandidateStatus{enumtypes.MissionControlVoiceCandidateStatusDraft},
+	})
+	if err != nil || len(drafts) != 0 {
+		t.Fatalf("rejected candidate must leave the draft list, got %d err=%v", len(drafts), err)
+	}
+
+	disabled, _, _, _ := newTestService(t, valuetypes.MissionControlRolloutState{})
+	_, err = disabled.CreateVoiceCandidate(ctx, CreateVoiceCandidateParams{ProjectID: "project-1", Transcript: "x", CreatedBy: "telegram:42"})
+	var precondition errs.FailedPrecondition
+	if !errors.As(err, &precondition) {
+		t.Fatalf("voice path must be gated by rollout state, got %v", err)
+	}
+}
@@ -0,0 +1,134 @@
+package projectrbac
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
+
+var qualifierPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_.:-]{0,127}$`)
+
+// qualifiedPermissions accept an optional qualifier that narrows the grant to one tool, stage or command kind.
+var qualifiedPermissions = []enumtypes.ProjectPermission{
+	enumtypes.ProjectPermissionApprovalsResolve,
+	enumtypes.ProjectPermissionNextStepExecute,
+	enumtypes.ProjectPermissionMissionControlCommand,
+}
+
+var readPermissions = []string{
+	string(enumtypes.ProjectPermissionProjectRead),
+	string(enumtypes.ProjectPermissionRuntimeErrorsManage),
+	string(enumtypes.ProjectPermissionMissionControlCommand),
+}
+
+var writePermissions = append(slices.Clone(readPermissions),
+	string(enumtypes.ProjectPermissionRunsCancel),
+	string(enumtypes.ProjectPermissionRunsTrigger),
+	string(enumtypes.ProjectPermissionRepositoriesManage),
+	string(enumtypes.ProjectPermissionGitHubTokensManage),
+	string(enumtypes.ProjectPermissionChangeGovernanceManage),
+)
+
+// builtinRoles keep exactly the access the legacy read/read_write/admin roles had,
+// so existing memberships migrate without behavior change.
+var builtinRoles = map[enumtypes.ProjectRoleBuiltin][]string{
+	enumtypes.ProjectRoleBuiltinRead:      readPermissions,
+	enumtypes.ProjectRoleBuiltinReadWrite: writePermissions,
+	enumtypes.ProjectRoleBuiltinAdmin:     writePermissions,
+}
+
+// BuiltinRoleNames lists built-in roles in stable order.
+var BuiltinRoleNames = []enumtypes.ProjectRoleBuiltin{
+	enumtypes.ProjectRoleBuiltinRead,
+	enumtypes.ProjectRoleBuiltinReadWrite,
+	enumtypes.ProjectRoleBuiltinAdmin,
+}
+
+// BuiltinPermissions returns grants of a built-in role.
+func BuiltinPermissions(role string) ([]string, bool) {
+	grants, ok := builtinRoles[enumtypes.ProjectRoleBuiltin(strings.TrimSpace(role))]
+	if !ok {
+		return nil, false
+	}
+	return slices.Clone(grants), true
+}
+
+// IsBuiltinRole reports whether role name is reserved by a built-in role.
+func IsBuiltinRole(role string) bool {
+	_, ok := builtinRoles[enumtypes.ProjectRoleBuiltin(strings.TrimSpace(role))]
+	return ok
+}
+
+// IsQualified reports whether permission accepts a qualifier.
+func IsQualified(permission enumtypes.ProjectPermission) bool {
+	return slices.Contains(qualifiedPermissions, permission)
+}
+
+// NormalizeGrants validates grants against the catalog and removes duplicates.
+func NormalizeGrants(grants []string) ([]string, error) {
+	out := make([]string, 0, len(grants))
+	for _, grant := range grants {
+		grant = strings.ToLower(strings.TrimSpace(grant))
+		permission, qualifier, hasQualifier := strings.Cut(grant, ":")
+		if !slices.Contains(enumtypes.ProjectPermissions, enumtypes.ProjectPermission(permission)) {
+			return nil, fmt.Errorf("unknown permission %q", grant)
+		}
+		if hasQualifier {
+			if !IsQualified(enumtypes.ProjectPermission(permission)) {
+				return nil, fmt.Errorf("permission %q does not accept qualifier", permission)
+			}
+			if !qualifierPattern.MatchString(qualifier) {
+				return nil, fmt.Errorf("invalid qualifier in %q", grant)
+			}
+		}
+		if !slices.Contains(out, grant) {
+			out = append(out, grant)
+		}
+	}
+	slices.Sort(out)
+	return out, nil
+}
+
+// PermissionSet is an effective set of grants of one project member.
+type PermissionSet struct {
+	grants []string
+}
+
+// NewPermissionSet wraps grants; grants are matched case-insensitively.
+func NewPermissionSet(grants []string) PermissionSet {
+	out := make([]string, 0, len(grants))
+	for _, grant := range grants {
+		out = append(out, strings.ToLower(strings.TrimSpace(grant)))
+	}
+	return PermissionSet{grants: out}
+}
+
+// Allows reports whether set grants permission for qualifier.
+// An unqualified grant covers every qualifier; a qualified grant covers only its own qualifier.
+func (p PermissionSet) Allows(permission enumtypes.ProjectPermission, qualifier string) bool {
+	if slices.Contains(p.grants, string(permission)) {
+		return true
+	}
+	qualifier = strings.ToLower(strings.TrimSpace(qualifier))
+	if qualifier == "" || !IsQualified(permission) {
+		return false
+	}
+	return slices.Contains(p.grants, string(permission)+":"+qualifier)
+}
+
+// Grants returns a copy of the underlying grants.
+func (p PermissionSet) Grants() []string {
+	return slices.Clone(p.grants)
+}
+
+// AllPermissions returns a set granting the whole catalog, used for platform admins.
+func AllPermissions() PermissionSet {
+	grants := make([]string, 0, len(enumtypes.ProjectPermissions))
+	for _, permission := range enumtypes.ProjectPermissions {
+		grants = append(grants, string(permission))
+	}
+	return PermissionSet{grants: grants}
+}
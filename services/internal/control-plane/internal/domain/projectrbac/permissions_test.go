@@ -0,0 +1,73 @@
+package projectrbac
+
+import (
+	"slices"
+	"testing"
+
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
+
+func TestNormalizeGrants(t *testing.T) {
+	t.Parallel()
+
+	got, err := NormalizeGrants([]string{" Approvals.Resolve:kubernetes_manifest_apply ", "project.read", "project.read", "next_step.execute:run:doc-audit"})
+	if err != nil {
+		t.Fatalf("NormalizeGrants() error = %v", err)
+	}
+	want := []string{"approvals.resolve:kubernetes_manifest_apply", "next_step.execute:run:doc-audit", "project.read"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("NormalizeGrants() = %#v, want %#v", got, want)
+	}
+
+	for _, invalid := range []string{"project.write", "project.read:anything", "mission_control.command:", "runs.cancel:x"} {
+		if _, err := NormalizeGrants([]string{invalid}); err == nil {
+			t.Fatalf("NormalizeGrants(%q) error = nil, want validation error", invalid)
+		}
+	}
+}
+
+func TestPermissionSetAllows(t *testing.T) {
+	t.Parallel()
+
+	set := NewPermissionSet([]string{"project.read", "next_step.execute:run:doc-audit", "mission_control.command"})
+	cases := []struct {
+		permission enumtypes.ProjectPermission
+		qualifier  string
+		want       bool
+	}{
+		{enumtypes.ProjectPermissionProjectRead, "", true},
+		{enumtypes.ProjectPermissionRepositoriesManage, "", false},
+		{enumtypes.ProjectPermissionNextStepExecute, "run:doc-audit", true},
+		{enumtypes.ProjectPermissionNextStepExecute, "run:dev", false},
+		{enumtypes.ProjectPermissionNextStepExecute, "", false},
+		{enumtypes.ProjectPermissionMissionControlCommand, "work_item.create", true},
+	}
+	for _, tc := range cases {
+		if got := set.Allows(tc.permission, tc.qualifier); got != tc.want {
+			t.Fatalf("Allows(%s, %q) = %v, want %v", tc.permission, tc.qualifier, got, tc.want)
+		}
+	}
+}
+
+func TestBuiltinRolesKeepLegacyAccess(t *testing.T) {
+	t.Parallel()
+
+	read, _ := BuiltinPermissions("read")
+	readSet := NewPermissionSet(read)
+	if !readSet.Allows(enumtypes.ProjectPermissionProjectRead, "") || readSet.Allows(enumtypes.ProjectPermissionRepositoriesManage, "") {
+		t.Fatalf("read role grants = %#v", read)
+	}
+	for _, role := range []string{"read_write", "admin"} {
+		grants, ok := BuiltinPermissions(role)
+		if !ok {
+			t.Fatalf("BuiltinPermissions(%q) not found", role)
+		}
+		set := NewPermissionSet(grants)
+		if !set.Allows(enumtypes.ProjectPermissionRunsTrigger, "") || !set.Allows(enumtypes.ProjectPermissionRepositoriesManage, "") {
+			t.Fatalf("%s role grants = %#v", role, grants)
+		}
+		if set.Allows(enumtypes.ProjectPermissionApprovalsResolve, "kubernetes_manifest_apply") {
+			t.Fatalf("%s role must not resolve approvals", role)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package projectrbac
+
+import (
+	"context"
+	"strings"
+
+	projectmemberrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectmember"
+	projectrolerepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectrole"
+)
+
+// Resolver computes effective project permissions from membership role.
+type Resolver struct {
+	members projectmemberrepo.Repository
+	roles   projectrolerepo.Repository
+}
+
+// NewResolver constructs permission resolver; roles may be nil when custom roles are not configured.
+func NewResolver(members projectmemberrepo.Repository, roles projectrolerepo.Repository) *Resolver {
+	return &Resolver{members: members, roles: roles}
+}
+
+// Resolve returns member role and its permissions; ok is false when user is not a project member.
+// A custom role that no longer exists resolves to an empty permission set.
+func (r *Resolver) Resolve(ctx context.Context, projectID string, userID string) (role string, permissions PermissionSet, ok bool, err error) {
+	role, ok, err = r.members.GetRole(ctx, projectID, userID)
+	if err != nil || !ok {
+		return "", PermissionSet{}, ok, err
+	}
+	role = strings.TrimSpace(role)
+	if grants, builtin := BuiltinPermissions(role); builtin {
+		return role, NewPermissionSet(grants), true, nil
+	}
+	if r.roles == nil {
+		return role, PermissionSet{}, true, nil
+	}
+	custom, found, err := r.roles.GetByName(ctx, projectID, role)
+	if err != nil {
+		return "", PermissionSet{}, false, err
+	}
+	if !found {
+		return role, PermissionSet{}, true, nil
+	}
+	return role, NewPermissionSet(custom.Permissions), true, nil
+}
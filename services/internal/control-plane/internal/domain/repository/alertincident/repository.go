@@ -0,0 +1,28 @@
+package alertincident
+
+import (
+	"context"
+
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+type (
+	Incident           = entitytypes.AlertIncident
+	UpsertFiringParams = querytypes.AlertIncidentUpsertFiringParams
+	UpsertFiringResult = querytypes.AlertIncidentUpsertFiringResult
+	MarkResolvedParams = querytypes.AlertIncidentMarkResolvedParams
+	SetIssueParams     = querytypes.AlertIncidentSetIssueParams
+)
+
+// Repository stores Alertmanager incidents deduplicated by project and fingerprint.
+type Repository interface {
+	// UpsertFiring opens a new incident, re-opens a resolved one or refreshes an already firing one.
+	UpsertFiring(ctx context.Context, params UpsertFiringParams) (UpsertFiringResult, error)
+	// MarkResolved resolves a firing incident; found is false when incident is unknown or already resolved.
+	MarkResolved(ctx context.Context, params MarkResolvedParams) (Incident, bool, error)
+	// SetIssue links incident to its GitHub issue.
+	SetIssue(ctx context.Context, params SetIssueParams) error
+	// SetLastRun stores the latest ops run started for incident.
+	SetLastRun(ctx context.Context, incidentID string, runID string) error
+}
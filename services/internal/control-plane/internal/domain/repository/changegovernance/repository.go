@@ -25,5 +25,8 @@ type Repository interface {
 	RecordDraftSignal(ctx context.Context, params querytypes.ChangeGovernanceDraftSignalParams) (Aggregate, bool, error)
 	PublishWaveMap(ctx context.Context, params querytypes.ChangeGovernanceWaveMapParams) (Aggregate, error)
 	UpsertEvidenceSignal(ctx context.Context, params querytypes.ChangeGovernanceEvidenceSignalParams) (Aggregate, error)
+	RecordDecision(ctx context.Context, params querytypes.ChangeGovernanceDecisionParams) (Aggregate, error)
+	RecordFeedback(ctx context.Context, params querytypes.ChangeGovernanceFeedbackParams) (Aggregate, error)
 	GetAggregateByPackageID(ctx context.Context, packageID string) (Aggregate, bool, error)
+	GetLatestPackageByPullRequest(ctx context.Context, repositoryFullName string, prNumber int) (Package, bool, error)
 }
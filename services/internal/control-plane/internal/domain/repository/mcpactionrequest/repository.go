@@ -2,6 +2,7 @@ package mcpactionrequest
 
 import (
 	"context"
+	"time"
 
 	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
 	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
@@ -11,6 +12,7 @@ type (
 	Item              = entitytypes.MCPActionRequest
 	CreateParams      = querytypes.MCPActionRequestCreateParams
 	UpdateStateParams = querytypes.MCPActionRequestUpdateStateParams
+	AppendVoteParams  = querytypes.MCPActionRequestAppendVoteParams
 	ApprovalState     = entitytypes.MCPApprovalState
 )
 
@@ -27,5 +29,15 @@ type Repository interface {
 	// ListPending returns pending approval queue.
 	ListPending(ctx context.Context, limit int) ([]Item, error)
 	// UpdateState updates approval state and returns updated row.
+	// ok is false when request is missing or its state differs from params.FromState.
 	UpdateState(ctx context.Context, params UpdateStateParams) (Item, bool, error)
+	// AppendVote atomically records one vote on a requested action.
+	// ok is false when request is no longer requested or the same user has already voted.
+	AppendVote(ctx context.Context, params AppendVoteParams) (Item, bool, error)
+	// ListExpired returns requested actions whose policy expiry is not later than now.
+	ListExpired(ctx context.Context, now time.Time, limit int) ([]Item, error)
+	// MarkRunSuspended stamps the latest requested/approved action of a run as awaiting a resume run.
+	// An approval-gated action already resolved without a stamp is matched as well.
+	// ok is false when the run has no open or unseen resolved approval request.
+	MarkRunSuspended(ctx context.Context, runID string, suspendedAt time.Time) (Item, bool, error)
 }
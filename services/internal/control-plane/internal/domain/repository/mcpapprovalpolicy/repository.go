@@ -0,0 +1,25 @@
+package mcpapprovalpolicy
+
+import (
+	"context"
+
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+type (
+	Policy       = entitytypes.MCPApprovalPolicy
+	UpsertParams = querytypes.MCPApprovalPolicyUpsertParams
+)
+
+// Repository persists per-project multi-approver policies for MCP tools.
+type Repository interface {
+	// Upsert creates policy or replaces rules for the same project, tool and environment.
+	Upsert(ctx context.Context, params UpsertParams) (Policy, error)
+	// List returns project policies ordered by tool and environment.
+	List(ctx context.Context, projectID string) ([]Policy, error)
+	// Match returns environment-specific policy first and falls back to the environment-agnostic one.
+	Match(ctx context.Context, projectID string, toolName string, environment string) (Policy, bool, error)
+	// Delete removes one project policy; returns false when nothing was deleted.
+	Delete(ctx context.Context, projectID string, id string) (bool, error)
+}
@@ -38,6 +38,10 @@ type (
 	CommandListFilter              = querytypes.MissionControlCommandListFilter
 	GlobalCommandListFilter        = querytypes.MissionControlGlobalCommandListFilter
 	WarmupSummary                  = valuetypes.MissionControlWarmupSummary
+	VoiceCandidate                 = entitytypes.MissionControlVoiceCandidate
+	CreateVoiceCandidateParams     = querytypes.MissionControlVoiceCandidateCreateParams
+	VoiceCandidateListFilter       = querytypes.MissionControlVoiceCandidateListFilter
+	VoiceCandidateDecisionParams   = querytypes.MissionControlVoiceCandidateDecisionParams
 )
 
 // Repository persists Mission Control projection foundation under control-plane ownership.
@@ -84,4 +88,12 @@ type Repository interface {
 	UpdateCommandStatus(ctx context.Context, params UpdateCommandStatusParams) (Command, bool, error)
 	// GetWarmupSummary returns aggregate counts used by worker warmup verification.
 	GetWarmupSummary(ctx context.Context, projectID string) (WarmupSummary, error)
+	// CreateVoiceCandidate inserts one voice candidate or returns the existing row for the same dedupe key.
+	CreateVoiceCandidate(ctx context.Context, params CreateVoiceCandidateParams) (VoiceCandidate, bool, error)
+	// GetVoiceCandidate loads one voice candidate by id scoped to one project.
+	GetVoiceCandidate(ctx context.Context, projectID string, candidateID string) (VoiceCandidate, bool, error)
+	// ListVoiceCandidates returns voice candidates for one project ordered newest first.
+	ListVoiceCandidates(ctx context.Context, filter VoiceCandidateListFilter) ([]VoiceCandidate, error)
+	// UpdateVoiceCandidateDecision moves one draft voice candidate into a terminal status.
+	UpdateVoiceCandidateDecision(ctx context.Context, params VoiceCandidateDecisionParams) (VoiceCandidate, bool, error)
 }
@@ -11,6 +11,8 @@ type (
 	Project         = entitytypes.Project
 	UpsertParams    = querytypes.ProjectUpsertParams
 	ProjectWithRole = entitytypes.ProjectWithRole
+	AlertPolicy     = querytypes.ProjectAlertPolicy
+	AutoAdvance     = querytypes.ProjectAutoAdvancePolicy
 )
 
 // Repository stores and loads projects.
@@ -30,4 +32,8 @@ type Repository interface {
 
 	// GetLearningModeDefault returns effective project default learning-mode flag.
 	GetLearningModeDefault(ctx context.Context, projectID string) (enabled bool, ok bool, err error)
+	// GetAlertPolicy returns project Alertmanager incident policy; ok is false for unknown project.
+	GetAlertPolicy(ctx context.Context, projectID string) (policy AlertPolicy, ok bool, err error)
+	// GetAutoAdvancePolicy returns project stage auto-advance rules; ok is false for unknown project.
+	GetAutoAdvancePolicy(ctx context.Context, projectID string) (policy AutoAdvance, ok bool, err error)
 }
@@ -14,6 +14,9 @@ type Repository interface {
 	List(ctx context.Context, projectID string, limit int) ([]Member, error)
 	// Upsert sets role for a user in a project.
 	Upsert(ctx context.Context, projectID string, userID string, role string) error
+	// SyncOIDCRoles replaces memberships granted by OIDC group mapping with roles keyed by project slug.
+	// Manually managed memberships are never changed; unknown slugs are ignored.
+	SyncOIDCRoles(ctx context.Context, userID string, rolesByProjectSlug map[string]string) error
 	// Delete removes a user from a project.
 	Delete(ctx context.Context, projectID string, userID string) error
 	// GetRole returns membership role for a user in a project.
@@ -0,0 +1,25 @@
+package projectrole
+
+import (
+	"context"
+
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+type (
+	Role         = entitytypes.ProjectRole
+	UpsertParams = querytypes.ProjectRoleUpsertParams
+)
+
+// Repository persists custom project roles.
+type Repository interface {
+	// Upsert creates role or replaces its description and permissions.
+	Upsert(ctx context.Context, params UpsertParams) (Role, error)
+	// List returns custom roles of one project ordered by name.
+	List(ctx context.Context, projectID string) ([]Role, error)
+	// GetByName returns one custom role of a project.
+	GetByName(ctx context.Context, projectID string, name string) (Role, bool, error)
+	// Delete removes role unless it is still assigned to a member; returns false when nothing was deleted.
+	Delete(ctx context.Context, projectID string, name string) (bool, error)
+}
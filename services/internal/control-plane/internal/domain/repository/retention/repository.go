@@ -0,0 +1,42 @@
+package retention
+
+import (
+	"context"
+
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
+)
+
+type (
+	Policy              = entitytypes.RetentionPolicy
+	UpsertPolicyParams  = querytypes.RetentionPolicyUpsertParams
+	ClaimPoliciesParams = querytypes.RetentionPolicyClaimParams
+	CompleteSweepParams = querytypes.RetentionPolicySweepCompleteParams
+	ExpiredRowsParams   = querytypes.RetentionExpiredRowsParams
+	ExpiredRow          = valuetypes.RetentionExpiredRow
+)
+
+// Repository persists retention policies and removes expired platform history rows.
+type Repository interface {
+	// ListPolicies returns global policies and, when projectID is set, overrides of that project.
+	// Empty projectID returns every policy.
+	ListPolicies(ctx context.Context, projectID string) ([]Policy, error)
+	// UpsertPolicy creates or replaces policy for target + scope.
+	UpsertPolicy(ctx context.Context, params UpsertPolicyParams) (Policy, error)
+	// DeletePolicy removes one project override; global policies cannot be deleted.
+	DeletePolicy(ctx context.Context, policyID string) (bool, error)
+	// ClaimDuePolicies leases enabled policies that were not swept within sweep interval.
+	ClaimDuePolicies(ctx context.Context, params ClaimPoliciesParams) ([]Policy, error)
+	// CompletePolicySweep records sweep outcome and releases lease held by worker.
+	CompletePolicySweep(ctx context.Context, params CompleteSweepParams) error
+	// ListOverrideProjectIDs returns projects that have own policy for target.
+	ListOverrideProjectIDs(ctx context.Context, target enumtypes.RetentionTarget) ([]string, error)
+	// ListExpiredRows returns one batch of expired rows, oldest first.
+	ListExpiredRows(ctx context.Context, params ExpiredRowsParams) ([]ExpiredRow, error)
+	// RemoveRows deletes rows (or clears log payloads for agent_run_logs) and returns affected count.
+	RemoveRows(ctx context.Context, target enumtypes.RetentionTarget, ids []string) (int64, error)
+	// SetFlowEventPinned toggles retention pin of one flow event.
+	SetFlowEventPinned(ctx context.Context, eventID int64, pinned bool) (bool, error)
+}
@@ -0,0 +1,19 @@
+package runoutcome
+
+import (
+	"context"
+
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
+)
+
+type (
+	AnalyticsParams = querytypes.RunOutcomeAnalyticsParams
+	Group           = valuetypes.RunOutcomeGroup
+)
+
+// Repository derives run outcome facts from agent_runs, agent_sessions and flow_events.
+type Repository interface {
+	// ListGroups aggregates issue/PR lineages by requested grouping keys, largest groups first.
+	ListGroups(ctx context.Context, params AnalyticsParams) ([]Group, error)
+}
@@ -8,15 +8,20 @@ import (
 )
 
 type (
-	Item             = entitytypes.RuntimeError
-	ListFilter       = querytypes.RuntimeErrorListFilter
-	RecordParams     = querytypes.RuntimeErrorRecordParams
-	MarkViewedParams = querytypes.RuntimeErrorMarkViewedParams
+	Item                  = entitytypes.RuntimeError
+	ListFilter            = querytypes.RuntimeErrorListFilter
+	RecordParams          = querytypes.RuntimeErrorRecordParams
+	MarkViewedParams      = querytypes.RuntimeErrorMarkViewedParams
+	Group                 = entitytypes.RuntimeErrorGroup
+	GroupListFilter       = querytypes.RuntimeErrorGroupListFilter
+	GroupStatusParams     = querytypes.RuntimeErrorGroupStatusParams
+	GroupEscalationParams = querytypes.RuntimeErrorGroupEscalationParams
 )
 
 // Repository stores runtime error journal entries.
 type Repository interface {
-	// Insert appends one runtime error entry.
+	// Insert appends one runtime error entry and upserts its fingerprint group.
+	// Resolved groups and groups with expired mute are reopened by the new occurrence.
 	Insert(ctx context.Context, params RecordParams) (Item, error)
 	// ListAll returns runtime errors for platform admin scope.
 	ListAll(ctx context.Context, filter ListFilter) ([]Item, error)
@@ -26,4 +31,14 @@ type Repository interface {
 	GetByID(ctx context.Context, id string) (Item, bool, error)
 	// MarkViewed marks runtime error as viewed by user and returns updated row.
 	MarkViewed(ctx context.Context, params MarkViewedParams) (Item, bool, error)
+	// ListGroups returns runtime error groups for platform admin scope.
+	ListGroups(ctx context.Context, filter GroupListFilter) ([]Group, error)
+	// ListGroupsForUser returns runtime error groups visible to projects where user is member.
+	ListGroupsForUser(ctx context.Context, userID string, filter GroupListFilter) ([]Group, error)
+	// GetGroupByID returns one runtime error group by id.
+	GetGroupByID(ctx context.Context, id string) (Group, bool, error)
+	// UpdateGroupStatus changes open/muted/resolved state of one group.
+	UpdateGroupStatus(ctx context.Context, params GroupStatusParams) (Group, bool, error)
+	// SetGroupEscalation stores escalation outcome of one group.
+	SetGroupEscalation(ctx context.Context, params GroupEscalationParams) (Group, bool, error)
 }
@@ -0,0 +1,28 @@
+package staffapitoken
+
+import (
+	"context"
+
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+type (
+	Token        = entitytypes.StaffAPIToken
+	CreateParams = querytypes.StaffAPITokenCreateParams
+	ListFilter   = querytypes.StaffAPITokenListFilter
+)
+
+// Repository persists hashed staff API tokens.
+type Repository interface {
+	// Create stores a new token.
+	Create(ctx context.Context, params CreateParams) (Token, error)
+	// List returns tokens ordered by creation time, newest first.
+	List(ctx context.Context, filter ListFilter) ([]Token, error)
+	// Revoke marks token revoked; returns false when token is missing or already revoked.
+	Revoke(ctx context.Context, tokenID string) (bool, error)
+	// GetActiveByHash returns token that is neither revoked nor expired.
+	GetActiveByHash(ctx context.Context, tokenHash []byte) (Token, bool, error)
+	// TouchLastUsed records token usage; writes are throttled to once per minute to keep hot paths cheap.
+	TouchLastUsed(ctx context.Context, tokenID string) error
+}
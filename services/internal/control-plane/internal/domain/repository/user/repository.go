@@ -22,6 +22,17 @@ type Repository interface {
 	UpdateGitHubIdentity(ctx context.Context, userID string, githubUserID int64, githubLogin string) error
 	// CreateAllowedUser creates a new allowed user (without OAuth identity yet).
 	CreateAllowedUser(ctx context.Context, email string, isPlatformAdmin bool) (User, error)
+	// GetByOIDCIdentity returns user linked to OIDC issuer and subject.
+	GetByOIDCIdentity(ctx context.Context, issuer string, subject string) (User, bool, error)
+	// UpdateOIDCIdentity links OIDC issuer and subject to a user.
+	// ok is false when the user is already linked to another OIDC identity.
+	UpdateOIDCIdentity(ctx context.Context, userID string, issuer string, subject string) (ok bool, err error)
+	// SyncOIDCPlatformAdmin grants or revokes platform admin flag managed by OIDC group mapping.
+	// Manually granted admin flag is never revoked; returns effective admin flag.
+	SyncOIDCPlatformAdmin(ctx context.Context, userID string, grant bool) (bool, error)
+	// CreateServiceAccount creates a machine user authenticated only by staff API tokens.
+	// ok is false when email is already taken.
+	CreateServiceAccount(ctx context.Context, email string, isPlatformAdmin bool) (user User, ok bool, err error)
 	// List returns all users.
 	List(ctx context.Context, limit int) ([]User, error)
 	// DeleteByID removes a user by id.
@@ -0,0 +1,293 @@
+package retention
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/blobstore"
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	retentionrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/retention"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+const (
+	defaultPolicyLimit   = 20
+	defaultBatchSize     = 500
+	maxBatchSize         = 5000
+	defaultMaxBatches    = 20
+	defaultSweepInterval = time.Hour
+	defaultLeaseTTL      = 10 * time.Minute
+	archiveKeyPrefix     = "retention-archive/"
+	errNoArchiveStore    = "archive action requires configured blob store"
+)
+
+// DefaultPinnedFlowEventTypes are audit-critical flow events never removed by retention.
+var DefaultPinnedFlowEventTypes = []floweventdomain.EventType{
+	floweventdomain.EventTypeApprovalRequested,
+	floweventdomain.EventTypeApprovalApproved,
+	floweventdomain.EventTypeApprovalDenied,
+	floweventdomain.EventTypeApprovalExpired,
+	floweventdomain.EventTypeApprovalFailed,
+	floweventdomain.EventTypeApprovalApplied,
+	floweventdomain.EventTypeApprovalVoteRecorded,
+	floweventdomain.EventTypeQualityGovernanceDecisionRecorded,
+	floweventdomain.EventTypeRunMCPTokenIssued,
+}
+
+// Config controls retention sweep limits.
+type Config struct {
+	// PinnedFlowEventTypes overrides DefaultPinnedFlowEventTypes when not empty.
+	PinnedFlowEventTypes []string
+}
+
+// Dependencies wires retention sweep collaborators.
+type Dependencies struct {
+	Repository retentionrepo.Repository
+	// Archive stores compressed JSONL archives; nil disables archive action.
+	Archive blobstore.Store
+	Logger  *slog.Logger
+}
+
+// SweepParams defines one worker-triggered retention sweep.
+type SweepParams struct {
+	WorkerID      string
+	PolicyLimit   int
+	BatchSize     int
+	MaxBatches    int
+	SweepInterval time.Duration
+	LeaseTTL      time.Duration
+}
+
+// PolicySweepResult reports outcome of one policy within sweep.
+type PolicySweepResult struct {
+	PolicyID    string
+	ProjectID   string
+	Target      enumtypes.RetentionTarget
+	Action      enumtypes.RetentionAction
+	Removed     int64
+	ArchiveKeys []string
+	// HasMore reports that batch limit was reached before all expired rows were removed.
+	HasMore bool
+	Error   string
+}
+
+// SweepResult aggregates policy outcomes of one sweep.
+type SweepResult struct {
+	Policies []PolicySweepResult
+}
+
+// Service deletes or archives expired platform history rows according to retention policies.
+type Service struct {
+	repo             retentionrepo.Repository
+	archive          blobstore.Store
+	logger           *slog.Logger
+	pinnedEventTypes []string
+	now              func() time.Time
+}
+
+// NewService constructs retention sweep service.
+func NewService(cfg Config, deps Dependencies) (*Service, error) {
+	if deps.Repository == nil {
+		return nil, fmt.Errorf("retention repository is required")
+	}
+	logger := deps.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	pinned := normalizeEventTypes(cfg.PinnedFlowEventTypes)
+	if len(pinned) == 0 {
+		for _, eventType := range DefaultPinnedFlowEventTypes {
+			pinned = append(pinned, string(eventType))
+		}
+	}
+	return &Service{
+		repo:             deps.Repository,
+		archive:          deps.Archive,
+		logger:           logger,
+		pinnedEventTypes: pinned,
+		now:              time.Now,
+	}, nil
+}
+
+// RunSweep claims due policies and removes expired rows in batches.
+// Policy failures are recorded on the policy row and in result; only claim failures are returned.
+func (s *Service) RunSweep(ctx context.Context, params SweepParams) (SweepResult, error) {
+	params = normalizeSweepParams(params)
+	policies, err := s.repo.ClaimDuePolicies(ctx, querytypes.RetentionPolicyClaimParams{
+		WorkerID:      params.WorkerID,
+		LeaseTTL:      params.LeaseTTL,
+		SweepInterval: params.SweepInterval,
+		Limit:         params.PolicyLimit,
+	})
+	if err != nil {
+		return SweepResult{}, fmt.Errorf("claim retention policies: %w", err)
+	}
+
+	result := SweepResult{Policies: make([]PolicySweepResult, 0, len(policies))}
+	for _, policy := range policies {
+		item := s.sweepPolicy(ctx, policy, params)
+		archiveKey := ""
+		if len(item.ArchiveKeys) > 0 {
+			archiveKey = item.ArchiveKeys[len(item.ArchiveKeys)-1]
+		}
+		if err := s.repo.CompletePolicySweep(ctx, querytypes.RetentionPolicySweepCompleteParams{
+			PolicyID:     policy.ID,
+			WorkerID:     params.WorkerID,
+			RemovedCount: item.Removed,
+			ArchiveKey:   archiveKey,
+			Drained:      !item.HasMore,
+			Error:        item.Error,
+		}); err != nil {
+			s.logger.Warn("complete retention policy sweep failed", "policy_id", policy.ID, "target", policy.Target, "err", err)
+		}
+		result.Policies = append(result.Policies, item)
+	}
+	return result, nil
+}
+
+func (s *Service) sweepPolicy(ctx context.Context, policy retentionrepo.Policy, params SweepParams) PolicySweepResult {
+	item := PolicySweepResult{
+		PolicyID:  policy.ID,
+		ProjectID: policy.ProjectID,
+		Target:    policy.Target,
+		Action:    policy.Action,
+	}
+	if policy.Action == enumtypes.RetentionActionArchive && s.archive == nil {
+		item.Error = errNoArchiveStore
+		return item
+	}
+
+	var excluded []string
+	if policy.ProjectID == "" {
+		ids, err := s.repo.ListOverrideProjectIDs(ctx, policy.Target)
+		if err != nil {
+			item.Error = err.Error()
+			return item
+		}
+		excluded = ids
+	}
+
+	now := s.now().UTC()
+	cutoff := now.AddDate(0, 0, -policy.RetentionDays)
+	for batchNo := 0; batchNo < params.MaxBatches; batchNo++ {
+		rows, err := s.repo.ListExpiredRows(ctx, querytypes.RetentionExpiredRowsParams{
+			Target:             policy.Target,
+			ProjectID:          policy.ProjectID,
+			ExcludedProjectIDs: excluded,
+			PinnedEventTypes:   s.pinnedEventTypes,
+			Cutoff:             cutoff,
+			Limit:              params.BatchSize,
+		})
+		if err != nil {
+			item.Error = err.Error()
+			return item
+		}
+		if len(rows) == 0 {
+			return item
+		}
+
+		if policy.Action == enumtypes.RetentionActionArchive {
+			key := archiveKey(policy, now, batchNo)
+			if err := s.writeArchive(ctx, key, rows); err != nil {
+				item.Error = err.Error()
+				return item
+			}
+			item.ArchiveKeys = append(item.ArchiveKeys, key)
+		}
+
+		ids := make([]string, 0, len(rows))
+		for _, row := range rows {
+			ids = append(ids, row.ID)
+		}
+		removed, err := s.repo.RemoveRows(ctx, policy.Target, ids)
+		if err != nil {
+			item.Error = err.Error()
+			return item
+		}
+		item.Removed += removed
+		if len(rows) < params.BatchSize {
+			return item
+		}
+	}
+	item.HasMore = true
+	return item
+}
+
+// writeArchive stores rows as gzip-compressed JSON Lines document.
+func (s *Service) writeArchive(ctx context.Context, key string, rows []retentionrepo.ExpiredRow) error {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	for _, row := range rows {
+		line := bytes.TrimSpace(row.RowJSON)
+		if len(line) == 0 {
+			line = []byte(`{}`)
+		}
+		if _, err := writer.Write(append(line, '\n')); err != nil {
+			return fmt.Errorf("compress retention archive: %w", err)
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("compress retention archive: %w", err)
+	}
+	if err := s.archive.Put(ctx, key, buf.Bytes()); err != nil {
+		return fmt.Errorf("store retention archive %s: %w", key, err)
+	}
+	return nil
+}
+
+// archiveKey builds retention-archive/<target>/<scope>/<yyyy>/<mm>/<dd>/<policy>-<unix>-<batch>.jsonl.gz.
+func archiveKey(policy retentionrepo.Policy, now time.Time, batchNo int) string {
+	scope := "global"
+	if policy.ProjectID != "" {
+		scope = "projects/" + policy.ProjectID
+	}
+	return fmt.Sprintf(
+		"%s%s/%s/%s/%s-%d-%03d.jsonl.gz",
+		archiveKeyPrefix,
+		policy.Target,
+		scope,
+		now.Format("2006/01/02"),
+		policy.ID,
+		now.Unix(),
+		batchNo,
+	)
+}
+
+func normalizeSweepParams(params SweepParams) SweepParams {
+	params.WorkerID = strings.TrimSpace(params.WorkerID)
+	if params.PolicyLimit <= 0 {
+		params.PolicyLimit = defaultPolicyLimit
+	}
+	if params.BatchSize <= 0 {
+		params.BatchSize = defaultBatchSize
+	}
+	if params.BatchSize > maxBatchSize {
+		params.BatchSize = maxBatchSize
+	}
+	if params.MaxBatches <= 0 {
+		params.MaxBatches = defaultMaxBatches
+	}
+	if params.SweepInterval <= 0 {
+		params.SweepInterval = defaultSweepInterval
+	}
+	if params.LeaseTTL <= 0 {
+		params.LeaseTTL = defaultLeaseTTL
+	}
+	return params
+}
+
+func normalizeEventTypes(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		if trimmed := strings.TrimSpace(value); trimmed != "" {
+			out = append(out, trimmed)
+		}
+	}
+	return out
+}
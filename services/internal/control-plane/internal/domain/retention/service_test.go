@@ -0,0 +1,236 @@
+package retention
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/blobstore"
+	retentionrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/retention"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
+
+func TestService_RunSweep_ArchivesBeforeRemoving(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRetentionRepository(retentionrepo.Policy{
+		ID:            "policy-1",
+		Target:        enumtypes.RetentionTargetFlowEvents,
+		RetentionDays: 30,
+		Action:        enumtypes.RetentionActionArchive,
+		Enabled:       true,
+	}, 3)
+	repo.overrideProjectIDs = []string{"project-keep"}
+	store, err := blobstore.NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFilesystemStore() error = %v", err)
+	}
+	svc := newTestService(t, repo, store)
+
+	result, err := svc.RunSweep(context.Background(), SweepParams{WorkerID: "worker-1", BatchSize: 2})
+	if err != nil {
+		t.Fatalf("RunSweep() error = %v", err)
+	}
+	if len(result.Policies) != 1 {
+		t.Fatalf("policies = %d, want 1", len(result.Policies))
+	}
+	item := result.Policies[0]
+	if item.Error != "" {
+		t.Fatalf("policy error = %q", item.Error)
+	}
+	if item.Removed != 3 || item.HasMore {
+		t.Fatalf("removed=%d has_more=%v, want 3/false", item.Removed, item.HasMore)
+	}
+	if len(item.ArchiveKeys) != 2 {
+		t.Fatalf("archive keys = %v, want 2 batches", item.ArchiveKeys)
+	}
+	wantPrefix := "retention-archive/flow_events/global/2026/03/27/policy-1-"
+	if !strings.HasPrefix(item.ArchiveKeys[0], wantPrefix) || !strings.HasSuffix(item.ArchiveKeys[0], "-000.jsonl.gz") {
+		t.Fatalf("archive key = %q, want prefix %q", item.ArchiveKeys[0], wantPrefix)
+	}
+
+	archived, err := store.Get(context.Background(), item.ArchiveKeys[0])
+	if err != nil {
+		t.Fatalf("Get(archive) error = %v", err)
+	}
+	if got := archiveLines(t, archived); len(got) != 2 || got[0] != `{"id":"1"}` {
+		t.Fatalf("archive lines = %v", got)
+	}
+
+	if got, want := strings.Join(repo.lastExcluded, ","), "project-keep"; got != want {
+		t.Fatalf("excluded projects = %q, want %q", got, want)
+	}
+	if len(repo.lastPinnedTypes) != len(DefaultPinnedFlowEventTypes) {
+		t.Fatalf("pinned types = %v, want defaults", repo.lastPinnedTypes)
+	}
+	if got, want := repo.lastCutoff, time.Date(2026, 2, 25, 12, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Fatalf("cutoff = %s, want %s", got, want)
+	}
+	if len(repo.completed) != 1 || !repo.completed[0].Drained || repo.completed[0].RemovedCount != 3 {
+		t.Fatalf("completed = %+v, want drained with 3 rows", repo.completed)
+	}
+	if repo.completed[0].ArchiveKey != item.ArchiveKeys[1] {
+		t.Fatalf("completed archive key = %q, want last key %q", repo.completed[0].ArchiveKey, item.ArchiveKeys[1])
+	}
+}
+
+func TestService_RunSweep_ArchiveWithoutStoreKeepsRows(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRetentionRepository(retentionrepo.Policy{
+		ID:            "policy-1",
+		Target:        enumtypes.RetentionTargetAgentRunLogs,
+		RetentionDays: 7,
+		Action:        enumtypes.RetentionActionArchive,
+		Enabled:       true,
+	}, 5)
+	svc := newTestService(t, repo, nil)
+
+	result, err := svc.RunSweep(context.Background(), SweepParams{WorkerID: "worker-1"})
+	if err != nil {
+		t.Fatalf("RunSweep() error = %v", err)
+	}
+	if got := result.Policies[0].Error; got != errNoArchiveStore {
+		t.Fatalf("policy error = %q, want %q", got, errNoArchiveStore)
+	}
+	if len(repo.rows) != 5 {
+		t.Fatalf("rows left = %d, want 5", len(repo.rows))
+	}
+	if len(repo.completed) != 1 || repo.completed[0].Error != errNoArchiveStore {
+		t.Fatalf("completed = %+v, want recorded error", repo.completed)
+	}
+}
+
+func TestService_RunSweep_BatchLimitLeavesPolicyDue(t *testing.T) {
+	t.Parallel()
+
+	repo := newFakeRetentionRepository(retentionrepo.Policy{
+		ID:            "policy-1",
+		ProjectID:     "project-1",
+		Target:        enumtypes.RetentionTargetInteractionDeliveryAttempts,
+		RetentionDays: 90,
+		Action:        enumtypes.RetentionActionDelete,
+		Enabled:       true,
+	}, 10)
+	svc := newTestService(t, repo, nil)
+
+	result, err := svc.RunSweep(context.Background(), SweepParams{WorkerID: "worker-1", BatchSize: 3, MaxBatches: 2})
+	if err != nil {
+		t.Fatalf("RunSweep() error = %v", err)
+	}
+	item := result.Policies[0]
+	if item.Removed != 6 || !item.HasMore {
+		t.Fatalf("removed=%d has_more=%v, want 6/true", item.Removed, item.HasMore)
+	}
+	if len(item.ArchiveKeys) != 0 {
+		t.Fatalf("archive keys = %v, want none for delete action", item.ArchiveKeys)
+	}
+	if repo.completed[0].Drained {
+		t.Fatal("expected policy to stay due when batch limit is reached")
+	}
+	if repo.overrideCalls != 0 {
+		t.Fatalf("override lookups = %d, want 0 for project policy", repo.overrideCalls)
+	}
+}
+
+func newTestService(t *testing.T, repo *fakeRetentionRepository, store blobstore.Store) *Service {
+	t.Helper()
+
+	svc, err := NewService(Config{}, Dependencies{Repository: repo, Archive: store})
+	if err != nil {
+		t.Fatalf("NewService() error = %v", err)
+	}
+	svc.now = func() time.Time { return time.Date(2026, 3, 27, 12, 0, 0, 0, time.UTC) }
+	return svc
+}
+
+func archiveLines(t *testing.T, data []byte) []string {
+	t.Helper()
+
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("gzip.NewReader() error = %v", err)
+	}
+	defer func() { _ = reader.Close() }()
+
+	var out []string
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		out = append(out, scanner.Text())
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatalf("scan archive: %v", err)
+	}
+	return out
+}
+
+type fakeRetentionRepository struct {
+	retentionrepo.Repository
+
+	policies           []retentionrepo.Policy
+	rows               []retentionrepo.ExpiredRow
+	overrideProjectIDs []string
+	overrideCalls      int
+
+	lastExcluded    []string
+	lastPinnedTypes []string
+	lastCutoff      time.Time
+	completed       []retentionrepo.CompleteSweepParams
+}
+
+func newFakeRetentionRepository(policy retentionrepo.Policy, rowCount int) *fakeRetentionRepository {
+	repo := &fakeRetentionRepository{policies: []retentionrepo.Policy{policy}}
+	for i := 1; i <= rowCount; i++ {
+		id := fmt.Sprint(i)
+		row, _ := json.Marshal(map[string]string{"id": id})
+		repo.rows = append(repo.rows, retentionrepo.ExpiredRow{ID: id, RowJSON: row})
+	}
+	return repo
+}
+
+func (r *fakeRetentionRepository) ClaimDuePolicies(context.Context, retentionrepo.ClaimPoliciesParams) ([]retentionrepo.Policy, error) {
+	return r.policies, nil
+}
+
+func (r *fakeRetentionRepository) CompletePolicySweep(_ context.Context, params retentionrepo.CompleteSweepParams) error {
+	r.completed = append(r.completed, params)
+	return nil
+}
+
+func (r *fakeRetentionRepository) ListOverrideProjectIDs(context.Context, enumtypes.RetentionTarget) ([]string, error) {
+	r.overrideCalls++
+	return r.overrideProjectIDs, nil
+}
+
+func (r *fakeRetentionRepository) ListExpiredRows(_ context.Context, params retentionrepo.ExpiredRowsParams) ([]retentionrepo.ExpiredRow, error) {
+	r.lastExcluded = params.ExcludedProjectIDs
+	r.lastPinnedTypes = params.PinnedEventTypes
+	r.lastCutoff = params.Cutoff
+	limit := params.Limit
+	if limit > len(r.rows) {
+		limit = len(r.rows)
+	}
+	return append([]retentionrepo.ExpiredRow(nil), r.rows[:limit]...), nil
+}
+
+func (r *fakeRetentionRepository) RemoveRows(_ context.Context, _ enumtypes.RetentionTarget, ids []string) (int64, error) {
+	removed := make(map[string]struct{}, len(ids))
+	for _, id := range ids {
+		removed[id] = struct{}{}
+	}
+	kept := r.rows[:0]
+	for _, row := range r.rows {
+		if _, ok := removed[row.ID]; !ok {
+			kept = append(kept, row)
+		}
+	}
+	count := int64(len(r.rows) - len(kept))
+	r.rows = kept
+	return count, nil
+}
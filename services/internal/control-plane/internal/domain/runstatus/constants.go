@@ -41,6 +41,8 @@ const (
 	runStatusFailed    = "failed"
 )
 
+const failureClassInfrastructure = "infrastructure"
+
 const (
 	githubIssueReactionEyes = "eyes"
 )
@@ -207,6 +207,20 @@ func mergeState(base commentState, update commentState) commentState {
 	if update.AlreadyDeleted {
 		base.AlreadyDeleted = true
 	}
+	if update.Attempt > 0 {
+		base.Attempt = update.Attempt
+	}
+	if strings.TrimSpace(update.FailureClass) != "" {
+		base.FailureClass = strings.TrimSpace(update.FailureClass)
+	}
+	if update.RetryScheduled {
+		base.RetryScheduled = true
+		base.RetryAttempt = update.RetryAttempt
+		base.RetryNotBefore = update.RetryNotBefore
+	}
+	if update.RetryMaxAttempts > 0 {
+		base.RetryMaxAttempts = update.RetryMaxAttempts
+	}
 	return base
 }
 
@@ -3,6 +3,7 @@ package runstatus
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/codex-k8s/kodex/libs/go/crypto/tokencrypt"
 	mcpdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/mcp"
@@ -48,6 +49,11 @@ type UpsertCommentParams struct {
 	CodexAuthUserCode        string
 	Deleted                  bool
 	AlreadyDeleted           bool
+	FailureClass             string
+	RetryScheduled           bool
+	RetryAttempt             int
+	RetryMaxAttempts         int
+	RetryNotBefore           *time.Time
 }
 
 // UpsertCommentResult returns tracked issue comment metadata.
@@ -293,4 +299,10 @@ type commentState struct {
 	CodexAuthUserCode        string `json:"codex_auth_user_code,omitempty"`
 	Deleted                  bool   `json:"deleted,omitempty"`
 	AlreadyDeleted           bool   `json:"already_deleted,omitempty"`
+	Attempt                  int    `json:"attempt,omitempty"`
+	FailureClass             string `json:"failure_class,omitempty"`
+	RetryScheduled           bool   `json:"retry_scheduled,omitempty"`
+	RetryAttempt             int    `json:"retry_attempt,omitempty"`
+	RetryMaxAttempts         int    `json:"retry_max_attempts,omitempty"`
+	RetryNotBefore           string `json:"retry_not_before,omitempty"`
 }
@@ -52,6 +52,11 @@ type commentTemplateContext struct {
 	RunStatus                string
 	CodexAuthVerificationURL string
 	CodexAuthUserCode        string
+	FailureClass             string
+	Attempt                  int
+	RetryAttempt             int
+	RetryMaxAttempts         int
+	RetryNotBefore           string
 	RecentAgentStatuses      []recentAgentStatus
 	NextStepActions          []commentTemplateNextStepAction
 
@@ -74,6 +79,10 @@ type commentTemplateContext struct {
 	ShowNamespaceAction    bool
 	ShowRuntimePreparation bool
 	ShowNextStepActions    bool
+	ShowAttempt            bool
+	ShowFailureClass       bool
+	RetryScheduled         bool
+	RetriesExhausted       bool
 
 	CreatedReached              bool
 	PreparingRuntimeReached     bool
@@ -128,6 +137,8 @@ func buildCommentTemplateContext(state commentState, managementURL string, marke
 	normalizedRuntimeMode := strings.ToLower(strings.TrimSpace(state.RuntimeMode))
 	phaseLevel := phaseOrder(state.Phase)
 	hasAuthRequested := state.AuthRequested
+	trimmedFailureClass := strings.TrimSpace(state.FailureClass)
+	isRunFailed := normalizedRunStatus == runStatusFailed
 
 	formattedRecentStatuses := make([]recentAgentStatus, 0, len(recentStatuses))
 	for _, item := range recentStatuses {
@@ -164,6 +175,11 @@ func buildCommentTemplateContext(state commentState, managementURL string, marke
 		RunStatus:                strings.TrimSpace(state.RunStatus),
 		CodexAuthVerificationURL: strings.TrimSpace(state.CodexAuthVerificationURL),
 		CodexAuthUserCode:        strings.TrimSpace(state.CodexAuthUserCode),
+		FailureClass:             trimmedFailureClass,
+		Attempt:                  state.Attempt,
+		RetryAttempt:             state.RetryAttempt,
+		RetryMaxAttempts:         state.RetryMaxAttempts,
+		RetryNotBefore:           strings.TrimSpace(state.RetryNotBefore),
 		RecentAgentStatuses:      formattedRecentStatuses,
 		NextStepActions:          templateActions,
 		ManagementURL:            managementURL,
@@ -184,8 +200,13 @@ func buildCommentTemplateContext(state commentState, managementURL string, marke
 		ShowNamespaceAction:      trimmedNamespace != "" && phaseLevel >= phaseOrder(PhaseNamespaceDeleted),
 		ShowRuntimePreparation:   normalizedRuntimeMode == runtimeModeFullEnv,
 		ShowNextStepActions:      len(templateActions) > 0,
-		CreatedReached:           phaseLevel >= phaseOrder(PhaseCreated),
-		PreparingRuntimeReached:  phaseLevel >= phaseOrder(PhasePreparingRuntime),
+		ShowAttempt:              state.Attempt > 1,
+		ShowFailureClass:         isRunFailed && trimmedFailureClass != "",
+		RetryScheduled:           isRunFailed && state.RetryScheduled,
+		RetriesExhausted: isRunFailed && !state.RetryScheduled &&
+			trimmedFailureClass == failureClassInfrastructure && state.RetryMaxAttempts > 1,
+		CreatedReached:          phaseLevel >= phaseOrder(PhaseCreated),
+		PreparingRuntimeReached: phaseLevel >= phaseOrder(PhasePreparingRuntime),
 		RuntimePreparationActive: normalizedRuntimeMode == runtimeModeFullEnv &&
 			phaseLevel >= phaseOrder(PhasePreparingRuntime) &&
 			phaseLevel < phaseOrder(PhaseStarted),
@@ -196,7 +217,7 @@ func buildCommentTemplateContext(state commentState, managementURL string, marke
 		ReadyReached:                 phaseLevel >= phaseOrder(PhaseReady),
 		IsRunSucceeded:               normalizedRunStatus == runStatusSucceeded,
 		IsRunCanceled:                normalizedRunStatus == runStatusCanceled,
-		IsRunFailed:                  normalizedRunStatus == runStatusFailed,
+		IsRunFailed:                  isRunFailed,
 		Deleted:                      state.Deleted,
 		AlreadyDeleted:               state.AlreadyDeleted,
 		NeedsCodexAuth:               state.Phase == PhaseAuthRequired,
@@ -321,3 +321,35 @@ func TestRenderCommentBody_RendersDiscussionRunAsPod(t *testing.T) {
 		t.Fatalf("expected runtime preparation timeline to be hidden for discussion run: %q", body)
 	}
 }
+
+func TestRenderCommentBody_RendersAutomaticRetryState(t *testing.T) {
+	t.Parallel()
+
+	assertRenderedBodyContains(t, commentState{
+		RunID:            "run-retry",
+		Phase:            PhaseFinished,
+		RunStatus:        "failed",
+		PromptLocale:     localeEN,
+		FailureClass:     "infrastructure",
+		RetryScheduled:   true,
+		RetryAttempt:     2,
+		RetryMaxAttempts: 3,
+		RetryNotBefore:   "2026-03-23T10:02:00Z",
+	}, "", nil, nil,
+		"- Failure class: `infrastructure`",
+		"Automatic retry scheduled: attempt 2 of 3, not before `2026-03-23T10:02:00Z`",
+	)
+
+	assertRenderedBodyContains(t, commentState{
+		RunID:            "run-retry-2",
+		Phase:            PhaseFinished,
+		RunStatus:        "failed",
+		PromptLocale:     localeRU,
+		Attempt:          3,
+		FailureClass:     "infrastructure",
+		RetryMaxAttempts: 3,
+	}, "", nil, nil,
+		"- Попытка: `3`",
+		"Автоматические перезапуски исчерпаны (3 попыток)",
+	)
+}
@@ -121,6 +121,14 @@ func (s *Service) UpsertRunStatusComment(ctx context.Context, params UpsertComme
 		CodexAuthUserCode:        strings.TrimSpace(params.CodexAuthUserCode),
 		Deleted:                  params.Deleted,
 		AlreadyDeleted:           params.AlreadyDeleted,
+		Attempt:                  runCtx.run.Attempt,
+		FailureClass:             strings.TrimSpace(params.FailureClass),
+		RetryScheduled:           params.RetryScheduled,
+		RetryAttempt:             params.RetryAttempt,
+		RetryMaxAttempts:         params.RetryMaxAttempts,
+	}
+	if params.RetryNotBefore != nil {
+		currentState.RetryNotBefore = params.RetryNotBefore.UTC().Format(time.RFC3339)
 	}
 	if runCtx.payload.Runtime != nil {
 		currentState.RuntimeTargetEnv = strings.TrimSpace(runCtx.payload.Runtime.TargetEnv)
@@ -66,6 +66,62 @@ type RuntimeNamespaceState struct {
 	Terminating bool
 }
 
+// PreviewParams describes one runtime deploy dry-run request.
+type PreviewParams struct {
+	Namespace          string
+	TargetEnv          string
+	SlotNo             int
+	RepositoryFullName string
+	ServicesYAMLPath   string
+	BuildRef           string
+}
+
+// PreviewObjectAction identifies what runtime deploy would do with one Kubernetes object.
+type PreviewObjectAction string
+
+const (
+	PreviewObjectActionCreate    PreviewObjectAction = "create"
+	PreviewObjectActionUpdate    PreviewObjectAction = "update"
+	PreviewObjectActionUnchanged PreviewObjectAction = "unchanged"
+	PreviewObjectActionPrune     PreviewObjectAction = "prune"
+)
+
+// PreviewObject describes planned change for one Kubernetes object.
+type PreviewObject struct {
+	Unit          string
+	APIVersion    string
+	Kind          string
+	Namespace     string
+	Name          string
+	Action        PreviewObjectAction
+	ChangedFields []string
+}
+
+// PreviewImageAction identifies how runtime deploy would obtain one stack image.
+type PreviewImageAction string
+
+const (
+	PreviewImageActionBuild    PreviewImageAction = "build"
+	PreviewImageActionReuse    PreviewImageAction = "reuse"
+	PreviewImageActionExternal PreviewImageAction = "external"
+)
+
+// PreviewImage describes planned image reference for one stack image.
+type PreviewImage struct {
+	Name     string
+	ImageRef string
+	Action   PreviewImageAction
+}
+
+// PreviewResult describes what one runtime deploy would change in the target namespace.
+type PreviewResult struct {
+	Namespace string
+	TargetEnv string
+	BuildRef  string
+	Objects   []PreviewObject
+	Images    []PreviewImage
+}
+
 // TaskAction identifies one operator-triggered runtime deploy control action.
 type TaskAction = querytypes.RuntimeDeployTaskAction
 
@@ -136,6 +192,8 @@ type KubernetesClient interface {
 	WaitForStatefulSetReady(ctx context.Context, namespace string, name string, timeout time.Duration) error
 	WaitForDaemonSetReady(ctx context.Context, namespace string, name string, timeout time.Duration) error
 	ApplyManifest(ctx context.Context, manifest []byte, namespaceOverride string, fieldManager string) ([]AppliedResourceRef, error)
+	DryRunApplyManifest(ctx context.Context, manifest []byte, namespaceOverride string, fieldManager string) ([]DryRunObject, error)
+	ListAppliedResources(ctx context.Context, namespace string, apiVersion string, kind string, fieldManager string) ([]AppliedResourceRef, error)
 }
 
 // RegistryClient describes internal registry operations required by runtime deploy.
@@ -158,6 +216,15 @@ type AppliedResourceRef struct {
 	Name       string
 }
 
+// DryRunObject keeps live and dry-run applied state of one rendered manifest object.
+// Live is nil when the object does not exist yet; ServerSide is false when Desired is the rendered object as-is.
+type DryRunObject struct {
+	Ref        AppliedResourceRef
+	Live       map[string]any
+	Desired    map[string]any
+	ServerSide bool
+}
+
 // Dependencies wires runtime deployment collaborators.
 type Dependencies struct {
 	Kubernetes KubernetesClient
@@ -41,7 +41,7 @@ func (s *Service) applyInfrastructure(ctx context.Context, repositoryRoot string
 	applied := make(map[string]struct{}, len(enabled))
 	for _, name := range order {
 		item := enabled[name]
-		if err := s.applyUnit(ctx, repositoryRoot, name, item.Manifests, namespace, vars, runID); err != nil {
+		if err := s.applyUnit(ctx, repositoryRoot, name, item.Manifests, stack.Spec.ManifestPolicy, namespace, vars, runID); err != nil {
 			return nil, err
 		}
 		applied[name] = struct{}{}
@@ -92,7 +92,7 @@ func (s *Service) applyServices(ctx context.Context, repositoryRoot string, stac
 				if !dependenciesSatisfied(service.DependsOn, applied, enabledByName) {
 					continue
 				}
-				if err := s.applyUnit(ctx, repositoryRoot, name, service.Manifests, namespace, vars, runID); err != nil {
+				if err := s.applyUnit(ctx, repositoryRoot, name, service.Manifests, stack.Spec.ManifestPolicy, namespace, vars, runID); err != nil {
 					return err
 				}
 				applied[name] = struct{}{}
@@ -109,7 +109,7 @@ func (s *Service) applyServices(ctx context.Context, repositoryRoot string, stac
 	return nil
 }
 
-func (s *Service) applyUnit(ctx context.Context, repositoryRoot string, unitName string, manifests []servicescfg.ManifestRef, namespace string, vars map[string]string, runID string) error {
+func (s *Service) applyUnit(ctx context.Context, repositoryRoot string, unitName string, manifests []servicescfg.ManifestRef, policy servicescfg.ManifestPolicy, namespace string, vars map[string]string, runID string) error {
 	s.appendTaskLogBestEffort(ctx, runID, "apply", "info", "Apply unit "+unitName+" started")
 	repoRoot := strings.TrimSpace(repositoryRoot)
 	if repoRoot == "" {
@@ -134,6 +134,9 @@ func (s *Service) applyUnit(ctx context.Context, repositoryRoot string, unitName
 			s.appendTaskLogBestEffort(ctx, runID, "apply", "error", "Render manifest failed for "+unitName+": "+fullPath)
 			return fmt.Errorf("render manifest template %s for %s: %w", fullPath, unitName, err)
 		}
+		if err := s.checkManifestPolicy(ctx, policy, unitName, fullPath, renderedRaw, namespace, vars, runID); err != nil {
+			return err
+		}
 		rendered := string(renderedRaw)
 
 		refs, err := parseManifestRefs([]byte(rendered), namespace)
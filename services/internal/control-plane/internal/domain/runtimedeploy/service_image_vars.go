@@ -3,7 +3,6 @@ package runtimedeploy
 import (
 	"sort"
 	"strings"
-	"unicode"
 
 	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 )
@@ -50,25 +49,5 @@ func resolveStackImageRef(image servicescfg.Image) string {
 }
 
 func imageEnvVar(name string) string {
-	trimmed := strings.TrimSpace(name)
-	if trimmed == "" {
-		return ""
-	}
-	normalized := strings.Map(func(r rune) rune {
-		switch {
-		case r >= 'a' && r <= 'z':
-			return unicode.ToUpper(r)
-		case r >= 'A' && r <= 'Z':
-			return r
-		case r >= '0' && r <= '9':
-			return r
-		default:
-			return '_'
-		}
-	}, trimmed)
-	normalized = strings.Trim(normalized, "_")
-	if normalized == "" {
-		return ""
-	}
-	return "KODEX_" + normalized + "_IMAGE"
+	return servicescfg.ImageEnvVar(name)
 }
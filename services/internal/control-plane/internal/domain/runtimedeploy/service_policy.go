@@ -0,0 +1,65 @@
+package runtimedeploy
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/codex-k8s/kodex/libs/go/manifestpolicy"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+const runtimeDeployPolicyErrorSource = "control-plane.runtime-deploy.policy"
+
+// checkManifestPolicy evaluates spec.manifestPolicy on one rendered manifest.
+// Every violation is written to task logs and runtime_errors; an error is returned only for blocking violations.
+func (s *Service) checkManifestPolicy(ctx context.Context, policy servicescfg.ManifestPolicy, unitName string, manifestPath string, rendered []byte, namespace string, vars map[string]string, runID string) error {
+	env := strings.ToLower(strings.TrimSpace(vars["KODEX_ENV"]))
+	violations, err := manifestpolicy.Evaluate(policy, env, rendered)
+	if err != nil {
+		return fmt.Errorf("evaluate manifest policy %s for %s: %w", manifestPath, unitName, err)
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	blocking := 0
+	for _, violation := range violations {
+		level := "warning"
+		if violation.Mode == servicescfg.ManifestPolicyModeBlock {
+			blocking++
+			level = "error"
+		}
+		s.appendTaskLogBestEffort(ctx, runID, "policy", "warning", "Manifest policy "+unitName+": "+violation.String())
+		s.recordPolicyViolation(ctx, violation, level, unitName, manifestPath, namespace, env, runID)
+	}
+	if blocking == 0 {
+		return nil
+	}
+	s.appendTaskLogBestEffort(ctx, runID, "policy", "error", fmt.Sprintf("Manifest policy blocked unit %s: %d blocking violation(s) in %s", unitName, blocking, manifestPath))
+	return fmt.Errorf("manifest policy blocked %s for %s: %d blocking violation(s)", manifestPath, unitName, blocking)
+}
+
+func (s *Service) recordPolicyViolation(ctx context.Context, violation manifestpolicy.Violation, level string, unitName string, manifestPath string, namespace string, env string, runID string) {
+	if s.runtimeErr == nil {
+		return
+	}
+	details, _ := json.Marshal(map[string]string{
+		"rule":     violation.Rule,
+		"mode":     string(violation.Mode),
+		"object":   violation.Object(),
+		"unit":     unitName,
+		"manifest": manifestPath,
+		"env":      env,
+	})
+	s.runtimeErr.RecordBestEffort(ctx, querytypes.RuntimeErrorRecordParams{
+		Source:      runtimeDeployPolicyErrorSource,
+		Level:       level,
+		Message:     "Manifest policy " + violation.Rule + " violated by " + violation.Object() + ": " + violation.Message,
+		DetailsJSON: details,
+		RunID:       strings.TrimSpace(runID),
+		Namespace:   strings.TrimSpace(namespace),
+	})
+}
@@ -0,0 +1,54 @@
+package runtimedeploy
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+type fakePolicyRuntimeErrorRecorder struct {
+	records []querytypes.RuntimeErrorRecordParams
+}
+
+func (f *fakePolicyRuntimeErrorRecorder) RecordBestEffort(_ context.Context, params querytypes.RuntimeErrorRecordParams) {
+	f.records = append(f.records, params)
+}
+
+func TestCheckManifestPolicy_BlocksAndRecordsViolations(t *testing.T) {
+	t.Parallel()
+
+	manifest := []byte(`
+apiVersion: v1
+kind: Service
+metadata:
+  name: edge
+spec:
+  type: LoadBalancer
+`)
+	recorder := &fakePolicyRuntimeErrorRecorder{}
+	svc := &Service{tasks: &fakeRuntimeReuseTasksRepo{}, runtimeErr: recorder}
+	policy := servicescfg.ManifestPolicy{
+		Rules: map[string]servicescfg.ManifestPolicyRuleMode{
+			servicescfg.ManifestPolicyRuleNoLoadBalancer: {Mode: servicescfg.ManifestPolicyModeBlock},
+		},
+	}
+
+	err := svc.checkManifestPolicy(context.Background(), policy, "edge", "deploy/edge.yaml", manifest, "kodex-dev-1", map[string]string{"KODEX_ENV": "ai"}, "run-1")
+	if err == nil || !strings.Contains(err.Error(), "manifest policy blocked") {
+		t.Fatalf("expected blocking error, got %v", err)
+	}
+	if len(recorder.records) == 0 {
+		t.Fatalf("expected violation to be recorded in runtime errors")
+	}
+	record := recorder.records[0]
+	if record.Source != runtimeDeployPolicyErrorSource || record.Level != "error" || record.RunID != "run-1" {
+		t.Fatalf("unexpected runtime error record: %+v", record)
+	}
+
+	if err := svc.checkManifestPolicy(context.Background(), policy, "edge", "deploy/edge.yaml", manifest, "kodex-prod", map[string]string{"KODEX_ENV": "production"}, "run-2"); err != nil {
+		t.Fatalf("LoadBalancer must be allowed in production: %v", err)
+	}
+}
@@ -166,6 +166,12 @@ func (s *Service) ensureCodexK8sPrerequisites(ctx context.Context, repositoryRoo
 	if err != nil {
 		return fmt.Errorf("resolve KODEX_GITHUB_WEBHOOK_SECRET: %w", err)
 	}
+	alertmanagerWebhookToken, err := valueOrExistingOrSharedOrGenerated(secretResolver, targetEnv, vars, existingRuntime, sharedRuntime, "KODEX_ALERTMANAGER_WEBHOOK_TOKEN", func() (string, error) {
+		return randomHex(32)
+	})
+	if err != nil {
+		return fmt.Errorf("resolve KODEX_ALERTMANAGER_WEBHOOK_TOKEN: %w", err)
+	}
 	jwtSigningKey, err := valueOrExistingOrSharedOrGenerated(secretResolver, targetEnv, vars, existingRuntime, sharedRuntime, "KODEX_JWT_SIGNING_KEY", func() (string, error) {
 		return randomHex(32)
 	})
@@ -227,6 +233,7 @@ func (s *Service) ensureCodexK8sPrerequisites(ctx context.Context, repositoryRoo
 		"KODEX_TELEGRAM_INTERACTION_ADAPTER_WEBHOOK_SECRET":          []byte(telegramRuntime.WebhookSecret),
 		"KODEX_TELEGRAM_INTERACTION_ADAPTER_TIMEOUT":                 []byte(telegramRuntime.Timeout),
 		"KODEX_TELEGRAM_INTERACTION_ADAPTER_RECIPIENT_BINDINGS_JSON": []byte(telegramRuntime.RecipientBindingsJSON),
+		"KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_API_KEY":        []byte(valueOrExistingOrShared(secretResolver, targetEnv, vars, existingRuntime, sharedRuntime, "KODEX_TELEGRAM_INTERACTION_ADAPTER_STT_HTTP_API_KEY", "")),
 		"KODEX_CONTEXT7_API_KEY":                                     []byte(valueOrExistingOrShared(secretResolver, targetEnv, vars, existingRuntime, sharedRuntime, "KODEX_CONTEXT7_API_KEY", "")),
 		"KODEX_APP_SECRET_KEY":                                       []byte(appSecretKey),
 		"KODEX_TOKEN_ENCRYPTION_KEY":                                 []byte(tokenEncryptionKey),
@@ -235,6 +242,7 @@ func (s *Service) ensureCodexK8sPrerequisites(ctx context.Context, repositoryRoo
 		"KODEX_RUN_AGENT_LOGS_RETENTION_DAYS":                        []byte(valueOrExistingOrShared(secretResolver, targetEnv, vars, existingRuntime, sharedRuntime, "KODEX_RUN_AGENT_LOGS_RETENTION_DAYS", "14")),
 		"KODEX_LEARNING_MODE_DEFAULT":                                []byte(valueOrExistingOrShared(secretResolver, targetEnv, vars, existingRuntime, sharedRuntime, "KODEX_LEARNING_MODE_DEFAULT", "true")),
 		"KODEX_GITHUB_WEBHOOK_SECRET":                                []byte(githubWebhookSecret),
+		"KODEX_ALERTMANAGER_WEBHOOK_TOKEN":                           []byte(alertmanagerWebhookToken),
 		"KODEX_GITHUB_WEBHOOK_URL":                                   []byte(valueOrExistingOrShared(secretResolver, targetEnv, vars, existingRuntime, sharedRuntime, "KODEX_GITHUB_WEBHOOK_URL", "")),
 		"KODEX_GITHUB_WEBHOOK_EVENTS":                                []byte(valueOrExistingOrShared(secretResolver, targetEnv, vars, existingRuntime, sharedRuntime, "KODEX_GITHUB_WEBHOOK_EVENTS", "push,pull_request,issues,issue_comment,pull_request_review,pull_request_review_comment")),
 		"KODEX_PRODUCTION_DOMAIN":                                    []byte(valueOr(vars, "KODEX_PRODUCTION_DOMAIN", "")),
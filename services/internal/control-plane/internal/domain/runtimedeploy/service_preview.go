@@ -0,0 +1,237 @@
+package runtimedeploy
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/codex-k8s/kodex/libs/go/manifesttpl"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+)
+
+// PreviewDeploy renders services.yaml at the requested build ref and compares every enabled unit
+// with live namespace state via server-side dry-run apply.
+// Nothing is built or applied: prerequisites, TLS and kaniko stages are only reflected in the image plan.
+func (s *Service) PreviewDeploy(ctx context.Context, params PreviewParams) (PreviewResult, error) {
+	prepare := normalizePrepareParams(PrepareParams{
+		Namespace:          params.Namespace,
+		TargetEnv:          params.TargetEnv,
+		SlotNo:             params.SlotNo,
+		RepositoryFullName: params.RepositoryFullName,
+		ServicesYAMLPath:   params.ServicesYAMLPath,
+		BuildRef:           params.BuildRef,
+	})
+	targetEnv := prepare.TargetEnv
+	targetNamespace := prepare.Namespace
+
+	// Preview is not bound to a run, so task logs are skipped (empty run id).
+	templateVars := s.buildTemplateVars(prepare, targetNamespace)
+	repositoryRoot, err := s.resolveRunRepositoryRoot(ctx, prepare, templateVars, "")
+	if err != nil {
+		return PreviewResult{}, fmt.Errorf("resolve repository snapshot: %w", err)
+	}
+	servicesConfigPath := s.resolveServicesConfigPath(repositoryRoot, prepare.ServicesYAMLPath)
+	loaded, err := servicescfg.Load(servicesConfigPath, servicescfg.LoadOptions{
+		Env:       targetEnv,
+		Namespace: targetNamespace,
+		Slot:      prepare.SlotNo,
+		Vars:      templateVars,
+	})
+	if err != nil {
+		return PreviewResult{}, fmt.Errorf("load services config: %w", err)
+	}
+	if targetNamespace == "" {
+		targetNamespace = strings.TrimSpace(loaded.Context.Namespace)
+	}
+	if targetNamespace == "" {
+		return PreviewResult{}, fmt.Errorf("resolved target namespace is empty")
+	}
+	if effectiveEnv := strings.TrimSpace(loaded.Context.Env); effectiveEnv != "" {
+		targetEnv = effectiveEnv
+		prepare.TargetEnv = targetEnv
+	}
+
+	templateVars = s.buildTemplateVars(prepare, targetNamespace)
+	applyStackImageVars(templateVars, loaded.Stack)
+	templateVars["KODEX_PRODUCTION_NAMESPACE"] = targetNamespace
+	templateVars["KODEX_WORKER_K8S_NAMESPACE"] = targetNamespace
+	templateVars["KODEX_REPOSITORY_ROOT"] = s.repositoryRootForRuntimeEnv(repositoryRoot)
+	if repoName := strings.TrimSpace(prepare.RepositoryFullName); repoName != "" {
+		templateVars["KODEX_GITHUB_REPO"] = repoName
+	}
+	applyEnvironmentDomainTemplate(templateVars, loaded.Stack, targetEnv)
+
+	images, err := s.previewImages(ctx, loaded.Stack, templateVars)
+	if err != nil {
+		return PreviewResult{}, fmt.Errorf("preview images: %w", err)
+	}
+	if strings.TrimSpace(templateVars["KODEX_WORKER_JOB_IMAGE"]) == "" {
+		if value := strings.TrimSpace(templateVars["KODEX_AGENT_RUNNER_IMAGE"]); value != "" {
+			templateVars["KODEX_WORKER_JOB_IMAGE"] = value
+		}
+	}
+
+	units, err := collectStackUnits(loaded.Stack, targetNamespace, templateVars)
+	if err != nil {
+		return PreviewResult{}, err
+	}
+	objects := make([]PreviewObject, 0, len(units))
+	for _, unit := range units {
+		unitObjects, err := s.previewUnit(ctx, repositoryRoot, unit, targetNamespace, templateVars)
+		if err != nil {
+			return PreviewResult{}, err
+		}
+		objects = append(objects, unitObjects...)
+	}
+	pruned, err := s.previewPrunedObjects(ctx, targetNamespace, objects)
+	if err != nil {
+		return PreviewResult{}, err
+	}
+	objects = append(objects, pruned...)
+
+	return PreviewResult{
+		Namespace: targetNamespace,
+		TargetEnv: targetEnv,
+		BuildRef:  templateVars["KODEX_BUILD_REF"],
+		Objects:   objects,
+		Images:    images,
+	}, nil
+}
+
+func (s *Service) previewUnit(ctx context.Context, repositoryRoot string, unit stackUnit, namespace string, vars map[string]string) ([]PreviewObject, error) {
+	repoRoot := strings.TrimSpace(repositoryRoot)
+	if repoRoot == "" {
+		repoRoot = s.cfg.RepositoryRoot
+	}
+	out := make([]PreviewObject, 0, len(unit.Manifests))
+	for _, manifest := range unit.Manifests {
+		path := strings.TrimSpace(manifest.Path)
+		if path == "" {
+			continue
+		}
+		fullPath := path
+		if !filepath.IsAbs(fullPath) {
+			fullPath = filepath.Join(repoRoot, path)
+		}
+		raw, err := os.ReadFile(fullPath)
+		if err != nil {
+			return nil, fmt.Errorf("read manifest %s for %s: %w", fullPath, unit.Name, err)
+		}
+		rendered, err := manifesttpl.Render(fullPath, raw, vars)
+		if err != nil {
+			return nil, fmt.Errorf("render manifest template %s for %s: %w", fullPath, unit.Name, err)
+		}
+		items, err := s.k8s.DryRunApplyManifest(ctx, rendered, namespace, s.cfg.KanikoFieldManager)
+		if err != nil {
+			return nil, fmt.Errorf("dry-run manifest %s for %s: %w", fullPath, unit.Name, err)
+		}
+		for _, item := range items {
+			out = append(out, previewObjectFromDryRun(unit.Name, item))
+		}
+	}
+	return out, nil
+}
+
+// previewPrunedObjects reports objects previously applied by control-plane in the target namespace
+// which are no longer rendered. Only kinds present in the rendered stack are inspected.
+func (s *Service) previewPrunedObjects(ctx context.Context, namespace string, objects []PreviewObject) ([]PreviewObject, error) {
+	type kindKey struct {
+		apiVersion string
+		kind       string
+	}
+	rendered := make(map[string]struct{}, len(objects))
+	kinds := make(map[kindKey]struct{})
+	for _, object := range objects {
+		if object.Namespace != namespace {
+			continue
+		}
+		rendered[previewObjectKey(object.APIVersion, object.Kind, object.Name)] = struct{}{}
+		kinds[kindKey{apiVersion: object.APIVersion, kind: object.Kind}] = struct{}{}
+	}
+	sortedKinds := make([]kindKey, 0, len(kinds))
+	for key := range kinds {
+		sortedKinds = append(sortedKinds, key)
+	}
+	sort.Slice(sortedKinds, func(i, j int) bool {
+		if sortedKinds[i].kind != sortedKinds[j].kind {
+			return sortedKinds[i].kind < sortedKinds[j].kind
+		}
+		return sortedKinds[i].apiVersion < sortedKinds[j].apiVersion
+	})
+
+	out := make([]PreviewObject, 0)
+	for _, key := range sortedKinds {
+		live, err := s.k8s.ListAppliedResources(ctx, namespace, key.apiVersion, key.kind, s.cfg.KanikoFieldManager)
+		if err != nil {
+			return nil, fmt.Errorf("list applied %s in namespace %s: %w", key.kind, namespace, err)
+		}
+		for _, ref := range live {
+			if _, ok := rendered[previewObjectKey(ref.APIVersion, ref.Kind, ref.Name)]; ok {
+				continue
+			}
+			out = append(out, PreviewObject{
+				APIVersion: ref.APIVersion,
+				Kind:       ref.Kind,
+				Namespace:  ref.Namespace,
+				Name:       ref.Name,
+				Action:     PreviewObjectActionPrune,
+			})
+		}
+	}
+	return out, nil
+}
+
+// previewImages resolves image refs for the stack and applies build image refs to template vars
+// exactly like the build stage would do after kaniko.
+func (s *Service) previewImages(ctx context.Context, stack *servicescfg.Stack, vars map[string]string) ([]PreviewImage, error) {
+	if stack == nil || len(stack.Spec.Images) == 0 {
+		return nil, nil
+	}
+	names := make([]string, 0, len(stack.Spec.Images))
+	for name := range stack.Spec.Images {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := make([]PreviewImage, 0, len(names))
+	for _, name := range names {
+		image := stack.Spec.Images[name]
+		name = strings.TrimSpace(name)
+		if !strings.EqualFold(strings.TrimSpace(image.Type), "build") {
+			imageRef := resolveStackImageRef(image)
+			if imageRef == "" {
+				continue
+			}
+			out = append(out, PreviewImage{Name: name, ImageRef: imageRef, Action: PreviewImageActionExternal})
+			continue
+		}
+
+		repository := strings.TrimSpace(image.Repository)
+		if repository == "" {
+			return nil, fmt.Errorf("image %q repository is required for build type", name)
+		}
+		tag := sanitizeImageTag(strings.TrimSpace(image.TagTemplate))
+		if tag == "" {
+			tag = "latest"
+		}
+		exists, err := s.shouldSkipKanikoBuild(ctx, repository, tag, vars, "", name)
+		if err != nil {
+			return nil, err
+		}
+		action := PreviewImageActionBuild
+		if exists {
+			action = PreviewImageActionReuse
+		}
+		imageRef := repository + ":" + tag
+		applyBuiltImageResult(vars, name, imageRef)
+		out = append(out, PreviewImage{Name: name, ImageRef: imageRef, Action: action})
+	}
+	return out, nil
+}
+
+func previewObjectKey(apiVersion string, kind string, name string) string {
+	return strings.TrimSpace(apiVersion) + "|" + strings.TrimSpace(kind) + "|" + strings.TrimSpace(name)
+}
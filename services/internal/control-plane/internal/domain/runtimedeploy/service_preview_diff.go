@@ -0,0 +1,128 @@
+package runtimedeploy
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const maxPreviewChangedFields = 50
+
+// Server-populated metadata never reflects a manifest change and is excluded from preview diff.
+var previewIgnoredMetadataFields = map[string]struct{}{
+	"managedFields":     {},
+	"resourceVersion":   {},
+	"uid":               {},
+	"creationTimestamp": {},
+	"generation":        {},
+	"selfLink":          {},
+}
+
+func previewObjectFromDryRun(unit string, item DryRunObject) PreviewObject {
+	object := PreviewObject{
+		Unit:       strings.TrimSpace(unit),
+		APIVersion: item.Ref.APIVersion,
+		Kind:       item.Ref.Kind,
+		Namespace:  item.Ref.Namespace,
+		Name:       item.Ref.Name,
+	}
+	if item.Live == nil {
+		object.Action = PreviewObjectActionCreate
+		return object
+	}
+
+	live := normalizePreviewObject(item.Live)
+	desired := normalizePreviewObject(item.Desired)
+	var changed []string
+	if item.ServerSide {
+		changed = diffPreviewValues("", live, desired, false)
+	} else {
+		// Rendered object lacks API server defaults, so only fields declared in the manifest are compared.
+		changed = diffPreviewValues("", live, desired, true)
+	}
+	if len(changed) == 0 {
+		object.Action = PreviewObjectActionUnchanged
+		return object
+	}
+	sort.Strings(changed)
+	if len(changed) > maxPreviewChangedFields {
+		changed = append(changed[:maxPreviewChangedFields], "...")
+	}
+	object.Action = PreviewObjectActionUpdate
+	object.ChangedFields = changed
+	return object
+}
+
+func normalizePreviewObject(object map[string]any) map[string]any {
+	out := make(map[string]any, len(object))
+	for key, value := range object {
+		switch key {
+		case "status":
+			continue
+		case "metadata":
+			metadata, ok := value.(map[string]any)
+			if !ok {
+				out[key] = value
+				continue
+			}
+			cleaned := make(map[string]any, len(metadata))
+			for metaKey, metaValue := range metadata {
+				if _, ignored := previewIgnoredMetadataFields[metaKey]; ignored {
+					continue
+				}
+				cleaned[metaKey] = metaValue
+			}
+			out[key] = cleaned
+		default:
+			out[key] = value
+		}
+	}
+	return out
+}
+
+// diffPreviewValues returns dotted paths where live and desired differ.
+// Lists are compared as a whole because element identity depends on per-kind merge keys.
+// Values are never included, so Secret data does not leak into preview output.
+func diffPreviewValues(path string, live any, desired any, desiredOnly bool) []string {
+	liveMap, liveIsMap := live.(map[string]any)
+	desiredMap, desiredIsMap := desired.(map[string]any)
+	if !liveIsMap || !desiredIsMap {
+		if reflect.DeepEqual(live, desired) {
+			return nil
+		}
+		return []string{previewFieldPath(path)}
+	}
+
+	keys := make(map[string]struct{}, len(liveMap)+len(desiredMap))
+	for key := range desiredMap {
+		keys[key] = struct{}{}
+	}
+	if !desiredOnly {
+		for key := range liveMap {
+			keys[key] = struct{}{}
+		}
+	}
+
+	var out []string
+	for key := range keys {
+		childPath := key
+		if path != "" {
+			childPath = path + "." + key
+		}
+		liveValue, liveOK := liveMap[key]
+		desiredValue, desiredOK := desiredMap[key]
+		if liveOK != desiredOK {
+			out = append(out, childPath)
+			continue
+		}
+		out = append(out, diffPreviewValues(childPath, liveValue, desiredValue, desiredOnly)...)
+	}
+	return out
+}
+
+func previewFieldPath(path string) string {
+	if path == "" {
+		return "."
+	}
+	return path
+}
@@ -0,0 +1,114 @@
+package runtimedeploy
+
+import (
+	"context"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestPreviewDeploy_ClassifiesObjects(t *testing.T) {
+	t.Parallel()
+
+	repoRoot := filepath.Join(t.TempDir(), "repo")
+	mustWriteRuntimeReuseTestRepo(t, repoRoot, "0123456789abcdef0123456789abcdef01234567")
+
+	namespace := "kodex-dev-1"
+	k8s := &fakeRuntimeReuseKubernetesClient{
+		namespaces: map[string]RuntimeNamespaceState{},
+		live: map[string]map[string]any{
+			"ConfigMap/infra": {
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]any{
+					"name":            "infra",
+					"namespace":       namespace,
+					"resourceVersion": "42",
+					"uid":             "uid-1",
+				},
+				"data": map[string]any{"env": "ai"},
+			},
+		},
+		applied: []AppliedResourceRef{
+			{APIVersion: "v1", Kind: "ConfigMap", Namespace: namespace, Name: "infra"},
+			{APIVersion: "v1", Kind: "ConfigMap", Namespace: namespace, Name: "legacy"},
+		},
+	}
+	svc := &Service{
+		cfg:   Config{RepositoryRoot: repoRoot},
+		k8s:   k8s,
+		tasks: &fakeRuntimeReuseTasksRepo{},
+	}
+
+	result, err := svc.PreviewDeploy(context.Background(), PreviewParams{
+		Namespace:        namespace,
+		TargetEnv:        "ai",
+		ServicesYAMLPath: "services.yaml",
+		BuildRef:         "main",
+	})
+	if err != nil {
+		t.Fatalf("PreviewDeploy() error = %v", err)
+	}
+	if result.Namespace != namespace || result.TargetEnv != "ai" {
+		t.Fatalf("unexpected target %q/%q", result.Namespace, result.TargetEnv)
+	}
+
+	got := make(map[string]PreviewObjectAction, len(result.Objects))
+	for _, object := range result.Objects {
+		got[object.Kind+"/"+object.Name] = object.Action
+	}
+	want := map[string]PreviewObjectAction{
+		"ConfigMap/infra":  PreviewObjectActionUnchanged,
+		"Deployment/app":   PreviewObjectActionCreate,
+		"ConfigMap/legacy": PreviewObjectActionPrune,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("actions = %#v, want %#v", got, want)
+	}
+}
+
+func TestPreviewObjectFromDryRun_ReportsChangedFieldsWithoutValues(t *testing.T) {
+	t.Parallel()
+
+	item := DryRunObject{
+		Ref: AppliedResourceRef{APIVersion: "v1", Kind: "Secret", Namespace: "ns", Name: "creds"},
+		Live: map[string]any{
+			"metadata": map[string]any{"name": "creds", "resourceVersion": "1"},
+			"data":     map[string]any{"token": "b2xk", "user": "dXNlcg=="},
+			"status":   map[string]any{"ignored": true},
+		},
+		Desired: map[string]any{
+			"metadata": map[string]any{"name": "creds", "resourceVersion": "2"},
+			"data":     map[string]any{"token": "bmV3", "user": "dXNlcg=="},
+		},
+		ServerSide: true,
+	}
+
+	object := previewObjectFromDryRun("app", item)
+	if object.Action != PreviewObjectActionUpdate {
+		t.Fatalf("action = %q, want update", object.Action)
+	}
+	if want := []string{"data.token"}; !reflect.DeepEqual(object.ChangedFields, want) {
+		t.Fatalf("changed fields = %#v, want %#v", object.ChangedFields, want)
+	}
+}
+
+func TestPreviewObjectFromDryRun_ClientSideComparesDeclaredFieldsOnly(t *testing.T) {
+	t.Parallel()
+
+	item := DryRunObject{
+		Ref: AppliedResourceRef{APIVersion: "batch/v1", Kind: "Job", Namespace: "ns", Name: "migrate"},
+		Live: map[string]any{
+			"metadata": map[string]any{"name": "migrate"},
+			"spec":     map[string]any{"backoffLimit": int64(6), "completions": int64(1)},
+		},
+		Desired: map[string]any{
+			"metadata": map[string]any{"name": "migrate"},
+			"spec":     map[string]any{"backoffLimit": int64(6)},
+		},
+	}
+
+	if object := previewObjectFromDryRun("migrations", item); object.Action != PreviewObjectActionUnchanged {
+		t.Fatalf("action = %q, want unchanged (%v)", object.Action, object.ChangedFields)
+	}
+}
@@ -8,7 +8,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"sort"
 	"strings"
 	"time"
 
@@ -397,85 +396,16 @@ func (s *Service) computeRenderedManifestHash(repositoryRoot string, stack *serv
 		})
 	}
 
-	enabledInfra := make(map[string]servicescfg.InfrastructureItem, len(stack.Spec.Infrastructure))
-	for _, item := range stack.Spec.Infrastructure {
-		name := strings.TrimSpace(item.Name)
-		if name == "" {
-			return "", fmt.Errorf("infrastructure item name is required")
-		}
-		include, err := evaluateWhen(item.When)
-		if err != nil {
-			return "", fmt.Errorf("infrastructure %q when expression: %w", name, err)
-		}
-		if !include {
-			continue
-		}
-		enabledInfra[name] = item
-	}
-	order, err := topoSortInfrastructure(enabledInfra)
+	units, err := collectStackUnits(stack, namespace, vars)
 	if err != nil {
 		return "", err
 	}
-	for _, name := range order {
-		item := enabledInfra[name]
-		if err := s.collectRenderedManifests(repositoryRoot, name, item.Manifests, vars, appendRecord); err != nil {
+	for _, unit := range units {
+		if err := s.collectRenderedManifests(repositoryRoot, unit.Name, unit.Manifests, vars, appendRecord); err != nil {
 			return "", err
 		}
 	}
 
-	enabledServices := make(map[string]servicescfg.Service, len(stack.Spec.Services))
-	groupToNames := make(map[string][]string)
-	for _, service := range stack.Spec.Services {
-		name := strings.TrimSpace(service.Name)
-		if name == "" {
-			return "", fmt.Errorf("service name is required")
-		}
-		includeScope, _ := shouldApplyServiceScope(service.Scope, namespace, vars)
-		if !includeScope {
-			continue
-		}
-		include, err := evaluateWhen(service.When)
-		if err != nil {
-			return "", fmt.Errorf("service %q when expression: %w", name, err)
-		}
-		if !include {
-			continue
-		}
-		enabledServices[name] = service
-		group := strings.TrimSpace(service.DeployGroup)
-		groupToNames[group] = append(groupToNames[group], name)
-	}
-
-	applied := make(map[string]struct{}, len(enabledInfra))
-	for name := range enabledInfra {
-		applied[name] = struct{}{}
-	}
-	groupOrder := buildServiceGroupOrder(stack.Spec.Orchestration.DeployOrder, groupToNames)
-	for _, group := range groupOrder {
-		names := append([]string(nil), groupToNames[group]...)
-		sort.Strings(names)
-		for len(names) > 0 {
-			progress := false
-			for idx := 0; idx < len(names); idx++ {
-				name := names[idx]
-				service := enabledServices[name]
-				if !dependenciesSatisfied(service.DependsOn, applied, enabledServices) {
-					continue
-				}
-				if err := s.collectRenderedManifests(repositoryRoot, name, service.Manifests, vars, appendRecord); err != nil {
-					return "", err
-				}
-				applied[name] = struct{}{}
-				names = append(names[:idx], names[idx+1:]...)
-				progress = true
-				break
-			}
-			if !progress {
-				return "", fmt.Errorf("service dependency deadlock in group %q: unresolved %s", group, strings.Join(names, ", "))
-			}
-		}
-	}
-
 	payload := struct {
 		ServicesYAML string                   `json:"services_yaml"`
 		Manifests    []renderedManifestRecord `json:"manifests"`
@@ -1,8 +1,10 @@
 package runtimedeploy
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -11,6 +13,8 @@ import (
 	agentrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentrun"
 	runtimedeploytaskrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/runtimedeploytask"
 	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
 )
 
 func TestEvaluateRuntimeReuse_AllowsFastPathWhenFingerprintMatches(t *testing.T) {
@@ -310,6 +314,9 @@ spec:
 
 type fakeRuntimeReuseKubernetesClient struct {
 	namespaces map[string]RuntimeNamespaceState
+	// live keeps existing objects by kind/name for dry-run preview.
+	live    map[string]map[string]any
+	applied []AppliedResourceRef
 }
 
 func (f *fakeRuntimeReuseKubernetesClient) EnsureNamespace(_ context.Context, _ string) error {
@@ -380,6 +387,44 @@ func (*fakeRuntimeReuseKubernetesClient) ApplyManifest(_ context.Context, _ []by
 	return nil, nil
 }
 
+func (f *fakeRuntimeReuseKubernetesClient) DryRunApplyManifest(_ context.Context, manifest []byte, namespaceOverride string, _ string) ([]DryRunObject, error) {
+	decoder := yamlutil.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	out := make([]DryRunObject, 0, 2)
+	for {
+		var object map[string]any
+		if err := decoder.Decode(&object); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return nil, err
+		}
+		obj := &unstructured.Unstructured{Object: object}
+		obj.SetNamespace(namespaceOverride)
+		out = append(out, DryRunObject{
+			Ref: AppliedResourceRef{
+				APIVersion: obj.GetAPIVersion(),
+				Kind:       obj.GetKind(),
+				Namespace:  namespaceOverride,
+				Name:       obj.GetName(),
+			},
+			Live:       f.live[obj.GetKind()+"/"+obj.GetName()],
+			Desired:    obj.Object,
+			ServerSide: true,
+		})
+	}
+	return out, nil
+}
+
+func (f *fakeRuntimeReuseKubernetesClient) ListAppliedResources(_ context.Context, _ string, apiVersion string, kind string, _ string) ([]AppliedResourceRef, error) {
+	out := make([]AppliedResourceRef, 0, len(f.applied))
+	for _, ref := range f.applied {
+		if ref.APIVersion == apiVersion && ref.Kind == kind {
+			out = append(out, ref)
+		}
+	}
+	return out, nil
+}
+
 type fakeRuntimeReuseTasksRepo struct {
 	active   runtimedeploytaskrepo.Task
 	activeOK bool
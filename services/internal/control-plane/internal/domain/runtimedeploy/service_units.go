@@ -0,0 +1,97 @@
+package runtimedeploy
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+)
+
+type stackUnit struct {
+	Name      string
+	Manifests []servicescfg.ManifestRef
+}
+
+// collectStackUnits returns enabled infrastructure and service units in the same order as runtime apply.
+func collectStackUnits(stack *servicescfg.Stack, namespace string, vars map[string]string) ([]stackUnit, error) {
+	if stack == nil {
+		return nil, fmt.Errorf("stack is nil")
+	}
+
+	enabledInfra := make(map[string]servicescfg.InfrastructureItem, len(stack.Spec.Infrastructure))
+	for _, item := range stack.Spec.Infrastructure {
+		name := strings.TrimSpace(item.Name)
+		if name == "" {
+			return nil, fmt.Errorf("infrastructure item name is required")
+		}
+		include, err := evaluateWhen(item.When)
+		if err != nil {
+			return nil, fmt.Errorf("infrastructure %q when expression: %w", name, err)
+		}
+		if !include {
+			continue
+		}
+		enabledInfra[name] = item
+	}
+	order, err := topoSortInfrastructure(enabledInfra)
+	if err != nil {
+		return nil, err
+	}
+	units := make([]stackUnit, 0, len(enabledInfra)+len(stack.Spec.Services))
+	for _, name := range order {
+		units = append(units, stackUnit{Name: name, Manifests: enabledInfra[name].Manifests})
+	}
+
+	enabledServices := make(map[string]servicescfg.Service, len(stack.Spec.Services))
+	groupToNames := make(map[string][]string)
+	for _, service := range stack.Spec.Services {
+		name := strings.TrimSpace(service.Name)
+		if name == "" {
+			return nil, fmt.Errorf("service name is required")
+		}
+		includeScope, _ := shouldApplyServiceScope(service.Scope, namespace, vars)
+		if !includeScope {
+			continue
+		}
+		include, err := evaluateWhen(service.When)
+		if err != nil {
+			return nil, fmt.Errorf("service %q when expression: %w", name, err)
+		}
+		if !include {
+			continue
+		}
+		enabledServices[name] = service
+		group := strings.TrimSpace(service.DeployGroup)
+		groupToNames[group] = append(groupToNames[group], name)
+	}
+
+	applied := make(map[string]struct{}, len(enabledInfra))
+	for name := range enabledInfra {
+		applied[name] = struct{}{}
+	}
+	groupOrder := buildServiceGroupOrder(stack.Spec.Orchestration.DeployOrder, groupToNames)
+	for _, group := range groupOrder {
+		names := append([]string(nil), groupToNames[group]...)
+		sort.Strings(names)
+		for len(names) > 0 {
+			progress := false
+			for idx := 0; idx < len(names); idx++ {
+				name := names[idx]
+				service := enabledServices[name]
+				if !dependenciesSatisfied(service.DependsOn, applied, enabledServices) {
+					continue
+				}
+				units = append(units, stackUnit{Name: name, Manifests: service.Manifests})
+				applied[name] = struct{}{}
+				names = append(names[:idx], names[idx+1:]...)
+				progress = true
+				break
+			}
+			if !progress {
+				return nil, fmt.Errorf("service dependency deadlock in group %q: unresolved %s", group, strings.Join(names, ", "))
+			}
+		}
+	}
+	return units, nil
+}
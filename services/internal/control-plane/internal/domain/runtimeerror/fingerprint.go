@@ -0,0 +1,102 @@
+package runtimeerror
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"regexp"
+	"strings"
+)
+
+const (
+	maxErrorClassLength        = 160
+	maxNormalizedMessageLength = 512
+	minVolatileTokenLength     = 6
+)
+
+var (
+	uuidPattern         = regexp.MustCompile(`(?i)\b[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}\b`)
+	timestampPattern    = regexp.MustCompile(`\b\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(?:\.\d+)?(?:Z|[+-]\d{2}:?\d{2})?`)
+	ipv4Pattern         = regexp.MustCompile(`\b\d{1,3}(?:\.\d{1,3}){3}(?::\d+)?\b`)
+	doubleQuotedPattern = regexp.MustCompile(`"[^"]*"`)
+	singleQuotedPattern = regexp.MustCompile(`'[^']*'`)
+	volatileTokenRegexp = regexp.MustCompile(`(?i)\b(?:0x)?[0-9a-z]*\d[0-9a-z]*\b`)
+	whitespacePattern   = regexp.MustCompile(`\s+`)
+)
+
+// groupKey is a stable identity of runtime errors that share one root cause.
+type groupKey struct {
+	Fingerprint       string
+	ErrorClass        string
+	NormalizedMessage string
+}
+
+// resolveGroupKey builds fingerprint from project, source, error class and message stripped of volatile values.
+func resolveGroupKey(projectID string, source string, message string, detailsJSON json.RawMessage) groupKey {
+	normalized := normalizeErrorMessage(message)
+	errorClass := resolveErrorClass(detailsJSON, normalized)
+	sum := sha256.Sum256([]byte(strings.Join([]string{
+		strings.TrimSpace(projectID),
+		strings.TrimSpace(source),
+		errorClass,
+		normalized,
+	}, "\x00")))
+	return groupKey{
+		Fingerprint:       hex.EncodeToString(sum[:]),
+		ErrorClass:        errorClass,
+		NormalizedMessage: normalized,
+	}
+}
+
+// normalizeErrorMessage replaces identifiers, timestamps, addresses, quoted values and numbers with placeholders.
+func normalizeErrorMessage(message string) string {
+	value := strings.TrimSpace(message)
+	value = uuidPattern.ReplaceAllString(value, "<uuid>")
+	value = timestampPattern.ReplaceAllString(value, "<time>")
+	value = ipv4Pattern.ReplaceAllString(value, "<ip>")
+	value = doubleQuotedPattern.ReplaceAllString(value, `"<str>"`)
+	value = singleQuotedPattern.ReplaceAllString(value, `'<str>'`)
+	value = volatileTokenRegexp.ReplaceAllStringFunc(value, normalizeVolatileToken)
+	value = whitespacePattern.ReplaceAllString(value, " ")
+	return strings.TrimSpace(truncateText(value, maxNormalizedMessageLength))
+}
+
+// normalizeVolatileToken keeps short alphanumeric words (e.g. "k8s", "v1") and masks numbers and generated ids.
+func normalizeVolatileToken(token string) string {
+	digitsOnly := strings.Trim(token, "0123456789") == ""
+	if digitsOnly {
+		return "<n>"
+	}
+	if len(token) < minVolatileTokenLength {
+		return token
+	}
+	return "<id>"
+}
+
+// resolveErrorClass prefers explicit details.error_class and falls back to the leading error-chain segment.
+func resolveErrorClass(detailsJSON json.RawMessage, normalizedMessage string) string {
+	var details struct {
+		ErrorClass string `json:"error_class"`
+		ErrorCode  string `json:"error_code"`
+	}
+	if len(detailsJSON) > 0 && json.Unmarshal(detailsJSON, &details) == nil {
+		for _, candidate := range []string{details.ErrorClass, details.ErrorCode} {
+			if value := strings.TrimSpace(candidate); value != "" {
+				return truncateText(value, maxErrorClassLength)
+			}
+		}
+	}
+	head, _, found := strings.Cut(normalizedMessage, ": ")
+	if !found {
+		return ""
+	}
+	return truncateText(strings.TrimSpace(head), maxErrorClassLength)
+}
+
+// truncateText cuts value to maxLength bytes without splitting a multi-byte rune.
+func truncateText(value string, maxLength int) string {
+	if len(value) > maxLength {
+		return strings.ToValidUTF8(value[:maxLength], "")
+	}
+	return value
+}
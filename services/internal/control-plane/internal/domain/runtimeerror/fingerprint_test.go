@@ -0,0 +1,111 @@
+package runtimeerror
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+func TestNormalizeErrorMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		message string
+		want    string
+	}{
+		{
+			name:    "uuid and numbers",
+			message: "load run 3f2b8c1e-9a7d-4c3b-8e1f-0a2b3c4d5e6f: attempt 3 of 5 failed",
+			want:    "load run <uuid>: attempt <n> of <n> failed",
+		},
+		{
+			name:    "timestamp ip and quoted value",
+			message: `dial tcp 10.0.12.7:5432 at 2026-03-25T10:00:01.123Z: namespace "kodex-dev-42" not found`,
+			want:    `dial tcp <ip> at <time>: namespace "<str>" not found`,
+		},
+		{
+			name:    "generated suffix and short words",
+			message: "k8s job kodex-run-7f3c9a2b exited   with code 137",
+			want:    "k8s job kodex-run-<id> exited with code <n>",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if got := normalizeErrorMessage(tc.message); got != tc.want {
+				t.Fatalf("normalizeErrorMessage() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeErrorMessage_TruncatesOnRuneBoundary(t *testing.T) {
+	t.Parallel()
+
+	message := "x" + strings.Repeat("я", maxNormalizedMessageLength)
+	got := normalizeErrorMessage(message)
+	if len(got) > maxNormalizedMessageLength {
+		t.Fatalf("normalized length = %d, want <= %d", len(got), maxNormalizedMessageLength)
+	}
+	if !utf8.ValidString(got) {
+		t.Fatalf("normalized message is not valid utf-8: %q", got)
+	}
+
+	key := resolveGroupKey("project-1", "worker.run", "x"+strings.Repeat("я", maxErrorClassLength)+": boom", nil)
+	if !utf8.ValidString(key.ErrorClass) || !utf8.ValidString(key.NormalizedMessage) {
+		t.Fatalf("group key is not valid utf-8: %+v", key)
+	}
+}
+
+func TestResolveGroupKey_GroupsVolatileOccurrences(t *testing.T) {
+	t.Parallel()
+
+	first := resolveGroupKey("project-1", "worker.run", "run 11 failed: pod kodex-run-aa11bb22 oom", nil)
+	second := resolveGroupKey("project-1", "worker.run", "run 12 failed: pod kodex-run-cc33dd44 oom", nil)
+	if first.Fingerprint != second.Fingerprint {
+		t.Fatalf("expected equal fingerprints, got %q and %q", first.Fingerprint, second.Fingerprint)
+	}
+	if got, want := first.ErrorClass, "run <n> failed"; got != want {
+		t.Fatalf("error class = %q, want %q", got, want)
+	}
+
+	otherProject := resolveGroupKey("project-2", "worker.run", "run 11 failed: pod kodex-run-aa11bb22 oom", nil)
+	if otherProject.Fingerprint == first.Fingerprint {
+		t.Fatal("expected project to be part of fingerprint")
+	}
+	otherSource := resolveGroupKey("project-1", "worker.finalize", "run 11 failed: pod kodex-run-aa11bb22 oom", nil)
+	if otherSource.Fingerprint == first.Fingerprint {
+		t.Fatal("expected source to be part of fingerprint")
+	}
+}
+
+func TestResolveGroupKey_PrefersExplicitErrorClass(t *testing.T) {
+	t.Parallel()
+
+	key := resolveGroupKey("", "control-plane.runtime-deploy.dev", "deploy failed: timeout", json.RawMessage(`{"error_class":"rollout_timeout"}`))
+	if got, want := key.ErrorClass, "rollout_timeout"; got != want {
+		t.Fatalf("error class = %q, want %q", got, want)
+	}
+}
+
+func TestNormalizeRecordParams_FillsGroupKey(t *testing.T) {
+	t.Parallel()
+
+	params := normalizeRecordParams(querytypes.RuntimeErrorRecordParams{
+		Source:  " webhook.trigger ",
+		Message: "resolve trigger for issue 42: label conflict",
+	})
+	if params.Fingerprint == "" {
+		t.Fatal("expected fingerprint to be set")
+	}
+	if got, want := params.NormalizedMessage, "resolve trigger for issue <n>: label conflict"; got != want {
+		t.Fatalf("normalized message = %q, want %q", got, want)
+	}
+	if got, want := params.ErrorClass, "resolve trigger for issue <n>"; got != want {
+		t.Fatalf("error class = %q, want %q", got, want)
+	}
+}
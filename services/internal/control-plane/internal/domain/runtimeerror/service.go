@@ -23,7 +23,7 @@ const (
 	maxIDStringLength = 64
 )
 
-// Service records runtime errors into persistent journal.
+// Service records runtime errors into persistent journal grouped by normalized fingerprint.
 type Service struct {
 	repo   runtimeerrorrepo.Repository
 	logger *slog.Logger
@@ -72,6 +72,11 @@ func normalizeRecordParams(params querytypes.RuntimeErrorRecordParams) querytype
 	normalized.RunID = normalizeLimitedText(params.RunID, "", maxIDStringLength)
 	normalized.ProjectID = normalizeLimitedText(params.ProjectID, "", maxIDStringLength)
 	normalized.DetailsJSON = normalizeDetailsJSON(params.DetailsJSON)
+
+	key := resolveGroupKey(normalized.ProjectID, normalized.Source, normalized.Message, normalized.DetailsJSON)
+	normalized.Fingerprint = key.Fingerprint
+	normalized.ErrorClass = key.ErrorClass
+	normalized.NormalizedMessage = key.NormalizedMessage
 	return normalized
 }
 
@@ -93,8 +98,8 @@ func normalizeLimitedText(raw string, fallback string, maxLength int) string {
 	if value == "" {
 		value = fallback
 	}
-	if maxLength > 0 && len(value) > maxLength {
-		value = value[:maxLength]
+	if maxLength > 0 {
+		value = truncateText(value, maxLength)
 	}
 	return value
 }
@@ -0,0 +1,138 @@
+package staff
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+const (
+	maxMCPApprovalRequiredApprovals = 10
+	maxMCPApprovalExpiresAfter      = 7 * 24 * 60 * 60
+	maxMCPApprovalEligibleEntries   = 50
+)
+
+// ListMCPApprovalPolicies returns multi-approver policies configured for project MCP tools.
+func (s *Service) ListMCPApprovalPolicies(ctx context.Context, principal Principal, projectID string) ([]entitytypes.MCPApprovalPolicy, error) {
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" {
+		return nil, errs.Validation{Field: "project_id", Msg: "is required"}
+	}
+	if err := s.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionProjectRead, ""); err != nil {
+		return nil, err
+	}
+	if s.approvals == nil {
+		return nil, nil
+	}
+	return s.approvals.List(ctx, projectID)
+}
+
+// UpsertMCPApprovalPolicy creates or replaces approval policy for project tool and environment (platform admin only).
+func (s *Service) UpsertMCPApprovalPolicy(ctx context.Context, principal Principal, params querytypes.MCPApprovalPolicyUpsertParams) (entitytypes.MCPApprovalPolicy, error) {
+	if err := s.requireMCPApprovalPolicyAdmin(principal); err != nil {
+		return entitytypes.MCPApprovalPolicy{}, err
+	}
+	projectID := strings.TrimSpace(params.ProjectID)
+	if projectID == "" {
+		return entitytypes.MCPApprovalPolicy{}, errs.Validation{Field: "project_id", Msg: "is required"}
+	}
+	toolName := strings.TrimSpace(params.ToolName)
+	if !slices.Contains(enumtypes.MCPApprovalPolicyTools, enumtypes.MCPApprovalPolicyTool(toolName)) {
+		return entitytypes.MCPApprovalPolicy{}, errs.Validation{Field: "tool_name", Msg: fmt.Sprintf("tool %q does not support approval policies", toolName)}
+	}
+	if params.RequiredApprovals < 1 || params.RequiredApprovals > maxMCPApprovalRequiredApprovals {
+		return entitytypes.MCPApprovalPolicy{}, errs.Validation{Field: "required_approvals", Msg: fmt.Sprintf("must be between 1 and %d", maxMCPApprovalRequiredApprovals)}
+	}
+	if params.ExpiresAfterSeconds < 0 || params.ExpiresAfterSeconds > maxMCPApprovalExpiresAfter {
+		return entitytypes.MCPApprovalPolicy{}, errs.Validation{Field: "expires_after_seconds", Msg: fmt.Sprintf("must be between 0 and %d", maxMCPApprovalExpiresAfter)}
+	}
+	if _, ok, err := s.projects.GetByID(ctx, projectID); err != nil {
+		return entitytypes.MCPApprovalPolicy{}, err
+	} else if !ok {
+		return entitytypes.MCPApprovalPolicy{}, errs.Validation{Field: "project_id", Msg: "not found"}
+	}
+
+	roles := normalizeDistinctValues(params.EligibleRoles)
+	if len(roles) > maxMCPApprovalEligibleEntries {
+		return entitytypes.MCPApprovalPolicy{}, errs.Validation{Field: "eligible_roles", Msg: fmt.Sprintf("must contain at most %d roles", maxMCPApprovalEligibleEntries)}
+	}
+	for _, role := range roles {
+		if err := s.validateMemberRole(ctx, projectID, role); err != nil {
+			return entitytypes.MCPApprovalPolicy{}, errs.Validation{Field: "eligible_roles", Msg: fmt.Sprintf("unknown role %q", role)}
+		}
+	}
+	userIDs := normalizeDistinctValues(params.EligibleUserIDs)
+	if len(userIDs) > maxMCPApprovalEligibleEntries {
+		return entitytypes.MCPApprovalPolicy{}, errs.Validation{Field: "eligible_user_ids", Msg: fmt.Sprintf("must contain at most %d users", maxMCPApprovalEligibleEntries)}
+	}
+	for _, userID := range userIDs {
+		if _, ok, err := s.users.GetByID(ctx, userID); err != nil {
+			return entitytypes.MCPApprovalPolicy{}, err
+		} else if !ok {
+			return entitytypes.MCPApprovalPolicy{}, errs.Validation{Field: "eligible_user_ids", Msg: fmt.Sprintf("user %q not found", userID)}
+		}
+	}
+
+	return s.approvals.Upsert(ctx, querytypes.MCPApprovalPolicyUpsertParams{
+		ProjectID:           projectID,
+		ToolName:            toolName,
+		Environment:         strings.ToLower(strings.TrimSpace(params.Environment)),
+		RequiredApprovals:   params.RequiredApprovals,
+		EligibleRoles:       roles,
+		EligibleUserIDs:     userIDs,
+		SeparationOfDuties:  params.SeparationOfDuties,
+		ExpiresAfterSeconds: params.ExpiresAfterSeconds,
+	})
+}
+
+// DeleteMCPApprovalPolicy removes one project approval policy (platform admin only).
+// Pending requests keep the policy snapshot taken when they were created.
+func (s *Service) DeleteMCPApprovalPolicy(ctx context.Context, principal Principal, projectID string, policyID string) error {
+	if err := s.requireMCPApprovalPolicyAdmin(principal); err != nil {
+		return err
+	}
+	projectID = strings.TrimSpace(projectID)
+	policyID = strings.TrimSpace(policyID)
+	if projectID == "" {
+		return errs.Validation{Field: "project_id", Msg: "is required"}
+	}
+	if policyID == "" {
+		return errs.Validation{Field: "policy_id", Msg: "is required"}
+	}
+	deleted, err := s.approvals.Delete(ctx, projectID, policyID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errs.NotFound{Msg: "approval policy not found"}
+	}
+	return nil
+}
+
+func (s *Service) requireMCPApprovalPolicyAdmin(principal Principal) error {
+	if !principal.IsPlatformAdmin {
+		return errs.Forbidden{Msg: "platform admin required"}
+	}
+	if s.approvals == nil {
+		return fmt.Errorf("mcp approval policy repository is not configured")
+	}
+	return nil
+}
+
+func normalizeDistinctValues(values []string) []string {
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" || slices.Contains(out, value) {
+			continue
+		}
+		out = append(out, value)
+	}
+	return out
+}
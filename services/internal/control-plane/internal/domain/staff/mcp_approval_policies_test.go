@@ -0,0 +1,85 @@
+package staff
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	mcpapprovalpolicyrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/mcpapprovalpolicy"
+	projectrolerepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectrole"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+type fakeMCPApprovalPolicyRepository struct {
+	mcpapprovalpolicyrepo.Repository
+	upserted []mcpapprovalpolicyrepo.UpsertParams
+}
+
+func (f *fakeMCPApprovalPolicyRepository) Upsert(_ context.Context, params mcpapprovalpolicyrepo.UpsertParams) (mcpapprovalpolicyrepo.Policy, error) {
+	f.upserted = append(f.upserted, params)
+	return mcpapprovalpolicyrepo.Policy{
+		ID:                 "policy-1",
+		ProjectID:          params.ProjectID,
+		ToolName:           params.ToolName,
+		Environment:        params.Environment,
+		RequiredApprovals:  params.RequiredApprovals,
+		EligibleRoles:      params.EligibleRoles,
+		SeparationOfDuties: params.SeparationOfDuties,
+	}, nil
+}
+
+func TestUpsertMCPApprovalPolicy_Validates(t *testing.T) {
+	t.Parallel()
+
+	service, roles, _ := newProjectRoleTestService()
+	roles.roles["p1/release-manager"] = projectrolerepo.Role{ProjectID: "p1", Name: "release-manager"}
+	policies := &fakeMCPApprovalPolicyRepository{}
+	service.approvals = policies
+	admin := Principal{UserID: "admin", IsPlatformAdmin: true}
+	ctx := context.Background()
+
+	valid := querytypes.MCPApprovalPolicyUpsertParams{
+		ProjectID:          "p1",
+		ToolName:           "secret.sync.k8s",
+		Environment:        " Production ",
+		RequiredApprovals:  2,
+		EligibleRoles:      []string{"admin", "release-manager", "admin"},
+		SeparationOfDuties: true,
+	}
+
+	if _, err := service.UpsertMCPApprovalPolicy(ctx, Principal{UserID: "u1"}, valid); !errors.As(err, new(errs.Forbidden)) {
+		t.Fatalf("UpsertMCPApprovalPolicy() by non-admin error = %v, want forbidden", err)
+	}
+
+	invalid := []func(p *querytypes.MCPApprovalPolicyUpsertParams){
+		func(p *querytypes.MCPApprovalPolicyUpsertParams) { p.ToolName = "github_labels_add" },
+		func(p *querytypes.MCPApprovalPolicyUpsertParams) { p.RequiredApprovals = 0 },
+		func(p *querytypes.MCPApprovalPolicyUpsertParams) { p.RequiredApprovals = 11 },
+		func(p *querytypes.MCPApprovalPolicyUpsertParams) { p.ExpiresAfterSeconds = -1 },
+		func(p *querytypes.MCPApprovalPolicyUpsertParams) { p.EligibleRoles = []string{"unknown"} },
+		func(p *querytypes.MCPApprovalPolicyUpsertParams) { p.ProjectID = "missing" },
+	}
+	for idx, mutate := range invalid {
+		params := valid
+		mutate(&params)
+		if _, err := service.UpsertMCPApprovalPolicy(ctx, admin, params); !errors.As(err, new(errs.Validation)) {
+			t.Fatalf("case %d: UpsertMCPApprovalPolicy() error = %v, want validation", idx, err)
+		}
+	}
+
+	got, err := service.UpsertMCPApprovalPolicy(ctx, admin, valid)
+	if err != nil {
+		t.Fatalf("UpsertMCPApprovalPolicy() error = %v", err)
+	}
+	if got.Environment != "production" {
+		t.Fatalf("environment = %q, want production", got.Environment)
+	}
+	if !slices.Equal(got.EligibleRoles, []string{"admin", "release-manager"}) {
+		t.Fatalf("eligible roles = %v, want deduplicated [admin release-manager]", got.EligibleRoles)
+	}
+	if len(policies.upserted) != 1 {
+		t.Fatalf("upserts = %d, want 1", len(policies.upserted))
+	}
+}
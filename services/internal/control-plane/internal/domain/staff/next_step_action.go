@@ -6,8 +6,10 @@ import (
 	"slices"
 	"strings"
 
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
 	"github.com/codex-k8s/kodex/libs/go/errs"
 	repoprovider "github.com/codex-k8s/kodex/libs/go/repo/provider"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
 )
 
@@ -17,14 +19,34 @@ func (s *Service) PreviewNextStepAction(ctx context.Context, principal Principal
 }
 
 // ExecuteNextStepAction applies one next-step action on GitHub labels.
+// Executed actions are audited in flow events and reset the stage auto-advance chain.
 func (s *Service) ExecuteNextStepAction(ctx context.Context, principal Principal, params querytypes.NextStepActionParams) (querytypes.NextStepActionResult, error) {
-	return s.resolveNextStepAction(ctx, principal, params, true)
+	result, err := s.resolveNextStepAction(ctx, principal, params, true)
+	if err != nil {
+		return querytypes.NextStepActionResult{}, err
+	}
+	owner, repo, _ := parseGitHubFullName(result.RepositoryFullName)
+	// Labels are already applied on GitHub, so audit is best-effort here.
+	_ = s.recordNextStepActionExecuted(
+		ctx,
+		nextStepCorrelationID(owner, repo, result.ThreadKind, result.ThreadNumber),
+		floweventdomain.ActorTypeHuman,
+		floweventdomain.ActorID(principal.UserID),
+		nextStepActionExecutedPayload{
+			Source:             querytypes.NextStepActionSourceManual,
+			RepositoryFullName: result.RepositoryFullName,
+			ThreadKind:         result.ThreadKind,
+			ThreadNumber:       result.ThreadNumber,
+			RemovedLabels:      result.RemovedLabels,
+			AddedLabels:        result.AddedLabels,
+			ActorUserID:        principal.UserID,
+			ActorLogin:         principal.GitHubLogin,
+		},
+	)
+	return result, nil
 }
 
 func (s *Service) resolveNextStepAction(ctx context.Context, principal Principal, params querytypes.NextStepActionParams, apply bool) (querytypes.NextStepActionResult, error) {
-	if !principal.IsPlatformAdmin {
-		return querytypes.NextStepActionResult{}, errs.Forbidden{Msg: "platform admin required"}
-	}
 	if s.githubMgmt == nil {
 		return querytypes.NextStepActionResult{}, fmt.Errorf("failed_precondition: github management client is not configured")
 	}
@@ -54,6 +76,10 @@ func (s *Service) resolveNextStepAction(ctx context.Context, principal Principal
 	if !ok {
 		return querytypes.NextStepActionResult{}, errs.Validation{Field: "repository_full_name", Msg: "repository is not bound to any project"}
 	}
+	// Qualified grants let custom roles run next-step actions only for selected stages.
+	if err := s.RequireProjectPermission(ctx, principal, binding.ProjectID, enumtypes.ProjectPermissionNextStepExecute, targetLabel); err != nil {
+		return querytypes.NextStepActionResult{}, err
+	}
 
 	_, botToken, _, _, err := s.resolveEffectiveGitHubTokens(ctx, binding.ProjectID, binding.RepositoryID)
 	if err != nil {
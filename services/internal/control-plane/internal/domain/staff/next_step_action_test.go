@@ -2,6 +2,7 @@ package staff
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	nextstepdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/nextstep"
@@ -12,6 +13,8 @@ type stubNextStepGitHubMgmt struct {
 	labels        []string
 	addedLabels   [][]string
 	removedLabels []string
+	createdIssues []string
+	approved      bool
 }
 
 func (s *stubNextStepGitHubMgmt) Preflight(context.Context, valuetypes.GitHubPreflightParams) (valuetypes.GitHubPreflightReport, error) {
@@ -30,6 +33,13 @@ func (s *stubNextStepGitHubMgmt) CreatePullRequestWithFiles(context.Context, str
 	return 0, "", nil
 }
 
+func (s *stubNextStepGitHubMgmt) CreateIssue(_ context.Context, _ string, _ string, _ string, title string, _ string, labels []string) (int, string, error) {
+	s.createdIssues = append(s.createdIssues, title)
+	s.labels = append(s.labels, labels...)
+	number := 500 + len(s.createdIssues)
+	return number, fmt.Sprintf("https://github.com/kodex/kodex/issues/%d", number), nil
+}
+
 func (s *stubNextStepGitHubMgmt) ListIssueLabels(context.Context, string, string, string, int) ([]string, error) {
 	return append([]string(nil), s.labels...), nil
 }
@@ -54,6 +64,10 @@ func (s *stubNextStepGitHubMgmt) RemoveIssueLabel(_ context.Context, _ string, _
 	return nil
 }
 
+func (s *stubNextStepGitHubMgmt) IsPullRequestApproved(context.Context, string, string, string, int, string) (bool, error) {
+	return s.approved, nil
+}
+
 var _ githubManagementClient = (*stubNextStepGitHubMgmt)(nil)
 
 func TestPreviewOrExecuteIssueStageTransition_AcceptsConfiguredReviseLabels(t *testing.T) {
@@ -0,0 +1,179 @@
+package staff
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/projectrbac"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+const maxProjectRoleDescriptionLen = 512
+
+var projectRoleNamePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,62}$`)
+
+// ResolveProjectPermissions returns effective permissions of principal in project.
+// Platform admins get every permission; non-members get project access error.
+func (s *Service) ResolveProjectPermissions(ctx context.Context, principal Principal, projectID string) (projectrbac.PermissionSet, error) {
+	if principal.IsPlatformAdmin {
+		return projectrbac.AllPermissions(), nil
+	}
+	if strings.TrimSpace(projectID) == "" {
+		return projectrbac.PermissionSet{}, errs.Forbidden{Msg: "project access required"}
+	}
+	_, permissions, ok, err := projectrbac.NewResolver(s.members, s.roles).Resolve(ctx, projectID, principal.UserID)
+	if err != nil {
+		return projectrbac.PermissionSet{}, err
+	}
+	if !ok {
+		return projectrbac.PermissionSet{}, errs.Forbidden{Msg: "project access required"}
+	}
+	return permissions, nil
+}
+
+// RequireProjectPermission ensures principal role grants permission in project.
+// Qualifier narrows qualified permissions (MCP tool, run:* label, Mission Control command kind).
+func (s *Service) RequireProjectPermission(ctx context.Context, principal Principal, projectID string, permission enumtypes.ProjectPermission, qualifier string) error {
+	permissions, err := s.ResolveProjectPermissions(ctx, principal, projectID)
+	if err != nil {
+		return err
+	}
+	if !permissions.Allows(permission, qualifier) {
+		if qualifier = strings.TrimSpace(qualifier); qualifier != "" && projectrbac.IsQualified(permission) {
+			return errs.Forbidden{Msg: fmt.Sprintf("project permission %s:%s required", permission, qualifier)}
+		}
+		return errs.Forbidden{Msg: fmt.Sprintf("project permission %s required", permission)}
+	}
+	return nil
+}
+
+// ListProjectRoles returns built-in and custom roles available in project.
+func (s *Service) ListProjectRoles(ctx context.Context, principal Principal, projectID string) ([]entitytypes.ProjectRole, error) {
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" {
+		return nil, errs.Validation{Field: "project_id", Msg: "is required"}
+	}
+	if err := s.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionProjectRead, ""); err != nil {
+		return nil, err
+	}
+
+	out := make([]entitytypes.ProjectRole, 0, len(projectrbac.BuiltinRoleNames))
+	for _, name := range projectrbac.BuiltinRoleNames {
+		grants, _ := projectrbac.BuiltinPermissions(string(name))
+		out = append(out, entitytypes.ProjectRole{
+			ProjectID:   projectID,
+			Name:        string(name),
+			Permissions: grants,
+			Builtin:     true,
+		})
+	}
+	if s.roles == nil {
+		return out, nil
+	}
+	custom, err := s.roles.List(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	return append(out, custom...), nil
+}
+
+// UpsertProjectRole creates or replaces a custom project role (platform admin only).
+func (s *Service) UpsertProjectRole(ctx context.Context, principal Principal, params querytypes.ProjectRoleUpsertParams) (entitytypes.ProjectRole, error) {
+	if err := s.requireProjectRoleAdmin(principal); err != nil {
+		return entitytypes.ProjectRole{}, err
+	}
+	projectID := strings.TrimSpace(params.ProjectID)
+	if projectID == "" {
+		return entitytypes.ProjectRole{}, errs.Validation{Field: "project_id", Msg: "is required"}
+	}
+	name := strings.TrimSpace(params.Name)
+	if !projectRoleNamePattern.MatchString(name) {
+		return entitytypes.ProjectRole{}, errs.Validation{Field: "name", Msg: "must start with a letter and contain only lowercase letters, digits, '_' and '-'"}
+	}
+	if projectrbac.IsBuiltinRole(name) {
+		return entitytypes.ProjectRole{}, errs.Validation{Field: "name", Msg: fmt.Sprintf("%q is a built-in role", name)}
+	}
+	description := strings.TrimSpace(params.Description)
+	if len(description) > maxProjectRoleDescriptionLen {
+		return entitytypes.ProjectRole{}, errs.Validation{Field: "description", Msg: fmt.Sprintf("must be at most %d characters", maxProjectRoleDescriptionLen)}
+	}
+	permissions, err := projectrbac.NormalizeGrants(params.Permissions)
+	if err != nil {
+		return entitytypes.ProjectRole{}, errs.Validation{Field: "permissions", Msg: err.Error()}
+	}
+	if _, ok, err := s.projects.GetByID(ctx, projectID); err != nil {
+		return entitytypes.ProjectRole{}, err
+	} else if !ok {
+		return entitytypes.ProjectRole{}, errs.Validation{Field: "project_id", Msg: "not found"}
+	}
+
+	return s.roles.Upsert(ctx, querytypes.ProjectRoleUpsertParams{
+		ProjectID:   projectID,
+		Name:        name,
+		Description: description,
+		Permissions: permissions,
+	})
+}
+
+// DeleteProjectRole removes a custom project role that is no longer assigned (platform admin only).
+func (s *Service) DeleteProjectRole(ctx context.Context, principal Principal, projectID string, name string) error {
+	if err := s.requireProjectRoleAdmin(principal); err != nil {
+		return err
+	}
+	projectID = strings.TrimSpace(projectID)
+	name = strings.TrimSpace(name)
+	if projectID == "" {
+		return errs.Validation{Field: "project_id", Msg: "is required"}
+	}
+	if name == "" {
+		return errs.Validation{Field: "name", Msg: "is required"}
+	}
+	if projectrbac.IsBuiltinRole(name) {
+		return errs.Validation{Field: "name", Msg: "built-in roles cannot be deleted"}
+	}
+	deleted, err := s.roles.Delete(ctx, projectID, name)
+	if err != nil {
+		return err
+	}
+	if deleted {
+		return nil
+	}
+	if _, ok, err := s.roles.GetByName(ctx, projectID, name); err != nil {
+		return err
+	} else if ok {
+		return errs.Conflict{Msg: "project role is assigned to members"}
+	}
+	return errs.NotFound{Msg: "project role not found"}
+}
+
+// validateMemberRole accepts built-in roles and custom roles defined in the project.
+func (s *Service) validateMemberRole(ctx context.Context, projectID string, role string) error {
+	if projectrbac.IsBuiltinRole(role) {
+		return nil
+	}
+	if s.roles != nil && projectRoleNamePattern.MatchString(role) {
+		_, ok, err := s.roles.GetByName(ctx, projectID, role)
+		if err != nil {
+			return err
+		}
+		if ok {
+			return nil
+		}
+	}
+	return errs.Validation{Field: "role", Msg: fmt.Sprintf("invalid role %q", role)}
+}
+
+func (s *Service) requireProjectRoleAdmin(principal Principal) error {
+	if !principal.IsPlatformAdmin {
+		return errs.Forbidden{Msg: "platform admin required"}
+	}
+	if s.roles == nil {
+		return fmt.Errorf("project role repository is not configured")
+	}
+	return nil
+}
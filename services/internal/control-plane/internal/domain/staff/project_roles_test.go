@@ -0,0 +1,141 @@
+package staff
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	projectrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/project"
+	projectmemberrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectmember"
+	projectrolerepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectrole"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+type fakeProjectRoleRepository struct {
+	projectrolerepo.Repository
+	roles    map[string]projectrolerepo.Role
+	assigned map[string]bool
+}
+
+func (f *fakeProjectRoleRepository) Upsert(_ context.Context, params projectrolerepo.UpsertParams) (projectrolerepo.Role, error) {
+	role := projectrolerepo.Role{ProjectID: params.ProjectID, Name: params.Name, Description: params.Description, Permissions: params.Permissions}
+	f.roles[params.ProjectID+"/"+params.Name] = role
+	return role, nil
+}
+
+func (f *fakeProjectRoleRepository) GetByName(_ context.Context, projectID string, name string) (projectrolerepo.Role, bool, error) {
+	role, ok := f.roles[projectID+"/"+name]
+	return role, ok, nil
+}
+
+func (f *fakeProjectRoleRepository) Delete(_ context.Context, projectID string, name string) (bool, error) {
+	key := projectID + "/" + name
+	if _, ok := f.roles[key]; !ok || f.assigned[key] {
+		return false, nil
+	}
+	delete(f.roles, key)
+	return true, nil
+}
+
+type fakeProjectRoleMembers struct {
+	projectmemberrepo.Repository
+	roles map[string]string
+}
+
+func (f *fakeProjectRoleMembers) GetRole(_ context.Context, projectID string, userID string) (string, bool, error) {
+	role, ok := f.roles[projectID+"/"+userID]
+	return role, ok, nil
+}
+
+func (f *fakeProjectRoleMembers) Upsert(_ context.Context, projectID string, userID string, role string) error {
+	f.roles[projectID+"/"+userID] = role
+	return nil
+}
+
+type fakeProjectRoleProjects struct {
+	projectrepo.Repository
+}
+
+func (fakeProjectRoleProjects) GetByID(_ context.Context, projectID string) (projectrepo.Project, bool, error) {
+	return projectrepo.Project{ID: projectID}, projectID == "p1", nil
+}
+
+func newProjectRoleTestService() (*Service, *fakeProjectRoleRepository, *fakeProjectRoleMembers) {
+	roles := &fakeProjectRoleRepository{roles: map[string]projectrolerepo.Role{}, assigned: map[string]bool{}}
+	members := &fakeProjectRoleMembers{roles: map[string]string{}}
+	return &Service{roles: roles, members: members, projects: fakeProjectRoleProjects{}}, roles, members
+}
+
+func TestUpsertProjectRole_ValidatesAndGrantsQualifiedPermissions(t *testing.T) {
+	t.Parallel()
+
+	service, _, members := newProjectRoleTestService()
+	admin := Principal{UserID: "admin", IsPlatformAdmin: true}
+	ctx := context.Background()
+
+	if _, err := service.UpsertProjectRole(ctx, Principal{UserID: "u1"}, querytypes.ProjectRoleUpsertParams{ProjectID: "p1", Name: "approver"}); !errors.As(err, new(errs.Forbidden)) {
+		t.Fatalf("UpsertProjectRole() by non-admin error = %v, want forbidden", err)
+	}
+	if _, err := service.UpsertProjectRole(ctx, admin, querytypes.ProjectRoleUpsertParams{ProjectID: "p1", Name: "admin"}); !errors.As(err, new(errs.Validation)) {
+		t.Fatalf("UpsertProjectRole() builtin name error = %v, want validation", err)
+	}
+	if _, err := service.UpsertProjectRole(ctx, admin, querytypes.ProjectRoleUpsertParams{ProjectID: "p1", Name: "docs", Permissions: []string{"repositories.write"}}); !errors.As(err, new(errs.Validation)) {
+		t.Fatalf("UpsertProjectRole() unknown permission error = %v, want validation", err)
+	}
+
+	role, err := service.UpsertProjectRole(ctx, admin, querytypes.ProjectRoleUpsertParams{
+		ProjectID:   "p1",
+		Name:        "doc-operator",
+		Permissions: []string{"project.read", "next_step.execute:run:doc-audit"},
+	})
+	if err != nil {
+		t.Fatalf("UpsertProjectRole() error = %v", err)
+	}
+	if len(role.Permissions) != 2 {
+		t.Fatalf("permissions = %#v", role.Permissions)
+	}
+
+	if err := service.UpsertProjectMember(ctx, admin, "p1", "u1", "doc-operator"); err != nil {
+		t.Fatalf("UpsertProjectMember() error = %v", err)
+	}
+	if err := service.UpsertProjectMember(ctx, admin, "p1", "u2", "missing"); !errors.As(err, new(errs.Validation)) {
+		t.Fatalf("UpsertProjectMember() unknown role error = %v, want validation", err)
+	}
+	if members.roles["p1/u1"] != "doc-operator" {
+		t.Fatalf("member role = %q", members.roles["p1/u1"])
+	}
+
+	member := Principal{UserID: "u1"}
+	if err := service.RequireProjectPermission(ctx, member, "p1", enumtypes.ProjectPermissionNextStepExecute, "run:doc-audit"); err != nil {
+		t.Fatalf("RequireProjectPermission(doc stage) error = %v", err)
+	}
+	if err := service.RequireProjectPermission(ctx, member, "p1", enumtypes.ProjectPermissionNextStepExecute, "run:dev"); !errors.As(err, new(errs.Forbidden)) {
+		t.Fatalf("RequireProjectPermission(dev stage) error = %v, want forbidden", err)
+	}
+	if err := service.RequireProjectPermission(ctx, member, "p1", enumtypes.ProjectPermissionRepositoriesManage, ""); !errors.As(err, new(errs.Forbidden)) {
+		t.Fatalf("RequireProjectPermission(repositories) error = %v, want forbidden", err)
+	}
+}
+
+func TestDeleteProjectRole_RejectsAssignedRole(t *testing.T) {
+	t.Parallel()
+
+	service, roles, _ := newProjectRoleTestService()
+	admin := Principal{UserID: "admin", IsPlatformAdmin: true}
+	ctx := context.Background()
+	roles.roles["p1/approver"] = projectrolerepo.Role{ProjectID: "p1", Name: "approver"}
+	roles.assigned["p1/approver"] = true
+
+	if err := service.DeleteProjectRole(ctx, admin, "p1", "approver"); !errors.As(err, new(errs.Conflict)) {
+		t.Fatalf("DeleteProjectRole() assigned error = %v, want conflict", err)
+	}
+	if err := service.DeleteProjectRole(ctx, admin, "p1", "missing"); !errors.As(err, new(errs.NotFound)) {
+		t.Fatalf("DeleteProjectRole() missing error = %v, want not found", err)
+	}
+	roles.assigned["p1/approver"] = false
+	if err := service.DeleteProjectRole(ctx, admin, "p1", "approver"); err != nil {
+		t.Fatalf("DeleteProjectRole() error = %v", err)
+	}
+}
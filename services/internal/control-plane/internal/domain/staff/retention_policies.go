@@ -0,0 +1,99 @@
+package staff
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	retentionrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/retention"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+const maxRetentionDays = 3650
+
+// ListRetentionPolicies returns global retention policies and project overrides.
+// Non-empty projectID narrows overrides to that project.
+func (s *Service) ListRetentionPolicies(ctx context.Context, principal Principal, projectID string) ([]retentionrepo.Policy, error) {
+	if err := s.requireRetentionAdmin(principal); err != nil {
+		return nil, err
+	}
+	return s.retention.ListPolicies(ctx, strings.TrimSpace(projectID))
+}
+
+// UpsertRetentionPolicy creates or replaces global policy or project override for one target.
+func (s *Service) UpsertRetentionPolicy(ctx context.Context, principal Principal, params querytypes.RetentionPolicyUpsertParams) (retentionrepo.Policy, error) {
+	if err := s.requireRetentionAdmin(principal); err != nil {
+		return retentionrepo.Policy{}, err
+	}
+	params.ProjectID = strings.TrimSpace(params.ProjectID)
+	if !slices.Contains(enumtypes.RetentionTargets, params.Target) {
+		return retentionrepo.Policy{}, errs.Validation{Field: "target", Msg: "is not supported"}
+	}
+	switch params.Action {
+	case enumtypes.RetentionActionDelete, enumtypes.RetentionActionArchive:
+	default:
+		return retentionrepo.Policy{}, errs.Validation{Field: "action", Msg: "must be delete or archive"}
+	}
+	if params.RetentionDays < 1 || params.RetentionDays > maxRetentionDays {
+		return retentionrepo.Policy{}, errs.Validation{Field: "retention_days", Msg: fmt.Sprintf("must be between 1 and %d", maxRetentionDays)}
+	}
+	if params.ProjectID != "" {
+		if _, ok, err := s.projects.GetByID(ctx, params.ProjectID); err != nil {
+			return retentionrepo.Policy{}, err
+		} else if !ok {
+			return retentionrepo.Policy{}, errs.Validation{Field: "project_id", Msg: "not found"}
+		}
+	}
+	params.ActorID = principal.UserID
+	return s.retention.UpsertPolicy(ctx, params)
+}
+
+// DeleteRetentionPolicy removes project override, so project falls back to global policy.
+func (s *Service) DeleteRetentionPolicy(ctx context.Context, principal Principal, policyID string) error {
+	if err := s.requireRetentionAdmin(principal); err != nil {
+		return err
+	}
+	policyID = strings.TrimSpace(policyID)
+	if policyID == "" {
+		return errs.Validation{Field: "policy_id", Msg: "is required"}
+	}
+	deleted, err := s.retention.DeletePolicy(ctx, policyID)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return errs.NotFound{Msg: "project retention policy not found"}
+	}
+	return nil
+}
+
+// SetFlowEventRetentionPin pins or unpins one flow event, so retention never drops pinned events.
+func (s *Service) SetFlowEventRetentionPin(ctx context.Context, principal Principal, eventID int64, pinned bool) error {
+	if err := s.requireRetentionAdmin(principal); err != nil {
+		return err
+	}
+	if eventID <= 0 {
+		return errs.Validation{Field: "event_id", Msg: "must be positive"}
+	}
+	updated, err := s.retention.SetFlowEventPinned(ctx, eventID, pinned)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		return errs.NotFound{Msg: "flow event not found"}
+	}
+	return nil
+}
+
+func (s *Service) requireRetentionAdmin(principal Principal) error {
+	if !principal.IsPlatformAdmin {
+		return errs.Forbidden{Msg: "platform admin required"}
+	}
+	if s.retention == nil {
+		return fmt.Errorf("retention repository is not configured")
+	}
+	return nil
+}
@@ -7,11 +7,7 @@ import (
 
 	"github.com/codex-k8s/kodex/libs/go/errs"
 	runstatusdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/runstatus"
-)
-
-const (
-	projectRoleReadWrite = "read_write"
-	projectRoleAdmin     = "admin"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 )
 
 type runNamespaceService interface {
@@ -86,16 +82,5 @@ func (s *Service) requireRunWriteAccess(ctx context.Context, principal Principal
 	if strings.TrimSpace(projectID) == "" {
 		return errs.Forbidden{Msg: "run is not assigned to a project"}
 	}
-
-	role, hasRole, err := s.members.GetRole(ctx, projectID, principal.UserID)
-	if err != nil {
-		return err
-	}
-	if !hasRole {
-		return errs.Forbidden{Msg: "project access required"}
-	}
-	if role != projectRoleAdmin && role != projectRoleReadWrite {
-		return errs.Forbidden{Msg: "project write access required"}
-	}
-	return nil
+	return s.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionRunsCancel, "")
 }
@@ -0,0 +1,97 @@
+package staff
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
+)
+
+const (
+	runOutcomeDefaultRange = 30 * 24 * time.Hour
+	runOutcomeMaxRange     = 366 * 24 * time.Hour
+)
+
+// GetRunOutcomeAnalytics aggregates issue/PR lineage outcomes (merge rate, revise rounds, time-to-merge)
+// for runs started in [From, To). Non-admin principals see only projects they are members of.
+func (s *Service) GetRunOutcomeAnalytics(ctx context.Context, principal Principal, params querytypes.RunOutcomeAnalyticsParams) (valuetypes.RunOutcomeAnalytics, error) {
+	if s.runOutcomes == nil {
+		return valuetypes.RunOutcomeAnalytics{}, fmt.Errorf("run outcome repository is not configured")
+	}
+	normalized, err := normalizeRunOutcomeAnalyticsParams(params, time.Now().UTC())
+	if err != nil {
+		return valuetypes.RunOutcomeAnalytics{}, err
+	}
+	if !principal.IsPlatformAdmin {
+		normalized.UserID = principal.UserID
+		if normalized.ProjectID != "" {
+			if err := s.RequireProjectPermission(ctx, principal, normalized.ProjectID, enumtypes.ProjectPermissionProjectRead, ""); err != nil {
+				return valuetypes.RunOutcomeAnalytics{}, err
+			}
+		}
+	}
+
+	groups, err := s.runOutcomes.ListGroups(ctx, normalized)
+	if err != nil {
+		return valuetypes.RunOutcomeAnalytics{}, err
+	}
+	totalsParams := normalized
+	totalsParams.GroupBy = nil
+	totals, err := s.runOutcomes.ListGroups(ctx, totalsParams)
+	if err != nil {
+		return valuetypes.RunOutcomeAnalytics{}, err
+	}
+
+	result := valuetypes.RunOutcomeAnalytics{
+		From:    normalized.From,
+		To:      normalized.To,
+		GroupBy: normalized.GroupBy,
+		Groups:  groups,
+	}
+	if len(totals) > 0 {
+		result.Totals = totals[0]
+	}
+	return result, nil
+}
+
+func normalizeRunOutcomeAnalyticsParams(params querytypes.RunOutcomeAnalyticsParams, now time.Time) (querytypes.RunOutcomeAnalyticsParams, error) {
+	params.ProjectID = strings.TrimSpace(params.ProjectID)
+	params.UserID = ""
+	if params.To.IsZero() {
+		params.To = now
+	}
+	if params.From.IsZero() {
+		params.From = params.To.Add(-runOutcomeDefaultRange)
+	}
+	params.From = params.From.UTC()
+	params.To = params.To.UTC()
+	if !params.From.Before(params.To) {
+		return querytypes.RunOutcomeAnalyticsParams{}, errs.Validation{Field: "from", Msg: "must be before to"}
+	}
+	if params.To.Sub(params.From) > runOutcomeMaxRange {
+		return querytypes.RunOutcomeAnalyticsParams{}, errs.Validation{Field: "from", Msg: "date range must not exceed 366 days"}
+	}
+
+	if len(params.GroupBy) == 0 {
+		params.GroupBy = slices.Clone(enumtypes.RunOutcomeDimensions)
+		return params, nil
+	}
+	groupBy := make([]enumtypes.RunOutcomeDimension, 0, len(params.GroupBy))
+	for _, raw := range params.GroupBy {
+		dimension := enumtypes.RunOutcomeDimension(strings.ToLower(strings.TrimSpace(string(raw))))
+		if !slices.Contains(enumtypes.RunOutcomeDimensions, dimension) {
+			return querytypes.RunOutcomeAnalyticsParams{}, errs.Validation{Field: "group_by", Msg: fmt.Sprintf("unsupported dimension %q", raw)}
+		}
+		if !slices.Contains(groupBy, dimension) {
+			groupBy = append(groupBy, dimension)
+		}
+	}
+	params.GroupBy = groupBy
+	return params, nil
+}
@@ -0,0 +1,110 @@
+package staff
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	projectmemberrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectmember"
+	runoutcomerepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/runoutcome"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+type fakeRunOutcomeRepository struct {
+	runoutcomerepo.Repository
+	calls []runoutcomerepo.AnalyticsParams
+}
+
+func (f *fakeRunOutcomeRepository) ListGroups(_ context.Context, params runoutcomerepo.AnalyticsParams) ([]runoutcomerepo.Group, error) {
+	f.calls = append(f.calls, params)
+	if len(params.GroupBy) == 0 {
+		return []runoutcomerepo.Group{{Lineages: 3, PRsMerged: 2, PRsClosedUnmerged: 1}}, nil
+	}
+	return []runoutcomerepo.Group{{Role: "dev", Lineages: 2}, {Role: "qa", Lineages: 1}}, nil
+}
+
+type fakeRunOutcomeMembers struct {
+	projectmemberrepo.Repository
+	roles map[string]string
+}
+
+func (f *fakeRunOutcomeMembers) GetRole(_ context.Context, projectID string, userID string) (string, bool, error) {
+	role, ok := f.roles[projectID+"/"+userID]
+	return role, ok, nil
+}
+
+func TestGetRunOutcomeAnalytics_DefaultsAndTotals(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeRunOutcomeRepository{}
+	service := &Service{runOutcomes: repo}
+	to := time.Date(2026, 3, 28, 0, 0, 0, 0, time.UTC)
+
+	result, err := service.GetRunOutcomeAnalytics(context.Background(), Principal{UserID: "admin", IsPlatformAdmin: true}, querytypes.RunOutcomeAnalyticsParams{To: to})
+	if err != nil {
+		t.Fatalf("GetRunOutcomeAnalytics() error = %v", err)
+	}
+	if want := to.Add(-30 * 24 * time.Hour); !result.From.Equal(want) {
+		t.Fatalf("from = %s, want %s", result.From, want)
+	}
+	if !slices.Equal(result.GroupBy, enumtypes.RunOutcomeDimensions) {
+		t.Fatalf("group_by = %#v, want all dimensions", result.GroupBy)
+	}
+	if len(result.Groups) != 2 || result.Totals.Lineages != 3 {
+		t.Fatalf("unexpected analytics result: %#v", result)
+	}
+	if len(repo.calls) != 2 || repo.calls[0].UserID != "" || len(repo.calls[1].GroupBy) != 0 {
+		t.Fatalf("unexpected repository calls: %#v", repo.calls)
+	}
+}
+
+func TestGetRunOutcomeAnalytics_RestrictsNonAdminToMemberProjects(t *testing.T) {
+	t.Parallel()
+
+	repo := &fakeRunOutcomeRepository{}
+	service := &Service{
+		runOutcomes: repo,
+		members:     &fakeRunOutcomeMembers{roles: map[string]string{"p1/u1": "read"}},
+	}
+	principal := Principal{UserID: "u1"}
+
+	if _, err := service.GetRunOutcomeAnalytics(context.Background(), principal, querytypes.RunOutcomeAnalyticsParams{
+		GroupBy: []enumtypes.RunOutcomeDimension{" Model ", "role", "model"},
+	}); err != nil {
+		t.Fatalf("GetRunOutcomeAnalytics() error = %v", err)
+	}
+	if got := repo.calls[0]; got.UserID != "u1" || !slices.Equal(got.GroupBy, []enumtypes.RunOutcomeDimension{"model", "role"}) {
+		t.Fatalf("unexpected repository params: %#v", got)
+	}
+
+	_, err := service.GetRunOutcomeAnalytics(context.Background(), principal, querytypes.RunOutcomeAnalyticsParams{ProjectID: "p2"})
+	var forbidden errs.Forbidden
+	if !errors.As(err, &forbidden) {
+		t.Fatalf("GetRunOutcomeAnalytics() error = %v, want forbidden", err)
+	}
+}
+
+func TestGetRunOutcomeAnalytics_ValidatesParams(t *testing.T) {
+	t.Parallel()
+
+	service := &Service{runOutcomes: &fakeRunOutcomeRepository{}}
+	admin := Principal{UserID: "admin", IsPlatformAdmin: true}
+	now := time.Date(2026, 3, 28, 0, 0, 0, 0, time.UTC)
+
+	cases := []querytypes.RunOutcomeAnalyticsParams{
+		{From: now, To: now},
+		{From: now.Add(-400 * 24 * time.Hour), To: now},
+		{To: now, GroupBy: []enumtypes.RunOutcomeDimension{"repository"}},
+	}
+	for _, params := range cases {
+		_, err := service.GetRunOutcomeAnalytics(context.Background(), admin, params)
+		var validation errs.Validation
+		if !errors.As(err, &validation) {
+			t.Fatalf("GetRunOutcomeAnalytics(%#v) error = %v, want validation", params, err)
+		}
+	}
+}
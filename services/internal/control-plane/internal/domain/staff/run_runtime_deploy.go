@@ -92,3 +92,34 @@ func (s *Service) RequestRuntimeDeployTaskAction(
 		},
 	})
 }
+
+// PreviewRuntimeDeploy renders one runtime deploy target and dry-runs it against live namespace state (platform admin only).
+func (s *Service) PreviewRuntimeDeploy(ctx context.Context, principal Principal, params runtimedeploydomain.PreviewParams) (runtimedeploydomain.PreviewResult, error) {
+	if !principal.IsPlatformAdmin {
+		return runtimedeploydomain.PreviewResult{}, errs.Forbidden{Msg: "platform admin required"}
+	}
+	if s.runtimeDeploy == nil {
+		return runtimedeploydomain.PreviewResult{}, errs.Validation{Field: "runtime_deploy", Msg: "runtime deploy service is not configured"}
+	}
+	params.Namespace = strings.TrimSpace(params.Namespace)
+	params.TargetEnv = strings.TrimSpace(params.TargetEnv)
+	params.RepositoryFullName = strings.TrimSpace(params.RepositoryFullName)
+	params.ServicesYAMLPath = strings.TrimSpace(params.ServicesYAMLPath)
+	params.BuildRef = strings.TrimSpace(params.BuildRef)
+	if params.BuildRef == "" {
+		return runtimedeploydomain.PreviewResult{}, errs.Validation{Field: "build_ref", Msg: "is required"}
+	}
+	if params.TargetEnv == "" {
+		return runtimedeploydomain.PreviewResult{}, errs.Validation{Field: "target_env", Msg: "is required"}
+	}
+	if params.SlotNo < 0 {
+		return runtimedeploydomain.PreviewResult{}, errs.Validation{Field: "slot_no", Msg: "must be >= 0"}
+	}
+	if params.RepositoryFullName != "" {
+		owner, name, ok := strings.Cut(params.RepositoryFullName, "/")
+		if !ok || strings.TrimSpace(owner) == "" || strings.TrimSpace(name) == "" {
+			return runtimedeploydomain.PreviewResult{}, errs.Validation{Field: "repository_full_name", Msg: "must be in owner/name form"}
+		}
+	}
+	return s.runtimeDeploy.PreviewDeploy(ctx, params)
+}
@@ -0,0 +1,297 @@
+package staff
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	repoprovider "github.com/codex-k8s/kodex/libs/go/repo/provider"
+	repocfgrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/repocfg"
+	runtimeerrorrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/runtimeerror"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+const (
+	runtimeErrorGroupRepositoryLookupLimit = 50
+	runtimeErrorGroupIssueTitleMaxLength   = 240
+	runtimeErrorGroupIssueMarkerPrefix     = "<!-- kodex:runtime-error-group"
+)
+
+// ListRuntimeErrorGroups returns runtime error fingerprint groups visible to principal.
+func (s *Service) ListRuntimeErrorGroups(ctx context.Context, principal Principal, filter querytypes.RuntimeErrorGroupListFilter) ([]runtimeerrorrepo.Group, error) {
+	if s.runtimeErrors == nil {
+		return nil, fmt.Errorf("runtime error repository is not configured")
+	}
+	normalized := normalizeRuntimeErrorGroupListFilter(filter)
+	if principal.IsPlatformAdmin {
+		return s.runtimeErrors.ListGroups(ctx, normalized)
+	}
+	return s.runtimeErrors.ListGroupsForUser(ctx, principal.UserID, normalized)
+}
+
+// UpdateRuntimeErrorGroupStatus mutes, resolves or reopens one runtime error group.
+// Resolved groups and groups with expired mute reopen automatically on the next occurrence.
+func (s *Service) UpdateRuntimeErrorGroupStatus(ctx context.Context, principal Principal, params querytypes.RuntimeErrorGroupStatusParams) (runtimeerrorrepo.Group, error) {
+	if s.runtimeErrors == nil {
+		return runtimeerrorrepo.Group{}, fmt.Errorf("runtime error repository is not configured")
+	}
+	groupID := strings.TrimSpace(params.GroupID)
+	if groupID == "" {
+		return runtimeerrorrepo.Group{}, errs.Validation{Field: "group_id", Msg: "is required"}
+	}
+	if err := validateRuntimeErrorGroupStatus(params.Status, params.MutedUntil, time.Now().UTC()); err != nil {
+		return runtimeerrorrepo.Group{}, err
+	}
+
+	group, ok, err := s.runtimeErrors.GetGroupByID(ctx, groupID)
+	if err != nil {
+		return runtimeerrorrepo.Group{}, err
+	}
+	if !ok {
+		return runtimeerrorrepo.Group{}, errs.Validation{Field: "group_id", Msg: "not found"}
+	}
+	if err := s.authorizeRuntimeErrorProject(ctx, principal, group.ProjectID); err != nil {
+		return runtimeerrorrepo.Group{}, err
+	}
+
+	mutedUntil := params.MutedUntil
+	if params.Status != enumtypes.RuntimeErrorGroupStatusMuted {
+		mutedUntil = nil
+	}
+	updated, updatedOK, err := s.runtimeErrors.UpdateGroupStatus(ctx, querytypes.RuntimeErrorGroupStatusParams{
+		GroupID:    groupID,
+		Status:     params.Status,
+		MutedUntil: mutedUntil,
+		ActorID:    principal.UserID,
+	})
+	if err != nil {
+		return runtimeerrorrepo.Group{}, err
+	}
+	if !updatedOK {
+		return runtimeerrorrepo.Group{}, errs.Validation{Field: "group_id", Msg: "not found"}
+	}
+	return updated, nil
+}
+
+// EscalateRuntimeErrorGroup opens a GitHub issue for one runtime error group.
+// The ai_repair kind additionally applies run:ai-repair label so the issue starts an ai-repair run.
+func (s *Service) EscalateRuntimeErrorGroup(ctx context.Context, principal Principal, params querytypes.RuntimeErrorGroupEscalateParams) (runtimeerrorrepo.Group, error) {
+	if !principal.IsPlatformAdmin {
+		return runtimeerrorrepo.Group{}, errs.Forbidden{Msg: "platform admin required"}
+	}
+	if s.runtimeErrors == nil {
+		return runtimeerrorrepo.Group{}, fmt.Errorf("runtime error repository is not configured")
+	}
+	if s.githubMgmt == nil {
+		return runtimeerrorrepo.Group{}, errs.FailedPrecondition{Msg: "github management client is not configured"}
+	}
+	groupID := strings.TrimSpace(params.GroupID)
+	if groupID == "" {
+		return runtimeerrorrepo.Group{}, errs.Validation{Field: "group_id", Msg: "is required"}
+	}
+	switch params.Kind {
+	case enumtypes.RuntimeErrorGroupEscalationKindIssue, enumtypes.RuntimeErrorGroupEscalationKindAIRepair:
+	default:
+		return runtimeerrorrepo.Group{}, errs.Validation{Field: "kind", Msg: "must be one of issue, ai_repair"}
+	}
+
+	group, ok, err := s.runtimeErrors.GetGroupByID(ctx, groupID)
+	if err != nil {
+		return runtimeerrorrepo.Group{}, err
+	}
+	if !ok {
+		return runtimeerrorrepo.Group{}, errs.Validation{Field: "group_id", Msg: "not found"}
+	}
+	if runtimeErrorGroupEscalationSatisfied(group, params.Kind) {
+		return group, nil
+	}
+	if strings.TrimSpace(group.ProjectID) == "" {
+		return runtimeerrorrepo.Group{}, errs.FailedPrecondition{Msg: "runtime error group is not bound to a project"}
+	}
+
+	bindings, err := s.repos.ListForProject(ctx, group.ProjectID, runtimeErrorGroupRepositoryLookupLimit)
+	if err != nil {
+		return runtimeerrorrepo.Group{}, err
+	}
+	binding, ok := selectRuntimeErrorGroupRepository(bindings, group.RepositoryFullName)
+	if !ok {
+		return runtimeerrorrepo.Group{}, errs.FailedPrecondition{Msg: "project has no GitHub repository to escalate into"}
+	}
+	_, botToken, _, _, err := s.resolveEffectiveGitHubTokens(ctx, binding.ProjectID, binding.ID)
+	if err != nil {
+		return runtimeerrorrepo.Group{}, err
+	}
+
+	escalation, err := s.applyRuntimeErrorGroupEscalation(ctx, botToken, binding.Owner, binding.Name, group, params.Kind)
+	if err != nil {
+		return runtimeerrorrepo.Group{}, err
+	}
+	escalation.ActorID = principal.UserID
+	updated, updatedOK, err := s.runtimeErrors.SetGroupEscalation(ctx, escalation)
+	if err != nil {
+		return runtimeerrorrepo.Group{}, err
+	}
+	if !updatedOK {
+		return runtimeerrorrepo.Group{}, errs.Validation{Field: "group_id", Msg: "not found"}
+	}
+	return updated, nil
+}
+
+// applyRuntimeErrorGroupEscalation reuses an existing escalation issue or opens a new one.
+func (s *Service) applyRuntimeErrorGroupEscalation(
+	ctx context.Context,
+	botToken string,
+	owner string,
+	repo string,
+	group runtimeerrorrepo.Group,
+	kind enumtypes.RuntimeErrorGroupEscalationKind,
+) (querytypes.RuntimeErrorGroupEscalationParams, error) {
+	var labels []string
+	if kind == enumtypes.RuntimeErrorGroupEscalationKindAIRepair {
+		labels = []string{s.runAIRepairLabel()}
+	}
+	result := querytypes.RuntimeErrorGroupEscalationParams{
+		GroupID:            group.ID,
+		Kind:               kind,
+		RepositoryFullName: owner + "/" + repo,
+		IssueNumber:        group.IssueNumber,
+		IssueURL:           group.IssueURL,
+	}
+
+	if group.IssueNumber > 0 {
+		if len(labels) > 0 {
+			if _, err := s.githubMgmt.AddIssueLabels(ctx, botToken, owner, repo, int(group.IssueNumber), labels); err != nil {
+				return querytypes.RuntimeErrorGroupEscalationParams{}, fmt.Errorf("add runtime error group issue labels: %w", err)
+			}
+		}
+		return result, nil
+	}
+
+	issueNumber, issueURL, err := s.githubMgmt.CreateIssue(
+		ctx,
+		botToken,
+		owner,
+		repo,
+		renderRuntimeErrorGroupIssueTitle(group),
+		renderRuntimeErrorGroupIssueBody(group),
+		labels,
+	)
+	if err != nil {
+		return querytypes.RuntimeErrorGroupEscalationParams{}, fmt.Errorf("create runtime error group issue: %w", err)
+	}
+	result.IssueNumber = int64(issueNumber)
+	result.IssueURL = issueURL
+	return result, nil
+}
+
+func (s *Service) runAIRepairLabel() string {
+	if label := strings.TrimSpace(s.cfg.RunAIRepairLabel); label != "" {
+		return label
+	}
+	return webhookdomain.DefaultRunAIRepairLabel
+}
+
+// runtimeErrorGroupEscalationSatisfied keeps repeated escalation requests idempotent.
+func runtimeErrorGroupEscalationSatisfied(group runtimeerrorrepo.Group, kind enumtypes.RuntimeErrorGroupEscalationKind) bool {
+	if group.IssueNumber <= 0 {
+		return false
+	}
+	switch kind {
+	case enumtypes.RuntimeErrorGroupEscalationKindIssue:
+		return true
+	case enumtypes.RuntimeErrorGroupEscalationKindAIRepair:
+		return group.EscalationKind == enumtypes.RuntimeErrorGroupEscalationKindAIRepair
+	default:
+		return false
+	}
+}
+
+// selectRuntimeErrorGroupRepository prefers repository of an existing escalation issue and falls back to the first GitHub binding.
+func selectRuntimeErrorGroupRepository(bindings []repocfgrepo.RepositoryBinding, repositoryFullName string) (repocfgrepo.RepositoryBinding, bool) {
+	var fallback repocfgrepo.RepositoryBinding
+	hasFallback := false
+	for _, binding := range bindings {
+		if !strings.EqualFold(strings.TrimSpace(binding.Provider), string(repoprovider.ProviderGitHub)) {
+			continue
+		}
+		if strings.TrimSpace(binding.Owner) == "" || strings.TrimSpace(binding.Name) == "" {
+			continue
+		}
+		if repositoryFullName != "" && strings.EqualFold(binding.Owner+"/"+binding.Name, repositoryFullName) {
+			return binding, true
+		}
+		if !hasFallback {
+			fallback = binding
+			hasFallback = true
+		}
+	}
+	if repositoryFullName != "" {
+		return repocfgrepo.RepositoryBinding{}, false
+	}
+	return fallback, hasFallback
+}
+
+func validateRuntimeErrorGroupStatus(status enumtypes.RuntimeErrorGroupStatus, mutedUntil *time.Time, now time.Time) error {
+	switch status {
+	case enumtypes.RuntimeErrorGroupStatusOpen, enumtypes.RuntimeErrorGroupStatusResolved:
+		return nil
+	case enumtypes.RuntimeErrorGroupStatusMuted:
+		if mutedUntil != nil && !mutedUntil.After(now) {
+			return errs.Validation{Field: "muted_until", Msg: "must be in the future"}
+		}
+		return nil
+	default:
+		return errs.Validation{Field: "status", Msg: "must be one of open, muted, resolved"}
+	}
+}
+
+func normalizeRuntimeErrorGroupListFilter(filter querytypes.RuntimeErrorGroupListFilter) querytypes.RuntimeErrorGroupListFilter {
+	normalized := filter
+	if normalized.Limit <= 0 {
+		normalized.Limit = defaultRuntimeErrorListLimit
+	}
+	if normalized.Limit > maxRuntimeErrorListLimit {
+		normalized.Limit = maxRuntimeErrorListLimit
+	}
+	normalized.Status = strings.ToLower(strings.TrimSpace(normalized.Status))
+	normalized.Level = strings.TrimSpace(normalized.Level)
+	normalized.Source = strings.TrimSpace(normalized.Source)
+	normalized.ProjectID = strings.TrimSpace(normalized.ProjectID)
+	return normalized
+}
+
+func renderRuntimeErrorGroupIssueTitle(group runtimeerrorrepo.Group) string {
+	title := fmt.Sprintf("[runtime-error] %s: %s", group.Source, group.NormalizedMessage)
+	if len(title) > runtimeErrorGroupIssueTitleMaxLength {
+		title = title[:runtimeErrorGroupIssueTitleMaxLength]
+	}
+	return title
+}
+
+func renderRuntimeErrorGroupIssueBody(group runtimeerrorrepo.Group) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s id=%s fingerprint=%s -->\n", runtimeErrorGroupIssueMarkerPrefix, group.ID, group.Fingerprint)
+	fmt.Fprintf(&b, "## Runtime error group `%s`\n\n", group.Source)
+	if group.ErrorClass != "" {
+		fmt.Fprintf(&b, "- Error class: `%s`\n", group.ErrorClass)
+	}
+	fmt.Fprintf(&b, "- Level: `%s`\n", group.Level)
+	fmt.Fprintf(&b, "- Occurrences: %d\n", group.Occurrences)
+	fmt.Fprintf(&b, "- Affected runs: %d\n", group.AffectedRunCount)
+	if group.RegressionCount > 0 {
+		fmt.Fprintf(&b, "- Regressions after resolve: %d\n", group.RegressionCount)
+	}
+	fmt.Fprintf(&b, "- First seen: %s\n", group.FirstSeenAt.UTC().Format(time.RFC3339))
+	fmt.Fprintf(&b, "- Last seen: %s\n", group.LastSeenAt.UTC().Format(time.RFC3339))
+	if group.LastRunID != "" {
+		fmt.Fprintf(&b, "- Last run: `%s`\n", group.LastRunID)
+	}
+	fmt.Fprintf(&b, "- Fingerprint: `%s`\n", group.Fingerprint)
+	fmt.Fprintf(&b, "\n### Normalized message\n\n```text\n%s\n```\n", group.NormalizedMessage)
+	fmt.Fprintf(&b, "\n### Latest sample\n\n```text\n%s\n```\n", group.SampleMessage)
+	return b.String()
+}
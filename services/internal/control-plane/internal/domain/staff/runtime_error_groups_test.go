@@ -0,0 +1,130 @@
+package staff
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	repocfgrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/repocfg"
+	runtimeerrorrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/runtimeerror"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
+
+func TestApplyRuntimeErrorGroupEscalation_CreatesIssueWithAIRepairLabel(t *testing.T) {
+	t.Parallel()
+
+	mgmt := &stubNextStepGitHubMgmt{}
+	service := &Service{cfg: Config{RunAIRepairLabel: "run:repair"}, githubMgmt: mgmt}
+	group := runtimeerrorrepo.Group{
+		ID:                "group-1",
+		Fingerprint:       "abc",
+		Source:            "worker.run",
+		NormalizedMessage: "run <n> failed",
+		SampleMessage:     "run 7 failed",
+		Level:             "error",
+		Occurrences:       3,
+	}
+
+	result, err := service.applyRuntimeErrorGroupEscalation(context.Background(), "token", "kodex", "kodex", group, enumtypes.RuntimeErrorGroupEscalationKindAIRepair)
+	if err != nil {
+		t.Fatalf("applyRuntimeErrorGroupEscalation() error = %v", err)
+	}
+	if got, want := mgmt.createdIssues, []string{"[runtime-error] worker.run: run <n> failed"}; !slices.Equal(got, want) {
+		t.Fatalf("created issues = %#v, want %#v", got, want)
+	}
+	if got, want := mgmt.labels, []string{"run:repair"}; !slices.Equal(got, want) {
+		t.Fatalf("issue labels = %#v, want %#v", got, want)
+	}
+	if result.IssueNumber != 501 || result.RepositoryFullName != "kodex/kodex" || result.Kind != enumtypes.RuntimeErrorGroupEscalationKindAIRepair {
+		t.Fatalf("unexpected escalation result: %#v", result)
+	}
+}
+
+func TestApplyRuntimeErrorGroupEscalation_ReusesExistingIssueForAIRepair(t *testing.T) {
+	t.Parallel()
+
+	mgmt := &stubNextStepGitHubMgmt{}
+	service := &Service{githubMgmt: mgmt}
+	group := runtimeerrorrepo.Group{
+		ID:             "group-1",
+		EscalationKind: enumtypes.RuntimeErrorGroupEscalationKindIssue,
+		IssueNumber:    42,
+		IssueURL:       "https://github.com/kodex/kodex/issues/42",
+	}
+
+	result, err := service.applyRuntimeErrorGroupEscalation(context.Background(), "token", "kodex", "kodex", group, enumtypes.RuntimeErrorGroupEscalationKindAIRepair)
+	if err != nil {
+		t.Fatalf("applyRuntimeErrorGroupEscalation() error = %v", err)
+	}
+	if len(mgmt.createdIssues) != 0 {
+		t.Fatalf("expected no new issue, got %#v", mgmt.createdIssues)
+	}
+	if len(mgmt.addedLabels) != 1 || !slices.Equal(mgmt.addedLabels[0], []string{"run:ai-repair"}) {
+		t.Fatalf("added labels = %#v, want default ai-repair label", mgmt.addedLabels)
+	}
+	if result.IssueNumber != 42 || result.IssueURL != group.IssueURL {
+		t.Fatalf("unexpected escalation result: %#v", result)
+	}
+}
+
+func TestRuntimeErrorGroupEscalationSatisfied(t *testing.T) {
+	t.Parallel()
+
+	issueGroup := runtimeerrorrepo.Group{IssueNumber: 7, EscalationKind: enumtypes.RuntimeErrorGroupEscalationKindIssue}
+	if !runtimeErrorGroupEscalationSatisfied(issueGroup, enumtypes.RuntimeErrorGroupEscalationKindIssue) {
+		t.Fatal("expected repeated issue escalation to be satisfied")
+	}
+	if runtimeErrorGroupEscalationSatisfied(issueGroup, enumtypes.RuntimeErrorGroupEscalationKindAIRepair) {
+		t.Fatal("expected ai_repair escalation of issue-only group to proceed")
+	}
+	if runtimeErrorGroupEscalationSatisfied(runtimeerrorrepo.Group{}, enumtypes.RuntimeErrorGroupEscalationKindIssue) {
+		t.Fatal("expected escalation without issue to proceed")
+	}
+}
+
+func TestSelectRuntimeErrorGroupRepository(t *testing.T) {
+	t.Parallel()
+
+	bindings := []repocfgrepo.RepositoryBinding{
+		{ID: "repo-gitlab", Provider: "gitlab", Owner: "kodex", Name: "mirror"},
+		{ID: "repo-main", Provider: "github", Owner: "kodex", Name: "kodex"},
+		{ID: "repo-docs", Provider: "github", Owner: "kodex", Name: "docs"},
+	}
+
+	binding, ok := selectRuntimeErrorGroupRepository(bindings, "")
+	if !ok || binding.ID != "repo-main" {
+		t.Fatalf("fallback binding = %#v, %v; want repo-main", binding, ok)
+	}
+	binding, ok = selectRuntimeErrorGroupRepository(bindings, "Kodex/Docs")
+	if !ok || binding.ID != "repo-docs" {
+		t.Fatalf("existing issue binding = %#v, %v; want repo-docs", binding, ok)
+	}
+	if _, ok := selectRuntimeErrorGroupRepository(bindings, "kodex/removed"); ok {
+		t.Fatal("expected missing escalation repository to be rejected")
+	}
+}
+
+func TestValidateRuntimeErrorGroupStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 25, 10, 0, 0, 0, time.UTC)
+	past := now.Add(-time.Minute)
+	future := now.Add(time.Hour)
+
+	if err := validateRuntimeErrorGroupStatus(enumtypes.RuntimeErrorGroupStatusMuted, &future, now); err != nil {
+		t.Fatalf("mute until future: unexpected error %v", err)
+	}
+	if err := validateRuntimeErrorGroupStatus(enumtypes.RuntimeErrorGroupStatusMuted, nil, now); err != nil {
+		t.Fatalf("mute without expiry: unexpected error %v", err)
+	}
+	var validationErr errs.Validation
+	if err := validateRuntimeErrorGroupStatus(enumtypes.RuntimeErrorGroupStatusMuted, &past, now); !errors.As(err, &validationErr) || validationErr.Field != "muted_until" {
+		t.Fatalf("mute until past: got %v, want muted_until validation", err)
+	}
+	if err := validateRuntimeErrorGroupStatus("ignored", nil, now); !errors.As(err, &validationErr) || validationErr.Field != "status" {
+		t.Fatalf("unknown status: got %v, want status validation", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/codex-k8s/kodex/libs/go/errs"
 	runtimeerrorrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/runtimeerror"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
 )
 
@@ -44,17 +45,8 @@ func (s *Service) MarkRuntimeErrorViewed(ctx context.Context, principal Principa
 	if !ok {
 		return runtimeerrorrepo.Item{}, errs.Validation{Field: "runtime_error_id", Msg: "not found"}
 	}
-	if !principal.IsPlatformAdmin {
-		if strings.TrimSpace(item.ProjectID) == "" {
-			return runtimeerrorrepo.Item{}, errs.Forbidden{Msg: "platform admin required"}
-		}
-		_, hasRole, err := s.members.GetRole(ctx, item.ProjectID, principal.UserID)
-		if err != nil {
-			return runtimeerrorrepo.Item{}, err
-		}
-		if !hasRole {
-			return runtimeerrorrepo.Item{}, errs.Forbidden{Msg: "project access required"}
-		}
+	if err := s.authorizeRuntimeErrorProject(ctx, principal, item.ProjectID); err != nil {
+		return runtimeerrorrepo.Item{}, err
 	}
 
 	updated, updatedOK, err := s.runtimeErrors.MarkViewed(ctx, querytypes.RuntimeErrorMarkViewedParams{
@@ -70,6 +62,17 @@ func (s *Service) MarkRuntimeErrorViewed(ctx context.Context, principal Principa
 	return updated, nil
 }
 
+// authorizeRuntimeErrorProject allows platform admins and project members allowed to manage runtime errors.
+func (s *Service) authorizeRuntimeErrorProject(ctx context.Context, principal Principal, projectID string) error {
+	if principal.IsPlatformAdmin {
+		return nil
+	}
+	if strings.TrimSpace(projectID) == "" {
+		return errs.Forbidden{Msg: "platform admin required"}
+	}
+	return s.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionRuntimeErrorsManage, "")
+}
+
 func normalizeRuntimeErrorListFilter(filter querytypes.RuntimeErrorListFilter) querytypes.RuntimeErrorListFilter {
 	normalized := filter
 	if normalized.Limit <= 0 {
@@ -6,13 +6,19 @@ import (
 	"github.com/codex-k8s/kodex/libs/go/crypto/tokencrypt"
 	"github.com/codex-k8s/kodex/libs/go/repo/provider"
 	nextstepdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/nextstep"
+	floweventrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/flowevent"
 	learningfeedbackrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/learningfeedback"
+	mcpapprovalpolicyrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/mcpapprovalpolicy"
 	projectrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/project"
 	projectmemberrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectmember"
+	projectrolerepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectrole"
 	projecttokenrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projecttoken"
 	repocfgrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/repocfg"
+	retentionrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/retention"
+	runoutcomerepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/runoutcome"
 	runtimedeploytaskrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/runtimedeploytask"
 	runtimeerrorrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/runtimeerror"
+	staffapitokenrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/staffapitoken"
 	staffrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/staffrun"
 	userrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/user"
 	runtimedeploydomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/runtimedeploy"
@@ -37,6 +43,14 @@ type Config struct {
 	PromptSeedsDir string
 	// NextStepLabels defines env-aware run:* labels allowed for next-step actions.
 	NextStepLabels nextstepdomain.Labels
+	// RunAIRepairLabel is applied to escalation issues that must start an ai-repair run.
+	RunAIRepairLabel string
+	// StateApprovedLabel is checked by stage auto-advance rules that require explicit approval label.
+	StateApprovedLabel string
+	// GitBotUsername is the platform bot login whose reviews never count as PR approval.
+	GitBotUsername string
+	// OIDCGroupRoleMappings maps OIDC groups to platform admin flag and project roles.
+	OIDCGroupRoleMappings []querytypes.OIDCGroupRoleMapping
 }
 
 // Service exposes staff-only read/write operations protected by JWT + RBAC.
@@ -45,12 +59,18 @@ type Service struct {
 	users         userrepo.Repository
 	projects      projectrepo.Repository
 	members       projectmemberrepo.Repository
+	roles         projectrolerepo.Repository
+	approvals     mcpapprovalpolicyrepo.Repository
 	repos         repocfgrepo.Repository
 	projectTokens projecttokenrepo.Repository
 	feedback      learningfeedbackrepo.Repository
 	runs          staffrunrepo.Repository
 	tasks         runtimedeploytaskrepo.Repository
 	runtimeErrors runtimeerrorrepo.Repository
+	retention     retentionrepo.Repository
+	runOutcomes   runoutcomerepo.Repository
+	flowEvents    floweventrepo.Repository
+	apiTokens     staffapitokenrepo.Repository
 	k8s           kubernetesConfigSync
 
 	tokencrypt     *tokencrypt.Service
@@ -71,9 +91,11 @@ type githubManagementClient interface {
 	GetDefaultBranch(ctx context.Context, token string, owner string, repo string) (string, error)
 	GetFile(ctx context.Context, token string, owner string, repo string, path string, ref string) ([]byte, bool, error)
 	CreatePullRequestWithFiles(ctx context.Context, token string, owner string, repo string, baseBranch string, headBranch string, title string, body string, files map[string][]byte) (prNumber int, prURL string, err error)
+	CreateIssue(ctx context.Context, token string, owner string, repo string, title string, body string, labels []string) (issueNumber int, issueURL string, err error)
 	ListIssueLabels(ctx context.Context, token string, owner string, repo string, issueNumber int) ([]string, error)
 	AddIssueLabels(ctx context.Context, token string, owner string, repo string, issueNumber int, labels []string) ([]string, error)
 	RemoveIssueLabel(ctx context.Context, token string, owner string, repo string, issueNumber int, label string) error
+	IsPullRequestApproved(ctx context.Context, token string, owner string, repo string, number int, botLogin string) (bool, error)
 }
 
 type kubernetesConfigSync interface {
@@ -87,6 +109,7 @@ type kubernetesConfigSync interface {
 
 type runtimeDeployController interface {
 	RequestTaskAction(ctx context.Context, params runtimedeploydomain.TaskActionParams) (runtimedeploydomain.TaskActionResult, error)
+	PreviewDeploy(ctx context.Context, params runtimedeploydomain.PreviewParams) (runtimedeploydomain.PreviewResult, error)
 }
 
 type systemSettingsService interface {
@@ -98,23 +121,29 @@ type systemSettingsService interface {
 
 // Dependencies defines external collaborators required by staff service.
 type Dependencies struct {
-	Users          userrepo.Repository
-	Projects       projectrepo.Repository
-	Members        projectmemberrepo.Repository
-	Repos          repocfgrepo.Repository
-	ProjectTokens  projecttokenrepo.Repository
-	Feedback       learningfeedbackrepo.Repository
-	Runs           staffrunrepo.Repository
-	Tasks          runtimedeploytaskrepo.Repository
-	RuntimeErrors  runtimeerrorrepo.Repository
-	K8s            kubernetesConfigSync
-	Tokencrypt     *tokencrypt.Service
-	PlatformTokens platformTokensRepository
-	GitHub         provider.RepositoryProvider
-	GitHubMgmt     githubManagementClient
-	RunStatus      runNamespaceService
-	RuntimeDeploy  runtimeDeployController
-	SystemSettings systemSettingsService
+	Users               userrepo.Repository
+	Projects            projectrepo.Repository
+	Members             projectmemberrepo.Repository
+	ProjectRoles        projectrolerepo.Repository
+	MCPApprovalPolicies mcpapprovalpolicyrepo.Repository
+	Repos               repocfgrepo.Repository
+	ProjectTokens       projecttokenrepo.Repository
+	Feedback            learningfeedbackrepo.Repository
+	Runs                staffrunrepo.Repository
+	Tasks               runtimedeploytaskrepo.Repository
+	RuntimeErrors       runtimeerrorrepo.Repository
+	Retention           retentionrepo.Repository
+	RunOutcomes         runoutcomerepo.Repository
+	FlowEvents          floweventrepo.Repository
+	APITokens           staffapitokenrepo.Repository
+	K8s                 kubernetesConfigSync
+	Tokencrypt          *tokencrypt.Service
+	PlatformTokens      platformTokensRepository
+	GitHub              provider.RepositoryProvider
+	GitHubMgmt          githubManagementClient
+	RunStatus           runNamespaceService
+	RuntimeDeploy       runtimeDeployController
+	SystemSettings      systemSettingsService
 }
 
 // NewService constructs staff service.
@@ -124,12 +153,18 @@ func NewService(cfg Config, deps Dependencies) *Service {
 		users:          deps.Users,
 		projects:       deps.Projects,
 		members:        deps.Members,
+		roles:          deps.ProjectRoles,
+		approvals:      deps.MCPApprovalPolicies,
 		repos:          deps.Repos,
 		projectTokens:  deps.ProjectTokens,
 		feedback:       deps.Feedback,
 		runs:           deps.Runs,
 		tasks:          deps.Tasks,
 		runtimeErrors:  deps.RuntimeErrors,
+		retention:      deps.Retention,
+		runOutcomes:    deps.RunOutcomes,
+		flowEvents:     deps.FlowEvents,
+		apiTokens:      deps.APITokens,
 		k8s:            deps.K8s,
 		tokencrypt:     deps.Tokencrypt,
 		platformTokens: deps.PlatformTokens,
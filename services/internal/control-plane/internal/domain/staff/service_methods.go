@@ -15,6 +15,7 @@ import (
 	repocfgrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/repocfg"
 	staffrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/staffrun"
 	userrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/user"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
 
 	"github.com/google/uuid"
@@ -40,13 +41,9 @@ func (s *Service) resolveRunAccess(ctx context.Context, principal Principal, run
 		if projectID == "" {
 			return "", "", errs.Forbidden{Msg: "run is not assigned to a project"}
 		}
-		_, hasRole, err := s.members.GetRole(ctx, projectID, principal.UserID)
-		if err != nil {
+		if err := s.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionProjectRead, ""); err != nil {
 			return "", "", err
 		}
-		if !hasRole {
-			return "", "", errs.Forbidden{Msg: "project access required"}
-		}
 	}
 
 	return correlationID, projectID, nil
@@ -92,14 +89,8 @@ func (s *Service) GetProject(ctx context.Context, principal Principal, projectID
 	if projectID == "" {
 		return projectrepo.Project{}, errs.Validation{Field: "project_id", Msg: "is required"}
 	}
-	if !principal.IsPlatformAdmin {
-		_, ok, err := s.members.GetRole(ctx, projectID, principal.UserID)
-		if err != nil {
-			return projectrepo.Project{}, err
-		}
-		if !ok {
-			return projectrepo.Project{}, errs.Forbidden{Msg: "project access required"}
-		}
+	if err := s.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionProjectRead, ""); err != nil {
+		return projectrepo.Project{}, err
 	}
 	p, ok, err := s.projects.GetByID(ctx, projectID)
 	if err != nil {
@@ -137,13 +128,9 @@ func (s *Service) GetRun(ctx context.Context, principal Principal, runID string)
 		if r.ProjectID == "" {
 			return staffrunrepo.Run{}, errs.Forbidden{Msg: "run is not assigned to a project"}
 		}
-		_, hasRole, err := s.members.GetRole(ctx, r.ProjectID, principal.UserID)
-		if err != nil {
+		if err := s.RequireProjectPermission(ctx, principal, r.ProjectID, enumtypes.ProjectPermissionProjectRead, ""); err != nil {
 			return staffrunrepo.Run{}, err
 		}
-		if !hasRole {
-			return staffrunrepo.Run{}, errs.Forbidden{Msg: "project access required"}
-		}
 	}
 
 	if s.runStatus != nil {
@@ -250,10 +237,8 @@ func (s *Service) UpsertProjectMemberByEmail(ctx context.Context, principal Prin
 	if email == "" {
 		return errs.Validation{Field: "email", Msg: "is required"}
 	}
-	switch role {
-	case "read", "read_write", "admin":
-	default:
-		return errs.Validation{Field: "role", Msg: fmt.Sprintf("invalid role %q", role)}
+	if err := s.validateMemberRole(ctx, projectID, role); err != nil {
+		return err
 	}
 
 	u, ok, err := s.users.GetByEmail(ctx, email)
@@ -307,10 +292,8 @@ func (s *Service) UpsertProjectMember(ctx context.Context, principal Principal,
 	if userID == "" {
 		return errs.Validation{Field: "user_id", Msg: "is required"}
 	}
-	switch role {
-	case "read", "read_write", "admin":
-	default:
-		return errs.Validation{Field: "role", Msg: fmt.Sprintf("invalid role %q", role)}
+	if err := s.validateMemberRole(ctx, projectID, role); err != nil {
+		return err
 	}
 	return s.members.Upsert(ctx, projectID, userID, role)
 }
@@ -399,14 +382,8 @@ func (s *Service) ListProjectRepositories(ctx context.Context, principal Princip
 	if projectID == "" {
 		return nil, errs.Validation{Field: "project_id", Msg: "is required"}
 	}
-	if !principal.IsPlatformAdmin {
-		_, ok, err := s.members.GetRole(ctx, projectID, principal.UserID)
-		if err != nil {
-			return nil, err
-		}
-		if !ok {
-			return nil, errs.Forbidden{Msg: "project access required"}
-		}
+	if err := s.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionProjectRead, ""); err != nil {
+		return nil, err
 	}
 	return s.repos.ListForProject(ctx, projectID, limit)
 }
@@ -444,19 +421,8 @@ func (s *Service) UpsertProjectRepository(
 		return repocfgrepo.RepositoryBinding{}, errs.Validation{Field: "token", Msg: "is required"}
 	}
 
-	memberRole := "admin"
-	if !principal.IsPlatformAdmin {
-		r, ok, err := s.members.GetRole(ctx, projectID, principal.UserID)
-		if err != nil {
-			return repocfgrepo.RepositoryBinding{}, err
-		}
-		if !ok {
-			return repocfgrepo.RepositoryBinding{}, errs.Forbidden{Msg: "project access required"}
-		}
-		memberRole = r
-	}
-	if memberRole != "admin" && memberRole != "read_write" {
-		return repocfgrepo.RepositoryBinding{}, errs.Forbidden{Msg: "project write access required"}
+	if err := s.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionRepositoriesManage, ""); err != nil {
+		return repocfgrepo.RepositoryBinding{}, err
 	}
 
 	if servicesYAMLPath = strings.TrimSpace(servicesYAMLPath); servicesYAMLPath == "" {
@@ -534,19 +500,8 @@ func (s *Service) DeleteProjectRepository(ctx context.Context, principal Princip
 		}
 	}
 
-	role := "admin"
-	if !principal.IsPlatformAdmin {
-		r, ok, err := s.members.GetRole(ctx, projectID, principal.UserID)
-		if err != nil {
-			return err
-		}
-		if !ok {
-			return errs.Forbidden{Msg: "project access required"}
-		}
-		role = r
-	}
-	if role != "admin" && role != "read_write" {
-		return errs.Forbidden{Msg: "project write access required"}
+	if err := s.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionRepositoriesManage, ""); err != nil {
+		return err
 	}
 
 	// Best-effort: attempt to delete the webhook from the provider repo.
@@ -615,14 +570,8 @@ func (s *Service) GetProjectGitHubTokens(ctx context.Context, principal Principa
 	if projectID == "" {
 		return projecttokenrepo.ProjectGitHubTokens{}, false, errs.Validation{Field: "project_id", Msg: "is required"}
 	}
-	if !principal.IsPlatformAdmin {
-		_, roleOK, err := s.members.GetRole(ctx, projectID, principal.UserID)
-		if err != nil {
-			return projecttokenrepo.ProjectGitHubTokens{}, false, err
-		}
-		if !roleOK {
-			return projecttokenrepo.ProjectGitHubTokens{}, false, errs.Forbidden{Msg: "project access required"}
-		}
+	if err := s.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionProjectRead, ""); err != nil {
+		return projecttokenrepo.ProjectGitHubTokens{}, false, err
 	}
 	return s.projectTokens.GetByProjectID(ctx, projectID)
 }
@@ -632,19 +581,8 @@ func (s *Service) UpsertProjectGitHubTokens(ctx context.Context, principal Princ
 		return errs.Validation{Field: "project_id", Msg: "is required"}
 	}
 
-	role := "admin"
-	if !principal.IsPlatformAdmin {
-		r, ok, err := s.members.GetRole(ctx, projectID, principal.UserID)
-		if err != nil {
-			return err
-		}
-		if !ok {
-			return errs.Forbidden{Msg: "project access required"}
-		}
-		role = r
-	}
-	if role != "admin" && role != "read_write" {
-		return errs.Forbidden{Msg: "project write access required"}
+	if err := s.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionGitHubTokensManage, ""); err != nil {
+		return err
 	}
 
 	var platformEnc []byte
@@ -10,6 +10,7 @@ import (
 	"github.com/codex-k8s/kodex/libs/go/errs"
 	docsetdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/docset"
 	repocfgrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/repocfg"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
 	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
 	"github.com/google/uuid"
@@ -23,19 +24,8 @@ func (s *Service) UpsertRepositoryBotParams(ctx context.Context, principal Princ
 		return errs.Validation{Field: "repository_id", Msg: "is required"}
 	}
 
-	role := "admin"
-	if !principal.IsPlatformAdmin {
-		r, ok, err := s.members.GetRole(ctx, projectID, principal.UserID)
-		if err != nil {
-			return err
-		}
-		if !ok {
-			return errs.Forbidden{Msg: "project access required"}
-		}
-		role = r
-	}
-	if role != "admin" && role != "read_write" {
-		return errs.Forbidden{Msg: "project write access required"}
+	if err := s.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionRepositoriesManage, ""); err != nil {
+		return err
 	}
 
 	var enc []byte
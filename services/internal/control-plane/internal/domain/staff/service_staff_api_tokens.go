@@ -0,0 +1,218 @@
+package staff
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/auth/apitoken"
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	staffapitokenrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/staffapitoken"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+const (
+	// serviceAccountEmailDomain keeps service-account emails out of any real mail domain,
+	// so OAuth/OIDC identities can never collide with machine users.
+	serviceAccountEmailDomain = "service-accounts.kodex.invalid"
+	maxStaffAPITokenTTLDays   = 3650
+	maxStaffAPITokenNameLen   = 128
+	// staffAPITokenTouchInterval matches the last_used_at write throttle in the repository query.
+	staffAPITokenTouchInterval = time.Minute
+)
+
+var serviceAccountNamePattern = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// CreateServiceAccount creates a machine user that can authenticate only with staff API tokens (platform admin only).
+func (s *Service) CreateServiceAccount(ctx context.Context, principal Principal, name string, isPlatformAdmin bool) (entitytypes.User, error) {
+	if err := s.requireAPITokenAdmin(principal); err != nil {
+		return entitytypes.User{}, err
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !serviceAccountNamePattern.MatchString(name) {
+		return entitytypes.User{}, errs.Validation{Field: "name", Msg: "must be a DNS label (lowercase letters, digits and dashes)"}
+	}
+	if isPlatformAdmin && !principal.IsPlatformOwner {
+		return entitytypes.User{}, errs.Forbidden{Msg: "platform owner required to create admin service account"}
+	}
+
+	user, ok, err := s.users.CreateServiceAccount(ctx, name+"@"+serviceAccountEmailDomain, isPlatformAdmin)
+	if err != nil {
+		return entitytypes.User{}, err
+	}
+	if !ok {
+		return entitytypes.User{}, errs.Conflict{Msg: "service account already exists"}
+	}
+	return user, nil
+}
+
+// CreateStaffAPIToken issues a scoped token for the caller's own account or a service account (platform admin only).
+// Tokens for other human users are refused so an admin cannot act as them in the audit trail.
+// Raw secret is returned once and only its hash is stored.
+func (s *Service) CreateStaffAPIToken(ctx context.Context, principal Principal, params querytypes.StaffAPITokenIssueParams) (staffapitokenrepo.Token, string, error) {
+	if err := s.requireAPITokenAdmin(principal); err != nil {
+		return staffapitokenrepo.Token{}, "", err
+	}
+
+	userID := strings.TrimSpace(params.UserID)
+	if userID == "" {
+		userID = principal.UserID
+	}
+	owner, ok, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return staffapitokenrepo.Token{}, "", err
+	}
+	if !ok {
+		return staffapitokenrepo.Token{}, "", errs.Validation{Field: "user_id", Msg: "not found"}
+	}
+	if owner.ID != principal.UserID && !owner.IsServiceAccount {
+		return staffapitokenrepo.Token{}, "", errs.Forbidden{Msg: "tokens can be issued only for your own account or a service account"}
+	}
+
+	name := strings.TrimSpace(params.Name)
+	if name == "" || len(name) > maxStaffAPITokenNameLen {
+		return staffapitokenrepo.Token{}, "", errs.Validation{Field: "name", Msg: fmt.Sprintf("must be 1..%d characters", maxStaffAPITokenNameLen)}
+	}
+	scopes, err := normalizeStaffAPITokenScopes(params.Scopes)
+	if err != nil {
+		return staffapitokenrepo.Token{}, "", err
+	}
+	projectIDs, err := s.normalizeStaffAPITokenProjects(ctx, params.ProjectIDs)
+	if err != nil {
+		return staffapitokenrepo.Token{}, "", err
+	}
+
+	var expiresAt *time.Time
+	if params.ExpiresInDays != nil {
+		days := *params.ExpiresInDays
+		if days < 1 || days > maxStaffAPITokenTTLDays {
+			return staffapitokenrepo.Token{}, "", errs.Validation{Field: "expires_in_days", Msg: fmt.Sprintf("must be between 1 and %d", maxStaffAPITokenTTLDays)}
+		}
+		value := time.Now().UTC().AddDate(0, 0, days)
+		expiresAt = &value
+	}
+
+	raw, prefix, err := apitoken.Generate()
+	if err != nil {
+		return staffapitokenrepo.Token{}, "", fmt.Errorf("generate staff api token: %w", err)
+	}
+	token, err := s.apiTokens.Create(ctx, staffapitokenrepo.CreateParams{
+		UserID:      owner.ID,
+		Name:        name,
+		TokenPrefix: prefix,
+		TokenHash:   apitoken.Hash(raw),
+		Scopes:      scopes,
+		ProjectIDs:  projectIDs,
+		ExpiresAt:   expiresAt,
+		CreatedBy:   principal.UserID,
+	})
+	if err != nil {
+		return staffapitokenrepo.Token{}, "", err
+	}
+	return token, raw, nil
+}
+
+// ListStaffAPITokens returns issued tokens without secrets (platform admin only).
+func (s *Service) ListStaffAPITokens(ctx context.Context, principal Principal, filter querytypes.StaffAPITokenListFilter) ([]staffapitokenrepo.Token, error) {
+	if err := s.requireAPITokenAdmin(principal); err != nil {
+		return nil, err
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = 200
+	}
+	return s.apiTokens.List(ctx, filter)
+}
+
+// RevokeStaffAPIToken revokes token immediately (platform admin only).
+func (s *Service) RevokeStaffAPIToken(ctx context.Context, principal Principal, tokenID string) error {
+	if err := s.requireAPITokenAdmin(principal); err != nil {
+		return err
+	}
+	tokenID = strings.TrimSpace(tokenID)
+	if tokenID == "" {
+		return errs.Validation{Field: "token_id", Msg: "is required"}
+	}
+	revoked, err := s.apiTokens.Revoke(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+	if !revoked {
+		return errs.NotFound{Msg: "active staff api token not found"}
+	}
+	return nil
+}
+
+// AuthenticateStaffAPIToken resolves token owner for staff auth middleware and records token usage.
+func (s *Service) AuthenticateStaffAPIToken(ctx context.Context, raw string) (entitytypes.User, staffapitokenrepo.Token, error) {
+	if s.apiTokens == nil || !apitoken.IsToken(raw) {
+		return entitytypes.User{}, staffapitokenrepo.Token{}, errs.Unauthorized{Msg: "invalid api token"}
+	}
+	token, ok, err := s.apiTokens.GetActiveByHash(ctx, apitoken.Hash(raw))
+	if err != nil {
+		return entitytypes.User{}, staffapitokenrepo.Token{}, err
+	}
+	if !ok {
+		return entitytypes.User{}, staffapitokenrepo.Token{}, errs.Unauthorized{Msg: "invalid api token"}
+	}
+	user, ok, err := s.users.GetByID(ctx, token.UserID)
+	if err != nil {
+		return entitytypes.User{}, staffapitokenrepo.Token{}, err
+	}
+	if !ok {
+		return entitytypes.User{}, staffapitokenrepo.Token{}, errs.Unauthorized{Msg: "invalid api token"}
+	}
+	if token.LastUsedAt == nil || time.Since(*token.LastUsedAt) >= staffAPITokenTouchInterval {
+		if err := s.apiTokens.TouchLastUsed(ctx, token.ID); err != nil {
+			return entitytypes.User{}, staffapitokenrepo.Token{}, err
+		}
+	}
+	return user, token, nil
+}
+
+func (s *Service) requireAPITokenAdmin(principal Principal) error {
+	if !principal.IsPlatformAdmin {
+		return errs.Forbidden{Msg: "platform admin required"}
+	}
+	if s.apiTokens == nil {
+		return fmt.Errorf("staff api token repository is not configured")
+	}
+	return nil
+}
+
+func normalizeStaffAPITokenScopes(scopes []string) ([]string, error) {
+	out := make([]string, 0, len(scopes))
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if !apitoken.IsKnownScope(scope) {
+			return nil, errs.Validation{Field: "scopes", Msg: fmt.Sprintf("unsupported scope %q", scope)}
+		}
+		if !slices.Contains(out, scope) {
+			out = append(out, scope)
+		}
+	}
+	if len(out) == 0 {
+		return nil, errs.Validation{Field: "scopes", Msg: "at least one scope is required"}
+	}
+	return out, nil
+}
+
+func (s *Service) normalizeStaffAPITokenProjects(ctx context.Context, projectIDs []string) ([]string, error) {
+	out := make([]string, 0, len(projectIDs))
+	for _, projectID := range projectIDs {
+		projectID = strings.TrimSpace(projectID)
+		if projectID == "" || slices.Contains(out, projectID) {
+			continue
+		}
+		if _, ok, err := s.projects.GetByID(ctx, projectID); err != nil {
+			return nil, err
+		} else if !ok {
+			return nil, errs.Validation{Field: "project_ids", Msg: fmt.Sprintf("project %s not found", projectID)}
+		}
+		out = append(out, projectID)
+	}
+	return out, nil
+}
@@ -0,0 +1,303 @@
+package staff
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	projectrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/project"
+	staffapitokenrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/staffapitoken"
+	userrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/user"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+type fakeAPITokenUsers struct {
+	userrepo.Repository
+	byID map[string]userrepo.User
+}
+
+func (f *fakeAPITokenUsers) GetByID(_ context.Context, id string) (userrepo.User, bool, error) {
+	user, ok := f.byID[id]
+	return user, ok, nil
+}
+
+func (f *fakeAPITokenUsers) GetByEmail(_ context.Context, email string) (userrepo.User, bool, error) {
+	for _, user := range f.byID {
+		if user.Email == email {
+			return user, true, nil
+		}
+	}
+	return userrepo.User{}, false, nil
+}
+
+func (f *fakeAPITokenUsers) GetByGitHubLogin(_ context.Context, githubLogin string) (userrepo.User, bool, error) {
+	for _, user := range f.byID {
+		if user.GitHubLogin != "" && strings.EqualFold(user.GitHubLogin, githubLogin) {
+			return user, true, nil
+		}
+	}
+	return userrepo.User{}, false, nil
+}
+
+func (f *fakeAPITokenUsers) CreateServiceAccount(_ context.Context, email string, isPlatformAdmin bool) (userrepo.User, bool, error) {
+	for _, user := range f.byID {
+		if user.Email == email {
+			return userrepo.User{}, false, nil
+		}
+	}
+	user := userrepo.User{ID: "sa-" + email, Email: email, IsPlatformAdmin: isPlatformAdmin, IsServiceAccount: true}
+	f.byID[user.ID] = user
+	return user, true, nil
+}
+
+type fakeAPITokenProjects struct {
+	projectrepo.Repository
+}
+
+func (f *fakeAPITokenProjects) GetByID(_ context.Context, id string) (projectrepo.Project, bool, error) {
+	return projectrepo.Project{ID: id}, id == "p1", nil
+}
+
+type fakeAPITokens struct {
+	items   []staffapitokenrepo.Token
+	hashes  map[string][]byte
+	touched []string
+}
+
+func (f *fakeAPITokens) Create(_ context.Context, params staffapitokenrepo.CreateParams) (staffapitokenrepo.Token, error) {
+	token := staffapitokenrepo.Token{
+		ID:          "t" + string(rune('0'+len(f.items))),
+		UserID:      params.UserID,
+		Name:        params.Name,
+		TokenPrefix: params.TokenPrefix,
+		Scopes:      params.Scopes,
+		ProjectIDs:  params.ProjectIDs,
+		ExpiresAt:   params.ExpiresAt,
+	}
+	f.items = append(f.items, token)
+	f.hashes[token.ID] = params.TokenHash
+	return token, nil
+}
+
+func (f *fakeAPITokens) List(context.Context, staffapitokenrepo.ListFilter) ([]staffapitokenrepo.Token, error) {
+	return f.items, nil
+}
+
+func (f *fakeAPITokens) Revoke(_ context.Context, tokenID string) (bool, error) {
+	for i, item := range f.items {
+		if item.ID == tokenID && item.RevokedAt == nil {
+			now := time.Now()
+			f.items[i].RevokedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (f *fakeAPITokens) GetActiveByHash(_ context.Context, tokenHash []byte) (staffapitokenrepo.Token, bool, error) {
+	for _, item := range f.items {
+		if item.RevokedAt == nil && bytes.Equal(f.hashes[item.ID], tokenHash) {
+			return item, true, nil
+		}
+	}
+	return staffapitokenrepo.Token{}, false, nil
+}
+
+func (f *fakeAPITokens) TouchLastUsed(_ context.Context, tokenID string) error {
+	f.touched = append(f.touched, tokenID)
+	return nil
+}
+
+func newAPITokenTestService() (*Service, *fakeAPITokens) {
+	tokens := &fakeAPITokens{hashes: map[string][]byte{}}
+	return &Service{
+		users: &fakeAPITokenUsers{byID: map[string]userrepo.User{
+			"admin":  {ID: "admin", Email: "admin@example.com", IsPlatformAdmin: true},
+			"admin2": {ID: "admin2", Email: "admin2@example.com", IsPlatformAdmin: true},
+			"dev":    {ID: "dev", Email: "dev@example.com"},
+		}},
+		projects:  &fakeAPITokenProjects{},
+		apiTokens: tokens,
+	}, tokens
+}
+
+func TestStaffAPIToken_IssueAuthenticateRevoke(t *testing.T) {
+	t.Parallel()
+
+	service, tokens := newAPITokenTestService()
+	admin := Principal{UserID: "admin", IsPlatformAdmin: true}
+	ctx := context.Background()
+
+	account, err := service.CreateServiceAccount(ctx, admin, "CI-Bot", false)
+	if err != nil {
+		t.Fatalf("CreateServiceAccount() error = %v", err)
+	}
+	if !account.IsServiceAccount || account.Email != "ci-bot@"+serviceAccountEmailDomain {
+		t.Fatalf("unexpected service account: %#v", account)
+	}
+	var conflict errs.Conflict
+	if _, err := service.CreateServiceAccount(ctx, admin, "ci-bot", false); !errors.As(err, &conflict) {
+		t.Fatalf("CreateServiceAccount() duplicate error = %v, want conflict", err)
+	}
+
+	days := 30
+	token, secret, err := service.CreateStaffAPIToken(ctx, admin, querytypes.StaffAPITokenIssueParams{
+		UserID:        account.ID,
+		Name:          "ci",
+		Scopes:        []string{"runs:read", "runs:cancel", "runs:read"},
+		ProjectIDs:    []string{"p1"},
+		ExpiresInDays: &days,
+	})
+	if err != nil {
+		t.Fatalf("CreateStaffAPIToken() error = %v", err)
+	}
+	if !slices.Equal(token.Scopes, []string{"runs:read", "runs:cancel"}) || token.ExpiresAt == nil || token.TokenPrefix == "" {
+		t.Fatalf("unexpected token: %#v", token)
+	}
+	if bytes.Contains(tokens.hashes[token.ID], []byte(secret)) {
+		t.Fatal("raw secret must not be stored")
+	}
+
+	user, authenticated, err := service.AuthenticateStaffAPIToken(ctx, secret)
+	if err != nil {
+		t.Fatalf("AuthenticateStaffAPIToken() error = %v", err)
+	}
+	if user.ID != account.ID || authenticated.ID != token.ID || !slices.Equal(tokens.touched, []string{token.ID}) {
+		t.Fatalf("unexpected authentication result: user %#v token %#v touched %v", user, authenticated, tokens.touched)
+	}
+
+	if err := service.RevokeStaffAPIToken(ctx, admin, token.ID); err != nil {
+		t.Fatalf("RevokeStaffAPIToken() error = %v", err)
+	}
+	var unauthorized errs.Unauthorized
+	if _, _, err := service.AuthenticateStaffAPIToken(ctx, secret); !errors.As(err, &unauthorized) {
+		t.Fatalf("AuthenticateStaffAPIToken() after revoke error = %v, want unauthorized", err)
+	}
+}
+
+func TestStaffAPIToken_IssueLimitedToOwnAccountOrServiceAccount(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newAPITokenTestService()
+	ctx := context.Background()
+	admin := Principal{UserID: "admin", IsPlatformAdmin: true}
+
+	var forbidden errs.Forbidden
+	for _, userID := range []string{"admin2", "dev"} {
+		_, _, err := service.CreateStaffAPIToken(ctx, admin, querytypes.StaffAPITokenIssueParams{UserID: userID, Name: "x", Scopes: []string{"runs:read"}})
+		if !errors.As(err, &forbidden) {
+			t.Fatalf("CreateStaffAPIToken(user %s) error = %v, want forbidden", userID, err)
+		}
+	}
+	if _, _, err := service.CreateStaffAPIToken(ctx, admin, querytypes.StaffAPITokenIssueParams{Name: "own", Scopes: []string{"runs:read"}}); err != nil {
+		t.Fatalf("CreateStaffAPIToken(own account) error = %v", err)
+	}
+}
+
+func TestStaffAPIToken_AuthenticateThrottlesLastUsedWrites(t *testing.T) {
+	t.Parallel()
+
+	service, tokens := newAPITokenTestService()
+	ctx := context.Background()
+	admin := Principal{UserID: "admin", IsPlatformAdmin: true}
+
+	token, secret, err := service.CreateStaffAPIToken(ctx, admin, querytypes.StaffAPITokenIssueParams{Name: "own", Scopes: []string{"runs:read"}})
+	if err != nil {
+		t.Fatalf("CreateStaffAPIToken() error = %v", err)
+	}
+	recent := time.Now().Add(-10 * time.Second)
+	tokens.items[0].LastUsedAt = &recent
+	if _, _, err := service.AuthenticateStaffAPIToken(ctx, secret); err != nil {
+		t.Fatalf("AuthenticateStaffAPIToken() error = %v", err)
+	}
+	if len(tokens.touched) != 0 {
+		t.Fatalf("recently used token must not be touched, touched %v", tokens.touched)
+	}
+
+	stale := time.Now().Add(-2 * staffAPITokenTouchInterval)
+	tokens.items[0].LastUsedAt = &stale
+	if _, _, err := service.AuthenticateStaffAPIToken(ctx, secret); err != nil {
+		t.Fatalf("AuthenticateStaffAPIToken() error = %v", err)
+	}
+	if !slices.Equal(tokens.touched, []string{token.ID}) {
+		t.Fatalf("stale token must be touched once, touched %v", tokens.touched)
+	}
+}
+
+func TestStaffAPIToken_RejectsInvalidRequests(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newAPITokenTestService()
+	ctx := context.Background()
+	admin := Principal{UserID: "admin", IsPlatformAdmin: true}
+
+	var forbidden errs.Forbidden
+	if _, _, err := service.CreateStaffAPIToken(ctx, Principal{UserID: "admin"}, querytypes.StaffAPITokenIssueParams{Name: "x", Scopes: []string{"runs:read"}}); !errors.As(err, &forbidden) {
+		t.Fatalf("non-admin CreateStaffAPIToken() error = %v, want forbidden", err)
+	}
+
+	var validation errs.Validation
+	for _, params := range []querytypes.StaffAPITokenIssueParams{
+		{Name: "x", Scopes: []string{"runs:write"}},
+		{Name: "x"},
+		{Name: "x", Scopes: []string{"runs:read"}, ProjectIDs: []string{"missing"}},
+		{Name: "", Scopes: []string{"runs:read"}},
+	} {
+		if _, _, err := service.CreateStaffAPIToken(ctx, admin, params); !errors.As(err, &validation) {
+			t.Fatalf("CreateStaffAPIToken(%#v) error = %v, want validation", params, err)
+		}
+	}
+
+	var unauthorized errs.Unauthorized
+	if _, _, err := service.AuthenticateStaffAPIToken(ctx, "not-a-token"); !errors.As(err, &unauthorized) {
+		t.Fatalf("AuthenticateStaffAPIToken() error = %v, want unauthorized", err)
+	}
+}
+
+func TestResolveStaffByEmail_RejectsServiceAccounts(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newAPITokenTestService()
+	account, err := service.CreateServiceAccount(context.Background(), Principal{UserID: "admin", IsPlatformAdmin: true}, "deployer", false)
+	if err != nil {
+		t.Fatalf("CreateServiceAccount() error = %v", err)
+	}
+	var forbidden errs.Forbidden
+	if _, err := service.ResolveStaffByEmail(context.Background(), querytypes.StaffResolveByEmailParams{Email: account.Email}); !errors.As(err, &forbidden) {
+		t.Fatalf("ResolveStaffByEmail() error = %v, want forbidden", err)
+	}
+}
+
+func TestResolveStaffByGitHubLogin(t *testing.T) {
+	t.Parallel()
+
+	service, _ := newAPITokenTestService()
+	users := service.users.(*fakeAPITokenUsers)
+	users.byID["dev"] = userrepo.User{ID: "dev", Email: "dev@example.com", GitHubLogin: "Dev-User"}
+	users.byID["bot"] = userrepo.User{ID: "bot", Email: "bot@example.com", GitHubLogin: "deploy-bot", IsServiceAccount: true}
+
+	user, err := service.ResolveStaffByGitHubLogin(context.Background(), " dev-user ")
+	if err != nil {
+		t.Fatalf("ResolveStaffByGitHubLogin() error = %v", err)
+	}
+	if user.ID != "dev" {
+		t.Fatalf("ResolveStaffByGitHubLogin() user = %q, want dev", user.ID)
+	}
+
+	var forbidden errs.Forbidden
+	if _, err := service.ResolveStaffByGitHubLogin(context.Background(), "deploy-bot"); !errors.As(err, &forbidden) {
+		t.Fatalf("ResolveStaffByGitHubLogin(service account) error = %v, want forbidden", err)
+	}
+	if _, err := service.ResolveStaffByGitHubLogin(context.Background(), "stranger"); !errors.As(err, &forbidden) {
+		t.Fatalf("ResolveStaffByGitHubLogin(unknown) error = %v, want forbidden", err)
+	}
+	var validation errs.Validation
+	if _, err := service.ResolveStaffByGitHubLogin(context.Background(), " "); !errors.As(err, &validation) {
+		t.Fatalf("ResolveStaffByGitHubLogin(empty) error = %v, want validation", err)
+	}
+}
@@ -9,6 +9,9 @@ import (
 	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
 )
 
+// errServiceAccountInteractiveLogin rejects browser logins of machine users; they authenticate only with API tokens.
+var errServiceAccountInteractiveLogin = errs.Forbidden{Msg: "service accounts cannot sign in interactively"}
+
 // ResolveStaffByEmail resolves one allowed staff user by email and optionally refreshes GitHub login.
 func (s *Service) ResolveStaffByEmail(ctx context.Context, params querytypes.StaffResolveByEmailParams) (entitytypes.User, error) {
 	email := strings.TrimSpace(params.Email)
@@ -23,6 +26,9 @@ func (s *Service) ResolveStaffByEmail(ctx context.Context, params querytypes.Sta
 	if !ok {
 		return entitytypes.User{}, errs.Forbidden{Msg: "email is not allowed"}
 	}
+	if user.IsServiceAccount {
+		return entitytypes.User{}, errServiceAccountInteractiveLogin
+	}
 
 	login := strings.TrimSpace(params.GitHubLogin)
 	if login != "" && !strings.EqualFold(user.GitHubLogin, login) {
@@ -35,6 +41,26 @@ func (s *Service) ResolveStaffByEmail(ctx context.Context, params querytypes.Sta
 	return user, nil
 }
 
+// ResolveStaffByGitHubLogin resolves one allowed interactive staff user by GitHub login.
+func (s *Service) ResolveStaffByGitHubLogin(ctx context.Context, githubLogin string) (entitytypes.User, error) {
+	login := strings.TrimSpace(githubLogin)
+	if login == "" {
+		return entitytypes.User{}, errs.Validation{Field: "github_login", Msg: "is required"}
+	}
+
+	user, ok, err := s.users.GetByGitHubLogin(ctx, login)
+	if err != nil {
+		return entitytypes.User{}, err
+	}
+	if !ok {
+		return entitytypes.User{}, errs.Forbidden{Msg: "github login is not allowed"}
+	}
+	if user.IsServiceAccount {
+		return entitytypes.User{}, errServiceAccountInteractiveLogin
+	}
+	return user, nil
+}
+
 // AuthorizeOAuthUser authorizes OAuth identity for an allowed user.
 func (s *Service) AuthorizeOAuthUser(ctx context.Context, params querytypes.StaffAuthorizeOAuthUserParams) (entitytypes.User, error) {
 	email := strings.TrimSpace(params.Email)
@@ -56,6 +82,9 @@ func (s *Service) AuthorizeOAuthUser(ctx context.Context, params querytypes.Staf
 	if !ok {
 		return entitytypes.User{}, errs.Forbidden{Msg: "email is not allowed"}
 	}
+	if user.IsServiceAccount {
+		return entitytypes.User{}, errServiceAccountInteractiveLogin
+	}
 
 	if err := s.users.UpdateGitHubIdentity(ctx, user.ID, params.GitHubUserID, login); err != nil {
 		return entitytypes.User{}, err
@@ -0,0 +1,133 @@
+package staff
+
+import (
+	"context"
+	"strings"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+// projectRoleRank orders built-in roles; OIDC group mappings grant only built-in roles.
+var projectRoleRank = map[string]int{
+	string(enumtypes.ProjectRoleBuiltinRead):      1,
+	string(enumtypes.ProjectRoleBuiltinReadWrite): 2,
+	string(enumtypes.ProjectRoleBuiltinAdmin):     3,
+}
+
+type oidcGroupGrants struct {
+	platformAdmin bool
+	projectRoles  map[string]string
+}
+
+func (g oidcGroupGrants) grantsAccess() bool {
+	return g.platformAdmin || len(g.projectRoles) > 0
+}
+
+// AuthorizeOIDCUser authorizes an identity verified by the generic OIDC provider.
+//
+// Existing users are matched by OIDC issuer+subject first and by email second; an email match is
+// refused when the user is already linked to another issuer+subject. Unknown users are
+// provisioned only when configured group mappings grant them access. When mappings are configured,
+// OIDC-granted platform admin flag and project memberships are synchronized on every login;
+// manually managed admin flags and memberships are left untouched.
+func (s *Service) AuthorizeOIDCUser(ctx context.Context, params querytypes.StaffAuthorizeOIDCUserParams) (entitytypes.User, error) {
+	issuer := strings.TrimSpace(params.Issuer)
+	if issuer == "" {
+		return entitytypes.User{}, errs.Validation{Field: "issuer", Msg: "is required"}
+	}
+	subject := strings.TrimSpace(params.Subject)
+	if subject == "" {
+		return entitytypes.User{}, errs.Validation{Field: "subject", Msg: "is required"}
+	}
+	email := strings.TrimSpace(params.Email)
+	if email == "" {
+		return entitytypes.User{}, errs.Validation{Field: "email", Msg: "is required"}
+	}
+
+	grants := resolveOIDCGroupGrants(s.cfg.OIDCGroupRoleMappings, params.Groups)
+
+	user, ok, err := s.users.GetByOIDCIdentity(ctx, issuer, subject)
+	if err != nil {
+		return entitytypes.User{}, err
+	}
+	if !ok {
+		user, ok, err = s.users.GetByEmail(ctx, email)
+		if err != nil {
+			return entitytypes.User{}, err
+		}
+	}
+	if !ok {
+		if !grants.grantsAccess() {
+			return entitytypes.User{}, errs.Forbidden{Msg: "email is not allowed"}
+		}
+		user, err = s.users.CreateAllowedUser(ctx, email, false)
+		if err != nil {
+			return entitytypes.User{}, err
+		}
+	}
+	if user.IsServiceAccount {
+		return entitytypes.User{}, errServiceAccountInteractiveLogin
+	}
+
+	linked, err := s.users.UpdateOIDCIdentity(ctx, user.ID, issuer, subject)
+	if err != nil {
+		return entitytypes.User{}, err
+	}
+	if !linked {
+		return entitytypes.User{}, errs.Forbidden{Msg: "user is linked to another oidc identity"}
+	}
+	if len(s.cfg.OIDCGroupRoleMappings) == 0 {
+		return user, nil
+	}
+
+	isPlatformAdmin, err := s.users.SyncOIDCPlatformAdmin(ctx, user.ID, grants.platformAdmin)
+	if err != nil {
+		return entitytypes.User{}, err
+	}
+	user.IsPlatformAdmin = isPlatformAdmin
+	if err := s.members.SyncOIDCRoles(ctx, user.ID, grants.projectRoles); err != nil {
+		return entitytypes.User{}, err
+	}
+
+	return user, nil
+}
+
+// resolveOIDCGroupGrants folds group mappings matched by user groups; the highest project role wins.
+func resolveOIDCGroupGrants(mappings []querytypes.OIDCGroupRoleMapping, groups []string) oidcGroupGrants {
+	grants := oidcGroupGrants{projectRoles: make(map[string]string)}
+	if len(mappings) == 0 || len(groups) == 0 {
+		return grants
+	}
+
+	memberOf := make(map[string]struct{}, len(groups))
+	for _, group := range groups {
+		if normalized := normalizeOIDCGroup(group); normalized != "" {
+			memberOf[normalized] = struct{}{}
+		}
+	}
+	for _, mapping := range mappings {
+		if _, ok := memberOf[normalizeOIDCGroup(mapping.Group)]; !ok {
+			continue
+		}
+		if mapping.PlatformAdmin {
+			grants.platformAdmin = true
+		}
+		slug := strings.TrimSpace(mapping.ProjectSlug)
+		role := strings.TrimSpace(mapping.Role)
+		if slug == "" || projectRoleRank[role] == 0 {
+			continue
+		}
+		if projectRoleRank[role] > projectRoleRank[grants.projectRoles[slug]] {
+			grants.projectRoles[slug] = role
+		}
+	}
+	return grants
+}
+
+// normalizeOIDCGroup trims whitespace and the leading slash used by group-path claims (e.g. Keycloak).
+func normalizeOIDCGroup(group string) string {
+	return strings.TrimPrefix(strings.TrimSpace(group), "/")
+}
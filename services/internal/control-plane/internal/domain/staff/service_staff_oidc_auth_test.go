@@ -0,0 +1,206 @@
+package staff
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	projectmemberrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectmember"
+	userrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/user"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+type fakeOIDCUsers struct {
+	userrepo.Repository
+	byEmail      map[string]userrepo.User
+	oidcIdentity map[string]string
+	oidcAdmin    map[string]bool
+	created      []string
+}
+
+func (f *fakeOIDCUsers) GetByOIDCIdentity(_ context.Context, issuer string, subject string) (userrepo.User, bool, error) {
+	for _, user := range f.byEmail {
+		if f.oidcIdentity[user.ID] == issuer+"|"+subject {
+			return user, true, nil
+		}
+	}
+	return userrepo.User{}, false, nil
+}
+
+func (f *fakeOIDCUsers) GetByEmail(_ context.Context, email string) (userrepo.User, bool, error) {
+	user, ok := f.byEmail[email]
+	return user, ok, nil
+}
+
+func (f *fakeOIDCUsers) CreateAllowedUser(_ context.Context, email string, isPlatformAdmin bool) (userrepo.User, error) {
+	user := userrepo.User{ID: "u-" + email, Email: email, IsPlatformAdmin: isPlatformAdmin}
+	f.byEmail[email] = user
+	f.created = append(f.created, email)
+	return user, nil
+}
+
+func (f *fakeOIDCUsers) UpdateOIDCIdentity(_ context.Context, userID string, issuer string, subject string) (bool, error) {
+	identity := issuer + "|" + subject
+	if linked, ok := f.oidcIdentity[userID]; ok && linked != identity {
+		return false, nil
+	}
+	f.oidcIdentity[userID] = identity
+	return true, nil
+}
+
+func (f *fakeOIDCUsers) SyncOIDCPlatformAdmin(_ context.Context, userID string, grant bool) (bool, error) {
+	for email, user := range f.byEmail {
+		if user.ID != userID {
+			continue
+		}
+		switch {
+		case grant && !user.IsPlatformAdmin:
+			user.IsPlatformAdmin = true
+			f.oidcAdmin[userID] = true
+		case !grant && f.oidcAdmin[userID]:
+			user.IsPlatformAdmin = false
+			f.oidcAdmin[userID] = false
+		}
+		f.byEmail[email] = user
+		return user.IsPlatformAdmin, nil
+	}
+	return false, nil
+}
+
+type fakeOIDCMembers struct {
+	projectmemberrepo.Repository
+	synced map[string]map[string]string
+}
+
+func (f *fakeOIDCMembers) SyncOIDCRoles(_ context.Context, userID string, rolesByProjectSlug map[string]string) error {
+	f.synced[userID] = maps.Clone(rolesByProjectSlug)
+	return nil
+}
+
+func newOIDCTestService(mappings []querytypes.OIDCGroupRoleMapping, users ...userrepo.User) (*Service, *fakeOIDCUsers, *fakeOIDCMembers) {
+	userRepo := &fakeOIDCUsers{byEmail: map[string]userrepo.User{}, oidcIdentity: map[string]string{}, oidcAdmin: map[string]bool{}}
+	for _, user := range users {
+		userRepo.byEmail[user.Email] = user
+	}
+	memberRepo := &fakeOIDCMembers{synced: map[string]map[string]string{}}
+	return &Service{
+		cfg:     Config{OIDCGroupRoleMappings: mappings},
+		users:   userRepo,
+		members: memberRepo,
+	}, userRepo, memberRepo
+}
+
+var testOIDCMappings = []querytypes.OIDCGroupRoleMapping{
+	{Group: "kodex-admins", PlatformAdmin: true},
+	{Group: "team-a", ProjectSlug: "alpha", Role: "read"},
+	{Group: "team-a-leads", ProjectSlug: "alpha", Role: "admin"},
+	{Group: "team-b", ProjectSlug: "beta", Role: "read_write"},
+}
+
+func TestAuthorizeOIDCUser_ProvisionsMappedUserWithHighestRole(t *testing.T) {
+	t.Parallel()
+
+	service, users, members := newOIDCTestService(testOIDCMappings)
+	user, err := service.AuthorizeOIDCUser(context.Background(), querytypes.StaffAuthorizeOIDCUserParams{
+		Issuer:  "https://idp.example.com",
+		Subject: "sub-1",
+		Email:   "dev@example.com",
+		Groups:  []string{"/team-a", "team-a-leads", "team-b", "unmapped"},
+	})
+	if err != nil {
+		t.Fatalf("AuthorizeOIDCUser() error = %v", err)
+	}
+	if user.IsPlatformAdmin || len(users.created) != 1 {
+		t.Fatalf("unexpected provisioned user: %#v, created %v", user, users.created)
+	}
+	want := map[string]string{"alpha": "admin", "beta": "read_write"}
+	if got := members.synced[user.ID]; !maps.Equal(got, want) {
+		t.Fatalf("synced roles = %v, want %v", got, want)
+	}
+	if users.oidcIdentity[user.ID] != "https://idp.example.com|sub-1" {
+		t.Fatalf("oidc identity is not linked: %v", users.oidcIdentity)
+	}
+}
+
+func TestAuthorizeOIDCUser_RejectsUnknownUserWithoutMappedGroups(t *testing.T) {
+	t.Parallel()
+
+	service, users, _ := newOIDCTestService(testOIDCMappings)
+	_, err := service.AuthorizeOIDCUser(context.Background(), querytypes.StaffAuthorizeOIDCUserParams{
+		Issuer:  "https://idp.example.com",
+		Subject: "sub-2",
+		Email:   "guest@example.com",
+		Groups:  []string{"unmapped"},
+	})
+	var forbidden errs.Forbidden
+	if !errors.As(err, &forbidden) {
+		t.Fatalf("AuthorizeOIDCUser() error = %v, want forbidden", err)
+	}
+	if len(users.created) != 0 {
+		t.Fatalf("unexpected provisioned users: %v", users.created)
+	}
+}
+
+func TestAuthorizeOIDCUser_SyncsPlatformAdminWithoutRevokingManualGrant(t *testing.T) {
+	t.Parallel()
+
+	service, _, members := newOIDCTestService(testOIDCMappings,
+		userrepo.User{ID: "owner", Email: "owner@example.com", IsPlatformAdmin: true},
+		userrepo.User{ID: "ops", Email: "ops@example.com"},
+	)
+	params := querytypes.StaffAuthorizeOIDCUserParams{Issuer: "https://idp.example.com", Subject: "sub-ops", Email: "ops@example.com", Groups: []string{"kodex-admins"}}
+
+	user, err := service.AuthorizeOIDCUser(context.Background(), params)
+	if err != nil || !user.IsPlatformAdmin {
+		t.Fatalf("AuthorizeOIDCUser() = %#v, %v; want OIDC-granted admin", user, err)
+	}
+	params.Groups = nil
+	user, err = service.AuthorizeOIDCUser(context.Background(), params)
+	if err != nil || user.IsPlatformAdmin {
+		t.Fatalf("AuthorizeOIDCUser() = %#v, %v; want revoked OIDC admin", user, err)
+	}
+	if got := members.synced["ops"]; len(got) != 0 {
+		t.Fatalf("expected OIDC memberships to be cleared, got %v", got)
+	}
+
+	user, err = service.AuthorizeOIDCUser(context.Background(), querytypes.StaffAuthorizeOIDCUserParams{
+		Issuer:  "https://idp.example.com",
+		Subject: "sub-owner",
+		Email:   "owner@example.com",
+	})
+	if err != nil || !user.IsPlatformAdmin {
+		t.Fatalf("AuthorizeOIDCUser() = %#v, %v; manual admin must be kept", user, err)
+	}
+}
+
+func TestAuthorizeOIDCUser_RefusesToRelinkBoundUserByEmail(t *testing.T) {
+	t.Parallel()
+
+	service, users, _ := newOIDCTestService(testOIDCMappings, userrepo.User{ID: "dev", Email: "dev@example.com"})
+	users.oidcIdentity["dev"] = "https://idp.example.com|sub-dev"
+
+	_, err := service.AuthorizeOIDCUser(context.Background(), querytypes.StaffAuthorizeOIDCUserParams{
+		Issuer:  "https://other-idp.example.com",
+		Subject: "sub-attacker",
+		Email:   "dev@example.com",
+		Groups:  []string{"team-a"},
+	})
+	var forbidden errs.Forbidden
+	if !errors.As(err, &forbidden) {
+		t.Fatalf("AuthorizeOIDCUser() error = %v, want forbidden", err)
+	}
+	if got := users.oidcIdentity["dev"]; got != "https://idp.example.com|sub-dev" {
+		t.Fatalf("oidc identity was relinked to %q", got)
+	}
+
+	user, err := service.AuthorizeOIDCUser(context.Background(), querytypes.StaffAuthorizeOIDCUserParams{
+		Issuer:  "https://idp.example.com",
+		Subject: "sub-dev",
+		Email:   "dev@example.com",
+	})
+	if err != nil || user.ID != "dev" {
+		t.Fatalf("AuthorizeOIDCUser() = %#v, %v; want linked user", user, err)
+	}
+}
@@ -0,0 +1,295 @@
+package staff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	repoprovider "github.com/codex-k8s/kodex/libs/go/repo/provider"
+	floweventrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/flowevent"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+const (
+	autoAdvanceSkipPolicyNotConfigured   = "policy_not_configured"
+	autoAdvanceSkipStageNotResolved      = "current_stage_not_resolved"
+	autoAdvanceSkipStageNotOptedIn       = "stage_not_opted_in"
+	autoAdvanceSkipRuleWithoutConditions = "rule_without_conditions"
+	autoAdvanceSkipNoNextStage           = "no_next_stage"
+	autoAdvanceSkipApprovedLabelMissing  = "approved_label_missing"
+	autoAdvanceSkipPullRequestMissing    = "pull_request_not_resolved"
+	autoAdvanceSkipReviewNotApproved     = "review_not_approved"
+	autoAdvanceSkipMaxChainReached       = "max_chain_reached"
+
+	// autoAdvanceChainLookback bounds audit events scanned per issue when counting the chain.
+	autoAdvanceChainLookback = 200
+)
+
+type nextStepActionExecutedPayload struct {
+	Source             string   `json:"source"`
+	Trigger            string   `json:"trigger,omitempty"`
+	RepositoryFullName string   `json:"repository_full_name"`
+	ThreadKind         string   `json:"thread_kind"`
+	ThreadNumber       int      `json:"thread_number"`
+	PullRequestNumber  int      `json:"pull_request_number,omitempty"`
+	Stage              string   `json:"stage,omitempty"`
+	RemovedLabels      []string `json:"removed_labels"`
+	AddedLabels        []string `json:"added_labels"`
+	ActorUserID        string   `json:"actor_user_id,omitempty"`
+	ActorLogin         string   `json:"actor_login,omitempty"`
+	ChainPosition      int      `json:"chain_position,omitempty"`
+}
+
+type stageAutoAdvanceSkippedPayload struct {
+	Reason             string `json:"reason"`
+	Trigger            string `json:"trigger"`
+	RepositoryFullName string `json:"repository_full_name"`
+	IssueNumber        int    `json:"issue_number"`
+	PullRequestNumber  int    `json:"pull_request_number,omitempty"`
+	Stage              string `json:"stage"`
+	CurrentLabel       string `json:"current_label"`
+	TargetLabel        string `json:"target_label,omitempty"`
+	ActorLogin         string `json:"actor_login,omitempty"`
+}
+
+// AutoAdvanceStage applies project `auto_advance` rules to one approval signal.
+// Transitions run through the same executor as staff next-step actions and share their audit trail;
+// skips are audited only for stages that opted in.
+func (s *Service) AutoAdvanceStage(ctx context.Context, params querytypes.StageAutoAdvanceParams) (querytypes.StageAutoAdvanceResult, error) {
+	if s.githubMgmt == nil {
+		return querytypes.StageAutoAdvanceResult{}, fmt.Errorf("failed_precondition: github management client is not configured")
+	}
+	projectID := strings.TrimSpace(params.ProjectID)
+	if projectID == "" {
+		return querytypes.StageAutoAdvanceResult{}, errs.Validation{Field: "project_id", Msg: "is required"}
+	}
+	if params.IssueNumber <= 0 {
+		return querytypes.StageAutoAdvanceResult{}, errs.Validation{Field: "issue_number", Msg: "must be positive"}
+	}
+	owner, repo, err := parseGitHubFullName(params.RepositoryFullName)
+	if err != nil {
+		return querytypes.StageAutoAdvanceResult{}, errs.Validation{Field: "repository_full_name", Msg: err.Error()}
+	}
+
+	policy, ok, err := s.projects.GetAutoAdvancePolicy(ctx, projectID)
+	if err != nil {
+		return querytypes.StageAutoAdvanceResult{}, err
+	}
+	if !ok || len(policy.Rules) == 0 {
+		return querytypes.StageAutoAdvanceResult{SkipReason: autoAdvanceSkipPolicyNotConfigured}, nil
+	}
+
+	binding, ok, err := s.repos.FindByProviderOwnerName(ctx, string(repoprovider.ProviderGitHub), owner, repo)
+	if err != nil {
+		return querytypes.StageAutoAdvanceResult{}, err
+	}
+	if !ok || binding.ProjectID != projectID {
+		return querytypes.StageAutoAdvanceResult{}, errs.Validation{Field: "repository_full_name", Msg: "repository is not bound to project"}
+	}
+	_, botToken, _, _, err := s.resolveEffectiveGitHubTokens(ctx, projectID, binding.RepositoryID)
+	if err != nil {
+		return querytypes.StageAutoAdvanceResult{}, err
+	}
+
+	issueLabels, err := s.githubMgmt.ListIssueLabels(ctx, botToken, owner, repo, params.IssueNumber)
+	if err != nil {
+		return querytypes.StageAutoAdvanceResult{}, fmt.Errorf("list issue labels: %w", err)
+	}
+	issueLabels = normalizeManagedLabels(issueLabels)
+	currentRunLabels := collectRunStageLabels(issueLabels)
+	if len(currentRunLabels) != 1 {
+		return querytypes.StageAutoAdvanceResult{SkipReason: autoAdvanceSkipStageNotResolved}, nil
+	}
+	descriptor, ok := s.cfg.NextStepLabels.DescriptorByRunLabel(currentRunLabels[0])
+	if !ok {
+		// Revise and auxiliary labels mean the stage is still in progress.
+		return querytypes.StageAutoAdvanceResult{SkipReason: autoAdvanceSkipStageNotResolved}, nil
+	}
+	rule, ok := findAutoAdvanceRule(policy.Rules, descriptor.Stage)
+	if !ok {
+		return querytypes.StageAutoAdvanceResult{SkipReason: autoAdvanceSkipStageNotOptedIn, Stage: descriptor.Stage}, nil
+	}
+
+	result := querytypes.StageAutoAdvanceResult{
+		Stage:        descriptor.Stage,
+		CurrentLabel: currentRunLabels[0],
+	}
+	skip := func(reason string) (querytypes.StageAutoAdvanceResult, error) {
+		result.SkipReason = reason
+		return result, s.recordStageAutoAdvanceSkipped(ctx, owner, repo, params, result)
+	}
+
+	targetLabel, ok := s.cfg.NextStepLabels.NextMainPathRunLabel(result.CurrentLabel)
+	if !ok {
+		return skip(autoAdvanceSkipNoNextStage)
+	}
+	result.TargetLabel = targetLabel
+	if !rule.RequireApprovedLabel && !rule.RequireReviewApproval {
+		return skip(autoAdvanceSkipRuleWithoutConditions)
+	}
+	if rule.RequireApprovedLabel {
+		approvedLabel := s.stateApprovedLabel()
+		hasApprovedLabel := slices.Contains(issueLabels, approvedLabel)
+		if !hasApprovedLabel && params.PullRequestNumber > 0 {
+			prLabels, err := s.githubMgmt.ListIssueLabels(ctx, botToken, owner, repo, params.PullRequestNumber)
+			if err != nil {
+				return querytypes.StageAutoAdvanceResult{}, fmt.Errorf("list pull request labels: %w", err)
+			}
+			hasApprovedLabel = slices.Contains(normalizeManagedLabels(prLabels), approvedLabel)
+		}
+		if !hasApprovedLabel {
+			return skip(autoAdvanceSkipApprovedLabelMissing)
+		}
+	}
+	if rule.RequireReviewApproval {
+		if params.PullRequestNumber <= 0 {
+			return skip(autoAdvanceSkipPullRequestMissing)
+		}
+		approved, err := s.githubMgmt.IsPullRequestApproved(ctx, botToken, owner, repo, params.PullRequestNumber, s.cfg.GitBotUsername)
+		if err != nil {
+			return querytypes.StageAutoAdvanceResult{}, fmt.Errorf("check pull request approval: %w", err)
+		}
+		if !approved {
+			return skip(autoAdvanceSkipReviewNotApproved)
+		}
+	}
+
+	correlationID := nextStepCorrelationID(owner, repo, querytypes.NextStepThreadKindIssue, params.IssueNumber)
+	maxChain := policy.MaxChain
+	if maxChain <= 0 {
+		maxChain = querytypes.StageAutoAdvanceDefaultMaxChain
+	}
+	chain, err := s.countAutoAdvanceChain(ctx, correlationID)
+	if err != nil {
+		return querytypes.StageAutoAdvanceResult{}, err
+	}
+	if chain >= maxChain {
+		return skip(autoAdvanceSkipMaxChainReached)
+	}
+
+	// Current run labels act as CAS guard against concurrent approvals of the same stage.
+	transition, err := s.previewOrExecuteIssueStageTransitionWithCAS(ctx, botToken, owner, repo, params.IssueNumber, targetLabel, currentRunLabels, true)
+	if err != nil {
+		return querytypes.StageAutoAdvanceResult{}, err
+	}
+	// Approval is per stage: the issue-level approved label must not carry over to the next stage.
+	// Cleanup is best-effort so the transition is always audited and counted in the chain.
+	if approvedLabel := s.stateApprovedLabel(); slices.Contains(transition.FinalLabels, approvedLabel) {
+		if err := s.githubMgmt.RemoveIssueLabel(ctx, botToken, owner, repo, params.IssueNumber, approvedLabel); err == nil {
+			transition.RemovedLabels = append(transition.RemovedLabels, approvedLabel)
+			transition.FinalLabels = slices.DeleteFunc(transition.FinalLabels, func(label string) bool { return label == approvedLabel })
+		}
+	}
+	result.Advanced = true
+	result.Transition = transition
+
+	if err := s.recordNextStepActionExecuted(ctx, correlationID, floweventdomain.ActorTypeSystem, floweventdomain.ActorIDControlPlane, nextStepActionExecutedPayload{
+		Source:             querytypes.NextStepActionSourceAutoAdvance,
+		Trigger:            strings.TrimSpace(params.Trigger),
+		RepositoryFullName: transition.RepositoryFullName,
+		ThreadKind:         transition.ThreadKind,
+		ThreadNumber:       transition.ThreadNumber,
+		PullRequestNumber:  params.PullRequestNumber,
+		Stage:              descriptor.Stage,
+		RemovedLabels:      transition.RemovedLabels,
+		AddedLabels:        transition.AddedLabels,
+		ActorLogin:         strings.TrimSpace(params.SenderLogin),
+		ChainPosition:      chain + 1,
+	}); err != nil {
+		return result, fmt.Errorf("record auto-advance audit event: %w", err)
+	}
+	return result, nil
+}
+
+// countAutoAdvanceChain returns the number of consecutive automatic transitions since the last manual one.
+func (s *Service) countAutoAdvanceChain(ctx context.Context, correlationID string) (int, error) {
+	events, err := s.runs.ListEventsByCorrelation(ctx, correlationID, autoAdvanceChainLookback)
+	if err != nil {
+		return 0, fmt.Errorf("list next-step audit events: %w", err)
+	}
+	chain := 0
+	for _, event := range events {
+		if event.EventType != string(floweventdomain.EventTypeNextStepActionExecuted) {
+			continue
+		}
+		var payload nextStepActionExecutedPayload
+		if err := json.Unmarshal(event.PayloadJSON, &payload); err != nil || payload.Source != querytypes.NextStepActionSourceAutoAdvance {
+			break
+		}
+		chain++
+	}
+	return chain, nil
+}
+
+func (s *Service) recordStageAutoAdvanceSkipped(ctx context.Context, owner string, repo string, params querytypes.StageAutoAdvanceParams, result querytypes.StageAutoAdvanceResult) error {
+	payload, err := json.Marshal(stageAutoAdvanceSkippedPayload{
+		Reason:             result.SkipReason,
+		Trigger:            strings.TrimSpace(params.Trigger),
+		RepositoryFullName: owner + "/" + repo,
+		IssueNumber:        params.IssueNumber,
+		PullRequestNumber:  params.PullRequestNumber,
+		Stage:              result.Stage,
+		CurrentLabel:       result.CurrentLabel,
+		TargetLabel:        result.TargetLabel,
+		ActorLogin:         strings.TrimSpace(params.SenderLogin),
+	})
+	if err != nil {
+		return fmt.Errorf("marshal auto-advance skip payload: %w", err)
+	}
+	return s.insertNextStepFlowEvent(ctx, floweventrepo.InsertParams{
+		CorrelationID: nextStepCorrelationID(owner, repo, querytypes.NextStepThreadKindIssue, params.IssueNumber),
+		ActorType:     floweventdomain.ActorTypeSystem,
+		ActorID:       floweventdomain.ActorIDControlPlane,
+		EventType:     floweventdomain.EventTypeStageAutoAdvanceSkipped,
+		Payload:       payload,
+	})
+}
+
+func (s *Service) recordNextStepActionExecuted(ctx context.Context, correlationID string, actorType floweventdomain.ActorType, actorID floweventdomain.ActorID, item nextStepActionExecutedPayload) error {
+	payload, err := json.Marshal(item)
+	if err != nil {
+		return fmt.Errorf("marshal next-step audit payload: %w", err)
+	}
+	return s.insertNextStepFlowEvent(ctx, floweventrepo.InsertParams{
+		CorrelationID: correlationID,
+		ActorType:     actorType,
+		ActorID:       actorID,
+		EventType:     floweventdomain.EventTypeNextStepActionExecuted,
+		Payload:       payload,
+	})
+}
+
+func (s *Service) insertNextStepFlowEvent(ctx context.Context, params floweventrepo.InsertParams) error {
+	if s.flowEvents == nil {
+		return nil
+	}
+	params.CreatedAt = time.Now().UTC()
+	return s.flowEvents.Insert(ctx, params)
+}
+
+func (s *Service) stateApprovedLabel() string {
+	if label := strings.ToLower(strings.TrimSpace(s.cfg.StateApprovedLabel)); label != "" {
+		return label
+	}
+	return webhookdomain.DefaultStateApprovedLabel
+}
+
+// nextStepCorrelationID groups next-step audit events of one GitHub thread.
+func nextStepCorrelationID(owner string, repo string, threadKind string, threadNumber int) string {
+	return strings.ToLower(fmt.Sprintf("next-step:%s/%s:%s:%d", owner, repo, threadKind, threadNumber))
+}
+
+func findAutoAdvanceRule(rules []querytypes.ProjectAutoAdvanceRule, stage string) (querytypes.ProjectAutoAdvanceRule, bool) {
+	for _, rule := range rules {
+		if strings.EqualFold(strings.TrimSpace(rule.Stage), stage) {
+			return rule, true
+		}
+	}
+	return querytypes.ProjectAutoAdvanceRule{}, false
+}
@@ -0,0 +1,203 @@
+package staff
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/codex-k8s/kodex/libs/go/crypto/tokencrypt"
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	nextstepdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/nextstep"
+	floweventrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/flowevent"
+	projectrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/project"
+	repocfgrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/repocfg"
+	staffrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/staffrun"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+type fakeAutoAdvanceProjects struct {
+	projectrepo.Repository
+	policy projectrepo.AutoAdvance
+}
+
+func (f *fakeAutoAdvanceProjects) GetAutoAdvancePolicy(context.Context, string) (projectrepo.AutoAdvance, bool, error) {
+	return f.policy, true, nil
+}
+
+type fakeAutoAdvanceRepos struct {
+	repocfgrepo.Repository
+	token []byte
+}
+
+func (f *fakeAutoAdvanceRepos) FindByProviderOwnerName(context.Context, string, string, string) (repocfgrepo.FindResult, bool, error) {
+	return repocfgrepo.FindResult{ProjectID: "p1", RepositoryID: "r1"}, true, nil
+}
+
+func (f *fakeAutoAdvanceRepos) GetTokenEncrypted(context.Context, string) ([]byte, bool, error) {
+	return f.token, true, nil
+}
+
+func (f *fakeAutoAdvanceRepos) GetBotTokenEncrypted(context.Context, string) ([]byte, bool, error) {
+	return f.token, true, nil
+}
+
+type fakeAutoAdvanceFlowEvents struct {
+	inserted []floweventrepo.InsertParams
+}
+
+func (f *fakeAutoAdvanceFlowEvents) Insert(_ context.Context, params floweventrepo.InsertParams) error {
+	f.inserted = append(f.inserted, params)
+	return nil
+}
+
+type fakeAutoAdvanceRuns struct {
+	staffrunrepo.Repository
+	events *fakeAutoAdvanceFlowEvents
+}
+
+func (f *fakeAutoAdvanceRuns) ListEventsByCorrelation(_ context.Context, correlationID string, _ int) ([]staffrunrepo.FlowEvent, error) {
+	out := make([]staffrunrepo.FlowEvent, 0, len(f.events.inserted))
+	for _, item := range slices.Backward(f.events.inserted) {
+		if item.CorrelationID != correlationID {
+			continue
+		}
+		out = append(out, staffrunrepo.FlowEvent{CorrelationID: item.CorrelationID, EventType: string(item.EventType), PayloadJSON: item.Payload})
+	}
+	return out, nil
+}
+
+func newAutoAdvanceTestService(t *testing.T, policy projectrepo.AutoAdvance, github *stubNextStepGitHubMgmt) (*Service, *fakeAutoAdvanceFlowEvents) {
+	t.Helper()
+
+	crypto, err := tokencrypt.NewService("00112233445566778899aabbccddeeff00112233445566778899aabbccddeeff")
+	if err != nil {
+		t.Fatalf("tokencrypt.NewService: %v", err)
+	}
+	token, err := crypto.EncryptString("bot-token")
+	if err != nil {
+		t.Fatalf("EncryptString: %v", err)
+	}
+	events := &fakeAutoAdvanceFlowEvents{}
+	return &Service{
+		cfg:        Config{NextStepLabels: nextstepdomain.DefaultLabels()},
+		projects:   &fakeAutoAdvanceProjects{policy: policy},
+		repos:      &fakeAutoAdvanceRepos{token: token},
+		tokencrypt: crypto,
+		runs:       &fakeAutoAdvanceRuns{events: events},
+		flowEvents: events,
+		githubMgmt: github,
+	}, events
+}
+
+func TestAutoAdvanceStage_AdvancesOptedInStageAndAudits(t *testing.T) {
+	t.Parallel()
+
+	github := &stubNextStepGitHubMgmt{labels: []string{"run:prd", "state:approved"}, approved: true}
+	service, events := newAutoAdvanceTestService(t, projectrepo.AutoAdvance{
+		Rules: []querytypes.ProjectAutoAdvanceRule{{Stage: "prd", RequireReviewApproval: true, RequireApprovedLabel: true}},
+	}, github)
+
+	result, err := service.AutoAdvanceStage(context.Background(), querytypes.StageAutoAdvanceParams{
+		ProjectID:          "p1",
+		RepositoryFullName: "kodex/kodex",
+		IssueNumber:        42,
+		PullRequestNumber:  43,
+		Trigger:            querytypes.StageAutoAdvanceTriggerReviewApproved,
+	})
+	if err != nil {
+		t.Fatalf("AutoAdvanceStage() error = %v", err)
+	}
+	if !result.Advanced || result.TargetLabel != "run:arch" {
+		t.Fatalf("unexpected auto-advance result: %#v", result)
+	}
+	if slices.Contains(github.labels, "run:prd") || !slices.Contains(github.labels, "run:arch") || slices.Contains(github.labels, "state:approved") {
+		t.Fatalf("unexpected issue labels after auto-advance: %#v", github.labels)
+	}
+	if len(events.inserted) != 1 || events.inserted[0].EventType != floweventdomain.EventTypeNextStepActionExecuted {
+		t.Fatalf("unexpected audit events: %#v", events.inserted)
+	}
+	var payload nextStepActionExecutedPayload
+	if err := json.Unmarshal(events.inserted[0].Payload, &payload); err != nil {
+		t.Fatalf("decode audit payload: %v", err)
+	}
+	if payload.Source != querytypes.NextStepActionSourceAutoAdvance || payload.Stage != "prd" || payload.ChainPosition != 1 {
+		t.Fatalf("unexpected audit payload: %#v", payload)
+	}
+}
+
+func TestAutoAdvanceStage_SkipsWhenConditionsFailOrStageNotOptedIn(t *testing.T) {
+	t.Parallel()
+
+	policy := projectrepo.AutoAdvance{
+		Rules: []querytypes.ProjectAutoAdvanceRule{{Stage: "prd", RequireReviewApproval: true}},
+	}
+	params := querytypes.StageAutoAdvanceParams{ProjectID: "p1", RepositoryFullName: "kodex/kodex", IssueNumber: 42, PullRequestNumber: 43}
+
+	service, events := newAutoAdvanceTestService(t, policy, &stubNextStepGitHubMgmt{labels: []string{"run:prd"}})
+	result, err := service.AutoAdvanceStage(context.Background(), params)
+	if err != nil {
+		t.Fatalf("AutoAdvanceStage() error = %v", err)
+	}
+	if result.Advanced || result.SkipReason != autoAdvanceSkipReviewNotApproved {
+		t.Fatalf("unexpected result for unapproved review: %#v", result)
+	}
+	if len(events.inserted) != 1 || events.inserted[0].EventType != floweventdomain.EventTypeStageAutoAdvanceSkipped {
+		t.Fatalf("expected skip audit event, got %#v", events.inserted)
+	}
+
+	service, events = newAutoAdvanceTestService(t, policy, &stubNextStepGitHubMgmt{labels: []string{"run:arch"}, approved: true})
+	result, err = service.AutoAdvanceStage(context.Background(), params)
+	if err != nil {
+		t.Fatalf("AutoAdvanceStage() error = %v", err)
+	}
+	if result.Advanced || result.SkipReason != autoAdvanceSkipStageNotOptedIn || len(events.inserted) != 0 {
+		t.Fatalf("unexpected result for stage without rule: %#v, events %#v", result, events.inserted)
+	}
+}
+
+func TestAutoAdvanceStage_StopsAtMaxChainUntilManualAction(t *testing.T) {
+	t.Parallel()
+
+	github := &stubNextStepGitHubMgmt{labels: []string{"run:intake"}, approved: true}
+	service, events := newAutoAdvanceTestService(t, projectrepo.AutoAdvance{
+		MaxChain: 2,
+		Rules: []querytypes.ProjectAutoAdvanceRule{
+			{Stage: "intake", RequireReviewApproval: true},
+			{Stage: "vision", RequireReviewApproval: true},
+			{Stage: "prd", RequireReviewApproval: true},
+		},
+	}, github)
+	params := querytypes.StageAutoAdvanceParams{ProjectID: "p1", RepositoryFullName: "kodex/kodex", IssueNumber: 42, PullRequestNumber: 43}
+
+	for _, want := range []string{"run:vision", "run:prd"} {
+		result, err := service.AutoAdvanceStage(context.Background(), params)
+		if err != nil {
+			t.Fatalf("AutoAdvanceStage() error = %v", err)
+		}
+		if !result.Advanced || result.TargetLabel != want {
+			t.Fatalf("AutoAdvanceStage() = %#v, want advance to %q", result, want)
+		}
+	}
+	result, err := service.AutoAdvanceStage(context.Background(), params)
+	if err != nil {
+		t.Fatalf("AutoAdvanceStage() error = %v", err)
+	}
+	if result.Advanced || result.SkipReason != autoAdvanceSkipMaxChainReached {
+		t.Fatalf("expected max chain skip, got %#v", result)
+	}
+
+	payload, _ := json.Marshal(nextStepActionExecutedPayload{Source: querytypes.NextStepActionSourceManual})
+	events.inserted = append(events.inserted, floweventrepo.InsertParams{
+		CorrelationID: nextStepCorrelationID("kodex", "kodex", querytypes.NextStepThreadKindIssue, 42),
+		EventType:     floweventdomain.EventTypeNextStepActionExecuted,
+		Payload:       payload,
+	})
+	result, err = service.AutoAdvanceStage(context.Background(), params)
+	if err != nil {
+		t.Fatalf("AutoAdvanceStage() error = %v", err)
+	}
+	if !result.Advanced || result.TargetLabel != "run:arch" {
+		t.Fatalf("expected chain reset after manual action, got %#v", result)
+	}
+}
@@ -9,4 +9,6 @@ type AgentRun struct {
 	ProjectID     string
 	Status        string
 	RunPayload    json.RawMessage
+	Priority      int
+	Attempt       int
 }
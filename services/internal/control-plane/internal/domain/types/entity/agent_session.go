@@ -3,6 +3,8 @@ package entity
 import (
 	"encoding/json"
 	"time"
+
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 )
 
 // AgentSession stores resumable codex-cli snapshot and run-level execution metadata.
@@ -34,8 +36,12 @@ type AgentSession struct {
 	SnapshotVersion      int64
 	SnapshotChecksum     string
 	SnapshotUpdatedAt    time.Time
-	StartedAt            time.Time
-	FinishedAt           time.Time
-	CreatedAt            time.Time
-	UpdatedAt            time.Time
+	// SnapshotStorage is "blob" when payloads live in object storage and only metadata stays in Postgres.
+	SnapshotStorage       enumtypes.AgentSessionSnapshotStorage
+	SnapshotBlobKey       string
+	SnapshotBlobSizeBytes int64
+	StartedAt             time.Time
+	FinishedAt            time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
 }
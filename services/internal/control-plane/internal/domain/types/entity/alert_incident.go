@@ -0,0 +1,26 @@
+package entity
+
+import "time"
+
+// AlertIncident is one deduplicated Alertmanager alert tracked per project by fingerprint.
+type AlertIncident struct {
+	ID                 string
+	ProjectID          string
+	Fingerprint        string
+	AlertName          string
+	Severity           string
+	Status             string
+	LabelsJSON         []byte
+	AnnotationsJSON    []byte
+	GeneratorURL       string
+	StartsAt           time.Time
+	EndsAt             *time.Time
+	Occurrences        int
+	RepositoryFullName string
+	IssueNumber        int64
+	IssueURL           string
+	LastRunID          string
+	LastReceivedAt     time.Time
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
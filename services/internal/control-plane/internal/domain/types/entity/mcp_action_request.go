@@ -45,6 +45,23 @@ type MCPActionRequest struct {
 	RequestedBy   string
 	AppliedBy     string
 	Payload       json.RawMessage
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	// Policy is set when a multi-approver policy matched at request creation.
+	Policy    *MCPApprovalPolicySnapshot
+	Votes     []MCPApprovalVote
+	ExpiresAt *time.Time
+	// RunSuspendedAt is set once the source run released its pod to wait for the decision.
+	RunSuspendedAt *time.Time
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// ApprovedVotes returns number of approving votes recorded on the request.
+func (r MCPActionRequest) ApprovedVotes() int {
+	count := 0
+	for _, vote := range r.Votes {
+		if vote.Decision == string(MCPApprovalStateApproved) {
+			count++
+		}
+	}
+	return count
 }
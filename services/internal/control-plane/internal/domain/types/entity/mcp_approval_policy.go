@@ -0,0 +1,43 @@
+package entity
+
+import "time"
+
+// MCPApprovalPolicy defines multi-approver rules for one approval-gated MCP tool in a project.
+// Empty Environment matches every environment without a more specific policy.
+type MCPApprovalPolicy struct {
+	ID                  string
+	ProjectID           string
+	ToolName            string
+	Environment         string
+	RequiredApprovals   int
+	EligibleRoles       []string
+	EligibleUserIDs     []string
+	SeparationOfDuties  bool
+	ExpiresAfterSeconds int
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// MCPApprovalPolicySnapshot is the policy copy frozen into one action request when it is created.
+type MCPApprovalPolicySnapshot struct {
+	PolicyID            string   `json:"policy_id"`
+	Environment         string   `json:"environment,omitempty"`
+	RequiredApprovals   int      `json:"required_approvals"`
+	EligibleRoles       []string `json:"eligible_roles,omitempty"`
+	EligibleUserIDs     []string `json:"eligible_user_ids,omitempty"`
+	SeparationOfDuties  bool     `json:"separation_of_duties"`
+	ExpiresAfterSeconds int      `json:"expires_after_seconds,omitempty"`
+	// Initiator is GitHub login of the run sender; it may not vote when SeparationOfDuties is set.
+	Initiator string `json:"initiator,omitempty"`
+	// InitiatorUserID is the platform user resolved from Initiator; separation of duties compares approvers by it.
+	InitiatorUserID string `json:"initiator_user_id,omitempty"`
+}
+
+// MCPApprovalVote is one individual approver decision recorded on an action request.
+type MCPApprovalVote struct {
+	ActorID  string    `json:"actor_id"`
+	UserID   string    `json:"user_id"`
+	Decision string    `json:"decision"`
+	Reason   string    `json:"reason,omitempty"`
+	VotedAt  time.Time `json:"voted_at"`
+}
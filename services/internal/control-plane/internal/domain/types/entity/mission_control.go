@@ -116,3 +116,22 @@ type MissionControlCommand struct {
 	UpdatedAt           time.Time
 	ReconciledAt        *time.Time
 }
+
+// MissionControlVoiceCandidate stores one voice-originated draft command awaiting staff confirmation.
+type MissionControlVoiceCandidate struct {
+	ID                string
+	ProjectID         string
+	SourceKind        enumtypes.MissionControlVoiceCandidateSourceKind
+	Status            enumtypes.MissionControlVoiceCandidateStatus
+	DedupeKey         string
+	CommandKind       enumtypes.MissionControlCommandKind
+	TranscriptExcerpt string
+	StructuredSummary string
+	PayloadJSON       json.RawMessage
+	PromotedCommandID string
+	CreatedBy         string
+	DecidedBy         string
+	DecidedAt         *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
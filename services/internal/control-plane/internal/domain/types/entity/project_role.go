@@ -0,0 +1,16 @@
+package entity
+
+import "time"
+
+// ProjectRole is a named set of permission grants assigned to project members.
+// Built-in roles (read, read_write, admin) are defined in code and never stored.
+type ProjectRole struct {
+	ProjectID   string
+	Name        string
+	Description string
+	// Permissions holds grants in "<permission>" or "<permission>:<qualifier>" form.
+	Permissions []string
+	Builtin     bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
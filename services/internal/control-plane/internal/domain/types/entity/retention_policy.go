@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
+
+// RetentionPolicy defines how long rows of one history target are kept globally or for one project.
+type RetentionPolicy struct {
+	ID string
+	// ProjectID is empty for global policy; project policies override global one for the same target.
+	ProjectID        string
+	Target           enumtypes.RetentionTarget
+	RetentionDays    int
+	Action           enumtypes.RetentionAction
+	Enabled          bool
+	UpdatedBy        string
+	LastSweptAt      *time.Time
+	LastRemovedCount int64
+	LastArchiveKey   string
+	LastError        string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
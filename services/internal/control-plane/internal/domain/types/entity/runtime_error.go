@@ -15,6 +15,7 @@ type RuntimeError struct {
 	ProjectID     string
 	Namespace     string
 	JobName       string
+	GroupID       string
 	ViewedAt      *time.Time
 	ViewedBy      string
 	CreatedAt     time.Time
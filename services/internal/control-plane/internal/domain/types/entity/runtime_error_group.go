@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
+
+// RuntimeErrorGroup aggregates runtime errors sharing one normalized fingerprint.
+type RuntimeErrorGroup struct {
+	ID                 string
+	ProjectID          string
+	Fingerprint        string
+	Source             string
+	ErrorClass         string
+	NormalizedMessage  string
+	SampleMessage      string
+	Level              string
+	Status             enumtypes.RuntimeErrorGroupStatus
+	MutedUntil         *time.Time
+	StatusChangedAt    *time.Time
+	StatusChangedBy    string
+	ResolvedAt         *time.Time
+	Occurrences        int64
+	RegressionCount    int
+	AffectedRunCount   int
+	FirstSeenAt        time.Time
+	LastSeenAt         time.Time
+	LastRunID          string
+	EscalationKind     enumtypes.RuntimeErrorGroupEscalationKind
+	EscalatedAt        *time.Time
+	EscalatedBy        string
+	RepositoryFullName string
+	IssueNumber        int64
+	IssueURL           string
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+// StaffAPIToken is one scoped bearer token for staff API automation.
+// Raw secret is never stored; TokenPrefix is kept only to let operators recognize tokens.
+type StaffAPIToken struct {
+	ID          string
+	UserID      string
+	UserEmail   string
+	Name        string
+	TokenPrefix string
+	Scopes      []string
+	// ProjectIDs restricts token to listed projects; empty means no project restriction.
+	ProjectIDs []string
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+	CreatedBy  string
+	CreatedAt  time.Time
+}
@@ -29,6 +29,12 @@ type StaffRun struct {
 	CreatedAt       time.Time
 	StartedAt       *time.Time
 	FinishedAt      *time.Time
+	// Priority is run queue priority (1..100).
+	Priority int
+	// QueuePosition is approximate 1-based claim position among pending runs in fair-share order; zero when run is not pending.
+	QueuePosition int
+	// EstimatedWaitSeconds is a rough queue wait estimate; nil when unknown or run is not pending.
+	EstimatedWaitSeconds *int64
 }
 
 // StaffRunLogs is a staff-visible logs snapshot for one run.
@@ -42,8 +48,11 @@ type StaffRunLogs struct {
 
 // StaffFlowEvent is a staff-visible flow event.
 type StaffFlowEvent struct {
+	ID            int64
 	CorrelationID string
 	EventType     string
 	CreatedAt     time.Time
 	PayloadJSON   []byte
+	// RetentionPinned keeps event out of retention sweeps.
+	RetentionPinned bool
 }
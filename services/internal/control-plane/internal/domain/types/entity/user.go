@@ -9,4 +9,6 @@ type User struct {
 	IsPlatformAdmin bool
 	// IsPlatformOwner marks the single platform owner (highest privileges).
 	IsPlatformOwner bool
+	// IsServiceAccount marks machine users that authenticate only with staff API tokens.
+	IsServiceAccount bool
 }
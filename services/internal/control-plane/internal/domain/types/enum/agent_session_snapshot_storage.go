@@ -0,0 +1,9 @@
+package enum
+
+// AgentSessionSnapshotStorage defines where agent session snapshot payload is persisted.
+type AgentSessionSnapshotStorage string
+
+const (
+	AgentSessionSnapshotStoragePostgres AgentSessionSnapshotStorage = "postgres"
+	AgentSessionSnapshotStorageBlob     AgentSessionSnapshotStorage = "blob"
+)
@@ -0,0 +1,17 @@
+package enum
+
+// MCPApprovalPolicyTool is an approval-gated MCP tool that accepts multi-approver policies.
+type MCPApprovalPolicyTool string
+
+const (
+	MCPApprovalPolicyToolSecretSyncEnv        MCPApprovalPolicyTool = "secret.sync.k8s"
+	MCPApprovalPolicyToolDatabaseLifecycle    MCPApprovalPolicyTool = "database.lifecycle"
+	MCPApprovalPolicyToolOwnerFeedbackRequest MCPApprovalPolicyTool = "owner.feedback.request"
+)
+
+// MCPApprovalPolicyTools lists tools that may be governed by approval policies.
+var MCPApprovalPolicyTools = []MCPApprovalPolicyTool{
+	MCPApprovalPolicyToolSecretSyncEnv,
+	MCPApprovalPolicyToolDatabaseLifecycle,
+	MCPApprovalPolicyToolOwnerFeedbackRequest,
+}
@@ -226,3 +226,22 @@ const (
 	MissionControlWorkspaceVisibilityTierPrimary         MissionControlWorkspaceVisibilityTier = "primary"
 	MissionControlWorkspaceVisibilityTierSecondaryDimmed MissionControlWorkspaceVisibilityTier = "secondary_dimmed"
 )
+
+// MissionControlVoiceCandidateStatus captures the lifecycle of one voice-originated draft command.
+type MissionControlVoiceCandidateStatus string
+
+const (
+	MissionControlVoiceCandidateStatusDraft    MissionControlVoiceCandidateStatus = "draft"
+	MissionControlVoiceCandidateStatusPromoted MissionControlVoiceCandidateStatus = "promoted"
+	MissionControlVoiceCandidateStatusRejected MissionControlVoiceCandidateStatus = "rejected"
+	MissionControlVoiceCandidateStatusExpired  MissionControlVoiceCandidateStatus = "expired"
+)
+
+// MissionControlVoiceCandidateSourceKind identifies how the candidate transcript was obtained.
+type MissionControlVoiceCandidateSourceKind string
+
+const (
+	MissionControlVoiceCandidateSourceKindVoice       MissionControlVoiceCandidateSourceKind = "voice"
+	MissionControlVoiceCandidateSourceKindAudioUpload MissionControlVoiceCandidateSourceKind = "audio_upload"
+	MissionControlVoiceCandidateSourceKindTranscript  MissionControlVoiceCandidateSourceKind = "transcript"
+)
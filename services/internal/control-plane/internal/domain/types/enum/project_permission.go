@@ -0,0 +1,51 @@
+package enum
+
+// ProjectPermission is one project-scoped staff capability from the permission catalog.
+// Role grants use "<permission>" or "<permission>:<qualifier>" form for qualified permissions.
+type ProjectPermission string
+
+const (
+	// ProjectPermissionProjectRead allows viewing project, repositories, runs, logs and analytics.
+	ProjectPermissionProjectRead ProjectPermission = "project.read"
+	// ProjectPermissionRuntimeErrorsManage allows marking runtime errors viewed and muting/resolving groups.
+	ProjectPermissionRuntimeErrorsManage ProjectPermission = "runtime_errors.manage"
+	// ProjectPermissionRunsCancel allows cancelling runs and deleting run namespaces.
+	ProjectPermissionRunsCancel ProjectPermission = "runs.cancel"
+	// ProjectPermissionRunsTrigger allows starting runs by run:* labels in GitHub.
+	ProjectPermissionRunsTrigger ProjectPermission = "runs.trigger"
+	// ProjectPermissionRepositoriesManage allows attaching, detaching and configuring repositories.
+	ProjectPermissionRepositoriesManage ProjectPermission = "repositories.manage"
+	// ProjectPermissionGitHubTokensManage allows replacing project GitHub tokens.
+	ProjectPermissionGitHubTokensManage ProjectPermission = "github_tokens.manage"
+	// ProjectPermissionChangeGovernanceManage allows mutating change governance packages.
+	ProjectPermissionChangeGovernanceManage ProjectPermission = "change_governance.manage"
+	// ProjectPermissionApprovalsResolve allows resolving MCP approvals; qualifier is MCP tool name.
+	ProjectPermissionApprovalsResolve ProjectPermission = "approvals.resolve"
+	// ProjectPermissionNextStepExecute allows next-step actions; qualifier is target run:* label.
+	ProjectPermissionNextStepExecute ProjectPermission = "next_step.execute"
+	// ProjectPermissionMissionControlCommand allows Mission Control commands; qualifier is command kind.
+	ProjectPermissionMissionControlCommand ProjectPermission = "mission_control.command"
+)
+
+// ProjectPermissions lists the whole permission catalog in stable order.
+var ProjectPermissions = []ProjectPermission{
+	ProjectPermissionProjectRead,
+	ProjectPermissionRuntimeErrorsManage,
+	ProjectPermissionRunsCancel,
+	ProjectPermissionRunsTrigger,
+	ProjectPermissionRepositoriesManage,
+	ProjectPermissionGitHubTokensManage,
+	ProjectPermissionChangeGovernanceManage,
+	ProjectPermissionApprovalsResolve,
+	ProjectPermissionNextStepExecute,
+	ProjectPermissionMissionControlCommand,
+}
+
+// ProjectRoleBuiltin is one predefined project role kept for backward compatibility.
+type ProjectRoleBuiltin string
+
+const (
+	ProjectRoleBuiltinRead      ProjectRoleBuiltin = "read"
+	ProjectRoleBuiltinReadWrite ProjectRoleBuiltin = "read_write"
+	ProjectRoleBuiltinAdmin     ProjectRoleBuiltin = "admin"
+)
@@ -0,0 +1,33 @@
+package enum
+
+// RetentionTarget identifies one platform history dataset governed by retention policies.
+type RetentionTarget string
+
+const (
+	RetentionTargetFlowEvents                    RetentionTarget = "flow_events"
+	RetentionTargetAgentRunLogs                  RetentionTarget = "agent_run_logs"
+	RetentionTargetAgentSessions                 RetentionTarget = "agent_sessions"
+	RetentionTargetInteractionDeliveryAttempts   RetentionTarget = "interaction_delivery_attempts"
+	RetentionTargetInteractionCallbackEvents     RetentionTarget = "interaction_callback_events"
+	RetentionTargetMissionControlTimelineEntries RetentionTarget = "mission_control_timeline_entries"
+	RetentionTargetGitHubRateLimitWaitEvidence   RetentionTarget = "github_rate_limit_wait_evidence"
+)
+
+// RetentionTargets lists every supported retention target in stable order.
+var RetentionTargets = []RetentionTarget{
+	RetentionTargetFlowEvents,
+	RetentionTargetAgentRunLogs,
+	RetentionTargetAgentSessions,
+	RetentionTargetInteractionDeliveryAttempts,
+	RetentionTargetInteractionCallbackEvents,
+	RetentionTargetMissionControlTimelineEntries,
+	RetentionTargetGitHubRateLimitWaitEvidence,
+}
+
+// RetentionAction defines what happens with expired rows.
+type RetentionAction string
+
+const (
+	RetentionActionDelete  RetentionAction = "delete"
+	RetentionActionArchive RetentionAction = "archive"
+)
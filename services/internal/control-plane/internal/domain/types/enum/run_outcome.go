@@ -0,0 +1,23 @@
+package enum
+
+// RunOutcomeDimension is one grouping key of run outcome analytics.
+type RunOutcomeDimension string
+
+const (
+	RunOutcomeDimensionProject      RunOutcomeDimension = "project"
+	RunOutcomeDimensionRole         RunOutcomeDimension = "role"
+	RunOutcomeDimensionTrigger      RunOutcomeDimension = "trigger"
+	RunOutcomeDimensionModel        RunOutcomeDimension = "model"
+	RunOutcomeDimensionReasoning    RunOutcomeDimension = "reasoning"
+	RunOutcomeDimensionPromptSource RunOutcomeDimension = "prompt_source"
+)
+
+// RunOutcomeDimensions lists every supported grouping key in stable order.
+var RunOutcomeDimensions = []RunOutcomeDimension{
+	RunOutcomeDimensionProject,
+	RunOutcomeDimensionRole,
+	RunOutcomeDimensionTrigger,
+	RunOutcomeDimensionModel,
+	RunOutcomeDimensionReasoning,
+	RunOutcomeDimensionPromptSource,
+}
@@ -0,0 +1,19 @@
+package enum
+
+// RuntimeErrorGroupStatus is lifecycle state of one runtime error fingerprint group.
+type RuntimeErrorGroupStatus string
+
+const (
+	RuntimeErrorGroupStatusOpen     RuntimeErrorGroupStatus = "open"
+	RuntimeErrorGroupStatusMuted    RuntimeErrorGroupStatus = "muted"
+	RuntimeErrorGroupStatusResolved RuntimeErrorGroupStatus = "resolved"
+)
+
+// RuntimeErrorGroupEscalationKind defines how runtime error group was escalated.
+type RuntimeErrorGroupEscalationKind string
+
+const (
+	RuntimeErrorGroupEscalationKindNone     RuntimeErrorGroupEscalationKind = ""
+	RuntimeErrorGroupEscalationKindIssue    RuntimeErrorGroupEscalationKind = "issue"
+	RuntimeErrorGroupEscalationKindAIRepair RuntimeErrorGroupEscalationKind = "ai_repair"
+)
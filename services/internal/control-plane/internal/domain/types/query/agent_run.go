@@ -15,6 +15,8 @@ type AgentRunCreateParams struct {
 	RunPayload json.RawMessage
 	// LearningMode is an effective run-level learning mode flag.
 	LearningMode bool
+	// Priority is run queue priority (1..100); normal priority is used when zero.
+	Priority int
 }
 
 // AgentRunCreateResult describes the outcome of idempotent run creation.
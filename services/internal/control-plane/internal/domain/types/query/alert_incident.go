@@ -0,0 +1,58 @@
+package query
+
+import (
+	"encoding/json"
+	"time"
+
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+)
+
+// AlertIncidentUpsertFiringParams records one firing alert notification.
+type AlertIncidentUpsertFiringParams struct {
+	ProjectID       string
+	Fingerprint     string
+	AlertName       string
+	Severity        string
+	LabelsJSON      json.RawMessage
+	AnnotationsJSON json.RawMessage
+	GeneratorURL    string
+	StartsAt        time.Time
+	ReceivedAt      time.Time
+}
+
+// AlertIncidentUpsertFiringResult returns stored incident and its status before the write.
+type AlertIncidentUpsertFiringResult struct {
+	Incident entitytypes.AlertIncident
+	// PreviousStatus is empty for a newly opened incident.
+	PreviousStatus string
+}
+
+// AlertIncidentMarkResolvedParams records one resolved alert notification.
+type AlertIncidentMarkResolvedParams struct {
+	ProjectID   string
+	Fingerprint string
+	EndsAt      time.Time
+	ReceivedAt  time.Time
+}
+
+// AlertIncidentSetIssueParams links incident to its GitHub issue.
+type AlertIncidentSetIssueParams struct {
+	ID                 string
+	RepositoryFullName string
+	IssueNumber        int64
+	IssueURL           string
+}
+
+// ProjectAlertPolicy stores Alertmanager incident handling rules in `projects.settings.alert_policy`.
+type ProjectAlertPolicy struct {
+	// RepositoryAlias selects repository binding for incident issues; first binding is used when empty.
+	RepositoryAlias string `json:"repository_alias,omitempty"`
+	// IssueLabels are added to newly opened incident issues.
+	IssueLabels []string `json:"issue_labels,omitempty"`
+	// AutoRun enables automatic `run:ops` launch for firing incidents.
+	AutoRun bool `json:"auto_run"`
+	// MinSeverity is the lowest severity (`info`, `warning`, `critical`) that launches a run.
+	MinSeverity string `json:"min_severity,omitempty"`
+	// AlertNames restricts automatic runs to listed alert names when not empty.
+	AlertNames []string `json:"alert_names,omitempty"`
+}
@@ -78,3 +78,73 @@ type ChangeGovernanceEvidenceSignalParams struct {
 	RequiredByTier        bool
 	OccurredAt            time.Time
 }
+
+// ChangeGovernanceWaiverDecisionParams captures one staff waiver approval or rejection.
+type ChangeGovernanceWaiverDecisionParams struct {
+	PackageID                 string
+	ExpectedProjectID         string
+	DecisionID                string
+	CorrelationID             string
+	ExpectedProjectionVersion int64
+	ActorUserID               string
+	ActorKind                 enumtypes.ChangeGovernanceDecisionActorKind
+	State                     enumtypes.ChangeGovernanceDecisionState
+	ResidualRiskTier          enumtypes.ChangeGovernanceRiskTier
+	SummaryMarkdown           string
+	ExpiresAt                 *time.Time
+	OccurredAt                time.Time
+}
+
+// ChangeGovernanceReleaseReadinessDecisionParams captures one staff release-readiness decision.
+type ChangeGovernanceReleaseReadinessDecisionParams struct {
+	PackageID                 string
+	ExpectedProjectID         string
+	DecisionID                string
+	CorrelationID             string
+	ExpectedProjectionVersion int64
+	ActorUserID               string
+	ActorKind                 enumtypes.ChangeGovernanceDecisionActorKind
+	ReleaseReadinessState     enumtypes.ChangeGovernanceReleaseReadinessState
+	SummaryMarkdown           string
+	OccurredAt                time.Time
+}
+
+// ChangeGovernanceDecisionPayload is persisted in decision_payload_json of staff decisions.
+type ChangeGovernanceDecisionPayload struct {
+	ActorUserID           string                                          `json:"actor_user_id,omitempty"`
+	ReleaseReadinessState enumtypes.ChangeGovernanceReleaseReadinessState `json:"release_readiness_state,omitempty"`
+	ExpiresAt             *time.Time                                      `json:"expires_at,omitempty"`
+}
+
+// ChangeGovernanceDecisionParams captures one persisted decision record.
+type ChangeGovernanceDecisionParams struct {
+	PackageID                 string
+	ExpectedProjectID         string
+	DecisionID                string
+	CorrelationID             string
+	ExpectedProjectionVersion int64
+	ScopeKind                 enumtypes.ChangeGovernanceDecisionScopeKind
+	ScopeRef                  string
+	DecisionKind              enumtypes.ChangeGovernanceDecisionKind
+	State                     enumtypes.ChangeGovernanceDecisionState
+	ActorKind                 enumtypes.ChangeGovernanceDecisionActorKind
+	ResidualRiskTier          enumtypes.ChangeGovernanceRiskTier
+	SummaryMarkdown           string
+	Payload                   ChangeGovernanceDecisionPayload
+	OccurredAt                time.Time
+}
+
+// ChangeGovernanceFeedbackParams captures one staff-opened governance feedback gap.
+type ChangeGovernanceFeedbackParams struct {
+	PackageID          string
+	ExpectedProjectID  string
+	FeedbackID         string
+	CorrelationID      string
+	GapKind            enumtypes.ChangeGovernanceFeedbackGapKind
+	SourceKind         enumtypes.ChangeGovernanceFeedbackSourceKind
+	Severity           enumtypes.ChangeGovernanceFeedbackSeverity
+	SuggestedAction    enumtypes.ChangeGovernanceFeedbackSuggestedAction
+	SummaryMarkdown    string
+	RelatedArtifactRef string
+	OccurredAt         time.Time
+}
@@ -2,6 +2,7 @@ package query
 
 import (
 	"encoding/json"
+	"time"
 
 	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
 )
@@ -18,6 +19,8 @@ type MCPActionRequestCreateParams struct {
 	RequestedBy   string
 	AppliedBy     string
 	Payload       json.RawMessage
+	Policy        *entitytypes.MCPApprovalPolicySnapshot
+	ExpiresAt     *time.Time
 }
 
 // MCPActionRequestUpdateStateParams describes one approval state update.
@@ -26,4 +29,12 @@ type MCPActionRequestUpdateStateParams struct {
 	ApprovalState entitytypes.MCPApprovalState
 	AppliedBy     string
 	Payload       json.RawMessage
+	// FromState guards the transition; empty value updates regardless of current state.
+	FromState entitytypes.MCPApprovalState
+}
+
+// MCPActionRequestAppendVoteParams records one approver vote on a requested action.
+type MCPActionRequestAppendVoteParams struct {
+	ID   int64
+	Vote entitytypes.MCPApprovalVote
 }
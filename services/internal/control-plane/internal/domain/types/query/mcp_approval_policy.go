@@ -0,0 +1,13 @@
+package query
+
+// MCPApprovalPolicyUpsertParams creates or replaces approval policy for project+tool+environment.
+type MCPApprovalPolicyUpsertParams struct {
+	ProjectID           string
+	ToolName            string
+	Environment         string
+	RequiredApprovals   int
+	EligibleRoles       []string
+	EligibleUserIDs     []string
+	SeparationOfDuties  bool
+	ExpiresAfterSeconds int
+}
@@ -224,3 +224,36 @@ type MissionControlWarmupRequest struct {
 	CorrelationID string
 	ForceRebuild  bool
 }
+
+// MissionControlVoiceCandidateCreateParams defines one voice candidate insert deduplicated by dedupe_key.
+type MissionControlVoiceCandidateCreateParams struct {
+	ProjectID         string
+	SourceKind        enumtypes.MissionControlVoiceCandidateSourceKind
+	DedupeKey         string
+	CommandKind       enumtypes.MissionControlCommandKind
+	TranscriptExcerpt string
+	StructuredSummary string
+	PayloadJSON       json.RawMessage
+	CreatedBy         string
+	CreatedAt         time.Time
+}
+
+// MissionControlVoiceCandidateListFilter defines one project-scoped voice candidate lookup.
+type MissionControlVoiceCandidateListFilter struct {
+	ProjectID string
+	Statuses  []enumtypes.MissionControlVoiceCandidateStatus
+	Limit     int
+}
+
+// MissionControlVoiceCandidateDecisionParams defines one guarded transition out of draft state.
+type MissionControlVoiceCandidateDecisionParams struct {
+	ProjectID         string
+	CandidateID       string
+	Status            enumtypes.MissionControlVoiceCandidateStatus
+	CommandKind       enumtypes.MissionControlCommandKind
+	StructuredSummary string
+	PayloadJSON       json.RawMessage
+	PromotedCommandID string
+	DecidedBy         string
+	DecidedAt         time.Time
+}
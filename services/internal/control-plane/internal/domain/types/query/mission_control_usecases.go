@@ -90,3 +90,42 @@ type MissionControlCommandCancelParams struct {
 	StatusMessage string
 	UpdatedAt     time.Time
 }
+
+// MissionControlCreateVoiceCandidateParams defines one transcript submitted for structuring into a draft command.
+type MissionControlCreateVoiceCandidateParams struct {
+	ProjectID  string
+	SourceKind enumtypes.MissionControlVoiceCandidateSourceKind
+	Transcript string
+	DedupeKey  string
+	CreatedBy  string
+	Source     valuetypes.MissionControlVoiceCandidateSource
+	CreatedAt  time.Time
+}
+
+// MissionControlVoiceCandidateListQuery defines one voice candidate list lookup.
+type MissionControlVoiceCandidateListQuery struct {
+	ProjectID string
+	Statuses  []enumtypes.MissionControlVoiceCandidateStatus
+	Limit     int
+}
+
+// MissionControlPromoteVoiceCandidateParams defines one staff confirmation with optional draft edits.
+type MissionControlPromoteVoiceCandidateParams struct {
+	ProjectID     string
+	CandidateID   string
+	ActorID       string
+	CommandKind   enumtypes.MissionControlCommandKind
+	Title         string
+	BodyMarkdown  string
+	InitialLabels []string
+	RequestedAt   time.Time
+}
+
+// MissionControlRejectVoiceCandidateParams defines one staff rejection of a draft voice candidate.
+type MissionControlRejectVoiceCandidateParams struct {
+	ProjectID   string
+	CandidateID string
+	ActorID     string
+	Reason      string
+	RejectedAt  time.Time
+}
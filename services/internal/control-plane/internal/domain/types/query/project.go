@@ -1,5 +1,7 @@
 package query
 
+import agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+
 // ProjectUpsertParams defines inputs for creating or updating a project.
 type ProjectUpsertParams struct {
 	// ID is a project id to use for insert (server-generated in staff API).
@@ -16,4 +18,16 @@ type ProjectUpsertParams struct {
 type ProjectSettings struct {
 	LearningModeDefault bool `json:"learning_mode_default"`
 	SlotsPerProject     int  `json:"slots_per_project,omitempty"`
+	// QueueWeight is the project fair-share weight in the run queue; 1 is used when unset.
+	QueueWeight float64 `json:"queue_weight,omitempty"`
+	// WarmPoolSize is the number of pre-deployed full-env namespaces worker keeps for new runs; 0 disables the pool.
+	WarmPoolSize int `json:"warm_pool_size,omitempty"`
+	// ResourceProfiles overrides platform run resource profiles by profile name.
+	ResourceProfiles map[string]agentdomain.ResourceProfile `json:"resource_profiles,omitempty"`
+	// ResourceProfileByRole overrides platform role->profile bindings.
+	ResourceProfileByRole map[string]string `json:"resource_profile_by_role,omitempty"`
+	// AlertPolicy controls Alertmanager incident issues and automatic ops runs.
+	AlertPolicy *ProjectAlertPolicy `json:"alert_policy,omitempty"`
+	// AutoAdvance declares stages that advance automatically after approval.
+	AutoAdvance *ProjectAutoAdvancePolicy `json:"auto_advance,omitempty"`
 }
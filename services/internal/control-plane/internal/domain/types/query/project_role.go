@@ -0,0 +1,9 @@
+package query
+
+// ProjectRoleUpsertParams creates or replaces one custom project role.
+type ProjectRoleUpsertParams struct {
+	ProjectID   string
+	Name        string
+	Description string
+	Permissions []string
+}
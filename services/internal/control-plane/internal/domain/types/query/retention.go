@@ -0,0 +1,49 @@
+package query
+
+import (
+	"time"
+
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
+
+// RetentionPolicyUpsertParams creates or replaces policy for target + scope.
+type RetentionPolicyUpsertParams struct {
+	Target        enumtypes.RetentionTarget
+	ProjectID     string
+	RetentionDays int
+	Action        enumtypes.RetentionAction
+	Enabled       bool
+	ActorID       string
+}
+
+// RetentionPolicyClaimParams leases enabled policies whose previous sweep is older than SweepInterval.
+type RetentionPolicyClaimParams struct {
+	WorkerID      string
+	LeaseTTL      time.Duration
+	SweepInterval time.Duration
+	Limit         int
+}
+
+// RetentionPolicySweepCompleteParams records sweep outcome and releases policy lease.
+type RetentionPolicySweepCompleteParams struct {
+	PolicyID     string
+	WorkerID     string
+	RemovedCount int64
+	ArchiveKey   string
+	// Drained marks policy swept (or failed) until now; otherwise policy stays due for the next sweep.
+	Drained bool
+	Error   string
+}
+
+// RetentionExpiredRowsParams selects one batch of expired rows for target scope.
+type RetentionExpiredRowsParams struct {
+	Target enumtypes.RetentionTarget
+	// ProjectID restricts batch to one project; empty means global scope.
+	ProjectID string
+	// ExcludedProjectIDs are projects with own policy, skipped by global scope.
+	ExcludedProjectIDs []string
+	// PinnedEventTypes are flow event types never removed by retention.
+	PinnedEventTypes []string
+	Cutoff           time.Time
+	Limit            int
+}
@@ -0,0 +1,18 @@
+package query
+
+import (
+	"time"
+
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
+
+// RunOutcomeAnalyticsParams selects run lineages started in [From, To) and grouping keys.
+type RunOutcomeAnalyticsParams struct {
+	ProjectID string
+	From      time.Time
+	To        time.Time
+	// GroupBy lists grouping keys; empty list aggregates everything into one group.
+	GroupBy []enumtypes.RunOutcomeDimension
+	// UserID restricts lineages to projects where user is a member; empty means all projects.
+	UserID string
+}
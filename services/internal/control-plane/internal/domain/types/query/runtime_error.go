@@ -1,6 +1,11 @@
 package query
 
-import "encoding/json"
+import (
+	"encoding/json"
+	"time"
+
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
 
 // RuntimeErrorListState defines runtime error list scope.
 type RuntimeErrorListState string
@@ -23,6 +28,11 @@ type RuntimeErrorRecordParams struct {
 	ProjectID     string
 	Namespace     string
 	JobName       string
+
+	// Fingerprint, ErrorClass and NormalizedMessage identify the runtime error group.
+	Fingerprint       string
+	ErrorClass        string
+	NormalizedMessage string
 }
 
 // RuntimeErrorListFilter defines runtime error list filters.
@@ -40,3 +50,40 @@ type RuntimeErrorMarkViewedParams struct {
 	ID       string
 	ViewerID string
 }
+
+// RuntimeErrorGroupListStatusAll disables group status filter.
+const RuntimeErrorGroupListStatusAll = "all"
+
+// RuntimeErrorGroupListFilter defines runtime error group list filters.
+// Empty Status lists open groups.
+type RuntimeErrorGroupListFilter struct {
+	Limit     int
+	Status    string
+	Level     string
+	Source    string
+	ProjectID string
+}
+
+// RuntimeErrorGroupStatusParams changes mute/resolve state of one runtime error group.
+type RuntimeErrorGroupStatusParams struct {
+	GroupID    string
+	Status     enumtypes.RuntimeErrorGroupStatus
+	MutedUntil *time.Time
+	ActorID    string
+}
+
+// RuntimeErrorGroupEscalationParams stores escalation outcome of one runtime error group.
+type RuntimeErrorGroupEscalationParams struct {
+	GroupID            string
+	Kind               enumtypes.RuntimeErrorGroupEscalationKind
+	ActorID            string
+	RepositoryFullName string
+	IssueNumber        int64
+	IssueURL           string
+}
+
+// RuntimeErrorGroupEscalateParams requests escalation of one runtime error group.
+type RuntimeErrorGroupEscalateParams struct {
+	GroupID string
+	Kind    enumtypes.RuntimeErrorGroupEscalationKind
+}
@@ -0,0 +1,32 @@
+package query
+
+import "time"
+
+// StaffAPITokenCreateParams stores hashed staff API token for user.
+type StaffAPITokenCreateParams struct {
+	UserID      string
+	Name        string
+	TokenPrefix string
+	TokenHash   []byte
+	Scopes      []string
+	ProjectIDs  []string
+	ExpiresAt   *time.Time
+	CreatedBy   string
+}
+
+// StaffAPITokenListFilter narrows staff API token listing.
+type StaffAPITokenListFilter struct {
+	// UserID limits listing to one token owner; empty returns tokens of all users.
+	UserID string
+	Limit  int
+}
+
+// StaffAPITokenIssueParams describes staff API token requested by platform admin.
+type StaffAPITokenIssueParams struct {
+	// UserID is token owner; empty issues token for the requesting admin.
+	UserID        string
+	Name          string
+	Scopes        []string
+	ProjectIDs    []string
+	ExpiresInDays *int
+}
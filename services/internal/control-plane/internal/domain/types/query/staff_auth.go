@@ -12,3 +12,20 @@ type StaffAuthorizeOAuthUserParams struct {
 	GitHubUserID int64
 	GitHubLogin  string
 }
+
+// StaffAuthorizeOIDCUserParams defines inputs for generic OIDC identity authorization flow.
+type StaffAuthorizeOIDCUserParams struct {
+	Issuer            string
+	Subject           string
+	Email             string
+	PreferredUsername string
+	Groups            []string
+}
+
+// OIDCGroupRoleMapping maps one identity-provider group to platform admin flag and/or project role.
+type OIDCGroupRoleMapping struct {
+	Group         string `json:"group"`
+	PlatformAdmin bool   `json:"platform_admin,omitempty"`
+	ProjectSlug   string `json:"project,omitempty"`
+	Role          string `json:"role,omitempty"`
+}
@@ -0,0 +1,50 @@
+package query
+
+const (
+	// StageAutoAdvanceDefaultMaxChain limits consecutive automatic transitions when policy does not set max_chain.
+	StageAutoAdvanceDefaultMaxChain = 3
+
+	StageAutoAdvanceTriggerReviewApproved = "review_approved"
+	StageAutoAdvanceTriggerApprovedLabel  = "approved_label"
+
+	NextStepActionSourceManual      = "manual"
+	NextStepActionSourceAutoAdvance = "auto_advance"
+)
+
+// ProjectAutoAdvancePolicy stores declarative stage auto-advance rules in `projects.settings.auto_advance`.
+type ProjectAutoAdvancePolicy struct {
+	// MaxChain limits consecutive automatic transitions for one issue; StageAutoAdvanceDefaultMaxChain is used when 0.
+	MaxChain int `json:"max_chain,omitempty"`
+	// Rules opt individual stages into automatic advance to the next main-path stage.
+	Rules []ProjectAutoAdvanceRule `json:"rules,omitempty"`
+}
+
+// ProjectAutoAdvanceRule declares when one stage advances automatically; all enabled conditions must hold.
+type ProjectAutoAdvanceRule struct {
+	// Stage is the current stage key (`intake`, `vision`, ..., `postdeploy`).
+	Stage string `json:"stage"`
+	// RequireReviewApproval requires an approved review on the stage PR without outstanding changes requests.
+	RequireReviewApproval bool `json:"require_review_approval,omitempty"`
+	// RequireApprovedLabel requires `state:approved` on the issue or the stage PR.
+	RequireApprovedLabel bool `json:"require_approved_label,omitempty"`
+}
+
+// StageAutoAdvanceParams describes one approval signal that may advance the issue stage.
+type StageAutoAdvanceParams struct {
+	ProjectID          string
+	RepositoryFullName string
+	IssueNumber        int
+	PullRequestNumber  int
+	Trigger            string
+	SenderLogin        string
+}
+
+// StageAutoAdvanceResult describes auto-advance decision for one approval signal.
+type StageAutoAdvanceResult struct {
+	Advanced     bool
+	SkipReason   string
+	Stage        string
+	CurrentLabel string
+	TargetLabel  string
+	Transition   NextStepActionResult
+}
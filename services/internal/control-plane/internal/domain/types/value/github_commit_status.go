@@ -0,0 +1,10 @@
+package value
+
+// GitHubCommitStatusParams describes one commit status published on a pull request head.
+type GitHubCommitStatusParams struct {
+	SHA         string
+	State       string
+	Context     string
+	Description string
+	TargetURL   string
+}
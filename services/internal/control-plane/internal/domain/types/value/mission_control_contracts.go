@@ -129,3 +129,39 @@ type MissionControlCommandStatusView struct {
 	StatusMessage       string                            `json:"status_message,omitempty"`
 	ProviderDeliveryIDs []string                          `json:"provider_delivery_ids,omitempty"`
 }
+
+// MissionControlVoiceCandidateDraft captures the editable command draft structured from one transcript.
+type MissionControlVoiceCandidateDraft struct {
+	Title         string   `json:"title"`
+	BodyMarkdown  string   `json:"body_markdown,omitempty"`
+	InitialLabels []string `json:"initial_labels,omitempty"`
+}
+
+// MissionControlVoiceCandidateSource captures adapter-side provenance of one voice candidate.
+type MissionControlVoiceCandidateSource struct {
+	Adapter    string `json:"adapter,omitempty"`
+	ChatRef    string `json:"chat_ref,omitempty"`
+	MessageRef string `json:"message_ref,omitempty"`
+	Locale     string `json:"locale,omitempty"`
+}
+
+// MissionControlVoiceCandidatePayload is the typed payload stored for one voice candidate.
+type MissionControlVoiceCandidatePayload struct {
+	Transcript   string                             `json:"transcript"`
+	Confidence   float64                            `json:"confidence"`
+	Draft        MissionControlVoiceCandidateDraft  `json:"draft"`
+	Source       MissionControlVoiceCandidateSource `json:"source"`
+	RejectReason string                             `json:"reject_reason,omitempty"`
+}
+
+// MissionControlVoiceCandidateView is the typed domain view of one voice candidate.
+type MissionControlVoiceCandidateView struct {
+	Candidate entitytypes.MissionControlVoiceCandidate `json:"candidate"`
+	Payload   MissionControlVoiceCandidatePayload      `json:"payload"`
+}
+
+// MissionControlVoiceCandidatePromotion describes the result of promoting one voice candidate into a command.
+type MissionControlVoiceCandidatePromotion struct {
+	Candidate MissionControlVoiceCandidateView `json:"candidate"`
+	Admission MissionControlCommandAdmission   `json:"admission"`
+}
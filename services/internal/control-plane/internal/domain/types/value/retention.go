@@ -0,0 +1,9 @@
+package value
+
+import "encoding/json"
+
+// RetentionExpiredRow is one expired history row with its archival JSON representation.
+type RetentionExpiredRow struct {
+	ID      string
+	RowJSON json.RawMessage
+}
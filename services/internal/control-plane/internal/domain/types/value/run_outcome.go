@@ -0,0 +1,46 @@
+package value
+
+import (
+	"time"
+
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
+
+// RunOutcomeGroup aggregates outcome facts of issue/PR lineages sharing the same grouping keys.
+// Keys not requested in GroupBy are empty. Lineage attributes come from its first non-revise run.
+type RunOutcomeGroup struct {
+	ProjectID       string
+	Role            string
+	TriggerKind     string
+	Model           string
+	ReasoningEffort string
+	PromptSource    string
+
+	Lineages          int64
+	Runs              int64
+	ReviseRuns        int64
+	FailedRuns        int64
+	ChangesRequested  int64
+	PRsOpened         int64
+	PRsMerged         int64
+	PRsClosedUnmerged int64
+	// MergeRate is merged / (merged + closed unmerged); 0 when no PR was closed yet.
+	MergeRate float64
+	// AvgReviseRounds is average number of revise runs per lineage.
+	AvgReviseRounds float64
+	// MedianTimeToMergeSeconds measures first run creation to PR close for merged lineages.
+	MedianTimeToMergeSeconds float64
+	// AvgWallClockSeconds measures first run creation to PR close or last run finish.
+	AvgWallClockSeconds float64
+	// AgentSeconds is total running time of all lineage runs.
+	AgentSeconds float64
+}
+
+// RunOutcomeAnalytics is run outcome report for one date range.
+type RunOutcomeAnalytics struct {
+	From    time.Time
+	To      time.Time
+	GroupBy []enumtypes.RunOutcomeDimension
+	Totals  RunOutcomeGroup
+	Groups  []RunOutcomeGroup
+}
@@ -0,0 +1,357 @@
+package webhook
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	alertincidentrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/alertincident"
+	floweventrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/flowevent"
+	projectrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/project"
+)
+
+const (
+	alertmanagerPayloadVersion = "4"
+	alertmanagerEventType      = "alertmanager"
+	alertmanagerActorID        = floweventdomain.ActorIDAlertmanager
+
+	// alertProjectLabel lets one Alertmanager receiver route alerts of several projects.
+	alertProjectLabel = "kodex_project_id"
+
+	alertStatusFiring   = "firing"
+	alertStatusResolved = "resolved"
+
+	alertRepositoryLookupLimit = 50
+)
+
+type alertIssueClient interface {
+	CreateIssue(ctx context.Context, token string, owner string, repo string, title string, body string, labels []string) (int, string, error)
+	CreateIssueComment(ctx context.Context, token string, owner string, repo string, issueNumber int, body string) (string, error)
+}
+
+// IngestAlertmanagerWebhook deduplicates Alertmanager alerts into project incidents, keeps one GitHub issue per
+// incident in sync and starts `run:ops` runs for firing incidents when project alert policy allows it.
+func (s *Service) IngestAlertmanagerWebhook(ctx context.Context, cmd AlertmanagerIngestCommand) (AlertmanagerIngestResult, error) {
+	if cmd.CorrelationID == "" {
+		return AlertmanagerIngestResult{}, errs.Validation{Field: "correlation_id", Msg: "is required"}
+	}
+	if len(cmd.Payload) == 0 {
+		return AlertmanagerIngestResult{}, errs.Validation{Field: "payload", Msg: "is required"}
+	}
+	if s.alertIncidents == nil || s.projects == nil {
+		return AlertmanagerIngestResult{}, errs.FailedPrecondition{Msg: "alert incident ingestion is not configured"}
+	}
+	if cmd.ReceivedAt.IsZero() {
+		cmd.ReceivedAt = time.Now().UTC()
+	}
+
+	var payload alertmanagerPayload
+	if err := json.Unmarshal(cmd.Payload, &payload); err != nil {
+		return AlertmanagerIngestResult{}, errs.Validation{Field: "payload", Msg: "must be valid JSON"}
+	}
+	if strings.TrimSpace(payload.Version) != alertmanagerPayloadVersion {
+		return AlertmanagerIngestResult{}, errs.Validation{Field: "payload.version", Msg: "must be " + alertmanagerPayloadVersion}
+	}
+
+	projectID := strings.TrimSpace(cmd.ProjectID)
+	if projectID == "" {
+		projectID = strings.TrimSpace(payload.CommonLabels[alertProjectLabel])
+	}
+	if projectID == "" {
+		return AlertmanagerIngestResult{}, errs.Validation{Field: "project_id", Msg: "is required"}
+	}
+	if _, ok, err := s.projects.GetByID(ctx, projectID); err != nil {
+		return AlertmanagerIngestResult{}, fmt.Errorf("get project: %w", err)
+	} else if !ok {
+		return AlertmanagerIngestResult{}, errs.NotFound{Msg: "project not found"}
+	}
+	policy, _, err := s.projects.GetAlertPolicy(ctx, projectID)
+	if err != nil {
+		return AlertmanagerIngestResult{}, fmt.Errorf("get project alert policy: %w", err)
+	}
+	target, hasTarget, err := s.resolveAlertIssueTarget(ctx, projectID, policy)
+	if err != nil {
+		return AlertmanagerIngestResult{}, fmt.Errorf("resolve alert issue repository: %w", err)
+	}
+
+	outcomes := make([]AlertIncidentOutcome, 0, len(payload.Alerts))
+	for _, alert := range payload.Alerts {
+		outcome, err := s.ingestAlert(ctx, alertIngestInput{
+			Command:   cmd,
+			ProjectID: projectID,
+			Policy:    policy,
+			Target:    target,
+			HasTarget: hasTarget,
+			Alert:     alert,
+		})
+		if err != nil {
+			return AlertmanagerIngestResult{}, err
+		}
+		outcomes = append(outcomes, outcome)
+	}
+
+	status := webhookdomain.IngestStatusAccepted
+	if !slices.ContainsFunc(outcomes, func(item AlertIncidentOutcome) bool { return item.Action != AlertIncidentActionIgnored }) {
+		status = webhookdomain.IngestStatusIgnored
+	}
+	eventPayload, err := json.Marshal(alertFlowEventPayload{
+		Source:    webhookdomain.TriggerSourceAlertmanager,
+		ProjectID: projectID,
+		GroupKey:  strings.TrimSpace(payload.GroupKey),
+		Receiver:  strings.TrimSpace(payload.Receiver),
+		Status:    strings.TrimSpace(payload.Status),
+		Incidents: outcomes,
+	})
+	if err != nil {
+		return AlertmanagerIngestResult{}, fmt.Errorf("marshal alertmanager flow event payload: %w", err)
+	}
+	eventType := floweventdomain.EventTypeWebhookReceived
+	if status == webhookdomain.IngestStatusIgnored {
+		eventType = floweventdomain.EventTypeWebhookIgnored
+	}
+	if err := s.flowEvents.Insert(ctx, floweventrepo.InsertParams{
+		CorrelationID: cmd.CorrelationID,
+		ActorType:     floweventdomain.ActorTypeSystem,
+		ActorID:       alertmanagerActorID,
+		EventType:     eventType,
+		Payload:       eventPayload,
+		CreatedAt:     cmd.ReceivedAt,
+	}); err != nil {
+		return AlertmanagerIngestResult{}, fmt.Errorf("insert flow event: %w", err)
+	}
+
+	return AlertmanagerIngestResult{
+		CorrelationID: cmd.CorrelationID,
+		Status:        status,
+		Incidents:     outcomes,
+	}, nil
+}
+
+type alertIngestInput struct {
+	Command   AlertmanagerIngestCommand
+	ProjectID string
+	Policy    projectrepo.AlertPolicy
+	Target    alertIssueTarget
+	HasTarget bool
+	Alert     alertmanagerAlert
+}
+
+func (s *Service) ingestAlert(ctx context.Context, input alertIngestInput) (AlertIncidentOutcome, error) {
+	alert := input.Alert
+	alertName := strings.TrimSpace(alert.Labels["alertname"])
+	if alertName == "" {
+		alertName = "unnamed"
+	}
+	fingerprint := resolveAlertFingerprint(alert)
+	outcome := AlertIncidentOutcome{Fingerprint: fingerprint, AlertName: alertName}
+
+	switch strings.ToLower(strings.TrimSpace(alert.Status)) {
+	case alertStatusResolved:
+		return s.resolveAlertIncident(ctx, input, outcome)
+	case alertStatusFiring:
+	default:
+		outcome.Action = AlertIncidentActionIgnored
+		return outcome, nil
+	}
+
+	labelsJSON, err := json.Marshal(alert.Labels)
+	if err != nil {
+		return AlertIncidentOutcome{}, fmt.Errorf("marshal alert labels: %w", err)
+	}
+	annotationsJSON, err := json.Marshal(alert.Annotations)
+	if err != nil {
+		return AlertIncidentOutcome{}, fmt.Errorf("marshal alert annotations: %w", err)
+	}
+	startsAt := alert.StartsAt
+	if startsAt.IsZero() {
+		startsAt = input.Command.ReceivedAt
+	}
+	upserted, err := s.alertIncidents.UpsertFiring(ctx, alertincidentrepo.UpsertFiringParams{
+		ProjectID:       input.ProjectID,
+		Fingerprint:     fingerprint,
+		AlertName:       alertName,
+		Severity:        normalizeAlertSeverity(alert.Labels["severity"]),
+		LabelsJSON:      labelsJSON,
+		AnnotationsJSON: annotationsJSON,
+		GeneratorURL:    alert.GeneratorURL,
+		StartsAt:        startsAt,
+		ReceivedAt:      input.Command.ReceivedAt,
+	})
+	if err != nil {
+		return AlertIncidentOutcome{}, fmt.Errorf("upsert alert incident: %w", err)
+	}
+	incident := upserted.Incident
+	outcome.IncidentID = incident.ID
+	outcome.IssueURL = incident.IssueURL
+	switch upserted.PreviousStatus {
+	case "":
+		outcome.Action = AlertIncidentActionOpened
+	case alertStatusResolved:
+		outcome.Action = AlertIncidentActionReopened
+	default:
+		outcome.Action = AlertIncidentActionUpdated
+	}
+
+	switch {
+	case incident.IssueNumber > 0 && outcome.Action == AlertIncidentActionReopened:
+		if err := s.commentAlertIncident(ctx, incident, renderAlertIncidentRefiredComment(incident)); err != nil {
+			return AlertIncidentOutcome{}, err
+		}
+	case incident.IssueNumber == 0 && input.HasTarget:
+		// Repeated notifications also retry issue creation that failed on a previous delivery.
+		issueNumber, issueURL, err := s.alertIssues.CreateIssue(
+			ctx,
+			s.githubToken,
+			input.Target.Owner,
+			input.Target.Name,
+			renderAlertIncidentIssueTitle(incident),
+			renderAlertIncidentIssueBody(incident),
+			input.Policy.IssueLabels,
+		)
+		if err != nil {
+			return AlertIncidentOutcome{}, fmt.Errorf("create alert incident issue: %w", err)
+		}
+		incident.RepositoryFullName = input.Target.fullName()
+		incident.IssueNumber = int64(issueNumber)
+		incident.IssueURL = issueURL
+		if err := s.alertIncidents.SetIssue(ctx, alertincidentrepo.SetIssueParams{
+			ID:                 incident.ID,
+			RepositoryFullName: incident.RepositoryFullName,
+			IssueNumber:        incident.IssueNumber,
+			IssueURL:           incident.IssueURL,
+		}); err != nil {
+			return AlertIncidentOutcome{}, err
+		}
+		outcome.IssueURL = issueURL
+	}
+
+	// Already handled occurrences do not start runs again on Alertmanager repeat notifications.
+	if outcome.Action == AlertIncidentActionUpdated && incident.LastRunID != "" {
+		return outcome, nil
+	}
+	if allowed, reason := alertPolicyAllowsRun(input.Policy, incident.AlertName, incident.Severity); !allowed {
+		outcome.RunSkipReason = reason
+		return outcome, nil
+	}
+	if incident.IssueNumber == 0 || !input.HasTarget {
+		outcome.RunSkipReason = "incident_issue_missing"
+		return outcome, nil
+	}
+	runID, err := s.launchAlertOpsRun(ctx, alertRunInput{
+		Command:   input.Command,
+		ProjectID: input.ProjectID,
+		Policy:    input.Policy,
+		Target:    input.Target,
+		Incident:  incident,
+		Alert:     alert,
+	})
+	if err != nil {
+		return AlertIncidentOutcome{}, fmt.Errorf("launch alert ops run: %w", err)
+	}
+	if err := s.alertIncidents.SetLastRun(ctx, incident.ID, runID); err != nil {
+		return AlertIncidentOutcome{}, err
+	}
+	outcome.RunID = runID
+	return outcome, nil
+}
+
+func (s *Service) resolveAlertIncident(ctx context.Context, input alertIngestInput, outcome AlertIncidentOutcome) (AlertIncidentOutcome, error) {
+	endsAt := input.Alert.EndsAt
+	if endsAt.IsZero() {
+		endsAt = input.Command.ReceivedAt
+	}
+	incident, found, err := s.alertIncidents.MarkResolved(ctx, alertincidentrepo.MarkResolvedParams{
+		ProjectID:   input.ProjectID,
+		Fingerprint: outcome.Fingerprint,
+		EndsAt:      endsAt,
+		ReceivedAt:  input.Command.ReceivedAt,
+	})
+	if err != nil {
+		return AlertIncidentOutcome{}, fmt.Errorf("resolve alert incident: %w", err)
+	}
+	if !found {
+		// Unknown or already resolved incident: Alertmanager resends resolved alerts while they stay in the group.
+		outcome.Action = AlertIncidentActionIgnored
+		return outcome, nil
+	}
+	outcome.IncidentID = incident.ID
+	outcome.IssueURL = incident.IssueURL
+	outcome.RunID = incident.LastRunID
+	outcome.Action = AlertIncidentActionResolved
+	if incident.IssueNumber > 0 {
+		if err := s.commentAlertIncident(ctx, incident, renderAlertIncidentResolvedComment(incident)); err != nil {
+			return AlertIncidentOutcome{}, err
+		}
+	}
+	return outcome, nil
+}
+
+func (s *Service) commentAlertIncident(ctx context.Context, incident alertincidentrepo.Incident, body string) error {
+	if s.alertIssues == nil {
+		return nil
+	}
+	owner, repo, err := splitRepositoryFullName(incident.RepositoryFullName)
+	if err != nil {
+		return fmt.Errorf("alert incident repository: %w", err)
+	}
+	if _, err := s.alertIssues.CreateIssueComment(ctx, s.githubToken, owner, repo, int(incident.IssueNumber), body); err != nil {
+		return fmt.Errorf("comment alert incident issue: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) resolveAlertIssueTarget(ctx context.Context, projectID string, policy projectrepo.AlertPolicy) (alertIssueTarget, bool, error) {
+	if s.repos == nil || s.alertIssues == nil || strings.TrimSpace(s.githubToken) == "" {
+		return alertIssueTarget{}, false, nil
+	}
+	bindings, err := s.repos.ListForProject(ctx, projectID, alertRepositoryLookupLimit)
+	if err != nil {
+		return alertIssueTarget{}, false, err
+	}
+	alias := strings.TrimSpace(policy.RepositoryAlias)
+	for _, binding := range bindings {
+		if alias != "" && !strings.EqualFold(strings.TrimSpace(binding.Alias), alias) {
+			continue
+		}
+		if strings.TrimSpace(binding.Owner) == "" || strings.TrimSpace(binding.Name) == "" {
+			continue
+		}
+		return alertIssueTarget{
+			RepositoryID:     binding.ID,
+			Owner:            strings.TrimSpace(binding.Owner),
+			Name:             strings.TrimSpace(binding.Name),
+			ExternalID:       binding.ExternalID,
+			DefaultRef:       strings.TrimSpace(binding.DefaultRef),
+			ServicesYAMLPath: strings.TrimSpace(binding.ServicesYAMLPath),
+		}, true, nil
+	}
+	return alertIssueTarget{}, false, nil
+}
+
+// resolveAlertFingerprint prefers Alertmanager fingerprint and falls back to a stable hash of alert labels.
+func resolveAlertFingerprint(alert alertmanagerAlert) string {
+	if fingerprint := strings.TrimSpace(alert.Fingerprint); fingerprint != "" {
+		return fingerprint
+	}
+	keys := make([]string, 0, len(alert.Labels))
+	for key := range alert.Labels {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	hash := sha256.New()
+	for _, key := range keys {
+		hash.Write([]byte(key))
+		hash.Write([]byte{0})
+		hash.Write([]byte(alert.Labels[key]))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))[:16]
+}
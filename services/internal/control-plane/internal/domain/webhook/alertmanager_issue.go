@@ -0,0 +1,111 @@
+package webhook
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	alertincidentrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/alertincident"
+)
+
+const (
+	alertIssueTitleMaxLength = 200
+	alertIssueMarkerPrefix   = "<!-- kodex:alert-incident"
+)
+
+func renderAlertIncidentIssueTitle(incident alertincidentrepo.Incident) string {
+	annotations := decodeAlertStringMap(incident.AnnotationsJSON)
+	title := "[alert] " + incident.AlertName
+	if summary := strings.TrimSpace(annotations["summary"]); summary != "" {
+		title += ": " + summary
+	}
+	if len(title) > alertIssueTitleMaxLength {
+		title = title[:alertIssueTitleMaxLength]
+	}
+	return title
+}
+
+func renderAlertIncidentIssueBody(incident alertincidentrepo.Incident) string {
+	labels := decodeAlertStringMap(incident.LabelsJSON)
+	annotations := decodeAlertStringMap(incident.AnnotationsJSON)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s id=%s fingerprint=%s -->\n", alertIssueMarkerPrefix, incident.ID, incident.Fingerprint)
+	fmt.Fprintf(&b, "## Alert `%s` is firing\n\n", incident.AlertName)
+	if incident.Severity != "" {
+		fmt.Fprintf(&b, "- Severity: `%s`\n", incident.Severity)
+	}
+	fmt.Fprintf(&b, "- Started at: %s\n", formatAlertTime(incident.StartsAt))
+	fmt.Fprintf(&b, "- Fingerprint: `%s`\n", incident.Fingerprint)
+	if incident.GeneratorURL != "" {
+		fmt.Fprintf(&b, "- Source: %s\n", incident.GeneratorURL)
+	}
+	for _, key := range []string{"summary", "description", "runbook_url"} {
+		if value := strings.TrimSpace(annotations[key]); value != "" {
+			fmt.Fprintf(&b, "\n**%s:** %s\n", key, value)
+		}
+	}
+	if len(labels) > 0 {
+		b.WriteString("\n### Labels\n\n| Label | Value |\n| --- | --- |\n")
+		for _, key := range sortedAlertMapKeys(labels) {
+			fmt.Fprintf(&b, "| `%s` | `%s` |\n", key, escapeAlertTableCell(labels[key]))
+		}
+	}
+	b.WriteString("\nThis issue is maintained by Alertmanager ingestion: repeated firing and resolution are reported as comments.\n")
+	return b.String()
+}
+
+func renderAlertIncidentRefiredComment(incident alertincidentrepo.Incident) string {
+	return fmt.Sprintf(
+		"Alert `%s` is firing again since %s (occurrence %d).",
+		incident.AlertName,
+		formatAlertTime(incident.StartsAt),
+		incident.Occurrences,
+	)
+}
+
+func renderAlertIncidentResolvedComment(incident alertincidentrepo.Incident) string {
+	endsAt := incident.LastReceivedAt
+	if incident.EndsAt != nil {
+		endsAt = *incident.EndsAt
+	}
+	text := fmt.Sprintf(
+		"Alert `%s` resolved at %s (fired at %s).",
+		incident.AlertName,
+		formatAlertTime(endsAt),
+		formatAlertTime(incident.StartsAt),
+	)
+	if incident.LastRunID != "" {
+		text += fmt.Sprintf(" Review findings of ops run `%s` before closing this issue.", incident.LastRunID)
+	}
+	return text
+}
+
+func decodeAlertStringMap(raw []byte) map[string]string {
+	out := map[string]string{}
+	if len(raw) == 0 {
+		return out
+	}
+	_ = json.Unmarshal(raw, &out)
+	return out
+}
+
+func sortedAlertMapKeys(values map[string]string) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+func escapeAlertTableCell(value string) string {
+	value = strings.ReplaceAll(value, "|", "\\|")
+	return strings.ReplaceAll(value, "\n", " ")
+}
+
+func formatAlertTime(value time.Time) string {
+	return value.UTC().Format(time.RFC3339)
+}
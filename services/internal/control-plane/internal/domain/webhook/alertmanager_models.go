@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"encoding/json"
+	"time"
+
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+)
+
+// AlertmanagerIngestCommand is a normalized Alertmanager notification accepted by the domain service.
+type AlertmanagerIngestCommand struct {
+	// CorrelationID identifies this notification in flow events.
+	CorrelationID string
+	// ProjectID scopes incidents; falls back to `kodex_project_id` common label when empty.
+	ProjectID string
+	// ReceivedAt is the time when ingress received the notification.
+	ReceivedAt time.Time
+	// Payload is raw Alertmanager webhook JSON body (version 4).
+	Payload json.RawMessage
+}
+
+// AlertIncidentAction describes what one alert changed in incident state.
+type AlertIncidentAction string
+
+const (
+	AlertIncidentActionOpened   AlertIncidentAction = "opened"
+	AlertIncidentActionReopened AlertIncidentAction = "reopened"
+	AlertIncidentActionUpdated  AlertIncidentAction = "updated"
+	AlertIncidentActionResolved AlertIncidentAction = "resolved"
+	AlertIncidentActionIgnored  AlertIncidentAction = "ignored"
+)
+
+// AlertIncidentOutcome is one alert processing result.
+type AlertIncidentOutcome struct {
+	IncidentID  string              `json:"incident_id,omitempty"`
+	Fingerprint string              `json:"fingerprint"`
+	AlertName   string              `json:"alert_name"`
+	Action      AlertIncidentAction `json:"action"`
+	IssueURL    string              `json:"issue_url,omitempty"`
+	RunID       string              `json:"run_id,omitempty"`
+	// RunSkipReason explains why no ops run was started for a firing incident.
+	RunSkipReason string `json:"run_skip_reason,omitempty"`
+}
+
+// AlertmanagerIngestResult is a transport-facing outcome of Alertmanager ingestion.
+type AlertmanagerIngestResult struct {
+	CorrelationID string                     `json:"correlation_id"`
+	Status        webhookdomain.IngestStatus `json:"status"`
+	Incidents     []AlertIncidentOutcome     `json:"incidents"`
+}
+
+// alertmanagerPayload is a local DTO for Alertmanager webhook payload version 4.
+type alertmanagerPayload struct {
+	Version      string              `json:"version"`
+	GroupKey     string              `json:"groupKey"`
+	Status       string              `json:"status"`
+	Receiver     string              `json:"receiver"`
+	CommonLabels map[string]string   `json:"commonLabels"`
+	ExternalURL  string              `json:"externalURL"`
+	Alerts       []alertmanagerAlert `json:"alerts"`
+}
+
+type alertmanagerAlert struct {
+	Status       string            `json:"status"`
+	Labels       map[string]string `json:"labels"`
+	Annotations  map[string]string `json:"annotations"`
+	StartsAt     time.Time         `json:"startsAt"`
+	EndsAt       time.Time         `json:"endsAt"`
+	GeneratorURL string            `json:"generatorURL"`
+	Fingerprint  string            `json:"fingerprint"`
+}
+
+type alertIssueTarget struct {
+	RepositoryID     string
+	Owner            string
+	Name             string
+	ExternalID       int64
+	DefaultRef       string
+	ServicesYAMLPath string
+}
+
+func (t alertIssueTarget) fullName() string {
+	return t.Owner + "/" + t.Name
+}
+
+type alertFlowEventPayload struct {
+	Source    string                 `json:"source"`
+	ProjectID string                 `json:"project_id"`
+	GroupKey  string                 `json:"group_key,omitempty"`
+	Receiver  string                 `json:"receiver,omitempty"`
+	Status    string                 `json:"status"`
+	Incidents []AlertIncidentOutcome `json:"incidents"`
+}
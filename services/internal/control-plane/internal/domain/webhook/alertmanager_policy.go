@@ -0,0 +1,58 @@
+package webhook
+
+import (
+	"strings"
+
+	projectrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/project"
+)
+
+const (
+	alertSeverityInfo     = "info"
+	alertSeverityWarning  = "warning"
+	alertSeverityCritical = "critical"
+)
+
+// alertSeverityRanks orders normalized severities; unknown severities rank below info.
+var alertSeverityRanks = map[string]int{
+	alertSeverityInfo:     1,
+	alertSeverityWarning:  2,
+	alertSeverityCritical: 3,
+}
+
+func normalizeAlertSeverity(raw string) string {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "critical", "page", "error", "high":
+		return alertSeverityCritical
+	case "warning", "warn", "medium":
+		return alertSeverityWarning
+	case "info", "low", "none":
+		return alertSeverityInfo
+	default:
+		return strings.ToLower(strings.TrimSpace(raw))
+	}
+}
+
+// alertPolicyAllowsRun reports whether a firing incident starts `run:ops` and the skip reason otherwise.
+func alertPolicyAllowsRun(policy projectrepo.AlertPolicy, alertName string, severity string) (bool, string) {
+	if !policy.AutoRun {
+		return false, "auto_run_disabled"
+	}
+	if minSeverity := normalizeAlertSeverity(policy.MinSeverity); minSeverity != "" {
+		if alertSeverityRanks[normalizeAlertSeverity(severity)] < alertSeverityRanks[minSeverity] {
+			return false, "severity_below_threshold"
+		}
+	}
+	if len(policy.AlertNames) > 0 {
+		allowed := false
+		for _, name := range policy.AlertNames {
+			if strings.EqualFold(strings.TrimSpace(name), strings.TrimSpace(alertName)) {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false, "alert_name_not_allowed"
+		}
+	}
+	return true, ""
+}
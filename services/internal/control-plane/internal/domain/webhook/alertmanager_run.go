@@ -0,0 +1,141 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+	agentrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentrun"
+	alertincidentrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/alertincident"
+	projectrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/project"
+)
+
+const (
+	alertRunCorrelationPrefix = "alert:"
+	alertSenderLogin          = "alertmanager"
+)
+
+type alertRunInput struct {
+	Command   AlertmanagerIngestCommand
+	ProjectID string
+	Policy    projectrepo.AlertPolicy
+	Target    alertIssueTarget
+	Incident  alertincidentrepo.Incident
+	Alert     alertmanagerAlert
+}
+
+// launchAlertOpsRun creates one `run:ops` run per incident occurrence bound to the incident issue.
+// Alert runs bypass the human-actor check of label triggers: the project alert policy is the authorization.
+func (s *Service) launchAlertOpsRun(ctx context.Context, input alertRunInput) (string, error) {
+	incident := input.Incident
+	trigger := issueRunTrigger{
+		Source: webhookdomain.TriggerSourceAlertmanager,
+		Label:  s.triggerLabels.RunOps,
+		Kind:   webhookdomain.TriggerKindOps,
+	}
+	issueLabels := make([]githubLabelRecord, 0, len(input.Policy.IssueLabels))
+	for _, label := range input.Policy.IssueLabels {
+		issueLabels = append(issueLabels, githubLabelRecord{Name: label})
+	}
+	envelope := githubWebhookEnvelope{
+		Action: alertStatusFiring,
+		Repository: githubRepositoryRecord{
+			ID:       input.Target.ExternalID,
+			FullName: input.Target.fullName(),
+			Name:     input.Target.Name,
+		},
+		Issue: githubIssueRecord{
+			Number:  incident.IssueNumber,
+			Title:   renderAlertIncidentIssueTitle(incident),
+			HTMLURL: incident.IssueURL,
+			State:   "open",
+			Labels:  issueLabels,
+		},
+		Sender: githubActorRecord{Login: alertSenderLogin},
+	}
+	rawAlert, err := json.Marshal(input.Alert)
+	if err != nil {
+		return "", fmt.Errorf("marshal alert: %w", err)
+	}
+	cmd := IngestCommand{
+		CorrelationID: fmt.Sprintf("%s%s:%d", alertRunCorrelationPrefix, incident.ID, incident.Occurrences),
+		EventType:     alertmanagerEventType,
+		DeliveryID:    input.Command.CorrelationID,
+		ReceivedAt:    input.Command.ReceivedAt,
+		Payload:       rawAlert,
+	}
+
+	learningMode, err := s.resolveLearningMode(ctx, input.ProjectID, "")
+	if err != nil {
+		return "", fmt.Errorf("resolve learning mode: %w", err)
+	}
+	agent, err := s.resolveRunAgent(ctx, input.ProjectID, &trigger)
+	if err != nil {
+		return "", fmt.Errorf("resolve run agent: %w", err)
+	}
+	runtimeMode, runtimeModeSource := s.resolveRunRuntimeMode(&trigger)
+	routing := s.resolveIssueTriggerRuntimeProfile(ctx, input.ProjectID, envelope, trigger, input.Target.DefaultRef, runtimeMode)
+	servicesYAMLPath := input.Target.ServicesYAMLPath
+	if servicesYAMLPath == "" {
+		servicesYAMLPath = "services.yaml"
+	}
+
+	priority, prioritySource := resolveRunPriority(envelope, &trigger)
+	runPayload, err := buildRunPayload(runPayloadInput{
+		Command:          cmd,
+		Envelope:         envelope,
+		ProjectID:        input.ProjectID,
+		RepositoryID:     input.Target.RepositoryID,
+		ServicesYAMLPath: servicesYAMLPath,
+		HasBinding:       true,
+		LearningMode:     learningMode,
+		Trigger:          &trigger,
+		Agent:            agent,
+		RuntimeMode:      runtimeMode,
+		RuntimeSource:    runtimeModeSource,
+		RuntimeTargetEnv: routing.TargetEnv,
+		RuntimeNamespace: routing.Namespace,
+		RuntimeBuildRef:  routing.BuildRef,
+		RuntimeAccess:    routing.AccessProfile,
+		Alert:            buildAlertContext(incident),
+		Priority:         priority,
+		PrioritySource:   prioritySource,
+	})
+	if err != nil {
+		return "", fmt.Errorf("build run payload: %w", err)
+	}
+
+	createResult, err := s.agentRuns.CreatePendingIfAbsent(ctx, agentrunrepo.CreateParams{
+		CorrelationID: cmd.CorrelationID,
+		ProjectID:     input.ProjectID,
+		AgentID:       agent.ID,
+		RunPayload:    runPayload,
+		LearningMode:  learningMode,
+		Priority:      priority,
+	})
+	if err != nil {
+		return "", fmt.Errorf("create pending agent run: %w", err)
+	}
+	if createResult.Inserted {
+		s.postRunLaunchPlannedFeedback(ctx, createResult.RunID, trigger, runtimeMode, routing.Namespace, cmd.EventType)
+	}
+	return createResult.RunID, nil
+}
+
+func buildAlertContext(incident alertincidentrepo.Incident) *webhookdomain.AlertContext {
+	return &webhookdomain.AlertContext{
+		IncidentID:   incident.ID,
+		Fingerprint:  incident.Fingerprint,
+		AlertName:    incident.AlertName,
+		Severity:     incident.Severity,
+		Status:       strings.TrimSpace(incident.Status),
+		StartsAt:     incident.StartsAt.UTC().Format(time.RFC3339),
+		Occurrences:  incident.Occurrences,
+		GeneratorURL: incident.GeneratorURL,
+		Labels:       decodeAlertStringMap(incident.LabelsJSON),
+		Annotations:  decodeAlertStringMap(incident.AnnotationsJSON),
+	}
+}
@@ -0,0 +1,410 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+	agentrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agent"
+	alertincidentrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/alertincident"
+	projectrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/project"
+	repocfgrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/repocfg"
+)
+
+const alertmanagerTestPayloadFiring = `{
+	"version":"4",
+	"groupKey":"{}:{alertname=\"HighLatency\"}",
+	"status":"firing",
+	"receiver":"kodex",
+	"alerts":[{
+		"status":"firing",
+		"labels":{"alertname":"HighLatency","severity":"critical","service":"api"},
+		"annotations":{"summary":"p99 latency above 2s","runbook_url":"https://runbooks/latency"},
+		"startsAt":"2026-03-24T10:00:00Z",
+		"generatorURL":"https://prometheus/graph",
+		"fingerprint":"fp-latency"
+	}]
+}`
+
+const alertmanagerTestPayloadResolved = `{
+	"version":"4",
+	"status":"resolved",
+	"alerts":[{
+		"status":"resolved",
+		"labels":{"alertname":"HighLatency","severity":"critical","service":"api"},
+		"startsAt":"2026-03-24T10:00:00Z",
+		"endsAt":"2026-03-24T10:30:00Z",
+		"fingerprint":"fp-latency"
+	}]
+}`
+
+func TestIngestAlertmanagerWebhook_OpensIncidentIssueAndStartsOpsRun(t *testing.T) {
+	ctx := context.Background()
+	fixture := newAlertmanagerTestFixture(projectrepo.AlertPolicy{AutoRun: true, MinSeverity: "warning", IssueLabels: []string{"incident"}})
+
+	got, err := fixture.svc.IngestAlertmanagerWebhook(ctx, alertmanagerTestCommand("notification-1", alertmanagerTestPayloadFiring))
+	if err != nil {
+		t.Fatalf("ingest failed: %v", err)
+	}
+	if got.Status != webhookdomain.IngestStatusAccepted || len(got.Incidents) != 1 {
+		t.Fatalf("unexpected result: %+v", got)
+	}
+	outcome := got.Incidents[0]
+	if outcome.Action != AlertIncidentActionOpened || outcome.RunID == "" || outcome.IssueURL == "" {
+		t.Fatalf("unexpected outcome: %+v", outcome)
+	}
+	if len(fixture.issues.created) != 1 {
+		t.Fatalf("expected one incident issue, got %d", len(fixture.issues.created))
+	}
+	created := fixture.issues.created[0]
+	if created.owner != "codex-k8s" || created.repo != "ops" || !strings.Contains(created.title, "HighLatency") {
+		t.Fatalf("unexpected issue: %+v", created)
+	}
+	if !strings.Contains(created.body, "fingerprint=fp-latency") || strings.Join(created.labels, ",") != "incident" {
+		t.Fatalf("unexpected issue body or labels: %+v", created)
+	}
+
+	var runPayload githubRunPayload
+	if err := json.Unmarshal(fixture.runs.last.RunPayload, &runPayload); err != nil {
+		t.Fatalf("unmarshal run payload: %v", err)
+	}
+	if runPayload.Trigger == nil || runPayload.Trigger.Kind != webhookdomain.TriggerKindOps || runPayload.Trigger.Source != webhookdomain.TriggerSourceAlertmanager {
+		t.Fatalf("unexpected trigger: %#v", runPayload.Trigger)
+	}
+	if runPayload.Alert == nil || runPayload.Alert.Fingerprint != "fp-latency" || runPayload.Alert.Labels["service"] != "api" {
+		t.Fatalf("unexpected alert context: %#v", runPayload.Alert)
+	}
+	if runPayload.Issue == nil || runPayload.Issue.Number != 501 {
+		t.Fatalf("expected run bound to incident issue, got %#v", runPayload.Issue)
+	}
+	if fixture.events.items[len(fixture.events.items)-1].ActorID != floweventdomain.ActorIDAlertmanager {
+		t.Fatalf("expected alertmanager flow event actor")
+	}
+}
+
+func TestIngestAlertmanagerWebhook_RepeatedFiringDeduplicatesIncident(t *testing.T) {
+	ctx := context.Background()
+	fixture := newAlertmanagerTestFixture(projectrepo.AlertPolicy{AutoRun: true})
+
+	first, err := fixture.svc.IngestAlertmanagerWebhook(ctx, alertmanagerTestCommand("notification-1", alertmanagerTestPayloadFiring))
+	if err != nil {
+		t.Fatalf("first ingest failed: %v", err)
+	}
+	second, err := fixture.svc.IngestAlertmanagerWebhook(ctx, alertmanagerTestCommand("notification-2", alertmanagerTestPayloadFiring))
+	if err != nil {
+		t.Fatalf("second ingest failed: %v", err)
+	}
+	if got := second.Incidents[0]; got.Action != AlertIncidentActionUpdated || got.RunID != "" || got.IncidentID != first.Incidents[0].IncidentID {
+		t.Fatalf("unexpected repeated outcome: %+v", got)
+	}
+	if len(fixture.issues.created) != 1 || len(fixture.runs.items) != 1 {
+		t.Fatalf("expected one issue and one run, got %d issues and %d runs", len(fixture.issues.created), len(fixture.runs.items))
+	}
+}
+
+func TestIngestAlertmanagerWebhook_ResolveAndRefireReopensIncident(t *testing.T) {
+	ctx := context.Background()
+	fixture := newAlertmanagerTestFixture(projectrepo.AlertPolicy{AutoRun: true})
+
+	if _, err := fixture.svc.IngestAlertmanagerWebhook(ctx, alertmanagerTestCommand("notification-1", alertmanagerTestPayloadFiring)); err != nil {
+		t.Fatalf("firing ingest failed: %v", err)
+	}
+	resolved, err := fixture.svc.IngestAlertmanagerWebhook(ctx, alertmanagerTestCommand("notification-2", alertmanagerTestPayloadResolved))
+	if err != nil {
+		t.Fatalf("resolved ingest failed: %v", err)
+	}
+	if got := resolved.Incidents[0]; got.Action != AlertIncidentActionResolved {
+		t.Fatalf("unexpected resolved outcome: %+v", got)
+	}
+	repeatedResolved, err := fixture.svc.IngestAlertmanagerWebhook(ctx, alertmanagerTestCommand("notification-3", alertmanagerTestPayloadResolved))
+	if err != nil {
+		t.Fatalf("repeated resolved ingest failed: %v", err)
+	}
+	if repeatedResolved.Status != webhookdomain.IngestStatusIgnored {
+		t.Fatalf("expected repeated resolved notification to be ignored, got %+v", repeatedResolved)
+	}
+	refired, err := fixture.svc.IngestAlertmanagerWebhook(ctx, alertmanagerTestCommand("notification-4", alertmanagerTestPayloadFiring))
+	if err != nil {
+		t.Fatalf("refire ingest failed: %v", err)
+	}
+	if got := refired.Incidents[0]; got.Action != AlertIncidentActionReopened || got.RunID == "" {
+		t.Fatalf("unexpected refired outcome: %+v", got)
+	}
+
+	if len(fixture.issues.created) != 1 {
+		t.Fatalf("expected incident issue reuse, got %d issues", len(fixture.issues.created))
+	}
+	if len(fixture.issues.comments) != 2 ||
+		!strings.Contains(fixture.issues.comments[0], "resolved") ||
+		!strings.Contains(fixture.issues.comments[1], "occurrence 2") {
+		t.Fatalf("unexpected issue comments: %q", fixture.issues.comments)
+	}
+	if len(fixture.runs.items) != 2 {
+		t.Fatalf("expected a new run per occurrence, got %d", len(fixture.runs.items))
+	}
+}
+
+func TestIngestAlertmanagerWebhook_PolicySkipsRunButKeepsIssue(t *testing.T) {
+	ctx := context.Background()
+	fixture := newAlertmanagerTestFixture(projectrepo.AlertPolicy{AutoRun: true, AlertNames: []string{"DiskFull"}})
+
+	got, err := fixture.svc.IngestAlertmanagerWebhook(ctx, alertmanagerTestCommand("notification-1", alertmanagerTestPayloadFiring))
+	if err != nil {
+		t.Fatalf("ingest failed: %v", err)
+	}
+	if outcome := got.Incidents[0]; outcome.RunID != "" || outcome.RunSkipReason != "alert_name_not_allowed" || outcome.IssueURL == "" {
+		t.Fatalf("unexpected outcome: %+v", outcome)
+	}
+	if len(fixture.runs.items) != 0 {
+		t.Fatalf("expected no runs, got %d", len(fixture.runs.items))
+	}
+}
+
+func TestIngestAlertmanagerWebhook_UnknownProject(t *testing.T) {
+	fixture := newAlertmanagerTestFixture(projectrepo.AlertPolicy{})
+	cmd := alertmanagerTestCommand("notification-1", alertmanagerTestPayloadFiring)
+	cmd.ProjectID = "project-missing"
+
+	_, err := fixture.svc.IngestAlertmanagerWebhook(context.Background(), cmd)
+	if err == nil || !strings.Contains(err.Error(), "project not found") {
+		t.Fatalf("expected project not found error, got %v", err)
+	}
+}
+
+func TestAlertPolicyAllowsRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		policy     projectrepo.AlertPolicy
+		alertName  string
+		severity   string
+		wantReason string
+	}{
+		{name: "disabled", policy: projectrepo.AlertPolicy{}, alertName: "HighLatency", severity: "critical", wantReason: "auto_run_disabled"},
+		{name: "no filters", policy: projectrepo.AlertPolicy{AutoRun: true}, alertName: "HighLatency", severity: ""},
+		{name: "severity alias passes", policy: projectrepo.AlertPolicy{AutoRun: true, MinSeverity: "critical"}, alertName: "HighLatency", severity: "page"},
+		{name: "severity below", policy: projectrepo.AlertPolicy{AutoRun: true, MinSeverity: "warning"}, alertName: "HighLatency", severity: "info", wantReason: "severity_below_threshold"},
+		{name: "unknown severity below", policy: projectrepo.AlertPolicy{AutoRun: true, MinSeverity: "info"}, alertName: "HighLatency", severity: "custom", wantReason: "severity_below_threshold"},
+		{name: "name allowed", policy: projectrepo.AlertPolicy{AutoRun: true, AlertNames: []string{"highlatency"}}, alertName: "HighLatency", severity: "warning"},
+		{name: "name denied", policy: projectrepo.AlertPolicy{AutoRun: true, AlertNames: []string{"DiskFull"}}, alertName: "HighLatency", severity: "warning", wantReason: "alert_name_not_allowed"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			allowed, reason := alertPolicyAllowsRun(tc.policy, tc.alertName, tc.severity)
+			if allowed != (tc.wantReason == "") || reason != tc.wantReason {
+				t.Fatalf("alertPolicyAllowsRun() = (%t, %q), want reason %q", allowed, reason, tc.wantReason)
+			}
+		})
+	}
+}
+
+func TestResolveAlertFingerprint_FallsBackToStableLabelHash(t *testing.T) {
+	t.Parallel()
+
+	first := resolveAlertFingerprint(alertmanagerAlert{Labels: map[string]string{"alertname": "HighLatency", "service": "api"}})
+	second := resolveAlertFingerprint(alertmanagerAlert{Labels: map[string]string{"service": "api", "alertname": "HighLatency"}})
+	other := resolveAlertFingerprint(alertmanagerAlert{Labels: map[string]string{"alertname": "HighLatency", "service": "web"}})
+	if first == "" || first != second {
+		t.Fatalf("expected stable fingerprint, got %q and %q", first, second)
+	}
+	if first == other {
+		t.Fatalf("expected different fingerprint for different labels")
+	}
+	if got := resolveAlertFingerprint(alertmanagerAlert{Fingerprint: " fp-1 "}); got != "fp-1" {
+		t.Fatalf("expected alertmanager fingerprint, got %q", got)
+	}
+}
+
+type alertmanagerTestFixture struct {
+	svc    *Service
+	runs   *inMemoryRunRepo
+	events *inMemoryEventRepo
+	issues *inMemoryAlertIssueClient
+}
+
+func newAlertmanagerTestFixture(policy projectrepo.AlertPolicy) alertmanagerTestFixture {
+	runs := &inMemoryRunRepo{items: map[string]string{}}
+	events := &inMemoryEventRepo{}
+	issues := &inMemoryAlertIssueClient{}
+	svc := NewService(Config{
+		AgentRuns:  runs,
+		Agents:     &inMemoryAgentRepo{items: map[string]agentrepo.Agent{"sre": {ID: "agent-sre", AgentKey: "sre", Name: "AI SRE"}}},
+		FlowEvents: events,
+		Repos: &inMemoryRepoCfgRepo{
+			bindings: []repocfgrepo.RepositoryBinding{{
+				ID:               "repo-ops",
+				ProjectID:        "project-1",
+				Alias:            "ops",
+				Owner:            "codex-k8s",
+				Name:             "ops",
+				ExternalID:       4242,
+				ServicesYAMLPath: "services.yaml",
+			}},
+		},
+		Users:          &inMemoryUserRepo{},
+		Members:        &inMemoryProjectMemberRepo{},
+		Projects:       &inMemoryAlertProjectRepo{projectID: "project-1", policy: policy},
+		AlertIncidents: &inMemoryAlertIncidentRepo{},
+		AlertIssues:    issues,
+		GitHubToken:    "platform-token",
+	})
+	return alertmanagerTestFixture{svc: svc, runs: runs, events: events, issues: issues}
+}
+
+func alertmanagerTestCommand(correlationID string, payload string) AlertmanagerIngestCommand {
+	return AlertmanagerIngestCommand{
+		CorrelationID: correlationID,
+		ProjectID:     "project-1",
+		ReceivedAt:    time.Date(2026, 3, 24, 10, 5, 0, 0, time.UTC),
+		Payload:       json.RawMessage(payload),
+	}
+}
+
+type inMemoryAlertIncidentRepo struct {
+	items map[string]alertincidentrepo.Incident
+}
+
+func (r *inMemoryAlertIncidentRepo) UpsertFiring(_ context.Context, params alertincidentrepo.UpsertFiringParams) (alertincidentrepo.UpsertFiringResult, error) {
+	if r.items == nil {
+		r.items = make(map[string]alertincidentrepo.Incident)
+	}
+	key := params.ProjectID + "|" + params.Fingerprint
+	item, exists := r.items[key]
+	previousStatus := ""
+	if !exists {
+		item = alertincidentrepo.Incident{
+			ID:          fmt.Sprintf("incident-%d", len(r.items)+1),
+			ProjectID:   params.ProjectID,
+			Fingerprint: params.Fingerprint,
+			Occurrences: 1,
+			StartsAt:    params.StartsAt,
+		}
+	} else {
+		previousStatus = item.Status
+		if item.Status == alertStatusResolved {
+			item.Occurrences++
+			item.StartsAt = params.StartsAt
+		}
+	}
+	item.AlertName = params.AlertName
+	item.Severity = params.Severity
+	item.Status = alertStatusFiring
+	item.LabelsJSON = params.LabelsJSON
+	item.AnnotationsJSON = params.AnnotationsJSON
+	item.GeneratorURL = params.GeneratorURL
+	item.EndsAt = nil
+	item.LastReceivedAt = params.ReceivedAt
+	r.items[key] = item
+	return alertincidentrepo.UpsertFiringResult{Incident: item, PreviousStatus: previousStatus}, nil
+}
+
+func (r *inMemoryAlertIncidentRepo) MarkResolved(_ context.Context, params alertincidentrepo.MarkResolvedParams) (alertincidentrepo.Incident, bool, error) {
+	key := params.ProjectID + "|" + params.Fingerprint
+	item, ok := r.items[key]
+	if !ok || item.Status != alertStatusFiring {
+		return alertincidentrepo.Incident{}, false, nil
+	}
+	endsAt := params.EndsAt
+	item.Status = alertStatusResolved
+	item.EndsAt = &endsAt
+	item.LastReceivedAt = params.ReceivedAt
+	r.items[key] = item
+	return item, true, nil
+}
+
+func (r *inMemoryAlertIncidentRepo) SetIssue(_ context.Context, params alertincidentrepo.SetIssueParams) error {
+	return r.update(params.ID, func(item *alertincidentrepo.Incident) {
+		item.RepositoryFullName = params.RepositoryFullName
+		item.IssueNumber = params.IssueNumber
+		item.IssueURL = params.IssueURL
+	})
+}
+
+func (r *inMemoryAlertIncidentRepo) SetLastRun(_ context.Context, incidentID string, runID string) error {
+	return r.update(incidentID, func(item *alertincidentrepo.Incident) {
+		item.LastRunID = runID
+	})
+}
+
+func (r *inMemoryAlertIncidentRepo) update(incidentID string, apply func(item *alertincidentrepo.Incident)) error {
+	for key, item := range r.items {
+		if item.ID == incidentID {
+			apply(&item)
+			r.items[key] = item
+			return nil
+		}
+	}
+	return fmt.Errorf("incident %s not found", incidentID)
+}
+
+type inMemoryAlertIssue struct {
+	owner  string
+	repo   string
+	title  string
+	body   string
+	labels []string
+}
+
+type inMemoryAlertIssueClient struct {
+	created  []inMemoryAlertIssue
+	comments []string
+}
+
+func (c *inMemoryAlertIssueClient) CreateIssue(_ context.Context, _ string, owner string, repo string, title string, body string, labels []string) (int, string, error) {
+	c.created = append(c.created, inMemoryAlertIssue{owner: owner, repo: repo, title: title, body: body, labels: labels})
+	number := 500 + len(c.created)
+	return number, fmt.Sprintf("https://github.com/%s/%s/issues/%d", owner, repo, number), nil
+}
+
+func (c *inMemoryAlertIssueClient) CreateIssueComment(_ context.Context, _ string, _ string, _ string, _ int, body string) (string, error) {
+	c.comments = append(c.comments, body)
+	return "", nil
+}
+
+type inMemoryAlertProjectRepo struct {
+	projectID string
+	policy    projectrepo.AlertPolicy
+}
+
+func (r *inMemoryAlertProjectRepo) ListAll(_ context.Context, _ int) ([]projectrepo.Project, error) {
+	return nil, nil
+}
+
+func (r *inMemoryAlertProjectRepo) ListForUser(_ context.Context, _ string, _ int) ([]projectrepo.ProjectWithRole, error) {
+	return nil, nil
+}
+
+func (r *inMemoryAlertProjectRepo) Upsert(_ context.Context, _ projectrepo.UpsertParams) (projectrepo.Project, error) {
+	return projectrepo.Project{}, fmt.Errorf("not implemented")
+}
+
+func (r *inMemoryAlertProjectRepo) GetByID(_ context.Context, projectID string) (projectrepo.Project, bool, error) {
+	if projectID != r.projectID {
+		return projectrepo.Project{}, false, nil
+	}
+	return projectrepo.Project{ID: projectID}, true, nil
+}
+
+func (r *inMemoryAlertProjectRepo) DeleteByID(_ context.Context, _ string) error {
+	return nil
+}
+
+func (r *inMemoryAlertProjectRepo) GetLearningModeDefault(_ context.Context, _ string) (bool, bool, error) {
+	return false, false, nil
+}
+
+func (r *inMemoryAlertProjectRepo) GetAlertPolicy(_ context.Context, projectID string) (projectrepo.AlertPolicy, bool, error) {
+	return r.policy, projectID == r.projectID, nil
+}
+
+func (r *inMemoryAlertProjectRepo) GetAutoAdvancePolicy(_ context.Context, projectID string) (projectrepo.AutoAdvance, bool, error) {
+	return projectrepo.AutoAdvance{}, projectID == r.projectID, nil
+}
@@ -52,6 +52,7 @@ type TriggerLabels struct {
 	RunRethink                           string
 	ModeDiscussion                       string
 	NeedReviewer                         string
+	StateApproved                        string
 }
 
 func defaultTriggerLabels() TriggerLabels {
@@ -86,6 +87,7 @@ func defaultTriggerLabels() TriggerLabels {
 		RunRethink:           webhookdomain.DefaultRunRethinkLabel,
 		ModeDiscussion:       webhookdomain.DefaultModeDiscussionLabel,
 		NeedReviewer:         webhookdomain.DefaultNeedReviewerLabel,
+		StateApproved:        webhookdomain.DefaultStateApprovedLabel,
 	}
 }
 
@@ -179,6 +181,8 @@ type githubPullRequestRecord struct {
 	Title     string                `json:"title"`
 	HTMLURL   string                `json:"html_url"`
 	State     string                `json:"state"`
+	Merged    bool                  `json:"merged"`
+	MergedAt  string                `json:"merged_at"`
 	UpdatedAt string                `json:"updated_at"`
 	Labels    []githubLabelRecord   `json:"labels"`
 	Head      githubPullRequestHead `json:"head"`
@@ -31,6 +31,9 @@ type runPayloadInput struct {
 	RuntimeDeployOnly bool
 	RuntimeAccess     agentdomain.RuntimeAccessProfile
 	DiscussionMode    bool
+	Alert             *webhookdomain.AlertContext
+	Priority          int
+	PrioritySource    string
 }
 
 type eventPayloadInput struct {
@@ -104,6 +107,10 @@ func buildRunPayload(input runPayloadInput) (json.RawMessage, error) {
 			DeployOnly:    input.RuntimeDeployOnly,
 			AccessProfile: strings.TrimSpace(string(input.RuntimeAccess)),
 		},
+		Priority: githubRunPriorityPayload{
+			Value:  input.Priority,
+			Source: strings.TrimSpace(input.PrioritySource),
+		},
 	}
 
 	if input.Envelope.Issue.Number > 0 {
@@ -163,6 +170,10 @@ func buildRunPayload(input runPayloadInput) (json.RawMessage, error) {
 			Kind:   input.Trigger.Kind,
 		}
 	}
+	if input.Alert != nil {
+		payload.Source = webhookdomain.TriggerSourceAlertmanager
+		payload.Alert = input.Alert
+	}
 	if input.ProfileHints != nil {
 		payload.ProfileHints = &githubRunProfileHints{
 			LastRunIssueLabels:       normalizeWebhookLabels(input.ProfileHints.LastRunIssueLabels),
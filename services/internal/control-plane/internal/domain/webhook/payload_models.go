@@ -7,25 +7,27 @@ import (
 )
 
 type githubRunPayload struct {
-	Source         string                     `json:"source"`
-	DeliveryID     string                     `json:"delivery_id"`
-	EventType      string                     `json:"event_type"`
-	ReceivedAt     string                     `json:"received_at"`
-	Repository     githubRunRepositoryPayload `json:"repository"`
-	Installation   githubInstallationPayload  `json:"installation"`
-	Sender         githubActorPayload         `json:"sender"`
-	Action         string                     `json:"action"`
-	RawPayload     json.RawMessage            `json:"raw_payload"`
-	CorrelationID  string                     `json:"correlation_id"`
-	Project        githubRunProjectPayload    `json:"project"`
-	LearningMode   bool                       `json:"learning_mode"`
-	DiscussionMode bool                       `json:"discussion_mode,omitempty"`
-	Agent          githubRunAgentPayload      `json:"agent"`
-	Issue          *githubRunIssuePayload     `json:"issue,omitempty"`
-	PullRequest    *githubRunPRPayload        `json:"pull_request,omitempty"`
-	Trigger        *githubIssueTriggerPayload `json:"trigger,omitempty"`
-	ProfileHints   *githubRunProfileHints     `json:"profile_hints,omitempty"`
-	Runtime        githubRunRuntimePayload    `json:"runtime"`
+	Source         string                      `json:"source"`
+	DeliveryID     string                      `json:"delivery_id"`
+	EventType      string                      `json:"event_type"`
+	ReceivedAt     string                      `json:"received_at"`
+	Repository     githubRunRepositoryPayload  `json:"repository"`
+	Installation   githubInstallationPayload   `json:"installation"`
+	Sender         githubActorPayload          `json:"sender"`
+	Action         string                      `json:"action"`
+	RawPayload     json.RawMessage             `json:"raw_payload"`
+	CorrelationID  string                      `json:"correlation_id"`
+	Project        githubRunProjectPayload     `json:"project"`
+	LearningMode   bool                        `json:"learning_mode"`
+	DiscussionMode bool                        `json:"discussion_mode,omitempty"`
+	Agent          githubRunAgentPayload       `json:"agent"`
+	Issue          *githubRunIssuePayload      `json:"issue,omitempty"`
+	PullRequest    *githubRunPRPayload         `json:"pull_request,omitempty"`
+	Trigger        *githubIssueTriggerPayload  `json:"trigger,omitempty"`
+	ProfileHints   *githubRunProfileHints      `json:"profile_hints,omitempty"`
+	Alert          *webhookdomain.AlertContext `json:"alert,omitempty"`
+	Runtime        githubRunRuntimePayload     `json:"runtime"`
+	Priority       githubRunPriorityPayload    `json:"priority"`
 }
 
 type githubRunRepositoryPayload struct {
@@ -110,6 +112,11 @@ type githubRunRuntimePayload struct {
 	AccessProfile string `json:"access_profile,omitempty"`
 }
 
+type githubRunPriorityPayload struct {
+	Value  int    `json:"value"`
+	Source string `json:"source,omitempty"`
+}
+
 type githubFlowEventPayload struct {
 	Source            string                      `json:"source"`
 	DeliveryID        string                      `json:"delivery_id"`
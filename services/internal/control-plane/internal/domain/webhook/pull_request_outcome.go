@@ -0,0 +1,39 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+)
+
+type pullRequestClosedFlowEventPayload struct {
+	RepositoryFullName string `json:"repository_full_name"`
+	PullRequestNumber  int64  `json:"pull_request_number"`
+	Merged             bool   `json:"merged"`
+	MergedAt           string `json:"merged_at,omitempty"`
+}
+
+// recordPullRequestClosedEvent keeps PR merge/close outcome for run outcome analytics.
+// Analytics joins it to run lineage by repository and PR number.
+func (s *Service) recordPullRequestClosedEvent(ctx context.Context, cmd IngestCommand, envelope githubWebhookEnvelope, hasBinding bool) {
+	if !hasBinding || envelope.PullRequest.Number <= 0 {
+		return
+	}
+	if !strings.EqualFold(strings.TrimSpace(cmd.EventType), string(webhookdomain.GitHubEventPullRequest)) ||
+		!strings.EqualFold(strings.TrimSpace(envelope.Action), string(webhookdomain.GitHubActionClosed)) {
+		return
+	}
+	payload, err := json.Marshal(pullRequestClosedFlowEventPayload{
+		RepositoryFullName: strings.TrimSpace(envelope.Repository.FullName),
+		PullRequestNumber:  envelope.PullRequest.Number,
+		Merged:             envelope.PullRequest.Merged,
+		MergedAt:           strings.TrimSpace(envelope.PullRequest.MergedAt),
+	})
+	if err != nil {
+		return
+	}
+	_ = s.insertSystemFlowEvent(ctx, cmd.CorrelationID, floweventdomain.EventTypeRunPRClosed, payload, cmd.ReceivedAt)
+}
@@ -0,0 +1,55 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+type releaseGateRepublisher interface {
+	RepublishPullRequestReleaseGate(ctx context.Context, repositoryFullName string, prNumber int, headSHA string) error
+}
+
+// maybeRepublishReleaseGate re-publishes the change-governance release gate when the PR head moves.
+// Commit statuses are bound to a SHA, so without this the new head stays without the gate status.
+// Failures are reported as runtime errors and never fail ingestion.
+func (s *Service) maybeRepublishReleaseGate(ctx context.Context, cmd IngestCommand, envelope githubWebhookEnvelope, projectID string, hasBinding bool) {
+	if s.releaseGates == nil || !hasBinding {
+		return
+	}
+	if !strings.EqualFold(strings.TrimSpace(cmd.EventType), string(webhookdomain.GitHubEventPullRequest)) {
+		return
+	}
+	switch webhookdomain.GitHubAction(strings.ToLower(strings.TrimSpace(envelope.Action))) {
+	case webhookdomain.GitHubActionOpened, webhookdomain.GitHubActionSynchronize, webhookdomain.GitHubActionReopened:
+	default:
+		return
+	}
+	repositoryFullName := strings.TrimSpace(envelope.Repository.FullName)
+	headSHA := strings.TrimSpace(envelope.PullRequest.Head.SHA)
+	if envelope.PullRequest.Number <= 0 || headSHA == "" || repositoryFullName == "" {
+		return
+	}
+
+	err := s.releaseGates.RepublishPullRequestReleaseGate(ctx, repositoryFullName, int(envelope.PullRequest.Number), headSHA)
+	if err != nil && s.runtimeErr != nil {
+		details, _ := json.Marshal(map[string]any{
+			"repository_fullname": repositoryFullName,
+			"pull_request_number": envelope.PullRequest.Number,
+			"head_sha":            headSHA,
+			"action":              strings.TrimSpace(envelope.Action),
+			"error":               err.Error(),
+		})
+		s.runtimeErr.RecordBestEffort(ctx, querytypes.RuntimeErrorRecordParams{
+			Source:        "webhook.release_gate",
+			Level:         "error",
+			Message:       "Change-governance release gate republish failed",
+			CorrelationID: strings.TrimSpace(cmd.CorrelationID),
+			ProjectID:     strings.TrimSpace(projectID),
+			DetailsJSON:   details,
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+type recordingReleaseGates struct {
+	calls []string
+}
+
+func (r *recordingReleaseGates) RepublishPullRequestReleaseGate(_ context.Context, repositoryFullName string, prNumber int, headSHA string) error {
+	r.calls = append(r.calls, fmt.Sprintf("%s#%d@%s", repositoryFullName, prNumber, headSHA))
+	return nil
+}
+
+func TestMaybeRepublishReleaseGate(t *testing.T) {
+	t.Parallel()
+
+	pullRequest := githubPullRequestRecord{Number: 7, Head: githubPullRequestHead{SHA: "head-sha"}}
+	testCases := []struct {
+		name       string
+		eventType  string
+		action     string
+		hasBinding bool
+		want       int
+	}{
+		{name: "opened", eventType: "pull_request", action: "opened", hasBinding: true, want: 1},
+		{name: "synchronize", eventType: "pull_request", action: "synchronize", hasBinding: true, want: 1},
+		{name: "reopened", eventType: "pull_request", action: "reopened", hasBinding: true, want: 1},
+		{name: "labeled", eventType: "pull_request", action: "labeled", hasBinding: true},
+		{name: "review", eventType: "pull_request_review", action: "submitted", hasBinding: true},
+		{name: "unbound repository", eventType: "pull_request", action: "synchronize"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			gates := &recordingReleaseGates{}
+			service := &Service{releaseGates: gates}
+			envelope := githubWebhookEnvelope{
+				Action:      tc.action,
+				Repository:  githubRepositoryRecord{FullName: "codex-k8s/kodex"},
+				PullRequest: pullRequest,
+			}
+			service.maybeRepublishReleaseGate(context.Background(), IngestCommand{EventType: tc.eventType}, envelope, "project-1", tc.hasBinding)
+			if len(gates.calls) != tc.want {
+				t.Fatalf("calls = %v, want %d", gates.calls, tc.want)
+			}
+			if tc.want > 0 && gates.calls[0] != "codex-k8s/kodex#7@head-sha" {
+				t.Fatalf("call = %q, want new head sha", gates.calls[0])
+			}
+		})
+	}
+}
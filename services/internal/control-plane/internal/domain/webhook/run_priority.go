@@ -0,0 +1,22 @@
+package webhook
+
+import (
+	rundomain "github.com/codex-k8s/kodex/libs/go/domain/run"
+)
+
+// resolveRunPriority derives queue priority from `priority:*` labels of the source issue/PR and trigger kind.
+func resolveRunPriority(envelope githubWebhookEnvelope, trigger *issueRunTrigger) (int, string) {
+	labels := make([]string, 0, len(envelope.Issue.Labels)+len(envelope.PullRequest.Labels))
+	for _, label := range envelope.Issue.Labels {
+		labels = append(labels, label.Name)
+	}
+	for _, label := range envelope.PullRequest.Labels {
+		labels = append(labels, label.Name)
+	}
+
+	input := rundomain.PriorityInput{Labels: labels}
+	if trigger != nil {
+		input.TriggerKind = string(trigger.Kind)
+	}
+	return rundomain.ResolvePriority(input)
+}
@@ -0,0 +1,33 @@
+package webhook
+
+import (
+	"testing"
+
+	rundomain "github.com/codex-k8s/kodex/libs/go/domain/run"
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+)
+
+func TestResolveRunPriority(t *testing.T) {
+	t.Parallel()
+
+	opsTrigger := &issueRunTrigger{Kind: webhookdomain.TriggerKindOps}
+
+	priority, source := resolveRunPriority(githubWebhookEnvelope{}, opsTrigger)
+	if priority != rundomain.PriorityHigh || source != rundomain.PrioritySourceTriggerKind {
+		t.Fatalf("ops trigger: got %d/%s", priority, source)
+	}
+
+	envelope := githubWebhookEnvelope{
+		Issue:       githubIssueRecord{Labels: []githubLabelRecord{{Name: "run:ops"}}},
+		PullRequest: githubPullRequestRecord{Labels: []githubLabelRecord{{Name: "priority:low"}}},
+	}
+	priority, source = resolveRunPriority(envelope, opsTrigger)
+	if priority != rundomain.PriorityLow || source != rundomain.PrioritySourceLabel {
+		t.Fatalf("priority label: got %d/%s", priority, source)
+	}
+
+	priority, source = resolveRunPriority(githubWebhookEnvelope{}, nil)
+	if priority != rundomain.PriorityNormal || source != rundomain.PrioritySourceDefault {
+		t.Fatalf("no trigger: got %d/%s", priority, source)
+	}
+}
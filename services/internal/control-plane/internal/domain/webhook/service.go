@@ -14,9 +14,11 @@ import (
 	"github.com/codex-k8s/kodex/libs/go/errs"
 	agentrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agent"
 	agentrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentrun"
+	alertincidentrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/alertincident"
 	floweventrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/flowevent"
 	projectrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/project"
 	projectmemberrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectmember"
+	projectrolerepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectrole"
 	repocfgrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/repocfg"
 	userrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/user"
 	runstatusdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/runstatus"
@@ -71,6 +73,11 @@ type pushMainVersionBumpClient interface {
 	GetPullRequestHead(ctx context.Context, token string, owner string, repo string, number int) (GitHubPullRequestHeadDetails, error)
 }
 
+// gitHubCacheInvalidator drops cached GitHub API responses made stale by incoming webhook.
+type gitHubCacheInvalidator interface {
+	InvalidateRepository(fullName string) int
+}
+
 type GitHubPullRequestHeadDetails struct {
 	State   string
 	HeadRef string
@@ -86,9 +93,13 @@ type Service struct {
 	projects   projectrepo.Repository
 	users      userrepo.Repository
 	members    projectmemberrepo.Repository
+	roles      projectrolerepo.Repository
 	runStatus  runStatusService
 	runtimeErr runtimeErrorRecorder
 
+	alertIncidents alertincidentrepo.Repository
+	alertIssues    alertIssueClient
+
 	learningModeDefault bool
 	triggerLabels       TriggerLabels
 	runtimeModePolicy   RuntimeModePolicy
@@ -96,6 +107,9 @@ type Service struct {
 	githubToken         string
 	gitBotUsername      string
 	githubMgmt          pushMainVersionBumpClient
+	githubCache         gitHubCacheInvalidator
+	stageAutoAdvance    stageAutoAdvancer
+	releaseGates        releaseGateRepublisher
 	autoVersionBump     bool
 }
 
@@ -109,9 +123,15 @@ type Config struct {
 	GitBotUsername      string
 	GitHubMgmt          pushMainVersionBumpClient
 	PushMainAutoBump    bool
+	GitHubCache         gitHubCacheInvalidator
+	StageAutoAdvance    stageAutoAdvancer
+	ReleaseGates        releaseGateRepublisher
 	RunStatus           runStatusService
 	RuntimeErrors       runtimeErrorRecorder
+	AlertIncidents      alertincidentrepo.Repository
+	AlertIssues         alertIssueClient
 	Members             projectmemberrepo.Repository
+	ProjectRoles        projectrolerepo.Repository
 	Users               userrepo.Repository
 	Projects            projectrepo.Repository
 	Repos               repocfgrepo.Repository
@@ -132,8 +152,11 @@ func NewService(cfg Config) *Service {
 		projects:            cfg.Projects,
 		users:               cfg.Users,
 		members:             cfg.Members,
+		roles:               cfg.ProjectRoles,
 		runStatus:           cfg.RunStatus,
 		runtimeErr:          cfg.RuntimeErrors,
+		alertIncidents:      cfg.AlertIncidents,
+		alertIssues:         cfg.AlertIssues,
 		learningModeDefault: cfg.LearningModeDefault,
 		triggerLabels:       triggerLabels,
 		runtimeModePolicy:   cfg.RuntimeModePolicy.withDefaults(),
@@ -141,6 +164,9 @@ func NewService(cfg Config) *Service {
 		githubToken:         strings.TrimSpace(cfg.GitHubToken),
 		gitBotUsername:      normalizeLabelToken(cfg.GitBotUsername),
 		githubMgmt:          cfg.GitHubMgmt,
+		githubCache:         cfg.GitHubCache,
+		stageAutoAdvance:    cfg.StageAutoAdvance,
+		releaseGates:        cfg.ReleaseGates,
 		autoVersionBump:     cfg.PushMainAutoBump,
 	}
 }
@@ -168,6 +194,9 @@ func (s *Service) IngestGitHubWebhook(ctx context.Context, cmd IngestCommand) (I
 	if err := json.Unmarshal(cmd.Payload, &envelope); err != nil {
 		return IngestResult{}, errs.Validation{Field: "payload", Msg: "must be valid JSON"}
 	}
+	if s.githubCache != nil {
+		s.githubCache.InvalidateRepository(envelope.Repository.FullName)
+	}
 
 	projectID, repositoryID, servicesYAMLPath, repositoryDefaultRef, hasBinding, err := s.resolveProjectBinding(ctx, envelope)
 	if err != nil {
@@ -176,6 +205,9 @@ func (s *Service) IngestGitHubWebhook(ctx context.Context, cmd IngestCommand) (I
 	if err := s.maybeCleanupRunNamespaces(ctx, cmd, envelope, hasBinding); err != nil {
 		return IngestResult{}, fmt.Errorf("cleanup run namespaces on close event: %w", err)
 	}
+	s.recordPullRequestClosedEvent(ctx, cmd, envelope, hasBinding)
+	s.maybeAutoAdvanceStage(ctx, cmd, envelope, projectID, hasBinding)
+	s.maybeRepublishReleaseGate(ctx, cmd, envelope, projectID, hasBinding)
 
 	trigger, hasIssueRunTrigger, conflict, reviewMeta, err := s.resolveIssueRunTrigger(ctx, projectID, cmd.EventType, envelope)
 	if err != nil {
@@ -352,6 +384,7 @@ func (s *Service) IngestGitHubWebhook(ctx context.Context, cmd IngestCommand) (I
 		}
 	}
 
+	priority, prioritySource := resolveRunPriority(envelope, triggerPtr(trigger, hasIssueRunTrigger))
 	runPayload, err := buildRunPayload(runPayloadInput{
 		Command:           effectiveCmd,
 		Envelope:          envelope,
@@ -373,6 +406,8 @@ func (s *Service) IngestGitHubWebhook(ctx context.Context, cmd IngestCommand) (I
 		RuntimeDeployOnly: runtimeDeployOnly,
 		RuntimeAccess:     runtimeAccessProfile,
 		DiscussionMode:    hasIssueRunTrigger && trigger.DiscussionMode,
+		Priority:          priority,
+		PrioritySource:    prioritySource,
 	})
 	if err != nil {
 		return IngestResult{}, fmt.Errorf("build run payload: %w", err)
@@ -384,6 +419,7 @@ func (s *Service) IngestGitHubWebhook(ctx context.Context, cmd IngestCommand) (I
 		AgentID:       agent.ID,
 		RunPayload:    runPayload,
 		LearningMode:  learningMode,
+		Priority:      priority,
 	})
 	if err != nil {
 		return IngestResult{}, fmt.Errorf("create pending agent run: %w", err)
@@ -14,8 +14,10 @@ import (
 	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
 	"github.com/google/uuid"
 
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/projectrbac"
 	floweventrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/flowevent"
 	runstatusdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/runstatus"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
 )
 
@@ -680,7 +682,7 @@ func (s *Service) isActorAllowedForIssueTrigger(ctx context.Context, projectID s
 		return false, "project_membership_repository_unavailable", nil
 	}
 
-	role, ok, err := s.members.GetRole(ctx, projectID, u.ID)
+	role, permissions, ok, err := projectrbac.NewResolver(s.members, s.roles).Resolve(ctx, projectID, u.ID)
 	if err != nil {
 		return false, "", err
 	}
@@ -688,15 +690,14 @@ func (s *Service) isActorAllowedForIssueTrigger(ctx context.Context, projectID s
 		return false, "sender_not_project_member", nil
 	}
 
-	switch strings.ToLower(strings.TrimSpace(role)) {
-	case "admin", "read_write":
-		return true, "project_member_" + strings.ToLower(strings.TrimSpace(role)), nil
-	default:
-		if strings.TrimSpace(role) == "" {
-			return false, "sender_role_not_permitted", nil
-		}
-		return false, "sender_role_" + strings.ToLower(strings.TrimSpace(role)) + "_not_permitted", nil
+	role = strings.ToLower(strings.TrimSpace(role))
+	if role == "" {
+		return false, "sender_role_not_permitted", nil
+	}
+	if permissions.Allows(enumtypes.ProjectPermissionRunsTrigger, "") {
+		return true, "project_member_" + role, nil
 	}
+	return false, "sender_role_" + role + "_not_permitted", nil
 }
 
 func triggerPtr(trigger issueRunTrigger, ok bool) *issueRunTrigger {
@@ -11,6 +11,7 @@ import (
 
 	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
 	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	rundomain "github.com/codex-k8s/kodex/libs/go/domain/run"
 	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
 	agentrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agent"
 	agentrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentrun"
@@ -1079,6 +1080,22 @@ func TestIngestGitHubWebhook_ClosedEvents_TriggersNamespaceCleanup(t *testing.T)
 			if runStatus.lastPullRequestCleanup.PRNumber != expectedPRNumber {
 				t.Fatalf("unexpected pull request number: %d", runStatus.lastPullRequestCleanup.PRNumber)
 			}
+			var closedEvent *floweventrepo.InsertParams
+			for idx := range events.items {
+				if events.items[idx].EventType == floweventdomain.EventTypeRunPRClosed {
+					closedEvent = &events.items[idx]
+				}
+			}
+			if closedEvent == nil {
+				t.Fatalf("expected %s flow event", floweventdomain.EventTypeRunPRClosed)
+			}
+			var closedPayload pullRequestClosedFlowEventPayload
+			if err := json.Unmarshal(closedEvent.Payload, &closedPayload); err != nil {
+				t.Fatalf("decode pull request closed payload: %v", err)
+			}
+			if !closedPayload.Merged || closedPayload.PullRequestNumber != expectedPRNumber || closedPayload.RepositoryFullName != "codex-k8s/kodex" {
+				t.Fatalf("unexpected pull request closed payload: %+v", closedPayload)
+			}
 		})
 	}
 
@@ -1091,7 +1108,7 @@ func TestIngestGitHubWebhook_ClosedEvents_TriggersNamespaceCleanup(t *testing.T)
 
 	runCase(t, "pull_request_closed", "delivery-pr-close-1", string(webhookdomain.GitHubEventPullRequest), json.RawMessage(`{
 		"action":"closed",
-		"pull_request":{"id":501,"number":200},
+		"pull_request":{"id":501,"number":200,"merged":true,"merged_at":"2026-03-28T10:00:00Z"},
 		"repository":{"id":42,"full_name":"codex-k8s/kodex","name":"kodex"},
 		"sender":{"id":10,"login":"member"}
 	}`), 0, 200)
@@ -1239,6 +1256,9 @@ func TestIngestGitHubWebhook_IssueRunDev_CreatesRunForAllowedMember(t *testing.T
 	if got, want := runPayload.Runtime.Source, runtimeModeSourceTriggerDefault; got != want {
 		t.Fatalf("unexpected runtime source: got %q want %q", got, want)
 	}
+	if runPayload.Priority.Value != rundomain.PriorityNormal || runPayload.Priority.Source != rundomain.PrioritySourceDefault {
+		t.Fatalf("unexpected priority payload: %+v", runPayload.Priority)
+	}
 	if got, want := runPayload.Runtime.BuildRef, "codex/feature-branch"; got != want {
 		t.Fatalf("unexpected runtime build ref: got %q want %q", got, want)
 	}
@@ -3311,10 +3331,11 @@ func (r *inMemoryEventRepo) Insert(_ context.Context, params floweventrepo.Inser
 
 type inMemoryRepoCfgRepo struct {
 	byExternalID map[int64]repocfgrepo.FindResult
+	bindings     []repocfgrepo.RepositoryBinding
 }
 
 func (r *inMemoryRepoCfgRepo) ListForProject(_ context.Context, _ string, _ int) ([]repocfgrepo.RepositoryBinding, error) {
-	return nil, nil
+	return r.bindings, nil
 }
 
 func (r *inMemoryRepoCfgRepo) GetByID(_ context.Context, repositoryID string) (repocfgrepo.RepositoryBinding, bool, error) {
@@ -3410,6 +3431,22 @@ func (r *inMemoryUserRepo) CreateAllowedUser(_ context.Context, _ string, _ bool
 	return userrepo.User{}, nil
 }
 
+func (r *inMemoryUserRepo) GetByOIDCIdentity(_ context.Context, _ string, _ string) (userrepo.User, bool, error) {
+	return userrepo.User{}, false, nil
+}
+
+func (r *inMemoryUserRepo) UpdateOIDCIdentity(_ context.Context, _ string, _ string, _ string) (bool, error) {
+	return true, nil
+}
+
+func (r *inMemoryUserRepo) SyncOIDCPlatformAdmin(_ context.Context, _ string, grant bool) (bool, error) {
+	return grant, nil
+}
+
+func (r *inMemoryUserRepo) CreateServiceAccount(_ context.Context, _ string, _ bool) (userrepo.User, bool, error) {
+	return userrepo.User{}, false, nil
+}
+
 func (r *inMemoryUserRepo) List(_ context.Context, _ int) ([]userrepo.User, error) {
 	return nil, nil
 }
@@ -3422,6 +3459,10 @@ type inMemoryProjectMemberRepo struct {
 	roles map[string]string
 }
 
+func (r *inMemoryProjectMemberRepo) SyncOIDCRoles(_ context.Context, _ string, _ map[string]string) error {
+	return nil
+}
+
 func (r *inMemoryProjectMemberRepo) List(_ context.Context, _ string, _ int) ([]projectmemberrepo.Member, error) {
 	return nil, nil
 }
@@ -0,0 +1,98 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+const gitHubReviewStateApproved = "approved"
+
+type stageAutoAdvancer interface {
+	AutoAdvanceStage(ctx context.Context, params querytypes.StageAutoAdvanceParams) (querytypes.StageAutoAdvanceResult, error)
+}
+
+// maybeAutoAdvanceStage forwards approval signals (approved PR review or `state:approved` label)
+// to project auto-advance rules. Failures are reported as runtime errors and never fail ingestion.
+func (s *Service) maybeAutoAdvanceStage(ctx context.Context, cmd IngestCommand, envelope githubWebhookEnvelope, projectID string, hasBinding bool) {
+	if s.stageAutoAdvance == nil || !hasBinding || strings.TrimSpace(projectID) == "" {
+		return
+	}
+	params, ok := s.resolveStageAutoAdvanceSignal(cmd.EventType, envelope)
+	if !ok {
+		return
+	}
+	if params.IssueNumber <= 0 && params.PullRequestNumber > 0 {
+		issueNumber, _, err := s.resolveLinkedIssueNumberFromHistory(ctx, projectID, envelope.Repository.FullName, int64(params.PullRequestNumber))
+		if err != nil || issueNumber <= 0 {
+			return
+		}
+		params.IssueNumber = int(issueNumber)
+	}
+	if params.IssueNumber <= 0 {
+		return
+	}
+	params.ProjectID = projectID
+	params.RepositoryFullName = strings.TrimSpace(envelope.Repository.FullName)
+	params.SenderLogin = strings.TrimSpace(envelope.Sender.Login)
+
+	if _, err := s.stageAutoAdvance.AutoAdvanceStage(ctx, params); err != nil && s.runtimeErr != nil {
+		details, _ := json.Marshal(map[string]any{
+			"repository_fullname": params.RepositoryFullName,
+			"issue_number":        params.IssueNumber,
+			"pull_request_number": params.PullRequestNumber,
+			"trigger":             params.Trigger,
+			"error":               err.Error(),
+		})
+		s.runtimeErr.RecordBestEffort(ctx, querytypes.RuntimeErrorRecordParams{
+			Source:        "webhook.auto_advance",
+			Level:         "error",
+			Message:       "Stage auto-advance failed",
+			CorrelationID: strings.TrimSpace(cmd.CorrelationID),
+			ProjectID:     projectID,
+			DetailsJSON:   details,
+		})
+	}
+}
+
+func (s *Service) resolveStageAutoAdvanceSignal(eventType string, envelope githubWebhookEnvelope) (querytypes.StageAutoAdvanceParams, bool) {
+	action := strings.TrimSpace(envelope.Action)
+	switch strings.ToLower(strings.TrimSpace(eventType)) {
+	case string(webhookdomain.GitHubEventPullRequestReview):
+		if !strings.EqualFold(action, string(webhookdomain.GitHubActionSubmitted)) ||
+			!strings.EqualFold(strings.TrimSpace(envelope.Review.State), gitHubReviewStateApproved) ||
+			envelope.PullRequest.Number <= 0 {
+			return querytypes.StageAutoAdvanceParams{}, false
+		}
+		return querytypes.StageAutoAdvanceParams{
+			PullRequestNumber: int(envelope.PullRequest.Number),
+			Trigger:           querytypes.StageAutoAdvanceTriggerReviewApproved,
+		}, true
+	case string(webhookdomain.GitHubEventPullRequest):
+		if !strings.EqualFold(action, string(webhookdomain.GitHubActionLabeled)) ||
+			!s.triggerLabels.isStateApprovedLabel(envelope.Label.Name) ||
+			envelope.PullRequest.Number <= 0 {
+			return querytypes.StageAutoAdvanceParams{}, false
+		}
+		return querytypes.StageAutoAdvanceParams{
+			PullRequestNumber: int(envelope.PullRequest.Number),
+			Trigger:           querytypes.StageAutoAdvanceTriggerApprovedLabel,
+		}, true
+	case string(webhookdomain.GitHubEventIssues):
+		if !strings.EqualFold(action, string(webhookdomain.GitHubActionLabeled)) ||
+			!s.triggerLabels.isStateApprovedLabel(envelope.Label.Name) ||
+			envelope.Issue.PullRequest != nil ||
+			envelope.Issue.Number <= 0 {
+			return querytypes.StageAutoAdvanceParams{}, false
+		}
+		return querytypes.StageAutoAdvanceParams{
+			IssueNumber: int(envelope.Issue.Number),
+			Trigger:     querytypes.StageAutoAdvanceTriggerApprovedLabel,
+		}, true
+	default:
+		return querytypes.StageAutoAdvanceParams{}, false
+	}
+}
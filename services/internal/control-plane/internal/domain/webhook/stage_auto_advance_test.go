@@ -0,0 +1,59 @@
+package webhook
+
+import (
+	"testing"
+
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+func TestResolveStageAutoAdvanceSignal(t *testing.T) {
+	t.Parallel()
+
+	service := &Service{triggerLabels: defaultTriggerLabels()}
+	testCases := []struct {
+		name      string
+		eventType string
+		envelope  githubWebhookEnvelope
+		ok        bool
+		want      querytypes.StageAutoAdvanceParams
+	}{
+		{
+			name:      "approved review",
+			eventType: "pull_request_review",
+			envelope:  githubWebhookEnvelope{Action: "submitted", Review: githubReviewRecord{State: "APPROVED"}, PullRequest: githubPullRequestRecord{Number: 7}},
+			ok:        true,
+			want:      querytypes.StageAutoAdvanceParams{PullRequestNumber: 7, Trigger: querytypes.StageAutoAdvanceTriggerReviewApproved},
+		},
+		{
+			name:      "commented review",
+			eventType: "pull_request_review",
+			envelope:  githubWebhookEnvelope{Action: "submitted", Review: githubReviewRecord{State: "commented"}, PullRequest: githubPullRequestRecord{Number: 7}},
+		},
+		{
+			name:      "approved label on pull request",
+			eventType: "pull_request",
+			envelope:  githubWebhookEnvelope{Action: "labeled", Label: githubLabelRecord{Name: "state:approved"}, PullRequest: githubPullRequestRecord{Number: 7}},
+			ok:        true,
+			want:      querytypes.StageAutoAdvanceParams{PullRequestNumber: 7, Trigger: querytypes.StageAutoAdvanceTriggerApprovedLabel},
+		},
+		{
+			name:      "approved label on issue",
+			eventType: "issues",
+			envelope:  githubWebhookEnvelope{Action: "labeled", Label: githubLabelRecord{Name: "State:Approved"}, Issue: githubIssueRecord{Number: 3}},
+			ok:        true,
+			want:      querytypes.StageAutoAdvanceParams{IssueNumber: 3, Trigger: querytypes.StageAutoAdvanceTriggerApprovedLabel},
+		},
+		{
+			name:      "other label on issue",
+			eventType: "issues",
+			envelope:  githubWebhookEnvelope{Action: "labeled", Label: githubLabelRecord{Name: "run:dev"}, Issue: githubIssueRecord{Number: 3}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		got, ok := service.resolveStageAutoAdvanceSignal(testCase.eventType, testCase.envelope)
+		if ok != testCase.ok || got != testCase.want {
+			t.Fatalf("%s: resolveStageAutoAdvanceSignal() = %#v, %v; want %#v, %v", testCase.name, got, ok, testCase.want, testCase.ok)
+		}
+	}
+}
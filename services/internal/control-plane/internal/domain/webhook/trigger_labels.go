@@ -99,6 +99,9 @@ func (labels TriggerLabels) withDefaults() TriggerLabels {
 	if strings.TrimSpace(labels.NeedReviewer) == "" {
 		labels.NeedReviewer = defaults.NeedReviewer
 	}
+	if strings.TrimSpace(labels.StateApproved) == "" {
+		labels.StateApproved = defaults.StateApproved
+	}
 	return labels
 }
 
@@ -171,6 +174,14 @@ func (labels TriggerLabels) isNeedReviewerLabel(label string) bool {
 	return normalizeLabelToken(label) == reviewerLabel
 }
 
+func (labels TriggerLabels) isStateApprovedLabel(label string) bool {
+	approvedLabel := normalizeLabelToken(labels.withDefaults().StateApproved)
+	if approvedLabel == "" {
+		return false
+	}
+	return normalizeLabelToken(label) == approvedLabel
+}
+
 func (labels TriggerLabels) isModeDiscussionLabel(label string) bool {
 	discussionLabel := normalizeLabelToken(labels.withDefaults().ModeDiscussion)
 	if discussionLabel == "" {
@@ -13,6 +13,8 @@ func runFromDBModel(row dbmodel.RunRow) domainrepo.Run {
 		CorrelationID: row.CorrelationID,
 		Status:        row.Status,
 		RunPayload:    json.RawMessage(row.RunPayload),
+		Priority:      int(row.Priority),
+		Attempt:       int(row.Attempt),
 	}
 	if row.ProjectID.Valid {
 		item.ProjectID = row.ProjectID.String
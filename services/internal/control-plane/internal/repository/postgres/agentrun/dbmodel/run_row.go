@@ -9,4 +9,6 @@ type RunRow struct {
 	ProjectID     pgtype.Text `db:"project_id"`
 	Status        string      `db:"status"`
 	RunPayload    []byte      `db:"run_payload"`
+	Priority      int16       `db:"priority"`
+	Attempt       int32       `db:"attempt"`
 }
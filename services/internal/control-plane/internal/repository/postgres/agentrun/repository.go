@@ -69,6 +69,7 @@ func (r *Repository) CreatePendingIfAbsent(ctx context.Context, params domainrep
 		params.AgentID,
 		[]byte(params.RunPayload),
 		params.LearningMode,
+		params.Priority,
 	).Scan(&insertedRunID)
 	if err == nil {
 		return domainrepo.CreateResult{
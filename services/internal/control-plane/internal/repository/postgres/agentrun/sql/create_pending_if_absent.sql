@@ -6,7 +6,8 @@ INSERT INTO agent_runs (
     agent_id,
     status,
     run_payload,
-    learning_mode
+    learning_mode,
+    priority
 )
 VALUES (
     $1,
@@ -15,7 +16,8 @@ VALUES (
     NULLIF($4, '')::uuid,
     'pending',
     $5::jsonb,
-    $6
+    $6,
+    COALESCE(NULLIF($7::int, 0), 50)
 )
 ON CONFLICT (correlation_id) DO NOTHING
 RETURNING id;
@@ -1,5 +1,5 @@
 -- name: agentrun__get_by_id :one
-SELECT id, correlation_id, project_id::text AS project_id, status, run_payload
+SELECT id, correlation_id, project_id::text AS project_id, status, run_payload, priority, attempt
 FROM agent_runs
 WHERE id = $1
 LIMIT 1;
@@ -9,7 +9,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/codex-k8s/kodex/libs/go/blobstore"
 	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentsession"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -28,16 +30,25 @@ var (
 	querySetWaitStateByRunID string
 	//go:embed sql/cleanup_session_payloads_finished_before.sql
 	queryCleanupSessionPayloadsFinishedBefore string
+	//go:embed sql/list_blob_run_ids_finished_before.sql
+	queryListBlobRunIDsFinishedBefore string
+	//go:embed sql/list_inline_snapshots_after_id.sql
+	queryListInlineSnapshotsAfterID string
+	//go:embed sql/mark_snapshot_offloaded.sql
+	queryMarkSnapshotOffloaded string
 )
 
 // Repository stores resumable agent sessions in PostgreSQL.
+// When snapshot blob store is configured, heavy session payloads are kept there and Postgres keeps metadata only.
 type Repository struct {
-	db *pgxpool.Pool
+	db        *pgxpool.Pool
+	snapshots blobstore.Store
 }
 
 // NewRepository constructs PostgreSQL agent session repository.
-func NewRepository(db *pgxpool.Pool) *Repository {
-	return &Repository{db: db}
+// Nil snapshots store keeps session payloads inline in JSONB columns.
+func NewRepository(db *pgxpool.Pool, snapshots blobstore.Store) *Repository {
+	return &Repository{db: db, snapshots: snapshots}
 }
 
 // Upsert stores or updates run session snapshot by run_id.
@@ -52,6 +63,9 @@ func (r *Repository) Upsert(ctx context.Context, params domainrepo.UpsertParams)
 	}
 
 	if record.ExpectedVersion == 0 {
+		if err := r.offloadSnapshot(ctx, &record); err != nil {
+			return domainrepo.UpsertResult{}, fmt.Errorf("upsert agent session: %w", err)
+		}
 		inserted, result, err := r.insertIfAbsent(ctx, record)
 		if err != nil {
 			return domainrepo.UpsertResult{}, err
@@ -88,6 +102,9 @@ func (r *Repository) Upsert(ctx context.Context, params domainrepo.UpsertParams)
 	if err != nil {
 		return domainrepo.UpsertResult{}, fmt.Errorf("upsert agent session: build merged record: %w", err)
 	}
+	if err := r.offloadSnapshot(ctx, &record); err != nil {
+		return domainrepo.UpsertResult{}, fmt.Errorf("upsert agent session: %w", err)
+	}
 
 	updated, result, err := r.updateIfVersionMatches(ctx, record)
 	if err != nil {
@@ -165,6 +182,20 @@ func (r *Repository) CleanupSessionPayloadsFinishedBefore(ctx context.Context, f
 		return 0, fmt.Errorf("finished_before is required")
 	}
 
+	// Blob objects are removed first: rows keep their blob references until storage cleanup succeeds,
+	// so failed deletions are retried by the next cleanup pass.
+	rows, err := r.db.Query(ctx, queryListBlobRunIDsFinishedBefore, cutoff)
+	if err != nil {
+		return 0, fmt.Errorf("list blob agent sessions before %s: %w", cutoff.Format(time.RFC3339), err)
+	}
+	runIDs, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return 0, fmt.Errorf("collect blob agent sessions before %s: %w", cutoff.Format(time.RFC3339), err)
+	}
+	if err := r.deleteRunSnapshotBlobs(ctx, runIDs); err != nil {
+		return 0, err
+	}
+
 	res, err := r.db.Exec(ctx, queryCleanupSessionPayloadsFinishedBefore, cutoff)
 	if err != nil {
 		return 0, fmt.Errorf("cleanup agent session payloads before %s: %w", cutoff.Format(time.RFC3339), err)
@@ -201,6 +232,9 @@ func (r *Repository) insertIfAbsent(ctx context.Context, record upsertRecord) (b
 		timestamptzPtrOrNil(record.FinishedAt),
 		record.SnapshotChecksum,
 		record.SnapshotUpdatedAt,
+		string(record.SnapshotStorage),
+		nullableTrimmedText(record.SnapshotBlobKey),
+		int64OrNil(record.SnapshotBlobSizeBytes),
 	)
 
 	result, found, err := scanUpsertResult(row)
@@ -239,6 +273,9 @@ func (r *Repository) updateIfVersionMatches(ctx context.Context, record upsertRe
 		record.ExpectedVersion,
 		record.SnapshotChecksum,
 		record.SnapshotUpdatedAt,
+		string(record.SnapshotStorage),
+		nullableTrimmedText(record.SnapshotBlobKey),
+		int64OrNil(record.SnapshotBlobSizeBytes),
 	)
 
 	result, found, err := scanUpsertResult(row)
@@ -272,6 +309,9 @@ func (r *Repository) queryOneSession(ctx context.Context, query string, operatio
 		finishedAt        pgtype.Timestamptz
 		snapshotChecksum  pgtype.Text
 		snapshotUpdatedAt pgtype.Timestamptz
+		snapshotStorage   string
+		snapshotBlobKey   pgtype.Text
+		snapshotBlobSize  pgtype.Int8
 	)
 
 	err := r.db.QueryRow(ctx, query, args...).Scan(
@@ -302,6 +342,9 @@ func (r *Repository) queryOneSession(ctx context.Context, query string, operatio
 		&item.SnapshotVersion,
 		&snapshotChecksum,
 		&snapshotUpdatedAt,
+		&snapshotStorage,
+		&snapshotBlobKey,
+		&snapshotBlobSize,
 		&startedAt,
 		&finishedAt,
 		&item.CreatedAt,
@@ -367,6 +410,13 @@ func (r *Repository) queryOneSession(ctx context.Context, query string, operatio
 	if snapshotUpdatedAt.Valid {
 		item.SnapshotUpdatedAt = snapshotUpdatedAt.Time.UTC()
 	}
+	item.SnapshotStorage = enumtypes.AgentSessionSnapshotStorage(snapshotStorage)
+	if snapshotBlobKey.Valid {
+		item.SnapshotBlobKey = snapshotBlobKey.String
+	}
+	if snapshotBlobSize.Valid {
+		item.SnapshotBlobSizeBytes = snapshotBlobSize.Int64
+	}
 	if startedAt.Valid {
 		item.StartedAt = startedAt.Time.UTC()
 	}
@@ -375,6 +425,9 @@ func (r *Repository) queryOneSession(ctx context.Context, query string, operatio
 	}
 	item.CreatedAt = item.CreatedAt.UTC()
 	item.UpdatedAt = item.UpdatedAt.UTC()
+	if err := r.hydrateSnapshot(ctx, &item); err != nil {
+		return domainrepo.Session{}, false, err
+	}
 
 	return item, true, nil
 }
@@ -409,6 +462,13 @@ func bytesOrNil(value []byte) any {
 	return value
 }
 
+func int64OrNil(value int64) any {
+	if value <= 0 {
+		return nil
+	}
+	return value
+}
+
 func timestamptzOrNil(value time.Time) pgtype.Timestamptz {
 	if value.IsZero() {
 		return pgtype.Timestamptz{}
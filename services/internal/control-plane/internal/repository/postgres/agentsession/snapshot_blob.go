@@ -0,0 +1,151 @@
+package agentsession
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/codex-k8s/kodex/libs/go/blobstore"
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentsession"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
+
+const snapshotBlobKeyPrefix = "agent-sessions/"
+
+// snapshotBlobPayload is gzip-compressed JSON document stored in blob storage for one snapshot checksum.
+type snapshotBlobPayload struct {
+	SessionJSON      json.RawMessage `json:"session_json"`
+	CodexSessionJSON json.RawMessage `json:"codex_cli_session_json,omitempty"`
+}
+
+// snapshotBlobRunPrefix groups all snapshot versions of one run, so cleanup can drop them together.
+func snapshotBlobRunPrefix(runID string) string {
+	return snapshotBlobKeyPrefix + strings.TrimSpace(runID) + "/"
+}
+
+// snapshotBlobKey addresses snapshot content by its checksum inside run prefix.
+func snapshotBlobKey(runID string, checksum string) string {
+	return snapshotBlobRunPrefix(runID) + strings.ToLower(strings.TrimSpace(checksum)) + ".json.gz"
+}
+
+// isEmptySnapshot reports whether payload carries no session data worth offloading.
+func isEmptySnapshot(sessionJSON []byte, codexSessionJSON []byte) bool {
+	session := strings.TrimSpace(string(sessionJSON))
+	return (session == "" || session == "{}" || session == "null") && len(bytes.TrimSpace(codexSessionJSON)) == 0
+}
+
+func encodeSnapshotBlob(sessionJSON []byte, codexSessionJSON []byte) ([]byte, error) {
+	payload := snapshotBlobPayload{SessionJSON: json.RawMessage(`{}`)}
+	if len(bytes.TrimSpace(sessionJSON)) > 0 {
+		payload.SessionJSON = json.RawMessage(sessionJSON)
+	}
+	if len(bytes.TrimSpace(codexSessionJSON)) > 0 {
+		payload.CodexSessionJSON = json.RawMessage(codexSessionJSON)
+	}
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("marshal snapshot payload: %w", err)
+	}
+
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write(raw); err != nil {
+		return nil, fmt.Errorf("compress snapshot payload: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, fmt.Errorf("compress snapshot payload: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeSnapshotBlob decompresses payload and verifies it against checksum recorded in Postgres.
+func decodeSnapshotBlob(data []byte, expectedChecksum string) (json.RawMessage, json.RawMessage, error) {
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, nil, fmt.Errorf("open snapshot gzip stream: %w", err)
+	}
+	raw, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, nil, fmt.Errorf("decompress snapshot payload: %w", err)
+	}
+
+	var payload snapshotBlobPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, nil, fmt.Errorf("decode snapshot payload: %w", err)
+	}
+	checksum, err := agentdomain.ComputeSessionSnapshotChecksum(payload.SessionJSON, payload.CodexSessionJSON)
+	if err != nil {
+		return nil, nil, fmt.Errorf("compute snapshot checksum: %w", err)
+	}
+	if expected := strings.TrimSpace(expectedChecksum); expected != "" && !strings.EqualFold(checksum, expected) {
+		return nil, nil, fmt.Errorf("snapshot checksum mismatch: blob=%s row=%s", checksum, expected)
+	}
+	return payload.SessionJSON, payload.CodexSessionJSON, nil
+}
+
+// offloadSnapshot moves record payload into blob storage and leaves only metadata for Postgres.
+func (r *Repository) offloadSnapshot(ctx context.Context, record *upsertRecord) error {
+	record.SnapshotStorage = enumtypes.AgentSessionSnapshotStoragePostgres
+	if r.snapshots == nil || isEmptySnapshot(record.SessionJSON, record.CodexSessionJSON) {
+		return nil
+	}
+
+	data, err := encodeSnapshotBlob(record.SessionJSON, record.CodexSessionJSON)
+	if err != nil {
+		return err
+	}
+	key := snapshotBlobKey(record.RunID, record.SnapshotChecksum)
+	if err := r.snapshots.Put(ctx, key, data); err != nil {
+		return fmt.Errorf("store agent session snapshot blob: %w", err)
+	}
+
+	record.SnapshotStorage = enumtypes.AgentSessionSnapshotStorageBlob
+	record.SnapshotBlobKey = key
+	record.SnapshotBlobSizeBytes = int64(len(data))
+	record.SessionJSON = []byte(`{}`)
+	record.CodexSessionJSON = nil
+	return nil
+}
+
+// hydrateSnapshot loads blob-backed payload into session entity.
+func (r *Repository) hydrateSnapshot(ctx context.Context, item *domainrepo.Session) error {
+	if item.SnapshotStorage != enumtypes.AgentSessionSnapshotStorageBlob {
+		return nil
+	}
+	if r.snapshots == nil {
+		return fmt.Errorf("agent session %s snapshot is stored in blob storage, but blob store is not configured", item.RunID)
+	}
+
+	data, err := r.snapshots.Get(ctx, item.SnapshotBlobKey)
+	if err != nil {
+		return fmt.Errorf("load agent session snapshot blob %s: %w", item.SnapshotBlobKey, err)
+	}
+	sessionJSON, codexSessionJSON, err := decodeSnapshotBlob(data, item.SnapshotChecksum)
+	if err != nil {
+		return fmt.Errorf("load agent session snapshot blob %s: %w", item.SnapshotBlobKey, err)
+	}
+	item.SessionJSON = sessionJSON
+	item.CodexSessionJSON = codexSessionJSON
+	return nil
+}
+
+// deleteRunSnapshotBlobs drops every stored snapshot version for runs.
+func (r *Repository) deleteRunSnapshotBlobs(ctx context.Context, runIDs []string) error {
+	if len(runIDs) == 0 {
+		return nil
+	}
+	if r.snapshots == nil {
+		return fmt.Errorf("%d agent session snapshots are stored in blob storage, but blob store is not configured", len(runIDs))
+	}
+	for _, runID := range runIDs {
+		if _, err := blobstore.DeletePrefix(ctx, r.snapshots, snapshotBlobRunPrefix(runID)); err != nil {
+			return fmt.Errorf("delete agent session snapshot blobs for run %s: %w", runID, err)
+		}
+	}
+	return nil
+}
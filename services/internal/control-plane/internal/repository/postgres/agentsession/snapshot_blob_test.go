@@ -0,0 +1,118 @@
+package agentsession
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/codex-k8s/kodex/libs/go/blobstore"
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentsession"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+)
+
+func TestOffloadSnapshot_RoundTripsThroughBlobStore(t *testing.T) {
+	t.Parallel()
+
+	store, err := blobstore.NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFilesystemStore() error = %v", err)
+	}
+	repo := &Repository{snapshots: store}
+
+	record, err := buildUpsertRecord(domainrepo.UpsertParams{
+		RunID:            "run-1",
+		SessionJSON:      json.RawMessage(`{"status":"running","turns":[1,2,3]}`),
+		CodexSessionJSON: json.RawMessage(`{"cursor":"abc"}`),
+	}, nil)
+	if err != nil {
+		t.Fatalf("buildUpsertRecord() error = %v", err)
+	}
+	checksum := record.SnapshotChecksum
+
+	if err := repo.offloadSnapshot(context.Background(), &record); err != nil {
+		t.Fatalf("offloadSnapshot() error = %v", err)
+	}
+	if record.SnapshotStorage != enumtypes.AgentSessionSnapshotStorageBlob {
+		t.Fatalf("snapshot storage = %q, want blob", record.SnapshotStorage)
+	}
+	if want := "agent-sessions/run-1/" + checksum + ".json.gz"; record.SnapshotBlobKey != want {
+		t.Fatalf("blob key = %q, want %q", record.SnapshotBlobKey, want)
+	}
+	if string(record.SessionJSON) != `{}` || record.CodexSessionJSON != nil {
+		t.Fatalf("expected inline payloads to be cleared, got %s / %s", record.SessionJSON, record.CodexSessionJSON)
+	}
+
+	item := domainrepo.Session{
+		RunID:            "run-1",
+		SnapshotChecksum: checksum,
+		SnapshotStorage:  record.SnapshotStorage,
+		SnapshotBlobKey:  record.SnapshotBlobKey,
+	}
+	if err := repo.hydrateSnapshot(context.Background(), &item); err != nil {
+		t.Fatalf("hydrateSnapshot() error = %v", err)
+	}
+	if string(item.SessionJSON) != `{"status":"running","turns":[1,2,3]}` || string(item.CodexSessionJSON) != `{"cursor":"abc"}` {
+		t.Fatalf("unexpected hydrated payloads: %s / %s", item.SessionJSON, item.CodexSessionJSON)
+	}
+
+	item.SnapshotChecksum = "deadbeef"
+	if err := repo.hydrateSnapshot(context.Background(), &item); err == nil || !strings.Contains(err.Error(), "checksum mismatch") {
+		t.Fatalf("expected checksum mismatch error, got %v", err)
+	}
+}
+
+func TestOffloadSnapshot_KeepsInlineWithoutStoreOrPayload(t *testing.T) {
+	t.Parallel()
+
+	store, err := blobstore.NewFilesystemStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("NewFilesystemStore() error = %v", err)
+	}
+
+	tests := []struct {
+		name   string
+		repo   *Repository
+		params domainrepo.UpsertParams
+	}{
+		{
+			name:   "store disabled",
+			repo:   &Repository{},
+			params: domainrepo.UpsertParams{RunID: "run-1", SessionJSON: json.RawMessage(`{"status":"running"}`)},
+		},
+		{
+			name:   "empty snapshot",
+			repo:   &Repository{snapshots: store},
+			params: domainrepo.UpsertParams{RunID: "run-1"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			record, err := buildUpsertRecord(tc.params, nil)
+			if err != nil {
+				t.Fatalf("buildUpsertRecord() error = %v", err)
+			}
+			if err := tc.repo.offloadSnapshot(context.Background(), &record); err != nil {
+				t.Fatalf("offloadSnapshot() error = %v", err)
+			}
+			if record.SnapshotStorage != enumtypes.AgentSessionSnapshotStoragePostgres || record.SnapshotBlobKey != "" {
+				t.Fatalf("expected inline storage, got %q / %q", record.SnapshotStorage, record.SnapshotBlobKey)
+			}
+		})
+	}
+}
+
+func TestHydrateSnapshot_RequiresConfiguredStore(t *testing.T) {
+	t.Parallel()
+
+	item := domainrepo.Session{
+		RunID:           "run-1",
+		SnapshotStorage: enumtypes.AgentSessionSnapshotStorageBlob,
+		SnapshotBlobKey: "agent-sessions/run-1/abc.json.gz",
+	}
+	if err := (&Repository{}).hydrateSnapshot(context.Background(), &item); err == nil {
+		t.Fatal("expected error for blob snapshot without configured store")
+	}
+}
@@ -0,0 +1,89 @@
+package agentsession
+
+import (
+	"context"
+	"fmt"
+
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	"github.com/jackc/pgx/v5"
+)
+
+// SnapshotMigrationBatch reports one batch of inline snapshots moved to blob storage.
+type SnapshotMigrationBatch struct {
+	// Scanned is number of inline rows inspected; zero means no rows are left after LastID.
+	Scanned int
+	Moved   int
+	// Skipped counts empty snapshots and rows changed concurrently while batch was processed.
+	Skipped int
+	LastID  int64
+}
+
+type inlineSnapshotRow struct {
+	ID                  int64  `db:"id"`
+	RunID               string `db:"run_id"`
+	SessionJSON         []byte `db:"session_json"`
+	CodexCLISessionJSON []byte `db:"codex_cli_session_json"`
+	SnapshotVersion     int64  `db:"snapshot_version"`
+}
+
+// MoveInlineSnapshotsToBlob offloads up to limit inline snapshots with id greater than afterID.
+// Each row is re-addressed by canonical snapshot checksum, so legacy SQL-computed checksums are normalized.
+func (r *Repository) MoveInlineSnapshotsToBlob(ctx context.Context, afterID int64, limit int) (SnapshotMigrationBatch, error) {
+	if r.snapshots == nil {
+		return SnapshotMigrationBatch{}, fmt.Errorf("move agent session snapshots: blob store is not configured")
+	}
+	if limit <= 0 {
+		return SnapshotMigrationBatch{}, fmt.Errorf("move agent session snapshots: limit must be positive")
+	}
+
+	rows, err := r.db.Query(ctx, queryListInlineSnapshotsAfterID, afterID, limit)
+	if err != nil {
+		return SnapshotMigrationBatch{}, fmt.Errorf("list inline agent session snapshots: %w", err)
+	}
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[inlineSnapshotRow])
+	if err != nil {
+		return SnapshotMigrationBatch{}, fmt.Errorf("collect inline agent session snapshots: %w", err)
+	}
+
+	batch := SnapshotMigrationBatch{Scanned: len(items), LastID: afterID}
+	for _, item := range items {
+		batch.LastID = item.ID
+		if isEmptySnapshot(item.SessionJSON, item.CodexCLISessionJSON) {
+			batch.Skipped++
+			continue
+		}
+
+		checksum, err := agentdomain.ComputeSessionSnapshotChecksum(item.SessionJSON, item.CodexCLISessionJSON)
+		if err != nil {
+			return batch, fmt.Errorf("compute snapshot checksum for agent session %d: %w", item.ID, err)
+		}
+		record := upsertRecord{
+			RunID:            item.RunID,
+			SessionJSON:      item.SessionJSON,
+			CodexSessionJSON: item.CodexCLISessionJSON,
+			SnapshotChecksum: checksum,
+		}
+		if err := r.offloadSnapshot(ctx, &record); err != nil {
+			return batch, fmt.Errorf("offload agent session %d: %w", item.ID, err)
+		}
+
+		res, err := r.db.Exec(
+			ctx,
+			queryMarkSnapshotOffloaded,
+			item.ID,
+			item.SnapshotVersion,
+			checksum,
+			record.SnapshotBlobKey,
+			record.SnapshotBlobSizeBytes,
+		)
+		if err != nil {
+			return batch, fmt.Errorf("mark agent session %d snapshot offloaded: %w", item.ID, err)
+		}
+		if res.RowsAffected() == 0 {
+			batch.Skipped++
+			continue
+		}
+		batch.Moved++
+	}
+	return batch, nil
+}
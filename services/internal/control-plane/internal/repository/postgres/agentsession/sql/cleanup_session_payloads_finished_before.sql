@@ -2,10 +2,14 @@
 UPDATE agent_sessions
 SET session_json = '{}'::jsonb,
     codex_cli_session_json = NULL,
+    snapshot_storage = 'postgres',
+    snapshot_blob_key = NULL,
+    snapshot_blob_size_bytes = NULL,
     updated_at = NOW()
 WHERE finished_at IS NOT NULL
   AND finished_at < $1::timestamptz
   AND (
       COALESCE(session_json, '{}'::jsonb) <> '{}'::jsonb
       OR codex_cli_session_json IS NOT NULL
+      OR snapshot_storage = 'blob'
   );
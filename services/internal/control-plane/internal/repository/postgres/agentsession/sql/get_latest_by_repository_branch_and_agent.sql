@@ -27,6 +27,9 @@ SELECT
     snapshot_version,
     snapshot_checksum,
     snapshot_updated_at,
+    snapshot_storage,
+    snapshot_blob_key,
+    snapshot_blob_size_bytes,
     started_at,
     finished_at,
     created_at,
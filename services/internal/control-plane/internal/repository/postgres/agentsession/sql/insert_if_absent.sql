@@ -25,6 +25,9 @@ INSERT INTO agent_sessions (
     snapshot_updated_at,
     started_at,
     finished_at,
+    snapshot_storage,
+    snapshot_blob_key,
+    snapshot_blob_size_bytes,
     updated_at
 )
 VALUES (
@@ -53,6 +56,9 @@ VALUES (
     COALESCE($24, NOW()),
     COALESCE($21, NOW()),
     $22,
+    $25,
+    $26,
+    $27,
     NOW()
 )
 ON CONFLICT (run_id) DO NOTHING
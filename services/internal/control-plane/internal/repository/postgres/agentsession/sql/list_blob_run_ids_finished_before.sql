@@ -0,0 +1,6 @@
+-- name: agentsession__list_blob_run_ids_finished_before :many
+SELECT DISTINCT run_id::text
+FROM agent_sessions
+WHERE finished_at IS NOT NULL
+  AND finished_at < $1::timestamptz
+  AND snapshot_storage = 'blob';
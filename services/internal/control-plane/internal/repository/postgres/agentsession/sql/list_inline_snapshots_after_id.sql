@@ -0,0 +1,12 @@
+-- name: agentsession__list_inline_snapshots_after_id :many
+SELECT
+    id,
+    run_id::text AS run_id,
+    session_json,
+    codex_cli_session_json,
+    snapshot_version
+FROM agent_sessions
+WHERE snapshot_storage = 'postgres'
+  AND id > $1
+ORDER BY id ASC
+LIMIT $2;
@@ -0,0 +1,12 @@
+-- name: agentsession__mark_snapshot_offloaded :exec
+UPDATE agent_sessions
+SET session_json = '{}'::jsonb,
+    codex_cli_session_json = NULL,
+    snapshot_checksum = $3,
+    snapshot_storage = 'blob',
+    snapshot_blob_key = $4,
+    snapshot_blob_size_bytes = $5,
+    updated_at = NOW()
+WHERE id = $1
+  AND snapshot_version = $2
+  AND snapshot_storage = 'postgres';
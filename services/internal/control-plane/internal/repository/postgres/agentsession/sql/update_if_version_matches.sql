@@ -21,6 +21,9 @@ SET correlation_id = $2,
     codex_cli_session_json = $20::jsonb,
     started_at = COALESCE($21, started_at),
     finished_at = COALESCE($22, finished_at),
+    snapshot_storage = $26,
+    snapshot_blob_key = $27,
+    snapshot_blob_size_bytes = $28,
     snapshot_version = CASE
         WHEN COALESCE(snapshot_checksum, '') = COALESCE(NULLIF($24, ''), '') THEN snapshot_version
         ELSE snapshot_version + 1
@@ -6,6 +6,7 @@ import (
 
 	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
 	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentsession"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 )
 
 type upsertRecord struct {
@@ -34,6 +35,10 @@ type upsertRecord struct {
 	ExpectedVersion    int64
 	SnapshotChecksum   string
 	SnapshotUpdatedAt  time.Time
+	// Snapshot storage fields are filled by Repository.offloadSnapshot after checksum is known.
+	SnapshotStorage       enumtypes.AgentSessionSnapshotStorage
+	SnapshotBlobKey       string
+	SnapshotBlobSizeBytes int64
 }
 
 func buildUpsertRecord(params domainrepo.UpsertParams, existing *domainrepo.Session) (upsertRecord, error) {
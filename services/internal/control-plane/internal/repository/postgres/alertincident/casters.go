@@ -0,0 +1,38 @@
+package alertincident
+
+import (
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/alertincident"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/alertincident/dbmodel"
+)
+
+func fromDBModel(row dbmodel.AlertIncidentRow) domainrepo.Incident {
+	item := domainrepo.Incident{
+		ID:                 row.ID,
+		ProjectID:          row.ProjectID,
+		Fingerprint:        row.Fingerprint,
+		AlertName:          row.AlertName,
+		Severity:           row.Severity,
+		Status:             row.Status,
+		LabelsJSON:         row.Labels,
+		AnnotationsJSON:    row.Annotations,
+		GeneratorURL:       row.GeneratorURL,
+		StartsAt:           row.StartsAt.UTC(),
+		Occurrences:        int(row.Occurrences),
+		RepositoryFullName: row.RepositoryFullName,
+		IssueURL:           row.IssueURL,
+		LastReceivedAt:     row.LastReceivedAt.UTC(),
+		CreatedAt:          row.CreatedAt.UTC(),
+		UpdatedAt:          row.UpdatedAt.UTC(),
+	}
+	if row.EndsAt.Valid {
+		endsAt := row.EndsAt.Time.UTC()
+		item.EndsAt = &endsAt
+	}
+	if row.IssueNumber.Valid {
+		item.IssueNumber = row.IssueNumber.Int64
+	}
+	if row.LastRunID.Valid {
+		item.LastRunID = row.LastRunID.String
+	}
+	return item
+}
@@ -0,0 +1,36 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// AlertIncidentRow mirrors one alert_incidents row selected from PostgreSQL.
+type AlertIncidentRow struct {
+	ID                 string             `db:"id"`
+	ProjectID          string             `db:"project_id"`
+	Fingerprint        string             `db:"fingerprint"`
+	AlertName          string             `db:"alert_name"`
+	Severity           string             `db:"severity"`
+	Status             string             `db:"status"`
+	Labels             []byte             `db:"labels"`
+	Annotations        []byte             `db:"annotations"`
+	GeneratorURL       string             `db:"generator_url"`
+	StartsAt           time.Time          `db:"starts_at"`
+	EndsAt             pgtype.Timestamptz `db:"ends_at"`
+	Occurrences        int32              `db:"occurrences"`
+	RepositoryFullName string             `db:"repository_full_name"`
+	IssueNumber        pgtype.Int8        `db:"issue_number"`
+	IssueURL           string             `db:"issue_url"`
+	LastRunID          pgtype.Text        `db:"last_run_id"`
+	LastReceivedAt     time.Time          `db:"last_received_at"`
+	CreatedAt          time.Time          `db:"created_at"`
+	UpdatedAt          time.Time          `db:"updated_at"`
+}
+
+// AlertIncidentUpsertRow extends incident row with status observed before upsert.
+type AlertIncidentUpsertRow struct {
+	AlertIncidentRow
+	PreviousStatus string `db:"previous_status"`
+}
@@ -0,0 +1,116 @@
+package alertincident
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/alertincident"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/alertincident/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	//go:embed sql/upsert_firing.sql
+	queryUpsertFiring string
+	//go:embed sql/mark_resolved.sql
+	queryMarkResolved string
+	//go:embed sql/set_issue.sql
+	querySetIssue string
+	//go:embed sql/set_last_run.sql
+	querySetLastRun string
+)
+
+// Repository persists alert_incidents in PostgreSQL.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+// NewRepository constructs alert incidents repository.
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// UpsertFiring opens, re-opens or refreshes one incident by project and fingerprint.
+func (r *Repository) UpsertFiring(ctx context.Context, params domainrepo.UpsertFiringParams) (domainrepo.UpsertFiringResult, error) {
+	rows, err := r.db.Query(
+		ctx,
+		queryUpsertFiring,
+		strings.TrimSpace(params.ProjectID),
+		strings.TrimSpace(params.Fingerprint),
+		strings.TrimSpace(params.AlertName),
+		strings.TrimSpace(params.Severity),
+		jsonOrEmptyObject(params.LabelsJSON),
+		jsonOrEmptyObject(params.AnnotationsJSON),
+		strings.TrimSpace(params.GeneratorURL),
+		params.StartsAt.UTC(),
+		params.ReceivedAt.UTC(),
+	)
+	if err != nil {
+		return domainrepo.UpsertFiringResult{}, fmt.Errorf("upsert firing alert incident: %w", err)
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.AlertIncidentUpsertRow])
+	if err != nil {
+		return domainrepo.UpsertFiringResult{}, fmt.Errorf("collect firing alert incident: %w", err)
+	}
+	return domainrepo.UpsertFiringResult{
+		Incident:       fromDBModel(row.AlertIncidentRow),
+		PreviousStatus: row.PreviousStatus,
+	}, nil
+}
+
+// MarkResolved resolves one firing incident.
+func (r *Repository) MarkResolved(ctx context.Context, params domainrepo.MarkResolvedParams) (domainrepo.Incident, bool, error) {
+	rows, err := r.db.Query(
+		ctx,
+		queryMarkResolved,
+		strings.TrimSpace(params.ProjectID),
+		strings.TrimSpace(params.Fingerprint),
+		params.EndsAt.UTC(),
+		params.ReceivedAt.UTC(),
+	)
+	if err != nil {
+		return domainrepo.Incident{}, false, fmt.Errorf("mark alert incident resolved: %w", err)
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.AlertIncidentRow])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainrepo.Incident{}, false, nil
+		}
+		return domainrepo.Incident{}, false, fmt.Errorf("collect resolved alert incident: %w", err)
+	}
+	return fromDBModel(row), true, nil
+}
+
+// SetIssue links incident to its GitHub issue.
+func (r *Repository) SetIssue(ctx context.Context, params domainrepo.SetIssueParams) error {
+	if _, err := r.db.Exec(
+		ctx,
+		querySetIssue,
+		strings.TrimSpace(params.ID),
+		strings.TrimSpace(params.RepositoryFullName),
+		params.IssueNumber,
+		strings.TrimSpace(params.IssueURL),
+	); err != nil {
+		return fmt.Errorf("set alert incident issue: %w", err)
+	}
+	return nil
+}
+
+// SetLastRun stores the latest ops run started for incident.
+func (r *Repository) SetLastRun(ctx context.Context, incidentID string, runID string) error {
+	if _, err := r.db.Exec(ctx, querySetLastRun, strings.TrimSpace(incidentID), strings.TrimSpace(runID)); err != nil {
+		return fmt.Errorf("set alert incident last run: %w", err)
+	}
+	return nil
+}
+
+func jsonOrEmptyObject(value []byte) []byte {
+	if len(value) == 0 {
+		return []byte(`{}`)
+	}
+	return value
+}
@@ -0,0 +1,30 @@
+-- name: alertincident__mark_resolved :one
+UPDATE alert_incidents
+SET
+    status = 'resolved',
+    ends_at = $3,
+    last_received_at = $4,
+    updated_at = $4
+WHERE project_id = $1::uuid
+  AND fingerprint = $2
+  AND status = 'firing'
+RETURNING
+    id::text AS id,
+    project_id::text AS project_id,
+    fingerprint,
+    alert_name,
+    severity,
+    status,
+    labels,
+    annotations,
+    generator_url,
+    starts_at,
+    ends_at,
+    occurrences,
+    repository_full_name,
+    issue_number,
+    issue_url,
+    last_run_id::text AS last_run_id,
+    last_received_at,
+    created_at,
+    updated_at;
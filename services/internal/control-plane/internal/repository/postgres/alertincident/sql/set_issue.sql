@@ -0,0 +1,8 @@
+-- name: alertincident__set_issue :exec
+UPDATE alert_incidents
+SET
+    repository_full_name = $2,
+    issue_number = $3,
+    issue_url = $4,
+    updated_at = NOW()
+WHERE id = $1::uuid;
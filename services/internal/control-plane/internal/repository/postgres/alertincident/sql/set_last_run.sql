@@ -0,0 +1,6 @@
+-- name: alertincident__set_last_run :exec
+UPDATE alert_incidents
+SET
+    last_run_id = $2::uuid,
+    updated_at = NOW()
+WHERE id = $1::uuid;
@@ -0,0 +1,59 @@
+-- name: alertincident__upsert_firing :one
+WITH previous AS (
+    SELECT status
+    FROM alert_incidents
+    WHERE project_id = $1::uuid
+      AND fingerprint = $2
+)
+INSERT INTO alert_incidents (
+    project_id,
+    fingerprint,
+    alert_name,
+    severity,
+    status,
+    labels,
+    annotations,
+    generator_url,
+    starts_at,
+    last_received_at,
+    created_at,
+    updated_at
+)
+VALUES ($1::uuid, $2, $3, $4, 'firing', $5::jsonb, $6::jsonb, $7, $8, $9, $9, $9)
+ON CONFLICT (project_id, fingerprint) DO UPDATE
+SET
+    alert_name = EXCLUDED.alert_name,
+    severity = EXCLUDED.severity,
+    labels = EXCLUDED.labels,
+    annotations = EXCLUDED.annotations,
+    generator_url = EXCLUDED.generator_url,
+    starts_at = CASE
+        WHEN alert_incidents.status = 'resolved' THEN EXCLUDED.starts_at
+        ELSE alert_incidents.starts_at
+    END,
+    ends_at = NULL,
+    occurrences = alert_incidents.occurrences + CASE WHEN alert_incidents.status = 'resolved' THEN 1 ELSE 0 END,
+    status = 'firing',
+    last_received_at = EXCLUDED.last_received_at,
+    updated_at = EXCLUDED.updated_at
+RETURNING
+    id::text AS id,
+    project_id::text AS project_id,
+    fingerprint,
+    alert_name,
+    severity,
+    status,
+    labels,
+    annotations,
+    generator_url,
+    starts_at,
+    ends_at,
+    occurrences,
+    repository_full_name,
+    issue_number,
+    issue_url,
+    last_run_id::text AS last_run_id,
+    last_received_at,
+    created_at,
+    updated_at,
+    COALESCE((SELECT status FROM previous), '') AS previous_status;
@@ -25,6 +25,9 @@ import (
 //go:embed sql/get_package_by_id.sql
 var queryGetPackageByID string
 
+//go:embed sql/get_latest_package_by_pull_request.sql
+var queryGetLatestPackageByPullRequest string
+
 //go:embed sql/get_package_by_key_for_update.sql
 var queryGetPackageByKeyForUpdate string
 
@@ -343,6 +346,26 @@ func (r *Repository) GetAggregateByPackageID(ctx context.Context, packageID stri
 	return aggregate, true, nil
 }
 
+// GetLatestPackageByPullRequest returns the most recently updated package bound to one pull request.
+func (r *Repository) GetLatestPackageByPullRequest(ctx context.Context, repositoryFullName string, prNumber int) (domainrepo.Package, bool, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return domainrepo.Package{}, false, fmt.Errorf("begin get change-governance package by pull request tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	return queryOptionalDomainRow(
+		ctx,
+		tx,
+		queryGetLatestPackageByPullRequest,
+		"query change-governance package by pull request",
+		"collect change-governance package by pull request",
+		fromPackageRow,
+		strings.TrimSpace(repositoryFullName),
+		prNumber,
+	)
+}
+
 func queryOptionalDomainRow[DBRow any, DomainItem any](
 	ctx context.Context,
 	tx pgx.Tx,
@@ -1027,6 +1050,9 @@ func buildProjectionEvidenceBlocks(waves []domainrepo.Wave, items []domainrepo.E
 func buildProjectionDecisionSummaries(items []domainrepo.DecisionRecord) []projectionDecisionSummary {
 	result := make([]projectionDecisionSummary, 0, len(items))
 	for _, item := range items {
+		if item.State == enumtypes.ChangeGovernanceDecisionStateSuperseded {
+			continue
+		}
 		summary := projectionDecisionSummary{
 			DecisionKind: item.DecisionKind,
 			State:        item.State,
@@ -1102,6 +1128,18 @@ func derivePackageState(aggregate domainrepo.Aggregate, correlationID string, pr
 	derived.EvidenceCompletenessState = deriveEvidenceCompletenessState(activeEvidenceBlocks)
 	derived.VerificationMinimumState = deriveVerificationMinimumState(activeEvidenceBlocks)
 	derived.GovernanceFeedbackState = deriveFeedbackState(aggregate.FeedbackRecords)
+	derived.WaiverState = deriveWaiverState(aggregate.DecisionRecords, time.Now().UTC())
+
+	readiness, decided := deriveReleaseReadinessState(aggregate.DecisionRecords, aggregate.FeedbackRecords)
+	derived.ReleaseReadinessState = readiness
+	if len(activeWaveItems) > 0 {
+		switch {
+		case derived.GovernanceFeedbackState == enumtypes.ChangeGovernanceFeedbackStateOpen:
+			derived.PublicationState = enumtypes.ChangeGovernancePublicationStateFeedbackOpen
+		case decided:
+			derived.PublicationState = enumtypes.ChangeGovernancePublicationStateReleaseDecided
+		}
+	}
 	return derived
 }
 
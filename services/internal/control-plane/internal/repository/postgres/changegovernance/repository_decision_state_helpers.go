@@ -0,0 +1,88 @@
+package changegovernance
+
+import (
+	"encoding/json"
+	"time"
+
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/changegovernance"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+)
+
+// latestActiveDecision returns the newest non-superseded package-scoped decision of one kind.
+// Decision records are listed newest first.
+func latestActiveDecision(items []domainrepo.DecisionRecord, kind enumtypes.ChangeGovernanceDecisionKind) (domainrepo.DecisionRecord, bool) {
+	for _, item := range items {
+		if item.DecisionKind != kind || item.ScopeKind != enumtypes.ChangeGovernanceDecisionScopeKindPackage {
+			continue
+		}
+		if item.State == enumtypes.ChangeGovernanceDecisionStateSuperseded {
+			continue
+		}
+		return item, true
+	}
+	return domainrepo.DecisionRecord{}, false
+}
+
+func decodeDecisionPayload(raw json.RawMessage) querytypes.ChangeGovernanceDecisionPayload {
+	var payload querytypes.ChangeGovernanceDecisionPayload
+	if len(raw) == 0 {
+		return payload
+	}
+	_ = json.Unmarshal(raw, &payload)
+	return payload
+}
+
+func deriveWaiverState(items []domainrepo.DecisionRecord, now time.Time) enumtypes.ChangeGovernanceWaiverState {
+	decision, found := latestActiveDecision(items, enumtypes.ChangeGovernanceDecisionKindWaiver)
+	if !found {
+		return enumtypes.ChangeGovernanceWaiverStateNone
+	}
+	switch decision.State {
+	case enumtypes.ChangeGovernanceDecisionStateApproved:
+		payload := decodeDecisionPayload(decision.DecisionPayloadJSON)
+		if payload.ExpiresAt != nil && !payload.ExpiresAt.After(now) {
+			return enumtypes.ChangeGovernanceWaiverStateExpired
+		}
+		return enumtypes.ChangeGovernanceWaiverStateApproved
+	case enumtypes.ChangeGovernanceDecisionStateRejected:
+		return enumtypes.ChangeGovernanceWaiverStateRejected
+	default:
+		return enumtypes.ChangeGovernanceWaiverStateRequested
+	}
+}
+
+// deriveReleaseReadinessState combines the latest staff release decision with blocking feedback.
+// Open feedback that is critical or asks to block release always wins over recorded readiness.
+func deriveReleaseReadinessState(decisions []domainrepo.DecisionRecord, feedback []domainrepo.FeedbackRecord) (enumtypes.ChangeGovernanceReleaseReadinessState, bool) {
+	if hasBlockingFeedback(feedback) {
+		return enumtypes.ChangeGovernanceReleaseReadinessStateBlocked, false
+	}
+	decision, found := latestActiveDecision(decisions, enumtypes.ChangeGovernanceDecisionKindReleaseReadiness)
+	if !found || decision.State != enumtypes.ChangeGovernanceDecisionStateApproved {
+		return enumtypes.ChangeGovernanceReleaseReadinessStateNotReady, false
+	}
+	payload := decodeDecisionPayload(decision.DecisionPayloadJSON)
+	switch payload.ReleaseReadinessState {
+	case enumtypes.ChangeGovernanceReleaseReadinessStateConditionallyReady,
+		enumtypes.ChangeGovernanceReleaseReadinessStateReady,
+		enumtypes.ChangeGovernanceReleaseReadinessStateBlocked,
+		enumtypes.ChangeGovernanceReleaseReadinessStateReleased:
+		return payload.ReleaseReadinessState, true
+	default:
+		return enumtypes.ChangeGovernanceReleaseReadinessStateNotReady, true
+	}
+}
+
+func hasBlockingFeedback(items []domainrepo.FeedbackRecord) bool {
+	for _, item := range items {
+		if item.State != enumtypes.ChangeGovernanceFeedbackRecordStateOpen && item.State != enumtypes.ChangeGovernanceFeedbackRecordStateAcknowledged {
+			continue
+		}
+		if item.Severity == enumtypes.ChangeGovernanceFeedbackSeverityCritical ||
+			item.SuggestedAction == enumtypes.ChangeGovernanceFeedbackSuggestedActionBlockRelease {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,211 @@
+package changegovernance
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+	"time"
+
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	"github.com/codex-k8s/kodex/libs/go/errs"
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/changegovernance"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:embed sql/insert_decision_record.sql
+var queryInsertDecisionRecord string
+
+//go:embed sql/supersede_decision_records.sql
+var querySupersedeDecisionRecords string
+
+//go:embed sql/insert_feedback_record.sql
+var queryInsertFeedbackRecord string
+
+// RecordDecision persists one staff decision, supersedes older decisions of the same kind and refreshes package summary.
+func (r *Repository) RecordDecision(ctx context.Context, params querytypes.ChangeGovernanceDecisionParams) (domainrepo.Aggregate, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return domainrepo.Aggregate{}, fmt.Errorf("begin record change-governance decision tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	pkg, err := r.lockPackageInProjectScope(ctx, tx, params.PackageID, params.ExpectedProjectID)
+	if err != nil {
+		return domainrepo.Aggregate{}, err
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		queryInsertDecisionRecord,
+		pkg.ID,
+		string(params.ScopeKind),
+		strings.TrimSpace(params.ScopeRef),
+		strings.TrimSpace(params.DecisionID),
+		string(params.DecisionKind),
+		string(params.State),
+		string(params.ActorKind),
+		nullableText(string(params.ResidualRiskTier)),
+		strings.TrimSpace(params.SummaryMarkdown),
+		marshalJSONPayload(params.Payload),
+		timestamptzOrNow(params.OccurredAt),
+	)
+	if err != nil {
+		return domainrepo.Aggregate{}, fmt.Errorf("insert change-governance decision record: %w", err)
+	}
+	if tag.RowsAffected() > 0 {
+		// Idempotent replays are accepted as-is; only new decisions must target the current projection.
+		if pkg.ActiveProjectionVersion != params.ExpectedProjectionVersion {
+			return domainrepo.Aggregate{}, errs.Conflict{Msg: fmt.Sprintf(
+				"change governance package projection version is stale: expected %d, current %d",
+				params.ExpectedProjectionVersion,
+				pkg.ActiveProjectionVersion,
+			)}
+		}
+		if _, err := tx.Exec(
+			ctx,
+			querySupersedeDecisionRecords,
+			pkg.ID,
+			string(params.DecisionKind),
+			string(params.ScopeKind),
+			strings.TrimSpace(params.ScopeRef),
+			strings.TrimSpace(params.DecisionID),
+		); err != nil {
+			return domainrepo.Aggregate{}, fmt.Errorf("supersede change-governance decision records: %w", err)
+		}
+	}
+
+	aggregate, err := r.refreshPackageAfterStaffAction(ctx, tx, pkg, params.CorrelationID, params.OccurredAt)
+	if err != nil {
+		return domainrepo.Aggregate{}, err
+	}
+	if err := r.insertFlowEvent(ctx, tx, params.CorrelationID, floweventdomain.ActorIDControlPlane, floweventdomain.EventTypeQualityGovernanceDecisionRecorded, decisionRecordedEventPayload{
+		PackageID:             aggregate.Package.ID,
+		DecisionID:            strings.TrimSpace(params.DecisionID),
+		DecisionKind:          params.DecisionKind,
+		State:                 params.State,
+		ActorKind:             params.ActorKind,
+		ActorUserID:           strings.TrimSpace(params.Payload.ActorUserID),
+		Duplicate:             tag.RowsAffected() == 0,
+		WaiverState:           aggregate.Package.WaiverState,
+		ReleaseReadinessState: aggregate.Package.ReleaseReadinessState,
+		ProjectionVersion:     aggregate.Package.ActiveProjectionVersion,
+	}); err != nil {
+		return domainrepo.Aggregate{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domainrepo.Aggregate{}, fmt.Errorf("commit record change-governance decision tx: %w", err)
+	}
+	return aggregate, nil
+}
+
+// RecordFeedback persists one governance feedback gap and refreshes package summary.
+func (r *Repository) RecordFeedback(ctx context.Context, params querytypes.ChangeGovernanceFeedbackParams) (domainrepo.Aggregate, error) {
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return domainrepo.Aggregate{}, fmt.Errorf("begin open change-governance feedback tx: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	pkg, err := r.lockPackageInProjectScope(ctx, tx, params.PackageID, params.ExpectedProjectID)
+	if err != nil {
+		return domainrepo.Aggregate{}, err
+	}
+
+	tag, err := tx.Exec(
+		ctx,
+		queryInsertFeedbackRecord,
+		pkg.ID,
+		strings.TrimSpace(params.FeedbackID),
+		string(params.GapKind),
+		string(params.SourceKind),
+		string(params.Severity),
+		string(params.SuggestedAction),
+		strings.TrimSpace(params.SummaryMarkdown),
+		nullableText(strings.TrimSpace(params.RelatedArtifactRef)),
+		timestamptzOrNow(params.OccurredAt),
+	)
+	if err != nil {
+		return domainrepo.Aggregate{}, fmt.Errorf("insert change-governance feedback record: %w", err)
+	}
+
+	aggregate, err := r.refreshPackageAfterStaffAction(ctx, tx, pkg, params.CorrelationID, params.OccurredAt)
+	if err != nil {
+		return domainrepo.Aggregate{}, err
+	}
+	if err := r.insertFlowEvent(ctx, tx, params.CorrelationID, floweventdomain.ActorIDControlPlane, floweventdomain.EventTypeQualityGovernanceFeedbackOpened, feedbackOpenedEventPayload{
+		PackageID:             aggregate.Package.ID,
+		FeedbackID:            strings.TrimSpace(params.FeedbackID),
+		GapKind:               params.GapKind,
+		Severity:              params.Severity,
+		SuggestedAction:       params.SuggestedAction,
+		Duplicate:             tag.RowsAffected() == 0,
+		ReleaseReadinessState: aggregate.Package.ReleaseReadinessState,
+		ProjectionVersion:     aggregate.Package.ActiveProjectionVersion,
+	}); err != nil {
+		return domainrepo.Aggregate{}, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return domainrepo.Aggregate{}, fmt.Errorf("commit open change-governance feedback tx: %w", err)
+	}
+	return aggregate, nil
+}
+
+func (r *Repository) lockPackageInProjectScope(ctx context.Context, tx pgx.Tx, packageID string, expectedProjectID string) (domainrepo.Package, error) {
+	pkg, found, err := r.getPackageByIDForUpdate(ctx, tx, packageID)
+	if err != nil {
+		return domainrepo.Package{}, err
+	}
+	if !found {
+		return domainrepo.Package{}, errs.NotFound{Msg: fmt.Sprintf("change governance package %q not found", packageID)}
+	}
+	if strings.TrimSpace(expectedProjectID) != "" && pkg.ProjectID != strings.TrimSpace(expectedProjectID) {
+		return domainrepo.Package{}, errs.Forbidden{Msg: "change governance package is outside authenticated project scope"}
+	}
+	return pkg, nil
+}
+
+func (r *Repository) refreshPackageAfterStaffAction(ctx context.Context, tx pgx.Tx, pkg domainrepo.Package, correlationID string, occurredAt time.Time) (domainrepo.Aggregate, error) {
+	aggregate, err := r.buildAggregate(ctx, tx, pkg.ID)
+	if err != nil {
+		return domainrepo.Aggregate{}, err
+	}
+	derived := derivePackageState(aggregate, correlationID, pkg.PRNumber)
+	updated, err := r.updatePackageSummary(ctx, tx, aggregate.Package, derived, occurredAt)
+	if err != nil {
+		return domainrepo.Aggregate{}, err
+	}
+	aggregate.Package = updated
+	if err := r.refreshCurrentProjections(ctx, tx, aggregate); err != nil {
+		return domainrepo.Aggregate{}, err
+	}
+	return aggregate, nil
+}
+
+type decisionRecordedEventPayload struct {
+	PackageID             string                                          `json:"package_id"`
+	DecisionID            string                                          `json:"decision_id"`
+	DecisionKind          enumtypes.ChangeGovernanceDecisionKind          `json:"decision_kind"`
+	State                 enumtypes.ChangeGovernanceDecisionState         `json:"state"`
+	ActorKind             enumtypes.ChangeGovernanceDecisionActorKind     `json:"actor_kind"`
+	ActorUserID           string                                          `json:"actor_user_id,omitempty"`
+	Duplicate             bool                                            `json:"duplicate,omitempty"`
+	WaiverState           enumtypes.ChangeGovernanceWaiverState           `json:"waiver_state"`
+	ReleaseReadinessState enumtypes.ChangeGovernanceReleaseReadinessState `json:"release_readiness_state"`
+	ProjectionVersion     int64                                           `json:"projection_version"`
+}
+
+type feedbackOpenedEventPayload struct {
+	PackageID             string                                            `json:"package_id"`
+	FeedbackID            string                                            `json:"feedback_id"`
+	GapKind               enumtypes.ChangeGovernanceFeedbackGapKind         `json:"gap_kind"`
+	Severity              enumtypes.ChangeGovernanceFeedbackSeverity        `json:"severity"`
+	SuggestedAction       enumtypes.ChangeGovernanceFeedbackSuggestedAction `json:"suggested_action"`
+	Duplicate             bool                                              `json:"duplicate,omitempty"`
+	ReleaseReadinessState enumtypes.ChangeGovernanceReleaseReadinessState   `json:"release_readiness_state"`
+	ProjectionVersion     int64                                             `json:"projection_version"`
+}
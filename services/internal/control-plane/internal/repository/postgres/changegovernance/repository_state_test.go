@@ -1,8 +1,10 @@
 package changegovernance
 
 import (
+	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
 	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/changegovernance"
 	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
@@ -136,3 +138,90 @@ func TestInsertDraftIfAbsentQueryClearsLatestAtomically(t *testing.T) {
 		}
 	}
 }
+
+func TestDerivePackageState_UsesLatestStaffDecisions(t *testing.T) {
+	t.Parallel()
+
+	expiredAt := time.Now().UTC().Add(-time.Hour)
+	aggregate := domainrepo.Aggregate{
+		Package: domainrepo.Package{ID: "pkg-1"},
+		Waves: []domainrepo.Wave{
+			{
+				ID:               "wave-1",
+				WaveKey:          "docs",
+				PublishOrder:     1,
+				DominantIntent:   enumtypes.ChangeGovernanceDominantIntentDocsOnly,
+				BoundedScopeKind: enumtypes.ChangeGovernanceBoundedScopeKindMechanicalBoundedScope,
+				PublicationState: enumtypes.ChangeGovernanceWavePublicationStatePublished,
+			},
+		},
+		DecisionRecords: []domainrepo.DecisionRecord{
+			{
+				DecisionID:          "release-2",
+				ScopeKind:           enumtypes.ChangeGovernanceDecisionScopeKindPackage,
+				DecisionKind:        enumtypes.ChangeGovernanceDecisionKindReleaseReadiness,
+				State:               enumtypes.ChangeGovernanceDecisionStateApproved,
+				DecisionPayloadJSON: json.RawMessage(`{"release_readiness_state":"ready"}`),
+			},
+			{
+				DecisionID:          "waiver-1",
+				ScopeKind:           enumtypes.ChangeGovernanceDecisionScopeKindPackage,
+				DecisionKind:        enumtypes.ChangeGovernanceDecisionKindWaiver,
+				State:               enumtypes.ChangeGovernanceDecisionStateApproved,
+				ResidualRiskTier:    enumtypes.ChangeGovernanceRiskTierMedium,
+				DecisionPayloadJSON: json.RawMessage(`{"expires_at":"` + expiredAt.Format(time.RFC3339) + `"}`),
+			},
+			{
+				DecisionID:          "release-1",
+				ScopeKind:           enumtypes.ChangeGovernanceDecisionScopeKindPackage,
+				DecisionKind:        enumtypes.ChangeGovernanceDecisionKindReleaseReadiness,
+				State:               enumtypes.ChangeGovernanceDecisionStateSuperseded,
+				DecisionPayloadJSON: json.RawMessage(`{"release_readiness_state":"blocked"}`),
+			},
+		},
+	}
+
+	state := derivePackageState(aggregate, "corr-1", nil)
+	if got, want := state.ReleaseReadinessState, enumtypes.ChangeGovernanceReleaseReadinessStateReady; got != want {
+		t.Fatalf("release readiness = %q, want %q", got, want)
+	}
+	if got, want := state.WaiverState, enumtypes.ChangeGovernanceWaiverStateExpired; got != want {
+		t.Fatalf("waiver state = %q, want %q", got, want)
+	}
+	if got, want := state.PublicationState, enumtypes.ChangeGovernancePublicationStateReleaseDecided; got != want {
+		t.Fatalf("publication state = %q, want %q", got, want)
+	}
+}
+
+func TestDerivePackageState_BlockingFeedbackOverridesReadiness(t *testing.T) {
+	t.Parallel()
+
+	aggregate := domainrepo.Aggregate{
+		Package: domainrepo.Package{ID: "pkg-1"},
+		DecisionRecords: []domainrepo.DecisionRecord{
+			{
+				DecisionID:          "release-1",
+				ScopeKind:           enumtypes.ChangeGovernanceDecisionScopeKindPackage,
+				DecisionKind:        enumtypes.ChangeGovernanceDecisionKindReleaseReadiness,
+				State:               enumtypes.ChangeGovernanceDecisionStateApproved,
+				DecisionPayloadJSON: json.RawMessage(`{"release_readiness_state":"ready"}`),
+			},
+		},
+		FeedbackRecords: []domainrepo.FeedbackRecord{
+			{
+				FeedbackID:      "gap-1",
+				Severity:        enumtypes.ChangeGovernanceFeedbackSeverityHigh,
+				State:           enumtypes.ChangeGovernanceFeedbackRecordStateOpen,
+				SuggestedAction: enumtypes.ChangeGovernanceFeedbackSuggestedActionBlockRelease,
+			},
+		},
+	}
+
+	state := derivePackageState(aggregate, "corr-1", nil)
+	if got, want := state.ReleaseReadinessState, enumtypes.ChangeGovernanceReleaseReadinessStateBlocked; got != want {
+		t.Fatalf("release readiness = %q, want %q", got, want)
+	}
+	if got, want := state.WaiverState, enumtypes.ChangeGovernanceWaiverStateNone; got != want {
+		t.Fatalf("waiver state = %q, want %q", got, want)
+	}
+}
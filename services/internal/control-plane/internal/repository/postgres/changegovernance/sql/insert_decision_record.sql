@@ -0,0 +1,28 @@
+-- name: changegovernance__insert_decision_record :exec
+INSERT INTO change_governance_decision_records (
+    package_id,
+    scope_kind,
+    scope_ref,
+    decision_id,
+    decision_kind,
+    state,
+    actor_kind,
+    residual_risk_tier,
+    summary_markdown,
+    decision_payload_json,
+    recorded_at
+)
+VALUES (
+    $1::uuid,
+    $2,
+    $3,
+    $4,
+    $5,
+    $6,
+    $7,
+    $8,
+    $9,
+    $10::jsonb,
+    COALESCE($11::timestamptz, NOW())
+)
+ON CONFLICT (decision_id) DO NOTHING;
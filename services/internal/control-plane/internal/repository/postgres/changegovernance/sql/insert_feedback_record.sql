@@ -0,0 +1,26 @@
+-- name: changegovernance__insert_feedback_record :exec
+INSERT INTO change_governance_feedback_records (
+    package_id,
+    feedback_id,
+    gap_kind,
+    source_kind,
+    severity,
+    state,
+    suggested_action,
+    summary_markdown,
+    related_artifact_ref,
+    opened_at
+)
+VALUES (
+    $1::uuid,
+    $2,
+    $3,
+    $4,
+    $5,
+    'open',
+    $6,
+    $7,
+    $8,
+    COALESCE($9::timestamptz, NOW())
+)
+ON CONFLICT (feedback_id) DO NOTHING;
@@ -0,0 +1,9 @@
+-- name: changegovernance__supersede_decision_records :exec
+UPDATE change_governance_decision_records
+SET state = 'superseded'
+WHERE package_id = $1::uuid
+  AND decision_kind = $2
+  AND scope_kind = $3
+  AND scope_ref = $4
+  AND decision_id <> $5
+  AND state <> 'superseded';
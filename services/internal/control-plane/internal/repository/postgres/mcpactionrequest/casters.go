@@ -1,6 +1,8 @@
 package mcpactionrequest
 
 import (
+	"encoding/json"
+
 	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/mcpactionrequest"
 	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
 	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/mcpactionrequest/dbmodel"
@@ -44,5 +46,30 @@ func fromDBModel(row dbmodel.ActionRequestRow) domainrepo.Item {
 	if row.AppliedBy.Valid {
 		item.AppliedBy = row.AppliedBy.String
 	}
+	if len(row.ApprovalPolicy) > 0 {
+		var policy entitytypes.MCPApprovalPolicySnapshot
+		if err := json.Unmarshal(row.ApprovalPolicy, &policy); err == nil {
+			item.Policy = &policy
+		}
+	}
+	if len(row.ApprovalVotes) > 0 {
+		_ = json.Unmarshal(row.ApprovalVotes, &item.Votes)
+	}
+	if row.ExpiresAt.Valid {
+		expiresAt := row.ExpiresAt.Time.UTC()
+		item.ExpiresAt = &expiresAt
+	}
+	if row.RunSuspendedAt.Valid {
+		suspendedAt := row.RunSuspendedAt.Time.UTC()
+		item.RunSuspendedAt = &suspendedAt
+	}
 	return item
 }
+
+func fromDBModels(rows []dbmodel.ActionRequestRow) []domainrepo.Item {
+	out := make([]domainrepo.Item, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, fromDBModel(row))
+	}
+	return out
+}
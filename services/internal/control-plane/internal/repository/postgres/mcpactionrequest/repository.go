@@ -3,10 +3,13 @@ package mcpactionrequest
 import (
 	"context"
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/mcpactionrequest"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
 	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/mcpactionrequest/dbmodel"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -25,6 +28,12 @@ var (
 	queryListPending string
 	//go:embed sql/update_state.sql
 	queryUpdateState string
+	//go:embed sql/append_vote.sql
+	queryAppendVote string
+	//go:embed sql/list_expired.sql
+	queryListExpired string
+	//go:embed sql/mark_run_suspended.sql
+	queryMarkRunSuspended string
 )
 
 // Repository persists mcp_action_requests in PostgreSQL.
@@ -41,6 +50,10 @@ func NewRepository(db *pgxpool.Pool) *Repository {
 func (r *Repository) Create(ctx context.Context, params domainrepo.CreateParams) (domainrepo.Item, error) {
 	targetRef := jsonOrEmptyObject(params.TargetRef)
 	payload := jsonOrEmptyObject(params.Payload)
+	policy, err := marshalPolicy(params.Policy)
+	if err != nil {
+		return domainrepo.Item{}, err
+	}
 
 	var id int64
 	if err := r.db.QueryRow(
@@ -56,6 +69,8 @@ func (r *Repository) Create(ctx context.Context, params domainrepo.CreateParams)
 		params.RequestedBy,
 		nullableText(params.AppliedBy),
 		payload,
+		policy,
+		params.ExpiresAt,
 	).Scan(&id); err != nil {
 		return domainrepo.Item{}, fmt.Errorf("create mcp action request: %w", err)
 	}
@@ -130,11 +145,23 @@ func (r *Repository) ListPending(ctx context.Context, limit int) ([]domainrepo.I
 	if err != nil {
 		return nil, fmt.Errorf("collect pending mcp action requests: %w", err)
 	}
-	out := make([]domainrepo.Item, 0, len(items))
-	for _, item := range items {
-		out = append(out, fromDBModel(item))
+	return fromDBModels(items), nil
+}
+
+// ListExpired returns requested actions whose policy expiry is not later than now.
+func (r *Repository) ListExpired(ctx context.Context, now time.Time, limit int) ([]domainrepo.Item, error) {
+	if limit <= 0 {
+		limit = 100
+	}
+	rows, err := r.db.Query(ctx, queryListExpired, now.UTC(), limit)
+	if err != nil {
+		return nil, fmt.Errorf("query expired mcp action requests: %w", err)
 	}
-	return out, nil
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.ActionRequestRow])
+	if err != nil {
+		return nil, fmt.Errorf("collect expired mcp action requests: %w", err)
+	}
+	return fromDBModels(items), nil
 }
 
 // UpdateState updates approval state and returns updated row.
@@ -147,6 +174,7 @@ func (r *Repository) UpdateState(ctx context.Context, params domainrepo.UpdateSt
 		string(params.ApprovalState),
 		nullableText(params.AppliedBy),
 		jsonOrEmptyObject(params.Payload),
+		string(params.FromState),
 	).Scan(&id)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -165,6 +193,50 @@ func (r *Repository) UpdateState(ctx context.Context, params domainrepo.UpdateSt
 	return item, true, nil
 }
 
+// AppendVote atomically records one vote on a requested action.
+func (r *Repository) AppendVote(ctx context.Context, params domainrepo.AppendVoteParams) (domainrepo.Item, bool, error) {
+	vote, err := json.Marshal(params.Vote)
+	if err != nil {
+		return domainrepo.Item{}, false, fmt.Errorf("marshal mcp approval vote: %w", err)
+	}
+	var id int64
+	err = r.db.QueryRow(ctx, queryAppendVote, params.ID, vote, params.Vote.UserID).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainrepo.Item{}, false, nil
+		}
+		return domainrepo.Item{}, false, fmt.Errorf("append mcp approval vote: %w", err)
+	}
+	return r.GetByID(ctx, id)
+}
+
+// MarkRunSuspended stamps the latest open or unseen resolved approval request of a run as awaiting a resume run.
+func (r *Repository) MarkRunSuspended(ctx context.Context, runID string, suspendedAt time.Time) (domainrepo.Item, bool, error) {
+	if runID == "" {
+		return domainrepo.Item{}, false, nil
+	}
+	var id int64
+	err := r.db.QueryRow(ctx, queryMarkRunSuspended, runID, suspendedAt.UTC()).Scan(&id)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainrepo.Item{}, false, nil
+		}
+		return domainrepo.Item{}, false, fmt.Errorf("mark mcp action request run suspended: %w", err)
+	}
+	return r.GetByID(ctx, id)
+}
+
+func marshalPolicy(policy *entitytypes.MCPApprovalPolicySnapshot) ([]byte, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	raw, err := json.Marshal(policy)
+	if err != nil {
+		return nil, fmt.Errorf("marshal mcp approval policy snapshot: %w", err)
+	}
+	return raw, nil
+}
+
 func nullableUUID(value string) any {
 	if value == "" {
 		return nil
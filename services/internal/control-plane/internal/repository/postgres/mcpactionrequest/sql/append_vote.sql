@@ -0,0 +1,9 @@
+-- name: mcpactionrequest__append_vote :one
+UPDATE mcp_action_requests
+SET
+    approval_votes = approval_votes || jsonb_build_array($2::jsonb),
+    updated_at = NOW()
+WHERE id = $1
+  AND approval_state = 'requested'
+  AND NOT approval_votes @> jsonb_build_array(jsonb_build_object('user_id', $3::text))
+RETURNING id;
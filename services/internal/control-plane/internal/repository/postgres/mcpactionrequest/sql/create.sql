@@ -9,7 +9,9 @@ INSERT INTO mcp_action_requests (
     approval_state,
     requested_by,
     applied_by,
-    payload
+    payload,
+    approval_policy,
+    expires_at
 )
-VALUES ($1, $2, $3, $4, $5::jsonb, $6, $7, $8, $9, $10::jsonb)
+VALUES ($1, $2, $3, $4, $5::jsonb, $6, $7, $8, $9, $10::jsonb, $11::jsonb, $12)
 RETURNING id;
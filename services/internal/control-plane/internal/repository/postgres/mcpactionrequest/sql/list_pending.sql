@@ -25,6 +25,10 @@ SELECT
     mar.requested_by,
     COALESCE(mar.applied_by, '') AS applied_by,
     mar.payload,
+    mar.approval_policy,
+    mar.approval_votes,
+    mar.expires_at,
+    mar.run_suspended_at,
     mar.created_at,
     mar.updated_at
 FROM mcp_action_requests mar
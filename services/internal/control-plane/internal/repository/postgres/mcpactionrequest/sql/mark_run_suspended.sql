@@ -0,0 +1,23 @@
+-- name: mcpactionrequest__mark_run_suspended :one
+-- Final approval-gated requests are matched too while they are not stamped yet:
+-- a decision may land before agent-runner reports suspension, and its outcome still needs a resume run.
+UPDATE mcp_action_requests
+SET
+    run_suspended_at = $2,
+    updated_at = NOW()
+WHERE id = (
+    SELECT id
+    FROM mcp_action_requests
+    WHERE run_id = $1::uuid
+      AND (
+          approval_state IN ('requested', 'approved')
+          OR (
+              run_suspended_at IS NULL
+              AND approval_mode <> 'none'
+              AND approval_state IN ('applied', 'denied', 'expired', 'failed')
+          )
+      )
+    ORDER BY created_at DESC, id DESC
+    LIMIT 1
+)
+RETURNING id;
@@ -9,4 +9,5 @@ SET
     END,
     updated_at = NOW()
 WHERE id = $1
+  AND ($5::text = '' OR approval_state = $5::text)
 RETURNING id;
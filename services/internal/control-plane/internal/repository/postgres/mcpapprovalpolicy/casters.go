@@ -0,0 +1,22 @@
+package mcpapprovalpolicy
+
+import (
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/mcpapprovalpolicy"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/mcpapprovalpolicy/dbmodel"
+)
+
+func fromDBModel(row dbmodel.MCPApprovalPolicyRow) domainrepo.Policy {
+	return domainrepo.Policy{
+		ID:                  row.ID,
+		ProjectID:           row.ProjectID,
+		ToolName:            row.ToolName,
+		Environment:         row.Environment,
+		RequiredApprovals:   int(row.RequiredApprovals),
+		EligibleRoles:       row.EligibleRoles,
+		EligibleUserIDs:     row.EligibleUserIDs,
+		SeparationOfDuties:  row.SeparationOfDuties,
+		ExpiresAfterSeconds: int(row.ExpiresAfterSeconds),
+		CreatedAt:           row.CreatedAt,
+		UpdatedAt:           row.UpdatedAt,
+	}
+}
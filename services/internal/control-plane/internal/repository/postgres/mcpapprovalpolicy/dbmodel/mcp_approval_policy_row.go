@@ -0,0 +1,18 @@
+package dbmodel
+
+import "time"
+
+// MCPApprovalPolicyRow mirrors one mcp_approval_policies row selected from PostgreSQL.
+type MCPApprovalPolicyRow struct {
+	ID                  string    `db:"id"`
+	ProjectID           string    `db:"project_id"`
+	ToolName            string    `db:"tool_name"`
+	Environment         string    `db:"environment"`
+	RequiredApprovals   int32     `db:"required_approvals"`
+	EligibleRoles       []string  `db:"eligible_roles"`
+	EligibleUserIDs     []string  `db:"eligible_user_ids"`
+	SeparationOfDuties  bool      `db:"separation_of_duties"`
+	ExpiresAfterSeconds int32     `db:"expires_after_seconds"`
+	CreatedAt           time.Time `db:"created_at"`
+	UpdatedAt           time.Time `db:"updated_at"`
+}
@@ -0,0 +1,108 @@
+package mcpapprovalpolicy
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/mcpapprovalpolicy"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/mcpapprovalpolicy/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	//go:embed sql/upsert.sql
+	queryUpsert string
+	//go:embed sql/list.sql
+	queryList string
+	//go:embed sql/match.sql
+	queryMatch string
+	//go:embed sql/delete.sql
+	queryDelete string
+)
+
+// Repository stores MCP approval policies in PostgreSQL.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+// NewRepository constructs PostgreSQL MCP approval policy repository.
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// Upsert creates policy or replaces rules for the same project, tool and environment.
+func (r *Repository) Upsert(ctx context.Context, params domainrepo.UpsertParams) (domainrepo.Policy, error) {
+	rows, err := r.db.Query(
+		ctx,
+		queryUpsert,
+		strings.TrimSpace(params.ProjectID),
+		strings.TrimSpace(params.ToolName),
+		strings.TrimSpace(params.Environment),
+		params.RequiredApprovals,
+		nonNilStrings(params.EligibleRoles),
+		nonNilStrings(params.EligibleUserIDs),
+		params.SeparationOfDuties,
+		params.ExpiresAfterSeconds,
+	)
+	if err != nil {
+		return domainrepo.Policy{}, fmt.Errorf("upsert mcp approval policy: %w", err)
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.MCPApprovalPolicyRow])
+	if err != nil {
+		return domainrepo.Policy{}, fmt.Errorf("collect upserted mcp approval policy: %w", err)
+	}
+	return fromDBModel(row), nil
+}
+
+// List returns project policies ordered by tool and environment.
+func (r *Repository) List(ctx context.Context, projectID string) ([]domainrepo.Policy, error) {
+	rows, err := r.db.Query(ctx, queryList, strings.TrimSpace(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("list mcp approval policies: %w", err)
+	}
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.MCPApprovalPolicyRow])
+	if err != nil {
+		return nil, fmt.Errorf("collect mcp approval policies: %w", err)
+	}
+	out := make([]domainrepo.Policy, 0, len(items))
+	for _, item := range items {
+		out = append(out, fromDBModel(item))
+	}
+	return out, nil
+}
+
+// Match returns environment-specific policy first and falls back to the environment-agnostic one.
+func (r *Repository) Match(ctx context.Context, projectID string, toolName string, environment string) (domainrepo.Policy, bool, error) {
+	rows, err := r.db.Query(ctx, queryMatch, strings.TrimSpace(projectID), strings.TrimSpace(toolName), strings.TrimSpace(environment))
+	if err != nil {
+		return domainrepo.Policy{}, false, fmt.Errorf("match mcp approval policy: %w", err)
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.MCPApprovalPolicyRow])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainrepo.Policy{}, false, nil
+		}
+		return domainrepo.Policy{}, false, fmt.Errorf("collect matched mcp approval policy: %w", err)
+	}
+	return fromDBModel(row), true, nil
+}
+
+// Delete removes one project policy.
+func (r *Repository) Delete(ctx context.Context, projectID string, id string) (bool, error) {
+	res, err := r.db.Exec(ctx, queryDelete, strings.TrimSpace(projectID), strings.TrimSpace(id))
+	if err != nil {
+		return false, fmt.Errorf("delete mcp approval policy: %w", err)
+	}
+	return res.RowsAffected() > 0, nil
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,4 @@
+-- name: mcpapprovalpolicy__delete :execrows
+DELETE FROM mcp_approval_policies
+WHERE project_id = $1::uuid
+  AND id = $2::uuid;
@@ -0,0 +1,16 @@
+-- name: mcpapprovalpolicy__list :many
+SELECT
+    id::text AS id,
+    project_id::text AS project_id,
+    tool_name,
+    environment,
+    required_approvals,
+    eligible_roles,
+    eligible_user_ids::text[] AS eligible_user_ids,
+    separation_of_duties,
+    expires_after_seconds,
+    created_at,
+    updated_at
+FROM mcp_approval_policies
+WHERE project_id = $1::uuid
+ORDER BY tool_name, environment;
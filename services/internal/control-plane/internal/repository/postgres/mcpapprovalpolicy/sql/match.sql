@@ -0,0 +1,19 @@
+-- name: mcpapprovalpolicy__match :one
+SELECT
+    id::text AS id,
+    project_id::text AS project_id,
+    tool_name,
+    environment,
+    required_approvals,
+    eligible_roles,
+    eligible_user_ids::text[] AS eligible_user_ids,
+    separation_of_duties,
+    expires_after_seconds,
+    created_at,
+    updated_at
+FROM mcp_approval_policies
+WHERE project_id = $1::uuid
+  AND tool_name = $2
+  AND environment IN ($3, '')
+ORDER BY environment DESC
+LIMIT 1;
@@ -0,0 +1,39 @@
+-- name: mcpapprovalpolicy__upsert :one
+INSERT INTO mcp_approval_policies (
+    project_id,
+    tool_name,
+    environment,
+    required_approvals,
+    eligible_roles,
+    eligible_user_ids,
+    separation_of_duties,
+    expires_after_seconds
+) VALUES (
+    $1::uuid,
+    $2,
+    $3,
+    $4,
+    $5::text[],
+    $6::uuid[],
+    $7,
+    $8
+)
+ON CONFLICT (project_id, tool_name, environment) DO UPDATE
+SET required_approvals = EXCLUDED.required_approvals,
+    eligible_roles = EXCLUDED.eligible_roles,
+    eligible_user_ids = EXCLUDED.eligible_user_ids,
+    separation_of_duties = EXCLUDED.separation_of_duties,
+    expires_after_seconds = EXCLUDED.expires_after_seconds,
+    updated_at = NOW()
+RETURNING
+    id::text AS id,
+    project_id::text AS project_id,
+    tool_name,
+    environment,
+    required_approvals,
+    eligible_roles,
+    eligible_user_ids::text[] AS eligible_user_ids,
+    separation_of_duties,
+    expires_after_seconds,
+    created_at,
+    updated_at;
@@ -202,3 +202,31 @@ func fromWarmupSummaryRow(row dbmodel.WarmupSummaryRow) valuetypes.MissionContro
 		WatermarkCount:               row.WatermarkCount,
 	}
 }
+
+func fromVoiceCandidateRow(row dbmodel.VoiceCandidateRow) domainrepo.VoiceCandidate {
+	item := domainrepo.VoiceCandidate{
+		ID:                row.ID,
+		ProjectID:         row.ProjectID,
+		SourceKind:        enumtypes.MissionControlVoiceCandidateSourceKind(row.SourceKind),
+		Status:            enumtypes.MissionControlVoiceCandidateStatus(row.Status),
+		DedupeKey:         row.DedupeKey,
+		CommandKind:       enumtypes.MissionControlCommandKind(row.CommandKind),
+		TranscriptExcerpt: row.TranscriptExcerpt,
+		StructuredSummary: row.StructuredSummary,
+		PayloadJSON:       json.RawMessage(row.PayloadJSON),
+		CreatedBy:         row.CreatedBy,
+		CreatedAt:         row.CreatedAt,
+		UpdatedAt:         row.UpdatedAt,
+	}
+	if row.PromotedCommandID.Valid {
+		item.PromotedCommandID = row.PromotedCommandID.String
+	}
+	if row.DecidedBy.Valid {
+		item.DecidedBy = row.DecidedBy.String
+	}
+	if row.DecidedAt.Valid {
+		value := row.DecidedAt.Time
+		item.DecidedAt = &value
+	}
+	return item
+}
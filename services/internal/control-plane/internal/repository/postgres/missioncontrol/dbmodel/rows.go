@@ -133,3 +133,22 @@ type WarmupSummaryRow struct {
 	MissingFollowUpIssueGapCount int64  `db:"missing_follow_up_issue_gap_count"`
 	WatermarkCount               int64  `db:"watermark_count"`
 }
+
+// VoiceCandidateRow mirrors one mission_control_voice_candidates row.
+type VoiceCandidateRow struct {
+	ID                string             `db:"id"`
+	ProjectID         string             `db:"project_id"`
+	SourceKind        string             `db:"source_kind"`
+	Status            string             `db:"status"`
+	DedupeKey         string             `db:"dedupe_key"`
+	CommandKind       string             `db:"command_kind"`
+	TranscriptExcerpt string             `db:"transcript_excerpt"`
+	StructuredSummary string             `db:"structured_summary"`
+	PayloadJSON       []byte             `db:"payload_json"`
+	PromotedCommandID pgtype.Text        `db:"promoted_command_id"`
+	CreatedBy         string             `db:"created_by"`
+	DecidedBy         pgtype.Text        `db:"decided_by"`
+	DecidedAt         pgtype.Timestamptz `db:"decided_at"`
+	CreatedAt         time.Time          `db:"created_at"`
+	UpdatedAt         time.Time          `db:"updated_at"`
+}
@@ -37,6 +37,23 @@ func TestMissionControlReadQueriesStayProjectScoped(t *testing.T) {
 	if !strings.Contains(queryListLatestWorkspaceWatermarks, "WHERE project_id = $1::uuid") {
 		t.Fatal("list_latest_workspace_watermarks query must scope lookups by project_id")
 	}
+	if !strings.Contains(queryGetVoiceCandidateByID, "WHERE project_id = $1") {
+		t.Fatal("get_voice_candidate_by_id query must scope lookups by project_id")
+	}
+	if !strings.Contains(queryListVoiceCandidates, "WHERE project_id = $1") {
+		t.Fatal("list_voice_candidates query must scope lookups by project_id")
+	}
+}
+
+func TestVoiceCandidateQueriesKeepDedupeAndDraftGuard(t *testing.T) {
+	t.Parallel()
+
+	if !strings.Contains(queryInsertVoiceCandidate, "ON CONFLICT (project_id, dedupe_key) DO NOTHING") {
+		t.Fatal("insert_voice_candidate query must deduplicate by project_id and dedupe_key")
+	}
+	if !strings.Contains(queryUpdateVoiceCandidateDecision, "AND status = 'draft'") {
+		t.Fatal("update_voice_candidate_decision query must only move draft candidates")
+	}
 }
 
 func TestUpdateCommandStatusQueryUsesPatchSemantics(t *testing.T) {
@@ -0,0 +1,131 @@
+package missioncontrol
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/missioncontrol"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/missioncontrol/dbmodel"
+	"github.com/jackc/pgx/v5"
+)
+
+//go:embed sql/insert_voice_candidate.sql
+var queryInsertVoiceCandidate string
+
+//go:embed sql/get_voice_candidate_by_id.sql
+var queryGetVoiceCandidateByID string
+
+//go:embed sql/get_voice_candidate_by_dedupe_key.sql
+var queryGetVoiceCandidateByDedupeKey string
+
+//go:embed sql/list_voice_candidates.sql
+var queryListVoiceCandidates string
+
+//go:embed sql/update_voice_candidate_decision.sql
+var queryUpdateVoiceCandidateDecision string
+
+// CreateVoiceCandidate inserts one voice candidate or returns the existing row for the same dedupe key.
+func (r *Repository) CreateVoiceCandidate(ctx context.Context, params domainrepo.CreateVoiceCandidateParams) (domainrepo.VoiceCandidate, bool, error) {
+	projectID := strings.TrimSpace(params.ProjectID)
+	dedupeKey := strings.TrimSpace(params.DedupeKey)
+	rows, err := r.db.Query(
+		ctx,
+		queryInsertVoiceCandidate,
+		projectID,
+		string(params.SourceKind),
+		dedupeKey,
+		string(params.CommandKind),
+		params.TranscriptExcerpt,
+		params.StructuredSummary,
+		jsonOrEmptyObject(params.PayloadJSON),
+		strings.TrimSpace(params.CreatedBy),
+		timestamptzOrNil(params.CreatedAt),
+	)
+	if err != nil {
+		return domainrepo.VoiceCandidate{}, false, fmt.Errorf("insert mission control voice candidate: %w", err)
+	}
+	item, created, err := collectOptionalVoiceCandidate(rows, "collect mission control voice candidate insert")
+	if err != nil || created {
+		return item, created, err
+	}
+
+	rows, err = r.db.Query(ctx, queryGetVoiceCandidateByDedupeKey, projectID, dedupeKey)
+	if err != nil {
+		return domainrepo.VoiceCandidate{}, false, fmt.Errorf("query mission control voice candidate by dedupe key: %w", err)
+	}
+	item, found, err := collectOptionalVoiceCandidate(rows, "collect mission control voice candidate by dedupe key")
+	if err != nil {
+		return domainrepo.VoiceCandidate{}, false, err
+	}
+	if !found {
+		return domainrepo.VoiceCandidate{}, false, fmt.Errorf("mission control voice candidate %q vanished after dedupe conflict", dedupeKey)
+	}
+	return item, false, nil
+}
+
+// GetVoiceCandidate loads one voice candidate scoped to one project.
+func (r *Repository) GetVoiceCandidate(ctx context.Context, projectID string, candidateID string) (domainrepo.VoiceCandidate, bool, error) {
+	rows, err := r.db.Query(ctx, queryGetVoiceCandidateByID, strings.TrimSpace(projectID), strings.TrimSpace(candidateID))
+	if err != nil {
+		return domainrepo.VoiceCandidate{}, false, fmt.Errorf("query mission control voice candidate by id: %w", err)
+	}
+	return collectOptionalVoiceCandidate(rows, "collect mission control voice candidate by id")
+}
+
+// ListVoiceCandidates returns voice candidates for one project ordered newest first.
+func (r *Repository) ListVoiceCandidates(ctx context.Context, filter domainrepo.VoiceCandidateListFilter) ([]domainrepo.VoiceCandidate, error) {
+	rows, err := r.db.Query(
+		ctx,
+		queryListVoiceCandidates,
+		strings.TrimSpace(filter.ProjectID),
+		enumStrings(filter.Statuses),
+		normalizeLimit(filter.Limit),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list mission control voice candidates: %w", err)
+	}
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.VoiceCandidateRow])
+	if err != nil {
+		return nil, fmt.Errorf("collect mission control voice candidates: %w", err)
+	}
+	out := make([]domainrepo.VoiceCandidate, 0, len(items))
+	for _, item := range items {
+		out = append(out, fromVoiceCandidateRow(item))
+	}
+	return out, nil
+}
+
+// UpdateVoiceCandidateDecision moves one draft voice candidate into a terminal status.
+func (r *Repository) UpdateVoiceCandidateDecision(ctx context.Context, params domainrepo.VoiceCandidateDecisionParams) (domainrepo.VoiceCandidate, bool, error) {
+	rows, err := r.db.Query(
+		ctx,
+		queryUpdateVoiceCandidateDecision,
+		strings.TrimSpace(params.ProjectID),
+		strings.TrimSpace(params.CandidateID),
+		string(params.Status),
+		string(params.CommandKind),
+		params.StructuredSummary,
+		jsonOrEmptyObject(params.PayloadJSON),
+		nullableUUID(params.PromotedCommandID),
+		nullableText(params.DecidedBy),
+		timestamptzOrNil(params.DecidedAt),
+	)
+	if err != nil {
+		return domainrepo.VoiceCandidate{}, false, fmt.Errorf("update mission control voice candidate decision: %w", err)
+	}
+	return collectOptionalVoiceCandidate(rows, "collect mission control voice candidate decision")
+}
+
+func collectOptionalVoiceCandidate(rows pgx.Rows, op string) (domainrepo.VoiceCandidate, bool, error) {
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.VoiceCandidateRow])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainrepo.VoiceCandidate{}, false, nil
+		}
+		return domainrepo.VoiceCandidate{}, false, fmt.Errorf("%s: %w", op, err)
+	}
+	return fromVoiceCandidateRow(row), true, nil
+}
@@ -0,0 +1,20 @@
+-- name: missioncontrol__get_voice_candidate_by_id :one
+SELECT
+    id::text AS id,
+    project_id::text AS project_id,
+    source_kind,
+    status,
+    dedupe_key,
+    command_kind,
+    transcript_excerpt,
+    structured_summary,
+    payload AS payload_json,
+    promoted_command_id::text AS promoted_command_id,
+    created_by,
+    decided_by,
+    decided_at,
+    created_at,
+    updated_at
+FROM mission_control_voice_candidates
+WHERE project_id = $1
+  AND id = $2;
@@ -0,0 +1,31 @@
+-- name: missioncontrol__insert_voice_candidate :one
+INSERT INTO mission_control_voice_candidates (
+    project_id,
+    source_kind,
+    dedupe_key,
+    command_kind,
+    transcript_excerpt,
+    structured_summary,
+    payload,
+    created_by,
+    created_at,
+    updated_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, COALESCE($9, NOW()), COALESCE($9, NOW()))
+ON CONFLICT (project_id, dedupe_key) DO NOTHING
+RETURNING
+    id::text AS id,
+    project_id::text AS project_id,
+    source_kind,
+    status,
+    dedupe_key,
+    command_kind,
+    transcript_excerpt,
+    structured_summary,
+    payload AS payload_json,
+    promoted_command_id::text AS promoted_command_id,
+    created_by,
+    decided_by,
+    decided_at,
+    created_at,
+    updated_at;
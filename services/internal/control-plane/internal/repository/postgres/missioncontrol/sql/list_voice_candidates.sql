@@ -0,0 +1,25 @@
+-- name: missioncontrol__list_voice_candidates :many
+SELECT
+    id::text AS id,
+    project_id::text AS project_id,
+    source_kind,
+    status,
+    dedupe_key,
+    command_kind,
+    transcript_excerpt,
+    structured_summary,
+    payload AS payload_json,
+    promoted_command_id::text AS promoted_command_id,
+    created_by,
+    decided_by,
+    decided_at,
+    created_at,
+    updated_at
+FROM mission_control_voice_candidates
+WHERE project_id = $1
+  AND (
+      COALESCE(array_length($2::text[], 1), 0) = 0
+      OR status = ANY($2::text[])
+  )
+ORDER BY created_at DESC, id DESC
+LIMIT $3;
@@ -0,0 +1,30 @@
+-- name: missioncontrol__update_voice_candidate_decision :one
+UPDATE mission_control_voice_candidates
+SET
+    status = $3,
+    command_kind = $4,
+    structured_summary = $5,
+    payload = $6,
+    promoted_command_id = $7,
+    decided_by = $8,
+    decided_at = COALESCE($9, NOW()),
+    updated_at = COALESCE($9, NOW())
+WHERE project_id = $1
+  AND id = $2
+  AND status = 'draft'
+RETURNING
+    id::text AS id,
+    project_id::text AS project_id,
+    source_kind,
+    status,
+    dedupe_key,
+    command_kind,
+    transcript_excerpt,
+    structured_summary,
+    payload AS payload_json,
+    promoted_command_id::text AS promoted_command_id,
+    created_by,
+    decided_by,
+    decided_at,
+    created_at,
+    updated_at;
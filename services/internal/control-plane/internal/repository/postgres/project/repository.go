@@ -3,6 +3,7 @@ package project
 import (
 	"context"
 	_ "embed"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -26,6 +27,10 @@ var (
 	queryDeleteByID string
 	//go:embed sql/get_learning_mode_default.sql
 	queryGetLearningModeDefault string
+	//go:embed sql/get_alert_policy.sql
+	queryGetAlertPolicy string
+	//go:embed sql/get_auto_advance_policy.sql
+	queryGetAutoAdvancePolicy string
 )
 
 // Repository stores projects in PostgreSQL.
@@ -128,3 +133,37 @@ func (r *Repository) GetLearningModeDefault(ctx context.Context, projectID strin
 	}
 	return false, false, fmt.Errorf("get project learning_mode_default: %w", err)
 }
+
+// GetAlertPolicy returns project Alertmanager incident policy from JSONB settings.
+func (r *Repository) GetAlertPolicy(ctx context.Context, projectID string) (domainrepo.AlertPolicy, bool, error) {
+	var raw []byte
+	err := r.db.QueryRow(ctx, queryGetAlertPolicy, projectID).Scan(&raw)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domainrepo.AlertPolicy{}, false, nil
+	}
+	if err != nil {
+		return domainrepo.AlertPolicy{}, false, fmt.Errorf("get project alert_policy: %w", err)
+	}
+	var policy domainrepo.AlertPolicy
+	if err := json.Unmarshal(raw, &policy); err != nil {
+		return domainrepo.AlertPolicy{}, false, fmt.Errorf("decode project alert_policy: %w", err)
+	}
+	return policy, true, nil
+}
+
+// GetAutoAdvancePolicy returns project stage auto-advance rules from JSONB settings.
+func (r *Repository) GetAutoAdvancePolicy(ctx context.Context, projectID string) (domainrepo.AutoAdvance, bool, error) {
+	var raw []byte
+	err := r.db.QueryRow(ctx, queryGetAutoAdvancePolicy, projectID).Scan(&raw)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domainrepo.AutoAdvance{}, false, nil
+	}
+	if err != nil {
+		return domainrepo.AutoAdvance{}, false, fmt.Errorf("get project auto_advance: %w", err)
+	}
+	var policy domainrepo.AutoAdvance
+	if err := json.Unmarshal(raw, &policy); err != nil {
+		return domainrepo.AutoAdvance{}, false, fmt.Errorf("decode project auto_advance: %w", err)
+	}
+	return policy, true, nil
+}
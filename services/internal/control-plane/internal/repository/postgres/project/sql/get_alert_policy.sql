@@ -0,0 +1,4 @@
+-- name: project__get_alert_policy :one
+SELECT COALESCE(settings->'alert_policy', '{}'::jsonb) AS alert_policy
+FROM projects
+WHERE id = $1::uuid;
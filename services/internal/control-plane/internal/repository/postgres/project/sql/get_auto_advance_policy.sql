@@ -0,0 +1,4 @@
+-- name: project__get_auto_advance_policy :one
+SELECT COALESCE(settings->'auto_advance', '{}'::jsonb) AS auto_advance
+FROM projects
+WHERE id = $1::uuid;
@@ -27,6 +27,8 @@ var (
 	querySetLearningModeOverride string
 	//go:embed sql/get_learning_mode_override.sql
 	queryGetLearningModeOverride string
+	//go:embed sql/sync_oidc_roles.sql
+	querySyncOIDCRoles string
 )
 
 // Repository stores project members in PostgreSQL.
@@ -76,6 +78,17 @@ func (r *Repository) Upsert(ctx context.Context, projectID string, userID string
 	return postgres.ExecOrWrap(ctx, r.db, queryUpsert, "upsert project member", projectID, userID, role)
 }
 
+// SyncOIDCRoles replaces OIDC-sourced memberships of a user with roles keyed by project slug.
+func (r *Repository) SyncOIDCRoles(ctx context.Context, userID string, rolesByProjectSlug map[string]string) error {
+	slugs := make([]string, 0, len(rolesByProjectSlug))
+	roles := make([]string, 0, len(rolesByProjectSlug))
+	for slug, role := range rolesByProjectSlug {
+		slugs = append(slugs, slug)
+		roles = append(roles, role)
+	}
+	return postgres.ExecOrWrap(ctx, r.db, querySyncOIDCRoles, "sync oidc project member roles", userID, slugs, roles)
+}
+
 // Delete removes a user from a project.
 func (r *Repository) Delete(ctx context.Context, projectID string, userID string) error {
 	return postgres.ExecRequireRowOrWrap(ctx, r.db, queryDelete, "delete project member", projectID, userID)
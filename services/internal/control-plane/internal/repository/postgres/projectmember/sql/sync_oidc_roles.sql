@@ -0,0 +1,19 @@
+-- name: projectmember__sync_oidc_roles :exec
+WITH desired AS (
+    SELECT p.id AS project_id, d.role
+    FROM unnest($2::text[], $3::text[]) AS d(slug, role)
+    JOIN projects p ON p.slug = d.slug
+),
+revoked AS (
+    DELETE FROM project_members pm
+    WHERE pm.user_id = $1::uuid
+      AND pm.source = 'oidc'
+      AND pm.project_id NOT IN (SELECT project_id FROM desired)
+)
+INSERT INTO project_members (project_id, user_id, role, source, created_at, updated_at)
+SELECT desired.project_id, $1::uuid, desired.role, 'oidc', NOW(), NOW()
+FROM desired
+ON CONFLICT (project_id, user_id) DO UPDATE
+SET role = EXCLUDED.role,
+    updated_at = NOW()
+WHERE project_members.source = 'oidc';
@@ -0,0 +1,17 @@
+package projectrole
+
+import (
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectrole"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/projectrole/dbmodel"
+)
+
+func fromDBModel(row dbmodel.ProjectRoleRow) domainrepo.Role {
+	return domainrepo.Role{
+		ProjectID:   row.ProjectID,
+		Name:        row.Name,
+		Description: row.Description,
+		Permissions: row.Permissions,
+		CreatedAt:   row.CreatedAt,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
@@ -0,0 +1,13 @@
+package dbmodel
+
+import "time"
+
+// ProjectRoleRow mirrors one project_roles row selected from PostgreSQL.
+type ProjectRoleRow struct {
+	ProjectID   string    `db:"project_id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	Permissions []string  `db:"permissions"`
+	CreatedAt   time.Time `db:"created_at"`
+	UpdatedAt   time.Time `db:"updated_at"`
+}
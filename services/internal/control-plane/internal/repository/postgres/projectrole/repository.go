@@ -0,0 +1,101 @@
+package projectrole
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/projectrole"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/projectrole/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	//go:embed sql/upsert.sql
+	queryUpsert string
+	//go:embed sql/list.sql
+	queryList string
+	//go:embed sql/get_by_name.sql
+	queryGetByName string
+	//go:embed sql/delete.sql
+	queryDelete string
+)
+
+// Repository stores custom project roles in PostgreSQL.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+// NewRepository constructs PostgreSQL project role repository.
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// Upsert creates role or replaces its description and permissions.
+func (r *Repository) Upsert(ctx context.Context, params domainrepo.UpsertParams) (domainrepo.Role, error) {
+	permissions := params.Permissions
+	if permissions == nil {
+		permissions = []string{}
+	}
+	rows, err := r.db.Query(
+		ctx,
+		queryUpsert,
+		strings.TrimSpace(params.ProjectID),
+		strings.TrimSpace(params.Name),
+		strings.TrimSpace(params.Description),
+		permissions,
+	)
+	if err != nil {
+		return domainrepo.Role{}, fmt.Errorf("upsert project role: %w", err)
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.ProjectRoleRow])
+	if err != nil {
+		return domainrepo.Role{}, fmt.Errorf("collect upserted project role: %w", err)
+	}
+	return fromDBModel(row), nil
+}
+
+// List returns custom roles of one project ordered by name.
+func (r *Repository) List(ctx context.Context, projectID string) ([]domainrepo.Role, error) {
+	rows, err := r.db.Query(ctx, queryList, strings.TrimSpace(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("list project roles: %w", err)
+	}
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.ProjectRoleRow])
+	if err != nil {
+		return nil, fmt.Errorf("collect project roles: %w", err)
+	}
+	out := make([]domainrepo.Role, 0, len(items))
+	for _, item := range items {
+		out = append(out, fromDBModel(item))
+	}
+	return out, nil
+}
+
+// GetByName returns one custom role of a project.
+func (r *Repository) GetByName(ctx context.Context, projectID string, name string) (domainrepo.Role, bool, error) {
+	rows, err := r.db.Query(ctx, queryGetByName, strings.TrimSpace(projectID), strings.TrimSpace(name))
+	if err != nil {
+		return domainrepo.Role{}, false, fmt.Errorf("get project role: %w", err)
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.ProjectRoleRow])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainrepo.Role{}, false, nil
+		}
+		return domainrepo.Role{}, false, fmt.Errorf("collect project role: %w", err)
+	}
+	return fromDBModel(row), true, nil
+}
+
+// Delete removes role unless it is still assigned to a member.
+func (r *Repository) Delete(ctx context.Context, projectID string, name string) (bool, error) {
+	res, err := r.db.Exec(ctx, queryDelete, strings.TrimSpace(projectID), strings.TrimSpace(name))
+	if err != nil {
+		return false, fmt.Errorf("delete project role: %w", err)
+	}
+	return res.RowsAffected() > 0, nil
+}
@@ -0,0 +1,10 @@
+-- name: projectrole__delete :execrows
+DELETE FROM project_roles pr
+WHERE pr.project_id = $1::uuid
+  AND pr.name = $2
+  AND NOT EXISTS (
+      SELECT 1
+      FROM project_members pm
+      WHERE pm.project_id = pr.project_id
+        AND pm.role = pr.name
+  );
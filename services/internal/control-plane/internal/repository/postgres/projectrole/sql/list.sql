@@ -0,0 +1,11 @@
+-- name: projectrole__list :many
+SELECT
+    project_id::text AS project_id,
+    name,
+    description,
+    permissions,
+    created_at,
+    updated_at
+FROM project_roles
+WHERE project_id = $1::uuid
+ORDER BY name;
@@ -0,0 +1,23 @@
+-- name: projectrole__upsert :one
+INSERT INTO project_roles (
+    project_id,
+    name,
+    description,
+    permissions
+) VALUES (
+    $1::uuid,
+    $2,
+    $3,
+    $4::text[]
+)
+ON CONFLICT (project_id, name) DO UPDATE
+SET description = EXCLUDED.description,
+    permissions = EXCLUDED.permissions,
+    updated_at = NOW()
+RETURNING
+    project_id::text AS project_id,
+    name,
+    description,
+    permissions,
+    created_at,
+    updated_at;
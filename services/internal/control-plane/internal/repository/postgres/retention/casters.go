@@ -0,0 +1,29 @@
+package retention
+
+import (
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/retention"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/retention/dbmodel"
+)
+
+func fromDBModel(row dbmodel.RetentionPolicyRow) domainrepo.Policy {
+	item := domainrepo.Policy{
+		ID:               row.ID,
+		ProjectID:        row.ProjectID,
+		Target:           enumtypes.RetentionTarget(row.Target),
+		RetentionDays:    int(row.RetentionDays),
+		Action:           enumtypes.RetentionAction(row.Action),
+		Enabled:          row.Enabled,
+		UpdatedBy:        row.UpdatedBy,
+		LastRemovedCount: row.LastRemovedCount,
+		LastArchiveKey:   row.LastArchiveKey,
+		LastError:        row.LastError,
+		CreatedAt:        row.CreatedAt,
+		UpdatedAt:        row.UpdatedAt,
+	}
+	if row.LastSweptAt.Valid {
+		value := row.LastSweptAt.Time
+		item.LastSweptAt = &value
+	}
+	return item
+}
@@ -0,0 +1,7 @@
+package dbmodel
+
+// ExpiredRow mirrors one expired history row selected for retention sweep.
+type ExpiredRow struct {
+	ID      string `db:"id"`
+	RowJSON []byte `db:"row_json"`
+}
@@ -0,0 +1,24 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// RetentionPolicyRow mirrors one retention_policies row selected from PostgreSQL.
+type RetentionPolicyRow struct {
+	ID               string             `db:"id"`
+	ProjectID        string             `db:"project_id"`
+	Target           string             `db:"target"`
+	RetentionDays    int32              `db:"retention_days"`
+	Action           string             `db:"action"`
+	Enabled          bool               `db:"enabled"`
+	UpdatedBy        string             `db:"updated_by"`
+	LastSweptAt      pgtype.Timestamptz `db:"last_swept_at"`
+	LastRemovedCount int64              `db:"last_removed_count"`
+	LastArchiveKey   string             `db:"last_archive_key"`
+	LastError        string             `db:"last_error"`
+	CreatedAt        time.Time          `db:"created_at"`
+	UpdatedAt        time.Time          `db:"updated_at"`
+}
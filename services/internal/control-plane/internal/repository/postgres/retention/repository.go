@@ -0,0 +1,248 @@
+package retention
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"strings"
+
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/retention"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/retention/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	//go:embed sql/list_policies.sql
+	queryListPolicies string
+	//go:embed sql/upsert_policy.sql
+	queryUpsertPolicy string
+	//go:embed sql/delete_policy.sql
+	queryDeletePolicy string
+	//go:embed sql/claim_due_policies.sql
+	queryClaimDuePolicies string
+	//go:embed sql/complete_policy_sweep.sql
+	queryCompletePolicySweep string
+	//go:embed sql/list_override_project_ids.sql
+	queryListOverrideProjectIDs string
+	//go:embed sql/set_flow_event_pinned.sql
+	querySetFlowEventPinned string
+
+	//go:embed sql/list_expired_flow_events.sql
+	queryListExpiredFlowEvents string
+	//go:embed sql/list_expired_agent_run_logs.sql
+	queryListExpiredAgentRunLogs string
+	//go:embed sql/list_expired_agent_sessions.sql
+	queryListExpiredAgentSessions string
+	//go:embed sql/list_expired_interaction_delivery_attempts.sql
+	queryListExpiredInteractionDeliveryAttempts string
+	//go:embed sql/list_expired_interaction_callback_events.sql
+	queryListExpiredInteractionCallbackEvents string
+	//go:embed sql/list_expired_mission_control_timeline_entries.sql
+	queryListExpiredMissionControlTimelineEntries string
+	//go:embed sql/list_expired_github_rate_limit_wait_evidence.sql
+	queryListExpiredGitHubRateLimitWaitEvidence string
+
+	//go:embed sql/delete_flow_events.sql
+	queryDeleteFlowEvents string
+	//go:embed sql/clear_agent_run_logs.sql
+	queryClearAgentRunLogs string
+	//go:embed sql/delete_agent_sessions.sql
+	queryDeleteAgentSessions string
+	//go:embed sql/delete_interaction_delivery_attempts.sql
+	queryDeleteInteractionDeliveryAttempts string
+	//go:embed sql/delete_interaction_callback_events.sql
+	queryDeleteInteractionCallbackEvents string
+	//go:embed sql/delete_mission_control_timeline_entries.sql
+	queryDeleteMissionControlTimelineEntries string
+	//go:embed sql/delete_github_rate_limit_wait_evidence.sql
+	queryDeleteGitHubRateLimitWaitEvidence string
+)
+
+// targetQueries binds each retention target to its expired-rows selector and removal statement.
+type targetQueries struct {
+	listExpired string
+	remove      string
+}
+
+var targetQueriesByTarget = map[enumtypes.RetentionTarget]targetQueries{
+	enumtypes.RetentionTargetFlowEvents:                    {listExpired: queryListExpiredFlowEvents, remove: queryDeleteFlowEvents},
+	enumtypes.RetentionTargetAgentRunLogs:                  {listExpired: queryListExpiredAgentRunLogs, remove: queryClearAgentRunLogs},
+	enumtypes.RetentionTargetAgentSessions:                 {listExpired: queryListExpiredAgentSessions, remove: queryDeleteAgentSessions},
+	enumtypes.RetentionTargetInteractionDeliveryAttempts:   {listExpired: queryListExpiredInteractionDeliveryAttempts, remove: queryDeleteInteractionDeliveryAttempts},
+	enumtypes.RetentionTargetInteractionCallbackEvents:     {listExpired: queryListExpiredInteractionCallbackEvents, remove: queryDeleteInteractionCallbackEvents},
+	enumtypes.RetentionTargetMissionControlTimelineEntries: {listExpired: queryListExpiredMissionControlTimelineEntries, remove: queryDeleteMissionControlTimelineEntries},
+	enumtypes.RetentionTargetGitHubRateLimitWaitEvidence:   {listExpired: queryListExpiredGitHubRateLimitWaitEvidence, remove: queryDeleteGitHubRateLimitWaitEvidence},
+}
+
+// Repository persists retention policies and sweeps history tables in PostgreSQL.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+// NewRepository constructs retention repository.
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// ListPolicies returns global policies and project overrides visible for projectID.
+func (r *Repository) ListPolicies(ctx context.Context, projectID string) ([]domainrepo.Policy, error) {
+	rows, err := r.db.Query(ctx, queryListPolicies, strings.TrimSpace(projectID))
+	if err != nil {
+		return nil, fmt.Errorf("list retention policies: %w", err)
+	}
+	return collectPolicies(rows, "retention policies")
+}
+
+// UpsertPolicy creates or replaces policy for target + scope.
+func (r *Repository) UpsertPolicy(ctx context.Context, params domainrepo.UpsertPolicyParams) (domainrepo.Policy, error) {
+	rows, err := r.db.Query(
+		ctx,
+		queryUpsertPolicy,
+		string(params.Target),
+		strings.TrimSpace(params.ProjectID),
+		params.RetentionDays,
+		string(params.Action),
+		params.Enabled,
+		strings.TrimSpace(params.ActorID),
+	)
+	if err != nil {
+		return domainrepo.Policy{}, fmt.Errorf("upsert retention policy: %w", err)
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.RetentionPolicyRow])
+	if err != nil {
+		return domainrepo.Policy{}, fmt.Errorf("collect upserted retention policy: %w", err)
+	}
+	return fromDBModel(row), nil
+}
+
+// DeletePolicy removes one project override.
+func (r *Repository) DeletePolicy(ctx context.Context, policyID string) (bool, error) {
+	res, err := r.db.Exec(ctx, queryDeletePolicy, strings.TrimSpace(policyID))
+	if err != nil {
+		return false, fmt.Errorf("delete retention policy: %w", err)
+	}
+	return res.RowsAffected() > 0, nil
+}
+
+// ClaimDuePolicies leases enabled policies that were not swept within sweep interval.
+func (r *Repository) ClaimDuePolicies(ctx context.Context, params domainrepo.ClaimPoliciesParams) ([]domainrepo.Policy, error) {
+	rows, err := r.db.Query(
+		ctx,
+		queryClaimDuePolicies,
+		params.Limit,
+		strings.TrimSpace(params.WorkerID),
+		int(params.LeaseTTL.Seconds()),
+		int(params.SweepInterval.Seconds()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("claim retention policies: %w", err)
+	}
+	return collectPolicies(rows, "claimed retention policies")
+}
+
+// CompletePolicySweep records sweep outcome and releases lease held by worker.
+func (r *Repository) CompletePolicySweep(ctx context.Context, params domainrepo.CompleteSweepParams) error {
+	if _, err := r.db.Exec(
+		ctx,
+		queryCompletePolicySweep,
+		strings.TrimSpace(params.PolicyID),
+		strings.TrimSpace(params.WorkerID),
+		params.RemovedCount,
+		strings.TrimSpace(params.ArchiveKey),
+		strings.TrimSpace(params.Error),
+		params.Drained,
+	); err != nil {
+		return fmt.Errorf("complete retention policy sweep: %w", err)
+	}
+	return nil
+}
+
+// ListOverrideProjectIDs returns projects that have own policy for target.
+func (r *Repository) ListOverrideProjectIDs(ctx context.Context, target enumtypes.RetentionTarget) ([]string, error) {
+	rows, err := r.db.Query(ctx, queryListOverrideProjectIDs, string(target))
+	if err != nil {
+		return nil, fmt.Errorf("list retention override projects: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		return nil, fmt.Errorf("collect retention override projects: %w", err)
+	}
+	return ids, nil
+}
+
+// ListExpiredRows returns one batch of expired rows, oldest first.
+func (r *Repository) ListExpiredRows(ctx context.Context, params domainrepo.ExpiredRowsParams) ([]domainrepo.ExpiredRow, error) {
+	queries, ok := targetQueriesByTarget[params.Target]
+	if !ok {
+		return nil, fmt.Errorf("unsupported retention target %q", params.Target)
+	}
+	args := []any{
+		strings.TrimSpace(params.ProjectID),
+		nonNilStrings(params.ExcludedProjectIDs),
+		params.Cutoff.UTC(),
+		params.Limit,
+	}
+	if params.Target == enumtypes.RetentionTargetFlowEvents {
+		args = append(args, nonNilStrings(params.PinnedEventTypes))
+	}
+
+	rows, err := r.db.Query(ctx, queries.listExpired, args...)
+	if err != nil {
+		return nil, fmt.Errorf("list expired %s rows: %w", params.Target, err)
+	}
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.ExpiredRow])
+	if err != nil {
+		return nil, fmt.Errorf("collect expired %s rows: %w", params.Target, err)
+	}
+	out := make([]domainrepo.ExpiredRow, 0, len(items))
+	for _, item := range items {
+		out = append(out, domainrepo.ExpiredRow{ID: item.ID, RowJSON: item.RowJSON})
+	}
+	return out, nil
+}
+
+// RemoveRows deletes rows (or clears log payloads for agent_run_logs) and returns affected count.
+func (r *Repository) RemoveRows(ctx context.Context, target enumtypes.RetentionTarget, ids []string) (int64, error) {
+	queries, ok := targetQueriesByTarget[target]
+	if !ok {
+		return 0, fmt.Errorf("unsupported retention target %q", target)
+	}
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	res, err := r.db.Exec(ctx, queries.remove, ids)
+	if err != nil {
+		return 0, fmt.Errorf("remove expired %s rows: %w", target, err)
+	}
+	return res.RowsAffected(), nil
+}
+
+// SetFlowEventPinned toggles retention pin of one flow event.
+func (r *Repository) SetFlowEventPinned(ctx context.Context, eventID int64, pinned bool) (bool, error) {
+	res, err := r.db.Exec(ctx, querySetFlowEventPinned, eventID, pinned)
+	if err != nil {
+		return false, fmt.Errorf("set flow event retention pin: %w", err)
+	}
+	return res.RowsAffected() > 0, nil
+}
+
+func collectPolicies(rows pgx.Rows, label string) ([]domainrepo.Policy, error) {
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.RetentionPolicyRow])
+	if err != nil {
+		return nil, fmt.Errorf("collect %s: %w", label, err)
+	}
+	out := make([]domainrepo.Policy, 0, len(items))
+	for _, item := range items {
+		out = append(out, fromDBModel(item))
+	}
+	return out, nil
+}
+
+func nonNilStrings(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,36 @@
+-- name: retention__claim_due_policies :many
+WITH candidates AS (
+    SELECT id
+    FROM retention_policies
+    WHERE enabled = true
+      AND (sweep_lease_until IS NULL OR sweep_lease_until <= NOW())
+      AND (last_swept_at IS NULL OR last_swept_at <= NOW() - make_interval(secs => GREATEST($4::integer, 0)))
+    ORDER BY last_swept_at ASC NULLS FIRST, id ASC
+    FOR UPDATE SKIP LOCKED
+    LIMIT $1
+),
+claimed AS (
+    UPDATE retention_policies AS rp
+    SET
+        sweep_lease_owner = NULLIF(BTRIM($2::text), ''),
+        sweep_lease_until = NOW() + make_interval(secs => GREATEST($3::integer, 1))
+    FROM candidates
+    WHERE rp.id = candidates.id
+    RETURNING
+    rp.id::text AS id,
+    COALESCE(rp.project_id::text, '') AS project_id,
+    rp.target,
+    rp.retention_days,
+    rp.action,
+    rp.enabled,
+    COALESCE(rp.updated_by::text, '') AS updated_by,
+    rp.last_swept_at,
+    rp.last_removed_count,
+    rp.last_archive_key,
+    rp.last_error,
+    rp.created_at,
+    rp.updated_at
+)
+SELECT *
+FROM claimed
+ORDER BY target ASC, project_id ASC;
@@ -0,0 +1,6 @@
+-- name: retention__clear_agent_run_logs :execrows
+UPDATE agent_runs
+SET agent_logs_json = NULL,
+    updated_at = NOW()
+WHERE id = ANY($1::text[]::uuid[])
+  AND agent_logs_json IS NOT NULL;
@@ -0,0 +1,10 @@
+-- name: retention__complete_policy_sweep :exec
+UPDATE retention_policies
+SET sweep_lease_owner = NULL,
+    sweep_lease_until = NULL,
+    last_swept_at = CASE WHEN $6::boolean THEN NOW() ELSE last_swept_at END,
+    last_removed_count = $3,
+    last_archive_key = CASE WHEN $4::text = '' THEN last_archive_key ELSE $4::text END,
+    last_error = $5
+WHERE id = $1::uuid
+  AND sweep_lease_owner IS NOT DISTINCT FROM NULLIF(BTRIM($2::text), '');
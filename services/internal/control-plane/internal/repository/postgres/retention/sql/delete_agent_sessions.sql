@@ -0,0 +1,4 @@
+-- name: retention__delete_agent_sessions :execrows
+DELETE FROM agent_sessions
+WHERE id = ANY($1::text[]::bigint[])
+  AND snapshot_storage = 'postgres';
@@ -0,0 +1,4 @@
+-- name: retention__delete_flow_events :execrows
+DELETE FROM flow_events
+WHERE id = ANY($1::text[]::bigint[])
+  AND retention_pinned = false;
@@ -0,0 +1,3 @@
+-- name: retention__delete_github_rate_limit_wait_evidence :execrows
+DELETE FROM github_rate_limit_wait_evidence
+WHERE id = ANY($1::text[]::bigint[]);
@@ -0,0 +1,3 @@
+-- name: retention__delete_interaction_callback_events :execrows
+DELETE FROM interaction_callback_events
+WHERE id = ANY($1::text[]::bigint[]);
@@ -0,0 +1,3 @@
+-- name: retention__delete_interaction_delivery_attempts :execrows
+DELETE FROM interaction_delivery_attempts
+WHERE id = ANY($1::text[]::bigint[]);
@@ -0,0 +1,3 @@
+-- name: retention__delete_mission_control_timeline_entries :execrows
+DELETE FROM mission_control_timeline_entries
+WHERE id = ANY($1::text[]::bigint[]);
@@ -0,0 +1,4 @@
+-- name: retention__delete_policy :execrows
+DELETE FROM retention_policies
+WHERE id = $1::uuid
+  AND project_id IS NOT NULL;
@@ -0,0 +1,21 @@
+-- name: retention__list_expired_agent_run_logs :many
+SELECT
+    ar.id::text AS id,
+    jsonb_build_object(
+        'run_id', ar.id,
+        'project_id', ar.project_id,
+        'correlation_id', ar.correlation_id,
+        'status', ar.status,
+        'finished_at', ar.finished_at,
+        'agent_logs_json', ar.agent_logs_json
+    ) AS row_json
+FROM agent_runs ar
+WHERE ar.agent_logs_json IS NOT NULL
+  AND ar.finished_at IS NOT NULL
+  AND ar.finished_at < $3::timestamptz
+  AND (
+        ($1::text = '' AND NOT (COALESCE(ar.project_id::text, '') = ANY($2::text[])))
+        OR ($1::text <> '' AND ar.project_id::text = $1::text)
+    )
+ORDER BY ar.finished_at ASC, ar.id ASC
+LIMIT $4;
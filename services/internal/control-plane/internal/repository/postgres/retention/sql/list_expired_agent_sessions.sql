@@ -0,0 +1,14 @@
+-- name: retention__list_expired_agent_sessions :many
+SELECT
+    s.id::text AS id,
+    to_jsonb(s) AS row_json
+FROM agent_sessions s
+WHERE s.snapshot_storage = 'postgres'
+  AND s.finished_at IS NOT NULL
+  AND s.finished_at < $3::timestamptz
+  AND (
+        ($1::text = '' AND NOT (COALESCE(s.project_id::text, '') = ANY($2::text[])))
+        OR ($1::text <> '' AND s.project_id::text = $1::text)
+    )
+ORDER BY s.finished_at ASC, s.id ASC
+LIMIT $4;
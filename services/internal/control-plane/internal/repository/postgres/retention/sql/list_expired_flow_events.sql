@@ -0,0 +1,15 @@
+-- name: retention__list_expired_flow_events :many
+SELECT
+    fe.id::text AS id,
+    to_jsonb(fe) || jsonb_build_object('project_id', ar.project_id) AS row_json
+FROM flow_events fe
+LEFT JOIN agent_runs ar ON ar.correlation_id = fe.correlation_id
+WHERE fe.created_at < $3::timestamptz
+  AND fe.retention_pinned = false
+  AND NOT (fe.event_type = ANY($5::text[]))
+  AND (
+        ($1::text = '' AND NOT (COALESCE(ar.project_id::text, '') = ANY($2::text[])))
+        OR ($1::text <> '' AND ar.project_id::text = $1::text)
+    )
+ORDER BY fe.created_at ASC, fe.id ASC
+LIMIT $4;
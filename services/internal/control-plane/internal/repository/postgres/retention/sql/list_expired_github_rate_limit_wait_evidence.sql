@@ -0,0 +1,14 @@
+-- name: retention__list_expired_github_rate_limit_wait_evidence :many
+SELECT
+    ev.id::text AS id,
+    to_jsonb(ev) || jsonb_build_object('project_id', w.project_id, 'run_id', w.run_id) AS row_json
+FROM github_rate_limit_wait_evidence ev
+JOIN github_rate_limit_waits w ON w.id = ev.wait_id
+WHERE w.state IN ('resolved', 'cancelled')
+  AND ev.created_at < $3::timestamptz
+  AND (
+        ($1::text = '' AND NOT (COALESCE(w.project_id::text, '') = ANY($2::text[])))
+        OR ($1::text <> '' AND w.project_id::text = $1::text)
+    )
+ORDER BY ev.created_at ASC, ev.id ASC
+LIMIT $4;
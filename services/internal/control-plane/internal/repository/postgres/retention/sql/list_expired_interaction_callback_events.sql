@@ -0,0 +1,14 @@
+-- name: retention__list_expired_interaction_callback_events :many
+SELECT
+    e.id::text AS id,
+    to_jsonb(e) || jsonb_build_object('project_id', ir.project_id) AS row_json
+FROM interaction_callback_events e
+JOIN interaction_requests ir ON ir.id = e.interaction_id
+WHERE ir.state IN ('resolved', 'expired', 'delivery_exhausted', 'cancelled')
+  AND e.received_at < $3::timestamptz
+  AND (
+        ($1::text = '' AND NOT (COALESCE(ir.project_id::text, '') = ANY($2::text[])))
+        OR ($1::text <> '' AND ir.project_id::text = $1::text)
+    )
+ORDER BY e.received_at ASC, e.id ASC
+LIMIT $4;
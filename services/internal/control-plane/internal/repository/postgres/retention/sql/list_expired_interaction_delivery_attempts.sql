@@ -0,0 +1,14 @@
+-- name: retention__list_expired_interaction_delivery_attempts :many
+SELECT
+    a.id::text AS id,
+    to_jsonb(a) || jsonb_build_object('project_id', ir.project_id) AS row_json
+FROM interaction_delivery_attempts a
+JOIN interaction_requests ir ON ir.id = a.interaction_id
+WHERE ir.state IN ('resolved', 'expired', 'delivery_exhausted', 'cancelled')
+  AND COALESCE(a.finished_at, a.started_at) < $3::timestamptz
+  AND (
+        ($1::text = '' AND NOT (COALESCE(ir.project_id::text, '') = ANY($2::text[])))
+        OR ($1::text <> '' AND ir.project_id::text = $1::text)
+    )
+ORDER BY a.started_at ASC, a.id ASC
+LIMIT $4;
@@ -0,0 +1,12 @@
+-- name: retention__list_expired_mission_control_timeline_entries :many
+SELECT
+    t.id::text AS id,
+    to_jsonb(t) AS row_json
+FROM mission_control_timeline_entries t
+WHERE t.occurred_at < $3::timestamptz
+  AND (
+        ($1::text = '' AND NOT (COALESCE(t.project_id::text, '') = ANY($2::text[])))
+        OR ($1::text <> '' AND t.project_id::text = $1::text)
+    )
+ORDER BY t.occurred_at ASC, t.id ASC
+LIMIT $4;
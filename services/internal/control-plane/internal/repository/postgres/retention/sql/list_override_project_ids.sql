@@ -0,0 +1,6 @@
+-- name: retention__list_override_project_ids :many
+SELECT project_id::text AS project_id
+FROM retention_policies
+WHERE target = $1
+  AND project_id IS NOT NULL
+ORDER BY project_id ASC;
@@ -0,0 +1,20 @@
+-- name: retention__list_policies :many
+SELECT
+    rp.id::text AS id,
+    COALESCE(rp.project_id::text, '') AS project_id,
+    rp.target,
+    rp.retention_days,
+    rp.action,
+    rp.enabled,
+    COALESCE(rp.updated_by::text, '') AS updated_by,
+    rp.last_swept_at,
+    rp.last_removed_count,
+    rp.last_archive_key,
+    rp.last_error,
+    rp.created_at,
+    rp.updated_at
+FROM retention_policies rp
+WHERE $1::text = ''
+   OR rp.project_id IS NULL
+   OR rp.project_id::text = $1::text
+ORDER BY rp.target ASC, rp.project_id ASC NULLS FIRST;
@@ -0,0 +1,4 @@
+-- name: retention__set_flow_event_pinned :execrows
+UPDATE flow_events
+SET retention_pinned = $2
+WHERE id = $1;
@@ -0,0 +1,37 @@
+-- name: retention__upsert_policy :one
+INSERT INTO retention_policies AS rp (
+    target,
+    project_id,
+    retention_days,
+    action,
+    enabled,
+    updated_by
+) VALUES (
+    $1,
+    NULLIF($2::text, '')::uuid,
+    $3,
+    $4,
+    $5,
+    NULLIF($6::text, '')::uuid
+)
+ON CONFLICT (target, COALESCE(project_id, '00000000-0000-0000-0000-000000000000'::uuid)) DO UPDATE
+SET retention_days = EXCLUDED.retention_days,
+    action = EXCLUDED.action,
+    enabled = EXCLUDED.enabled,
+    updated_by = EXCLUDED.updated_by,
+    last_error = '',
+    updated_at = NOW()
+RETURNING
+    rp.id::text AS id,
+    COALESCE(rp.project_id::text, '') AS project_id,
+    rp.target,
+    rp.retention_days,
+    rp.action,
+    rp.enabled,
+    COALESCE(rp.updated_by::text, '') AS updated_by,
+    rp.last_swept_at,
+    rp.last_removed_count,
+    rp.last_archive_key,
+    rp.last_error,
+    rp.created_at,
+    rp.updated_at;
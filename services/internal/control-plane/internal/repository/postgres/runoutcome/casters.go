@@ -0,0 +1,33 @@
+package runoutcome
+
+import (
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/runoutcome"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/runoutcome/dbmodel"
+)
+
+func fromDBModel(row dbmodel.GroupRow) domainrepo.Group {
+	group := domainrepo.Group{
+		ProjectID:                row.ProjectID,
+		Role:                     row.Role,
+		TriggerKind:              row.TriggerKind,
+		Model:                    row.Model,
+		ReasoningEffort:          row.ReasoningEffort,
+		PromptSource:             row.PromptSource,
+		Lineages:                 row.Lineages,
+		Runs:                     row.Runs,
+		ReviseRuns:               row.ReviseRuns,
+		FailedRuns:               row.FailedRuns,
+		ChangesRequested:         row.ChangesRequested,
+		PRsOpened:                row.PRsOpened,
+		PRsMerged:                row.PRsMerged,
+		PRsClosedUnmerged:        row.PRsClosedUnmerged,
+		AvgReviseRounds:          row.AvgReviseRounds,
+		MedianTimeToMergeSeconds: row.MedianTimeToMergeSeconds,
+		AvgWallClockSeconds:      row.AvgWallClockSeconds,
+		AgentSeconds:             row.AgentSeconds,
+	}
+	if closed := row.PRsMerged + row.PRsClosedUnmerged; closed > 0 {
+		group.MergeRate = float64(row.PRsMerged) / float64(closed)
+	}
+	return group
+}
@@ -0,0 +1,23 @@
+package dbmodel
+
+// GroupRow mirrors one aggregated run outcome group selected from PostgreSQL.
+type GroupRow struct {
+	ProjectID                string  `db:"project_id"`
+	Role                     string  `db:"role"`
+	TriggerKind              string  `db:"trigger_kind"`
+	Model                    string  `db:"model"`
+	ReasoningEffort          string  `db:"reasoning_effort"`
+	PromptSource             string  `db:"prompt_source"`
+	Lineages                 int64   `db:"lineages"`
+	Runs                     int64   `db:"runs"`
+	ReviseRuns               int64   `db:"revise_runs"`
+	FailedRuns               int64   `db:"failed_runs"`
+	ChangesRequested         int64   `db:"changes_requested"`
+	PRsOpened                int64   `db:"prs_opened"`
+	PRsMerged                int64   `db:"prs_merged"`
+	PRsClosedUnmerged        int64   `db:"prs_closed_unmerged"`
+	AvgReviseRounds          float64 `db:"avg_revise_rounds"`
+	MedianTimeToMergeSeconds float64 `db:"median_time_to_merge_seconds"`
+	AvgWallClockSeconds      float64 `db:"avg_wall_clock_seconds"`
+	AgentSeconds             float64 `db:"agent_seconds"`
+}
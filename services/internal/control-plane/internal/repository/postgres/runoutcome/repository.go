@@ -0,0 +1,60 @@
+package runoutcome
+
+import (
+	"context"
+	_ "embed"
+	"fmt"
+	"slices"
+	"strings"
+
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/runoutcome"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/runoutcome/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	//go:embed sql/list_groups.sql
+	queryListGroups string
+)
+
+// Repository derives run outcome analytics from PostgreSQL run and flow event history.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+// NewRepository constructs run outcome analytics repository.
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// ListGroups aggregates issue/PR lineages started in [From, To) by requested grouping keys.
+func (r *Repository) ListGroups(ctx context.Context, params domainrepo.AnalyticsParams) ([]domainrepo.Group, error) {
+	rows, err := r.db.Query(
+		ctx,
+		queryListGroups,
+		params.From.UTC(),
+		params.To.UTC(),
+		strings.TrimSpace(params.ProjectID),
+		strings.TrimSpace(params.UserID),
+		slices.Contains(params.GroupBy, enumtypes.RunOutcomeDimensionProject),
+		slices.Contains(params.GroupBy, enumtypes.RunOutcomeDimensionRole),
+		slices.Contains(params.GroupBy, enumtypes.RunOutcomeDimensionTrigger),
+		slices.Contains(params.GroupBy, enumtypes.RunOutcomeDimensionModel),
+		slices.Contains(params.GroupBy, enumtypes.RunOutcomeDimensionReasoning),
+		slices.Contains(params.GroupBy, enumtypes.RunOutcomeDimensionPromptSource),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list run outcome groups: %w", err)
+	}
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.GroupRow])
+	if err != nil {
+		return nil, fmt.Errorf("collect run outcome groups: %w", err)
+	}
+	out := make([]domainrepo.Group, 0, len(items))
+	for _, item := range items {
+		out = append(out, fromDBModel(item))
+	}
+	return out, nil
+}
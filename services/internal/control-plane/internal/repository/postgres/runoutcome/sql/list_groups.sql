@@ -0,0 +1,151 @@
+-- name: runoutcome__list_groups :many
+WITH runs AS (
+    SELECT
+        ar.id,
+        ar.project_id,
+        ar.status,
+        ar.created_at,
+        ar.started_at,
+        ar.finished_at,
+        lower(COALESCE(NULLIF(ar.run_payload->'repository'->>'full_name', ''), s.repository_full_name, '')) AS repository_full_name,
+        CASE
+            WHEN COALESCE(ar.run_payload->'issue'->>'number', '') ~ '^[0-9]+$'
+                THEN (ar.run_payload->'issue'->>'number')::bigint
+            ELSE s.issue_number::bigint
+        END AS issue_number,
+        CASE
+            WHEN COALESCE(ar.run_payload->'pull_request'->>'number', '') ~ '^[0-9]+$'
+                THEN (ar.run_payload->'pull_request'->>'number')::bigint
+            ELSE s.pr_number::bigint
+        END AS pr_number,
+        COALESCE(NULLIF(ar.run_payload->'agent'->>'key', ''), s.agent_key, '') AS role,
+        COALESCE(NULLIF(ar.run_payload->'trigger'->>'kind', ''), s.trigger_kind, '') AS trigger_kind,
+        COALESCE(NULLIF(s.model, ''), profile.model, '') AS model,
+        COALESCE(NULLIF(s.reasoning_effort, ''), profile.reasoning_effort, '') AS reasoning_effort,
+        COALESCE(s.template_source, '') AS prompt_source
+    FROM agent_runs ar
+    LEFT JOIN agent_sessions s ON s.run_id = ar.id
+    LEFT JOIN LATERAL (
+        SELECT
+            NULLIF(fe.payload->>'model', '') AS model,
+            NULLIF(fe.payload->>'reasoning_effort', '') AS reasoning_effort
+        FROM flow_events fe
+        WHERE fe.correlation_id = ar.correlation_id
+          AND fe.event_type = 'run.profile.resolved'
+        ORDER BY fe.created_at DESC
+        LIMIT 1
+    ) profile ON s.id IS NULL OR COALESCE(s.model, '') = '' OR COALESCE(s.reasoning_effort, '') = ''
+    WHERE ar.created_at >= $1
+      AND ar.created_at < $2
+      AND ar.project_id IS NOT NULL
+      AND ($3::text = '' OR ar.project_id::text = $3::text)
+      AND (
+        $4::text = ''
+        OR ar.project_id IN (SELECT pm.project_id FROM project_members pm WHERE pm.user_id::text = $4::text)
+      )
+),
+keyed AS (
+    SELECT
+        r.*,
+        CASE
+            WHEN r.issue_number IS NOT NULL THEN 'issue:' || r.issue_number
+            WHEN r.pr_number IS NOT NULL THEN 'pr:' || r.pr_number
+            ELSE 'run:' || r.id::text
+        END AS lineage_key,
+        right(r.trigger_kind, 7) = '_revise' AS is_revise
+    FROM runs r
+),
+lineage_heads AS (
+    SELECT DISTINCT ON (k.project_id, k.repository_full_name, k.lineage_key)
+        k.project_id,
+        k.repository_full_name,
+        k.lineage_key,
+        k.role,
+        k.trigger_kind,
+        k.model,
+        k.reasoning_effort,
+        k.prompt_source
+    FROM keyed k
+    ORDER BY k.project_id, k.repository_full_name, k.lineage_key, k.is_revise, k.created_at, k.id
+),
+lineages AS (
+    SELECT
+        k.project_id,
+        k.repository_full_name,
+        k.lineage_key,
+        min(k.created_at) AS first_created_at,
+        max(COALESCE(k.finished_at, k.started_at, k.created_at)) AS last_activity_at,
+        max(k.pr_number) AS pr_number,
+        count(*) AS runs,
+        count(*) FILTER (WHERE k.is_revise) AS revise_runs,
+        count(*) FILTER (WHERE k.status IN ('failed', 'timed_out')) AS failed_runs,
+        COALESCE(sum(EXTRACT(EPOCH FROM (k.finished_at - k.started_at))) FILTER (
+            WHERE k.started_at IS NOT NULL AND k.finished_at IS NOT NULL AND k.finished_at > k.started_at
+        ), 0)::double precision AS agent_seconds
+    FROM keyed k
+    GROUP BY k.project_id, k.repository_full_name, k.lineage_key
+),
+facts AS (
+    SELECT
+        h.*,
+        l.runs,
+        l.revise_runs,
+        l.failed_runs,
+        l.agent_seconds,
+        l.pr_number,
+        l.first_created_at,
+        outcome.merged,
+        outcome.closed_at,
+        COALESCE(reviews.changes_requested, 0) AS changes_requested,
+        EXTRACT(EPOCH FROM (COALESCE(outcome.closed_at, l.last_activity_at) - l.first_created_at))::double precision AS wall_clock_seconds
+    FROM lineages l
+    JOIN lineage_heads h
+        ON h.project_id = l.project_id
+       AND h.repository_full_name = l.repository_full_name
+       AND h.lineage_key = l.lineage_key
+    LEFT JOIN LATERAL (
+        SELECT
+            COALESCE((fe.payload->>'merged')::boolean, false) AS merged,
+            fe.created_at AS closed_at
+        FROM flow_events fe
+        WHERE lower(fe.payload->>'repository_full_name') = l.repository_full_name
+          AND fe.payload->>'pull_request_number' = l.pr_number::text
+          AND fe.event_type = 'run.pr.closed'
+          AND fe.created_at >= l.first_created_at
+        ORDER BY fe.created_at DESC
+        LIMIT 1
+    ) outcome ON l.pr_number IS NOT NULL
+    LEFT JOIN LATERAL (
+        SELECT count(*) AS changes_requested
+        FROM flow_events fe
+        WHERE lower(fe.payload->>'repository_full_name') = l.repository_full_name
+          AND fe.payload->>'pull_request_number' = l.pr_number::text
+          AND fe.event_type = 'run.review.changes_requested.received'
+          AND fe.created_at >= l.first_created_at
+    ) reviews ON l.pr_number IS NOT NULL
+)
+SELECT
+    CASE WHEN $5::boolean THEN f.project_id::text ELSE '' END AS project_id,
+    CASE WHEN $6::boolean THEN f.role ELSE '' END AS role,
+    CASE WHEN $7::boolean THEN f.trigger_kind ELSE '' END AS trigger_kind,
+    CASE WHEN $8::boolean THEN f.model ELSE '' END AS model,
+    CASE WHEN $9::boolean THEN f.reasoning_effort ELSE '' END AS reasoning_effort,
+    CASE WHEN $10::boolean THEN f.prompt_source ELSE '' END AS prompt_source,
+    count(*) AS lineages,
+    sum(f.runs)::bigint AS runs,
+    sum(f.revise_runs)::bigint AS revise_runs,
+    sum(f.failed_runs)::bigint AS failed_runs,
+    sum(f.changes_requested)::bigint AS changes_requested,
+    count(*) FILTER (WHERE f.pr_number IS NOT NULL) AS prs_opened,
+    count(*) FILTER (WHERE f.merged IS TRUE) AS prs_merged,
+    count(*) FILTER (WHERE f.merged IS FALSE) AS prs_closed_unmerged,
+    COALESCE(avg(f.revise_runs), 0)::double precision AS avg_revise_rounds,
+    COALESCE(
+        percentile_cont(0.5) WITHIN GROUP (ORDER BY EXTRACT(EPOCH FROM (f.closed_at - f.first_created_at))) FILTER (WHERE f.merged IS TRUE),
+        0
+    )::double precision AS median_time_to_merge_seconds,
+    COALESCE(avg(f.wall_clock_seconds), 0)::double precision AS avg_wall_clock_seconds,
+    COALESCE(sum(f.agent_seconds), 0)::double precision AS agent_seconds
+FROM facts f
+GROUP BY 1, 2, 3, 4, 5, 6
+ORDER BY lineages DESC, 1, 2, 3, 4, 5, 6;
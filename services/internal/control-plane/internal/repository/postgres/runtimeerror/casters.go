@@ -1,8 +1,12 @@
 package runtimeerror
 
 import (
+	"time"
+
 	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/runtimeerror"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/runtimeerror/dbmodel"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 func fromDBModel(row dbmodel.RuntimeErrorRow) domainrepo.Item {
@@ -32,6 +36,9 @@ func fromDBModel(row dbmodel.RuntimeErrorRow) domainrepo.Item {
 	if row.JobName.Valid {
 		item.JobName = row.JobName.String
 	}
+	if row.GroupID.Valid {
+		item.GroupID = row.GroupID.String
+	}
 	if row.ViewedAt.Valid {
 		value := row.ViewedAt.Time
 		item.ViewedAt = &value
@@ -41,3 +48,54 @@ func fromDBModel(row dbmodel.RuntimeErrorRow) domainrepo.Item {
 	}
 	return item
 }
+
+func groupFromDBModel(row dbmodel.RuntimeErrorGroupRow) domainrepo.Group {
+	group := domainrepo.Group{
+		ID:                 row.ID,
+		Fingerprint:        row.Fingerprint,
+		Source:             row.Source,
+		ErrorClass:         row.ErrorClass,
+		NormalizedMessage:  row.NormalizedMessage,
+		SampleMessage:      row.SampleMessage,
+		Level:              row.Level,
+		Status:             enumtypes.RuntimeErrorGroupStatus(row.Status),
+		MutedUntil:         timestamptzPtr(row.MutedUntil),
+		StatusChangedAt:    timestamptzPtr(row.StatusChangedAt),
+		ResolvedAt:         timestamptzPtr(row.ResolvedAt),
+		Occurrences:        row.Occurrences,
+		RegressionCount:    int(row.RegressionCount),
+		AffectedRunCount:   int(row.AffectedRunCount),
+		FirstSeenAt:        row.FirstSeenAt,
+		LastSeenAt:         row.LastSeenAt,
+		EscalationKind:     enumtypes.RuntimeErrorGroupEscalationKind(row.EscalationKind),
+		EscalatedAt:        timestamptzPtr(row.EscalatedAt),
+		RepositoryFullName: row.RepositoryFullName,
+		IssueURL:           row.IssueURL,
+		CreatedAt:          row.CreatedAt,
+		UpdatedAt:          row.UpdatedAt,
+	}
+	if row.ProjectID.Valid {
+		group.ProjectID = row.ProjectID.String
+	}
+	if row.StatusChangedBy.Valid {
+		group.StatusChangedBy = row.StatusChangedBy.String
+	}
+	if row.LastRunID.Valid {
+		group.LastRunID = row.LastRunID.String
+	}
+	if row.EscalatedBy.Valid {
+		group.EscalatedBy = row.EscalatedBy.String
+	}
+	if row.IssueNumber.Valid {
+		group.IssueNumber = row.IssueNumber.Int64
+	}
+	return group
+}
+
+func timestamptzPtr(value pgtype.Timestamptz) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	result := value.Time
+	return &result
+}
@@ -0,0 +1,38 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// RuntimeErrorGroupRow mirrors one runtime_error_groups row selected from PostgreSQL.
+type RuntimeErrorGroupRow struct {
+	ID                 string             `db:"id"`
+	ProjectID          pgtype.Text        `db:"project_id"`
+	Fingerprint        string             `db:"fingerprint"`
+	Source             string             `db:"source"`
+	ErrorClass         string             `db:"error_class"`
+	NormalizedMessage  string             `db:"normalized_message"`
+	SampleMessage      string             `db:"sample_message"`
+	Level              string             `db:"level"`
+	Status             string             `db:"status"`
+	MutedUntil         pgtype.Timestamptz `db:"muted_until"`
+	StatusChangedAt    pgtype.Timestamptz `db:"status_changed_at"`
+	StatusChangedBy    pgtype.Text        `db:"status_changed_by"`
+	ResolvedAt         pgtype.Timestamptz `db:"resolved_at"`
+	Occurrences        int64              `db:"occurrences"`
+	RegressionCount    int32              `db:"regression_count"`
+	AffectedRunCount   int32              `db:"affected_run_count"`
+	FirstSeenAt        time.Time          `db:"first_seen_at"`
+	LastSeenAt         time.Time          `db:"last_seen_at"`
+	LastRunID          pgtype.Text        `db:"last_run_id"`
+	EscalationKind     string             `db:"escalation_kind"`
+	EscalatedAt        pgtype.Timestamptz `db:"escalated_at"`
+	EscalatedBy        pgtype.Text        `db:"escalated_by"`
+	RepositoryFullName string             `db:"repository_full_name"`
+	IssueNumber        pgtype.Int8        `db:"issue_number"`
+	IssueURL           string             `db:"issue_url"`
+	CreatedAt          time.Time          `db:"created_at"`
+	UpdatedAt          time.Time          `db:"updated_at"`
+}
@@ -19,6 +19,7 @@ type RuntimeErrorRow struct {
 	ProjectID     pgtype.Text        `db:"project_id"`
 	Namespace     pgtype.Text        `db:"namespace"`
 	JobName       pgtype.Text        `db:"job_name"`
+	GroupID       pgtype.Text        `db:"group_id"`
 	ViewedAt      pgtype.Timestamptz `db:"viewed_at"`
 	ViewedBy      pgtype.Text        `db:"viewed_by"`
 	CreatedAt     time.Time          `db:"created_at"`
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/runtimeerror"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
 	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/runtimeerror/dbmodel"
 	"github.com/jackc/pgx/v5"
@@ -25,6 +26,16 @@ var (
 	queryGetByID string
 	//go:embed sql/mark_viewed.sql
 	queryMarkViewed string
+	//go:embed sql/list_groups_all.sql
+	queryListGroupsAll string
+	//go:embed sql/list_groups_for_user.sql
+	queryListGroupsForUser string
+	//go:embed sql/get_group_by_id.sql
+	queryGetGroupByID string
+	//go:embed sql/update_group_status.sql
+	queryUpdateGroupStatus string
+	//go:embed sql/set_group_escalation.sql
+	querySetGroupEscalation string
 )
 
 // Repository persists runtime_errors in PostgreSQL.
@@ -37,7 +48,7 @@ func NewRepository(db *pgxpool.Pool) *Repository {
 	return &Repository{db: db}
 }
 
-// Insert appends one runtime error entry.
+// Insert appends one runtime error entry and upserts its fingerprint group.
 func (r *Repository) Insert(ctx context.Context, params domainrepo.RecordParams) (domainrepo.Item, error) {
 	rows, err := r.db.Query(
 		ctx,
@@ -52,6 +63,9 @@ func (r *Repository) Insert(ctx context.Context, params domainrepo.RecordParams)
 		nullableUUID(params.ProjectID),
 		nullableText(params.Namespace),
 		nullableText(params.JobName),
+		strings.TrimSpace(params.Fingerprint),
+		strings.TrimSpace(params.ErrorClass),
+		strings.TrimSpace(params.NormalizedMessage),
 	)
 	if err != nil {
 		return domainrepo.Item{}, fmt.Errorf("insert runtime error: %w", err)
@@ -125,6 +139,105 @@ func (r *Repository) MarkViewed(ctx context.Context, params domainrepo.MarkViewe
 	return fromDBModel(item), true, nil
 }
 
+// ListGroups returns runtime error groups for platform admin scope.
+func (r *Repository) ListGroups(ctx context.Context, filter domainrepo.GroupListFilter) ([]domainrepo.Group, error) {
+	f := normalizeGroupListFilter(filter)
+	rows, err := r.db.Query(ctx, queryListGroupsAll, f.Limit, f.Status, nullableText(f.Level), nullableText(f.Source), nullableText(f.ProjectID))
+	if err != nil {
+		return nil, fmt.Errorf("list runtime error groups: %w", err)
+	}
+	return collectGroups(rows, "runtime error groups")
+}
+
+// ListGroupsForUser returns runtime error groups visible to user projects.
+func (r *Repository) ListGroupsForUser(ctx context.Context, userID string, filter domainrepo.GroupListFilter) ([]domainrepo.Group, error) {
+	f := normalizeGroupListFilter(filter)
+	rows, err := r.db.Query(
+		ctx,
+		queryListGroupsForUser,
+		strings.TrimSpace(userID),
+		f.Limit,
+		f.Status,
+		nullableText(f.Level),
+		nullableText(f.Source),
+		nullableText(f.ProjectID),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list runtime error groups for user: %w", err)
+	}
+	return collectGroups(rows, "runtime error groups for user")
+}
+
+// GetGroupByID returns one runtime error group by id.
+func (r *Repository) GetGroupByID(ctx context.Context, id string) (domainrepo.Group, bool, error) {
+	rows, err := r.db.Query(ctx, queryGetGroupByID, strings.TrimSpace(id))
+	if err != nil {
+		return domainrepo.Group{}, false, fmt.Errorf("query runtime error group by id: %w", err)
+	}
+	return collectOneGroup(rows, "runtime error group by id")
+}
+
+// UpdateGroupStatus changes open/muted/resolved state of one group.
+func (r *Repository) UpdateGroupStatus(ctx context.Context, params domainrepo.GroupStatusParams) (domainrepo.Group, bool, error) {
+	rows, err := r.db.Query(
+		ctx,
+		queryUpdateGroupStatus,
+		strings.TrimSpace(params.GroupID),
+		string(params.Status),
+		params.MutedUntil,
+		nullableUUID(params.ActorID),
+	)
+	if err != nil {
+		return domainrepo.Group{}, false, fmt.Errorf("update runtime error group status: %w", err)
+	}
+	return collectOneGroup(rows, "updated runtime error group")
+}
+
+// SetGroupEscalation stores escalation outcome of one group.
+func (r *Repository) SetGroupEscalation(ctx context.Context, params domainrepo.GroupEscalationParams) (domainrepo.Group, bool, error) {
+	var issueNumber any
+	if params.IssueNumber > 0 {
+		issueNumber = params.IssueNumber
+	}
+	rows, err := r.db.Query(
+		ctx,
+		querySetGroupEscalation,
+		strings.TrimSpace(params.GroupID),
+		string(params.Kind),
+		nullableUUID(params.ActorID),
+		strings.TrimSpace(params.RepositoryFullName),
+		issueNumber,
+		strings.TrimSpace(params.IssueURL),
+	)
+	if err != nil {
+		return domainrepo.Group{}, false, fmt.Errorf("set runtime error group escalation: %w", err)
+	}
+	return collectOneGroup(rows, "escalated runtime error group")
+}
+
+func collectGroups(rows pgx.Rows, op string) ([]domainrepo.Group, error) {
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.RuntimeErrorGroupRow])
+	if err != nil {
+		return nil, fmt.Errorf("collect %s: %w", op, err)
+	}
+	out := make([]domainrepo.Group, 0, len(items))
+	for _, row := range items {
+		out = append(out, groupFromDBModel(row))
+	}
+	return out, nil
+}
+
+func collectOneGroup(rows pgx.Rows, op string) (domainrepo.Group, bool, error) {
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.RuntimeErrorGroupRow])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainrepo.Group{}, false, nil
+		}
+		return domainrepo.Group{}, false, fmt.Errorf("collect %s: %w", op, err)
+	}
+	return groupFromDBModel(row), true, nil
+}
+
 func collectItems(rows pgx.Rows, op string) ([]domainrepo.Item, error) {
 	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.RuntimeErrorRow])
 	if err != nil {
@@ -153,6 +266,26 @@ func normalizeListFilter(filter domainrepo.ListFilter) domainrepo.ListFilter {
 	return normalized
 }
 
+func normalizeGroupListFilter(filter domainrepo.GroupListFilter) domainrepo.GroupListFilter {
+	normalized := filter
+	if normalized.Limit <= 0 {
+		normalized.Limit = 100
+	}
+	if normalized.Limit > 1000 {
+		normalized.Limit = 1000
+	}
+	normalized.Level = strings.TrimSpace(normalized.Level)
+	normalized.Source = strings.TrimSpace(normalized.Source)
+	normalized.ProjectID = strings.TrimSpace(normalized.ProjectID)
+	switch status := strings.TrimSpace(normalized.Status); status {
+	case string(enumtypes.RuntimeErrorGroupStatusOpen), string(enumtypes.RuntimeErrorGroupStatusMuted), string(enumtypes.RuntimeErrorGroupStatusResolved), querytypes.RuntimeErrorGroupListStatusAll:
+		normalized.Status = status
+	default:
+		normalized.Status = string(enumtypes.RuntimeErrorGroupStatusOpen)
+	}
+	return normalized
+}
+
 func normalizeListState(state querytypes.RuntimeErrorListState) querytypes.RuntimeErrorListState {
 	switch state {
 	case querytypes.RuntimeErrorListStateActive, querytypes.RuntimeErrorListStateViewed, querytypes.RuntimeErrorListStateAll:
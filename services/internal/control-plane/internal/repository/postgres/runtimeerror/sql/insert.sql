@@ -1,4 +1,47 @@
 -- name: runtimeerror__insert :one
+WITH upserted_group AS (
+    INSERT INTO runtime_error_groups AS g (
+        project_id,
+        fingerprint,
+        source,
+        error_class,
+        normalized_message,
+        sample_message,
+        level,
+        last_run_id
+    )
+    VALUES ($8::uuid, $11, $1, $12, $13, $3, $2, $7::uuid)
+    ON CONFLICT (fingerprint) DO UPDATE
+    SET
+        occurrences = g.occurrences + 1,
+        last_seen_at = NOW(),
+        sample_message = EXCLUDED.sample_message,
+        level = EXCLUDED.level,
+        last_run_id = COALESCE(EXCLUDED.last_run_id, g.last_run_id),
+        regression_count = g.regression_count + CASE WHEN g.status = 'resolved' THEN 1 ELSE 0 END,
+        status = CASE
+            WHEN g.status = 'resolved' THEN 'open'
+            WHEN g.status = 'muted' AND g.muted_until IS NOT NULL AND g.muted_until <= NOW() THEN 'open'
+            ELSE g.status
+        END,
+        muted_until = CASE
+            WHEN g.status = 'muted' AND g.muted_until IS NOT NULL AND g.muted_until <= NOW() THEN NULL
+            ELSE g.muted_until
+        END,
+        resolved_at = CASE WHEN g.status = 'resolved' THEN NULL ELSE g.resolved_at END,
+        status_changed_at = CASE
+            WHEN g.status = 'resolved' THEN NOW()
+            WHEN g.status = 'muted' AND g.muted_until IS NOT NULL AND g.muted_until <= NOW() THEN NOW()
+            ELSE g.status_changed_at
+        END,
+        status_changed_by = CASE
+            WHEN g.status = 'resolved' THEN NULL
+            WHEN g.status = 'muted' AND g.muted_until IS NOT NULL AND g.muted_until <= NOW() THEN NULL
+            ELSE g.status_changed_by
+        END,
+        updated_at = NOW()
+    RETURNING g.id
+)
 INSERT INTO runtime_errors (
     source,
     level,
@@ -9,9 +52,11 @@ INSERT INTO runtime_errors (
     run_id,
     project_id,
     namespace,
-    job_name
+    job_name,
+    group_id
 )
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+SELECT $1::text, $2::text, $3::text, $4::jsonb, $5::text, $6::text, $7::uuid, $8::uuid, $9::text, $10::text, ug.id
+FROM upserted_group ug
 RETURNING
     id,
     source,
@@ -24,6 +69,7 @@ RETURNING
     project_id::text AS project_id,
     namespace,
     job_name,
+    group_id::text AS group_id,
     viewed_at,
     viewed_by::text AS viewed_by,
     created_at;
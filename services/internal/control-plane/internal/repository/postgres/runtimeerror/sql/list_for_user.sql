@@ -11,6 +11,7 @@ SELECT
     re.project_id::text AS project_id,
     re.namespace,
     re.job_name,
+    re.group_id::text AS group_id,
     re.viewed_at,
     re.viewed_by::text AS viewed_by,
     re.created_at
@@ -0,0 +1,43 @@
+-- name: runtimeerror__list_groups_for_user :many
+SELECT
+    g.id,
+    g.project_id::text AS project_id,
+    g.fingerprint,
+    g.source,
+    g.error_class,
+    g.normalized_message,
+    g.sample_message,
+    g.level,
+    g.status,
+    g.muted_until,
+    g.status_changed_at,
+    g.status_changed_by::text AS status_changed_by,
+    g.resolved_at,
+    g.occurrences,
+    g.regression_count,
+    (
+        SELECT COUNT(DISTINCT re.run_id)
+        FROM runtime_errors re
+        WHERE re.group_id = g.id
+    )::int AS affected_run_count,
+    g.first_seen_at,
+    g.last_seen_at,
+    g.last_run_id::text AS last_run_id,
+    g.escalation_kind,
+    g.escalated_at,
+    g.escalated_by::text AS escalated_by,
+    g.repository_full_name,
+    g.issue_number,
+    g.issue_url,
+    g.created_at,
+    g.updated_at
+FROM runtime_error_groups g
+JOIN project_members pm
+  ON pm.project_id = g.project_id
+WHERE pm.user_id = $1
+  AND ($3::text = 'all' OR g.status = $3::text)
+  AND ($4::text IS NULL OR g.level = $4::text)
+  AND ($5::text IS NULL OR g.source = $5::text)
+  AND ($6::text IS NULL OR g.project_id::text = $6::text)
+ORDER BY g.last_seen_at DESC
+LIMIT $2;
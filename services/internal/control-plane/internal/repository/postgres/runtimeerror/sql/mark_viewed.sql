@@ -16,6 +16,7 @@ RETURNING
     re.project_id::text AS project_id,
     re.namespace,
     re.job_name,
+    re.group_id::text AS group_id,
     re.viewed_at,
     re.viewed_by::text AS viewed_by,
     re.created_at;
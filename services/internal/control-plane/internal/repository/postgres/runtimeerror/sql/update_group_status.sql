@@ -0,0 +1,42 @@
+-- name: runtimeerror__update_group_status :one
+UPDATE runtime_error_groups g
+SET
+    status = $2::text,
+    muted_until = CASE WHEN $2::text = 'muted' THEN $3::timestamptz ELSE NULL END,
+    resolved_at = CASE WHEN $2::text = 'resolved' THEN NOW() ELSE NULL END,
+    status_changed_at = NOW(),
+    status_changed_by = $4::uuid,
+    updated_at = NOW()
+WHERE g.id = $1
+RETURNING
+    g.id,
+    g.project_id::text AS project_id,
+    g.fingerprint,
+    g.source,
+    g.error_class,
+    g.normalized_message,
+    g.sample_message,
+    g.level,
+    g.status,
+    g.muted_until,
+    g.status_changed_at,
+    g.status_changed_by::text AS status_changed_by,
+    g.resolved_at,
+    g.occurrences,
+    g.regression_count,
+    (
+        SELECT COUNT(DISTINCT re.run_id)
+        FROM runtime_errors re
+        WHERE re.group_id = g.id
+    )::int AS affected_run_count,
+    g.first_seen_at,
+    g.last_seen_at,
+    g.last_run_id::text AS last_run_id,
+    g.escalation_kind,
+    g.escalated_at,
+    g.escalated_by::text AS escalated_by,
+    g.repository_full_name,
+    g.issue_number,
+    g.issue_url,
+    g.created_at,
+    g.updated_at;
@@ -0,0 +1,34 @@
+package staffapitoken
+
+import (
+	"time"
+
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/staffapitoken"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/staffapitoken/dbmodel"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+func fromDBModel(row dbmodel.StaffAPITokenRow) domainrepo.Token {
+	return domainrepo.Token{
+		ID:          row.ID,
+		UserID:      row.UserID,
+		UserEmail:   row.UserEmail,
+		Name:        row.Name,
+		TokenPrefix: row.TokenPrefix,
+		Scopes:      row.Scopes,
+		ProjectIDs:  row.ProjectIDs,
+		ExpiresAt:   optionalTime(row.ExpiresAt),
+		LastUsedAt:  optionalTime(row.LastUsedAt),
+		RevokedAt:   optionalTime(row.RevokedAt),
+		CreatedBy:   row.CreatedBy,
+		CreatedAt:   row.CreatedAt,
+	}
+}
+
+func optionalTime(value pgtype.Timestamptz) *time.Time {
+	if !value.Valid {
+		return nil
+	}
+	out := value.Time
+	return &out
+}
@@ -0,0 +1,23 @@
+package dbmodel
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// StaffAPITokenRow mirrors one staff_api_tokens row selected from PostgreSQL.
+type StaffAPITokenRow struct {
+	ID          string             `db:"id"`
+	UserID      string             `db:"user_id"`
+	UserEmail   string             `db:"user_email"`
+	Name        string             `db:"name"`
+	TokenPrefix string             `db:"token_prefix"`
+	Scopes      []string           `db:"scopes"`
+	ProjectIDs  []string           `db:"project_ids"`
+	ExpiresAt   pgtype.Timestamptz `db:"expires_at"`
+	LastUsedAt  pgtype.Timestamptz `db:"last_used_at"`
+	RevokedAt   pgtype.Timestamptz `db:"revoked_at"`
+	CreatedBy   string             `db:"created_by"`
+	CreatedAt   time.Time          `db:"created_at"`
+}
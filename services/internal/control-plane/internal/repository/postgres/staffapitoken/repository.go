@@ -0,0 +1,113 @@
+package staffapitoken
+
+import (
+	"context"
+	_ "embed"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/codex-k8s/kodex/libs/go/postgres"
+	domainrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/staffapitoken"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/repository/postgres/staffapitoken/dbmodel"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var (
+	//go:embed sql/create.sql
+	queryCreate string
+	//go:embed sql/list.sql
+	queryList string
+	//go:embed sql/revoke.sql
+	queryRevoke string
+	//go:embed sql/get_active_by_hash.sql
+	queryGetActiveByHash string
+	//go:embed sql/touch_last_used.sql
+	queryTouchLastUsed string
+)
+
+// Repository stores hashed staff API tokens in PostgreSQL.
+type Repository struct {
+	db *pgxpool.Pool
+}
+
+// NewRepository constructs PostgreSQL staff API token repository.
+func NewRepository(db *pgxpool.Pool) *Repository {
+	return &Repository{db: db}
+}
+
+// Create stores a new token.
+func (r *Repository) Create(ctx context.Context, params domainrepo.CreateParams) (domainrepo.Token, error) {
+	projectIDs := params.ProjectIDs
+	if projectIDs == nil {
+		projectIDs = []string{}
+	}
+	rows, err := r.db.Query(
+		ctx,
+		queryCreate,
+		strings.TrimSpace(params.UserID),
+		strings.TrimSpace(params.Name),
+		params.TokenPrefix,
+		params.TokenHash,
+		params.Scopes,
+		projectIDs,
+		params.ExpiresAt,
+		strings.TrimSpace(params.CreatedBy),
+	)
+	if err != nil {
+		return domainrepo.Token{}, fmt.Errorf("create staff api token: %w", err)
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.StaffAPITokenRow])
+	if err != nil {
+		return domainrepo.Token{}, fmt.Errorf("collect created staff api token: %w", err)
+	}
+	return fromDBModel(row), nil
+}
+
+// List returns tokens ordered by creation time, newest first.
+func (r *Repository) List(ctx context.Context, filter domainrepo.ListFilter) ([]domainrepo.Token, error) {
+	rows, err := r.db.Query(ctx, queryList, strings.TrimSpace(filter.UserID), filter.Limit)
+	if err != nil {
+		return nil, fmt.Errorf("list staff api tokens: %w", err)
+	}
+	items, err := pgx.CollectRows(rows, pgx.RowToStructByName[dbmodel.StaffAPITokenRow])
+	if err != nil {
+		return nil, fmt.Errorf("collect staff api tokens: %w", err)
+	}
+	out := make([]domainrepo.Token, 0, len(items))
+	for _, item := range items {
+		out = append(out, fromDBModel(item))
+	}
+	return out, nil
+}
+
+// Revoke marks token revoked.
+func (r *Repository) Revoke(ctx context.Context, tokenID string) (bool, error) {
+	res, err := r.db.Exec(ctx, queryRevoke, strings.TrimSpace(tokenID))
+	if err != nil {
+		return false, fmt.Errorf("revoke staff api token: %w", err)
+	}
+	return res.RowsAffected() > 0, nil
+}
+
+// GetActiveByHash returns token that is neither revoked nor expired.
+func (r *Repository) GetActiveByHash(ctx context.Context, tokenHash []byte) (domainrepo.Token, bool, error) {
+	rows, err := r.db.Query(ctx, queryGetActiveByHash, tokenHash)
+	if err != nil {
+		return domainrepo.Token{}, false, fmt.Errorf("get staff api token by hash: %w", err)
+	}
+	row, err := pgx.CollectOneRow(rows, pgx.RowToStructByName[dbmodel.StaffAPITokenRow])
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainrepo.Token{}, false, nil
+		}
+		return domainrepo.Token{}, false, fmt.Errorf("collect staff api token by hash: %w", err)
+	}
+	return fromDBModel(row), true, nil
+}
+
+// TouchLastUsed records token usage at most once per minute.
+func (r *Repository) TouchLastUsed(ctx context.Context, tokenID string) error {
+	return postgres.ExecOrWrap(ctx, r.db, queryTouchLastUsed, "touch staff api token last used", strings.TrimSpace(tokenID))
+}
@@ -0,0 +1,38 @@
+-- name: staffapitoken__create :one
+WITH inserted AS (
+    INSERT INTO staff_api_tokens (
+        user_id,
+        name,
+        token_prefix,
+        token_hash,
+        scopes,
+        project_ids,
+        expires_at,
+        created_by
+    ) VALUES (
+        $1::uuid,
+        $2,
+        $3,
+        $4,
+        $5::text[],
+        $6::uuid[],
+        $7,
+        NULLIF($8::text, '')::uuid
+    )
+    RETURNING *
+)
+SELECT
+    t.id::text AS id,
+    t.user_id::text AS user_id,
+    COALESCE(u.email, '') AS user_email,
+    t.name,
+    t.token_prefix,
+    t.scopes,
+    t.project_ids::text[] AS project_ids,
+    t.expires_at,
+    t.last_used_at,
+    t.revoked_at,
+    COALESCE(t.created_by::text, '') AS created_by,
+    t.created_at
+FROM inserted t
+LEFT JOIN users u ON u.id = t.user_id;
@@ -0,0 +1,20 @@
+-- name: staffapitoken__get_active_by_hash :one
+SELECT
+    t.id::text AS id,
+    t.user_id::text AS user_id,
+    COALESCE(u.email, '') AS user_email,
+    t.name,
+    t.token_prefix,
+    t.scopes,
+    t.project_ids::text[] AS project_ids,
+    t.expires_at,
+    t.last_used_at,
+    t.revoked_at,
+    COALESCE(t.created_by::text, '') AS created_by,
+    t.created_at
+FROM staff_api_tokens t
+JOIN users u ON u.id = t.user_id
+WHERE t.token_hash = $1
+  AND t.revoked_at IS NULL
+  AND (t.expires_at IS NULL OR t.expires_at > NOW())
+LIMIT 1;
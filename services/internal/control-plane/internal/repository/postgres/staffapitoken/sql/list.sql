@@ -0,0 +1,19 @@
+-- name: staffapitoken__list :many
+SELECT
+    t.id::text AS id,
+    t.user_id::text AS user_id,
+    COALESCE(u.email, '') AS user_email,
+    t.name,
+    t.token_prefix,
+    t.scopes,
+    t.project_ids::text[] AS project_ids,
+    t.expires_at,
+    t.last_used_at,
+    t.revoked_at,
+    COALESCE(t.created_by::text, '') AS created_by,
+    t.created_at
+FROM staff_api_tokens t
+LEFT JOIN users u ON u.id = t.user_id
+WHERE $1::text = '' OR t.user_id::text = $1::text
+ORDER BY t.created_at DESC
+LIMIT $2;
@@ -0,0 +1,5 @@
+-- name: staffapitoken__revoke :execrows
+UPDATE staff_api_tokens
+SET revoked_at = NOW()
+WHERE id = $1::uuid
+  AND revoked_at IS NULL;
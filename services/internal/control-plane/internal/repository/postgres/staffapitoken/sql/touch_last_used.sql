@@ -0,0 +1,5 @@
+-- name: staffapitoken__touch_last_used :exec
+UPDATE staff_api_tokens
+SET last_used_at = NOW()
+WHERE id = $1::uuid
+  AND (last_used_at IS NULL OR last_used_at < NOW() - INTERVAL '1 minute');
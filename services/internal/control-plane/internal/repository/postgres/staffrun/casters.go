@@ -72,6 +72,17 @@ func runFromDBModel(row dbmodel.RunRow) domainrepo.Run {
 	return item
 }
 
+func applyRunQueueRow(item *domainrepo.Run, row dbmodel.RunQueueRow) {
+	item.Priority = int(row.Priority)
+	if row.QueuePosition.Valid {
+		item.QueuePosition = int(row.QueuePosition.Int64)
+	}
+	if row.EstimatedWaitSeconds.Valid {
+		v := row.EstimatedWaitSeconds.Int64
+		item.EstimatedWaitSeconds = &v
+	}
+}
+
 func runLogsFromDBModel(row dbmodel.RunLogsRow) domainrepo.RunLogs {
 	item := domainrepo.RunLogs{
 		RunID:        row.RunID,
@@ -87,9 +98,11 @@ func runLogsFromDBModel(row dbmodel.RunLogsRow) domainrepo.RunLogs {
 
 func flowEventFromDBModel(row dbmodel.FlowEventRow) domainrepo.FlowEvent {
 	return domainrepo.FlowEvent{
-		CorrelationID: row.CorrelationID,
-		EventType:     row.EventType,
-		CreatedAt:     row.CreatedAt,
-		PayloadJSON:   []byte(row.PayloadText),
+		ID:              row.ID,
+		CorrelationID:   row.CorrelationID,
+		EventType:       row.EventType,
+		CreatedAt:       row.CreatedAt,
+		PayloadJSON:     []byte(row.PayloadText),
+		RetentionPinned: row.RetentionPinned,
 	}
 }
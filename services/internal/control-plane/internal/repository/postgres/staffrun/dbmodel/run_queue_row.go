@@ -0,0 +1,10 @@
+package dbmodel
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+// RunQueueRow mirrors one run queue snapshot selected from PostgreSQL.
+type RunQueueRow struct {
+	Priority             int32       `db:"priority"`
+	QueuePosition        pgtype.Int8 `db:"queue_position"`
+	EstimatedWaitSeconds pgtype.Int8 `db:"estimated_wait_seconds"`
+}
@@ -33,6 +33,8 @@ var (
 	queryListWaitsForUser string
 	//go:embed sql/get_by_id.sql
 	queryGetByID string
+	//go:embed sql/get_queue_by_run_id.sql
+	queryGetQueueByRunID string
 	//go:embed sql/get_logs_by_run_id.sql
 	queryGetLogsByRunID string
 	//go:embed sql/list_events_by_correlation.sql
@@ -133,9 +135,21 @@ func (r *Repository) ListWaitsForUser(ctx context.Context, userID string, filter
 	return collectRuns(rows, "run waits for user")
 }
 
-// GetByID returns a run by id.
+// GetByID returns a run by id together with its queue snapshot.
 func (r *Repository) GetByID(ctx context.Context, runID string) (domainrepo.Run, bool, error) {
-	return queryOneMappedByID(ctx, r.db, queryGetByID, runID, "run", runFromDBModel)
+	item, ok, err := queryOneMappedByID(ctx, r.db, queryGetByID, runID, "run", runFromDBModel)
+	if err != nil || !ok {
+		return item, ok, err
+	}
+
+	queueRow, ok, err := queryOneRowByID[dbmodel.RunQueueRow](ctx, r.db, queryGetQueueByRunID, runID, "run queue")
+	if err != nil {
+		return domainrepo.Run{}, false, err
+	}
+	if ok {
+		applyRunQueueRow(&item, queueRow)
+	}
+	return item, true, nil
 }
 
 // GetLogsByRunID returns one run logs snapshot by run id.
@@ -0,0 +1,91 @@
+-- name: staffrun__get_queue_by_run_id :one
+-- Queue snapshot for one run:
+-- - priority of the run;
+-- - approximate 1-based position among claimable pending runs, using the worker claim key
+--   (priority DESC, project running/queue_weight share ASC, created_at ASC). The claim also skips
+--   projects without a free slot and the shares change as runs start, so the position is an estimate;
+-- - estimated wait: full slot waves ahead of the run in its project multiplied by the average
+--   duration of the project runs finished in the last 7 days (NULL without history).
+-- Position and estimate are NULL for non-pending runs.
+WITH running AS (
+    SELECT project_id, COUNT(*) AS running_count
+    FROM agent_runs
+    WHERE status = 'running'
+      AND project_id IS NOT NULL
+    GROUP BY project_id
+),
+pending AS (
+    SELECT
+        ar.id,
+        ar.priority,
+        ar.created_at,
+        ar.not_before,
+        COALESCE(r.running_count, 0)::numeric / (
+            CASE
+                WHEN jsonb_typeof(p.settings->'queue_weight') = 'number'
+                     AND (p.settings->>'queue_weight')::numeric > 0
+                    THEN (p.settings->>'queue_weight')::numeric
+                ELSE 1
+            END
+        ) AS share
+    FROM agent_runs ar
+    LEFT JOIN projects p ON p.id = ar.project_id
+    LEFT JOIN running r ON r.project_id = ar.project_id
+    WHERE ar.status = 'pending'
+)
+SELECT
+    t.priority::int AS priority,
+    CASE
+        WHEN t.status = 'pending' THEN pos.ahead_total + 1
+        ELSE NULL
+    END AS queue_position,
+    CASE
+        WHEN t.status = 'pending' AND hist.avg_seconds IS NOT NULL
+            THEN (
+                FLOOR((qa.ahead_in_project + qa.running_in_project)::numeric / GREATEST(cap.slots, 1))
+                * hist.avg_seconds
+            )::bigint
+        ELSE NULL
+    END AS estimated_wait_seconds
+FROM agent_runs t
+LEFT JOIN pending tp ON tp.id = t.id
+LEFT JOIN LATERAL (
+    SELECT COUNT(*) AS ahead_total
+    FROM pending ap
+    WHERE ap.id <> t.id
+      AND (ap.not_before IS NULL OR ap.not_before <= NOW())
+      AND (
+            ap.priority > tp.priority
+            OR (ap.priority = tp.priority AND ap.share < tp.share)
+            OR (ap.priority = tp.priority AND ap.share = tp.share AND ap.created_at < tp.created_at)
+      )
+) pos ON true
+LEFT JOIN LATERAL (
+    SELECT
+        COUNT(*) FILTER (
+            WHERE ar.status = 'pending'
+              AND ar.id <> t.id
+              AND ar.project_id = t.project_id
+              AND (ar.priority > t.priority OR (ar.priority = t.priority AND ar.created_at < t.created_at))
+        ) AS ahead_in_project,
+        COUNT(*) FILTER (
+            WHERE ar.status = 'running'
+              AND ar.project_id = t.project_id
+        ) AS running_in_project
+    FROM agent_runs ar
+    WHERE ar.status IN ('pending', 'running')
+) qa ON true
+LEFT JOIN LATERAL (
+    SELECT COUNT(*) AS slots
+    FROM slots s
+    WHERE s.project_id = t.project_id
+) cap ON true
+LEFT JOIN LATERAL (
+    SELECT AVG(EXTRACT(EPOCH FROM (ar.finished_at - ar.started_at))) AS avg_seconds
+    FROM agent_runs ar
+    WHERE ar.project_id = t.project_id
+      AND ar.started_at IS NOT NULL
+      AND ar.finished_at IS NOT NULL
+      AND ar.finished_at >= NOW() - INTERVAL '7 days'
+) hist ON true
+WHERE t.id = $1::uuid;
@@ -1,5 +1,5 @@
 -- name: staffrun__list_events_by_correlation :many
-SELECT correlation_id, event_type, created_at, payload::text
+SELECT id, correlation_id, event_type, created_at, payload::text, retention_pinned
 FROM flow_events
 WHERE correlation_id = $1
 ORDER BY created_at DESC
@@ -30,6 +30,14 @@ var (
 	queryListUsers string
 	//go:embed sql/delete_by_id.sql
 	queryDeleteByID string
+	//go:embed sql/get_by_oidc_identity.sql
+	queryGetByOIDCIdentity string
+	//go:embed sql/update_oidc_identity.sql
+	queryUpdateOIDCIdentity string
+	//go:embed sql/sync_oidc_platform_admin.sql
+	querySyncOIDCPlatformAdmin string
+	//go:embed sql/create_service_account.sql
+	queryCreateServiceAccount string
 )
 
 // Repository stores staff users in PostgreSQL.
@@ -91,6 +99,11 @@ func (r *Repository) CreateAllowedUser(ctx context.Context, email string, isPlat
 	return u, nil
 }
 
+// CreateServiceAccount creates a service-account user; ok is false when email is already taken.
+func (r *Repository) CreateServiceAccount(ctx context.Context, email string, isPlatformAdmin bool) (domainrepo.User, bool, error) {
+	return r.getOne(ctx, queryCreateServiceAccount, "create service account", email, isPlatformAdmin)
+}
+
 // List returns all users.
 func (r *Repository) List(ctx context.Context, limit int) ([]domainrepo.User, error) {
 	if limit <= 0 {
@@ -105,7 +118,7 @@ func (r *Repository) List(ctx context.Context, limit int) ([]domainrepo.User, er
 	var out []domainrepo.User
 	for rows.Next() {
 		var u domainrepo.User
-		if err := rows.Scan(&u.ID, &u.Email, &u.GitHubUserID, &u.GitHubLogin, &u.IsPlatformAdmin, &u.IsPlatformOwner); err != nil {
+		if err := rows.Scan(&u.ID, &u.Email, &u.GitHubUserID, &u.GitHubLogin, &u.IsPlatformAdmin, &u.IsPlatformOwner, &u.IsServiceAccount); err != nil {
 			return nil, fmt.Errorf("scan user: %w", err)
 		}
 		out = append(out, u)
@@ -121,13 +134,34 @@ func (r *Repository) DeleteByID(ctx context.Context, userID string) error {
 	return postgres.ExecRequireRowOrWrap(ctx, r.db, queryDeleteByID, "delete user by id", userID)
 }
 
+// GetByOIDCIdentity returns a user linked to OIDC issuer and subject.
+func (r *Repository) GetByOIDCIdentity(ctx context.Context, issuer string, subject string) (domainrepo.User, bool, error) {
+	return r.getOne(ctx, queryGetByOIDCIdentity, "get by oidc identity", issuer, subject)
+}
+
+// UpdateOIDCIdentity links OIDC issuer and subject to an existing user; ok is false when
+// the user is already linked to another OIDC identity.
+func (r *Repository) UpdateOIDCIdentity(ctx context.Context, userID string, issuer string, subject string) (bool, error) {
+	_, ok, err := r.getOne(ctx, queryUpdateOIDCIdentity, "update oidc identity", userID, issuer, subject)
+	return ok, err
+}
+
+// SyncOIDCPlatformAdmin grants or revokes OIDC-managed platform admin flag and returns effective value.
+func (r *Repository) SyncOIDCPlatformAdmin(ctx context.Context, userID string, grant bool) (bool, error) {
+	var isPlatformAdmin bool
+	if err := r.db.QueryRow(ctx, querySyncOIDCPlatformAdmin, userID, grant).Scan(&isPlatformAdmin); err != nil {
+		return false, fmt.Errorf("sync oidc platform admin: %w", err)
+	}
+	return isPlatformAdmin, nil
+}
+
 type rowScanner interface {
 	Scan(dest ...any) error
 }
 
 func scanUser(row rowScanner) (domainrepo.User, error) {
 	var u domainrepo.User
-	if err := row.Scan(&u.ID, &u.Email, &u.GitHubUserID, &u.GitHubLogin, &u.IsPlatformAdmin, &u.IsPlatformOwner); err != nil {
+	if err := row.Scan(&u.ID, &u.Email, &u.GitHubUserID, &u.GitHubLogin, &u.IsPlatformAdmin, &u.IsPlatformOwner, &u.IsServiceAccount); err != nil {
 		return domainrepo.User{}, err
 	}
 	return u, nil
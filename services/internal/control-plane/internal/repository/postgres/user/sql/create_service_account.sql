@@ -0,0 +1,5 @@
+-- name: user__create_service_account :one
+INSERT INTO users (email, is_platform_admin, is_service_account)
+VALUES (LOWER($1), $2, true)
+ON CONFLICT (email) DO NOTHING
+RETURNING id, email, COALESCE(github_user_id, 0) AS github_user_id, COALESCE(github_login, '') AS github_login, is_platform_admin, is_platform_owner, is_service_account;
@@ -14,4 +14,4 @@ ON CONFLICT (email) DO UPDATE
 SET is_platform_admin = TRUE,
     is_platform_owner = TRUE,
     updated_at = NOW()
-RETURNING id, email, COALESCE(github_user_id, 0) AS github_user_id, COALESCE(github_login, '') AS github_login, is_platform_admin, is_platform_owner;
+RETURNING id, email, COALESCE(github_user_id, 0) AS github_user_id, COALESCE(github_login, '') AS github_login, is_platform_admin, is_platform_owner, is_service_account;
@@ -1,4 +1,4 @@
 -- name: user__get_by_email :one
-SELECT id, email, COALESCE(github_user_id, 0) AS github_user_id, COALESCE(github_login, '') AS github_login, is_platform_admin, is_platform_owner
+SELECT id, email, COALESCE(github_user_id, 0) AS github_user_id, COALESCE(github_login, '') AS github_login, is_platform_admin, is_platform_owner, is_service_account
 FROM users
 WHERE email = LOWER($1);
@@ -0,0 +1,5 @@
+-- name: user__get_by_oidc_identity :one
+SELECT id, email, COALESCE(github_user_id, 0) AS github_user_id, COALESCE(github_login, '') AS github_login, is_platform_admin, is_platform_owner, is_service_account
+FROM users
+WHERE oidc_issuer = $1
+  AND oidc_subject = $2;
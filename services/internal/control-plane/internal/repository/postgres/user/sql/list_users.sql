@@ -1,5 +1,5 @@
 -- name: user__list_users :many
-SELECT id, email, COALESCE(github_user_id, 0) AS github_user_id, COALESCE(github_login, '') AS github_login, is_platform_admin, is_platform_owner
+SELECT id, email, COALESCE(github_user_id, 0) AS github_user_id, COALESCE(github_login, '') AS github_login, is_platform_admin, is_platform_owner, is_service_account
 FROM users
 ORDER BY is_platform_owner DESC, is_platform_admin DESC, email ASC
 LIMIT $1;
@@ -0,0 +1,14 @@
+-- name: user__sync_oidc_platform_admin :one
+UPDATE users
+SET is_platform_admin = CASE
+        WHEN $2::boolean THEN true
+        WHEN is_platform_admin_oidc THEN false
+        ELSE is_platform_admin
+    END,
+    is_platform_admin_oidc = CASE
+        WHEN $2::boolean THEN (is_platform_admin_oidc OR NOT is_platform_admin)
+        ELSE false
+    END,
+    updated_at = NOW()
+WHERE id = $1::uuid
+RETURNING is_platform_admin;
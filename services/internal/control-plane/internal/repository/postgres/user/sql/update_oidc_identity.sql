@@ -0,0 +1,11 @@
+-- name: user__update_oidc_identity :one
+UPDATE users
+SET oidc_issuer = $2,
+    oidc_subject = $3,
+    updated_at = NOW()
+WHERE id = $1::uuid
+  AND (
+      (oidc_issuer IS NULL AND oidc_subject IS NULL)
+      OR (oidc_issuer = $2 AND oidc_subject = $3)
+  )
+RETURNING id, email, COALESCE(github_user_id, 0) AS github_user_id, COALESCE(github_login, '') AS github_login, is_platform_admin, is_platform_owner, is_service_account;
@@ -11,6 +11,7 @@ import (
 
 	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
 	"github.com/codex-k8s/kodex/libs/go/errs"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
 	agentcallbackdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/agentcallback"
 	changegovernancedomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/changegovernance"
@@ -19,6 +20,7 @@ import (
 	missioncontroldomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/missioncontrol"
 	missioncontrolworkerdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/missioncontrolworker"
 	agentrunrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/agentrun"
+	retentiondomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/retention"
 	runstatusdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/runstatus"
 	runtimedeploydomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/runtimedeploy"
 	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/staff"
@@ -36,6 +38,7 @@ import (
 
 type webhookIngress interface {
 	IngestGitHubWebhook(ctx context.Context, cmd webhook.IngestCommand) (webhook.IngestResult, error)
+	IngestAlertmanagerWebhook(ctx context.Context, cmd webhook.AlertmanagerIngestCommand) (webhook.AlertmanagerIngestResult, error)
 }
 
 type mcpRunTokenService interface {
@@ -43,11 +46,14 @@ type mcpRunTokenService interface {
 	VerifyRunToken(ctx context.Context, rawToken string) (mcpdomain.SessionContext, error)
 	VerifyInteractionCallbackToken(ctx context.Context, rawToken string, interactionID string) (mcpdomain.SessionContext, error)
 	ListPendingApprovals(ctx context.Context, limit int) ([]mcpdomain.ApprovalListItem, error)
+	GetApproval(ctx context.Context, requestID int64) (mcpdomain.ApprovalListItem, bool, error)
 	ResolveApproval(ctx context.Context, params mcpdomain.ResolveApprovalParams) (mcpdomain.ResolveApprovalResult, error)
 	ClaimNextInteractionDispatch(ctx context.Context, params mcpdomain.ClaimNextInteractionDispatchParams) (mcpdomain.InteractionDispatchClaim, bool, error)
 	CompleteInteractionDispatch(ctx context.Context, params mcpdomain.CompleteInteractionDispatchParams) (mcpdomain.CompleteInteractionDispatchResult, error)
 	ExpireNextDueInteraction(ctx context.Context) (mcpdomain.ExpireNextInteractionResult, bool, error)
 	SubmitInteractionCallback(ctx context.Context, params mcpdomain.SubmitInteractionCallbackParams) (mcpdomain.SubmitInteractionCallbackResult, error)
+	SuspendRunForApproval(ctx context.Context, runID string) (mcpdomain.SuspendRunForApprovalResult, error)
+	ProjectMCPServers(ctx context.Context, session mcpdomain.SessionContext) ([]servicescfg.AgentMCPServer, error)
 }
 
 type agentCallbackService interface {
@@ -55,6 +61,7 @@ type agentCallbackService interface {
 	GetLatestAgentSession(ctx context.Context, query agentcallbackdomain.GetLatestAgentSessionQuery) (agentcallbackdomain.Session, bool, error)
 	GetRunInteractionResumePayload(ctx context.Context, runID string) (json.RawMessage, bool, error)
 	GetRunGitHubRateLimitResumePayload(ctx context.Context, runID string) (json.RawMessage, bool, error)
+	GetRunApprovalResumePayload(ctx context.Context, runID string) (json.RawMessage, bool, error)
 	LookupPullRequest(ctx context.Context, query agentcallbackdomain.LookupPullRequestQuery) (agentcallbackdomain.PullRequestLookupResult, bool, error)
 	InsertRunFlowEvent(ctx context.Context, params agentcallbackdomain.InsertRunFlowEventParams) error
 }
@@ -75,6 +82,10 @@ type missionControlWorkerService interface {
 	ClaimPendingCommands(ctx context.Context, workerID string, leaseTTL time.Duration, limit int) ([]missioncontrolworkerdomain.PendingCommand, error)
 }
 
+type retentionSweepService interface {
+	RunSweep(ctx context.Context, params retentiondomain.SweepParams) (retentiondomain.SweepResult, error)
+}
+
 type githubRateLimitService interface {
 	ReportSignal(ctx context.Context, params githubratelimitdomain.ReportSignalParams) (githubratelimitdomain.ReportSignalResult, error)
 	ProcessNextAutoResume(ctx context.Context, params githubratelimitdomain.ProcessNextAutoResumeParams) (githubratelimitdomain.ProcessNextAutoResumeResult, error)
@@ -107,6 +118,7 @@ type Dependencies struct {
 	GitHubRateLimit      githubRateLimitService
 	MissionControl       missionControlWorkerService
 	MissionControlDomain missioncontroldomain.DomainService
+	Retention            retentionSweepService
 	RunStatus            runStatusService
 	Runs                 runReader
 	RuntimeDeploy        runtimeDeployService
@@ -127,6 +139,7 @@ type Server struct {
 	githubRateLimit      githubRateLimitService
 	missionControl       missionControlWorkerService
 	missionControlDomain missioncontroldomain.DomainService
+	retention            retentionSweepService
 	runStatus            runStatusService
 	runs                 runReader
 	runtimeDeploy        runtimeDeployService
@@ -145,6 +158,7 @@ func NewServer(deps Dependencies) *Server {
 	server.githubRateLimit = deps.GitHubRateLimit
 	server.missionControl = deps.MissionControl
 	server.missionControlDomain = deps.MissionControlDomain
+	server.retention = deps.Retention
 	server.runStatus = deps.RunStatus
 	server.runs = deps.Runs
 	server.runtimeDeploy = deps.RuntimeDeploy
@@ -177,6 +191,38 @@ func (s *Server) IngestGitHubWebhook(ctx context.Context, req *controlplanev1.In
 	}, nil
 }
 
+func (s *Server) IngestAlertmanagerWebhook(ctx context.Context, req *controlplanev1.IngestAlertmanagerWebhookRequest) (*controlplanev1.IngestAlertmanagerWebhookResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	res, err := s.webhook.IngestAlertmanagerWebhook(ctx, webhook.AlertmanagerIngestCommand{
+		CorrelationID: strings.TrimSpace(req.CorrelationId),
+		ProjectID:     strings.TrimSpace(req.ProjectId),
+		ReceivedAt:    tsToTime(req.ReceivedAt),
+		Payload:       req.PayloadJson,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	incidents := make([]*controlplanev1.AlertIncidentOutcome, 0, len(res.Incidents))
+	for _, item := range res.Incidents {
+		incidents = append(incidents, &controlplanev1.AlertIncidentOutcome{
+			IncidentId:    item.IncidentID,
+			Fingerprint:   item.Fingerprint,
+			AlertName:     item.AlertName,
+			Action:        string(item.Action),
+			IssueUrl:      item.IssueURL,
+			RunId:         item.RunID,
+			RunSkipReason: item.RunSkipReason,
+		})
+	}
+	return &controlplanev1.IngestAlertmanagerWebhookResponse{
+		CorrelationId: res.CorrelationID,
+		Status:        string(res.Status),
+		Incidents:     incidents,
+	}, nil
+}
+
 func (s *Server) ResolveStaffByEmail(ctx context.Context, req *controlplanev1.ResolveStaffByEmailRequest) (*controlplanev1.ResolveStaffByEmailResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
@@ -192,6 +238,18 @@ func (s *Server) ResolveStaffByEmail(ctx context.Context, req *controlplanev1.Re
 	return &controlplanev1.ResolveStaffByEmailResponse{Principal: userToPrincipal(u)}, nil
 }
 
+func (s *Server) ResolveStaffByGitHubLogin(ctx context.Context, req *controlplanev1.ResolveStaffByGitHubLoginRequest) (*controlplanev1.ResolveStaffByGitHubLoginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	u, err := s.staff.ResolveStaffByGitHubLogin(ctx, req.GetGithubLogin())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &controlplanev1.ResolveStaffByGitHubLoginResponse{Principal: userToPrincipal(u)}, nil
+}
+
 func (s *Server) AuthorizeOAuthUser(ctx context.Context, req *controlplanev1.AuthorizeOAuthUserRequest) (*controlplanev1.AuthorizeOAuthUserResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "request is required")
@@ -208,6 +266,24 @@ func (s *Server) AuthorizeOAuthUser(ctx context.Context, req *controlplanev1.Aut
 	return &controlplanev1.AuthorizeOAuthUserResponse{Principal: userToPrincipal(u)}, nil
 }
 
+func (s *Server) AuthorizeOIDCUser(ctx context.Context, req *controlplanev1.AuthorizeOIDCUserRequest) (*controlplanev1.AuthorizeOIDCUserResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	u, err := s.staff.AuthorizeOIDCUser(ctx, querytypes.StaffAuthorizeOIDCUserParams{
+		Issuer:            strings.TrimSpace(req.GetIssuer()),
+		Subject:           strings.TrimSpace(req.GetSubject()),
+		Email:             strings.TrimSpace(req.GetEmail()),
+		PreferredUsername: strings.TrimSpace(req.GetPreferredUsername()),
+		Groups:            req.GetGroups(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &controlplanev1.AuthorizeOIDCUserResponse{Principal: userToPrincipal(u)}, nil
+}
+
 func (s *Server) authenticateRunToken(ctx context.Context) (mcpdomain.SessionContext, error) {
 	if s.mcp == nil {
 		return mcpdomain.SessionContext{}, status.Error(codes.FailedPrecondition, "mcp service is not configured")
@@ -292,6 +368,13 @@ func optionalTime(ts *timestamppb.Timestamp) *time.Time {
 	return &value
 }
 
+func optionalTimestamp(value *time.Time) *timestamppb.Timestamp {
+	if value == nil {
+		return nil
+	}
+	return timestamppb.New(value.UTC())
+}
+
 type delete1Fn func(context.Context, staff.Principal, string) error
 type delete2Fn func(context.Context, staff.Principal, string, string) error
 
@@ -340,6 +423,18 @@ func userToPrincipal(u entitytypes.User) *controlplanev1.Principal {
 	}
 }
 
+func userToProto(u entitytypes.User) *controlplanev1.User {
+	return &controlplanev1.User{
+		Id:               u.ID,
+		Email:            u.Email,
+		GithubUserId:     int64PtrOrNil(u.GitHubUserID),
+		GithubLogin:      stringPtrOrNil(u.GitHubLogin),
+		IsPlatformAdmin:  u.IsPlatformAdmin,
+		IsPlatformOwner:  u.IsPlatformOwner,
+		IsServiceAccount: u.IsServiceAccount,
+	}
+}
+
 func toStatus(err error) error {
 	if err == nil {
 		return nil
@@ -484,6 +579,12 @@ func runToProto(r entitytypes.StaffRun) *controlplanev1.Run {
 		WaitReason:      stringPtrOrNil(r.WaitReason),
 		Status:          r.Status,
 		CreatedAt:       timestamppb.New(r.CreatedAt.UTC()),
+		Priority:        int32PtrOrNil(int32(r.Priority)),
+		QueuePosition:   int32PtrOrNil(int32(r.QueuePosition)),
+	}
+	if r.EstimatedWaitSeconds != nil {
+		v := *r.EstimatedWaitSeconds
+		out.EstimatedWaitSeconds = &v
 	}
 	if r.StartedAt != nil {
 		out.StartedAt = timestamppb.New(r.StartedAt.UTC())
@@ -519,6 +620,7 @@ func runtimeErrorToProto(item entitytypes.RuntimeError) *controlplanev1.RuntimeE
 		JobName:       stringPtrOrNil(item.JobName),
 		ViewedBy:      stringPtrOrNil(item.ViewedBy),
 		CreatedAt:     timestamppb.New(item.CreatedAt.UTC()),
+		GroupId:       stringPtrOrNil(item.GroupID),
 	}
 	if item.ViewedAt != nil {
 		out.ViewedAt = timestamppb.New(item.ViewedAt.UTC())
@@ -526,6 +628,38 @@ func runtimeErrorToProto(item entitytypes.RuntimeError) *controlplanev1.RuntimeE
 	return out
 }
 
+func runtimeErrorGroupToProto(item entitytypes.RuntimeErrorGroup) *controlplanev1.RuntimeErrorGroup {
+	return &controlplanev1.RuntimeErrorGroup{
+		Id:                 item.ID,
+		ProjectId:          stringPtrOrNil(item.ProjectID),
+		Fingerprint:        item.Fingerprint,
+		Source:             item.Source,
+		ErrorClass:         item.ErrorClass,
+		NormalizedMessage:  item.NormalizedMessage,
+		SampleMessage:      item.SampleMessage,
+		Level:              item.Level,
+		Status:             string(item.Status),
+		MutedUntil:         optionalTimestamp(item.MutedUntil),
+		StatusChangedAt:    optionalTimestamp(item.StatusChangedAt),
+		StatusChangedBy:    stringPtrOrNil(item.StatusChangedBy),
+		ResolvedAt:         optionalTimestamp(item.ResolvedAt),
+		Occurrences:        item.Occurrences,
+		RegressionCount:    int32(item.RegressionCount),
+		AffectedRunCount:   int32(item.AffectedRunCount),
+		FirstSeenAt:        timestamppb.New(item.FirstSeenAt.UTC()),
+		LastSeenAt:         timestamppb.New(item.LastSeenAt.UTC()),
+		LastRunId:          stringPtrOrNil(item.LastRunID),
+		EscalationKind:     string(item.EscalationKind),
+		EscalatedAt:        optionalTimestamp(item.EscalatedAt),
+		EscalatedBy:        stringPtrOrNil(item.EscalatedBy),
+		RepositoryFullName: stringPtrOrNil(item.RepositoryFullName),
+		IssueNumber:        int64PtrOrNil(item.IssueNumber),
+		IssueUrl:           stringPtrOrNil(item.IssueURL),
+		CreatedAt:          timestamppb.New(item.CreatedAt.UTC()),
+		UpdatedAt:          timestamppb.New(item.UpdatedAt.UTC()),
+	}
+}
+
 func runtimeDeployTaskToProto(item entitytypes.RuntimeDeployTask) *controlplanev1.RuntimeDeployTask {
 	out := &controlplanev1.RuntimeDeployTask{
 		RunId:                item.RunID,
@@ -595,6 +729,36 @@ func runActionToProto(item staff.RunCancelResult) *controlplanev1.RunActionRespo
 	}
 }
 
+func runtimeDeployPreviewToProto(item runtimedeploydomain.PreviewResult) *controlplanev1.PreviewRuntimeDeployResponse {
+	objects := make([]*controlplanev1.RuntimeDeployPreviewObject, 0, len(item.Objects))
+	for _, object := range item.Objects {
+		objects = append(objects, &controlplanev1.RuntimeDeployPreviewObject{
+			Unit:          object.Unit,
+			ApiVersion:    object.APIVersion,
+			Kind:          object.Kind,
+			Namespace:     object.Namespace,
+			Name:          object.Name,
+			Action:        string(object.Action),
+			ChangedFields: append([]string(nil), object.ChangedFields...),
+		})
+	}
+	images := make([]*controlplanev1.RuntimeDeployPreviewImage, 0, len(item.Images))
+	for _, image := range item.Images {
+		images = append(images, &controlplanev1.RuntimeDeployPreviewImage{
+			Name:     image.Name,
+			ImageRef: image.ImageRef,
+			Action:   string(image.Action),
+		})
+	}
+	return &controlplanev1.PreviewRuntimeDeployResponse{
+		Namespace: item.Namespace,
+		TargetEnv: item.TargetEnv,
+		BuildRef:  item.BuildRef,
+		Objects:   objects,
+		Images:    images,
+	}
+}
+
 func runtimeDeployLogsToProto(items []entitytypes.RuntimeDeployTaskLogEntry) []*controlplanev1.RuntimeDeployTaskLog {
 	if len(items) == 0 {
 		return []*controlplanev1.RuntimeDeployTaskLog{}
@@ -615,7 +779,7 @@ func runtimeDeployLogsToProto(items []entitytypes.RuntimeDeployTaskLogEntry) []*
 }
 
 func approvalToProto(item mcpdomain.ApprovalListItem) *controlplanev1.ApprovalRequest {
-	return &controlplanev1.ApprovalRequest{
+	out := &controlplanev1.ApprovalRequest{
 		Id:            item.ID,
 		CorrelationId: item.CorrelationID,
 		RunId:         stringPtrOrNil(item.RunID),
@@ -630,7 +794,22 @@ func approvalToProto(item mcpdomain.ApprovalListItem) *controlplanev1.ApprovalRe
 		ApprovalMode:  item.ApprovalMode,
 		RequestedBy:   item.RequestedBy,
 		CreatedAt:     timestamppb.New(item.CreatedAt.UTC()),
+
+		RequiredApprovals: int32(item.RequiredApprovals),
+		ApprovalsCount:    int32(item.ApprovalsCount),
 	}
+	if item.ExpiresAt != nil {
+		out.ExpiresAt = timestamppb.New(item.ExpiresAt.UTC())
+	}
+	for _, vote := range item.Votes {
+		out.Votes = append(out.Votes, &controlplanev1.ApprovalVote{
+			ActorId:  vote.ActorID,
+			Decision: vote.Decision,
+			Reason:   stringPtrOrNil(vote.Reason),
+			VotedAt:  timestamppb.New(vote.VotedAt.UTC()),
+		})
+	}
+	return out
 }
 
 func approvalActorID(principal staff.Principal) string {
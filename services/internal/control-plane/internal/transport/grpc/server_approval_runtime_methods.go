@@ -0,0 +1,56 @@
+package grpc
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) SuspendRunForApproval(
+	ctx context.Context,
+	req *controlplanev1.SuspendRunForApprovalRequest,
+) (*controlplanev1.SuspendRunForApprovalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	runSession, err := s.authenticateRunToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := s.mcp.SuspendRunForApproval(ctx, runSession.RunID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &controlplanev1.SuspendRunForApprovalResponse{
+		Suspended:         result.Suspended,
+		ApprovalRequestId: result.ApprovalRequestID,
+		ToolName:          strings.TrimSpace(result.ToolName),
+		ApprovalState:     strings.TrimSpace(result.ApprovalState),
+		RunnerAction:      strings.TrimSpace(result.RunnerAction),
+	}, nil
+}
+
+func (s *Server) GetRunApprovalResumePayload(
+	ctx context.Context,
+	req *controlplanev1.GetRunApprovalResumePayloadRequest,
+) (*controlplanev1.GetRunApprovalResumePayloadResponse, error) {
+	return executeRunScopedPayloadSpec(ctx, req, s.loadRunScopedPayload, approvalResumePayloadSpec(s))
+}
+
+func approvalResumePayloadSpec(s *Server) runScopedPayloadSpec[*controlplanev1.GetRunApprovalResumePayloadResponse] {
+	return runScopedPayloadSpec[*controlplanev1.GetRunApprovalResumePayloadResponse]{
+		label: "approval resume payload",
+		load:  s.agentCallbacks.GetRunApprovalResumePayload,
+		build: approvalResumePayloadResponseBuilder,
+	}
+}
+
+var approvalResumePayloadResponseBuilder = func(found bool, payload json.RawMessage) *controlplanev1.GetRunApprovalResumePayloadResponse {
+	return &controlplanev1.GetRunApprovalResumePayloadResponse{Found: found, PayloadJson: payload}
+}
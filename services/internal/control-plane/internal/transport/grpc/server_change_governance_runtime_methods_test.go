@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
 	changegovernancedomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/changegovernance"
 	mcpdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/mcp"
@@ -126,6 +127,22 @@ func (fakeChangeGovernanceService) UpsertEvidenceSignal(context.Context, queryty
 	return changegovernancedomain.EvidenceSignalResult{}, nil
 }
 
+func (fakeChangeGovernanceService) GetPackage(context.Context, string) (changegovernancedomain.Aggregate, error) {
+	return changegovernancedomain.Aggregate{}, nil
+}
+
+func (fakeChangeGovernanceService) SubmitWaiverDecision(context.Context, querytypes.ChangeGovernanceWaiverDecisionParams) (changegovernancedomain.Aggregate, error) {
+	return changegovernancedomain.Aggregate{}, nil
+}
+
+func (fakeChangeGovernanceService) SubmitReleaseReadinessDecision(context.Context, querytypes.ChangeGovernanceReleaseReadinessDecisionParams) (changegovernancedomain.Aggregate, error) {
+	return changegovernancedomain.Aggregate{}, nil
+}
+
+func (fakeChangeGovernanceService) ReportFeedback(context.Context, querytypes.ChangeGovernanceFeedbackParams) (changegovernancedomain.Aggregate, error) {
+	return changegovernancedomain.Aggregate{}, nil
+}
+
 type fakeChangeGovernanceMCPService struct {
 	session mcpdomain.SessionContext
 }
@@ -146,6 +163,10 @@ func (fakeChangeGovernanceMCPService) ListPendingApprovals(context.Context, int)
 	return nil, nil
 }
 
+func (fakeChangeGovernanceMCPService) GetApproval(context.Context, int64) (mcpdomain.ApprovalListItem, bool, error) {
+	return mcpdomain.ApprovalListItem{}, false, nil
+}
+
 func (fakeChangeGovernanceMCPService) ResolveApproval(context.Context, mcpdomain.ResolveApprovalParams) (mcpdomain.ResolveApprovalResult, error) {
 	return mcpdomain.ResolveApprovalResult{}, nil
 }
@@ -166,6 +187,14 @@ func (fakeChangeGovernanceMCPService) SubmitInteractionCallback(context.Context,
 	return mcpdomain.SubmitInteractionCallbackResult{}, nil
 }
 
+func (fakeChangeGovernanceMCPService) SuspendRunForApproval(context.Context, string) (mcpdomain.SuspendRunForApprovalResult, error) {
+	return mcpdomain.SuspendRunForApprovalResult{}, nil
+}
+
+func (fakeChangeGovernanceMCPService) ProjectMCPServers(context.Context, mcpdomain.SessionContext) ([]servicescfg.AgentMCPServer, error) {
+	return nil, nil
+}
+
 type fakeChangeGovernanceRunReader struct {
 	run   agentrunrepo.Run
 	found bool
@@ -0,0 +1,254 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	changegovernancedomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/changegovernance"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/staff"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func (s *Server) GetChangeGovernancePackage(
+	ctx context.Context,
+	req *controlplanev1.GetChangeGovernancePackageRequest,
+) (*controlplanev1.ChangeGovernancePackage, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	principal, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	aggregate, err := s.loadChangeGovernancePackageForStaff(ctx, principal, req.GetPackageId(), false)
+	if err != nil {
+		return nil, err
+	}
+	return changeGovernancePackageToProto(aggregate), nil
+}
+
+func (s *Server) SubmitChangeGovernanceWaiverDecision(
+	ctx context.Context,
+	req *controlplanev1.SubmitChangeGovernanceWaiverDecisionRequest,
+) (*controlplanev1.ChangeGovernancePackage, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	principal, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.loadChangeGovernancePackageForStaff(ctx, principal, req.GetPackageId(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt *time.Time
+	if req.GetExpiresAt() != nil {
+		value := tsToTime(req.GetExpiresAt())
+		expiresAt = &value
+	}
+	aggregate, err := s.changeGovernance.SubmitWaiverDecision(ctx, querytypes.ChangeGovernanceWaiverDecisionParams{
+		PackageID:                 current.Package.ID,
+		ExpectedProjectID:         current.Package.ProjectID,
+		DecisionID:                strings.TrimSpace(req.GetDecisionId()),
+		CorrelationID:             changeGovernanceStaffCorrelationID(current.Package),
+		ExpectedProjectionVersion: req.GetExpectedProjectionVersion(),
+		ActorUserID:               principal.UserID,
+		ActorKind:                 enumtypes.ChangeGovernanceDecisionActorKind(strings.TrimSpace(req.GetActorKind())),
+		State:                     changeGovernanceWaiverDecisionState(req.GetDecisionState()),
+		ResidualRiskTier:          enumtypes.ChangeGovernanceRiskTier(strings.TrimSpace(req.GetResidualRiskTier())),
+		SummaryMarkdown:           strings.TrimSpace(req.GetReasonMarkdown()),
+		ExpiresAt:                 expiresAt,
+		OccurredAt:                time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return changeGovernancePackageToProto(aggregate), nil
+}
+
+func (s *Server) SubmitChangeGovernanceReleaseReadinessDecision(
+	ctx context.Context,
+	req *controlplanev1.SubmitChangeGovernanceReleaseReadinessDecisionRequest,
+) (*controlplanev1.ChangeGovernancePackage, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	principal, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.loadChangeGovernancePackageForStaff(ctx, principal, req.GetPackageId(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregate, err := s.changeGovernance.SubmitReleaseReadinessDecision(ctx, querytypes.ChangeGovernanceReleaseReadinessDecisionParams{
+		PackageID:                 current.Package.ID,
+		ExpectedProjectID:         current.Package.ProjectID,
+		DecisionID:                strings.TrimSpace(req.GetDecisionId()),
+		CorrelationID:             changeGovernanceStaffCorrelationID(current.Package),
+		ExpectedProjectionVersion: req.GetExpectedProjectionVersion(),
+		ActorUserID:               principal.UserID,
+		ActorKind:                 enumtypes.ChangeGovernanceDecisionActorKind(strings.TrimSpace(req.GetActorKind())),
+		ReleaseReadinessState:     enumtypes.ChangeGovernanceReleaseReadinessState(strings.TrimSpace(req.GetDecisionState())),
+		SummaryMarkdown:           strings.TrimSpace(req.GetSummaryMarkdown()),
+		OccurredAt:                time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return changeGovernancePackageToProto(aggregate), nil
+}
+
+func (s *Server) ReportChangeGovernanceFeedback(
+	ctx context.Context,
+	req *controlplanev1.ReportChangeGovernanceFeedbackRequest,
+) (*controlplanev1.ChangeGovernancePackage, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	principal, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.loadChangeGovernancePackageForStaff(ctx, principal, req.GetPackageId(), true)
+	if err != nil {
+		return nil, err
+	}
+
+	aggregate, err := s.changeGovernance.ReportFeedback(ctx, querytypes.ChangeGovernanceFeedbackParams{
+		PackageID:          current.Package.ID,
+		ExpectedProjectID:  current.Package.ProjectID,
+		FeedbackID:         strings.TrimSpace(req.GetFeedbackId()),
+		CorrelationID:      changeGovernanceStaffCorrelationID(current.Package),
+		GapKind:            enumtypes.ChangeGovernanceFeedbackGapKind(strings.TrimSpace(req.GetGapKind())),
+		SourceKind:         enumtypes.ChangeGovernanceFeedbackSourceKind(strings.TrimSpace(req.GetSourceKind())),
+		Severity:           enumtypes.ChangeGovernanceFeedbackSeverity(strings.TrimSpace(req.GetSeverity())),
+		SuggestedAction:    enumtypes.ChangeGovernanceFeedbackSuggestedAction(strings.TrimSpace(req.GetSuggestedAction())),
+		SummaryMarkdown:    strings.TrimSpace(req.GetSummaryMarkdown()),
+		RelatedArtifactRef: strings.TrimSpace(req.GetRelatedArtifactRef()),
+		OccurredAt:         time.Now().UTC(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return changeGovernancePackageToProto(aggregate), nil
+}
+
+// loadChangeGovernancePackageForStaff resolves package project scope and enforces staff RBAC on it.
+func (s *Server) loadChangeGovernancePackageForStaff(
+	ctx context.Context,
+	principal staff.Principal,
+	packageID string,
+	requireWrite bool,
+) (changegovernancedomain.Aggregate, error) {
+	if s.changeGovernance == nil {
+		return changegovernancedomain.Aggregate{}, status.Error(codes.FailedPrecondition, "change governance service is not configured")
+	}
+	if s.staff == nil {
+		return changegovernancedomain.Aggregate{}, status.Error(codes.FailedPrecondition, "staff service is not configured")
+	}
+	aggregate, err := s.changeGovernance.GetPackage(ctx, strings.TrimSpace(packageID))
+	if err != nil {
+		return changegovernancedomain.Aggregate{}, toStatus(err)
+	}
+	if requireWrite {
+		err = s.staff.RequireProjectPermission(ctx, principal, aggregate.Package.ProjectID, enumtypes.ProjectPermissionChangeGovernanceManage, "")
+	} else {
+		_, err = s.staff.GetProject(ctx, principal, aggregate.Package.ProjectID)
+	}
+	if err != nil {
+		return changegovernancedomain.Aggregate{}, toStatus(err)
+	}
+	return aggregate, nil
+}
+
+func changeGovernanceStaffCorrelationID(pkg changegovernancedomain.Package) string {
+	if value := strings.TrimSpace(pkg.LatestCorrelationID); value != "" {
+		return value
+	}
+	return "change-governance:" + pkg.ID
+}
+
+func changeGovernanceWaiverDecisionState(raw string) enumtypes.ChangeGovernanceDecisionState {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "approve", "approved":
+		return enumtypes.ChangeGovernanceDecisionStateApproved
+	case "reject", "rejected":
+		return enumtypes.ChangeGovernanceDecisionStateRejected
+	default:
+		return enumtypes.ChangeGovernanceDecisionState(strings.TrimSpace(raw))
+	}
+}
+
+func changeGovernancePackageToProto(aggregate changegovernancedomain.Aggregate) *controlplanev1.ChangeGovernancePackage {
+	pkg := aggregate.Package
+	out := &controlplanev1.ChangeGovernancePackage{
+		Id:                        pkg.ID,
+		ProjectId:                 pkg.ProjectID,
+		RepositoryFullName:        pkg.RepositoryFullName,
+		IssueNumber:               int32(pkg.IssueNumber),
+		RiskTier:                  stringPtrOrNil(string(pkg.RiskTier)),
+		BundleAdmissibility:       string(pkg.BundleAdmissibility),
+		PublicationState:          string(pkg.PublicationState),
+		EvidenceCompletenessState: string(pkg.EvidenceCompletenessState),
+		VerificationMinimumState:  string(pkg.VerificationMinimumState),
+		WaiverState:               string(pkg.WaiverState),
+		ReleaseReadinessState:     string(pkg.ReleaseReadinessState),
+		GovernanceFeedbackState:   string(pkg.GovernanceFeedbackState),
+		ProjectionVersion:         pkg.ActiveProjectionVersion,
+		Decisions:                 make([]*controlplanev1.ChangeGovernanceDecision, 0, len(aggregate.DecisionRecords)),
+		Feedback:                  make([]*controlplanev1.ChangeGovernanceFeedback, 0, len(aggregate.FeedbackRecords)),
+	}
+	if pkg.PRNumber != nil {
+		out.PrNumber = wrapperspb.Int32(int32(*pkg.PRNumber))
+	}
+	if !pkg.UpdatedAt.IsZero() {
+		out.UpdatedAt = timestamppb.New(pkg.UpdatedAt.UTC())
+	}
+	for _, item := range aggregate.DecisionRecords {
+		decision := &controlplanev1.ChangeGovernanceDecision{
+			Id:               item.ID,
+			DecisionId:       item.DecisionID,
+			DecisionKind:     string(item.DecisionKind),
+			State:            string(item.State),
+			ActorKind:        string(item.ActorKind),
+			ResidualRiskTier: stringPtrOrNil(string(item.ResidualRiskTier)),
+			SummaryMarkdown:  item.SummaryMarkdown,
+		}
+		if !item.RecordedAt.IsZero() {
+			decision.RecordedAt = timestamppb.New(item.RecordedAt.UTC())
+		}
+		out.Decisions = append(out.Decisions, decision)
+	}
+	for _, item := range aggregate.FeedbackRecords {
+		feedback := &controlplanev1.ChangeGovernanceFeedback{
+			Id:                 item.ID,
+			FeedbackId:         item.FeedbackID,
+			GapKind:            string(item.GapKind),
+			SourceKind:         string(item.SourceKind),
+			Severity:           string(item.Severity),
+			State:              string(item.State),
+			SuggestedAction:    string(item.SuggestedAction),
+			SummaryMarkdown:    item.SummaryMarkdown,
+			RelatedArtifactRef: stringPtrOrNil(item.RelatedArtifactRef),
+		}
+		if !item.OpenedAt.IsZero() {
+			feedback.OpenedAt = timestamppb.New(item.OpenedAt.UTC())
+		}
+		if item.ClosedAt != nil {
+			feedback.ClosedAt = timestamppb.New(item.ClosedAt.UTC())
+		}
+		out.Feedback = append(out.Feedback, feedback)
+	}
+	return out
+}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
 	mcpdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/mcp"
 	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
@@ -216,6 +217,10 @@ func (f fakeMCPRunTokenService) ListPendingApprovals(ctx context.Context, limit
 	return nil, nil
 }
 
+func (f fakeMCPRunTokenService) GetApproval(ctx context.Context, requestID int64) (mcpdomain.ApprovalListItem, bool, error) {
+	return mcpdomain.ApprovalListItem{}, false, nil
+}
+
 func (f fakeMCPRunTokenService) ResolveApproval(ctx context.Context, params mcpdomain.ResolveApprovalParams) (mcpdomain.ResolveApprovalResult, error) {
 	return mcpdomain.ResolveApprovalResult{}, nil
 }
@@ -239,6 +244,14 @@ func (f fakeMCPRunTokenService) SubmitInteractionCallback(ctx context.Context, p
 	return mcpdomain.SubmitInteractionCallbackResult{}, nil
 }
 
+func (f fakeMCPRunTokenService) SuspendRunForApproval(context.Context, string) (mcpdomain.SuspendRunForApprovalResult, error) {
+	return mcpdomain.SuspendRunForApprovalResult{}, nil
+}
+
+func (f fakeMCPRunTokenService) ProjectMCPServers(context.Context, mcpdomain.SessionContext) ([]servicescfg.AgentMCPServer, error) {
+	return nil, nil
+}
+
 func stringPtr(value string) *string {
 	return &value
 }
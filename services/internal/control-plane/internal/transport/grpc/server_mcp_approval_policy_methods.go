@@ -0,0 +1,71 @@
+package grpc
+
+import (
+	"context"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListMCPApprovalPolicies(ctx context.Context, req *controlplanev1.ListMCPApprovalPoliciesRequest) (*controlplanev1.ListMCPApprovalPoliciesResponse, error) {
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.staff.ListMCPApprovalPolicies(ctx, p, req.GetProjectId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := make([]*controlplanev1.MCPApprovalPolicy, 0, len(items))
+	for _, item := range items {
+		out = append(out, mcpApprovalPolicyToProto(item))
+	}
+	return &controlplanev1.ListMCPApprovalPoliciesResponse{Items: out}, nil
+}
+
+func (s *Server) UpsertMCPApprovalPolicy(ctx context.Context, req *controlplanev1.UpsertMCPApprovalPolicyRequest) (*controlplanev1.MCPApprovalPolicy, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	return mapStaffEntity(func() (entitytypes.MCPApprovalPolicy, error) {
+		return s.staff.UpsertMCPApprovalPolicy(ctx, p, querytypes.MCPApprovalPolicyUpsertParams{
+			ProjectID:           req.GetProjectId(),
+			ToolName:            req.GetToolName(),
+			Environment:         req.GetEnvironment(),
+			RequiredApprovals:   int(req.GetRequiredApprovals()),
+			EligibleRoles:       req.GetEligibleRoles(),
+			EligibleUserIDs:     req.GetEligibleUserIds(),
+			SeparationOfDuties:  req.GetSeparationOfDuties(),
+			ExpiresAfterSeconds: int(req.GetExpiresAfterSeconds()),
+		})
+	}, mcpApprovalPolicyToProto)
+}
+
+func (s *Server) DeleteMCPApprovalPolicy(ctx context.Context, req *controlplanev1.DeleteMCPApprovalPolicyRequest) (*emptypb.Empty, error) {
+	return s.delete2(ctx, req.GetPrincipal(), req.GetProjectId(), req.GetPolicyId(), s.staff.DeleteMCPApprovalPolicy)
+}
+
+func mcpApprovalPolicyToProto(item entitytypes.MCPApprovalPolicy) *controlplanev1.MCPApprovalPolicy {
+	return &controlplanev1.MCPApprovalPolicy{
+		Id:                  item.ID,
+		ProjectId:           item.ProjectID,
+		ToolName:            item.ToolName,
+		Environment:         item.Environment,
+		RequiredApprovals:   int32(item.RequiredApprovals),
+		EligibleRoles:       item.EligibleRoles,
+		EligibleUserIds:     item.EligibleUserIDs,
+		SeparationOfDuties:  item.SeparationOfDuties,
+		ExpiresAfterSeconds: int32(item.ExpiresAfterSeconds),
+		CreatedAt:           timestamppb.New(item.CreatedAt.UTC()),
+		UpdatedAt:           timestamppb.New(item.UpdatedAt.UTC()),
+	}
+}
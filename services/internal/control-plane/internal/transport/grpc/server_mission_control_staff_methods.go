@@ -217,6 +217,10 @@ func (s *Server) SubmitMissionControlCommand(ctx context.Context, req *controlpl
 	if _, err := s.staff.GetProject(ctx, principal, projectID); err != nil {
 		return nil, toStatus(err)
 	}
+	commandKind := strings.TrimSpace(req.GetCommandKind())
+	if err := s.staff.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionMissionControlCommand, commandKind); err != nil {
+		return nil, toStatus(err)
+	}
 	payload, err := missionControlCommandPayloadFromProto(req)
 	if err != nil {
 		return nil, toStatus(err)
@@ -226,7 +230,7 @@ func (s *Server) SubmitMissionControlCommand(ctx context.Context, req *controlpl
 		ProjectID:                 projectID,
 		ActorID:                   principal.UserID,
 		CorrelationID:             strings.TrimSpace(req.GetCorrelationId()),
-		CommandKind:               enumtypes.MissionControlCommandKind(strings.TrimSpace(req.GetCommandKind())),
+		CommandKind:               enumtypes.MissionControlCommandKind(commandKind),
 		TargetEntityRef:           missionControlTargetRefFromProto(req),
 		BusinessIntentKey:         strings.TrimSpace(req.GetBusinessIntentKey()),
 		ExpectedProjectionVersion: req.GetExpectedProjectionVersion(),
@@ -304,3 +304,23 @@ func (stubMissionControlDomain) CancelCommand(context.Context, missioncontroldom
 func (stubMissionControlDomain) ApplyApprovalDecision(context.Context, missioncontroldomain.ApprovalDecisionParams) (missioncontroldomain.Command, error) {
 	return missioncontroldomain.Command{}, nil
 }
+
+func (stubMissionControlDomain) CreateVoiceCandidate(context.Context, missioncontroldomain.CreateVoiceCandidateParams) (missioncontroldomain.VoiceCandidateView, error) {
+	return missioncontroldomain.VoiceCandidateView{}, nil
+}
+
+func (stubMissionControlDomain) ListVoiceCandidates(context.Context, missioncontroldomain.VoiceCandidateListQuery) ([]missioncontroldomain.VoiceCandidateView, error) {
+	return nil, nil
+}
+
+func (stubMissionControlDomain) GetVoiceCandidate(context.Context, string, string) (missioncontroldomain.VoiceCandidateView, error) {
+	return missioncontroldomain.VoiceCandidateView{}, nil
+}
+
+func (stubMissionControlDomain) PromoteVoiceCandidate(context.Context, missioncontroldomain.PromoteVoiceCandidateParams) (missioncontroldomain.VoiceCandidatePromotion, error) {
+	return missioncontroldomain.VoiceCandidatePromotion{}, nil
+}
+
+func (stubMissionControlDomain) RejectVoiceCandidate(context.Context, missioncontroldomain.RejectVoiceCandidateParams) (missioncontroldomain.VoiceCandidateView, error) {
+	return missioncontroldomain.VoiceCandidateView{}, nil
+}
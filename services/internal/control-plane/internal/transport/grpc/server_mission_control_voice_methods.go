@@ -0,0 +1,209 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	missioncontroldomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/missioncontrol"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/staff"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// CreateMissionControlVoiceCandidate accepts one adapter-side transcript for a project-bound chat.
+func (s *Server) CreateMissionControlVoiceCandidate(ctx context.Context, req *controlplanev1.CreateMissionControlVoiceCandidateRequest) (*controlplanev1.MissionControlVoiceCandidate, error) {
+	if s.missionControlDomain == nil {
+		return nil, status.Error(codes.FailedPrecondition, "mission control domain service is not configured")
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	view, err := s.missionControlDomain.CreateVoiceCandidate(ctx, missioncontroldomain.CreateVoiceCandidateParams{
+		ProjectID:  strings.TrimSpace(req.GetProjectId()),
+		SourceKind: enumtypes.MissionControlVoiceCandidateSourceKind(strings.TrimSpace(req.GetSourceKind())),
+		Transcript: req.GetTranscript(),
+		DedupeKey:  strings.TrimSpace(req.GetDedupeKey()),
+		CreatedBy:  strings.TrimSpace(req.GetCreatedBy()),
+		Source: valuetypes.MissionControlVoiceCandidateSource{
+			Adapter:    strings.TrimSpace(req.GetSourceAdapter()),
+			ChatRef:    strings.TrimSpace(req.GetSourceChatRef()),
+			MessageRef: strings.TrimSpace(req.GetSourceMessageRef()),
+			Locale:     strings.TrimSpace(req.GetSourceLocale()),
+		},
+		CreatedAt: tsToTime(req.GetCreatedAt()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return missionControlVoiceCandidateToProto(view), nil
+}
+
+func (s *Server) ListMissionControlVoiceCandidates(ctx context.Context, req *controlplanev1.ListMissionControlVoiceCandidatesRequest) (*controlplanev1.ListMissionControlVoiceCandidatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	_, projectID, err := s.missionControlVoiceProjectScope(ctx, req.GetPrincipal(), req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]enumtypes.MissionControlVoiceCandidateStatus, 0, len(req.GetStatuses()))
+	for _, item := range trimStringSlice(req.GetStatuses()) {
+		statuses = append(statuses, enumtypes.MissionControlVoiceCandidateStatus(item))
+	}
+
+	items, err := s.missionControlDomain.ListVoiceCandidates(ctx, missioncontroldomain.VoiceCandidateListQuery{
+		ProjectID: projectID,
+		Statuses:  statuses,
+		Limit:     int(req.GetLimit()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &controlplanev1.ListMissionControlVoiceCandidatesResponse{
+		Items: make([]*controlplanev1.MissionControlVoiceCandidate, 0, len(items)),
+	}
+	for _, item := range items {
+		resp.Items = append(resp.Items, missionControlVoiceCandidateToProto(item))
+	}
+	return resp, nil
+}
+
+func (s *Server) PromoteMissionControlVoiceCandidate(ctx context.Context, req *controlplanev1.PromoteMissionControlVoiceCandidateRequest) (*controlplanev1.PromoteMissionControlVoiceCandidateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	principal, projectID, err := s.missionControlVoiceProjectScope(ctx, req.GetPrincipal(), req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	candidateID := strings.TrimSpace(req.GetCandidateId())
+	commandKind := strings.TrimSpace(req.GetCommandKind())
+	if err := s.requireMissionControlVoiceCommandPermission(ctx, principal, projectID, candidateID, commandKind); err != nil {
+		return nil, err
+	}
+
+	var initialLabels []string
+	if labels := trimStringSlice(req.GetInitialLabels()); len(labels) > 0 {
+		initialLabels = labels
+	}
+	promotion, err := s.missionControlDomain.PromoteVoiceCandidate(ctx, missioncontroldomain.PromoteVoiceCandidateParams{
+		ProjectID:     projectID,
+		CandidateID:   candidateID,
+		ActorID:       principal.UserID,
+		CommandKind:   enumtypes.MissionControlCommandKind(commandKind),
+		Title:         strings.TrimSpace(req.GetTitle()),
+		BodyMarkdown:  strings.TrimSpace(req.GetBodyMarkdown()),
+		InitialLabels: initialLabels,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	resp := &controlplanev1.PromoteMissionControlVoiceCandidateResponse{
+		Candidate: missionControlVoiceCandidateToProto(promotion.Candidate),
+	}
+	if strings.TrimSpace(promotion.Admission.Command.ID) != "" {
+		command, err := s.commandStateToProto(ctx, promotion.Admission.Command)
+		if err != nil {
+			return nil, err
+		}
+		resp.Command = command
+	}
+	return resp, nil
+}
+
+func (s *Server) RejectMissionControlVoiceCandidate(ctx context.Context, req *controlplanev1.RejectMissionControlVoiceCandidateRequest) (*controlplanev1.MissionControlVoiceCandidate, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	principal, projectID, err := s.missionControlVoiceProjectScope(ctx, req.GetPrincipal(), req.GetProjectId())
+	if err != nil {
+		return nil, err
+	}
+	candidateID := strings.TrimSpace(req.GetCandidateId())
+	if err := s.requireMissionControlVoiceCommandPermission(ctx, principal, projectID, candidateID, ""); err != nil {
+		return nil, err
+	}
+
+	view, err := s.missionControlDomain.RejectVoiceCandidate(ctx, missioncontroldomain.RejectVoiceCandidateParams{
+		ProjectID:   projectID,
+		CandidateID: candidateID,
+		ActorID:     principal.UserID,
+		Reason:      strings.TrimSpace(req.GetReason()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return missionControlVoiceCandidateToProto(view), nil
+}
+
+func (s *Server) missionControlVoiceProjectScope(ctx context.Context, rawPrincipal *controlplanev1.Principal, rawProjectID string) (staff.Principal, string, error) {
+	if s.missionControlDomain == nil {
+		return staff.Principal{}, "", status.Error(codes.FailedPrecondition, "mission control domain service is not configured")
+	}
+	if s.staff == nil {
+		return staff.Principal{}, "", status.Error(codes.FailedPrecondition, "staff service is not configured")
+	}
+	principal, err := requirePrincipal(rawPrincipal)
+	if err != nil {
+		return staff.Principal{}, "", err
+	}
+	projectID := strings.TrimSpace(rawProjectID)
+	if projectID == "" {
+		return staff.Principal{}, "", status.Error(codes.InvalidArgument, "project_id is required")
+	}
+	if _, err := s.staff.GetProject(ctx, principal, projectID); err != nil {
+		return staff.Principal{}, "", toStatus(err)
+	}
+	return principal, projectID, nil
+}
+
+// requireMissionControlVoiceCommandPermission checks the command permission for the kind the candidate would submit.
+func (s *Server) requireMissionControlVoiceCommandPermission(ctx context.Context, principal staff.Principal, projectID string, candidateID string, commandKind string) error {
+	if candidateID == "" {
+		return status.Error(codes.InvalidArgument, "candidate_id is required")
+	}
+	if commandKind == "" {
+		view, err := s.missionControlDomain.GetVoiceCandidate(ctx, projectID, candidateID)
+		if err != nil {
+			return toStatus(err)
+		}
+		commandKind = string(view.Candidate.CommandKind)
+	}
+	if err := s.staff.RequireProjectPermission(ctx, principal, projectID, enumtypes.ProjectPermissionMissionControlCommand, commandKind); err != nil {
+		return toStatus(err)
+	}
+	return nil
+}
+
+func missionControlVoiceCandidateToProto(view missioncontroldomain.VoiceCandidateView) *controlplanev1.MissionControlVoiceCandidate {
+	candidate := view.Candidate
+	out := &controlplanev1.MissionControlVoiceCandidate{
+		ProjectId:          candidate.ProjectID,
+		CandidateId:        candidate.ID,
+		Status:             string(candidate.Status),
+		SourceKind:         string(candidate.SourceKind),
+		CommandKind:        string(candidate.CommandKind),
+		TranscriptExcerpt:  candidate.TranscriptExcerpt,
+		StructuredSummary:  candidate.StructuredSummary,
+		Transcript:         view.Payload.Transcript,
+		Confidence:         view.Payload.Confidence,
+		DraftTitle:         view.Payload.Draft.Title,
+		DraftBodyMarkdown:  stringPtrOrNil(view.Payload.Draft.BodyMarkdown),
+		DraftInitialLabels: trimStringSlice(view.Payload.Draft.InitialLabels),
+		PromotedCommandId:  stringPtrOrNil(candidate.PromotedCommandID),
+		CreatedBy:          candidate.CreatedBy,
+		DecidedBy:          stringPtrOrNil(candidate.DecidedBy),
+		CreatedAt:          timestamppb.New(candidate.CreatedAt.UTC()),
+		RejectReason:       stringPtrOrNil(view.Payload.RejectReason),
+		SourceAdapter:      stringPtrOrNil(view.Payload.Source.Adapter),
+	}
+	if candidate.DecidedAt != nil {
+		out.DecidedAt = timestamppb.New(candidate.DecidedAt.UTC())
+	}
+	return out
+}
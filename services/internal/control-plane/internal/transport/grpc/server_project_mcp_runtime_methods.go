@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *Server) ListRunProjectMCPServers(
+	ctx context.Context,
+	req *controlplanev1.ListRunProjectMCPServersRequest,
+) (*controlplanev1.ListRunProjectMCPServersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+
+	runSession, err := s.authenticateRunToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := s.mcp.ProjectMCPServers(ctx, runSession)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := make([]*controlplanev1.ProjectMCPServer, 0, len(servers))
+	for _, server := range servers {
+		out = append(out, projectMCPServerToProto(server))
+	}
+	return &controlplanev1.ListRunProjectMCPServersResponse{Servers: out}, nil
+}
+
+func projectMCPServerToProto(server servicescfg.AgentMCPServer) *controlplanev1.ProjectMCPServer {
+	return &controlplanev1.ProjectMCPServer{
+		Name:               server.Name,
+		Command:            server.Command,
+		Args:               server.Args,
+		Env:                server.Env,
+		Url:                server.URL,
+		ToolTimeoutSeconds: int32(server.ToolTimeoutSeconds),
+	}
+}
@@ -0,0 +1,77 @@
+package grpc
+
+import (
+	"context"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/projectrbac"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListProjectRoles(ctx context.Context, req *controlplanev1.ListProjectRolesRequest) (*controlplanev1.ListProjectRolesResponse, error) {
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.staff.ListProjectRoles(ctx, p, req.GetProjectId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := make([]*controlplanev1.ProjectRole, 0, len(items))
+	for _, item := range items {
+		out = append(out, projectRoleToProto(item))
+	}
+	catalog := make([]*controlplanev1.ProjectPermissionDescriptor, 0, len(enumtypes.ProjectPermissions))
+	for _, permission := range enumtypes.ProjectPermissions {
+		catalog = append(catalog, &controlplanev1.ProjectPermissionDescriptor{
+			Permission: string(permission),
+			Qualified:  projectrbac.IsQualified(permission),
+		})
+	}
+	return &controlplanev1.ListProjectRolesResponse{Items: out, Catalog: catalog}, nil
+}
+
+func (s *Server) UpsertProjectRole(ctx context.Context, req *controlplanev1.UpsertProjectRoleRequest) (*controlplanev1.ProjectRole, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	return mapStaffEntity(func() (entitytypes.ProjectRole, error) {
+		return s.staff.UpsertProjectRole(ctx, p, querytypes.ProjectRoleUpsertParams{
+			ProjectID:   req.GetProjectId(),
+			Name:        req.GetName(),
+			Description: req.GetDescription(),
+			Permissions: req.GetPermissions(),
+		})
+	}, projectRoleToProto)
+}
+
+func (s *Server) DeleteProjectRole(ctx context.Context, req *controlplanev1.DeleteProjectRoleRequest) (*emptypb.Empty, error) {
+	return s.delete2(ctx, req.GetPrincipal(), req.GetProjectId(), req.GetName(), s.staff.DeleteProjectRole)
+}
+
+func projectRoleToProto(item entitytypes.ProjectRole) *controlplanev1.ProjectRole {
+	out := &controlplanev1.ProjectRole{
+		ProjectId:   item.ProjectID,
+		Name:        item.Name,
+		Description: item.Description,
+		Permissions: item.Permissions,
+		Builtin:     item.Builtin,
+	}
+	if !item.CreatedAt.IsZero() {
+		out.CreatedAt = timestamppb.New(item.CreatedAt.UTC())
+	}
+	if !item.UpdatedAt.IsZero() {
+		out.UpdatedAt = timestamppb.New(item.UpdatedAt.UTC())
+	}
+	return out
+}
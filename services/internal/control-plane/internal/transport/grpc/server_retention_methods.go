@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	retentiondomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/retention"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) ListRetentionPolicies(ctx context.Context, req *controlplanev1.ListRetentionPoliciesRequest) (*controlplanev1.ListRetentionPoliciesResponse, error) {
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.staff.ListRetentionPolicies(ctx, p, req.GetProjectId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := make([]*controlplanev1.RetentionPolicy, 0, len(items))
+	for _, item := range items {
+		out = append(out, retentionPolicyToProto(item))
+	}
+	return &controlplanev1.ListRetentionPoliciesResponse{Items: out}, nil
+}
+
+func (s *Server) UpsertRetentionPolicy(ctx context.Context, req *controlplanev1.UpsertRetentionPolicyRequest) (*controlplanev1.RetentionPolicy, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	return mapStaffEntity(func() (entitytypes.RetentionPolicy, error) {
+		return s.staff.UpsertRetentionPolicy(ctx, p, querytypes.RetentionPolicyUpsertParams{
+			Target:        enumtypes.RetentionTarget(strings.ToLower(strings.TrimSpace(req.GetTarget()))),
+			ProjectID:     req.GetProjectId(),
+			RetentionDays: int(req.GetRetentionDays()),
+			Action:        enumtypes.RetentionAction(strings.ToLower(strings.TrimSpace(req.GetAction()))),
+			Enabled:       req.GetEnabled(),
+		})
+	}, retentionPolicyToProto)
+}
+
+func (s *Server) DeleteRetentionPolicy(ctx context.Context, req *controlplanev1.DeleteRetentionPolicyRequest) (*emptypb.Empty, error) {
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.staff.DeleteRetentionPolicy(ctx, p, req.GetPolicyId()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) SetFlowEventRetentionPin(ctx context.Context, req *controlplanev1.SetFlowEventRetentionPinRequest) (*emptypb.Empty, error) {
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.staff.SetFlowEventRetentionPin(ctx, p, req.GetEventId(), req.GetPinned()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *Server) RunRetentionSweep(ctx context.Context, req *controlplanev1.RunRetentionSweepRequest) (*controlplanev1.RunRetentionSweepResponse, error) {
+	if s.retention == nil {
+		return nil, status.Error(codes.FailedPrecondition, "retention service is not configured")
+	}
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	if strings.TrimSpace(req.GetWorkerId()) == "" {
+		return nil, status.Error(codes.InvalidArgument, "worker_id is required")
+	}
+
+	result, err := s.retention.RunSweep(ctx, retentiondomain.SweepParams{
+		WorkerID:      req.GetWorkerId(),
+		PolicyLimit:   int(req.GetPolicyLimit()),
+		BatchSize:     int(req.GetBatchSize()),
+		MaxBatches:    int(req.GetMaxBatches()),
+		SweepInterval: time.Duration(req.GetSweepIntervalSeconds()) * time.Second,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	out := make([]*controlplanev1.RetentionSweepPolicyResult, 0, len(result.Policies))
+	for _, item := range result.Policies {
+		out = append(out, &controlplanev1.RetentionSweepPolicyResult{
+			PolicyId:     item.PolicyID,
+			ProjectId:    stringPtrOrNil(item.ProjectID),
+			Target:       string(item.Target),
+			Action:       string(item.Action),
+			RemovedCount: item.Removed,
+			ArchiveKeys:  item.ArchiveKeys,
+			Error:        stringPtrOrNil(item.Error),
+			HasMore:      item.HasMore,
+		})
+	}
+	return &controlplanev1.RunRetentionSweepResponse{Policies: out}, nil
+}
+
+func retentionPolicyToProto(item entitytypes.RetentionPolicy) *controlplanev1.RetentionPolicy {
+	return &controlplanev1.RetentionPolicy{
+		Id:               item.ID,
+		ProjectId:        stringPtrOrNil(item.ProjectID),
+		Target:           string(item.Target),
+		RetentionDays:    int32(item.RetentionDays),
+		Action:           string(item.Action),
+		Enabled:          item.Enabled,
+		UpdatedBy:        stringPtrOrNil(item.UpdatedBy),
+		LastSweptAt:      optionalTimestamp(item.LastSweptAt),
+		LastRemovedCount: item.LastRemovedCount,
+		LastArchiveKey:   stringPtrOrNil(item.LastArchiveKey),
+		LastError:        stringPtrOrNil(item.LastError),
+		CreatedAt:        timestamppb.New(item.CreatedAt.UTC()),
+		UpdatedAt:        timestamppb.New(item.UpdatedAt.UTC()),
+	}
+}
@@ -0,0 +1,75 @@
+package grpc
+
+import (
+	"context"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+	valuetypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/value"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) GetRunOutcomeAnalytics(ctx context.Context, req *controlplanev1.GetRunOutcomeAnalyticsRequest) (*controlplanev1.RunOutcomeAnalytics, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	groupBy := make([]enumtypes.RunOutcomeDimension, 0, len(req.GetGroupBy()))
+	for _, dimension := range req.GetGroupBy() {
+		groupBy = append(groupBy, enumtypes.RunOutcomeDimension(dimension))
+	}
+	result, err := s.staff.GetRunOutcomeAnalytics(ctx, p, querytypes.RunOutcomeAnalyticsParams{
+		ProjectID: req.GetProjectId(),
+		From:      tsToTime(req.GetFrom()),
+		To:        tsToTime(req.GetTo()),
+		GroupBy:   groupBy,
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	out := &controlplanev1.RunOutcomeAnalytics{
+		From:    timestamppb.New(result.From),
+		To:      timestamppb.New(result.To),
+		GroupBy: make([]string, 0, len(result.GroupBy)),
+		Totals:  runOutcomeGroupToProto(result.Totals),
+		Groups:  make([]*controlplanev1.RunOutcomeGroup, 0, len(result.Groups)),
+	}
+	for _, dimension := range result.GroupBy {
+		out.GroupBy = append(out.GroupBy, string(dimension))
+	}
+	for _, group := range result.Groups {
+		out.Groups = append(out.Groups, runOutcomeGroupToProto(group))
+	}
+	return out, nil
+}
+
+func runOutcomeGroupToProto(group valuetypes.RunOutcomeGroup) *controlplanev1.RunOutcomeGroup {
+	return &controlplanev1.RunOutcomeGroup{
+		ProjectId:                group.ProjectID,
+		Role:                     group.Role,
+		TriggerKind:              group.TriggerKind,
+		Model:                    group.Model,
+		ReasoningEffort:          group.ReasoningEffort,
+		PromptSource:             group.PromptSource,
+		Lineages:                 group.Lineages,
+		Runs:                     group.Runs,
+		ReviseRuns:               group.ReviseRuns,
+		FailedRuns:               group.FailedRuns,
+		ChangesRequested:         group.ChangesRequested,
+		PrsOpened:                group.PRsOpened,
+		PrsMerged:                group.PRsMerged,
+		PrsClosedUnmerged:        group.PRsClosedUnmerged,
+		MergeRate:                group.MergeRate,
+		AvgReviseRounds:          group.AvgReviseRounds,
+		MedianTimeToMergeSeconds: group.MedianTimeToMergeSeconds,
+		AvgWallClockSeconds:      group.AvgWallClockSeconds,
+		AgentSeconds:             group.AgentSeconds,
+	}
+}
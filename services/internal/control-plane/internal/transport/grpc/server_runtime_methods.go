@@ -14,6 +14,8 @@ import (
 	runstatusdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/runstatus"
 	runtimedeploydomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/runtimedeploy"
 	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/staff"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
 	agentcallback "github.com/codex-k8s/kodex/services/internal/control-plane/internal/transport/agentcallback"
 	"google.golang.org/grpc/codes"
@@ -210,6 +212,28 @@ func (s *Server) StopRuntimeDeployTask(ctx context.Context, req *controlplanev1.
 	return s.requestRuntimeDeployTaskAction(ctx, req, runtimedeploydomain.TaskActionStop)
 }
 
+func (s *Server) PreviewRuntimeDeploy(ctx context.Context, req *controlplanev1.PreviewRuntimeDeployRequest) (*controlplanev1.PreviewRuntimeDeployResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	result, err := s.staff.PreviewRuntimeDeploy(ctx, p, runtimedeploydomain.PreviewParams{
+		Namespace:          strings.TrimSpace(req.GetNamespace()),
+		TargetEnv:          strings.TrimSpace(req.GetTargetEnv()),
+		SlotNo:             int(req.GetSlotNo()),
+		RepositoryFullName: strings.TrimSpace(req.GetRepositoryFullName()),
+		ServicesYAMLPath:   strings.TrimSpace(req.GetServicesYamlPath()),
+		BuildRef:           strings.TrimSpace(req.GetBuildRef()),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return runtimeDeployPreviewToProto(result), nil
+}
+
 // TODO(kodex#81): This RPC is temporarily unused after staff UI removed
 // "platform error" alerts. Decide later whether to keep, repurpose, or remove it.
 func (s *Server) ListRuntimeErrors(ctx context.Context, req *controlplanev1.ListRuntimeErrorsRequest) (*controlplanev1.ListRuntimeErrorsResponse, error) {
@@ -244,6 +268,64 @@ func (s *Server) MarkRuntimeErrorViewed(ctx context.Context, req *controlplanev1
 	return requestStaffEntity(ctx, req, req.GetRuntimeErrorId(), s.staff.MarkRuntimeErrorViewed, runtimeErrorToProto)
 }
 
+func (s *Server) ListRuntimeErrorGroups(ctx context.Context, req *controlplanev1.ListRuntimeErrorGroupsRequest) (*controlplanev1.ListRuntimeErrorGroupsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.staff.ListRuntimeErrorGroups(ctx, p, querytypes.RuntimeErrorGroupListFilter{
+		Limit:     clampLimit(req.GetLimit(), 100),
+		Status:    optionalProtoString(req.Status),
+		Level:     optionalProtoString(req.Level),
+		Source:    optionalProtoString(req.Source),
+		ProjectID: optionalProtoString(req.ProjectId),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := make([]*controlplanev1.RuntimeErrorGroup, 0, len(items))
+	for _, item := range items {
+		out = append(out, runtimeErrorGroupToProto(item))
+	}
+	return &controlplanev1.ListRuntimeErrorGroupsResponse{Items: out}, nil
+}
+
+func (s *Server) UpdateRuntimeErrorGroupStatus(ctx context.Context, req *controlplanev1.UpdateRuntimeErrorGroupStatusRequest) (*controlplanev1.RuntimeErrorGroup, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	return mapStaffEntity(func() (entitytypes.RuntimeErrorGroup, error) {
+		return s.staff.UpdateRuntimeErrorGroupStatus(ctx, p, querytypes.RuntimeErrorGroupStatusParams{
+			GroupID:    req.GetGroupId(),
+			Status:     enumtypes.RuntimeErrorGroupStatus(strings.ToLower(strings.TrimSpace(req.GetStatus()))),
+			MutedUntil: optionalTime(req.GetMutedUntil()),
+		})
+	}, runtimeErrorGroupToProto)
+}
+
+func (s *Server) EscalateRuntimeErrorGroup(ctx context.Context, req *controlplanev1.EscalateRuntimeErrorGroupRequest) (*controlplanev1.RuntimeErrorGroup, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	return mapStaffEntity(func() (entitytypes.RuntimeErrorGroup, error) {
+		return s.staff.EscalateRuntimeErrorGroup(ctx, p, querytypes.RuntimeErrorGroupEscalateParams{
+			GroupID: req.GetGroupId(),
+			Kind:    enumtypes.RuntimeErrorGroupEscalationKind(strings.ToLower(strings.TrimSpace(req.GetKind()))),
+		})
+	}, runtimeErrorGroupToProto)
+}
+
 func mapStaffEntity[Item any, Out any](fetch func() (Item, error), cast func(Item) Out) (Out, error) {
 	item, err := fetch()
 	if err != nil {
@@ -630,6 +712,11 @@ func (s *Server) UpsertRunStatusComment(ctx context.Context, req *controlplanev1
 		CodexAuthUserCode:        strings.TrimSpace(req.GetCodexAuthUserCode()),
 		Deleted:                  req.GetDeleted(),
 		AlreadyDeleted:           req.GetAlreadyDeleted(),
+		FailureClass:             strings.TrimSpace(req.GetFailureClass()),
+		RetryScheduled:           req.GetRetryScheduled(),
+		RetryAttempt:             int(req.GetRetryAttempt()),
+		RetryMaxAttempts:         int(req.GetRetryMaxAttempts()),
+		RetryNotBefore:           optionalTime(req.GetRetryNotBefore()),
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, "failed to upsert run status comment")
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/codex-k8s/kodex/libs/go/errs"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
 	agentcallbackdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/agentcallback"
 	githubratelimitdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/githubratelimit"
@@ -161,6 +162,44 @@ func TestGetRunGitHubRateLimitResumePayload_ReturnsRunScopedPayload(t *testing.T
 	}
 }
 
+func TestSuspendRunForApproval_UsesAuthenticatedRun(t *testing.T) {
+	t.Parallel()
+
+	var gotRunID string
+	srv := &Server{
+		mcp: fakeRuntimeMCPRunTokenService{
+			verifyRunToken: func(ctx context.Context, rawToken string) (mcpdomain.SessionContext, error) {
+				return mcpdomain.SessionContext{RunID: "run-1"}, nil
+			},
+			suspendRunForApproval: func(ctx context.Context, runID string) (mcpdomain.SuspendRunForApprovalResult, error) {
+				gotRunID = runID
+				return mcpdomain.SuspendRunForApprovalResult{
+					Suspended:         true,
+					ApprovalRequestID: 42,
+					ToolName:          "secret.sync.k8s",
+					ApprovalState:     "requested",
+					RunnerAction:      "persist_session_and_exit_wait",
+				}, nil
+			},
+		},
+	}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer token-1"))
+	resp, err := srv.SuspendRunForApproval(ctx, &controlplanev1.SuspendRunForApprovalRequest{})
+	if err != nil {
+		t.Fatalf("SuspendRunForApproval() error = %v", err)
+	}
+	if gotRunID != "run-1" {
+		t.Fatalf("runID = %q, want run-1", gotRunID)
+	}
+	if !resp.GetSuspended() || resp.GetApprovalRequestId() != 42 {
+		t.Fatalf("response = %+v, want suspended request 42", resp)
+	}
+	if got, want := resp.GetRunnerAction(), "persist_session_and_exit_wait"; got != want {
+		t.Fatalf("runner_action = %q, want %q", got, want)
+	}
+}
+
 func TestReportGitHubRateLimitSignal_MapsHardFailureToFailedPrecondition(t *testing.T) {
 	t.Parallel()
 
@@ -255,6 +294,7 @@ func TestReportGitHubRateLimitSignal_ReturnsRunnerAction(t *testing.T) {
 type fakeRuntimeAgentCallbackService struct {
 	getRunInteractionResumePayload     func(ctx context.Context, runID string) (json.RawMessage, bool, error)
 	getRunGitHubRateLimitResumePayload func(ctx context.Context, runID string) (json.RawMessage, bool, error)
+	getRunApprovalResumePayload        func(ctx context.Context, runID string) (json.RawMessage, bool, error)
 }
 
 func (f fakeRuntimeAgentCallbackService) UpsertAgentSession(context.Context, agentcallbackdomain.UpsertAgentSessionParams) (agentcallbackdomain.UpsertAgentSessionResult, error) {
@@ -279,6 +319,13 @@ func (f fakeRuntimeAgentCallbackService) GetRunGitHubRateLimitResumePayload(ctx
 	return nil, false, nil
 }
 
+func (f fakeRuntimeAgentCallbackService) GetRunApprovalResumePayload(ctx context.Context, runID string) (json.RawMessage, bool, error) {
+	if f.getRunApprovalResumePayload != nil {
+		return f.getRunApprovalResumePayload(ctx, runID)
+	}
+	return nil, false, nil
+}
+
 func (f fakeRuntimeAgentCallbackService) LookupPullRequest(context.Context, agentcallbackdomain.LookupPullRequestQuery) (agentcallbackdomain.PullRequestLookupResult, bool, error) {
 	return agentcallbackdomain.PullRequestLookupResult{}, false, nil
 }
@@ -288,7 +335,8 @@ func (f fakeRuntimeAgentCallbackService) InsertRunFlowEvent(context.Context, age
 }
 
 type fakeRuntimeMCPRunTokenService struct {
-	verifyRunToken func(ctx context.Context, rawToken string) (mcpdomain.SessionContext, error)
+	verifyRunToken        func(ctx context.Context, rawToken string) (mcpdomain.SessionContext, error)
+	suspendRunForApproval func(ctx context.Context, runID string) (mcpdomain.SuspendRunForApprovalResult, error)
 }
 
 func (f fakeRuntimeMCPRunTokenService) IssueRunToken(context.Context, mcpdomain.IssueRunTokenParams) (mcpdomain.IssuedToken, error) {
@@ -310,6 +358,10 @@ func (f fakeRuntimeMCPRunTokenService) ListPendingApprovals(context.Context, int
 	return nil, nil
 }
 
+func (f fakeRuntimeMCPRunTokenService) GetApproval(context.Context, int64) (mcpdomain.ApprovalListItem, bool, error) {
+	return mcpdomain.ApprovalListItem{}, false, nil
+}
+
 func (f fakeRuntimeMCPRunTokenService) ResolveApproval(context.Context, mcpdomain.ResolveApprovalParams) (mcpdomain.ResolveApprovalResult, error) {
 	return mcpdomain.ResolveApprovalResult{}, nil
 }
@@ -330,6 +382,17 @@ func (f fakeRuntimeMCPRunTokenService) SubmitInteractionCallback(context.Context
 	return mcpdomain.SubmitInteractionCallbackResult{}, nil
 }
 
+func (f fakeRuntimeMCPRunTokenService) SuspendRunForApproval(ctx context.Context, runID string) (mcpdomain.SuspendRunForApprovalResult, error) {
+	if f.suspendRunForApproval != nil {
+		return f.suspendRunForApproval(ctx, runID)
+	}
+	return mcpdomain.SuspendRunForApprovalResult{}, nil
+}
+
+func (f fakeRuntimeMCPRunTokenService) ProjectMCPServers(context.Context, mcpdomain.SessionContext) ([]servicescfg.AgentMCPServer, error) {
+	return nil, nil
+}
+
 type fakeRuntimeGitHubRateLimitService struct {
 	reportSignal func(context.Context, githubratelimitdomain.ReportSignalParams) (githubratelimitdomain.ReportSignalResult, error)
 }
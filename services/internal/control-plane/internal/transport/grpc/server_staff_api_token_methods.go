@@ -0,0 +1,114 @@
+package grpc
+
+import (
+	"context"
+
+	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
+	entitytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/entity"
+	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (s *Server) AuthenticateStaffAPIToken(ctx context.Context, req *controlplanev1.AuthenticateStaffAPITokenRequest) (*controlplanev1.AuthenticateStaffAPITokenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	u, token, err := s.staff.AuthenticateStaffAPIToken(ctx, req.GetToken())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &controlplanev1.AuthenticateStaffAPITokenResponse{
+		Principal:  userToPrincipal(u),
+		TokenId:    token.ID,
+		Scopes:     token.Scopes,
+		ProjectIds: token.ProjectIDs,
+	}, nil
+}
+
+func (s *Server) CreateServiceAccount(ctx context.Context, req *controlplanev1.CreateServiceAccountRequest) (*controlplanev1.User, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	return mapStaffEntity(func() (entitytypes.User, error) {
+		return s.staff.CreateServiceAccount(ctx, p, req.GetName(), req.GetIsPlatformAdmin())
+	}, userToProto)
+}
+
+func (s *Server) CreateStaffAPIToken(ctx context.Context, req *controlplanev1.CreateStaffAPITokenRequest) (*controlplanev1.CreateStaffAPITokenResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "request is required")
+	}
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	params := querytypes.StaffAPITokenIssueParams{
+		UserID:     req.GetUserId(),
+		Name:       req.GetName(),
+		Scopes:     req.GetScopes(),
+		ProjectIDs: req.GetProjectIds(),
+	}
+	if req.ExpiresInDays != nil {
+		days := int(req.GetExpiresInDays())
+		params.ExpiresInDays = &days
+	}
+	token, secret, err := s.staff.CreateStaffAPIToken(ctx, p, params)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &controlplanev1.CreateStaffAPITokenResponse{Token: staffAPITokenToProto(token), Secret: secret}, nil
+}
+
+func (s *Server) ListStaffAPITokens(ctx context.Context, req *controlplanev1.ListStaffAPITokensRequest) (*controlplanev1.ListStaffAPITokensResponse, error) {
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.staff.ListStaffAPITokens(ctx, p, querytypes.StaffAPITokenListFilter{
+		UserID: req.GetUserId(),
+		Limit:  clampLimit(req.GetLimit(), 200),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	out := make([]*controlplanev1.StaffAPIToken, 0, len(items))
+	for _, item := range items {
+		out = append(out, staffAPITokenToProto(item))
+	}
+	return &controlplanev1.ListStaffAPITokensResponse{Items: out}, nil
+}
+
+func (s *Server) RevokeStaffAPIToken(ctx context.Context, req *controlplanev1.RevokeStaffAPITokenRequest) (*emptypb.Empty, error) {
+	p, err := requirePrincipal(req.GetPrincipal())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.staff.RevokeStaffAPIToken(ctx, p, req.GetTokenId()); err != nil {
+		return nil, toStatus(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func staffAPITokenToProto(item entitytypes.StaffAPIToken) *controlplanev1.StaffAPIToken {
+	return &controlplanev1.StaffAPIToken{
+		Id:          item.ID,
+		UserId:      item.UserID,
+		UserEmail:   item.UserEmail,
+		Name:        item.Name,
+		TokenPrefix: item.TokenPrefix,
+		Scopes:      item.Scopes,
+		ProjectIds:  item.ProjectIDs,
+		ExpiresAt:   optionalTimestamp(item.ExpiresAt),
+		LastUsedAt:  optionalTimestamp(item.LastUsedAt),
+		RevokedAt:   optionalTimestamp(item.RevokedAt),
+		CreatedBy:   stringPtrOrNil(item.CreatedBy),
+		CreatedAt:   timestamppb.New(item.CreatedAt.UTC()),
+	}
+}
@@ -3,11 +3,15 @@ package grpc
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"strings"
 
+	"github.com/codex-k8s/kodex/libs/go/errs"
 	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
 	mcpdomain "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/mcp"
+	"github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/projectrbac"
 	repocfgrepo "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/repository/repocfg"
+	enumtypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/enum"
 	querytypes "github.com/codex-k8s/kodex/services/internal/control-plane/internal/domain/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -198,9 +202,6 @@ func (s *Server) ListPendingApprovals(ctx context.Context, req *controlplanev1.L
 	if err != nil {
 		return nil, err
 	}
-	if !p.IsPlatformAdmin {
-		return nil, status.Error(codes.PermissionDenied, "platform admin required")
-	}
 
 	limit := clampLimit(req.GetLimit(), 200)
 	items, err := s.mcp.ListPendingApprovals(ctx, limit)
@@ -208,8 +209,27 @@ func (s *Server) ListPendingApprovals(ctx context.Context, req *controlplanev1.L
 		return nil, toStatus(err)
 	}
 
+	// Non-admins see only approvals their project role is allowed to resolve.
+	permissionsByProject := make(map[string]projectrbac.PermissionSet)
 	out := make([]*controlplanev1.ApprovalRequest, 0, len(items))
 	for _, item := range items {
+		if !p.IsPlatformAdmin {
+			if item.ProjectID == "" {
+				continue
+			}
+			permissions, ok := permissionsByProject[item.ProjectID]
+			if !ok {
+				var forbidden errs.Forbidden
+				permissions, err = s.staff.ResolveProjectPermissions(ctx, p, item.ProjectID)
+				if err != nil && !errors.As(err, &forbidden) {
+					return nil, toStatus(err)
+				}
+				permissionsByProject[item.ProjectID] = permissions
+			}
+			if !permissions.Allows(enumtypes.ProjectPermissionApprovalsResolve, item.ToolName) {
+				continue
+			}
+		}
 		out = append(out, approvalToProto(item))
 	}
 	return &controlplanev1.ListPendingApprovalsResponse{Items: out}, nil
@@ -227,10 +247,6 @@ func (s *Server) ResolveApprovalDecision(ctx context.Context, req *controlplanev
 	if err != nil {
 		return nil, err
 	}
-	if !p.IsPlatformAdmin {
-		return nil, status.Error(codes.PermissionDenied, "platform admin required")
-	}
-
 	if req.GetApprovalRequestId() <= 0 {
 		return nil, status.Error(codes.InvalidArgument, "approval_request_id is required")
 	}
@@ -238,12 +254,27 @@ func (s *Server) ResolveApprovalDecision(ctx context.Context, req *controlplanev
 	if decision == "" {
 		return nil, status.Error(codes.InvalidArgument, "decision is required")
 	}
+	if !p.IsPlatformAdmin {
+		approval, ok, err := s.mcp.GetApproval(ctx, req.GetApprovalRequestId())
+		if err != nil {
+			return nil, toStatus(err)
+		}
+		if !ok {
+			return nil, status.Error(codes.NotFound, "approval request not found")
+		}
+		if err := s.staff.RequireProjectPermission(ctx, p, approval.ProjectID, enumtypes.ProjectPermissionApprovalsResolve, approval.ToolName); err != nil {
+			return nil, toStatus(err)
+		}
+	}
 
 	item, err := s.mcp.ResolveApproval(ctx, mcpdomain.ResolveApprovalParams{
 		RequestID: req.GetApprovalRequestId(),
 		Decision:  mcpdomain.ApprovalDecision(decision),
 		ActorID:   approvalActorID(p),
 		Reason:    strings.TrimSpace(req.GetReason()),
+
+		ActorUserID:      p.UserID,
+		ActorGitHubLogin: p.GitHubLogin,
 	})
 	if err != nil {
 		return nil, toStatus(err)
@@ -255,6 +286,9 @@ func (s *Server) ResolveApprovalDecision(ctx context.Context, req *controlplanev
 		ToolName:      item.ToolName,
 		Action:        item.Action,
 		ApprovalState: item.ApprovalState,
+
+		RequiredApprovals: int32(item.RequiredApprovals),
+		ApprovalsCount:    int32(item.ApprovalsCount),
 	}, nil
 }
 
@@ -271,10 +305,12 @@ func (s *Server) ListRunEvents(ctx context.Context, req *controlplanev1.ListRunE
 	out := make([]*controlplanev1.FlowEvent, 0, len(items))
 	for _, e := range items {
 		out = append(out, &controlplanev1.FlowEvent{
-			CorrelationId: e.CorrelationID,
-			EventType:     e.EventType,
-			CreatedAt:     timestamppb.New(e.CreatedAt.UTC()),
-			PayloadJson:   string(e.PayloadJSON),
+			Id:              e.ID,
+			CorrelationId:   e.CorrelationID,
+			EventType:       e.EventType,
+			CreatedAt:       timestamppb.New(e.CreatedAt.UTC()),
+			PayloadJson:     string(e.PayloadJSON),
+			RetentionPinned: e.RetentionPinned,
 		})
 	}
 	return &controlplanev1.ListRunEventsResponse{Items: out}, nil
@@ -344,14 +380,7 @@ func (s *Server) ListUsers(ctx context.Context, req *controlplanev1.ListUsersReq
 	}
 	out := make([]*controlplanev1.User, 0, len(items))
 	for _, u := range items {
-		out = append(out, &controlplanev1.User{
-			Id:              u.ID,
-			Email:           u.Email,
-			GithubUserId:    int64PtrOrNil(u.GitHubUserID),
-			GithubLogin:     stringPtrOrNil(u.GitHubLogin),
-			IsPlatformAdmin: u.IsPlatformAdmin,
-			IsPlatformOwner: u.IsPlatformOwner,
-		})
+		out = append(out, userToProto(u))
 	}
 	return &controlplanev1.ListUsersResponse{Items: out}, nil
 }
@@ -365,14 +394,7 @@ func (s *Server) CreateUser(ctx context.Context, req *controlplanev1.CreateUserR
 	if err != nil {
 		return nil, toStatus(err)
 	}
-	return &controlplanev1.User{
-		Id:              u.ID,
-		Email:           u.Email,
-		GithubUserId:    int64PtrOrNil(u.GitHubUserID),
-		GithubLogin:     stringPtrOrNil(u.GitHubLogin),
-		IsPlatformAdmin: u.IsPlatformAdmin,
-		IsPlatformOwner: u.IsPlatformOwner,
-	}, nil
+	return userToProto(u), nil
 }
 
 func (s *Server) DeleteUser(ctx context.Context, req *controlplanev1.DeleteUserRequest) (*emptypb.Empty, error) {
@@ -2,6 +2,7 @@ package mcp
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -20,6 +21,7 @@ type domainService interface {
 	VerifyRunToken(ctx context.Context, rawToken string) (mcpdomain.SessionContext, error)
 	AllowedTools(ctx context.Context, session mcpdomain.SessionContext) ([]mcpdomain.ToolCapability, error)
 	IsToolAllowed(ctx context.Context, session mcpdomain.SessionContext, toolName mcpdomain.ToolName) (bool, error)
+	CallProjectTool(ctx context.Context, session mcpdomain.SessionContext, toolName mcpdomain.ToolName, arguments json.RawMessage) (mcpdomain.ProjectToolCallResult, error)
 	GitHubLabelsList(ctx context.Context, session mcpdomain.SessionContext, input mcpdomain.GitHubLabelsListInput) (mcpdomain.GitHubLabelsListResult, error)
 	GitHubLabelsAdd(ctx context.Context, session mcpdomain.SessionContext, input mcpdomain.GitHubLabelsAddInput) (mcpdomain.GitHubLabelsMutationResult, error)
 	GitHubLabelsRemove(ctx context.Context, session mcpdomain.SessionContext, input mcpdomain.GitHubLabelsRemoveInput) (mcpdomain.GitHubLabelsMutationResult, error)
@@ -2,6 +2,7 @@ package mcp
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strings"
 
@@ -51,7 +52,7 @@ func handleToolsListAccess(ctx context.Context, method string, req sdkmcp.Reques
 		return result, nil
 	}
 
-	filteredTools := make([]*sdkmcp.Tool, 0, len(toolsResult.Tools))
+	filteredTools := make([]*sdkmcp.Tool, 0, len(toolsResult.Tools)+len(allowedTools))
 	for _, tool := range toolsResult.Tools {
 		if tool == nil {
 			continue
@@ -60,6 +61,16 @@ func handleToolsListAccess(ctx context.Context, method string, req sdkmcp.Reques
 			filteredTools = append(filteredTools, tool)
 		}
 	}
+	// Project tools come from repository services.yaml and are not registered on the shared server.
+	for _, tool := range allowedTools {
+		if mcpdomain.IsProjectToolName(tool.Name) {
+			filteredTools = append(filteredTools, &sdkmcp.Tool{
+				Name:        string(tool.Name),
+				Description: tool.Description,
+				InputSchema: tool.InputSchema,
+			})
+		}
+	}
 
 	return &sdkmcp.ListToolsResult{
 		Meta:       toolsResult.Meta,
@@ -85,10 +96,31 @@ func handleToolCallAccess(ctx context.Context, method string, req sdkmcp.Request
 	if !allowed {
 		return nil, fmt.Errorf("tool %q is not available for current run profile", toolName)
 	}
+	if mcpdomain.IsProjectToolName(toolName) {
+		return callProjectTool(ctx, req, service, session, toolName)
+	}
 
 	return next(ctx, method, req)
 }
 
+func callProjectTool(ctx context.Context, req sdkmcp.Request, service domainService, session mcpdomain.SessionContext, toolName mcpdomain.ToolName) (sdkmcp.Result, error) {
+	params, _ := req.GetParams().(*sdkmcp.CallToolParamsRaw)
+	result, err := service.CallProjectTool(ctx, session, toolName, params.Arguments)
+	if err != nil {
+		errResult := &sdkmcp.CallToolResult{}
+		errResult.SetError(err)
+		return errResult, nil
+	}
+	encoded, err := json.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("marshal project tool result: %w", err)
+	}
+	return &sdkmcp.CallToolResult{
+		Content:           []sdkmcp.Content{&sdkmcp.TextContent{Text: string(encoded)}},
+		StructuredContent: result,
+	}, nil
+}
+
 func sessionFromRequest(req sdkmcp.Request) (mcpdomain.SessionContext, error) {
 	return sessionFromTokenInfo(req.GetExtra())
 }
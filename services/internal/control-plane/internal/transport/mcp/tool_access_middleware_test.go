@@ -2,6 +2,7 @@ package mcp
 
 import (
 	"context"
+	"encoding/json"
 	"testing"
 	"time"
 
@@ -61,6 +62,73 @@ func TestHandleToolsListAccessFiltersToAllowedCatalog(t *testing.T) {
 	}
 }
 
+func TestHandleToolsListAccessAppendsProjectTools(t *testing.T) {
+	t.Parallel()
+
+	req := &sdkmcp.ServerRequest[*sdkmcp.ListToolsParams]{
+		Params: &sdkmcp.ListToolsParams{},
+		Extra:  testRequestExtra(),
+	}
+	service := toolAccessMiddlewareTestService{
+		allowedTools: []mcpdomain.ToolCapability{
+			{Name: mcpdomain.ToolGitHubLabelsList},
+			{Name: "project.flags_toggle", Description: "Toggle flag", InputSchema: map[string]any{"type": "object"}},
+		},
+	}
+
+	result, err := handleToolsListAccess(context.Background(), mcpMethodToolsList, req, service, func(context.Context, string, sdkmcp.Request) (sdkmcp.Result, error) {
+		return &sdkmcp.ListToolsResult{Tools: []*sdkmcp.Tool{{Name: string(mcpdomain.ToolGitHubLabelsList)}}}, nil
+	})
+	if err != nil {
+		t.Fatalf("handleToolsListAccess() error = %v", err)
+	}
+	toolsResult := result.(*sdkmcp.ListToolsResult)
+	if len(toolsResult.Tools) != 2 || toolsResult.Tools[1].Name != "project.flags_toggle" {
+		t.Fatalf("unexpected tools/list result: %#v", toolsResult.Tools)
+	}
+	if toolsResult.Tools[1].InputSchema == nil {
+		t.Fatal("expected project tool input schema to be exposed")
+	}
+}
+
+func TestHandleToolCallAccessDispatchesProjectTool(t *testing.T) {
+	t.Parallel()
+
+	req := &sdkmcp.ServerRequest[*sdkmcp.CallToolParamsRaw]{
+		Params: &sdkmcp.CallToolParamsRaw{Name: "project.flags_toggle", Arguments: json.RawMessage(`{"flag":"beta"}`)},
+		Extra:  testRequestExtra(),
+	}
+	service := toolAccessMiddlewareTestService{
+		allowedTools: []mcpdomain.ToolCapability{{Name: "project.flags_toggle"}},
+	}
+
+	result, err := handleToolCallAccess(context.Background(), mcpMethodToolsCall, req, service, func(context.Context, string, sdkmcp.Request) (sdkmcp.Result, error) {
+		t.Fatal("project tool call must not reach static tool handlers")
+		return nil, nil
+	})
+	if err != nil {
+		t.Fatalf("handleToolCallAccess() error = %v", err)
+	}
+	callResult, ok := result.(*sdkmcp.CallToolResult)
+	if !ok || callResult.IsError {
+		t.Fatalf("unexpected call result: %#v", result)
+	}
+	structured, ok := callResult.StructuredContent.(mcpdomain.ProjectToolCallResult)
+	if !ok || string(structured.Response) != `{"flag":"beta"}` {
+		t.Fatalf("unexpected structured content: %#v", callResult.StructuredContent)
+	}
+}
+
+func testRequestExtra() *sdkmcp.RequestExtra {
+	return &sdkmcp.RequestExtra{
+		TokenInfo: &auth.TokenInfo{
+			Extra: map[string]any{
+				tokenInfoSessionKey: mcpdomain.SessionContext{RunID: "run-1"},
+			},
+		},
+	}
+}
+
 type toolAccessMiddlewareTestService struct {
 	allowedTools []mcpdomain.ToolCapability
 }
@@ -82,6 +150,10 @@ func (s toolAccessMiddlewareTestService) IsToolAllowed(_ context.Context, _ mcpd
 	return false, nil
 }
 
+func (toolAccessMiddlewareTestService) CallProjectTool(_ context.Context, _ mcpdomain.SessionContext, toolName mcpdomain.ToolName, arguments json.RawMessage) (mcpdomain.ProjectToolCallResult, error) {
+	return mcpdomain.ProjectToolCallResult{Status: mcpdomain.ToolExecutionStatusOK, Tool: toolName, Response: arguments}, nil
+}
+
 func (toolAccessMiddlewareTestService) GitHubLabelsList(context.Context, mcpdomain.SessionContext, mcpdomain.GitHubLabelsListInput) (mcpdomain.GitHubLabelsListResult, error) {
 	return mcpdomain.GitHubLabelsListResult{}, nil
 }
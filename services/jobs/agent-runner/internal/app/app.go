@@ -55,6 +55,7 @@ func Run() error {
 		RuntimeTargetEnv:         cfg.RuntimeTargetEnv,
 		RuntimeBuildRef:          cfg.RuntimeBuildRef,
 		RuntimeAccessProfile:     cfg.RuntimeAccessProfile,
+		AlertContext:             cfg.AlertContext,
 		QualityGovernanceEnabled: cfg.QualityGovernanceEnabled,
 		PromptConfig: runner.PromptConfig{
 			TriggerKind:          cfg.TriggerKind,
@@ -23,6 +23,8 @@ type Config struct {
 	RuntimeTargetEnv     string `env:"KODEX_RUNTIME_TARGET_ENV"`
 	RuntimeBuildRef      string `env:"KODEX_RUNTIME_BUILD_REF"`
 	RuntimeAccessProfile string `env:"KODEX_RUNTIME_ACCESS_PROFILE"`
+	// AlertContext is Alertmanager incident JSON injected by worker for alert-triggered ops runs.
+	AlertContext string `env:"KODEX_ALERT_CONTEXT"`
 	// QualityGovernanceEnabled is a worker-injected snapshot of the current runtime system setting.
 	QualityGovernanceEnabled bool `env:"KODEX_QUALITY_GOVERNANCE_ENABLED" envDefault:"false"`
 
@@ -9,6 +9,7 @@ import (
 
 	"github.com/codex-k8s/kodex/libs/go/domain/flowevent"
 	"github.com/codex-k8s/kodex/libs/go/grpcutil"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 	controlplanev1 "github.com/codex-k8s/kodex/proto/gen/go/kodex/controlplane/v1"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -237,6 +238,20 @@ type RunGitHubRateLimitResumePayload struct {
 	Payload json.RawMessage
 }
 
+// RunApprovalResumePayload is the deterministic approval outcome fetched for the current run.
+type RunApprovalResumePayload struct {
+	Payload json.RawMessage
+}
+
+// SuspendRunForApprovalResult reports whether the run may persist its session and exit until approval is decided.
+type SuspendRunForApprovalResult struct {
+	Suspended         bool
+	ApprovalRequestID int64
+	ToolName          string
+	ApprovalState     string
+	RunnerAction      string
+}
+
 // LatestAgentSessionQuery describes latest-session lookup identity.
 type LatestAgentSessionQuery struct {
 	RepositoryFullName string
@@ -407,6 +422,48 @@ func (c *Client) GetRunGitHubRateLimitResumePayload(ctx context.Context) (RunGit
 	})
 }
 
+// GetRunApprovalResumePayload loads deterministic approval resume payload for the authenticated run.
+func (c *Client) GetRunApprovalResumePayload(ctx context.Context) (RunApprovalResumePayload, bool, error) {
+	return loadRunPayload(ctx, "approval resume payload", c.getRunApprovalResumePayload, func(payload json.RawMessage) RunApprovalResumePayload {
+		return RunApprovalResumePayload{Payload: payload}
+	})
+}
+
+// SuspendRunForApproval asks control-plane to suspend the authenticated run until its open approval is decided.
+func (c *Client) SuspendRunForApproval(ctx context.Context) (SuspendRunForApprovalResult, error) {
+	resp, err := c.svc.SuspendRunForApproval(c.withAuth(ctx), &controlplanev1.SuspendRunForApprovalRequest{})
+	if err != nil {
+		return SuspendRunForApprovalResult{}, fmt.Errorf("suspend run for approval: %w", err)
+	}
+	return SuspendRunForApprovalResult{
+		Suspended:         resp.GetSuspended(),
+		ApprovalRequestID: resp.GetApprovalRequestId(),
+		ToolName:          strings.TrimSpace(resp.GetToolName()),
+		ApprovalState:     strings.TrimSpace(resp.GetApprovalState()),
+		RunnerAction:      strings.TrimSpace(resp.GetRunnerAction()),
+	}, nil
+}
+
+// ListRunProjectMCPServers loads external MCP servers that control-plane allows for the authenticated run.
+func (c *Client) ListRunProjectMCPServers(ctx context.Context) ([]servicescfg.AgentMCPServer, error) {
+	resp, err := c.svc.ListRunProjectMCPServers(c.withAuth(ctx), &controlplanev1.ListRunProjectMCPServersRequest{})
+	if err != nil {
+		return nil, fmt.Errorf("list run project mcp servers: %w", err)
+	}
+	out := make([]servicescfg.AgentMCPServer, 0, len(resp.GetServers()))
+	for _, server := range resp.GetServers() {
+		out = append(out, servicescfg.AgentMCPServer{
+			Name:               strings.TrimSpace(server.GetName()),
+			Command:            strings.TrimSpace(server.GetCommand()),
+			Args:               server.GetArgs(),
+			Env:                server.GetEnv(),
+			URL:                strings.TrimSpace(server.GetUrl()),
+			ToolTimeoutSeconds: int(server.GetToolTimeoutSeconds()),
+		})
+	}
+	return out, nil
+}
+
 // ReportGitHubRateLimitSignal hands off one agent-runner GitHub rate-limit signal to control-plane.
 func (c *Client) ReportGitHubRateLimitSignal(ctx context.Context, params ReportGitHubRateLimitSignalParams) (ReportGitHubRateLimitSignalResult, error) {
 	request := &controlplanev1.ReportGitHubRateLimitSignalRequest{
@@ -674,6 +731,10 @@ func (c *Client) getRunGitHubRateLimitResumePayload(ctx context.Context) (runPay
 	return c.svc.GetRunGitHubRateLimitResumePayload(c.withAuth(ctx), &controlplanev1.GetRunGitHubRateLimitResumePayloadRequest{})
 }
 
+func (c *Client) getRunApprovalResumePayload(ctx context.Context) (runPayloadResponse, error) {
+	return c.svc.GetRunApprovalResumePayload(c.withAuth(ctx), &controlplanev1.GetRunApprovalResumePayloadRequest{})
+}
+
 func intToOptional(value *int) *wrapperspb.Int32Value {
 	if value == nil || *value <= 0 {
 		return nil
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 	cpclient "github.com/codex-k8s/kodex/services/jobs/agent-runner/internal/controlplane"
 )
 
@@ -68,12 +69,13 @@ type codexAuthSynchronizedPayload struct {
 	Source string `json:"source"`
 }
 
-func (s *Service) ensureCodexReady(ctx context.Context, state codexState) error {
+func (s *Service) ensureCodexReady(ctx context.Context, state codexState, mcpServers []servicescfg.AgentMCPServer) error {
 	desiredMode := s.desiredCodexAuthMode()
 
 	if desiredMode == codexAuthModeChatGPT {
 		// Ensure ChatGPT device auth is valid by doing a minimal call to gpt-5.2.
-		if err := s.writeCodexConfig(state.codexDir, codexAuthCheckModel, codexAuthCheckReasoningEffort); err != nil {
+		// Project MCP servers are not mounted for the auth check to keep it fast.
+		if err := s.writeCodexConfig(state.codexDir, codexAuthCheckModel, codexAuthCheckReasoningEffort, nil); err != nil {
 			return err
 		}
 		if err := s.ensureCodexAuthenticated(ctx, state); err != nil {
@@ -82,7 +84,7 @@ func (s *Service) ensureCodexReady(ctx context.Context, state codexState) error
 	}
 
 	// Restore actual run model config.
-	if err := s.writeCodexConfig(state.codexDir, s.cfg.AgentModel, s.cfg.AgentReasoningEffort); err != nil {
+	if err := s.writeCodexConfig(state.codexDir, s.cfg.AgentModel, s.cfg.AgentReasoningEffort, mcpServers); err != nil {
 		return err
 	}
 
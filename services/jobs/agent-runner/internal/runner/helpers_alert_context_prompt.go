@@ -0,0 +1,67 @@
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+)
+
+// buildAlertContextPromptBlock renders the Alertmanager incident of alert-triggered ops runs.
+// Unlike resume payloads, alert context does not require a restored session: it starts a fresh run.
+func buildAlertContextPromptBlock(locale string, rawPayload string) (string, error) {
+	if strings.TrimSpace(rawPayload) == "" {
+		return "", nil
+	}
+	payload, err := parseDeterministicResumePayload(
+		rawPayload,
+		webhookdomain.AlertContextMaxBytes,
+		"alert context",
+		normalizeAlertContext,
+		validateAlertContext,
+	)
+	if err != nil {
+		return "", err
+	}
+	prettyPayload, err := json.MarshalIndent(payload, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshal alert context: %w", err)
+	}
+
+	if normalizePromptLocale(locale) == promptLocaleRU {
+		return fmt.Sprintf(
+			"Контекст инцидента Alertmanager:\n%s\n\n```json\n%s\n```",
+			"Этот run запущен сработавшим алертом. Начните диагностику с labels, annotations и generator_url ниже; выводы и предлагаемые действия фиксируйте в issue инцидента.",
+			prettyPayload,
+		), nil
+	}
+	return fmt.Sprintf(
+		"Alertmanager incident context:\n%s\n\n```json\n%s\n```",
+		"This run was started by a firing alert. Start diagnostics from the labels, annotations, and generator_url below; report findings and proposed actions in the incident issue.",
+		prettyPayload,
+	), nil
+}
+
+func normalizeAlertContext(payload webhookdomain.AlertContext) webhookdomain.AlertContext {
+	trimStringFields(
+		&payload.IncidentID,
+		&payload.Fingerprint,
+		&payload.AlertName,
+		&payload.Severity,
+		&payload.Status,
+		&payload.StartsAt,
+		&payload.GeneratorURL,
+	)
+	return payload
+}
+
+func validateAlertContext(payload webhookdomain.AlertContext) error {
+	if payload.Fingerprint == "" {
+		return fmt.Errorf("alert context fingerprint is required")
+	}
+	if payload.AlertName == "" {
+		return fmt.Errorf("alert context alert_name is required")
+	}
+	return nil
+}
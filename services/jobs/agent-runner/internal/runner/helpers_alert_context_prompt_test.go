@@ -0,0 +1,52 @@
+package runner
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildPrompt_PrependsAlertContextPromptBlockWithoutRestoredSession(t *testing.T) {
+	t.Parallel()
+
+	service := &Service{
+		cfg: Config{
+			RunID:              "run-alert",
+			RepositoryFullName: "codex-k8s/kodex",
+			AgentKey:           "sre",
+			IssueNumber:        77,
+			RuntimeMode:        runtimeModeFullEnv,
+			AlertContext:       `{"incident_id":"incident-1","fingerprint":"fp-1","alert_name":"HighLatency","severity":"critical","labels":{"service":"api"}}`,
+			PromptConfig: PromptConfig{
+				TriggerKind:          "ops",
+				TriggerLabel:         "run:ops",
+				StateInReviewLabel:   "state:in-review",
+				PromptTemplateLocale: promptLocaleEN,
+				AgentBaseBranch:      "main",
+			},
+		},
+	}
+
+	prompt, err := service.buildPrompt("task body", runResult{
+		targetBranch: "codex/issue-77",
+		triggerKind:  "ops",
+		templateKind: promptTemplateKindWork,
+	}, t.TempDir())
+	if err != nil {
+		t.Fatalf("buildPrompt() error = %v", err)
+	}
+	if !strings.HasPrefix(prompt, "Alertmanager incident context:") {
+		t.Fatalf("prompt must start with alert context block, got: %q", prompt)
+	}
+	if !strings.Contains(prompt, `"alert_name": "HighLatency"`) {
+		t.Fatalf("prompt must contain pretty-printed alert context, got: %q", prompt)
+	}
+}
+
+func TestBuildAlertContextPromptBlock_RequiresFingerprint(t *testing.T) {
+	t.Parallel()
+
+	_, err := buildAlertContextPromptBlock(promptLocaleRU, `{"alert_name":"HighLatency"}`)
+	if err == nil || !strings.Contains(err.Error(), "fingerprint is required") {
+		t.Fatalf("expected fingerprint validation error, got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/mcp/approvalwait"
+)
+
+type approvalWaitAcceptedError struct {
+	ApprovalRequestID int64
+	ToolName          string
+	ApprovalState     string
+	PersistErr        error
+}
+
+func (e approvalWaitAcceptedError) Error() string {
+	message := fmt.Sprintf("approval wait accepted: approval_request_id=%d tool=%s state=%s", e.ApprovalRequestID, e.ToolName, e.ApprovalState)
+	if e.PersistErr == nil {
+		return message
+	}
+	return fmt.Sprintf("%s waiting_snapshot_persist_err=%v", message, e.PersistErr)
+}
+
+func (e approvalWaitAcceptedError) Unwrap() error {
+	return e.PersistErr
+}
+
+// trySuspendForApproval releases the run pod when the agent ended its turn on an open approval request.
+// Control-plane queues a resume run once the approval reaches a final state.
+func (s *Service) trySuspendForApproval(ctx context.Context, state codexState, result *runResult, runStartedAt time.Time, output []byte) error {
+	if s == nil || s.cp == nil || result == nil {
+		return nil
+	}
+
+	suspension, err := s.cp.SuspendRunForApproval(ctx)
+	if err != nil {
+		s.logger.Warn("approval suspension check failed; continuing run", "run_id", s.cfg.RunID, "err", err)
+		return nil
+	}
+	if !suspension.Suspended {
+		return nil
+	}
+	if action := strings.TrimSpace(suspension.RunnerAction); action != approvalwait.RunnerActionPersistSessionAndExitWait {
+		return fmt.Errorf("unsupported approval runner action %q", action)
+	}
+
+	s.refreshSessionResultFromDisk(result, state.sessionsDir)
+	result.codexExecOutput = redactSensitiveOutput(trimCapturedOutput(string(output), maxCapturedCommandOutput), s.sensitiveValues())
+	waitAcceptedErr := approvalWaitAcceptedError{
+		ApprovalRequestID: suspension.ApprovalRequestID,
+		ToolName:          suspension.ToolName,
+		ApprovalState:     suspension.ApprovalState,
+	}
+	if _, err := s.persistSessionSnapshot(ctx, result, state, runStartedAt, runStatusRunning, nil); err != nil {
+		s.logger.Warn(
+			"approval wait accepted but session snapshot persist failed; keeping accepted wait path to avoid terminal failed overwrite",
+			"run_id", s.cfg.RunID,
+			"approval_request_id", suspension.ApprovalRequestID,
+			"err", err,
+		)
+		waitAcceptedErr.PersistErr = fmt.Errorf("persist session snapshot after approval suspension: %w", err)
+	}
+	return waitAcceptedErr
+}
+
+func isApprovalWaitAccepted(err error) bool {
+	var waitErr approvalWaitAcceptedError
+	return errors.As(err, &waitErr)
+}
+
+// isRunWaitAccepted reports whether the run handed off to a platform-owned wait and must exit cleanly.
+func isRunWaitAccepted(err error) bool {
+	return isGitHubRateLimitWaitAccepted(err) || isApprovalWaitAccepted(err)
+}
@@ -0,0 +1,106 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/mcp/approvalwait"
+	cpclient "github.com/codex-k8s/kodex/services/jobs/agent-runner/internal/controlplane"
+)
+
+func TestRunCodexExecWithAuthRecovery_ApprovalSuspendedPersistsRunningSnapshot(t *testing.T) {
+	t.Setenv("PATH", buildFakeSucceedingCodexPath(t))
+
+	sessionsDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(sessionsDir, "session.json"), []byte(`{"session_id":"sess-approval","cwd":"/workspace"}`), 0o600); err != nil {
+		t.Fatalf("write fake session file: %v", err)
+	}
+
+	controlPlane := &fakeGitHubRateLimitControlPlane{
+		suspendResult: cpclient.SuspendRunForApprovalResult{
+			Suspended:         true,
+			ApprovalRequestID: 42,
+			ToolName:          "database_lifecycle",
+			ApprovalState:     "requested",
+			RunnerAction:      approvalwait.RunnerActionPersistSessionAndExitWait,
+		},
+	}
+	service := NewService(Config{
+		RunID:              "run-approval",
+		CorrelationID:      "corr-approval",
+		RepositoryFullName: "codex-k8s/kodex",
+		AgentKey:           "dev",
+	}, controlPlane, nil)
+
+	result := runResult{targetBranch: "codex/issue-45", triggerKind: "dev", templateKind: promptTemplateKindWork}
+	_, err := service.runCodexExecWithAuthRecovery(
+		context.Background(),
+		codexState{repoDir: t.TempDir(), sessionsDir: sessionsDir},
+		&result,
+		time.Date(2026, time.April, 2, 10, 0, 0, 0, time.UTC),
+		codexExecParams{RepoDir: t.TempDir(), Prompt: "prompt"},
+	)
+	if !isRunWaitAccepted(err) {
+		t.Fatalf("expected approval wait acceptance, got %v", err)
+	}
+	var waitErr approvalWaitAcceptedError
+	if !errors.As(err, &waitErr) {
+		t.Fatalf("expected approvalWaitAcceptedError, got %T", err)
+	}
+	if waitErr.ApprovalRequestID != 42 || waitErr.PersistErr != nil {
+		t.Fatalf("unexpected wait error: %+v", waitErr)
+	}
+	if got, want := controlPlane.persistedStatuses, []string{runStatusRunning}; !equalStringSlices(got, want) {
+		t.Fatalf("persisted statuses = %v, want %v", got, want)
+	}
+	if got := result.sessionID; got != "sess-approval" {
+		t.Fatalf("result.sessionID = %q, want sess-approval", got)
+	}
+}
+
+func TestRunCodexExecWithAuthRecovery_NoOpenApprovalFinishesNormally(t *testing.T) {
+	t.Setenv("PATH", buildFakeSucceedingCodexPath(t))
+
+	controlPlane := &fakeGitHubRateLimitControlPlane{}
+	service := NewService(Config{RunID: "run-plain", RepositoryFullName: "codex-k8s/kodex", AgentKey: "dev"}, controlPlane, nil)
+
+	result := runResult{targetBranch: "codex/issue-45", triggerKind: "dev", templateKind: promptTemplateKindWork}
+	output, err := service.runCodexExecWithAuthRecovery(
+		context.Background(),
+		codexState{repoDir: t.TempDir(), sessionsDir: t.TempDir()},
+		&result,
+		time.Date(2026, time.April, 2, 10, 0, 0, 0, time.UTC),
+		codexExecParams{RepoDir: t.TempDir(), Prompt: "prompt"},
+	)
+	if err != nil {
+		t.Fatalf("runCodexExecWithAuthRecovery() error = %v", err)
+	}
+	if len(output) == 0 {
+		t.Fatal("expected codex output to be returned")
+	}
+	if controlPlane.suspendCalls != 1 {
+		t.Fatalf("suspend calls = %d, want 1", controlPlane.suspendCalls)
+	}
+	if len(controlPlane.persistedStatuses) != 0 {
+		t.Fatalf("persisted statuses = %v, want none", controlPlane.persistedStatuses)
+	}
+}
+
+func buildFakeSucceedingCodexPath(t *testing.T) string {
+	t.Helper()
+
+	binDir := t.TempDir()
+	scriptPath := filepath.Join(binDir, "codex")
+	script := `#!/usr/bin/env bash
+set -euo pipefail
+echo '{"summary":"waiting for approval"}'
+`
+	if err := os.WriteFile(scriptPath, []byte(script), 0o755); err != nil {
+		t.Fatalf("write fake codex script: %v", err)
+	}
+	return binDir + string(os.PathListSeparator) + os.Getenv("PATH")
+}
@@ -0,0 +1,88 @@
+package runner
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/codex-k8s/kodex/libs/go/mcp/approvalwait"
+)
+
+const (
+	approvalStateApplied = "applied"
+	approvalStateDenied  = "denied"
+	approvalStateExpired = "expired"
+	approvalStateFailed  = "failed"
+)
+
+type approvalResumePayload struct {
+	ApprovalRequestID int64  `json:"approval_request_id"`
+	ToolName          string `json:"tool_name"`
+	Action            string `json:"action"`
+	ApprovalState     string `json:"approval_state"`
+	ResolvedAt        string `json:"resolved_at"`
+	ResolutionReason  string `json:"resolution_reason,omitempty"`
+
+	ToolHTTPStatus        int             `json:"tool_http_status,omitempty"`
+	ToolResponse          json.RawMessage `json:"tool_response,omitempty"`
+	ToolResponseTruncated bool            `json:"tool_response_truncated,omitempty"`
+}
+
+func buildApprovalResumePromptBlock(locale string, rawPayload string, resume bool) (string, error) {
+	return buildDeterministicResumePromptBlock(
+		locale,
+		rawPayload,
+		resume,
+		"approval resume payload requires restored codex session",
+		parseApprovalResumePayload,
+		"Детерминированный resume context (approval wait):",
+		"Ниже machine-readable итог approval для привилегированного MCP-действия, на котором run был приостановлен. Используйте этот JSON как authoritative source: при `applied` действие уже выполнено платформой и не вызывайте инструмент повторно, а результат project-инструмента (если есть) находится в `tool_http_status`/`tool_response`; при `denied`/`expired`/`failed` продолжайте без этого действия или зафиксируйте блокер.",
+		"Deterministic resume context (approval wait):",
+		"Below is the machine-readable approval outcome for the privileged MCP action the run was suspended on. Treat this JSON as the authoritative source: on `applied` the platform has already performed the action and the tool must not be called again, and a project tool result (if any) is in `tool_http_status`/`tool_response`; on `denied`/`expired`/`failed` continue without the action or record the blocker.",
+	)
+}
+
+func parseApprovalResumePayload(rawPayload string) (approvalResumePayload, error) {
+	return parseDeterministicResumePayload(
+		rawPayload,
+		approvalwait.ResumePayloadMaxBytes,
+		"approval resume payload",
+		normalizeApprovalResumePayload,
+		validateApprovalResumePayload,
+	)
+}
+
+func normalizeApprovalResumePayload(payload approvalResumePayload) approvalResumePayload {
+	trimStringFields(
+		&payload.ToolName,
+		&payload.Action,
+		&payload.ApprovalState,
+		&payload.ResolvedAt,
+		&payload.ResolutionReason,
+	)
+	return payload
+}
+
+func validateApprovalResumePayload(payload approvalResumePayload) error {
+	if payload.ApprovalRequestID <= 0 {
+		return fmt.Errorf("approval resume payload: approval_request_id must be > 0")
+	}
+	if payload.ToolName == "" {
+		return fmt.Errorf("approval resume payload: tool_name is required")
+	}
+	if payload.Action == "" {
+		return fmt.Errorf("approval resume payload: action is required")
+	}
+	switch payload.ApprovalState {
+	case approvalStateApplied, approvalStateDenied, approvalStateExpired, approvalStateFailed:
+	default:
+		return fmt.Errorf("approval resume payload: approval_state %q is not supported", payload.ApprovalState)
+	}
+	if payload.ResolvedAt == "" {
+		return fmt.Errorf("approval resume payload: resolved_at is required")
+	}
+	if _, err := time.Parse(time.RFC3339Nano, payload.ResolvedAt); err != nil {
+		return fmt.Errorf("approval resume payload: resolved_at must be RFC3339: %w", err)
+	}
+	return nil
+}
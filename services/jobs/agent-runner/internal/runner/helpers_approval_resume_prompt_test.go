@@ -0,0 +1,57 @@
+package runner
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildApprovalResumePromptBlock_ValidatesResumeContext(t *testing.T) {
+	t.Parallel()
+
+	_, err := buildApprovalResumePromptBlock(promptLocaleEN, `{"approval_request_id":42}`, false)
+	if err == nil {
+		t.Fatal("expected error when approval resume payload is provided without restored session")
+	}
+	if !strings.Contains(err.Error(), "requires restored codex session") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestBuildApprovalResumePromptBlock_RendersPayload(t *testing.T) {
+	t.Parallel()
+
+	block, err := buildApprovalResumePromptBlock(promptLocaleEN, `{"approval_request_id":42,"tool_name":"database_lifecycle","action":"delete","approval_state":"applied","resolved_at":"2026-04-02T10:00:00Z"}`, true)
+	if err != nil {
+		t.Fatalf("buildApprovalResumePromptBlock() error = %v", err)
+	}
+	if !strings.HasPrefix(block, "Deterministic resume context (approval wait):") {
+		t.Fatalf("unexpected block header: %q", block)
+	}
+	if !strings.Contains(block, `"approval_state": "applied"`) {
+		t.Fatalf("block must contain pretty-printed approval payload, got: %q", block)
+	}
+}
+
+func TestParseApprovalResumePayload_RejectsUnsupportedState(t *testing.T) {
+	t.Parallel()
+
+	_, err := parseApprovalResumePayload(`{"approval_request_id":42,"tool_name":"database_lifecycle","action":"delete","approval_state":"requested","resolved_at":"2026-04-02T10:00:00Z"}`)
+	if err == nil {
+		t.Fatal("expected unsupported approval_state error")
+	}
+	if !strings.Contains(err.Error(), "approval_state") {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestBuildApprovalResumePromptBlock_RendersProjectToolResult(t *testing.T) {
+	t.Parallel()
+
+	block, err := buildApprovalResumePromptBlock(promptLocaleEN, `{"approval_request_id":42,"tool_name":"project.deploy_preview","action":"project_tool_call","approval_state":"applied","resolved_at":"2026-04-02T10:00:00Z","tool_http_status":200,"tool_response":{"preview_url":"https://preview.example.com"}}`, true)
+	if err != nil {
+		t.Fatalf("buildApprovalResumePromptBlock() error = %v", err)
+	}
+	if !strings.Contains(block, `"tool_http_status": 200`) || !strings.Contains(block, `"preview_url": "https://preview.example.com"`) {
+		t.Fatalf("block must contain project tool result, got: %q", block)
+	}
+}
@@ -12,6 +12,7 @@ import (
 
 	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
 	sharedgithubratelimit "github.com/codex-k8s/kodex/libs/go/domain/githubratelimit"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 	cpclient "github.com/codex-k8s/kodex/services/jobs/agent-runner/internal/controlplane"
 )
 
@@ -179,6 +180,8 @@ type fakeGitHubRateLimitControlPlane struct {
 	reportParams      cpclient.ReportGitHubRateLimitSignalParams
 	reportResult      cpclient.ReportGitHubRateLimitSignalResult
 	failPersistAt     int
+	suspendResult     cpclient.SuspendRunForApprovalResult
+	suspendCalls      int
 }
 
 func (f *fakeGitHubRateLimitControlPlane) UpsertAgentSession(_ context.Context, params cpclient.AgentSessionUpsertParams) (cpclient.AgentSessionUpsertResult, error) {
@@ -204,6 +207,19 @@ func (f *fakeGitHubRateLimitControlPlane) GetRunGitHubRateLimitResumePayload(con
 	return cpclient.RunGitHubRateLimitResumePayload{}, false, nil
 }
 
+func (f *fakeGitHubRateLimitControlPlane) GetRunApprovalResumePayload(context.Context) (cpclient.RunApprovalResumePayload, bool, error) {
+	return cpclient.RunApprovalResumePayload{}, false, nil
+}
+
+func (f *fakeGitHubRateLimitControlPlane) SuspendRunForApproval(context.Context) (cpclient.SuspendRunForApprovalResult, error) {
+	f.suspendCalls++
+	return f.suspendResult, nil
+}
+
+func (f *fakeGitHubRateLimitControlPlane) ListRunProjectMCPServers(context.Context) ([]servicescfg.AgentMCPServer, error) {
+	return nil, nil
+}
+
 func (f *fakeGitHubRateLimitControlPlane) ReportGitHubRateLimitSignal(_ context.Context, params cpclient.ReportGitHubRateLimitSignalParams) (cpclient.ReportGitHubRateLimitSignalResult, error) {
 	f.reportParams = params
 	return f.reportResult, nil
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 	cpclient "github.com/codex-k8s/kodex/services/jobs/agent-runner/internal/controlplane"
 )
 
@@ -121,6 +122,18 @@ func (f *fakeOutputRecoveryControlPlane) GetRunGitHubRateLimitResumePayload(cont
 	return cpclient.RunGitHubRateLimitResumePayload{}, false, nil
 }
 
+func (f *fakeOutputRecoveryControlPlane) GetRunApprovalResumePayload(context.Context) (cpclient.RunApprovalResumePayload, bool, error) {
+	return cpclient.RunApprovalResumePayload{}, false, nil
+}
+
+func (f *fakeOutputRecoveryControlPlane) SuspendRunForApproval(context.Context) (cpclient.SuspendRunForApprovalResult, error) {
+	return cpclient.SuspendRunForApprovalResult{}, nil
+}
+
+func (f *fakeOutputRecoveryControlPlane) ListRunProjectMCPServers(context.Context) ([]servicescfg.AgentMCPServer, error) {
+	return nil, nil
+}
+
 func (f *fakeOutputRecoveryControlPlane) ReportGitHubRateLimitSignal(context.Context, cpclient.ReportGitHubRateLimitSignalParams) (cpclient.ReportGitHubRateLimitSignalResult, error) {
 	return cpclient.ReportGitHubRateLimitSignalResult{}, nil
 }
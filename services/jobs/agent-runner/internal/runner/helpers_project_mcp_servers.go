@@ -0,0 +1,76 @@
+package runner
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+)
+
+// loadProjectMCPServersForRun asks control-plane for external MCP servers of the run. Control-plane resolves
+// them from services.yaml of the repository default ref, so edits in the run branch cannot add servers;
+// a lookup failure leaves only platform MCP servers mounted.
+func (s *Service) loadProjectMCPServersForRun(ctx context.Context) []servicescfg.AgentMCPServer {
+	servers, err := s.cp.ListRunProjectMCPServers(ctx)
+	if err != nil {
+		s.logger.Warn("list project mcp servers failed", "err", err)
+		return nil
+	}
+	if len(servers) == 0 {
+		return nil
+	}
+	return servers
+}
+
+func projectMCPServerNames(servers []servicescfg.AgentMCPServer) []string {
+	names := make([]string, 0, len(servers))
+	for _, server := range servers {
+		names = append(names, server.Name)
+	}
+	return names
+}
+
+// buildCodexMCPServersTemplateData pre-renders TOML values; JSON strings and string arrays are valid TOML.
+func buildCodexMCPServersTemplateData(servers []servicescfg.AgentMCPServer) []codexMCPServerTemplateData {
+	if len(servers) == 0 {
+		return nil
+	}
+
+	items := make([]codexMCPServerTemplateData, 0, len(servers))
+	for _, server := range servers {
+		item := codexMCPServerTemplateData{
+			Name:           server.Name,
+			ToolTimeoutSec: server.ToolTimeoutSeconds,
+		}
+		if server.Command != "" {
+			item.Command = tomlQuote(server.Command)
+			args := server.Args
+			if args == nil {
+				args = []string{}
+			}
+			item.Args = tomlQuote(args)
+		} else {
+			item.URL = tomlQuote(server.URL)
+		}
+
+		keys := make([]string, 0, len(server.Env))
+		for key := range server.Env {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			item.Env = append(item.Env, codexMCPServerEnvTemplateData{Key: key, Value: tomlQuote(server.Env[key])})
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func tomlQuote(value any) string {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return `""`
+	}
+	return string(raw)
+}
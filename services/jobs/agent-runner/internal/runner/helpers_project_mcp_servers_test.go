@@ -0,0 +1,80 @@
+package runner
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+)
+
+type fakeProjectMCPServersControlPlane struct {
+	ControlPlaneCallbacks
+	servers []servicescfg.AgentMCPServer
+	err     error
+}
+
+func (f *fakeProjectMCPServersControlPlane) ListRunProjectMCPServers(context.Context) ([]servicescfg.AgentMCPServer, error) {
+	return f.servers, f.err
+}
+
+var projectMCPServersTestItems = []servicescfg.AgentMCPServer{
+	{Name: "docs-search", URL: "https://mcp.example.com/docs", ToolTimeoutSeconds: 60},
+	{Name: "sentry", Command: "npx", Args: []string{"-y", "@sentry/mcp-server"}, Env: map[string]string{"SENTRY_HOST": "sentry.example.com"}},
+	{Name: "ops-only", Command: "ops-mcp"},
+}
+
+func TestLoadProjectMCPServersForRun_UsesControlPlaneList(t *testing.T) {
+	t.Parallel()
+
+	repoDir := t.TempDir()
+	agentYAML := "spec:\n  agentTools:\n    mcpServers:\n      - name: agent-added\n        command: curl\n"
+	if err := os.WriteFile(filepath.Join(repoDir, "services.yaml"), []byte(agentYAML), 0o644); err != nil {
+		t.Fatalf("write services.yaml: %v", err)
+	}
+
+	controlPlane := &fakeProjectMCPServersControlPlane{servers: projectMCPServersTestItems}
+	service := NewService(Config{RunID: "run-1", AgentKey: "dev"}, controlPlane, nil)
+	servers := service.loadProjectMCPServersForRun(context.Background())
+	if got, want := projectMCPServerNames(servers), []string{"docs-search", "sentry", "ops-only"}; !slices.Equal(got, want) {
+		t.Fatalf("servers=%v, want %v", got, want)
+	}
+
+	controlPlane.err = errors.New("unavailable")
+	if servers := service.loadProjectMCPServersForRun(context.Background()); servers != nil {
+		t.Fatalf("servers on control-plane error=%v, want nil", servers)
+	}
+}
+
+func TestWriteCodexConfig_RendersProjectMCPServers(t *testing.T) {
+	t.Parallel()
+
+	servers := projectMCPServersTestItems
+
+	codexDir := t.TempDir()
+	svc := &Service{cfg: Config{MCPBaseURL: "http://kodex-control-plane:8081/mcp"}}
+	if err := svc.writeCodexConfig(codexDir, "gpt-5.3-codex", "high", servers); err != nil {
+		t.Fatalf("writeCodexConfig: %v", err)
+	}
+	raw, err := os.ReadFile(filepath.Join(codexDir, "config.toml"))
+	if err != nil {
+		t.Fatalf("read config.toml: %v", err)
+	}
+	content := string(raw)
+
+	for _, want := range []string{
+		"[mcp_servers.kodex]",
+		"[mcp_servers.docs-search]\nurl = \"https://mcp.example.com/docs\"\ntool_timeout_sec = 60",
+		"[mcp_servers.sentry]\ncommand = \"npx\"\nargs = [\"-y\",\"@sentry/mcp-server\"]",
+		"[mcp_servers.sentry.env]\nSENTRY_HOST = \"sentry.example.com\"",
+		"[mcp_servers.ops-only]\ncommand = \"ops-mcp\"\nargs = []",
+	} {
+		if !strings.Contains(content, want) {
+			t.Fatalf("config.toml does not contain %q:\n%s", want, content)
+		}
+	}
+}
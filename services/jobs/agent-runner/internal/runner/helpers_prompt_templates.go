@@ -10,6 +10,7 @@ import (
 	"text/template"
 
 	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 )
 
 //go:embed templates/*.tmpl templates/prompt_blocks/*.tmpl
@@ -85,7 +86,7 @@ func (s *Service) renderTaskTemplate(templateKind string, repoDir string) (strin
 	return renderTemplate(templateName, templateData)
 }
 
-func (s *Service) writeCodexConfig(codexDir string, model string, reasoningEffort string) error {
+func (s *Service) writeCodexConfig(codexDir string, model string, reasoningEffort string, mcpServers []servicescfg.AgentMCPServer) error {
 	context7APIKey := strings.TrimSpace(os.Getenv(envContext7APIKey))
 	hasContext7 := context7APIKey != ""
 	content, err := renderTemplate(templateNameCodexConfig, codexConfigTemplateData{
@@ -94,6 +95,7 @@ func (s *Service) writeCodexConfig(codexDir string, model string, reasoningEffor
 		MCPBaseURL:      s.cfg.MCPBaseURL,
 		HasContext7:     hasContext7,
 		Context7APIKey:  context7APIKey,
+		MCPServers:      buildCodexMCPServersTemplateData(mcpServers),
 	})
 	if err != nil {
 		return err
@@ -129,6 +131,18 @@ func (s *Service) buildPrompt(taskBody string, result runResult, repoDir string)
 	if err != nil {
 		return "", fmt.Errorf("build github rate-limit resume prompt block: %w", err)
 	}
+	approvalResumePromptBlock, err := buildApprovalResumePromptBlock(
+		s.cfg.PromptTemplateLocale,
+		s.cfg.ApprovalResumePayload,
+		result.restoredSessionPath != "" || result.sessionID != "",
+	)
+	if err != nil {
+		return "", fmt.Errorf("build approval resume prompt block: %w", err)
+	}
+	alertContextPromptBlock, err := buildAlertContextPromptBlock(s.cfg.PromptTemplateLocale, s.cfg.AlertContext)
+	if err != nil {
+		return "", fmt.Errorf("build alert context prompt block: %w", err)
+	}
 	isReviseTrigger := !isDiscussionMode && webhookdomain.IsReviseTriggerKind(webhookdomain.NormalizeTriggerKind(result.triggerKind))
 	roleProfileBlock, err := renderPromptRoleProfileBlock(s.cfg.AgentKey, s.cfg.PromptTemplateLocale)
 	if err != nil {
@@ -180,13 +194,19 @@ func (s *Service) buildPrompt(taskBody string, result runResult, repoDir string)
 	if err != nil {
 		return "", err
 	}
-	resumeBlocks := make([]string, 0, 2)
+	resumeBlocks := make([]string, 0, 4)
+	if strings.TrimSpace(alertContextPromptBlock) != "" {
+		resumeBlocks = append(resumeBlocks, alertContextPromptBlock)
+	}
 	if strings.TrimSpace(interactionResumePromptBlock) != "" {
 		resumeBlocks = append(resumeBlocks, interactionResumePromptBlock)
 	}
 	if strings.TrimSpace(githubRateLimitResumePromptBlock) != "" {
 		resumeBlocks = append(resumeBlocks, githubRateLimitResumePromptBlock)
 	}
+	if strings.TrimSpace(approvalResumePromptBlock) != "" {
+		resumeBlocks = append(resumeBlocks, approvalResumePromptBlock)
+	}
 	if len(resumeBlocks) == 0 {
 		return prompt, nil
 	}
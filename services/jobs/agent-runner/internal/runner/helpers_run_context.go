@@ -7,6 +7,7 @@ import (
 
 	sharedgithubratelimit "github.com/codex-k8s/kodex/libs/go/domain/githubratelimit"
 	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+	"github.com/codex-k8s/kodex/libs/go/mcp/approvalwait"
 	"github.com/codex-k8s/kodex/libs/go/mcp/userinteraction"
 )
 
@@ -22,6 +23,10 @@ func hasGitHubRateLimitResumePayload(rawPayload string) bool {
 	return strings.TrimSpace(rawPayload) != ""
 }
 
+func hasApprovalResumePayload(rawPayload string) bool {
+	return strings.TrimSpace(rawPayload) != ""
+}
+
 func isInteractionResumeRun(correlationID string) bool {
 	return strings.HasPrefix(strings.TrimSpace(correlationID), userinteraction.ResumeCorrelationPrefix)
 }
@@ -30,8 +35,15 @@ func isGitHubRateLimitResumeRun(correlationID string) bool {
 	return strings.HasPrefix(strings.TrimSpace(correlationID), sharedgithubratelimit.ResumeCorrelationPrefix)
 }
 
-func shouldRestoreLatestSession(triggerKind string, discussionMode bool, interactionResumePayload string, githubRateLimitResumePayload string) bool {
-	if discussionMode || hasInteractionResumePayload(interactionResumePayload) || hasGitHubRateLimitResumePayload(githubRateLimitResumePayload) {
+func isApprovalResumeRun(correlationID string) bool {
+	return strings.HasPrefix(strings.TrimSpace(correlationID), approvalwait.ResumeCorrelationPrefix)
+}
+
+func shouldRestoreLatestSession(triggerKind string, discussionMode bool, interactionResumePayload string, githubRateLimitResumePayload string, approvalResumePayload string) bool {
+	if discussionMode ||
+		hasInteractionResumePayload(interactionResumePayload) ||
+		hasGitHubRateLimitResumePayload(githubRateLimitResumePayload) ||
+		hasApprovalResumePayload(approvalResumePayload) {
 		return true
 	}
 	return webhookdomain.IsReviseTriggerKind(webhookdomain.NormalizeTriggerKind(triggerKind))
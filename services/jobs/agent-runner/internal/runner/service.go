@@ -80,7 +80,7 @@ func (s *Service) Run(ctx context.Context) (err error) {
 		if errors.As(err, &exitErr) && exitErr.ExitCode == 42 {
 			return
 		}
-		if isGitHubRateLimitWaitAccepted(err) {
+		if isRunWaitAccepted(err) {
 			return
 		}
 		finishedAt := time.Now().UTC()
@@ -117,8 +117,13 @@ func (s *Service) Run(ctx context.Context) (err error) {
 		return err
 	}
 	s.cfg.GitHubRateLimitResumePayload = githubRateLimitResumePayload
+	approvalResumePayload, err := s.resolveApprovalResumePayload(ctx)
+	if err != nil {
+		return err
+	}
+	s.cfg.ApprovalResumePayload = approvalResumePayload
 
-	if shouldRestoreLatestSession(triggerKind, s.cfg.DiscussionMode, s.cfg.InteractionResumePayload, s.cfg.GitHubRateLimitResumePayload) {
+	if shouldRestoreLatestSession(triggerKind, s.cfg.DiscussionMode, s.cfg.InteractionResumePayload, s.cfg.GitHubRateLimitResumePayload, s.cfg.ApprovalResumePayload) {
 		restored, restoreErr := s.restoreLatestSession(ctx, result.targetBranch, state.sessionsDir)
 		if restoreErr != nil {
 			return ExitError{ExitCode: 5, Err: fmt.Errorf("restore latest session: %w", restoreErr)}
@@ -144,14 +149,16 @@ func (s *Service) Run(ctx context.Context) (err error) {
 		return fmt.Errorf("resolve repository baseline head: %w", err)
 	}
 
-	if err := s.ensureCodexReady(ctx, state); err != nil {
+	projectMCPServers := s.loadProjectMCPServersForRun(ctx)
+	if err := s.ensureCodexReady(ctx, state, projectMCPServers); err != nil {
 		return err
 	}
 	if err := s.emitEvent(ctx, floweventdomain.EventTypeRunAgentReady, map[string]string{
-		"branch":       targetBranch,
-		"trigger_kind": triggerKind,
-		"runtime_mode": runtimeMode,
-		"agent_key":    s.cfg.AgentKey,
+		"branch":              targetBranch,
+		"trigger_kind":        triggerKind,
+		"runtime_mode":        runtimeMode,
+		"agent_key":           s.cfg.AgentKey,
+		"project_mcp_servers": strings.Join(projectMCPServerNames(projectMCPServers), ","),
 	}); err != nil {
 		s.logger.Warn("emit run.agent.ready failed", "err", err)
 	}
@@ -189,8 +196,8 @@ func (s *Service) Run(ctx context.Context) (err error) {
 	}
 	if s.cfg.DiscussionMode {
 		if err := s.runDiscussionLoop(ctx, state, &result, runStartedAt, outputSchemaFile, sensitiveValues); err != nil {
-			if isGitHubRateLimitWaitAccepted(err) {
-				s.logger.Info("agent-runner entered github rate-limit wait", "run_id", s.cfg.RunID, "branch", result.targetBranch)
+			if isRunWaitAccepted(err) {
+				s.logger.Info("agent-runner entered platform wait", "run_id", s.cfg.RunID, "branch", result.targetBranch, "wait", err.Error())
 				return nil
 			}
 			return err
@@ -223,8 +230,8 @@ func (s *Service) Run(ctx context.Context) (err error) {
 		Prompt:           prompt,
 	})
 	if err != nil {
-		if isGitHubRateLimitWaitAccepted(err) {
-			s.logger.Info("agent-runner entered github rate-limit wait", "run_id", s.cfg.RunID, "branch", result.targetBranch)
+		if isRunWaitAccepted(err) {
+			s.logger.Info("agent-runner entered platform wait", "run_id", s.cfg.RunID, "branch", result.targetBranch, "wait", err.Error())
 			return nil
 		}
 		return fmt.Errorf("codex exec failed: %w", err)
@@ -240,8 +247,8 @@ func (s *Service) Run(ctx context.Context) (err error) {
 
 	report, repairedOutput, err := s.resolveCodexReport(ctx, state, &result, runStartedAt, outputSchemaFile, codexOutput, requiresPRFlow)
 	if err != nil {
-		if isGitHubRateLimitWaitAccepted(err) {
-			s.logger.Info("agent-runner entered github rate-limit wait", "run_id", s.cfg.RunID, "branch", result.targetBranch)
+		if isRunWaitAccepted(err) {
+			s.logger.Info("agent-runner entered platform wait", "run_id", s.cfg.RunID, "branch", result.targetBranch, "wait", err.Error())
 			return nil
 		}
 		return err
@@ -377,6 +384,10 @@ func (s *Service) resolveGitHubRateLimitResumePayload(ctx context.Context) (stri
 	return s.resolveResumePayload(ctx, "github rate-limit resume payload", isGitHubRateLimitResumeRun, hasGitHubRateLimitResumePayload, s.loadGitHubRateLimitResumePayload)
 }
 
+func (s *Service) resolveApprovalResumePayload(ctx context.Context) (string, error) {
+	return s.resolveResumePayload(ctx, "approval resume payload", isApprovalResumeRun, hasApprovalResumePayload, s.loadApprovalResumePayload)
+}
+
 func (s *Service) resolveResumePayload(
 	ctx context.Context,
 	payloadLabel string,
@@ -406,6 +417,10 @@ func (s *Service) loadGitHubRateLimitResumePayload(ctx context.Context) (string,
 	return s.loadRunScopedPayload(ctx, "github rate-limit resume payload", s.getGitHubRateLimitResumePayloadBytes)
 }
 
+func (s *Service) loadApprovalResumePayload(ctx context.Context) (string, error) {
+	return s.loadRunScopedPayload(ctx, "approval resume payload", s.getApprovalResumePayloadBytes)
+}
+
 func (s *Service) loadRunScopedPayload(
 	ctx context.Context,
 	payloadLabel string,
@@ -435,9 +450,17 @@ func (s *Service) getGitHubRateLimitResumePayloadBytes(ctx context.Context) ([]b
 	return payload.Payload, found, err
 }
 
+func (s *Service) getApprovalResumePayloadBytes(ctx context.Context) ([]byte, bool, error) {
+	payload, found, err := s.cp.GetRunApprovalResumePayload(ctx)
+	return payload.Payload, found, err
+}
+
 func (s *Service) runCodexExecWithAuthRecovery(ctx context.Context, state codexState, result *runResult, runStartedAt time.Time, params codexExecParams) ([]byte, error) {
 	output, stderr, err := runCodexExec(ctx, params)
 	if err == nil {
+		if suspendErr := s.trySuspendForApproval(ctx, state, result, runStartedAt, output); suspendErr != nil {
+			return nil, suspendErr
+		}
 		return output, nil
 	}
 	if handoffErr := s.tryHandoffGitHubRateLimit(ctx, state, result, runStartedAt, output, stderr, err); handoffErr != nil {
@@ -459,6 +482,9 @@ func (s *Service) runCodexExecWithAuthRecovery(ctx context.Context, state codexS
 		}
 		return nil, codexExecFailure{Output: output, Stderr: stderr, Err: err}
 	}
+	if suspendErr := s.trySuspendForApproval(ctx, state, result, runStartedAt, output); suspendErr != nil {
+		return nil, suspendErr
+	}
 	return output, nil
 }
 
@@ -542,7 +568,8 @@ func (s *Service) restoreLatestSession(ctx context.Context, branch string, sessi
 
 	allowsSessionRestoreWithoutPR := s.cfg.DiscussionMode ||
 		hasInteractionResumePayload(s.cfg.InteractionResumePayload) ||
-		hasGitHubRateLimitResumePayload(s.cfg.GitHubRateLimitResumePayload)
+		hasGitHubRateLimitResumePayload(s.cfg.GitHubRateLimitResumePayload) ||
+		hasApprovalResumePayload(s.cfg.ApprovalResumePayload)
 	if snapshot.PRNumber <= 0 {
 		if allowsSessionRestoreWithoutPR {
 			result := restoredSession{}
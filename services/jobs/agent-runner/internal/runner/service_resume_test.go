@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 	cpclient "github.com/codex-k8s/kodex/services/jobs/agent-runner/internal/controlplane"
 )
 
@@ -241,6 +242,18 @@ func (f *fakeSessionRestoreControlPlane) GetRunGitHubRateLimitResumePayload(cont
 	return f.githubResumePayload, f.githubResumeFound, nil
 }
 
+func (f *fakeSessionRestoreControlPlane) GetRunApprovalResumePayload(context.Context) (cpclient.RunApprovalResumePayload, bool, error) {
+	return cpclient.RunApprovalResumePayload{}, false, nil
+}
+
+func (f *fakeSessionRestoreControlPlane) SuspendRunForApproval(context.Context) (cpclient.SuspendRunForApprovalResult, error) {
+	return cpclient.SuspendRunForApprovalResult{}, nil
+}
+
+func (f *fakeSessionRestoreControlPlane) ListRunProjectMCPServers(context.Context) ([]servicescfg.AgentMCPServer, error) {
+	return nil, nil
+}
+
 func (f *fakeSessionRestoreControlPlane) ReportGitHubRateLimitSignal(context.Context, cpclient.ReportGitHubRateLimitSignalParams) (cpclient.ReportGitHubRateLimitSignalResult, error) {
 	return cpclient.ReportGitHubRateLimitSignalResult{}, nil
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 	cpclient "github.com/codex-k8s/kodex/services/jobs/agent-runner/internal/controlplane"
 )
 
@@ -71,6 +72,8 @@ type Config struct {
 	RuntimeAccessProfile         string
 	InteractionResumePayload     string
 	GitHubRateLimitResumePayload string
+	ApprovalResumePayload        string
+	AlertContext                 string
 	QualityGovernanceEnabled     bool
 
 	PromptConfig
@@ -91,6 +94,9 @@ type ControlPlaneCallbacks interface {
 	GetLatestAgentSession(ctx context.Context, query cpclient.LatestAgentSessionQuery) (cpclient.AgentSessionSnapshot, bool, error)
 	GetRunInteractionResumePayload(ctx context.Context) (cpclient.RunInteractionResumePayload, bool, error)
 	GetRunGitHubRateLimitResumePayload(ctx context.Context) (cpclient.RunGitHubRateLimitResumePayload, bool, error)
+	GetRunApprovalResumePayload(ctx context.Context) (cpclient.RunApprovalResumePayload, bool, error)
+	SuspendRunForApproval(ctx context.Context) (cpclient.SuspendRunForApprovalResult, error)
+	ListRunProjectMCPServers(ctx context.Context) ([]servicescfg.AgentMCPServer, error)
 	ReportGitHubRateLimitSignal(ctx context.Context, params cpclient.ReportGitHubRateLimitSignalParams) (cpclient.ReportGitHubRateLimitSignalResult, error)
 	ReportChangeGovernanceDraftSignal(ctx context.Context, params cpclient.ReportChangeGovernanceDraftSignalParams) (cpclient.ReportChangeGovernanceDraftSignalResult, error)
 	PublishChangeGovernanceWaveMap(ctx context.Context, params cpclient.PublishChangeGovernanceWaveMapParams) (cpclient.PublishChangeGovernanceWaveMapResult, error)
@@ -228,6 +234,22 @@ type codexConfigTemplateData struct {
 	MCPBaseURL      string
 	HasContext7     bool
 	Context7APIKey  string
+	MCPServers      []codexMCPServerTemplateData
+}
+
+// codexMCPServerTemplateData holds pre-quoted TOML values of one project-declared MCP server.
+type codexMCPServerTemplateData struct {
+	Name           string
+	Command        string
+	Args           string
+	URL            string
+	Env            []codexMCPServerEnvTemplateData
+	ToolTimeoutSec int
+}
+
+type codexMCPServerEnvTemplateData struct {
+	Key   string
+	Value string
 }
 
 type kubectlKubeconfigTemplateData struct {
@@ -117,6 +117,41 @@ func Run() error {
 	if missionControlRetryBaseInterval <= 0 {
 		return fmt.Errorf("KODEX_WORKER_MISSION_CONTROL_RETRY_BASE_INTERVAL must be > 0")
 	}
+	retentionPollInterval, err := time.ParseDuration(cfg.RetentionPollInterval)
+	if err != nil {
+		return fmt.Errorf("parse KODEX_WORKER_RETENTION_POLL_INTERVAL: %w", err)
+	}
+	if retentionPollInterval <= 0 {
+		return fmt.Errorf("KODEX_WORKER_RETENTION_POLL_INTERVAL must be > 0")
+	}
+	retentionSweepInterval, err := time.ParseDuration(cfg.RetentionSweepInterval)
+	if err != nil {
+		return fmt.Errorf("parse KODEX_WORKER_RETENTION_SWEEP_INTERVAL: %w", err)
+	}
+	if retentionSweepInterval <= 0 {
+		return fmt.Errorf("KODEX_WORKER_RETENTION_SWEEP_INTERVAL must be > 0")
+	}
+	warmPoolReconcileInterval, err := time.ParseDuration(cfg.WarmPoolReconcileInterval)
+	if err != nil {
+		return fmt.Errorf("parse KODEX_WORKER_WARM_POOL_RECONCILE_INTERVAL: %w", err)
+	}
+	if warmPoolReconcileInterval <= 0 {
+		return fmt.Errorf("KODEX_WORKER_WARM_POOL_RECONCILE_INTERVAL must be > 0")
+	}
+	warmPoolPrepareTimeout, err := time.ParseDuration(cfg.WarmPoolPrepareTimeout)
+	if err != nil {
+		return fmt.Errorf("parse KODEX_WORKER_WARM_POOL_PREPARE_TIMEOUT: %w", err)
+	}
+	if warmPoolPrepareTimeout <= 0 {
+		return fmt.Errorf("KODEX_WORKER_WARM_POOL_PREPARE_TIMEOUT must be > 0")
+	}
+	warmPoolMaxAge, err := time.ParseDuration(cfg.WarmPoolMaxAge)
+	if err != nil {
+		return fmt.Errorf("parse KODEX_WORKER_WARM_POOL_MAX_AGE: %w", err)
+	}
+	if warmPoolMaxAge <= 0 {
+		return fmt.Errorf("KODEX_WORKER_WARM_POOL_MAX_AGE must be > 0")
+	}
 	jobImageCheckTimeout, err := time.ParseDuration(cfg.JobImageCheckTimeout)
 	if err != nil {
 		return fmt.Errorf("parse KODEX_WORKER_JOB_IMAGE_CHECK_TIMEOUT: %w", err)
@@ -143,6 +178,8 @@ func Run() error {
 
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
 	namespaceLeaseDefaultTTL, namespaceLeaseTTLByRole := loadWebhookRuntimeNamespaceTTLPolicy(cfg, logger)
+	resourceProfiles, resourceProfileByRole := loadWebhookRuntimeResourceProfilePolicy(cfg, logger)
+	runRetryPolicy, runRetryPolicyByTriggerKind := loadWebhookRuntimeRetryPolicy(cfg, logger)
 	ctx, stop := signal.NotifyContext(appCtx, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT, syscall.SIGHUP)
 	defer stop()
 
@@ -250,11 +287,23 @@ func Run() error {
 		MissionControlClaimTTL:            missionControlClaimTTL,
 		MissionControlRetryMaxAttempts:    cfg.MissionControlRetryMaxAttempts,
 		MissionControlRetryBaseInterval:   missionControlRetryBaseInterval,
+		RetentionPollInterval:             retentionPollInterval,
+		RetentionSweepInterval:            retentionSweepInterval,
+		RetentionPolicyLimit:              cfg.RetentionPolicyLimit,
+		RetentionBatchSize:                cfg.RetentionBatchSize,
+		RetentionMaxBatches:               cfg.RetentionMaxBatches,
+		WarmPoolReconcileInterval:         warmPoolReconcileInterval,
+		WarmPoolPrepareTimeout:            warmPoolPrepareTimeout,
+		WarmPoolMaxAge:                    warmPoolMaxAge,
 		ProjectLearningModeDefault:        learningDefault,
 		RunNamespacePrefix:                cfg.RunNamespacePrefix,
 		RunNamespaceCleanupEnabled:        cfg.RunNamespaceCleanup,
 		DefaultNamespaceTTL:               namespaceLeaseDefaultTTL,
 		NamespaceTTLByRole:                namespaceLeaseTTLByRole,
+		ResourceProfiles:                  resourceProfiles,
+		ResourceProfileByRole:             resourceProfileByRole,
+		RunRetryPolicy:                    runRetryPolicy,
+		RunRetryPolicyByTriggerKind:       runRetryPolicyByTriggerKind,
 		NamespaceLeaseSweepLimit:          cfg.NamespaceLeaseSweepLimit,
 		StateInReviewLabel:                cfg.StateInReviewLabel,
 		ControlPlaneGRPCTarget:            cfg.ControlPlaneGRPCTarget,
@@ -297,6 +346,7 @@ func Run() error {
 		Interactions:          controlPlane,
 		GitHubRateLimits:      controlPlane,
 		MissionControl:        controlPlane,
+		Retention:             controlPlane,
 		InteractionDispatcher: interactionDispatcher,
 		JobImageChecker:       jobImageChecker,
 		Logger:                logger,
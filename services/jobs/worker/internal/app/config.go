@@ -53,6 +53,22 @@ type Config struct {
 	MissionControlRetryMaxAttempts int `env:"KODEX_WORKER_MISSION_CONTROL_RETRY_MAX_ATTEMPTS" envDefault:"3"`
 	// MissionControlRetryBaseInterval defines the first retry delay for Mission Control commands.
 	MissionControlRetryBaseInterval string `env:"KODEX_WORKER_MISSION_CONTROL_RETRY_BASE_INTERVAL" envDefault:"2s"`
+	// RetentionPollInterval throttles how often worker triggers retention sweeps of history tables.
+	RetentionPollInterval string `env:"KODEX_WORKER_RETENTION_POLL_INTERVAL" envDefault:"5m"`
+	// RetentionSweepInterval defines how often one drained retention policy is swept again.
+	RetentionSweepInterval string `env:"KODEX_WORKER_RETENTION_SWEEP_INTERVAL" envDefault:"1h"`
+	// RetentionPolicyLimit limits retention policies claimed per sweep.
+	RetentionPolicyLimit int `env:"KODEX_WORKER_RETENTION_POLICY_LIMIT" envDefault:"20"`
+	// RetentionBatchSize limits expired rows removed (and archived) per batch.
+	RetentionBatchSize int `env:"KODEX_WORKER_RETENTION_BATCH_SIZE" envDefault:"500"`
+	// RetentionMaxBatches limits batches processed per retention policy in one sweep.
+	RetentionMaxBatches int `env:"KODEX_WORKER_RETENTION_MAX_BATCHES" envDefault:"20"`
+	// WarmPoolReconcileInterval throttles how often worker replenishes project warm pools of full-env namespaces.
+	WarmPoolReconcileInterval string `env:"KODEX_WORKER_WARM_POOL_RECONCILE_INTERVAL" envDefault:"1m"`
+	// WarmPoolPrepareTimeout bounds how long a warm pool namespace may stay in preparing state.
+	WarmPoolPrepareTimeout string `env:"KODEX_WORKER_WARM_POOL_PREPARE_TIMEOUT" envDefault:"40m"`
+	// WarmPoolMaxAge recycles unclaimed ready warm pool namespaces so the pool follows the default branch.
+	WarmPoolMaxAge string `env:"KODEX_WORKER_WARM_POOL_MAX_AGE" envDefault:"12h"`
 	// ServicesConfigPath points to services.yaml for runtime policy (mode/namespace TTL).
 	ServicesConfigPath string `env:"KODEX_SERVICES_CONFIG_PATH" envDefault:"services.yaml"`
 	// ServicesConfigEnv selects render environment for services.yaml policy.
@@ -173,7 +189,7 @@ type Config struct {
 	RunResourceQuotaName string `env:"KODEX_WORKER_RUN_RESOURCE_QUOTA_NAME" envDefault:"codex-run-quota"`
 	// RunLimitRangeName is LimitRange name in runtime namespaces.
 	RunLimitRangeName string `env:"KODEX_WORKER_RUN_LIMIT_RANGE_NAME" envDefault:"codex-run-limits"`
-	// RunCredentialsSecretName is Secret name used for run pod credentials in runtime namespaces.
+	// RunCredentialsSecretName is name prefix of per-run Secret with run pod credentials.
 	RunCredentialsSecretName string `env:"KODEX_WORKER_RUN_CREDENTIALS_SECRET_NAME" envDefault:"codex-run-credentials"`
 	// RunResourceQuotaPods controls max pods per run namespace.
 	RunResourceQuotaPods int64 `env:"KODEX_WORKER_RUN_QUOTA_PODS" envDefault:"20"`
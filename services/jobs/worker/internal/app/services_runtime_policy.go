@@ -5,6 +5,8 @@ import (
 	"strings"
 	"time"
 
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	rundomain "github.com/codex-k8s/kodex/libs/go/domain/run"
 	"github.com/codex-k8s/kodex/libs/go/servicescfg"
 )
 
@@ -58,3 +60,49 @@ func loadWebhookRuntimeNamespaceTTLPolicy(cfg Config, logger *slog.Logger) (time
 
 	return defaultTTL, ttlByRole
 }
+
+func loadWebhookRuntimeResourceProfilePolicy(cfg Config, logger *slog.Logger) (map[string]agentdomain.ResourceProfile, map[string]string) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	path := strings.TrimSpace(cfg.ServicesConfigPath)
+	if path == "" {
+		return map[string]agentdomain.ResourceProfile{}, map[string]string{}
+	}
+
+	loaded, err := servicescfg.Load(path, servicescfg.LoadOptions{Env: cfg.ServicesConfigEnv})
+	if err != nil {
+		logger.Warn("skip services.yaml resource profile policy: load failed", "path", path, "env", cfg.ServicesConfigEnv, "err", err)
+		return map[string]agentdomain.ResourceProfile{}, map[string]string{}
+	}
+
+	runtime := loaded.Stack.Spec.WebhookRuntime
+	profiles := make(map[string]agentdomain.ResourceProfile, len(runtime.ResourceProfiles))
+	for name, profile := range runtime.ResourceProfiles {
+		profiles[name] = profile
+	}
+	byRole := make(map[string]string, len(runtime.ResourceProfileByRole))
+	for role, name := range runtime.ResourceProfileByRole {
+		byRole[role] = name
+	}
+	return profiles, byRole
+}
+
+func loadWebhookRuntimeRetryPolicy(cfg Config, logger *slog.Logger) (rundomain.RetryPolicy, map[string]rundomain.RetryPolicy) {
+	if logger == nil {
+		logger = slog.Default()
+	}
+
+	path := strings.TrimSpace(cfg.ServicesConfigPath)
+	if path == "" {
+		return rundomain.DefaultRetryPolicy, map[string]rundomain.RetryPolicy{}
+	}
+
+	loaded, err := servicescfg.Load(path, servicescfg.LoadOptions{Env: cfg.ServicesConfigEnv})
+	if err != nil {
+		logger.Warn("skip services.yaml run retry policy: load failed", "path", path, "env", cfg.ServicesConfigEnv, "err", err)
+		return rundomain.DefaultRetryPolicy, map[string]rundomain.RetryPolicy{}
+	}
+	return servicescfg.ResolveRunRetryPolicies(loaded.Stack.Spec.WebhookRuntime)
+}
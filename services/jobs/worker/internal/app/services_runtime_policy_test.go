@@ -60,6 +60,90 @@ spec:
 	}
 }
 
+func TestLoadWebhookRuntimeResourceProfilePolicy_FromServicesConfig(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "services.yaml")
+	writePolicyFixture(t, path, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-prod"
+  webhookRuntime:
+    resourceProfiles:
+      default:
+        requests:
+          cpu: 500m
+      heavy:
+        limits:
+          memory: 8Gi
+    resourceProfileByRole:
+      qa: heavy
+`)
+
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	profiles, byRole := loadWebhookRuntimeResourceProfilePolicy(Config{
+		ServicesConfigPath: path,
+		ServicesConfigEnv:  "production",
+	}, logger)
+
+	if got, want := profiles["default"].Requests.CPU, "500m"; got != want {
+		t.Fatalf("unexpected default profile cpu request: got %q want %q", got, want)
+	}
+	if got, want := profiles["heavy"].Limits.Memory, "8Gi"; got != want {
+		t.Fatalf("unexpected heavy profile memory limit: got %q want %q", got, want)
+	}
+	if got, want := byRole["qa"], "heavy"; got != want {
+		t.Fatalf("unexpected qa profile binding: got %q want %q", got, want)
+	}
+}
+
+func TestLoadWebhookRuntimeRetryPolicy_FromServicesConfig(t *testing.T) {
+	t.Parallel()
+
+	tmpDir := t.TempDir()
+	path := filepath.Join(tmpDir, "services.yaml")
+	writePolicyFixture(t, path, `
+apiVersion: kodex.works/v1alpha1
+kind: ServiceStack
+metadata:
+  name: demo
+spec:
+  environments:
+    production:
+      namespaceTemplate: "{{ .Project }}-prod"
+  webhookRuntime:
+    retryPolicy:
+      default:
+        maxAttempts: 3
+        initialBackoff: 30s
+      byTriggerKind:
+        ops:
+          maxAttempts: 1
+`)
+
+	logger := slog.New(slog.NewJSONHandler(io.Discard, nil))
+	fallback, byTriggerKind := loadWebhookRuntimeRetryPolicy(Config{
+		ServicesConfigPath: path,
+		ServicesConfigEnv:  "production",
+	}, logger)
+
+	if fallback.MaxAttempts != 3 || fallback.InitialBackoff != 30*time.Second {
+		t.Fatalf("unexpected default retry policy: %+v", fallback)
+	}
+	if got := byTriggerKind["ops"].MaxAttempts; got != 1 {
+		t.Fatalf("unexpected ops max attempts: got %d want 1", got)
+	}
+	if got, want := byTriggerKind["ops"].InitialBackoff, 30*time.Second; got != want {
+		t.Fatalf("ops rule must inherit default backoff: got %s want %s", got, want)
+	}
+}
+
 func writePolicyFixture(t *testing.T, path string, content string) {
 	t.Helper()
 	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
@@ -69,6 +69,28 @@ func (a *Adapter) DeleteManagedNamespace(ctx context.Context, namespace string)
 	return a.impl.DeleteManagedNamespace(ctx, namespace)
 }
 
+func (a *Adapter) ListWarmPoolNamespaces(ctx context.Context, projectID string) ([]worker.WarmPoolNamespace, error) {
+	return a.impl.ListWarmPoolNamespaces(ctx, projectID)
+}
+
+func (a *Adapter) MarkWarmPoolNamespaceReady(ctx context.Context, namespace string, buildRef string) error {
+	return a.impl.MarkWarmPoolNamespaceReady(ctx, namespace, buildRef)
+}
+
+// ClaimWarmPoolNamespace hands one ready warm pool namespace over to a run.
+func (a *Adapter) ClaimWarmPoolNamespace(ctx context.Context, spec worker.NamespaceSpec) (string, bool, error) {
+	return a.impl.ClaimWarmPoolNamespace(ctx, spec)
+}
+
+func (a *Adapter) DeleteWarmPoolNamespace(ctx context.Context, namespace string) (bool, error) {
+	return a.impl.DeleteWarmPoolNamespace(ctx, namespace)
+}
+
+// DeleteRunCredentials removes per-run credentials Secret.
+func (a *Adapter) DeleteRunCredentials(ctx context.Context, ref worker.JobRef, runID string) error {
+	return a.impl.DeleteRunCredentials(ctx, ref, runID)
+}
+
 // Launch creates Kubernetes Job for run.
 func (a *Adapter) Launch(ctx context.Context, spec worker.JobSpec) (worker.JobRef, error) {
 	return a.impl.Launch(ctx, spec)
@@ -96,3 +118,8 @@ func (a *Adapter) Status(ctx context.Context, ref worker.JobRef) (worker.JobStat
 		return worker.JobStatePending, nil
 	}
 }
+
+// FailureCause returns normalized failure cause of a failed Kubernetes run workload.
+func (a *Adapter) FailureCause(ctx context.Context, ref worker.JobRef) (worker.JobFailureCause, error) {
+	return a.impl.FailureCause(ctx, ref)
+}
@@ -288,6 +288,34 @@ func (c *Client) RunMissionControlWarmup(ctx context.Context, projectID string,
 	}, nil
 }
 
+// RunRetentionSweep asks control-plane to sweep due retention policies.
+func (c *Client) RunRetentionSweep(ctx context.Context, params workerdomain.RetentionSweepParams) ([]workerdomain.RetentionSweepPolicyResult, error) {
+	resp, err := c.svc.RunRetentionSweep(ctx, &controlplanev1.RunRetentionSweepRequest{
+		WorkerId:             strings.TrimSpace(params.WorkerID),
+		PolicyLimit:          int32(maxInt64(0, int64(params.PolicyLimit))),
+		BatchSize:            int32(maxInt64(0, int64(params.BatchSize))),
+		MaxBatches:           int32(maxInt64(0, int64(params.MaxBatches))),
+		SweepIntervalSeconds: int64(params.SweepInterval / time.Second),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]workerdomain.RetentionSweepPolicyResult, 0, len(resp.GetPolicies()))
+	for _, item := range resp.GetPolicies() {
+		items = append(items, workerdomain.RetentionSweepPolicyResult{
+			PolicyID:     strings.TrimSpace(item.GetPolicyId()),
+			ProjectID:    strings.TrimSpace(item.GetProjectId()),
+			Target:       strings.TrimSpace(item.GetTarget()),
+			Action:       strings.TrimSpace(item.GetAction()),
+			RemovedCount: item.GetRemovedCount(),
+			ArchiveKeys:  item.GetArchiveKeys(),
+			HasMore:      item.GetHasMore(),
+			Error:        strings.TrimSpace(item.GetError()),
+		})
+	}
+	return items, nil
+}
+
 // ClaimMissionControlPendingCommands returns Mission Control commands leased for this worker.
 func (c *Client) ClaimMissionControlPendingCommands(ctx context.Context, workerID string, leaseTTL time.Duration, limit int) ([]workerdomain.MissionControlPendingCommand, error) {
 	resp, err := c.svc.ClaimMissionControlPendingCommands(ctx, &controlplanev1.ClaimMissionControlPendingCommandsRequest{
@@ -414,19 +442,24 @@ func (c *Client) ExecuteNextStepAction(ctx context.Context, params workerdomain.
 // UpsertRunStatusComment updates one run status comment in issue thread.
 func (c *Client) UpsertRunStatusComment(ctx context.Context, params workerdomain.RunStatusCommentParams) (workerdomain.RunStatusCommentResult, error) {
 	resp, err := c.svc.UpsertRunStatusComment(ctx, &controlplanev1.UpsertRunStatusCommentRequest{
-		RunId:           strings.TrimSpace(params.RunID),
-		Phase:           strings.TrimSpace(string(params.Phase)),
-		JobName:         optionalString(strings.TrimSpace(params.JobName)),
-		JobNamespace:    optionalString(strings.TrimSpace(params.JobNamespace)),
-		RuntimeMode:     optionalString(strings.TrimSpace(params.RuntimeMode)),
-		Namespace:       optionalString(strings.TrimSpace(params.Namespace)),
-		TriggerKind:     optionalString(strings.TrimSpace(params.TriggerKind)),
-		PromptLocale:    optionalString(strings.TrimSpace(params.PromptLocale)),
-		Model:           optionalString(strings.TrimSpace(params.Model)),
-		ReasoningEffort: optionalString(strings.TrimSpace(params.ReasoningEffort)),
-		RunStatus:       optionalString(strings.TrimSpace(params.RunStatus)),
-		Deleted:         params.Deleted,
-		AlreadyDeleted:  params.AlreadyDeleted,
+		RunId:            strings.TrimSpace(params.RunID),
+		Phase:            strings.TrimSpace(string(params.Phase)),
+		JobName:          optionalString(strings.TrimSpace(params.JobName)),
+		JobNamespace:     optionalString(strings.TrimSpace(params.JobNamespace)),
+		RuntimeMode:      optionalString(strings.TrimSpace(params.RuntimeMode)),
+		Namespace:        optionalString(strings.TrimSpace(params.Namespace)),
+		TriggerKind:      optionalString(strings.TrimSpace(params.TriggerKind)),
+		PromptLocale:     optionalString(strings.TrimSpace(params.PromptLocale)),
+		Model:            optionalString(strings.TrimSpace(params.Model)),
+		ReasoningEffort:  optionalString(strings.TrimSpace(params.ReasoningEffort)),
+		RunStatus:        optionalString(strings.TrimSpace(params.RunStatus)),
+		Deleted:          params.Deleted,
+		AlreadyDeleted:   params.AlreadyDeleted,
+		FailureClass:     optionalString(strings.TrimSpace(params.FailureClass)),
+		RetryScheduled:   params.RetryScheduled,
+		RetryAttempt:     int32(params.RetryAttempt),
+		RetryMaxAttempts: int32(params.RetryMaxAttempts),
+		RetryNotBefore:   optionalTimestamp(timePointer(params.RetryNotBefore)),
 	})
 	if err != nil {
 		return workerdomain.RunStatusCommentResult{}, err
@@ -7,17 +7,22 @@ import (
 )
 
 type (
-	ClaimParams               = querytypes.RunQueueClaimParams
-	ClaimRunningParams        = querytypes.RunQueueClaimRunningParams
-	CreatePendingResumeParams = querytypes.RunQueueCreatePendingResumeParams
-	ReleaseStaleLeasesParams  = querytypes.RunQueueReleaseStaleLeasesParams
-	ReleaseOwnedLeasesParams  = querytypes.RunQueueReleaseOwnedLeasesParams
-	ClaimedRun                = querytypes.RunQueueClaimedRun
-	RunningRun                = querytypes.RunQueueRunningRun
-	NonTerminalRun            = querytypes.RunQueueNonTerminalRun
-	ReleasedStaleLease        = querytypes.RunQueueReleasedStaleLease
-	FinishParams              = querytypes.RunQueueFinishParams
-	ExtendLeaseParams         = querytypes.RunQueueExtendLeaseParams
+	ClaimParams                = querytypes.RunQueueClaimParams
+	ClaimRunningParams         = querytypes.RunQueueClaimRunningParams
+	CreatePendingResumeParams  = querytypes.RunQueueCreatePendingResumeParams
+	CreatePendingRetryParams   = querytypes.RunQueueCreatePendingRetryParams
+	CreatePendingRetryResult   = querytypes.RunQueueCreatePendingRetryResult
+	ReleaseStaleLeasesParams   = querytypes.RunQueueReleaseStaleLeasesParams
+	ReleaseOwnedLeasesParams   = querytypes.RunQueueReleaseOwnedLeasesParams
+	ClaimedRun                 = querytypes.RunQueueClaimedRun
+	RunningRun                 = querytypes.RunQueueRunningRun
+	NonTerminalRun             = querytypes.RunQueueNonTerminalRun
+	ReleasedStaleLease         = querytypes.RunQueueReleasedStaleLease
+	FinishParams               = querytypes.RunQueueFinishParams
+	ExtendLeaseParams          = querytypes.RunQueueExtendLeaseParams
+	ProjectSettings            = querytypes.ProjectSettings
+	WarmPoolTarget             = querytypes.RunQueueWarmPoolTarget
+	CreateWarmPoolDeployParams = querytypes.RunQueueCreateWarmPoolDeployParams
 )
 
 // Repository provides queue-like operations over agent runs and slots.
@@ -26,6 +31,8 @@ type Repository interface {
 	ClaimNextPending(ctx context.Context, params ClaimParams) (ClaimedRun, bool, error)
 	// CreatePendingResumeIfAbsent inserts one pending resume run derived from an existing source run.
 	CreatePendingResumeIfAbsent(ctx context.Context, params CreatePendingResumeParams) (bool, error)
+	// CreatePendingRetryIfAbsent inserts one delayed pending retry run derived from a failed source run.
+	CreatePendingRetryIfAbsent(ctx context.Context, params CreatePendingRetryParams) (CreatePendingRetryResult, error)
 	// ClaimRunning atomically leases running runs for one worker reconcile tick.
 	ClaimRunning(ctx context.Context, params ClaimRunningParams) ([]RunningRun, error)
 	// ReleaseStaleLeases clears running-run leases whose owner worker instance is stale.
@@ -40,4 +47,13 @@ type Repository interface {
 	ExtendLease(ctx context.Context, params ExtendLeaseParams) (bool, error)
 	// FinishRun finalizes run status and releases slot lease when it exists.
 	FinishRun(ctx context.Context, params FinishParams) (bool, error)
+	// GetProjectSettings returns decoded `projects.settings`; zero value when project has no settings.
+	GetProjectSettings(ctx context.Context, projectID string) (ProjectSettings, error)
+	// ListWarmPoolTargets returns projects with positive `projects.settings.warm_pool_size` and a bound repository.
+	ListWarmPoolTargets(ctx context.Context) ([]WarmPoolTarget, error)
+	// CreateWarmPoolDeploy inserts one pending deploy-only run preparing a warm pool namespace.
+	CreateWarmPoolDeploy(ctx context.Context, params CreateWarmPoolDeployParams) error
+	// TryLockWarmPool takes the cross-replica warm pool reconcile lock without waiting.
+	// ok is false when another worker holds it; release must be called once reconcile is done.
+	TryLockWarmPool(ctx context.Context) (release func(), ok bool, err error)
 }
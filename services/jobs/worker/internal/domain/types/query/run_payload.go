@@ -1,6 +1,9 @@
 package query
 
-import webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+import (
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+)
 
 // RunRuntimePayload keeps only fields that influence worker runtime decisions.
 type RunRuntimePayload struct {
@@ -40,6 +43,8 @@ type RunRuntimeProfile struct {
 	BuildRef      string `json:"build_ref,omitempty"`
 	DeployOnly    bool   `json:"deploy_only,omitempty"`
 	AccessProfile string `json:"access_profile,omitempty"`
+	// WarmPool marks deploy-only run preparing a warm pool namespace.
+	WarmPool bool `json:"warm_pool,omitempty"`
 }
 
 // RepositoryPayload keeps repository fields required for project derivation.
@@ -58,4 +63,12 @@ type RunQueuePayload struct {
 type ProjectSettings struct {
 	LearningModeDefault bool `json:"learning_mode_default"`
 	SlotsPerProject     int  `json:"slots_per_project,omitempty"`
+	// QueueWeight is the project fair-share weight in the run queue; 1 is used when unset.
+	QueueWeight float64 `json:"queue_weight,omitempty"`
+	// ResourceProfiles overrides platform run resource profiles by profile name.
+	ResourceProfiles map[string]agentdomain.ResourceProfile `json:"resource_profiles,omitempty"`
+	// ResourceProfileByRole overrides platform role->profile bindings.
+	ResourceProfileByRole map[string]string `json:"resource_profile_by_role,omitempty"`
+	// WarmPoolSize is the number of pre-deployed full-env namespaces kept for new runs; 0 disables the pool.
+	WarmPoolSize int `json:"warm_pool_size,omitempty"`
 }
@@ -65,6 +65,8 @@ type RunQueueClaimedRun struct {
 	SlotNo int
 	// SlotID is a unique slot identifier, empty when lease is not required.
 	SlotID string
+	// Attempt is a 1-based run attempt number; retries of failed runs increment it.
+	Attempt int
 }
 
 // RunQueueRunningRun is an active run tracked for reconciliation.
@@ -87,6 +89,8 @@ type RunQueueRunningRun struct {
 	StartedAt time.Time
 	// ReclaimedAfterStaleLease indicates the run lease was previously released by stale-worker recovery.
 	ReclaimedAfterStaleLease bool
+	// Attempt is a 1-based run attempt number; retries of failed runs increment it.
+	Attempt int
 }
 
 // RunQueueReleasedStaleLease describes one run lease released after stale-worker detection.
@@ -121,6 +125,8 @@ type RunQueueFinishParams struct {
 	Status rundomain.Status
 	// FinishedAt is a final status timestamp.
 	FinishedAt time.Time
+	// FailureClass classifies failed runs (infrastructure, agent, precondition); empty for other statuses.
+	FailureClass rundomain.FailureClass
 }
 
 // RunQueueExtendLeaseParams describes slot lease keepalive update for one running run.
@@ -0,0 +1,23 @@
+package query
+
+import "time"
+
+// RunQueueCreatePendingRetryParams describes one idempotent pending-retry insert derived from a failed run.
+type RunQueueCreatePendingRetryParams struct {
+	// SourceRunID identifies the failed run whose payload/agent/project should be reused for retry.
+	SourceRunID string
+	// CorrelationID deduplicates retry scheduling across worker restarts and duplicate terminal outcomes.
+	CorrelationID string
+	// MaxAttempts bounds total attempts; retry is not inserted when source attempt already reached it.
+	MaxAttempts int
+	// NotBefore delays claim of the retry run until backoff expires.
+	NotBefore time.Time
+}
+
+// RunQueueCreatePendingRetryResult describes retry scheduling outcome.
+type RunQueueCreatePendingRetryResult struct {
+	// Created is true when a new pending retry run was inserted.
+	Created bool
+	// Attempt is the 1-based attempt number of the inserted retry run.
+	Attempt int
+}
@@ -0,0 +1,23 @@
+package query
+
+// RunQueueWarmPoolTarget describes one project that keeps a warm pool of pre-deployed full-env namespaces.
+type RunQueueWarmPoolTarget struct {
+	ProjectID string
+	// PoolSize is `projects.settings.warm_pool_size`.
+	PoolSize int
+	// RepositoryFullName is the project repository whose stack is deployed into pool namespaces.
+	RepositoryFullName string
+	ServicesYAMLPath   string
+	// BuildRef is repository default ref pool namespaces are deployed at.
+	BuildRef string
+	// InFlightDeploys counts pending or running warm pool deploy runs of the project.
+	InFlightDeploys int
+}
+
+// RunQueueCreateWarmPoolDeployParams describes one pending deploy-only run preparing a warm pool namespace.
+type RunQueueCreateWarmPoolDeployParams struct {
+	RunID         string
+	CorrelationID string
+	ProjectID     string
+	RunPayload    []byte
+}
@@ -1,6 +1,7 @@
 package worker
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"slices"
@@ -59,6 +60,8 @@ type runAgentContext struct {
 	runAgentPromptContext
 	runAgentModelContext
 	DiscussionMode bool
+	// AlertContext is compact Alertmanager incident JSON passed to the run pod as-is.
+	AlertContext string
 }
 
 type runAgentPayload struct {
@@ -69,6 +72,7 @@ type runAgentPayload struct {
 	Agent          *runAgentDescriptor   `json:"agent"`
 	ProfileHints   *runAgentProfileHints `json:"profile_hints"`
 	RawPayload     json.RawMessage       `json:"raw_payload"`
+	Alert          json.RawMessage       `json:"alert,omitempty"`
 }
 
 type runAgentRepository struct {
@@ -121,6 +125,7 @@ func resolveRunAgentContext(runPayload json.RawMessage, defaults runAgentDefault
 			}(),
 		},
 		DiscussionMode: payload.discussionMode,
+		AlertContext:   payload.alertContext,
 		runAgentModelContext: runAgentModelContext{
 			Model:           defaults.DefaultModel,
 			ModelSource:     modelSourceDefault,
@@ -206,6 +211,7 @@ type parsedRunAgentPayload struct {
 	triggerLabel       string
 	agentDisplayName   string
 	discussionMode     bool
+	alertContext       string
 	issueLabels        []string
 	pullRequestLabels  []string
 	historyIssueLabels []string
@@ -244,6 +250,7 @@ func parseRunAgentPayload(raw json.RawMessage) parsedRunAgentPayload {
 		out.triggerLabel = strings.TrimSpace(payload.Trigger.Label)
 	}
 	out.discussionMode = payload.DiscussionMode
+	out.alertContext = compactRunAlertContext(payload.Alert)
 	if payload.Agent != nil {
 		out.agentKey = strings.TrimSpace(payload.Agent.Key)
 		out.agentDisplayName = strings.TrimSpace(payload.Agent.Name)
@@ -444,3 +451,19 @@ func isGPT53Model(model string) bool {
 	normalizedModel := strings.TrimSpace(model)
 	return strings.EqualFold(normalizedModel, modelGPT53Codex) || strings.EqualFold(normalizedModel, modelGPT53CodexSpark)
 }
+
+// compactRunAlertContext keeps alert context only when it is a bounded JSON object.
+func compactRunAlertContext(raw json.RawMessage) string {
+	trimmed := bytes.TrimSpace(raw)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return ""
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, trimmed); err != nil {
+		return ""
+	}
+	if buf.Len() > webhookdomain.AlertContextMaxBytes {
+		return ""
+	}
+	return buf.String()
+}
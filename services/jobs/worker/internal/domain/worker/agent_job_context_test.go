@@ -2,7 +2,10 @@ package worker
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
+
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
 )
 
 func TestResolveModelFromLabels(t *testing.T) {
@@ -537,3 +540,49 @@ func TestResolveRunAgentContext_CustomReasoningLabelFromCatalog(t *testing.T) {
 		t.Fatalf("ReasoningSource = %q, want %q", got.ReasoningSource, modelSourceIssueLabel)
 	}
 }
+
+func TestResolveRunAgentContext_CompactsAlertContext(t *testing.T) {
+	t.Parallel()
+
+	runPayload := json.RawMessage(`{
+		"source":"alertmanager",
+		"repository":{"full_name":"codex-k8s/kodex"},
+		"issue":{"number":77},
+		"agent":{"key":"sre","name":"AI SRE"},
+		"trigger":{"source":"alertmanager","kind":"ops","label":"run:ops"},
+		"alert":{
+			"incident_id":"incident-1",
+			"fingerprint":"fp-1",
+			"alert_name":"HighLatency"
+		}
+	}`)
+
+	got, err := resolveRunAgentContext(runPayload, runAgentDefaults{
+		DefaultModel:           modelGPT54,
+		DefaultReasoningEffort: reasoningEffortHigh,
+		DefaultLocale:          "ru",
+		AllowGPT53:             true,
+	})
+	if err != nil {
+		t.Fatalf("resolveRunAgentContext() error = %v", err)
+	}
+	want := `{"incident_id":"incident-1","fingerprint":"fp-1","alert_name":"HighLatency"}`
+	if got.AlertContext != want {
+		t.Fatalf("AlertContext = %q, want %q", got.AlertContext, want)
+	}
+}
+
+func TestCompactRunAlertContext_DropsInvalidOrOversizedPayload(t *testing.T) {
+	t.Parallel()
+
+	oversized := json.RawMessage(`{"alert_name":"` + strings.Repeat("a", webhookdomain.AlertContextMaxBytes) + `"}`)
+	for name, raw := range map[string]json.RawMessage{
+		"empty":      nil,
+		"not object": json.RawMessage(`["alert"]`),
+		"oversized":  oversized,
+	} {
+		if got := compactRunAlertContext(raw); got != "" {
+			t.Fatalf("%s: compactRunAlertContext() = %q, want empty", name, got)
+		}
+	}
+}
@@ -45,42 +45,46 @@ const (
 
 // runStartedEventPayload defines payload shape for run.started flow events.
 type runStartedEventPayload struct {
-	RunID                string                  `json:"run_id"`
-	ProjectID            string                  `json:"project_id"`
-	SlotNo               int                     `json:"slot_no"`
-	JobName              string                  `json:"job_name"`
-	JobNamespace         string                  `json:"job_namespace"`
-	RuntimeMode          agentdomain.RuntimeMode `json:"runtime_mode"`
-	RepositoryFullName   string                  `json:"repository_full_name,omitempty"`
-	AgentKey             string                  `json:"agent_key,omitempty"`
-	IssueNumber          int64                   `json:"issue_number,omitempty"`
-	TriggerKind          string                  `json:"trigger_kind,omitempty"`
-	TriggerLabel         string                  `json:"trigger_label,omitempty"`
-	DiscussionMode       bool                    `json:"discussion_mode,omitempty"`
-	JobImage             string                  `json:"job_image,omitempty"`
-	Model                string                  `json:"model,omitempty"`
-	ModelSource          string                  `json:"model_source,omitempty"`
-	ReasoningEffort      string                  `json:"reasoning_effort,omitempty"`
-	ReasoningSource      string                  `json:"reasoning_source,omitempty"`
-	PromptTemplateKind   string                  `json:"prompt_template_kind,omitempty"`
-	PromptTemplateSource string                  `json:"prompt_template_source,omitempty"`
-	PromptTemplateLocale string                  `json:"prompt_template_locale,omitempty"`
-	BaseBranch           string                  `json:"base_branch,omitempty"`
+	RunID                 string                  `json:"run_id"`
+	ProjectID             string                  `json:"project_id"`
+	SlotNo                int                     `json:"slot_no"`
+	JobName               string                  `json:"job_name"`
+	JobNamespace          string                  `json:"job_namespace"`
+	RuntimeMode           agentdomain.RuntimeMode `json:"runtime_mode"`
+	RepositoryFullName    string                  `json:"repository_full_name,omitempty"`
+	AgentKey              string                  `json:"agent_key,omitempty"`
+	IssueNumber           int64                   `json:"issue_number,omitempty"`
+	TriggerKind           string                  `json:"trigger_kind,omitempty"`
+	TriggerLabel          string                  `json:"trigger_label,omitempty"`
+	DiscussionMode        bool                    `json:"discussion_mode,omitempty"`
+	JobImage              string                  `json:"job_image,omitempty"`
+	Model                 string                  `json:"model,omitempty"`
+	ModelSource           string                  `json:"model_source,omitempty"`
+	ReasoningEffort       string                  `json:"reasoning_effort,omitempty"`
+	ReasoningSource       string                  `json:"reasoning_source,omitempty"`
+	PromptTemplateKind    string                  `json:"prompt_template_kind,omitempty"`
+	PromptTemplateSource  string                  `json:"prompt_template_source,omitempty"`
+	PromptTemplateLocale  string                  `json:"prompt_template_locale,omitempty"`
+	BaseBranch            string                  `json:"base_branch,omitempty"`
+	ResourceProfile       string                  `json:"resource_profile,omitempty"`
+	ResourceProfileSource string                  `json:"resource_profile_source,omitempty"`
 }
 
 // runProfileResolvedEventPayload defines payload shape for run.profile.resolved flow events.
 type runProfileResolvedEventPayload struct {
-	RunID              string `json:"run_id"`
-	ProjectID          string `json:"project_id"`
-	RepositoryFullName string `json:"repository_full_name,omitempty"`
-	IssueNumber        int64  `json:"issue_number,omitempty"`
-	PullRequestNumber  int    `json:"pull_request_number,omitempty"`
-	TriggerKind        string `json:"trigger_kind,omitempty"`
-	DiscussionMode     bool   `json:"discussion_mode,omitempty"`
-	Model              string `json:"model,omitempty"`
-	ModelSource        string `json:"model_source,omitempty"`
-	ReasoningEffort    string `json:"reasoning_effort,omitempty"`
-	ReasoningSource    string `json:"reasoning_source,omitempty"`
+	RunID                 string `json:"run_id"`
+	ProjectID             string `json:"project_id"`
+	RepositoryFullName    string `json:"repository_full_name,omitempty"`
+	IssueNumber           int64  `json:"issue_number,omitempty"`
+	PullRequestNumber     int    `json:"pull_request_number,omitempty"`
+	TriggerKind           string `json:"trigger_kind,omitempty"`
+	DiscussionMode        bool   `json:"discussion_mode,omitempty"`
+	Model                 string `json:"model,omitempty"`
+	ModelSource           string `json:"model_source,omitempty"`
+	ReasoningEffort       string `json:"reasoning_effort,omitempty"`
+	ReasoningSource       string `json:"reasoning_source,omitempty"`
+	ResourceProfile       string `json:"resource_profile,omitempty"`
+	ResourceProfileSource string `json:"resource_profile_source,omitempty"`
 }
 
 // runJobImageResolvedEventPayload defines payload shape for run.job.image.resolved flow events.
@@ -107,12 +111,25 @@ type runFinishedEventPayload struct {
 	Namespace    string                  `json:"namespace,omitempty"`
 	Error        string                  `json:"error,omitempty"`
 	Reason       runFailureReason        `json:"reason,omitempty"`
+	FailureClass rundomain.FailureClass  `json:"failure_class,omitempty"`
+	FailureCause JobFailureCause         `json:"failure_cause,omitempty"`
+	Attempt      int                     `json:"attempt,omitempty"`
+	Retry        *runRetryEventPayload   `json:"retry,omitempty"`
+}
+
+// runRetryEventPayload describes automatic retry scheduled for an infrastructure-caused failure.
+type runRetryEventPayload struct {
+	Attempt     int    `json:"attempt"`
+	MaxAttempts int    `json:"max_attempts"`
+	NotBefore   string `json:"not_before"`
 }
 
 // runFinishedEventExtra carries optional failure details for run finish payloads.
 type runFinishedEventExtra struct {
-	Error  string
-	Reason runFailureReason
+	Error        string
+	Reason       runFailureReason
+	FailureClass rundomain.FailureClass
+	FailureCause JobFailureCause
 }
 
 // workerHeartbeatMissedEventPayload defines payload shape for stale worker heartbeat events.
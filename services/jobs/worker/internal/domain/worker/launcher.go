@@ -17,6 +17,19 @@ const (
 	JobStateNotFound  JobState = libslauncher.JobStateNotFound
 )
 
+type JobFailureCause = libslauncher.JobFailureCause
+
+const (
+	JobFailureCauseImagePull        JobFailureCause = libslauncher.JobFailureCauseImagePull
+	JobFailureCauseContainerConfig  JobFailureCause = libslauncher.JobFailureCauseContainerConfig
+	JobFailureCauseEvicted          JobFailureCause = libslauncher.JobFailureCauseEvicted
+	JobFailureCauseDisrupted        JobFailureCause = libslauncher.JobFailureCauseDisrupted
+	JobFailureCauseDeadlineExceeded JobFailureCause = libslauncher.JobFailureCauseDeadlineExceeded
+	JobFailureCauseOOMKilled        JobFailureCause = libslauncher.JobFailureCauseOOMKilled
+	JobFailureCauseExitCode         JobFailureCause = libslauncher.JobFailureCauseExitCode
+	JobFailureCauseUnknown          JobFailureCause = libslauncher.JobFailureCauseUnknown
+)
+
 type JobRef = libslauncher.JobRef
 type NamespaceSpec = libslauncher.NamespaceSpec
 type NamespaceEnsureResult = libslauncher.NamespaceEnsureResult
@@ -26,6 +39,13 @@ type ManagedNamespaceState = libslauncher.ManagedNamespaceState
 type ManagedNamespaceListParams = libslauncher.ManagedNamespaceListParams
 type NamespaceWorkloadState = libslauncher.NamespaceWorkloadState
 type JobSpec = libslauncher.JobSpec
+type WarmPoolNamespace = libslauncher.WarmPoolNamespace
+type WarmPoolNamespaceState = libslauncher.WarmPoolNamespaceState
+
+const (
+	WarmPoolNamespaceStatePreparing WarmPoolNamespaceState = libslauncher.WarmPoolNamespaceStatePreparing
+	WarmPoolNamespaceStateReady     WarmPoolNamespaceState = libslauncher.WarmPoolNamespaceStateReady
+)
 
 // Launcher creates and reconciles Kubernetes run workloads (Job/Pod) for runs.
 type Launcher interface {
@@ -49,8 +69,20 @@ type Launcher interface {
 	InspectNamespaceWorkloads(ctx context.Context, namespace string) (NamespaceWorkloadState, error)
 	// DeleteManagedNamespace removes one worker-managed namespace after guardrails passed.
 	DeleteManagedNamespace(ctx context.Context, namespace string) (bool, error)
+	// ListWarmPoolNamespaces returns pre-provisioned warm pool namespaces of one project (all projects when empty).
+	ListWarmPoolNamespaces(ctx context.Context, projectID string) ([]WarmPoolNamespace, error)
+	// MarkWarmPoolNamespaceReady flips warm pool namespace to ready after its stack deploy succeeded.
+	MarkWarmPoolNamespaceReady(ctx context.Context, namespace string, buildRef string) error
+	// ClaimWarmPoolNamespace re-labels one ready warm pool namespace as run namespace described by spec.
+	ClaimWarmPoolNamespace(ctx context.Context, spec NamespaceSpec) (string, bool, error)
+	// DeleteWarmPoolNamespace removes one unclaimed warm pool namespace.
+	DeleteWarmPoolNamespace(ctx context.Context, namespace string) (bool, error)
+	// DeleteRunCredentials removes per-run credentials Secret after run reached terminal state.
+	DeleteRunCredentials(ctx context.Context, ref JobRef, runID string) error
 	// Launch creates workload if needed and returns its reference.
 	Launch(ctx context.Context, spec JobSpec) (JobRef, error)
 	// Status returns current workload state for a given run workload reference.
 	Status(ctx context.Context, ref JobRef) (JobState, error)
+	// FailureCause inspects a failed run workload and returns normalized failure cause.
+	FailureCause(ctx context.Context, ref JobRef) (JobFailureCause, error)
 }
@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	"github.com/codex-k8s/kodex/libs/go/servicescfg"
+	runqueuerepo "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/repository/runqueue"
+)
+
+const (
+	resourceProfileSourceProject  = "project"
+	resourceProfileSourcePlatform = "platform"
+	resourceProfileSourceNone     = "none"
+)
+
+// runResourceProfile is the resource profile chosen for one run launch.
+type runResourceProfile struct {
+	Name    string
+	Source  string
+	Profile agentdomain.ResourceProfile
+}
+
+// resolveRunResourceProfile picks profile by role with project overrides taking precedence:
+// project role binding, platform role binding, then `default`; profile body is looked up in project
+// settings first and then in platform services.yaml.
+func (s *Service) resolveRunResourceProfile(ctx context.Context, projectID string, agentKey string) runResourceProfile {
+	role := strings.ToLower(strings.TrimSpace(agentKey))
+
+	var settings runqueuerepo.ProjectSettings
+	if strings.TrimSpace(projectID) != "" {
+		loaded, err := s.runs.GetProjectSettings(ctx, projectID)
+		if err != nil {
+			s.logger.Warn("load project resource profiles failed; using platform profiles", "project_id", projectID, "err", err)
+		} else {
+			settings = loaded
+		}
+	}
+
+	name := agentdomain.NormalizeResourceProfileName(lookupResourceProfileBinding(settings.ResourceProfileByRole, role))
+	if name == "" {
+		name = s.cfg.ResourceProfileByRole[role]
+	}
+	if name == "" {
+		name = agentdomain.DefaultResourceProfileName
+	}
+
+	if profile, ok := lookupResourceProfile(settings.ResourceProfiles, name); ok {
+		if err := servicescfg.ValidateResourceProfile(profile, fmt.Sprintf("projects.settings.resource_profiles[%q]", name)); err != nil {
+			s.logger.Warn("ignore invalid project resource profile", "project_id", projectID, "profile", name, "err", err)
+		} else {
+			return runResourceProfile{Name: name, Source: resourceProfileSourceProject, Profile: profile}
+		}
+	}
+	if profile, ok := s.cfg.ResourceProfiles[name]; ok {
+		return runResourceProfile{Name: name, Source: resourceProfileSourcePlatform, Profile: profile}
+	}
+	return runResourceProfile{Source: resourceProfileSourceNone}
+}
+
+func lookupResourceProfileBinding(byRole map[string]string, role string) string {
+	if role == "" {
+		return ""
+	}
+	for rawRole, name := range byRole {
+		if strings.ToLower(strings.TrimSpace(rawRole)) == role {
+			return name
+		}
+	}
+	return ""
+}
+
+func lookupResourceProfile(profiles map[string]agentdomain.ResourceProfile, name string) (agentdomain.ResourceProfile, bool) {
+	for rawName, profile := range profiles {
+		if agentdomain.NormalizeResourceProfileName(rawName) == name {
+			return profile, true
+		}
+	}
+	return agentdomain.ResourceProfile{}, false
+}
@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	runqueuerepo "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/repository/runqueue"
+)
+
+func TestServiceResolveRunResourceProfile_ProjectOverridesPlatform(t *testing.T) {
+	t.Parallel()
+
+	runs := &fakeRunQueue{
+		projectSettings: map[string]runqueuerepo.ProjectSettings{
+			"project-1": {
+				ResourceProfiles: map[string]agentdomain.ResourceProfile{
+					"Heavy": {Limits: agentdomain.ResourceAmounts{Memory: "16Gi"}},
+					"light": {Limits: agentdomain.ResourceAmounts{Memory: "bogus"}},
+				},
+				ResourceProfileByRole: map[string]string{"QA": "heavy", "reviewer": "light"},
+			},
+		},
+	}
+	svc := NewService(Config{
+		ResourceProfiles: map[string]agentdomain.ResourceProfile{
+			"default": {Requests: agentdomain.ResourceAmounts{CPU: "250m"}},
+			"heavy":   {Limits: agentdomain.ResourceAmounts{Memory: "8Gi"}},
+			"light":   {Limits: agentdomain.ResourceAmounts{Memory: "1Gi"}},
+		},
+		ResourceProfileByRole: map[string]string{"dev": "heavy"},
+	}, Dependencies{Runs: runs, Logger: slog.New(slog.NewJSONHandler(io.Discard, nil))})
+
+	cases := []struct {
+		name       string
+		projectID  string
+		agentKey   string
+		wantName   string
+		wantSource string
+		wantMemory string
+		wantCPU    string
+	}{
+		{name: "project binding and project profile", projectID: "project-1", agentKey: "qa", wantName: "heavy", wantSource: resourceProfileSourceProject, wantMemory: "16Gi"},
+		{name: "platform binding resolved from project profile", projectID: "project-1", agentKey: "dev", wantName: "heavy", wantSource: resourceProfileSourceProject, wantMemory: "16Gi"},
+		{name: "invalid project profile falls back to platform", projectID: "project-1", agentKey: "reviewer", wantName: "light", wantSource: resourceProfileSourcePlatform, wantMemory: "1Gi"},
+		{name: "platform binding without project settings", projectID: "project-2", agentKey: "dev", wantName: "heavy", wantSource: resourceProfileSourcePlatform, wantMemory: "8Gi"},
+		{name: "unbound role uses default profile", projectID: "project-2", agentKey: "pm", wantName: "default", wantSource: resourceProfileSourcePlatform, wantCPU: "250m"},
+	}
+	for _, tc := range cases {
+		got := svc.resolveRunResourceProfile(context.Background(), tc.projectID, tc.agentKey)
+		if got.Name != tc.wantName || got.Source != tc.wantSource {
+			t.Fatalf("%s: unexpected profile: got %q/%q want %q/%q", tc.name, got.Name, got.Source, tc.wantName, tc.wantSource)
+		}
+		if got.Profile.Limits.Memory != tc.wantMemory || got.Profile.Requests.CPU != tc.wantCPU {
+			t.Fatalf("%s: unexpected profile body: %+v", tc.name, got.Profile)
+		}
+	}
+}
+
+func TestServiceResolveRunResourceProfile_NoneConfigured(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(Config{}, Dependencies{Runs: &fakeRunQueue{}, Logger: slog.New(slog.NewJSONHandler(io.Discard, nil))})
+	got := svc.resolveRunResourceProfile(context.Background(), "project-1", "dev")
+	if got.Name != "" || got.Source != resourceProfileSourceNone {
+		t.Fatalf("expected no profile, got %q/%q", got.Name, got.Source)
+	}
+}
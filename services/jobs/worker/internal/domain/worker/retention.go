@@ -0,0 +1,85 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RetentionSweepParams configures one retention sweep executed by control-plane.
+type RetentionSweepParams struct {
+	WorkerID      string
+	PolicyLimit   int
+	BatchSize     int
+	MaxBatches    int
+	SweepInterval time.Duration
+}
+
+// RetentionSweepPolicyResult reports outcome of one retention policy sweep.
+type RetentionSweepPolicyResult struct {
+	PolicyID     string
+	ProjectID    string
+	Target       string
+	Action       string
+	RemovedCount int64
+	ArchiveKeys  []string
+	HasMore      bool
+	Error        string
+}
+
+// RetentionClient triggers retention sweeps of platform history tables through control-plane.
+type RetentionClient interface {
+	RunRetentionSweep(ctx context.Context, params RetentionSweepParams) ([]RetentionSweepPolicyResult, error)
+}
+
+// reconcileRetention runs throttled retention sweep and exports removed row counters.
+func (s *Service) reconcileRetention(ctx context.Context) error {
+	if s.retention == nil {
+		return nil
+	}
+	now := s.now().UTC()
+	if !s.lastRetentionSweep.IsZero() && now.Sub(s.lastRetentionSweep) < s.cfg.RetentionPollInterval {
+		return nil
+	}
+	s.lastRetentionSweep = now
+
+	results, err := s.retention.RunRetentionSweep(ctx, RetentionSweepParams{
+		WorkerID:      s.cfg.WorkerID,
+		PolicyLimit:   s.cfg.RetentionPolicyLimit,
+		BatchSize:     s.cfg.RetentionBatchSize,
+		MaxBatches:    s.cfg.RetentionMaxBatches,
+		SweepInterval: s.cfg.RetentionSweepInterval,
+	})
+	if err != nil {
+		return fmt.Errorf("run retention sweep: %w", err)
+	}
+
+	for _, result := range results {
+		recordRetentionRowsRemoved(result.Target, result.Action, result.RemovedCount)
+		if strings.TrimSpace(result.Error) != "" {
+			recordRetentionSweepFailure(result.Target)
+			s.logger.Warn(
+				"retention policy sweep failed",
+				"policy_id", result.PolicyID,
+				"project_id", result.ProjectID,
+				"target", result.Target,
+				"err", result.Error,
+			)
+			continue
+		}
+		if result.RemovedCount > 0 {
+			s.logger.Info(
+				"retention policy sweep removed rows",
+				"policy_id", result.PolicyID,
+				"project_id", result.ProjectID,
+				"target", result.Target,
+				"action", result.Action,
+				"removed", result.RemovedCount,
+				"archives", len(result.ArchiveKeys),
+				"has_more", result.HasMore,
+			)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package worker
+
+import (
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const retentionMetricUnknownLabel = "unknown"
+
+var (
+	retentionRowsRemovedTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kodex_retention_rows_removed_total",
+			Help: "Total number of expired history rows removed by retention sweeps grouped by target table and action.",
+		},
+		[]string{"target", "action"},
+	)
+
+	retentionSweepFailuresTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kodex_retention_sweep_failures_total",
+			Help: "Total number of failed retention policy sweeps grouped by target table.",
+		},
+		[]string{"target"},
+	)
+)
+
+func init() {
+	for _, target := range []string{
+		"flow_events",
+		"agent_run_logs",
+		"agent_sessions",
+		"interaction_delivery_attempts",
+		"interaction_callback_events",
+		"mission_control_timeline_entries",
+		"github_rate_limit_wait_evidence",
+	} {
+		for _, action := range []string{"delete", "archive"} {
+			retentionRowsRemovedTotal.WithLabelValues(target, action)
+		}
+		retentionSweepFailuresTotal.WithLabelValues(target)
+	}
+}
+
+func recordRetentionRowsRemoved(target string, action string, removed int64) {
+	if removed <= 0 {
+		return
+	}
+	retentionRowsRemovedTotal.WithLabelValues(
+		normalizeRetentionMetricLabel(target),
+		normalizeRetentionMetricLabel(action),
+	).Add(float64(removed))
+}
+
+func recordRetentionSweepFailure(target string) {
+	retentionSweepFailuresTotal.WithLabelValues(normalizeRetentionMetricLabel(target)).Inc()
+}
+
+func normalizeRetentionMetricLabel(value string) string {
+	normalized := strings.ToLower(strings.TrimSpace(value))
+	if normalized == "" {
+		return retentionMetricUnknownLabel
+	}
+	return normalized
+}
@@ -0,0 +1,62 @@
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestReconcileRetentionThrottlesSweeps(t *testing.T) {
+	t.Parallel()
+
+	retention := &fakeRetentionClient{
+		results: []RetentionSweepPolicyResult{
+			{PolicyID: "policy-1", Target: "flow_events", Action: "archive", RemovedCount: 12},
+			{PolicyID: "policy-2", Target: "agent_run_logs", Action: "archive", Error: "archive action requires configured blob store"},
+		},
+	}
+	svc := NewService(Config{
+		WorkerID:               "worker-1",
+		RetentionPollInterval:  5 * time.Minute,
+		RetentionSweepInterval: time.Hour,
+		RetentionBatchSize:     100,
+	}, Dependencies{
+		Retention: retention,
+	})
+	now := time.Date(2026, 3, 27, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+
+	for _, step := range []time.Duration{0, time.Minute, 5 * time.Minute} {
+		now = now.Add(step)
+		if err := svc.reconcileRetention(context.Background()); err != nil {
+			t.Fatalf("reconcileRetention() error = %v", err)
+		}
+	}
+
+	if len(retention.calls) != 2 {
+		t.Fatalf("expected 2 sweep calls, got %d", len(retention.calls))
+	}
+	call := retention.calls[0]
+	if call.WorkerID != "worker-1" || call.BatchSize != 100 || call.SweepInterval != time.Hour {
+		t.Fatalf("unexpected sweep params: %+v", call)
+	}
+}
+
+func TestReconcileRetentionDisabledWithoutClient(t *testing.T) {
+	t.Parallel()
+
+	svc := NewService(Config{WorkerID: "worker-1"}, Dependencies{})
+	if err := svc.reconcileRetention(context.Background()); err != nil {
+		t.Fatalf("reconcileRetention() error = %v", err)
+	}
+}
+
+type fakeRetentionClient struct {
+	results []RetentionSweepPolicyResult
+	calls   []RetentionSweepParams
+}
+
+func (f *fakeRetentionClient) RunRetentionSweep(_ context.Context, params RetentionSweepParams) ([]RetentionSweepPolicyResult, error) {
+	f.calls = append(f.calls, params)
+	return f.results, nil
+}
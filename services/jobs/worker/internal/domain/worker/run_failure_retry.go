@@ -0,0 +1,154 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+
+	rundomain "github.com/codex-k8s/kodex/libs/go/domain/run"
+	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
+	runqueuerepo "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/repository/runqueue"
+)
+
+// runRetryCorrelationPrefix marks correlation ids of automatic retry runs.
+const runRetryCorrelationPrefix = "retry:"
+
+// runRetryOutcome describes automatic retry scheduling result for one failed run.
+type runRetryOutcome struct {
+	Scheduled   bool
+	Attempt     int
+	MaxAttempts int
+	NotBefore   time.Time
+}
+
+// classifyJobFailureCause maps Kubernetes workload failure cause to a run failure class.
+// Image pull/config, eviction and disruption are platform issues; container exit, OOM and deadline belong to the agent.
+func classifyJobFailureCause(cause JobFailureCause) rundomain.FailureClass {
+	switch cause {
+	case JobFailureCauseImagePull, JobFailureCauseContainerConfig, JobFailureCauseEvicted, JobFailureCauseDisrupted:
+		return rundomain.FailureClassInfrastructure
+	default:
+		return rundomain.FailureClassAgent
+	}
+}
+
+// classifyLaunchFailure maps worker-side launch/preparation failures to a run failure class.
+func classifyLaunchFailure(err error, reason runFailureReason) rundomain.FailureClass {
+	switch reason {
+	case runFailureReasonAgentContextResolve, runFailureReasonPreconditionFailed:
+		return rundomain.FailureClassPrecondition
+	case runFailureReasonKubernetesJobNotFound, runFailureReasonNamespacePrepareFailed, runFailureReasonMCPTokenIssueFailed:
+		return rundomain.FailureClassInfrastructure
+	case runFailureReasonRuntimeDeployCanceled:
+		// Canceled deploy is an explicit operator decision and must not be retried.
+		return rundomain.FailureClassPrecondition
+	}
+	if isFailedPreconditionError(err) {
+		return rundomain.FailureClassPrecondition
+	}
+	if isTransientInfrastructureError(err) {
+		return rundomain.FailureClassInfrastructure
+	}
+	return rundomain.FailureClassPrecondition
+}
+
+// isTransientInfrastructureError reports control-plane and Kubernetes outages that are expected to heal on retry.
+// Only typed signals are trusted: gRPC Unavailable/DeadlineExceeded/ResourceExhausted/Aborted codes,
+// Kubernetes API timeout/throttling/unavailable statuses, deadlines, network timeouts and ECONNREFUSED/ECONNRESET.
+// Error messages are never matched and cancellation is not retryable: it comes from worker shutdown or operator action.
+func isTransientInfrastructureError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if errors.Is(err, errRuntimeDeployTaskCanceled) || errors.Is(err, context.Canceled) {
+		return false
+	}
+	if st, ok := status.FromError(err); ok {
+		switch st.Code() {
+		case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Aborted:
+			return true
+		}
+	}
+	if apierrors.IsServerTimeout(err) || apierrors.IsTimeout(err) || apierrors.IsTooManyRequests(err) || apierrors.IsServiceUnavailable(err) {
+		return true
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, os.ErrDeadlineExceeded) {
+		return true
+	}
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// resolveJobFailureCause inspects failed workload; inspection errors degrade to unknown cause.
+func (s *Service) resolveJobFailureCause(ctx context.Context, runID string, ref JobRef) JobFailureCause {
+	cause, err := s.launcher.FailureCause(ctx, ref)
+	if err != nil {
+		s.logger.Warn("inspect run job failure cause failed", "run_id", runID, "job_name", ref.Name, "err", err)
+		return JobFailureCauseUnknown
+	}
+	if cause == "" {
+		return JobFailureCauseUnknown
+	}
+	return cause
+}
+
+// resolveRunRetryPolicy returns retry policy for run trigger kind.
+func (s *Service) resolveRunRetryPolicy(run runqueuerepo.RunningRun) rundomain.RetryPolicy {
+	triggerKind := ""
+	if payload := parseRunRuntimePayload(run.RunPayload); payload.Trigger != nil {
+		triggerKind = string(webhookdomain.NormalizeTriggerKind(string(payload.Trigger.Kind)))
+	}
+	return rundomain.ResolveRetryPolicy(s.cfg.RunRetryPolicy, s.cfg.RunRetryPolicyByTriggerKind, triggerKind)
+}
+
+// scheduleRunRetry re-queues infrastructure-caused failure as a delayed pending run with the same payload,
+// so the next attempt restores the latest agent session snapshot of the same issue/PR.
+func (s *Service) scheduleRunRetry(ctx context.Context, run runqueuerepo.RunningRun, failureClass rundomain.FailureClass, finishedAt time.Time) runRetryOutcome {
+	if failureClass != rundomain.FailureClassInfrastructure {
+		return runRetryOutcome{}
+	}
+	// Failed warm pool deploys are not retried: their namespace is dropped and the pool reconciler replaces it.
+	if isWarmPoolDeployPayload(parseRunRuntimePayload(run.RunPayload)) {
+		return runRetryOutcome{}
+	}
+	attempt := run.Attempt
+	if attempt < 1 {
+		attempt = 1
+	}
+	policy := s.resolveRunRetryPolicy(run)
+	outcome := runRetryOutcome{Attempt: attempt, MaxAttempts: policy.MaxAttempts}
+	if !policy.AllowsRetry(attempt) {
+		return outcome
+	}
+
+	notBefore := finishedAt.Add(policy.Backoff(attempt)).UTC()
+	result, err := s.runs.CreatePendingRetryIfAbsent(ctx, runqueuerepo.CreatePendingRetryParams{
+		SourceRunID:   run.RunID,
+		CorrelationID: runRetryCorrelationPrefix + run.RunID,
+		MaxAttempts:   policy.MaxAttempts,
+		NotBefore:     notBefore,
+	})
+	if err != nil {
+		s.logger.Error("schedule run retry failed", "run_id", run.RunID, "attempt", attempt, "err", err)
+		return outcome
+	}
+	if !result.Created {
+		return outcome
+	}
+	return runRetryOutcome{
+		Scheduled:   true,
+		Attempt:     result.Attempt,
+		MaxAttempts: policy.MaxAttempts,
+		NotBefore:   notBefore,
+	}
+}
@@ -0,0 +1,168 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	rundomain "github.com/codex-k8s/kodex/libs/go/domain/run"
+	runqueuerepo "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/repository/runqueue"
+)
+
+func TestClassifyLaunchFailure(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		err    error
+		reason runFailureReason
+		want   rundomain.FailureClass
+	}{
+		{name: "namespace prepare", err: errors.New("boom"), reason: runFailureReasonNamespacePrepareFailed, want: rundomain.FailureClassInfrastructure},
+		{name: "job not found", reason: runFailureReasonKubernetesJobNotFound, want: rundomain.FailureClassInfrastructure},
+		{name: "runtime deploy unavailable", err: status.Error(codes.Unavailable, "control-plane down"), reason: runFailureReasonRuntimeDeployFailed, want: rundomain.FailureClassInfrastructure},
+		{name: "runtime deploy timeout", err: fmt.Errorf("wait rollout: %w", context.DeadlineExceeded), reason: runFailureReasonRuntimeDeployFailed, want: rundomain.FailureClassInfrastructure},
+		{name: "connection refused", err: fmt.Errorf("issue mcp token: %w", &net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}), want: rundomain.FailureClassInfrastructure},
+		{name: "network timeout", err: &net.DNSError{Err: "i/o timeout", Name: "control-plane", IsTimeout: true}, want: rundomain.FailureClassInfrastructure},
+		{name: "timeout wording without typed cause", err: errors.New("wait rollout: timed out"), reason: runFailureReasonRuntimeDeployFailed, want: rundomain.FailureClassPrecondition},
+		{name: "unavailable in message", err: errors.New("image tag unavailable in registry"), want: rundomain.FailureClassPrecondition},
+		{name: "timeout setting in message", err: errors.New("agent timeout setting is invalid"), want: rundomain.FailureClassPrecondition},
+		{name: "refused in invalid argument", err: status.Error(codes.InvalidArgument, "connection refused by policy"), reason: runFailureReasonRuntimeDeployFailed, want: rundomain.FailureClassPrecondition},
+		{name: "internal with connection refused text", err: status.Error(codes.Internal, "connection refused"), reason: runFailureReasonRuntimeDeployFailed, want: rundomain.FailureClassPrecondition},
+		{name: "canceled context", err: fmt.Errorf("prepare runtime: %w", context.Canceled), reason: runFailureReasonRuntimeDeployFailed, want: rundomain.FailureClassPrecondition},
+		{name: "grpc canceled", err: status.Error(codes.Canceled, "worker shutdown"), reason: runFailureReasonRuntimeDeployFailed, want: rundomain.FailureClassPrecondition},
+		{name: "grpc resource exhausted", err: status.Error(codes.ResourceExhausted, "too many deploys"), reason: runFailureReasonRuntimeDeployFailed, want: rundomain.FailureClassInfrastructure},
+		{name: "kubernetes throttled", err: fmt.Errorf("create job: %w", apierrors.NewTooManyRequests("slow down", 1)), want: rundomain.FailureClassInfrastructure},
+		{name: "kubernetes server timeout", err: apierrors.NewServerTimeout(schema.GroupResource{Resource: "jobs"}, "create", 1), want: rundomain.FailureClassInfrastructure},
+		{name: "kubernetes invalid", err: apierrors.NewBadRequest("connection refused"), want: rundomain.FailureClassPrecondition},
+		{name: "runtime deploy invalid manifest", err: status.Error(codes.InvalidArgument, "bad manifest"), reason: runFailureReasonRuntimeDeployFailed, want: rundomain.FailureClassPrecondition},
+		{name: "runtime deploy canceled", err: errRuntimeDeployTaskCanceled, reason: runFailureReasonRuntimeDeployCanceled, want: rundomain.FailureClassPrecondition},
+		{name: "agent context", err: errors.New("unknown agent"), reason: runFailureReasonAgentContextResolve, want: rundomain.FailureClassPrecondition},
+	}
+	for _, tc := range cases {
+		if got := classifyLaunchFailure(tc.err, tc.reason); got != tc.want {
+			t.Fatalf("%s: got %q want %q", tc.name, got, tc.want)
+		}
+	}
+
+	if got := classifyJobFailureCause(JobFailureCauseImagePull); got != rundomain.FailureClassInfrastructure {
+		t.Fatalf("image pull must be infrastructure, got %q", got)
+	}
+	if got := classifyJobFailureCause(JobFailureCauseExitCode); got != rundomain.FailureClassAgent {
+		t.Fatalf("exit code must be agent, got %q", got)
+	}
+}
+
+func TestTickFailedJobWithInfrastructureCauseSchedulesRetry(t *testing.T) {
+	t.Parallel()
+
+	payload := json.RawMessage(`{"repository":{"full_name":"codex-k8s/kodex"},"trigger":{"kind":"dev"},"issue":{"number":7},"runtime":{"mode":"code-only"}}`)
+	runs := &fakeRunQueue{
+		running: []runqueuerepo.RunningRun{{RunID: "run-retry", CorrelationID: "corr-retry", ProjectID: "proj-retry", RunPayload: payload, Attempt: 1}},
+	}
+	events := &fakeFlowEvents{}
+	launcher := &fakeLauncher{
+		states:        map[string]JobState{"run-retry": JobStateFailed},
+		failureCauses: map[string]JobFailureCause{"run-retry": JobFailureCauseImagePull},
+	}
+	runStatus := &fakeRunStatusNotifier{}
+	now := time.Date(2026, 3, 23, 10, 0, 0, 0, time.UTC)
+
+	svc := NewService(Config{
+		WorkerID:          "worker-1",
+		ClaimLimit:        1,
+		RunningCheckLimit: 10,
+		SlotsPerProject:   2,
+		SlotLeaseTTL:      time.Minute,
+		RunRetryPolicy:    rundomain.RetryPolicy{MaxAttempts: 3, InitialBackoff: 2 * time.Minute},
+	}, Dependencies{
+		Runs:      runs,
+		Events:    events,
+		Launcher:  launcher,
+		RunStatus: runStatus,
+		Logger:    slog.New(slog.NewJSONHandler(io.Discard, nil)),
+	})
+	svc.now = func() time.Time { return now }
+
+	if err := svc.Tick(context.Background()); err != nil {
+		t.Fatalf("Tick() error = %v", err)
+	}
+
+	if len(runs.finished) != 1 || runs.finished[0].FailureClass != rundomain.FailureClassInfrastructure {
+		t.Fatalf("expected failed run with infrastructure class, got %#v", runs.finished)
+	}
+	if len(runs.retryPending) != 1 {
+		t.Fatalf("expected one retry scheduled, got %d", len(runs.retryPending))
+	}
+	retry := runs.retryPending[0]
+	if retry.SourceRunID != "run-retry" || retry.CorrelationID != "retry:run-retry" || retry.MaxAttempts != 3 {
+		t.Fatalf("unexpected retry params: %#v", retry)
+	}
+	if got, want := retry.NotBefore, now.Add(2*time.Minute); !got.Equal(want) {
+		t.Fatalf("unexpected retry not_before: got %s want %s", got, want)
+	}
+	if len(runStatus.upserts) != 1 {
+		t.Fatalf("expected finished status comment, got %d", len(runStatus.upserts))
+	}
+	comment := runStatus.upserts[0]
+	if !comment.RetryScheduled || comment.RetryAttempt != 2 || comment.RetryMaxAttempts != 3 || comment.FailureClass != string(rundomain.FailureClassInfrastructure) {
+		t.Fatalf("unexpected finished status comment: %#v", comment)
+	}
+
+	var finished runFinishedEventPayload
+	if err := json.Unmarshal(events.inserted[0].Payload, &finished); err != nil {
+		t.Fatalf("decode finish payload: %v", err)
+	}
+	if finished.FailureCause != JobFailureCauseImagePull || finished.Retry == nil || finished.Retry.Attempt != 2 {
+		t.Fatalf("unexpected finish payload: %#v", finished)
+	}
+}
+
+func TestTickFailedJobWithAgentCauseDoesNotRetry(t *testing.T) {
+	t.Parallel()
+
+	runs := &fakeRunQueue{
+		running: []runqueuerepo.RunningRun{{RunID: "run-agent", CorrelationID: "corr-agent", ProjectID: "proj-agent", Attempt: 1}},
+	}
+	launcher := &fakeLauncher{
+		states:        map[string]JobState{"run-agent": JobStateFailed},
+		failureCauses: map[string]JobFailureCause{"run-agent": JobFailureCauseExitCode},
+	}
+
+	svc := NewService(Config{
+		WorkerID:          "worker-1",
+		ClaimLimit:        1,
+		RunningCheckLimit: 10,
+		SlotsPerProject:   2,
+		SlotLeaseTTL:      time.Minute,
+		RunRetryPolicy:    rundomain.DefaultRetryPolicy,
+	}, Dependencies{
+		Runs:     runs,
+		Events:   &fakeFlowEvents{},
+		Launcher: launcher,
+		Logger:   slog.New(slog.NewJSONHandler(io.Discard, nil)),
+	})
+
+	if err := svc.Tick(context.Background()); err != nil {
+		t.Fatalf("Tick() error = %v", err)
+	}
+
+	if len(runs.finished) != 1 || runs.finished[0].FailureClass != rundomain.FailureClassAgent {
+		t.Fatalf("expected failed run with agent class, got %#v", runs.finished)
+	}
+	if len(runs.retryPending) != 0 {
+		t.Fatalf("agent failures must not be retried, got %#v", runs.retryPending)
+	}
+}
@@ -26,15 +26,18 @@ func (s *Service) launchPreparedRunWorkload(ctx context.Context, run runqueuerep
 		}
 	}
 
+	resourceProfile := s.resolveRunResourceProfile(ctx, run.ProjectID, agentCtx.AgentKey)
+
 	namespaceSpec := NamespaceSpec{
-		RunID:         run.RunID,
-		ProjectID:     run.ProjectID,
-		IssueNumber:   lease.IssueNumber,
-		AgentKey:      lease.AgentKey,
-		CorrelationID: run.CorrelationID,
-		RuntimeMode:   execution.RuntimeMode,
-		Namespace:     execution.Namespace,
-		AccessProfile: runtimeAccessProfile,
+		RunID:           run.RunID,
+		ProjectID:       run.ProjectID,
+		IssueNumber:     lease.IssueNumber,
+		AgentKey:        lease.AgentKey,
+		CorrelationID:   run.CorrelationID,
+		RuntimeMode:     execution.RuntimeMode,
+		Namespace:       execution.Namespace,
+		AccessProfile:   runtimeAccessProfile,
+		ResourceProfile: resourceProfile.Profile,
 	}
 
 	if shouldManageRunNamespace(execution, agentCtx) && !options.SkipNamespacePreparation {
@@ -146,17 +149,19 @@ func (s *Service) launchPreparedRunWorkload(ctx context.Context, run runqueuerep
 		ActorID:       floweventdomain.ActorID(s.cfg.WorkerID),
 		EventType:     floweventdomain.EventTypeRunProfileResolved,
 		Payload: encodeRunProfileResolvedEventPayload(runProfileResolvedEventPayload{
-			RunID:              run.RunID,
-			ProjectID:          run.ProjectID,
-			RepositoryFullName: agentCtx.RepositoryFullName,
-			IssueNumber:        agentCtx.IssueNumber,
-			PullRequestNumber:  agentCtx.ExistingPRNumber,
-			TriggerKind:        agentCtx.TriggerKind,
-			DiscussionMode:     agentCtx.DiscussionMode,
-			Model:              agentCtx.Model,
-			ModelSource:        agentCtx.ModelSource,
-			ReasoningEffort:    agentCtx.ReasoningEffort,
-			ReasoningSource:    agentCtx.ReasoningSource,
+			RunID:                 run.RunID,
+			ProjectID:             run.ProjectID,
+			RepositoryFullName:    agentCtx.RepositoryFullName,
+			IssueNumber:           agentCtx.IssueNumber,
+			PullRequestNumber:     agentCtx.ExistingPRNumber,
+			TriggerKind:           agentCtx.TriggerKind,
+			DiscussionMode:        agentCtx.DiscussionMode,
+			Model:                 agentCtx.Model,
+			ModelSource:           agentCtx.ModelSource,
+			ReasoningEffort:       agentCtx.ReasoningEffort,
+			ReasoningSource:       agentCtx.ReasoningSource,
+			ResourceProfile:       resourceProfile.Name,
+			ResourceProfileSource: resourceProfile.Source,
 		}),
 		CreatedAt: s.now().UTC(),
 	}); err != nil {
@@ -212,6 +217,7 @@ func (s *Service) launchPreparedRunWorkload(ctx context.Context, run runqueuerep
 		TriggerKind:              agentCtx.TriggerKind,
 		TriggerLabel:             agentCtx.TriggerLabel,
 		DiscussionMode:           agentCtx.DiscussionMode,
+		AlertContext:             agentCtx.AlertContext,
 		TargetBranch:             targetBranch,
 		ExistingPRNumber:         agentCtx.ExistingPRNumber,
 		AgentKey:                 agentCtx.AgentKey,
@@ -229,6 +235,7 @@ func (s *Service) launchPreparedRunWorkload(ctx context.Context, run runqueuerep
 		GitBotUsername:           s.cfg.GitBotUsername,
 		GitBotMail:               s.cfg.GitBotMail,
 		ServiceAccountName:       strings.TrimSpace(options.ServiceAccountName),
+		ResourceProfile:          resourceProfile.Profile,
 	})
 	if err != nil {
 		s.logger.Error("launch run job failed", "run_id", run.RunID, "err", err)
@@ -239,7 +246,8 @@ func (s *Service) launchPreparedRunWorkload(ctx context.Context, run runqueuerep
 			EventType: floweventdomain.EventTypeRunFailedLaunchError,
 			Ref:       ref,
 			Extra: runFinishedEventExtra{
-				Error: err.Error(),
+				Error:        err.Error(),
+				FailureClass: classifyLaunchFailure(err, ""),
 			},
 		}); finishErr != nil {
 			return fmt.Errorf("mark run failed after launch error: %w", finishErr)
@@ -253,27 +261,29 @@ func (s *Service) launchPreparedRunWorkload(ctx context.Context, run runqueuerep
 		ActorID:       floweventdomain.ActorID(s.cfg.WorkerID),
 		EventType:     floweventdomain.EventTypeRunStarted,
 		Payload: encodeRunStartedEventPayload(runStartedEventPayload{
-			RunID:                run.RunID,
-			ProjectID:            run.ProjectID,
-			SlotNo:               run.SlotNo,
-			JobName:              ref.Name,
-			JobNamespace:         ref.Namespace,
-			RuntimeMode:          execution.RuntimeMode,
-			RepositoryFullName:   agentCtx.RepositoryFullName,
-			AgentKey:             agentCtx.AgentKey,
-			IssueNumber:          agentCtx.IssueNumber,
-			TriggerKind:          agentCtx.TriggerKind,
-			TriggerLabel:         agentCtx.TriggerLabel,
-			DiscussionMode:       agentCtx.DiscussionMode,
-			JobImage:             jobImage.SelectedImage,
-			Model:                agentCtx.Model,
-			ModelSource:          agentCtx.ModelSource,
-			ReasoningEffort:      agentCtx.ReasoningEffort,
-			ReasoningSource:      agentCtx.ReasoningSource,
-			PromptTemplateKind:   agentCtx.PromptTemplateKind,
-			PromptTemplateSource: agentCtx.PromptTemplateSource,
-			PromptTemplateLocale: agentCtx.PromptTemplateLocale,
-			BaseBranch:           s.cfg.AgentBaseBranch,
+			RunID:                 run.RunID,
+			ProjectID:             run.ProjectID,
+			SlotNo:                run.SlotNo,
+			JobName:               ref.Name,
+			JobNamespace:          ref.Namespace,
+			RuntimeMode:           execution.RuntimeMode,
+			RepositoryFullName:    agentCtx.RepositoryFullName,
+			AgentKey:              agentCtx.AgentKey,
+			IssueNumber:           agentCtx.IssueNumber,
+			TriggerKind:           agentCtx.TriggerKind,
+			TriggerLabel:          agentCtx.TriggerLabel,
+			DiscussionMode:        agentCtx.DiscussionMode,
+			JobImage:              jobImage.SelectedImage,
+			Model:                 agentCtx.Model,
+			ModelSource:           agentCtx.ModelSource,
+			ReasoningEffort:       agentCtx.ReasoningEffort,
+			ReasoningSource:       agentCtx.ReasoningSource,
+			PromptTemplateKind:    agentCtx.PromptTemplateKind,
+			PromptTemplateSource:  agentCtx.PromptTemplateSource,
+			PromptTemplateLocale:  agentCtx.PromptTemplateLocale,
+			BaseBranch:            s.cfg.AgentBaseBranch,
+			ResourceProfile:       resourceProfile.Name,
+			ResourceProfileSource: resourceProfile.Source,
 		}),
 		CreatedAt: s.now().UTC(),
 	}); err != nil {
@@ -1,6 +1,9 @@
 package worker
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // RunStatusPhase identifies one run status transition mirrored to issue comment.
 type RunStatusPhase string
@@ -28,6 +31,16 @@ type RunStatusCommentParams struct {
 	RunStatus       string
 	Deleted         bool
 	AlreadyDeleted  bool
+	// FailureClass is set for failed runs (infrastructure, agent, precondition).
+	FailureClass string
+	// RetryScheduled reports that an automatic retry run was queued.
+	RetryScheduled bool
+	// RetryAttempt is the attempt number of the scheduled retry.
+	RetryAttempt int
+	// RetryMaxAttempts is the total attempts budget of the retry policy.
+	RetryMaxAttempts int
+	// RetryNotBefore is the earliest time when scheduled retry may start.
+	RetryNotBefore time.Time
 }
 
 // RunStatusCommentResult is a worker-side run status update response.
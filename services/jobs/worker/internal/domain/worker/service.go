@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	rundomain "github.com/codex-k8s/kodex/libs/go/domain/run"
 	webhookdomain "github.com/codex-k8s/kodex/libs/go/domain/webhook"
 	floweventrepo "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/repository/flowevent"
 	learningfeedbackrepo "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/repository/learningfeedback"
@@ -64,6 +66,22 @@ type Config struct {
 	MissionControlRetryMaxAttempts int
 	// MissionControlRetryBaseInterval defines the first retry delay for Mission Control provider mutations.
 	MissionControlRetryBaseInterval time.Duration
+	// RetentionPollInterval throttles how often worker triggers retention sweeps.
+	RetentionPollInterval time.Duration
+	// RetentionSweepInterval defines how often one retention policy is swept after it was drained.
+	RetentionSweepInterval time.Duration
+	// RetentionPolicyLimit limits retention policies claimed per sweep.
+	RetentionPolicyLimit int
+	// RetentionBatchSize limits rows removed per batch.
+	RetentionBatchSize int
+	// RetentionMaxBatches limits batches processed per policy in one sweep.
+	RetentionMaxBatches int
+	// WarmPoolReconcileInterval throttles how often worker replenishes project warm pools.
+	WarmPoolReconcileInterval time.Duration
+	// WarmPoolPrepareTimeout bounds how long a warm pool namespace may stay in preparing state.
+	WarmPoolPrepareTimeout time.Duration
+	// WarmPoolMaxAge recycles unclaimed ready warm pool namespaces so the pool follows the default branch.
+	WarmPoolMaxAge time.Duration
 
 	// ProjectLearningModeDefault is applied when the worker auto-creates projects from webhook payloads.
 	ProjectLearningModeDefault bool
@@ -75,6 +93,14 @@ type Config struct {
 	DefaultNamespaceTTL time.Duration
 	// NamespaceTTLByRole contains full-env namespace retention overrides per agent role key.
 	NamespaceTTLByRole map[string]time.Duration
+	// ResourceProfiles contains platform run resource profiles by name; projects may override them.
+	ResourceProfiles map[string]agentdomain.ResourceProfile
+	// ResourceProfileByRole binds agent role keys to platform resource profile names.
+	ResourceProfileByRole map[string]string
+	// RunRetryPolicy re-queues infrastructure-caused run failures; zero value disables automatic retries.
+	RunRetryPolicy rundomain.RetryPolicy
+	// RunRetryPolicyByTriggerKind overrides RunRetryPolicy per normalized trigger kind.
+	RunRetryPolicyByTriggerKind map[string]rundomain.RetryPolicy
 	// NamespaceLeaseSweepLimit limits how many expired managed namespaces are cleaned per tick.
 	NamespaceLeaseSweepLimit int
 	// StateInReviewLabel is applied to PR when run is ready for owner review.
@@ -161,6 +187,8 @@ type Dependencies struct {
 	GitHubRateLimits GitHubRateLimitWaitProcessor
 	// MissionControl coordinates Mission Control warmup and command execution through control-plane.
 	MissionControl MissionControlClient
+	// Retention triggers retention sweeps of platform history tables through control-plane; nil disables sweeps.
+	Retention RetentionClient
 	// InteractionDispatcher sends interaction envelopes to the current adapter implementation.
 	InteractionDispatcher InteractionDispatcher
 	// Logger records worker diagnostics.
@@ -184,12 +212,15 @@ type Service struct {
 	interactions             InteractionLifecycleClient
 	githubRateLimits         GitHubRateLimitWaitProcessor
 	missionCtl               MissionControlClient
+	retention                RetentionClient
 	dispatcher               InteractionDispatcher
 	logger                   *slog.Logger
 	labels                   runAgentLabelCatalog
 	image                    JobImageSelectionPolicy
 	systemSettings           runtimeSystemSettings
 	lastMissionControlWarmup map[string]time.Time
+	lastRetentionSweep       time.Time
+	lastWarmPoolReconcile    time.Time
 	now                      func() time.Time
 }
 
@@ -267,6 +298,30 @@ func NewService(cfg Config, deps Dependencies) *Service {
 	if cfg.MissionControlWarmupProjectLimit <= 0 {
 		cfg.MissionControlWarmupProjectLimit = 20
 	}
+	if cfg.WarmPoolReconcileInterval <= 0 {
+		cfg.WarmPoolReconcileInterval = time.Minute
+	}
+	if cfg.WarmPoolPrepareTimeout <= 0 {
+		cfg.WarmPoolPrepareTimeout = cfg.RuntimePrepareRetryTimeout + 10*time.Minute
+	}
+	if cfg.WarmPoolMaxAge <= 0 {
+		cfg.WarmPoolMaxAge = 12 * time.Hour
+	}
+	if cfg.RetentionPollInterval <= 0 {
+		cfg.RetentionPollInterval = 5 * time.Minute
+	}
+	if cfg.RetentionSweepInterval <= 0 {
+		cfg.RetentionSweepInterval = time.Hour
+	}
+	if cfg.RetentionPolicyLimit <= 0 {
+		cfg.RetentionPolicyLimit = 20
+	}
+	if cfg.RetentionBatchSize <= 0 {
+		cfg.RetentionBatchSize = 500
+	}
+	if cfg.RetentionMaxBatches <= 0 {
+		cfg.RetentionMaxBatches = 20
+	}
 	if cfg.MissionControlPendingCommandLimit <= 0 {
 		cfg.MissionControlPendingCommandLimit = 20
 	}
@@ -396,6 +451,7 @@ func NewService(cfg Config, deps Dependencies) *Service {
 		interactions:     deps.Interactions,
 		githubRateLimits: deps.GitHubRateLimits,
 		missionCtl:       deps.MissionControl,
+		retention:        deps.Retention,
 		dispatcher:       deps.InteractionDispatcher,
 		logger:           deps.Logger,
 		labels:           labelCatalog,
@@ -89,6 +89,12 @@ func (s *Service) launchPending(ctx context.Context) error {
 			execution = reuseResolution.execution
 			prepareParams = reuseResolution.prepareParams
 			reusedFullEnvNamespace = reuseResolution.reusable
+			if !reusedFullEnvNamespace && !aiRepairRun && !productionReadOnlyRun {
+				execution, prepareParams, err = s.claimWarmPoolNamespace(ctx, runningRun, execution, prepareParams, leaseCtx, leaseTTL, triggerKind)
+				if err != nil {
+					return err
+				}
+			}
 		}
 
 		if aiRepairRun {
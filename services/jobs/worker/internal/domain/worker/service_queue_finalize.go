@@ -18,12 +18,19 @@ import (
 // finishRun persists terminal run state, emits flow events, and finalizes runtime namespace lifecycle.
 func (s *Service) finishRun(ctx context.Context, params finishRunParams) error {
 	finishedAt := s.now().UTC()
+	failureClass := params.Extra.FailureClass
+	if params.Status != rundomain.StatusFailed {
+		failureClass = ""
+	} else if failureClass == "" {
+		failureClass = classifyLaunchFailure(nil, params.Extra.Reason)
+	}
 	updated, err := s.runs.FinishRun(ctx, runqueuerepo.FinishParams{
-		RunID:      params.Run.RunID,
-		ProjectID:  params.Run.ProjectID,
-		LeaseOwner: s.cfg.WorkerID,
-		Status:     params.Status,
-		FinishedAt: finishedAt,
+		RunID:        params.Run.RunID,
+		ProjectID:    params.Run.ProjectID,
+		LeaseOwner:   s.cfg.WorkerID,
+		Status:       params.Status,
+		FinishedAt:   finishedAt,
+		FailureClass: failureClass,
 	})
 	if err != nil {
 		return fmt.Errorf("finish run %s as %s: %w", params.Run.RunID, params.Status, err)
@@ -31,6 +38,9 @@ func (s *Service) finishRun(ctx context.Context, params finishRunParams) error {
 	if !updated {
 		return nil
 	}
+	s.deleteRunCredentialsBestEffort(ctx, params)
+	s.completeWarmPoolDeploy(ctx, params.Run, params.Status)
+	retry := s.scheduleRunRetry(ctx, params.Run, failureClass, finishedAt)
 
 	payload := runFinishedEventPayload{
 		RunID:        params.Run.RunID,
@@ -42,6 +52,18 @@ func (s *Service) finishRun(ctx context.Context, params finishRunParams) error {
 		Namespace:    params.Execution.Namespace,
 		Error:        params.Extra.Error,
 		Reason:       params.Extra.Reason,
+		FailureClass: failureClass,
+		FailureCause: params.Extra.FailureCause,
+	}
+	if failureClass != "" {
+		payload.Attempt = retry.Attempt
+	}
+	if retry.Scheduled {
+		payload.Retry = &runRetryEventPayload{
+			Attempt:     retry.Attempt,
+			MaxAttempts: retry.MaxAttempts,
+			NotBefore:   retry.NotBefore.Format(time.RFC3339),
+		}
 	}
 
 	if err := s.insertEvent(ctx, floweventrepo.InsertParams{
@@ -56,13 +78,18 @@ func (s *Service) finishRun(ctx context.Context, params finishRunParams) error {
 	}
 
 	if _, err := s.runStatus.UpsertRunStatusComment(ctx, RunStatusCommentParams{
-		RunID:        params.Run.RunID,
-		Phase:        RunStatusPhaseFinished,
-		JobName:      params.Ref.Name,
-		JobNamespace: params.Ref.Namespace,
-		RuntimeMode:  string(params.Execution.RuntimeMode),
-		Namespace:    params.Execution.Namespace,
-		RunStatus:    string(params.Status),
+		RunID:            params.Run.RunID,
+		Phase:            RunStatusPhaseFinished,
+		JobName:          params.Ref.Name,
+		JobNamespace:     params.Ref.Namespace,
+		RuntimeMode:      string(params.Execution.RuntimeMode),
+		Namespace:        params.Execution.Namespace,
+		RunStatus:        string(params.Status),
+		FailureClass:     string(failureClass),
+		RetryScheduled:   retry.Scheduled,
+		RetryAttempt:     retry.Attempt,
+		RetryMaxAttempts: retry.MaxAttempts,
+		RetryNotBefore:   retry.NotBefore,
 	}); err != nil {
 		s.logger.Warn("upsert run status comment (finished) failed", "run_id", params.Run.RunID, "err", err)
 	}
@@ -98,6 +125,19 @@ func (s *Service) finishRun(ctx context.Context, params finishRunParams) error {
 	return nil
 }
 
+// deleteRunCredentialsBestEffort drops per-run credentials Secret as soon as run is terminal;
+// owner references remain a fallback for workloads removed by Kubernetes garbage collection.
+func (s *Service) deleteRunCredentialsBestEffort(ctx context.Context, params finishRunParams) {
+	namespace := strings.TrimSpace(params.Ref.Namespace)
+	if namespace == "" {
+		namespace = strings.TrimSpace(params.Execution.Namespace)
+	}
+	ref := s.launcher.JobRef(params.Run.RunID, namespace)
+	if err := s.launcher.DeleteRunCredentials(ctx, ref, params.Run.RunID); err != nil {
+		s.logger.Warn("delete run credentials secret failed", "run_id", params.Run.RunID, "namespace", ref.Namespace, "err", err)
+	}
+}
+
 func (s *Service) finishLaunchFailedRun(ctx context.Context, run runqueuerepo.RunningRun, execution valuetypes.RunExecutionContext, failure error, reason runFailureReason) error {
 	return s.finishRun(ctx, finishRunParams{
 		Run:       run,
@@ -105,8 +145,9 @@ func (s *Service) finishLaunchFailedRun(ctx context.Context, run runqueuerepo.Ru
 		Status:    rundomain.StatusFailed,
 		EventType: floweventdomain.EventTypeRunFailedLaunchError,
 		Extra: runFinishedEventExtra{
-			Error:  failure.Error(),
-			Reason: reason,
+			Error:        failure.Error(),
+			Reason:       reason,
+			FailureClass: classifyLaunchFailure(failure, reason),
 		},
 	})
 }
@@ -178,6 +219,7 @@ func runningRunFromClaimed(claimed runqueuerepo.ClaimedRun) runqueuerepo.Running
 		SlotNo:        claimed.SlotNo,
 		LearningMode:  claimed.LearningMode,
 		RunPayload:    claimed.RunPayload,
+		Attempt:       claimed.Attempt,
 	}
 }
 
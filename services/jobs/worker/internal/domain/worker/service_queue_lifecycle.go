@@ -28,6 +28,12 @@ func (s *Service) Tick(ctx context.Context) error {
 	if err := s.reconcileMissionControl(ctx); err != nil {
 		s.logger.Error("reconcile mission control failed", "worker_id", s.cfg.WorkerID, "err", err)
 	}
+	if err := s.reconcileRetention(ctx); err != nil {
+		s.logger.Error("reconcile retention failed", "worker_id", s.cfg.WorkerID, "err", err)
+	}
+	if err := s.reconcileWarmPool(ctx); err != nil {
+		s.logger.Error("reconcile warm pool failed", "worker_id", s.cfg.WorkerID, "err", err)
+	}
 	if err := s.cleanupExpiredNamespaces(ctx); err != nil {
 		return fmt.Errorf("cleanup expired namespaces: %w", err)
 	}
@@ -138,6 +144,7 @@ func (s *Service) reconcileRunning(ctx context.Context) error {
 				return err
 			}
 		case JobStateFailed:
+			failureCause := s.resolveJobFailureCause(ctx, run.RunID, ref)
 			if err := s.finishRun(ctx, finishRunParams{
 				Run:       run,
 				Execution: execution,
@@ -145,7 +152,9 @@ func (s *Service) reconcileRunning(ctx context.Context) error {
 				EventType: floweventdomain.EventTypeRunFailed,
 				Ref:       ref,
 				Extra: runFinishedEventExtra{
-					Reason: runFailureReasonKubernetesJobFailed,
+					Reason:       runFailureReasonKubernetesJobFailed,
+					FailureClass: classifyJobFailureCause(failureCause),
+					FailureCause: failureCause,
 				},
 			}); err != nil {
 				return err
@@ -860,6 +860,9 @@ func TestTickFinalizesSucceededRun(t *testing.T) {
 	if events.inserted[0].EventType != floweventdomain.EventTypeRunSucceeded {
 		t.Fatalf("expected run.succeeded event, got %s", events.inserted[0].EventType)
 	}
+	if got, want := launcher.deletedCredentials, []string{"ns/run-2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected run credentials cleanup %v, got %v", want, got)
+	}
 }
 
 func TestTickFinalizesCodeOnlyRun_UpdatesFinishedStatusComment(t *testing.T) {
@@ -1858,8 +1861,14 @@ type fakeRunQueue struct {
 	releaseStaleParams  []runqueuerepo.ReleaseStaleLeasesParams
 	releaseOwnedParams  []runqueuerepo.ReleaseOwnedLeasesParams
 	resumePending       []runqueuerepo.CreatePendingResumeParams
+	retryPending        []runqueuerepo.CreatePendingRetryParams
 	finished            []runqueuerepo.FinishParams
 	extended            []runqueuerepo.ExtendLeaseParams
+	projectSettings     map[string]runqueuerepo.ProjectSettings
+	warmPoolTargets     []runqueuerepo.WarmPoolTarget
+	warmPoolDeploys     []runqueuerepo.CreateWarmPoolDeployParams
+	warmPoolLockHeld    bool
+	warmPoolReleases    int
 	claimErr            error
 	claimRunningErr     error
 	releaseStaleErr     error
@@ -1926,6 +1935,14 @@ func (f *fakeRunQueue) CreatePendingResumeIfAbsent(_ context.Context, params run
 	return appendIfNoError(&f.resumePending, params, f.resumePendingErr)
 }
 
+func (f *fakeRunQueue) CreatePendingRetryIfAbsent(_ context.Context, params runqueuerepo.CreatePendingRetryParams) (runqueuerepo.CreatePendingRetryResult, error) {
+	if params.MaxAttempts <= 1 {
+		return runqueuerepo.CreatePendingRetryResult{}, nil
+	}
+	f.retryPending = append(f.retryPending, params)
+	return runqueuerepo.CreatePendingRetryResult{Created: true, Attempt: 2}, nil
+}
+
 func (f *fakeRunQueue) ExtendLease(_ context.Context, params runqueuerepo.ExtendLeaseParams) (bool, error) {
 	return appendIfNoError(&f.extended, params, f.extendErr)
 }
@@ -1934,6 +1951,26 @@ func (f *fakeRunQueue) FinishRun(_ context.Context, params runqueuerepo.FinishPa
 	return appendIfNoError(&f.finished, params, f.finishErr)
 }
 
+func (f *fakeRunQueue) GetProjectSettings(_ context.Context, projectID string) (runqueuerepo.ProjectSettings, error) {
+	return f.projectSettings[projectID], nil
+}
+
+func (f *fakeRunQueue) ListWarmPoolTargets(_ context.Context) ([]runqueuerepo.WarmPoolTarget, error) {
+	return f.warmPoolTargets, nil
+}
+
+func (f *fakeRunQueue) CreateWarmPoolDeploy(_ context.Context, params runqueuerepo.CreateWarmPoolDeployParams) error {
+	f.warmPoolDeploys = append(f.warmPoolDeploys, params)
+	return nil
+}
+
+func (f *fakeRunQueue) TryLockWarmPool(_ context.Context) (func(), bool, error) {
+	if f.warmPoolLockHeld {
+		return nil, false, nil
+	}
+	return func() { f.warmPoolReleases++ }, true, nil
+}
+
 func appendIfNoError[T any](dst *[]T, value T, err error) (bool, error) {
 	if err != nil {
 		return false, err
@@ -1975,11 +2012,16 @@ type fakeLauncher struct {
 	managedNamespaces       []ManagedNamespaceState
 	workloadStates          map[string]NamespaceWorkloadState
 	deletedNamespaces       []string
+	deletedCredentials      []string
+	failureCauses           map[string]JobFailureCause
 	launchErr               error
 	statusErr               error
 	listManagedErr          error
 	inspectWorkloadsErr     error
 	deleteManagedErr        error
+	warmPoolNamespaces      []WarmPoolNamespace
+	warmPoolReady           []string
+	warmPoolDeleted         []string
 }
 
 type fakeMCPTokenIssuer struct {
@@ -2104,6 +2146,42 @@ func (f *fakeLauncher) DeleteManagedNamespace(_ context.Context, namespace strin
 	return true, nil
 }
 
+func (f *fakeLauncher) ListWarmPoolNamespaces(_ context.Context, projectID string) ([]WarmPoolNamespace, error) {
+	out := make([]WarmPoolNamespace, 0, len(f.warmPoolNamespaces))
+	for _, item := range f.warmPoolNamespaces {
+		if projectID == "" || item.ProjectID == projectID {
+			out = append(out, item)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeLauncher) MarkWarmPoolNamespaceReady(_ context.Context, namespace string, _ string) error {
+	f.warmPoolReady = append(f.warmPoolReady, namespace)
+	return nil
+}
+
+func (f *fakeLauncher) ClaimWarmPoolNamespace(_ context.Context, spec NamespaceSpec) (string, bool, error) {
+	f.callLog = append(f.callLog, "claim_warm_pool_namespace")
+	for idx, item := range f.warmPoolNamespaces {
+		if item.ProjectID == spec.ProjectID && item.State == WarmPoolNamespaceStateReady {
+			f.warmPoolNamespaces = append(f.warmPoolNamespaces[:idx], f.warmPoolNamespaces[idx+1:]...)
+			return item.Namespace, true, nil
+		}
+	}
+	return "", false, nil
+}
+
+func (f *fakeLauncher) DeleteWarmPoolNamespace(_ context.Context, namespace string) (bool, error) {
+	f.warmPoolDeleted = append(f.warmPoolDeleted, namespace)
+	return true, nil
+}
+
+func (f *fakeLauncher) DeleteRunCredentials(_ context.Context, ref JobRef, runID string) error {
+	f.deletedCredentials = append(f.deletedCredentials, ref.Namespace+"/"+runID)
+	return nil
+}
+
 func (f *fakeLauncher) Launch(_ context.Context, spec JobSpec) (JobRef, error) {
 	if f.launchErr != nil {
 		return JobRef{}, f.launchErr
@@ -2112,6 +2190,14 @@ func (f *fakeLauncher) Launch(_ context.Context, spec JobSpec) (JobRef, error) {
 	return f.JobRef(spec.RunID, spec.Namespace), nil
 }
 
+func (f *fakeLauncher) FailureCause(_ context.Context, ref JobRef) (JobFailureCause, error) {
+	f.callLog = append(f.callLog, "failure_cause")
+	if cause, ok := f.failureCauses[strings.TrimPrefix(ref.Name, "job-")]; ok {
+		return cause, nil
+	}
+	return JobFailureCauseUnknown, nil
+}
+
 func (f *fakeLauncher) Status(_ context.Context, ref JobRef) (JobState, error) {
 	f.callLog = append(f.callLog, "status")
 	if f.statusErr != nil {
@@ -0,0 +1,306 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	rundomain "github.com/codex-k8s/kodex/libs/go/domain/run"
+	floweventrepo "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/repository/flowevent"
+	runqueuerepo "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/repository/runqueue"
+	querytypes "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/types/query"
+	valuetypes "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/types/value"
+)
+
+const (
+	warmPoolCorrelationPrefix = "warm-pool:"
+	warmPoolTargetEnv         = "ai"
+)
+
+// warmPoolEventPayload defines payload shape for warm pool hit/miss evidence.
+type warmPoolEventPayload struct {
+	RunID       string `json:"run_id"`
+	ProjectID   string `json:"project_id"`
+	Namespace   string `json:"namespace,omitempty"`
+	IssueNumber int64  `json:"issue_number,omitempty"`
+	AgentKey    string `json:"agent_key,omitempty"`
+	TriggerKind string `json:"trigger_kind,omitempty"`
+}
+
+// reconcileWarmPool keeps per-project pools of pre-deployed full-env namespaces at `projects.settings.warm_pool_size`.
+// Missing members are created as warm namespaces plus deploy-only runs that roll out the default branch into them.
+// A Postgres advisory lock lets only one worker replica reconcile at a time.
+func (s *Service) reconcileWarmPool(ctx context.Context) error {
+	now := s.now().UTC()
+	if !s.lastWarmPoolReconcile.IsZero() && now.Sub(s.lastWarmPoolReconcile) < s.cfg.WarmPoolReconcileInterval {
+		return nil
+	}
+	s.lastWarmPoolReconcile = now
+
+	release, locked, err := s.runs.TryLockWarmPool(ctx)
+	if err != nil {
+		return fmt.Errorf("lock warm pool: %w", err)
+	}
+	if !locked {
+		return nil
+	}
+	defer release()
+
+	targets, err := s.runs.ListWarmPoolTargets(ctx)
+	if err != nil {
+		return fmt.Errorf("list warm pool targets: %w", err)
+	}
+	items, err := s.launcher.ListWarmPoolNamespaces(ctx, "")
+	if err != nil {
+		return fmt.Errorf("list warm pool namespaces: %w", err)
+	}
+	byProject := make(map[string][]WarmPoolNamespace, len(targets))
+	for _, item := range items {
+		byProject[item.ProjectID] = append(byProject[item.ProjectID], item)
+	}
+
+	kept := make([]WarmPoolNamespace, 0, len(items))
+	for _, target := range targets {
+		projectKept := s.reconcileProjectWarmPool(ctx, target, byProject[target.ProjectID])
+		kept = append(kept, projectKept...)
+		delete(byProject, target.ProjectID)
+	}
+	// Pools of projects that disabled warm pool (or lost repository binding) are drained.
+	for _, orphaned := range byProject {
+		for _, item := range orphaned {
+			s.deleteWarmPoolNamespace(ctx, item, "pool_disabled")
+		}
+	}
+
+	setWarmPoolNamespaceCounts(kept)
+	return nil
+}
+
+// reconcileProjectWarmPool evicts stale members of one project pool, tops it up to target size and returns kept members.
+// In-flight deploy runs count toward the pool size, and only ready members are trimmed as surplus:
+// deleting a preparing namespace would leave its deploy run rolling out into nothing.
+func (s *Service) reconcileProjectWarmPool(ctx context.Context, target runqueuerepo.WarmPoolTarget, items []WarmPoolNamespace) []WarmPoolNamespace {
+	now := s.now().UTC()
+	ready := make([]WarmPoolNamespace, 0, len(items))
+	preparing := make([]WarmPoolNamespace, 0, len(items))
+	for _, item := range items {
+		age := now.Sub(item.CreatedAt)
+		switch {
+		case item.State == WarmPoolNamespaceStateReady && age > s.cfg.WarmPoolMaxAge:
+			s.deleteWarmPoolNamespace(ctx, item, "max_age")
+		case item.State != WarmPoolNamespaceStateReady && age > s.cfg.WarmPoolPrepareTimeout:
+			s.deleteWarmPoolNamespace(ctx, item, "prepare_timeout")
+		case item.State == WarmPoolNamespaceStateReady:
+			ready = append(ready, item)
+		default:
+			preparing = append(preparing, item)
+		}
+	}
+
+	inFlight := max(len(preparing), target.InFlightDeploys)
+	if surplus := min(len(ready)+inFlight-target.PoolSize, len(ready)); surplus > 0 {
+		for _, item := range ready[len(ready)-surplus:] {
+			s.deleteWarmPoolNamespace(ctx, item, "surplus")
+		}
+		ready = ready[:len(ready)-surplus]
+	}
+
+	kept := append(ready, preparing...)
+	for missing := target.PoolSize - len(ready) - inFlight; missing > 0; missing-- {
+		created, err := s.createWarmPoolNamespace(ctx, target)
+		if err != nil {
+			s.logger.Warn("create warm pool namespace failed", "project_id", target.ProjectID, "err", err)
+			break
+		}
+		kept = append(kept, created)
+	}
+	return kept
+}
+
+// createWarmPoolNamespace creates one warm namespace and enqueues deploy-only run rolling out the default branch into it.
+func (s *Service) createWarmPoolNamespace(ctx context.Context, target runqueuerepo.WarmPoolTarget) (WarmPoolNamespace, error) {
+	runID := uuid.NewString()
+	namespace := buildWarmPoolNamespace(s.cfg.RunNamespacePrefix, target.ProjectID, runID)
+	correlationID := warmPoolCorrelationPrefix + namespace
+	resourceProfile := s.resolveRunResourceProfile(ctx, target.ProjectID, "")
+
+	if _, err := s.launcher.EnsureNamespace(ctx, NamespaceSpec{
+		RunID:           runID,
+		ProjectID:       target.ProjectID,
+		CorrelationID:   correlationID,
+		RuntimeMode:     agentdomain.RuntimeModeFullEnv,
+		Namespace:       namespace,
+		ResourceProfile: resourceProfile.Profile,
+		WarmPool:        true,
+	}); err != nil {
+		return WarmPoolNamespace{}, fmt.Errorf("ensure warm pool namespace %s: %w", namespace, err)
+	}
+
+	payload, err := json.Marshal(querytypes.RunRuntimePayload{
+		Project:    &querytypes.RunRuntimeProject{ServicesYAML: strings.TrimSpace(target.ServicesYAMLPath)},
+		Repository: &querytypes.RunRuntimeRepository{FullName: strings.TrimSpace(target.RepositoryFullName)},
+		Runtime: &querytypes.RunRuntimeProfile{
+			Mode:       string(agentdomain.RuntimeModeFullEnv),
+			TargetEnv:  warmPoolTargetEnv,
+			Namespace:  namespace,
+			BuildRef:   strings.TrimSpace(target.BuildRef),
+			DeployOnly: true,
+			WarmPool:   true,
+		},
+	})
+	if err != nil {
+		return WarmPoolNamespace{}, fmt.Errorf("marshal warm pool deploy payload: %w", err)
+	}
+	if err := s.runs.CreateWarmPoolDeploy(ctx, runqueuerepo.CreateWarmPoolDeployParams{
+		RunID:         runID,
+		CorrelationID: correlationID,
+		ProjectID:     target.ProjectID,
+		RunPayload:    payload,
+	}); err != nil {
+		if _, deleteErr := s.launcher.DeleteWarmPoolNamespace(ctx, namespace); deleteErr != nil {
+			s.logger.Warn("delete warm pool namespace after deploy enqueue error failed", "namespace", namespace, "err", deleteErr)
+		}
+		return WarmPoolNamespace{}, err
+	}
+
+	s.logger.Info("warm pool namespace created", "project_id", target.ProjectID, "namespace", namespace, "deploy_run_id", runID, "build_ref", target.BuildRef)
+	return WarmPoolNamespace{
+		Namespace:   namespace,
+		ProjectID:   target.ProjectID,
+		State:       WarmPoolNamespaceStatePreparing,
+		DeployRunID: runID,
+		CreatedAt:   s.now().UTC(),
+	}, nil
+}
+
+func (s *Service) deleteWarmPoolNamespace(ctx context.Context, item WarmPoolNamespace, reason string) {
+	if _, err := s.launcher.DeleteWarmPoolNamespace(ctx, item.Namespace); err != nil {
+		s.logger.Warn("delete warm pool namespace failed", "project_id", item.ProjectID, "namespace", item.Namespace, "reason", reason, "err", err)
+		return
+	}
+	s.logger.Info("warm pool namespace deleted", "project_id", item.ProjectID, "namespace", item.Namespace, "reason", reason)
+}
+
+// claimWarmPoolNamespace hands a ready warm pool namespace over to a fresh full-env run.
+// On hit the regular runtime prepare continues into the claimed namespace, which only rolls out
+// the difference between the default branch and the run build ref.
+func (s *Service) claimWarmPoolNamespace(
+	ctx context.Context,
+	run runqueuerepo.RunningRun,
+	execution valuetypes.RunExecutionContext,
+	prepareParams PrepareRunEnvironmentParams,
+	leaseCtx namespaceLeaseContext,
+	leaseTTL time.Duration,
+	triggerKind string,
+) (valuetypes.RunExecutionContext, PrepareRunEnvironmentParams, error) {
+	if execution.RuntimeMode != agentdomain.RuntimeModeFullEnv || prepareParams.DeployOnly || prepareParams.Namespace != "" {
+		return execution, prepareParams, nil
+	}
+	if prepareParams.TargetEnv != "" && prepareParams.TargetEnv != warmPoolTargetEnv {
+		return execution, prepareParams, nil
+	}
+	settings, err := s.runs.GetProjectSettings(ctx, run.ProjectID)
+	if err != nil {
+		s.logger.Warn("load project settings for warm pool claim failed", "run_id", run.RunID, "project_id", run.ProjectID, "err", err)
+		return execution, prepareParams, nil
+	}
+	if settings.WarmPoolSize <= 0 {
+		return execution, prepareParams, nil
+	}
+
+	namespace, found, err := s.launcher.ClaimWarmPoolNamespace(ctx, NamespaceSpec{
+		RunID:          run.RunID,
+		ProjectID:      run.ProjectID,
+		CorrelationID:  run.CorrelationID,
+		RuntimeMode:    execution.RuntimeMode,
+		IssueNumber:    leaseCtx.IssueNumber,
+		AgentKey:       leaseCtx.AgentKey,
+		LeaseTTL:       leaseTTL,
+		LeaseExpiresAt: s.now().UTC().Add(leaseTTL),
+	})
+	if err != nil {
+		s.logger.Warn("claim warm pool namespace failed", "run_id", run.RunID, "project_id", run.ProjectID, "err", err)
+		found = false
+	}
+	namespace = sanitizeDNSLabelValue(namespace)
+
+	eventType := floweventdomain.EventTypeRunNamespaceWarmPoolMiss
+	if found && namespace != "" {
+		eventType = floweventdomain.EventTypeRunNamespaceWarmPoolHit
+		execution.Namespace = namespace
+		prepareParams.Namespace = namespace
+	} else {
+		namespace = ""
+	}
+	recordWarmPoolClaim(namespace != "")
+
+	if err := s.insertEvent(ctx, floweventrepo.InsertParams{
+		CorrelationID: run.CorrelationID,
+		ActorType:     floweventdomain.ActorTypeSystem,
+		ActorID:       floweventdomain.ActorID(s.cfg.WorkerID),
+		EventType:     eventType,
+		Payload: encodeWarmPoolEventPayload(warmPoolEventPayload{
+			RunID:       run.RunID,
+			ProjectID:   run.ProjectID,
+			Namespace:   namespace,
+			IssueNumber: leaseCtx.IssueNumber,
+			AgentKey:    leaseCtx.AgentKey,
+			TriggerKind: triggerKind,
+		}),
+		CreatedAt: s.now().UTC(),
+	}); err != nil {
+		return execution, prepareParams, err
+	}
+	return execution, prepareParams, nil
+}
+
+// completeWarmPoolDeploy flips warm namespace to ready after its deploy-only run succeeded, or drops it on failure.
+func (s *Service) completeWarmPoolDeploy(ctx context.Context, run runqueuerepo.RunningRun, status rundomain.Status) {
+	payload := parseRunRuntimePayload(run.RunPayload)
+	if !isWarmPoolDeployPayload(payload) {
+		return
+	}
+	namespace := sanitizeDNSLabelValue(payload.Runtime.Namespace)
+	if namespace == "" {
+		return
+	}
+	if status == rundomain.StatusSucceeded {
+		if err := s.launcher.MarkWarmPoolNamespaceReady(ctx, namespace, payload.Runtime.BuildRef); err != nil {
+			s.logger.Warn("mark warm pool namespace ready failed", "run_id", run.RunID, "namespace", namespace, "err", err)
+		}
+		return
+	}
+	s.deleteWarmPoolNamespace(ctx, WarmPoolNamespace{Namespace: namespace, ProjectID: run.ProjectID}, "deploy_"+string(status))
+}
+
+func isWarmPoolDeployPayload(payload querytypes.RunRuntimePayload) bool {
+	return payload.Runtime != nil && payload.Runtime.WarmPool && payload.Runtime.DeployOnly
+}
+
+// buildWarmPoolNamespace composes DNS-safe warm pool namespace name; issue part is absent until claim.
+func buildWarmPoolNamespace(prefix string, projectID string, runID string) string {
+	basePrefix := sanitizeDNSLabelValue(prefix)
+	if basePrefix == "" {
+		basePrefix = defaultRunNamespacePrefix
+	}
+	projectPart := compactIdentifier(projectID, 12)
+	if projectPart == "" {
+		projectPart = "project"
+	}
+	candidate := sanitizeDNSLabelValue(fmt.Sprintf("%s-%s-w%s", basePrefix, projectPart, compactIdentifier(runID, 12)))
+	if len(candidate) > 63 {
+		candidate = strings.TrimRight(candidate[:63], "-")
+	}
+	return candidate
+}
+
+func encodeWarmPoolEventPayload(payload warmPoolEventPayload) json.RawMessage {
+	bytes, err := json.Marshal(payload)
+	return marshalPayload(bytes, err)
+}
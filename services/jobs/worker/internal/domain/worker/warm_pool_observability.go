@@ -0,0 +1,57 @@
+package worker
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	warmPoolClaimResultHit  = "hit"
+	warmPoolClaimResultMiss = "miss"
+)
+
+var (
+	warmPoolClaimsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kodex_warm_pool_claims_total",
+			Help: "Total number of full-env run namespace claims from project warm pools grouped by result.",
+		},
+		[]string{"result"},
+	)
+
+	warmPoolNamespaces = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "kodex_warm_pool_namespaces",
+			Help: "Current number of unclaimed warm pool namespaces grouped by state.",
+		},
+		[]string{"state"},
+	)
+)
+
+func init() {
+	warmPoolClaimsTotal.WithLabelValues(warmPoolClaimResultHit)
+	warmPoolClaimsTotal.WithLabelValues(warmPoolClaimResultMiss)
+	warmPoolNamespaces.WithLabelValues(string(WarmPoolNamespaceStatePreparing))
+	warmPoolNamespaces.WithLabelValues(string(WarmPoolNamespaceStateReady))
+}
+
+func recordWarmPoolClaim(hit bool) {
+	if hit {
+		warmPoolClaimsTotal.WithLabelValues(warmPoolClaimResultHit).Inc()
+		return
+	}
+	warmPoolClaimsTotal.WithLabelValues(warmPoolClaimResultMiss).Inc()
+}
+
+func setWarmPoolNamespaceCounts(items []WarmPoolNamespace) {
+	ready, preparing := 0, 0
+	for _, item := range items {
+		if item.State == WarmPoolNamespaceStateReady {
+			ready++
+			continue
+		}
+		preparing++
+	}
+	warmPoolNamespaces.WithLabelValues(string(WarmPoolNamespaceStateReady)).Set(float64(ready))
+	warmPoolNamespaces.WithLabelValues(string(WarmPoolNamespaceStatePreparing)).Set(float64(preparing))
+}
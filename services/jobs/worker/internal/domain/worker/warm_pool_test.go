@@ -0,0 +1,204 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	agentdomain "github.com/codex-k8s/kodex/libs/go/domain/agent"
+	floweventdomain "github.com/codex-k8s/kodex/libs/go/domain/flowevent"
+	rundomain "github.com/codex-k8s/kodex/libs/go/domain/run"
+	runqueuerepo "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/repository/runqueue"
+	querytypes "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/types/query"
+	valuetypes "github.com/codex-k8s/kodex/services/jobs/worker/internal/domain/types/value"
+)
+
+func TestReconcileWarmPool_ReplenishesAndEvicts(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	runs := &fakeRunQueue{
+		warmPoolTargets: []runqueuerepo.WarmPoolTarget{{
+			ProjectID:          "project-1",
+			PoolSize:           2,
+			RepositoryFullName: "acme/app",
+			ServicesYAMLPath:   "services.yaml",
+			BuildRef:           "main",
+		}},
+	}
+	launcher := &fakeLauncher{
+		warmPoolNamespaces: []WarmPoolNamespace{
+			{Namespace: "codex-issue-project1-wold", ProjectID: "project-1", State: WarmPoolNamespaceStateReady, CreatedAt: now.Add(-13 * time.Hour)},
+			{Namespace: "codex-issue-project1-wok", ProjectID: "project-1", State: WarmPoolNamespaceStateReady, CreatedAt: now.Add(-time.Hour)},
+			{Namespace: "codex-issue-project2-wgone", ProjectID: "project-2", State: WarmPoolNamespaceStatePreparing, CreatedAt: now},
+		},
+	}
+	svc := NewService(Config{WorkerID: "worker-1", WarmPoolMaxAge: 12 * time.Hour}, Dependencies{
+		Runs:     runs,
+		Launcher: launcher,
+	})
+	svc.now = func() time.Time { return now }
+
+	if err := svc.reconcileWarmPool(context.Background()); err != nil {
+		t.Fatalf("reconcileWarmPool() error = %v", err)
+	}
+
+	if got := strings.Join(launcher.warmPoolDeleted, ","); got != "codex-issue-project1-wold,codex-issue-project2-wgone" {
+		t.Fatalf("deleted warm namespaces = %q", got)
+	}
+	if len(runs.warmPoolDeploys) != 1 {
+		t.Fatalf("expected one warm pool deploy run, got %d", len(runs.warmPoolDeploys))
+	}
+	deploy := runs.warmPoolDeploys[0]
+	payload := parseRunRuntimePayload(deploy.RunPayload)
+	if !isWarmPoolDeployPayload(payload) || payload.Runtime.BuildRef != "main" || payload.Runtime.TargetEnv != "ai" {
+		t.Fatalf("unexpected warm pool deploy payload: %s", deploy.RunPayload)
+	}
+	if deploy.CorrelationID != warmPoolCorrelationPrefix+payload.Runtime.Namespace {
+		t.Fatalf("unexpected correlation id %q for namespace %q", deploy.CorrelationID, payload.Runtime.Namespace)
+	}
+	if len(launcher.prepared) != 1 || !launcher.prepared[0].WarmPool || launcher.prepared[0].RunID != deploy.RunID {
+		t.Fatalf("unexpected warm namespace spec: %+v", launcher.prepared)
+	}
+
+	now = now.Add(10 * time.Second)
+	if err := svc.reconcileWarmPool(context.Background()); err != nil {
+		t.Fatalf("throttled reconcileWarmPool() error = %v", err)
+	}
+	if len(runs.warmPoolDeploys) != 1 {
+		t.Fatalf("throttled reconcile must not create deploy runs, got %d", len(runs.warmPoolDeploys))
+	}
+}
+
+func TestReconcileWarmPool_CountsInFlightDeploysAndKeepsPreparing(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	runs := &fakeRunQueue{
+		warmPoolTargets: []runqueuerepo.WarmPoolTarget{{
+			ProjectID:       "project-1",
+			PoolSize:        2,
+			BuildRef:        "main",
+			InFlightDeploys: 2,
+		}},
+	}
+	launcher := &fakeLauncher{
+		warmPoolNamespaces: []WarmPoolNamespace{
+			{Namespace: "codex-issue-project1-wready", ProjectID: "project-1", State: WarmPoolNamespaceStateReady, CreatedAt: now.Add(-time.Hour)},
+			{Namespace: "codex-issue-project1-wprep1", ProjectID: "project-1", State: WarmPoolNamespaceStatePreparing, CreatedAt: now},
+			{Namespace: "codex-issue-project1-wprep2", ProjectID: "project-1", State: WarmPoolNamespaceStatePreparing, CreatedAt: now},
+		},
+	}
+	svc := NewService(Config{WorkerID: "worker-1", WarmPoolMaxAge: 12 * time.Hour}, Dependencies{
+		Runs:     runs,
+		Launcher: launcher,
+	})
+	svc.now = func() time.Time { return now }
+
+	if err := svc.reconcileWarmPool(context.Background()); err != nil {
+		t.Fatalf("reconcileWarmPool() error = %v", err)
+	}
+	if got := strings.Join(launcher.warmPoolDeleted, ","); got != "codex-issue-project1-wready" {
+		t.Fatalf("surplus trim must delete only ready namespaces, deleted %q", got)
+	}
+	if len(runs.warmPoolDeploys) != 0 {
+		t.Fatalf("in-flight deploys fill the pool, got %d new deploy runs", len(runs.warmPoolDeploys))
+	}
+	if runs.warmPoolReleases != 1 {
+		t.Fatalf("warm pool lock releases = %d, want 1", runs.warmPoolReleases)
+	}
+}
+
+func TestReconcileWarmPool_SkipsWhenAnotherReplicaHoldsLock(t *testing.T) {
+	t.Parallel()
+
+	runs := &fakeRunQueue{
+		warmPoolTargets:  []runqueuerepo.WarmPoolTarget{{ProjectID: "project-1", PoolSize: 1, BuildRef: "main"}},
+		warmPoolLockHeld: true,
+	}
+	launcher := &fakeLauncher{}
+	svc := NewService(Config{WorkerID: "worker-1"}, Dependencies{Runs: runs, Launcher: launcher})
+
+	if err := svc.reconcileWarmPool(context.Background()); err != nil {
+		t.Fatalf("reconcileWarmPool() error = %v", err)
+	}
+	if len(runs.warmPoolDeploys) != 0 || len(launcher.prepared) != 0 {
+		t.Fatalf("locked reconcile must not create warm namespaces: deploys=%d prepared=%d", len(runs.warmPoolDeploys), len(launcher.prepared))
+	}
+}
+
+func TestClaimWarmPoolNamespace(t *testing.T) {
+	t.Parallel()
+
+	runs := &fakeRunQueue{projectSettings: map[string]runqueuerepo.ProjectSettings{"project-1": {WarmPoolSize: 1}}}
+	launcher := &fakeLauncher{
+		warmPoolNamespaces: []WarmPoolNamespace{
+			{Namespace: "codex-issue-project1-w1", ProjectID: "project-1", State: WarmPoolNamespaceStateReady},
+		},
+	}
+	events := &fakeFlowEvents{}
+	svc := NewService(Config{WorkerID: "worker-1"}, Dependencies{Runs: runs, Launcher: launcher, Events: events})
+
+	run := runqueuerepo.RunningRun{RunID: "run-1", CorrelationID: "corr-1", ProjectID: "project-1"}
+	execution := valuetypes.RunExecutionContext{RuntimeMode: agentdomain.RuntimeModeFullEnv, Namespace: "codex-issue-project1-i7-rrun1"}
+	prepareParams := PrepareRunEnvironmentParams{RunID: "run-1", RuntimeMode: "full-env", TargetEnv: "ai"}
+	leaseCtx := namespaceLeaseContext{AgentKey: "dev", IssueNumber: 7}
+
+	gotExecution, gotParams, err := svc.claimWarmPoolNamespace(context.Background(), run, execution, prepareParams, leaseCtx, time.Hour, "dev")
+	if err != nil {
+		t.Fatalf("claimWarmPoolNamespace() error = %v", err)
+	}
+	if gotExecution.Namespace != "codex-issue-project1-w1" || gotParams.Namespace != "codex-issue-project1-w1" {
+		t.Fatalf("warm pool hit must switch namespace: execution=%q params=%q", gotExecution.Namespace, gotParams.Namespace)
+	}
+
+	gotExecution, gotParams, err = svc.claimWarmPoolNamespace(context.Background(), run, execution, prepareParams, leaseCtx, time.Hour, "dev")
+	if err != nil {
+		t.Fatalf("claimWarmPoolNamespace(miss) error = %v", err)
+	}
+	if gotExecution.Namespace != execution.Namespace || gotParams.Namespace != "" {
+		t.Fatalf("warm pool miss must keep default namespace: execution=%q params=%q", gotExecution.Namespace, gotParams.Namespace)
+	}
+
+	if len(events.inserted) != 2 ||
+		events.inserted[0].EventType != floweventdomain.EventTypeRunNamespaceWarmPoolHit ||
+		events.inserted[1].EventType != floweventdomain.EventTypeRunNamespaceWarmPoolMiss {
+		t.Fatalf("unexpected warm pool events: %+v", events.inserted)
+	}
+
+	runs.projectSettings = nil
+	if _, gotParams, _ = svc.claimWarmPoolNamespace(context.Background(), run, execution, prepareParams, leaseCtx, time.Hour, "dev"); gotParams.Namespace != "" || len(events.inserted) != 2 {
+		t.Fatal("projects without warm pool must not try to claim")
+	}
+}
+
+func TestCompleteWarmPoolDeploy(t *testing.T) {
+	t.Parallel()
+
+	payload, err := json.Marshal(querytypes.RunRuntimePayload{Runtime: &querytypes.RunRuntimeProfile{
+		Mode:       "full-env",
+		Namespace:  "codex-issue-project1-w1",
+		BuildRef:   "main",
+		DeployOnly: true,
+		WarmPool:   true,
+	}})
+	if err != nil {
+		t.Fatalf("marshal payload: %v", err)
+	}
+	launcher := &fakeLauncher{}
+	svc := NewService(Config{WorkerID: "worker-1"}, Dependencies{Launcher: launcher})
+	run := runqueuerepo.RunningRun{RunID: "deploy-1", ProjectID: "project-1", RunPayload: payload}
+
+	svc.completeWarmPoolDeploy(context.Background(), run, rundomain.StatusSucceeded)
+	svc.completeWarmPoolDeploy(context.Background(), run, rundomain.StatusFailed)
+	svc.completeWarmPoolDeploy(context.Background(), runqueuerepo.RunningRun{RunID: "regular"}, rundomain.StatusFailed)
+
+	if len(launcher.warmPoolReady) != 1 || launcher.warmPoolReady[0] != "codex-issue-project1-w1" {
+		t.Fatalf("ready warm namespaces = %v", launcher.warmPoolReady)
+	}
+	if len(launcher.warmPoolDeleted) != 1 || launcher.warmPoolDeleted[0] != "codex-issue-project1-w1" {
+		t.Fatalf("deleted warm namespaces = %v", launcher.warmPoolDeleted)
+	}
+}
@@ -25,6 +25,8 @@ var (
 	queryClaimNextPendingForUpdate string
 	//go:embed sql/create_pending_resume_if_absent.sql
 	queryCreatePendingResumeIfAbsent string
+	//go:embed sql/create_pending_retry_if_absent.sql
+	queryCreatePendingRetryIfAbsent string
 	//go:embed sql/get_run_id_by_correlation_id.sql
 	queryGetRunIDByCorrelationID string
 	//go:embed sql/upsert_project.sql
@@ -33,6 +35,14 @@ var (
 	queryEnsureProjectExists string
 	//go:embed sql/get_project_settings.sql
 	queryGetProjectSettings string
+	//go:embed sql/list_warm_pool_targets.sql
+	queryListWarmPoolTargets string
+	//go:embed sql/create_warm_pool_deploy.sql
+	queryCreateWarmPoolDeploy string
+	//go:embed sql/try_lock_warm_pool.sql
+	queryTryLockWarmPool string
+	//go:embed sql/unlock_warm_pool.sql
+	queryUnlockWarmPool string
 	//go:embed sql/ensure_project_slots.sql
 	queryEnsureProjectSlots string
 	//go:embed sql/release_expired_slots.sql
@@ -102,6 +112,44 @@ func (r *Repository) CreatePendingResumeIfAbsent(ctx context.Context, params dom
 	return false, fmt.Errorf("source run %s not found for pending resume", sourceRunID)
 }
 
+// CreatePendingRetryIfAbsent inserts one delayed pending retry run derived from a failed source run.
+// Result.Created is false when retry already exists or source run exhausted max attempts.
+func (r *Repository) CreatePendingRetryIfAbsent(ctx context.Context, params domainrepo.CreatePendingRetryParams) (domainrepo.CreatePendingRetryResult, error) {
+	sourceRunID := strings.TrimSpace(params.SourceRunID)
+	if sourceRunID == "" {
+		return domainrepo.CreatePendingRetryResult{}, fmt.Errorf("create pending retry run: source_run_id is required")
+	}
+	correlationID := strings.TrimSpace(params.CorrelationID)
+	if correlationID == "" {
+		return domainrepo.CreatePendingRetryResult{}, fmt.Errorf("create pending retry run: correlation_id is required")
+	}
+	if params.MaxAttempts <= 1 {
+		return domainrepo.CreatePendingRetryResult{}, nil
+	}
+	notBefore := params.NotBefore.UTC()
+	if params.NotBefore.IsZero() {
+		notBefore = time.Now().UTC()
+	}
+
+	var attempt int32
+	err := r.db.QueryRow(
+		ctx,
+		queryCreatePendingRetryIfAbsent,
+		uuid.NewString(),
+		sourceRunID,
+		correlationID,
+		params.MaxAttempts,
+		notBefore,
+	).Scan(&attempt)
+	if err == nil {
+		return domainrepo.CreatePendingRetryResult{Created: true, Attempt: int(attempt)}, nil
+	}
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domainrepo.CreatePendingRetryResult{}, nil
+	}
+	return domainrepo.CreatePendingRetryResult{}, fmt.Errorf("insert pending retry run: %w", err)
+}
+
 // ClaimNextPending atomically claims one pending run and optionally leases a slot.
 func (r *Repository) ClaimNextPending(ctx context.Context, params domainrepo.ClaimParams) (domainrepo.ClaimedRun, bool, error) {
 	workerID := strings.TrimSpace(params.WorkerID)
@@ -130,14 +178,16 @@ func (r *Repository) ClaimNextPending(ctx context.Context, params domainrepo.Cla
 		projectIDRaw  pgtype.Text
 		learningMode  bool
 		runPayload    []byte
+		attempt       int32
 	)
 
-	err = tx.QueryRow(ctx, queryClaimNextPendingForUpdate).Scan(
+	err = tx.QueryRow(ctx, queryClaimNextPendingForUpdate, params.SlotsPerProject).Scan(
 		&runID,
 		&correlationID,
 		&projectIDRaw,
 		&learningMode,
 		&runPayload,
+		&attempt,
 	)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -218,6 +268,7 @@ func (r *Repository) ClaimNextPending(ctx context.Context, params domainrepo.Cla
 		RunPayload:    json.RawMessage(runPayload),
 		SlotNo:        slotNo,
 		SlotID:        slotID,
+		Attempt:       int(attempt),
 	}, true, nil
 }
 
@@ -431,7 +482,7 @@ func (r *Repository) FinishRun(ctx context.Context, params domainrepo.FinishPara
 		_ = tx.Rollback(ctx)
 	}()
 
-	res, err := tx.Exec(ctx, queryMarkRunFinished, params.RunID, string(params.Status), params.FinishedAt.UTC(), leaseOwner)
+	res, err := tx.Exec(ctx, queryMarkRunFinished, params.RunID, string(params.Status), params.FinishedAt.UTC(), leaseOwner, string(params.FailureClass))
 	if err != nil {
 		return false, fmt.Errorf("mark run %s as %s: %w", params.RunID, params.Status, err)
 	}
@@ -470,8 +521,9 @@ func scanRunningRows(rows pgx.Rows, limit int) ([]domainrepo.RunningRun, error)
 			runPayload               []byte
 			startedAt                pgtype.Timestamptz
 			reclaimedAfterStaleLease bool
+			attempt                  int32
 		)
-		if err := rows.Scan(&runID, &correlationID, &projectID, &slotID, &slotNo, &learningMode, &runPayload, &startedAt, &reclaimedAfterStaleLease); err != nil {
+		if err := rows.Scan(&runID, &correlationID, &projectID, &slotID, &slotNo, &learningMode, &runPayload, &startedAt, &reclaimedAfterStaleLease, &attempt); err != nil {
 			return nil, fmt.Errorf("scan running run row: %w", err)
 		}
 		item := domainrepo.RunningRun{
@@ -483,6 +535,7 @@ func scanRunningRows(rows pgx.Rows, limit int) ([]domainrepo.RunningRun, error)
 			LearningMode:             learningMode,
 			RunPayload:               json.RawMessage(runPayload),
 			ReclaimedAfterStaleLease: reclaimedAfterStaleLease,
+			Attempt:                  int(attempt),
 		}
 		if startedAt.Valid {
 			item.StartedAt = startedAt.Time.UTC()
@@ -517,6 +570,94 @@ func parseRunQueuePayload(raw []byte) querytypes.RunQueuePayload {
 	return payload
 }
 
+// GetProjectSettings returns decoded project settings used by run launch policies.
+func (r *Repository) GetProjectSettings(ctx context.Context, projectID string) (domainrepo.ProjectSettings, error) {
+	projectID = strings.TrimSpace(projectID)
+	if projectID == "" {
+		return domainrepo.ProjectSettings{}, nil
+	}
+
+	var settingsRaw []byte
+	if err := r.db.QueryRow(ctx, queryGetProjectSettings, projectID).Scan(&settingsRaw); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domainrepo.ProjectSettings{}, nil
+		}
+		return domainrepo.ProjectSettings{}, fmt.Errorf("get project settings %s: %w", projectID, err)
+	}
+	if len(settingsRaw) == 0 {
+		return domainrepo.ProjectSettings{}, nil
+	}
+
+	var settings domainrepo.ProjectSettings
+	if err := json.Unmarshal(settingsRaw, &settings); err != nil {
+		return domainrepo.ProjectSettings{}, fmt.Errorf("decode project settings %s: %w", projectID, err)
+	}
+	return settings, nil
+}
+
+// ListWarmPoolTargets returns projects with positive warm pool size and their primary repository binding.
+func (r *Repository) ListWarmPoolTargets(ctx context.Context) ([]domainrepo.WarmPoolTarget, error) {
+	rows, err := r.db.Query(ctx, queryListWarmPoolTargets)
+	if err != nil {
+		return nil, fmt.Errorf("list warm pool targets: %w", err)
+	}
+	defer rows.Close()
+
+	var items []domainrepo.WarmPoolTarget
+	for rows.Next() {
+		var item domainrepo.WarmPoolTarget
+		if err := rows.Scan(&item.ProjectID, &item.PoolSize, &item.RepositoryFullName, &item.ServicesYAMLPath, &item.BuildRef, &item.InFlightDeploys); err != nil {
+			return nil, fmt.Errorf("scan warm pool target row: %w", err)
+		}
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate warm pool targets: %w", err)
+	}
+	return items, nil
+}
+
+// CreateWarmPoolDeploy inserts one pending deploy-only run preparing a warm pool namespace.
+func (r *Repository) CreateWarmPoolDeploy(ctx context.Context, params domainrepo.CreateWarmPoolDeployParams) error {
+	runID := strings.TrimSpace(params.RunID)
+	correlationID := strings.TrimSpace(params.CorrelationID)
+	projectID := strings.TrimSpace(params.ProjectID)
+	if runID == "" || correlationID == "" || projectID == "" {
+		return fmt.Errorf("create warm pool deploy run: run_id, correlation_id and project_id are required")
+	}
+	if _, err := r.db.Exec(ctx, queryCreateWarmPoolDeploy, runID, correlationID, projectID, params.RunPayload); err != nil {
+		return fmt.Errorf("insert warm pool deploy run: %w", err)
+	}
+	return nil
+}
+
+// TryLockWarmPool takes the session-level advisory lock serializing warm pool reconcile across worker replicas.
+func (r *Repository) TryLockWarmPool(ctx context.Context) (func(), bool, error) {
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("acquire warm pool lock connection: %w", err)
+	}
+	var locked bool
+	if err := conn.QueryRow(ctx, queryTryLockWarmPool).Scan(&locked); err != nil {
+		conn.Release()
+		return nil, false, fmt.Errorf("try warm pool lock: %w", err)
+	}
+	if !locked {
+		conn.Release()
+		return nil, false, nil
+	}
+	release := func() {
+		var unlocked bool
+		if err := conn.QueryRow(context.Background(), queryUnlockWarmPool).Scan(&unlocked); err != nil || !unlocked {
+			// Closing the session drops the advisory lock, so a failed unlock cannot leak it into the pool.
+			_ = conn.Hijack().Close(context.Background())
+			return
+		}
+		conn.Release()
+	}
+	return release, true, nil
+}
+
 func (r *Repository) getProjectSettingsJSON(ctx context.Context, tx pgx.Tx, projectID string) ([]byte, error) {
 	var settingsRaw []byte
 	err := tx.QueryRow(ctx, queryGetProjectSettings, projectID).Scan(&settingsRaw)
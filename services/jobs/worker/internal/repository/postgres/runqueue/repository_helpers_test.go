@@ -1,6 +1,7 @@
 package runqueue
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/google/uuid"
@@ -183,3 +184,42 @@ func TestResolveSlotsPerProject(t *testing.T) {
 		})
 	}
 }
+
+func TestClaimNextPendingQueryOrdersByPriorityAndFairShare(t *testing.T) {
+	t.Parallel()
+
+	for _, fragment := range []string{
+		"ar.priority DESC",
+		"queue_weight",
+		"FOR UPDATE OF ar SKIP LOCKED",
+		"s.state = 'free'",
+	} {
+		if !strings.Contains(queryClaimNextPendingForUpdate, fragment) {
+			t.Fatalf("claim query must contain %q", fragment)
+		}
+	}
+	if !strings.Contains(queryCreatePendingResumeIfAbsent, "source_run.priority") {
+		t.Fatal("resume run must inherit source run priority")
+	}
+}
+
+func TestRetryQueriesRespectAttemptsAndBackoff(t *testing.T) {
+	t.Parallel()
+
+	if !strings.Contains(queryClaimNextPendingForUpdate, "ar.not_before <= NOW()") {
+		t.Fatal("claim query must skip retry runs before not_before")
+	}
+	for _, fragment := range []string{
+		"attempt < $4",
+		"source_run.attempt + 1",
+		"source_run.run_payload",
+		"source_run.priority",
+	} {
+		if !strings.Contains(queryCreatePendingRetryIfAbsent, fragment) {
+			t.Fatalf("retry query must contain %q", fragment)
+		}
+	}
+	if !strings.Contains(queryMarkRunFinished, "failure_class") {
+		t.Fatal("finish query must persist failure_class")
+	}
+}
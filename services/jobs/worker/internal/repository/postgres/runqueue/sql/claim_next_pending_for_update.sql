@@ -1,7 +1,60 @@
 -- name: runqueue__claim_next_pending_for_update :one
-SELECT id, correlation_id, project_id, learning_mode, run_payload
-FROM agent_runs
-WHERE status = 'pending'
-ORDER BY created_at ASC
-FOR UPDATE SKIP LOCKED
+-- Pick the highest-priority pending run. Within one priority, prefer the project with the lowest
+-- weighted running share (running runs / queue_weight), then the oldest run.
+-- Slot-bound runs of projects without a free slot are skipped so they do not block other projects.
+-- Retry runs stay invisible until their backoff deadline (not_before) passes.
+WITH running AS (
+    SELECT project_id, COUNT(*) AS running_count
+    FROM agent_runs
+    WHERE status = 'running'
+      AND project_id IS NOT NULL
+    GROUP BY project_id
+),
+project_queue AS (
+    SELECT
+        p.id AS project_id,
+        CASE
+            WHEN jsonb_typeof(p.settings->'queue_weight') = 'number'
+                 AND (p.settings->>'queue_weight')::numeric > 0
+                THEN (p.settings->>'queue_weight')::numeric
+            ELSE 1
+        END AS queue_weight,
+        CASE
+            WHEN jsonb_typeof(p.settings->'slots_per_project') = 'number'
+                 AND (p.settings->>'slots_per_project')::numeric >= 1
+                THEN (p.settings->>'slots_per_project')::numeric::int
+            ELSE GREATEST($1::int, 1)
+        END AS slots_limit
+    FROM projects p
+)
+SELECT ar.id, ar.correlation_id, ar.project_id, ar.learning_mode, ar.run_payload, ar.attempt
+FROM agent_runs ar
+LEFT JOIN project_queue pq ON pq.project_id = ar.project_id
+LEFT JOIN running r ON r.project_id = ar.project_id
+WHERE ar.status = 'pending'
+  AND (ar.not_before IS NULL OR ar.not_before <= NOW())
+  AND (
+        ar.project_id IS NULL
+        OR pq.project_id IS NULL
+        OR COALESCE(ar.run_payload->'runtime'->>'deploy_only', '') = 'true'
+        OR (
+            jsonb_typeof(ar.run_payload->'runtime') = 'object'
+            AND lower(btrim(COALESCE(ar.run_payload->'runtime'->>'mode', ''))) <> 'full-env'
+        )
+        OR EXISTS (
+            SELECT 1
+            FROM slots s
+            WHERE s.project_id = ar.project_id
+              AND (
+                    s.state = 'free'
+                    OR (s.state = 'leased' AND s.lease_until IS NOT NULL AND s.lease_until < NOW())
+              )
+        )
+        OR (SELECT COUNT(*) FROM slots s WHERE s.project_id = ar.project_id) < pq.slots_limit
+  )
+ORDER BY
+    ar.priority DESC,
+    COALESCE(r.running_count, 0)::numeric / COALESCE(pq.queue_weight, 1) ASC,
+    ar.created_at ASC
+FOR UPDATE OF ar SKIP LOCKED
 LIMIT 1;
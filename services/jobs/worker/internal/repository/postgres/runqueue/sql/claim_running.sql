@@ -29,6 +29,7 @@ claimed AS (
               r.learning_mode,
               r.run_payload,
               r.started_at,
+              r.attempt,
               c.stale_reclaim_pending
 )
 SELECT c.id,
@@ -39,7 +40,8 @@ SELECT c.id,
        c.learning_mode,
        c.run_payload,
        c.started_at,
-       c.stale_reclaim_pending
+       c.stale_reclaim_pending,
+       c.attempt
 FROM claimed AS c
 LEFT JOIN slots AS s
   ON s.project_id = c.project_id
@@ -1,6 +1,6 @@
 -- name: runqueue__create_pending_resume_if_absent :one
 WITH source_run AS (
-    SELECT project_id, agent_id, run_payload, learning_mode
+    SELECT project_id, agent_id, run_payload, learning_mode, priority
     FROM agent_runs
     WHERE id = $2
 )
@@ -11,7 +11,8 @@ INSERT INTO agent_runs (
     agent_id,
     status,
     run_payload,
-    learning_mode
+    learning_mode,
+    priority
 )
 SELECT
     $1,
@@ -20,7 +21,8 @@ SELECT
     source_run.agent_id,
     'pending',
     source_run.run_payload,
-    source_run.learning_mode
+    source_run.learning_mode,
+    source_run.priority
 FROM source_run
 ON CONFLICT (correlation_id) DO NOTHING
 RETURNING id;
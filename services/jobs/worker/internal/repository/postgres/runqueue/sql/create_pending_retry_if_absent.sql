@@ -0,0 +1,35 @@
+-- name: runqueue__create_pending_retry_if_absent :one
+WITH source_run AS (
+    SELECT project_id, agent_id, run_payload, learning_mode, priority, attempt
+    FROM agent_runs
+    WHERE id = $2
+      AND attempt < $4
+)
+INSERT INTO agent_runs (
+    id,
+    correlation_id,
+    project_id,
+    agent_id,
+    status,
+    run_payload,
+    learning_mode,
+    priority,
+    attempt,
+    retry_of_run_id,
+    not_before
+)
+SELECT
+    $1,
+    $3,
+    source_run.project_id,
+    source_run.agent_id,
+    'pending',
+    source_run.run_payload,
+    source_run.learning_mode,
+    source_run.priority,
+    source_run.attempt + 1,
+    $2,
+    $5
+FROM source_run
+ON CONFLICT DO NOTHING
+RETURNING attempt;
@@ -0,0 +1,11 @@
+-- name: runqueue__create_warm_pool_deploy :exec
+INSERT INTO agent_runs (
+    id,
+    correlation_id,
+    project_id,
+    status,
+    run_payload,
+    learning_mode
+)
+VALUES ($1, $2, $3::uuid, 'pending', $4::jsonb, false)
+ON CONFLICT DO NOTHING;
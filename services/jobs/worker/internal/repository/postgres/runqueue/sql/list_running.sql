@@ -7,7 +7,8 @@ SELECT r.id,
        r.learning_mode,
        r.run_payload,
        r.started_at,
-       FALSE AS stale_reclaim_pending
+       FALSE AS stale_reclaim_pending,
+       r.attempt
 FROM agent_runs AS r
 LEFT JOIN slots AS s
   ON s.project_id = r.project_id
@@ -0,0 +1,25 @@
+-- name: runqueue__list_warm_pool_targets :many
+SELECT
+    p.id::text,
+    (p.settings->>'warm_pool_size')::numeric::int AS warm_pool_size,
+    r.owner || '/' || r.name AS repository_full_name,
+    r.services_yaml_path,
+    r.default_ref,
+    (
+        SELECT COUNT(*)
+        FROM agent_runs ar
+        WHERE ar.project_id = p.id
+          AND ar.correlation_id LIKE 'warm-pool:%'
+          AND ar.status IN ('pending', 'running')
+    )::int AS in_flight_deploys
+FROM projects p
+JOIN LATERAL (
+    SELECT owner, name, services_yaml_path, default_ref
+    FROM repositories
+    WHERE project_id = p.id
+    ORDER BY (role = 'orchestrator') DESC, created_at, id
+    LIMIT 1
+) r ON TRUE
+WHERE jsonb_typeof(p.settings->'warm_pool_size') = 'number'
+  AND (p.settings->>'warm_pool_size')::numeric >= 1
+ORDER BY p.id;
@@ -2,6 +2,7 @@
 UPDATE agent_runs
 SET status = $2,
     finished_at = $3,
+    failure_class = NULLIF($5, ''),
     lease_owner = NULL,
     lease_until = NULL,
     updated_at = NOW()
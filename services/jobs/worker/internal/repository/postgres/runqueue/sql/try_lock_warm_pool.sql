@@ -0,0 +1,2 @@
+-- name: runqueue__try_lock_warm_pool :one
+SELECT pg_try_advisory_lock(hashtext('kodex.worker.warm_pool'));
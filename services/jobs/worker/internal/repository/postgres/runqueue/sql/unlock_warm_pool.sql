@@ -0,0 +1,2 @@
+-- name: runqueue__unlock_warm_pool :one
+SELECT pg_advisory_unlock(hashtext('kodex.worker.warm_pool'));
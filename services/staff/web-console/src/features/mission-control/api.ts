@@ -2,7 +2,10 @@ import {
   getMissionControlNode as getMissionControlNodeRequest,
   getMissionControlWorkspace as getMissionControlWorkspaceRequest,
   listMissionControlNodeActivity as listMissionControlNodeActivityRequest,
+  listMissionControlVoiceCandidates as listMissionControlVoiceCandidatesRequest,
   previewMissionControlLaunch as previewMissionControlLaunchRequest,
+  promoteMissionControlVoiceCandidate as promoteMissionControlVoiceCandidateRequest,
+  rejectMissionControlVoiceCandidate as rejectMissionControlVoiceCandidateRequest,
 } from "../../shared/api/sdk";
 
 import type {
@@ -10,6 +13,9 @@ import type {
   MissionControlNodeActivityItemsResponse,
   MissionControlNodeDetails,
   MissionControlNodeKind,
+  MissionControlVoiceCandidate,
+  MissionControlVoiceCandidateCommandKind,
+  MissionControlVoiceCandidatePromotion,
   MissionControlWorkspaceSnapshot,
 } from "./types";
 
@@ -94,3 +100,58 @@ export async function previewMissionControlLaunch(params: {
 
   return response.data;
 }
+
+export async function listMissionControlVoiceCandidates(params: {
+  projectId: string;
+  status?: MissionControlVoiceCandidate["status"];
+  limit?: number;
+}): Promise<MissionControlVoiceCandidate[]> {
+  const response = await listMissionControlVoiceCandidatesRequest({
+    query: {
+      project_id: params.projectId,
+      status: params.status,
+      limit: params.limit,
+    },
+    throwOnError: true,
+  });
+
+  return response.data.items ?? [];
+}
+
+export async function promoteMissionControlVoiceCandidate(params: {
+  projectId: string;
+  candidateId: string;
+  commandKind?: MissionControlVoiceCandidateCommandKind;
+  title?: string;
+  bodyMarkdown?: string;
+}): Promise<MissionControlVoiceCandidatePromotion> {
+  const response = await promoteMissionControlVoiceCandidateRequest({
+    path: { candidate_id: params.candidateId },
+    body: {
+      project_id: params.projectId,
+      command_kind: params.commandKind,
+      title: params.title?.trim() || undefined,
+      body_markdown: params.bodyMarkdown?.trim() || undefined,
+    },
+    throwOnError: true,
+  });
+
+  return response.data;
+}
+
+export async function rejectMissionControlVoiceCandidate(params: {
+  projectId: string;
+  candidateId: string;
+  reason?: string;
+}): Promise<MissionControlVoiceCandidate> {
+  const response = await rejectMissionControlVoiceCandidateRequest({
+    path: { candidate_id: params.candidateId },
+    body: {
+      project_id: params.projectId,
+      reason: params.reason?.trim() || undefined,
+    },
+    throwOnError: true,
+  });
+
+  return response.data;
+}
@@ -8,6 +8,7 @@ import type {
   MissionControlNodeRef,
   MissionControlProviderDeepLink,
   MissionControlRelatedNodesSection,
+  MissionControlVoiceCandidateCommandKind,
   MissionControlWorkspaceWatermark,
 } from "./types";
 
@@ -36,6 +37,12 @@ export function missionControlNodeKindLabelKey(kind: MissionControlNodeKind): st
   return `pages.missionControl.nodeKinds.${kind}`;
 }
 
+export function missionControlVoiceCommandKindLabelKey(kind: MissionControlVoiceCandidateCommandKind): string {
+  return kind === "discussion.create"
+    ? "pages.missionControl.voiceCandidates.commandKind.discussion"
+    : "pages.missionControl.voiceCandidates.commandKind.workItem";
+}
+
 export function missionControlStateLabelKey(state: MissionControlNode["active_state"]): string {
   return `pages.missionControl.states.${state}`;
 }
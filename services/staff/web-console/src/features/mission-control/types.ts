@@ -12,6 +12,8 @@ import type {
   MissionControlProviderDeepLink,
   MissionControlRootGroup,
   MissionControlStageNextStepTemplate,
+  MissionControlVoiceCandidate,
+  MissionControlVoiceCandidatePromotion,
   MissionControlWorkspaceSnapshot,
   MissionControlWorkspaceStatePreset,
   MissionControlWorkspaceViewMode,
@@ -33,12 +35,16 @@ export type {
   MissionControlProviderDeepLink,
   MissionControlRootGroup,
   MissionControlStageNextStepTemplate,
+  MissionControlVoiceCandidate,
+  MissionControlVoiceCandidatePromotion,
   MissionControlWorkspaceSnapshot,
   MissionControlWorkspaceStatePreset,
   MissionControlWorkspaceViewMode,
   MissionControlWorkspaceWatermark,
 } from "../../shared/api/generated";
 
+export type MissionControlVoiceCandidateCommandKind = MissionControlVoiceCandidate["command_kind"];
+
 export type MissionControlRouteState = {
   viewMode: MissionControlWorkspaceViewMode;
   statePreset: MissionControlWorkspaceStatePreset;
@@ -1,6 +1,12 @@
-import { listRuntimeErrors as listRuntimeErrorsRequest, markRuntimeErrorViewed as markRuntimeErrorViewedRequest } from "../../shared/api/sdk";
+import {
+  escalateRuntimeErrorGroup as escalateRuntimeErrorGroupRequest,
+  listRuntimeErrorGroups as listRuntimeErrorGroupsRequest,
+  listRuntimeErrors as listRuntimeErrorsRequest,
+  markRuntimeErrorViewed as markRuntimeErrorViewedRequest,
+  updateRuntimeErrorGroupStatus as updateRuntimeErrorGroupStatusRequest,
+} from "../../shared/api/sdk";
 
-import type { RuntimeError } from "./types";
+import type { RuntimeError, RuntimeErrorGroup, RuntimeErrorGroupEscalationKind, RuntimeErrorGroupStatus } from "./types";
 
 export type RuntimeErrorsFilters = {
   state?: "active" | "viewed" | "all";
@@ -33,3 +39,42 @@ export async function markRuntimeErrorViewed(runtimeErrorId: string): Promise<Ru
   return resp.data;
 }
 
+
+export type RuntimeErrorGroupsFilters = {
+  status?: RuntimeErrorGroupStatus | "all";
+  level?: "error" | "warning" | "critical";
+  source?: string;
+  projectId?: string;
+};
+
+export async function listRuntimeErrorGroups(filters: RuntimeErrorGroupsFilters = {}, limit = 100): Promise<RuntimeErrorGroup[]> {
+  const resp = await listRuntimeErrorGroupsRequest({
+    query: {
+      limit,
+      status: filters.status,
+      level: filters.level,
+      source: filters.source?.trim() || undefined,
+      project_id: filters.projectId?.trim() || undefined,
+    },
+    throwOnError: true,
+  });
+  return resp.data.items ?? [];
+}
+
+export async function updateRuntimeErrorGroupStatus(groupId: string, status: RuntimeErrorGroupStatus, mutedUntil?: string): Promise<RuntimeErrorGroup> {
+  const resp = await updateRuntimeErrorGroupStatusRequest({
+    path: { group_id: groupId.trim() },
+    body: { status, muted_until: mutedUntil?.trim() || undefined },
+    throwOnError: true,
+  });
+  return resp.data;
+}
+
+export async function escalateRuntimeErrorGroup(groupId: string, kind: RuntimeErrorGroupEscalationKind): Promise<RuntimeErrorGroup> {
+  const resp = await escalateRuntimeErrorGroupRequest({
+    path: { group_id: groupId.trim() },
+    body: { kind },
+    throwOnError: true,
+  });
+  return resp.data;
+}
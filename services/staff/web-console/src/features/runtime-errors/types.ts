@@ -1,2 +1,4 @@
-export type { RuntimeError } from "../../shared/api/generated";
+export type { RuntimeError, RuntimeErrorGroup } from "../../shared/api/generated";
 
+export type RuntimeErrorGroupStatus = "open" | "muted" | "resolved";
+export type RuntimeErrorGroupEscalationKind = "issue" | "ai_repair";
@@ -659,6 +659,26 @@ export const en = {
         providerLinks: "Provider links",
         noProviderLinks: "No provider deep links are available.",
       },
+      voiceCandidates: {
+        title: "Voice candidates",
+        hint: "Drafts structured from voice notes in project chats. Review and confirm before the command is submitted.",
+        empty: "No voice drafts waiting for review.",
+        confidence: "Structuring confidence: {value}%",
+        review: "Review",
+        reject: "Reject",
+        confirm: "Confirm and submit",
+        editorTitle: "Review voice draft",
+        commandKind: {
+          workItem: "Work item",
+          discussion: "Discussion",
+        },
+        fields: {
+          commandKind: "Command",
+          title: "Title",
+          body: "Body",
+          transcript: "Transcript",
+        },
+      },
       preview: {
         title: "Launch preview",
         loading: "Building read-only preview...",
@@ -770,6 +790,9 @@ export const en = {
       pr: "PR",
       triggerKind: "Trigger kind",
       triggerLabel: "Trigger label",
+      priority: "Priority",
+      queuePosition: "Queue position (approx.)",
+      estimatedWait: "Estimated wait",
       job: "Job",
       jobNamespace: "Job namespace",
       noJob: "Job not found",
@@ -659,6 +659,26 @@ export const ru = {
         providerLinks: "Provider links",
         noProviderLinks: "Provider deep-links отсутствуют.",
       },
+      voiceCandidates: {
+        title: "Голосовые кандидаты",
+        hint: "Черновики из голосовых сообщений в чатах проекта. Проверьте и подтвердите перед отправкой команды.",
+        empty: "Нет голосовых черновиков на проверку.",
+        confidence: "Уверенность разбора: {value}%",
+        review: "Проверить",
+        reject: "Отклонить",
+        confirm: "Подтвердить и отправить",
+        editorTitle: "Проверка голосового черновика",
+        commandKind: {
+          workItem: "Задача",
+          discussion: "Обсуждение",
+        },
+        fields: {
+          commandKind: "Команда",
+          title: "Заголовок",
+          body: "Описание",
+          transcript: "Расшифровка",
+        },
+      },
       preview: {
         title: "Launch preview",
         loading: "Подготавливаю read-only preview...",
@@ -770,6 +790,9 @@ export const ru = {
       pr: "PR",
       triggerKind: "Вид триггера",
       triggerLabel: "Триггер-лейбл",
+      priority: "Приоритет",
+      queuePosition: "Позиция в очереди (примерно)",
+      estimatedWait: "Ожидаемое ожидание",
       job: "Job",
       jobNamespace: "Job namespace",
       noJob: "Job не найдена",
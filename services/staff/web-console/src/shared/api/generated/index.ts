@@ -1,4 +1,4 @@
 // This file is auto-generated by @hey-api/openapi-ts
 
-export { callbackGithub, cancelRun, cancelRuntimeDeployTask, createUser, deleteProject, deleteProjectMember, deleteProjectRepository, deleteRunNamespace, deleteUser, executeNextStepAction, getMe, getMissionControlCommand, getMissionControlNode, getMissionControlWorkspace, getProject, getProjectGitHubTokens, getRun, getRunLogs, getRuntimeDeployTask, getSystemSetting, importDocset, ingestGithubWebhook, listDocsetGroups, listMissionControlNodeActivity, listPendingApprovals, listProjectMembers, listProjectRepositories, listProjects, listRunEvents, listRunLearningFeedback, listRuntimeErrors, listRunWaits, listSystemSettings, listUsers, loginGithub, logout, markRuntimeErrorViewed, mcpApproverCallback, mcpExecutorCallback, mcpInteractionCallback, missionControlRealtime, type Options, previewMissionControlLaunch, previewNextStepAction, resetSystemSetting, resolveApprovalDecision, runRealtime, runRepositoryPreflight, runsRealtime, runtimeDeployTasksRealtime, setProjectMemberLearningModeOverride, stopRuntimeDeployTask, submitMissionControlCommand, syncDocset, systemSettingsRealtime, updateSystemSettingBoolean, upsertProject, upsertProjectGitHubTokens, upsertProjectMember, upsertProjectRepository, upsertRepositoryBotParams } from './sdk.gen';
-export type { AgentDetailsPayload, AgentKeyFilter, ApprovalRequest, ApprovalRequestId, ApprovalRequestItemsResponse, CallbackGithubData, CallbackGithubError, CallbackGithubErrors, CancelRunData, CancelRunError, CancelRunErrors, CancelRunResponse, CancelRunResponses, CancelRuntimeDeployTaskData, CancelRuntimeDeployTaskError, CancelRuntimeDeployTaskErrors, CancelRuntimeDeployTaskResponse, CancelRuntimeDeployTaskResponses, ClientOptions, CreateUserData, CreateUserError, CreateUserErrors, CreateUserRequest, CreateUserResponse, CreateUserResponses, DeleteProjectData, DeleteProjectError, DeleteProjectErrors, DeleteProjectMemberData, DeleteProjectMemberError, DeleteProjectMemberErrors, DeleteProjectMemberResponse, DeleteProjectMemberResponses, DeleteProjectRepositoryData, DeleteProjectRepositoryError, DeleteProjectRepositoryErrors, DeleteProjectRepositoryResponse, DeleteProjectRepositoryResponses, DeleteProjectResponse, DeleteProjectResponses, DeleteRunNamespaceData, DeleteRunNamespaceError, DeleteRunNamespaceErrors, DeleteRunNamespaceResponse, DeleteRunNamespaceResponses, DeleteUserData, DeleteUserError, DeleteUserErrors, DeleteUserResponse, DeleteUserResponses, DiscussionCreatePayload, DiscussionDetailsPayload, DiscussionFormalizePayload, DocsetGroup, DocsetGroupItemsResponse, ErrorResponse, ExecuteNextStepActionData, ExecuteNextStepActionError, ExecuteNextStepActionErrors, ExecuteNextStepActionResponse, ExecuteNextStepActionResponses, FlowEvent, FlowEventItemsResponse, GetMeData, GetMeError, GetMeErrors, GetMeResponse, GetMeResponses, GetMissionControlCommandData, GetMissionControlCommandError, GetMissionControlCommandErrors, GetMissionControlCommandResponse, GetMissionControlCommandResponses, GetMissionControlNodeData, GetMissionControlNodeError, GetMissionControlNodeErrors, GetMissionControlNodeResponse, GetMissionControlNodeResponses, GetMissionControlWorkspaceData, GetMissionControlWorkspaceError, GetMissionControlWorkspaceErrors, GetMissionControlWorkspaceResponse, GetMissionControlWorkspaceResponses, GetProjectData, GetProjectError, GetProjectErrors, GetProjectGitHubTokensData, GetProjectGitHubTokensError, GetProjectGitHubTokensErrors, GetProjectGitHubTokensResponse, GetProjectGitHubTokensResponses, GetProjectResponse, GetProjectResponses, GetRunData, GetRunError, GetRunErrors, GetRunLogsData, GetRunLogsError, GetRunLogsErrors, GetRunLogsResponse, GetRunLogsResponses, GetRunResponse, GetRunResponses, GetRuntimeDeployTaskData, GetRuntimeDeployTaskError, GetRuntimeDeployTaskErrors, GetRuntimeDeployTaskResponse, GetRuntimeDeployTaskResponses, GetSystemSettingData, GetSystemSettingError, GetSystemSettingErrors, GetSystemSettingResponse, GetSystemSettingResponses, GitHubRateLimitManualAction, GitHubRateLimitRecoveryHint, GitHubRateLimitWaitItem, ImportDocsetData, ImportDocsetError, ImportDocsetErrors, ImportDocsetRequest, ImportDocsetResponse, ImportDocsetResponse2, ImportDocsetResponses, IncludeLogs, IncludePayload, IncludeSnapshot, IngestGithubWebhookData, IngestGithubWebhookError, IngestGithubWebhookErrors, IngestGithubWebhookResponse, IngestGitHubWebhookResponse, IngestGithubWebhookResponses, InteractionCallbackEnvelope, InteractionCallbackError, InteractionCallbackOutcome, InteractionProviderMessageRef, LearningFeedback, LearningFeedbackItemsResponse, Limit, ListDocsetGroupsData, ListDocsetGroupsError, ListDocsetGroupsErrors, ListDocsetGroupsResponse, ListDocsetGroupsResponses, ListMissionControlNodeActivityData, ListMissionControlNodeActivityError, ListMissionControlNodeActivityErrors, ListMissionControlNodeActivityResponse, ListMissionControlNodeActivityResponses, ListPendingApprovalsData, ListPendingApprovalsError, ListPendingApprovalsErrors, ListPendingApprovalsResponse, ListPendingApprovalsResponses, ListProjectMembersData, ListProjectMembersError, ListProjectMembersErrors, ListProjectMembersResponse, ListProjectMembersResponses, ListProjectRepositoriesData, ListProjectRepositoriesError, ListProjectRepositoriesErrors, ListProjectRepositoriesResponse, ListProjectRepositoriesResponses, ListProjectsData, ListProjectsError, ListProjectsErrors, ListProjectsResponse, ListProjectsResponses, ListRunEventsData, ListRunEventsError, ListRunEventsErrors, ListRunEventsResponse, ListRunEventsResponses, ListRunLearningFeedbackData, ListRunLearningFeedbackError, ListRunLearningFeedbackErrors, ListRunLearningFeedbackResponse, ListRunLearningFeedbackResponses, ListRuntimeErrorsData, ListRuntimeErrorsError, ListRuntimeErrorsErrors, ListRuntimeErrorsResponse, ListRuntimeErrorsResponses, ListRunWaitsData, ListRunWaitsError, ListRunWaitsErrors, ListRunWaitsResponse, ListRunWaitsResponses, ListSystemSettingsData, ListSystemSettingsError, ListSystemSettingsErrors, ListSystemSettingsResponse, ListSystemSettingsResponses, ListUsersData, ListUsersError, ListUsersErrors, ListUsersResponse, ListUsersResponses, LoginGithubData, LoginGithubError, LoginGithubErrors, LogoutData, LogoutError, LogoutErrors, LogoutResponse, LogoutResponses, MarkRuntimeErrorViewedData, MarkRuntimeErrorViewedError, MarkRuntimeErrorViewedErrors, MarkRuntimeErrorViewedResponse, MarkRuntimeErrorViewedResponses, McpApprovalCallbackRequest, McpApproverCallbackData, McpApproverCallbackError, McpApproverCallbackErrors, McpApproverCallbackResponse, McpApproverCallbackResponses, McpCallbackToken, McpExecutorCallbackData, McpExecutorCallbackError, McpExecutorCallbackErrors, McpExecutorCallbackResponse, McpExecutorCallbackResponses, McpInteractionCallbackData, McpInteractionCallbackError, McpInteractionCallbackErrors, McpInteractionCallbackResponse, McpInteractionCallbackResponses, MeResponse, MeUser, MissionControlActiveFilter, MissionControlActivityEntry, MissionControlAllowedAction, MissionControlCommandApproval, MissionControlCommandId, MissionControlCommandRequest, MissionControlCommandState, MissionControlConnectedRealtimePayload, MissionControlContinuityGap, MissionControlCorrelationId, MissionControlCursor, MissionControlDashboardSnapshot, MissionControlDashboardSummary, MissionControlDegradedRealtimePayload, MissionControlDeltaRealtimePayload, MissionControlDiscussionCreateCommandRequest, MissionControlDiscussionFormalizeCommandRequest, MissionControlDiscussionNodeDetails, MissionControlEdge, MissionControlEntityCard, MissionControlEntityDetails, MissionControlEntityDetailsPayload, MissionControlEntityKind, MissionControlEntityPublicId, MissionControlEntityRef, MissionControlErrorRealtimePayload, MissionControlHeartbeatRealtimePayload, MissionControlIdempotencyKey, MissionControlInvalidateRealtimePayload, MissionControlLaunchPreview, MissionControlLaunchPreviewContinuityEffect, MissionControlLaunchPreviewLabelDiff, MissionControlLaunchPreviewRequest, MissionControlLaunchSurface, MissionControlNode, MissionControlNodeActivityItemsResponse, MissionControlNodeDetails, MissionControlNodeDetailsPayload, MissionControlNodeKind, MissionControlNodePublicId, MissionControlNodeRef, MissionControlPrimaryActor, MissionControlProviderDeepLink, MissionControlProviderReference, MissionControlPullRequestNodeDetails, MissionControlRealtimeData, MissionControlRealtimeEnvelope, MissionControlRealtimeError, MissionControlRealtimeErrors, MissionControlRealtimePayload, MissionControlRealtimeResponses, MissionControlRelation, MissionControlResumeToken, MissionControlResyncRequiredRealtimePayload, MissionControlRetrySyncCommandRequest, MissionControlRootGroup, MissionControlRootLimit, MissionControlRunNodeDetails, MissionControlSearch, MissionControlStageNextStepCommandRequest, MissionControlStageNextStepPayload, MissionControlStageNextStepTemplate, MissionControlStaleRealtimePayload, MissionControlTimelineEntry, MissionControlTimelineItemsResponse, MissionControlViewMode, MissionControlWorkItemCreateCommandRequest, MissionControlWorkItemNodeDetails, MissionControlWorkspaceFilters, MissionControlWorkspaceSnapshot, MissionControlWorkspaceStatePreset, MissionControlWorkspaceSummary, MissionControlWorkspaceViewMode, MissionControlWorkspaceWatermark, NextStepActionRequest, NextStepActionResponse, Page, PageSize, PreflightCheckResult, PreviewMissionControlLaunchData, PreviewMissionControlLaunchError, PreviewMissionControlLaunchErrors, PreviewMissionControlLaunchResponse, PreviewMissionControlLaunchResponses, PreviewNextStepActionData, PreviewNextStepActionError, PreviewNextStepActionErrors, PreviewNextStepActionResponse, PreviewNextStepActionResponses, Project, ProjectGitHubTokens, ProjectId, ProjectItemsResponse, ProjectMember, ProjectMemberItemsResponse, PullRequestDetailsPayload, RepositoryBinding, RepositoryBindingItemsResponse, ResetSystemSettingData, ResetSystemSettingError, ResetSystemSettingErrors, ResetSystemSettingResponse, ResetSystemSettingResponses, ResolveApprovalDecisionData, ResolveApprovalDecisionError, ResolveApprovalDecisionErrors, ResolveApprovalDecisionRequest, ResolveApprovalDecisionResponse, ResolveApprovalDecisionResponse2, ResolveApprovalDecisionResponses, RetrySyncPayload, Run, RunActionRequest, RunActionResponse, RunId, RunItemsResponse, RunLogs, RunNamespaceCleanupResponse, RunRealtimeData, RunRealtimeError, RunRealtimeErrors, RunRealtimeMessage, RunRealtimeResponse, RunRealtimeResponses, RunRepositoryPreflightData, RunRepositoryPreflightError, RunRepositoryPreflightErrors, RunRepositoryPreflightResponse, RunRepositoryPreflightResponse2, RunRepositoryPreflightResponses, RunsRealtimeData, RunsRealtimeError, RunsRealtimeErrors, RunsRealtimeResponses, RunStatusFilter, RuntimeDeployTask, RuntimeDeployTaskActionRequest, RuntimeDeployTaskActionResponse, RuntimeDeployTaskItemsResponse, RuntimeDeployTaskListItem, RuntimeDeployTaskLog, RuntimeDeployTasksRealtimeData, RuntimeDeployTasksRealtimeError, RuntimeDeployTasksRealtimeErrors, RuntimeDeployTasksRealtimeResponses, RuntimeError, RuntimeErrorId, RuntimeErrorItemsResponse, RunWaitManualActionEvent, RunWaitProjection, RunWaitResolution, SetProjectMemberLearningModeOverrideData, SetProjectMemberLearningModeOverrideError, SetProjectMemberLearningModeOverrideErrors, SetProjectMemberLearningModeOverrideResponse, SetProjectMemberLearningModeOverrideResponses, SetProjectMemberLearningModeRequest, SettingKey, StopRuntimeDeployTaskData, StopRuntimeDeployTaskError, StopRuntimeDeployTaskErrors, StopRuntimeDeployTaskResponse, StopRuntimeDeployTaskResponses, SubmitMissionControlCommandData, SubmitMissionControlCommandError, SubmitMissionControlCommandErrors, SubmitMissionControlCommandResponse, SubmitMissionControlCommandResponses, SyncDocsetData, SyncDocsetError, SyncDocsetErrors, SyncDocsetRequest, SyncDocsetResponse, SyncDocsetResponse2, SyncDocsetResponses, SystemSetting, SystemSettingItemsResponse, SystemSettingsRealtimeData, SystemSettingsRealtimeError, SystemSettingsRealtimeErrors, SystemSettingsRealtimeResponses, TailLines, TriggerKindFilter, UpdateSystemSettingBooleanData, UpdateSystemSettingBooleanError, UpdateSystemSettingBooleanErrors, UpdateSystemSettingBooleanRequest, UpdateSystemSettingBooleanResponse, UpdateSystemSettingBooleanResponses, UpsertProjectData, UpsertProjectError, UpsertProjectErrors, UpsertProjectGitHubTokensData, UpsertProjectGitHubTokensError, UpsertProjectGitHubTokensErrors, UpsertProjectGitHubTokensRequest, UpsertProjectGitHubTokensResponse, UpsertProjectGitHubTokensResponses, UpsertProjectMemberData, UpsertProjectMemberError, UpsertProjectMemberErrors, UpsertProjectMemberRequest, UpsertProjectMemberResponse, UpsertProjectMemberResponses, UpsertProjectRepositoryData, UpsertProjectRepositoryError, UpsertProjectRepositoryErrors, UpsertProjectRepositoryRequest, UpsertProjectRepositoryResponse, UpsertProjectRepositoryResponses, UpsertProjectRequest, UpsertProjectResponse, UpsertProjectResponses, UpsertRepositoryBotParamsData, UpsertRepositoryBotParamsError, UpsertRepositoryBotParamsErrors, UpsertRepositoryBotParamsRequest, UpsertRepositoryBotParamsResponse, UpsertRepositoryBotParamsResponses, User, UserId, UserItemsResponse, WaitStateFilter, WorkItemCreatePayload, WorkItemDetailsPayload } from './types.gen';
+export { callbackGithub, callbackOidc, cancelRun, cancelRuntimeDeployTask, createServiceAccount, createStaffApiToken, createUser, deleteMcpApprovalPolicy, deleteProject, deleteProjectMember, deleteProjectRepository, deleteProjectRole, deleteRetentionPolicy, deleteRunNamespace, deleteUser, escalateRuntimeErrorGroup, executeNextStepAction, getMe, getMissionControlCommand, getMissionControlNode, getMissionControlWorkspace, getProject, getProjectGitHubTokens, getRun, getRunLogs, getRunOutcomeAnalytics, getRuntimeDeployTask, getSystemSetting, importDocset, ingestAlertmanagerWebhook, ingestGithubWebhook, listDocsetGroups, listMcpApprovalPolicies, listMissionControlNodeActivity, listMissionControlVoiceCandidates, listPendingApprovals, listProjectMembers, listProjectRepositories, listProjectRoles, listProjects, listRetentionPolicies, listRunEvents, listRunLearningFeedback, listRuntimeErrorGroups, listRuntimeErrors, listRunWaits, listStaffApiTokens, listSystemSettings, listUsers, loginGithub, loginOidc, logout, markRuntimeErrorViewed, mcpApproverCallback, mcpExecutorCallback, mcpInteractionCallback, missionControlRealtime, type Options, previewMissionControlLaunch, previewNextStepAction, promoteMissionControlVoiceCandidate, rejectMissionControlVoiceCandidate, resetSystemSetting, resolveApprovalDecision, revokeStaffApiToken, runRealtime, runRepositoryPreflight, runsRealtime, runtimeDeployTasksRealtime, setFlowEventRetentionPin, setProjectMemberLearningModeOverride, stopRuntimeDeployTask, submitMissionControlCommand, syncDocset, systemSettingsRealtime, updateRuntimeErrorGroupStatus, updateSystemSettingBoolean, upsertMcpApprovalPolicy, upsertProject, upsertProjectGitHubTokens, upsertProjectMember, upsertProjectRepository, upsertProjectRole, upsertRepositoryBotParams, upsertRetentionPolicy } from './sdk.gen';
+export type { AgentDetailsPayload, AgentKeyFilter, AlertIncidentOutcome, ApprovalRequest, ApprovalRequestId, ApprovalRequestItemsResponse, ApprovalVote, CallbackGithubData, CallbackGithubError, CallbackGithubErrors, CallbackOidcData, CallbackOidcError, CallbackOidcErrors, CancelRunData, CancelRunError, CancelRunErrors, CancelRunResponse, CancelRunResponses, CancelRuntimeDeployTaskData, CancelRuntimeDeployTaskError, CancelRuntimeDeployTaskErrors, CancelRuntimeDeployTaskResponse, CancelRuntimeDeployTaskResponses, ClientOptions, CreateServiceAccountData, CreateServiceAccountError, CreateServiceAccountErrors, CreateServiceAccountRequest, CreateServiceAccountResponse, CreateServiceAccountResponses, CreateStaffApiTokenData, CreateStaffApiTokenError, CreateStaffApiTokenErrors, CreateStaffApiTokenRequest, CreateStaffApiTokenResponse, CreateStaffApiTokenResponse2, CreateStaffApiTokenResponses, CreateUserData, CreateUserError, CreateUserErrors, CreateUserRequest, CreateUserResponse, CreateUserResponses, DeleteMcpApprovalPolicyData, DeleteMcpApprovalPolicyError, DeleteMcpApprovalPolicyErrors, DeleteMcpApprovalPolicyResponse, DeleteMcpApprovalPolicyResponses, DeleteProjectData, DeleteProjectError, DeleteProjectErrors, DeleteProjectMemberData, DeleteProjectMemberError, DeleteProjectMemberErrors, DeleteProjectMemberResponse, DeleteProjectMemberResponses, DeleteProjectRepositoryData, DeleteProjectRepositoryError, DeleteProjectRepositoryErrors, DeleteProjectRepositoryResponse, DeleteProjectRepositoryResponses, DeleteProjectResponse, DeleteProjectResponses, DeleteProjectRoleData, DeleteProjectRoleError, DeleteProjectRoleErrors, DeleteProjectRoleResponse, DeleteProjectRoleResponses, DeleteRetentionPolicyData, DeleteRetentionPolicyError, DeleteRetentionPolicyErrors, DeleteRetentionPolicyResponse, DeleteRetentionPolicyResponses, DeleteRunNamespaceData, DeleteRunNamespaceError, DeleteRunNamespaceErrors, DeleteRunNamespaceResponse, DeleteRunNamespaceResponses, DeleteUserData, DeleteUserError, DeleteUserErrors, DeleteUserResponse, DeleteUserResponses, DiscussionCreatePayload, DiscussionDetailsPayload, DiscussionFormalizePayload, DocsetGroup, DocsetGroupItemsResponse, ErrorResponse, EscalateRuntimeErrorGroupData, EscalateRuntimeErrorGroupError, EscalateRuntimeErrorGroupErrors, EscalateRuntimeErrorGroupRequest, EscalateRuntimeErrorGroupResponse, EscalateRuntimeErrorGroupResponses, ExecuteNextStepActionData, ExecuteNextStepActionError, ExecuteNextStepActionErrors, ExecuteNextStepActionResponse, ExecuteNextStepActionResponses, FlowEvent, FlowEventId, FlowEventItemsResponse, GetMeData, GetMeError, GetMeErrors, GetMeResponse, GetMeResponses, GetMissionControlCommandData, GetMissionControlCommandError, GetMissionControlCommandErrors, GetMissionControlCommandResponse, GetMissionControlCommandResponses, GetMissionControlNodeData, GetMissionControlNodeError, GetMissionControlNodeErrors, GetMissionControlNodeResponse, GetMissionControlNodeResponses, GetMissionControlWorkspaceData, GetMissionControlWorkspaceError, GetMissionControlWorkspaceErrors, GetMissionControlWorkspaceResponse, GetMissionControlWorkspaceResponses, GetProjectData, GetProjectError, GetProjectErrors, GetProjectGitHubTokensData, GetProjectGitHubTokensError, GetProjectGitHubTokensErrors, GetProjectGitHubTokensResponse, GetProjectGitHubTokensResponses, GetProjectResponse, GetProjectResponses, GetRunData, GetRunError, GetRunErrors, GetRunLogsData, GetRunLogsError, GetRunLogsErrors, GetRunLogsResponse, GetRunLogsResponses, GetRunOutcomeAnalyticsData, GetRunOutcomeAnalyticsError, GetRunOutcomeAnalyticsErrors, GetRunOutcomeAnalyticsResponse, GetRunOutcomeAnalyticsResponses, GetRunResponse, GetRunResponses, GetRuntimeDeployTaskData, GetRuntimeDeployTaskError, GetRuntimeDeployTaskErrors, GetRuntimeDeployTaskResponse, GetRuntimeDeployTaskResponses, GetSystemSettingData, GetSystemSettingError, GetSystemSettingErrors, GetSystemSettingResponse, GetSystemSettingResponses, GitHubRateLimitManualAction, GitHubRateLimitRecoveryHint, GitHubRateLimitWaitItem, ImportDocsetData, ImportDocsetError, ImportDocsetErrors, ImportDocsetRequest, ImportDocsetResponse, ImportDocsetResponse2, ImportDocsetResponses, IncludeLogs, IncludePayload, IncludeSnapshot, IngestAlertmanagerWebhookData, IngestAlertmanagerWebhookError, IngestAlertmanagerWebhookErrors, IngestAlertmanagerWebhookResponse, IngestAlertmanagerWebhookResponse2, IngestAlertmanagerWebhookResponses, IngestGithubWebhookData, IngestGithubWebhookError, IngestGithubWebhookErrors, IngestGithubWebhookResponse, IngestGitHubWebhookResponse, IngestGithubWebhookResponses, InteractionCallbackEnvelope, InteractionCallbackError, InteractionCallbackOutcome, InteractionProviderMessageRef, LearningFeedback, LearningFeedbackItemsResponse, Limit, ListDocsetGroupsData, ListDocsetGroupsError, ListDocsetGroupsErrors, ListDocsetGroupsResponse, ListDocsetGroupsResponses, ListMcpApprovalPoliciesData, ListMcpApprovalPoliciesError, ListMcpApprovalPoliciesErrors, ListMcpApprovalPoliciesResponse, ListMcpApprovalPoliciesResponses, ListMissionControlNodeActivityData, ListMissionControlNodeActivityError, ListMissionControlNodeActivityErrors, ListMissionControlNodeActivityResponse, ListMissionControlNodeActivityResponses, ListMissionControlVoiceCandidatesData, ListMissionControlVoiceCandidatesError, ListMissionControlVoiceCandidatesErrors, ListMissionControlVoiceCandidatesResponse, ListMissionControlVoiceCandidatesResponses, ListPendingApprovalsData, ListPendingApprovalsError, ListPendingApprovalsErrors, ListPendingApprovalsResponse, ListPendingApprovalsResponses, ListProjectMembersData, ListProjectMembersError, ListProjectMembersErrors, ListProjectMembersResponse, ListProjectMembersResponses, ListProjectRepositoriesData, ListProjectRepositoriesError, ListProjectRepositoriesErrors, ListProjectRepositoriesResponse, ListProjectRepositoriesResponses, ListProjectRolesData, ListProjectRolesError, ListProjectRolesErrors, ListProjectRolesResponse, ListProjectRolesResponses, ListProjectsData, ListProjectsError, ListProjectsErrors, ListProjectsResponse, ListProjectsResponses, ListRetentionPoliciesData, ListRetentionPoliciesError, ListRetentionPoliciesErrors, ListRetentionPoliciesResponse, ListRetentionPoliciesResponses, ListRunEventsData, ListRunEventsError, ListRunEventsErrors, ListRunEventsResponse, ListRunEventsResponses, ListRunLearningFeedbackData, ListRunLearningFeedbackError, ListRunLearningFeedbackErrors, ListRunLearningFeedbackResponse, ListRunLearningFeedbackResponses, ListRuntimeErrorGroupsData, ListRuntimeErrorGroupsError, ListRuntimeErrorGroupsErrors, ListRuntimeErrorGroupsResponse, ListRuntimeErrorGroupsResponses, ListRuntimeErrorsData, ListRuntimeErrorsError, ListRuntimeErrorsErrors, ListRuntimeErrorsResponse, ListRuntimeErrorsResponses, ListRunWaitsData, ListRunWaitsError, ListRunWaitsErrors, ListRunWaitsResponse, ListRunWaitsResponses, ListStaffApiTokensData, ListStaffApiTokensError, ListStaffApiTokensErrors, ListStaffApiTokensResponse, ListStaffApiTokensResponses, ListSystemSettingsData, ListSystemSettingsError, ListSystemSettingsErrors, ListSystemSettingsResponse, ListSystemSettingsResponses, ListUsersData, ListUsersError, ListUsersErrors, ListUsersResponse, ListUsersResponses, LoginGithubData, LoginGithubError, LoginGithubErrors, LoginOidcData, LoginOidcError, LoginOidcErrors, LogoutData, LogoutError, LogoutErrors, LogoutResponse, LogoutResponses, MarkRuntimeErrorViewedData, MarkRuntimeErrorViewedError, MarkRuntimeErrorViewedErrors, MarkRuntimeErrorViewedResponse, MarkRuntimeErrorViewedResponses, McpApprovalCallbackRequest, McpApprovalPolicy, McpApprovalPolicyItemsResponse, McpApproverCallbackData, McpApproverCallbackError, McpApproverCallbackErrors, McpApproverCallbackResponse, McpApproverCallbackResponses, McpCallbackToken, McpExecutorCallbackData, McpExecutorCallbackError, McpExecutorCallbackErrors, McpExecutorCallbackResponse, McpExecutorCallbackResponses, McpInteractionCallbackData, McpInteractionCallbackError, McpInteractionCallbackErrors, McpInteractionCallbackResponse, McpInteractionCallbackResponses, MeResponse, MeUser, MissionControlActiveFilter, MissionControlActivityEntry, MissionControlAllowedAction, MissionControlCommandApproval, MissionControlCommandId, MissionControlCommandRequest, MissionControlCommandState, MissionControlConnectedRealtimePayload, MissionControlContinuityGap, MissionControlCorrelationId, MissionControlCursor, MissionControlDashboardSnapshot, MissionControlDashboardSummary, MissionControlDegradedRealtimePayload, MissionControlDeltaRealtimePayload, MissionControlDiscussionCreateCommandRequest, MissionControlDiscussionFormalizeCommandRequest, MissionControlDiscussionNodeDetails, MissionControlEdge, MissionControlEntityCard, MissionControlEntityDetails, MissionControlEntityDetailsPayload, MissionControlEntityKind, MissionControlEntityPublicId, MissionControlEntityRef, MissionControlErrorRealtimePayload, MissionControlHeartbeatRealtimePayload, MissionControlIdempotencyKey, MissionControlInvalidateRealtimePayload, MissionControlLaunchPreview, MissionControlLaunchPreviewContinuityEffect, MissionControlLaunchPreviewLabelDiff, MissionControlLaunchPreviewRequest, MissionControlLaunchSurface, MissionControlNode, MissionControlNodeActivityItemsResponse, MissionControlNodeDetails, MissionControlNodeDetailsPayload, MissionControlNodeKind, MissionControlNodePublicId, MissionControlNodeRef, MissionControlPrimaryActor, MissionControlProviderDeepLink, MissionControlProviderReference, MissionControlPullRequestNodeDetails, MissionControlRealtimeData, MissionControlRealtimeEnvelope, MissionControlRealtimeError, MissionControlRealtimeErrors, MissionControlRealtimePayload, MissionControlRealtimeResponses, MissionControlRelation, MissionControlResumeToken, MissionControlResyncRequiredRealtimePayload, MissionControlRetrySyncCommandRequest, MissionControlRootGroup, MissionControlRootLimit, MissionControlRunNodeDetails, MissionControlSearch, MissionControlStageNextStepCommandRequest, MissionControlStageNextStepPayload, MissionControlStageNextStepTemplate, MissionControlStaleRealtimePayload, MissionControlTimelineEntry, MissionControlTimelineItemsResponse, MissionControlViewMode, MissionControlVoiceCandidate, MissionControlVoiceCandidateId, MissionControlVoiceCandidateItemsResponse, MissionControlVoiceCandidatePromoteRequest, MissionControlVoiceCandidatePromotion, MissionControlVoiceCandidateRejectRequest, MissionControlWorkItemCreateCommandRequest, MissionControlWorkItemNodeDetails, MissionControlWorkspaceFilters, MissionControlWorkspaceSnapshot, MissionControlWorkspaceStatePreset, MissionControlWorkspaceSummary, MissionControlWorkspaceViewMode, MissionControlWorkspaceWatermark, NextStepActionRequest, NextStepActionResponse, Page, PageSize, PreflightCheckResult, PreviewMissionControlLaunchData, PreviewMissionControlLaunchError, PreviewMissionControlLaunchErrors, PreviewMissionControlLaunchResponse, PreviewMissionControlLaunchResponses, PreviewNextStepActionData, PreviewNextStepActionError, PreviewNextStepActionErrors, PreviewNextStepActionResponse, PreviewNextStepActionResponses, Project, ProjectGitHubTokens, ProjectId, ProjectItemsResponse, ProjectMember, ProjectMemberItemsResponse, ProjectPermissionDescriptor, ProjectRole, ProjectRolesResponse, PromoteMissionControlVoiceCandidateData, PromoteMissionControlVoiceCandidateError, PromoteMissionControlVoiceCandidateErrors, PromoteMissionControlVoiceCandidateResponse, PromoteMissionControlVoiceCandidateResponses, PullRequestDetailsPayload, RejectMissionControlVoiceCandidateData, RejectMissionControlVoiceCandidateError, RejectMissionControlVoiceCandidateErrors, RejectMissionControlVoiceCandidateResponse, RejectMissionControlVoiceCandidateResponses, RepositoryBinding, RepositoryBindingItemsResponse, ResetSystemSettingData, ResetSystemSettingError, ResetSystemSettingErrors, ResetSystemSettingResponse, ResetSystemSettingResponses, ResolveApprovalDecisionData, ResolveApprovalDecisionError, ResolveApprovalDecisionErrors, ResolveApprovalDecisionRequest, ResolveApprovalDecisionResponse, ResolveApprovalDecisionResponse2, ResolveApprovalDecisionResponses, RetentionPolicy, RetentionPolicyId, RetentionPolicyItemsResponse, RetrySyncPayload, RevokeStaffApiTokenData, RevokeStaffApiTokenError, RevokeStaffApiTokenErrors, RevokeStaffApiTokenResponse, RevokeStaffApiTokenResponses, Run, RunActionRequest, RunActionResponse, RunId, RunItemsResponse, RunLogs, RunNamespaceCleanupResponse, RunOutcomeAnalytics, RunOutcomeGroup, RunRealtimeData, RunRealtimeError, RunRealtimeErrors, RunRealtimeMessage, RunRealtimeResponse, RunRealtimeResponses, RunRepositoryPreflightData, RunRepositoryPreflightError, RunRepositoryPreflightErrors, RunRepositoryPreflightResponse, RunRepositoryPreflightResponse2, RunRepositoryPreflightResponses, RunsRealtimeData, RunsRealtimeError, RunsRealtimeErrors, RunsRealtimeResponses, RunStatusFilter, RuntimeDeployTask, RuntimeDeployTaskActionRequest, RuntimeDeployTaskActionResponse, RuntimeDeployTaskItemsResponse, RuntimeDeployTaskListItem, RuntimeDeployTaskLog, RuntimeDeployTasksRealtimeData, RuntimeDeployTasksRealtimeError, RuntimeDeployTasksRealtimeErrors, RuntimeDeployTasksRealtimeResponses, RuntimeError, RuntimeErrorGroup, RuntimeErrorGroupId, RuntimeErrorGroupItemsResponse, RuntimeErrorId, RuntimeErrorItemsResponse, RunWaitManualActionEvent, RunWaitProjection, RunWaitResolution, SetFlowEventRetentionPinData, SetFlowEventRetentionPinError, SetFlowEventRetentionPinErrors, SetFlowEventRetentionPinRequest, SetFlowEventRetentionPinResponse, SetFlowEventRetentionPinResponses, SetProjectMemberLearningModeOverrideData, SetProjectMemberLearningModeOverrideError, SetProjectMemberLearningModeOverrideErrors, SetProjectMemberLearningModeOverrideResponse, SetProjectMemberLearningModeOverrideResponses, SetProjectMemberLearningModeRequest, SettingKey, StaffApiToken, StaffApiTokenItemsResponse, StaffApiTokenScope, StopRuntimeDeployTaskData, StopRuntimeDeployTaskError, StopRuntimeDeployTaskErrors, StopRuntimeDeployTaskResponse, StopRuntimeDeployTaskResponses, SubmitMissionControlCommandData, SubmitMissionControlCommandError, SubmitMissionControlCommandErrors, SubmitMissionControlCommandResponse, SubmitMissionControlCommandResponses, SyncDocsetData, SyncDocsetError, SyncDocsetErrors, SyncDocsetRequest, SyncDocsetResponse, SyncDocsetResponse2, SyncDocsetResponses, SystemSetting, SystemSettingItemsResponse, SystemSettingsRealtimeData, SystemSettingsRealtimeError, SystemSettingsRealtimeErrors, SystemSettingsRealtimeResponses, TailLines, TriggerKindFilter, UpdateRuntimeErrorGroupStatusData, UpdateRuntimeErrorGroupStatusError, UpdateRuntimeErrorGroupStatusErrors, UpdateRuntimeErrorGroupStatusRequest, UpdateRuntimeErrorGroupStatusResponse, UpdateRuntimeErrorGroupStatusResponses, UpdateSystemSettingBooleanData, UpdateSystemSettingBooleanError, UpdateSystemSettingBooleanErrors, UpdateSystemSettingBooleanRequest, UpdateSystemSettingBooleanResponse, UpdateSystemSettingBooleanResponses, UpsertMcpApprovalPolicyData, UpsertMcpApprovalPolicyError, UpsertMcpApprovalPolicyErrors, UpsertMcpApprovalPolicyRequest, UpsertMcpApprovalPolicyResponse, UpsertMcpApprovalPolicyResponses, UpsertProjectData, UpsertProjectError, UpsertProjectErrors, UpsertProjectGitHubTokensData, UpsertProjectGitHubTokensError, UpsertProjectGitHubTokensErrors, UpsertProjectGitHubTokensRequest, UpsertProjectGitHubTokensResponse, UpsertProjectGitHubTokensResponses, UpsertProjectMemberData, UpsertProjectMemberError, UpsertProjectMemberErrors, UpsertProjectMemberRequest, UpsertProjectMemberResponse, UpsertProjectMemberResponses, UpsertProjectRepositoryData, UpsertProjectRepositoryError, UpsertProjectRepositoryErrors, UpsertProjectRepositoryRequest, UpsertProjectRepositoryResponse, UpsertProjectRepositoryResponses, UpsertProjectRequest, UpsertProjectResponse, UpsertProjectResponses, UpsertProjectRoleData, UpsertProjectRoleError, UpsertProjectRoleErrors, UpsertProjectRoleRequest, UpsertProjectRoleResponse, UpsertProjectRoleResponses, UpsertRepositoryBotParamsData, UpsertRepositoryBotParamsError, UpsertRepositoryBotParamsErrors, UpsertRepositoryBotParamsRequest, UpsertRepositoryBotParamsResponse, UpsertRepositoryBotParamsResponses, UpsertRetentionPolicyData, UpsertRetentionPolicyError, UpsertRetentionPolicyErrors, UpsertRetentionPolicyRequest, UpsertRetentionPolicyResponse, UpsertRetentionPolicyResponses, User, UserId, UserItemsResponse, WaitStateFilter, WorkItemCreatePayload, WorkItemDetailsPayload } from './types.gen';
@@ -2,7 +2,7 @@
 
 import type { Client, Options as Options2, TDataShape } from './client';
 import { client } from './client.gen';
-import type { CallbackGithubData, CallbackGithubErrors, CancelRunData, CancelRunErrors, CancelRunResponses, CancelRuntimeDeployTaskData, CancelRuntimeDeployTaskErrors, CancelRuntimeDeployTaskResponses, CreateUserData, CreateUserErrors, CreateUserResponses, DeleteProjectData, DeleteProjectErrors, DeleteProjectMemberData, DeleteProjectMemberErrors, DeleteProjectMemberResponses, DeleteProjectRepositoryData, DeleteProjectRepositoryErrors, DeleteProjectRepositoryResponses, DeleteProjectResponses, DeleteRunNamespaceData, DeleteRunNamespaceErrors, DeleteRunNamespaceResponses, DeleteUserData, DeleteUserErrors, DeleteUserResponses, ExecuteNextStepActionData, ExecuteNextStepActionErrors, ExecuteNextStepActionResponses, GetMeData, GetMeErrors, GetMeResponses, GetMissionControlCommandData, GetMissionControlCommandErrors, GetMissionControlCommandResponses, GetMissionControlNodeData, GetMissionControlNodeErrors, GetMissionControlNodeResponses, GetMissionControlWorkspaceData, GetMissionControlWorkspaceErrors, GetMissionControlWorkspaceResponses, GetProjectData, GetProjectErrors, GetProjectGitHubTokensData, GetProjectGitHubTokensErrors, GetProjectGitHubTokensResponses, GetProjectResponses, GetRunData, GetRunErrors, GetRunLogsData, GetRunLogsErrors, GetRunLogsResponses, GetRunResponses, GetRuntimeDeployTaskData, GetRuntimeDeployTaskErrors, GetRuntimeDeployTaskResponses, GetSystemSettingData, GetSystemSettingErrors, GetSystemSettingResponses, ImportDocsetData, ImportDocsetErrors, ImportDocsetResponses, IngestGithubWebhookData, IngestGithubWebhookErrors, IngestGithubWebhookResponses, ListDocsetGroupsData, ListDocsetGroupsErrors, ListDocsetGroupsResponses, ListMissionControlNodeActivityData, ListMissionControlNodeActivityErrors, ListMissionControlNodeActivityResponses, ListPendingApprovalsData, ListPendingApprovalsErrors, ListPendingApprovalsResponses, ListProjectMembersData, ListProjectMembersErrors, ListProjectMembersResponses, ListProjectRepositoriesData, ListProjectRepositoriesErrors, ListProjectRepositoriesResponses, ListProjectsData, ListProjectsErrors, ListProjectsResponses, ListRunEventsData, ListRunEventsErrors, ListRunEventsResponses, ListRunLearningFeedbackData, ListRunLearningFeedbackErrors, ListRunLearningFeedbackResponses, ListRuntimeErrorsData, ListRuntimeErrorsErrors, ListRuntimeErrorsResponses, ListRunWaitsData, ListRunWaitsErrors, ListRunWaitsResponses, ListSystemSettingsData, ListSystemSettingsErrors, ListSystemSettingsResponses, ListUsersData, ListUsersErrors, ListUsersResponses, LoginGithubData, LoginGithubErrors, LogoutData, LogoutErrors, LogoutResponses, MarkRuntimeErrorViewedData, MarkRuntimeErrorViewedErrors, MarkRuntimeErrorViewedResponses, McpApproverCallbackData, McpApproverCallbackErrors, McpApproverCallbackResponses, McpExecutorCallbackData, McpExecutorCallbackErrors, McpExecutorCallbackResponses, McpInteractionCallbackData, McpInteractionCallbackErrors, McpInteractionCallbackResponses, MissionControlRealtimeData, MissionControlRealtimeErrors, MissionControlRealtimeResponses, PreviewMissionControlLaunchData, PreviewMissionControlLaunchErrors, PreviewMissionControlLaunchResponses, PreviewNextStepActionData, PreviewNextStepActionErrors, PreviewNextStepActionResponses, ResetSystemSettingData, ResetSystemSettingErrors, ResetSystemSettingResponses, ResolveApprovalDecisionData, ResolveApprovalDecisionErrors, ResolveApprovalDecisionResponses, RunRealtimeData, RunRealtimeErrors, RunRealtimeResponses, RunRepositoryPreflightData, RunRepositoryPreflightErrors, RunRepositoryPreflightResponses, RunsRealtimeData, RunsRealtimeErrors, RunsRealtimeResponses, RuntimeDeployTasksRealtimeData, RuntimeDeployTasksRealtimeErrors, RuntimeDeployTasksRealtimeResponses, SetProjectMemberLearningModeOverrideData, SetProjectMemberLearningModeOverrideErrors, SetProjectMemberLearningModeOverrideResponses, StopRuntimeDeployTaskData, StopRuntimeDeployTaskErrors, StopRuntimeDeployTaskResponses, SubmitMissionControlCommandData, SubmitMissionControlCommandErrors, SubmitMissionControlCommandResponses, SyncDocsetData, SyncDocsetErrors, SyncDocsetResponses, SystemSettingsRealtimeData, SystemSettingsRealtimeErrors, SystemSettingsRealtimeResponses, UpdateSystemSettingBooleanData, UpdateSystemSettingBooleanErrors, UpdateSystemSettingBooleanResponses, UpsertProjectData, UpsertProjectErrors, UpsertProjectGitHubTokensData, UpsertProjectGitHubTokensErrors, UpsertProjectGitHubTokensResponses, UpsertProjectMemberData, UpsertProjectMemberErrors, UpsertProjectMemberResponses, UpsertProjectRepositoryData, UpsertProjectRepositoryErrors, UpsertProjectRepositoryResponses, UpsertProjectResponses, UpsertRepositoryBotParamsData, UpsertRepositoryBotParamsErrors, UpsertRepositoryBotParamsResponses } from './types.gen';
+import type { CallbackGithubData, CallbackGithubErrors, CallbackOidcData, CallbackOidcErrors, CancelRunData, CancelRunErrors, CancelRunResponses, CancelRuntimeDeployTaskData, CancelRuntimeDeployTaskErrors, CancelRuntimeDeployTaskResponses, CreateServiceAccountData, CreateServiceAccountErrors, CreateServiceAccountResponses, CreateStaffApiTokenData, CreateStaffApiTokenErrors, CreateStaffApiTokenResponses, CreateUserData, CreateUserErrors, CreateUserResponses, DeleteMcpApprovalPolicyData, DeleteMcpApprovalPolicyErrors, DeleteMcpApprovalPolicyResponses, DeleteProjectData, DeleteProjectErrors, DeleteProjectMemberData, DeleteProjectMemberErrors, DeleteProjectMemberResponses, DeleteProjectRepositoryData, DeleteProjectRepositoryErrors, DeleteProjectRepositoryResponses, DeleteProjectResponses, DeleteProjectRoleData, DeleteProjectRoleErrors, DeleteProjectRoleResponses, DeleteRetentionPolicyData, DeleteRetentionPolicyErrors, DeleteRetentionPolicyResponses, DeleteRunNamespaceData, DeleteRunNamespaceErrors, DeleteRunNamespaceResponses, DeleteUserData, DeleteUserErrors, DeleteUserResponses, EscalateRuntimeErrorGroupData, EscalateRuntimeErrorGroupErrors, EscalateRuntimeErrorGroupResponses, ExecuteNextStepActionData, ExecuteNextStepActionErrors, ExecuteNextStepActionResponses, GetMeData, GetMeErrors, GetMeResponses, GetMissionControlCommandData, GetMissionControlCommandErrors, GetMissionControlCommandResponses, GetMissionControlNodeData, GetMissionControlNodeErrors, GetMissionControlNodeResponses, GetMissionControlWorkspaceData, GetMissionControlWorkspaceErrors, GetMissionControlWorkspaceResponses, GetProjectData, GetProjectErrors, GetProjectGitHubTokensData, GetProjectGitHubTokensErrors, GetProjectGitHubTokensResponses, GetProjectResponses, GetRunData, GetRunErrors, GetRunLogsData, GetRunLogsErrors, GetRunLogsResponses, GetRunOutcomeAnalyticsData, GetRunOutcomeAnalyticsErrors, GetRunOutcomeAnalyticsResponses, GetRunResponses, GetRuntimeDeployTaskData, GetRuntimeDeployTaskErrors, GetRuntimeDeployTaskResponses, GetSystemSettingData, GetSystemSettingErrors, GetSystemSettingResponses, ImportDocsetData, ImportDocsetErrors, ImportDocsetResponses, IngestAlertmanagerWebhookData, IngestAlertmanagerWebhookErrors, IngestAlertmanagerWebhookResponses, IngestGithubWebhookData, IngestGithubWebhookErrors, IngestGithubWebhookResponses, ListDocsetGroupsData, ListDocsetGroupsErrors, ListDocsetGroupsResponses, ListMcpApprovalPoliciesData, ListMcpApprovalPoliciesErrors, ListMcpApprovalPoliciesResponses, ListMissionControlNodeActivityData, ListMissionControlNodeActivityErrors, ListMissionControlNodeActivityResponses, ListMissionControlVoiceCandidatesData, ListMissionControlVoiceCandidatesErrors, ListMissionControlVoiceCandidatesResponses, ListPendingApprovalsData, ListPendingApprovalsErrors, ListPendingApprovalsResponses, ListProjectMembersData, ListProjectMembersErrors, ListProjectMembersResponses, ListProjectRepositoriesData, ListProjectRepositoriesErrors, ListProjectRepositoriesResponses, ListProjectRolesData, ListProjectRolesErrors, ListProjectRolesResponses, ListProjectsData, ListProjectsErrors, ListProjectsResponses, ListRetentionPoliciesData, ListRetentionPoliciesErrors, ListRetentionPoliciesResponses, ListRunEventsData, ListRunEventsErrors, ListRunEventsResponses, ListRunLearningFeedbackData, ListRunLearningFeedbackErrors, ListRunLearningFeedbackResponses, ListRuntimeErrorGroupsData, ListRuntimeErrorGroupsErrors, ListRuntimeErrorGroupsResponses, ListRuntimeErrorsData, ListRuntimeErrorsErrors, ListRuntimeErrorsResponses, ListRunWaitsData, ListRunWaitsErrors, ListRunWaitsResponses, ListStaffApiTokensData, ListStaffApiTokensErrors, ListStaffApiTokensResponses, ListSystemSettingsData, ListSystemSettingsErrors, ListSystemSettingsResponses, ListUsersData, ListUsersErrors, ListUsersResponses, LoginGithubData, LoginGithubErrors, LoginOidcData, LoginOidcErrors, LogoutData, LogoutErrors, LogoutResponses, MarkRuntimeErrorViewedData, MarkRuntimeErrorViewedErrors, MarkRuntimeErrorViewedResponses, McpApproverCallbackData, McpApproverCallbackErrors, McpApproverCallbackResponses, McpExecutorCallbackData, McpExecutorCallbackErrors, McpExecutorCallbackResponses, McpInteractionCallbackData, McpInteractionCallbackErrors, McpInteractionCallbackResponses, MissionControlRealtimeData, MissionControlRealtimeErrors, MissionControlRealtimeResponses, PreviewMissionControlLaunchData, PreviewMissionControlLaunchErrors, PreviewMissionControlLaunchResponses, PreviewNextStepActionData, PreviewNextStepActionErrors, PreviewNextStepActionResponses, PromoteMissionControlVoiceCandidateData, PromoteMissionControlVoiceCandidateErrors, PromoteMissionControlVoiceCandidateResponses, RejectMissionControlVoiceCandidateData, RejectMissionControlVoiceCandidateErrors, RejectMissionControlVoiceCandidateResponses, ResetSystemSettingData, ResetSystemSettingErrors, ResetSystemSettingResponses, ResolveApprovalDecisionData, ResolveApprovalDecisionErrors, ResolveApprovalDecisionResponses, RevokeStaffApiTokenData, RevokeStaffApiTokenErrors, RevokeStaffApiTokenResponses, RunRealtimeData, RunRealtimeErrors, RunRealtimeResponses, RunRepositoryPreflightData, RunRepositoryPreflightErrors, RunRepositoryPreflightResponses, RunsRealtimeData, RunsRealtimeErrors, RunsRealtimeResponses, RuntimeDeployTasksRealtimeData, RuntimeDeployTasksRealtimeErrors, RuntimeDeployTasksRealtimeResponses, SetFlowEventRetentionPinData, SetFlowEventRetentionPinErrors, SetFlowEventRetentionPinResponses, SetProjectMemberLearningModeOverrideData, SetProjectMemberLearningModeOverrideErrors, SetProjectMemberLearningModeOverrideResponses, StopRuntimeDeployTaskData, StopRuntimeDeployTaskErrors, StopRuntimeDeployTaskResponses, SubmitMissionControlCommandData, SubmitMissionControlCommandErrors, SubmitMissionControlCommandResponses, SyncDocsetData, SyncDocsetErrors, SyncDocsetResponses, SystemSettingsRealtimeData, SystemSettingsRealtimeErrors, SystemSettingsRealtimeResponses, UpdateRuntimeErrorGroupStatusData, UpdateRuntimeErrorGroupStatusErrors, UpdateRuntimeErrorGroupStatusResponses, UpdateSystemSettingBooleanData, UpdateSystemSettingBooleanErrors, UpdateSystemSettingBooleanResponses, UpsertMcpApprovalPolicyData, UpsertMcpApprovalPolicyErrors, UpsertMcpApprovalPolicyResponses, UpsertProjectData, UpsertProjectErrors, UpsertProjectGitHubTokensData, UpsertProjectGitHubTokensErrors, UpsertProjectGitHubTokensResponses, UpsertProjectMemberData, UpsertProjectMemberErrors, UpsertProjectMemberResponses, UpsertProjectRepositoryData, UpsertProjectRepositoryErrors, UpsertProjectRepositoryResponses, UpsertProjectResponses, UpsertProjectRoleData, UpsertProjectRoleErrors, UpsertProjectRoleResponses, UpsertRepositoryBotParamsData, UpsertRepositoryBotParamsErrors, UpsertRepositoryBotParamsResponses, UpsertRetentionPolicyData, UpsertRetentionPolicyErrors, UpsertRetentionPolicyResponses } from './types.gen';
 
 export type Options<TData extends TDataShape = TDataShape, ThrowOnError extends boolean = boolean> = Options2<TData, ThrowOnError> & {
     /**
@@ -31,6 +31,19 @@ export const ingestGithubWebhook = <ThrowOnError extends boolean = false>(option
     }
 });
 
+/**
+ * Ingest Alertmanager webhook notification
+ */
+export const ingestAlertmanagerWebhook = <ThrowOnError extends boolean = false>(options: Options<IngestAlertmanagerWebhookData, ThrowOnError>) => (options.client ?? client).post<IngestAlertmanagerWebhookResponses, IngestAlertmanagerWebhookErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/webhooks/alertmanager',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
 /**
  * Resolve one MCP approval request from external approver callback
  */
@@ -80,6 +93,16 @@ export const loginGithub = <ThrowOnError extends boolean = false>(options?: Opti
  */
 export const callbackGithub = <ThrowOnError extends boolean = false>(options: Options<CallbackGithubData, ThrowOnError>) => (options.client ?? client).get<unknown, CallbackGithubErrors, ThrowOnError>({ url: '/api/v1/auth/github/callback', ...options });
 
+/**
+ * Start generic OIDC single sign-on login (authorization code + PKCE)
+ */
+export const loginOidc = <ThrowOnError extends boolean = false>(options?: Options<LoginOidcData, ThrowOnError>) => (options?.client ?? client).get<unknown, LoginOidcErrors, ThrowOnError>({ url: '/api/v1/auth/oidc/login', ...options });
+
+/**
+ * Complete OIDC callback, verify ID token and set auth cookie
+ */
+export const callbackOidc = <ThrowOnError extends boolean = false>(options: Options<CallbackOidcData, ThrowOnError>) => (options.client ?? client).get<unknown, CallbackOidcErrors, ThrowOnError>({ url: '/api/v1/auth/oidc/callback', ...options });
+
 /**
  * Logout current staff user
  */
@@ -336,6 +359,89 @@ export const markRuntimeErrorViewed = <ThrowOnError extends boolean = false>(opt
     ...options
 });
 
+/**
+ * List runtime error groups aggregated by normalized fingerprint
+ */
+export const listRuntimeErrorGroups = <ThrowOnError extends boolean = false>(options?: Options<ListRuntimeErrorGroupsData, ThrowOnError>) => (options?.client ?? client).get<ListRuntimeErrorGroupsResponses, ListRuntimeErrorGroupsErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/staff/runtime-errors/groups',
+    ...options
+});
+
+/**
+ * Mute, resolve or reopen runtime error group
+ */
+export const updateRuntimeErrorGroupStatus = <ThrowOnError extends boolean = false>(options: Options<UpdateRuntimeErrorGroupStatusData, ThrowOnError>) => (options.client ?? client).post<UpdateRuntimeErrorGroupStatusResponses, UpdateRuntimeErrorGroupStatusErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/staff/runtime-errors/groups/{group_id}/status',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Escalate runtime error group to GitHub issue or ai-repair run
+ */
+export const escalateRuntimeErrorGroup = <ThrowOnError extends boolean = false>(options: Options<EscalateRuntimeErrorGroupData, ThrowOnError>) => (options.client ?? client).post<EscalateRuntimeErrorGroupResponses, EscalateRuntimeErrorGroupErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/staff/runtime-errors/groups/{group_id}/escalate',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * List data retention policies
+ */
+export const listRetentionPolicies = <ThrowOnError extends boolean = false>(options?: Options<ListRetentionPoliciesData, ThrowOnError>) => (options?.client ?? client).get<ListRetentionPoliciesResponses, ListRetentionPoliciesErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/staff/retention-policies',
+    ...options
+});
+
+/**
+ * Create or update data retention policy for target and scope
+ */
+export const upsertRetentionPolicy = <ThrowOnError extends boolean = false>(options: Options<UpsertRetentionPolicyData, ThrowOnError>) => (options.client ?? client).put<UpsertRetentionPolicyResponses, UpsertRetentionPolicyErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/staff/retention-policies',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Delete project-scoped retention policy override
+ */
+export const deleteRetentionPolicy = <ThrowOnError extends boolean = false>(options: Options<DeleteRetentionPolicyData, ThrowOnError>) => (options.client ?? client).delete<DeleteRetentionPolicyResponses, DeleteRetentionPolicyErrors, ThrowOnError>({ url: '/api/v1/staff/retention-policies/{policy_id}', ...options });
+
+/**
+ * Pin or unpin flow event from retention sweeps
+ */
+export const setFlowEventRetentionPin = <ThrowOnError extends boolean = false>(options: Options<SetFlowEventRetentionPinData, ThrowOnError>) => (options.client ?? client).put<SetFlowEventRetentionPinResponses, SetFlowEventRetentionPinErrors, ThrowOnError>({
+    url: '/api/v1/staff/flow-events/{event_id}/retention-pin',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Aggregate run outcomes (merge rate, revise rounds, time-to-merge) per issue/PR lineage
+ */
+export const getRunOutcomeAnalytics = <ThrowOnError extends boolean = false>(options?: Options<GetRunOutcomeAnalyticsData, ThrowOnError>) => (options?.client ?? client).get<GetRunOutcomeAnalyticsResponses, GetRunOutcomeAnalyticsErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/staff/analytics/run-outcomes',
+    ...options
+});
+
 /**
  * List users
  */
@@ -363,6 +469,46 @@ export const createUser = <ThrowOnError extends boolean = false>(options: Option
  */
 export const deleteUser = <ThrowOnError extends boolean = false>(options: Options<DeleteUserData, ThrowOnError>) => (options.client ?? client).delete<DeleteUserResponses, DeleteUserErrors, ThrowOnError>({ url: '/api/v1/staff/users/{user_id}', ...options });
 
+/**
+ * Create service account
+ */
+export const createServiceAccount = <ThrowOnError extends boolean = false>(options: Options<CreateServiceAccountData, ThrowOnError>) => (options.client ?? client).post<CreateServiceAccountResponses, CreateServiceAccountErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/staff/service-accounts',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * List staff API tokens
+ */
+export const listStaffApiTokens = <ThrowOnError extends boolean = false>(options?: Options<ListStaffApiTokensData, ThrowOnError>) => (options?.client ?? client).get<ListStaffApiTokensResponses, ListStaffApiTokensErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/staff/api-tokens',
+    ...options
+});
+
+/**
+ * Issue staff API token
+ */
+export const createStaffApiToken = <ThrowOnError extends boolean = false>(options: Options<CreateStaffApiTokenData, ThrowOnError>) => (options.client ?? client).post<CreateStaffApiTokenResponses, CreateStaffApiTokenErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/staff/api-tokens',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Revoke staff API token
+ */
+export const revokeStaffApiToken = <ThrowOnError extends boolean = false>(options: Options<RevokeStaffApiTokenData, ThrowOnError>) => (options.client ?? client).delete<RevokeStaffApiTokenResponses, RevokeStaffApiTokenErrors, ThrowOnError>({ url: '/api/v1/staff/api-tokens/{token_id}', ...options });
+
 /**
  * List project members
  */
@@ -401,6 +547,50 @@ export const setProjectMemberLearningModeOverride = <ThrowOnError extends boolea
     }
 });
 
+/**
+ * List project roles and permission catalog
+ */
+export const listProjectRoles = <ThrowOnError extends boolean = false>(options: Options<ListProjectRolesData, ThrowOnError>) => (options.client ?? client).get<ListProjectRolesResponses, ListProjectRolesErrors, ThrowOnError>({ url: '/api/v1/staff/projects/{project_id}/roles', ...options });
+
+/**
+ * Delete custom project role
+ */
+export const deleteProjectRole = <ThrowOnError extends boolean = false>(options: Options<DeleteProjectRoleData, ThrowOnError>) => (options.client ?? client).delete<DeleteProjectRoleResponses, DeleteProjectRoleErrors, ThrowOnError>({ url: '/api/v1/staff/projects/{project_id}/roles/{role_name}', ...options });
+
+/**
+ * Create or update custom project role
+ */
+export const upsertProjectRole = <ThrowOnError extends boolean = false>(options: Options<UpsertProjectRoleData, ThrowOnError>) => (options.client ?? client).put<UpsertProjectRoleResponses, UpsertProjectRoleErrors, ThrowOnError>({
+    url: '/api/v1/staff/projects/{project_id}/roles/{role_name}',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * List MCP approval policies of project
+ */
+export const listMcpApprovalPolicies = <ThrowOnError extends boolean = false>(options: Options<ListMcpApprovalPoliciesData, ThrowOnError>) => (options.client ?? client).get<ListMcpApprovalPoliciesResponses, ListMcpApprovalPoliciesErrors, ThrowOnError>({ url: '/api/v1/staff/projects/{project_id}/mcp-approval-policies', ...options });
+
+/**
+ * Create or update MCP approval policy for tool and environment
+ */
+export const upsertMcpApprovalPolicy = <ThrowOnError extends boolean = false>(options: Options<UpsertMcpApprovalPolicyData, ThrowOnError>) => (options.client ?? client).put<UpsertMcpApprovalPolicyResponses, UpsertMcpApprovalPolicyErrors, ThrowOnError>({
+    url: '/api/v1/staff/projects/{project_id}/mcp-approval-policies',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Delete MCP approval policy
+ */
+export const deleteMcpApprovalPolicy = <ThrowOnError extends boolean = false>(options: Options<DeleteMcpApprovalPolicyData, ThrowOnError>) => (options.client ?? client).delete<DeleteMcpApprovalPolicyResponses, DeleteMcpApprovalPolicyErrors, ThrowOnError>({ url: '/api/v1/staff/projects/{project_id}/mcp-approval-policies/{policy_id}', ...options });
+
 /**
  * List project repositories
  */
@@ -593,6 +783,41 @@ export const getMissionControlCommand = <ThrowOnError extends boolean = false>(o
     ...options
 });
 
+/**
+ * List Mission Control voice candidates
+ */
+export const listMissionControlVoiceCandidates = <ThrowOnError extends boolean = false>(options: Options<ListMissionControlVoiceCandidatesData, ThrowOnError>) => (options.client ?? client).get<ListMissionControlVoiceCandidatesResponses, ListMissionControlVoiceCandidatesErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/staff/mission-control/voice-candidates',
+    ...options
+});
+
+/**
+ * Confirm Mission Control voice candidate and submit its command
+ */
+export const promoteMissionControlVoiceCandidate = <ThrowOnError extends boolean = false>(options: Options<PromoteMissionControlVoiceCandidateData, ThrowOnError>) => (options.client ?? client).post<PromoteMissionControlVoiceCandidateResponses, PromoteMissionControlVoiceCandidateErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/staff/mission-control/voice-candidates/{candidate_id}/promote',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
+/**
+ * Reject Mission Control voice candidate
+ */
+export const rejectMissionControlVoiceCandidate = <ThrowOnError extends boolean = false>(options: Options<RejectMissionControlVoiceCandidateData, ThrowOnError>) => (options.client ?? client).post<RejectMissionControlVoiceCandidateResponses, RejectMissionControlVoiceCandidateErrors, ThrowOnError>({
+    responseType: 'json',
+    url: '/api/v1/staff/mission-control/voice-candidates/{candidate_id}/reject',
+    ...options,
+    headers: {
+        'Content-Type': 'application/json',
+        ...options.headers
+    }
+});
+
 /**
  * Open Mission Control realtime stream (WebSocket upgrade)
  */
@@ -17,6 +17,22 @@ export type IngestGitHubWebhookResponse = {
     duplicate: boolean;
 };
 
+export type IngestAlertmanagerWebhookResponse = {
+    correlation_id: string;
+    status: 'accepted' | 'ignored';
+    incidents: Array<AlertIncidentOutcome>;
+};
+
+export type AlertIncidentOutcome = {
+    incident_id?: string;
+    fingerprint: string;
+    alert_name: string;
+    action: 'opened' | 'reopened' | 'updated' | 'resolved' | 'ignored';
+    issue_url?: string;
+    run_id?: string;
+    run_skip_reason?: string;
+};
+
 export type MeUser = {
     id: string;
     email: string;
@@ -102,6 +118,18 @@ export type Run = {
     started_at?: string | null;
     finished_at?: string | null;
     wait_projection?: RunWaitProjection;
+    /**
+     * Run queue priority (1..100); higher runs are claimed first.
+     */
+    priority?: number | null;
+    /**
+     * Approximate 1-based claim position among pending runs in fair-share order; null when run is not pending.
+     */
+    queue_position?: number | null;
+    /**
+     * Rough queue wait estimate from recent project run durations; null when unknown.
+     */
+    estimated_wait_seconds?: number | null;
 };
 
 export type RunWaitProjection = {
@@ -181,6 +209,20 @@ export type ApprovalRequest = {
     approval_mode: 'none' | 'owner' | 'delegated';
     requested_by: string;
     created_at: string;
+    /**
+     * Approvals required by project policy; 0 means the request is resolved by a single decision.
+     */
+    required_approvals: number;
+    approvals_count: number;
+    votes: Array<ApprovalVote>;
+    expires_at?: string | null;
+};
+
+export type ApprovalVote = {
+    actor_id: string;
+    decision: 'approved' | 'denied';
+    reason?: string | null;
+    voted_at?: string | null;
 };
 
 export type ResolveApprovalDecisionRequest = {
@@ -249,13 +291,17 @@ export type ResolveApprovalDecisionResponse = {
     tool_name: string;
     action: string;
     approval_state: 'requested' | 'approved' | 'denied' | 'expired' | 'failed' | 'applied';
+    required_approvals: number;
+    approvals_count: number;
 };
 
 export type FlowEvent = {
+    id: number;
     correlation_id: string;
     event_type: string;
     created_at: string;
     payload_json: string;
+    retention_pinned: boolean;
 };
 
 export type RunLogs = {
@@ -350,6 +396,104 @@ export type RuntimeError = {
     viewed_at?: string | null;
     viewed_by?: string | null;
     created_at: string;
+    group_id?: string | null;
+};
+
+export type RuntimeErrorGroup = {
+    id: string;
+    project_id?: string | null;
+    fingerprint: string;
+    source: string;
+    error_class: string;
+    normalized_message: string;
+    sample_message: string;
+    level: 'error' | 'warning' | 'critical';
+    status: 'open' | 'muted' | 'resolved';
+    muted_until?: string | null;
+    status_changed_at?: string | null;
+    status_changed_by?: string | null;
+    resolved_at?: string | null;
+    occurrences: number;
+    regression_count: number;
+    affected_run_count: number;
+    first_seen_at: string;
+    last_seen_at: string;
+    last_run_id?: string | null;
+    escalation_kind?: 'issue' | 'ai_repair' | null;
+    escalated_at?: string | null;
+    escalated_by?: string | null;
+    repository_full_name?: string | null;
+    issue_number?: number | null;
+    issue_url?: string | null;
+    created_at: string;
+    updated_at: string;
+};
+
+export type UpdateRuntimeErrorGroupStatusRequest = {
+    status: 'open' | 'muted' | 'resolved';
+    muted_until?: string;
+};
+
+export type EscalateRuntimeErrorGroupRequest = {
+    kind: 'issue' | 'ai_repair';
+};
+
+export type RunOutcomeGroup = {
+    project_id: string;
+    role: string;
+    trigger_kind: string;
+    model: string;
+    reasoning_effort: string;
+    prompt_source: string;
+    lineages: number;
+    runs: number;
+    revise_runs: number;
+    failed_runs: number;
+    changes_requested: number;
+    prs_opened: number;
+    prs_merged: number;
+    prs_closed_unmerged: number;
+    merge_rate: number;
+    avg_revise_rounds: number;
+    median_time_to_merge_seconds: number;
+    avg_wall_clock_seconds: number;
+    agent_seconds: number;
+};
+
+export type RunOutcomeAnalytics = {
+    from: string;
+    to: string;
+    group_by: Array<string>;
+    totals: RunOutcomeGroup;
+    groups: Array<RunOutcomeGroup>;
+};
+
+export type RetentionPolicy = {
+    id: string;
+    project_id?: string | null;
+    target: 'flow_events' | 'agent_run_logs' | 'agent_sessions' | 'interaction_delivery_attempts' | 'interaction_callback_events' | 'mission_control_timeline_entries' | 'github_rate_limit_wait_evidence';
+    retention_days: number;
+    action: 'delete' | 'archive';
+    enabled: boolean;
+    updated_by?: string | null;
+    last_swept_at?: string | null;
+    last_removed_count: number;
+    last_archive_key?: string | null;
+    last_error?: string | null;
+    created_at: string;
+    updated_at: string;
+};
+
+export type UpsertRetentionPolicyRequest = {
+    target: 'flow_events' | 'agent_run_logs' | 'agent_sessions' | 'interaction_delivery_attempts' | 'interaction_callback_events' | 'mission_control_timeline_entries' | 'github_rate_limit_wait_evidence';
+    project_id?: string | null;
+    retention_days: number;
+    action: 'delete' | 'archive';
+    enabled: boolean;
+};
+
+export type SetFlowEventRetentionPinRequest = {
+    pinned: boolean;
 };
 
 export type LearningFeedback = {
@@ -371,16 +515,104 @@ export type User = {
     github_login?: string | null;
     is_platform_admin: boolean;
     is_platform_owner: boolean;
+    is_service_account?: boolean;
+};
+
+export type StaffApiTokenScope = 'projects:read' | 'runs:read' | 'runs:cancel' | 'repositories:manage' | 'mission_control:commands' | 'admin';
+
+export type StaffApiToken = {
+    id: string;
+    user_id: string;
+    user_email: string;
+    name: string;
+    /**
+     * Leading characters of the token to recognize it; the secret itself is never returned again.
+     */
+    token_prefix: string;
+    scopes: Array<StaffApiTokenScope>;
+    /**
+     * Projects the token is restricted to; empty means no project restriction.
+     */
+    project_ids: Array<string>;
+    expires_at?: string | null;
+    last_used_at?: string | null;
+    revoked_at?: string | null;
+    created_by?: string | null;
+    created_at: string;
 };
 
 export type ProjectMember = {
     project_id: string;
     user_id: string;
     email: string;
-    role: 'read' | 'read_write' | 'admin';
+    /**
+     * Built-in role (read, read_write, admin) or custom project role name.
+     */
+    role: string;
     learning_mode_override?: boolean | null;
 };
 
+export type ProjectRole = {
+    project_id: string;
+    name: string;
+    description: string;
+    /**
+     * Permission grants in "<permission>" or "<permission>:<qualifier>" form.
+     */
+    permissions: Array<string>;
+    builtin: boolean;
+    created_at?: string | null;
+    updated_at?: string | null;
+};
+
+export type ProjectPermissionDescriptor = {
+    permission: string;
+    qualified: boolean;
+};
+
+export type ProjectRolesResponse = {
+    items: Array<ProjectRole>;
+    catalog: Array<ProjectPermissionDescriptor>;
+};
+
+export type UpsertProjectRoleRequest = {
+    description?: string;
+    permissions: Array<string>;
+};
+
+export type McpApprovalPolicy = {
+    id: string;
+    project_id: string;
+    tool_name: 'secret.sync.k8s' | 'database.lifecycle' | 'owner.feedback.request';
+    /**
+     * Empty value matches every environment without a more specific policy.
+     */
+    environment: string;
+    required_approvals: number;
+    eligible_roles: Array<string>;
+    eligible_user_ids: Array<string>;
+    /**
+     * Run initiator cannot approve the request.
+     */
+    separation_of_duties: boolean;
+    /**
+     * Pending requests are auto-rejected after this period; 0 disables expiry.
+     */
+    expires_after_seconds: number;
+    created_at?: string | null;
+    updated_at?: string | null;
+};
+
+export type UpsertMcpApprovalPolicyRequest = {
+    tool_name: 'secret.sync.k8s' | 'database.lifecycle' | 'owner.feedback.request';
+    environment?: string;
+    required_approvals: number;
+    eligible_roles?: Array<string>;
+    eligible_user_ids?: Array<string>;
+    separation_of_duties?: boolean;
+    expires_after_seconds?: number;
+};
+
 export type RepositoryBinding = {
     id: string;
     project_id: string;
@@ -1042,6 +1274,50 @@ export type MissionControlTimelineItemsResponse = {
     next_cursor?: string | null;
 };
 
+export type MissionControlVoiceCandidate = {
+    candidate_id: string;
+    project_id: string;
+    status: 'draft' | 'promoted' | 'rejected' | 'expired';
+    source_kind: 'voice' | 'audio_upload' | 'transcript';
+    command_kind: 'discussion.create' | 'work_item.create';
+    transcript_excerpt: string;
+    structured_summary: string;
+    transcript: string;
+    confidence: number;
+    draft_title: string;
+    draft_body_markdown?: string | null;
+    draft_initial_labels: Array<string>;
+    promoted_command_id?: string | null;
+    created_by: string;
+    source_adapter?: string | null;
+    decided_by?: string | null;
+    reject_reason?: string | null;
+    created_at: string;
+    decided_at?: string | null;
+};
+
+export type MissionControlVoiceCandidateItemsResponse = {
+    items: Array<MissionControlVoiceCandidate>;
+};
+
+export type MissionControlVoiceCandidatePromoteRequest = {
+    project_id: string;
+    command_kind?: 'discussion.create' | 'work_item.create';
+    title?: string;
+    body_markdown?: string;
+    initial_labels?: Array<string>;
+};
+
+export type MissionControlVoiceCandidatePromotion = {
+    candidate: MissionControlVoiceCandidate;
+    command?: MissionControlCommandState;
+};
+
+export type MissionControlVoiceCandidateRejectRequest = {
+    project_id: string;
+    reason?: string;
+};
+
 export type ProjectItemsResponse = {
     items: Array<Project>;
 };
@@ -1062,10 +1338,22 @@ export type RuntimeErrorItemsResponse = {
     items: Array<RuntimeError>;
 };
 
+export type RuntimeErrorGroupItemsResponse = {
+    items: Array<RuntimeErrorGroup>;
+};
+
+export type RetentionPolicyItemsResponse = {
+    items: Array<RetentionPolicy>;
+};
+
 export type ApprovalRequestItemsResponse = {
     items: Array<ApprovalRequest>;
 };
 
+export type McpApprovalPolicyItemsResponse = {
+    items: Array<McpApprovalPolicy>;
+};
+
 export type FlowEventItemsResponse = {
     items: Array<FlowEvent>;
 };
@@ -1074,6 +1362,10 @@ export type LearningFeedbackItemsResponse = {
     items: Array<LearningFeedback>;
 };
 
+export type StaffApiTokenItemsResponse = {
+    items: Array<StaffApiToken>;
+};
+
 export type UserItemsResponse = {
     items: Array<User>;
 };
@@ -1091,6 +1383,33 @@ export type UpsertProjectRequest = {
     name: string;
 };
 
+export type CreateServiceAccountRequest = {
+    /**
+     * DNS label; service account email becomes `<name>@service-accounts.kodex.invalid`.
+     */
+    name: string;
+    is_platform_admin?: boolean;
+};
+
+export type CreateStaffApiTokenRequest = {
+    /**
+     * Token owner: the requesting admin (default) or a service account.
+     */
+    user_id?: string;
+    name: string;
+    scopes: Array<StaffApiTokenScope>;
+    project_ids?: Array<string>;
+    expires_in_days?: number;
+};
+
+export type CreateStaffApiTokenResponse = {
+    token: StaffApiToken;
+    /**
+     * Raw bearer token (`kxt_...`); returned only once.
+     */
+    secret: string;
+};
+
 export type CreateUserRequest = {
     email: string;
     is_platform_admin: boolean;
@@ -1099,7 +1418,10 @@ export type CreateUserRequest = {
 export type UpsertProjectMemberRequest = unknown & {
     user_id?: string;
     email?: string;
-    role?: 'read' | 'read_write' | 'admin';
+    /**
+     * Built-in role (read, read_write, admin) or custom project role name.
+     */
+    role?: string;
 };
 
 export type SetProjectMemberLearningModeRequest = {
@@ -1124,6 +1446,12 @@ export type RunId = string;
 
 export type RuntimeErrorId = string;
 
+export type RuntimeErrorGroupId = string;
+
+export type RetentionPolicyId = string;
+
+export type FlowEventId = number;
+
 export type ApprovalRequestId = number;
 
 export type SettingKey = string;
@@ -1193,6 +1521,8 @@ export type MissionControlNodePublicId = string;
 
 export type MissionControlCommandId = string;
 
+export type MissionControlVoiceCandidateId = string;
+
 export type IngestGithubWebhookData = {
     body: {
         [key: string]: unknown;
@@ -1237,6 +1567,60 @@ export type IngestGithubWebhookResponses = {
 
 export type IngestGithubWebhookResponse = IngestGithubWebhookResponses[keyof IngestGithubWebhookResponses];
 
+export type IngestAlertmanagerWebhookData = {
+    body: {
+        [key: string]: unknown;
+    };
+    headers: {
+        /**
+         * Bearer token configured as KODEX_ALERTMANAGER_WEBHOOK_TOKEN.
+         */
+        Authorization: string;
+    };
+    path?: never;
+    query?: {
+        /**
+         * Target project. Falls back to `kodex_project_id` common alert label.
+         */
+        project_id?: string;
+    };
+    url: '/api/v1/webhooks/alertmanager';
+};
+
+export type IngestAlertmanagerWebhookErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+    /**
+     * Internal error
+     */
+    500: ErrorResponse;
+};
+
+export type IngestAlertmanagerWebhookError = IngestAlertmanagerWebhookErrors[keyof IngestAlertmanagerWebhookErrors];
+
+export type IngestAlertmanagerWebhookResponses = {
+    /**
+     * Notification ignored
+     */
+    200: IngestAlertmanagerWebhookResponse;
+    /**
+     * Accepted, at least one incident was opened or updated
+     */
+    202: IngestAlertmanagerWebhookResponse;
+};
+
+export type IngestAlertmanagerWebhookResponse2 = IngestAlertmanagerWebhookResponses[keyof IngestAlertmanagerWebhookResponses];
+
 export type McpApproverCallbackData = {
     body: McpApprovalCallbackRequest;
     headers?: {
@@ -1405,6 +1789,62 @@ export type CallbackGithubErrors = {
 
 export type CallbackGithubError = CallbackGithubErrors[keyof CallbackGithubErrors];
 
+export type LoginOidcData = {
+    body?: never;
+    path?: never;
+    query?: never;
+    url: '/api/v1/auth/oidc/login';
+};
+
+export type LoginOidcErrors = {
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+    /**
+     * Internal error
+     */
+    500: ErrorResponse;
+};
+
+export type LoginOidcError = LoginOidcErrors[keyof LoginOidcErrors];
+
+export type CallbackOidcData = {
+    body?: never;
+    path?: never;
+    query: {
+        state: string;
+        code?: string;
+        error?: string;
+    };
+    url: '/api/v1/auth/oidc/callback';
+};
+
+export type CallbackOidcErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+    /**
+     * Internal error
+     */
+    500: ErrorResponse;
+};
+
+export type CallbackOidcError = CallbackOidcErrors[keyof CallbackOidcErrors];
+
 export type LogoutData = {
     body?: never;
     path?: never;
@@ -2398,16 +2838,20 @@ export type MarkRuntimeErrorViewedResponses = {
 
 export type MarkRuntimeErrorViewedResponse = MarkRuntimeErrorViewedResponses[keyof MarkRuntimeErrorViewedResponses];
 
-export type ListUsersData = {
+export type ListRuntimeErrorGroupsData = {
     body?: never;
     path?: never;
     query?: {
         limit?: number;
+        status?: 'open' | 'muted' | 'resolved' | 'all';
+        level?: 'error' | 'warning' | 'critical';
+        source?: string;
+        project_id?: string;
     };
-    url: '/api/v1/staff/users';
+    url: '/api/v1/staff/runtime-errors/groups';
 };
 
-export type ListUsersErrors = {
+export type ListRuntimeErrorGroupsErrors = {
     /**
      * Invalid request
      */
@@ -2416,27 +2860,33 @@ export type ListUsersErrors = {
      * Unauthorized
      */
     401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
 };
 
-export type ListUsersError = ListUsersErrors[keyof ListUsersErrors];
+export type ListRuntimeErrorGroupsError = ListRuntimeErrorGroupsErrors[keyof ListRuntimeErrorGroupsErrors];
 
-export type ListUsersResponses = {
+export type ListRuntimeErrorGroupsResponses = {
     /**
-     * Users list
+     * Runtime error groups
      */
-    200: UserItemsResponse;
+    200: RuntimeErrorGroupItemsResponse;
 };
 
-export type ListUsersResponse = ListUsersResponses[keyof ListUsersResponses];
+export type ListRuntimeErrorGroupsResponse = ListRuntimeErrorGroupsResponses[keyof ListRuntimeErrorGroupsResponses];
 
-export type CreateUserData = {
-    body: CreateUserRequest;
-    path?: never;
+export type UpdateRuntimeErrorGroupStatusData = {
+    body: UpdateRuntimeErrorGroupStatusRequest;
+    path: {
+        group_id: string;
+    };
     query?: never;
-    url: '/api/v1/staff/users';
+    url: '/api/v1/staff/runtime-errors/groups/{group_id}/status';
 };
 
-export type CreateUserErrors = {
+export type UpdateRuntimeErrorGroupStatusErrors = {
     /**
      * Invalid request
      */
@@ -2449,29 +2899,585 @@ export type CreateUserErrors = {
      * Forbidden
      */
     403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
 };
 
-export type CreateUserError = CreateUserErrors[keyof CreateUserErrors];
+export type UpdateRuntimeErrorGroupStatusError = UpdateRuntimeErrorGroupStatusErrors[keyof UpdateRuntimeErrorGroupStatusErrors];
 
-export type CreateUserResponses = {
+export type UpdateRuntimeErrorGroupStatusResponses = {
     /**
-     * User created
+     * Runtime error group status updated
      */
-    201: User;
+    200: RuntimeErrorGroup;
 };
 
-export type CreateUserResponse = CreateUserResponses[keyof CreateUserResponses];
+export type UpdateRuntimeErrorGroupStatusResponse = UpdateRuntimeErrorGroupStatusResponses[keyof UpdateRuntimeErrorGroupStatusResponses];
 
-export type DeleteUserData = {
-    body?: never;
+export type EscalateRuntimeErrorGroupData = {
+    body: EscalateRuntimeErrorGroupRequest;
     path: {
-        user_id: string;
+        group_id: string;
+    };
+    query?: never;
+    url: '/api/v1/staff/runtime-errors/groups/{group_id}/escalate';
+};
+
+export type EscalateRuntimeErrorGroupErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+};
+
+export type EscalateRuntimeErrorGroupError = EscalateRuntimeErrorGroupErrors[keyof EscalateRuntimeErrorGroupErrors];
+
+export type EscalateRuntimeErrorGroupResponses = {
+    /**
+     * Runtime error group escalated
+     */
+    200: RuntimeErrorGroup;
+};
+
+export type EscalateRuntimeErrorGroupResponse = EscalateRuntimeErrorGroupResponses[keyof EscalateRuntimeErrorGroupResponses];
+
+export type ListRetentionPoliciesData = {
+    body?: never;
+    path?: never;
+    query?: {
+        /**
+         * Return only overrides for this project; global defaults are always included.
+         */
+        project_id?: string;
+    };
+    url: '/api/v1/staff/retention-policies';
+};
+
+export type ListRetentionPoliciesErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+};
+
+export type ListRetentionPoliciesError = ListRetentionPoliciesErrors[keyof ListRetentionPoliciesErrors];
+
+export type ListRetentionPoliciesResponses = {
+    /**
+     * Retention policies list
+     */
+    200: RetentionPolicyItemsResponse;
+};
+
+export type ListRetentionPoliciesResponse = ListRetentionPoliciesResponses[keyof ListRetentionPoliciesResponses];
+
+export type UpsertRetentionPolicyData = {
+    body: UpsertRetentionPolicyRequest;
+    path?: never;
+    query?: never;
+    url: '/api/v1/staff/retention-policies';
+};
+
+export type UpsertRetentionPolicyErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+};
+
+export type UpsertRetentionPolicyError = UpsertRetentionPolicyErrors[keyof UpsertRetentionPolicyErrors];
+
+export type UpsertRetentionPolicyResponses = {
+    /**
+     * Retention policy saved
+     */
+    200: RetentionPolicy;
+};
+
+export type UpsertRetentionPolicyResponse = UpsertRetentionPolicyResponses[keyof UpsertRetentionPolicyResponses];
+
+export type DeleteRetentionPolicyData = {
+    body?: never;
+    path: {
+        policy_id: string;
+    };
+    query?: never;
+    url: '/api/v1/staff/retention-policies/{policy_id}';
+};
+
+export type DeleteRetentionPolicyErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+};
+
+export type DeleteRetentionPolicyError = DeleteRetentionPolicyErrors[keyof DeleteRetentionPolicyErrors];
+
+export type DeleteRetentionPolicyResponses = {
+    /**
+     * Retention policy override deleted
+     */
+    204: void;
+};
+
+export type DeleteRetentionPolicyResponse = DeleteRetentionPolicyResponses[keyof DeleteRetentionPolicyResponses];
+
+export type SetFlowEventRetentionPinData = {
+    body: SetFlowEventRetentionPinRequest;
+    path: {
+        event_id: number;
+    };
+    query?: never;
+    url: '/api/v1/staff/flow-events/{event_id}/retention-pin';
+};
+
+export type SetFlowEventRetentionPinErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+};
+
+export type SetFlowEventRetentionPinError = SetFlowEventRetentionPinErrors[keyof SetFlowEventRetentionPinErrors];
+
+export type SetFlowEventRetentionPinResponses = {
+    /**
+     * Flow event retention pin updated
+     */
+    204: void;
+};
+
+export type SetFlowEventRetentionPinResponse = SetFlowEventRetentionPinResponses[keyof SetFlowEventRetentionPinResponses];
+
+export type GetRunOutcomeAnalyticsData = {
+    body?: never;
+    path?: never;
+    query?: {
+        project_id?: string;
+        /**
+         * Lineages whose first run was created at or after this RFC3339 timestamp. Defaults to `to` minus 30 days.
+         */
+        from?: string;
+        /**
+         * Exclusive RFC3339 upper bound; defaults to now. Range must not exceed 366 days.
+         */
+        to?: string;
+        /**
+         * Grouping keys, repeated or comma-separated. Defaults to all keys.
+         */
+        group_by?: Array<'project' | 'role' | 'trigger' | 'model' | 'reasoning' | 'prompt_source'>;
+    };
+    url: '/api/v1/staff/analytics/run-outcomes';
+};
+
+export type GetRunOutcomeAnalyticsErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+};
+
+export type GetRunOutcomeAnalyticsError = GetRunOutcomeAnalyticsErrors[keyof GetRunOutcomeAnalyticsErrors];
+
+export type GetRunOutcomeAnalyticsResponses = {
+    /**
+     * Run outcome analytics
+     */
+    200: RunOutcomeAnalytics;
+};
+
+export type GetRunOutcomeAnalyticsResponse = GetRunOutcomeAnalyticsResponses[keyof GetRunOutcomeAnalyticsResponses];
+
+export type ListUsersData = {
+    body?: never;
+    path?: never;
+    query?: {
+        limit?: number;
+    };
+    url: '/api/v1/staff/users';
+};
+
+export type ListUsersErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+};
+
+export type ListUsersError = ListUsersErrors[keyof ListUsersErrors];
+
+export type ListUsersResponses = {
+    /**
+     * Users list
+     */
+    200: UserItemsResponse;
+};
+
+export type ListUsersResponse = ListUsersResponses[keyof ListUsersResponses];
+
+export type CreateUserData = {
+    body: CreateUserRequest;
+    path?: never;
+    query?: never;
+    url: '/api/v1/staff/users';
+};
+
+export type CreateUserErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+};
+
+export type CreateUserError = CreateUserErrors[keyof CreateUserErrors];
+
+export type CreateUserResponses = {
+    /**
+     * User created
+     */
+    201: User;
+};
+
+export type CreateUserResponse = CreateUserResponses[keyof CreateUserResponses];
+
+export type DeleteUserData = {
+    body?: never;
+    path: {
+        user_id: string;
     };
     query?: never;
     url: '/api/v1/staff/users/{user_id}';
 };
 
-export type DeleteUserErrors = {
+export type DeleteUserErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+};
+
+export type DeleteUserError = DeleteUserErrors[keyof DeleteUserErrors];
+
+export type DeleteUserResponses = {
+    /**
+     * User deleted
+     */
+    204: void;
+};
+
+export type DeleteUserResponse = DeleteUserResponses[keyof DeleteUserResponses];
+
+export type CreateServiceAccountData = {
+    body: CreateServiceAccountRequest;
+    path?: never;
+    query?: never;
+    url: '/api/v1/staff/service-accounts';
+};
+
+export type CreateServiceAccountErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+    /**
+     * Conflict
+     */
+    409: ErrorResponse;
+};
+
+export type CreateServiceAccountError = CreateServiceAccountErrors[keyof CreateServiceAccountErrors];
+
+export type CreateServiceAccountResponses = {
+    /**
+     * Service account created
+     */
+    201: User;
+};
+
+export type CreateServiceAccountResponse = CreateServiceAccountResponses[keyof CreateServiceAccountResponses];
+
+export type ListStaffApiTokensData = {
+    body?: never;
+    path?: never;
+    query?: {
+        user_id?: string;
+        limit?: number;
+    };
+    url: '/api/v1/staff/api-tokens';
+};
+
+export type ListStaffApiTokensErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+};
+
+export type ListStaffApiTokensError = ListStaffApiTokensErrors[keyof ListStaffApiTokensErrors];
+
+export type ListStaffApiTokensResponses = {
+    /**
+     * Staff API tokens list
+     */
+    200: StaffApiTokenItemsResponse;
+};
+
+export type ListStaffApiTokensResponse = ListStaffApiTokensResponses[keyof ListStaffApiTokensResponses];
+
+export type CreateStaffApiTokenData = {
+    body: CreateStaffApiTokenRequest;
+    path?: never;
+    query?: never;
+    url: '/api/v1/staff/api-tokens';
+};
+
+export type CreateStaffApiTokenErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+};
+
+export type CreateStaffApiTokenError = CreateStaffApiTokenErrors[keyof CreateStaffApiTokenErrors];
+
+export type CreateStaffApiTokenResponses = {
+    /**
+     * Staff API token issued
+     */
+    201: CreateStaffApiTokenResponse;
+};
+
+export type CreateStaffApiTokenResponse2 = CreateStaffApiTokenResponses[keyof CreateStaffApiTokenResponses];
+
+export type RevokeStaffApiTokenData = {
+    body?: never;
+    path: {
+        token_id: string;
+    };
+    query?: never;
+    url: '/api/v1/staff/api-tokens/{token_id}';
+};
+
+export type RevokeStaffApiTokenErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+};
+
+export type RevokeStaffApiTokenError = RevokeStaffApiTokenErrors[keyof RevokeStaffApiTokenErrors];
+
+export type RevokeStaffApiTokenResponses = {
+    /**
+     * Staff API token revoked
+     */
+    204: void;
+};
+
+export type RevokeStaffApiTokenResponse = RevokeStaffApiTokenResponses[keyof RevokeStaffApiTokenResponses];
+
+export type ListProjectMembersData = {
+    body?: never;
+    path: {
+        project_id: string;
+    };
+    query?: {
+        limit?: number;
+    };
+    url: '/api/v1/staff/projects/{project_id}/members';
+};
+
+export type ListProjectMembersErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+};
+
+export type ListProjectMembersError = ListProjectMembersErrors[keyof ListProjectMembersErrors];
+
+export type ListProjectMembersResponses = {
+    /**
+     * Project members list
+     */
+    200: ProjectMemberItemsResponse;
+};
+
+export type ListProjectMembersResponse = ListProjectMembersResponses[keyof ListProjectMembersResponses];
+
+export type UpsertProjectMemberData = {
+    body: UpsertProjectMemberRequest;
+    path: {
+        project_id: string;
+    };
+    query?: never;
+    url: '/api/v1/staff/projects/{project_id}/members';
+};
+
+export type UpsertProjectMemberErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+};
+
+export type UpsertProjectMemberError = UpsertProjectMemberErrors[keyof UpsertProjectMemberErrors];
+
+export type UpsertProjectMemberResponses = {
+    /**
+     * Member upserted
+     */
+    204: void;
+};
+
+export type UpsertProjectMemberResponse = UpsertProjectMemberResponses[keyof UpsertProjectMemberResponses];
+
+export type DeleteProjectMemberData = {
+    body?: never;
+    path: {
+        project_id: string;
+        user_id: string;
+    };
+    query?: never;
+    url: '/api/v1/staff/projects/{project_id}/members/{user_id}';
+};
+
+export type DeleteProjectMemberErrors = {
     /**
      * Invalid request
      */
@@ -2486,29 +3492,63 @@ export type DeleteUserErrors = {
     403: ErrorResponse;
 };
 
-export type DeleteUserError = DeleteUserErrors[keyof DeleteUserErrors];
+export type DeleteProjectMemberError = DeleteProjectMemberErrors[keyof DeleteProjectMemberErrors];
 
-export type DeleteUserResponses = {
+export type DeleteProjectMemberResponses = {
     /**
-     * User deleted
+     * Member deleted
      */
     204: void;
 };
 
-export type DeleteUserResponse = DeleteUserResponses[keyof DeleteUserResponses];
+export type DeleteProjectMemberResponse = DeleteProjectMemberResponses[keyof DeleteProjectMemberResponses];
 
-export type ListProjectMembersData = {
-    body?: never;
+export type SetProjectMemberLearningModeOverrideData = {
+    body: SetProjectMemberLearningModeRequest;
     path: {
         project_id: string;
+        user_id: string;
     };
-    query?: {
-        limit?: number;
+    query?: never;
+    url: '/api/v1/staff/projects/{project_id}/members/{user_id}/learning-mode';
+};
+
+export type SetProjectMemberLearningModeOverrideErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+};
+
+export type SetProjectMemberLearningModeOverrideError = SetProjectMemberLearningModeOverrideErrors[keyof SetProjectMemberLearningModeOverrideErrors];
+
+export type SetProjectMemberLearningModeOverrideResponses = {
+    /**
+     * Learning mode override updated
+     */
+    204: void;
+};
+
+export type SetProjectMemberLearningModeOverrideResponse = SetProjectMemberLearningModeOverrideResponses[keyof SetProjectMemberLearningModeOverrideResponses];
+
+export type ListProjectRolesData = {
+    body?: never;
+    path: {
+        project_id: string;
     };
-    url: '/api/v1/staff/projects/{project_id}/members';
+    query?: never;
+    url: '/api/v1/staff/projects/{project_id}/roles';
 };
 
-export type ListProjectMembersErrors = {
+export type ListProjectRolesErrors = {
     /**
      * Invalid request
      */
@@ -2517,29 +3557,34 @@ export type ListProjectMembersErrors = {
      * Unauthorized
      */
     401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
 };
 
-export type ListProjectMembersError = ListProjectMembersErrors[keyof ListProjectMembersErrors];
+export type ListProjectRolesError = ListProjectRolesErrors[keyof ListProjectRolesErrors];
 
-export type ListProjectMembersResponses = {
+export type ListProjectRolesResponses = {
     /**
-     * Project members list
+     * Built-in and custom project roles with permission catalog
      */
-    200: ProjectMemberItemsResponse;
+    200: ProjectRolesResponse;
 };
 
-export type ListProjectMembersResponse = ListProjectMembersResponses[keyof ListProjectMembersResponses];
+export type ListProjectRolesResponse = ListProjectRolesResponses[keyof ListProjectRolesResponses];
 
-export type UpsertProjectMemberData = {
-    body: UpsertProjectMemberRequest;
+export type DeleteProjectRoleData = {
+    body?: never;
     path: {
         project_id: string;
+        role_name: string;
     };
     query?: never;
-    url: '/api/v1/staff/projects/{project_id}/members';
+    url: '/api/v1/staff/projects/{project_id}/roles/{role_name}';
 };
 
-export type UpsertProjectMemberErrors = {
+export type DeleteProjectRoleErrors = {
     /**
      * Invalid request
      */
@@ -2552,30 +3597,77 @@ export type UpsertProjectMemberErrors = {
      * Forbidden
      */
     403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+    /**
+     * Conflict
+     */
+    409: ErrorResponse;
 };
 
-export type UpsertProjectMemberError = UpsertProjectMemberErrors[keyof UpsertProjectMemberErrors];
+export type DeleteProjectRoleError = DeleteProjectRoleErrors[keyof DeleteProjectRoleErrors];
 
-export type UpsertProjectMemberResponses = {
+export type DeleteProjectRoleResponses = {
     /**
-     * Member upserted
+     * Custom project role deleted
      */
     204: void;
 };
 
-export type UpsertProjectMemberResponse = UpsertProjectMemberResponses[keyof UpsertProjectMemberResponses];
+export type DeleteProjectRoleResponse = DeleteProjectRoleResponses[keyof DeleteProjectRoleResponses];
 
-export type DeleteProjectMemberData = {
+export type UpsertProjectRoleData = {
+    body: UpsertProjectRoleRequest;
+    path: {
+        project_id: string;
+        role_name: string;
+    };
+    query?: never;
+    url: '/api/v1/staff/projects/{project_id}/roles/{role_name}';
+};
+
+export type UpsertProjectRoleErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+};
+
+export type UpsertProjectRoleError = UpsertProjectRoleErrors[keyof UpsertProjectRoleErrors];
+
+export type UpsertProjectRoleResponses = {
+    /**
+     * Custom project role
+     */
+    200: ProjectRole;
+};
+
+export type UpsertProjectRoleResponse = UpsertProjectRoleResponses[keyof UpsertProjectRoleResponses];
+
+export type ListMcpApprovalPoliciesData = {
     body?: never;
     path: {
         project_id: string;
-        user_id: string;
     };
     query?: never;
-    url: '/api/v1/staff/projects/{project_id}/members/{user_id}';
+    url: '/api/v1/staff/projects/{project_id}/mcp-approval-policies';
 };
 
-export type DeleteProjectMemberErrors = {
+export type ListMcpApprovalPoliciesErrors = {
     /**
      * Invalid request
      */
@@ -2590,28 +3682,27 @@ export type DeleteProjectMemberErrors = {
     403: ErrorResponse;
 };
 
-export type DeleteProjectMemberError = DeleteProjectMemberErrors[keyof DeleteProjectMemberErrors];
+export type ListMcpApprovalPoliciesError = ListMcpApprovalPoliciesErrors[keyof ListMcpApprovalPoliciesErrors];
 
-export type DeleteProjectMemberResponses = {
+export type ListMcpApprovalPoliciesResponses = {
     /**
-     * Member deleted
+     * Multi-approver policies for project MCP tools
      */
-    204: void;
+    200: McpApprovalPolicyItemsResponse;
 };
 
-export type DeleteProjectMemberResponse = DeleteProjectMemberResponses[keyof DeleteProjectMemberResponses];
+export type ListMcpApprovalPoliciesResponse = ListMcpApprovalPoliciesResponses[keyof ListMcpApprovalPoliciesResponses];
 
-export type SetProjectMemberLearningModeOverrideData = {
-    body: SetProjectMemberLearningModeRequest;
+export type UpsertMcpApprovalPolicyData = {
+    body: UpsertMcpApprovalPolicyRequest;
     path: {
         project_id: string;
-        user_id: string;
     };
     query?: never;
-    url: '/api/v1/staff/projects/{project_id}/members/{user_id}/learning-mode';
+    url: '/api/v1/staff/projects/{project_id}/mcp-approval-policies';
 };
 
-export type SetProjectMemberLearningModeOverrideErrors = {
+export type UpsertMcpApprovalPolicyErrors = {
     /**
      * Invalid request
      */
@@ -2626,16 +3717,56 @@ export type SetProjectMemberLearningModeOverrideErrors = {
     403: ErrorResponse;
 };
 
-export type SetProjectMemberLearningModeOverrideError = SetProjectMemberLearningModeOverrideErrors[keyof SetProjectMemberLearningModeOverrideErrors];
+export type UpsertMcpApprovalPolicyError = UpsertMcpApprovalPolicyErrors[keyof UpsertMcpApprovalPolicyErrors];
 
-export type SetProjectMemberLearningModeOverrideResponses = {
+export type UpsertMcpApprovalPolicyResponses = {
     /**
-     * Learning mode override updated
+     * MCP approval policy
+     */
+    200: McpApprovalPolicy;
+};
+
+export type UpsertMcpApprovalPolicyResponse = UpsertMcpApprovalPolicyResponses[keyof UpsertMcpApprovalPolicyResponses];
+
+export type DeleteMcpApprovalPolicyData = {
+    body?: never;
+    path: {
+        project_id: string;
+        policy_id: string;
+    };
+    query?: never;
+    url: '/api/v1/staff/projects/{project_id}/mcp-approval-policies/{policy_id}';
+};
+
+export type DeleteMcpApprovalPolicyErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+};
+
+export type DeleteMcpApprovalPolicyError = DeleteMcpApprovalPolicyErrors[keyof DeleteMcpApprovalPolicyErrors];
+
+export type DeleteMcpApprovalPolicyResponses = {
+    /**
+     * MCP approval policy deleted
      */
     204: void;
 };
 
-export type SetProjectMemberLearningModeOverrideResponse = SetProjectMemberLearningModeOverrideResponses[keyof SetProjectMemberLearningModeOverrideResponses];
+export type DeleteMcpApprovalPolicyResponse = DeleteMcpApprovalPolicyResponses[keyof DeleteMcpApprovalPolicyResponses];
 
 export type ListProjectRepositoriesData = {
     body?: never;
@@ -3324,6 +4455,133 @@ export type GetMissionControlCommandResponses = {
 
 export type GetMissionControlCommandResponse = GetMissionControlCommandResponses[keyof GetMissionControlCommandResponses];
 
+export type ListMissionControlVoiceCandidatesData = {
+    body?: never;
+    path?: never;
+    query: {
+        project_id: string;
+        status?: 'draft' | 'promoted' | 'rejected' | 'expired';
+        limit?: number;
+    };
+    url: '/api/v1/staff/mission-control/voice-candidates';
+};
+
+export type ListMissionControlVoiceCandidatesErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+};
+
+export type ListMissionControlVoiceCandidatesError = ListMissionControlVoiceCandidatesErrors[keyof ListMissionControlVoiceCandidatesErrors];
+
+export type ListMissionControlVoiceCandidatesResponses = {
+    /**
+     * Voice candidates ordered newest first
+     */
+    200: MissionControlVoiceCandidateItemsResponse;
+};
+
+export type ListMissionControlVoiceCandidatesResponse = ListMissionControlVoiceCandidatesResponses[keyof ListMissionControlVoiceCandidatesResponses];
+
+export type PromoteMissionControlVoiceCandidateData = {
+    body: MissionControlVoiceCandidatePromoteRequest;
+    path: {
+        candidate_id: string;
+    };
+    query?: never;
+    url: '/api/v1/staff/mission-control/voice-candidates/{candidate_id}/promote';
+};
+
+export type PromoteMissionControlVoiceCandidateErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+    /**
+     * Conflict
+     */
+    409: ErrorResponse;
+};
+
+export type PromoteMissionControlVoiceCandidateError = PromoteMissionControlVoiceCandidateErrors[keyof PromoteMissionControlVoiceCandidateErrors];
+
+export type PromoteMissionControlVoiceCandidateResponses = {
+    /**
+     * Voice candidate promoted
+     */
+    200: MissionControlVoiceCandidatePromotion;
+};
+
+export type PromoteMissionControlVoiceCandidateResponse = PromoteMissionControlVoiceCandidateResponses[keyof PromoteMissionControlVoiceCandidateResponses];
+
+export type RejectMissionControlVoiceCandidateData = {
+    body: MissionControlVoiceCandidateRejectRequest;
+    path: {
+        candidate_id: string;
+    };
+    query?: never;
+    url: '/api/v1/staff/mission-control/voice-candidates/{candidate_id}/reject';
+};
+
+export type RejectMissionControlVoiceCandidateErrors = {
+    /**
+     * Invalid request
+     */
+    400: ErrorResponse;
+    /**
+     * Unauthorized
+     */
+    401: ErrorResponse;
+    /**
+     * Forbidden
+     */
+    403: ErrorResponse;
+    /**
+     * Not found
+     */
+    404: ErrorResponse;
+    /**
+     * Conflict
+     */
+    409: ErrorResponse;
+};
+
+export type RejectMissionControlVoiceCandidateError = RejectMissionControlVoiceCandidateErrors[keyof RejectMissionControlVoiceCandidateErrors];
+
+export type RejectMissionControlVoiceCandidateResponses = {
+    /**
+     * Voice candidate rejected
+     */
+    200: MissionControlVoiceCandidate;
+};
+
+export type RejectMissionControlVoiceCandidateResponse = RejectMissionControlVoiceCandidateResponses[keyof RejectMissionControlVoiceCandidateResponses];
+
 export type MissionControlRealtimeData = {
     body?: never;
     path?: never;